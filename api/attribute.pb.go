@@ -107,11 +107,12 @@ func (LsOspfRouteType) EnumDescriptor() ([]byte, []int) {
 type LsNLRIType int32
 
 const (
-	LsNLRIType_LS_NLRI_UNKNOWN   LsNLRIType = 0
-	LsNLRIType_LS_NLRI_NODE      LsNLRIType = 1
-	LsNLRIType_LS_NLRI_LINK      LsNLRIType = 2
-	LsNLRIType_LS_NLRI_PREFIX_V4 LsNLRIType = 3
-	LsNLRIType_LS_NLRI_PREFIX_V6 LsNLRIType = 4
+	LsNLRIType_LS_NLRI_UNKNOWN                  LsNLRIType = 0
+	LsNLRIType_LS_NLRI_NODE                     LsNLRIType = 1
+	LsNLRIType_LS_NLRI_LINK                     LsNLRIType = 2
+	LsNLRIType_LS_NLRI_PREFIX_V4                LsNLRIType = 3
+	LsNLRIType_LS_NLRI_PREFIX_V6                LsNLRIType = 4
+	LsNLRIType_LS_NLRI_SR_POLICY_CANDIDATE_PATH LsNLRIType = 5
 )
 
 // Enum value maps for LsNLRIType.
@@ -122,13 +123,15 @@ var (
 		2: "LS_NLRI_LINK",
 		3: "LS_NLRI_PREFIX_V4",
 		4: "LS_NLRI_PREFIX_V6",
+		5: "LS_NLRI_SR_POLICY_CANDIDATE_PATH",
 	}
 	LsNLRIType_value = map[string]int32{
-		"LS_NLRI_UNKNOWN":   0,
-		"LS_NLRI_NODE":      1,
-		"LS_NLRI_LINK":      2,
-		"LS_NLRI_PREFIX_V4": 3,
-		"LS_NLRI_PREFIX_V6": 4,
+		"LS_NLRI_UNKNOWN":                  0,
+		"LS_NLRI_NODE":                     1,
+		"LS_NLRI_LINK":                     2,
+		"LS_NLRI_PREFIX_V4":                3,
+		"LS_NLRI_PREFIX_V6":                4,
+		"LS_NLRI_SR_POLICY_CANDIDATE_PATH": 5,
 	}
 )
 
@@ -169,6 +172,9 @@ const (
 	LsProtocolID_LS_PROTOCOL_DIRECT  LsProtocolID = 4
 	LsProtocolID_LS_PROTOCOL_STATIC  LsProtocolID = 5
 	LsProtocolID_LS_PROTOCOL_OSPF_V3 LsProtocolID = 6
+	LsProtocolID_LS_PROTOCOL_BGP     LsProtocolID = 7
+	LsProtocolID_LS_PROTOCOL_RSVP_TE LsProtocolID = 8
+	LsProtocolID_LS_PROTOCOL_SR      LsProtocolID = 9
 )
 
 // Enum value maps for LsProtocolID.
@@ -181,6 +187,9 @@ var (
 		4: "LS_PROTOCOL_DIRECT",
 		5: "LS_PROTOCOL_STATIC",
 		6: "LS_PROTOCOL_OSPF_V3",
+		7: "LS_PROTOCOL_BGP",
+		8: "LS_PROTOCOL_RSVP_TE",
+		9: "LS_PROTOCOL_SR",
 	}
 	LsProtocolID_value = map[string]int32{
 		"LS_PROTOCOL_UNKNOWN": 0,
@@ -190,6 +199,9 @@ var (
 		"LS_PROTOCOL_DIRECT":  4,
 		"LS_PROTOCOL_STATIC":  5,
 		"LS_PROTOCOL_OSPF_V3": 6,
+		"LS_PROTOCOL_BGP":     7,
+		"LS_PROTOCOL_RSVP_TE": 8,
+		"LS_PROTOCOL_SR":      9,
 	}
 )
 
@@ -1905,6 +1917,320 @@ func (x *EVPNIPPrefixRoute) GetLabel() uint32 {
 	return 0
 }
 
+// EVPNSelectiveMulticastEthernetTagRoute represents the NLRI for:
+// - AFI=25, SAFI=70, RouteType=6
+type EVPNSelectiveMulticastEthernetTagRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One of:
+	// - RouteDistinguisherTwoOctetASN
+	// - RouteDistinguisherIPAddress
+	// - RouteDistinguisherFourOctetASN
+	Rd          *anypb.Any `protobuf:"bytes,1,opt,name=rd,proto3" json:"rd,omitempty"`
+	EthernetTag uint32     `protobuf:"varint,2,opt,name=ethernet_tag,json=ethernetTag,proto3" json:"ethernet_tag,omitempty"`
+	// empty for any source
+	SourceAddress     string `protobuf:"bytes,3,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
+	GroupAddress      string `protobuf:"bytes,4,opt,name=group_address,json=groupAddress,proto3" json:"group_address,omitempty"`
+	OriginatorAddress string `protobuf:"bytes,5,opt,name=originator_address,json=originatorAddress,proto3" json:"originator_address,omitempty"`
+	Flags             uint32 `protobuf:"varint,6,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *EVPNSelectiveMulticastEthernetTagRoute) Reset() {
+	*x = EVPNSelectiveMulticastEthernetTagRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EVPNSelectiveMulticastEthernetTagRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EVPNSelectiveMulticastEthernetTagRoute) ProtoMessage() {}
+
+func (x *EVPNSelectiveMulticastEthernetTagRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EVPNSelectiveMulticastEthernetTagRoute.ProtoReflect.Descriptor instead.
+func (*EVPNSelectiveMulticastEthernetTagRoute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *EVPNSelectiveMulticastEthernetTagRoute) GetRd() *anypb.Any {
+	if x != nil {
+		return x.Rd
+	}
+	return nil
+}
+
+func (x *EVPNSelectiveMulticastEthernetTagRoute) GetEthernetTag() uint32 {
+	if x != nil {
+		return x.EthernetTag
+	}
+	return 0
+}
+
+func (x *EVPNSelectiveMulticastEthernetTagRoute) GetSourceAddress() string {
+	if x != nil {
+		return x.SourceAddress
+	}
+	return ""
+}
+
+func (x *EVPNSelectiveMulticastEthernetTagRoute) GetGroupAddress() string {
+	if x != nil {
+		return x.GroupAddress
+	}
+	return ""
+}
+
+func (x *EVPNSelectiveMulticastEthernetTagRoute) GetOriginatorAddress() string {
+	if x != nil {
+		return x.OriginatorAddress
+	}
+	return ""
+}
+
+func (x *EVPNSelectiveMulticastEthernetTagRoute) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+// EVPNMulticastJoinSynchRoute represents the NLRI for:
+// - AFI=25, SAFI=70, RouteType=7
+type EVPNMulticastJoinSynchRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One of:
+	// - RouteDistinguisherTwoOctetASN
+	// - RouteDistinguisherIPAddress
+	// - RouteDistinguisherFourOctetASN
+	Rd          *anypb.Any                 `protobuf:"bytes,1,opt,name=rd,proto3" json:"rd,omitempty"`
+	Esi         *EthernetSegmentIdentifier `protobuf:"bytes,2,opt,name=esi,proto3" json:"esi,omitempty"`
+	EthernetTag uint32                     `protobuf:"varint,3,opt,name=ethernet_tag,json=ethernetTag,proto3" json:"ethernet_tag,omitempty"`
+	// empty for any source
+	SourceAddress     string `protobuf:"bytes,4,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
+	GroupAddress      string `protobuf:"bytes,5,opt,name=group_address,json=groupAddress,proto3" json:"group_address,omitempty"`
+	OriginatorAddress string `protobuf:"bytes,6,opt,name=originator_address,json=originatorAddress,proto3" json:"originator_address,omitempty"`
+	Flags             uint32 `protobuf:"varint,7,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *EVPNMulticastJoinSynchRoute) Reset() {
+	*x = EVPNMulticastJoinSynchRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EVPNMulticastJoinSynchRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EVPNMulticastJoinSynchRoute) ProtoMessage() {}
+
+func (x *EVPNMulticastJoinSynchRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EVPNMulticastJoinSynchRoute.ProtoReflect.Descriptor instead.
+func (*EVPNMulticastJoinSynchRoute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *EVPNMulticastJoinSynchRoute) GetRd() *anypb.Any {
+	if x != nil {
+		return x.Rd
+	}
+	return nil
+}
+
+func (x *EVPNMulticastJoinSynchRoute) GetEsi() *EthernetSegmentIdentifier {
+	if x != nil {
+		return x.Esi
+	}
+	return nil
+}
+
+func (x *EVPNMulticastJoinSynchRoute) GetEthernetTag() uint32 {
+	if x != nil {
+		return x.EthernetTag
+	}
+	return 0
+}
+
+func (x *EVPNMulticastJoinSynchRoute) GetSourceAddress() string {
+	if x != nil {
+		return x.SourceAddress
+	}
+	return ""
+}
+
+func (x *EVPNMulticastJoinSynchRoute) GetGroupAddress() string {
+	if x != nil {
+		return x.GroupAddress
+	}
+	return ""
+}
+
+func (x *EVPNMulticastJoinSynchRoute) GetOriginatorAddress() string {
+	if x != nil {
+		return x.OriginatorAddress
+	}
+	return ""
+}
+
+func (x *EVPNMulticastJoinSynchRoute) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+// EVPNMulticastLeaveSynchRoute represents the NLRI for:
+// - AFI=25, SAFI=70, RouteType=8
+type EVPNMulticastLeaveSynchRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One of:
+	// - RouteDistinguisherTwoOctetASN
+	// - RouteDistinguisherIPAddress
+	// - RouteDistinguisherFourOctetASN
+	Rd          *anypb.Any                 `protobuf:"bytes,1,opt,name=rd,proto3" json:"rd,omitempty"`
+	Esi         *EthernetSegmentIdentifier `protobuf:"bytes,2,opt,name=esi,proto3" json:"esi,omitempty"`
+	EthernetTag uint32                     `protobuf:"varint,3,opt,name=ethernet_tag,json=ethernetTag,proto3" json:"ethernet_tag,omitempty"`
+	// empty for any source
+	SourceAddress     string `protobuf:"bytes,4,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
+	GroupAddress      string `protobuf:"bytes,5,opt,name=group_address,json=groupAddress,proto3" json:"group_address,omitempty"`
+	OriginatorAddress string `protobuf:"bytes,6,opt,name=originator_address,json=originatorAddress,proto3" json:"originator_address,omitempty"`
+	SyncNumber        uint32 `protobuf:"varint,7,opt,name=sync_number,json=syncNumber,proto3" json:"sync_number,omitempty"`
+	MaxResponseTime   uint32 `protobuf:"varint,8,opt,name=max_response_time,json=maxResponseTime,proto3" json:"max_response_time,omitempty"`
+	Flags             uint32 `protobuf:"varint,9,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *EVPNMulticastLeaveSynchRoute) Reset() {
+	*x = EVPNMulticastLeaveSynchRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EVPNMulticastLeaveSynchRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EVPNMulticastLeaveSynchRoute) ProtoMessage() {}
+
+func (x *EVPNMulticastLeaveSynchRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EVPNMulticastLeaveSynchRoute.ProtoReflect.Descriptor instead.
+func (*EVPNMulticastLeaveSynchRoute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *EVPNMulticastLeaveSynchRoute) GetRd() *anypb.Any {
+	if x != nil {
+		return x.Rd
+	}
+	return nil
+}
+
+func (x *EVPNMulticastLeaveSynchRoute) GetEsi() *EthernetSegmentIdentifier {
+	if x != nil {
+		return x.Esi
+	}
+	return nil
+}
+
+func (x *EVPNMulticastLeaveSynchRoute) GetEthernetTag() uint32 {
+	if x != nil {
+		return x.EthernetTag
+	}
+	return 0
+}
+
+func (x *EVPNMulticastLeaveSynchRoute) GetSourceAddress() string {
+	if x != nil {
+		return x.SourceAddress
+	}
+	return ""
+}
+
+func (x *EVPNMulticastLeaveSynchRoute) GetGroupAddress() string {
+	if x != nil {
+		return x.GroupAddress
+	}
+	return ""
+}
+
+func (x *EVPNMulticastLeaveSynchRoute) GetOriginatorAddress() string {
+	if x != nil {
+		return x.OriginatorAddress
+	}
+	return ""
+}
+
+func (x *EVPNMulticastLeaveSynchRoute) GetSyncNumber() uint32 {
+	if x != nil {
+		return x.SyncNumber
+	}
+	return 0
+}
+
+func (x *EVPNMulticastLeaveSynchRoute) GetMaxResponseTime() uint32 {
+	if x != nil {
+		return x.MaxResponseTime
+	}
+	return 0
+}
+
+func (x *EVPNMulticastLeaveSynchRoute) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
 // EVPNIPMSIRoute represents the NLRI for:
 // - AFI=25, SAFI=70, RouteType=9
 type EVPNIPMSIRoute struct {
@@ -1924,7 +2250,7 @@ type EVPNIPMSIRoute struct {
 func (x *EVPNIPMSIRoute) Reset() {
 	*x = EVPNIPMSIRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[24]
+		mi := &file_attribute_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1937,7 +2263,7 @@ func (x *EVPNIPMSIRoute) String() string {
 func (*EVPNIPMSIRoute) ProtoMessage() {}
 
 func (x *EVPNIPMSIRoute) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[24]
+	mi := &file_attribute_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1950,7 +2276,7 @@ func (x *EVPNIPMSIRoute) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EVPNIPMSIRoute.ProtoReflect.Descriptor instead.
 func (*EVPNIPMSIRoute) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{24}
+	return file_attribute_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *EVPNIPMSIRoute) GetRd() *anypb.Any {
@@ -2001,7 +2327,7 @@ type SRPolicyNLRI struct {
 func (x *SRPolicyNLRI) Reset() {
 	*x = SRPolicyNLRI{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[25]
+		mi := &file_attribute_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2014,7 +2340,7 @@ func (x *SRPolicyNLRI) String() string {
 func (*SRPolicyNLRI) ProtoMessage() {}
 
 func (x *SRPolicyNLRI) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[25]
+	mi := &file_attribute_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2027,7 +2353,7 @@ func (x *SRPolicyNLRI) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SRPolicyNLRI.ProtoReflect.Descriptor instead.
 func (*SRPolicyNLRI) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{25}
+	return file_attribute_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *SRPolicyNLRI) GetLength() uint32 {
@@ -2061,6 +2387,8 @@ func (x *SRPolicyNLRI) GetEndpoint() []byte {
 // LabeledVPNIPAddressPrefix represents the NLRI for:
 // - AFI=1, SAFI=128
 // - AFI=2, SAFI=128
+// - AFI=1, SAFI=76
+// - AFI=2, SAFI=76
 type LabeledVPNIPAddressPrefix struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2079,7 +2407,7 @@ type LabeledVPNIPAddressPrefix struct {
 func (x *LabeledVPNIPAddressPrefix) Reset() {
 	*x = LabeledVPNIPAddressPrefix{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[26]
+		mi := &file_attribute_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2092,7 +2420,7 @@ func (x *LabeledVPNIPAddressPrefix) String() string {
 func (*LabeledVPNIPAddressPrefix) ProtoMessage() {}
 
 func (x *LabeledVPNIPAddressPrefix) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[26]
+	mi := &file_attribute_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2105,7 +2433,7 @@ func (x *LabeledVPNIPAddressPrefix) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LabeledVPNIPAddressPrefix.ProtoReflect.Descriptor instead.
 func (*LabeledVPNIPAddressPrefix) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{26}
+	return file_attribute_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *LabeledVPNIPAddressPrefix) GetLabels() []uint32 {
@@ -2154,7 +2482,7 @@ type RouteTargetMembershipNLRI struct {
 func (x *RouteTargetMembershipNLRI) Reset() {
 	*x = RouteTargetMembershipNLRI{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[27]
+		mi := &file_attribute_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2167,7 +2495,7 @@ func (x *RouteTargetMembershipNLRI) String() string {
 func (*RouteTargetMembershipNLRI) ProtoMessage() {}
 
 func (x *RouteTargetMembershipNLRI) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[27]
+	mi := &file_attribute_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2180,7 +2508,7 @@ func (x *RouteTargetMembershipNLRI) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteTargetMembershipNLRI.ProtoReflect.Descriptor instead.
 func (*RouteTargetMembershipNLRI) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{27}
+	return file_attribute_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *RouteTargetMembershipNLRI) GetAsn() uint32 {
@@ -2212,7 +2540,7 @@ type FlowSpecIPPrefix struct {
 func (x *FlowSpecIPPrefix) Reset() {
 	*x = FlowSpecIPPrefix{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[28]
+		mi := &file_attribute_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2225,7 +2553,7 @@ func (x *FlowSpecIPPrefix) String() string {
 func (*FlowSpecIPPrefix) ProtoMessage() {}
 
 func (x *FlowSpecIPPrefix) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[28]
+	mi := &file_attribute_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2238,7 +2566,7 @@ func (x *FlowSpecIPPrefix) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FlowSpecIPPrefix.ProtoReflect.Descriptor instead.
 func (*FlowSpecIPPrefix) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{28}
+	return file_attribute_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *FlowSpecIPPrefix) GetType() uint32 {
@@ -2281,7 +2609,7 @@ type FlowSpecMAC struct {
 func (x *FlowSpecMAC) Reset() {
 	*x = FlowSpecMAC{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[29]
+		mi := &file_attribute_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2294,7 +2622,7 @@ func (x *FlowSpecMAC) String() string {
 func (*FlowSpecMAC) ProtoMessage() {}
 
 func (x *FlowSpecMAC) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[29]
+	mi := &file_attribute_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2307,7 +2635,7 @@ func (x *FlowSpecMAC) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FlowSpecMAC.ProtoReflect.Descriptor instead.
 func (*FlowSpecMAC) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{29}
+	return file_attribute_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *FlowSpecMAC) GetType() uint32 {
@@ -2337,7 +2665,7 @@ type FlowSpecComponentItem struct {
 func (x *FlowSpecComponentItem) Reset() {
 	*x = FlowSpecComponentItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[30]
+		mi := &file_attribute_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2350,7 +2678,7 @@ func (x *FlowSpecComponentItem) String() string {
 func (*FlowSpecComponentItem) ProtoMessage() {}
 
 func (x *FlowSpecComponentItem) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[30]
+	mi := &file_attribute_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2363,7 +2691,7 @@ func (x *FlowSpecComponentItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FlowSpecComponentItem.ProtoReflect.Descriptor instead.
 func (*FlowSpecComponentItem) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{30}
+	return file_attribute_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *FlowSpecComponentItem) GetOp() uint32 {
@@ -2392,7 +2720,7 @@ type FlowSpecComponent struct {
 func (x *FlowSpecComponent) Reset() {
 	*x = FlowSpecComponent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[31]
+		mi := &file_attribute_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2405,7 +2733,7 @@ func (x *FlowSpecComponent) String() string {
 func (*FlowSpecComponent) ProtoMessage() {}
 
 func (x *FlowSpecComponent) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[31]
+	mi := &file_attribute_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2418,7 +2746,7 @@ func (x *FlowSpecComponent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FlowSpecComponent.ProtoReflect.Descriptor instead.
 func (*FlowSpecComponent) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{31}
+	return file_attribute_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *FlowSpecComponent) GetType() uint32 {
@@ -2453,7 +2781,7 @@ type FlowSpecNLRI struct {
 func (x *FlowSpecNLRI) Reset() {
 	*x = FlowSpecNLRI{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[32]
+		mi := &file_attribute_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2466,7 +2794,7 @@ func (x *FlowSpecNLRI) String() string {
 func (*FlowSpecNLRI) ProtoMessage() {}
 
 func (x *FlowSpecNLRI) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[32]
+	mi := &file_attribute_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2479,7 +2807,7 @@ func (x *FlowSpecNLRI) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FlowSpecNLRI.ProtoReflect.Descriptor instead.
 func (*FlowSpecNLRI) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{32}
+	return file_attribute_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *FlowSpecNLRI) GetRules() []*anypb.Any {
@@ -2513,7 +2841,7 @@ type VPNFlowSpecNLRI struct {
 func (x *VPNFlowSpecNLRI) Reset() {
 	*x = VPNFlowSpecNLRI{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[33]
+		mi := &file_attribute_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2526,7 +2854,7 @@ func (x *VPNFlowSpecNLRI) String() string {
 func (*VPNFlowSpecNLRI) ProtoMessage() {}
 
 func (x *VPNFlowSpecNLRI) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[33]
+	mi := &file_attribute_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2539,7 +2867,7 @@ func (x *VPNFlowSpecNLRI) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VPNFlowSpecNLRI.ProtoReflect.Descriptor instead.
 func (*VPNFlowSpecNLRI) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{33}
+	return file_attribute_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *VPNFlowSpecNLRI) GetRd() *anypb.Any {
@@ -2570,7 +2898,7 @@ type OpaqueNLRI struct {
 func (x *OpaqueNLRI) Reset() {
 	*x = OpaqueNLRI{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[34]
+		mi := &file_attribute_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2583,7 +2911,7 @@ func (x *OpaqueNLRI) String() string {
 func (*OpaqueNLRI) ProtoMessage() {}
 
 func (x *OpaqueNLRI) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[34]
+	mi := &file_attribute_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2596,7 +2924,7 @@ func (x *OpaqueNLRI) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OpaqueNLRI.ProtoReflect.Descriptor instead.
 func (*OpaqueNLRI) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{34}
+	return file_attribute_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *OpaqueNLRI) GetKey() []byte {
@@ -2630,7 +2958,7 @@ type LsNodeDescriptor struct {
 func (x *LsNodeDescriptor) Reset() {
 	*x = LsNodeDescriptor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[35]
+		mi := &file_attribute_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2643,7 +2971,7 @@ func (x *LsNodeDescriptor) String() string {
 func (*LsNodeDescriptor) ProtoMessage() {}
 
 func (x *LsNodeDescriptor) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[35]
+	mi := &file_attribute_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2656,7 +2984,7 @@ func (x *LsNodeDescriptor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LsNodeDescriptor.ProtoReflect.Descriptor instead.
 func (*LsNodeDescriptor) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{35}
+	return file_attribute_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *LsNodeDescriptor) GetAsn() uint32 {
@@ -2724,7 +3052,7 @@ type LsLinkDescriptor struct {
 func (x *LsLinkDescriptor) Reset() {
 	*x = LsLinkDescriptor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[36]
+		mi := &file_attribute_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2737,7 +3065,7 @@ func (x *LsLinkDescriptor) String() string {
 func (*LsLinkDescriptor) ProtoMessage() {}
 
 func (x *LsLinkDescriptor) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[36]
+	mi := &file_attribute_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2750,7 +3078,7 @@ func (x *LsLinkDescriptor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LsLinkDescriptor.ProtoReflect.Descriptor instead.
 func (*LsLinkDescriptor) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{36}
+	return file_attribute_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *LsLinkDescriptor) GetLinkLocalId() uint32 {
@@ -2807,7 +3135,7 @@ type LsPrefixDescriptor struct {
 func (x *LsPrefixDescriptor) Reset() {
 	*x = LsPrefixDescriptor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[37]
+		mi := &file_attribute_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2820,7 +3148,7 @@ func (x *LsPrefixDescriptor) String() string {
 func (*LsPrefixDescriptor) ProtoMessage() {}
 
 func (x *LsPrefixDescriptor) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[37]
+	mi := &file_attribute_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2833,7 +3161,7 @@ func (x *LsPrefixDescriptor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LsPrefixDescriptor.ProtoReflect.Descriptor instead.
 func (*LsPrefixDescriptor) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{37}
+	return file_attribute_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *LsPrefixDescriptor) GetIpReachability() []string {
@@ -2861,7 +3189,7 @@ type LsNodeNLRI struct {
 func (x *LsNodeNLRI) Reset() {
 	*x = LsNodeNLRI{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[38]
+		mi := &file_attribute_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2874,7 +3202,7 @@ func (x *LsNodeNLRI) String() string {
 func (*LsNodeNLRI) ProtoMessage() {}
 
 func (x *LsNodeNLRI) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[38]
+	mi := &file_attribute_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2887,7 +3215,7 @@ func (x *LsNodeNLRI) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LsNodeNLRI.ProtoReflect.Descriptor instead.
 func (*LsNodeNLRI) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{38}
+	return file_attribute_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *LsNodeNLRI) GetLocalNode() *LsNodeDescriptor {
@@ -2910,7 +3238,7 @@ type LsLinkNLRI struct {
 func (x *LsLinkNLRI) Reset() {
 	*x = LsLinkNLRI{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[39]
+		mi := &file_attribute_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2923,7 +3251,7 @@ func (x *LsLinkNLRI) String() string {
 func (*LsLinkNLRI) ProtoMessage() {}
 
 func (x *LsLinkNLRI) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[39]
+	mi := &file_attribute_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2936,7 +3264,7 @@ func (x *LsLinkNLRI) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LsLinkNLRI.ProtoReflect.Descriptor instead.
 func (*LsLinkNLRI) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{39}
+	return file_attribute_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *LsLinkNLRI) GetLocalNode() *LsNodeDescriptor {
@@ -2972,7 +3300,7 @@ type LsPrefixV4NLRI struct {
 func (x *LsPrefixV4NLRI) Reset() {
 	*x = LsPrefixV4NLRI{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[40]
+		mi := &file_attribute_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2985,7 +3313,7 @@ func (x *LsPrefixV4NLRI) String() string {
 func (*LsPrefixV4NLRI) ProtoMessage() {}
 
 func (x *LsPrefixV4NLRI) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[40]
+	mi := &file_attribute_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2998,7 +3326,7 @@ func (x *LsPrefixV4NLRI) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LsPrefixV4NLRI.ProtoReflect.Descriptor instead.
 func (*LsPrefixV4NLRI) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{40}
+	return file_attribute_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *LsPrefixV4NLRI) GetLocalNode() *LsNodeDescriptor {
@@ -3027,7 +3355,7 @@ type LsPrefixV6NLRI struct {
 func (x *LsPrefixV6NLRI) Reset() {
 	*x = LsPrefixV6NLRI{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[41]
+		mi := &file_attribute_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3040,7 +3368,7 @@ func (x *LsPrefixV6NLRI) String() string {
 func (*LsPrefixV6NLRI) ProtoMessage() {}
 
 func (x *LsPrefixV6NLRI) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[41]
+	mi := &file_attribute_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3053,7 +3381,7 @@ func (x *LsPrefixV6NLRI) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LsPrefixV6NLRI.ProtoReflect.Descriptor instead.
 func (*LsPrefixV6NLRI) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{41}
+	return file_attribute_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *LsPrefixV6NLRI) GetLocalNode() *LsNodeDescriptor {
@@ -3070,6 +3398,149 @@ func (x *LsPrefixV6NLRI) GetPrefixDescriptor() *LsPrefixDescriptor {
 	return nil
 }
 
+// https://www.rfc-editor.org/rfc/rfc9857.html#section-5.1
+type LsSrPolicyCandidatePathDescriptor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolOrigin    uint32 `protobuf:"varint,1,opt,name=protocol_origin,json=protocolOrigin,proto3" json:"protocol_origin,omitempty"`
+	Endpoint          string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Color             uint32 `protobuf:"varint,3,opt,name=color,proto3" json:"color,omitempty"`
+	OriginatorAsn     uint32 `protobuf:"varint,4,opt,name=originator_asn,json=originatorAsn,proto3" json:"originator_asn,omitempty"`
+	OriginatorAddress string `protobuf:"bytes,5,opt,name=originator_address,json=originatorAddress,proto3" json:"originator_address,omitempty"`
+	Discriminator     uint32 `protobuf:"varint,6,opt,name=discriminator,proto3" json:"discriminator,omitempty"`
+}
+
+func (x *LsSrPolicyCandidatePathDescriptor) Reset() {
+	*x = LsSrPolicyCandidatePathDescriptor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsSrPolicyCandidatePathDescriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsSrPolicyCandidatePathDescriptor) ProtoMessage() {}
+
+func (x *LsSrPolicyCandidatePathDescriptor) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsSrPolicyCandidatePathDescriptor.ProtoReflect.Descriptor instead.
+func (*LsSrPolicyCandidatePathDescriptor) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *LsSrPolicyCandidatePathDescriptor) GetProtocolOrigin() uint32 {
+	if x != nil {
+		return x.ProtocolOrigin
+	}
+	return 0
+}
+
+func (x *LsSrPolicyCandidatePathDescriptor) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *LsSrPolicyCandidatePathDescriptor) GetColor() uint32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *LsSrPolicyCandidatePathDescriptor) GetOriginatorAsn() uint32 {
+	if x != nil {
+		return x.OriginatorAsn
+	}
+	return 0
+}
+
+func (x *LsSrPolicyCandidatePathDescriptor) GetOriginatorAddress() string {
+	if x != nil {
+		return x.OriginatorAddress
+	}
+	return ""
+}
+
+func (x *LsSrPolicyCandidatePathDescriptor) GetDiscriminator() uint32 {
+	if x != nil {
+		return x.Discriminator
+	}
+	return 0
+}
+
+type LsSrPolicyCandidatePathNLRI struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocalNode               *LsNodeDescriptor                  `protobuf:"bytes,1,opt,name=local_node,json=localNode,proto3" json:"local_node,omitempty"`
+	CandidatePathDescriptor *LsSrPolicyCandidatePathDescriptor `protobuf:"bytes,2,opt,name=candidate_path_descriptor,json=candidatePathDescriptor,proto3" json:"candidate_path_descriptor,omitempty"`
+}
+
+func (x *LsSrPolicyCandidatePathNLRI) Reset() {
+	*x = LsSrPolicyCandidatePathNLRI{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsSrPolicyCandidatePathNLRI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsSrPolicyCandidatePathNLRI) ProtoMessage() {}
+
+func (x *LsSrPolicyCandidatePathNLRI) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsSrPolicyCandidatePathNLRI.ProtoReflect.Descriptor instead.
+func (*LsSrPolicyCandidatePathNLRI) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *LsSrPolicyCandidatePathNLRI) GetLocalNode() *LsNodeDescriptor {
+	if x != nil {
+		return x.LocalNode
+	}
+	return nil
+}
+
+func (x *LsSrPolicyCandidatePathNLRI) GetCandidatePathDescriptor() *LsSrPolicyCandidatePathDescriptor {
+	if x != nil {
+		return x.CandidatePathDescriptor
+	}
+	return nil
+}
+
 // LsAddrPrefix represents the NLRI for:
 // - AFI=16388, SAFI=71
 type LsAddrPrefix struct {
@@ -3083,6 +3554,7 @@ type LsAddrPrefix struct {
 	// - LsLinkNLRI
 	// - LsPrefixV4NLRI
 	// - LsPrefixV6NLRI
+	// - LsSrPolicyCandidatePathNLRI
 	Nlri       *anypb.Any   `protobuf:"bytes,2,opt,name=nlri,proto3" json:"nlri,omitempty"`
 	Length     uint32       `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
 	ProtocolId LsProtocolID `protobuf:"varint,4,opt,name=protocol_id,json=protocolId,proto3,enum=apipb.LsProtocolID" json:"protocol_id,omitempty"`
@@ -3092,7 +3564,7 @@ type LsAddrPrefix struct {
 func (x *LsAddrPrefix) Reset() {
 	*x = LsAddrPrefix{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[42]
+		mi := &file_attribute_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3105,7 +3577,7 @@ func (x *LsAddrPrefix) String() string {
 func (*LsAddrPrefix) ProtoMessage() {}
 
 func (x *LsAddrPrefix) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[42]
+	mi := &file_attribute_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3118,7 +3590,7 @@ func (x *LsAddrPrefix) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LsAddrPrefix.ProtoReflect.Descriptor instead.
 func (*LsAddrPrefix) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{42}
+	return file_attribute_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *LsAddrPrefix) GetType() LsNLRIType {
@@ -3172,7 +3644,7 @@ type MUPInterworkSegmentDiscoveryRoute struct {
 func (x *MUPInterworkSegmentDiscoveryRoute) Reset() {
 	*x = MUPInterworkSegmentDiscoveryRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[43]
+		mi := &file_attribute_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3185,7 +3657,7 @@ func (x *MUPInterworkSegmentDiscoveryRoute) String() string {
 func (*MUPInterworkSegmentDiscoveryRoute) ProtoMessage() {}
 
 func (x *MUPInterworkSegmentDiscoveryRoute) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[43]
+	mi := &file_attribute_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3198,7 +3670,7 @@ func (x *MUPInterworkSegmentDiscoveryRoute) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use MUPInterworkSegmentDiscoveryRoute.ProtoReflect.Descriptor instead.
 func (*MUPInterworkSegmentDiscoveryRoute) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{43}
+	return file_attribute_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *MUPInterworkSegmentDiscoveryRoute) GetRd() *anypb.Any {
@@ -3231,7 +3703,7 @@ type MUPDirectSegmentDiscoveryRoute struct {
 func (x *MUPDirectSegmentDiscoveryRoute) Reset() {
 	*x = MUPDirectSegmentDiscoveryRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[44]
+		mi := &file_attribute_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3244,7 +3716,7 @@ func (x *MUPDirectSegmentDiscoveryRoute) String() string {
 func (*MUPDirectSegmentDiscoveryRoute) ProtoMessage() {}
 
 func (x *MUPDirectSegmentDiscoveryRoute) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[44]
+	mi := &file_attribute_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3257,7 +3729,7 @@ func (x *MUPDirectSegmentDiscoveryRoute) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MUPDirectSegmentDiscoveryRoute.ProtoReflect.Descriptor instead.
 func (*MUPDirectSegmentDiscoveryRoute) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{44}
+	return file_attribute_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *MUPDirectSegmentDiscoveryRoute) GetRd() *anypb.Any {
@@ -3298,7 +3770,7 @@ type MUPType1SessionTransformedRoute struct {
 func (x *MUPType1SessionTransformedRoute) Reset() {
 	*x = MUPType1SessionTransformedRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[45]
+		mi := &file_attribute_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3311,7 +3783,7 @@ func (x *MUPType1SessionTransformedRoute) String() string {
 func (*MUPType1SessionTransformedRoute) ProtoMessage() {}
 
 func (x *MUPType1SessionTransformedRoute) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[45]
+	mi := &file_attribute_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3324,7 +3796,7 @@ func (x *MUPType1SessionTransformedRoute) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MUPType1SessionTransformedRoute.ProtoReflect.Descriptor instead.
 func (*MUPType1SessionTransformedRoute) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{45}
+	return file_attribute_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *MUPType1SessionTransformedRoute) GetRd() *anypb.Any {
@@ -3409,7 +3881,7 @@ type MUPType2SessionTransformedRoute struct {
 func (x *MUPType2SessionTransformedRoute) Reset() {
 	*x = MUPType2SessionTransformedRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[46]
+		mi := &file_attribute_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3422,7 +3894,7 @@ func (x *MUPType2SessionTransformedRoute) String() string {
 func (*MUPType2SessionTransformedRoute) ProtoMessage() {}
 
 func (x *MUPType2SessionTransformedRoute) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[46]
+	mi := &file_attribute_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3435,7 +3907,7 @@ func (x *MUPType2SessionTransformedRoute) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MUPType2SessionTransformedRoute.ProtoReflect.Descriptor instead.
 func (*MUPType2SessionTransformedRoute) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{46}
+	return file_attribute_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *MUPType2SessionTransformedRoute) GetRd() *anypb.Any {
@@ -3466,54 +3938,38 @@ func (x *MUPType2SessionTransformedRoute) GetTeid() uint32 {
 	return 0
 }
 
-type MpReachNLRIAttribute struct {
+// MVPNIntraASIPMSIADRoute represents the NLRI for:
+// - AFI=1 or 2, SAFI=5, RouteType=1
+type MVPNIntraASIPMSIADRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Family   *Family  `protobuf:"bytes,1,opt,name=family,proto3" json:"family,omitempty"`
-	NextHops []string `protobuf:"bytes,2,rep,name=next_hops,json=nextHops,proto3" json:"next_hops,omitempty"`
-	// Each NLRI must be one of:
-	// - IPAddressPrefix
-	// - LabeledIPAddressPrefix
-	// - EncapsulationNLRI
-	// - EVPNEthernetAutoDiscoveryRoute
-	// - EVPNMACIPAdvertisementRoute
-	// - EVPNInclusiveMulticastEthernetTagRoute
-	// - EVPNEthernetSegmentRoute
-	// - EVPNIPPrefixRoute
-	// - EVPNIPMSIRoute
-	// - LabeledVPNIPAddressPrefix
-	// - RouteTargetMembershipNLRI
-	// - FlowSpecNLRI
-	// - VPNFlowSpecNLRI
-	// - OpaqueNLRI
-	// - LsAddrPrefix
-	// - SR Policy NLRI
-	// - MUPInterworkSegmentDiscoveryRoute
-	// - MUPDirectSegmentDiscoveryRoute
-	// - MUPType1SessionTransformedRoute
-	// - MUPType2SessionTransformedRoute
-	Nlris []*anypb.Any `protobuf:"bytes,3,rep,name=nlris,proto3" json:"nlris,omitempty"`
+	// One of:
+	// - RouteDistinguisherTwoOctetASN
+	// - RouteDistinguisherIPAddress
+	// - RouteDistinguisherFourOctetASN
+	Rd                *anypb.Any `protobuf:"bytes,1,opt,name=rd,proto3" json:"rd,omitempty"`
+	OriginatorAddress string     `protobuf:"bytes,2,opt,name=originator_address,json=originatorAddress,proto3" json:"originator_address,omitempty"`
 }
 
-func (x *MpReachNLRIAttribute) Reset() {
-	*x = MpReachNLRIAttribute{}
+func (x *MVPNIntraASIPMSIADRoute) Reset() {
+	*x = MVPNIntraASIPMSIADRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[47]
+		mi := &file_attribute_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MpReachNLRIAttribute) String() string {
+func (x *MVPNIntraASIPMSIADRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MpReachNLRIAttribute) ProtoMessage() {}
+func (*MVPNIntraASIPMSIADRoute) ProtoMessage() {}
 
-func (x *MpReachNLRIAttribute) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[47]
+func (x *MVPNIntraASIPMSIADRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3524,59 +3980,57 @@ func (x *MpReachNLRIAttribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MpReachNLRIAttribute.ProtoReflect.Descriptor instead.
-func (*MpReachNLRIAttribute) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{47}
-}
-
-func (x *MpReachNLRIAttribute) GetFamily() *Family {
-	if x != nil {
-		return x.Family
-	}
-	return nil
+// Deprecated: Use MVPNIntraASIPMSIADRoute.ProtoReflect.Descriptor instead.
+func (*MVPNIntraASIPMSIADRoute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *MpReachNLRIAttribute) GetNextHops() []string {
+func (x *MVPNIntraASIPMSIADRoute) GetRd() *anypb.Any {
 	if x != nil {
-		return x.NextHops
+		return x.Rd
 	}
 	return nil
 }
 
-func (x *MpReachNLRIAttribute) GetNlris() []*anypb.Any {
+func (x *MVPNIntraASIPMSIADRoute) GetOriginatorAddress() string {
 	if x != nil {
-		return x.Nlris
+		return x.OriginatorAddress
 	}
-	return nil
+	return ""
 }
 
-type MpUnreachNLRIAttribute struct {
+// MVPNInterASIPMSIADRoute represents the NLRI for:
+// - AFI=1 or 2, SAFI=5, RouteType=2
+type MVPNInterASIPMSIADRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Family *Family `protobuf:"bytes,1,opt,name=family,proto3" json:"family,omitempty"`
-	// The same as NLRI field of MpReachNLRIAttribute
-	Nlris []*anypb.Any `protobuf:"bytes,3,rep,name=nlris,proto3" json:"nlris,omitempty"`
+	// One of:
+	// - RouteDistinguisherTwoOctetASN
+	// - RouteDistinguisherIPAddress
+	// - RouteDistinguisherFourOctetASN
+	Rd       *anypb.Any `protobuf:"bytes,1,opt,name=rd,proto3" json:"rd,omitempty"`
+	SourceAs uint32     `protobuf:"varint,2,opt,name=source_as,json=sourceAs,proto3" json:"source_as,omitempty"`
 }
 
-func (x *MpUnreachNLRIAttribute) Reset() {
-	*x = MpUnreachNLRIAttribute{}
+func (x *MVPNInterASIPMSIADRoute) Reset() {
+	*x = MVPNInterASIPMSIADRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[48]
+		mi := &file_attribute_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MpUnreachNLRIAttribute) String() string {
+func (x *MVPNInterASIPMSIADRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MpUnreachNLRIAttribute) ProtoMessage() {}
+func (*MVPNInterASIPMSIADRoute) ProtoMessage() {}
 
-func (x *MpUnreachNLRIAttribute) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[48]
+func (x *MVPNInterASIPMSIADRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3587,53 +4041,61 @@ func (x *MpUnreachNLRIAttribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MpUnreachNLRIAttribute.ProtoReflect.Descriptor instead.
-func (*MpUnreachNLRIAttribute) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use MVPNInterASIPMSIADRoute.ProtoReflect.Descriptor instead.
+func (*MVPNInterASIPMSIADRoute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *MpUnreachNLRIAttribute) GetFamily() *Family {
+func (x *MVPNInterASIPMSIADRoute) GetRd() *anypb.Any {
 	if x != nil {
-		return x.Family
+		return x.Rd
 	}
 	return nil
 }
 
-func (x *MpUnreachNLRIAttribute) GetNlris() []*anypb.Any {
+func (x *MVPNInterASIPMSIADRoute) GetSourceAs() uint32 {
 	if x != nil {
-		return x.Nlris
+		return x.SourceAs
 	}
-	return nil
+	return 0
 }
 
-type TwoOctetAsSpecificExtended struct {
+// MVPNSPMSIADRoute represents the NLRI for:
+// - AFI=1 or 2, SAFI=5, RouteType=3
+type MVPNSPMSIADRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsTransitive bool   `protobuf:"varint,1,opt,name=is_transitive,json=isTransitive,proto3" json:"is_transitive,omitempty"`
-	SubType      uint32 `protobuf:"varint,2,opt,name=sub_type,json=subType,proto3" json:"sub_type,omitempty"`
-	Asn          uint32 `protobuf:"varint,3,opt,name=asn,proto3" json:"asn,omitempty"`
-	LocalAdmin   uint32 `protobuf:"varint,4,opt,name=local_admin,json=localAdmin,proto3" json:"local_admin,omitempty"`
+	// One of:
+	// - RouteDistinguisherTwoOctetASN
+	// - RouteDistinguisherIPAddress
+	// - RouteDistinguisherFourOctetASN
+	Rd *anypb.Any `protobuf:"bytes,1,opt,name=rd,proto3" json:"rd,omitempty"`
+	// empty for the wildcard
+	SourceAddress string `protobuf:"bytes,2,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
+	// empty for the wildcard
+	GroupAddress      string `protobuf:"bytes,3,opt,name=group_address,json=groupAddress,proto3" json:"group_address,omitempty"`
+	OriginatorAddress string `protobuf:"bytes,4,opt,name=originator_address,json=originatorAddress,proto3" json:"originator_address,omitempty"`
 }
 
-func (x *TwoOctetAsSpecificExtended) Reset() {
-	*x = TwoOctetAsSpecificExtended{}
+func (x *MVPNSPMSIADRoute) Reset() {
+	*x = MVPNSPMSIADRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[49]
+		mi := &file_attribute_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TwoOctetAsSpecificExtended) String() string {
+func (x *MVPNSPMSIADRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TwoOctetAsSpecificExtended) ProtoMessage() {}
+func (*MVPNSPMSIADRoute) ProtoMessage() {}
 
-func (x *TwoOctetAsSpecificExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[49]
+func (x *MVPNSPMSIADRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3644,67 +4106,70 @@ func (x *TwoOctetAsSpecificExtended) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TwoOctetAsSpecificExtended.ProtoReflect.Descriptor instead.
-func (*TwoOctetAsSpecificExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use MVPNSPMSIADRoute.ProtoReflect.Descriptor instead.
+func (*MVPNSPMSIADRoute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *TwoOctetAsSpecificExtended) GetIsTransitive() bool {
+func (x *MVPNSPMSIADRoute) GetRd() *anypb.Any {
 	if x != nil {
-		return x.IsTransitive
+		return x.Rd
 	}
-	return false
+	return nil
 }
 
-func (x *TwoOctetAsSpecificExtended) GetSubType() uint32 {
+func (x *MVPNSPMSIADRoute) GetSourceAddress() string {
 	if x != nil {
-		return x.SubType
+		return x.SourceAddress
 	}
-	return 0
+	return ""
 }
 
-func (x *TwoOctetAsSpecificExtended) GetAsn() uint32 {
+func (x *MVPNSPMSIADRoute) GetGroupAddress() string {
 	if x != nil {
-		return x.Asn
+		return x.GroupAddress
 	}
-	return 0
+	return ""
 }
 
-func (x *TwoOctetAsSpecificExtended) GetLocalAdmin() uint32 {
+func (x *MVPNSPMSIADRoute) GetOriginatorAddress() string {
 	if x != nil {
-		return x.LocalAdmin
+		return x.OriginatorAddress
 	}
-	return 0
+	return ""
 }
 
-type IPv4AddressSpecificExtended struct {
+// MVPNLeafADRoute represents the NLRI for:
+// - AFI=1 or 2, SAFI=5, RouteType=4
+type MVPNLeafADRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsTransitive bool   `protobuf:"varint,1,opt,name=is_transitive,json=isTransitive,proto3" json:"is_transitive,omitempty"`
-	SubType      uint32 `protobuf:"varint,2,opt,name=sub_type,json=subType,proto3" json:"sub_type,omitempty"`
-	Address      string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	LocalAdmin   uint32 `protobuf:"varint,4,opt,name=local_admin,json=localAdmin,proto3" json:"local_admin,omitempty"`
+	// One of the other MVPN*Route, typically:
+	// - MVPNSPMSIADRoute
+	// - MVPNInterASIPMSIADRoute
+	RouteKey          *anypb.Any `protobuf:"bytes,1,opt,name=route_key,json=routeKey,proto3" json:"route_key,omitempty"`
+	OriginatorAddress string     `protobuf:"bytes,2,opt,name=originator_address,json=originatorAddress,proto3" json:"originator_address,omitempty"`
 }
 
-func (x *IPv4AddressSpecificExtended) Reset() {
-	*x = IPv4AddressSpecificExtended{}
+func (x *MVPNLeafADRoute) Reset() {
+	*x = MVPNLeafADRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[50]
+		mi := &file_attribute_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IPv4AddressSpecificExtended) String() string {
+func (x *MVPNLeafADRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IPv4AddressSpecificExtended) ProtoMessage() {}
+func (*MVPNLeafADRoute) ProtoMessage() {}
 
-func (x *IPv4AddressSpecificExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[50]
+func (x *MVPNLeafADRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3715,67 +4180,58 @@ func (x *IPv4AddressSpecificExtended) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IPv4AddressSpecificExtended.ProtoReflect.Descriptor instead.
-func (*IPv4AddressSpecificExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{50}
-}
-
-func (x *IPv4AddressSpecificExtended) GetIsTransitive() bool {
-	if x != nil {
-		return x.IsTransitive
-	}
-	return false
+// Deprecated: Use MVPNLeafADRoute.ProtoReflect.Descriptor instead.
+func (*MVPNLeafADRoute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *IPv4AddressSpecificExtended) GetSubType() uint32 {
+func (x *MVPNLeafADRoute) GetRouteKey() *anypb.Any {
 	if x != nil {
-		return x.SubType
+		return x.RouteKey
 	}
-	return 0
+	return nil
 }
 
-func (x *IPv4AddressSpecificExtended) GetAddress() string {
+func (x *MVPNLeafADRoute) GetOriginatorAddress() string {
 	if x != nil {
-		return x.Address
+		return x.OriginatorAddress
 	}
 	return ""
 }
 
-func (x *IPv4AddressSpecificExtended) GetLocalAdmin() uint32 {
-	if x != nil {
-		return x.LocalAdmin
-	}
-	return 0
-}
-
-type FourOctetAsSpecificExtended struct {
+// MVPNSourceActiveADRoute represents the NLRI for:
+// - AFI=1 or 2, SAFI=5, RouteType=5
+type MVPNSourceActiveADRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsTransitive bool   `protobuf:"varint,1,opt,name=is_transitive,json=isTransitive,proto3" json:"is_transitive,omitempty"`
-	SubType      uint32 `protobuf:"varint,2,opt,name=sub_type,json=subType,proto3" json:"sub_type,omitempty"`
-	Asn          uint32 `protobuf:"varint,3,opt,name=asn,proto3" json:"asn,omitempty"`
-	LocalAdmin   uint32 `protobuf:"varint,4,opt,name=local_admin,json=localAdmin,proto3" json:"local_admin,omitempty"`
+	// One of:
+	// - RouteDistinguisherTwoOctetASN
+	// - RouteDistinguisherIPAddress
+	// - RouteDistinguisherFourOctetASN
+	Rd            *anypb.Any `protobuf:"bytes,1,opt,name=rd,proto3" json:"rd,omitempty"`
+	SourceAddress string     `protobuf:"bytes,2,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
+	GroupAddress  string     `protobuf:"bytes,3,opt,name=group_address,json=groupAddress,proto3" json:"group_address,omitempty"`
 }
 
-func (x *FourOctetAsSpecificExtended) Reset() {
-	*x = FourOctetAsSpecificExtended{}
+func (x *MVPNSourceActiveADRoute) Reset() {
+	*x = MVPNSourceActiveADRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[51]
+		mi := &file_attribute_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FourOctetAsSpecificExtended) String() string {
+func (x *MVPNSourceActiveADRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FourOctetAsSpecificExtended) ProtoMessage() {}
+func (*MVPNSourceActiveADRoute) ProtoMessage() {}
 
-func (x *FourOctetAsSpecificExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[51]
+func (x *MVPNSourceActiveADRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3786,65 +4242,68 @@ func (x *FourOctetAsSpecificExtended) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FourOctetAsSpecificExtended.ProtoReflect.Descriptor instead.
-func (*FourOctetAsSpecificExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{51}
-}
-
-func (x *FourOctetAsSpecificExtended) GetIsTransitive() bool {
-	if x != nil {
-		return x.IsTransitive
-	}
-	return false
+// Deprecated: Use MVPNSourceActiveADRoute.ProtoReflect.Descriptor instead.
+func (*MVPNSourceActiveADRoute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *FourOctetAsSpecificExtended) GetSubType() uint32 {
+func (x *MVPNSourceActiveADRoute) GetRd() *anypb.Any {
 	if x != nil {
-		return x.SubType
+		return x.Rd
 	}
-	return 0
+	return nil
 }
 
-func (x *FourOctetAsSpecificExtended) GetAsn() uint32 {
+func (x *MVPNSourceActiveADRoute) GetSourceAddress() string {
 	if x != nil {
-		return x.Asn
+		return x.SourceAddress
 	}
-	return 0
+	return ""
 }
 
-func (x *FourOctetAsSpecificExtended) GetLocalAdmin() uint32 {
+func (x *MVPNSourceActiveADRoute) GetGroupAddress() string {
 	if x != nil {
-		return x.LocalAdmin
+		return x.GroupAddress
 	}
-	return 0
+	return ""
 }
 
-type LinkBandwidthExtended struct {
+// MVPNSharedTreeJoinRoute represents the NLRI for:
+// - AFI=1 or 2, SAFI=5, RouteType=6
+type MVPNSharedTreeJoinRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Asn       uint32  `protobuf:"varint,1,opt,name=asn,proto3" json:"asn,omitempty"`
-	Bandwidth float32 `protobuf:"fixed32,2,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	// One of:
+	// - RouteDistinguisherTwoOctetASN
+	// - RouteDistinguisherIPAddress
+	// - RouteDistinguisherFourOctetASN
+	Rd       *anypb.Any `protobuf:"bytes,1,opt,name=rd,proto3" json:"rd,omitempty"`
+	SourceAs uint32     `protobuf:"varint,2,opt,name=source_as,json=sourceAs,proto3" json:"source_as,omitempty"`
+	// the C-RP address
+	RpAddress string `protobuf:"bytes,3,opt,name=rp_address,json=rpAddress,proto3" json:"rp_address,omitempty"`
+	// empty for the wildcard
+	GroupAddress string `protobuf:"bytes,4,opt,name=group_address,json=groupAddress,proto3" json:"group_address,omitempty"`
 }
 
-func (x *LinkBandwidthExtended) Reset() {
-	*x = LinkBandwidthExtended{}
+func (x *MVPNSharedTreeJoinRoute) Reset() {
+	*x = MVPNSharedTreeJoinRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[52]
+		mi := &file_attribute_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LinkBandwidthExtended) String() string {
+func (x *MVPNSharedTreeJoinRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LinkBandwidthExtended) ProtoMessage() {}
+func (*MVPNSharedTreeJoinRoute) ProtoMessage() {}
 
-func (x *LinkBandwidthExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[52]
+func (x *MVPNSharedTreeJoinRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3855,50 +4314,75 @@ func (x *LinkBandwidthExtended) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LinkBandwidthExtended.ProtoReflect.Descriptor instead.
-func (*LinkBandwidthExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use MVPNSharedTreeJoinRoute.ProtoReflect.Descriptor instead.
+func (*MVPNSharedTreeJoinRoute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *LinkBandwidthExtended) GetAsn() uint32 {
+func (x *MVPNSharedTreeJoinRoute) GetRd() *anypb.Any {
 	if x != nil {
-		return x.Asn
+		return x.Rd
 	}
-	return 0
+	return nil
 }
 
-func (x *LinkBandwidthExtended) GetBandwidth() float32 {
+func (x *MVPNSharedTreeJoinRoute) GetSourceAs() uint32 {
 	if x != nil {
-		return x.Bandwidth
+		return x.SourceAs
 	}
 	return 0
 }
 
-type ValidationExtended struct {
+func (x *MVPNSharedTreeJoinRoute) GetRpAddress() string {
+	if x != nil {
+		return x.RpAddress
+	}
+	return ""
+}
+
+func (x *MVPNSharedTreeJoinRoute) GetGroupAddress() string {
+	if x != nil {
+		return x.GroupAddress
+	}
+	return ""
+}
+
+// MVPNSourceTreeJoinRoute represents the NLRI for:
+// - AFI=1 or 2, SAFI=5, RouteType=7
+type MVPNSourceTreeJoinRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State uint32 `protobuf:"varint,1,opt,name=state,proto3" json:"state,omitempty"`
+	// One of:
+	// - RouteDistinguisherTwoOctetASN
+	// - RouteDistinguisherIPAddress
+	// - RouteDistinguisherFourOctetASN
+	Rd       *anypb.Any `protobuf:"bytes,1,opt,name=rd,proto3" json:"rd,omitempty"`
+	SourceAs uint32     `protobuf:"varint,2,opt,name=source_as,json=sourceAs,proto3" json:"source_as,omitempty"`
+	// empty for the wildcard
+	SourceAddress string `protobuf:"bytes,3,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
+	// empty for the wildcard
+	GroupAddress string `protobuf:"bytes,4,opt,name=group_address,json=groupAddress,proto3" json:"group_address,omitempty"`
 }
 
-func (x *ValidationExtended) Reset() {
-	*x = ValidationExtended{}
+func (x *MVPNSourceTreeJoinRoute) Reset() {
+	*x = MVPNSourceTreeJoinRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[53]
+		mi := &file_attribute_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidationExtended) String() string {
+func (x *MVPNSourceTreeJoinRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidationExtended) ProtoMessage() {}
+func (*MVPNSourceTreeJoinRoute) ProtoMessage() {}
 
-func (x *ValidationExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[53]
+func (x *MVPNSourceTreeJoinRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3909,43 +4393,74 @@ func (x *ValidationExtended) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidationExtended.ProtoReflect.Descriptor instead.
-func (*ValidationExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use MVPNSourceTreeJoinRoute.ProtoReflect.Descriptor instead.
+func (*MVPNSourceTreeJoinRoute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ValidationExtended) GetState() uint32 {
+func (x *MVPNSourceTreeJoinRoute) GetRd() *anypb.Any {
 	if x != nil {
-		return x.State
+		return x.Rd
+	}
+	return nil
+}
+
+func (x *MVPNSourceTreeJoinRoute) GetSourceAs() uint32 {
+	if x != nil {
+		return x.SourceAs
 	}
 	return 0
 }
 
-type ColorExtended struct {
+func (x *MVPNSourceTreeJoinRoute) GetSourceAddress() string {
+	if x != nil {
+		return x.SourceAddress
+	}
+	return ""
+}
+
+func (x *MVPNSourceTreeJoinRoute) GetGroupAddress() string {
+	if x != nil {
+		return x.GroupAddress
+	}
+	return ""
+}
+
+// CARColorAwareRoute represents the NLRI for:
+// - AFI=1 or 2, SAFI=83, NLRI Type=1
+type CARColorAwareRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Color uint32 `protobuf:"varint,1,opt,name=color,proto3" json:"color,omitempty"`
+	Prefix    string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	PrefixLen uint32 `protobuf:"varint,2,opt,name=prefix_len,json=prefixLen,proto3" json:"prefix_len,omitempty"`
+	Color     uint32 `protobuf:"varint,3,opt,name=color,proto3" json:"color,omitempty"`
+	// Each TLV must be one of:
+	// - CARLabelTLV
+	// - CARLabelIndexTLV
+	// - CARSRv6SIDTLV
+	// - CARUnknownTLV
+	Tlvs []*anypb.Any `protobuf:"bytes,4,rep,name=tlvs,proto3" json:"tlvs,omitempty"`
 }
 
-func (x *ColorExtended) Reset() {
-	*x = ColorExtended{}
+func (x *CARColorAwareRoute) Reset() {
+	*x = CARColorAwareRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[54]
+		mi := &file_attribute_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ColorExtended) String() string {
+func (x *CARColorAwareRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ColorExtended) ProtoMessage() {}
+func (*CARColorAwareRoute) ProtoMessage() {}
 
-func (x *ColorExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[54]
+func (x *CARColorAwareRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3956,43 +4471,70 @@ func (x *ColorExtended) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ColorExtended.ProtoReflect.Descriptor instead.
-func (*ColorExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use CARColorAwareRoute.ProtoReflect.Descriptor instead.
+func (*CARColorAwareRoute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ColorExtended) GetColor() uint32 {
+func (x *CARColorAwareRoute) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *CARColorAwareRoute) GetPrefixLen() uint32 {
+	if x != nil {
+		return x.PrefixLen
+	}
+	return 0
+}
+
+func (x *CARColorAwareRoute) GetColor() uint32 {
 	if x != nil {
 		return x.Color
 	}
 	return 0
 }
 
-type EncapExtended struct {
+func (x *CARColorAwareRoute) GetTlvs() []*anypb.Any {
+	if x != nil {
+		return x.Tlvs
+	}
+	return nil
+}
+
+// CARUnknownRoute represents the NLRI of the unknown types for:
+// - AFI=1 or 2, SAFI=83
+type CARUnknownRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TunnelType uint32 `protobuf:"varint,1,opt,name=tunnel_type,json=tunnelType,proto3" json:"tunnel_type,omitempty"`
+	Type uint32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	// the type-specific key fields
+	Key []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// The same as the tlvs field of CARColorAwareRoute
+	Tlvs []*anypb.Any `protobuf:"bytes,3,rep,name=tlvs,proto3" json:"tlvs,omitempty"`
 }
 
-func (x *EncapExtended) Reset() {
-	*x = EncapExtended{}
+func (x *CARUnknownRoute) Reset() {
+	*x = CARUnknownRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[55]
+		mi := &file_attribute_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EncapExtended) String() string {
+func (x *CARUnknownRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EncapExtended) ProtoMessage() {}
+func (*CARUnknownRoute) ProtoMessage() {}
 
-func (x *EncapExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[55]
+func (x *CARUnknownRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4003,41 +4545,57 @@ func (x *EncapExtended) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EncapExtended.ProtoReflect.Descriptor instead.
-func (*EncapExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use CARUnknownRoute.ProtoReflect.Descriptor instead.
+func (*CARUnknownRoute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *EncapExtended) GetTunnelType() uint32 {
+func (x *CARUnknownRoute) GetType() uint32 {
 	if x != nil {
-		return x.TunnelType
+		return x.Type
 	}
 	return 0
 }
 
-type DefaultGatewayExtended struct {
+func (x *CARUnknownRoute) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *CARUnknownRoute) GetTlvs() []*anypb.Any {
+	if x != nil {
+		return x.Tlvs
+	}
+	return nil
+}
+
+type CARLabelTLV struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Labels []uint32 `protobuf:"varint,1,rep,packed,name=labels,proto3" json:"labels,omitempty"`
 }
 
-func (x *DefaultGatewayExtended) Reset() {
-	*x = DefaultGatewayExtended{}
+func (x *CARLabelTLV) Reset() {
+	*x = CARLabelTLV{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[56]
+		mi := &file_attribute_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DefaultGatewayExtended) String() string {
+func (x *CARLabelTLV) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DefaultGatewayExtended) ProtoMessage() {}
+func (*CARLabelTLV) ProtoMessage() {}
 
-func (x *DefaultGatewayExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[56]
+func (x *CARLabelTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4048,37 +4606,44 @@ func (x *DefaultGatewayExtended) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DefaultGatewayExtended.ProtoReflect.Descriptor instead.
-func (*DefaultGatewayExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use CARLabelTLV.ProtoReflect.Descriptor instead.
+func (*CARLabelTLV) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{61}
 }
 
-type OpaqueExtended struct {
+func (x *CARLabelTLV) GetLabels() []uint32 {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type CARLabelIndexTLV struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsTransitive bool   `protobuf:"varint,1,opt,name=is_transitive,json=isTransitive,proto3" json:"is_transitive,omitempty"`
-	Value        []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Flags      uint32 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	LabelIndex uint32 `protobuf:"varint,2,opt,name=label_index,json=labelIndex,proto3" json:"label_index,omitempty"`
 }
 
-func (x *OpaqueExtended) Reset() {
-	*x = OpaqueExtended{}
+func (x *CARLabelIndexTLV) Reset() {
+	*x = CARLabelIndexTLV{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[57]
+		mi := &file_attribute_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OpaqueExtended) String() string {
+func (x *CARLabelIndexTLV) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpaqueExtended) ProtoMessage() {}
+func (*CARLabelIndexTLV) ProtoMessage() {}
 
-func (x *OpaqueExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[57]
+func (x *CARLabelIndexTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4089,51 +4654,50 @@ func (x *OpaqueExtended) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpaqueExtended.ProtoReflect.Descriptor instead.
-func (*OpaqueExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use CARLabelIndexTLV.ProtoReflect.Descriptor instead.
+func (*CARLabelIndexTLV) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *OpaqueExtended) GetIsTransitive() bool {
+func (x *CARLabelIndexTLV) GetFlags() uint32 {
 	if x != nil {
-		return x.IsTransitive
+		return x.Flags
 	}
-	return false
+	return 0
 }
 
-func (x *OpaqueExtended) GetValue() []byte {
+func (x *CARLabelIndexTLV) GetLabelIndex() uint32 {
 	if x != nil {
-		return x.Value
+		return x.LabelIndex
 	}
-	return nil
+	return 0
 }
 
-type ESILabelExtended struct {
+type CARSRv6SIDTLV struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsSingleActive bool   `protobuf:"varint,1,opt,name=is_single_active,json=isSingleActive,proto3" json:"is_single_active,omitempty"`
-	Label          uint32 `protobuf:"varint,2,opt,name=label,proto3" json:"label,omitempty"`
+	Sid string `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`
 }
 
-func (x *ESILabelExtended) Reset() {
-	*x = ESILabelExtended{}
+func (x *CARSRv6SIDTLV) Reset() {
+	*x = CARSRv6SIDTLV{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[58]
+		mi := &file_attribute_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ESILabelExtended) String() string {
+func (x *CARSRv6SIDTLV) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ESILabelExtended) ProtoMessage() {}
+func (*CARSRv6SIDTLV) ProtoMessage() {}
 
-func (x *ESILabelExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[58]
+func (x *CARSRv6SIDTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4144,50 +4708,44 @@ func (x *ESILabelExtended) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ESILabelExtended.ProtoReflect.Descriptor instead.
-func (*ESILabelExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{58}
-}
-
-func (x *ESILabelExtended) GetIsSingleActive() bool {
-	if x != nil {
-		return x.IsSingleActive
-	}
-	return false
+// Deprecated: Use CARSRv6SIDTLV.ProtoReflect.Descriptor instead.
+func (*CARSRv6SIDTLV) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ESILabelExtended) GetLabel() uint32 {
+func (x *CARSRv6SIDTLV) GetSid() string {
 	if x != nil {
-		return x.Label
+		return x.Sid
 	}
-	return 0
+	return ""
 }
 
-type ESImportRouteTarget struct {
+type CARUnknownTLV struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EsImport string `protobuf:"bytes,1,opt,name=es_import,json=esImport,proto3" json:"es_import,omitempty"`
+	Type  uint32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *ESImportRouteTarget) Reset() {
-	*x = ESImportRouteTarget{}
+func (x *CARUnknownTLV) Reset() {
+	*x = CARUnknownTLV{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[59]
+		mi := &file_attribute_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ESImportRouteTarget) String() string {
+func (x *CARUnknownTLV) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ESImportRouteTarget) ProtoMessage() {}
+func (*CARUnknownTLV) ProtoMessage() {}
 
-func (x *ESImportRouteTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[59]
+func (x *CARUnknownTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4198,44 +4756,85 @@ func (x *ESImportRouteTarget) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ESImportRouteTarget.ProtoReflect.Descriptor instead.
-func (*ESImportRouteTarget) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use CARUnknownTLV.ProtoReflect.Descriptor instead.
+func (*CARUnknownTLV) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ESImportRouteTarget) GetEsImport() string {
+func (x *CARUnknownTLV) GetType() uint32 {
 	if x != nil {
-		return x.EsImport
+		return x.Type
 	}
-	return ""
+	return 0
 }
 
-type MacMobilityExtended struct {
+func (x *CARUnknownTLV) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type MpReachNLRIAttribute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsSticky    bool   `protobuf:"varint,1,opt,name=is_sticky,json=isSticky,proto3" json:"is_sticky,omitempty"`
-	SequenceNum uint32 `protobuf:"varint,2,opt,name=sequence_num,json=sequenceNum,proto3" json:"sequence_num,omitempty"`
+	Family   *Family  `protobuf:"bytes,1,opt,name=family,proto3" json:"family,omitempty"`
+	NextHops []string `protobuf:"bytes,2,rep,name=next_hops,json=nextHops,proto3" json:"next_hops,omitempty"`
+	// Each NLRI must be one of:
+	// - IPAddressPrefix
+	// - LabeledIPAddressPrefix
+	// - EncapsulationNLRI
+	// - EVPNEthernetAutoDiscoveryRoute
+	// - EVPNMACIPAdvertisementRoute
+	// - EVPNInclusiveMulticastEthernetTagRoute
+	// - EVPNEthernetSegmentRoute
+	// - EVPNIPPrefixRoute
+	// - EVPNSelectiveMulticastEthernetTagRoute
+	// - EVPNMulticastJoinSynchRoute
+	// - EVPNMulticastLeaveSynchRoute
+	// - EVPNIPMSIRoute
+	// - LabeledVPNIPAddressPrefix
+	// - RouteTargetMembershipNLRI
+	// - FlowSpecNLRI
+	// - VPNFlowSpecNLRI
+	// - OpaqueNLRI
+	// - LsAddrPrefix
+	// - SR Policy NLRI
+	// - MUPInterworkSegmentDiscoveryRoute
+	// - MUPDirectSegmentDiscoveryRoute
+	// - MUPType1SessionTransformedRoute
+	// - MUPType2SessionTransformedRoute
+	// - MVPNIntraASIPMSIADRoute
+	// - MVPNInterASIPMSIADRoute
+	// - MVPNSPMSIADRoute
+	// - MVPNLeafADRoute
+	// - MVPNSourceActiveADRoute
+	// - MVPNSharedTreeJoinRoute
+	// - MVPNSourceTreeJoinRoute
+	// - CARColorAwareRoute
+	// - CARUnknownRoute
+	Nlris []*anypb.Any `protobuf:"bytes,3,rep,name=nlris,proto3" json:"nlris,omitempty"`
 }
 
-func (x *MacMobilityExtended) Reset() {
-	*x = MacMobilityExtended{}
+func (x *MpReachNLRIAttribute) Reset() {
+	*x = MpReachNLRIAttribute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[60]
+		mi := &file_attribute_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MacMobilityExtended) String() string {
+func (x *MpReachNLRIAttribute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MacMobilityExtended) ProtoMessage() {}
+func (*MpReachNLRIAttribute) ProtoMessage() {}
 
-func (x *MacMobilityExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[60]
+func (x *MpReachNLRIAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4246,50 +4845,59 @@ func (x *MacMobilityExtended) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MacMobilityExtended.ProtoReflect.Descriptor instead.
-func (*MacMobilityExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use MpReachNLRIAttribute.ProtoReflect.Descriptor instead.
+func (*MpReachNLRIAttribute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *MacMobilityExtended) GetIsSticky() bool {
+func (x *MpReachNLRIAttribute) GetFamily() *Family {
 	if x != nil {
-		return x.IsSticky
+		return x.Family
 	}
-	return false
+	return nil
 }
 
-func (x *MacMobilityExtended) GetSequenceNum() uint32 {
+func (x *MpReachNLRIAttribute) GetNextHops() []string {
 	if x != nil {
-		return x.SequenceNum
+		return x.NextHops
 	}
-	return 0
+	return nil
 }
 
-type RouterMacExtended struct {
+func (x *MpReachNLRIAttribute) GetNlris() []*anypb.Any {
+	if x != nil {
+		return x.Nlris
+	}
+	return nil
+}
+
+type MpUnreachNLRIAttribute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Mac string `protobuf:"bytes,1,opt,name=mac,proto3" json:"mac,omitempty"`
+	Family *Family `protobuf:"bytes,1,opt,name=family,proto3" json:"family,omitempty"`
+	// The same as NLRI field of MpReachNLRIAttribute
+	Nlris []*anypb.Any `protobuf:"bytes,3,rep,name=nlris,proto3" json:"nlris,omitempty"`
 }
 
-func (x *RouterMacExtended) Reset() {
-	*x = RouterMacExtended{}
+func (x *MpUnreachNLRIAttribute) Reset() {
+	*x = MpUnreachNLRIAttribute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[61]
+		mi := &file_attribute_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RouterMacExtended) String() string {
+func (x *MpUnreachNLRIAttribute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouterMacExtended) ProtoMessage() {}
+func (*MpUnreachNLRIAttribute) ProtoMessage() {}
 
-func (x *RouterMacExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[61]
+func (x *MpUnreachNLRIAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4300,44 +4908,53 @@ func (x *RouterMacExtended) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RouterMacExtended.ProtoReflect.Descriptor instead.
-func (*RouterMacExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{61}
-}
-
-func (x *RouterMacExtended) GetMac() string {
+// Deprecated: Use MpUnreachNLRIAttribute.ProtoReflect.Descriptor instead.
+func (*MpUnreachNLRIAttribute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *MpUnreachNLRIAttribute) GetFamily() *Family {
 	if x != nil {
-		return x.Mac
+		return x.Family
 	}
-	return ""
+	return nil
 }
 
-type TrafficRateExtended struct {
+func (x *MpUnreachNLRIAttribute) GetNlris() []*anypb.Any {
+	if x != nil {
+		return x.Nlris
+	}
+	return nil
+}
+
+type TwoOctetAsSpecificExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Asn  uint32  `protobuf:"varint,1,opt,name=asn,proto3" json:"asn,omitempty"`
-	Rate float32 `protobuf:"fixed32,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	IsTransitive bool   `protobuf:"varint,1,opt,name=is_transitive,json=isTransitive,proto3" json:"is_transitive,omitempty"`
+	SubType      uint32 `protobuf:"varint,2,opt,name=sub_type,json=subType,proto3" json:"sub_type,omitempty"`
+	Asn          uint32 `protobuf:"varint,3,opt,name=asn,proto3" json:"asn,omitempty"`
+	LocalAdmin   uint32 `protobuf:"varint,4,opt,name=local_admin,json=localAdmin,proto3" json:"local_admin,omitempty"`
 }
 
-func (x *TrafficRateExtended) Reset() {
-	*x = TrafficRateExtended{}
+func (x *TwoOctetAsSpecificExtended) Reset() {
+	*x = TwoOctetAsSpecificExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[62]
+		mi := &file_attribute_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TrafficRateExtended) String() string {
+func (x *TwoOctetAsSpecificExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrafficRateExtended) ProtoMessage() {}
+func (*TwoOctetAsSpecificExtended) ProtoMessage() {}
 
-func (x *TrafficRateExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[62]
+func (x *TwoOctetAsSpecificExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4348,51 +4965,67 @@ func (x *TrafficRateExtended) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrafficRateExtended.ProtoReflect.Descriptor instead.
-func (*TrafficRateExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use TwoOctetAsSpecificExtended.ProtoReflect.Descriptor instead.
+func (*TwoOctetAsSpecificExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *TrafficRateExtended) GetAsn() uint32 {
+func (x *TwoOctetAsSpecificExtended) GetIsTransitive() bool {
+	if x != nil {
+		return x.IsTransitive
+	}
+	return false
+}
+
+func (x *TwoOctetAsSpecificExtended) GetSubType() uint32 {
+	if x != nil {
+		return x.SubType
+	}
+	return 0
+}
+
+func (x *TwoOctetAsSpecificExtended) GetAsn() uint32 {
 	if x != nil {
 		return x.Asn
 	}
 	return 0
 }
 
-func (x *TrafficRateExtended) GetRate() float32 {
+func (x *TwoOctetAsSpecificExtended) GetLocalAdmin() uint32 {
 	if x != nil {
-		return x.Rate
+		return x.LocalAdmin
 	}
 	return 0
 }
 
-type TrafficActionExtended struct {
+type IPv4AddressSpecificExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Terminal bool `protobuf:"varint,1,opt,name=terminal,proto3" json:"terminal,omitempty"`
-	Sample   bool `protobuf:"varint,2,opt,name=sample,proto3" json:"sample,omitempty"`
+	IsTransitive bool   `protobuf:"varint,1,opt,name=is_transitive,json=isTransitive,proto3" json:"is_transitive,omitempty"`
+	SubType      uint32 `protobuf:"varint,2,opt,name=sub_type,json=subType,proto3" json:"sub_type,omitempty"`
+	Address      string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	LocalAdmin   uint32 `protobuf:"varint,4,opt,name=local_admin,json=localAdmin,proto3" json:"local_admin,omitempty"`
 }
 
-func (x *TrafficActionExtended) Reset() {
-	*x = TrafficActionExtended{}
+func (x *IPv4AddressSpecificExtended) Reset() {
+	*x = IPv4AddressSpecificExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[63]
+		mi := &file_attribute_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TrafficActionExtended) String() string {
+func (x *IPv4AddressSpecificExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrafficActionExtended) ProtoMessage() {}
+func (*IPv4AddressSpecificExtended) ProtoMessage() {}
 
-func (x *TrafficActionExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[63]
+func (x *IPv4AddressSpecificExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4403,51 +5036,67 @@ func (x *TrafficActionExtended) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrafficActionExtended.ProtoReflect.Descriptor instead.
-func (*TrafficActionExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use IPv4AddressSpecificExtended.ProtoReflect.Descriptor instead.
+func (*IPv4AddressSpecificExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *TrafficActionExtended) GetTerminal() bool {
+func (x *IPv4AddressSpecificExtended) GetIsTransitive() bool {
 	if x != nil {
-		return x.Terminal
+		return x.IsTransitive
 	}
 	return false
 }
 
-func (x *TrafficActionExtended) GetSample() bool {
+func (x *IPv4AddressSpecificExtended) GetSubType() uint32 {
 	if x != nil {
-		return x.Sample
+		return x.SubType
 	}
-	return false
+	return 0
 }
 
-type RedirectTwoOctetAsSpecificExtended struct {
+func (x *IPv4AddressSpecificExtended) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *IPv4AddressSpecificExtended) GetLocalAdmin() uint32 {
+	if x != nil {
+		return x.LocalAdmin
+	}
+	return 0
+}
+
+type FourOctetAsSpecificExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Asn        uint32 `protobuf:"varint,1,opt,name=asn,proto3" json:"asn,omitempty"`
-	LocalAdmin uint32 `protobuf:"varint,2,opt,name=local_admin,json=localAdmin,proto3" json:"local_admin,omitempty"`
+	IsTransitive bool   `protobuf:"varint,1,opt,name=is_transitive,json=isTransitive,proto3" json:"is_transitive,omitempty"`
+	SubType      uint32 `protobuf:"varint,2,opt,name=sub_type,json=subType,proto3" json:"sub_type,omitempty"`
+	Asn          uint32 `protobuf:"varint,3,opt,name=asn,proto3" json:"asn,omitempty"`
+	LocalAdmin   uint32 `protobuf:"varint,4,opt,name=local_admin,json=localAdmin,proto3" json:"local_admin,omitempty"`
 }
 
-func (x *RedirectTwoOctetAsSpecificExtended) Reset() {
-	*x = RedirectTwoOctetAsSpecificExtended{}
+func (x *FourOctetAsSpecificExtended) Reset() {
+	*x = FourOctetAsSpecificExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[64]
+		mi := &file_attribute_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RedirectTwoOctetAsSpecificExtended) String() string {
+func (x *FourOctetAsSpecificExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RedirectTwoOctetAsSpecificExtended) ProtoMessage() {}
+func (*FourOctetAsSpecificExtended) ProtoMessage() {}
 
-func (x *RedirectTwoOctetAsSpecificExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[64]
+func (x *FourOctetAsSpecificExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4458,51 +5107,68 @@ func (x *RedirectTwoOctetAsSpecificExtended) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RedirectTwoOctetAsSpecificExtended.ProtoReflect.Descriptor instead.
-func (*RedirectTwoOctetAsSpecificExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use FourOctetAsSpecificExtended.ProtoReflect.Descriptor instead.
+func (*FourOctetAsSpecificExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *RedirectTwoOctetAsSpecificExtended) GetAsn() uint32 {
+func (x *FourOctetAsSpecificExtended) GetIsTransitive() bool {
+	if x != nil {
+		return x.IsTransitive
+	}
+	return false
+}
+
+func (x *FourOctetAsSpecificExtended) GetSubType() uint32 {
+	if x != nil {
+		return x.SubType
+	}
+	return 0
+}
+
+func (x *FourOctetAsSpecificExtended) GetAsn() uint32 {
 	if x != nil {
 		return x.Asn
 	}
 	return 0
 }
 
-func (x *RedirectTwoOctetAsSpecificExtended) GetLocalAdmin() uint32 {
+func (x *FourOctetAsSpecificExtended) GetLocalAdmin() uint32 {
 	if x != nil {
 		return x.LocalAdmin
 	}
 	return 0
 }
 
-type RedirectIPv4AddressSpecificExtended struct {
+type LinkBandwidthExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	LocalAdmin uint32 `protobuf:"varint,2,opt,name=local_admin,json=localAdmin,proto3" json:"local_admin,omitempty"`
+	Asn       uint32  `protobuf:"varint,1,opt,name=asn,proto3" json:"asn,omitempty"`
+	Bandwidth float32 `protobuf:"fixed32,2,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	// the transitive two-octet AS specific type instead of the non-transitive
+	// one
+	IsTransitive bool `protobuf:"varint,3,opt,name=is_transitive,json=isTransitive,proto3" json:"is_transitive,omitempty"`
 }
 
-func (x *RedirectIPv4AddressSpecificExtended) Reset() {
-	*x = RedirectIPv4AddressSpecificExtended{}
+func (x *LinkBandwidthExtended) Reset() {
+	*x = LinkBandwidthExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[65]
+		mi := &file_attribute_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RedirectIPv4AddressSpecificExtended) String() string {
+func (x *LinkBandwidthExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RedirectIPv4AddressSpecificExtended) ProtoMessage() {}
+func (*LinkBandwidthExtended) ProtoMessage() {}
 
-func (x *RedirectIPv4AddressSpecificExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[65]
+func (x *LinkBandwidthExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4513,51 +5179,57 @@ func (x *RedirectIPv4AddressSpecificExtended) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RedirectIPv4AddressSpecificExtended.ProtoReflect.Descriptor instead.
-func (*RedirectIPv4AddressSpecificExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use LinkBandwidthExtended.ProtoReflect.Descriptor instead.
+func (*LinkBandwidthExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *RedirectIPv4AddressSpecificExtended) GetAddress() string {
+func (x *LinkBandwidthExtended) GetAsn() uint32 {
 	if x != nil {
-		return x.Address
+		return x.Asn
 	}
-	return ""
+	return 0
 }
 
-func (x *RedirectIPv4AddressSpecificExtended) GetLocalAdmin() uint32 {
+func (x *LinkBandwidthExtended) GetBandwidth() float32 {
 	if x != nil {
-		return x.LocalAdmin
+		return x.Bandwidth
 	}
 	return 0
 }
 
-type RedirectFourOctetAsSpecificExtended struct {
+func (x *LinkBandwidthExtended) GetIsTransitive() bool {
+	if x != nil {
+		return x.IsTransitive
+	}
+	return false
+}
+
+type ValidationExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Asn        uint32 `protobuf:"varint,1,opt,name=asn,proto3" json:"asn,omitempty"`
-	LocalAdmin uint32 `protobuf:"varint,2,opt,name=local_admin,json=localAdmin,proto3" json:"local_admin,omitempty"`
+	State uint32 `protobuf:"varint,1,opt,name=state,proto3" json:"state,omitempty"`
 }
 
-func (x *RedirectFourOctetAsSpecificExtended) Reset() {
-	*x = RedirectFourOctetAsSpecificExtended{}
+func (x *ValidationExtended) Reset() {
+	*x = ValidationExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[66]
+		mi := &file_attribute_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RedirectFourOctetAsSpecificExtended) String() string {
+func (x *ValidationExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RedirectFourOctetAsSpecificExtended) ProtoMessage() {}
+func (*ValidationExtended) ProtoMessage() {}
 
-func (x *RedirectFourOctetAsSpecificExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[66]
+func (x *ValidationExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4568,50 +5240,43 @@ func (x *RedirectFourOctetAsSpecificExtended) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RedirectFourOctetAsSpecificExtended.ProtoReflect.Descriptor instead.
-func (*RedirectFourOctetAsSpecificExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{66}
-}
-
-func (x *RedirectFourOctetAsSpecificExtended) GetAsn() uint32 {
-	if x != nil {
-		return x.Asn
-	}
-	return 0
+// Deprecated: Use ValidationExtended.ProtoReflect.Descriptor instead.
+func (*ValidationExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *RedirectFourOctetAsSpecificExtended) GetLocalAdmin() uint32 {
+func (x *ValidationExtended) GetState() uint32 {
 	if x != nil {
-		return x.LocalAdmin
+		return x.State
 	}
 	return 0
 }
 
-type TrafficRemarkExtended struct {
+type ColorExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Dscp uint32 `protobuf:"varint,1,opt,name=dscp,proto3" json:"dscp,omitempty"`
+	Color uint32 `protobuf:"varint,1,opt,name=color,proto3" json:"color,omitempty"`
 }
 
-func (x *TrafficRemarkExtended) Reset() {
-	*x = TrafficRemarkExtended{}
+func (x *ColorExtended) Reset() {
+	*x = ColorExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[67]
+		mi := &file_attribute_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TrafficRemarkExtended) String() string {
+func (x *ColorExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrafficRemarkExtended) ProtoMessage() {}
+func (*ColorExtended) ProtoMessage() {}
 
-func (x *TrafficRemarkExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[67]
+func (x *ColorExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4622,45 +5287,43 @@ func (x *TrafficRemarkExtended) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrafficRemarkExtended.ProtoReflect.Descriptor instead.
-func (*TrafficRemarkExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use ColorExtended.ProtoReflect.Descriptor instead.
+func (*ColorExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *TrafficRemarkExtended) GetDscp() uint32 {
+func (x *ColorExtended) GetColor() uint32 {
 	if x != nil {
-		return x.Dscp
+		return x.Color
 	}
 	return 0
 }
 
-type MUPExtended struct {
+type EncapExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubType    uint32 `protobuf:"varint,1,opt,name=sub_type,json=subType,proto3" json:"sub_type,omitempty"`
-	SegmentId2 uint32 `protobuf:"varint,2,opt,name=segment_id2,json=segmentId2,proto3" json:"segment_id2,omitempty"`
-	SegmentId4 uint32 `protobuf:"varint,3,opt,name=segment_id4,json=segmentId4,proto3" json:"segment_id4,omitempty"`
+	TunnelType uint32 `protobuf:"varint,1,opt,name=tunnel_type,json=tunnelType,proto3" json:"tunnel_type,omitempty"`
 }
 
-func (x *MUPExtended) Reset() {
-	*x = MUPExtended{}
+func (x *EncapExtended) Reset() {
+	*x = EncapExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[68]
+		mi := &file_attribute_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MUPExtended) String() string {
+func (x *EncapExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MUPExtended) ProtoMessage() {}
+func (*EncapExtended) ProtoMessage() {}
 
-func (x *MUPExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[68]
+func (x *EncapExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4671,58 +5334,41 @@ func (x *MUPExtended) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MUPExtended.ProtoReflect.Descriptor instead.
-func (*MUPExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{68}
-}
-
-func (x *MUPExtended) GetSubType() uint32 {
-	if x != nil {
-		return x.SubType
-	}
-	return 0
-}
-
-func (x *MUPExtended) GetSegmentId2() uint32 {
-	if x != nil {
-		return x.SegmentId2
-	}
-	return 0
+// Deprecated: Use EncapExtended.ProtoReflect.Descriptor instead.
+func (*EncapExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *MUPExtended) GetSegmentId4() uint32 {
+func (x *EncapExtended) GetTunnelType() uint32 {
 	if x != nil {
-		return x.SegmentId4
+		return x.TunnelType
 	}
 	return 0
 }
 
-type VPLSExtended struct {
+type DefaultGatewayExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ControlFlags uint32 `protobuf:"varint,1,opt,name=control_flags,json=controlFlags,proto3" json:"control_flags,omitempty"`
-	Mtu          uint32 `protobuf:"varint,2,opt,name=mtu,proto3" json:"mtu,omitempty"`
 }
 
-func (x *VPLSExtended) Reset() {
-	*x = VPLSExtended{}
+func (x *DefaultGatewayExtended) Reset() {
+	*x = DefaultGatewayExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[69]
+		mi := &file_attribute_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VPLSExtended) String() string {
+func (x *DefaultGatewayExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VPLSExtended) ProtoMessage() {}
+func (*DefaultGatewayExtended) ProtoMessage() {}
 
-func (x *VPLSExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[69]
+func (x *DefaultGatewayExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4733,51 +5379,37 @@ func (x *VPLSExtended) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VPLSExtended.ProtoReflect.Descriptor instead.
-func (*VPLSExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{69}
-}
-
-func (x *VPLSExtended) GetControlFlags() uint32 {
-	if x != nil {
-		return x.ControlFlags
-	}
-	return 0
-}
-
-func (x *VPLSExtended) GetMtu() uint32 {
-	if x != nil {
-		return x.Mtu
-	}
-	return 0
+// Deprecated: Use DefaultGatewayExtended.ProtoReflect.Descriptor instead.
+func (*DefaultGatewayExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{74}
 }
 
-type UnknownExtended struct {
+type OpaqueExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type  uint32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
-	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	IsTransitive bool   `protobuf:"varint,1,opt,name=is_transitive,json=isTransitive,proto3" json:"is_transitive,omitempty"`
+	Value        []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *UnknownExtended) Reset() {
-	*x = UnknownExtended{}
+func (x *OpaqueExtended) Reset() {
+	*x = OpaqueExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[70]
+		mi := &file_attribute_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnknownExtended) String() string {
+func (x *OpaqueExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnknownExtended) ProtoMessage() {}
+func (*OpaqueExtended) ProtoMessage() {}
 
-func (x *UnknownExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[70]
+func (x *OpaqueExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4788,67 +5420,51 @@ func (x *UnknownExtended) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnknownExtended.ProtoReflect.Descriptor instead.
-func (*UnknownExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use OpaqueExtended.ProtoReflect.Descriptor instead.
+func (*OpaqueExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *UnknownExtended) GetType() uint32 {
+func (x *OpaqueExtended) GetIsTransitive() bool {
 	if x != nil {
-		return x.Type
+		return x.IsTransitive
 	}
-	return 0
+	return false
 }
 
-func (x *UnknownExtended) GetValue() []byte {
+func (x *OpaqueExtended) GetValue() []byte {
 	if x != nil {
 		return x.Value
 	}
 	return nil
 }
 
-type ExtendedCommunitiesAttribute struct {
+type ESILabelExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Each Community must be one of:
-	// - TwoOctetAsSpecificExtended
-	// - IPv4AddressSpecificExtended
-	// - FourOctetAsSpecificExtended
-	// - OpaqueExtended
-	// - ESILabelExtended
-	// - MacMobilityExtended
-	// - RouterMacExtended
-	// - TrafficRateExtended
-	// - TrafficActionExtended
-	// - RedirectTwoOctetAsSpecificExtended
-	// - RedirectIPv4AddressSpecificExtended
-	// - RedirectFourOctetAsSpecificExtended
-	// - TrafficRemarkExtended
-	// - MUPExtended
-	// - VPLSExtended
-	// - UnknownExtended
-	Communities []*anypb.Any `protobuf:"bytes,1,rep,name=communities,proto3" json:"communities,omitempty"`
+	IsSingleActive bool   `protobuf:"varint,1,opt,name=is_single_active,json=isSingleActive,proto3" json:"is_single_active,omitempty"`
+	Label          uint32 `protobuf:"varint,2,opt,name=label,proto3" json:"label,omitempty"`
 }
 
-func (x *ExtendedCommunitiesAttribute) Reset() {
-	*x = ExtendedCommunitiesAttribute{}
+func (x *ESILabelExtended) Reset() {
+	*x = ESILabelExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[71]
+		mi := &file_attribute_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExtendedCommunitiesAttribute) String() string {
+func (x *ESILabelExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExtendedCommunitiesAttribute) ProtoMessage() {}
+func (*ESILabelExtended) ProtoMessage() {}
 
-func (x *ExtendedCommunitiesAttribute) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[71]
+func (x *ESILabelExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4859,43 +5475,50 @@ func (x *ExtendedCommunitiesAttribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExtendedCommunitiesAttribute.ProtoReflect.Descriptor instead.
-func (*ExtendedCommunitiesAttribute) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use ESILabelExtended.ProtoReflect.Descriptor instead.
+func (*ESILabelExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ExtendedCommunitiesAttribute) GetCommunities() []*anypb.Any {
+func (x *ESILabelExtended) GetIsSingleActive() bool {
 	if x != nil {
-		return x.Communities
+		return x.IsSingleActive
 	}
-	return nil
+	return false
 }
 
-type As4PathAttribute struct {
+func (x *ESILabelExtended) GetLabel() uint32 {
+	if x != nil {
+		return x.Label
+	}
+	return 0
+}
+
+type ESImportRouteTarget struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Segments []*AsSegment `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
+	EsImport string `protobuf:"bytes,1,opt,name=es_import,json=esImport,proto3" json:"es_import,omitempty"`
 }
 
-func (x *As4PathAttribute) Reset() {
-	*x = As4PathAttribute{}
+func (x *ESImportRouteTarget) Reset() {
+	*x = ESImportRouteTarget{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[72]
+		mi := &file_attribute_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *As4PathAttribute) String() string {
+func (x *ESImportRouteTarget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*As4PathAttribute) ProtoMessage() {}
+func (*ESImportRouteTarget) ProtoMessage() {}
 
-func (x *As4PathAttribute) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[72]
+func (x *ESImportRouteTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4906,44 +5529,44 @@ func (x *As4PathAttribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use As4PathAttribute.ProtoReflect.Descriptor instead.
-func (*As4PathAttribute) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use ESImportRouteTarget.ProtoReflect.Descriptor instead.
+func (*ESImportRouteTarget) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *As4PathAttribute) GetSegments() []*AsSegment {
+func (x *ESImportRouteTarget) GetEsImport() string {
 	if x != nil {
-		return x.Segments
+		return x.EsImport
 	}
-	return nil
+	return ""
 }
 
-type As4AggregatorAttribute struct {
+type MacMobilityExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Asn     uint32 `protobuf:"varint,2,opt,name=asn,proto3" json:"asn,omitempty"`
-	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	IsSticky    bool   `protobuf:"varint,1,opt,name=is_sticky,json=isSticky,proto3" json:"is_sticky,omitempty"`
+	SequenceNum uint32 `protobuf:"varint,2,opt,name=sequence_num,json=sequenceNum,proto3" json:"sequence_num,omitempty"`
 }
 
-func (x *As4AggregatorAttribute) Reset() {
-	*x = As4AggregatorAttribute{}
+func (x *MacMobilityExtended) Reset() {
+	*x = MacMobilityExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[73]
+		mi := &file_attribute_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *As4AggregatorAttribute) String() string {
+func (x *MacMobilityExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*As4AggregatorAttribute) ProtoMessage() {}
+func (*MacMobilityExtended) ProtoMessage() {}
 
-func (x *As4AggregatorAttribute) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[73]
+func (x *MacMobilityExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4954,53 +5577,50 @@ func (x *As4AggregatorAttribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use As4AggregatorAttribute.ProtoReflect.Descriptor instead.
-func (*As4AggregatorAttribute) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use MacMobilityExtended.ProtoReflect.Descriptor instead.
+func (*MacMobilityExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *As4AggregatorAttribute) GetAsn() uint32 {
+func (x *MacMobilityExtended) GetIsSticky() bool {
 	if x != nil {
-		return x.Asn
+		return x.IsSticky
 	}
-	return 0
+	return false
 }
 
-func (x *As4AggregatorAttribute) GetAddress() string {
+func (x *MacMobilityExtended) GetSequenceNum() uint32 {
 	if x != nil {
-		return x.Address
+		return x.SequenceNum
 	}
-	return ""
+	return 0
 }
 
-type PmsiTunnelAttribute struct {
+type RouterMacExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Flags uint32 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
-	Type  uint32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
-	Label uint32 `protobuf:"varint,3,opt,name=label,proto3" json:"label,omitempty"`
-	Id    []byte `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Mac string `protobuf:"bytes,1,opt,name=mac,proto3" json:"mac,omitempty"`
 }
 
-func (x *PmsiTunnelAttribute) Reset() {
-	*x = PmsiTunnelAttribute{}
+func (x *RouterMacExtended) Reset() {
+	*x = RouterMacExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[74]
+		mi := &file_attribute_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PmsiTunnelAttribute) String() string {
+func (x *RouterMacExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PmsiTunnelAttribute) ProtoMessage() {}
+func (*RouterMacExtended) ProtoMessage() {}
 
-func (x *PmsiTunnelAttribute) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[74]
+func (x *RouterMacExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5011,65 +5631,50 @@ func (x *PmsiTunnelAttribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PmsiTunnelAttribute.ProtoReflect.Descriptor instead.
-func (*PmsiTunnelAttribute) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{74}
-}
-
-func (x *PmsiTunnelAttribute) GetFlags() uint32 {
-	if x != nil {
-		return x.Flags
-	}
-	return 0
-}
-
-func (x *PmsiTunnelAttribute) GetType() uint32 {
-	if x != nil {
-		return x.Type
-	}
-	return 0
-}
-
-func (x *PmsiTunnelAttribute) GetLabel() uint32 {
-	if x != nil {
-		return x.Label
-	}
-	return 0
+// Deprecated: Use RouterMacExtended.ProtoReflect.Descriptor instead.
+func (*RouterMacExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *PmsiTunnelAttribute) GetId() []byte {
+func (x *RouterMacExtended) GetMac() string {
 	if x != nil {
-		return x.Id
+		return x.Mac
 	}
-	return nil
+	return ""
 }
 
-type TunnelEncapSubTLVEncapsulation struct {
+// Layer2AttributesExtended represents the EVPN Layer 2 Attributes Extended
+// Community of RFC8214 for EVPN VPWS.
+type Layer2AttributesExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key    uint32 `protobuf:"varint,1,opt,name=key,proto3" json:"key,omitempty"`
-	Cookie []byte `protobuf:"bytes,2,opt,name=cookie,proto3" json:"cookie,omitempty"`
+	CiLabel     bool   `protobuf:"varint,1,opt,name=ci_label,json=ciLabel,proto3" json:"ci_label,omitempty"`
+	FlowLabel   bool   `protobuf:"varint,2,opt,name=flow_label,json=flowLabel,proto3" json:"flow_label,omitempty"`
+	ControlWord bool   `protobuf:"varint,3,opt,name=control_word,json=controlWord,proto3" json:"control_word,omitempty"`
+	PrimaryPe   bool   `protobuf:"varint,4,opt,name=primary_pe,json=primaryPe,proto3" json:"primary_pe,omitempty"`
+	BackupPe    bool   `protobuf:"varint,5,opt,name=backup_pe,json=backupPe,proto3" json:"backup_pe,omitempty"`
+	Mtu         uint32 `protobuf:"varint,6,opt,name=mtu,proto3" json:"mtu,omitempty"`
 }
 
-func (x *TunnelEncapSubTLVEncapsulation) Reset() {
-	*x = TunnelEncapSubTLVEncapsulation{}
+func (x *Layer2AttributesExtended) Reset() {
+	*x = Layer2AttributesExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[75]
+		mi := &file_attribute_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TunnelEncapSubTLVEncapsulation) String() string {
+func (x *Layer2AttributesExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TunnelEncapSubTLVEncapsulation) ProtoMessage() {}
+func (*Layer2AttributesExtended) ProtoMessage() {}
 
-func (x *TunnelEncapSubTLVEncapsulation) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[75]
+func (x *Layer2AttributesExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5080,50 +5685,80 @@ func (x *TunnelEncapSubTLVEncapsulation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TunnelEncapSubTLVEncapsulation.ProtoReflect.Descriptor instead.
-func (*TunnelEncapSubTLVEncapsulation) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use Layer2AttributesExtended.ProtoReflect.Descriptor instead.
+func (*Layer2AttributesExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *TunnelEncapSubTLVEncapsulation) GetKey() uint32 {
+func (x *Layer2AttributesExtended) GetCiLabel() bool {
 	if x != nil {
-		return x.Key
+		return x.CiLabel
 	}
-	return 0
+	return false
 }
 
-func (x *TunnelEncapSubTLVEncapsulation) GetCookie() []byte {
+func (x *Layer2AttributesExtended) GetFlowLabel() bool {
 	if x != nil {
-		return x.Cookie
+		return x.FlowLabel
 	}
-	return nil
+	return false
 }
 
-type TunnelEncapSubTLVProtocol struct {
+func (x *Layer2AttributesExtended) GetControlWord() bool {
+	if x != nil {
+		return x.ControlWord
+	}
+	return false
+}
+
+func (x *Layer2AttributesExtended) GetPrimaryPe() bool {
+	if x != nil {
+		return x.PrimaryPe
+	}
+	return false
+}
+
+func (x *Layer2AttributesExtended) GetBackupPe() bool {
+	if x != nil {
+		return x.BackupPe
+	}
+	return false
+}
+
+func (x *Layer2AttributesExtended) GetMtu() uint32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+type DFElectionExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Protocol uint32 `protobuf:"varint,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// 0: Default (service carving), 1: HRW
+	Algorithm uint32 `protobuf:"varint,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Bitmap    uint32 `protobuf:"varint,2,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
 }
 
-func (x *TunnelEncapSubTLVProtocol) Reset() {
-	*x = TunnelEncapSubTLVProtocol{}
+func (x *DFElectionExtended) Reset() {
+	*x = DFElectionExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[76]
+		mi := &file_attribute_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TunnelEncapSubTLVProtocol) String() string {
+func (x *DFElectionExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TunnelEncapSubTLVProtocol) ProtoMessage() {}
+func (*DFElectionExtended) ProtoMessage() {}
 
-func (x *TunnelEncapSubTLVProtocol) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[76]
+func (x *DFElectionExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5134,43 +5769,51 @@ func (x *TunnelEncapSubTLVProtocol) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TunnelEncapSubTLVProtocol.ProtoReflect.Descriptor instead.
-func (*TunnelEncapSubTLVProtocol) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use DFElectionExtended.ProtoReflect.Descriptor instead.
+func (*DFElectionExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *TunnelEncapSubTLVProtocol) GetProtocol() uint32 {
+func (x *DFElectionExtended) GetAlgorithm() uint32 {
 	if x != nil {
-		return x.Protocol
+		return x.Algorithm
 	}
 	return 0
 }
 
-type TunnelEncapSubTLVColor struct {
+func (x *DFElectionExtended) GetBitmap() uint32 {
+	if x != nil {
+		return x.Bitmap
+	}
+	return 0
+}
+
+type TrafficRateExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Color uint32 `protobuf:"varint,1,opt,name=color,proto3" json:"color,omitempty"`
+	Asn  uint32  `protobuf:"varint,1,opt,name=asn,proto3" json:"asn,omitempty"`
+	Rate float32 `protobuf:"fixed32,2,opt,name=rate,proto3" json:"rate,omitempty"`
 }
 
-func (x *TunnelEncapSubTLVColor) Reset() {
-	*x = TunnelEncapSubTLVColor{}
+func (x *TrafficRateExtended) Reset() {
+	*x = TrafficRateExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[77]
+		mi := &file_attribute_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TunnelEncapSubTLVColor) String() string {
+func (x *TrafficRateExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TunnelEncapSubTLVColor) ProtoMessage() {}
+func (*TrafficRateExtended) ProtoMessage() {}
 
-func (x *TunnelEncapSubTLVColor) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[77]
+func (x *TrafficRateExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5181,44 +5824,51 @@ func (x *TunnelEncapSubTLVColor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TunnelEncapSubTLVColor.ProtoReflect.Descriptor instead.
-func (*TunnelEncapSubTLVColor) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use TrafficRateExtended.ProtoReflect.Descriptor instead.
+func (*TrafficRateExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *TunnelEncapSubTLVColor) GetColor() uint32 {
+func (x *TrafficRateExtended) GetAsn() uint32 {
 	if x != nil {
-		return x.Color
+		return x.Asn
 	}
 	return 0
 }
 
-type TunnelEncapSubTLVSRPreference struct {
+func (x *TrafficRateExtended) GetRate() float32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type TrafficActionExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Flags      uint32 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
-	Preference uint32 `protobuf:"varint,2,opt,name=preference,proto3" json:"preference,omitempty"`
+	Terminal bool `protobuf:"varint,1,opt,name=terminal,proto3" json:"terminal,omitempty"`
+	Sample   bool `protobuf:"varint,2,opt,name=sample,proto3" json:"sample,omitempty"`
 }
 
-func (x *TunnelEncapSubTLVSRPreference) Reset() {
-	*x = TunnelEncapSubTLVSRPreference{}
+func (x *TrafficActionExtended) Reset() {
+	*x = TrafficActionExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[78]
+		mi := &file_attribute_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TunnelEncapSubTLVSRPreference) String() string {
+func (x *TrafficActionExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TunnelEncapSubTLVSRPreference) ProtoMessage() {}
+func (*TrafficActionExtended) ProtoMessage() {}
 
-func (x *TunnelEncapSubTLVSRPreference) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[78]
+func (x *TrafficActionExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5229,50 +5879,51 @@ func (x *TunnelEncapSubTLVSRPreference) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TunnelEncapSubTLVSRPreference.ProtoReflect.Descriptor instead.
-func (*TunnelEncapSubTLVSRPreference) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use TrafficActionExtended.ProtoReflect.Descriptor instead.
+func (*TrafficActionExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *TunnelEncapSubTLVSRPreference) GetFlags() uint32 {
+func (x *TrafficActionExtended) GetTerminal() bool {
 	if x != nil {
-		return x.Flags
+		return x.Terminal
 	}
-	return 0
+	return false
 }
 
-func (x *TunnelEncapSubTLVSRPreference) GetPreference() uint32 {
+func (x *TrafficActionExtended) GetSample() bool {
 	if x != nil {
-		return x.Preference
+		return x.Sample
 	}
-	return 0
+	return false
 }
 
-type TunnelEncapSubTLVSRCandidatePathName struct {
+type RedirectTwoOctetAsSpecificExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CandidatePathName string `protobuf:"bytes,1,opt,name=candidate_path_name,json=candidatePathName,proto3" json:"candidate_path_name,omitempty"`
+	Asn        uint32 `protobuf:"varint,1,opt,name=asn,proto3" json:"asn,omitempty"`
+	LocalAdmin uint32 `protobuf:"varint,2,opt,name=local_admin,json=localAdmin,proto3" json:"local_admin,omitempty"`
 }
 
-func (x *TunnelEncapSubTLVSRCandidatePathName) Reset() {
-	*x = TunnelEncapSubTLVSRCandidatePathName{}
+func (x *RedirectTwoOctetAsSpecificExtended) Reset() {
+	*x = RedirectTwoOctetAsSpecificExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[79]
+		mi := &file_attribute_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TunnelEncapSubTLVSRCandidatePathName) String() string {
+func (x *RedirectTwoOctetAsSpecificExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TunnelEncapSubTLVSRCandidatePathName) ProtoMessage() {}
+func (*RedirectTwoOctetAsSpecificExtended) ProtoMessage() {}
 
-func (x *TunnelEncapSubTLVSRCandidatePathName) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[79]
+func (x *RedirectTwoOctetAsSpecificExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5283,43 +5934,51 @@ func (x *TunnelEncapSubTLVSRCandidatePathName) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TunnelEncapSubTLVSRCandidatePathName.ProtoReflect.Descriptor instead.
-func (*TunnelEncapSubTLVSRCandidatePathName) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use RedirectTwoOctetAsSpecificExtended.ProtoReflect.Descriptor instead.
+func (*RedirectTwoOctetAsSpecificExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *TunnelEncapSubTLVSRCandidatePathName) GetCandidatePathName() string {
+func (x *RedirectTwoOctetAsSpecificExtended) GetAsn() uint32 {
 	if x != nil {
-		return x.CandidatePathName
+		return x.Asn
 	}
-	return ""
+	return 0
 }
 
-type TunnelEncapSubTLVSRPriority struct {
+func (x *RedirectTwoOctetAsSpecificExtended) GetLocalAdmin() uint32 {
+	if x != nil {
+		return x.LocalAdmin
+	}
+	return 0
+}
+
+type RedirectIPv4AddressSpecificExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Priority uint32 `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	LocalAdmin uint32 `protobuf:"varint,2,opt,name=local_admin,json=localAdmin,proto3" json:"local_admin,omitempty"`
 }
 
-func (x *TunnelEncapSubTLVSRPriority) Reset() {
-	*x = TunnelEncapSubTLVSRPriority{}
+func (x *RedirectIPv4AddressSpecificExtended) Reset() {
+	*x = RedirectIPv4AddressSpecificExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[80]
+		mi := &file_attribute_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TunnelEncapSubTLVSRPriority) String() string {
+func (x *RedirectIPv4AddressSpecificExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TunnelEncapSubTLVSRPriority) ProtoMessage() {}
+func (*RedirectIPv4AddressSpecificExtended) ProtoMessage() {}
 
-func (x *TunnelEncapSubTLVSRPriority) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[80]
+func (x *RedirectIPv4AddressSpecificExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5330,46 +5989,51 @@ func (x *TunnelEncapSubTLVSRPriority) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TunnelEncapSubTLVSRPriority.ProtoReflect.Descriptor instead.
-func (*TunnelEncapSubTLVSRPriority) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use RedirectIPv4AddressSpecificExtended.ProtoReflect.Descriptor instead.
+func (*RedirectIPv4AddressSpecificExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *TunnelEncapSubTLVSRPriority) GetPriority() uint32 {
+func (x *RedirectIPv4AddressSpecificExtended) GetAddress() string {
 	if x != nil {
-		return x.Priority
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RedirectIPv4AddressSpecificExtended) GetLocalAdmin() uint32 {
+	if x != nil {
+		return x.LocalAdmin
 	}
 	return 0
 }
 
-type TunnelEncapSubTLVSRBindingSID struct {
+type RedirectFourOctetAsSpecificExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// bsid must be one of:
-	// - SRBindingSID
-	// - SRv6BindingSID
-	Bsid *anypb.Any `protobuf:"bytes,1,opt,name=bsid,proto3" json:"bsid,omitempty"`
+	Asn        uint32 `protobuf:"varint,1,opt,name=asn,proto3" json:"asn,omitempty"`
+	LocalAdmin uint32 `protobuf:"varint,2,opt,name=local_admin,json=localAdmin,proto3" json:"local_admin,omitempty"`
 }
 
-func (x *TunnelEncapSubTLVSRBindingSID) Reset() {
-	*x = TunnelEncapSubTLVSRBindingSID{}
+func (x *RedirectFourOctetAsSpecificExtended) Reset() {
+	*x = RedirectFourOctetAsSpecificExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[81]
+		mi := &file_attribute_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TunnelEncapSubTLVSRBindingSID) String() string {
+func (x *RedirectFourOctetAsSpecificExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TunnelEncapSubTLVSRBindingSID) ProtoMessage() {}
+func (*RedirectFourOctetAsSpecificExtended) ProtoMessage() {}
 
-func (x *TunnelEncapSubTLVSRBindingSID) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[81]
+func (x *RedirectFourOctetAsSpecificExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5380,45 +6044,50 @@ func (x *TunnelEncapSubTLVSRBindingSID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TunnelEncapSubTLVSRBindingSID.ProtoReflect.Descriptor instead.
-func (*TunnelEncapSubTLVSRBindingSID) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use RedirectFourOctetAsSpecificExtended.ProtoReflect.Descriptor instead.
+func (*RedirectFourOctetAsSpecificExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *TunnelEncapSubTLVSRBindingSID) GetBsid() *anypb.Any {
+func (x *RedirectFourOctetAsSpecificExtended) GetAsn() uint32 {
 	if x != nil {
-		return x.Bsid
+		return x.Asn
 	}
-	return nil
+	return 0
 }
 
-type SRBindingSID struct {
+func (x *RedirectFourOctetAsSpecificExtended) GetLocalAdmin() uint32 {
+	if x != nil {
+		return x.LocalAdmin
+	}
+	return 0
+}
+
+type TrafficRemarkExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SFlag bool   `protobuf:"varint,1,opt,name=s_flag,json=sFlag,proto3" json:"s_flag,omitempty"`
-	IFlag bool   `protobuf:"varint,2,opt,name=i_flag,json=iFlag,proto3" json:"i_flag,omitempty"`
-	Sid   []byte `protobuf:"bytes,3,opt,name=sid,proto3" json:"sid,omitempty"`
+	Dscp uint32 `protobuf:"varint,1,opt,name=dscp,proto3" json:"dscp,omitempty"`
 }
 
-func (x *SRBindingSID) Reset() {
-	*x = SRBindingSID{}
+func (x *TrafficRemarkExtended) Reset() {
+	*x = TrafficRemarkExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[82]
+		mi := &file_attribute_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SRBindingSID) String() string {
+func (x *TrafficRemarkExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SRBindingSID) ProtoMessage() {}
+func (*TrafficRemarkExtended) ProtoMessage() {}
 
-func (x *SRBindingSID) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[82]
+func (x *TrafficRemarkExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5429,61 +6098,46 @@ func (x *SRBindingSID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SRBindingSID.ProtoReflect.Descriptor instead.
-func (*SRBindingSID) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{82}
-}
-
-func (x *SRBindingSID) GetSFlag() bool {
-	if x != nil {
-		return x.SFlag
-	}
-	return false
-}
-
-func (x *SRBindingSID) GetIFlag() bool {
-	if x != nil {
-		return x.IFlag
-	}
-	return false
+// Deprecated: Use TrafficRemarkExtended.ProtoReflect.Descriptor instead.
+func (*TrafficRemarkExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *SRBindingSID) GetSid() []byte {
+func (x *TrafficRemarkExtended) GetDscp() uint32 {
 	if x != nil {
-		return x.Sid
+		return x.Dscp
 	}
-	return nil
+	return 0
 }
 
-type SRv6EndPointBehavior struct {
+type InterfaceSetExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Behavior SRv6Behavior `protobuf:"varint,1,opt,name=behavior,proto3,enum=apipb.SRv6Behavior" json:"behavior,omitempty"`
-	BlockLen uint32       `protobuf:"varint,2,opt,name=block_len,json=blockLen,proto3" json:"block_len,omitempty"`
-	NodeLen  uint32       `protobuf:"varint,3,opt,name=node_len,json=nodeLen,proto3" json:"node_len,omitempty"`
-	FuncLen  uint32       `protobuf:"varint,4,opt,name=func_len,json=funcLen,proto3" json:"func_len,omitempty"`
-	ArgLen   uint32       `protobuf:"varint,5,opt,name=arg_len,json=argLen,proto3" json:"arg_len,omitempty"`
+	Asn      uint32 `protobuf:"varint,1,opt,name=asn,proto3" json:"asn,omitempty"`
+	GroupId  uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Inbound  bool   `protobuf:"varint,3,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	Outbound bool   `protobuf:"varint,4,opt,name=outbound,proto3" json:"outbound,omitempty"`
 }
 
-func (x *SRv6EndPointBehavior) Reset() {
-	*x = SRv6EndPointBehavior{}
+func (x *InterfaceSetExtended) Reset() {
+	*x = InterfaceSetExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[83]
+		mi := &file_attribute_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SRv6EndPointBehavior) String() string {
+func (x *InterfaceSetExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SRv6EndPointBehavior) ProtoMessage() {}
+func (*InterfaceSetExtended) ProtoMessage() {}
 
-func (x *SRv6EndPointBehavior) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[83]
+func (x *InterfaceSetExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5494,75 +6148,66 @@ func (x *SRv6EndPointBehavior) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SRv6EndPointBehavior.ProtoReflect.Descriptor instead.
-func (*SRv6EndPointBehavior) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{83}
-}
-
-func (x *SRv6EndPointBehavior) GetBehavior() SRv6Behavior {
-	if x != nil {
-		return x.Behavior
-	}
-	return SRv6Behavior_RESERVED
+// Deprecated: Use InterfaceSetExtended.ProtoReflect.Descriptor instead.
+func (*InterfaceSetExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *SRv6EndPointBehavior) GetBlockLen() uint32 {
+func (x *InterfaceSetExtended) GetAsn() uint32 {
 	if x != nil {
-		return x.BlockLen
+		return x.Asn
 	}
 	return 0
 }
 
-func (x *SRv6EndPointBehavior) GetNodeLen() uint32 {
+func (x *InterfaceSetExtended) GetGroupId() uint32 {
 	if x != nil {
-		return x.NodeLen
+		return x.GroupId
 	}
 	return 0
 }
 
-func (x *SRv6EndPointBehavior) GetFuncLen() uint32 {
+func (x *InterfaceSetExtended) GetInbound() bool {
 	if x != nil {
-		return x.FuncLen
+		return x.Inbound
 	}
-	return 0
+	return false
 }
 
-func (x *SRv6EndPointBehavior) GetArgLen() uint32 {
+func (x *InterfaceSetExtended) GetOutbound() bool {
 	if x != nil {
-		return x.ArgLen
+		return x.Outbound
 	}
-	return 0
+	return false
 }
 
-type SRv6BindingSID struct {
+type MUPExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SFlag                     bool                  `protobuf:"varint,1,opt,name=s_flag,json=sFlag,proto3" json:"s_flag,omitempty"`
-	IFlag                     bool                  `protobuf:"varint,2,opt,name=i_flag,json=iFlag,proto3" json:"i_flag,omitempty"`
-	BFlag                     bool                  `protobuf:"varint,3,opt,name=b_flag,json=bFlag,proto3" json:"b_flag,omitempty"`
-	Sid                       []byte                `protobuf:"bytes,4,opt,name=sid,proto3" json:"sid,omitempty"`
-	EndpointBehaviorStructure *SRv6EndPointBehavior `protobuf:"bytes,5,opt,name=endpoint_behavior_structure,json=endpointBehaviorStructure,proto3" json:"endpoint_behavior_structure,omitempty"`
+	SubType    uint32 `protobuf:"varint,1,opt,name=sub_type,json=subType,proto3" json:"sub_type,omitempty"`
+	SegmentId2 uint32 `protobuf:"varint,2,opt,name=segment_id2,json=segmentId2,proto3" json:"segment_id2,omitempty"`
+	SegmentId4 uint32 `protobuf:"varint,3,opt,name=segment_id4,json=segmentId4,proto3" json:"segment_id4,omitempty"`
 }
 
-func (x *SRv6BindingSID) Reset() {
-	*x = SRv6BindingSID{}
+func (x *MUPExtended) Reset() {
+	*x = MUPExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[84]
+		mi := &file_attribute_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SRv6BindingSID) String() string {
+func (x *MUPExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SRv6BindingSID) ProtoMessage() {}
+func (*MUPExtended) ProtoMessage() {}
 
-func (x *SRv6BindingSID) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[84]
+func (x *MUPExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5573,72 +6218,58 @@ func (x *SRv6BindingSID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SRv6BindingSID.ProtoReflect.Descriptor instead.
-func (*SRv6BindingSID) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{84}
-}
-
-func (x *SRv6BindingSID) GetSFlag() bool {
-	if x != nil {
-		return x.SFlag
-	}
-	return false
-}
-
-func (x *SRv6BindingSID) GetIFlag() bool {
-	if x != nil {
-		return x.IFlag
-	}
-	return false
+// Deprecated: Use MUPExtended.ProtoReflect.Descriptor instead.
+func (*MUPExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *SRv6BindingSID) GetBFlag() bool {
+func (x *MUPExtended) GetSubType() uint32 {
 	if x != nil {
-		return x.BFlag
+		return x.SubType
 	}
-	return false
+	return 0
 }
 
-func (x *SRv6BindingSID) GetSid() []byte {
+func (x *MUPExtended) GetSegmentId2() uint32 {
 	if x != nil {
-		return x.Sid
+		return x.SegmentId2
 	}
-	return nil
+	return 0
 }
 
-func (x *SRv6BindingSID) GetEndpointBehaviorStructure() *SRv6EndPointBehavior {
+func (x *MUPExtended) GetSegmentId4() uint32 {
 	if x != nil {
-		return x.EndpointBehaviorStructure
+		return x.SegmentId4
 	}
-	return nil
+	return 0
 }
 
-type TunnelEncapSubTLVSRENLP struct {
+type VPLSExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Flags uint32   `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
-	Enlp  ENLPType `protobuf:"varint,2,opt,name=enlp,proto3,enum=apipb.ENLPType" json:"enlp,omitempty"`
+	ControlFlags uint32 `protobuf:"varint,1,opt,name=control_flags,json=controlFlags,proto3" json:"control_flags,omitempty"`
+	Mtu          uint32 `protobuf:"varint,2,opt,name=mtu,proto3" json:"mtu,omitempty"`
 }
 
-func (x *TunnelEncapSubTLVSRENLP) Reset() {
-	*x = TunnelEncapSubTLVSRENLP{}
+func (x *VPLSExtended) Reset() {
+	*x = VPLSExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[85]
+		mi := &file_attribute_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TunnelEncapSubTLVSRENLP) String() string {
+func (x *VPLSExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TunnelEncapSubTLVSRENLP) ProtoMessage() {}
+func (*VPLSExtended) ProtoMessage() {}
 
-func (x *TunnelEncapSubTLVSRENLP) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[85]
+func (x *VPLSExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5649,51 +6280,53 @@ func (x *TunnelEncapSubTLVSRENLP) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TunnelEncapSubTLVSRENLP.ProtoReflect.Descriptor instead.
-func (*TunnelEncapSubTLVSRENLP) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use VPLSExtended.ProtoReflect.Descriptor instead.
+func (*VPLSExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *TunnelEncapSubTLVSRENLP) GetFlags() uint32 {
+func (x *VPLSExtended) GetControlFlags() uint32 {
 	if x != nil {
-		return x.Flags
+		return x.ControlFlags
 	}
 	return 0
 }
 
-func (x *TunnelEncapSubTLVSRENLP) GetEnlp() ENLPType {
+func (x *VPLSExtended) GetMtu() uint32 {
 	if x != nil {
-		return x.Enlp
+		return x.Mtu
 	}
-	return ENLPType_Reserved
+	return 0
 }
 
-type SRWeight struct {
+// TransportClassExtended represents the Transport Class Route Target
+// Extended Community of the Classful Transport SAFI.
+type TransportClassExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Flags  uint32 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
-	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	TransportClass uint32 `protobuf:"varint,1,opt,name=transport_class,json=transportClass,proto3" json:"transport_class,omitempty"`
+	IsTransitive   bool   `protobuf:"varint,2,opt,name=is_transitive,json=isTransitive,proto3" json:"is_transitive,omitempty"`
 }
 
-func (x *SRWeight) Reset() {
-	*x = SRWeight{}
+func (x *TransportClassExtended) Reset() {
+	*x = TransportClassExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[86]
+		mi := &file_attribute_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SRWeight) String() string {
+func (x *TransportClassExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SRWeight) ProtoMessage() {}
+func (*TransportClassExtended) ProtoMessage() {}
 
-func (x *SRWeight) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[86]
+func (x *TransportClassExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5704,53 +6337,51 @@ func (x *SRWeight) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SRWeight.ProtoReflect.Descriptor instead.
-func (*SRWeight) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use TransportClassExtended.ProtoReflect.Descriptor instead.
+func (*TransportClassExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *SRWeight) GetFlags() uint32 {
+func (x *TransportClassExtended) GetTransportClass() uint32 {
 	if x != nil {
-		return x.Flags
+		return x.TransportClass
 	}
 	return 0
 }
 
-func (x *SRWeight) GetWeight() uint32 {
+func (x *TransportClassExtended) GetIsTransitive() bool {
 	if x != nil {
-		return x.Weight
+		return x.IsTransitive
 	}
-	return 0
+	return false
 }
 
-type SegmentFlags struct {
+type UnknownExtended struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VFlag bool `protobuf:"varint,1,opt,name=v_flag,json=vFlag,proto3" json:"v_flag,omitempty"`
-	AFlag bool `protobuf:"varint,2,opt,name=a_flag,json=aFlag,proto3" json:"a_flag,omitempty"`
-	SFlag bool `protobuf:"varint,3,opt,name=s_flag,json=sFlag,proto3" json:"s_flag,omitempty"`
-	BFlag bool `protobuf:"varint,4,opt,name=b_flag,json=bFlag,proto3" json:"b_flag,omitempty"`
+	Type  uint32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *SegmentFlags) Reset() {
-	*x = SegmentFlags{}
+func (x *UnknownExtended) Reset() {
+	*x = UnknownExtended{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[87]
+		mi := &file_attribute_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SegmentFlags) String() string {
+func (x *UnknownExtended) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SegmentFlags) ProtoMessage() {}
+func (*UnknownExtended) ProtoMessage() {}
 
-func (x *SegmentFlags) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[87]
+func (x *UnknownExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5761,65 +6392,71 @@ func (x *SegmentFlags) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SegmentFlags.ProtoReflect.Descriptor instead.
-func (*SegmentFlags) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{87}
-}
-
-func (x *SegmentFlags) GetVFlag() bool {
-	if x != nil {
-		return x.VFlag
-	}
-	return false
-}
-
-func (x *SegmentFlags) GetAFlag() bool {
-	if x != nil {
-		return x.AFlag
-	}
-	return false
+// Deprecated: Use UnknownExtended.ProtoReflect.Descriptor instead.
+func (*UnknownExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *SegmentFlags) GetSFlag() bool {
+func (x *UnknownExtended) GetType() uint32 {
 	if x != nil {
-		return x.SFlag
+		return x.Type
 	}
-	return false
+	return 0
 }
 
-func (x *SegmentFlags) GetBFlag() bool {
+func (x *UnknownExtended) GetValue() []byte {
 	if x != nil {
-		return x.BFlag
+		return x.Value
 	}
-	return false
+	return nil
 }
 
-type SegmentTypeA struct {
+type ExtendedCommunitiesAttribute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Flags *SegmentFlags `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
-	Label uint32        `protobuf:"varint,2,opt,name=label,proto3" json:"label,omitempty"`
+	// Each Community must be one of:
+	// - TwoOctetAsSpecificExtended
+	// - IPv4AddressSpecificExtended
+	// - FourOctetAsSpecificExtended
+	// - OpaqueExtended
+	// - ESILabelExtended
+	// - MacMobilityExtended
+	// - RouterMacExtended
+	// - Layer2AttributesExtended
+	// - DFElectionExtended
+	// - TrafficRateExtended
+	// - TrafficActionExtended
+	// - RedirectTwoOctetAsSpecificExtended
+	// - RedirectIPv4AddressSpecificExtended
+	// - RedirectFourOctetAsSpecificExtended
+	// - TrafficRemarkExtended
+	// - InterfaceSetExtended
+	// - MUPExtended
+	// - VPLSExtended
+	// - TransportClassExtended
+	// - UnknownExtended
+	Communities []*anypb.Any `protobuf:"bytes,1,rep,name=communities,proto3" json:"communities,omitempty"`
 }
 
-func (x *SegmentTypeA) Reset() {
-	*x = SegmentTypeA{}
+func (x *ExtendedCommunitiesAttribute) Reset() {
+	*x = ExtendedCommunitiesAttribute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[88]
+		mi := &file_attribute_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SegmentTypeA) String() string {
+func (x *ExtendedCommunitiesAttribute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SegmentTypeA) ProtoMessage() {}
+func (*ExtendedCommunitiesAttribute) ProtoMessage() {}
 
-func (x *SegmentTypeA) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[88]
+func (x *ExtendedCommunitiesAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5830,52 +6467,43 @@ func (x *SegmentTypeA) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SegmentTypeA.ProtoReflect.Descriptor instead.
-func (*SegmentTypeA) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use ExtendedCommunitiesAttribute.ProtoReflect.Descriptor instead.
+func (*ExtendedCommunitiesAttribute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *SegmentTypeA) GetFlags() *SegmentFlags {
+func (x *ExtendedCommunitiesAttribute) GetCommunities() []*anypb.Any {
 	if x != nil {
-		return x.Flags
+		return x.Communities
 	}
 	return nil
 }
 
-func (x *SegmentTypeA) GetLabel() uint32 {
-	if x != nil {
-		return x.Label
-	}
-	return 0
-}
-
-type SegmentTypeB struct {
+type As4PathAttribute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Flags                     *SegmentFlags         `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
-	Sid                       []byte                `protobuf:"bytes,2,opt,name=sid,proto3" json:"sid,omitempty"`
-	EndpointBehaviorStructure *SRv6EndPointBehavior `protobuf:"bytes,3,opt,name=endpoint_behavior_structure,json=endpointBehaviorStructure,proto3" json:"endpoint_behavior_structure,omitempty"`
+	Segments []*AsSegment `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
 }
 
-func (x *SegmentTypeB) Reset() {
-	*x = SegmentTypeB{}
+func (x *As4PathAttribute) Reset() {
+	*x = As4PathAttribute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[89]
+		mi := &file_attribute_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SegmentTypeB) String() string {
+func (x *As4PathAttribute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SegmentTypeB) ProtoMessage() {}
+func (*As4PathAttribute) ProtoMessage() {}
 
-func (x *SegmentTypeB) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[89]
+func (x *As4PathAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5886,61 +6514,44 @@ func (x *SegmentTypeB) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SegmentTypeB.ProtoReflect.Descriptor instead.
-func (*SegmentTypeB) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{89}
-}
-
-func (x *SegmentTypeB) GetFlags() *SegmentFlags {
-	if x != nil {
-		return x.Flags
-	}
-	return nil
-}
-
-func (x *SegmentTypeB) GetSid() []byte {
-	if x != nil {
-		return x.Sid
-	}
-	return nil
+// Deprecated: Use As4PathAttribute.ProtoReflect.Descriptor instead.
+func (*As4PathAttribute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *SegmentTypeB) GetEndpointBehaviorStructure() *SRv6EndPointBehavior {
+func (x *As4PathAttribute) GetSegments() []*AsSegment {
 	if x != nil {
-		return x.EndpointBehaviorStructure
+		return x.Segments
 	}
 	return nil
 }
 
-type TunnelEncapSubTLVSRSegmentList struct {
+type As4AggregatorAttribute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Weight *SRWeight `protobuf:"bytes,1,opt,name=weight,proto3" json:"weight,omitempty"`
-	// segments must be one of:
-	// - SegmentTypeA
-	// - SegmentTypeB
-	Segments []*anypb.Any `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`
+	Asn     uint32 `protobuf:"varint,2,opt,name=asn,proto3" json:"asn,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *TunnelEncapSubTLVSRSegmentList) Reset() {
-	*x = TunnelEncapSubTLVSRSegmentList{}
+func (x *As4AggregatorAttribute) Reset() {
+	*x = As4AggregatorAttribute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[90]
+		mi := &file_attribute_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TunnelEncapSubTLVSRSegmentList) String() string {
+func (x *As4AggregatorAttribute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TunnelEncapSubTLVSRSegmentList) ProtoMessage() {}
+func (*As4AggregatorAttribute) ProtoMessage() {}
 
-func (x *TunnelEncapSubTLVSRSegmentList) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[90]
+func (x *As4AggregatorAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5951,50 +6562,53 @@ func (x *TunnelEncapSubTLVSRSegmentList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TunnelEncapSubTLVSRSegmentList.ProtoReflect.Descriptor instead.
-func (*TunnelEncapSubTLVSRSegmentList) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use As4AggregatorAttribute.ProtoReflect.Descriptor instead.
+func (*As4AggregatorAttribute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *TunnelEncapSubTLVSRSegmentList) GetWeight() *SRWeight {
+func (x *As4AggregatorAttribute) GetAsn() uint32 {
 	if x != nil {
-		return x.Weight
+		return x.Asn
 	}
-	return nil
+	return 0
 }
 
-func (x *TunnelEncapSubTLVSRSegmentList) GetSegments() []*anypb.Any {
+func (x *As4AggregatorAttribute) GetAddress() string {
 	if x != nil {
-		return x.Segments
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-type TunnelEncapSubTLVEgressEndpoint struct {
+type PmsiTunnelAttribute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Flags uint32 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Type  uint32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Label uint32 `protobuf:"varint,3,opt,name=label,proto3" json:"label,omitempty"`
+	Id    []byte `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *TunnelEncapSubTLVEgressEndpoint) Reset() {
-	*x = TunnelEncapSubTLVEgressEndpoint{}
+func (x *PmsiTunnelAttribute) Reset() {
+	*x = PmsiTunnelAttribute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[91]
+		mi := &file_attribute_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TunnelEncapSubTLVEgressEndpoint) String() string {
+func (x *PmsiTunnelAttribute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TunnelEncapSubTLVEgressEndpoint) ProtoMessage() {}
+func (*PmsiTunnelAttribute) ProtoMessage() {}
 
-func (x *TunnelEncapSubTLVEgressEndpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[91]
+func (x *PmsiTunnelAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6005,91 +6619,65 @@ func (x *TunnelEncapSubTLVEgressEndpoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TunnelEncapSubTLVEgressEndpoint.ProtoReflect.Descriptor instead.
-func (*TunnelEncapSubTLVEgressEndpoint) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use PmsiTunnelAttribute.ProtoReflect.Descriptor instead.
+func (*PmsiTunnelAttribute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *TunnelEncapSubTLVEgressEndpoint) GetAddress() string {
+func (x *PmsiTunnelAttribute) GetFlags() uint32 {
 	if x != nil {
-		return x.Address
+		return x.Flags
 	}
-	return ""
+	return 0
 }
 
-type TunnelEncapSubTLVUDPDestPort struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Port uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+func (x *PmsiTunnelAttribute) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
 }
 
-func (x *TunnelEncapSubTLVUDPDestPort) Reset() {
-	*x = TunnelEncapSubTLVUDPDestPort{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[92]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PmsiTunnelAttribute) GetLabel() uint32 {
+	if x != nil {
+		return x.Label
 	}
+	return 0
 }
 
-func (x *TunnelEncapSubTLVUDPDestPort) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PmsiTunnelAttribute) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
 }
 
-func (*TunnelEncapSubTLVUDPDestPort) ProtoMessage() {}
-
-func (x *TunnelEncapSubTLVUDPDestPort) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[92]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use TunnelEncapSubTLVUDPDestPort.ProtoReflect.Descriptor instead.
-func (*TunnelEncapSubTLVUDPDestPort) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{92}
-}
-
-func (x *TunnelEncapSubTLVUDPDestPort) GetPort() uint32 {
-	if x != nil {
-		return x.Port
-	}
-	return 0
-}
-
-type TunnelEncapSubTLVUnknown struct {
+type TunnelEncapSubTLVEncapsulation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type  uint32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
-	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Key    uint32 `protobuf:"varint,1,opt,name=key,proto3" json:"key,omitempty"`
+	Cookie []byte `protobuf:"bytes,2,opt,name=cookie,proto3" json:"cookie,omitempty"`
 }
 
-func (x *TunnelEncapSubTLVUnknown) Reset() {
-	*x = TunnelEncapSubTLVUnknown{}
+func (x *TunnelEncapSubTLVEncapsulation) Reset() {
+	*x = TunnelEncapSubTLVEncapsulation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[93]
+		mi := &file_attribute_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TunnelEncapSubTLVUnknown) String() string {
+func (x *TunnelEncapSubTLVEncapsulation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TunnelEncapSubTLVUnknown) ProtoMessage() {}
+func (*TunnelEncapSubTLVEncapsulation) ProtoMessage() {}
 
-func (x *TunnelEncapSubTLVUnknown) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[93]
+func (x *TunnelEncapSubTLVEncapsulation) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6100,57 +6688,50 @@ func (x *TunnelEncapSubTLVUnknown) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TunnelEncapSubTLVUnknown.ProtoReflect.Descriptor instead.
-func (*TunnelEncapSubTLVUnknown) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use TunnelEncapSubTLVEncapsulation.ProtoReflect.Descriptor instead.
+func (*TunnelEncapSubTLVEncapsulation) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *TunnelEncapSubTLVUnknown) GetType() uint32 {
+func (x *TunnelEncapSubTLVEncapsulation) GetKey() uint32 {
 	if x != nil {
-		return x.Type
+		return x.Key
 	}
 	return 0
 }
 
-func (x *TunnelEncapSubTLVUnknown) GetValue() []byte {
+func (x *TunnelEncapSubTLVEncapsulation) GetCookie() []byte {
 	if x != nil {
-		return x.Value
+		return x.Cookie
 	}
 	return nil
 }
 
-type TunnelEncapTLV struct {
+type TunnelEncapSubTLVProtocol struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type uint32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
-	// Each TLV must be one of:
-	// - TunnelEncapSubTLVEncapsulation
-	// - TunnelEncapSubTLVProtocol
-	// - TunnelEncapSubTLVColor
-	// - TunnelEncapSubTLVSRPolicy
-	// - TunnelEncapSubTLVUnknown
-	Tlvs []*anypb.Any `protobuf:"bytes,2,rep,name=tlvs,proto3" json:"tlvs,omitempty"`
+	Protocol uint32 `protobuf:"varint,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
 }
 
-func (x *TunnelEncapTLV) Reset() {
-	*x = TunnelEncapTLV{}
+func (x *TunnelEncapSubTLVProtocol) Reset() {
+	*x = TunnelEncapSubTLVProtocol{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[94]
+		mi := &file_attribute_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TunnelEncapTLV) String() string {
+func (x *TunnelEncapSubTLVProtocol) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TunnelEncapTLV) ProtoMessage() {}
+func (*TunnelEncapSubTLVProtocol) ProtoMessage() {}
 
-func (x *TunnelEncapTLV) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[94]
+func (x *TunnelEncapSubTLVProtocol) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6161,50 +6742,43 @@ func (x *TunnelEncapTLV) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TunnelEncapTLV.ProtoReflect.Descriptor instead.
-func (*TunnelEncapTLV) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use TunnelEncapSubTLVProtocol.ProtoReflect.Descriptor instead.
+func (*TunnelEncapSubTLVProtocol) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *TunnelEncapTLV) GetType() uint32 {
+func (x *TunnelEncapSubTLVProtocol) GetProtocol() uint32 {
 	if x != nil {
-		return x.Type
+		return x.Protocol
 	}
 	return 0
 }
 
-func (x *TunnelEncapTLV) GetTlvs() []*anypb.Any {
-	if x != nil {
-		return x.Tlvs
-	}
-	return nil
-}
-
-type TunnelEncapAttribute struct {
+type TunnelEncapSubTLVColor struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tlvs []*TunnelEncapTLV `protobuf:"bytes,1,rep,name=tlvs,proto3" json:"tlvs,omitempty"`
+	Color uint32 `protobuf:"varint,1,opt,name=color,proto3" json:"color,omitempty"`
 }
 
-func (x *TunnelEncapAttribute) Reset() {
-	*x = TunnelEncapAttribute{}
+func (x *TunnelEncapSubTLVColor) Reset() {
+	*x = TunnelEncapSubTLVColor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[95]
+		mi := &file_attribute_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TunnelEncapAttribute) String() string {
+func (x *TunnelEncapSubTLVColor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TunnelEncapAttribute) ProtoMessage() {}
+func (*TunnelEncapSubTLVColor) ProtoMessage() {}
 
-func (x *TunnelEncapAttribute) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[95]
+func (x *TunnelEncapSubTLVColor) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6215,46 +6789,44 @@ func (x *TunnelEncapAttribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TunnelEncapAttribute.ProtoReflect.Descriptor instead.
-func (*TunnelEncapAttribute) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use TunnelEncapSubTLVColor.ProtoReflect.Descriptor instead.
+func (*TunnelEncapSubTLVColor) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *TunnelEncapAttribute) GetTlvs() []*TunnelEncapTLV {
+func (x *TunnelEncapSubTLVColor) GetColor() uint32 {
 	if x != nil {
-		return x.Tlvs
+		return x.Color
 	}
-	return nil
+	return 0
 }
 
-type IPv6AddressSpecificExtended struct {
+type TunnelEncapSubTLVSRPreference struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsTransitive bool   `protobuf:"varint,1,opt,name=is_transitive,json=isTransitive,proto3" json:"is_transitive,omitempty"`
-	SubType      uint32 `protobuf:"varint,2,opt,name=sub_type,json=subType,proto3" json:"sub_type,omitempty"`
-	Address      string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	LocalAdmin   uint32 `protobuf:"varint,4,opt,name=local_admin,json=localAdmin,proto3" json:"local_admin,omitempty"`
+	Flags      uint32 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Preference uint32 `protobuf:"varint,2,opt,name=preference,proto3" json:"preference,omitempty"`
 }
 
-func (x *IPv6AddressSpecificExtended) Reset() {
-	*x = IPv6AddressSpecificExtended{}
+func (x *TunnelEncapSubTLVSRPreference) Reset() {
+	*x = TunnelEncapSubTLVSRPreference{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[96]
+		mi := &file_attribute_proto_msgTypes[100]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IPv6AddressSpecificExtended) String() string {
+func (x *TunnelEncapSubTLVSRPreference) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IPv6AddressSpecificExtended) ProtoMessage() {}
+func (*TunnelEncapSubTLVSRPreference) ProtoMessage() {}
 
-func (x *IPv6AddressSpecificExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[96]
+func (x *TunnelEncapSubTLVSRPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6265,65 +6837,50 @@ func (x *IPv6AddressSpecificExtended) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IPv6AddressSpecificExtended.ProtoReflect.Descriptor instead.
-func (*IPv6AddressSpecificExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{96}
-}
-
-func (x *IPv6AddressSpecificExtended) GetIsTransitive() bool {
-	if x != nil {
-		return x.IsTransitive
-	}
-	return false
+// Deprecated: Use TunnelEncapSubTLVSRPreference.ProtoReflect.Descriptor instead.
+func (*TunnelEncapSubTLVSRPreference) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *IPv6AddressSpecificExtended) GetSubType() uint32 {
+func (x *TunnelEncapSubTLVSRPreference) GetFlags() uint32 {
 	if x != nil {
-		return x.SubType
+		return x.Flags
 	}
 	return 0
 }
 
-func (x *IPv6AddressSpecificExtended) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *IPv6AddressSpecificExtended) GetLocalAdmin() uint32 {
+func (x *TunnelEncapSubTLVSRPreference) GetPreference() uint32 {
 	if x != nil {
-		return x.LocalAdmin
+		return x.Preference
 	}
 	return 0
 }
 
-type RedirectIPv6AddressSpecificExtended struct {
+type TunnelEncapSubTLVSRCandidatePathName struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	LocalAdmin uint32 `protobuf:"varint,2,opt,name=local_admin,json=localAdmin,proto3" json:"local_admin,omitempty"`
+	CandidatePathName string `protobuf:"bytes,1,opt,name=candidate_path_name,json=candidatePathName,proto3" json:"candidate_path_name,omitempty"`
 }
 
-func (x *RedirectIPv6AddressSpecificExtended) Reset() {
-	*x = RedirectIPv6AddressSpecificExtended{}
+func (x *TunnelEncapSubTLVSRCandidatePathName) Reset() {
+	*x = TunnelEncapSubTLVSRCandidatePathName{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[97]
+		mi := &file_attribute_proto_msgTypes[101]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RedirectIPv6AddressSpecificExtended) String() string {
+func (x *TunnelEncapSubTLVSRCandidatePathName) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RedirectIPv6AddressSpecificExtended) ProtoMessage() {}
+func (*TunnelEncapSubTLVSRCandidatePathName) ProtoMessage() {}
 
-func (x *RedirectIPv6AddressSpecificExtended) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[97]
+func (x *TunnelEncapSubTLVSRCandidatePathName) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[101]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6334,53 +6891,43 @@ func (x *RedirectIPv6AddressSpecificExtended) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RedirectIPv6AddressSpecificExtended.ProtoReflect.Descriptor instead.
-func (*RedirectIPv6AddressSpecificExtended) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use TunnelEncapSubTLVSRCandidatePathName.ProtoReflect.Descriptor instead.
+func (*TunnelEncapSubTLVSRCandidatePathName) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *RedirectIPv6AddressSpecificExtended) GetAddress() string {
+func (x *TunnelEncapSubTLVSRCandidatePathName) GetCandidatePathName() string {
 	if x != nil {
-		return x.Address
+		return x.CandidatePathName
 	}
 	return ""
 }
 
-func (x *RedirectIPv6AddressSpecificExtended) GetLocalAdmin() uint32 {
-	if x != nil {
-		return x.LocalAdmin
-	}
-	return 0
-}
-
-type IP6ExtendedCommunitiesAttribute struct {
+type TunnelEncapSubTLVSRPriority struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Each Community must be one of:
-	// - IPv6AddressSpecificExtended
-	// - RedirectIPv6AddressSpecificExtended
-	Communities []*anypb.Any `protobuf:"bytes,1,rep,name=communities,proto3" json:"communities,omitempty"`
+	Priority uint32 `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
-func (x *IP6ExtendedCommunitiesAttribute) Reset() {
-	*x = IP6ExtendedCommunitiesAttribute{}
+func (x *TunnelEncapSubTLVSRPriority) Reset() {
+	*x = TunnelEncapSubTLVSRPriority{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[98]
+		mi := &file_attribute_proto_msgTypes[102]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IP6ExtendedCommunitiesAttribute) String() string {
+func (x *TunnelEncapSubTLVSRPriority) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IP6ExtendedCommunitiesAttribute) ProtoMessage() {}
+func (*TunnelEncapSubTLVSRPriority) ProtoMessage() {}
 
-func (x *IP6ExtendedCommunitiesAttribute) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[98]
+func (x *TunnelEncapSubTLVSRPriority) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[102]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6391,43 +6938,46 @@ func (x *IP6ExtendedCommunitiesAttribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IP6ExtendedCommunitiesAttribute.ProtoReflect.Descriptor instead.
-func (*IP6ExtendedCommunitiesAttribute) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use TunnelEncapSubTLVSRPriority.ProtoReflect.Descriptor instead.
+func (*TunnelEncapSubTLVSRPriority) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *IP6ExtendedCommunitiesAttribute) GetCommunities() []*anypb.Any {
+func (x *TunnelEncapSubTLVSRPriority) GetPriority() uint32 {
 	if x != nil {
-		return x.Communities
+		return x.Priority
 	}
-	return nil
+	return 0
 }
 
-type AigpTLVIGPMetric struct {
+type TunnelEncapSubTLVSRBindingSID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Metric uint64 `protobuf:"varint,1,opt,name=metric,proto3" json:"metric,omitempty"`
-}
-
-func (x *AigpTLVIGPMetric) Reset() {
-	*x = AigpTLVIGPMetric{}
+	// bsid must be one of:
+	// - SRBindingSID
+	// - SRv6BindingSID
+	Bsid *anypb.Any `protobuf:"bytes,1,opt,name=bsid,proto3" json:"bsid,omitempty"`
+}
+
+func (x *TunnelEncapSubTLVSRBindingSID) Reset() {
+	*x = TunnelEncapSubTLVSRBindingSID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[99]
+		mi := &file_attribute_proto_msgTypes[103]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AigpTLVIGPMetric) String() string {
+func (x *TunnelEncapSubTLVSRBindingSID) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AigpTLVIGPMetric) ProtoMessage() {}
+func (*TunnelEncapSubTLVSRBindingSID) ProtoMessage() {}
 
-func (x *AigpTLVIGPMetric) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[99]
+func (x *TunnelEncapSubTLVSRBindingSID) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[103]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6438,44 +6988,45 @@ func (x *AigpTLVIGPMetric) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AigpTLVIGPMetric.ProtoReflect.Descriptor instead.
-func (*AigpTLVIGPMetric) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{99}
+// Deprecated: Use TunnelEncapSubTLVSRBindingSID.ProtoReflect.Descriptor instead.
+func (*TunnelEncapSubTLVSRBindingSID) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *AigpTLVIGPMetric) GetMetric() uint64 {
+func (x *TunnelEncapSubTLVSRBindingSID) GetBsid() *anypb.Any {
 	if x != nil {
-		return x.Metric
+		return x.Bsid
 	}
-	return 0
+	return nil
 }
 
-type AigpTLVUnknown struct {
+type SRBindingSID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type  uint32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
-	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	SFlag bool   `protobuf:"varint,1,opt,name=s_flag,json=sFlag,proto3" json:"s_flag,omitempty"`
+	IFlag bool   `protobuf:"varint,2,opt,name=i_flag,json=iFlag,proto3" json:"i_flag,omitempty"`
+	Sid   []byte `protobuf:"bytes,3,opt,name=sid,proto3" json:"sid,omitempty"`
 }
 
-func (x *AigpTLVUnknown) Reset() {
-	*x = AigpTLVUnknown{}
+func (x *SRBindingSID) Reset() {
+	*x = SRBindingSID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[100]
+		mi := &file_attribute_proto_msgTypes[104]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AigpTLVUnknown) String() string {
+func (x *SRBindingSID) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AigpTLVUnknown) ProtoMessage() {}
+func (*SRBindingSID) ProtoMessage() {}
 
-func (x *AigpTLVUnknown) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[100]
+func (x *SRBindingSID) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[104]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6486,53 +7037,61 @@ func (x *AigpTLVUnknown) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AigpTLVUnknown.ProtoReflect.Descriptor instead.
-func (*AigpTLVUnknown) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use SRBindingSID.ProtoReflect.Descriptor instead.
+func (*SRBindingSID) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *AigpTLVUnknown) GetType() uint32 {
+func (x *SRBindingSID) GetSFlag() bool {
 	if x != nil {
-		return x.Type
+		return x.SFlag
 	}
-	return 0
+	return false
 }
 
-func (x *AigpTLVUnknown) GetValue() []byte {
+func (x *SRBindingSID) GetIFlag() bool {
 	if x != nil {
-		return x.Value
+		return x.IFlag
+	}
+	return false
+}
+
+func (x *SRBindingSID) GetSid() []byte {
+	if x != nil {
+		return x.Sid
 	}
 	return nil
 }
 
-type AigpAttribute struct {
+type SRv6EndPointBehavior struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Each TLV must be one of:
-	// - AigpTLVIGPMetric
-	// - AigpTLVUnknown
-	Tlvs []*anypb.Any `protobuf:"bytes,1,rep,name=tlvs,proto3" json:"tlvs,omitempty"`
+	Behavior SRv6Behavior `protobuf:"varint,1,opt,name=behavior,proto3,enum=apipb.SRv6Behavior" json:"behavior,omitempty"`
+	BlockLen uint32       `protobuf:"varint,2,opt,name=block_len,json=blockLen,proto3" json:"block_len,omitempty"`
+	NodeLen  uint32       `protobuf:"varint,3,opt,name=node_len,json=nodeLen,proto3" json:"node_len,omitempty"`
+	FuncLen  uint32       `protobuf:"varint,4,opt,name=func_len,json=funcLen,proto3" json:"func_len,omitempty"`
+	ArgLen   uint32       `protobuf:"varint,5,opt,name=arg_len,json=argLen,proto3" json:"arg_len,omitempty"`
 }
 
-func (x *AigpAttribute) Reset() {
-	*x = AigpAttribute{}
+func (x *SRv6EndPointBehavior) Reset() {
+	*x = SRv6EndPointBehavior{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[101]
+		mi := &file_attribute_proto_msgTypes[105]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AigpAttribute) String() string {
+func (x *SRv6EndPointBehavior) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AigpAttribute) ProtoMessage() {}
+func (*SRv6EndPointBehavior) ProtoMessage() {}
 
-func (x *AigpAttribute) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[101]
+func (x *SRv6EndPointBehavior) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[105]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6543,45 +7102,75 @@ func (x *AigpAttribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AigpAttribute.ProtoReflect.Descriptor instead.
-func (*AigpAttribute) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use SRv6EndPointBehavior.ProtoReflect.Descriptor instead.
+func (*SRv6EndPointBehavior) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *AigpAttribute) GetTlvs() []*anypb.Any {
+func (x *SRv6EndPointBehavior) GetBehavior() SRv6Behavior {
 	if x != nil {
-		return x.Tlvs
+		return x.Behavior
 	}
-	return nil
+	return SRv6Behavior_RESERVED
 }
 
-type LargeCommunity struct {
+func (x *SRv6EndPointBehavior) GetBlockLen() uint32 {
+	if x != nil {
+		return x.BlockLen
+	}
+	return 0
+}
+
+func (x *SRv6EndPointBehavior) GetNodeLen() uint32 {
+	if x != nil {
+		return x.NodeLen
+	}
+	return 0
+}
+
+func (x *SRv6EndPointBehavior) GetFuncLen() uint32 {
+	if x != nil {
+		return x.FuncLen
+	}
+	return 0
+}
+
+func (x *SRv6EndPointBehavior) GetArgLen() uint32 {
+	if x != nil {
+		return x.ArgLen
+	}
+	return 0
+}
+
+type SRv6BindingSID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GlobalAdmin uint32 `protobuf:"varint,1,opt,name=global_admin,json=globalAdmin,proto3" json:"global_admin,omitempty"`
-	LocalData1  uint32 `protobuf:"varint,2,opt,name=local_data1,json=localData1,proto3" json:"local_data1,omitempty"`
-	LocalData2  uint32 `protobuf:"varint,3,opt,name=local_data2,json=localData2,proto3" json:"local_data2,omitempty"`
+	SFlag                     bool                  `protobuf:"varint,1,opt,name=s_flag,json=sFlag,proto3" json:"s_flag,omitempty"`
+	IFlag                     bool                  `protobuf:"varint,2,opt,name=i_flag,json=iFlag,proto3" json:"i_flag,omitempty"`
+	BFlag                     bool                  `protobuf:"varint,3,opt,name=b_flag,json=bFlag,proto3" json:"b_flag,omitempty"`
+	Sid                       []byte                `protobuf:"bytes,4,opt,name=sid,proto3" json:"sid,omitempty"`
+	EndpointBehaviorStructure *SRv6EndPointBehavior `protobuf:"bytes,5,opt,name=endpoint_behavior_structure,json=endpointBehaviorStructure,proto3" json:"endpoint_behavior_structure,omitempty"`
 }
 
-func (x *LargeCommunity) Reset() {
-	*x = LargeCommunity{}
+func (x *SRv6BindingSID) Reset() {
+	*x = SRv6BindingSID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[102]
+		mi := &file_attribute_proto_msgTypes[106]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LargeCommunity) String() string {
+func (x *SRv6BindingSID) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LargeCommunity) ProtoMessage() {}
+func (*SRv6BindingSID) ProtoMessage() {}
 
-func (x *LargeCommunity) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[102]
+func (x *SRv6BindingSID) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[106]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6592,57 +7181,72 @@ func (x *LargeCommunity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LargeCommunity.ProtoReflect.Descriptor instead.
-func (*LargeCommunity) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use SRv6BindingSID.ProtoReflect.Descriptor instead.
+func (*SRv6BindingSID) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *LargeCommunity) GetGlobalAdmin() uint32 {
+func (x *SRv6BindingSID) GetSFlag() bool {
 	if x != nil {
-		return x.GlobalAdmin
+		return x.SFlag
 	}
-	return 0
+	return false
 }
 
-func (x *LargeCommunity) GetLocalData1() uint32 {
+func (x *SRv6BindingSID) GetIFlag() bool {
 	if x != nil {
-		return x.LocalData1
+		return x.IFlag
 	}
-	return 0
+	return false
 }
 
-func (x *LargeCommunity) GetLocalData2() uint32 {
+func (x *SRv6BindingSID) GetBFlag() bool {
 	if x != nil {
-		return x.LocalData2
+		return x.BFlag
 	}
-	return 0
+	return false
 }
 
-type LargeCommunitiesAttribute struct {
+func (x *SRv6BindingSID) GetSid() []byte {
+	if x != nil {
+		return x.Sid
+	}
+	return nil
+}
+
+func (x *SRv6BindingSID) GetEndpointBehaviorStructure() *SRv6EndPointBehavior {
+	if x != nil {
+		return x.EndpointBehaviorStructure
+	}
+	return nil
+}
+
+type TunnelEncapSubTLVSRENLP struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Communities []*LargeCommunity `protobuf:"bytes,1,rep,name=communities,proto3" json:"communities,omitempty"`
+	Flags uint32   `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Enlp  ENLPType `protobuf:"varint,2,opt,name=enlp,proto3,enum=apipb.ENLPType" json:"enlp,omitempty"`
 }
 
-func (x *LargeCommunitiesAttribute) Reset() {
-	*x = LargeCommunitiesAttribute{}
+func (x *TunnelEncapSubTLVSRENLP) Reset() {
+	*x = TunnelEncapSubTLVSRENLP{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[103]
+		mi := &file_attribute_proto_msgTypes[107]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LargeCommunitiesAttribute) String() string {
+func (x *TunnelEncapSubTLVSRENLP) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LargeCommunitiesAttribute) ProtoMessage() {}
+func (*TunnelEncapSubTLVSRENLP) ProtoMessage() {}
 
-func (x *LargeCommunitiesAttribute) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[103]
+func (x *TunnelEncapSubTLVSRENLP) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[107]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6653,48 +7257,51 @@ func (x *LargeCommunitiesAttribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LargeCommunitiesAttribute.ProtoReflect.Descriptor instead.
-func (*LargeCommunitiesAttribute) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use TunnelEncapSubTLVSRENLP.ProtoReflect.Descriptor instead.
+func (*TunnelEncapSubTLVSRENLP) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *LargeCommunitiesAttribute) GetCommunities() []*LargeCommunity {
+func (x *TunnelEncapSubTLVSRENLP) GetFlags() uint32 {
 	if x != nil {
-		return x.Communities
+		return x.Flags
 	}
-	return nil
+	return 0
 }
 
-type LsNodeFlags struct {
+func (x *TunnelEncapSubTLVSRENLP) GetEnlp() ENLPType {
+	if x != nil {
+		return x.Enlp
+	}
+	return ENLPType_Reserved
+}
+
+type SRWeight struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Overload bool `protobuf:"varint,1,opt,name=overload,proto3" json:"overload,omitempty"`
-	Attached bool `protobuf:"varint,2,opt,name=attached,proto3" json:"attached,omitempty"`
-	External bool `protobuf:"varint,3,opt,name=external,proto3" json:"external,omitempty"`
-	Abr      bool `protobuf:"varint,4,opt,name=abr,proto3" json:"abr,omitempty"`
-	Router   bool `protobuf:"varint,5,opt,name=router,proto3" json:"router,omitempty"`
-	V6       bool `protobuf:"varint,6,opt,name=v6,proto3" json:"v6,omitempty"`
+	Flags  uint32 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
 }
 
-func (x *LsNodeFlags) Reset() {
-	*x = LsNodeFlags{}
+func (x *SRWeight) Reset() {
+	*x = SRWeight{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[104]
+		mi := &file_attribute_proto_msgTypes[108]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LsNodeFlags) String() string {
+func (x *SRWeight) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LsNodeFlags) ProtoMessage() {}
+func (*SRWeight) ProtoMessage() {}
 
-func (x *LsNodeFlags) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[104]
+func (x *SRWeight) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[108]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6705,81 +7312,53 @@ func (x *LsNodeFlags) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LsNodeFlags.ProtoReflect.Descriptor instead.
-func (*LsNodeFlags) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{104}
-}
-
-func (x *LsNodeFlags) GetOverload() bool {
-	if x != nil {
-		return x.Overload
-	}
-	return false
-}
-
-func (x *LsNodeFlags) GetAttached() bool {
-	if x != nil {
-		return x.Attached
-	}
-	return false
-}
-
-func (x *LsNodeFlags) GetExternal() bool {
-	if x != nil {
-		return x.External
-	}
-	return false
-}
-
-func (x *LsNodeFlags) GetAbr() bool {
-	if x != nil {
-		return x.Abr
-	}
-	return false
+// Deprecated: Use SRWeight.ProtoReflect.Descriptor instead.
+func (*SRWeight) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *LsNodeFlags) GetRouter() bool {
+func (x *SRWeight) GetFlags() uint32 {
 	if x != nil {
-		return x.Router
+		return x.Flags
 	}
-	return false
+	return 0
 }
 
-func (x *LsNodeFlags) GetV6() bool {
+func (x *SRWeight) GetWeight() uint32 {
 	if x != nil {
-		return x.V6
+		return x.Weight
 	}
-	return false
+	return 0
 }
 
-type LsIGPFlags struct {
+type SegmentFlags struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Down          bool `protobuf:"varint,1,opt,name=down,proto3" json:"down,omitempty"`
-	NoUnicast     bool `protobuf:"varint,2,opt,name=no_unicast,json=noUnicast,proto3" json:"no_unicast,omitempty"`
-	LocalAddress  bool `protobuf:"varint,3,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
-	PropagateNssa bool `protobuf:"varint,4,opt,name=propagate_nssa,json=propagateNssa,proto3" json:"propagate_nssa,omitempty"`
+	VFlag bool `protobuf:"varint,1,opt,name=v_flag,json=vFlag,proto3" json:"v_flag,omitempty"`
+	AFlag bool `protobuf:"varint,2,opt,name=a_flag,json=aFlag,proto3" json:"a_flag,omitempty"`
+	SFlag bool `protobuf:"varint,3,opt,name=s_flag,json=sFlag,proto3" json:"s_flag,omitempty"`
+	BFlag bool `protobuf:"varint,4,opt,name=b_flag,json=bFlag,proto3" json:"b_flag,omitempty"`
 }
 
-func (x *LsIGPFlags) Reset() {
-	*x = LsIGPFlags{}
+func (x *SegmentFlags) Reset() {
+	*x = SegmentFlags{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[105]
+		mi := &file_attribute_proto_msgTypes[109]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LsIGPFlags) String() string {
+func (x *SegmentFlags) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LsIGPFlags) ProtoMessage() {}
+func (*SegmentFlags) ProtoMessage() {}
 
-func (x *LsIGPFlags) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[105]
+func (x *SegmentFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[109]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6790,65 +7369,65 @@ func (x *LsIGPFlags) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LsIGPFlags.ProtoReflect.Descriptor instead.
-func (*LsIGPFlags) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use SegmentFlags.ProtoReflect.Descriptor instead.
+func (*SegmentFlags) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *LsIGPFlags) GetDown() bool {
+func (x *SegmentFlags) GetVFlag() bool {
 	if x != nil {
-		return x.Down
+		return x.VFlag
 	}
 	return false
 }
 
-func (x *LsIGPFlags) GetNoUnicast() bool {
+func (x *SegmentFlags) GetAFlag() bool {
 	if x != nil {
-		return x.NoUnicast
+		return x.AFlag
 	}
 	return false
 }
 
-func (x *LsIGPFlags) GetLocalAddress() bool {
+func (x *SegmentFlags) GetSFlag() bool {
 	if x != nil {
-		return x.LocalAddress
+		return x.SFlag
 	}
 	return false
 }
 
-func (x *LsIGPFlags) GetPropagateNssa() bool {
+func (x *SegmentFlags) GetBFlag() bool {
 	if x != nil {
-		return x.PropagateNssa
+		return x.BFlag
 	}
 	return false
 }
 
-type LsSrRange struct {
+type SegmentTypeA struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Begin uint32 `protobuf:"varint,1,opt,name=begin,proto3" json:"begin,omitempty"`
-	End   uint32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Flags *SegmentFlags `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Label uint32        `protobuf:"varint,2,opt,name=label,proto3" json:"label,omitempty"`
 }
 
-func (x *LsSrRange) Reset() {
-	*x = LsSrRange{}
+func (x *SegmentTypeA) Reset() {
+	*x = SegmentTypeA{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[106]
+		mi := &file_attribute_proto_msgTypes[110]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LsSrRange) String() string {
+func (x *SegmentTypeA) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LsSrRange) ProtoMessage() {}
+func (*SegmentTypeA) ProtoMessage() {}
 
-func (x *LsSrRange) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[106]
+func (x *SegmentTypeA) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[110]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6859,52 +7438,52 @@ func (x *LsSrRange) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LsSrRange.ProtoReflect.Descriptor instead.
-func (*LsSrRange) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{106}
+// Deprecated: Use SegmentTypeA.ProtoReflect.Descriptor instead.
+func (*SegmentTypeA) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *LsSrRange) GetBegin() uint32 {
+func (x *SegmentTypeA) GetFlags() *SegmentFlags {
 	if x != nil {
-		return x.Begin
+		return x.Flags
 	}
-	return 0
+	return nil
 }
 
-func (x *LsSrRange) GetEnd() uint32 {
+func (x *SegmentTypeA) GetLabel() uint32 {
 	if x != nil {
-		return x.End
+		return x.Label
 	}
 	return 0
 }
 
-type LsSrCapabilities struct {
+type SegmentTypeB struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ipv4Supported bool         `protobuf:"varint,1,opt,name=ipv4_supported,json=ipv4Supported,proto3" json:"ipv4_supported,omitempty"`
-	Ipv6Supported bool         `protobuf:"varint,2,opt,name=ipv6_supported,json=ipv6Supported,proto3" json:"ipv6_supported,omitempty"`
-	Ranges        []*LsSrRange `protobuf:"bytes,3,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	Flags                     *SegmentFlags         `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Sid                       []byte                `protobuf:"bytes,2,opt,name=sid,proto3" json:"sid,omitempty"`
+	EndpointBehaviorStructure *SRv6EndPointBehavior `protobuf:"bytes,3,opt,name=endpoint_behavior_structure,json=endpointBehaviorStructure,proto3" json:"endpoint_behavior_structure,omitempty"`
 }
 
-func (x *LsSrCapabilities) Reset() {
-	*x = LsSrCapabilities{}
+func (x *SegmentTypeB) Reset() {
+	*x = SegmentTypeB{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[107]
+		mi := &file_attribute_proto_msgTypes[111]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LsSrCapabilities) String() string {
+func (x *SegmentTypeB) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LsSrCapabilities) ProtoMessage() {}
+func (*SegmentTypeB) ProtoMessage() {}
 
-func (x *LsSrCapabilities) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[107]
+func (x *SegmentTypeB) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[111]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6915,57 +7494,62 @@ func (x *LsSrCapabilities) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LsSrCapabilities.ProtoReflect.Descriptor instead.
-func (*LsSrCapabilities) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use SegmentTypeB.ProtoReflect.Descriptor instead.
+func (*SegmentTypeB) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *LsSrCapabilities) GetIpv4Supported() bool {
+func (x *SegmentTypeB) GetFlags() *SegmentFlags {
 	if x != nil {
-		return x.Ipv4Supported
+		return x.Flags
 	}
-	return false
+	return nil
 }
 
-func (x *LsSrCapabilities) GetIpv6Supported() bool {
+func (x *SegmentTypeB) GetSid() []byte {
 	if x != nil {
-		return x.Ipv6Supported
+		return x.Sid
 	}
-	return false
+	return nil
 }
 
-func (x *LsSrCapabilities) GetRanges() []*LsSrRange {
+func (x *SegmentTypeB) GetEndpointBehaviorStructure() *SRv6EndPointBehavior {
 	if x != nil {
-		return x.Ranges
+		return x.EndpointBehaviorStructure
 	}
 	return nil
 }
 
-type LsSrLocalBlock struct {
+// The label of the segments of type C to H is the 32-bit SR-MPLS SID field
+// like SegmentTypeA, and 0 means that the SID is not present.
+type SegmentTypeC struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ranges []*LsSrRange `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	Flags     *SegmentFlags `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Algorithm uint32        `protobuf:"varint,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Address   string        `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Label     uint32        `protobuf:"varint,4,opt,name=label,proto3" json:"label,omitempty"`
 }
 
-func (x *LsSrLocalBlock) Reset() {
-	*x = LsSrLocalBlock{}
+func (x *SegmentTypeC) Reset() {
+	*x = SegmentTypeC{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[108]
+		mi := &file_attribute_proto_msgTypes[112]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LsSrLocalBlock) String() string {
+func (x *SegmentTypeC) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LsSrLocalBlock) ProtoMessage() {}
+func (*SegmentTypeC) ProtoMessage() {}
 
-func (x *LsSrLocalBlock) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[108]
+func (x *SegmentTypeC) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[112]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6976,51 +7560,67 @@ func (x *LsSrLocalBlock) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LsSrLocalBlock.ProtoReflect.Descriptor instead.
-func (*LsSrLocalBlock) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{108}
+// Deprecated: Use SegmentTypeC.ProtoReflect.Descriptor instead.
+func (*SegmentTypeC) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *LsSrLocalBlock) GetRanges() []*LsSrRange {
+func (x *SegmentTypeC) GetFlags() *SegmentFlags {
 	if x != nil {
-		return x.Ranges
+		return x.Flags
 	}
 	return nil
 }
 
-type LsAttributeNode struct {
+func (x *SegmentTypeC) GetAlgorithm() uint32 {
+	if x != nil {
+		return x.Algorithm
+	}
+	return 0
+}
+
+func (x *SegmentTypeC) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SegmentTypeC) GetLabel() uint32 {
+	if x != nil {
+		return x.Label
+	}
+	return 0
+}
+
+type SegmentTypeD struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name            string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Flags           *LsNodeFlags      `protobuf:"bytes,2,opt,name=flags,proto3" json:"flags,omitempty"`
-	LocalRouterId   string            `protobuf:"bytes,3,opt,name=local_router_id,json=localRouterId,proto3" json:"local_router_id,omitempty"`
-	LocalRouterIdV6 string            `protobuf:"bytes,4,opt,name=local_router_id_v6,json=localRouterIdV6,proto3" json:"local_router_id_v6,omitempty"`
-	IsisArea        []byte            `protobuf:"bytes,5,opt,name=isis_area,json=isisArea,proto3" json:"isis_area,omitempty"`
-	Opaque          []byte            `protobuf:"bytes,6,opt,name=opaque,proto3" json:"opaque,omitempty"`
-	SrCapabilities  *LsSrCapabilities `protobuf:"bytes,7,opt,name=sr_capabilities,json=srCapabilities,proto3" json:"sr_capabilities,omitempty"`
-	SrAlgorithms    []byte            `protobuf:"bytes,8,opt,name=sr_algorithms,json=srAlgorithms,proto3" json:"sr_algorithms,omitempty"`
-	SrLocalBlock    *LsSrLocalBlock   `protobuf:"bytes,9,opt,name=sr_local_block,json=srLocalBlock,proto3" json:"sr_local_block,omitempty"`
+	Flags     *SegmentFlags `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Algorithm uint32        `protobuf:"varint,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Address   string        `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Label     uint32        `protobuf:"varint,4,opt,name=label,proto3" json:"label,omitempty"`
 }
 
-func (x *LsAttributeNode) Reset() {
-	*x = LsAttributeNode{}
+func (x *SegmentTypeD) Reset() {
+	*x = SegmentTypeD{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[109]
+		mi := &file_attribute_proto_msgTypes[113]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LsAttributeNode) String() string {
+func (x *SegmentTypeD) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LsAttributeNode) ProtoMessage() {}
+func (*SegmentTypeD) ProtoMessage() {}
 
-func (x *LsAttributeNode) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[109]
+func (x *SegmentTypeD) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[113]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7031,112 +7631,138 @@ func (x *LsAttributeNode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LsAttributeNode.ProtoReflect.Descriptor instead.
-func (*LsAttributeNode) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{109}
-}
-
-func (x *LsAttributeNode) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use SegmentTypeD.ProtoReflect.Descriptor instead.
+func (*SegmentTypeD) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *LsAttributeNode) GetFlags() *LsNodeFlags {
+func (x *SegmentTypeD) GetFlags() *SegmentFlags {
 	if x != nil {
 		return x.Flags
 	}
 	return nil
 }
 
-func (x *LsAttributeNode) GetLocalRouterId() string {
+func (x *SegmentTypeD) GetAlgorithm() uint32 {
 	if x != nil {
-		return x.LocalRouterId
+		return x.Algorithm
 	}
-	return ""
+	return 0
 }
 
-func (x *LsAttributeNode) GetLocalRouterIdV6() string {
+func (x *SegmentTypeD) GetAddress() string {
 	if x != nil {
-		return x.LocalRouterIdV6
+		return x.Address
 	}
 	return ""
 }
 
-func (x *LsAttributeNode) GetIsisArea() []byte {
+func (x *SegmentTypeD) GetLabel() uint32 {
 	if x != nil {
-		return x.IsisArea
+		return x.Label
 	}
-	return nil
+	return 0
 }
 
-func (x *LsAttributeNode) GetOpaque() []byte {
+type SegmentTypeE struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags            *SegmentFlags `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	LocalInterfaceId uint32        `protobuf:"varint,2,opt,name=local_interface_id,json=localInterfaceId,proto3" json:"local_interface_id,omitempty"`
+	Address          string        `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Label            uint32        `protobuf:"varint,4,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *SegmentTypeE) Reset() {
+	*x = SegmentTypeE{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SegmentTypeE) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentTypeE) ProtoMessage() {}
+
+func (x *SegmentTypeE) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentTypeE.ProtoReflect.Descriptor instead.
+func (*SegmentTypeE) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *SegmentTypeE) GetFlags() *SegmentFlags {
 	if x != nil {
-		return x.Opaque
+		return x.Flags
 	}
 	return nil
 }
 
-func (x *LsAttributeNode) GetSrCapabilities() *LsSrCapabilities {
+func (x *SegmentTypeE) GetLocalInterfaceId() uint32 {
 	if x != nil {
-		return x.SrCapabilities
+		return x.LocalInterfaceId
 	}
-	return nil
+	return 0
 }
 
-func (x *LsAttributeNode) GetSrAlgorithms() []byte {
+func (x *SegmentTypeE) GetAddress() string {
 	if x != nil {
-		return x.SrAlgorithms
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-func (x *LsAttributeNode) GetSrLocalBlock() *LsSrLocalBlock {
+func (x *SegmentTypeE) GetLabel() uint32 {
 	if x != nil {
-		return x.SrLocalBlock
+		return x.Label
 	}
-	return nil
+	return 0
 }
 
-type LsAttributeLink struct {
+type SegmentTypeF struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name                string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	LocalRouterId       string    `protobuf:"bytes,2,opt,name=local_router_id,json=localRouterId,proto3" json:"local_router_id,omitempty"`
-	LocalRouterIdV6     string    `protobuf:"bytes,3,opt,name=local_router_id_v6,json=localRouterIdV6,proto3" json:"local_router_id_v6,omitempty"`
-	RemoteRouterId      string    `protobuf:"bytes,4,opt,name=remote_router_id,json=remoteRouterId,proto3" json:"remote_router_id,omitempty"`
-	RemoteRouterIdV6    string    `protobuf:"bytes,5,opt,name=remote_router_id_v6,json=remoteRouterIdV6,proto3" json:"remote_router_id_v6,omitempty"`
-	AdminGroup          uint32    `protobuf:"varint,6,opt,name=admin_group,json=adminGroup,proto3" json:"admin_group,omitempty"`
-	DefaultTeMetric     uint32    `protobuf:"varint,7,opt,name=default_te_metric,json=defaultTeMetric,proto3" json:"default_te_metric,omitempty"`
-	IgpMetric           uint32    `protobuf:"varint,8,opt,name=igp_metric,json=igpMetric,proto3" json:"igp_metric,omitempty"`
-	Opaque              []byte    `protobuf:"bytes,9,opt,name=opaque,proto3" json:"opaque,omitempty"`
-	Bandwidth           float32   `protobuf:"fixed32,10,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
-	ReservableBandwidth float32   `protobuf:"fixed32,11,opt,name=reservable_bandwidth,json=reservableBandwidth,proto3" json:"reservable_bandwidth,omitempty"`
-	UnreservedBandwidth []float32 `protobuf:"fixed32,12,rep,packed,name=unreserved_bandwidth,json=unreservedBandwidth,proto3" json:"unreserved_bandwidth,omitempty"`
-	SrAdjacencySid      uint32    `protobuf:"varint,13,opt,name=sr_adjacency_sid,json=srAdjacencySid,proto3" json:"sr_adjacency_sid,omitempty"`
-	Srlgs               []uint32  `protobuf:"varint,14,rep,packed,name=srlgs,proto3" json:"srlgs,omitempty"`
+	Flags         *SegmentFlags `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	LocalAddress  string        `protobuf:"bytes,2,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
+	RemoteAddress string        `protobuf:"bytes,3,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	Label         uint32        `protobuf:"varint,4,opt,name=label,proto3" json:"label,omitempty"`
 }
 
-func (x *LsAttributeLink) Reset() {
-	*x = LsAttributeLink{}
+func (x *SegmentTypeF) Reset() {
+	*x = SegmentTypeF{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[110]
+		mi := &file_attribute_proto_msgTypes[115]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LsAttributeLink) String() string {
+func (x *SegmentTypeF) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LsAttributeLink) ProtoMessage() {}
+func (*SegmentTypeF) ProtoMessage() {}
 
-func (x *LsAttributeLink) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[110]
+func (x *SegmentTypeF) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[115]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7147,136 +7773,154 @@ func (x *LsAttributeLink) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LsAttributeLink.ProtoReflect.Descriptor instead.
-func (*LsAttributeLink) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{110}
+// Deprecated: Use SegmentTypeF.ProtoReflect.Descriptor instead.
+func (*SegmentTypeF) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *LsAttributeLink) GetName() string {
+func (x *SegmentTypeF) GetFlags() *SegmentFlags {
 	if x != nil {
-		return x.Name
+		return x.Flags
 	}
-	return ""
+	return nil
 }
 
-func (x *LsAttributeLink) GetLocalRouterId() string {
+func (x *SegmentTypeF) GetLocalAddress() string {
 	if x != nil {
-		return x.LocalRouterId
+		return x.LocalAddress
 	}
 	return ""
 }
 
-func (x *LsAttributeLink) GetLocalRouterIdV6() string {
+func (x *SegmentTypeF) GetRemoteAddress() string {
 	if x != nil {
-		return x.LocalRouterIdV6
+		return x.RemoteAddress
 	}
 	return ""
 }
 
-func (x *LsAttributeLink) GetRemoteRouterId() string {
+func (x *SegmentTypeF) GetLabel() uint32 {
 	if x != nil {
-		return x.RemoteRouterId
+		return x.Label
 	}
-	return ""
+	return 0
 }
 
-func (x *LsAttributeLink) GetRemoteRouterIdV6() string {
-	if x != nil {
-		return x.RemoteRouterIdV6
-	}
-	return ""
+type SegmentTypeG struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags             *SegmentFlags `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	LocalInterfaceId  uint32        `protobuf:"varint,2,opt,name=local_interface_id,json=localInterfaceId,proto3" json:"local_interface_id,omitempty"`
+	LocalAddress      string        `protobuf:"bytes,3,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
+	RemoteInterfaceId uint32        `protobuf:"varint,4,opt,name=remote_interface_id,json=remoteInterfaceId,proto3" json:"remote_interface_id,omitempty"`
+	RemoteAddress     string        `protobuf:"bytes,5,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	Label             uint32        `protobuf:"varint,6,opt,name=label,proto3" json:"label,omitempty"`
 }
 
-func (x *LsAttributeLink) GetAdminGroup() uint32 {
-	if x != nil {
-		return x.AdminGroup
+func (x *SegmentTypeG) Reset() {
+	*x = SegmentTypeG{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *LsAttributeLink) GetDefaultTeMetric() uint32 {
-	if x != nil {
-		return x.DefaultTeMetric
-	}
-	return 0
+func (x *SegmentTypeG) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *LsAttributeLink) GetIgpMetric() uint32 {
-	if x != nil {
-		return x.IgpMetric
+func (*SegmentTypeG) ProtoMessage() {}
+
+func (x *SegmentTypeG) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *LsAttributeLink) GetOpaque() []byte {
+// Deprecated: Use SegmentTypeG.ProtoReflect.Descriptor instead.
+func (*SegmentTypeG) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *SegmentTypeG) GetFlags() *SegmentFlags {
 	if x != nil {
-		return x.Opaque
+		return x.Flags
 	}
 	return nil
 }
 
-func (x *LsAttributeLink) GetBandwidth() float32 {
+func (x *SegmentTypeG) GetLocalInterfaceId() uint32 {
 	if x != nil {
-		return x.Bandwidth
+		return x.LocalInterfaceId
 	}
 	return 0
 }
 
-func (x *LsAttributeLink) GetReservableBandwidth() float32 {
+func (x *SegmentTypeG) GetLocalAddress() string {
 	if x != nil {
-		return x.ReservableBandwidth
+		return x.LocalAddress
 	}
-	return 0
+	return ""
 }
 
-func (x *LsAttributeLink) GetUnreservedBandwidth() []float32 {
+func (x *SegmentTypeG) GetRemoteInterfaceId() uint32 {
 	if x != nil {
-		return x.UnreservedBandwidth
+		return x.RemoteInterfaceId
 	}
-	return nil
+	return 0
 }
 
-func (x *LsAttributeLink) GetSrAdjacencySid() uint32 {
+func (x *SegmentTypeG) GetRemoteAddress() string {
 	if x != nil {
-		return x.SrAdjacencySid
+		return x.RemoteAddress
 	}
-	return 0
+	return ""
 }
 
-func (x *LsAttributeLink) GetSrlgs() []uint32 {
+func (x *SegmentTypeG) GetLabel() uint32 {
 	if x != nil {
-		return x.Srlgs
+		return x.Label
 	}
-	return nil
+	return 0
 }
 
-type LsAttributePrefix struct {
+type SegmentTypeH struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IgpFlags    *LsIGPFlags `protobuf:"bytes,1,opt,name=igp_flags,json=igpFlags,proto3" json:"igp_flags,omitempty"`
-	Opaque      []byte      `protobuf:"bytes,2,opt,name=opaque,proto3" json:"opaque,omitempty"`
-	SrPrefixSid uint32      `protobuf:"varint,3,opt,name=sr_prefix_sid,json=srPrefixSid,proto3" json:"sr_prefix_sid,omitempty"`
+	Flags         *SegmentFlags `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	LocalAddress  string        `protobuf:"bytes,2,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
+	RemoteAddress string        `protobuf:"bytes,3,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	Label         uint32        `protobuf:"varint,4,opt,name=label,proto3" json:"label,omitempty"`
 }
 
-func (x *LsAttributePrefix) Reset() {
-	*x = LsAttributePrefix{}
+func (x *SegmentTypeH) Reset() {
+	*x = SegmentTypeH{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[111]
+		mi := &file_attribute_proto_msgTypes[117]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LsAttributePrefix) String() string {
+func (x *SegmentTypeH) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LsAttributePrefix) ProtoMessage() {}
+func (*SegmentTypeH) ProtoMessage() {}
 
-func (x *LsAttributePrefix) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[111]
+func (x *SegmentTypeH) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[117]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7287,60 +7931,70 @@ func (x *LsAttributePrefix) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LsAttributePrefix.ProtoReflect.Descriptor instead.
-func (*LsAttributePrefix) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{111}
+// Deprecated: Use SegmentTypeH.ProtoReflect.Descriptor instead.
+func (*SegmentTypeH) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *LsAttributePrefix) GetIgpFlags() *LsIGPFlags {
+func (x *SegmentTypeH) GetFlags() *SegmentFlags {
 	if x != nil {
-		return x.IgpFlags
+		return x.Flags
 	}
 	return nil
 }
 
-func (x *LsAttributePrefix) GetOpaque() []byte {
+func (x *SegmentTypeH) GetLocalAddress() string {
 	if x != nil {
-		return x.Opaque
+		return x.LocalAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *LsAttributePrefix) GetSrPrefixSid() uint32 {
+func (x *SegmentTypeH) GetRemoteAddress() string {
 	if x != nil {
-		return x.SrPrefixSid
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+func (x *SegmentTypeH) GetLabel() uint32 {
+	if x != nil {
+		return x.Label
 	}
 	return 0
 }
 
-type LsBgpPeerSegmentSIDFlags struct {
+// The sid of the segments of type I to K is optional, and the
+// endpoint_behavior_structure is ignored without the sid.
+type SegmentTypeI struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value      bool `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
-	Local      bool `protobuf:"varint,2,opt,name=local,proto3" json:"local,omitempty"`
-	Backup     bool `protobuf:"varint,3,opt,name=backup,proto3" json:"backup,omitempty"`
-	Persistent bool `protobuf:"varint,4,opt,name=persistent,proto3" json:"persistent,omitempty"`
+	Flags                     *SegmentFlags         `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Algorithm                 uint32                `protobuf:"varint,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Address                   string                `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Sid                       []byte                `protobuf:"bytes,4,opt,name=sid,proto3" json:"sid,omitempty"`
+	EndpointBehaviorStructure *SRv6EndPointBehavior `protobuf:"bytes,5,opt,name=endpoint_behavior_structure,json=endpointBehaviorStructure,proto3" json:"endpoint_behavior_structure,omitempty"`
 }
 
-func (x *LsBgpPeerSegmentSIDFlags) Reset() {
-	*x = LsBgpPeerSegmentSIDFlags{}
+func (x *SegmentTypeI) Reset() {
+	*x = SegmentTypeI{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[112]
+		mi := &file_attribute_proto_msgTypes[118]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LsBgpPeerSegmentSIDFlags) String() string {
+func (x *SegmentTypeI) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LsBgpPeerSegmentSIDFlags) ProtoMessage() {}
+func (*SegmentTypeI) ProtoMessage() {}
 
-func (x *LsBgpPeerSegmentSIDFlags) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[112]
+func (x *SegmentTypeI) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[118]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7351,66 +8005,78 @@ func (x *LsBgpPeerSegmentSIDFlags) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LsBgpPeerSegmentSIDFlags.ProtoReflect.Descriptor instead.
-func (*LsBgpPeerSegmentSIDFlags) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{112}
+// Deprecated: Use SegmentTypeI.ProtoReflect.Descriptor instead.
+func (*SegmentTypeI) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *LsBgpPeerSegmentSIDFlags) GetValue() bool {
+func (x *SegmentTypeI) GetFlags() *SegmentFlags {
 	if x != nil {
-		return x.Value
+		return x.Flags
 	}
-	return false
+	return nil
 }
 
-func (x *LsBgpPeerSegmentSIDFlags) GetLocal() bool {
+func (x *SegmentTypeI) GetAlgorithm() uint32 {
 	if x != nil {
-		return x.Local
+		return x.Algorithm
 	}
-	return false
+	return 0
 }
 
-func (x *LsBgpPeerSegmentSIDFlags) GetBackup() bool {
+func (x *SegmentTypeI) GetAddress() string {
 	if x != nil {
-		return x.Backup
+		return x.Address
 	}
-	return false
+	return ""
 }
 
-func (x *LsBgpPeerSegmentSIDFlags) GetPersistent() bool {
+func (x *SegmentTypeI) GetSid() []byte {
 	if x != nil {
-		return x.Persistent
+		return x.Sid
 	}
-	return false
+	return nil
 }
 
-type LsBgpPeerSegmentSID struct {
+func (x *SegmentTypeI) GetEndpointBehaviorStructure() *SRv6EndPointBehavior {
+	if x != nil {
+		return x.EndpointBehaviorStructure
+	}
+	return nil
+}
+
+type SegmentTypeJ struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Flags  *LsBgpPeerSegmentSIDFlags `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
-	Weight uint32                    `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
-	Sid    uint32                    `protobuf:"varint,3,opt,name=sid,proto3" json:"sid,omitempty"`
+	Flags                     *SegmentFlags         `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Algorithm                 uint32                `protobuf:"varint,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	LocalInterfaceId          uint32                `protobuf:"varint,3,opt,name=local_interface_id,json=localInterfaceId,proto3" json:"local_interface_id,omitempty"`
+	LocalAddress              string                `protobuf:"bytes,4,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
+	RemoteInterfaceId         uint32                `protobuf:"varint,5,opt,name=remote_interface_id,json=remoteInterfaceId,proto3" json:"remote_interface_id,omitempty"`
+	RemoteAddress             string                `protobuf:"bytes,6,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	Sid                       []byte                `protobuf:"bytes,7,opt,name=sid,proto3" json:"sid,omitempty"`
+	EndpointBehaviorStructure *SRv6EndPointBehavior `protobuf:"bytes,8,opt,name=endpoint_behavior_structure,json=endpointBehaviorStructure,proto3" json:"endpoint_behavior_structure,omitempty"`
 }
 
-func (x *LsBgpPeerSegmentSID) Reset() {
-	*x = LsBgpPeerSegmentSID{}
+func (x *SegmentTypeJ) Reset() {
+	*x = SegmentTypeJ{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[113]
+		mi := &file_attribute_proto_msgTypes[119]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LsBgpPeerSegmentSID) String() string {
+func (x *SegmentTypeJ) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LsBgpPeerSegmentSID) ProtoMessage() {}
+func (*SegmentTypeJ) ProtoMessage() {}
 
-func (x *LsBgpPeerSegmentSID) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[113]
+func (x *SegmentTypeJ) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[119]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7421,123 +8087,97 @@ func (x *LsBgpPeerSegmentSID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LsBgpPeerSegmentSID.ProtoReflect.Descriptor instead.
-func (*LsBgpPeerSegmentSID) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{113}
+// Deprecated: Use SegmentTypeJ.ProtoReflect.Descriptor instead.
+func (*SegmentTypeJ) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *LsBgpPeerSegmentSID) GetFlags() *LsBgpPeerSegmentSIDFlags {
+func (x *SegmentTypeJ) GetFlags() *SegmentFlags {
 	if x != nil {
 		return x.Flags
 	}
 	return nil
 }
 
-func (x *LsBgpPeerSegmentSID) GetWeight() uint32 {
+func (x *SegmentTypeJ) GetAlgorithm() uint32 {
 	if x != nil {
-		return x.Weight
+		return x.Algorithm
 	}
 	return 0
 }
 
-func (x *LsBgpPeerSegmentSID) GetSid() uint32 {
+func (x *SegmentTypeJ) GetLocalInterfaceId() uint32 {
 	if x != nil {
-		return x.Sid
+		return x.LocalInterfaceId
 	}
 	return 0
 }
 
-type LsAttributeBgpPeerSegment struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	BgpPeerNodeSid      *LsBgpPeerSegmentSID `protobuf:"bytes,1,opt,name=bgp_peer_node_sid,json=bgpPeerNodeSid,proto3" json:"bgp_peer_node_sid,omitempty"`
-	BgpPeerAdjacencySid *LsBgpPeerSegmentSID `protobuf:"bytes,2,opt,name=bgp_peer_adjacency_sid,json=bgpPeerAdjacencySid,proto3" json:"bgp_peer_adjacency_sid,omitempty"`
-	BgpPeerSetSid       *LsBgpPeerSegmentSID `protobuf:"bytes,3,opt,name=bgp_peer_set_sid,json=bgpPeerSetSid,proto3" json:"bgp_peer_set_sid,omitempty"`
-}
-
-func (x *LsAttributeBgpPeerSegment) Reset() {
-	*x = LsAttributeBgpPeerSegment{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[114]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SegmentTypeJ) GetLocalAddress() string {
+	if x != nil {
+		return x.LocalAddress
 	}
+	return ""
 }
 
-func (x *LsAttributeBgpPeerSegment) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*LsAttributeBgpPeerSegment) ProtoMessage() {}
-
-func (x *LsAttributeBgpPeerSegment) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[114]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SegmentTypeJ) GetRemoteInterfaceId() uint32 {
+	if x != nil {
+		return x.RemoteInterfaceId
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use LsAttributeBgpPeerSegment.ProtoReflect.Descriptor instead.
-func (*LsAttributeBgpPeerSegment) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{114}
+	return 0
 }
 
-func (x *LsAttributeBgpPeerSegment) GetBgpPeerNodeSid() *LsBgpPeerSegmentSID {
+func (x *SegmentTypeJ) GetRemoteAddress() string {
 	if x != nil {
-		return x.BgpPeerNodeSid
+		return x.RemoteAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *LsAttributeBgpPeerSegment) GetBgpPeerAdjacencySid() *LsBgpPeerSegmentSID {
+func (x *SegmentTypeJ) GetSid() []byte {
 	if x != nil {
-		return x.BgpPeerAdjacencySid
+		return x.Sid
 	}
 	return nil
 }
 
-func (x *LsAttributeBgpPeerSegment) GetBgpPeerSetSid() *LsBgpPeerSegmentSID {
+func (x *SegmentTypeJ) GetEndpointBehaviorStructure() *SRv6EndPointBehavior {
 	if x != nil {
-		return x.BgpPeerSetSid
+		return x.EndpointBehaviorStructure
 	}
 	return nil
 }
 
-type LsAttribute struct {
+type SegmentTypeK struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node           *LsAttributeNode           `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Link           *LsAttributeLink           `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
-	Prefix         *LsAttributePrefix         `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	BgpPeerSegment *LsAttributeBgpPeerSegment `protobuf:"bytes,4,opt,name=bgp_peer_segment,json=bgpPeerSegment,proto3" json:"bgp_peer_segment,omitempty"`
+	Flags                     *SegmentFlags         `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Algorithm                 uint32                `protobuf:"varint,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	LocalAddress              string                `protobuf:"bytes,3,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
+	RemoteAddress             string                `protobuf:"bytes,4,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	Sid                       []byte                `protobuf:"bytes,5,opt,name=sid,proto3" json:"sid,omitempty"`
+	EndpointBehaviorStructure *SRv6EndPointBehavior `protobuf:"bytes,6,opt,name=endpoint_behavior_structure,json=endpointBehaviorStructure,proto3" json:"endpoint_behavior_structure,omitempty"`
 }
 
-func (x *LsAttribute) Reset() {
-	*x = LsAttribute{}
+func (x *SegmentTypeK) Reset() {
+	*x = SegmentTypeK{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[115]
+		mi := &file_attribute_proto_msgTypes[120]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LsAttribute) String() string {
+func (x *SegmentTypeK) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LsAttribute) ProtoMessage() {}
+func (*SegmentTypeK) ProtoMessage() {}
 
-func (x *LsAttribute) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[115]
+func (x *SegmentTypeK) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[120]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7548,66 +8188,91 @@ func (x *LsAttribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LsAttribute.ProtoReflect.Descriptor instead.
-func (*LsAttribute) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{115}
+// Deprecated: Use SegmentTypeK.ProtoReflect.Descriptor instead.
+func (*SegmentTypeK) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *LsAttribute) GetNode() *LsAttributeNode {
+func (x *SegmentTypeK) GetFlags() *SegmentFlags {
 	if x != nil {
-		return x.Node
+		return x.Flags
 	}
 	return nil
 }
 
-func (x *LsAttribute) GetLink() *LsAttributeLink {
+func (x *SegmentTypeK) GetAlgorithm() uint32 {
 	if x != nil {
-		return x.Link
+		return x.Algorithm
 	}
-	return nil
+	return 0
 }
 
-func (x *LsAttribute) GetPrefix() *LsAttributePrefix {
+func (x *SegmentTypeK) GetLocalAddress() string {
 	if x != nil {
-		return x.Prefix
+		return x.LocalAddress
+	}
+	return ""
+}
+
+func (x *SegmentTypeK) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+func (x *SegmentTypeK) GetSid() []byte {
+	if x != nil {
+		return x.Sid
 	}
 	return nil
 }
 
-func (x *LsAttribute) GetBgpPeerSegment() *LsAttributeBgpPeerSegment {
+func (x *SegmentTypeK) GetEndpointBehaviorStructure() *SRv6EndPointBehavior {
 	if x != nil {
-		return x.BgpPeerSegment
+		return x.EndpointBehaviorStructure
 	}
 	return nil
 }
 
-type UnknownAttribute struct {
+type TunnelEncapSubTLVSRSegmentList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Flags uint32 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
-	Type  uint32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
-	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Weight *SRWeight `protobuf:"bytes,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	// segments must be one of:
+	// - SegmentTypeA
+	// - SegmentTypeB
+	// - SegmentTypeC
+	// - SegmentTypeD
+	// - SegmentTypeE
+	// - SegmentTypeF
+	// - SegmentTypeG
+	// - SegmentTypeH
+	// - SegmentTypeI
+	// - SegmentTypeJ
+	// - SegmentTypeK
+	Segments []*anypb.Any `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments,omitempty"`
 }
 
-func (x *UnknownAttribute) Reset() {
-	*x = UnknownAttribute{}
+func (x *TunnelEncapSubTLVSRSegmentList) Reset() {
+	*x = TunnelEncapSubTLVSRSegmentList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[116]
+		mi := &file_attribute_proto_msgTypes[121]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnknownAttribute) String() string {
+func (x *TunnelEncapSubTLVSRSegmentList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnknownAttribute) ProtoMessage() {}
+func (*TunnelEncapSubTLVSRSegmentList) ProtoMessage() {}
 
-func (x *UnknownAttribute) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[116]
+func (x *TunnelEncapSubTLVSRSegmentList) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[121]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7618,63 +8283,50 @@ func (x *UnknownAttribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnknownAttribute.ProtoReflect.Descriptor instead.
-func (*UnknownAttribute) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{116}
-}
-
-func (x *UnknownAttribute) GetFlags() uint32 {
-	if x != nil {
-		return x.Flags
-	}
-	return 0
+// Deprecated: Use TunnelEncapSubTLVSRSegmentList.ProtoReflect.Descriptor instead.
+func (*TunnelEncapSubTLVSRSegmentList) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *UnknownAttribute) GetType() uint32 {
+func (x *TunnelEncapSubTLVSRSegmentList) GetWeight() *SRWeight {
 	if x != nil {
-		return x.Type
+		return x.Weight
 	}
-	return 0
+	return nil
 }
 
-func (x *UnknownAttribute) GetValue() []byte {
+func (x *TunnelEncapSubTLVSRSegmentList) GetSegments() []*anypb.Any {
 	if x != nil {
-		return x.Value
+		return x.Segments
 	}
 	return nil
 }
 
-// https://www.rfc-editor.org/rfc/rfc9252.html#section-3.2.1
-type SRv6StructureSubSubTLV struct {
+type TunnelEncapSubTLVEgressEndpoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LocatorBlockLength  uint32 `protobuf:"varint,1,opt,name=locator_block_length,json=locatorBlockLength,proto3" json:"locator_block_length,omitempty"`
-	LocatorNodeLength   uint32 `protobuf:"varint,2,opt,name=locator_node_length,json=locatorNodeLength,proto3" json:"locator_node_length,omitempty"`
-	FunctionLength      uint32 `protobuf:"varint,3,opt,name=function_length,json=functionLength,proto3" json:"function_length,omitempty"`
-	ArgumentLength      uint32 `protobuf:"varint,4,opt,name=argument_length,json=argumentLength,proto3" json:"argument_length,omitempty"`
-	TranspositionLength uint32 `protobuf:"varint,5,opt,name=transposition_length,json=transpositionLength,proto3" json:"transposition_length,omitempty"`
-	TranspositionOffset uint32 `protobuf:"varint,6,opt,name=transposition_offset,json=transpositionOffset,proto3" json:"transposition_offset,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *SRv6StructureSubSubTLV) Reset() {
-	*x = SRv6StructureSubSubTLV{}
+func (x *TunnelEncapSubTLVEgressEndpoint) Reset() {
+	*x = TunnelEncapSubTLVEgressEndpoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[117]
+		mi := &file_attribute_proto_msgTypes[122]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SRv6StructureSubSubTLV) String() string {
+func (x *TunnelEncapSubTLVEgressEndpoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SRv6StructureSubSubTLV) ProtoMessage() {}
+func (*TunnelEncapSubTLVEgressEndpoint) ProtoMessage() {}
 
-func (x *SRv6StructureSubSubTLV) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[117]
+func (x *TunnelEncapSubTLVEgressEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[122]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7685,79 +8337,90 @@ func (x *SRv6StructureSubSubTLV) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SRv6StructureSubSubTLV.ProtoReflect.Descriptor instead.
-func (*SRv6StructureSubSubTLV) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{117}
+// Deprecated: Use TunnelEncapSubTLVEgressEndpoint.ProtoReflect.Descriptor instead.
+func (*TunnelEncapSubTLVEgressEndpoint) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *SRv6StructureSubSubTLV) GetLocatorBlockLength() uint32 {
+func (x *TunnelEncapSubTLVEgressEndpoint) GetAddress() string {
 	if x != nil {
-		return x.LocatorBlockLength
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-func (x *SRv6StructureSubSubTLV) GetLocatorNodeLength() uint32 {
-	if x != nil {
-		return x.LocatorNodeLength
-	}
-	return 0
+type TunnelEncapSubTLVUDPDestPort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
 }
 
-func (x *SRv6StructureSubSubTLV) GetFunctionLength() uint32 {
-	if x != nil {
-		return x.FunctionLength
+func (x *TunnelEncapSubTLVUDPDestPort) Reset() {
+	*x = TunnelEncapSubTLVUDPDestPort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *SRv6StructureSubSubTLV) GetArgumentLength() uint32 {
-	if x != nil {
-		return x.ArgumentLength
-	}
-	return 0
+func (x *TunnelEncapSubTLVUDPDestPort) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SRv6StructureSubSubTLV) GetTranspositionLength() uint32 {
-	if x != nil {
-		return x.TranspositionLength
+func (*TunnelEncapSubTLVUDPDestPort) ProtoMessage() {}
+
+func (x *TunnelEncapSubTLVUDPDestPort) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SRv6StructureSubSubTLV) GetTranspositionOffset() uint32 {
+// Deprecated: Use TunnelEncapSubTLVUDPDestPort.ProtoReflect.Descriptor instead.
+func (*TunnelEncapSubTLVUDPDestPort) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *TunnelEncapSubTLVUDPDestPort) GetPort() uint32 {
 	if x != nil {
-		return x.TranspositionOffset
+		return x.Port
 	}
 	return 0
 }
 
-type SRv6SIDFlags struct {
+type TunnelEncapSubTLVDSField struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Placeholder for future sid flags
-	Flag_1 bool `protobuf:"varint,1,opt,name=flag_1,json=flag1,proto3" json:"flag_1,omitempty"`
+	DsField uint32 `protobuf:"varint,1,opt,name=ds_field,json=dsField,proto3" json:"ds_field,omitempty"`
 }
 
-func (x *SRv6SIDFlags) Reset() {
-	*x = SRv6SIDFlags{}
+func (x *TunnelEncapSubTLVDSField) Reset() {
+	*x = TunnelEncapSubTLVDSField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[118]
+		mi := &file_attribute_proto_msgTypes[124]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SRv6SIDFlags) String() string {
+func (x *TunnelEncapSubTLVDSField) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SRv6SIDFlags) ProtoMessage() {}
+func (*TunnelEncapSubTLVDSField) ProtoMessage() {}
 
-func (x *SRv6SIDFlags) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[118]
+func (x *TunnelEncapSubTLVDSField) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[124]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7768,43 +8431,43 @@ func (x *SRv6SIDFlags) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SRv6SIDFlags.ProtoReflect.Descriptor instead.
-func (*SRv6SIDFlags) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{118}
+// Deprecated: Use TunnelEncapSubTLVDSField.ProtoReflect.Descriptor instead.
+func (*TunnelEncapSubTLVDSField) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *SRv6SIDFlags) GetFlag_1() bool {
+func (x *TunnelEncapSubTLVDSField) GetDsField() uint32 {
 	if x != nil {
-		return x.Flag_1
+		return x.DsField
 	}
-	return false
+	return 0
 }
 
-type SRv6TLV struct {
+type TunnelEncapSubTLVEmbeddedLabelHandling struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tlv []*anypb.Any `protobuf:"bytes,1,rep,name=tlv,proto3" json:"tlv,omitempty"`
+	Handling uint32 `protobuf:"varint,1,opt,name=handling,proto3" json:"handling,omitempty"`
 }
 
-func (x *SRv6TLV) Reset() {
-	*x = SRv6TLV{}
+func (x *TunnelEncapSubTLVEmbeddedLabelHandling) Reset() {
+	*x = TunnelEncapSubTLVEmbeddedLabelHandling{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[119]
+		mi := &file_attribute_proto_msgTypes[125]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SRv6TLV) String() string {
+func (x *TunnelEncapSubTLVEmbeddedLabelHandling) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SRv6TLV) ProtoMessage() {}
+func (*TunnelEncapSubTLVEmbeddedLabelHandling) ProtoMessage() {}
 
-func (x *SRv6TLV) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[119]
+func (x *TunnelEncapSubTLVEmbeddedLabelHandling) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[125]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7815,49 +8478,46 @@ func (x *SRv6TLV) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SRv6TLV.ProtoReflect.Descriptor instead.
-func (*SRv6TLV) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{119}
+// Deprecated: Use TunnelEncapSubTLVEmbeddedLabelHandling.ProtoReflect.Descriptor instead.
+func (*TunnelEncapSubTLVEmbeddedLabelHandling) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *SRv6TLV) GetTlv() []*anypb.Any {
+func (x *TunnelEncapSubTLVEmbeddedLabelHandling) GetHandling() uint32 {
 	if x != nil {
-		return x.Tlv
+		return x.Handling
 	}
-	return nil
+	return 0
 }
 
-// https://tools.ietf.org/html/draft-dawra-bess-srv6-services-02#section-2.1.1
-type SRv6InformationSubTLV struct {
+type MPLSLabelStackEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sid              []byte        `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`
-	Flags            *SRv6SIDFlags `protobuf:"bytes,2,opt,name=flags,proto3" json:"flags,omitempty"`
-	EndpointBehavior uint32        `protobuf:"varint,3,opt,name=endpoint_behavior,json=endpointBehavior,proto3" json:"endpoint_behavior,omitempty"`
-	// SRv6TLV is one of:
-	// - SRv6StructureSubSubTLV
-	SubSubTlvs map[uint32]*SRv6TLV `protobuf:"bytes,4,rep,name=sub_sub_tlvs,json=subSubTlvs,proto3" json:"sub_sub_tlvs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Label         uint32 `protobuf:"varint,1,opt,name=label,proto3" json:"label,omitempty"`
+	TrafficClass  uint32 `protobuf:"varint,2,opt,name=traffic_class,json=trafficClass,proto3" json:"traffic_class,omitempty"`
+	BottomOfStack bool   `protobuf:"varint,3,opt,name=bottom_of_stack,json=bottomOfStack,proto3" json:"bottom_of_stack,omitempty"`
+	Ttl           uint32 `protobuf:"varint,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
 }
 
-func (x *SRv6InformationSubTLV) Reset() {
-	*x = SRv6InformationSubTLV{}
+func (x *MPLSLabelStackEntry) Reset() {
+	*x = MPLSLabelStackEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[120]
+		mi := &file_attribute_proto_msgTypes[126]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SRv6InformationSubTLV) String() string {
+func (x *MPLSLabelStackEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SRv6InformationSubTLV) ProtoMessage() {}
+func (*MPLSLabelStackEntry) ProtoMessage() {}
 
-func (x *SRv6InformationSubTLV) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[120]
+func (x *MPLSLabelStackEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[126]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7868,67 +8528,112 @@ func (x *SRv6InformationSubTLV) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SRv6InformationSubTLV.ProtoReflect.Descriptor instead.
-func (*SRv6InformationSubTLV) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{120}
+// Deprecated: Use MPLSLabelStackEntry.ProtoReflect.Descriptor instead.
+func (*MPLSLabelStackEntry) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *SRv6InformationSubTLV) GetSid() []byte {
+func (x *MPLSLabelStackEntry) GetLabel() uint32 {
 	if x != nil {
-		return x.Sid
+		return x.Label
 	}
-	return nil
+	return 0
 }
 
-func (x *SRv6InformationSubTLV) GetFlags() *SRv6SIDFlags {
+func (x *MPLSLabelStackEntry) GetTrafficClass() uint32 {
 	if x != nil {
-		return x.Flags
+		return x.TrafficClass
 	}
-	return nil
+	return 0
 }
 
-func (x *SRv6InformationSubTLV) GetEndpointBehavior() uint32 {
+func (x *MPLSLabelStackEntry) GetBottomOfStack() bool {
 	if x != nil {
-		return x.EndpointBehavior
+		return x.BottomOfStack
+	}
+	return false
+}
+
+func (x *MPLSLabelStackEntry) GetTtl() uint32 {
+	if x != nil {
+		return x.Ttl
 	}
 	return 0
 }
 
-func (x *SRv6InformationSubTLV) GetSubSubTlvs() map[uint32]*SRv6TLV {
+type TunnelEncapSubTLVMPLSLabelStack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*MPLSLabelStackEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *TunnelEncapSubTLVMPLSLabelStack) Reset() {
+	*x = TunnelEncapSubTLVMPLSLabelStack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelEncapSubTLVMPLSLabelStack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelEncapSubTLVMPLSLabelStack) ProtoMessage() {}
+
+func (x *TunnelEncapSubTLVMPLSLabelStack) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelEncapSubTLVMPLSLabelStack.ProtoReflect.Descriptor instead.
+func (*TunnelEncapSubTLVMPLSLabelStack) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *TunnelEncapSubTLVMPLSLabelStack) GetEntries() []*MPLSLabelStackEntry {
 	if x != nil {
-		return x.SubSubTlvs
+		return x.Entries
 	}
 	return nil
 }
 
-// https://www.rfc-editor.org/rfc/rfc9252.html#section-2
-type SRv6L3ServiceTLV struct {
+type TunnelEncapSubTLVPrefixSID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// SRv6TLV is one of:
-	// - SRv6InformationSubTLV
-	SubTlvs map[uint32]*SRv6TLV `protobuf:"bytes,1,rep,name=sub_tlvs,json=subTlvs,proto3" json:"sub_tlvs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Flags      uint32 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	LabelIndex uint32 `protobuf:"varint,2,opt,name=label_index,json=labelIndex,proto3" json:"label_index,omitempty"`
 }
 
-func (x *SRv6L3ServiceTLV) Reset() {
-	*x = SRv6L3ServiceTLV{}
+func (x *TunnelEncapSubTLVPrefixSID) Reset() {
+	*x = TunnelEncapSubTLVPrefixSID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[121]
+		mi := &file_attribute_proto_msgTypes[128]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SRv6L3ServiceTLV) String() string {
+func (x *TunnelEncapSubTLVPrefixSID) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SRv6L3ServiceTLV) ProtoMessage() {}
+func (*TunnelEncapSubTLVPrefixSID) ProtoMessage() {}
 
-func (x *SRv6L3ServiceTLV) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[121]
+func (x *TunnelEncapSubTLVPrefixSID) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[128]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7939,46 +8644,118 @@ func (x *SRv6L3ServiceTLV) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SRv6L3ServiceTLV.ProtoReflect.Descriptor instead.
-func (*SRv6L3ServiceTLV) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{121}
+// Deprecated: Use TunnelEncapSubTLVPrefixSID.ProtoReflect.Descriptor instead.
+func (*TunnelEncapSubTLVPrefixSID) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *SRv6L3ServiceTLV) GetSubTlvs() map[uint32]*SRv6TLV {
+func (x *TunnelEncapSubTLVPrefixSID) GetFlags() uint32 {
 	if x != nil {
-		return x.SubTlvs
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *TunnelEncapSubTLVPrefixSID) GetLabelIndex() uint32 {
+	if x != nil {
+		return x.LabelIndex
+	}
+	return 0
+}
+
+type TunnelEncapSubTLVUnknown struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  uint32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *TunnelEncapSubTLVUnknown) Reset() {
+	*x = TunnelEncapSubTLVUnknown{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelEncapSubTLVUnknown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelEncapSubTLVUnknown) ProtoMessage() {}
+
+func (x *TunnelEncapSubTLVUnknown) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelEncapSubTLVUnknown.ProtoReflect.Descriptor instead.
+func (*TunnelEncapSubTLVUnknown) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *TunnelEncapSubTLVUnknown) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *TunnelEncapSubTLVUnknown) GetValue() []byte {
+	if x != nil {
+		return x.Value
 	}
 	return nil
 }
 
-// https://www.rfc-editor.org/rfc/rfc9252.html#section-2
-type SRv6L2ServiceTLV struct {
+type TunnelEncapTLV struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// SRv6TLV is one of:
-	// - SRv6InformationSubTLV
-	SubTlvs map[uint32]*SRv6TLV `protobuf:"bytes,1,rep,name=sub_tlvs,json=subTlvs,proto3" json:"sub_tlvs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Type uint32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Each TLV must be one of:
+	// - TunnelEncapSubTLVEncapsulation
+	// - TunnelEncapSubTLVProtocol
+	// - TunnelEncapSubTLVColor
+	// - TunnelEncapSubTLVEgressEndpoint
+	// - TunnelEncapSubTLVDSField
+	// - TunnelEncapSubTLVUDPDestPort
+	// - TunnelEncapSubTLVEmbeddedLabelHandling
+	// - TunnelEncapSubTLVMPLSLabelStack
+	// - TunnelEncapSubTLVPrefixSID
+	// - TunnelEncapSubTLVSRPolicy
+	// - TunnelEncapSubTLVUnknown
+	Tlvs []*anypb.Any `protobuf:"bytes,2,rep,name=tlvs,proto3" json:"tlvs,omitempty"`
 }
 
-func (x *SRv6L2ServiceTLV) Reset() {
-	*x = SRv6L2ServiceTLV{}
+func (x *TunnelEncapTLV) Reset() {
+	*x = TunnelEncapTLV{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[122]
+		mi := &file_attribute_proto_msgTypes[130]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SRv6L2ServiceTLV) String() string {
+func (x *TunnelEncapTLV) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SRv6L2ServiceTLV) ProtoMessage() {}
+func (*TunnelEncapTLV) ProtoMessage() {}
 
-func (x *SRv6L2ServiceTLV) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[122]
+func (x *TunnelEncapTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[130]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7989,49 +8766,50 @@ func (x *SRv6L2ServiceTLV) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SRv6L2ServiceTLV.ProtoReflect.Descriptor instead.
-func (*SRv6L2ServiceTLV) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{122}
+// Deprecated: Use TunnelEncapTLV.ProtoReflect.Descriptor instead.
+func (*TunnelEncapTLV) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *SRv6L2ServiceTLV) GetSubTlvs() map[uint32]*SRv6TLV {
+func (x *TunnelEncapTLV) GetType() uint32 {
 	if x != nil {
-		return x.SubTlvs
+		return x.Type
+	}
+	return 0
+}
+
+func (x *TunnelEncapTLV) GetTlvs() []*anypb.Any {
+	if x != nil {
+		return x.Tlvs
 	}
 	return nil
 }
 
-// https://tools.ietf.org/html/rfc8669
-type PrefixSID struct {
+type TunnelEncapAttribute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// tlv is one of:
-	// - IndexLabelTLV Type 1 (not yet implemented)
-	// - OriginatorSRGBTLV Type 3 (not yet implemented)
-	// - SRv6L3ServiceTLV Type 5
-	// - SRv6L2ServiceTLV Type 6
-	Tlvs []*anypb.Any `protobuf:"bytes,1,rep,name=tlvs,proto3" json:"tlvs,omitempty"`
+	Tlvs []*TunnelEncapTLV `protobuf:"bytes,1,rep,name=tlvs,proto3" json:"tlvs,omitempty"`
 }
 
-func (x *PrefixSID) Reset() {
-	*x = PrefixSID{}
+func (x *TunnelEncapAttribute) Reset() {
+	*x = TunnelEncapAttribute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_attribute_proto_msgTypes[123]
+		mi := &file_attribute_proto_msgTypes[131]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PrefixSID) String() string {
+func (x *TunnelEncapAttribute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrefixSID) ProtoMessage() {}
+func (*TunnelEncapAttribute) ProtoMessage() {}
 
-func (x *PrefixSID) ProtoReflect() protoreflect.Message {
-	mi := &file_attribute_proto_msgTypes[123]
+func (x *TunnelEncapAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[131]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8042,1240 +8820,4516 @@ func (x *PrefixSID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrefixSID.ProtoReflect.Descriptor instead.
-func (*PrefixSID) Descriptor() ([]byte, []int) {
-	return file_attribute_proto_rawDescGZIP(), []int{123}
+// Deprecated: Use TunnelEncapAttribute.ProtoReflect.Descriptor instead.
+func (*TunnelEncapAttribute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *PrefixSID) GetTlvs() []*anypb.Any {
+func (x *TunnelEncapAttribute) GetTlvs() []*TunnelEncapTLV {
 	if x != nil {
 		return x.Tlvs
 	}
 	return nil
 }
 
-var File_attribute_proto protoreflect.FileDescriptor
+type IPv6AddressSpecificExtended struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_attribute_proto_rawDesc = []byte{
-	0x0a, 0x0f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x05, 0x61, 0x70, 0x69, 0x70, 0x62, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x67, 0x6f, 0x62, 0x67, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x29, 0x0a, 0x0f, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0xad, 0x01, 0x0a, 0x09,
-	0x41, 0x73, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e,
-	0x41, 0x73, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x5b,
-	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
-	0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x53, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x01, 0x12,
-	0x0f, 0x0a, 0x0b, 0x41, 0x53, 0x5f, 0x53, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x02,
-	0x12, 0x16, 0x0a, 0x12, 0x41, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x45, 0x44, 0x5f, 0x53, 0x45,
-	0x51, 0x55, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x53, 0x5f, 0x43,
-	0x4f, 0x4e, 0x46, 0x45, 0x44, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x04, 0x22, 0x3f, 0x0a, 0x0f, 0x41,
-	0x73, 0x50, 0x61, 0x74, 0x68, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x2c,
-	0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x41, 0x73, 0x53, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x2d, 0x0a, 0x10,
-	0x4e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x22, 0x2a, 0x0a, 0x16, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x45, 0x78, 0x69, 0x74, 0x44, 0x69, 0x73, 0x63, 0x41, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x03, 0x6d, 0x65, 0x64, 0x22, 0x33, 0x0a, 0x12, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
-	0x50, 0x72, 0x65, 0x66, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x72, 0x65, 0x66, 0x22, 0x1a, 0x0a, 0x18,
-	0x41, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x41,
-	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x22, 0x41, 0x0a, 0x13, 0x41, 0x67, 0x67, 0x72,
-	0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x61, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x73,
-	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x38, 0x0a, 0x14, 0x43,
-	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
-	0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x27, 0x0a, 0x15, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x6f, 0x72, 0x49, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x28,
-	0x0a, 0x14, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x48, 0x0a, 0x0f, 0x49, 0x50, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
-	0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x09, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4c, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72,
-	0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66,
-	0x69, 0x78, 0x22, 0x67, 0x0a, 0x16, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x65, 0x64, 0x49, 0x50, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6c,
-	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
-	0x4c, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x2d, 0x0a, 0x11, 0x45,
-	0x6e, 0x63, 0x61, 0x70, 0x73, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x4c, 0x52, 0x49,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x51, 0x0a, 0x1d, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x44, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x75, 0x69, 0x73, 0x68, 0x65, 0x72,
-	0x54, 0x77, 0x6f, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x41, 0x53, 0x4e, 0x12, 0x14, 0x0a, 0x05, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x22, 0x4f, 0x0a,
-	0x1b, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x75, 0x69, 0x73,
-	0x68, 0x65, 0x72, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x22, 0x52,
-	0x0a, 0x1e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x75, 0x69,
-	0x73, 0x68, 0x65, 0x72, 0x46, 0x6f, 0x75, 0x72, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x41, 0x53, 0x4e,
-	0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x64, 0x22, 0x45, 0x0a, 0x19, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x08, 0x56, 0x50,
-	0x4c, 0x53, 0x4e, 0x4c, 0x52, 0x49, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64, 0x12, 0x13, 0x0a, 0x05,
-	0x76, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x76, 0x65, 0x49,
-	0x64, 0x12, 0x26, 0x0a, 0x0f, 0x76, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x76, 0x65, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x76, 0x65, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0b, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x28, 0x0a,
-	0x10, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x62, 0x61, 0x73,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x42, 0x61, 0x73, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x1e, 0x45, 0x56, 0x50, 0x4e,
-	0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x44, 0x69, 0x73, 0x63,
-	0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64,
-	0x12, 0x32, 0x0a, 0x03, 0x65, 0x73, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
-	0x03, 0x65, 0x73, 0x69, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74,
-	0x5f, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x74, 0x68, 0x65,
-	0x72, 0x6e, 0x65, 0x74, 0x54, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0xf2, 0x01,
-	0x0a, 0x1b, 0x45, 0x56, 0x50, 0x4e, 0x4d, 0x41, 0x43, 0x49, 0x50, 0x41, 0x64, 0x76, 0x65, 0x72,
-	0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24, 0x0a,
-	0x02, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52,
-	0x02, 0x72, 0x64, 0x12, 0x32, 0x0a, 0x03, 0x65, 0x73, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65,
-	0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x52, 0x03, 0x65, 0x73, 0x69, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x74, 0x68, 0x65, 0x72,
-	0x6e, 0x65, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65,
-	0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x54, 0x61, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
-	0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x6d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
-	0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x26, 0x45, 0x56, 0x50, 0x4e, 0x49, 0x6e, 0x63, 0x6c, 0x75,
-	0x73, 0x69, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x45, 0x74, 0x68,
-	0x65, 0x72, 0x6e, 0x65, 0x74, 0x54, 0x61, 0x67, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24, 0x0a,
-	0x02, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52,
-	0x02, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x5f,
-	0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x74, 0x68, 0x65, 0x72,
-	0x6e, 0x65, 0x74, 0x54, 0x61, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x18, 0x45, 0x56, 0x50, 0x4e, 0x45, 0x74,
-	0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64, 0x12, 0x32, 0x0a, 0x03, 0x65, 0x73, 0x69, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x45, 0x74,
-	0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x03, 0x65, 0x73, 0x69, 0x12, 0x1d, 0x0a, 0x0a,
-	0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x86, 0x02, 0x0a, 0x11,
-	0x45, 0x56, 0x50, 0x4e, 0x49, 0x50, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64, 0x12, 0x32, 0x0a, 0x03, 0x65, 0x73, 0x69, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x45, 0x74, 0x68,
-	0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x03, 0x65, 0x73, 0x69, 0x12, 0x21, 0x0a, 0x0c, 0x65,
-	0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0b, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x54, 0x61, 0x67, 0x12, 0x1b,
-	0x0a, 0x09, 0x69, 0x70, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x69, 0x70, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x22, 0x0a, 0x0d, 0x69,
-	0x70, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0b, 0x69, 0x70, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4c, 0x65, 0x6e, 0x12,
-	0x1d, 0x0a, 0x0a, 0x67, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x22, 0x7f, 0x0a, 0x0e, 0x45, 0x56, 0x50, 0x4e, 0x49, 0x50, 0x4d, 0x53,
-	0x49, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c,
-	0x65, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0b, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x54, 0x61, 0x67, 0x12,
-	0x24, 0x0a, 0x02, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
-	0x79, 0x52, 0x02, 0x72, 0x74, 0x22, 0x7e, 0x0a, 0x0c, 0x53, 0x52, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x4e, 0x4c, 0x52, 0x49, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x24, 0x0a,
-	0x0d, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x75, 0x69, 0x73, 0x68, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x75, 0x69, 0x73,
-	0x68, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x65, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x19, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x65,
-	0x64, 0x56, 0x50, 0x4e, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x02, 0x72,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4c, 0x65, 0x6e,
-	0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x53, 0x0a, 0x19, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69,
-	0x70, 0x4e, 0x4c, 0x52, 0x49, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x03, 0x61, 0x73, 0x6e, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x74, 0x22, 0x75, 0x0a,
-	0x10, 0x46, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x49, 0x50, 0x50, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f,
-	0x6c, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x4c, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x22, 0x3b, 0x0a, 0x0b, 0x46, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63,
-	0x4d, 0x41, 0x43, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x22, 0x3d, 0x0a, 0x15, 0x46, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x43, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0x5b, 0x0a, 0x11, 0x46, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x43, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62,
-	0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
-	0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x0a,
-	0x0c, 0x46, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x4e, 0x4c, 0x52, 0x49, 0x12, 0x2a, 0x0a,
-	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
-	0x6e, 0x79, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x63, 0x0a, 0x0f, 0x56, 0x50, 0x4e,
-	0x46, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x4e, 0x4c, 0x52, 0x49, 0x12, 0x24, 0x0a, 0x02,
-	0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02,
-	0x72, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x34,
-	0x0a, 0x0a, 0x4f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x4e, 0x4c, 0x52, 0x49, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0x84, 0x02, 0x0a, 0x10, 0x4c, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x44,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x73, 0x6e, 0x12, 0x1a, 0x0a, 0x09, 0x62,
-	0x67, 0x70, 0x5f, 0x6c, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
-	0x62, 0x67, 0x70, 0x4c, 0x73, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6f, 0x73, 0x70, 0x66, 0x5f,
-	0x61, 0x72, 0x65, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6f,
-	0x73, 0x70, 0x66, 0x41, 0x72, 0x65, 0x61, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x73, 0x65,
-	0x75, 0x64, 0x6f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70,
-	0x73, 0x65, 0x75, 0x64, 0x6f, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x67, 0x70,
-	0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x69, 0x67, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a,
-	0x0d, 0x62, 0x67, 0x70, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x67, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x38, 0x0a, 0x18, 0x62, 0x67, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x65, 0x64, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x16, 0x62, 0x67, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x65, 0x64, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x98, 0x02, 0x0a, 0x10,
-	0x4c, 0x73, 0x4c, 0x69, 0x6e, 0x6b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
-	0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6c, 0x69, 0x6e, 0x6b, 0x4c, 0x6f, 0x63,
-	0x61, 0x6c, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x72, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6c, 0x69,
-	0x6e, 0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x69, 0x70, 0x76,
-	0x34, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x49, 0x70, 0x76, 0x34, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x65,
-	0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x69, 0x70, 0x76, 0x34,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72,
-	0x41, 0x64, 0x64, 0x72, 0x49, 0x70, 0x76, 0x34, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-	0x41, 0x64, 0x64, 0x72, 0x49, 0x70, 0x76, 0x36, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x65, 0x69, 0x67,
-	0x68, 0x62, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x41, 0x64,
-	0x64, 0x72, 0x49, 0x70, 0x76, 0x36, 0x22, 0x7d, 0x0a, 0x12, 0x4c, 0x73, 0x50, 0x72, 0x65, 0x66,
-	0x69, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f,
-	0x69, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x70, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62,
-	0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x0f, 0x6f, 0x73, 0x70, 0x66, 0x5f, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
-	0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x4f, 0x73, 0x70, 0x66, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x6f, 0x73, 0x70, 0x66, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x44, 0x0a, 0x0a, 0x4c, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x4e,
-	0x4c, 0x52, 0x49, 0x12, 0x36, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x64,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e,
-	0x4c, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
-	0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x22, 0xc0, 0x01, 0x0a, 0x0a,
-	0x4c, 0x73, 0x4c, 0x69, 0x6e, 0x6b, 0x4e, 0x4c, 0x52, 0x49, 0x12, 0x36, 0x0a, 0x0a, 0x6c, 0x6f,
-	0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x6f,
-	0x64, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6e, 0x6f, 0x64,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e,
-	0x4c, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
-	0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a, 0x0f,
-	0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73,
-	0x4c, 0x69, 0x6e, 0x6b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x0e,
-	0x6c, 0x69, 0x6e, 0x6b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x22, 0x90,
-	0x01, 0x0a, 0x0e, 0x4c, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x56, 0x34, 0x4e, 0x4c, 0x52,
-	0x49, 0x12, 0x36, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73,
-	0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x09,
-	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x70, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x50,
-	0x72, 0x65, 0x66, 0x69, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52,
-	0x10, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
-	0x72, 0x22, 0x90, 0x01, 0x0a, 0x0e, 0x4c, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x56, 0x36,
-	0x4e, 0x4c, 0x52, 0x49, 0x12, 0x36, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62,
-	0x2e, 0x4c, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
-	0x72, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x46, 0x0a, 0x11,
-	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e,
-	0x4c, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x6f, 0x72, 0x52, 0x10, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x6f, 0x72, 0x22, 0xcd, 0x01, 0x0a, 0x0c, 0x4c, 0x73, 0x41, 0x64, 0x64, 0x72, 0x50,
-	0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x4e, 0x4c,
-	0x52, 0x49, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x04,
-	0x6e, 0x6c, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
-	0x52, 0x04, 0x6e, 0x6c, 0x72, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x34,
-	0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x22, 0x61, 0x0a, 0x21, 0x4d, 0x55, 0x50, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x77, 0x6f, 0x72, 0x6b, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x60, 0x0a, 0x1e, 0x4d, 0x55, 0x50, 0x44, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xec, 0x02, 0x0a, 0x1f, 0x4d, 0x55,
-	0x50, 0x54, 0x79, 0x70, 0x65, 0x31, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24, 0x0a,
-	0x02, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52,
-	0x02, 0x72, 0x64, 0x12, 0x27, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6c, 0x65,
-	0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0c,
-	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06,
-	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72,
-	0x65, 0x66, 0x69, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x04, 0x74, 0x65, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x66, 0x69, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x71, 0x66, 0x69, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x6e,
-	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c,
-	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x65, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x65, 0x6e, 0x67,
-	0x74, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x6e,
-	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a,
-	0x15, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f,
-	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x65, 0x6e, 0x67, 0x74,
-	0x68, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x1f, 0x4d, 0x55, 0x50,
-	0x54, 0x79, 0x70, 0x65, 0x32, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x02,
-	0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02,
-	0x72, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x15, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e,
-	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x65, 0x69, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x14, 0x4d, 0x70,
-	0x52, 0x65, 0x61, 0x63, 0x68, 0x4e, 0x4c, 0x52, 0x49, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x46, 0x61, 0x6d, 0x69, 0x6c,
-	0x79, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x78,
-	0x74, 0x5f, 0x68, 0x6f, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65,
-	0x78, 0x74, 0x48, 0x6f, 0x70, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x6e, 0x6c, 0x72, 0x69, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x6e, 0x6c, 0x72,
-	0x69, 0x73, 0x22, 0x6b, 0x0a, 0x16, 0x4d, 0x70, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x4e,
-	0x4c, 0x52, 0x49, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x06,
-	0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61,
-	0x70, 0x69, 0x70, 0x62, 0x2e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x06, 0x66, 0x61, 0x6d,
-	0x69, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x6e, 0x6c, 0x72, 0x69, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x6e, 0x6c, 0x72, 0x69, 0x73, 0x22,
-	0x8f, 0x01, 0x0a, 0x1a, 0x54, 0x77, 0x6f, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x41, 0x73, 0x53, 0x70,
-	0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x23,
-	0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
-	0x69, 0x76, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x75, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x61, 0x73, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x73, 0x6e,
-	0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x22, 0x98, 0x01, 0x0a, 0x1b, 0x49, 0x50, 0x76, 0x34, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65,
-	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
-	0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x75, 0x62, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x90, 0x01, 0x0a,
-	0x1b, 0x46, 0x6f, 0x75, 0x72, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x41, 0x73, 0x53, 0x70, 0x65, 0x63,
-	0x69, 0x66, 0x69, 0x63, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d,
-	0x69, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
-	0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x75, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x61, 0x73, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x73, 0x6e, 0x12, 0x1f,
-	0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x22,
-	0x47, 0x0a, 0x15, 0x4c, 0x69, 0x6e, 0x6b, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x73, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x61,
-	0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x62,
-	0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x22, 0x2a, 0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x22, 0x25, 0x0a, 0x0d, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x30, 0x0a, 0x0d, 0x45,
-	0x6e, 0x63, 0x61, 0x70, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
-	0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x22, 0x18, 0x0a,
-	0x16, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x22, 0x4b, 0x0a, 0x0e, 0x4f, 0x70, 0x61, 0x71, 0x75,
-	0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0c, 0x69, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0x52, 0x0a, 0x10, 0x45, 0x53, 0x49, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x73, 0x5f, 0x73,
-	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0e, 0x69, 0x73, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x32, 0x0a, 0x13, 0x45, 0x53, 0x49, 0x6d,
-	0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x65, 0x73, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x65, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x55, 0x0a, 0x13,
-	0x4d, 0x61, 0x63, 0x4d, 0x6f, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x64, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x79,
-	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
-	0x4e, 0x75, 0x6d, 0x22, 0x25, 0x0a, 0x11, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x63,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x63, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x63, 0x22, 0x3b, 0x0a, 0x13, 0x54, 0x72,
-	0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65,
-	0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
-	0x61, 0x73, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x22, 0x4b, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x66, 0x66,
-	0x69, 0x63, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x22, 0x57, 0x0a, 0x22, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x54, 0x77, 0x6f, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x41, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
-	0x69, 0x63, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x73, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
-	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x60, 0x0a,
-	0x23, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x49, 0x50, 0x76, 0x34, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x45, 0x78, 0x74, 0x65,
-	0x6e, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f,
-	0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x22,
-	0x58, 0x0a, 0x23, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x46, 0x6f, 0x75, 0x72, 0x4f,
-	0x63, 0x74, 0x65, 0x74, 0x41, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x45, 0x78,
-	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x73, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61,
-	0x6c, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x2b, 0x0a, 0x15, 0x54, 0x72, 0x61,
-	0x66, 0x66, 0x69, 0x63, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64,
-	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x73, 0x63, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x04, 0x64, 0x73, 0x63, 0x70, 0x22, 0x6a, 0x0a, 0x0b, 0x4d, 0x55, 0x50, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x75, 0x62, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x32, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x32, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x34,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49,
-	0x64, 0x34, 0x22, 0x45, 0x0a, 0x0c, 0x56, 0x50, 0x4c, 0x53, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64,
-	0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x66, 0x6c,
-	0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x22, 0x3b, 0x0a, 0x0f, 0x55, 0x6e, 0x6b,
-	0x6e, 0x6f, 0x77, 0x6e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x56, 0x0a, 0x1c, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64,
-	0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x41, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
-	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
-	0x79, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x40,
-	0x0a, 0x10, 0x41, 0x73, 0x34, 0x50, 0x61, 0x74, 0x68, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x41, 0x73, 0x53,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x22, 0x44, 0x0a, 0x16, 0x41, 0x73, 0x34, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x6f,
-	0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x73, 0x6e, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x65, 0x0a, 0x13, 0x50, 0x6d, 0x73, 0x69, 0x54, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c,
-	0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4a, 0x0a,
-	0x1e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x53, 0x75, 0x62, 0x54,
-	0x4c, 0x56, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x73, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x06, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x22, 0x37, 0x0a, 0x19, 0x54, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x22, 0x2e, 0x0a, 0x16, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61,
-	0x70, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x6c,
-	0x6f, 0x72, 0x22, 0x55, 0x0a, 0x1d, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61,
-	0x70, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x53, 0x52, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70,
-	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x56, 0x0a, 0x24, 0x54, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x53, 0x52,
-	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
-	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0x39, 0x0a, 0x1b, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70,
-	0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x53, 0x52, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x49, 0x0a, 0x1d,
-	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x53, 0x75, 0x62, 0x54, 0x4c,
-	0x56, 0x53, 0x52, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x49, 0x44, 0x12, 0x28, 0x0a,
-	0x04, 0x62, 0x73, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
-	0x79, 0x52, 0x04, 0x62, 0x73, 0x69, 0x64, 0x22, 0x4e, 0x0a, 0x0c, 0x53, 0x52, 0x42, 0x69, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x53, 0x49, 0x44, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x5f, 0x66, 0x6c, 0x61,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x15,
-	0x0a, 0x06, 0x69, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x69, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x64, 0x22, 0xb3, 0x01, 0x0a, 0x14, 0x53, 0x52, 0x76, 0x36,
-	0x45, 0x6e, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72,
-	0x12, 0x2f, 0x0a, 0x08, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x42,
-	0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x08, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f,
-	0x72, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x65, 0x6e, 0x12, 0x19,
-	0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x4c, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x75, 0x6e,
-	0x63, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x75, 0x6e,
-	0x63, 0x4c, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x72, 0x67, 0x5f, 0x6c, 0x65, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x72, 0x67, 0x4c, 0x65, 0x6e, 0x22, 0xc4, 0x01,
-	0x0a, 0x0e, 0x53, 0x52, 0x76, 0x36, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x49, 0x44,
-	0x12, 0x15, 0x0a, 0x06, 0x73, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x5f, 0x66, 0x6c, 0x61,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x15,
-	0x0a, 0x06, 0x62, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x62, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12, 0x5b, 0x0a, 0x1b, 0x65, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61,
-	0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x45, 0x6e, 0x64, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x19, 0x65, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x53, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x75, 0x72, 0x65, 0x22, 0x54, 0x0a, 0x17, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e,
-	0x63, 0x61, 0x70, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x53, 0x52, 0x45, 0x4e, 0x4c, 0x50, 0x12,
-	0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x65, 0x6e, 0x6c, 0x70, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x45, 0x4e, 0x4c, 0x50,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x65, 0x6e, 0x6c, 0x70, 0x22, 0x38, 0x0a, 0x08, 0x53, 0x52,
-	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x22, 0x6a, 0x0a, 0x0c, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46,
-	0x6c, 0x61, 0x67, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x76, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x61,
-	0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x46, 0x6c,
-	0x61, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x05, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x5f, 0x66,
-	0x6c, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x62, 0x46, 0x6c, 0x61, 0x67,
-	0x22, 0x4f, 0x0a, 0x0c, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x41,
-	0x12, 0x29, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46,
-	0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x22, 0xa8, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x42, 0x12, 0x29, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x10, 0x0a,
-	0x03, 0x73, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12,
-	0x5b, 0x0a, 0x1b, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x65, 0x68, 0x61,
-	0x76, 0x69, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x52, 0x76,
-	0x36, 0x45, 0x6e, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f,
-	0x72, 0x52, 0x19, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76,
-	0x69, 0x6f, 0x72, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x22, 0x7b, 0x0a, 0x1e,
-	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x53, 0x75, 0x62, 0x54, 0x4c,
-	0x56, 0x53, 0x52, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x27,
-	0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x52, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52,
-	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52,
-	0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x3b, 0x0a, 0x1f, 0x54, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x45, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x32, 0x0a, 0x1c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x45, 0x6e, 0x63, 0x61, 0x70, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x55, 0x44, 0x50, 0x44, 0x65,
-	0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x44, 0x0a, 0x18, 0x54, 0x75,
-	0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x55,
-	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0x4e, 0x0a, 0x0e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x54,
-	0x4c, 0x56, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x6c, 0x76, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x74, 0x6c, 0x76, 0x73,
-	0x22, 0x41, 0x0a, 0x14, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x41,
-	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x6c, 0x76, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x54,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x54, 0x4c, 0x56, 0x52, 0x04, 0x74,
-	0x6c, 0x76, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x1b, 0x49, 0x50, 0x76, 0x36, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x45, 0x78, 0x74, 0x65, 0x6e,
-	0x64, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69,
-	0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x75, 0x62, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x60,
-	0x0a, 0x23, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x49, 0x50, 0x76, 0x36, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x22, 0x59, 0x0a, 0x1f, 0x49, 0x50, 0x36, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x43,
-	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0b,
-	0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x10, 0x41,
-	0x69, 0x67, 0x70, 0x54, 0x4c, 0x56, 0x49, 0x47, 0x50, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12,
-	0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x22, 0x3a, 0x0a, 0x0e, 0x41, 0x69, 0x67, 0x70, 0x54,
-	0x4c, 0x56, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x39, 0x0a, 0x0d, 0x41, 0x69, 0x67, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x6c, 0x76, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x74, 0x6c, 0x76, 0x73, 0x22, 0x75,
-	0x0a, 0x0e, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
-	0x12, 0x21, 0x0a, 0x0c, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x64, 0x61, 0x74,
-	0x61, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x44,
-	0x61, 0x74, 0x61, 0x31, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x64, 0x61,
-	0x74, 0x61, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
-	0x44, 0x61, 0x74, 0x61, 0x32, 0x22, 0x54, 0x0a, 0x19, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x43, 0x6f,
-	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e,
-	0x4c, 0x61, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x0b,
-	0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x0b,
-	0x4c, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f,
-	0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f,
-	0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12,
-	0x10, 0x0a, 0x03, 0x61, 0x62, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x62,
-	0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x76, 0x36, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x76, 0x36, 0x22, 0x8b, 0x01, 0x0a, 0x0a, 0x4c, 0x73,
-	0x49, 0x47, 0x50, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x77, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
-	0x6e, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x6e, 0x6f, 0x55, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x61, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x73,
-	0x73, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x61, 0x67,
-	0x61, 0x74, 0x65, 0x4e, 0x73, 0x73, 0x61, 0x22, 0x33, 0x0a, 0x09, 0x4c, 0x73, 0x53, 0x72, 0x52,
-	0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x8a, 0x01, 0x0a,
-	0x10, 0x4c, 0x73, 0x53, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
-	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
-	0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x70, 0x76, 0x34, 0x53,
-	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x70, 0x76, 0x36,
-	0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0d, 0x69, 0x70, 0x76, 0x36, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12,
-	0x28, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x53, 0x72, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x3a, 0x0a, 0x0e, 0x4c, 0x73, 0x53,
-	0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x28, 0x0a, 0x06, 0x72,
-	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70,
-	0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x53, 0x72, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x72,
-	0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xfd, 0x02, 0x0a, 0x0f, 0x4c, 0x73, 0x41, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a,
-	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61,
-	0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73,
-	0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
-	0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x2b, 0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x5f, 0x76, 0x36, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x6f, 0x63,
-	0x61, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x56, 0x36, 0x12, 0x1b, 0x0a, 0x09,
-	0x69, 0x73, 0x69, 0x73, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x08, 0x69, 0x73, 0x69, 0x73, 0x41, 0x72, 0x65, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x61,
-	0x71, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6f, 0x70, 0x61, 0x71, 0x75,
-	0x65, 0x12, 0x40, 0x0a, 0x0f, 0x73, 0x72, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69,
-	0x70, 0x62, 0x2e, 0x4c, 0x73, 0x53, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x52, 0x0e, 0x73, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x72, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69,
-	0x74, 0x68, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x73, 0x72, 0x41, 0x6c,
-	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x12, 0x3b, 0x0a, 0x0e, 0x73, 0x72, 0x5f, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x53, 0x72, 0x4c, 0x6f, 0x63,
-	0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0c, 0x73, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x9b, 0x04, 0x0a, 0x0f, 0x4c, 0x73, 0x41, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a,
-	0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x5f, 0x76, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64,
-	0x56, 0x36, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65,
-	0x6d, 0x6f, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x13,
-	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x5f, 0x76, 0x36, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74,
-	0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x56, 0x36, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2a, 0x0a, 0x11,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x54, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x67, 0x70, 0x5f,
-	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x69, 0x67,
-	0x70, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x61, 0x71, 0x75,
-	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x09, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x31, 0x0a,
-	0x14, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6e, 0x64,
-	0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x72, 0x65, 0x73,
-	0x65, 0x72, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68,
-	0x12, 0x31, 0x0a, 0x14, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x62,
-	0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x02, 0x52, 0x13,
-	0x75, 0x6e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69,
-	0x64, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x72, 0x5f, 0x61, 0x64, 0x6a, 0x61, 0x63, 0x65,
-	0x6e, 0x63, 0x79, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x73,
-	0x72, 0x41, 0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x69, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x73, 0x72, 0x6c, 0x67, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x72,
-	0x6c, 0x67, 0x73, 0x22, 0x7f, 0x0a, 0x11, 0x4c, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x2e, 0x0a, 0x09, 0x69, 0x67, 0x70, 0x5f,
-	0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70,
-	0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x49, 0x47, 0x50, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x08,
-	0x69, 0x67, 0x70, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x61, 0x71,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65,
-	0x12, 0x22, 0x0a, 0x0d, 0x73, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x73, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x53, 0x69, 0x64, 0x22, 0x7e, 0x0a, 0x18, 0x4c, 0x73, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65,
-	0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x49, 0x44, 0x46, 0x6c, 0x61, 0x67, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06,
-	0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x62, 0x61,
-	0x63, 0x6b, 0x75, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73,
-	0x74, 0x65, 0x6e, 0x74, 0x22, 0x76, 0x0a, 0x13, 0x4c, 0x73, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65,
-	0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x05, 0x66,
-	0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x70, 0x69,
-	0x70, 0x62, 0x2e, 0x4c, 0x73, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x53, 0x49, 0x44, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61,
-	0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x73, 0x69, 0x64, 0x22, 0xf8, 0x01, 0x0a,
-	0x19, 0x4c, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x42, 0x67, 0x70, 0x50,
-	0x65, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x45, 0x0a, 0x11, 0x62, 0x67,
-	0x70, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73,
-	0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x49,
-	0x44, 0x52, 0x0e, 0x62, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x69,
-	0x64, 0x12, 0x4f, 0x0a, 0x16, 0x62, 0x67, 0x70, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x64,
-	0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x42, 0x67, 0x70, 0x50,
-	0x65, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x49, 0x44, 0x52, 0x13, 0x62,
-	0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x41, 0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x53,
-	0x69, 0x64, 0x12, 0x43, 0x0a, 0x10, 0x62, 0x67, 0x70, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x73,
-	0x65, 0x74, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61,
-	0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x53, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x49, 0x44, 0x52, 0x0d, 0x62, 0x67, 0x70, 0x50, 0x65, 0x65,
-	0x72, 0x53, 0x65, 0x74, 0x53, 0x69, 0x64, 0x22, 0xe3, 0x01, 0x0a, 0x0b, 0x4c, 0x73, 0x41, 0x74,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e,
-	0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x41, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12,
-	0x30, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x12, 0x4a, 0x0a, 0x10, 0x62, 0x67, 0x70, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x73, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70,
-	0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x42,
-	0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0e, 0x62,
-	0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x52, 0x0a,
-	0x10, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0xb2, 0x02, 0x0a, 0x16, 0x53, 0x52, 0x76, 0x36, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x75, 0x72, 0x65, 0x53, 0x75, 0x62, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x12, 0x30, 0x0a, 0x14,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6c, 0x65,
-	0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x2e,
-	0x0a, 0x13, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6c,
-	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x27,
-	0x0a, 0x0f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74,
-	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x72, 0x67, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0e, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
-	0x12, 0x31, 0x0a, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x6e,
-	0x67, 0x74, 0x68, 0x12, 0x31, 0x0a, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x25, 0x0a, 0x0c, 0x53, 0x52, 0x76, 0x36, 0x53, 0x49,
-	0x44, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x31,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x31, 0x22, 0x31, 0x0a,
-	0x07, 0x53, 0x52, 0x76, 0x36, 0x54, 0x4c, 0x56, 0x12, 0x26, 0x0a, 0x03, 0x74, 0x6c, 0x76, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x03, 0x74, 0x6c, 0x76,
-	0x22, 0xa0, 0x02, 0x0a, 0x15, 0x53, 0x52, 0x76, 0x36, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x05,
-	0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70,
-	0x69, 0x70, 0x62, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x53, 0x49, 0x44, 0x46, 0x6c, 0x61, 0x67, 0x73,
-	0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x10, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x65, 0x68, 0x61,
-	0x76, 0x69, 0x6f, 0x72, 0x12, 0x4e, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x5f, 0x73, 0x75, 0x62, 0x5f,
-	0x74, 0x6c, 0x76, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x61, 0x70, 0x69,
-	0x70, 0x62, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x2e, 0x53, 0x75, 0x62, 0x53, 0x75, 0x62, 0x54,
-	0x6c, 0x76, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x53, 0x75, 0x62,
-	0x54, 0x6c, 0x76, 0x73, 0x1a, 0x4d, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x53, 0x75, 0x62, 0x54, 0x6c,
-	0x76, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62,
-	0x2e, 0x53, 0x52, 0x76, 0x36, 0x54, 0x4c, 0x56, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x9f, 0x01, 0x0a, 0x10, 0x53, 0x52, 0x76, 0x36, 0x4c, 0x33, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x4c, 0x56, 0x12, 0x3f, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x5f,
-	0x74, 0x6c, 0x76, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69,
-	0x70, 0x62, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x4c, 0x33, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x54, 0x4c, 0x56, 0x2e, 0x53, 0x75, 0x62, 0x54, 0x6c, 0x76, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x07, 0x73, 0x75, 0x62, 0x54, 0x6c, 0x76, 0x73, 0x1a, 0x4a, 0x0a, 0x0c, 0x53, 0x75, 0x62,
-	0x54, 0x6c, 0x76, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69,
-	0x70, 0x62, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x54, 0x4c, 0x56, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9f, 0x01, 0x0a, 0x10, 0x53, 0x52, 0x76, 0x36, 0x4c, 0x32,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x4c, 0x56, 0x12, 0x3f, 0x0a, 0x08, 0x73, 0x75,
-	0x62, 0x5f, 0x74, 0x6c, 0x76, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61,
-	0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x4c, 0x32, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x54, 0x4c, 0x56, 0x2e, 0x53, 0x75, 0x62, 0x54, 0x6c, 0x76, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x62, 0x54, 0x6c, 0x76, 0x73, 0x1a, 0x4a, 0x0a, 0x0c, 0x53,
-	0x75, 0x62, 0x54, 0x6c, 0x76, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61,
-	0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x54, 0x4c, 0x56, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x35, 0x0a, 0x09, 0x50, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x53, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x6c, 0x76, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x74, 0x6c, 0x76, 0x73, 0x2a, 0xf7,
-	0x01, 0x0a, 0x0f, 0x4c, 0x73, 0x4f, 0x73, 0x70, 0x66, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x53, 0x5f, 0x4f, 0x53, 0x50, 0x46, 0x5f, 0x52, 0x4f,
-	0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
-	0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x4c, 0x53, 0x5f, 0x4f, 0x53, 0x50, 0x46, 0x5f, 0x52, 0x4f,
-	0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x52, 0x41, 0x5f, 0x41,
-	0x52, 0x45, 0x41, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x4c, 0x53, 0x5f, 0x4f, 0x53, 0x50, 0x46,
-	0x5f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45,
-	0x52, 0x5f, 0x41, 0x52, 0x45, 0x41, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x53, 0x5f, 0x4f,
-	0x53, 0x50, 0x46, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45,
-	0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x31, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x53,
-	0x5f, 0x4f, 0x53, 0x50, 0x46, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x32, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18,
-	0x4c, 0x53, 0x5f, 0x4f, 0x53, 0x50, 0x46, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x4e, 0x53, 0x53, 0x41, 0x31, 0x10, 0x05, 0x12, 0x1c, 0x0a, 0x18, 0x4c, 0x53,
-	0x5f, 0x4f, 0x53, 0x50, 0x46, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x4e, 0x53, 0x53, 0x41, 0x32, 0x10, 0x06, 0x2a, 0x73, 0x0a, 0x0a, 0x4c, 0x73, 0x4e, 0x4c,
-	0x52, 0x49, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x53, 0x5f, 0x4e, 0x4c, 0x52,
-	0x49, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4c,
-	0x53, 0x5f, 0x4e, 0x4c, 0x52, 0x49, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a,
-	0x0c, 0x4c, 0x53, 0x5f, 0x4e, 0x4c, 0x52, 0x49, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x10, 0x02, 0x12,
-	0x15, 0x0a, 0x11, 0x4c, 0x53, 0x5f, 0x4e, 0x4c, 0x52, 0x49, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x49,
-	0x58, 0x5f, 0x56, 0x34, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x53, 0x5f, 0x4e, 0x4c, 0x52,
-	0x49, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x49, 0x58, 0x5f, 0x56, 0x36, 0x10, 0x04, 0x2a, 0xbb, 0x01,
-	0x0a, 0x0c, 0x4c, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x44, 0x12, 0x17,
-	0x0a, 0x13, 0x4c, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x53, 0x5f, 0x50, 0x52,
-	0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x49, 0x53, 0x49, 0x53, 0x5f, 0x4c, 0x31, 0x10, 0x01,
-	0x12, 0x17, 0x0a, 0x13, 0x4c, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f,
-	0x49, 0x53, 0x49, 0x53, 0x5f, 0x4c, 0x32, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x53, 0x5f,
-	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4f, 0x53, 0x50, 0x46, 0x5f, 0x56, 0x32,
-	0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f,
-	0x4c, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x53,
-	0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43,
-	0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f,
-	0x4c, 0x5f, 0x4f, 0x53, 0x50, 0x46, 0x5f, 0x56, 0x33, 0x10, 0x06, 0x2a, 0xed, 0x05, 0x0a, 0x0c,
-	0x53, 0x52, 0x76, 0x36, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x12, 0x0c, 0x0a, 0x08,
-	0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x4e,
-	0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x4e, 0x44, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x50, 0x53, 0x50, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x4e, 0x44, 0x5f, 0x57, 0x49, 0x54,
-	0x48, 0x5f, 0x55, 0x53, 0x50, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x4e, 0x44, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x50, 0x53, 0x50, 0x5f, 0x55, 0x53, 0x50, 0x10, 0x04, 0x12, 0x08, 0x0a,
-	0x04, 0x45, 0x4e, 0x44, 0x58, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x44, 0x58, 0x5f,
-	0x57, 0x49, 0x54, 0x48, 0x5f, 0x50, 0x53, 0x50, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e,
-	0x44, 0x58, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x55, 0x53, 0x50, 0x10, 0x07, 0x12, 0x15, 0x0a,
-	0x11, 0x45, 0x4e, 0x44, 0x58, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x50, 0x53, 0x50, 0x5f, 0x55,
-	0x53, 0x50, 0x10, 0x08, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x4e, 0x44, 0x54, 0x10, 0x09, 0x12, 0x11,
-	0x0a, 0x0d, 0x45, 0x4e, 0x44, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x50, 0x53, 0x50, 0x10,
-	0x0a, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x44, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x55,
-	0x53, 0x50, 0x10, 0x0b, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x4e, 0x44, 0x54, 0x5f, 0x57, 0x49, 0x54,
-	0x48, 0x5f, 0x50, 0x53, 0x50, 0x5f, 0x55, 0x53, 0x50, 0x10, 0x0c, 0x12, 0x11, 0x0a, 0x0d, 0x45,
-	0x4e, 0x44, 0x5f, 0x42, 0x36, 0x5f, 0x45, 0x4e, 0x43, 0x41, 0x50, 0x53, 0x10, 0x0e, 0x12, 0x0a,
-	0x0a, 0x06, 0x45, 0x4e, 0x44, 0x5f, 0x42, 0x4d, 0x10, 0x0f, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x4e,
-	0x44, 0x5f, 0x44, 0x58, 0x36, 0x10, 0x10, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x4e, 0x44, 0x5f, 0x44,
-	0x58, 0x34, 0x10, 0x11, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x54, 0x36, 0x10,
-	0x12, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x54, 0x34, 0x10, 0x13, 0x12, 0x0c,
-	0x0a, 0x08, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x54, 0x34, 0x36, 0x10, 0x14, 0x12, 0x0b, 0x0a, 0x07,
-	0x45, 0x4e, 0x44, 0x5f, 0x44, 0x58, 0x32, 0x10, 0x15, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x4e, 0x44,
-	0x5f, 0x44, 0x58, 0x32, 0x56, 0x10, 0x16, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x4e, 0x44, 0x5f, 0x44,
-	0x54, 0x32, 0x55, 0x10, 0x17, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x54, 0x32,
-	0x4d, 0x10, 0x18, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x4e, 0x44, 0x5f, 0x42, 0x36, 0x5f, 0x45, 0x4e,
-	0x43, 0x41, 0x50, 0x53, 0x5f, 0x52, 0x65, 0x64, 0x10, 0x1b, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x4e,
-	0x44, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x1c, 0x12, 0x14, 0x0a, 0x10,
-	0x45, 0x4e, 0x44, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x50, 0x53, 0x50, 0x5f, 0x55, 0x53, 0x44,
-	0x10, 0x1d, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x4e, 0x44, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x55,
-	0x53, 0x50, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x1e, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x4e, 0x44, 0x5f,
-	0x57, 0x49, 0x54, 0x48, 0x5f, 0x50, 0x53, 0x50, 0x5f, 0x55, 0x53, 0x50, 0x5f, 0x55, 0x53, 0x44,
-	0x10, 0x1f, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x44, 0x58, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
-	0x55, 0x53, 0x44, 0x10, 0x20, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x4e, 0x44, 0x58, 0x5f, 0x57, 0x49,
-	0x54, 0x48, 0x5f, 0x50, 0x53, 0x50, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x21, 0x12, 0x15, 0x0a, 0x11,
-	0x45, 0x4e, 0x44, 0x58, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x55, 0x53, 0x50, 0x5f, 0x55, 0x53,
-	0x44, 0x10, 0x22, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x4e, 0x44, 0x58, 0x5f, 0x57, 0x49, 0x54, 0x48,
-	0x5f, 0x50, 0x53, 0x50, 0x5f, 0x55, 0x53, 0x50, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x23, 0x12, 0x11,
-	0x0a, 0x0d, 0x45, 0x4e, 0x44, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x55, 0x53, 0x44, 0x10,
-	0x24, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x4e, 0x44, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x50,
-	0x53, 0x50, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x25, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x4e, 0x44, 0x54,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x55, 0x53, 0x50, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x26, 0x12,
-	0x19, 0x0a, 0x15, 0x45, 0x4e, 0x44, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x50, 0x53, 0x50,
-	0x5f, 0x55, 0x53, 0x50, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x27, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x4e,
-	0x44, 0x4d, 0x5f, 0x47, 0x54, 0x50, 0x36, 0x44, 0x10, 0x45, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x4e,
-	0x44, 0x4d, 0x5f, 0x47, 0x54, 0x50, 0x36, 0x44, 0x49, 0x10, 0x46, 0x12, 0x0e, 0x0a, 0x0a, 0x45,
-	0x4e, 0x44, 0x4d, 0x5f, 0x47, 0x54, 0x50, 0x36, 0x45, 0x10, 0x47, 0x12, 0x0e, 0x0a, 0x0a, 0x45,
-	0x4e, 0x44, 0x4d, 0x5f, 0x47, 0x54, 0x50, 0x34, 0x45, 0x10, 0x48, 0x2a, 0x44, 0x0a, 0x08, 0x45,
-	0x4e, 0x4c, 0x50, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x64, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x79, 0x70, 0x65, 0x31, 0x10, 0x01,
-	0x12, 0x09, 0x0a, 0x05, 0x54, 0x79, 0x70, 0x65, 0x32, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x54,
-	0x79, 0x70, 0x65, 0x33, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x79, 0x70, 0x65, 0x34, 0x10,
-	0x04, 0x42, 0x24, 0x5a, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x6f, 0x73, 0x72, 0x67, 0x2f, 0x67, 0x6f, 0x62, 0x67, 0x70, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70,
-	0x69, 0x3b, 0x61, 0x70, 0x69, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	IsTransitive bool   `protobuf:"varint,1,opt,name=is_transitive,json=isTransitive,proto3" json:"is_transitive,omitempty"`
+	SubType      uint32 `protobuf:"varint,2,opt,name=sub_type,json=subType,proto3" json:"sub_type,omitempty"`
+	Address      string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	LocalAdmin   uint32 `protobuf:"varint,4,opt,name=local_admin,json=localAdmin,proto3" json:"local_admin,omitempty"`
 }
 
-var (
-	file_attribute_proto_rawDescOnce sync.Once
-	file_attribute_proto_rawDescData = file_attribute_proto_rawDesc
-)
+func (x *IPv6AddressSpecificExtended) Reset() {
+	*x = IPv6AddressSpecificExtended{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_attribute_proto_rawDescGZIP() []byte {
-	file_attribute_proto_rawDescOnce.Do(func() {
-		file_attribute_proto_rawDescData = protoimpl.X.CompressGZIP(file_attribute_proto_rawDescData)
-	})
-	return file_attribute_proto_rawDescData
+func (x *IPv6AddressSpecificExtended) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_attribute_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_attribute_proto_msgTypes = make([]protoimpl.MessageInfo, 127)
-var file_attribute_proto_goTypes = []interface{}{
-	(LsOspfRouteType)(0),                           // 0: apipb.LsOspfRouteType
-	(LsNLRIType)(0),                                // 1: apipb.LsNLRIType
-	(LsProtocolID)(0),                              // 2: apipb.LsProtocolID
-	(SRv6Behavior)(0),                              // 3: apipb.SRv6Behavior
-	(ENLPType)(0),                                  // 4: apipb.ENLPType
-	(AsSegment_Type)(0),                            // 5: apipb.AsSegment.Type
-	(*OriginAttribute)(nil),                        // 6: apipb.OriginAttribute
-	(*AsSegment)(nil),                              // 7: apipb.AsSegment
-	(*AsPathAttribute)(nil),                        // 8: apipb.AsPathAttribute
-	(*NextHopAttribute)(nil),                       // 9: apipb.NextHopAttribute
-	(*MultiExitDiscAttribute)(nil),                 // 10: apipb.MultiExitDiscAttribute
-	(*LocalPrefAttribute)(nil),                     // 11: apipb.LocalPrefAttribute
-	(*AtomicAggregateAttribute)(nil),               // 12: apipb.AtomicAggregateAttribute
-	(*AggregatorAttribute)(nil),                    // 13: apipb.AggregatorAttribute
-	(*CommunitiesAttribute)(nil),                   // 14: apipb.CommunitiesAttribute
-	(*OriginatorIdAttribute)(nil),                  // 15: apipb.OriginatorIdAttribute
-	(*ClusterListAttribute)(nil),                   // 16: apipb.ClusterListAttribute
-	(*IPAddressPrefix)(nil),                        // 17: apipb.IPAddressPrefix
-	(*LabeledIPAddressPrefix)(nil),                 // 18: apipb.LabeledIPAddressPrefix
-	(*EncapsulationNLRI)(nil),                      // 19: apipb.EncapsulationNLRI
-	(*RouteDistinguisherTwoOctetASN)(nil),          // 20: apipb.RouteDistinguisherTwoOctetASN
-	(*RouteDistinguisherIPAddress)(nil),            // 21: apipb.RouteDistinguisherIPAddress
-	(*RouteDistinguisherFourOctetASN)(nil),         // 22: apipb.RouteDistinguisherFourOctetASN
-	(*EthernetSegmentIdentifier)(nil),              // 23: apipb.EthernetSegmentIdentifier
-	(*VPLSNLRI)(nil),                               // 24: apipb.VPLSNLRI
-	(*EVPNEthernetAutoDiscoveryRoute)(nil),         // 25: apipb.EVPNEthernetAutoDiscoveryRoute
-	(*EVPNMACIPAdvertisementRoute)(nil),            // 26: apipb.EVPNMACIPAdvertisementRoute
-	(*EVPNInclusiveMulticastEthernetTagRoute)(nil), // 27: apipb.EVPNInclusiveMulticastEthernetTagRoute
-	(*EVPNEthernetSegmentRoute)(nil),               // 28: apipb.EVPNEthernetSegmentRoute
-	(*EVPNIPPrefixRoute)(nil),                      // 29: apipb.EVPNIPPrefixRoute
-	(*EVPNIPMSIRoute)(nil),                         // 30: apipb.EVPNIPMSIRoute
-	(*SRPolicyNLRI)(nil),                           // 31: apipb.SRPolicyNLRI
-	(*LabeledVPNIPAddressPrefix)(nil),              // 32: apipb.LabeledVPNIPAddressPrefix
-	(*RouteTargetMembershipNLRI)(nil),              // 33: apipb.RouteTargetMembershipNLRI
-	(*FlowSpecIPPrefix)(nil),                       // 34: apipb.FlowSpecIPPrefix
-	(*FlowSpecMAC)(nil),                            // 35: apipb.FlowSpecMAC
-	(*FlowSpecComponentItem)(nil),                  // 36: apipb.FlowSpecComponentItem
-	(*FlowSpecComponent)(nil),                      // 37: apipb.FlowSpecComponent
-	(*FlowSpecNLRI)(nil),                           // 38: apipb.FlowSpecNLRI
-	(*VPNFlowSpecNLRI)(nil),                        // 39: apipb.VPNFlowSpecNLRI
-	(*OpaqueNLRI)(nil),                             // 40: apipb.OpaqueNLRI
-	(*LsNodeDescriptor)(nil),                       // 41: apipb.LsNodeDescriptor
-	(*LsLinkDescriptor)(nil),                       // 42: apipb.LsLinkDescriptor
-	(*LsPrefixDescriptor)(nil),                     // 43: apipb.LsPrefixDescriptor
-	(*LsNodeNLRI)(nil),                             // 44: apipb.LsNodeNLRI
-	(*LsLinkNLRI)(nil),                             // 45: apipb.LsLinkNLRI
-	(*LsPrefixV4NLRI)(nil),                         // 46: apipb.LsPrefixV4NLRI
-	(*LsPrefixV6NLRI)(nil),                         // 47: apipb.LsPrefixV6NLRI
-	(*LsAddrPrefix)(nil),                           // 48: apipb.LsAddrPrefix
-	(*MUPInterworkSegmentDiscoveryRoute)(nil),      // 49: apipb.MUPInterworkSegmentDiscoveryRoute
-	(*MUPDirectSegmentDiscoveryRoute)(nil),         // 50: apipb.MUPDirectSegmentDiscoveryRoute
-	(*MUPType1SessionTransformedRoute)(nil),        // 51: apipb.MUPType1SessionTransformedRoute
-	(*MUPType2SessionTransformedRoute)(nil),        // 52: apipb.MUPType2SessionTransformedRoute
-	(*MpReachNLRIAttribute)(nil),                   // 53: apipb.MpReachNLRIAttribute
-	(*MpUnreachNLRIAttribute)(nil),                 // 54: apipb.MpUnreachNLRIAttribute
-	(*TwoOctetAsSpecificExtended)(nil),             // 55: apipb.TwoOctetAsSpecificExtended
-	(*IPv4AddressSpecificExtended)(nil),            // 56: apipb.IPv4AddressSpecificExtended
-	(*FourOctetAsSpecificExtended)(nil),            // 57: apipb.FourOctetAsSpecificExtended
-	(*LinkBandwidthExtended)(nil),                  // 58: apipb.LinkBandwidthExtended
-	(*ValidationExtended)(nil),                     // 59: apipb.ValidationExtended
-	(*ColorExtended)(nil),                          // 60: apipb.ColorExtended
-	(*EncapExtended)(nil),                          // 61: apipb.EncapExtended
-	(*DefaultGatewayExtended)(nil),                 // 62: apipb.DefaultGatewayExtended
-	(*OpaqueExtended)(nil),                         // 63: apipb.OpaqueExtended
-	(*ESILabelExtended)(nil),                       // 64: apipb.ESILabelExtended
-	(*ESImportRouteTarget)(nil),                    // 65: apipb.ESImportRouteTarget
-	(*MacMobilityExtended)(nil),                    // 66: apipb.MacMobilityExtended
-	(*RouterMacExtended)(nil),                      // 67: apipb.RouterMacExtended
-	(*TrafficRateExtended)(nil),                    // 68: apipb.TrafficRateExtended
-	(*TrafficActionExtended)(nil),                  // 69: apipb.TrafficActionExtended
-	(*RedirectTwoOctetAsSpecificExtended)(nil),     // 70: apipb.RedirectTwoOctetAsSpecificExtended
-	(*RedirectIPv4AddressSpecificExtended)(nil),    // 71: apipb.RedirectIPv4AddressSpecificExtended
-	(*RedirectFourOctetAsSpecificExtended)(nil),    // 72: apipb.RedirectFourOctetAsSpecificExtended
-	(*TrafficRemarkExtended)(nil),                  // 73: apipb.TrafficRemarkExtended
-	(*MUPExtended)(nil),                            // 74: apipb.MUPExtended
-	(*VPLSExtended)(nil),                           // 75: apipb.VPLSExtended
-	(*UnknownExtended)(nil),                        // 76: apipb.UnknownExtended
-	(*ExtendedCommunitiesAttribute)(nil),           // 77: apipb.ExtendedCommunitiesAttribute
-	(*As4PathAttribute)(nil),                       // 78: apipb.As4PathAttribute
-	(*As4AggregatorAttribute)(nil),                 // 79: apipb.As4AggregatorAttribute
-	(*PmsiTunnelAttribute)(nil),                    // 80: apipb.PmsiTunnelAttribute
-	(*TunnelEncapSubTLVEncapsulation)(nil),         // 81: apipb.TunnelEncapSubTLVEncapsulation
-	(*TunnelEncapSubTLVProtocol)(nil),              // 82: apipb.TunnelEncapSubTLVProtocol
-	(*TunnelEncapSubTLVColor)(nil),                 // 83: apipb.TunnelEncapSubTLVColor
-	(*TunnelEncapSubTLVSRPreference)(nil),          // 84: apipb.TunnelEncapSubTLVSRPreference
-	(*TunnelEncapSubTLVSRCandidatePathName)(nil),   // 85: apipb.TunnelEncapSubTLVSRCandidatePathName
-	(*TunnelEncapSubTLVSRPriority)(nil),            // 86: apipb.TunnelEncapSubTLVSRPriority
-	(*TunnelEncapSubTLVSRBindingSID)(nil),          // 87: apipb.TunnelEncapSubTLVSRBindingSID
-	(*SRBindingSID)(nil),                           // 88: apipb.SRBindingSID
-	(*SRv6EndPointBehavior)(nil),                   // 89: apipb.SRv6EndPointBehavior
-	(*SRv6BindingSID)(nil),                         // 90: apipb.SRv6BindingSID
-	(*TunnelEncapSubTLVSRENLP)(nil),                // 91: apipb.TunnelEncapSubTLVSRENLP
-	(*SRWeight)(nil),                               // 92: apipb.SRWeight
-	(*SegmentFlags)(nil),                           // 93: apipb.SegmentFlags
-	(*SegmentTypeA)(nil),                           // 94: apipb.SegmentTypeA
-	(*SegmentTypeB)(nil),                           // 95: apipb.SegmentTypeB
-	(*TunnelEncapSubTLVSRSegmentList)(nil),         // 96: apipb.TunnelEncapSubTLVSRSegmentList
-	(*TunnelEncapSubTLVEgressEndpoint)(nil),        // 97: apipb.TunnelEncapSubTLVEgressEndpoint
-	(*TunnelEncapSubTLVUDPDestPort)(nil),           // 98: apipb.TunnelEncapSubTLVUDPDestPort
-	(*TunnelEncapSubTLVUnknown)(nil),               // 99: apipb.TunnelEncapSubTLVUnknown
-	(*TunnelEncapTLV)(nil),                         // 100: apipb.TunnelEncapTLV
-	(*TunnelEncapAttribute)(nil),                   // 101: apipb.TunnelEncapAttribute
-	(*IPv6AddressSpecificExtended)(nil),            // 102: apipb.IPv6AddressSpecificExtended
-	(*RedirectIPv6AddressSpecificExtended)(nil),    // 103: apipb.RedirectIPv6AddressSpecificExtended
-	(*IP6ExtendedCommunitiesAttribute)(nil),        // 104: apipb.IP6ExtendedCommunitiesAttribute
-	(*AigpTLVIGPMetric)(nil),                       // 105: apipb.AigpTLVIGPMetric
-	(*AigpTLVUnknown)(nil),                         // 106: apipb.AigpTLVUnknown
-	(*AigpAttribute)(nil),                          // 107: apipb.AigpAttribute
-	(*LargeCommunity)(nil),                         // 108: apipb.LargeCommunity
-	(*LargeCommunitiesAttribute)(nil),              // 109: apipb.LargeCommunitiesAttribute
-	(*LsNodeFlags)(nil),                            // 110: apipb.LsNodeFlags
-	(*LsIGPFlags)(nil),                             // 111: apipb.LsIGPFlags
-	(*LsSrRange)(nil),                              // 112: apipb.LsSrRange
-	(*LsSrCapabilities)(nil),                       // 113: apipb.LsSrCapabilities
-	(*LsSrLocalBlock)(nil),                         // 114: apipb.LsSrLocalBlock
-	(*LsAttributeNode)(nil),                        // 115: apipb.LsAttributeNode
-	(*LsAttributeLink)(nil),                        // 116: apipb.LsAttributeLink
-	(*LsAttributePrefix)(nil),                      // 117: apipb.LsAttributePrefix
-	(*LsBgpPeerSegmentSIDFlags)(nil),               // 118: apipb.LsBgpPeerSegmentSIDFlags
-	(*LsBgpPeerSegmentSID)(nil),                    // 119: apipb.LsBgpPeerSegmentSID
-	(*LsAttributeBgpPeerSegment)(nil),              // 120: apipb.LsAttributeBgpPeerSegment
-	(*LsAttribute)(nil),                            // 121: apipb.LsAttribute
-	(*UnknownAttribute)(nil),                       // 122: apipb.UnknownAttribute
-	(*SRv6StructureSubSubTLV)(nil),                 // 123: apipb.SRv6StructureSubSubTLV
-	(*SRv6SIDFlags)(nil),                           // 124: apipb.SRv6SIDFlags
-	(*SRv6TLV)(nil),                                // 125: apipb.SRv6TLV
-	(*SRv6InformationSubTLV)(nil),                  // 126: apipb.SRv6InformationSubTLV
-	(*SRv6L3ServiceTLV)(nil),                       // 127: apipb.SRv6L3ServiceTLV
-	(*SRv6L2ServiceTLV)(nil),                       // 128: apipb.SRv6L2ServiceTLV
-	(*PrefixSID)(nil),                              // 129: apipb.PrefixSID
-	nil,                                            // 130: apipb.SRv6InformationSubTLV.SubSubTlvsEntry
-	nil,                                            // 131: apipb.SRv6L3ServiceTLV.SubTlvsEntry
-	nil,                                            // 132: apipb.SRv6L2ServiceTLV.SubTlvsEntry
-	(*anypb.Any)(nil),                              // 133: google.protobuf.Any
-	(*Family)(nil),                                 // 134: apipb.Family
+func (*IPv6AddressSpecificExtended) ProtoMessage() {}
+
+func (x *IPv6AddressSpecificExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_attribute_proto_depIdxs = []int32{
-	5,   // 0: apipb.AsSegment.type:type_name -> apipb.AsSegment.Type
-	7,   // 1: apipb.AsPathAttribute.segments:type_name -> apipb.AsSegment
-	133, // 2: apipb.VPLSNLRI.rd:type_name -> google.protobuf.Any
-	133, // 3: apipb.EVPNEthernetAutoDiscoveryRoute.rd:type_name -> google.protobuf.Any
-	23,  // 4: apipb.EVPNEthernetAutoDiscoveryRoute.esi:type_name -> apipb.EthernetSegmentIdentifier
-	133, // 5: apipb.EVPNMACIPAdvertisementRoute.rd:type_name -> google.protobuf.Any
-	23,  // 6: apipb.EVPNMACIPAdvertisementRoute.esi:type_name -> apipb.EthernetSegmentIdentifier
-	133, // 7: apipb.EVPNInclusiveMulticastEthernetTagRoute.rd:type_name -> google.protobuf.Any
-	133, // 8: apipb.EVPNEthernetSegmentRoute.rd:type_name -> google.protobuf.Any
-	23,  // 9: apipb.EVPNEthernetSegmentRoute.esi:type_name -> apipb.EthernetSegmentIdentifier
-	133, // 10: apipb.EVPNIPPrefixRoute.rd:type_name -> google.protobuf.Any
-	23,  // 11: apipb.EVPNIPPrefixRoute.esi:type_name -> apipb.EthernetSegmentIdentifier
-	133, // 12: apipb.EVPNIPMSIRoute.rd:type_name -> google.protobuf.Any
-	133, // 13: apipb.EVPNIPMSIRoute.rt:type_name -> google.protobuf.Any
-	133, // 14: apipb.LabeledVPNIPAddressPrefix.rd:type_name -> google.protobuf.Any
-	133, // 15: apipb.RouteTargetMembershipNLRI.rt:type_name -> google.protobuf.Any
-	36,  // 16: apipb.FlowSpecComponent.items:type_name -> apipb.FlowSpecComponentItem
-	133, // 17: apipb.FlowSpecNLRI.rules:type_name -> google.protobuf.Any
-	133, // 18: apipb.VPNFlowSpecNLRI.rd:type_name -> google.protobuf.Any
-	133, // 19: apipb.VPNFlowSpecNLRI.rules:type_name -> google.protobuf.Any
-	0,   // 20: apipb.LsPrefixDescriptor.ospf_route_type:type_name -> apipb.LsOspfRouteType
-	41,  // 21: apipb.LsNodeNLRI.local_node:type_name -> apipb.LsNodeDescriptor
-	41,  // 22: apipb.LsLinkNLRI.local_node:type_name -> apipb.LsNodeDescriptor
-	41,  // 23: apipb.LsLinkNLRI.remote_node:type_name -> apipb.LsNodeDescriptor
-	42,  // 24: apipb.LsLinkNLRI.link_descriptor:type_name -> apipb.LsLinkDescriptor
-	41,  // 25: apipb.LsPrefixV4NLRI.local_node:type_name -> apipb.LsNodeDescriptor
-	43,  // 26: apipb.LsPrefixV4NLRI.prefix_descriptor:type_name -> apipb.LsPrefixDescriptor
-	41,  // 27: apipb.LsPrefixV6NLRI.local_node:type_name -> apipb.LsNodeDescriptor
-	43,  // 28: apipb.LsPrefixV6NLRI.prefix_descriptor:type_name -> apipb.LsPrefixDescriptor
-	1,   // 29: apipb.LsAddrPrefix.type:type_name -> apipb.LsNLRIType
-	133, // 30: apipb.LsAddrPrefix.nlri:type_name -> google.protobuf.Any
-	2,   // 31: apipb.LsAddrPrefix.protocol_id:type_name -> apipb.LsProtocolID
-	133, // 32: apipb.MUPInterworkSegmentDiscoveryRoute.rd:type_name -> google.protobuf.Any
-	133, // 33: apipb.MUPDirectSegmentDiscoveryRoute.rd:type_name -> google.protobuf.Any
-	133, // 34: apipb.MUPType1SessionTransformedRoute.rd:type_name -> google.protobuf.Any
-	133, // 35: apipb.MUPType2SessionTransformedRoute.rd:type_name -> google.protobuf.Any
-	134, // 36: apipb.MpReachNLRIAttribute.family:type_name -> apipb.Family
-	133, // 37: apipb.MpReachNLRIAttribute.nlris:type_name -> google.protobuf.Any
-	134, // 38: apipb.MpUnreachNLRIAttribute.family:type_name -> apipb.Family
-	133, // 39: apipb.MpUnreachNLRIAttribute.nlris:type_name -> google.protobuf.Any
-	133, // 40: apipb.ExtendedCommunitiesAttribute.communities:type_name -> google.protobuf.Any
-	7,   // 41: apipb.As4PathAttribute.segments:type_name -> apipb.AsSegment
-	133, // 42: apipb.TunnelEncapSubTLVSRBindingSID.bsid:type_name -> google.protobuf.Any
-	3,   // 43: apipb.SRv6EndPointBehavior.behavior:type_name -> apipb.SRv6Behavior
-	89,  // 44: apipb.SRv6BindingSID.endpoint_behavior_structure:type_name -> apipb.SRv6EndPointBehavior
-	4,   // 45: apipb.TunnelEncapSubTLVSRENLP.enlp:type_name -> apipb.ENLPType
-	93,  // 46: apipb.SegmentTypeA.flags:type_name -> apipb.SegmentFlags
-	93,  // 47: apipb.SegmentTypeB.flags:type_name -> apipb.SegmentFlags
-	89,  // 48: apipb.SegmentTypeB.endpoint_behavior_structure:type_name -> apipb.SRv6EndPointBehavior
-	92,  // 49: apipb.TunnelEncapSubTLVSRSegmentList.weight:type_name -> apipb.SRWeight
-	133, // 50: apipb.TunnelEncapSubTLVSRSegmentList.segments:type_name -> google.protobuf.Any
-	133, // 51: apipb.TunnelEncapTLV.tlvs:type_name -> google.protobuf.Any
-	100, // 52: apipb.TunnelEncapAttribute.tlvs:type_name -> apipb.TunnelEncapTLV
-	133, // 53: apipb.IP6ExtendedCommunitiesAttribute.communities:type_name -> google.protobuf.Any
-	133, // 54: apipb.AigpAttribute.tlvs:type_name -> google.protobuf.Any
-	108, // 55: apipb.LargeCommunitiesAttribute.communities:type_name -> apipb.LargeCommunity
-	112, // 56: apipb.LsSrCapabilities.ranges:type_name -> apipb.LsSrRange
-	112, // 57: apipb.LsSrLocalBlock.ranges:type_name -> apipb.LsSrRange
-	110, // 58: apipb.LsAttributeNode.flags:type_name -> apipb.LsNodeFlags
-	113, // 59: apipb.LsAttributeNode.sr_capabilities:type_name -> apipb.LsSrCapabilities
-	114, // 60: apipb.LsAttributeNode.sr_local_block:type_name -> apipb.LsSrLocalBlock
-	111, // 61: apipb.LsAttributePrefix.igp_flags:type_name -> apipb.LsIGPFlags
-	118, // 62: apipb.LsBgpPeerSegmentSID.flags:type_name -> apipb.LsBgpPeerSegmentSIDFlags
-	119, // 63: apipb.LsAttributeBgpPeerSegment.bgp_peer_node_sid:type_name -> apipb.LsBgpPeerSegmentSID
-	119, // 64: apipb.LsAttributeBgpPeerSegment.bgp_peer_adjacency_sid:type_name -> apipb.LsBgpPeerSegmentSID
-	119, // 65: apipb.LsAttributeBgpPeerSegment.bgp_peer_set_sid:type_name -> apipb.LsBgpPeerSegmentSID
-	115, // 66: apipb.LsAttribute.node:type_name -> apipb.LsAttributeNode
-	116, // 67: apipb.LsAttribute.link:type_name -> apipb.LsAttributeLink
-	117, // 68: apipb.LsAttribute.prefix:type_name -> apipb.LsAttributePrefix
-	120, // 69: apipb.LsAttribute.bgp_peer_segment:type_name -> apipb.LsAttributeBgpPeerSegment
-	133, // 70: apipb.SRv6TLV.tlv:type_name -> google.protobuf.Any
-	124, // 71: apipb.SRv6InformationSubTLV.flags:type_name -> apipb.SRv6SIDFlags
-	130, // 72: apipb.SRv6InformationSubTLV.sub_sub_tlvs:type_name -> apipb.SRv6InformationSubTLV.SubSubTlvsEntry
-	131, // 73: apipb.SRv6L3ServiceTLV.sub_tlvs:type_name -> apipb.SRv6L3ServiceTLV.SubTlvsEntry
-	132, // 74: apipb.SRv6L2ServiceTLV.sub_tlvs:type_name -> apipb.SRv6L2ServiceTLV.SubTlvsEntry
-	133, // 75: apipb.PrefixSID.tlvs:type_name -> google.protobuf.Any
-	125, // 76: apipb.SRv6InformationSubTLV.SubSubTlvsEntry.value:type_name -> apipb.SRv6TLV
-	125, // 77: apipb.SRv6L3ServiceTLV.SubTlvsEntry.value:type_name -> apipb.SRv6TLV
-	125, // 78: apipb.SRv6L2ServiceTLV.SubTlvsEntry.value:type_name -> apipb.SRv6TLV
-	79,  // [79:79] is the sub-list for method output_type
-	79,  // [79:79] is the sub-list for method input_type
-	79,  // [79:79] is the sub-list for extension type_name
-	79,  // [79:79] is the sub-list for extension extendee
-	0,   // [0:79] is the sub-list for field type_name
+
+// Deprecated: Use IPv6AddressSpecificExtended.ProtoReflect.Descriptor instead.
+func (*IPv6AddressSpecificExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{132}
 }
 
-func init() { file_attribute_proto_init() }
-func file_attribute_proto_init() {
-	if File_attribute_proto != nil {
-		return
+func (x *IPv6AddressSpecificExtended) GetIsTransitive() bool {
+	if x != nil {
+		return x.IsTransitive
 	}
-	file_gobgp_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_attribute_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OriginAttribute); i {
+	return false
+}
+
+func (x *IPv6AddressSpecificExtended) GetSubType() uint32 {
+	if x != nil {
+		return x.SubType
+	}
+	return 0
+}
+
+func (x *IPv6AddressSpecificExtended) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *IPv6AddressSpecificExtended) GetLocalAdmin() uint32 {
+	if x != nil {
+		return x.LocalAdmin
+	}
+	return 0
+}
+
+type RedirectIPv6AddressSpecificExtended struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	LocalAdmin uint32 `protobuf:"varint,2,opt,name=local_admin,json=localAdmin,proto3" json:"local_admin,omitempty"`
+}
+
+func (x *RedirectIPv6AddressSpecificExtended) Reset() {
+	*x = RedirectIPv6AddressSpecificExtended{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedirectIPv6AddressSpecificExtended) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedirectIPv6AddressSpecificExtended) ProtoMessage() {}
+
+func (x *RedirectIPv6AddressSpecificExtended) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedirectIPv6AddressSpecificExtended.ProtoReflect.Descriptor instead.
+func (*RedirectIPv6AddressSpecificExtended) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *RedirectIPv6AddressSpecificExtended) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RedirectIPv6AddressSpecificExtended) GetLocalAdmin() uint32 {
+	if x != nil {
+		return x.LocalAdmin
+	}
+	return 0
+}
+
+type IP6ExtendedCommunitiesAttribute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Each Community must be one of:
+	// - IPv6AddressSpecificExtended
+	// - RedirectIPv6AddressSpecificExtended
+	Communities []*anypb.Any `protobuf:"bytes,1,rep,name=communities,proto3" json:"communities,omitempty"`
+}
+
+func (x *IP6ExtendedCommunitiesAttribute) Reset() {
+	*x = IP6ExtendedCommunitiesAttribute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IP6ExtendedCommunitiesAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IP6ExtendedCommunitiesAttribute) ProtoMessage() {}
+
+func (x *IP6ExtendedCommunitiesAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IP6ExtendedCommunitiesAttribute.ProtoReflect.Descriptor instead.
+func (*IP6ExtendedCommunitiesAttribute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *IP6ExtendedCommunitiesAttribute) GetCommunities() []*anypb.Any {
+	if x != nil {
+		return x.Communities
+	}
+	return nil
+}
+
+type AigpTLVIGPMetric struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metric uint64 `protobuf:"varint,1,opt,name=metric,proto3" json:"metric,omitempty"`
+}
+
+func (x *AigpTLVIGPMetric) Reset() {
+	*x = AigpTLVIGPMetric{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AigpTLVIGPMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AigpTLVIGPMetric) ProtoMessage() {}
+
+func (x *AigpTLVIGPMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AigpTLVIGPMetric.ProtoReflect.Descriptor instead.
+func (*AigpTLVIGPMetric) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *AigpTLVIGPMetric) GetMetric() uint64 {
+	if x != nil {
+		return x.Metric
+	}
+	return 0
+}
+
+type AigpTLVUnknown struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  uint32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AigpTLVUnknown) Reset() {
+	*x = AigpTLVUnknown{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AigpTLVUnknown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AigpTLVUnknown) ProtoMessage() {}
+
+func (x *AigpTLVUnknown) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AigpTLVUnknown.ProtoReflect.Descriptor instead.
+func (*AigpTLVUnknown) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *AigpTLVUnknown) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *AigpTLVUnknown) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type AigpAttribute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Each TLV must be one of:
+	// - AigpTLVIGPMetric
+	// - AigpTLVUnknown
+	Tlvs []*anypb.Any `protobuf:"bytes,1,rep,name=tlvs,proto3" json:"tlvs,omitempty"`
+}
+
+func (x *AigpAttribute) Reset() {
+	*x = AigpAttribute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AigpAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AigpAttribute) ProtoMessage() {}
+
+func (x *AigpAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AigpAttribute.ProtoReflect.Descriptor instead.
+func (*AigpAttribute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *AigpAttribute) GetTlvs() []*anypb.Any {
+	if x != nil {
+		return x.Tlvs
+	}
+	return nil
+}
+
+type LargeCommunity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GlobalAdmin uint32 `protobuf:"varint,1,opt,name=global_admin,json=globalAdmin,proto3" json:"global_admin,omitempty"`
+	LocalData1  uint32 `protobuf:"varint,2,opt,name=local_data1,json=localData1,proto3" json:"local_data1,omitempty"`
+	LocalData2  uint32 `protobuf:"varint,3,opt,name=local_data2,json=localData2,proto3" json:"local_data2,omitempty"`
+}
+
+func (x *LargeCommunity) Reset() {
+	*x = LargeCommunity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LargeCommunity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LargeCommunity) ProtoMessage() {}
+
+func (x *LargeCommunity) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LargeCommunity.ProtoReflect.Descriptor instead.
+func (*LargeCommunity) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *LargeCommunity) GetGlobalAdmin() uint32 {
+	if x != nil {
+		return x.GlobalAdmin
+	}
+	return 0
+}
+
+func (x *LargeCommunity) GetLocalData1() uint32 {
+	if x != nil {
+		return x.LocalData1
+	}
+	return 0
+}
+
+func (x *LargeCommunity) GetLocalData2() uint32 {
+	if x != nil {
+		return x.LocalData2
+	}
+	return 0
+}
+
+type LargeCommunitiesAttribute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Communities []*LargeCommunity `protobuf:"bytes,1,rep,name=communities,proto3" json:"communities,omitempty"`
+}
+
+func (x *LargeCommunitiesAttribute) Reset() {
+	*x = LargeCommunitiesAttribute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LargeCommunitiesAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LargeCommunitiesAttribute) ProtoMessage() {}
+
+func (x *LargeCommunitiesAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LargeCommunitiesAttribute.ProtoReflect.Descriptor instead.
+func (*LargeCommunitiesAttribute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *LargeCommunitiesAttribute) GetCommunities() []*LargeCommunity {
+	if x != nil {
+		return x.Communities
+	}
+	return nil
+}
+
+type LsNodeFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Overload bool `protobuf:"varint,1,opt,name=overload,proto3" json:"overload,omitempty"`
+	Attached bool `protobuf:"varint,2,opt,name=attached,proto3" json:"attached,omitempty"`
+	External bool `protobuf:"varint,3,opt,name=external,proto3" json:"external,omitempty"`
+	Abr      bool `protobuf:"varint,4,opt,name=abr,proto3" json:"abr,omitempty"`
+	Router   bool `protobuf:"varint,5,opt,name=router,proto3" json:"router,omitempty"`
+	V6       bool `protobuf:"varint,6,opt,name=v6,proto3" json:"v6,omitempty"`
+}
+
+func (x *LsNodeFlags) Reset() {
+	*x = LsNodeFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsNodeFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsNodeFlags) ProtoMessage() {}
+
+func (x *LsNodeFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsNodeFlags.ProtoReflect.Descriptor instead.
+func (*LsNodeFlags) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *LsNodeFlags) GetOverload() bool {
+	if x != nil {
+		return x.Overload
+	}
+	return false
+}
+
+func (x *LsNodeFlags) GetAttached() bool {
+	if x != nil {
+		return x.Attached
+	}
+	return false
+}
+
+func (x *LsNodeFlags) GetExternal() bool {
+	if x != nil {
+		return x.External
+	}
+	return false
+}
+
+func (x *LsNodeFlags) GetAbr() bool {
+	if x != nil {
+		return x.Abr
+	}
+	return false
+}
+
+func (x *LsNodeFlags) GetRouter() bool {
+	if x != nil {
+		return x.Router
+	}
+	return false
+}
+
+func (x *LsNodeFlags) GetV6() bool {
+	if x != nil {
+		return x.V6
+	}
+	return false
+}
+
+type LsIGPFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Down          bool `protobuf:"varint,1,opt,name=down,proto3" json:"down,omitempty"`
+	NoUnicast     bool `protobuf:"varint,2,opt,name=no_unicast,json=noUnicast,proto3" json:"no_unicast,omitempty"`
+	LocalAddress  bool `protobuf:"varint,3,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
+	PropagateNssa bool `protobuf:"varint,4,opt,name=propagate_nssa,json=propagateNssa,proto3" json:"propagate_nssa,omitempty"`
+}
+
+func (x *LsIGPFlags) Reset() {
+	*x = LsIGPFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsIGPFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsIGPFlags) ProtoMessage() {}
+
+func (x *LsIGPFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsIGPFlags.ProtoReflect.Descriptor instead.
+func (*LsIGPFlags) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *LsIGPFlags) GetDown() bool {
+	if x != nil {
+		return x.Down
+	}
+	return false
+}
+
+func (x *LsIGPFlags) GetNoUnicast() bool {
+	if x != nil {
+		return x.NoUnicast
+	}
+	return false
+}
+
+func (x *LsIGPFlags) GetLocalAddress() bool {
+	if x != nil {
+		return x.LocalAddress
+	}
+	return false
+}
+
+func (x *LsIGPFlags) GetPropagateNssa() bool {
+	if x != nil {
+		return x.PropagateNssa
+	}
+	return false
+}
+
+type LsSrRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Begin uint32 `protobuf:"varint,1,opt,name=begin,proto3" json:"begin,omitempty"`
+	End   uint32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *LsSrRange) Reset() {
+	*x = LsSrRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsSrRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsSrRange) ProtoMessage() {}
+
+func (x *LsSrRange) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsSrRange.ProtoReflect.Descriptor instead.
+func (*LsSrRange) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *LsSrRange) GetBegin() uint32 {
+	if x != nil {
+		return x.Begin
+	}
+	return 0
+}
+
+func (x *LsSrRange) GetEnd() uint32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type LsSrCapabilities struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ipv4Supported bool         `protobuf:"varint,1,opt,name=ipv4_supported,json=ipv4Supported,proto3" json:"ipv4_supported,omitempty"`
+	Ipv6Supported bool         `protobuf:"varint,2,opt,name=ipv6_supported,json=ipv6Supported,proto3" json:"ipv6_supported,omitempty"`
+	Ranges        []*LsSrRange `protobuf:"bytes,3,rep,name=ranges,proto3" json:"ranges,omitempty"`
+}
+
+func (x *LsSrCapabilities) Reset() {
+	*x = LsSrCapabilities{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsSrCapabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsSrCapabilities) ProtoMessage() {}
+
+func (x *LsSrCapabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsSrCapabilities.ProtoReflect.Descriptor instead.
+func (*LsSrCapabilities) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *LsSrCapabilities) GetIpv4Supported() bool {
+	if x != nil {
+		return x.Ipv4Supported
+	}
+	return false
+}
+
+func (x *LsSrCapabilities) GetIpv6Supported() bool {
+	if x != nil {
+		return x.Ipv6Supported
+	}
+	return false
+}
+
+func (x *LsSrCapabilities) GetRanges() []*LsSrRange {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+type LsSrLocalBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ranges []*LsSrRange `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges,omitempty"`
+}
+
+func (x *LsSrLocalBlock) Reset() {
+	*x = LsSrLocalBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsSrLocalBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsSrLocalBlock) ProtoMessage() {}
+
+func (x *LsSrLocalBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsSrLocalBlock.ProtoReflect.Descriptor instead.
+func (*LsSrLocalBlock) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *LsSrLocalBlock) GetRanges() []*LsSrRange {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+type LsAttributeNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Flags           *LsNodeFlags      `protobuf:"bytes,2,opt,name=flags,proto3" json:"flags,omitempty"`
+	LocalRouterId   string            `protobuf:"bytes,3,opt,name=local_router_id,json=localRouterId,proto3" json:"local_router_id,omitempty"`
+	LocalRouterIdV6 string            `protobuf:"bytes,4,opt,name=local_router_id_v6,json=localRouterIdV6,proto3" json:"local_router_id_v6,omitempty"`
+	IsisArea        []byte            `protobuf:"bytes,5,opt,name=isis_area,json=isisArea,proto3" json:"isis_area,omitempty"`
+	Opaque          []byte            `protobuf:"bytes,6,opt,name=opaque,proto3" json:"opaque,omitempty"`
+	SrCapabilities  *LsSrCapabilities `protobuf:"bytes,7,opt,name=sr_capabilities,json=srCapabilities,proto3" json:"sr_capabilities,omitempty"`
+	SrAlgorithms    []byte            `protobuf:"bytes,8,opt,name=sr_algorithms,json=srAlgorithms,proto3" json:"sr_algorithms,omitempty"`
+	SrLocalBlock    *LsSrLocalBlock   `protobuf:"bytes,9,opt,name=sr_local_block,json=srLocalBlock,proto3" json:"sr_local_block,omitempty"`
+}
+
+func (x *LsAttributeNode) Reset() {
+	*x = LsAttributeNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsAttributeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsAttributeNode) ProtoMessage() {}
+
+func (x *LsAttributeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsAttributeNode.ProtoReflect.Descriptor instead.
+func (*LsAttributeNode) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *LsAttributeNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LsAttributeNode) GetFlags() *LsNodeFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *LsAttributeNode) GetLocalRouterId() string {
+	if x != nil {
+		return x.LocalRouterId
+	}
+	return ""
+}
+
+func (x *LsAttributeNode) GetLocalRouterIdV6() string {
+	if x != nil {
+		return x.LocalRouterIdV6
+	}
+	return ""
+}
+
+func (x *LsAttributeNode) GetIsisArea() []byte {
+	if x != nil {
+		return x.IsisArea
+	}
+	return nil
+}
+
+func (x *LsAttributeNode) GetOpaque() []byte {
+	if x != nil {
+		return x.Opaque
+	}
+	return nil
+}
+
+func (x *LsAttributeNode) GetSrCapabilities() *LsSrCapabilities {
+	if x != nil {
+		return x.SrCapabilities
+	}
+	return nil
+}
+
+func (x *LsAttributeNode) GetSrAlgorithms() []byte {
+	if x != nil {
+		return x.SrAlgorithms
+	}
+	return nil
+}
+
+func (x *LsAttributeNode) GetSrLocalBlock() *LsSrLocalBlock {
+	if x != nil {
+		return x.SrLocalBlock
+	}
+	return nil
+}
+
+type LsAttributeLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LocalRouterId       string    `protobuf:"bytes,2,opt,name=local_router_id,json=localRouterId,proto3" json:"local_router_id,omitempty"`
+	LocalRouterIdV6     string    `protobuf:"bytes,3,opt,name=local_router_id_v6,json=localRouterIdV6,proto3" json:"local_router_id_v6,omitempty"`
+	RemoteRouterId      string    `protobuf:"bytes,4,opt,name=remote_router_id,json=remoteRouterId,proto3" json:"remote_router_id,omitempty"`
+	RemoteRouterIdV6    string    `protobuf:"bytes,5,opt,name=remote_router_id_v6,json=remoteRouterIdV6,proto3" json:"remote_router_id_v6,omitempty"`
+	AdminGroup          uint32    `protobuf:"varint,6,opt,name=admin_group,json=adminGroup,proto3" json:"admin_group,omitempty"`
+	DefaultTeMetric     uint32    `protobuf:"varint,7,opt,name=default_te_metric,json=defaultTeMetric,proto3" json:"default_te_metric,omitempty"`
+	IgpMetric           uint32    `protobuf:"varint,8,opt,name=igp_metric,json=igpMetric,proto3" json:"igp_metric,omitempty"`
+	Opaque              []byte    `protobuf:"bytes,9,opt,name=opaque,proto3" json:"opaque,omitempty"`
+	Bandwidth           float32   `protobuf:"fixed32,10,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	ReservableBandwidth float32   `protobuf:"fixed32,11,opt,name=reservable_bandwidth,json=reservableBandwidth,proto3" json:"reservable_bandwidth,omitempty"`
+	UnreservedBandwidth []float32 `protobuf:"fixed32,12,rep,packed,name=unreserved_bandwidth,json=unreservedBandwidth,proto3" json:"unreserved_bandwidth,omitempty"`
+	SrAdjacencySid      uint32    `protobuf:"varint,13,opt,name=sr_adjacency_sid,json=srAdjacencySid,proto3" json:"sr_adjacency_sid,omitempty"`
+	Srlgs               []uint32  `protobuf:"varint,14,rep,packed,name=srlgs,proto3" json:"srlgs,omitempty"`
+}
+
+func (x *LsAttributeLink) Reset() {
+	*x = LsAttributeLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsAttributeLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsAttributeLink) ProtoMessage() {}
+
+func (x *LsAttributeLink) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsAttributeLink.ProtoReflect.Descriptor instead.
+func (*LsAttributeLink) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *LsAttributeLink) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LsAttributeLink) GetLocalRouterId() string {
+	if x != nil {
+		return x.LocalRouterId
+	}
+	return ""
+}
+
+func (x *LsAttributeLink) GetLocalRouterIdV6() string {
+	if x != nil {
+		return x.LocalRouterIdV6
+	}
+	return ""
+}
+
+func (x *LsAttributeLink) GetRemoteRouterId() string {
+	if x != nil {
+		return x.RemoteRouterId
+	}
+	return ""
+}
+
+func (x *LsAttributeLink) GetRemoteRouterIdV6() string {
+	if x != nil {
+		return x.RemoteRouterIdV6
+	}
+	return ""
+}
+
+func (x *LsAttributeLink) GetAdminGroup() uint32 {
+	if x != nil {
+		return x.AdminGroup
+	}
+	return 0
+}
+
+func (x *LsAttributeLink) GetDefaultTeMetric() uint32 {
+	if x != nil {
+		return x.DefaultTeMetric
+	}
+	return 0
+}
+
+func (x *LsAttributeLink) GetIgpMetric() uint32 {
+	if x != nil {
+		return x.IgpMetric
+	}
+	return 0
+}
+
+func (x *LsAttributeLink) GetOpaque() []byte {
+	if x != nil {
+		return x.Opaque
+	}
+	return nil
+}
+
+func (x *LsAttributeLink) GetBandwidth() float32 {
+	if x != nil {
+		return x.Bandwidth
+	}
+	return 0
+}
+
+func (x *LsAttributeLink) GetReservableBandwidth() float32 {
+	if x != nil {
+		return x.ReservableBandwidth
+	}
+	return 0
+}
+
+func (x *LsAttributeLink) GetUnreservedBandwidth() []float32 {
+	if x != nil {
+		return x.UnreservedBandwidth
+	}
+	return nil
+}
+
+func (x *LsAttributeLink) GetSrAdjacencySid() uint32 {
+	if x != nil {
+		return x.SrAdjacencySid
+	}
+	return 0
+}
+
+func (x *LsAttributeLink) GetSrlgs() []uint32 {
+	if x != nil {
+		return x.Srlgs
+	}
+	return nil
+}
+
+type LsAttributePrefix struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IgpFlags    *LsIGPFlags `protobuf:"bytes,1,opt,name=igp_flags,json=igpFlags,proto3" json:"igp_flags,omitempty"`
+	Opaque      []byte      `protobuf:"bytes,2,opt,name=opaque,proto3" json:"opaque,omitempty"`
+	SrPrefixSid uint32      `protobuf:"varint,3,opt,name=sr_prefix_sid,json=srPrefixSid,proto3" json:"sr_prefix_sid,omitempty"`
+}
+
+func (x *LsAttributePrefix) Reset() {
+	*x = LsAttributePrefix{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsAttributePrefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsAttributePrefix) ProtoMessage() {}
+
+func (x *LsAttributePrefix) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsAttributePrefix.ProtoReflect.Descriptor instead.
+func (*LsAttributePrefix) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *LsAttributePrefix) GetIgpFlags() *LsIGPFlags {
+	if x != nil {
+		return x.IgpFlags
+	}
+	return nil
+}
+
+func (x *LsAttributePrefix) GetOpaque() []byte {
+	if x != nil {
+		return x.Opaque
+	}
+	return nil
+}
+
+func (x *LsAttributePrefix) GetSrPrefixSid() uint32 {
+	if x != nil {
+		return x.SrPrefixSid
+	}
+	return 0
+}
+
+type LsBgpPeerSegmentSIDFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value      bool `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Local      bool `protobuf:"varint,2,opt,name=local,proto3" json:"local,omitempty"`
+	Backup     bool `protobuf:"varint,3,opt,name=backup,proto3" json:"backup,omitempty"`
+	Persistent bool `protobuf:"varint,4,opt,name=persistent,proto3" json:"persistent,omitempty"`
+}
+
+func (x *LsBgpPeerSegmentSIDFlags) Reset() {
+	*x = LsBgpPeerSegmentSIDFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsBgpPeerSegmentSIDFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsBgpPeerSegmentSIDFlags) ProtoMessage() {}
+
+func (x *LsBgpPeerSegmentSIDFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsBgpPeerSegmentSIDFlags.ProtoReflect.Descriptor instead.
+func (*LsBgpPeerSegmentSIDFlags) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *LsBgpPeerSegmentSIDFlags) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+func (x *LsBgpPeerSegmentSIDFlags) GetLocal() bool {
+	if x != nil {
+		return x.Local
+	}
+	return false
+}
+
+func (x *LsBgpPeerSegmentSIDFlags) GetBackup() bool {
+	if x != nil {
+		return x.Backup
+	}
+	return false
+}
+
+func (x *LsBgpPeerSegmentSIDFlags) GetPersistent() bool {
+	if x != nil {
+		return x.Persistent
+	}
+	return false
+}
+
+type LsBgpPeerSegmentSID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags  *LsBgpPeerSegmentSIDFlags `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Weight uint32                    `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	Sid    uint32                    `protobuf:"varint,3,opt,name=sid,proto3" json:"sid,omitempty"`
+}
+
+func (x *LsBgpPeerSegmentSID) Reset() {
+	*x = LsBgpPeerSegmentSID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsBgpPeerSegmentSID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsBgpPeerSegmentSID) ProtoMessage() {}
+
+func (x *LsBgpPeerSegmentSID) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsBgpPeerSegmentSID.ProtoReflect.Descriptor instead.
+func (*LsBgpPeerSegmentSID) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *LsBgpPeerSegmentSID) GetFlags() *LsBgpPeerSegmentSIDFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *LsBgpPeerSegmentSID) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *LsBgpPeerSegmentSID) GetSid() uint32 {
+	if x != nil {
+		return x.Sid
+	}
+	return 0
+}
+
+type LsAttributeBgpPeerSegment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BgpPeerNodeSid      *LsBgpPeerSegmentSID `protobuf:"bytes,1,opt,name=bgp_peer_node_sid,json=bgpPeerNodeSid,proto3" json:"bgp_peer_node_sid,omitempty"`
+	BgpPeerAdjacencySid *LsBgpPeerSegmentSID `protobuf:"bytes,2,opt,name=bgp_peer_adjacency_sid,json=bgpPeerAdjacencySid,proto3" json:"bgp_peer_adjacency_sid,omitempty"`
+	BgpPeerSetSid       *LsBgpPeerSegmentSID `protobuf:"bytes,3,opt,name=bgp_peer_set_sid,json=bgpPeerSetSid,proto3" json:"bgp_peer_set_sid,omitempty"`
+}
+
+func (x *LsAttributeBgpPeerSegment) Reset() {
+	*x = LsAttributeBgpPeerSegment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsAttributeBgpPeerSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsAttributeBgpPeerSegment) ProtoMessage() {}
+
+func (x *LsAttributeBgpPeerSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsAttributeBgpPeerSegment.ProtoReflect.Descriptor instead.
+func (*LsAttributeBgpPeerSegment) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *LsAttributeBgpPeerSegment) GetBgpPeerNodeSid() *LsBgpPeerSegmentSID {
+	if x != nil {
+		return x.BgpPeerNodeSid
+	}
+	return nil
+}
+
+func (x *LsAttributeBgpPeerSegment) GetBgpPeerAdjacencySid() *LsBgpPeerSegmentSID {
+	if x != nil {
+		return x.BgpPeerAdjacencySid
+	}
+	return nil
+}
+
+func (x *LsAttributeBgpPeerSegment) GetBgpPeerSetSid() *LsBgpPeerSegmentSID {
+	if x != nil {
+		return x.BgpPeerSetSid
+	}
+	return nil
+}
+
+type LsSrBindingSIDFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Srv6        bool `protobuf:"varint,1,opt,name=srv6,proto3" json:"srv6,omitempty"`
+	Allocated   bool `protobuf:"varint,2,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	Unavailable bool `protobuf:"varint,3,opt,name=unavailable,proto3" json:"unavailable,omitempty"`
+	Local       bool `protobuf:"varint,4,opt,name=local,proto3" json:"local,omitempty"`
+	Fallback    bool `protobuf:"varint,5,opt,name=fallback,proto3" json:"fallback,omitempty"`
+}
+
+func (x *LsSrBindingSIDFlags) Reset() {
+	*x = LsSrBindingSIDFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsSrBindingSIDFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsSrBindingSIDFlags) ProtoMessage() {}
+
+func (x *LsSrBindingSIDFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsSrBindingSIDFlags.ProtoReflect.Descriptor instead.
+func (*LsSrBindingSIDFlags) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *LsSrBindingSIDFlags) GetSrv6() bool {
+	if x != nil {
+		return x.Srv6
+	}
+	return false
+}
+
+func (x *LsSrBindingSIDFlags) GetAllocated() bool {
+	if x != nil {
+		return x.Allocated
+	}
+	return false
+}
+
+func (x *LsSrBindingSIDFlags) GetUnavailable() bool {
+	if x != nil {
+		return x.Unavailable
+	}
+	return false
+}
+
+func (x *LsSrBindingSIDFlags) GetLocal() bool {
+	if x != nil {
+		return x.Local
+	}
+	return false
+}
+
+func (x *LsSrBindingSIDFlags) GetFallback() bool {
+	if x != nil {
+		return x.Fallback
+	}
+	return false
+}
+
+// The SIDs are the 4-octet label fields of SR-MPLS or the 16-octet SIDs of
+// SRv6.
+type LsSrBindingSID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags               *LsSrBindingSIDFlags `protobuf:"bytes,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	BindingSid          []byte               `protobuf:"bytes,2,opt,name=binding_sid,json=bindingSid,proto3" json:"binding_sid,omitempty"`
+	SpecifiedBindingSid []byte               `protobuf:"bytes,3,opt,name=specified_binding_sid,json=specifiedBindingSid,proto3" json:"specified_binding_sid,omitempty"`
+}
+
+func (x *LsSrBindingSID) Reset() {
+	*x = LsSrBindingSID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsSrBindingSID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsSrBindingSID) ProtoMessage() {}
+
+func (x *LsSrBindingSID) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsSrBindingSID.ProtoReflect.Descriptor instead.
+func (*LsSrBindingSID) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *LsSrBindingSID) GetFlags() *LsSrBindingSIDFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *LsSrBindingSID) GetBindingSid() []byte {
+	if x != nil {
+		return x.BindingSid
+	}
+	return nil
+}
+
+func (x *LsSrBindingSID) GetSpecifiedBindingSid() []byte {
+	if x != nil {
+		return x.SpecifiedBindingSid
+	}
+	return nil
+}
+
+type LsSrCandidatePathStateFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shutdown  bool `protobuf:"varint,1,opt,name=shutdown,proto3" json:"shutdown,omitempty"`
+	Active    bool `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	Backup    bool `protobuf:"varint,3,opt,name=backup,proto3" json:"backup,omitempty"`
+	Evaluated bool `protobuf:"varint,4,opt,name=evaluated,proto3" json:"evaluated,omitempty"`
+	Valid     bool `protobuf:"varint,5,opt,name=valid,proto3" json:"valid,omitempty"`
+	Delegated bool `protobuf:"varint,6,opt,name=delegated,proto3" json:"delegated,omitempty"`
+}
+
+func (x *LsSrCandidatePathStateFlags) Reset() {
+	*x = LsSrCandidatePathStateFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsSrCandidatePathStateFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsSrCandidatePathStateFlags) ProtoMessage() {}
+
+func (x *LsSrCandidatePathStateFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsSrCandidatePathStateFlags.ProtoReflect.Descriptor instead.
+func (*LsSrCandidatePathStateFlags) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *LsSrCandidatePathStateFlags) GetShutdown() bool {
+	if x != nil {
+		return x.Shutdown
+	}
+	return false
+}
+
+func (x *LsSrCandidatePathStateFlags) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *LsSrCandidatePathStateFlags) GetBackup() bool {
+	if x != nil {
+		return x.Backup
+	}
+	return false
+}
+
+func (x *LsSrCandidatePathStateFlags) GetEvaluated() bool {
+	if x != nil {
+		return x.Evaluated
+	}
+	return false
+}
+
+func (x *LsSrCandidatePathStateFlags) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *LsSrCandidatePathStateFlags) GetDelegated() bool {
+	if x != nil {
+		return x.Delegated
+	}
+	return false
+}
+
+type LsSrCandidatePathState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Priority   uint32                       `protobuf:"varint,1,opt,name=priority,proto3" json:"priority,omitempty"`
+	Flags      *LsSrCandidatePathStateFlags `protobuf:"bytes,2,opt,name=flags,proto3" json:"flags,omitempty"`
+	Preference uint32                       `protobuf:"varint,3,opt,name=preference,proto3" json:"preference,omitempty"`
+}
+
+func (x *LsSrCandidatePathState) Reset() {
+	*x = LsSrCandidatePathState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsSrCandidatePathState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsSrCandidatePathState) ProtoMessage() {}
+
+func (x *LsSrCandidatePathState) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsSrCandidatePathState.ProtoReflect.Descriptor instead.
+func (*LsSrCandidatePathState) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *LsSrCandidatePathState) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *LsSrCandidatePathState) GetFlags() *LsSrCandidatePathStateFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *LsSrCandidatePathState) GetPreference() uint32 {
+	if x != nil {
+		return x.Preference
+	}
+	return 0
+}
+
+type LsSrSegment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type              uint32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Flags             uint32 `protobuf:"varint,2,opt,name=flags,proto3" json:"flags,omitempty"`
+	Sid               []byte `protobuf:"bytes,3,opt,name=sid,proto3" json:"sid,omitempty"`
+	SegmentDescriptor []byte `protobuf:"bytes,4,opt,name=segment_descriptor,json=segmentDescriptor,proto3" json:"segment_descriptor,omitempty"`
+}
+
+func (x *LsSrSegment) Reset() {
+	*x = LsSrSegment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsSrSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsSrSegment) ProtoMessage() {}
+
+func (x *LsSrSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsSrSegment.ProtoReflect.Descriptor instead.
+func (*LsSrSegment) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *LsSrSegment) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *LsSrSegment) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *LsSrSegment) GetSid() []byte {
+	if x != nil {
+		return x.Sid
+	}
+	return nil
+}
+
+func (x *LsSrSegment) GetSegmentDescriptor() []byte {
+	if x != nil {
+		return x.SegmentDescriptor
+	}
+	return nil
+}
+
+type LsSrSegmentList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags     uint32         `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Mtid      uint32         `protobuf:"varint,2,opt,name=mtid,proto3" json:"mtid,omitempty"`
+	Algorithm uint32         `protobuf:"varint,3,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Weight    uint32         `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	Segments  []*LsSrSegment `protobuf:"bytes,5,rep,name=segments,proto3" json:"segments,omitempty"`
+}
+
+func (x *LsSrSegmentList) Reset() {
+	*x = LsSrSegmentList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsSrSegmentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsSrSegmentList) ProtoMessage() {}
+
+func (x *LsSrSegmentList) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsSrSegmentList.ProtoReflect.Descriptor instead.
+func (*LsSrSegmentList) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *LsSrSegmentList) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *LsSrSegmentList) GetMtid() uint32 {
+	if x != nil {
+		return x.Mtid
+	}
+	return 0
+}
+
+func (x *LsSrSegmentList) GetAlgorithm() uint32 {
+	if x != nil {
+		return x.Algorithm
+	}
+	return 0
+}
+
+func (x *LsSrSegmentList) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *LsSrSegmentList) GetSegments() []*LsSrSegment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+// https://www.rfc-editor.org/rfc/rfc9857.html#section-5.6
+type LsAttributeSrPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BindingSid         *LsSrBindingSID         `protobuf:"bytes,1,opt,name=binding_sid,json=bindingSid,proto3" json:"binding_sid,omitempty"`
+	CandidatePathState *LsSrCandidatePathState `protobuf:"bytes,2,opt,name=candidate_path_state,json=candidatePathState,proto3" json:"candidate_path_state,omitempty"`
+	CandidatePathName  string                  `protobuf:"bytes,3,opt,name=candidate_path_name,json=candidatePathName,proto3" json:"candidate_path_name,omitempty"`
+	PolicyName         string                  `protobuf:"bytes,4,opt,name=policy_name,json=policyName,proto3" json:"policy_name,omitempty"`
+	SegmentLists       []*LsSrSegmentList      `protobuf:"bytes,5,rep,name=segment_lists,json=segmentLists,proto3" json:"segment_lists,omitempty"`
+}
+
+func (x *LsAttributeSrPolicy) Reset() {
+	*x = LsAttributeSrPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsAttributeSrPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsAttributeSrPolicy) ProtoMessage() {}
+
+func (x *LsAttributeSrPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsAttributeSrPolicy.ProtoReflect.Descriptor instead.
+func (*LsAttributeSrPolicy) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *LsAttributeSrPolicy) GetBindingSid() *LsSrBindingSID {
+	if x != nil {
+		return x.BindingSid
+	}
+	return nil
+}
+
+func (x *LsAttributeSrPolicy) GetCandidatePathState() *LsSrCandidatePathState {
+	if x != nil {
+		return x.CandidatePathState
+	}
+	return nil
+}
+
+func (x *LsAttributeSrPolicy) GetCandidatePathName() string {
+	if x != nil {
+		return x.CandidatePathName
+	}
+	return ""
+}
+
+func (x *LsAttributeSrPolicy) GetPolicyName() string {
+	if x != nil {
+		return x.PolicyName
+	}
+	return ""
+}
+
+func (x *LsAttributeSrPolicy) GetSegmentLists() []*LsSrSegmentList {
+	if x != nil {
+		return x.SegmentLists
+	}
+	return nil
+}
+
+type LsAttribute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node           *LsAttributeNode           `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Link           *LsAttributeLink           `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+	Prefix         *LsAttributePrefix         `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	BgpPeerSegment *LsAttributeBgpPeerSegment `protobuf:"bytes,4,opt,name=bgp_peer_segment,json=bgpPeerSegment,proto3" json:"bgp_peer_segment,omitempty"`
+	SrPolicy       *LsAttributeSrPolicy       `protobuf:"bytes,5,opt,name=sr_policy,json=srPolicy,proto3" json:"sr_policy,omitempty"`
+}
+
+func (x *LsAttribute) Reset() {
+	*x = LsAttribute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LsAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LsAttribute) ProtoMessage() {}
+
+func (x *LsAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LsAttribute.ProtoReflect.Descriptor instead.
+func (*LsAttribute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *LsAttribute) GetNode() *LsAttributeNode {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *LsAttribute) GetLink() *LsAttributeLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+func (x *LsAttribute) GetPrefix() *LsAttributePrefix {
+	if x != nil {
+		return x.Prefix
+	}
+	return nil
+}
+
+func (x *LsAttribute) GetBgpPeerSegment() *LsAttributeBgpPeerSegment {
+	if x != nil {
+		return x.BgpPeerSegment
+	}
+	return nil
+}
+
+func (x *LsAttribute) GetSrPolicy() *LsAttributeSrPolicy {
+	if x != nil {
+		return x.SrPolicy
+	}
+	return nil
+}
+
+type UnknownAttribute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags uint32 `protobuf:"varint,1,opt,name=flags,proto3" json:"flags,omitempty"`
+	Type  uint32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *UnknownAttribute) Reset() {
+	*x = UnknownAttribute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnknownAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnknownAttribute) ProtoMessage() {}
+
+func (x *UnknownAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnknownAttribute.ProtoReflect.Descriptor instead.
+func (*UnknownAttribute) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *UnknownAttribute) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *UnknownAttribute) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *UnknownAttribute) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// https://www.rfc-editor.org/rfc/rfc9252.html#section-3.2.1
+type SRv6StructureSubSubTLV struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocatorBlockLength  uint32 `protobuf:"varint,1,opt,name=locator_block_length,json=locatorBlockLength,proto3" json:"locator_block_length,omitempty"`
+	LocatorNodeLength   uint32 `protobuf:"varint,2,opt,name=locator_node_length,json=locatorNodeLength,proto3" json:"locator_node_length,omitempty"`
+	FunctionLength      uint32 `protobuf:"varint,3,opt,name=function_length,json=functionLength,proto3" json:"function_length,omitempty"`
+	ArgumentLength      uint32 `protobuf:"varint,4,opt,name=argument_length,json=argumentLength,proto3" json:"argument_length,omitempty"`
+	TranspositionLength uint32 `protobuf:"varint,5,opt,name=transposition_length,json=transpositionLength,proto3" json:"transposition_length,omitempty"`
+	TranspositionOffset uint32 `protobuf:"varint,6,opt,name=transposition_offset,json=transpositionOffset,proto3" json:"transposition_offset,omitempty"`
+}
+
+func (x *SRv6StructureSubSubTLV) Reset() {
+	*x = SRv6StructureSubSubTLV{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SRv6StructureSubSubTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRv6StructureSubSubTLV) ProtoMessage() {}
+
+func (x *SRv6StructureSubSubTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRv6StructureSubSubTLV.ProtoReflect.Descriptor instead.
+func (*SRv6StructureSubSubTLV) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *SRv6StructureSubSubTLV) GetLocatorBlockLength() uint32 {
+	if x != nil {
+		return x.LocatorBlockLength
+	}
+	return 0
+}
+
+func (x *SRv6StructureSubSubTLV) GetLocatorNodeLength() uint32 {
+	if x != nil {
+		return x.LocatorNodeLength
+	}
+	return 0
+}
+
+func (x *SRv6StructureSubSubTLV) GetFunctionLength() uint32 {
+	if x != nil {
+		return x.FunctionLength
+	}
+	return 0
+}
+
+func (x *SRv6StructureSubSubTLV) GetArgumentLength() uint32 {
+	if x != nil {
+		return x.ArgumentLength
+	}
+	return 0
+}
+
+func (x *SRv6StructureSubSubTLV) GetTranspositionLength() uint32 {
+	if x != nil {
+		return x.TranspositionLength
+	}
+	return 0
+}
+
+func (x *SRv6StructureSubSubTLV) GetTranspositionOffset() uint32 {
+	if x != nil {
+		return x.TranspositionOffset
+	}
+	return 0
+}
+
+type SRv6SIDFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Placeholder for future sid flags
+	Flag_1 bool `protobuf:"varint,1,opt,name=flag_1,json=flag1,proto3" json:"flag_1,omitempty"`
+}
+
+func (x *SRv6SIDFlags) Reset() {
+	*x = SRv6SIDFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SRv6SIDFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRv6SIDFlags) ProtoMessage() {}
+
+func (x *SRv6SIDFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRv6SIDFlags.ProtoReflect.Descriptor instead.
+func (*SRv6SIDFlags) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *SRv6SIDFlags) GetFlag_1() bool {
+	if x != nil {
+		return x.Flag_1
+	}
+	return false
+}
+
+type SRv6TLV struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tlv []*anypb.Any `protobuf:"bytes,1,rep,name=tlv,proto3" json:"tlv,omitempty"`
+}
+
+func (x *SRv6TLV) Reset() {
+	*x = SRv6TLV{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SRv6TLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRv6TLV) ProtoMessage() {}
+
+func (x *SRv6TLV) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRv6TLV.ProtoReflect.Descriptor instead.
+func (*SRv6TLV) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *SRv6TLV) GetTlv() []*anypb.Any {
+	if x != nil {
+		return x.Tlv
+	}
+	return nil
+}
+
+// https://tools.ietf.org/html/draft-dawra-bess-srv6-services-02#section-2.1.1
+type SRv6InformationSubTLV struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sid              []byte        `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`
+	Flags            *SRv6SIDFlags `protobuf:"bytes,2,opt,name=flags,proto3" json:"flags,omitempty"`
+	EndpointBehavior uint32        `protobuf:"varint,3,opt,name=endpoint_behavior,json=endpointBehavior,proto3" json:"endpoint_behavior,omitempty"`
+	// SRv6TLV is one of:
+	// - SRv6StructureSubSubTLV
+	SubSubTlvs map[uint32]*SRv6TLV `protobuf:"bytes,4,rep,name=sub_sub_tlvs,json=subSubTlvs,proto3" json:"sub_sub_tlvs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *SRv6InformationSubTLV) Reset() {
+	*x = SRv6InformationSubTLV{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SRv6InformationSubTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRv6InformationSubTLV) ProtoMessage() {}
+
+func (x *SRv6InformationSubTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRv6InformationSubTLV.ProtoReflect.Descriptor instead.
+func (*SRv6InformationSubTLV) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *SRv6InformationSubTLV) GetSid() []byte {
+	if x != nil {
+		return x.Sid
+	}
+	return nil
+}
+
+func (x *SRv6InformationSubTLV) GetFlags() *SRv6SIDFlags {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *SRv6InformationSubTLV) GetEndpointBehavior() uint32 {
+	if x != nil {
+		return x.EndpointBehavior
+	}
+	return 0
+}
+
+func (x *SRv6InformationSubTLV) GetSubSubTlvs() map[uint32]*SRv6TLV {
+	if x != nil {
+		return x.SubSubTlvs
+	}
+	return nil
+}
+
+// https://www.rfc-editor.org/rfc/rfc9252.html#section-2
+type SRv6L3ServiceTLV struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SRv6TLV is one of:
+	// - SRv6InformationSubTLV
+	SubTlvs map[uint32]*SRv6TLV `protobuf:"bytes,1,rep,name=sub_tlvs,json=subTlvs,proto3" json:"sub_tlvs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *SRv6L3ServiceTLV) Reset() {
+	*x = SRv6L3ServiceTLV{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SRv6L3ServiceTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRv6L3ServiceTLV) ProtoMessage() {}
+
+func (x *SRv6L3ServiceTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRv6L3ServiceTLV.ProtoReflect.Descriptor instead.
+func (*SRv6L3ServiceTLV) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *SRv6L3ServiceTLV) GetSubTlvs() map[uint32]*SRv6TLV {
+	if x != nil {
+		return x.SubTlvs
+	}
+	return nil
+}
+
+// https://www.rfc-editor.org/rfc/rfc9252.html#section-2
+type SRv6L2ServiceTLV struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SRv6TLV is one of:
+	// - SRv6InformationSubTLV
+	SubTlvs map[uint32]*SRv6TLV `protobuf:"bytes,1,rep,name=sub_tlvs,json=subTlvs,proto3" json:"sub_tlvs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *SRv6L2ServiceTLV) Reset() {
+	*x = SRv6L2ServiceTLV{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SRv6L2ServiceTLV) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRv6L2ServiceTLV) ProtoMessage() {}
+
+func (x *SRv6L2ServiceTLV) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRv6L2ServiceTLV.ProtoReflect.Descriptor instead.
+func (*SRv6L2ServiceTLV) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *SRv6L2ServiceTLV) GetSubTlvs() map[uint32]*SRv6TLV {
+	if x != nil {
+		return x.SubTlvs
+	}
+	return nil
+}
+
+// https://tools.ietf.org/html/rfc8669
+type PrefixSID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tlv is one of:
+	// - IndexLabelTLV Type 1 (not yet implemented)
+	// - OriginatorSRGBTLV Type 3 (not yet implemented)
+	// - SRv6L3ServiceTLV Type 5
+	// - SRv6L2ServiceTLV Type 6
+	Tlvs []*anypb.Any `protobuf:"bytes,1,rep,name=tlvs,proto3" json:"tlvs,omitempty"`
+}
+
+func (x *PrefixSID) Reset() {
+	*x = PrefixSID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_attribute_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrefixSID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefixSID) ProtoMessage() {}
+
+func (x *PrefixSID) ProtoReflect() protoreflect.Message {
+	mi := &file_attribute_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefixSID.ProtoReflect.Descriptor instead.
+func (*PrefixSID) Descriptor() ([]byte, []int) {
+	return file_attribute_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *PrefixSID) GetTlvs() []*anypb.Any {
+	if x != nil {
+		return x.Tlvs
+	}
+	return nil
+}
+
+var File_attribute_proto protoreflect.FileDescriptor
+
+var file_attribute_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x05, 0x61, 0x70, 0x69, 0x70, 0x62, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x67, 0x6f, 0x62, 0x67, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x29, 0x0a, 0x0f, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x22, 0xad, 0x01, 0x0a, 0x09,
+	0x41, 0x73, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e,
+	0x41, 0x73, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x5b,
+	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x53, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x41, 0x53, 0x5f, 0x53, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x02,
+	0x12, 0x16, 0x0a, 0x12, 0x41, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x45, 0x44, 0x5f, 0x53, 0x45,
+	0x51, 0x55, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x53, 0x5f, 0x43,
+	0x4f, 0x4e, 0x46, 0x45, 0x44, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x04, 0x22, 0x3f, 0x0a, 0x0f, 0x41,
+	0x73, 0x50, 0x61, 0x74, 0x68, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x2c,
+	0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x41, 0x73, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x2d, 0x0a, 0x10,
+	0x4e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x22, 0x2a, 0x0a, 0x16, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x45, 0x78, 0x69, 0x74, 0x44, 0x69, 0x73, 0x63, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x03, 0x6d, 0x65, 0x64, 0x22, 0x33, 0x0a, 0x12, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x50, 0x72, 0x65, 0x66, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x72, 0x65, 0x66, 0x22, 0x1a, 0x0a, 0x18,
+	0x41, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x22, 0x41, 0x0a, 0x13, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x61, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x73,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x38, 0x0a, 0x14, 0x43,
+	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x27, 0x0a, 0x15, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x6f, 0x72, 0x49, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x28,
+	0x0a, 0x14, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x48, 0x0a, 0x0f, 0x49, 0x50, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4c, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x22, 0x67, 0x0a, 0x16, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x65, 0x64, 0x49, 0x50, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6c,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x4c, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x2d, 0x0a, 0x11, 0x45,
+	0x6e, 0x63, 0x61, 0x70, 0x73, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x4c, 0x52, 0x49,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x51, 0x0a, 0x1d, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x44, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x75, 0x69, 0x73, 0x68, 0x65, 0x72,
+	0x54, 0x77, 0x6f, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x41, 0x53, 0x4e, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x22, 0x4f, 0x0a,
+	0x1b, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x75, 0x69, 0x73,
+	0x68, 0x65, 0x72, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x22, 0x52,
+	0x0a, 0x1e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x75, 0x69,
+	0x73, 0x68, 0x65, 0x72, 0x46, 0x6f, 0x75, 0x72, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x41, 0x53, 0x4e,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x22, 0x45, 0x0a, 0x19, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x08, 0x56, 0x50,
+	0x4c, 0x53, 0x4e, 0x4c, 0x52, 0x49, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64, 0x12, 0x13, 0x0a, 0x05,
+	0x76, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x76, 0x65, 0x49,
+	0x64, 0x12, 0x26, 0x0a, 0x0f, 0x76, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x76, 0x65, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x76, 0x65, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x28, 0x0a,
+	0x10, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x62, 0x61, 0x73,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x42, 0x61, 0x73, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x1e, 0x45, 0x56, 0x50, 0x4e,
+	0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64,
+	0x12, 0x32, 0x0a, 0x03, 0x65, 0x73, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
+	0x03, 0x65, 0x73, 0x69, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74,
+	0x5f, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x6e, 0x65, 0x74, 0x54, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0xf2, 0x01,
+	0x0a, 0x1b, 0x45, 0x56, 0x50, 0x4e, 0x4d, 0x41, 0x43, 0x49, 0x50, 0x41, 0x64, 0x76, 0x65, 0x72,
+	0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24, 0x0a,
+	0x02, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52,
+	0x02, 0x72, 0x64, 0x12, 0x32, 0x0a, 0x03, 0x65, 0x73, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65,
+	0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x52, 0x03, 0x65, 0x73, 0x69, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x54, 0x61, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
+	0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6d, 0x61, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x26, 0x45, 0x56, 0x50, 0x4e, 0x49, 0x6e, 0x63, 0x6c, 0x75,
+	0x73, 0x69, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x45, 0x74, 0x68,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x54, 0x61, 0x67, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24, 0x0a,
+	0x02, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52,
+	0x02, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x5f,
+	0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x54, 0x61, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x18, 0x45, 0x56, 0x50, 0x4e, 0x45, 0x74,
+	0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64, 0x12, 0x32, 0x0a, 0x03, 0x65, 0x73, 0x69, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x45, 0x74,
+	0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x03, 0x65, 0x73, 0x69, 0x12, 0x1d, 0x0a, 0x0a,
+	0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x86, 0x02, 0x0a, 0x11,
+	0x45, 0x56, 0x50, 0x4e, 0x49, 0x50, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64, 0x12, 0x32, 0x0a, 0x03, 0x65, 0x73, 0x69, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x45, 0x74, 0x68,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x03, 0x65, 0x73, 0x69, 0x12, 0x21, 0x0a, 0x0c, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x54, 0x61, 0x67, 0x12, 0x1b,
+	0x0a, 0x09, 0x69, 0x70, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x69, 0x70, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x22, 0x0a, 0x0d, 0x69,
+	0x70, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x69, 0x70, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4c, 0x65, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x67, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x22, 0x82, 0x02, 0x0a, 0x26, 0x45, 0x56, 0x50, 0x4e, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x45,
+	0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x54, 0x61, 0x67, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12,
+	0x24, 0x0a, 0x02, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
+	0x79, 0x52, 0x02, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65,
+	0x74, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x54, 0x61, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0xab, 0x02, 0x0a, 0x1b, 0x45, 0x56,
+	0x50, 0x4e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x53,
+	0x79, 0x6e, 0x63, 0x68, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64, 0x12,
+	0x32, 0x0a, 0x03, 0x65, 0x73, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61,
+	0x70, 0x69, 0x70, 0x62, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x03,
+	0x65, 0x73, 0x69, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x5f,
+	0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x54, 0x61, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0xf9, 0x02, 0x0a, 0x1c, 0x45, 0x56, 0x50, 0x4e,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x79,
+	0x6e, 0x63, 0x68, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64, 0x12, 0x32,
+	0x0a, 0x03, 0x65, 0x73, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70,
+	0x69, 0x70, 0x62, 0x2e, 0x45, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x03, 0x65,
+	0x73, 0x69, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x5f, 0x74,
+	0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6e,
+	0x65, 0x74, 0x54, 0x61, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x61,
+	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x22, 0x7f, 0x0a, 0x0e, 0x45, 0x56, 0x50, 0x4e, 0x49, 0x50, 0x4d, 0x53, 0x49,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x65, 0x74, 0x68, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x54, 0x61, 0x67, 0x12, 0x24,
+	0x0a, 0x02, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
+	0x52, 0x02, 0x72, 0x74, 0x22, 0x7e, 0x0a, 0x0c, 0x53, 0x52, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x4e, 0x4c, 0x52, 0x49, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0d,
+	0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x75, 0x69, 0x73, 0x68, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x75, 0x69, 0x73, 0x68,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x19, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x65, 0x64,
+	0x56, 0x50, 0x4e, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0d, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x4c, 0x65, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x53, 0x0a, 0x19, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x4e, 0x4c, 0x52, 0x49, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x61, 0x73, 0x6e, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x74, 0x22, 0x75, 0x0a, 0x10,
+	0x46, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x49, 0x50, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6c,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x4c, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x22, 0x3b, 0x0a, 0x0b, 0x46, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x4d,
+	0x41, 0x43, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x3d, 0x0a, 0x15, 0x46, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x5b, 0x0a, 0x11, 0x46, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e,
+	0x46, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3a, 0x0a, 0x0c,
+	0x46, 0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x4e, 0x4c, 0x52, 0x49, 0x12, 0x2a, 0x0a, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
+	0x79, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x63, 0x0a, 0x0f, 0x56, 0x50, 0x4e, 0x46,
+	0x6c, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x4e, 0x4c, 0x52, 0x49, 0x12, 0x24, 0x0a, 0x02, 0x72,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72,
+	0x64, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x34, 0x0a,
+	0x0a, 0x4f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x4e, 0x4c, 0x52, 0x49, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x84, 0x02, 0x0a, 0x10, 0x4c, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x73, 0x6e, 0x12, 0x1a, 0x0a, 0x09, 0x62, 0x67,
+	0x70, 0x5f, 0x6c, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62,
+	0x67, 0x70, 0x4c, 0x73, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6f, 0x73, 0x70, 0x66, 0x5f, 0x61,
+	0x72, 0x65, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6f, 0x73,
+	0x70, 0x66, 0x41, 0x72, 0x65, 0x61, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x73, 0x65, 0x75,
+	0x64, 0x6f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x73,
+	0x65, 0x75, 0x64, 0x6f, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x67, 0x70, 0x5f,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x69, 0x67, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d,
+	0x62, 0x67, 0x70, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x67, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x38, 0x0a, 0x18, 0x62, 0x67, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x65, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x16, 0x62, 0x67, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x98, 0x02, 0x0a, 0x10, 0x4c,
+	0x73, 0x4c, 0x69, 0x6e, 0x6b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12,
+	0x22, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6c, 0x69, 0x6e, 0x6b, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6c, 0x69, 0x6e,
+	0x6b, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x69, 0x70, 0x76, 0x34,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x41, 0x64, 0x64, 0x72, 0x49, 0x70, 0x76, 0x34, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x65, 0x69,
+	0x67, 0x68, 0x62, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x49, 0x70, 0x76, 0x34, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x41,
+	0x64, 0x64, 0x72, 0x49, 0x70, 0x76, 0x36, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x65, 0x69, 0x67, 0x68,
+	0x62, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x49, 0x70, 0x76, 0x36, 0x22, 0x7d, 0x0a, 0x12, 0x4c, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x69,
+	0x70, 0x5f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x70, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x0f, 0x6f, 0x73, 0x70, 0x66, 0x5f, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
+	0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x4f, 0x73, 0x70, 0x66, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x6f, 0x73, 0x70, 0x66, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0x44, 0x0a, 0x0a, 0x4c, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x4e, 0x4c,
+	0x52, 0x49, 0x12, 0x36, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c,
+	0x73, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52,
+	0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x22, 0xc0, 0x01, 0x0a, 0x0a, 0x4c,
+	0x73, 0x4c, 0x69, 0x6e, 0x6b, 0x4e, 0x4c, 0x52, 0x49, 0x12, 0x36, 0x0a, 0x0a, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x6f, 0x64,
+	0x65, 0x12, 0x38, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c,
+	0x73, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52,
+	0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x6c,
+	0x69, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x4c,
+	0x69, 0x6e, 0x6b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x0e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x22, 0x90, 0x01,
+	0x0a, 0x0e, 0x4c, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x56, 0x34, 0x4e, 0x4c, 0x52, 0x49,
+	0x12, 0x36, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x4e,
+	0x6f, 0x64, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x10,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x22, 0x90, 0x01, 0x0a, 0x0e, 0x4c, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x56, 0x36, 0x4e,
+	0x4c, 0x52, 0x49, 0x12, 0x36, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e,
+	0x4c, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c,
+	0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x52, 0x10, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x6f, 0x72, 0x22, 0xfa, 0x01, 0x0a, 0x21, 0x4c, 0x73, 0x53, 0x72, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x4f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x61, 0x73, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x73, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69,
+	0x73, 0x63, 0x72, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x72, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72,
+	0x22, 0xbb, 0x01, 0x0a, 0x1b, 0x4c, 0x73, 0x53, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x4e, 0x4c, 0x52, 0x49,
+	0x12, 0x36, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x4e,
+	0x6f, 0x64, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x64, 0x0a, 0x19, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70,
+	0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x53, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x17, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x22, 0xcd,
+	0x01, 0x0a, 0x0c, 0x4c, 0x73, 0x41, 0x64, 0x64, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12,
+	0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x4e, 0x4c, 0x52, 0x49, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6e, 0x6c, 0x72, 0x69, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x6e, 0x6c, 0x72, 0x69,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x34, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x49, 0x44, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x61,
+	0x0a, 0x21, 0x4d, 0x55, 0x50, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x22, 0x60, 0x0a, 0x1e, 0x4d, 0x55, 0x50, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0xec, 0x02, 0x0a, 0x1f, 0x4d, 0x55, 0x50, 0x54, 0x79, 0x70, 0x65, 0x31,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x65, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64, 0x12, 0x27, 0x0a,
+	0x0d, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x65,
+	0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x71, 0x66, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x03, 0x71, 0x66, 0x69, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x10,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x1f, 0x4d, 0x55, 0x50, 0x54, 0x79, 0x70, 0x65, 0x32, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65,
+	0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64, 0x12, 0x36, 0x0a, 0x17,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x65, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74,
+	0x65, 0x69, 0x64, 0x22, 0x6e, 0x0a, 0x17, 0x4d, 0x56, 0x50, 0x4e, 0x49, 0x6e, 0x74, 0x72, 0x61,
+	0x41, 0x53, 0x49, 0x50, 0x4d, 0x53, 0x49, 0x41, 0x44, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24,
+	0x0a, 0x02, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
+	0x52, 0x02, 0x72, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0x5c, 0x0a, 0x17, 0x4d, 0x56, 0x50, 0x4e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x41, 0x53, 0x49, 0x50, 0x4d, 0x53, 0x49, 0x41, 0x44, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24,
+	0x0a, 0x02, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
+	0x52, 0x02, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41,
+	0x73, 0x22, 0xb3, 0x01, 0x0a, 0x10, 0x4d, 0x56, 0x50, 0x4e, 0x53, 0x50, 0x4d, 0x53, 0x49, 0x41,
+	0x44, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x73, 0x0a, 0x0f, 0x4d, 0x56, 0x50, 0x4e, 0x4c,
+	0x65, 0x61, 0x66, 0x41, 0x44, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x41, 0x6e, 0x79, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a,
+	0x12, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x8b, 0x01, 0x0a,
+	0x17, 0x4d, 0x56, 0x50, 0x4e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x41, 0x44, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x17, 0x4d,
+	0x56, 0x50, 0x4e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x54, 0x72, 0x65, 0x65, 0x4a, 0x6f, 0x69,
+	0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x70, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xa8, 0x01,
+	0x0a, 0x17, 0x4d, 0x56, 0x50, 0x4e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x72, 0x65, 0x65,
+	0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x02, 0x72, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x02, 0x72, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x12, 0x43, 0x41, 0x52,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x41, 0x77, 0x61, 0x72, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x4c, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x04,
+	0x74, 0x6c, 0x76, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79,
+	0x52, 0x04, 0x74, 0x6c, 0x76, 0x73, 0x22, 0x61, 0x0a, 0x0f, 0x43, 0x41, 0x52, 0x55, 0x6e, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x28, 0x0a, 0x04, 0x74, 0x6c, 0x76, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x41, 0x6e, 0x79, 0x52, 0x04, 0x74, 0x6c, 0x76, 0x73, 0x22, 0x25, 0x0a, 0x0b, 0x43, 0x41, 0x52,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x54, 0x4c, 0x56, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x22, 0x49, 0x0a, 0x10, 0x43, 0x41, 0x52, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x54, 0x4c, 0x56, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x21, 0x0a, 0x0d, 0x43,
+	0x41, 0x52, 0x53, 0x52, 0x76, 0x36, 0x53, 0x49, 0x44, 0x54, 0x4c, 0x56, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x69, 0x64, 0x22, 0x39,
+	0x0a, 0x0d, 0x43, 0x41, 0x52, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x54, 0x4c, 0x56, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x14, 0x4d, 0x70,
+	0x52, 0x65, 0x61, 0x63, 0x68, 0x4e, 0x4c, 0x52, 0x49, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x46, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x68, 0x6f, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65,
+	0x78, 0x74, 0x48, 0x6f, 0x70, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x6e, 0x6c, 0x72, 0x69, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x6e, 0x6c, 0x72,
+	0x69, 0x73, 0x22, 0x6b, 0x0a, 0x16, 0x4d, 0x70, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x4e,
+	0x4c, 0x52, 0x49, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x06,
+	0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61,
+	0x70, 0x69, 0x70, 0x62, 0x2e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x06, 0x66, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x6e, 0x6c, 0x72, 0x69, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x6e, 0x6c, 0x72, 0x69, 0x73, 0x22,
+	0x8f, 0x01, 0x0a, 0x1a, 0x54, 0x77, 0x6f, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x41, 0x73, 0x53, 0x70,
+	0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x75, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x61, 0x73, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x73, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x22, 0x98, 0x01, 0x0a, 0x1b, 0x49, 0x50, 0x76, 0x34, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x75, 0x62, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x90, 0x01, 0x0a,
+	0x1b, 0x46, 0x6f, 0x75, 0x72, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x41, 0x73, 0x53, 0x70, 0x65, 0x63,
+	0x69, 0x66, 0x69, 0x63, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x69, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x75, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x61, 0x73, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x73, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x22,
+	0x6c, 0x0a, 0x15, 0x4c, 0x69, 0x6e, 0x6b, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x73, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x61,
+	0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x62,
+	0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x69, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x22, 0x2a, 0x0a,
+	0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x25, 0x0a, 0x0d, 0x43, 0x6f, 0x6c,
+	0x6f, 0x72, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x22, 0x30, 0x0a, 0x0d, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x22, 0x4b, 0x0a, 0x0e,
+	0x4f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x52, 0x0a, 0x10, 0x45, 0x53, 0x49,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x28, 0x0a,
+	0x10, 0x69, 0x73, 0x5f, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x73, 0x53, 0x69, 0x6e, 0x67, 0x6c,
+	0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x32, 0x0a,
+	0x13, 0x45, 0x53, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x73, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x22, 0x55, 0x0a, 0x13, 0x4d, 0x61, 0x63, 0x4d, 0x6f, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x73,
+	0x74, 0x69, 0x63, 0x6b, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x53,
+	0x74, 0x69, 0x63, 0x6b, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x22, 0x25, 0x0a, 0x11, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x4d, 0x61, 0x63, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x6d, 0x61, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x63, 0x22,
+	0xc5, 0x01, 0x0a, 0x18, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x32, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x63, 0x69, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x63, 0x69, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x6c, 0x6f, 0x77, 0x5f,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x6c, 0x6f,
+	0x77, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x57, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x69,
+	0x6d, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70,
+	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x50, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x5f, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x50, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x22, 0x4a, 0x0a, 0x12, 0x44, 0x46, 0x45, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x69, 0x74, 0x6d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x62, 0x69, 0x74,
+	0x6d, 0x61, 0x70, 0x22, 0x3b, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x61,
+	0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x73, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x22, 0x4b, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x22, 0x57, 0x0a,
+	0x22, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x54, 0x77, 0x6f, 0x4f, 0x63, 0x74, 0x65,
+	0x74, 0x41, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x03, 0x61, 0x73, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x60, 0x0a, 0x23, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x49, 0x50, 0x76, 0x34, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x63, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x58, 0x0a, 0x23, 0x52, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x46, 0x6f, 0x75, 0x72, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x41, 0x73, 0x53,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x61, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x73,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x22, 0x2b, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x73, 0x63, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x64, 0x73, 0x63, 0x70, 0x22,
+	0x79, 0x0a, 0x14, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x65, 0x74, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x73, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x6a, 0x0a, 0x0b, 0x4d, 0x55,
+	0x50, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x75, 0x62,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x75, 0x62,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x32, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x34, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x34, 0x22, 0x45, 0x0a, 0x0c, 0x56, 0x50, 0x4c, 0x53, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x74, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x22, 0x66, 0x0a,
+	0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x76, 0x65, 0x22, 0x3b, 0x0a, 0x0f, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x56, 0x0a, 0x1c, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x43, 0x6f,
+	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0b, 0x63,
+	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x40, 0x0a, 0x10, 0x41, 0x73,
+	0x34, 0x50, 0x61, 0x74, 0x68, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x2c,
+	0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x41, 0x73, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x44, 0x0a, 0x16,
+	0x41, 0x73, 0x34, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x73, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0x65, 0x0a, 0x13, 0x50, 0x6d, 0x73, 0x69, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4a, 0x0a, 0x1e, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x45, 0x6e,
+	0x63, 0x61, 0x70, 0x73, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63,
+	0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x22, 0x37, 0x0a, 0x19, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45,
+	0x6e, 0x63, 0x61, 0x70, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x2e,
+	0x0a, 0x16, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x53, 0x75, 0x62,
+	0x54, 0x4c, 0x56, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x55,
+	0x0a, 0x1d, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x53, 0x75, 0x62,
+	0x54, 0x4c, 0x56, 0x53, 0x52, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x56, 0x0a, 0x24, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45,
+	0x6e, 0x63, 0x61, 0x70, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x53, 0x52, 0x43, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a,
+	0x13, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x39, 0x0a,
+	0x1b, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x53, 0x75, 0x62, 0x54,
+	0x4c, 0x56, 0x53, 0x52, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x49, 0x0a, 0x1d, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x53, 0x52, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x04, 0x62, 0x73, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x62,
+	0x73, 0x69, 0x64, 0x22, 0x4e, 0x0a, 0x0c, 0x53, 0x52, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x53, 0x49, 0x44, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x5f,
+	0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x46, 0x6c, 0x61,
+	0x67, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
+	0x73, 0x69, 0x64, 0x22, 0xb3, 0x01, 0x0a, 0x14, 0x53, 0x52, 0x76, 0x36, 0x45, 0x6e, 0x64, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x12, 0x2f, 0x0a, 0x08,
+	0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x42, 0x65, 0x68, 0x61, 0x76,
+	0x69, 0x6f, 0x72, 0x52, 0x08, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x12, 0x1b, 0x0a,
+	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x6f,
+	0x64, 0x65, 0x4c, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x5f, 0x6c, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x75, 0x6e, 0x63, 0x4c, 0x65, 0x6e,
+	0x12, 0x17, 0x0a, 0x07, 0x61, 0x72, 0x67, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x61, 0x72, 0x67, 0x4c, 0x65, 0x6e, 0x22, 0xc4, 0x01, 0x0a, 0x0e, 0x53, 0x52,
+	0x76, 0x36, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x49, 0x44, 0x12, 0x15, 0x0a, 0x06,
+	0x73, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x46,
+	0x6c, 0x61, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x5f,
+	0x66, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x62, 0x46, 0x6c, 0x61,
+	0x67, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
+	0x73, 0x69, 0x64, 0x12, 0x5b, 0x0a, 0x1b, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f,
+	0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62,
+	0x2e, 0x53, 0x52, 0x76, 0x36, 0x45, 0x6e, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x65, 0x68,
+	0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x19, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42,
+	0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x22, 0x54, 0x0a, 0x17, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x53,
+	0x75, 0x62, 0x54, 0x4c, 0x56, 0x53, 0x52, 0x45, 0x4e, 0x4c, 0x50, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x12, 0x23, 0x0a, 0x04, 0x65, 0x6e, 0x6c, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0f, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x45, 0x4e, 0x4c, 0x50, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x65, 0x6e, 0x6c, 0x70, 0x22, 0x38, 0x0a, 0x08, 0x53, 0x52, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x6a, 0x0a, 0x0c, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73,
+	0x12, 0x15, 0x0a, 0x06, 0x76, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x76, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x5f, 0x66, 0x6c, 0x61,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x15,
+	0x0a, 0x06, 0x73, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x73, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x62, 0x46, 0x6c, 0x61, 0x67, 0x22, 0x4f, 0x0a, 0x0c,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x41, 0x12, 0x29, 0x0a, 0x05,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70,
+	0x69, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73,
+	0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0xa8, 0x01,
+	0x0a, 0x0c, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x12, 0x29,
+	0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12, 0x5b, 0x0a, 0x1b, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72,
+	0x5f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x45, 0x6e, 0x64,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x19, 0x65,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x43, 0x12, 0x29, 0x0a, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x22, 0x87, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x44, 0x12, 0x29, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x97, 0x01, 0x0a,
+	0x0c, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x45, 0x12, 0x29, 0x0a,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61,
+	0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67,
+	0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x9b, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x46, 0x12, 0x29, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x22, 0xf9, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x47, 0x12, 0x29, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x22, 0x9b, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x48, 0x12, 0x29, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0xe0,
+	0x01, 0x0a, 0x0c, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x49, 0x12,
+	0x29, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6c,
+	0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x61,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x03, 0x73, 0x69, 0x64, 0x12, 0x5b, 0x0a, 0x1b, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x70,
+	0x62, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x45, 0x6e, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x65,
+	0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x19, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x22, 0xf0, 0x02, 0x0a, 0x0c, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x4a, 0x12, 0x29, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x2c, 0x0a, 0x12, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e,
+	0x0a, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12, 0x5b, 0x0a, 0x1b, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61,
+	0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x45, 0x6e, 0x64, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x19, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x22, 0x92, 0x02, 0x0a, 0x0c, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x4b, 0x12, 0x29, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x23,
+	0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12, 0x5b, 0x0a, 0x1b,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f,
+	0x72, 0x5f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x45, 0x6e,
+	0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x19,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x22, 0x7b, 0x0a, 0x1e, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x53, 0x52,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70,
+	0x69, 0x70, 0x62, 0x2e, 0x53, 0x52, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x73, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x3b, 0x0a, 0x1f, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x45, 0x6e, 0x63, 0x61, 0x70, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x45, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0x32, 0x0a, 0x1c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63,
+	0x61, 0x70, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x55, 0x44, 0x50, 0x44, 0x65, 0x73, 0x74, 0x50,
+	0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x35, 0x0a, 0x18, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x44, 0x53, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x64, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x44,
+	0x0a, 0x26, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x53, 0x75, 0x62,
+	0x54, 0x4c, 0x56, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x48, 0x61, 0x6e, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x61, 0x6e, 0x64,
+	0x6c, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x68, 0x61, 0x6e, 0x64,
+	0x6c, 0x69, 0x6e, 0x67, 0x22, 0x8a, 0x01, 0x0a, 0x13, 0x4d, 0x50, 0x4c, 0x53, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x6f, 0x74, 0x74, 0x6f,
+	0x6d, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x62, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x4f, 0x66, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x74, 0x74,
+	0x6c, 0x22, 0x57, 0x0a, 0x1f, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70,
+	0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x4d, 0x50, 0x4c, 0x53, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53,
+	0x74, 0x61, 0x63, 0x6b, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4d, 0x50,
+	0x4c, 0x53, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x53, 0x0a, 0x1a, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x53, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22,
+	0x44, 0x0a, 0x18, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x53, 0x75,
+	0x62, 0x54, 0x4c, 0x56, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4e, 0x0a, 0x0e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45,
+	0x6e, 0x63, 0x61, 0x70, 0x54, 0x4c, 0x56, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x74,
+	0x6c, 0x76, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52,
+	0x04, 0x74, 0x6c, 0x76, 0x73, 0x22, 0x41, 0x0a, 0x14, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45,
+	0x6e, 0x63, 0x61, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x29, 0x0a,
+	0x04, 0x74, 0x6c, 0x76, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70,
+	0x69, 0x70, 0x62, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x54,
+	0x4c, 0x56, 0x52, 0x04, 0x74, 0x6c, 0x76, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x1b, 0x49, 0x50, 0x76,
+	0x36, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x69, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x73, 0x75, 0x62, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x73, 0x75, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x22, 0x60, 0x0a, 0x23, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x49,
+	0x50, 0x76, 0x36, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
+	0x69, 0x63, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x59, 0x0a, 0x1f, 0x49, 0x50, 0x36, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x41, 0x6e, 0x79, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x22, 0x2a, 0x0a, 0x10, 0x41, 0x69, 0x67, 0x70, 0x54, 0x4c, 0x56, 0x49, 0x47, 0x50, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x22, 0x3a, 0x0a, 0x0e,
+	0x41, 0x69, 0x67, 0x70, 0x54, 0x4c, 0x56, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x39, 0x0a, 0x0d, 0x41, 0x69, 0x67, 0x70,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x6c, 0x76,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x74,
+	0x6c, 0x76, 0x73, 0x22, 0x75, 0x0a, 0x0e, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x67, 0x6c, 0x6f,
+	0x62, 0x61, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x31, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x32, 0x22, 0x54, 0x0a, 0x19, 0x4c, 0x61,
+	0x72, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61,
+	0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
+	0x69, 0x74, 0x79, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x22, 0x9b, 0x01, 0x0a, 0x0b, 0x4c, 0x73, 0x4e, 0x6f, 0x64, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x62, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x03, 0x61, 0x62, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x12, 0x0e,
+	0x0a, 0x02, 0x76, 0x36, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x76, 0x36, 0x22, 0x8b,
+	0x01, 0x0a, 0x0a, 0x4c, 0x73, 0x49, 0x47, 0x50, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x77,
+	0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6e, 0x6f, 0x55, 0x6e, 0x69, 0x63, 0x61, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x61, 0x67, 0x61,
+	0x74, 0x65, 0x5f, 0x6e, 0x73, 0x73, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x70,
+	0x72, 0x6f, 0x70, 0x61, 0x67, 0x61, 0x74, 0x65, 0x4e, 0x73, 0x73, 0x61, 0x22, 0x33, 0x0a, 0x09,
+	0x4c, 0x73, 0x53, 0x72, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x65, 0x67,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x12,
+	0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x65, 0x6e,
+	0x64, 0x22, 0x8a, 0x01, 0x0a, 0x10, 0x4c, 0x73, 0x53, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x73,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x69, 0x70, 0x76, 0x34, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x70, 0x76, 0x36, 0x53, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x53,
+	0x72, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x3a,
+	0x0a, 0x0e, 0x4c, 0x73, 0x53, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x28, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x53, 0x72, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xfd, 0x02, 0x0a, 0x0f, 0x4c,
+	0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x4e, 0x6f, 0x64, 0x65,
+	0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x5f, 0x76, 0x36, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x56,
+	0x36, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x69, 0x73, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x69, 0x73, 0x69, 0x73, 0x41, 0x72, 0x65, 0x61, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x73, 0x72, 0x5f, 0x63, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x53, 0x72, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0e, 0x73, 0x72, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x72, 0x5f, 0x61,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0c, 0x73, 0x72, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x12, 0x3b, 0x0a,
+	0x0e, 0x73, 0x72, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73,
+	0x53, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0c, 0x73, 0x72,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x9b, 0x04, 0x0a, 0x0f, 0x4c,
+	0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x12, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x5f, 0x76, 0x36,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x56, 0x36, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x2d, 0x0a, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x5f, 0x76, 0x36, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x72, 0x49, 0x64, 0x56, 0x36,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x65, 0x5f,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x1d, 0x0a,
+	0x0a, 0x69, 0x67, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x69, 0x67, 0x70, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6f, 0x70,
+	0x61, 0x71, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x13, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x6e, 0x64,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x31, 0x0a, 0x14, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x02, 0x52, 0x13, 0x75, 0x6e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x42,
+	0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x72, 0x5f, 0x61,
+	0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x73, 0x72, 0x41, 0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x53,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x72, 0x6c, 0x67, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28,
+	0x0d, 0x52, 0x05, 0x73, 0x72, 0x6c, 0x67, 0x73, 0x22, 0x7f, 0x0a, 0x11, 0x4c, 0x73, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x2e, 0x0a,
+	0x09, 0x69, 0x67, 0x70, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x49, 0x47, 0x50, 0x46, 0x6c,
+	0x61, 0x67, 0x73, 0x52, 0x08, 0x69, 0x67, 0x70, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6f,
+	0x70, 0x61, 0x71, 0x75, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x72,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x53, 0x69, 0x64, 0x22, 0x7e, 0x0a, 0x18, 0x4c, 0x73, 0x42,
+	0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x49, 0x44,
+	0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70,
+	0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x76, 0x0a, 0x13, 0x4c, 0x73, 0x42,
+	0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x49, 0x44,
+	0x12, 0x35, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65,
+	0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x49, 0x44, 0x46, 0x6c, 0x61, 0x67, 0x73,
+	0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x73, 0x69,
+	0x64, 0x22, 0xf8, 0x01, 0x0a, 0x19, 0x4c, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x45, 0x0a, 0x11, 0x62, 0x67, 0x70, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x73, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69,
+	0x70, 0x62, 0x2e, 0x4c, 0x73, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x49, 0x44, 0x52, 0x0e, 0x62, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x4e,
+	0x6f, 0x64, 0x65, 0x53, 0x69, 0x64, 0x12, 0x4f, 0x0a, 0x16, 0x62, 0x67, 0x70, 0x5f, 0x70, 0x65,
+	0x65, 0x72, 0x5f, 0x61, 0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c,
+	0x73, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x49, 0x44, 0x52, 0x13, 0x62, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x41, 0x64, 0x6a, 0x61, 0x63,
+	0x65, 0x6e, 0x63, 0x79, 0x53, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x10, 0x62, 0x67, 0x70, 0x5f, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x42, 0x67, 0x70, 0x50,
+	0x65, 0x65, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x49, 0x44, 0x52, 0x0d, 0x62,
+	0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x53, 0x65, 0x74, 0x53, 0x69, 0x64, 0x22, 0x9b, 0x01, 0x0a,
+	0x13, 0x4c, 0x73, 0x53, 0x72, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x49, 0x44, 0x46,
+	0x6c, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x72, 0x76, 0x36, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x73, 0x72, 0x76, 0x36, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x6e, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x97, 0x01, 0x0a, 0x0e, 0x4c,
+	0x73, 0x53, 0x72, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x49, 0x44, 0x12, 0x30, 0x0a,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61,
+	0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x53, 0x72, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x53, 0x49, 0x44, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x64,
+	0x12, 0x32, 0x0a, 0x15, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x62, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x13, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x53, 0x69, 0x64, 0x22, 0xbb, 0x01, 0x0a, 0x1b, 0x4c, 0x73, 0x53, 0x72, 0x43, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x46,
+	0x6c, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x16, 0x4c, 0x73, 0x53, 0x72, 0x43, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62,
+	0x2e, 0x4c, 0x73, 0x53, 0x72, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61,
+	0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x05, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x22, 0x78, 0x0a, 0x0b, 0x4c, 0x73, 0x53, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12, 0x2d,
+	0x0a, 0x12, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x22, 0xa1, 0x01,
+	0x0a, 0x0f, 0x4c, 0x73, 0x53, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x74, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x74, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x2e, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x53, 0x72,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0xac, 0x02, 0x0a, 0x13, 0x4c, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x53, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x36, 0x0a, 0x0b, 0x62, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x53, 0x72, 0x42, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x53, 0x49, 0x44, 0x52, 0x0a, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x69,
+	0x64, 0x12, 0x4f, 0x0a, 0x14, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x53, 0x72, 0x43, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x12,
+	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x74, 0x68, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0d, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69,
+	0x70, 0x62, 0x2e, 0x4c, 0x73, 0x53, 0x72, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x0c, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x73,
+	0x22, 0x9c, 0x02, 0x0a, 0x0b, 0x4c, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x12, 0x2a, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x04,
+	0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69,
+	0x70, 0x62, 0x2e, 0x4c, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62,
+	0x2e, 0x4c, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x4a, 0x0a, 0x10, 0x62, 0x67,
+	0x70, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x42, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0e, 0x62, 0x67, 0x70, 0x50, 0x65, 0x65, 0x72, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x72, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x70,
+	0x62, 0x2e, 0x4c, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x72, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x73, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22,
+	0x52, 0x0a, 0x10, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0xb2, 0x02, 0x0a, 0x16, 0x53, 0x52, 0x76, 0x36, 0x53, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x53, 0x75, 0x62, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x12, 0x30,
+	0x0a, 0x14, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x12, 0x27, 0x0a, 0x0f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x66, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x72, 0x67,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x12, 0x31, 0x0a, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x31, 0x0a, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x25, 0x0a, 0x0c, 0x53, 0x52, 0x76, 0x36,
+	0x53, 0x49, 0x44, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x66, 0x6c, 0x61, 0x67,
+	0x5f, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x31, 0x22,
+	0x31, 0x0a, 0x07, 0x53, 0x52, 0x76, 0x36, 0x54, 0x4c, 0x56, 0x12, 0x26, 0x0a, 0x03, 0x74, 0x6c,
+	0x76, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x03, 0x74,
+	0x6c, 0x76, 0x22, 0xa0, 0x02, 0x0a, 0x15, 0x53, 0x52, 0x76, 0x36, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12, 0x29,
+	0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x53, 0x49, 0x44, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x65,
+	0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x12, 0x4e, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x5f, 0x73, 0x75,
+	0x62, 0x5f, 0x74, 0x6c, 0x76, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x61,
+	0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x54, 0x4c, 0x56, 0x2e, 0x53, 0x75, 0x62, 0x53, 0x75,
+	0x62, 0x54, 0x6c, 0x76, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x53,
+	0x75, 0x62, 0x54, 0x6c, 0x76, 0x73, 0x1a, 0x4d, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x53, 0x75, 0x62,
+	0x54, 0x6c, 0x76, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x69,
+	0x70, 0x62, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x54, 0x4c, 0x56, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9f, 0x01, 0x0a, 0x10, 0x53, 0x52, 0x76, 0x36, 0x4c, 0x33,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x4c, 0x56, 0x12, 0x3f, 0x0a, 0x08, 0x73, 0x75,
+	0x62, 0x5f, 0x74, 0x6c, 0x76, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61,
+	0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x4c, 0x33, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x54, 0x4c, 0x56, 0x2e, 0x53, 0x75, 0x62, 0x54, 0x6c, 0x76, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x62, 0x54, 0x6c, 0x76, 0x73, 0x1a, 0x4a, 0x0a, 0x0c, 0x53,
+	0x75, 0x62, 0x54, 0x6c, 0x76, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61,
+	0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x54, 0x4c, 0x56, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9f, 0x01, 0x0a, 0x10, 0x53, 0x52, 0x76, 0x36,
+	0x4c, 0x32, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x4c, 0x56, 0x12, 0x3f, 0x0a, 0x08,
+	0x73, 0x75, 0x62, 0x5f, 0x74, 0x6c, 0x76, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x4c, 0x32, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x54, 0x4c, 0x56, 0x2e, 0x53, 0x75, 0x62, 0x54, 0x6c, 0x76, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x62, 0x54, 0x6c, 0x76, 0x73, 0x1a, 0x4a, 0x0a,
+	0x0c, 0x53, 0x75, 0x62, 0x54, 0x6c, 0x76, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x54, 0x4c, 0x56, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x35, 0x0a, 0x09, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x53, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x6c, 0x76, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x74, 0x6c, 0x76, 0x73,
+	0x2a, 0xf7, 0x01, 0x0a, 0x0f, 0x4c, 0x73, 0x4f, 0x73, 0x70, 0x66, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x53, 0x5f, 0x4f, 0x53, 0x50, 0x46, 0x5f,
+	0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x4c, 0x53, 0x5f, 0x4f, 0x53, 0x50, 0x46, 0x5f,
+	0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x52, 0x41,
+	0x5f, 0x41, 0x52, 0x45, 0x41, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x4c, 0x53, 0x5f, 0x4f, 0x53,
+	0x50, 0x46, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e,
+	0x54, 0x45, 0x52, 0x5f, 0x41, 0x52, 0x45, 0x41, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x53,
+	0x5f, 0x4f, 0x53, 0x50, 0x46, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x31, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c,
+	0x4c, 0x53, 0x5f, 0x4f, 0x53, 0x50, 0x46, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x32, 0x10, 0x04, 0x12, 0x1c,
+	0x0a, 0x18, 0x4c, 0x53, 0x5f, 0x4f, 0x53, 0x50, 0x46, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x53, 0x53, 0x41, 0x31, 0x10, 0x05, 0x12, 0x1c, 0x0a, 0x18,
+	0x4c, 0x53, 0x5f, 0x4f, 0x53, 0x50, 0x46, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x4e, 0x53, 0x53, 0x41, 0x32, 0x10, 0x06, 0x2a, 0x99, 0x01, 0x0a, 0x0a, 0x4c,
+	0x73, 0x4e, 0x4c, 0x52, 0x49, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x53, 0x5f,
+	0x4e, 0x4c, 0x52, 0x49, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x10,
+	0x0a, 0x0c, 0x4c, 0x53, 0x5f, 0x4e, 0x4c, 0x52, 0x49, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x01,
+	0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x53, 0x5f, 0x4e, 0x4c, 0x52, 0x49, 0x5f, 0x4c, 0x49, 0x4e, 0x4b,
+	0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x53, 0x5f, 0x4e, 0x4c, 0x52, 0x49, 0x5f, 0x50, 0x52,
+	0x45, 0x46, 0x49, 0x58, 0x5f, 0x56, 0x34, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x53, 0x5f,
+	0x4e, 0x4c, 0x52, 0x49, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x49, 0x58, 0x5f, 0x56, 0x36, 0x10, 0x04,
+	0x12, 0x24, 0x0a, 0x20, 0x4c, 0x53, 0x5f, 0x4e, 0x4c, 0x52, 0x49, 0x5f, 0x53, 0x52, 0x5f, 0x50,
+	0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x43, 0x41, 0x4e, 0x44, 0x49, 0x44, 0x41, 0x54, 0x45, 0x5f,
+	0x50, 0x41, 0x54, 0x48, 0x10, 0x05, 0x2a, 0xfd, 0x01, 0x0a, 0x0c, 0x4c, 0x73, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x44, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x53, 0x5f, 0x50, 0x52,
+	0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
+	0x12, 0x17, 0x0a, 0x13, 0x4c, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f,
+	0x49, 0x53, 0x49, 0x53, 0x5f, 0x4c, 0x31, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x53, 0x5f,
+	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x49, 0x53, 0x49, 0x53, 0x5f, 0x4c, 0x32,
+	0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f,
+	0x4c, 0x5f, 0x4f, 0x53, 0x50, 0x46, 0x5f, 0x56, 0x32, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x4c,
+	0x53, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43,
+	0x54, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43,
+	0x4f, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x4c,
+	0x53, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4f, 0x53, 0x50, 0x46, 0x5f,
+	0x56, 0x33, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f,
+	0x43, 0x4f, 0x4c, 0x5f, 0x42, 0x47, 0x50, 0x10, 0x07, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x53, 0x5f,
+	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x52, 0x53, 0x56, 0x50, 0x5f, 0x54, 0x45,
+	0x10, 0x08, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f,
+	0x4c, 0x5f, 0x53, 0x52, 0x10, 0x09, 0x2a, 0xed, 0x05, 0x0a, 0x0c, 0x53, 0x52, 0x76, 0x36, 0x42,
+	0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x53, 0x45, 0x52,
+	0x56, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x10,
+	0x0a, 0x0c, 0x45, 0x4e, 0x44, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x50, 0x53, 0x50, 0x10, 0x02,
+	0x12, 0x10, 0x0a, 0x0c, 0x45, 0x4e, 0x44, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x55, 0x53, 0x50,
+	0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x4e, 0x44, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x50,
+	0x53, 0x50, 0x5f, 0x55, 0x53, 0x50, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x4e, 0x44, 0x58,
+	0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x44, 0x58, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x50, 0x53, 0x50, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x44, 0x58, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x55, 0x53, 0x50, 0x10, 0x07, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x4e, 0x44, 0x58,
+	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x50, 0x53, 0x50, 0x5f, 0x55, 0x53, 0x50, 0x10, 0x08, 0x12,
+	0x08, 0x0a, 0x04, 0x45, 0x4e, 0x44, 0x54, 0x10, 0x09, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x44,
+	0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x50, 0x53, 0x50, 0x10, 0x0a, 0x12, 0x11, 0x0a, 0x0d,
+	0x45, 0x4e, 0x44, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x55, 0x53, 0x50, 0x10, 0x0b, 0x12,
+	0x15, 0x0a, 0x11, 0x45, 0x4e, 0x44, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x50, 0x53, 0x50,
+	0x5f, 0x55, 0x53, 0x50, 0x10, 0x0c, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x44, 0x5f, 0x42, 0x36,
+	0x5f, 0x45, 0x4e, 0x43, 0x41, 0x50, 0x53, 0x10, 0x0e, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x4e, 0x44,
+	0x5f, 0x42, 0x4d, 0x10, 0x0f, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x58, 0x36,
+	0x10, 0x10, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x58, 0x34, 0x10, 0x11, 0x12,
+	0x0b, 0x0a, 0x07, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x54, 0x36, 0x10, 0x12, 0x12, 0x0b, 0x0a, 0x07,
+	0x45, 0x4e, 0x44, 0x5f, 0x44, 0x54, 0x34, 0x10, 0x13, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x4e, 0x44,
+	0x5f, 0x44, 0x54, 0x34, 0x36, 0x10, 0x14, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x4e, 0x44, 0x5f, 0x44,
+	0x58, 0x32, 0x10, 0x15, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x58, 0x32, 0x56,
+	0x10, 0x16, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x54, 0x32, 0x55, 0x10, 0x17,
+	0x12, 0x0c, 0x0a, 0x08, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x54, 0x32, 0x4d, 0x10, 0x18, 0x12, 0x15,
+	0x0a, 0x11, 0x45, 0x4e, 0x44, 0x5f, 0x42, 0x36, 0x5f, 0x45, 0x4e, 0x43, 0x41, 0x50, 0x53, 0x5f,
+	0x52, 0x65, 0x64, 0x10, 0x1b, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x4e, 0x44, 0x5f, 0x57, 0x49, 0x54,
+	0x48, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x1c, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x4e, 0x44, 0x5f, 0x57,
+	0x49, 0x54, 0x48, 0x5f, 0x50, 0x53, 0x50, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x1d, 0x12, 0x14, 0x0a,
+	0x10, 0x45, 0x4e, 0x44, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x55, 0x53, 0x50, 0x5f, 0x55, 0x53,
+	0x44, 0x10, 0x1e, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x4e, 0x44, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x50, 0x53, 0x50, 0x5f, 0x55, 0x53, 0x50, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x1f, 0x12, 0x11, 0x0a,
+	0x0d, 0x45, 0x4e, 0x44, 0x58, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x20,
+	0x12, 0x15, 0x0a, 0x11, 0x45, 0x4e, 0x44, 0x58, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x50, 0x53,
+	0x50, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x21, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x4e, 0x44, 0x58, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x55, 0x53, 0x50, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x22, 0x12, 0x19,
+	0x0a, 0x15, 0x45, 0x4e, 0x44, 0x58, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x50, 0x53, 0x50, 0x5f,
+	0x55, 0x53, 0x50, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x23, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x44,
+	0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x24, 0x12, 0x15, 0x0a, 0x11,
+	0x45, 0x4e, 0x44, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x50, 0x53, 0x50, 0x5f, 0x55, 0x53,
+	0x44, 0x10, 0x25, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x4e, 0x44, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x55, 0x53, 0x50, 0x5f, 0x55, 0x53, 0x44, 0x10, 0x26, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x4e,
+	0x44, 0x54, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x50, 0x53, 0x50, 0x5f, 0x55, 0x53, 0x50, 0x5f,
+	0x55, 0x53, 0x44, 0x10, 0x27, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x4e, 0x44, 0x4d, 0x5f, 0x47, 0x54,
+	0x50, 0x36, 0x44, 0x10, 0x45, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x4e, 0x44, 0x4d, 0x5f, 0x47, 0x54,
+	0x50, 0x36, 0x44, 0x49, 0x10, 0x46, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x4e, 0x44, 0x4d, 0x5f, 0x47,
+	0x54, 0x50, 0x36, 0x45, 0x10, 0x47, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x4e, 0x44, 0x4d, 0x5f, 0x47,
+	0x54, 0x50, 0x34, 0x45, 0x10, 0x48, 0x2a, 0x44, 0x0a, 0x08, 0x45, 0x4e, 0x4c, 0x50, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x10, 0x00,
+	0x12, 0x09, 0x0a, 0x05, 0x54, 0x79, 0x70, 0x65, 0x31, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x54,
+	0x79, 0x70, 0x65, 0x32, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x79, 0x70, 0x65, 0x33, 0x10,
+	0x03, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x79, 0x70, 0x65, 0x34, 0x10, 0x04, 0x42, 0x24, 0x5a, 0x22,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x73, 0x72, 0x67, 0x2f,
+	0x67, 0x6f, 0x62, 0x67, 0x70, 0x2f, 0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x3b, 0x61, 0x70, 0x69,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_attribute_proto_rawDescOnce sync.Once
+	file_attribute_proto_rawDescData = file_attribute_proto_rawDesc
+)
+
+func file_attribute_proto_rawDescGZIP() []byte {
+	file_attribute_proto_rawDescOnce.Do(func() {
+		file_attribute_proto_rawDescData = protoimpl.X.CompressGZIP(file_attribute_proto_rawDescData)
+	})
+	return file_attribute_proto_rawDescData
+}
+
+var file_attribute_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_attribute_proto_msgTypes = make([]protoimpl.MessageInfo, 170)
+var file_attribute_proto_goTypes = []interface{}{
+	(LsOspfRouteType)(0),                           // 0: apipb.LsOspfRouteType
+	(LsNLRIType)(0),                                // 1: apipb.LsNLRIType
+	(LsProtocolID)(0),                              // 2: apipb.LsProtocolID
+	(SRv6Behavior)(0),                              // 3: apipb.SRv6Behavior
+	(ENLPType)(0),                                  // 4: apipb.ENLPType
+	(AsSegment_Type)(0),                            // 5: apipb.AsSegment.Type
+	(*OriginAttribute)(nil),                        // 6: apipb.OriginAttribute
+	(*AsSegment)(nil),                              // 7: apipb.AsSegment
+	(*AsPathAttribute)(nil),                        // 8: apipb.AsPathAttribute
+	(*NextHopAttribute)(nil),                       // 9: apipb.NextHopAttribute
+	(*MultiExitDiscAttribute)(nil),                 // 10: apipb.MultiExitDiscAttribute
+	(*LocalPrefAttribute)(nil),                     // 11: apipb.LocalPrefAttribute
+	(*AtomicAggregateAttribute)(nil),               // 12: apipb.AtomicAggregateAttribute
+	(*AggregatorAttribute)(nil),                    // 13: apipb.AggregatorAttribute
+	(*CommunitiesAttribute)(nil),                   // 14: apipb.CommunitiesAttribute
+	(*OriginatorIdAttribute)(nil),                  // 15: apipb.OriginatorIdAttribute
+	(*ClusterListAttribute)(nil),                   // 16: apipb.ClusterListAttribute
+	(*IPAddressPrefix)(nil),                        // 17: apipb.IPAddressPrefix
+	(*LabeledIPAddressPrefix)(nil),                 // 18: apipb.LabeledIPAddressPrefix
+	(*EncapsulationNLRI)(nil),                      // 19: apipb.EncapsulationNLRI
+	(*RouteDistinguisherTwoOctetASN)(nil),          // 20: apipb.RouteDistinguisherTwoOctetASN
+	(*RouteDistinguisherIPAddress)(nil),            // 21: apipb.RouteDistinguisherIPAddress
+	(*RouteDistinguisherFourOctetASN)(nil),         // 22: apipb.RouteDistinguisherFourOctetASN
+	(*EthernetSegmentIdentifier)(nil),              // 23: apipb.EthernetSegmentIdentifier
+	(*VPLSNLRI)(nil),                               // 24: apipb.VPLSNLRI
+	(*EVPNEthernetAutoDiscoveryRoute)(nil),         // 25: apipb.EVPNEthernetAutoDiscoveryRoute
+	(*EVPNMACIPAdvertisementRoute)(nil),            // 26: apipb.EVPNMACIPAdvertisementRoute
+	(*EVPNInclusiveMulticastEthernetTagRoute)(nil), // 27: apipb.EVPNInclusiveMulticastEthernetTagRoute
+	(*EVPNEthernetSegmentRoute)(nil),               // 28: apipb.EVPNEthernetSegmentRoute
+	(*EVPNIPPrefixRoute)(nil),                      // 29: apipb.EVPNIPPrefixRoute
+	(*EVPNSelectiveMulticastEthernetTagRoute)(nil), // 30: apipb.EVPNSelectiveMulticastEthernetTagRoute
+	(*EVPNMulticastJoinSynchRoute)(nil),            // 31: apipb.EVPNMulticastJoinSynchRoute
+	(*EVPNMulticastLeaveSynchRoute)(nil),           // 32: apipb.EVPNMulticastLeaveSynchRoute
+	(*EVPNIPMSIRoute)(nil),                         // 33: apipb.EVPNIPMSIRoute
+	(*SRPolicyNLRI)(nil),                           // 34: apipb.SRPolicyNLRI
+	(*LabeledVPNIPAddressPrefix)(nil),              // 35: apipb.LabeledVPNIPAddressPrefix
+	(*RouteTargetMembershipNLRI)(nil),              // 36: apipb.RouteTargetMembershipNLRI
+	(*FlowSpecIPPrefix)(nil),                       // 37: apipb.FlowSpecIPPrefix
+	(*FlowSpecMAC)(nil),                            // 38: apipb.FlowSpecMAC
+	(*FlowSpecComponentItem)(nil),                  // 39: apipb.FlowSpecComponentItem
+	(*FlowSpecComponent)(nil),                      // 40: apipb.FlowSpecComponent
+	(*FlowSpecNLRI)(nil),                           // 41: apipb.FlowSpecNLRI
+	(*VPNFlowSpecNLRI)(nil),                        // 42: apipb.VPNFlowSpecNLRI
+	(*OpaqueNLRI)(nil),                             // 43: apipb.OpaqueNLRI
+	(*LsNodeDescriptor)(nil),                       // 44: apipb.LsNodeDescriptor
+	(*LsLinkDescriptor)(nil),                       // 45: apipb.LsLinkDescriptor
+	(*LsPrefixDescriptor)(nil),                     // 46: apipb.LsPrefixDescriptor
+	(*LsNodeNLRI)(nil),                             // 47: apipb.LsNodeNLRI
+	(*LsLinkNLRI)(nil),                             // 48: apipb.LsLinkNLRI
+	(*LsPrefixV4NLRI)(nil),                         // 49: apipb.LsPrefixV4NLRI
+	(*LsPrefixV6NLRI)(nil),                         // 50: apipb.LsPrefixV6NLRI
+	(*LsSrPolicyCandidatePathDescriptor)(nil),      // 51: apipb.LsSrPolicyCandidatePathDescriptor
+	(*LsSrPolicyCandidatePathNLRI)(nil),            // 52: apipb.LsSrPolicyCandidatePathNLRI
+	(*LsAddrPrefix)(nil),                           // 53: apipb.LsAddrPrefix
+	(*MUPInterworkSegmentDiscoveryRoute)(nil),      // 54: apipb.MUPInterworkSegmentDiscoveryRoute
+	(*MUPDirectSegmentDiscoveryRoute)(nil),         // 55: apipb.MUPDirectSegmentDiscoveryRoute
+	(*MUPType1SessionTransformedRoute)(nil),        // 56: apipb.MUPType1SessionTransformedRoute
+	(*MUPType2SessionTransformedRoute)(nil),        // 57: apipb.MUPType2SessionTransformedRoute
+	(*MVPNIntraASIPMSIADRoute)(nil),                // 58: apipb.MVPNIntraASIPMSIADRoute
+	(*MVPNInterASIPMSIADRoute)(nil),                // 59: apipb.MVPNInterASIPMSIADRoute
+	(*MVPNSPMSIADRoute)(nil),                       // 60: apipb.MVPNSPMSIADRoute
+	(*MVPNLeafADRoute)(nil),                        // 61: apipb.MVPNLeafADRoute
+	(*MVPNSourceActiveADRoute)(nil),                // 62: apipb.MVPNSourceActiveADRoute
+	(*MVPNSharedTreeJoinRoute)(nil),                // 63: apipb.MVPNSharedTreeJoinRoute
+	(*MVPNSourceTreeJoinRoute)(nil),                // 64: apipb.MVPNSourceTreeJoinRoute
+	(*CARColorAwareRoute)(nil),                     // 65: apipb.CARColorAwareRoute
+	(*CARUnknownRoute)(nil),                        // 66: apipb.CARUnknownRoute
+	(*CARLabelTLV)(nil),                            // 67: apipb.CARLabelTLV
+	(*CARLabelIndexTLV)(nil),                       // 68: apipb.CARLabelIndexTLV
+	(*CARSRv6SIDTLV)(nil),                          // 69: apipb.CARSRv6SIDTLV
+	(*CARUnknownTLV)(nil),                          // 70: apipb.CARUnknownTLV
+	(*MpReachNLRIAttribute)(nil),                   // 71: apipb.MpReachNLRIAttribute
+	(*MpUnreachNLRIAttribute)(nil),                 // 72: apipb.MpUnreachNLRIAttribute
+	(*TwoOctetAsSpecificExtended)(nil),             // 73: apipb.TwoOctetAsSpecificExtended
+	(*IPv4AddressSpecificExtended)(nil),            // 74: apipb.IPv4AddressSpecificExtended
+	(*FourOctetAsSpecificExtended)(nil),            // 75: apipb.FourOctetAsSpecificExtended
+	(*LinkBandwidthExtended)(nil),                  // 76: apipb.LinkBandwidthExtended
+	(*ValidationExtended)(nil),                     // 77: apipb.ValidationExtended
+	(*ColorExtended)(nil),                          // 78: apipb.ColorExtended
+	(*EncapExtended)(nil),                          // 79: apipb.EncapExtended
+	(*DefaultGatewayExtended)(nil),                 // 80: apipb.DefaultGatewayExtended
+	(*OpaqueExtended)(nil),                         // 81: apipb.OpaqueExtended
+	(*ESILabelExtended)(nil),                       // 82: apipb.ESILabelExtended
+	(*ESImportRouteTarget)(nil),                    // 83: apipb.ESImportRouteTarget
+	(*MacMobilityExtended)(nil),                    // 84: apipb.MacMobilityExtended
+	(*RouterMacExtended)(nil),                      // 85: apipb.RouterMacExtended
+	(*Layer2AttributesExtended)(nil),               // 86: apipb.Layer2AttributesExtended
+	(*DFElectionExtended)(nil),                     // 87: apipb.DFElectionExtended
+	(*TrafficRateExtended)(nil),                    // 88: apipb.TrafficRateExtended
+	(*TrafficActionExtended)(nil),                  // 89: apipb.TrafficActionExtended
+	(*RedirectTwoOctetAsSpecificExtended)(nil),     // 90: apipb.RedirectTwoOctetAsSpecificExtended
+	(*RedirectIPv4AddressSpecificExtended)(nil),    // 91: apipb.RedirectIPv4AddressSpecificExtended
+	(*RedirectFourOctetAsSpecificExtended)(nil),    // 92: apipb.RedirectFourOctetAsSpecificExtended
+	(*TrafficRemarkExtended)(nil),                  // 93: apipb.TrafficRemarkExtended
+	(*InterfaceSetExtended)(nil),                   // 94: apipb.InterfaceSetExtended
+	(*MUPExtended)(nil),                            // 95: apipb.MUPExtended
+	(*VPLSExtended)(nil),                           // 96: apipb.VPLSExtended
+	(*TransportClassExtended)(nil),                 // 97: apipb.TransportClassExtended
+	(*UnknownExtended)(nil),                        // 98: apipb.UnknownExtended
+	(*ExtendedCommunitiesAttribute)(nil),           // 99: apipb.ExtendedCommunitiesAttribute
+	(*As4PathAttribute)(nil),                       // 100: apipb.As4PathAttribute
+	(*As4AggregatorAttribute)(nil),                 // 101: apipb.As4AggregatorAttribute
+	(*PmsiTunnelAttribute)(nil),                    // 102: apipb.PmsiTunnelAttribute
+	(*TunnelEncapSubTLVEncapsulation)(nil),         // 103: apipb.TunnelEncapSubTLVEncapsulation
+	(*TunnelEncapSubTLVProtocol)(nil),              // 104: apipb.TunnelEncapSubTLVProtocol
+	(*TunnelEncapSubTLVColor)(nil),                 // 105: apipb.TunnelEncapSubTLVColor
+	(*TunnelEncapSubTLVSRPreference)(nil),          // 106: apipb.TunnelEncapSubTLVSRPreference
+	(*TunnelEncapSubTLVSRCandidatePathName)(nil),   // 107: apipb.TunnelEncapSubTLVSRCandidatePathName
+	(*TunnelEncapSubTLVSRPriority)(nil),            // 108: apipb.TunnelEncapSubTLVSRPriority
+	(*TunnelEncapSubTLVSRBindingSID)(nil),          // 109: apipb.TunnelEncapSubTLVSRBindingSID
+	(*SRBindingSID)(nil),                           // 110: apipb.SRBindingSID
+	(*SRv6EndPointBehavior)(nil),                   // 111: apipb.SRv6EndPointBehavior
+	(*SRv6BindingSID)(nil),                         // 112: apipb.SRv6BindingSID
+	(*TunnelEncapSubTLVSRENLP)(nil),                // 113: apipb.TunnelEncapSubTLVSRENLP
+	(*SRWeight)(nil),                               // 114: apipb.SRWeight
+	(*SegmentFlags)(nil),                           // 115: apipb.SegmentFlags
+	(*SegmentTypeA)(nil),                           // 116: apipb.SegmentTypeA
+	(*SegmentTypeB)(nil),                           // 117: apipb.SegmentTypeB
+	(*SegmentTypeC)(nil),                           // 118: apipb.SegmentTypeC
+	(*SegmentTypeD)(nil),                           // 119: apipb.SegmentTypeD
+	(*SegmentTypeE)(nil),                           // 120: apipb.SegmentTypeE
+	(*SegmentTypeF)(nil),                           // 121: apipb.SegmentTypeF
+	(*SegmentTypeG)(nil),                           // 122: apipb.SegmentTypeG
+	(*SegmentTypeH)(nil),                           // 123: apipb.SegmentTypeH
+	(*SegmentTypeI)(nil),                           // 124: apipb.SegmentTypeI
+	(*SegmentTypeJ)(nil),                           // 125: apipb.SegmentTypeJ
+	(*SegmentTypeK)(nil),                           // 126: apipb.SegmentTypeK
+	(*TunnelEncapSubTLVSRSegmentList)(nil),         // 127: apipb.TunnelEncapSubTLVSRSegmentList
+	(*TunnelEncapSubTLVEgressEndpoint)(nil),        // 128: apipb.TunnelEncapSubTLVEgressEndpoint
+	(*TunnelEncapSubTLVUDPDestPort)(nil),           // 129: apipb.TunnelEncapSubTLVUDPDestPort
+	(*TunnelEncapSubTLVDSField)(nil),               // 130: apipb.TunnelEncapSubTLVDSField
+	(*TunnelEncapSubTLVEmbeddedLabelHandling)(nil), // 131: apipb.TunnelEncapSubTLVEmbeddedLabelHandling
+	(*MPLSLabelStackEntry)(nil),                    // 132: apipb.MPLSLabelStackEntry
+	(*TunnelEncapSubTLVMPLSLabelStack)(nil),        // 133: apipb.TunnelEncapSubTLVMPLSLabelStack
+	(*TunnelEncapSubTLVPrefixSID)(nil),             // 134: apipb.TunnelEncapSubTLVPrefixSID
+	(*TunnelEncapSubTLVUnknown)(nil),               // 135: apipb.TunnelEncapSubTLVUnknown
+	(*TunnelEncapTLV)(nil),                         // 136: apipb.TunnelEncapTLV
+	(*TunnelEncapAttribute)(nil),                   // 137: apipb.TunnelEncapAttribute
+	(*IPv6AddressSpecificExtended)(nil),            // 138: apipb.IPv6AddressSpecificExtended
+	(*RedirectIPv6AddressSpecificExtended)(nil),    // 139: apipb.RedirectIPv6AddressSpecificExtended
+	(*IP6ExtendedCommunitiesAttribute)(nil),        // 140: apipb.IP6ExtendedCommunitiesAttribute
+	(*AigpTLVIGPMetric)(nil),                       // 141: apipb.AigpTLVIGPMetric
+	(*AigpTLVUnknown)(nil),                         // 142: apipb.AigpTLVUnknown
+	(*AigpAttribute)(nil),                          // 143: apipb.AigpAttribute
+	(*LargeCommunity)(nil),                         // 144: apipb.LargeCommunity
+	(*LargeCommunitiesAttribute)(nil),              // 145: apipb.LargeCommunitiesAttribute
+	(*LsNodeFlags)(nil),                            // 146: apipb.LsNodeFlags
+	(*LsIGPFlags)(nil),                             // 147: apipb.LsIGPFlags
+	(*LsSrRange)(nil),                              // 148: apipb.LsSrRange
+	(*LsSrCapabilities)(nil),                       // 149: apipb.LsSrCapabilities
+	(*LsSrLocalBlock)(nil),                         // 150: apipb.LsSrLocalBlock
+	(*LsAttributeNode)(nil),                        // 151: apipb.LsAttributeNode
+	(*LsAttributeLink)(nil),                        // 152: apipb.LsAttributeLink
+	(*LsAttributePrefix)(nil),                      // 153: apipb.LsAttributePrefix
+	(*LsBgpPeerSegmentSIDFlags)(nil),               // 154: apipb.LsBgpPeerSegmentSIDFlags
+	(*LsBgpPeerSegmentSID)(nil),                    // 155: apipb.LsBgpPeerSegmentSID
+	(*LsAttributeBgpPeerSegment)(nil),              // 156: apipb.LsAttributeBgpPeerSegment
+	(*LsSrBindingSIDFlags)(nil),                    // 157: apipb.LsSrBindingSIDFlags
+	(*LsSrBindingSID)(nil),                         // 158: apipb.LsSrBindingSID
+	(*LsSrCandidatePathStateFlags)(nil),            // 159: apipb.LsSrCandidatePathStateFlags
+	(*LsSrCandidatePathState)(nil),                 // 160: apipb.LsSrCandidatePathState
+	(*LsSrSegment)(nil),                            // 161: apipb.LsSrSegment
+	(*LsSrSegmentList)(nil),                        // 162: apipb.LsSrSegmentList
+	(*LsAttributeSrPolicy)(nil),                    // 163: apipb.LsAttributeSrPolicy
+	(*LsAttribute)(nil),                            // 164: apipb.LsAttribute
+	(*UnknownAttribute)(nil),                       // 165: apipb.UnknownAttribute
+	(*SRv6StructureSubSubTLV)(nil),                 // 166: apipb.SRv6StructureSubSubTLV
+	(*SRv6SIDFlags)(nil),                           // 167: apipb.SRv6SIDFlags
+	(*SRv6TLV)(nil),                                // 168: apipb.SRv6TLV
+	(*SRv6InformationSubTLV)(nil),                  // 169: apipb.SRv6InformationSubTLV
+	(*SRv6L3ServiceTLV)(nil),                       // 170: apipb.SRv6L3ServiceTLV
+	(*SRv6L2ServiceTLV)(nil),                       // 171: apipb.SRv6L2ServiceTLV
+	(*PrefixSID)(nil),                              // 172: apipb.PrefixSID
+	nil,                                            // 173: apipb.SRv6InformationSubTLV.SubSubTlvsEntry
+	nil,                                            // 174: apipb.SRv6L3ServiceTLV.SubTlvsEntry
+	nil,                                            // 175: apipb.SRv6L2ServiceTLV.SubTlvsEntry
+	(*anypb.Any)(nil),                              // 176: google.protobuf.Any
+	(*Family)(nil),                                 // 177: apipb.Family
+}
+var file_attribute_proto_depIdxs = []int32{
+	5,   // 0: apipb.AsSegment.type:type_name -> apipb.AsSegment.Type
+	7,   // 1: apipb.AsPathAttribute.segments:type_name -> apipb.AsSegment
+	176, // 2: apipb.VPLSNLRI.rd:type_name -> google.protobuf.Any
+	176, // 3: apipb.EVPNEthernetAutoDiscoveryRoute.rd:type_name -> google.protobuf.Any
+	23,  // 4: apipb.EVPNEthernetAutoDiscoveryRoute.esi:type_name -> apipb.EthernetSegmentIdentifier
+	176, // 5: apipb.EVPNMACIPAdvertisementRoute.rd:type_name -> google.protobuf.Any
+	23,  // 6: apipb.EVPNMACIPAdvertisementRoute.esi:type_name -> apipb.EthernetSegmentIdentifier
+	176, // 7: apipb.EVPNInclusiveMulticastEthernetTagRoute.rd:type_name -> google.protobuf.Any
+	176, // 8: apipb.EVPNEthernetSegmentRoute.rd:type_name -> google.protobuf.Any
+	23,  // 9: apipb.EVPNEthernetSegmentRoute.esi:type_name -> apipb.EthernetSegmentIdentifier
+	176, // 10: apipb.EVPNIPPrefixRoute.rd:type_name -> google.protobuf.Any
+	23,  // 11: apipb.EVPNIPPrefixRoute.esi:type_name -> apipb.EthernetSegmentIdentifier
+	176, // 12: apipb.EVPNSelectiveMulticastEthernetTagRoute.rd:type_name -> google.protobuf.Any
+	176, // 13: apipb.EVPNMulticastJoinSynchRoute.rd:type_name -> google.protobuf.Any
+	23,  // 14: apipb.EVPNMulticastJoinSynchRoute.esi:type_name -> apipb.EthernetSegmentIdentifier
+	176, // 15: apipb.EVPNMulticastLeaveSynchRoute.rd:type_name -> google.protobuf.Any
+	23,  // 16: apipb.EVPNMulticastLeaveSynchRoute.esi:type_name -> apipb.EthernetSegmentIdentifier
+	176, // 17: apipb.EVPNIPMSIRoute.rd:type_name -> google.protobuf.Any
+	176, // 18: apipb.EVPNIPMSIRoute.rt:type_name -> google.protobuf.Any
+	176, // 19: apipb.LabeledVPNIPAddressPrefix.rd:type_name -> google.protobuf.Any
+	176, // 20: apipb.RouteTargetMembershipNLRI.rt:type_name -> google.protobuf.Any
+	39,  // 21: apipb.FlowSpecComponent.items:type_name -> apipb.FlowSpecComponentItem
+	176, // 22: apipb.FlowSpecNLRI.rules:type_name -> google.protobuf.Any
+	176, // 23: apipb.VPNFlowSpecNLRI.rd:type_name -> google.protobuf.Any
+	176, // 24: apipb.VPNFlowSpecNLRI.rules:type_name -> google.protobuf.Any
+	0,   // 25: apipb.LsPrefixDescriptor.ospf_route_type:type_name -> apipb.LsOspfRouteType
+	44,  // 26: apipb.LsNodeNLRI.local_node:type_name -> apipb.LsNodeDescriptor
+	44,  // 27: apipb.LsLinkNLRI.local_node:type_name -> apipb.LsNodeDescriptor
+	44,  // 28: apipb.LsLinkNLRI.remote_node:type_name -> apipb.LsNodeDescriptor
+	45,  // 29: apipb.LsLinkNLRI.link_descriptor:type_name -> apipb.LsLinkDescriptor
+	44,  // 30: apipb.LsPrefixV4NLRI.local_node:type_name -> apipb.LsNodeDescriptor
+	46,  // 31: apipb.LsPrefixV4NLRI.prefix_descriptor:type_name -> apipb.LsPrefixDescriptor
+	44,  // 32: apipb.LsPrefixV6NLRI.local_node:type_name -> apipb.LsNodeDescriptor
+	46,  // 33: apipb.LsPrefixV6NLRI.prefix_descriptor:type_name -> apipb.LsPrefixDescriptor
+	44,  // 34: apipb.LsSrPolicyCandidatePathNLRI.local_node:type_name -> apipb.LsNodeDescriptor
+	51,  // 35: apipb.LsSrPolicyCandidatePathNLRI.candidate_path_descriptor:type_name -> apipb.LsSrPolicyCandidatePathDescriptor
+	1,   // 36: apipb.LsAddrPrefix.type:type_name -> apipb.LsNLRIType
+	176, // 37: apipb.LsAddrPrefix.nlri:type_name -> google.protobuf.Any
+	2,   // 38: apipb.LsAddrPrefix.protocol_id:type_name -> apipb.LsProtocolID
+	176, // 39: apipb.MUPInterworkSegmentDiscoveryRoute.rd:type_name -> google.protobuf.Any
+	176, // 40: apipb.MUPDirectSegmentDiscoveryRoute.rd:type_name -> google.protobuf.Any
+	176, // 41: apipb.MUPType1SessionTransformedRoute.rd:type_name -> google.protobuf.Any
+	176, // 42: apipb.MUPType2SessionTransformedRoute.rd:type_name -> google.protobuf.Any
+	176, // 43: apipb.MVPNIntraASIPMSIADRoute.rd:type_name -> google.protobuf.Any
+	176, // 44: apipb.MVPNInterASIPMSIADRoute.rd:type_name -> google.protobuf.Any
+	176, // 45: apipb.MVPNSPMSIADRoute.rd:type_name -> google.protobuf.Any
+	176, // 46: apipb.MVPNLeafADRoute.route_key:type_name -> google.protobuf.Any
+	176, // 47: apipb.MVPNSourceActiveADRoute.rd:type_name -> google.protobuf.Any
+	176, // 48: apipb.MVPNSharedTreeJoinRoute.rd:type_name -> google.protobuf.Any
+	176, // 49: apipb.MVPNSourceTreeJoinRoute.rd:type_name -> google.protobuf.Any
+	176, // 50: apipb.CARColorAwareRoute.tlvs:type_name -> google.protobuf.Any
+	176, // 51: apipb.CARUnknownRoute.tlvs:type_name -> google.protobuf.Any
+	177, // 52: apipb.MpReachNLRIAttribute.family:type_name -> apipb.Family
+	176, // 53: apipb.MpReachNLRIAttribute.nlris:type_name -> google.protobuf.Any
+	177, // 54: apipb.MpUnreachNLRIAttribute.family:type_name -> apipb.Family
+	176, // 55: apipb.MpUnreachNLRIAttribute.nlris:type_name -> google.protobuf.Any
+	176, // 56: apipb.ExtendedCommunitiesAttribute.communities:type_name -> google.protobuf.Any
+	7,   // 57: apipb.As4PathAttribute.segments:type_name -> apipb.AsSegment
+	176, // 58: apipb.TunnelEncapSubTLVSRBindingSID.bsid:type_name -> google.protobuf.Any
+	3,   // 59: apipb.SRv6EndPointBehavior.behavior:type_name -> apipb.SRv6Behavior
+	111, // 60: apipb.SRv6BindingSID.endpoint_behavior_structure:type_name -> apipb.SRv6EndPointBehavior
+	4,   // 61: apipb.TunnelEncapSubTLVSRENLP.enlp:type_name -> apipb.ENLPType
+	115, // 62: apipb.SegmentTypeA.flags:type_name -> apipb.SegmentFlags
+	115, // 63: apipb.SegmentTypeB.flags:type_name -> apipb.SegmentFlags
+	111, // 64: apipb.SegmentTypeB.endpoint_behavior_structure:type_name -> apipb.SRv6EndPointBehavior
+	115, // 65: apipb.SegmentTypeC.flags:type_name -> apipb.SegmentFlags
+	115, // 66: apipb.SegmentTypeD.flags:type_name -> apipb.SegmentFlags
+	115, // 67: apipb.SegmentTypeE.flags:type_name -> apipb.SegmentFlags
+	115, // 68: apipb.SegmentTypeF.flags:type_name -> apipb.SegmentFlags
+	115, // 69: apipb.SegmentTypeG.flags:type_name -> apipb.SegmentFlags
+	115, // 70: apipb.SegmentTypeH.flags:type_name -> apipb.SegmentFlags
+	115, // 71: apipb.SegmentTypeI.flags:type_name -> apipb.SegmentFlags
+	111, // 72: apipb.SegmentTypeI.endpoint_behavior_structure:type_name -> apipb.SRv6EndPointBehavior
+	115, // 73: apipb.SegmentTypeJ.flags:type_name -> apipb.SegmentFlags
+	111, // 74: apipb.SegmentTypeJ.endpoint_behavior_structure:type_name -> apipb.SRv6EndPointBehavior
+	115, // 75: apipb.SegmentTypeK.flags:type_name -> apipb.SegmentFlags
+	111, // 76: apipb.SegmentTypeK.endpoint_behavior_structure:type_name -> apipb.SRv6EndPointBehavior
+	114, // 77: apipb.TunnelEncapSubTLVSRSegmentList.weight:type_name -> apipb.SRWeight
+	176, // 78: apipb.TunnelEncapSubTLVSRSegmentList.segments:type_name -> google.protobuf.Any
+	132, // 79: apipb.TunnelEncapSubTLVMPLSLabelStack.entries:type_name -> apipb.MPLSLabelStackEntry
+	176, // 80: apipb.TunnelEncapTLV.tlvs:type_name -> google.protobuf.Any
+	136, // 81: apipb.TunnelEncapAttribute.tlvs:type_name -> apipb.TunnelEncapTLV
+	176, // 82: apipb.IP6ExtendedCommunitiesAttribute.communities:type_name -> google.protobuf.Any
+	176, // 83: apipb.AigpAttribute.tlvs:type_name -> google.protobuf.Any
+	144, // 84: apipb.LargeCommunitiesAttribute.communities:type_name -> apipb.LargeCommunity
+	148, // 85: apipb.LsSrCapabilities.ranges:type_name -> apipb.LsSrRange
+	148, // 86: apipb.LsSrLocalBlock.ranges:type_name -> apipb.LsSrRange
+	146, // 87: apipb.LsAttributeNode.flags:type_name -> apipb.LsNodeFlags
+	149, // 88: apipb.LsAttributeNode.sr_capabilities:type_name -> apipb.LsSrCapabilities
+	150, // 89: apipb.LsAttributeNode.sr_local_block:type_name -> apipb.LsSrLocalBlock
+	147, // 90: apipb.LsAttributePrefix.igp_flags:type_name -> apipb.LsIGPFlags
+	154, // 91: apipb.LsBgpPeerSegmentSID.flags:type_name -> apipb.LsBgpPeerSegmentSIDFlags
+	155, // 92: apipb.LsAttributeBgpPeerSegment.bgp_peer_node_sid:type_name -> apipb.LsBgpPeerSegmentSID
+	155, // 93: apipb.LsAttributeBgpPeerSegment.bgp_peer_adjacency_sid:type_name -> apipb.LsBgpPeerSegmentSID
+	155, // 94: apipb.LsAttributeBgpPeerSegment.bgp_peer_set_sid:type_name -> apipb.LsBgpPeerSegmentSID
+	157, // 95: apipb.LsSrBindingSID.flags:type_name -> apipb.LsSrBindingSIDFlags
+	159, // 96: apipb.LsSrCandidatePathState.flags:type_name -> apipb.LsSrCandidatePathStateFlags
+	161, // 97: apipb.LsSrSegmentList.segments:type_name -> apipb.LsSrSegment
+	158, // 98: apipb.LsAttributeSrPolicy.binding_sid:type_name -> apipb.LsSrBindingSID
+	160, // 99: apipb.LsAttributeSrPolicy.candidate_path_state:type_name -> apipb.LsSrCandidatePathState
+	162, // 100: apipb.LsAttributeSrPolicy.segment_lists:type_name -> apipb.LsSrSegmentList
+	151, // 101: apipb.LsAttribute.node:type_name -> apipb.LsAttributeNode
+	152, // 102: apipb.LsAttribute.link:type_name -> apipb.LsAttributeLink
+	153, // 103: apipb.LsAttribute.prefix:type_name -> apipb.LsAttributePrefix
+	156, // 104: apipb.LsAttribute.bgp_peer_segment:type_name -> apipb.LsAttributeBgpPeerSegment
+	163, // 105: apipb.LsAttribute.sr_policy:type_name -> apipb.LsAttributeSrPolicy
+	176, // 106: apipb.SRv6TLV.tlv:type_name -> google.protobuf.Any
+	167, // 107: apipb.SRv6InformationSubTLV.flags:type_name -> apipb.SRv6SIDFlags
+	173, // 108: apipb.SRv6InformationSubTLV.sub_sub_tlvs:type_name -> apipb.SRv6InformationSubTLV.SubSubTlvsEntry
+	174, // 109: apipb.SRv6L3ServiceTLV.sub_tlvs:type_name -> apipb.SRv6L3ServiceTLV.SubTlvsEntry
+	175, // 110: apipb.SRv6L2ServiceTLV.sub_tlvs:type_name -> apipb.SRv6L2ServiceTLV.SubTlvsEntry
+	176, // 111: apipb.PrefixSID.tlvs:type_name -> google.protobuf.Any
+	168, // 112: apipb.SRv6InformationSubTLV.SubSubTlvsEntry.value:type_name -> apipb.SRv6TLV
+	168, // 113: apipb.SRv6L3ServiceTLV.SubTlvsEntry.value:type_name -> apipb.SRv6TLV
+	168, // 114: apipb.SRv6L2ServiceTLV.SubTlvsEntry.value:type_name -> apipb.SRv6TLV
+	115, // [115:115] is the sub-list for method output_type
+	115, // [115:115] is the sub-list for method input_type
+	115, // [115:115] is the sub-list for extension type_name
+	115, // [115:115] is the sub-list for extension extendee
+	0,   // [0:115] is the sub-list for field type_name
+}
+
+func init() { file_attribute_proto_init() }
+func file_attribute_proto_init() {
+	if File_attribute_proto != nil {
+		return
+	}
+	file_gobgp_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_attribute_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OriginAttribute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsSegment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsPathAttribute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NextHopAttribute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiExitDiscAttribute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalPrefAttribute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AtomicAggregateAttribute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregatorAttribute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommunitiesAttribute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OriginatorIdAttribute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterListAttribute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IPAddressPrefix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LabeledIPAddressPrefix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncapsulationNLRI); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteDistinguisherTwoOctetASN); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteDistinguisherIPAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteDistinguisherFourOctetASN); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthernetSegmentIdentifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VPLSNLRI); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EVPNEthernetAutoDiscoveryRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EVPNMACIPAdvertisementRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EVPNInclusiveMulticastEthernetTagRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EVPNEthernetSegmentRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EVPNIPPrefixRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EVPNSelectiveMulticastEthernetTagRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EVPNMulticastJoinSynchRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EVPNMulticastLeaveSynchRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EVPNIPMSIRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SRPolicyNLRI); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LabeledVPNIPAddressPrefix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteTargetMembershipNLRI); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlowSpecIPPrefix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlowSpecMAC); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlowSpecComponentItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9286,8 +13340,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AsSegment); i {
+		file_attribute_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlowSpecComponent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9298,8 +13352,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AsPathAttribute); i {
+		file_attribute_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlowSpecNLRI); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9310,8 +13364,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NextHopAttribute); i {
+		file_attribute_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VPNFlowSpecNLRI); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9322,8 +13376,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiExitDiscAttribute); i {
+		file_attribute_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpaqueNLRI); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9334,8 +13388,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocalPrefAttribute); i {
+		file_attribute_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsNodeDescriptor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9346,8 +13400,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AtomicAggregateAttribute); i {
+		file_attribute_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsLinkDescriptor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9358,8 +13412,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AggregatorAttribute); i {
+		file_attribute_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsPrefixDescriptor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9370,8 +13424,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommunitiesAttribute); i {
+		file_attribute_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsNodeNLRI); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9382,8 +13436,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OriginatorIdAttribute); i {
+		file_attribute_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsLinkNLRI); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9394,8 +13448,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterListAttribute); i {
+		file_attribute_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsPrefixV4NLRI); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9406,8 +13460,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IPAddressPrefix); i {
+		file_attribute_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsPrefixV6NLRI); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9418,8 +13472,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LabeledIPAddressPrefix); i {
+		file_attribute_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsSrPolicyCandidatePathDescriptor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9430,8 +13484,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncapsulationNLRI); i {
+		file_attribute_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsSrPolicyCandidatePathNLRI); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9442,8 +13496,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RouteDistinguisherTwoOctetASN); i {
+		file_attribute_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsAddrPrefix); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9454,8 +13508,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RouteDistinguisherIPAddress); i {
+		file_attribute_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MUPInterworkSegmentDiscoveryRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9466,8 +13520,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RouteDistinguisherFourOctetASN); i {
+		file_attribute_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MUPDirectSegmentDiscoveryRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9478,8 +13532,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthernetSegmentIdentifier); i {
+		file_attribute_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MUPType1SessionTransformedRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9490,8 +13544,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VPLSNLRI); i {
+		file_attribute_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MUPType2SessionTransformedRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9502,8 +13556,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EVPNEthernetAutoDiscoveryRoute); i {
+		file_attribute_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MVPNIntraASIPMSIADRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9514,8 +13568,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EVPNMACIPAdvertisementRoute); i {
+		file_attribute_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MVPNInterASIPMSIADRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9526,8 +13580,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EVPNInclusiveMulticastEthernetTagRoute); i {
+		file_attribute_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MVPNSPMSIADRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9538,8 +13592,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EVPNEthernetSegmentRoute); i {
+		file_attribute_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MVPNLeafADRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9550,8 +13604,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EVPNIPPrefixRoute); i {
+		file_attribute_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MVPNSourceActiveADRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9562,8 +13616,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EVPNIPMSIRoute); i {
+		file_attribute_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MVPNSharedTreeJoinRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9574,8 +13628,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SRPolicyNLRI); i {
+		file_attribute_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MVPNSourceTreeJoinRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9586,8 +13640,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LabeledVPNIPAddressPrefix); i {
+		file_attribute_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CARColorAwareRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9598,8 +13652,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RouteTargetMembershipNLRI); i {
+		file_attribute_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CARUnknownRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9610,8 +13664,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FlowSpecIPPrefix); i {
+		file_attribute_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CARLabelTLV); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9622,8 +13676,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FlowSpecMAC); i {
+		file_attribute_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CARLabelIndexTLV); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9634,8 +13688,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FlowSpecComponentItem); i {
+		file_attribute_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CARSRv6SIDTLV); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9646,8 +13700,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FlowSpecComponent); i {
+		file_attribute_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CARUnknownTLV); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9658,8 +13712,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FlowSpecNLRI); i {
+		file_attribute_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MpReachNLRIAttribute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9670,8 +13724,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VPNFlowSpecNLRI); i {
+		file_attribute_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MpUnreachNLRIAttribute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9682,8 +13736,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpaqueNLRI); i {
+		file_attribute_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TwoOctetAsSpecificExtended); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9694,8 +13748,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsNodeDescriptor); i {
+		file_attribute_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IPv4AddressSpecificExtended); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9706,8 +13760,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsLinkDescriptor); i {
+		file_attribute_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FourOctetAsSpecificExtended); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9718,8 +13772,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsPrefixDescriptor); i {
+		file_attribute_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkBandwidthExtended); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9730,8 +13784,128 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsNodeNLRI); i {
+		file_attribute_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidationExtended); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorExtended); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncapExtended); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DefaultGatewayExtended); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpaqueExtended); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ESILabelExtended); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ESImportRouteTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MacMobilityExtended); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouterMacExtended); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Layer2AttributesExtended); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_attribute_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DFElectionExtended); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9742,8 +13916,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsLinkNLRI); i {
+		file_attribute_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficRateExtended); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9754,8 +13928,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsPrefixV4NLRI); i {
+		file_attribute_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficActionExtended); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9766,8 +13940,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsPrefixV6NLRI); i {
+		file_attribute_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedirectTwoOctetAsSpecificExtended); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9778,8 +13952,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsAddrPrefix); i {
+		file_attribute_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedirectIPv4AddressSpecificExtended); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9790,8 +13964,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MUPInterworkSegmentDiscoveryRoute); i {
+		file_attribute_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedirectFourOctetAsSpecificExtended); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9802,8 +13976,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MUPDirectSegmentDiscoveryRoute); i {
+		file_attribute_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficRemarkExtended); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9814,8 +13988,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MUPType1SessionTransformedRoute); i {
+		file_attribute_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InterfaceSetExtended); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9826,8 +14000,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MUPType2SessionTransformedRoute); i {
+		file_attribute_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MUPExtended); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9838,8 +14012,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MpReachNLRIAttribute); i {
+		file_attribute_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VPLSExtended); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9850,8 +14024,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MpUnreachNLRIAttribute); i {
+		file_attribute_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransportClassExtended); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9862,8 +14036,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TwoOctetAsSpecificExtended); i {
+		file_attribute_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnknownExtended); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9874,8 +14048,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IPv4AddressSpecificExtended); i {
+		file_attribute_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtendedCommunitiesAttribute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9886,8 +14060,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FourOctetAsSpecificExtended); i {
+		file_attribute_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*As4PathAttribute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9898,8 +14072,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LinkBandwidthExtended); i {
+		file_attribute_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*As4AggregatorAttribute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9910,8 +14084,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidationExtended); i {
+		file_attribute_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PmsiTunnelAttribute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9922,8 +14096,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ColorExtended); i {
+		file_attribute_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelEncapSubTLVEncapsulation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9934,8 +14108,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncapExtended); i {
+		file_attribute_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelEncapSubTLVProtocol); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9946,8 +14120,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefaultGatewayExtended); i {
+		file_attribute_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelEncapSubTLVColor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9958,8 +14132,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpaqueExtended); i {
+		file_attribute_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelEncapSubTLVSRPreference); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9970,8 +14144,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ESILabelExtended); i {
+		file_attribute_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelEncapSubTLVSRCandidatePathName); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9982,8 +14156,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ESImportRouteTarget); i {
+		file_attribute_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelEncapSubTLVSRPriority); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9994,8 +14168,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MacMobilityExtended); i {
+		file_attribute_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelEncapSubTLVSRBindingSID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10006,8 +14180,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RouterMacExtended); i {
+		file_attribute_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SRBindingSID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10018,8 +14192,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrafficRateExtended); i {
+		file_attribute_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SRv6EndPointBehavior); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10030,8 +14204,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrafficActionExtended); i {
+		file_attribute_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SRv6BindingSID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10042,8 +14216,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RedirectTwoOctetAsSpecificExtended); i {
+		file_attribute_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelEncapSubTLVSRENLP); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10054,8 +14228,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RedirectIPv4AddressSpecificExtended); i {
+		file_attribute_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SRWeight); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10066,8 +14240,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RedirectFourOctetAsSpecificExtended); i {
+		file_attribute_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SegmentFlags); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10078,8 +14252,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrafficRemarkExtended); i {
+		file_attribute_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SegmentTypeA); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10090,8 +14264,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MUPExtended); i {
+		file_attribute_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SegmentTypeB); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10102,8 +14276,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VPLSExtended); i {
+		file_attribute_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SegmentTypeC); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10114,8 +14288,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnknownExtended); i {
+		file_attribute_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SegmentTypeD); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10126,8 +14300,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtendedCommunitiesAttribute); i {
+		file_attribute_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SegmentTypeE); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10138,8 +14312,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*As4PathAttribute); i {
+		file_attribute_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SegmentTypeF); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10150,8 +14324,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*As4AggregatorAttribute); i {
+		file_attribute_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SegmentTypeG); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10162,8 +14336,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PmsiTunnelAttribute); i {
+		file_attribute_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SegmentTypeH); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10174,8 +14348,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TunnelEncapSubTLVEncapsulation); i {
+		file_attribute_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SegmentTypeI); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10186,8 +14360,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TunnelEncapSubTLVProtocol); i {
+		file_attribute_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SegmentTypeJ); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10198,8 +14372,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TunnelEncapSubTLVColor); i {
+		file_attribute_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SegmentTypeK); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10210,8 +14384,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TunnelEncapSubTLVSRPreference); i {
+		file_attribute_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelEncapSubTLVSRSegmentList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10222,8 +14396,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TunnelEncapSubTLVSRCandidatePathName); i {
+		file_attribute_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelEncapSubTLVEgressEndpoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10234,8 +14408,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TunnelEncapSubTLVSRPriority); i {
+		file_attribute_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelEncapSubTLVUDPDestPort); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10246,8 +14420,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TunnelEncapSubTLVSRBindingSID); i {
+		file_attribute_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelEncapSubTLVDSField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10258,8 +14432,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SRBindingSID); i {
+		file_attribute_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelEncapSubTLVEmbeddedLabelHandling); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10270,8 +14444,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SRv6EndPointBehavior); i {
+		file_attribute_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MPLSLabelStackEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10282,8 +14456,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SRv6BindingSID); i {
+		file_attribute_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelEncapSubTLVMPLSLabelStack); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10294,8 +14468,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TunnelEncapSubTLVSRENLP); i {
+		file_attribute_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelEncapSubTLVPrefixSID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10306,8 +14480,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SRWeight); i {
+		file_attribute_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelEncapSubTLVUnknown); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10318,8 +14492,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SegmentFlags); i {
+		file_attribute_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelEncapTLV); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10330,8 +14504,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SegmentTypeA); i {
+		file_attribute_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelEncapAttribute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10342,8 +14516,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SegmentTypeB); i {
+		file_attribute_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IPv6AddressSpecificExtended); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10354,8 +14528,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TunnelEncapSubTLVSRSegmentList); i {
+		file_attribute_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedirectIPv6AddressSpecificExtended); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10366,8 +14540,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TunnelEncapSubTLVEgressEndpoint); i {
+		file_attribute_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IP6ExtendedCommunitiesAttribute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10378,8 +14552,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TunnelEncapSubTLVUDPDestPort); i {
+		file_attribute_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AigpTLVIGPMetric); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10390,8 +14564,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TunnelEncapSubTLVUnknown); i {
+		file_attribute_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AigpTLVUnknown); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10402,8 +14576,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TunnelEncapTLV); i {
+		file_attribute_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AigpAttribute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10414,8 +14588,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TunnelEncapAttribute); i {
+		file_attribute_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LargeCommunity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10426,8 +14600,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IPv6AddressSpecificExtended); i {
+		file_attribute_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LargeCommunitiesAttribute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10438,8 +14612,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RedirectIPv6AddressSpecificExtended); i {
+		file_attribute_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsNodeFlags); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10450,8 +14624,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IP6ExtendedCommunitiesAttribute); i {
+		file_attribute_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsIGPFlags); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10462,8 +14636,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AigpTLVIGPMetric); i {
+		file_attribute_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsSrRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10474,8 +14648,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AigpTLVUnknown); i {
+		file_attribute_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsSrCapabilities); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10486,8 +14660,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AigpAttribute); i {
+		file_attribute_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsSrLocalBlock); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10498,8 +14672,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LargeCommunity); i {
+		file_attribute_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsAttributeNode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10510,8 +14684,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LargeCommunitiesAttribute); i {
+		file_attribute_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsAttributeLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10522,8 +14696,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsNodeFlags); i {
+		file_attribute_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsAttributePrefix); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10534,8 +14708,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsIGPFlags); i {
+		file_attribute_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsBgpPeerSegmentSIDFlags); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10546,8 +14720,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsSrRange); i {
+		file_attribute_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsBgpPeerSegmentSID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10558,8 +14732,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsSrCapabilities); i {
+		file_attribute_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsAttributeBgpPeerSegment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10570,8 +14744,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsSrLocalBlock); i {
+		file_attribute_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsSrBindingSIDFlags); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10582,8 +14756,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsAttributeNode); i {
+		file_attribute_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsSrBindingSID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10594,8 +14768,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsAttributeLink); i {
+		file_attribute_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsSrCandidatePathStateFlags); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10606,8 +14780,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsAttributePrefix); i {
+		file_attribute_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsSrCandidatePathState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10618,8 +14792,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsBgpPeerSegmentSIDFlags); i {
+		file_attribute_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsSrSegment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10630,8 +14804,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsBgpPeerSegmentSID); i {
+		file_attribute_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsSrSegmentList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10642,8 +14816,8 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LsAttributeBgpPeerSegment); i {
+		file_attribute_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LsAttributeSrPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10654,7 +14828,7 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+		file_attribute_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LsAttribute); i {
 			case 0:
 				return &v.state
@@ -10666,7 +14840,7 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+		file_attribute_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnknownAttribute); i {
 			case 0:
 				return &v.state
@@ -10678,7 +14852,7 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+		file_attribute_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SRv6StructureSubSubTLV); i {
 			case 0:
 				return &v.state
@@ -10690,7 +14864,7 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+		file_attribute_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SRv6SIDFlags); i {
 			case 0:
 				return &v.state
@@ -10702,7 +14876,7 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+		file_attribute_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SRv6TLV); i {
 			case 0:
 				return &v.state
@@ -10714,7 +14888,7 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+		file_attribute_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SRv6InformationSubTLV); i {
 			case 0:
 				return &v.state
@@ -10726,7 +14900,7 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+		file_attribute_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SRv6L3ServiceTLV); i {
 			case 0:
 				return &v.state
@@ -10738,7 +14912,7 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+		file_attribute_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SRv6L2ServiceTLV); i {
 			case 0:
 				return &v.state
@@ -10750,7 +14924,7 @@ func file_attribute_proto_init() {
 				return nil
 			}
 		}
-		file_attribute_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+		file_attribute_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PrefixSID); i {
 			case 0:
 				return &v.state
@@ -10769,7 +14943,7 @@ func file_attribute_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_attribute_proto_rawDesc,
 			NumEnums:      6,
-			NumMessages:   127,
+			NumMessages:   170,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
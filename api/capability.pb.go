@@ -41,6 +41,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type OutboundRouteFilteringCapabilityEntry_Mode int32
+
+const (
+	OutboundRouteFilteringCapabilityEntry_NONE    OutboundRouteFilteringCapabilityEntry_Mode = 0
+	OutboundRouteFilteringCapabilityEntry_RECEIVE OutboundRouteFilteringCapabilityEntry_Mode = 1
+	OutboundRouteFilteringCapabilityEntry_SEND    OutboundRouteFilteringCapabilityEntry_Mode = 2
+	OutboundRouteFilteringCapabilityEntry_BOTH    OutboundRouteFilteringCapabilityEntry_Mode = 3
+)
+
+// Enum value maps for OutboundRouteFilteringCapabilityEntry_Mode.
+var (
+	OutboundRouteFilteringCapabilityEntry_Mode_name = map[int32]string{
+		0: "NONE",
+		1: "RECEIVE",
+		2: "SEND",
+		3: "BOTH",
+	}
+	OutboundRouteFilteringCapabilityEntry_Mode_value = map[string]int32{
+		"NONE":    0,
+		"RECEIVE": 1,
+		"SEND":    2,
+		"BOTH":    3,
+	}
+)
+
+func (x OutboundRouteFilteringCapabilityEntry_Mode) Enum() *OutboundRouteFilteringCapabilityEntry_Mode {
+	p := new(OutboundRouteFilteringCapabilityEntry_Mode)
+	*p = x
+	return p
+}
+
+func (x OutboundRouteFilteringCapabilityEntry_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OutboundRouteFilteringCapabilityEntry_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_capability_proto_enumTypes[0].Descriptor()
+}
+
+func (OutboundRouteFilteringCapabilityEntry_Mode) Type() protoreflect.EnumType {
+	return &file_capability_proto_enumTypes[0]
+}
+
+func (x OutboundRouteFilteringCapabilityEntry_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OutboundRouteFilteringCapabilityEntry_Mode.Descriptor instead.
+func (OutboundRouteFilteringCapabilityEntry_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_capability_proto_rawDescGZIP(), []int{2, 0}
+}
+
 type AddPathCapabilityTuple_Mode int32
 
 const (
@@ -77,11 +129,11 @@ func (x AddPathCapabilityTuple_Mode) String() string {
 }
 
 func (AddPathCapabilityTuple_Mode) Descriptor() protoreflect.EnumDescriptor {
-	return file_capability_proto_enumTypes[0].Descriptor()
+	return file_capability_proto_enumTypes[1].Descriptor()
 }
 
 func (AddPathCapabilityTuple_Mode) Type() protoreflect.EnumType {
-	return &file_capability_proto_enumTypes[0]
+	return &file_capability_proto_enumTypes[1]
 }
 
 func (x AddPathCapabilityTuple_Mode) Number() protoreflect.EnumNumber {
@@ -90,7 +142,7 @@ func (x AddPathCapabilityTuple_Mode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AddPathCapabilityTuple_Mode.Descriptor instead.
 func (AddPathCapabilityTuple_Mode) EnumDescriptor() ([]byte, []int) {
-	return file_capability_proto_rawDescGZIP(), []int{8, 0}
+	return file_capability_proto_rawDescGZIP(), []int{11, 0}
 }
 
 type MultiProtocolCapability struct {
@@ -178,6 +230,163 @@ func (*RouteRefreshCapability) Descriptor() ([]byte, []int) {
 	return file_capability_proto_rawDescGZIP(), []int{1}
 }
 
+type OutboundRouteFilteringCapabilityEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type uint32                                     `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Mode OutboundRouteFilteringCapabilityEntry_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=apipb.OutboundRouteFilteringCapabilityEntry_Mode" json:"mode,omitempty"`
+}
+
+func (x *OutboundRouteFilteringCapabilityEntry) Reset() {
+	*x = OutboundRouteFilteringCapabilityEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_capability_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutboundRouteFilteringCapabilityEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboundRouteFilteringCapabilityEntry) ProtoMessage() {}
+
+func (x *OutboundRouteFilteringCapabilityEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_capability_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboundRouteFilteringCapabilityEntry.ProtoReflect.Descriptor instead.
+func (*OutboundRouteFilteringCapabilityEntry) Descriptor() ([]byte, []int) {
+	return file_capability_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *OutboundRouteFilteringCapabilityEntry) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *OutboundRouteFilteringCapabilityEntry) GetMode() OutboundRouteFilteringCapabilityEntry_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return OutboundRouteFilteringCapabilityEntry_NONE
+}
+
+type OutboundRouteFilteringCapabilityTuple struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Family  *Family                                  `protobuf:"bytes,1,opt,name=family,proto3" json:"family,omitempty"`
+	Entries []*OutboundRouteFilteringCapabilityEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *OutboundRouteFilteringCapabilityTuple) Reset() {
+	*x = OutboundRouteFilteringCapabilityTuple{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_capability_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutboundRouteFilteringCapabilityTuple) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboundRouteFilteringCapabilityTuple) ProtoMessage() {}
+
+func (x *OutboundRouteFilteringCapabilityTuple) ProtoReflect() protoreflect.Message {
+	mi := &file_capability_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboundRouteFilteringCapabilityTuple.ProtoReflect.Descriptor instead.
+func (*OutboundRouteFilteringCapabilityTuple) Descriptor() ([]byte, []int) {
+	return file_capability_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OutboundRouteFilteringCapabilityTuple) GetFamily() *Family {
+	if x != nil {
+		return x.Family
+	}
+	return nil
+}
+
+func (x *OutboundRouteFilteringCapabilityTuple) GetEntries() []*OutboundRouteFilteringCapabilityEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type OutboundRouteFilteringCapability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tuples []*OutboundRouteFilteringCapabilityTuple `protobuf:"bytes,1,rep,name=tuples,proto3" json:"tuples,omitempty"`
+}
+
+func (x *OutboundRouteFilteringCapability) Reset() {
+	*x = OutboundRouteFilteringCapability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_capability_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutboundRouteFilteringCapability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboundRouteFilteringCapability) ProtoMessage() {}
+
+func (x *OutboundRouteFilteringCapability) ProtoReflect() protoreflect.Message {
+	mi := &file_capability_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboundRouteFilteringCapability.ProtoReflect.Descriptor instead.
+func (*OutboundRouteFilteringCapability) Descriptor() ([]byte, []int) {
+	return file_capability_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OutboundRouteFilteringCapability) GetTuples() []*OutboundRouteFilteringCapabilityTuple {
+	if x != nil {
+		return x.Tuples
+	}
+	return nil
+}
+
 type CarryingLabelInfoCapability struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -187,7 +396,7 @@ type CarryingLabelInfoCapability struct {
 func (x *CarryingLabelInfoCapability) Reset() {
 	*x = CarryingLabelInfoCapability{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_capability_proto_msgTypes[2]
+		mi := &file_capability_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -200,7 +409,7 @@ func (x *CarryingLabelInfoCapability) String() string {
 func (*CarryingLabelInfoCapability) ProtoMessage() {}
 
 func (x *CarryingLabelInfoCapability) ProtoReflect() protoreflect.Message {
-	mi := &file_capability_proto_msgTypes[2]
+	mi := &file_capability_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -213,7 +422,7 @@ func (x *CarryingLabelInfoCapability) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CarryingLabelInfoCapability.ProtoReflect.Descriptor instead.
 func (*CarryingLabelInfoCapability) Descriptor() ([]byte, []int) {
-	return file_capability_proto_rawDescGZIP(), []int{2}
+	return file_capability_proto_rawDescGZIP(), []int{5}
 }
 
 type ExtendedNexthopCapabilityTuple struct {
@@ -231,7 +440,7 @@ type ExtendedNexthopCapabilityTuple struct {
 func (x *ExtendedNexthopCapabilityTuple) Reset() {
 	*x = ExtendedNexthopCapabilityTuple{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_capability_proto_msgTypes[3]
+		mi := &file_capability_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -244,7 +453,7 @@ func (x *ExtendedNexthopCapabilityTuple) String() string {
 func (*ExtendedNexthopCapabilityTuple) ProtoMessage() {}
 
 func (x *ExtendedNexthopCapabilityTuple) ProtoReflect() protoreflect.Message {
-	mi := &file_capability_proto_msgTypes[3]
+	mi := &file_capability_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -257,7 +466,7 @@ func (x *ExtendedNexthopCapabilityTuple) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtendedNexthopCapabilityTuple.ProtoReflect.Descriptor instead.
 func (*ExtendedNexthopCapabilityTuple) Descriptor() ([]byte, []int) {
-	return file_capability_proto_rawDescGZIP(), []int{3}
+	return file_capability_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ExtendedNexthopCapabilityTuple) GetNlriFamily() *Family {
@@ -285,7 +494,7 @@ type ExtendedNexthopCapability struct {
 func (x *ExtendedNexthopCapability) Reset() {
 	*x = ExtendedNexthopCapability{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_capability_proto_msgTypes[4]
+		mi := &file_capability_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -298,7 +507,7 @@ func (x *ExtendedNexthopCapability) String() string {
 func (*ExtendedNexthopCapability) ProtoMessage() {}
 
 func (x *ExtendedNexthopCapability) ProtoReflect() protoreflect.Message {
-	mi := &file_capability_proto_msgTypes[4]
+	mi := &file_capability_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -311,7 +520,7 @@ func (x *ExtendedNexthopCapability) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtendedNexthopCapability.ProtoReflect.Descriptor instead.
 func (*ExtendedNexthopCapability) Descriptor() ([]byte, []int) {
-	return file_capability_proto_rawDescGZIP(), []int{4}
+	return file_capability_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ExtendedNexthopCapability) GetTuples() []*ExtendedNexthopCapabilityTuple {
@@ -333,7 +542,7 @@ type GracefulRestartCapabilityTuple struct {
 func (x *GracefulRestartCapabilityTuple) Reset() {
 	*x = GracefulRestartCapabilityTuple{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_capability_proto_msgTypes[5]
+		mi := &file_capability_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -346,7 +555,7 @@ func (x *GracefulRestartCapabilityTuple) String() string {
 func (*GracefulRestartCapabilityTuple) ProtoMessage() {}
 
 func (x *GracefulRestartCapabilityTuple) ProtoReflect() protoreflect.Message {
-	mi := &file_capability_proto_msgTypes[5]
+	mi := &file_capability_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -359,7 +568,7 @@ func (x *GracefulRestartCapabilityTuple) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GracefulRestartCapabilityTuple.ProtoReflect.Descriptor instead.
 func (*GracefulRestartCapabilityTuple) Descriptor() ([]byte, []int) {
-	return file_capability_proto_rawDescGZIP(), []int{5}
+	return file_capability_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GracefulRestartCapabilityTuple) GetFamily() *Family {
@@ -389,7 +598,7 @@ type GracefulRestartCapability struct {
 func (x *GracefulRestartCapability) Reset() {
 	*x = GracefulRestartCapability{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_capability_proto_msgTypes[6]
+		mi := &file_capability_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -402,7 +611,7 @@ func (x *GracefulRestartCapability) String() string {
 func (*GracefulRestartCapability) ProtoMessage() {}
 
 func (x *GracefulRestartCapability) ProtoReflect() protoreflect.Message {
-	mi := &file_capability_proto_msgTypes[6]
+	mi := &file_capability_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -415,7 +624,7 @@ func (x *GracefulRestartCapability) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GracefulRestartCapability.ProtoReflect.Descriptor instead.
 func (*GracefulRestartCapability) Descriptor() ([]byte, []int) {
-	return file_capability_proto_rawDescGZIP(), []int{6}
+	return file_capability_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GracefulRestartCapability) GetFlags() uint32 {
@@ -450,7 +659,7 @@ type FourOctetASNCapability struct {
 func (x *FourOctetASNCapability) Reset() {
 	*x = FourOctetASNCapability{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_capability_proto_msgTypes[7]
+		mi := &file_capability_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -463,7 +672,7 @@ func (x *FourOctetASNCapability) String() string {
 func (*FourOctetASNCapability) ProtoMessage() {}
 
 func (x *FourOctetASNCapability) ProtoReflect() protoreflect.Message {
-	mi := &file_capability_proto_msgTypes[7]
+	mi := &file_capability_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -476,7 +685,7 @@ func (x *FourOctetASNCapability) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FourOctetASNCapability.ProtoReflect.Descriptor instead.
 func (*FourOctetASNCapability) Descriptor() ([]byte, []int) {
-	return file_capability_proto_rawDescGZIP(), []int{7}
+	return file_capability_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *FourOctetASNCapability) GetAsn() uint32 {
@@ -498,7 +707,7 @@ type AddPathCapabilityTuple struct {
 func (x *AddPathCapabilityTuple) Reset() {
 	*x = AddPathCapabilityTuple{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_capability_proto_msgTypes[8]
+		mi := &file_capability_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -511,7 +720,7 @@ func (x *AddPathCapabilityTuple) String() string {
 func (*AddPathCapabilityTuple) ProtoMessage() {}
 
 func (x *AddPathCapabilityTuple) ProtoReflect() protoreflect.Message {
-	mi := &file_capability_proto_msgTypes[8]
+	mi := &file_capability_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -524,7 +733,7 @@ func (x *AddPathCapabilityTuple) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddPathCapabilityTuple.ProtoReflect.Descriptor instead.
 func (*AddPathCapabilityTuple) Descriptor() ([]byte, []int) {
-	return file_capability_proto_rawDescGZIP(), []int{8}
+	return file_capability_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *AddPathCapabilityTuple) GetFamily() *Family {
@@ -552,7 +761,7 @@ type AddPathCapability struct {
 func (x *AddPathCapability) Reset() {
 	*x = AddPathCapability{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_capability_proto_msgTypes[9]
+		mi := &file_capability_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -565,7 +774,7 @@ func (x *AddPathCapability) String() string {
 func (*AddPathCapability) ProtoMessage() {}
 
 func (x *AddPathCapability) ProtoReflect() protoreflect.Message {
-	mi := &file_capability_proto_msgTypes[9]
+	mi := &file_capability_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -578,7 +787,7 @@ func (x *AddPathCapability) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddPathCapability.ProtoReflect.Descriptor instead.
 func (*AddPathCapability) Descriptor() ([]byte, []int) {
-	return file_capability_proto_rawDescGZIP(), []int{9}
+	return file_capability_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AddPathCapability) GetTuples() []*AddPathCapabilityTuple {
@@ -597,7 +806,7 @@ type EnhancedRouteRefreshCapability struct {
 func (x *EnhancedRouteRefreshCapability) Reset() {
 	*x = EnhancedRouteRefreshCapability{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_capability_proto_msgTypes[10]
+		mi := &file_capability_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -610,7 +819,7 @@ func (x *EnhancedRouteRefreshCapability) String() string {
 func (*EnhancedRouteRefreshCapability) ProtoMessage() {}
 
 func (x *EnhancedRouteRefreshCapability) ProtoReflect() protoreflect.Message {
-	mi := &file_capability_proto_msgTypes[10]
+	mi := &file_capability_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,7 +832,45 @@ func (x *EnhancedRouteRefreshCapability) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnhancedRouteRefreshCapability.ProtoReflect.Descriptor instead.
 func (*EnhancedRouteRefreshCapability) Descriptor() ([]byte, []int) {
-	return file_capability_proto_rawDescGZIP(), []int{10}
+	return file_capability_proto_rawDescGZIP(), []int{13}
+}
+
+type ExtendedMessageCapability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExtendedMessageCapability) Reset() {
+	*x = ExtendedMessageCapability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_capability_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtendedMessageCapability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendedMessageCapability) ProtoMessage() {}
+
+func (x *ExtendedMessageCapability) ProtoReflect() protoreflect.Message {
+	mi := &file_capability_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendedMessageCapability.ProtoReflect.Descriptor instead.
+func (*ExtendedMessageCapability) Descriptor() ([]byte, []int) {
+	return file_capability_proto_rawDescGZIP(), []int{14}
 }
 
 type LongLivedGracefulRestartCapabilityTuple struct {
@@ -639,7 +886,7 @@ type LongLivedGracefulRestartCapabilityTuple struct {
 func (x *LongLivedGracefulRestartCapabilityTuple) Reset() {
 	*x = LongLivedGracefulRestartCapabilityTuple{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_capability_proto_msgTypes[11]
+		mi := &file_capability_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -652,7 +899,7 @@ func (x *LongLivedGracefulRestartCapabilityTuple) String() string {
 func (*LongLivedGracefulRestartCapabilityTuple) ProtoMessage() {}
 
 func (x *LongLivedGracefulRestartCapabilityTuple) ProtoReflect() protoreflect.Message {
-	mi := &file_capability_proto_msgTypes[11]
+	mi := &file_capability_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -665,7 +912,7 @@ func (x *LongLivedGracefulRestartCapabilityTuple) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use LongLivedGracefulRestartCapabilityTuple.ProtoReflect.Descriptor instead.
 func (*LongLivedGracefulRestartCapabilityTuple) Descriptor() ([]byte, []int) {
-	return file_capability_proto_rawDescGZIP(), []int{11}
+	return file_capability_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *LongLivedGracefulRestartCapabilityTuple) GetFamily() *Family {
@@ -700,7 +947,7 @@ type LongLivedGracefulRestartCapability struct {
 func (x *LongLivedGracefulRestartCapability) Reset() {
 	*x = LongLivedGracefulRestartCapability{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_capability_proto_msgTypes[12]
+		mi := &file_capability_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -713,7 +960,7 @@ func (x *LongLivedGracefulRestartCapability) String() string {
 func (*LongLivedGracefulRestartCapability) ProtoMessage() {}
 
 func (x *LongLivedGracefulRestartCapability) ProtoReflect() protoreflect.Message {
-	mi := &file_capability_proto_msgTypes[12]
+	mi := &file_capability_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,7 +973,7 @@ func (x *LongLivedGracefulRestartCapability) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use LongLivedGracefulRestartCapability.ProtoReflect.Descriptor instead.
 func (*LongLivedGracefulRestartCapability) Descriptor() ([]byte, []int) {
-	return file_capability_proto_rawDescGZIP(), []int{12}
+	return file_capability_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *LongLivedGracefulRestartCapability) GetTuples() []*LongLivedGracefulRestartCapabilityTuple {
@@ -745,7 +992,7 @@ type RouteRefreshCiscoCapability struct {
 func (x *RouteRefreshCiscoCapability) Reset() {
 	*x = RouteRefreshCiscoCapability{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_capability_proto_msgTypes[13]
+		mi := &file_capability_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -758,7 +1005,7 @@ func (x *RouteRefreshCiscoCapability) String() string {
 func (*RouteRefreshCiscoCapability) ProtoMessage() {}
 
 func (x *RouteRefreshCiscoCapability) ProtoReflect() protoreflect.Message {
-	mi := &file_capability_proto_msgTypes[13]
+	mi := &file_capability_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -771,7 +1018,7 @@ func (x *RouteRefreshCiscoCapability) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteRefreshCiscoCapability.ProtoReflect.Descriptor instead.
 func (*RouteRefreshCiscoCapability) Descriptor() ([]byte, []int) {
-	return file_capability_proto_rawDescGZIP(), []int{13}
+	return file_capability_proto_rawDescGZIP(), []int{17}
 }
 
 type FqdnCapability struct {
@@ -786,7 +1033,7 @@ type FqdnCapability struct {
 func (x *FqdnCapability) Reset() {
 	*x = FqdnCapability{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_capability_proto_msgTypes[14]
+		mi := &file_capability_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -799,7 +1046,7 @@ func (x *FqdnCapability) String() string {
 func (*FqdnCapability) ProtoMessage() {}
 
 func (x *FqdnCapability) ProtoReflect() protoreflect.Message {
-	mi := &file_capability_proto_msgTypes[14]
+	mi := &file_capability_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -812,7 +1059,7 @@ func (x *FqdnCapability) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FqdnCapability.ProtoReflect.Descriptor instead.
 func (*FqdnCapability) Descriptor() ([]byte, []int) {
-	return file_capability_proto_rawDescGZIP(), []int{14}
+	return file_capability_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *FqdnCapability) GetHostName() string {
@@ -840,7 +1087,7 @@ type SoftwareVersionCapability struct {
 func (x *SoftwareVersionCapability) Reset() {
 	*x = SoftwareVersionCapability{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_capability_proto_msgTypes[15]
+		mi := &file_capability_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -853,7 +1100,7 @@ func (x *SoftwareVersionCapability) String() string {
 func (*SoftwareVersionCapability) ProtoMessage() {}
 
 func (x *SoftwareVersionCapability) ProtoReflect() protoreflect.Message {
-	mi := &file_capability_proto_msgTypes[15]
+	mi := &file_capability_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -866,7 +1113,7 @@ func (x *SoftwareVersionCapability) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SoftwareVersionCapability.ProtoReflect.Descriptor instead.
 func (*SoftwareVersionCapability) Descriptor() ([]byte, []int) {
-	return file_capability_proto_rawDescGZIP(), []int{15}
+	return file_capability_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SoftwareVersionCapability) GetSoftwareVersion() string {
@@ -876,6 +1123,53 @@ func (x *SoftwareVersionCapability) GetSoftwareVersion() string {
 	return ""
 }
 
+type DynamicCapability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Codes []uint32 `protobuf:"varint,1,rep,packed,name=codes,proto3" json:"codes,omitempty"`
+}
+
+func (x *DynamicCapability) Reset() {
+	*x = DynamicCapability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_capability_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DynamicCapability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicCapability) ProtoMessage() {}
+
+func (x *DynamicCapability) ProtoReflect() protoreflect.Message {
+	mi := &file_capability_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynamicCapability.ProtoReflect.Descriptor instead.
+func (*DynamicCapability) Descriptor() ([]byte, []int) {
+	return file_capability_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DynamicCapability) GetCodes() []uint32 {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
 type UnknownCapability struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -888,7 +1182,7 @@ type UnknownCapability struct {
 func (x *UnknownCapability) Reset() {
 	*x = UnknownCapability{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_capability_proto_msgTypes[16]
+		mi := &file_capability_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -901,7 +1195,7 @@ func (x *UnknownCapability) String() string {
 func (*UnknownCapability) ProtoMessage() {}
 
 func (x *UnknownCapability) ProtoReflect() protoreflect.Message {
-	mi := &file_capability_proto_msgTypes[16]
+	mi := &file_capability_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -914,7 +1208,7 @@ func (x *UnknownCapability) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnknownCapability.ProtoReflect.Descriptor instead.
 func (*UnknownCapability) Descriptor() ([]byte, []int) {
-	return file_capability_proto_rawDescGZIP(), []int{16}
+	return file_capability_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *UnknownCapability) GetCode() uint32 {
@@ -942,91 +1236,123 @@ var file_capability_proto_rawDesc = []byte{
 	0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79,
 	0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x22, 0x18, 0x0a, 0x16, 0x52, 0x6f, 0x75, 0x74,
 	0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x79, 0x22, 0x1d, 0x0a, 0x1b, 0x43, 0x61, 0x72, 0x72, 0x79, 0x69, 0x6e, 0x67, 0x4c, 0x61,
-	0x62, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
-	0x79, 0x22, 0x86, 0x01, 0x0a, 0x1e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x4e, 0x65,
-	0x78, 0x74, 0x68, 0x6f, 0x70, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54,
-	0x75, 0x70, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x0b, 0x6e, 0x6c, 0x72, 0x69, 0x5f, 0x66, 0x61, 0x6d,
-	0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x70,
-	0x62, 0x2e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x0a, 0x6e, 0x6c, 0x72, 0x69, 0x46, 0x61,
-	0x6d, 0x69, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x5f,
-	0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61,
-	0x70, 0x69, 0x70, 0x62, 0x2e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x0d, 0x6e, 0x65, 0x78,
-	0x74, 0x68, 0x6f, 0x70, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x22, 0x5a, 0x0a, 0x19, 0x45, 0x78,
-	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x4e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x43, 0x61, 0x70,
-	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x06, 0x74, 0x75, 0x70, 0x6c, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x4e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x43,
-	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x52, 0x06,
-	0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x1e, 0x47, 0x72, 0x61, 0x63, 0x65, 0x66,
-	0x75, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x79, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69,
-	0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62,
-	0x2e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x19, 0x47, 0x72, 0x61, 0x63, 0x65, 0x66,
-	0x75, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a,
-	0x06, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
-	0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c, 0x52, 0x65,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54,
-	0x75, 0x70, 0x6c, 0x65, 0x52, 0x06, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x16,
-	0x46, 0x6f, 0x75, 0x72, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x41, 0x53, 0x4e, 0x43, 0x61, 0x70, 0x61,
-	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x73, 0x6e, 0x22, 0xaa, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x64,
-	0x50, 0x61, 0x74, 0x68, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x75,
+	0x74, 0x79, 0x22, 0xb5, 0x01, 0x0a, 0x25, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x45, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31,
+	0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x31, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x43,
+	0x45, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x4e, 0x44, 0x10, 0x02,
+	0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x54, 0x48, 0x10, 0x03, 0x22, 0x96, 0x01, 0x0a, 0x25, 0x4f,
+	0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54,
+	0x75, 0x70, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x46, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x46, 0x0a, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x61,
+	0x70, 0x69, 0x70, 0x62, 0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x70, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x20, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x44, 0x0a, 0x06, 0x74, 0x75, 0x70, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e,
+	0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x54, 0x75, 0x70, 0x6c, 0x65, 0x52, 0x06, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x1d, 0x0a,
+	0x1b, 0x43, 0x61, 0x72, 0x72, 0x79, 0x69, 0x6e, 0x67, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x6e,
+	0x66, 0x6f, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x86, 0x01, 0x0a,
+	0x1e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x4e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70,
+	0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x12,
+	0x2e, 0x0a, 0x0b, 0x6e, 0x6c, 0x72, 0x69, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x46, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x52, 0x0a, 0x6e, 0x6c, 0x72, 0x69, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12,
+	0x34, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e,
+	0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x46,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x22, 0x5a, 0x0a, 0x19, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x4e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x12, 0x3d, 0x0a, 0x06, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x4e, 0x65, 0x78, 0x74, 0x68, 0x6f, 0x70, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x52, 0x06, 0x74, 0x75, 0x70, 0x6c, 0x65,
+	0x73, 0x22, 0x5d, 0x0a, 0x1e, 0x47, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c, 0x52, 0x65, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x75,
 	0x70, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x46, 0x61, 0x6d, 0x69,
-	0x6c, 0x79, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x04, 0x6d, 0x6f,
-	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62,
-	0x2e, 0x41, 0x64, 0x64, 0x50, 0x61, 0x74, 0x68, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x79, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f,
-	0x64, 0x65, 0x22, 0x31, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f,
-	0x4e, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x10,
-	0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x42,
-	0x4f, 0x54, 0x48, 0x10, 0x03, 0x22, 0x4a, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x50, 0x61, 0x74, 0x68,
-	0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x74, 0x75,
-	0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69,
-	0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x61, 0x74, 0x68, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x52, 0x06, 0x74, 0x75, 0x70, 0x6c, 0x65,
-	0x73, 0x22, 0x20, 0x0a, 0x1e, 0x45, 0x6e, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x79, 0x22, 0x7a, 0x0a, 0x27, 0x4c, 0x6f, 0x6e, 0x67, 0x4c, 0x69, 0x76, 0x65, 0x64,
-	0x47, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43,
+	0x6c, 0x79, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x22, 0x84, 0x01, 0x0a, 0x19, 0x47, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c, 0x52, 0x65, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x74, 0x75, 0x70, 0x6c,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62,
+	0x2e, 0x47, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x52,
+	0x06, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x16, 0x46, 0x6f, 0x75, 0x72, 0x4f,
+	0x63, 0x74, 0x65, 0x74, 0x41, 0x53, 0x4e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x61, 0x73, 0x6e, 0x22, 0xaa, 0x01, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x50, 0x61, 0x74, 0x68, 0x43,
 	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x12, 0x25,
 	0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
 	0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x06, 0x66,
-	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22,
-	0x6c, 0x0a, 0x22, 0x4c, 0x6f, 0x6e, 0x67, 0x4c, 0x69, 0x76, 0x65, 0x64, 0x47, 0x72, 0x61, 0x63,
-	0x65, 0x66, 0x75, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62,
-	0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x06, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x6f,
-	0x6e, 0x67, 0x4c, 0x69, 0x76, 0x65, 0x64, 0x47, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c, 0x52,
-	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x54, 0x75, 0x70, 0x6c, 0x65, 0x52, 0x06, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x1d, 0x0a,
-	0x1b, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x69, 0x73,
-	0x63, 0x6f, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x4e, 0x0a, 0x0e,
-	0x46, 0x71, 0x64, 0x6e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1b,
-	0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
-	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x46, 0x0a, 0x19,
-	0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43,
-	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x6f, 0x66,
-	0x74, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x11, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x43,
-	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x42, 0x24, 0x5a, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6f, 0x73, 0x72, 0x67, 0x2f, 0x67, 0x6f, 0x62, 0x67, 0x70, 0x2f, 0x76, 0x33, 0x2f,
-	0x61, 0x70, 0x69, 0x3b, 0x61, 0x70, 0x69, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50,
+	0x61, 0x74, 0x68, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x75, 0x70,
+	0x6c, 0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x31, 0x0a,
+	0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x53, 0x45, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x54, 0x48, 0x10, 0x03,
+	0x22, 0x4a, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x50, 0x61, 0x74, 0x68, 0x43, 0x61, 0x70, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x41, 0x64,
+	0x64, 0x50, 0x61, 0x74, 0x68, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54,
+	0x75, 0x70, 0x6c, 0x65, 0x52, 0x06, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x20, 0x0a, 0x1e,
+	0x45, 0x6e, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x1b,
+	0x0a, 0x19, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x7a, 0x0a, 0x27, 0x4c,
+	0x6f, 0x6e, 0x67, 0x4c, 0x69, 0x76, 0x65, 0x64, 0x47, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c,
+	0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x46,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x6c, 0x0a, 0x22, 0x4c, 0x6f, 0x6e, 0x67, 0x4c,
+	0x69, 0x76, 0x65, 0x64, 0x47, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c, 0x52, 0x65, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x46, 0x0a,
+	0x06, 0x74, 0x75, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
+	0x61, 0x70, 0x69, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6e, 0x67, 0x4c, 0x69, 0x76, 0x65, 0x64, 0x47,
+	0x72, 0x61, 0x63, 0x65, 0x66, 0x75, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x61,
+	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x52, 0x06, 0x74,
+	0x75, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x1d, 0x0a, 0x1b, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x43, 0x69, 0x73, 0x63, 0x6f, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x22, 0x4e, 0x0a, 0x0e, 0x46, 0x71, 0x64, 0x6e, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x46, 0x0a, 0x19, 0x53, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x66,
+	0x74, 0x77, 0x61, 0x72, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x11,
+	0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x3d, 0x0a, 0x11, 0x55, 0x6e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x24, 0x5a, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x73, 0x72, 0x67, 0x2f, 0x67, 0x6f, 0x62, 0x67, 0x70, 0x2f,
+	0x76, 0x33, 0x2f, 0x61, 0x70, 0x69, 0x3b, 0x61, 0x70, 0x69, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1041,46 +1367,56 @@ func file_capability_proto_rawDescGZIP() []byte {
 	return file_capability_proto_rawDescData
 }
 
-var file_capability_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_capability_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_capability_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_capability_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_capability_proto_goTypes = []interface{}{
-	(AddPathCapabilityTuple_Mode)(0),                // 0: apipb.AddPathCapabilityTuple.Mode
-	(*MultiProtocolCapability)(nil),                 // 1: apipb.MultiProtocolCapability
-	(*RouteRefreshCapability)(nil),                  // 2: apipb.RouteRefreshCapability
-	(*CarryingLabelInfoCapability)(nil),             // 3: apipb.CarryingLabelInfoCapability
-	(*ExtendedNexthopCapabilityTuple)(nil),          // 4: apipb.ExtendedNexthopCapabilityTuple
-	(*ExtendedNexthopCapability)(nil),               // 5: apipb.ExtendedNexthopCapability
-	(*GracefulRestartCapabilityTuple)(nil),          // 6: apipb.GracefulRestartCapabilityTuple
-	(*GracefulRestartCapability)(nil),               // 7: apipb.GracefulRestartCapability
-	(*FourOctetASNCapability)(nil),                  // 8: apipb.FourOctetASNCapability
-	(*AddPathCapabilityTuple)(nil),                  // 9: apipb.AddPathCapabilityTuple
-	(*AddPathCapability)(nil),                       // 10: apipb.AddPathCapability
-	(*EnhancedRouteRefreshCapability)(nil),          // 11: apipb.EnhancedRouteRefreshCapability
-	(*LongLivedGracefulRestartCapabilityTuple)(nil), // 12: apipb.LongLivedGracefulRestartCapabilityTuple
-	(*LongLivedGracefulRestartCapability)(nil),      // 13: apipb.LongLivedGracefulRestartCapability
-	(*RouteRefreshCiscoCapability)(nil),             // 14: apipb.RouteRefreshCiscoCapability
-	(*FqdnCapability)(nil),                          // 15: apipb.FqdnCapability
-	(*SoftwareVersionCapability)(nil),               // 16: apipb.SoftwareVersionCapability
-	(*UnknownCapability)(nil),                       // 17: apipb.UnknownCapability
-	(*Family)(nil),                                  // 18: apipb.Family
+	(OutboundRouteFilteringCapabilityEntry_Mode)(0), // 0: apipb.OutboundRouteFilteringCapabilityEntry.Mode
+	(AddPathCapabilityTuple_Mode)(0),                // 1: apipb.AddPathCapabilityTuple.Mode
+	(*MultiProtocolCapability)(nil),                 // 2: apipb.MultiProtocolCapability
+	(*RouteRefreshCapability)(nil),                  // 3: apipb.RouteRefreshCapability
+	(*OutboundRouteFilteringCapabilityEntry)(nil),   // 4: apipb.OutboundRouteFilteringCapabilityEntry
+	(*OutboundRouteFilteringCapabilityTuple)(nil),   // 5: apipb.OutboundRouteFilteringCapabilityTuple
+	(*OutboundRouteFilteringCapability)(nil),        // 6: apipb.OutboundRouteFilteringCapability
+	(*CarryingLabelInfoCapability)(nil),             // 7: apipb.CarryingLabelInfoCapability
+	(*ExtendedNexthopCapabilityTuple)(nil),          // 8: apipb.ExtendedNexthopCapabilityTuple
+	(*ExtendedNexthopCapability)(nil),               // 9: apipb.ExtendedNexthopCapability
+	(*GracefulRestartCapabilityTuple)(nil),          // 10: apipb.GracefulRestartCapabilityTuple
+	(*GracefulRestartCapability)(nil),               // 11: apipb.GracefulRestartCapability
+	(*FourOctetASNCapability)(nil),                  // 12: apipb.FourOctetASNCapability
+	(*AddPathCapabilityTuple)(nil),                  // 13: apipb.AddPathCapabilityTuple
+	(*AddPathCapability)(nil),                       // 14: apipb.AddPathCapability
+	(*EnhancedRouteRefreshCapability)(nil),          // 15: apipb.EnhancedRouteRefreshCapability
+	(*ExtendedMessageCapability)(nil),               // 16: apipb.ExtendedMessageCapability
+	(*LongLivedGracefulRestartCapabilityTuple)(nil), // 17: apipb.LongLivedGracefulRestartCapabilityTuple
+	(*LongLivedGracefulRestartCapability)(nil),      // 18: apipb.LongLivedGracefulRestartCapability
+	(*RouteRefreshCiscoCapability)(nil),             // 19: apipb.RouteRefreshCiscoCapability
+	(*FqdnCapability)(nil),                          // 20: apipb.FqdnCapability
+	(*SoftwareVersionCapability)(nil),               // 21: apipb.SoftwareVersionCapability
+	(*DynamicCapability)(nil),                       // 22: apipb.DynamicCapability
+	(*UnknownCapability)(nil),                       // 23: apipb.UnknownCapability
+	(*Family)(nil),                                  // 24: apipb.Family
 }
 var file_capability_proto_depIdxs = []int32{
-	18, // 0: apipb.MultiProtocolCapability.family:type_name -> apipb.Family
-	18, // 1: apipb.ExtendedNexthopCapabilityTuple.nlri_family:type_name -> apipb.Family
-	18, // 2: apipb.ExtendedNexthopCapabilityTuple.nexthop_family:type_name -> apipb.Family
-	4,  // 3: apipb.ExtendedNexthopCapability.tuples:type_name -> apipb.ExtendedNexthopCapabilityTuple
-	18, // 4: apipb.GracefulRestartCapabilityTuple.family:type_name -> apipb.Family
-	6,  // 5: apipb.GracefulRestartCapability.tuples:type_name -> apipb.GracefulRestartCapabilityTuple
-	18, // 6: apipb.AddPathCapabilityTuple.family:type_name -> apipb.Family
-	0,  // 7: apipb.AddPathCapabilityTuple.mode:type_name -> apipb.AddPathCapabilityTuple.Mode
-	9,  // 8: apipb.AddPathCapability.tuples:type_name -> apipb.AddPathCapabilityTuple
-	18, // 9: apipb.LongLivedGracefulRestartCapabilityTuple.family:type_name -> apipb.Family
-	12, // 10: apipb.LongLivedGracefulRestartCapability.tuples:type_name -> apipb.LongLivedGracefulRestartCapabilityTuple
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	24, // 0: apipb.MultiProtocolCapability.family:type_name -> apipb.Family
+	0,  // 1: apipb.OutboundRouteFilteringCapabilityEntry.mode:type_name -> apipb.OutboundRouteFilteringCapabilityEntry.Mode
+	24, // 2: apipb.OutboundRouteFilteringCapabilityTuple.family:type_name -> apipb.Family
+	4,  // 3: apipb.OutboundRouteFilteringCapabilityTuple.entries:type_name -> apipb.OutboundRouteFilteringCapabilityEntry
+	5,  // 4: apipb.OutboundRouteFilteringCapability.tuples:type_name -> apipb.OutboundRouteFilteringCapabilityTuple
+	24, // 5: apipb.ExtendedNexthopCapabilityTuple.nlri_family:type_name -> apipb.Family
+	24, // 6: apipb.ExtendedNexthopCapabilityTuple.nexthop_family:type_name -> apipb.Family
+	8,  // 7: apipb.ExtendedNexthopCapability.tuples:type_name -> apipb.ExtendedNexthopCapabilityTuple
+	24, // 8: apipb.GracefulRestartCapabilityTuple.family:type_name -> apipb.Family
+	10, // 9: apipb.GracefulRestartCapability.tuples:type_name -> apipb.GracefulRestartCapabilityTuple
+	24, // 10: apipb.AddPathCapabilityTuple.family:type_name -> apipb.Family
+	1,  // 11: apipb.AddPathCapabilityTuple.mode:type_name -> apipb.AddPathCapabilityTuple.Mode
+	13, // 12: apipb.AddPathCapability.tuples:type_name -> apipb.AddPathCapabilityTuple
+	24, // 13: apipb.LongLivedGracefulRestartCapabilityTuple.family:type_name -> apipb.Family
+	17, // 14: apipb.LongLivedGracefulRestartCapability.tuples:type_name -> apipb.LongLivedGracefulRestartCapabilityTuple
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_capability_proto_init() }
@@ -1115,7 +1451,7 @@ func file_capability_proto_init() {
 			}
 		}
 		file_capability_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CarryingLabelInfoCapability); i {
+			switch v := v.(*OutboundRouteFilteringCapabilityEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1127,7 +1463,7 @@ func file_capability_proto_init() {
 			}
 		}
 		file_capability_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtendedNexthopCapabilityTuple); i {
+			switch v := v.(*OutboundRouteFilteringCapabilityTuple); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1139,7 +1475,7 @@ func file_capability_proto_init() {
 			}
 		}
 		file_capability_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtendedNexthopCapability); i {
+			switch v := v.(*OutboundRouteFilteringCapability); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1151,7 +1487,7 @@ func file_capability_proto_init() {
 			}
 		}
 		file_capability_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GracefulRestartCapabilityTuple); i {
+			switch v := v.(*CarryingLabelInfoCapability); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1163,7 +1499,7 @@ func file_capability_proto_init() {
 			}
 		}
 		file_capability_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GracefulRestartCapability); i {
+			switch v := v.(*ExtendedNexthopCapabilityTuple); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1175,7 +1511,7 @@ func file_capability_proto_init() {
 			}
 		}
 		file_capability_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FourOctetASNCapability); i {
+			switch v := v.(*ExtendedNexthopCapability); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1187,7 +1523,7 @@ func file_capability_proto_init() {
 			}
 		}
 		file_capability_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddPathCapabilityTuple); i {
+			switch v := v.(*GracefulRestartCapabilityTuple); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1199,7 +1535,7 @@ func file_capability_proto_init() {
 			}
 		}
 		file_capability_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddPathCapability); i {
+			switch v := v.(*GracefulRestartCapability); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1211,7 +1547,7 @@ func file_capability_proto_init() {
 			}
 		}
 		file_capability_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnhancedRouteRefreshCapability); i {
+			switch v := v.(*FourOctetASNCapability); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1223,7 +1559,7 @@ func file_capability_proto_init() {
 			}
 		}
 		file_capability_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LongLivedGracefulRestartCapabilityTuple); i {
+			switch v := v.(*AddPathCapabilityTuple); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1235,7 +1571,7 @@ func file_capability_proto_init() {
 			}
 		}
 		file_capability_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LongLivedGracefulRestartCapability); i {
+			switch v := v.(*AddPathCapability); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1247,7 +1583,7 @@ func file_capability_proto_init() {
 			}
 		}
 		file_capability_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RouteRefreshCiscoCapability); i {
+			switch v := v.(*EnhancedRouteRefreshCapability); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1259,7 +1595,7 @@ func file_capability_proto_init() {
 			}
 		}
 		file_capability_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FqdnCapability); i {
+			switch v := v.(*ExtendedMessageCapability); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1271,7 +1607,7 @@ func file_capability_proto_init() {
 			}
 		}
 		file_capability_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SoftwareVersionCapability); i {
+			switch v := v.(*LongLivedGracefulRestartCapabilityTuple); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1283,6 +1619,66 @@ func file_capability_proto_init() {
 			}
 		}
 		file_capability_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LongLivedGracefulRestartCapability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_capability_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteRefreshCiscoCapability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_capability_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FqdnCapability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_capability_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SoftwareVersionCapability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_capability_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DynamicCapability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_capability_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnknownCapability); i {
 			case 0:
 				return &v.state
@@ -1300,8 +1696,8 @@ func file_capability_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_capability_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   17,
+			NumEnums:      2,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -26,11 +26,16 @@ type GobgpApiClient interface {
 	AddPeer(ctx context.Context, in *AddPeerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeletePeer(ctx context.Context, in *DeletePeerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListPeer(ctx context.Context, in *ListPeerRequest, opts ...grpc.CallOption) (GobgpApi_ListPeerClient, error)
+	// ListPeer with all the peers in a single response for the clients
+	// preferring unary calls.
+	ListPeerAggregated(ctx context.Context, in *ListPeerRequest, opts ...grpc.CallOption) (*ListPeerAggregatedResponse, error)
+	GetEffectivePeerConfig(ctx context.Context, in *GetEffectivePeerConfigRequest, opts ...grpc.CallOption) (*GetEffectivePeerConfigResponse, error)
 	UpdatePeer(ctx context.Context, in *UpdatePeerRequest, opts ...grpc.CallOption) (*UpdatePeerResponse, error)
 	ResetPeer(ctx context.Context, in *ResetPeerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ShutdownPeer(ctx context.Context, in *ShutdownPeerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	EnablePeer(ctx context.Context, in *EnablePeerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DisablePeer(ctx context.Context, in *DisablePeerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GracefulShutdownPeer(ctx context.Context, in *GracefulShutdownPeerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AddPeerGroup(ctx context.Context, in *AddPeerGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeletePeerGroup(ctx context.Context, in *DeletePeerGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListPeerGroup(ctx context.Context, in *ListPeerGroupRequest, opts ...grpc.CallOption) (GobgpApi_ListPeerGroupClient, error)
@@ -41,18 +46,35 @@ type GobgpApiClient interface {
 	AddPath(ctx context.Context, in *AddPathRequest, opts ...grpc.CallOption) (*AddPathResponse, error)
 	DeletePath(ctx context.Context, in *DeletePathRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListPath(ctx context.Context, in *ListPathRequest, opts ...grpc.CallOption) (GobgpApi_ListPathClient, error)
+	// ListPath with the destinations in a single response for the clients
+	// preferring unary calls. Use page_size for the large tables not to
+	// exceed the maximum message size of the client.
+	ListPathAggregated(ctx context.Context, in *ListPathRequest, opts ...grpc.CallOption) (*ListPathAggregatedResponse, error)
 	AddPathStream(ctx context.Context, opts ...grpc.CallOption) (GobgpApi_AddPathStreamClient, error)
+	ExtendPathLifetime(ctx context.Context, in *ExtendPathLifetimeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetTable(ctx context.Context, in *GetTableRequest, opts ...grpc.CallOption) (*GetTableResponse, error)
 	AddVrf(ctx context.Context, in *AddVrfRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteVrf(ctx context.Context, in *DeleteVrfRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListVrf(ctx context.Context, in *ListVrfRequest, opts ...grpc.CallOption) (GobgpApi_ListVrfClient, error)
+	ListEvpnDesignatedForwarder(ctx context.Context, in *ListEvpnDesignatedForwarderRequest, opts ...grpc.CallOption) (GobgpApi_ListEvpnDesignatedForwarderClient, error)
+	ListEvpnFrozenMac(ctx context.Context, in *ListEvpnFrozenMacRequest, opts ...grpc.CallOption) (GobgpApi_ListEvpnFrozenMacClient, error)
+	ClearEvpnFrozenMac(ctx context.Context, in *ClearEvpnFrozenMacRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListColorBinding(ctx context.Context, in *ListColorBindingRequest, opts ...grpc.CallOption) (GobgpApi_ListColorBindingClient, error)
 	AddPolicy(ctx context.Context, in *AddPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeletePolicy(ctx context.Context, in *DeletePolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListPolicy(ctx context.Context, in *ListPolicyRequest, opts ...grpc.CallOption) (GobgpApi_ListPolicyClient, error)
 	SetPolicies(ctx context.Context, in *SetPoliciesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReplacePolicies(ctx context.Context, in *ReplacePoliciesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	StageConfig(ctx context.Context, in *StageConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DiscardConfig(ctx context.Context, in *DiscardConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CommitConfig(ctx context.Context, in *CommitConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ConfirmConfig(ctx context.Context, in *ConfirmConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RollbackConfig(ctx context.Context, in *RollbackConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetConfigTransaction(ctx context.Context, in *GetConfigTransactionRequest, opts ...grpc.CallOption) (*GetConfigTransactionResponse, error)
 	AddDefinedSet(ctx context.Context, in *AddDefinedSetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteDefinedSet(ctx context.Context, in *DeleteDefinedSetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListDefinedSet(ctx context.Context, in *ListDefinedSetRequest, opts ...grpc.CallOption) (GobgpApi_ListDefinedSetClient, error)
+	UpdateDefinedSet(ctx context.Context, in *UpdateDefinedSetRequest, opts ...grpc.CallOption) (*UpdateDefinedSetResponse, error)
 	AddStatement(ctx context.Context, in *AddStatementRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteStatement(ctx context.Context, in *DeleteStatementRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListStatement(ctx context.Context, in *ListStatementRequest, opts ...grpc.CallOption) (GobgpApi_ListStatementClient, error)
@@ -60,6 +82,8 @@ type GobgpApiClient interface {
 	DeletePolicyAssignment(ctx context.Context, in *DeletePolicyAssignmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListPolicyAssignment(ctx context.Context, in *ListPolicyAssignmentRequest, opts ...grpc.CallOption) (GobgpApi_ListPolicyAssignmentClient, error)
 	SetPolicyAssignment(ctx context.Context, in *SetPolicyAssignmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReplacePolicyAssignment(ctx context.Context, in *ReplacePolicyAssignmentRequest, opts ...grpc.CallOption) (*ReplacePolicyAssignmentResponse, error)
+	SimulatePolicy(ctx context.Context, in *SimulatePolicyRequest, opts ...grpc.CallOption) (*SimulatePolicyResponse, error)
 	AddRpki(ctx context.Context, in *AddRpkiRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteRpki(ctx context.Context, in *DeleteRpkiRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListRpki(ctx context.Context, in *ListRpkiRequest, opts ...grpc.CallOption) (GobgpApi_ListRpkiClient, error)
@@ -67,13 +91,50 @@ type GobgpApiClient interface {
 	DisableRpki(ctx context.Context, in *DisableRpkiRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ResetRpki(ctx context.Context, in *ResetRpkiRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListRpkiTable(ctx context.Context, in *ListRpkiTableRequest, opts ...grpc.CallOption) (GobgpApi_ListRpkiTableClient, error)
+	SetRpkiSlurm(ctx context.Context, in *SetRpkiSlurmRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	EnableZebra(ctx context.Context, in *EnableZebraRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	EnableNetlink(ctx context.Context, in *EnableNetlinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DisableNetlink(ctx context.Context, in *DisableNetlinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	EnablePeeringDb(ctx context.Context, in *EnablePeeringDbRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DisablePeeringDb(ctx context.Context, in *DisablePeeringDbRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListPeeringDbRecord(ctx context.Context, in *ListPeeringDbRecordRequest, opts ...grpc.CallOption) (GobgpApi_ListPeeringDbRecordClient, error)
+	SetSrv6Locator(ctx context.Context, in *SetSrv6LocatorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	EnableMrt(ctx context.Context, in *EnableMrtRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DisableMrt(ctx context.Context, in *DisableMrtRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AddBmp(ctx context.Context, in *AddBmpRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteBmp(ctx context.Context, in *DeleteBmpRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListBmp(ctx context.Context, in *ListBmpRequest, opts ...grpc.CallOption) (GobgpApi_ListBmpClient, error)
+	AddKafkaExporter(ctx context.Context, in *AddKafkaExporterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteKafkaExporter(ctx context.Context, in *DeleteKafkaExporterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListKafkaExporter(ctx context.Context, in *ListKafkaExporterRequest, opts ...grpc.CallOption) (GobgpApi_ListKafkaExporterClient, error)
+	AddIrrFilter(ctx context.Context, in *AddIrrFilterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteIrrFilter(ctx context.Context, in *DeleteIrrFilterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListIrrFilter(ctx context.Context, in *ListIrrFilterRequest, opts ...grpc.CallOption) (GobgpApi_ListIrrFilterClient, error)
+	AddAggregate(ctx context.Context, in *AddAggregateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteAggregate(ctx context.Context, in *DeleteAggregateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListAggregate(ctx context.Context, in *ListAggregateRequest, opts ...grpc.CallOption) (GobgpApi_ListAggregateClient, error)
+	AddVrfLeak(ctx context.Context, in *AddVrfLeakRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteVrfLeak(ctx context.Context, in *DeleteVrfLeakRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListVrfLeak(ctx context.Context, in *ListVrfLeakRequest, opts ...grpc.CallOption) (GobgpApi_ListVrfLeakClient, error)
+	AddOrrVantagePoint(ctx context.Context, in *AddOrrVantagePointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteOrrVantagePoint(ctx context.Context, in *DeleteOrrVantagePointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListOrrVantagePoint(ctx context.Context, in *ListOrrVantagePointRequest, opts ...grpc.CallOption) (GobgpApi_ListOrrVantagePointClient, error)
+	AddEvpnVpws(ctx context.Context, in *AddEvpnVpwsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteEvpnVpws(ctx context.Context, in *DeleteEvpnVpwsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListEvpnVpws(ctx context.Context, in *ListEvpnVpwsRequest, opts ...grpc.CallOption) (GobgpApi_ListEvpnVpwsClient, error)
+	AddRtbh(ctx context.Context, in *AddRtbhRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteRtbh(ctx context.Context, in *DeleteRtbhRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListRtbh(ctx context.Context, in *ListRtbhRequest, opts ...grpc.CallOption) (GobgpApi_ListRtbhClient, error)
+	ListPrefixActivity(ctx context.Context, in *ListPrefixActivityRequest, opts ...grpc.CallOption) (GobgpApi_ListPrefixActivityClient, error)
+	GetPathHistory(ctx context.Context, in *GetPathHistoryRequest, opts ...grpc.CallOption) (*GetPathHistoryResponse, error)
+	GetRibSummary(ctx context.Context, in *GetRibSummaryRequest, opts ...grpc.CallOption) (*GetRibSummaryResponse, error)
+	ListRibDiff(ctx context.Context, in *ListRibDiffRequest, opts ...grpc.CallOption) (GobgpApi_ListRibDiffClient, error)
 	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetLogLevel(ctx context.Context, in *GetLogLevelRequest, opts ...grpc.CallOption) (*GetLogLevelResponse, error)
+	StartDebugCapture(ctx context.Context, in *StartDebugCaptureRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	StopDebugCapture(ctx context.Context, in *StopDebugCaptureRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListDebugCapture(ctx context.Context, in *ListDebugCaptureRequest, opts ...grpc.CallOption) (GobgpApi_ListDebugCaptureClient, error)
+	GetApiVersion(ctx context.Context, in *GetApiVersionRequest, opts ...grpc.CallOption) (*GetApiVersionResponse, error)
 }
 
 type gobgpApiClient struct {
@@ -193,6 +254,24 @@ func (x *gobgpApiListPeerClient) Recv() (*ListPeerResponse, error) {
 	return m, nil
 }
 
+func (c *gobgpApiClient) ListPeerAggregated(ctx context.Context, in *ListPeerRequest, opts ...grpc.CallOption) (*ListPeerAggregatedResponse, error) {
+	out := new(ListPeerAggregatedResponse)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/ListPeerAggregated", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) GetEffectivePeerConfig(ctx context.Context, in *GetEffectivePeerConfigRequest, opts ...grpc.CallOption) (*GetEffectivePeerConfigResponse, error) {
+	out := new(GetEffectivePeerConfigResponse)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/GetEffectivePeerConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gobgpApiClient) UpdatePeer(ctx context.Context, in *UpdatePeerRequest, opts ...grpc.CallOption) (*UpdatePeerResponse, error) {
 	out := new(UpdatePeerResponse)
 	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/UpdatePeer", in, out, opts...)
@@ -238,6 +317,15 @@ func (c *gobgpApiClient) DisablePeer(ctx context.Context, in *DisablePeerRequest
 	return out, nil
 }
 
+func (c *gobgpApiClient) GracefulShutdownPeer(ctx context.Context, in *GracefulShutdownPeerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/GracefulShutdownPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gobgpApiClient) AddPeerGroup(ctx context.Context, in *AddPeerGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/AddPeerGroup", in, out, opts...)
@@ -397,6 +485,15 @@ func (x *gobgpApiListPathClient) Recv() (*ListPathResponse, error) {
 	return m, nil
 }
 
+func (c *gobgpApiClient) ListPathAggregated(ctx context.Context, in *ListPathRequest, opts ...grpc.CallOption) (*ListPathAggregatedResponse, error) {
+	out := new(ListPathAggregatedResponse)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/ListPathAggregated", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gobgpApiClient) AddPathStream(ctx context.Context, opts ...grpc.CallOption) (GobgpApi_AddPathStreamClient, error) {
 	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[5], "/apipb.GobgpApi/AddPathStream", opts...)
 	if err != nil {
@@ -431,6 +528,15 @@ func (x *gobgpApiAddPathStreamClient) CloseAndRecv() (*emptypb.Empty, error) {
 	return m, nil
 }
 
+func (c *gobgpApiClient) ExtendPathLifetime(ctx context.Context, in *ExtendPathLifetimeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/ExtendPathLifetime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gobgpApiClient) GetTable(ctx context.Context, in *GetTableRequest, opts ...grpc.CallOption) (*GetTableResponse, error) {
 	out := new(GetTableResponse)
 	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/GetTable", in, out, opts...)
@@ -490,6 +596,111 @@ func (x *gobgpApiListVrfClient) Recv() (*ListVrfResponse, error) {
 	return m, nil
 }
 
+func (c *gobgpApiClient) ListEvpnDesignatedForwarder(ctx context.Context, in *ListEvpnDesignatedForwarderRequest, opts ...grpc.CallOption) (GobgpApi_ListEvpnDesignatedForwarderClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[7], "/apipb.GobgpApi/ListEvpnDesignatedForwarder", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gobgpApiListEvpnDesignatedForwarderClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GobgpApi_ListEvpnDesignatedForwarderClient interface {
+	Recv() (*ListEvpnDesignatedForwarderResponse, error)
+	grpc.ClientStream
+}
+
+type gobgpApiListEvpnDesignatedForwarderClient struct {
+	grpc.ClientStream
+}
+
+func (x *gobgpApiListEvpnDesignatedForwarderClient) Recv() (*ListEvpnDesignatedForwarderResponse, error) {
+	m := new(ListEvpnDesignatedForwarderResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gobgpApiClient) ListEvpnFrozenMac(ctx context.Context, in *ListEvpnFrozenMacRequest, opts ...grpc.CallOption) (GobgpApi_ListEvpnFrozenMacClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[8], "/apipb.GobgpApi/ListEvpnFrozenMac", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gobgpApiListEvpnFrozenMacClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GobgpApi_ListEvpnFrozenMacClient interface {
+	Recv() (*ListEvpnFrozenMacResponse, error)
+	grpc.ClientStream
+}
+
+type gobgpApiListEvpnFrozenMacClient struct {
+	grpc.ClientStream
+}
+
+func (x *gobgpApiListEvpnFrozenMacClient) Recv() (*ListEvpnFrozenMacResponse, error) {
+	m := new(ListEvpnFrozenMacResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gobgpApiClient) ClearEvpnFrozenMac(ctx context.Context, in *ClearEvpnFrozenMacRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/ClearEvpnFrozenMac", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) ListColorBinding(ctx context.Context, in *ListColorBindingRequest, opts ...grpc.CallOption) (GobgpApi_ListColorBindingClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[9], "/apipb.GobgpApi/ListColorBinding", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gobgpApiListColorBindingClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GobgpApi_ListColorBindingClient interface {
+	Recv() (*ListColorBindingResponse, error)
+	grpc.ClientStream
+}
+
+type gobgpApiListColorBindingClient struct {
+	grpc.ClientStream
+}
+
+func (x *gobgpApiListColorBindingClient) Recv() (*ListColorBindingResponse, error) {
+	m := new(ListColorBindingResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *gobgpApiClient) AddPolicy(ctx context.Context, in *AddPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/AddPolicy", in, out, opts...)
@@ -509,7 +720,7 @@ func (c *gobgpApiClient) DeletePolicy(ctx context.Context, in *DeletePolicyReque
 }
 
 func (c *gobgpApiClient) ListPolicy(ctx context.Context, in *ListPolicyRequest, opts ...grpc.CallOption) (GobgpApi_ListPolicyClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[7], "/apipb.GobgpApi/ListPolicy", opts...)
+	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[10], "/apipb.GobgpApi/ListPolicy", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -549,6 +760,69 @@ func (c *gobgpApiClient) SetPolicies(ctx context.Context, in *SetPoliciesRequest
 	return out, nil
 }
 
+func (c *gobgpApiClient) ReplacePolicies(ctx context.Context, in *ReplacePoliciesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/ReplacePolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) StageConfig(ctx context.Context, in *StageConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/StageConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) DiscardConfig(ctx context.Context, in *DiscardConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/DiscardConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) CommitConfig(ctx context.Context, in *CommitConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/CommitConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) ConfirmConfig(ctx context.Context, in *ConfirmConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/ConfirmConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) RollbackConfig(ctx context.Context, in *RollbackConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/RollbackConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) GetConfigTransaction(ctx context.Context, in *GetConfigTransactionRequest, opts ...grpc.CallOption) (*GetConfigTransactionResponse, error) {
+	out := new(GetConfigTransactionResponse)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/GetConfigTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gobgpApiClient) AddDefinedSet(ctx context.Context, in *AddDefinedSetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/AddDefinedSet", in, out, opts...)
@@ -568,7 +842,7 @@ func (c *gobgpApiClient) DeleteDefinedSet(ctx context.Context, in *DeleteDefined
 }
 
 func (c *gobgpApiClient) ListDefinedSet(ctx context.Context, in *ListDefinedSetRequest, opts ...grpc.CallOption) (GobgpApi_ListDefinedSetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[8], "/apipb.GobgpApi/ListDefinedSet", opts...)
+	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[11], "/apipb.GobgpApi/ListDefinedSet", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -599,6 +873,15 @@ func (x *gobgpApiListDefinedSetClient) Recv() (*ListDefinedSetResponse, error) {
 	return m, nil
 }
 
+func (c *gobgpApiClient) UpdateDefinedSet(ctx context.Context, in *UpdateDefinedSetRequest, opts ...grpc.CallOption) (*UpdateDefinedSetResponse, error) {
+	out := new(UpdateDefinedSetResponse)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/UpdateDefinedSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gobgpApiClient) AddStatement(ctx context.Context, in *AddStatementRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/AddStatement", in, out, opts...)
@@ -618,7 +901,7 @@ func (c *gobgpApiClient) DeleteStatement(ctx context.Context, in *DeleteStatemen
 }
 
 func (c *gobgpApiClient) ListStatement(ctx context.Context, in *ListStatementRequest, opts ...grpc.CallOption) (GobgpApi_ListStatementClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[9], "/apipb.GobgpApi/ListStatement", opts...)
+	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[12], "/apipb.GobgpApi/ListStatement", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -668,7 +951,7 @@ func (c *gobgpApiClient) DeletePolicyAssignment(ctx context.Context, in *DeleteP
 }
 
 func (c *gobgpApiClient) ListPolicyAssignment(ctx context.Context, in *ListPolicyAssignmentRequest, opts ...grpc.CallOption) (GobgpApi_ListPolicyAssignmentClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[10], "/apipb.GobgpApi/ListPolicyAssignment", opts...)
+	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[13], "/apipb.GobgpApi/ListPolicyAssignment", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -708,6 +991,24 @@ func (c *gobgpApiClient) SetPolicyAssignment(ctx context.Context, in *SetPolicyA
 	return out, nil
 }
 
+func (c *gobgpApiClient) ReplacePolicyAssignment(ctx context.Context, in *ReplacePolicyAssignmentRequest, opts ...grpc.CallOption) (*ReplacePolicyAssignmentResponse, error) {
+	out := new(ReplacePolicyAssignmentResponse)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/ReplacePolicyAssignment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) SimulatePolicy(ctx context.Context, in *SimulatePolicyRequest, opts ...grpc.CallOption) (*SimulatePolicyResponse, error) {
+	out := new(SimulatePolicyResponse)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/SimulatePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gobgpApiClient) AddRpki(ctx context.Context, in *AddRpkiRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/AddRpki", in, out, opts...)
@@ -727,7 +1028,7 @@ func (c *gobgpApiClient) DeleteRpki(ctx context.Context, in *DeleteRpkiRequest,
 }
 
 func (c *gobgpApiClient) ListRpki(ctx context.Context, in *ListRpkiRequest, opts ...grpc.CallOption) (GobgpApi_ListRpkiClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[11], "/apipb.GobgpApi/ListRpki", opts...)
+	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[14], "/apipb.GobgpApi/ListRpki", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -786,7 +1087,7 @@ func (c *gobgpApiClient) ResetRpki(ctx context.Context, in *ResetRpkiRequest, op
 }
 
 func (c *gobgpApiClient) ListRpkiTable(ctx context.Context, in *ListRpkiTableRequest, opts ...grpc.CallOption) (GobgpApi_ListRpkiTableClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[12], "/apipb.GobgpApi/ListRpkiTable", opts...)
+	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[15], "/apipb.GobgpApi/ListRpkiTable", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -817,6 +1118,15 @@ func (x *gobgpApiListRpkiTableClient) Recv() (*ListRpkiTableResponse, error) {
 	return m, nil
 }
 
+func (c *gobgpApiClient) SetRpkiSlurm(ctx context.Context, in *SetRpkiSlurmRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/SetRpkiSlurm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gobgpApiClient) EnableZebra(ctx context.Context, in *EnableZebraRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/EnableZebra", in, out, opts...)
@@ -826,6 +1136,83 @@ func (c *gobgpApiClient) EnableZebra(ctx context.Context, in *EnableZebraRequest
 	return out, nil
 }
 
+func (c *gobgpApiClient) EnableNetlink(ctx context.Context, in *EnableNetlinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/EnableNetlink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) DisableNetlink(ctx context.Context, in *DisableNetlinkRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/DisableNetlink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) EnablePeeringDb(ctx context.Context, in *EnablePeeringDbRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/EnablePeeringDb", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) DisablePeeringDb(ctx context.Context, in *DisablePeeringDbRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/DisablePeeringDb", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gobgpApiClient) ListPeeringDbRecord(ctx context.Context, in *ListPeeringDbRecordRequest, opts ...grpc.CallOption) (GobgpApi_ListPeeringDbRecordClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[16], "/apipb.GobgpApi/ListPeeringDbRecord", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gobgpApiListPeeringDbRecordClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GobgpApi_ListPeeringDbRecordClient interface {
+	Recv() (*ListPeeringDbRecordResponse, error)
+	grpc.ClientStream
+}
+
+type gobgpApiListPeeringDbRecordClient struct {
+	grpc.ClientStream
+}
+
+func (x *gobgpApiListPeeringDbRecordClient) Recv() (*ListPeeringDbRecordResponse, error) {
+	m := new(ListPeeringDbRecordResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gobgpApiClient) SetSrv6Locator(ctx context.Context, in *SetSrv6LocatorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/SetSrv6Locator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gobgpApiClient) EnableMrt(ctx context.Context, in *EnableMrtRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/EnableMrt", in, out, opts...)
@@ -863,7 +1250,7 @@ func (c *gobgpApiClient) DeleteBmp(ctx context.Context, in *DeleteBmpRequest, op
 }
 
 func (c *gobgpApiClient) ListBmp(ctx context.Context, in *ListBmpRequest, opts ...grpc.CallOption) (GobgpApi_ListBmpClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[13], "/apipb.GobgpApi/ListBmp", opts...)
+	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[17], "/apipb.GobgpApi/ListBmp", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -894,1292 +1281,3071 @@ func (x *gobgpApiListBmpClient) Recv() (*ListBmpResponse, error) {
 	return m, nil
 }
 
-func (c *gobgpApiClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *gobgpApiClient) AddKafkaExporter(ctx context.Context, in *AddKafkaExporterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/SetLogLevel", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/AddKafkaExporter", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// GobgpApiServer is the server API for GobgpApi service.
-// All implementations must embed UnimplementedGobgpApiServer
-// for forward compatibility
-type GobgpApiServer interface {
-	StartBgp(context.Context, *StartBgpRequest) (*emptypb.Empty, error)
-	StopBgp(context.Context, *StopBgpRequest) (*emptypb.Empty, error)
-	GetBgp(context.Context, *GetBgpRequest) (*GetBgpResponse, error)
-	WatchEvent(*WatchEventRequest, GobgpApi_WatchEventServer) error
-	AddPeer(context.Context, *AddPeerRequest) (*emptypb.Empty, error)
-	DeletePeer(context.Context, *DeletePeerRequest) (*emptypb.Empty, error)
-	ListPeer(*ListPeerRequest, GobgpApi_ListPeerServer) error
-	UpdatePeer(context.Context, *UpdatePeerRequest) (*UpdatePeerResponse, error)
-	ResetPeer(context.Context, *ResetPeerRequest) (*emptypb.Empty, error)
-	ShutdownPeer(context.Context, *ShutdownPeerRequest) (*emptypb.Empty, error)
-	EnablePeer(context.Context, *EnablePeerRequest) (*emptypb.Empty, error)
-	DisablePeer(context.Context, *DisablePeerRequest) (*emptypb.Empty, error)
-	AddPeerGroup(context.Context, *AddPeerGroupRequest) (*emptypb.Empty, error)
-	DeletePeerGroup(context.Context, *DeletePeerGroupRequest) (*emptypb.Empty, error)
-	ListPeerGroup(*ListPeerGroupRequest, GobgpApi_ListPeerGroupServer) error
-	UpdatePeerGroup(context.Context, *UpdatePeerGroupRequest) (*UpdatePeerGroupResponse, error)
-	AddDynamicNeighbor(context.Context, *AddDynamicNeighborRequest) (*emptypb.Empty, error)
-	ListDynamicNeighbor(*ListDynamicNeighborRequest, GobgpApi_ListDynamicNeighborServer) error
-	DeleteDynamicNeighbor(context.Context, *DeleteDynamicNeighborRequest) (*emptypb.Empty, error)
-	AddPath(context.Context, *AddPathRequest) (*AddPathResponse, error)
-	DeletePath(context.Context, *DeletePathRequest) (*emptypb.Empty, error)
-	ListPath(*ListPathRequest, GobgpApi_ListPathServer) error
-	AddPathStream(GobgpApi_AddPathStreamServer) error
-	GetTable(context.Context, *GetTableRequest) (*GetTableResponse, error)
-	AddVrf(context.Context, *AddVrfRequest) (*emptypb.Empty, error)
-	DeleteVrf(context.Context, *DeleteVrfRequest) (*emptypb.Empty, error)
-	ListVrf(*ListVrfRequest, GobgpApi_ListVrfServer) error
-	AddPolicy(context.Context, *AddPolicyRequest) (*emptypb.Empty, error)
-	DeletePolicy(context.Context, *DeletePolicyRequest) (*emptypb.Empty, error)
-	ListPolicy(*ListPolicyRequest, GobgpApi_ListPolicyServer) error
-	SetPolicies(context.Context, *SetPoliciesRequest) (*emptypb.Empty, error)
-	AddDefinedSet(context.Context, *AddDefinedSetRequest) (*emptypb.Empty, error)
-	DeleteDefinedSet(context.Context, *DeleteDefinedSetRequest) (*emptypb.Empty, error)
-	ListDefinedSet(*ListDefinedSetRequest, GobgpApi_ListDefinedSetServer) error
-	AddStatement(context.Context, *AddStatementRequest) (*emptypb.Empty, error)
-	DeleteStatement(context.Context, *DeleteStatementRequest) (*emptypb.Empty, error)
-	ListStatement(*ListStatementRequest, GobgpApi_ListStatementServer) error
-	AddPolicyAssignment(context.Context, *AddPolicyAssignmentRequest) (*emptypb.Empty, error)
-	DeletePolicyAssignment(context.Context, *DeletePolicyAssignmentRequest) (*emptypb.Empty, error)
-	ListPolicyAssignment(*ListPolicyAssignmentRequest, GobgpApi_ListPolicyAssignmentServer) error
-	SetPolicyAssignment(context.Context, *SetPolicyAssignmentRequest) (*emptypb.Empty, error)
-	AddRpki(context.Context, *AddRpkiRequest) (*emptypb.Empty, error)
-	DeleteRpki(context.Context, *DeleteRpkiRequest) (*emptypb.Empty, error)
-	ListRpki(*ListRpkiRequest, GobgpApi_ListRpkiServer) error
-	EnableRpki(context.Context, *EnableRpkiRequest) (*emptypb.Empty, error)
-	DisableRpki(context.Context, *DisableRpkiRequest) (*emptypb.Empty, error)
-	ResetRpki(context.Context, *ResetRpkiRequest) (*emptypb.Empty, error)
-	ListRpkiTable(*ListRpkiTableRequest, GobgpApi_ListRpkiTableServer) error
-	EnableZebra(context.Context, *EnableZebraRequest) (*emptypb.Empty, error)
-	EnableMrt(context.Context, *EnableMrtRequest) (*emptypb.Empty, error)
-	DisableMrt(context.Context, *DisableMrtRequest) (*emptypb.Empty, error)
-	AddBmp(context.Context, *AddBmpRequest) (*emptypb.Empty, error)
-	DeleteBmp(context.Context, *DeleteBmpRequest) (*emptypb.Empty, error)
-	ListBmp(*ListBmpRequest, GobgpApi_ListBmpServer) error
-	SetLogLevel(context.Context, *SetLogLevelRequest) (*emptypb.Empty, error)
-	mustEmbedUnimplementedGobgpApiServer()
+func (c *gobgpApiClient) DeleteKafkaExporter(ctx context.Context, in *DeleteKafkaExporterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/DeleteKafkaExporter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedGobgpApiServer must be embedded to have forward compatible implementations.
-type UnimplementedGobgpApiServer struct {
+func (c *gobgpApiClient) ListKafkaExporter(ctx context.Context, in *ListKafkaExporterRequest, opts ...grpc.CallOption) (GobgpApi_ListKafkaExporterClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[18], "/apipb.GobgpApi/ListKafkaExporter", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gobgpApiListKafkaExporterClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (UnimplementedGobgpApiServer) StartBgp(context.Context, *StartBgpRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartBgp not implemented")
+type GobgpApi_ListKafkaExporterClient interface {
+	Recv() (*ListKafkaExporterResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedGobgpApiServer) StopBgp(context.Context, *StopBgpRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StopBgp not implemented")
+
+type gobgpApiListKafkaExporterClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedGobgpApiServer) GetBgp(context.Context, *GetBgpRequest) (*GetBgpResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBgp not implemented")
+
+func (x *gobgpApiListKafkaExporterClient) Recv() (*ListKafkaExporterResponse, error) {
+	m := new(ListKafkaExporterResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedGobgpApiServer) WatchEvent(*WatchEventRequest, GobgpApi_WatchEventServer) error {
-	return status.Errorf(codes.Unimplemented, "method WatchEvent not implemented")
+
+func (c *gobgpApiClient) AddIrrFilter(ctx context.Context, in *AddIrrFilterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/AddIrrFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGobgpApiServer) AddPeer(context.Context, *AddPeerRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPeer not implemented")
+
+func (c *gobgpApiClient) DeleteIrrFilter(ctx context.Context, in *DeleteIrrFilterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/DeleteIrrFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGobgpApiServer) DeletePeer(context.Context, *DeletePeerRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePeer not implemented")
+
+func (c *gobgpApiClient) ListIrrFilter(ctx context.Context, in *ListIrrFilterRequest, opts ...grpc.CallOption) (GobgpApi_ListIrrFilterClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[19], "/apipb.GobgpApi/ListIrrFilter", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gobgpApiListIrrFilterClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedGobgpApiServer) ListPeer(*ListPeerRequest, GobgpApi_ListPeerServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListPeer not implemented")
+
+type GobgpApi_ListIrrFilterClient interface {
+	Recv() (*ListIrrFilterResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedGobgpApiServer) UpdatePeer(context.Context, *UpdatePeerRequest) (*UpdatePeerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePeer not implemented")
+
+type gobgpApiListIrrFilterClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedGobgpApiServer) ResetPeer(context.Context, *ResetPeerRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResetPeer not implemented")
+
+func (x *gobgpApiListIrrFilterClient) Recv() (*ListIrrFilterResponse, error) {
+	m := new(ListIrrFilterResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedGobgpApiServer) ShutdownPeer(context.Context, *ShutdownPeerRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShutdownPeer not implemented")
+
+func (c *gobgpApiClient) AddAggregate(ctx context.Context, in *AddAggregateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/AddAggregate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGobgpApiServer) EnablePeer(context.Context, *EnablePeerRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnablePeer not implemented")
+
+func (c *gobgpApiClient) DeleteAggregate(ctx context.Context, in *DeleteAggregateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/DeleteAggregate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGobgpApiServer) DisablePeer(context.Context, *DisablePeerRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisablePeer not implemented")
+
+func (c *gobgpApiClient) ListAggregate(ctx context.Context, in *ListAggregateRequest, opts ...grpc.CallOption) (GobgpApi_ListAggregateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[20], "/apipb.GobgpApi/ListAggregate", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gobgpApiListAggregateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedGobgpApiServer) AddPeerGroup(context.Context, *AddPeerGroupRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPeerGroup not implemented")
+
+type GobgpApi_ListAggregateClient interface {
+	Recv() (*ListAggregateResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedGobgpApiServer) DeletePeerGroup(context.Context, *DeletePeerGroupRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePeerGroup not implemented")
+
+type gobgpApiListAggregateClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedGobgpApiServer) ListPeerGroup(*ListPeerGroupRequest, GobgpApi_ListPeerGroupServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListPeerGroup not implemented")
+
+func (x *gobgpApiListAggregateClient) Recv() (*ListAggregateResponse, error) {
+	m := new(ListAggregateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedGobgpApiServer) UpdatePeerGroup(context.Context, *UpdatePeerGroupRequest) (*UpdatePeerGroupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePeerGroup not implemented")
+
+func (c *gobgpApiClient) AddVrfLeak(ctx context.Context, in *AddVrfLeakRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/AddVrfLeak", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGobgpApiServer) AddDynamicNeighbor(context.Context, *AddDynamicNeighborRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddDynamicNeighbor not implemented")
+
+func (c *gobgpApiClient) DeleteVrfLeak(ctx context.Context, in *DeleteVrfLeakRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/DeleteVrfLeak", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGobgpApiServer) ListDynamicNeighbor(*ListDynamicNeighborRequest, GobgpApi_ListDynamicNeighborServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListDynamicNeighbor not implemented")
+
+func (c *gobgpApiClient) ListVrfLeak(ctx context.Context, in *ListVrfLeakRequest, opts ...grpc.CallOption) (GobgpApi_ListVrfLeakClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[21], "/apipb.GobgpApi/ListVrfLeak", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gobgpApiListVrfLeakClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedGobgpApiServer) DeleteDynamicNeighbor(context.Context, *DeleteDynamicNeighborRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteDynamicNeighbor not implemented")
+
+type GobgpApi_ListVrfLeakClient interface {
+	Recv() (*ListVrfLeakResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedGobgpApiServer) AddPath(context.Context, *AddPathRequest) (*AddPathResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPath not implemented")
+
+type gobgpApiListVrfLeakClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedGobgpApiServer) DeletePath(context.Context, *DeletePathRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePath not implemented")
+
+func (x *gobgpApiListVrfLeakClient) Recv() (*ListVrfLeakResponse, error) {
+	m := new(ListVrfLeakResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedGobgpApiServer) ListPath(*ListPathRequest, GobgpApi_ListPathServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListPath not implemented")
+
+func (c *gobgpApiClient) AddOrrVantagePoint(ctx context.Context, in *AddOrrVantagePointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/AddOrrVantagePoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGobgpApiServer) AddPathStream(GobgpApi_AddPathStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method AddPathStream not implemented")
+
+func (c *gobgpApiClient) DeleteOrrVantagePoint(ctx context.Context, in *DeleteOrrVantagePointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/DeleteOrrVantagePoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGobgpApiServer) GetTable(context.Context, *GetTableRequest) (*GetTableResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTable not implemented")
+
+func (c *gobgpApiClient) ListOrrVantagePoint(ctx context.Context, in *ListOrrVantagePointRequest, opts ...grpc.CallOption) (GobgpApi_ListOrrVantagePointClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[22], "/apipb.GobgpApi/ListOrrVantagePoint", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gobgpApiListOrrVantagePointClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedGobgpApiServer) AddVrf(context.Context, *AddVrfRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddVrf not implemented")
+
+type GobgpApi_ListOrrVantagePointClient interface {
+	Recv() (*ListOrrVantagePointResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedGobgpApiServer) DeleteVrf(context.Context, *DeleteVrfRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteVrf not implemented")
+
+type gobgpApiListOrrVantagePointClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedGobgpApiServer) ListVrf(*ListVrfRequest, GobgpApi_ListVrfServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListVrf not implemented")
+
+func (x *gobgpApiListOrrVantagePointClient) Recv() (*ListOrrVantagePointResponse, error) {
+	m := new(ListOrrVantagePointResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedGobgpApiServer) AddPolicy(context.Context, *AddPolicyRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPolicy not implemented")
+
+func (c *gobgpApiClient) AddEvpnVpws(ctx context.Context, in *AddEvpnVpwsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/AddEvpnVpws", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGobgpApiServer) DeletePolicy(context.Context, *DeletePolicyRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicy not implemented")
+
+func (c *gobgpApiClient) DeleteEvpnVpws(ctx context.Context, in *DeleteEvpnVpwsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/DeleteEvpnVpws", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGobgpApiServer) ListPolicy(*ListPolicyRequest, GobgpApi_ListPolicyServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListPolicy not implemented")
+
+func (c *gobgpApiClient) ListEvpnVpws(ctx context.Context, in *ListEvpnVpwsRequest, opts ...grpc.CallOption) (GobgpApi_ListEvpnVpwsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[23], "/apipb.GobgpApi/ListEvpnVpws", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gobgpApiListEvpnVpwsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedGobgpApiServer) SetPolicies(context.Context, *SetPoliciesRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetPolicies not implemented")
+
+type GobgpApi_ListEvpnVpwsClient interface {
+	Recv() (*ListEvpnVpwsResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedGobgpApiServer) AddDefinedSet(context.Context, *AddDefinedSetRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddDefinedSet not implemented")
+
+type gobgpApiListEvpnVpwsClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedGobgpApiServer) DeleteDefinedSet(context.Context, *DeleteDefinedSetRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteDefinedSet not implemented")
+
+func (x *gobgpApiListEvpnVpwsClient) Recv() (*ListEvpnVpwsResponse, error) {
+	m := new(ListEvpnVpwsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedGobgpApiServer) ListDefinedSet(*ListDefinedSetRequest, GobgpApi_ListDefinedSetServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListDefinedSet not implemented")
+
+func (c *gobgpApiClient) AddRtbh(ctx context.Context, in *AddRtbhRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/AddRtbh", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGobgpApiServer) AddStatement(context.Context, *AddStatementRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddStatement not implemented")
+
+func (c *gobgpApiClient) DeleteRtbh(ctx context.Context, in *DeleteRtbhRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/DeleteRtbh", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGobgpApiServer) DeleteStatement(context.Context, *DeleteStatementRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteStatement not implemented")
+
+func (c *gobgpApiClient) ListRtbh(ctx context.Context, in *ListRtbhRequest, opts ...grpc.CallOption) (GobgpApi_ListRtbhClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[24], "/apipb.GobgpApi/ListRtbh", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gobgpApiListRtbhClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedGobgpApiServer) ListStatement(*ListStatementRequest, GobgpApi_ListStatementServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListStatement not implemented")
+
+type GobgpApi_ListRtbhClient interface {
+	Recv() (*ListRtbhResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedGobgpApiServer) AddPolicyAssignment(context.Context, *AddPolicyAssignmentRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPolicyAssignment not implemented")
+
+type gobgpApiListRtbhClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedGobgpApiServer) DeletePolicyAssignment(context.Context, *DeletePolicyAssignmentRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicyAssignment not implemented")
+
+func (x *gobgpApiListRtbhClient) Recv() (*ListRtbhResponse, error) {
+	m := new(ListRtbhResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedGobgpApiServer) ListPolicyAssignment(*ListPolicyAssignmentRequest, GobgpApi_ListPolicyAssignmentServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListPolicyAssignment not implemented")
+
+func (c *gobgpApiClient) ListPrefixActivity(ctx context.Context, in *ListPrefixActivityRequest, opts ...grpc.CallOption) (GobgpApi_ListPrefixActivityClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[25], "/apipb.GobgpApi/ListPrefixActivity", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gobgpApiListPrefixActivityClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedGobgpApiServer) SetPolicyAssignment(context.Context, *SetPolicyAssignmentRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetPolicyAssignment not implemented")
+
+type GobgpApi_ListPrefixActivityClient interface {
+	Recv() (*ListPrefixActivityResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedGobgpApiServer) AddRpki(context.Context, *AddRpkiRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddRpki not implemented")
+
+type gobgpApiListPrefixActivityClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedGobgpApiServer) DeleteRpki(context.Context, *DeleteRpkiRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRpki not implemented")
+
+func (x *gobgpApiListPrefixActivityClient) Recv() (*ListPrefixActivityResponse, error) {
+	m := new(ListPrefixActivityResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedGobgpApiServer) ListRpki(*ListRpkiRequest, GobgpApi_ListRpkiServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListRpki not implemented")
+
+func (c *gobgpApiClient) GetPathHistory(ctx context.Context, in *GetPathHistoryRequest, opts ...grpc.CallOption) (*GetPathHistoryResponse, error) {
+	out := new(GetPathHistoryResponse)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/GetPathHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGobgpApiServer) EnableRpki(context.Context, *EnableRpkiRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableRpki not implemented")
+
+func (c *gobgpApiClient) GetRibSummary(ctx context.Context, in *GetRibSummaryRequest, opts ...grpc.CallOption) (*GetRibSummaryResponse, error) {
+	out := new(GetRibSummaryResponse)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/GetRibSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGobgpApiServer) DisableRpki(context.Context, *DisableRpkiRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableRpki not implemented")
+
+func (c *gobgpApiClient) ListRibDiff(ctx context.Context, in *ListRibDiffRequest, opts ...grpc.CallOption) (GobgpApi_ListRibDiffClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[26], "/apipb.GobgpApi/ListRibDiff", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gobgpApiListRibDiffClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedGobgpApiServer) ResetRpki(context.Context, *ResetRpkiRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResetRpki not implemented")
+
+type GobgpApi_ListRibDiffClient interface {
+	Recv() (*ListRibDiffResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedGobgpApiServer) ListRpkiTable(*ListRpkiTableRequest, GobgpApi_ListRpkiTableServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListRpkiTable not implemented")
+
+type gobgpApiListRibDiffClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedGobgpApiServer) EnableZebra(context.Context, *EnableZebraRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableZebra not implemented")
+
+func (x *gobgpApiListRibDiffClient) Recv() (*ListRibDiffResponse, error) {
+	m := new(ListRibDiffResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedGobgpApiServer) EnableMrt(context.Context, *EnableMrtRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableMrt not implemented")
+
+func (c *gobgpApiClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGobgpApiServer) DisableMrt(context.Context, *DisableMrtRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableMrt not implemented")
+
+func (c *gobgpApiClient) GetLogLevel(ctx context.Context, in *GetLogLevelRequest, opts ...grpc.CallOption) (*GetLogLevelResponse, error) {
+	out := new(GetLogLevelResponse)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/GetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGobgpApiServer) AddBmp(context.Context, *AddBmpRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddBmp not implemented")
+
+func (c *gobgpApiClient) StartDebugCapture(ctx context.Context, in *StartDebugCaptureRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/StartDebugCapture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGobgpApiServer) DeleteBmp(context.Context, *DeleteBmpRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteBmp not implemented")
+
+func (c *gobgpApiClient) StopDebugCapture(ctx context.Context, in *StopDebugCaptureRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/StopDebugCapture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGobgpApiServer) ListBmp(*ListBmpRequest, GobgpApi_ListBmpServer) error {
-	return status.Errorf(codes.Unimplemented, "method ListBmp not implemented")
+
+func (c *gobgpApiClient) ListDebugCapture(ctx context.Context, in *ListDebugCaptureRequest, opts ...grpc.CallOption) (GobgpApi_ListDebugCaptureClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GobgpApi_ServiceDesc.Streams[27], "/apipb.GobgpApi/ListDebugCapture", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gobgpApiListDebugCaptureClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedGobgpApiServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+
+type GobgpApi_ListDebugCaptureClient interface {
+	Recv() (*ListDebugCaptureResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedGobgpApiServer) mustEmbedUnimplementedGobgpApiServer() {}
 
-// UnsafeGobgpApiServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GobgpApiServer will
-// result in compilation errors.
-type UnsafeGobgpApiServer interface {
-	mustEmbedUnimplementedGobgpApiServer()
+type gobgpApiListDebugCaptureClient struct {
+	grpc.ClientStream
 }
 
-func RegisterGobgpApiServer(s grpc.ServiceRegistrar, srv GobgpApiServer) {
-	s.RegisterService(&GobgpApi_ServiceDesc, srv)
+func (x *gobgpApiListDebugCaptureClient) Recv() (*ListDebugCaptureResponse, error) {
+	m := new(ListDebugCaptureResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gobgpApiClient) GetApiVersion(ctx context.Context, in *GetApiVersionRequest, opts ...grpc.CallOption) (*GetApiVersionResponse, error) {
+	out := new(GetApiVersionResponse)
+	err := c.cc.Invoke(ctx, "/apipb.GobgpApi/GetApiVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GobgpApiServer is the server API for GobgpApi service.
+// All implementations must embed UnimplementedGobgpApiServer
+// for forward compatibility
+type GobgpApiServer interface {
+	StartBgp(context.Context, *StartBgpRequest) (*emptypb.Empty, error)
+	StopBgp(context.Context, *StopBgpRequest) (*emptypb.Empty, error)
+	GetBgp(context.Context, *GetBgpRequest) (*GetBgpResponse, error)
+	WatchEvent(*WatchEventRequest, GobgpApi_WatchEventServer) error
+	AddPeer(context.Context, *AddPeerRequest) (*emptypb.Empty, error)
+	DeletePeer(context.Context, *DeletePeerRequest) (*emptypb.Empty, error)
+	ListPeer(*ListPeerRequest, GobgpApi_ListPeerServer) error
+	// ListPeer with all the peers in a single response for the clients
+	// preferring unary calls.
+	ListPeerAggregated(context.Context, *ListPeerRequest) (*ListPeerAggregatedResponse, error)
+	GetEffectivePeerConfig(context.Context, *GetEffectivePeerConfigRequest) (*GetEffectivePeerConfigResponse, error)
+	UpdatePeer(context.Context, *UpdatePeerRequest) (*UpdatePeerResponse, error)
+	ResetPeer(context.Context, *ResetPeerRequest) (*emptypb.Empty, error)
+	ShutdownPeer(context.Context, *ShutdownPeerRequest) (*emptypb.Empty, error)
+	EnablePeer(context.Context, *EnablePeerRequest) (*emptypb.Empty, error)
+	DisablePeer(context.Context, *DisablePeerRequest) (*emptypb.Empty, error)
+	GracefulShutdownPeer(context.Context, *GracefulShutdownPeerRequest) (*emptypb.Empty, error)
+	AddPeerGroup(context.Context, *AddPeerGroupRequest) (*emptypb.Empty, error)
+	DeletePeerGroup(context.Context, *DeletePeerGroupRequest) (*emptypb.Empty, error)
+	ListPeerGroup(*ListPeerGroupRequest, GobgpApi_ListPeerGroupServer) error
+	UpdatePeerGroup(context.Context, *UpdatePeerGroupRequest) (*UpdatePeerGroupResponse, error)
+	AddDynamicNeighbor(context.Context, *AddDynamicNeighborRequest) (*emptypb.Empty, error)
+	ListDynamicNeighbor(*ListDynamicNeighborRequest, GobgpApi_ListDynamicNeighborServer) error
+	DeleteDynamicNeighbor(context.Context, *DeleteDynamicNeighborRequest) (*emptypb.Empty, error)
+	AddPath(context.Context, *AddPathRequest) (*AddPathResponse, error)
+	DeletePath(context.Context, *DeletePathRequest) (*emptypb.Empty, error)
+	ListPath(*ListPathRequest, GobgpApi_ListPathServer) error
+	// ListPath with the destinations in a single response for the clients
+	// preferring unary calls. Use page_size for the large tables not to
+	// exceed the maximum message size of the client.
+	ListPathAggregated(context.Context, *ListPathRequest) (*ListPathAggregatedResponse, error)
+	AddPathStream(GobgpApi_AddPathStreamServer) error
+	ExtendPathLifetime(context.Context, *ExtendPathLifetimeRequest) (*emptypb.Empty, error)
+	GetTable(context.Context, *GetTableRequest) (*GetTableResponse, error)
+	AddVrf(context.Context, *AddVrfRequest) (*emptypb.Empty, error)
+	DeleteVrf(context.Context, *DeleteVrfRequest) (*emptypb.Empty, error)
+	ListVrf(*ListVrfRequest, GobgpApi_ListVrfServer) error
+	ListEvpnDesignatedForwarder(*ListEvpnDesignatedForwarderRequest, GobgpApi_ListEvpnDesignatedForwarderServer) error
+	ListEvpnFrozenMac(*ListEvpnFrozenMacRequest, GobgpApi_ListEvpnFrozenMacServer) error
+	ClearEvpnFrozenMac(context.Context, *ClearEvpnFrozenMacRequest) (*emptypb.Empty, error)
+	ListColorBinding(*ListColorBindingRequest, GobgpApi_ListColorBindingServer) error
+	AddPolicy(context.Context, *AddPolicyRequest) (*emptypb.Empty, error)
+	DeletePolicy(context.Context, *DeletePolicyRequest) (*emptypb.Empty, error)
+	ListPolicy(*ListPolicyRequest, GobgpApi_ListPolicyServer) error
+	SetPolicies(context.Context, *SetPoliciesRequest) (*emptypb.Empty, error)
+	ReplacePolicies(context.Context, *ReplacePoliciesRequest) (*emptypb.Empty, error)
+	StageConfig(context.Context, *StageConfigRequest) (*emptypb.Empty, error)
+	DiscardConfig(context.Context, *DiscardConfigRequest) (*emptypb.Empty, error)
+	CommitConfig(context.Context, *CommitConfigRequest) (*emptypb.Empty, error)
+	ConfirmConfig(context.Context, *ConfirmConfigRequest) (*emptypb.Empty, error)
+	RollbackConfig(context.Context, *RollbackConfigRequest) (*emptypb.Empty, error)
+	GetConfigTransaction(context.Context, *GetConfigTransactionRequest) (*GetConfigTransactionResponse, error)
+	AddDefinedSet(context.Context, *AddDefinedSetRequest) (*emptypb.Empty, error)
+	DeleteDefinedSet(context.Context, *DeleteDefinedSetRequest) (*emptypb.Empty, error)
+	ListDefinedSet(*ListDefinedSetRequest, GobgpApi_ListDefinedSetServer) error
+	UpdateDefinedSet(context.Context, *UpdateDefinedSetRequest) (*UpdateDefinedSetResponse, error)
+	AddStatement(context.Context, *AddStatementRequest) (*emptypb.Empty, error)
+	DeleteStatement(context.Context, *DeleteStatementRequest) (*emptypb.Empty, error)
+	ListStatement(*ListStatementRequest, GobgpApi_ListStatementServer) error
+	AddPolicyAssignment(context.Context, *AddPolicyAssignmentRequest) (*emptypb.Empty, error)
+	DeletePolicyAssignment(context.Context, *DeletePolicyAssignmentRequest) (*emptypb.Empty, error)
+	ListPolicyAssignment(*ListPolicyAssignmentRequest, GobgpApi_ListPolicyAssignmentServer) error
+	SetPolicyAssignment(context.Context, *SetPolicyAssignmentRequest) (*emptypb.Empty, error)
+	ReplacePolicyAssignment(context.Context, *ReplacePolicyAssignmentRequest) (*ReplacePolicyAssignmentResponse, error)
+	SimulatePolicy(context.Context, *SimulatePolicyRequest) (*SimulatePolicyResponse, error)
+	AddRpki(context.Context, *AddRpkiRequest) (*emptypb.Empty, error)
+	DeleteRpki(context.Context, *DeleteRpkiRequest) (*emptypb.Empty, error)
+	ListRpki(*ListRpkiRequest, GobgpApi_ListRpkiServer) error
+	EnableRpki(context.Context, *EnableRpkiRequest) (*emptypb.Empty, error)
+	DisableRpki(context.Context, *DisableRpkiRequest) (*emptypb.Empty, error)
+	ResetRpki(context.Context, *ResetRpkiRequest) (*emptypb.Empty, error)
+	ListRpkiTable(*ListRpkiTableRequest, GobgpApi_ListRpkiTableServer) error
+	SetRpkiSlurm(context.Context, *SetRpkiSlurmRequest) (*emptypb.Empty, error)
+	EnableZebra(context.Context, *EnableZebraRequest) (*emptypb.Empty, error)
+	EnableNetlink(context.Context, *EnableNetlinkRequest) (*emptypb.Empty, error)
+	DisableNetlink(context.Context, *DisableNetlinkRequest) (*emptypb.Empty, error)
+	EnablePeeringDb(context.Context, *EnablePeeringDbRequest) (*emptypb.Empty, error)
+	DisablePeeringDb(context.Context, *DisablePeeringDbRequest) (*emptypb.Empty, error)
+	ListPeeringDbRecord(*ListPeeringDbRecordRequest, GobgpApi_ListPeeringDbRecordServer) error
+	SetSrv6Locator(context.Context, *SetSrv6LocatorRequest) (*emptypb.Empty, error)
+	EnableMrt(context.Context, *EnableMrtRequest) (*emptypb.Empty, error)
+	DisableMrt(context.Context, *DisableMrtRequest) (*emptypb.Empty, error)
+	AddBmp(context.Context, *AddBmpRequest) (*emptypb.Empty, error)
+	DeleteBmp(context.Context, *DeleteBmpRequest) (*emptypb.Empty, error)
+	ListBmp(*ListBmpRequest, GobgpApi_ListBmpServer) error
+	AddKafkaExporter(context.Context, *AddKafkaExporterRequest) (*emptypb.Empty, error)
+	DeleteKafkaExporter(context.Context, *DeleteKafkaExporterRequest) (*emptypb.Empty, error)
+	ListKafkaExporter(*ListKafkaExporterRequest, GobgpApi_ListKafkaExporterServer) error
+	AddIrrFilter(context.Context, *AddIrrFilterRequest) (*emptypb.Empty, error)
+	DeleteIrrFilter(context.Context, *DeleteIrrFilterRequest) (*emptypb.Empty, error)
+	ListIrrFilter(*ListIrrFilterRequest, GobgpApi_ListIrrFilterServer) error
+	AddAggregate(context.Context, *AddAggregateRequest) (*emptypb.Empty, error)
+	DeleteAggregate(context.Context, *DeleteAggregateRequest) (*emptypb.Empty, error)
+	ListAggregate(*ListAggregateRequest, GobgpApi_ListAggregateServer) error
+	AddVrfLeak(context.Context, *AddVrfLeakRequest) (*emptypb.Empty, error)
+	DeleteVrfLeak(context.Context, *DeleteVrfLeakRequest) (*emptypb.Empty, error)
+	ListVrfLeak(*ListVrfLeakRequest, GobgpApi_ListVrfLeakServer) error
+	AddOrrVantagePoint(context.Context, *AddOrrVantagePointRequest) (*emptypb.Empty, error)
+	DeleteOrrVantagePoint(context.Context, *DeleteOrrVantagePointRequest) (*emptypb.Empty, error)
+	ListOrrVantagePoint(*ListOrrVantagePointRequest, GobgpApi_ListOrrVantagePointServer) error
+	AddEvpnVpws(context.Context, *AddEvpnVpwsRequest) (*emptypb.Empty, error)
+	DeleteEvpnVpws(context.Context, *DeleteEvpnVpwsRequest) (*emptypb.Empty, error)
+	ListEvpnVpws(*ListEvpnVpwsRequest, GobgpApi_ListEvpnVpwsServer) error
+	AddRtbh(context.Context, *AddRtbhRequest) (*emptypb.Empty, error)
+	DeleteRtbh(context.Context, *DeleteRtbhRequest) (*emptypb.Empty, error)
+	ListRtbh(*ListRtbhRequest, GobgpApi_ListRtbhServer) error
+	ListPrefixActivity(*ListPrefixActivityRequest, GobgpApi_ListPrefixActivityServer) error
+	GetPathHistory(context.Context, *GetPathHistoryRequest) (*GetPathHistoryResponse, error)
+	GetRibSummary(context.Context, *GetRibSummaryRequest) (*GetRibSummaryResponse, error)
+	ListRibDiff(*ListRibDiffRequest, GobgpApi_ListRibDiffServer) error
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*emptypb.Empty, error)
+	GetLogLevel(context.Context, *GetLogLevelRequest) (*GetLogLevelResponse, error)
+	StartDebugCapture(context.Context, *StartDebugCaptureRequest) (*emptypb.Empty, error)
+	StopDebugCapture(context.Context, *StopDebugCaptureRequest) (*emptypb.Empty, error)
+	ListDebugCapture(*ListDebugCaptureRequest, GobgpApi_ListDebugCaptureServer) error
+	GetApiVersion(context.Context, *GetApiVersionRequest) (*GetApiVersionResponse, error)
+	mustEmbedUnimplementedGobgpApiServer()
+}
+
+// UnimplementedGobgpApiServer must be embedded to have forward compatible implementations.
+type UnimplementedGobgpApiServer struct {
+}
+
+func (UnimplementedGobgpApiServer) StartBgp(context.Context, *StartBgpRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartBgp not implemented")
+}
+func (UnimplementedGobgpApiServer) StopBgp(context.Context, *StopBgpRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopBgp not implemented")
+}
+func (UnimplementedGobgpApiServer) GetBgp(context.Context, *GetBgpRequest) (*GetBgpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBgp not implemented")
+}
+func (UnimplementedGobgpApiServer) WatchEvent(*WatchEventRequest, GobgpApi_WatchEventServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvent not implemented")
+}
+func (UnimplementedGobgpApiServer) AddPeer(context.Context, *AddPeerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPeer not implemented")
+}
+func (UnimplementedGobgpApiServer) DeletePeer(context.Context, *DeletePeerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePeer not implemented")
+}
+func (UnimplementedGobgpApiServer) ListPeer(*ListPeerRequest, GobgpApi_ListPeerServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListPeer not implemented")
+}
+func (UnimplementedGobgpApiServer) ListPeerAggregated(context.Context, *ListPeerRequest) (*ListPeerAggregatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeerAggregated not implemented")
+}
+func (UnimplementedGobgpApiServer) GetEffectivePeerConfig(context.Context, *GetEffectivePeerConfigRequest) (*GetEffectivePeerConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectivePeerConfig not implemented")
+}
+func (UnimplementedGobgpApiServer) UpdatePeer(context.Context, *UpdatePeerRequest) (*UpdatePeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePeer not implemented")
+}
+func (UnimplementedGobgpApiServer) ResetPeer(context.Context, *ResetPeerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPeer not implemented")
+}
+func (UnimplementedGobgpApiServer) ShutdownPeer(context.Context, *ShutdownPeerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShutdownPeer not implemented")
+}
+func (UnimplementedGobgpApiServer) EnablePeer(context.Context, *EnablePeerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnablePeer not implemented")
+}
+func (UnimplementedGobgpApiServer) DisablePeer(context.Context, *DisablePeerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisablePeer not implemented")
+}
+func (UnimplementedGobgpApiServer) GracefulShutdownPeer(context.Context, *GracefulShutdownPeerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GracefulShutdownPeer not implemented")
+}
+func (UnimplementedGobgpApiServer) AddPeerGroup(context.Context, *AddPeerGroupRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPeerGroup not implemented")
+}
+func (UnimplementedGobgpApiServer) DeletePeerGroup(context.Context, *DeletePeerGroupRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePeerGroup not implemented")
+}
+func (UnimplementedGobgpApiServer) ListPeerGroup(*ListPeerGroupRequest, GobgpApi_ListPeerGroupServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListPeerGroup not implemented")
+}
+func (UnimplementedGobgpApiServer) UpdatePeerGroup(context.Context, *UpdatePeerGroupRequest) (*UpdatePeerGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePeerGroup not implemented")
+}
+func (UnimplementedGobgpApiServer) AddDynamicNeighbor(context.Context, *AddDynamicNeighborRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDynamicNeighbor not implemented")
+}
+func (UnimplementedGobgpApiServer) ListDynamicNeighbor(*ListDynamicNeighborRequest, GobgpApi_ListDynamicNeighborServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListDynamicNeighbor not implemented")
+}
+func (UnimplementedGobgpApiServer) DeleteDynamicNeighbor(context.Context, *DeleteDynamicNeighborRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDynamicNeighbor not implemented")
+}
+func (UnimplementedGobgpApiServer) AddPath(context.Context, *AddPathRequest) (*AddPathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPath not implemented")
+}
+func (UnimplementedGobgpApiServer) DeletePath(context.Context, *DeletePathRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePath not implemented")
+}
+func (UnimplementedGobgpApiServer) ListPath(*ListPathRequest, GobgpApi_ListPathServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListPath not implemented")
+}
+func (UnimplementedGobgpApiServer) ListPathAggregated(context.Context, *ListPathRequest) (*ListPathAggregatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPathAggregated not implemented")
+}
+func (UnimplementedGobgpApiServer) AddPathStream(GobgpApi_AddPathStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method AddPathStream not implemented")
+}
+func (UnimplementedGobgpApiServer) ExtendPathLifetime(context.Context, *ExtendPathLifetimeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendPathLifetime not implemented")
+}
+func (UnimplementedGobgpApiServer) GetTable(context.Context, *GetTableRequest) (*GetTableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTable not implemented")
+}
+func (UnimplementedGobgpApiServer) AddVrf(context.Context, *AddVrfRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVrf not implemented")
+}
+func (UnimplementedGobgpApiServer) DeleteVrf(context.Context, *DeleteVrfRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVrf not implemented")
+}
+func (UnimplementedGobgpApiServer) ListVrf(*ListVrfRequest, GobgpApi_ListVrfServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListVrf not implemented")
+}
+func (UnimplementedGobgpApiServer) ListEvpnDesignatedForwarder(*ListEvpnDesignatedForwarderRequest, GobgpApi_ListEvpnDesignatedForwarderServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListEvpnDesignatedForwarder not implemented")
+}
+func (UnimplementedGobgpApiServer) ListEvpnFrozenMac(*ListEvpnFrozenMacRequest, GobgpApi_ListEvpnFrozenMacServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListEvpnFrozenMac not implemented")
+}
+func (UnimplementedGobgpApiServer) ClearEvpnFrozenMac(context.Context, *ClearEvpnFrozenMacRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearEvpnFrozenMac not implemented")
+}
+func (UnimplementedGobgpApiServer) ListColorBinding(*ListColorBindingRequest, GobgpApi_ListColorBindingServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListColorBinding not implemented")
+}
+func (UnimplementedGobgpApiServer) AddPolicy(context.Context, *AddPolicyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPolicy not implemented")
+}
+func (UnimplementedGobgpApiServer) DeletePolicy(context.Context, *DeletePolicyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicy not implemented")
+}
+func (UnimplementedGobgpApiServer) ListPolicy(*ListPolicyRequest, GobgpApi_ListPolicyServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListPolicy not implemented")
+}
+func (UnimplementedGobgpApiServer) SetPolicies(context.Context, *SetPoliciesRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPolicies not implemented")
+}
+func (UnimplementedGobgpApiServer) ReplacePolicies(context.Context, *ReplacePoliciesRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplacePolicies not implemented")
+}
+func (UnimplementedGobgpApiServer) StageConfig(context.Context, *StageConfigRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StageConfig not implemented")
+}
+func (UnimplementedGobgpApiServer) DiscardConfig(context.Context, *DiscardConfigRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardConfig not implemented")
+}
+func (UnimplementedGobgpApiServer) CommitConfig(context.Context, *CommitConfigRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitConfig not implemented")
+}
+func (UnimplementedGobgpApiServer) ConfirmConfig(context.Context, *ConfirmConfigRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmConfig not implemented")
+}
+func (UnimplementedGobgpApiServer) RollbackConfig(context.Context, *RollbackConfigRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackConfig not implemented")
+}
+func (UnimplementedGobgpApiServer) GetConfigTransaction(context.Context, *GetConfigTransactionRequest) (*GetConfigTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigTransaction not implemented")
+}
+func (UnimplementedGobgpApiServer) AddDefinedSet(context.Context, *AddDefinedSetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDefinedSet not implemented")
+}
+func (UnimplementedGobgpApiServer) DeleteDefinedSet(context.Context, *DeleteDefinedSetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDefinedSet not implemented")
+}
+func (UnimplementedGobgpApiServer) ListDefinedSet(*ListDefinedSetRequest, GobgpApi_ListDefinedSetServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListDefinedSet not implemented")
+}
+func (UnimplementedGobgpApiServer) UpdateDefinedSet(context.Context, *UpdateDefinedSetRequest) (*UpdateDefinedSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDefinedSet not implemented")
+}
+func (UnimplementedGobgpApiServer) AddStatement(context.Context, *AddStatementRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStatement not implemented")
+}
+func (UnimplementedGobgpApiServer) DeleteStatement(context.Context, *DeleteStatementRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStatement not implemented")
+}
+func (UnimplementedGobgpApiServer) ListStatement(*ListStatementRequest, GobgpApi_ListStatementServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListStatement not implemented")
+}
+func (UnimplementedGobgpApiServer) AddPolicyAssignment(context.Context, *AddPolicyAssignmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPolicyAssignment not implemented")
+}
+func (UnimplementedGobgpApiServer) DeletePolicyAssignment(context.Context, *DeletePolicyAssignmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicyAssignment not implemented")
+}
+func (UnimplementedGobgpApiServer) ListPolicyAssignment(*ListPolicyAssignmentRequest, GobgpApi_ListPolicyAssignmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListPolicyAssignment not implemented")
+}
+func (UnimplementedGobgpApiServer) SetPolicyAssignment(context.Context, *SetPolicyAssignmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPolicyAssignment not implemented")
+}
+func (UnimplementedGobgpApiServer) ReplacePolicyAssignment(context.Context, *ReplacePolicyAssignmentRequest) (*ReplacePolicyAssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplacePolicyAssignment not implemented")
+}
+func (UnimplementedGobgpApiServer) SimulatePolicy(context.Context, *SimulatePolicyRequest) (*SimulatePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulatePolicy not implemented")
+}
+func (UnimplementedGobgpApiServer) AddRpki(context.Context, *AddRpkiRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRpki not implemented")
+}
+func (UnimplementedGobgpApiServer) DeleteRpki(context.Context, *DeleteRpkiRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRpki not implemented")
+}
+func (UnimplementedGobgpApiServer) ListRpki(*ListRpkiRequest, GobgpApi_ListRpkiServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListRpki not implemented")
+}
+func (UnimplementedGobgpApiServer) EnableRpki(context.Context, *EnableRpkiRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableRpki not implemented")
+}
+func (UnimplementedGobgpApiServer) DisableRpki(context.Context, *DisableRpkiRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableRpki not implemented")
+}
+func (UnimplementedGobgpApiServer) ResetRpki(context.Context, *ResetRpkiRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetRpki not implemented")
+}
+func (UnimplementedGobgpApiServer) ListRpkiTable(*ListRpkiTableRequest, GobgpApi_ListRpkiTableServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListRpkiTable not implemented")
+}
+func (UnimplementedGobgpApiServer) SetRpkiSlurm(context.Context, *SetRpkiSlurmRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRpkiSlurm not implemented")
+}
+func (UnimplementedGobgpApiServer) EnableZebra(context.Context, *EnableZebraRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableZebra not implemented")
+}
+func (UnimplementedGobgpApiServer) EnableNetlink(context.Context, *EnableNetlinkRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableNetlink not implemented")
+}
+func (UnimplementedGobgpApiServer) DisableNetlink(context.Context, *DisableNetlinkRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableNetlink not implemented")
+}
+func (UnimplementedGobgpApiServer) EnablePeeringDb(context.Context, *EnablePeeringDbRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnablePeeringDb not implemented")
+}
+func (UnimplementedGobgpApiServer) DisablePeeringDb(context.Context, *DisablePeeringDbRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisablePeeringDb not implemented")
+}
+func (UnimplementedGobgpApiServer) ListPeeringDbRecord(*ListPeeringDbRecordRequest, GobgpApi_ListPeeringDbRecordServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListPeeringDbRecord not implemented")
+}
+func (UnimplementedGobgpApiServer) SetSrv6Locator(context.Context, *SetSrv6LocatorRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSrv6Locator not implemented")
+}
+func (UnimplementedGobgpApiServer) EnableMrt(context.Context, *EnableMrtRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableMrt not implemented")
+}
+func (UnimplementedGobgpApiServer) DisableMrt(context.Context, *DisableMrtRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableMrt not implemented")
+}
+func (UnimplementedGobgpApiServer) AddBmp(context.Context, *AddBmpRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBmp not implemented")
+}
+func (UnimplementedGobgpApiServer) DeleteBmp(context.Context, *DeleteBmpRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBmp not implemented")
+}
+func (UnimplementedGobgpApiServer) ListBmp(*ListBmpRequest, GobgpApi_ListBmpServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListBmp not implemented")
+}
+func (UnimplementedGobgpApiServer) AddKafkaExporter(context.Context, *AddKafkaExporterRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddKafkaExporter not implemented")
+}
+func (UnimplementedGobgpApiServer) DeleteKafkaExporter(context.Context, *DeleteKafkaExporterRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKafkaExporter not implemented")
+}
+func (UnimplementedGobgpApiServer) ListKafkaExporter(*ListKafkaExporterRequest, GobgpApi_ListKafkaExporterServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListKafkaExporter not implemented")
+}
+func (UnimplementedGobgpApiServer) AddIrrFilter(context.Context, *AddIrrFilterRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddIrrFilter not implemented")
+}
+func (UnimplementedGobgpApiServer) DeleteIrrFilter(context.Context, *DeleteIrrFilterRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIrrFilter not implemented")
+}
+func (UnimplementedGobgpApiServer) ListIrrFilter(*ListIrrFilterRequest, GobgpApi_ListIrrFilterServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListIrrFilter not implemented")
+}
+func (UnimplementedGobgpApiServer) AddAggregate(context.Context, *AddAggregateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAggregate not implemented")
+}
+func (UnimplementedGobgpApiServer) DeleteAggregate(context.Context, *DeleteAggregateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAggregate not implemented")
+}
+func (UnimplementedGobgpApiServer) ListAggregate(*ListAggregateRequest, GobgpApi_ListAggregateServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListAggregate not implemented")
+}
+func (UnimplementedGobgpApiServer) AddVrfLeak(context.Context, *AddVrfLeakRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVrfLeak not implemented")
+}
+func (UnimplementedGobgpApiServer) DeleteVrfLeak(context.Context, *DeleteVrfLeakRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVrfLeak not implemented")
+}
+func (UnimplementedGobgpApiServer) ListVrfLeak(*ListVrfLeakRequest, GobgpApi_ListVrfLeakServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListVrfLeak not implemented")
+}
+func (UnimplementedGobgpApiServer) AddOrrVantagePoint(context.Context, *AddOrrVantagePointRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOrrVantagePoint not implemented")
+}
+func (UnimplementedGobgpApiServer) DeleteOrrVantagePoint(context.Context, *DeleteOrrVantagePointRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrrVantagePoint not implemented")
+}
+func (UnimplementedGobgpApiServer) ListOrrVantagePoint(*ListOrrVantagePointRequest, GobgpApi_ListOrrVantagePointServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListOrrVantagePoint not implemented")
+}
+func (UnimplementedGobgpApiServer) AddEvpnVpws(context.Context, *AddEvpnVpwsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEvpnVpws not implemented")
+}
+func (UnimplementedGobgpApiServer) DeleteEvpnVpws(context.Context, *DeleteEvpnVpwsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEvpnVpws not implemented")
+}
+func (UnimplementedGobgpApiServer) ListEvpnVpws(*ListEvpnVpwsRequest, GobgpApi_ListEvpnVpwsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListEvpnVpws not implemented")
+}
+func (UnimplementedGobgpApiServer) AddRtbh(context.Context, *AddRtbhRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRtbh not implemented")
+}
+func (UnimplementedGobgpApiServer) DeleteRtbh(context.Context, *DeleteRtbhRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRtbh not implemented")
+}
+func (UnimplementedGobgpApiServer) ListRtbh(*ListRtbhRequest, GobgpApi_ListRtbhServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListRtbh not implemented")
+}
+func (UnimplementedGobgpApiServer) ListPrefixActivity(*ListPrefixActivityRequest, GobgpApi_ListPrefixActivityServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListPrefixActivity not implemented")
+}
+func (UnimplementedGobgpApiServer) GetPathHistory(context.Context, *GetPathHistoryRequest) (*GetPathHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPathHistory not implemented")
+}
+func (UnimplementedGobgpApiServer) GetRibSummary(context.Context, *GetRibSummaryRequest) (*GetRibSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRibSummary not implemented")
+}
+func (UnimplementedGobgpApiServer) ListRibDiff(*ListRibDiffRequest, GobgpApi_ListRibDiffServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListRibDiff not implemented")
+}
+func (UnimplementedGobgpApiServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedGobgpApiServer) GetLogLevel(context.Context, *GetLogLevelRequest) (*GetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogLevel not implemented")
+}
+func (UnimplementedGobgpApiServer) StartDebugCapture(context.Context, *StartDebugCaptureRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartDebugCapture not implemented")
+}
+func (UnimplementedGobgpApiServer) StopDebugCapture(context.Context, *StopDebugCaptureRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopDebugCapture not implemented")
+}
+func (UnimplementedGobgpApiServer) ListDebugCapture(*ListDebugCaptureRequest, GobgpApi_ListDebugCaptureServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListDebugCapture not implemented")
+}
+func (UnimplementedGobgpApiServer) GetApiVersion(context.Context, *GetApiVersionRequest) (*GetApiVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApiVersion not implemented")
+}
+func (UnimplementedGobgpApiServer) mustEmbedUnimplementedGobgpApiServer() {}
+
+// UnsafeGobgpApiServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GobgpApiServer will
+// result in compilation errors.
+type UnsafeGobgpApiServer interface {
+	mustEmbedUnimplementedGobgpApiServer()
+}
+
+func RegisterGobgpApiServer(s grpc.ServiceRegistrar, srv GobgpApiServer) {
+	s.RegisterService(&GobgpApi_ServiceDesc, srv)
+}
+
+func _GobgpApi_StartBgp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartBgpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).StartBgp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/StartBgp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).StartBgp(ctx, req.(*StartBgpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_StopBgp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopBgpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).StopBgp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/StopBgp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).StopBgp(ctx, req.(*StopBgpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_GetBgp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBgpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).GetBgp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/GetBgp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).GetBgp(ctx, req.(*GetBgpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_WatchEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GobgpApiServer).WatchEvent(m, &gobgpApiWatchEventServer{stream})
+}
+
+type GobgpApi_WatchEventServer interface {
+	Send(*WatchEventResponse) error
+	grpc.ServerStream
+}
+
+type gobgpApiWatchEventServer struct {
+	grpc.ServerStream
+}
+
+func (x *gobgpApiWatchEventServer) Send(m *WatchEventResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GobgpApi_AddPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).AddPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/AddPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).AddPeer(ctx, req.(*AddPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_DeletePeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).DeletePeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/DeletePeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).DeletePeer(ctx, req.(*DeletePeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_ListPeer_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListPeerRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GobgpApiServer).ListPeer(m, &gobgpApiListPeerServer{stream})
+}
+
+type GobgpApi_ListPeerServer interface {
+	Send(*ListPeerResponse) error
+	grpc.ServerStream
+}
+
+type gobgpApiListPeerServer struct {
+	grpc.ServerStream
+}
+
+func (x *gobgpApiListPeerServer) Send(m *ListPeerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GobgpApi_ListPeerAggregated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).ListPeerAggregated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/ListPeerAggregated",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).ListPeerAggregated(ctx, req.(*ListPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_GetEffectivePeerConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEffectivePeerConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).GetEffectivePeerConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/GetEffectivePeerConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).GetEffectivePeerConfig(ctx, req.(*GetEffectivePeerConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_UpdatePeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).UpdatePeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/UpdatePeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).UpdatePeer(ctx, req.(*UpdatePeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_ResetPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).ResetPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/ResetPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).ResetPeer(ctx, req.(*ResetPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_ShutdownPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShutdownPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).ShutdownPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/ShutdownPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).ShutdownPeer(ctx, req.(*ShutdownPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_EnablePeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnablePeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).EnablePeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/EnablePeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).EnablePeer(ctx, req.(*EnablePeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_DisablePeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisablePeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).DisablePeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/DisablePeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).DisablePeer(ctx, req.(*DisablePeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_GracefulShutdownPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GracefulShutdownPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).GracefulShutdownPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/GracefulShutdownPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).GracefulShutdownPeer(ctx, req.(*GracefulShutdownPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_AddPeerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPeerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).AddPeerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/AddPeerGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).AddPeerGroup(ctx, req.(*AddPeerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_DeletePeerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePeerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).DeletePeerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/DeletePeerGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).DeletePeerGroup(ctx, req.(*DeletePeerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_ListPeerGroup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListPeerGroupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GobgpApiServer).ListPeerGroup(m, &gobgpApiListPeerGroupServer{stream})
+}
+
+type GobgpApi_ListPeerGroupServer interface {
+	Send(*ListPeerGroupResponse) error
+	grpc.ServerStream
+}
+
+type gobgpApiListPeerGroupServer struct {
+	grpc.ServerStream
+}
+
+func (x *gobgpApiListPeerGroupServer) Send(m *ListPeerGroupResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GobgpApi_UpdatePeerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePeerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).UpdatePeerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/UpdatePeerGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).UpdatePeerGroup(ctx, req.(*UpdatePeerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_AddDynamicNeighbor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDynamicNeighborRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).AddDynamicNeighbor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/AddDynamicNeighbor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).AddDynamicNeighbor(ctx, req.(*AddDynamicNeighborRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_ListDynamicNeighbor_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListDynamicNeighborRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GobgpApiServer).ListDynamicNeighbor(m, &gobgpApiListDynamicNeighborServer{stream})
+}
+
+type GobgpApi_ListDynamicNeighborServer interface {
+	Send(*ListDynamicNeighborResponse) error
+	grpc.ServerStream
+}
+
+type gobgpApiListDynamicNeighborServer struct {
+	grpc.ServerStream
+}
+
+func (x *gobgpApiListDynamicNeighborServer) Send(m *ListDynamicNeighborResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GobgpApi_DeleteDynamicNeighbor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDynamicNeighborRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).DeleteDynamicNeighbor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/DeleteDynamicNeighbor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).DeleteDynamicNeighbor(ctx, req.(*DeleteDynamicNeighborRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_AddPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).AddPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/AddPath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).AddPath(ctx, req.(*AddPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_DeletePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).DeletePath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/DeletePath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).DeletePath(ctx, req.(*DeletePathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_ListPath_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListPathRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GobgpApiServer).ListPath(m, &gobgpApiListPathServer{stream})
+}
+
+type GobgpApi_ListPathServer interface {
+	Send(*ListPathResponse) error
+	grpc.ServerStream
+}
+
+type gobgpApiListPathServer struct {
+	grpc.ServerStream
+}
+
+func (x *gobgpApiListPathServer) Send(m *ListPathResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GobgpApi_ListPathAggregated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).ListPathAggregated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/ListPathAggregated",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).ListPathAggregated(ctx, req.(*ListPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_AddPathStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GobgpApiServer).AddPathStream(&gobgpApiAddPathStreamServer{stream})
+}
+
+type GobgpApi_AddPathStreamServer interface {
+	SendAndClose(*emptypb.Empty) error
+	Recv() (*AddPathStreamRequest, error)
+	grpc.ServerStream
+}
+
+type gobgpApiAddPathStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *gobgpApiAddPathStreamServer) SendAndClose(m *emptypb.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gobgpApiAddPathStreamServer) Recv() (*AddPathStreamRequest, error) {
+	m := new(AddPathStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _GobgpApi_ExtendPathLifetime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendPathLifetimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).ExtendPathLifetime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/ExtendPathLifetime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).ExtendPathLifetime(ctx, req.(*ExtendPathLifetimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_GetTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).GetTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/GetTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).GetTable(ctx, req.(*GetTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_AddVrf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddVrfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).AddVrf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/AddVrf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).AddVrf(ctx, req.(*AddVrfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_DeleteVrf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVrfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).DeleteVrf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/DeleteVrf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).DeleteVrf(ctx, req.(*DeleteVrfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_ListVrf_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListVrfRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GobgpApiServer).ListVrf(m, &gobgpApiListVrfServer{stream})
+}
+
+type GobgpApi_ListVrfServer interface {
+	Send(*ListVrfResponse) error
+	grpc.ServerStream
+}
+
+type gobgpApiListVrfServer struct {
+	grpc.ServerStream
+}
+
+func (x *gobgpApiListVrfServer) Send(m *ListVrfResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GobgpApi_ListEvpnDesignatedForwarder_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListEvpnDesignatedForwarderRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GobgpApiServer).ListEvpnDesignatedForwarder(m, &gobgpApiListEvpnDesignatedForwarderServer{stream})
+}
+
+type GobgpApi_ListEvpnDesignatedForwarderServer interface {
+	Send(*ListEvpnDesignatedForwarderResponse) error
+	grpc.ServerStream
+}
+
+type gobgpApiListEvpnDesignatedForwarderServer struct {
+	grpc.ServerStream
+}
+
+func (x *gobgpApiListEvpnDesignatedForwarderServer) Send(m *ListEvpnDesignatedForwarderResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GobgpApi_ListEvpnFrozenMac_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListEvpnFrozenMacRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GobgpApiServer).ListEvpnFrozenMac(m, &gobgpApiListEvpnFrozenMacServer{stream})
+}
+
+type GobgpApi_ListEvpnFrozenMacServer interface {
+	Send(*ListEvpnFrozenMacResponse) error
+	grpc.ServerStream
+}
+
+type gobgpApiListEvpnFrozenMacServer struct {
+	grpc.ServerStream
+}
+
+func (x *gobgpApiListEvpnFrozenMacServer) Send(m *ListEvpnFrozenMacResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GobgpApi_ClearEvpnFrozenMac_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearEvpnFrozenMacRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).ClearEvpnFrozenMac(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/ClearEvpnFrozenMac",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).ClearEvpnFrozenMac(ctx, req.(*ClearEvpnFrozenMacRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_ListColorBinding_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListColorBindingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GobgpApiServer).ListColorBinding(m, &gobgpApiListColorBindingServer{stream})
+}
+
+type GobgpApi_ListColorBindingServer interface {
+	Send(*ListColorBindingResponse) error
+	grpc.ServerStream
+}
+
+type gobgpApiListColorBindingServer struct {
+	grpc.ServerStream
+}
+
+func (x *gobgpApiListColorBindingServer) Send(m *ListColorBindingResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GobgpApi_AddPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).AddPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/AddPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).AddPolicy(ctx, req.(*AddPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_DeletePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).DeletePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/DeletePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).DeletePolicy(ctx, req.(*DeletePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_ListPolicy_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListPolicyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GobgpApiServer).ListPolicy(m, &gobgpApiListPolicyServer{stream})
+}
+
+type GobgpApi_ListPolicyServer interface {
+	Send(*ListPolicyResponse) error
+	grpc.ServerStream
+}
+
+type gobgpApiListPolicyServer struct {
+	grpc.ServerStream
+}
+
+func (x *gobgpApiListPolicyServer) Send(m *ListPolicyResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GobgpApi_SetPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).SetPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/SetPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).SetPolicies(ctx, req.(*SetPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_ReplacePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplacePoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).ReplacePolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/ReplacePolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).ReplacePolicies(ctx, req.(*ReplacePoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_StageConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StageConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).StageConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/StageConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).StageConfig(ctx, req.(*StageConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_DiscardConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).DiscardConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/DiscardConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).DiscardConfig(ctx, req.(*DiscardConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_CommitConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).CommitConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/CommitConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).CommitConfig(ctx, req.(*CommitConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_ConfirmConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).ConfirmConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/ConfirmConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).ConfirmConfig(ctx, req.(*ConfirmConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_RollbackConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).RollbackConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/RollbackConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).RollbackConfig(ctx, req.(*RollbackConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_GetConfigTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).GetConfigTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/GetConfigTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).GetConfigTransaction(ctx, req.(*GetConfigTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_AddDefinedSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDefinedSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).AddDefinedSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/AddDefinedSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).AddDefinedSet(ctx, req.(*AddDefinedSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_DeleteDefinedSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDefinedSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).DeleteDefinedSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/DeleteDefinedSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).DeleteDefinedSet(ctx, req.(*DeleteDefinedSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_ListDefinedSet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListDefinedSetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GobgpApiServer).ListDefinedSet(m, &gobgpApiListDefinedSetServer{stream})
+}
+
+type GobgpApi_ListDefinedSetServer interface {
+	Send(*ListDefinedSetResponse) error
+	grpc.ServerStream
+}
+
+type gobgpApiListDefinedSetServer struct {
+	grpc.ServerStream
+}
+
+func (x *gobgpApiListDefinedSetServer) Send(m *ListDefinedSetResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GobgpApi_UpdateDefinedSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDefinedSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).UpdateDefinedSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/UpdateDefinedSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).UpdateDefinedSet(ctx, req.(*UpdateDefinedSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_AddStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).AddStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/AddStatement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).AddStatement(ctx, req.(*AddStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_StartBgp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartBgpRequest)
+func _GobgpApi_DeleteStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStatementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).StartBgp(ctx, in)
+		return srv.(GobgpApiServer).DeleteStatement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/StartBgp",
+		FullMethod: "/apipb.GobgpApi/DeleteStatement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).StartBgp(ctx, req.(*StartBgpRequest))
+		return srv.(GobgpApiServer).DeleteStatement(ctx, req.(*DeleteStatementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_StopBgp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopBgpRequest)
+func _GobgpApi_ListStatement_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListStatementRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GobgpApiServer).ListStatement(m, &gobgpApiListStatementServer{stream})
+}
+
+type GobgpApi_ListStatementServer interface {
+	Send(*ListStatementResponse) error
+	grpc.ServerStream
+}
+
+type gobgpApiListStatementServer struct {
+	grpc.ServerStream
+}
+
+func (x *gobgpApiListStatementServer) Send(m *ListStatementResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GobgpApi_AddPolicyAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPolicyAssignmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).StopBgp(ctx, in)
+		return srv.(GobgpApiServer).AddPolicyAssignment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/StopBgp",
+		FullMethod: "/apipb.GobgpApi/AddPolicyAssignment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).StopBgp(ctx, req.(*StopBgpRequest))
+		return srv.(GobgpApiServer).AddPolicyAssignment(ctx, req.(*AddPolicyAssignmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_GetBgp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBgpRequest)
+func _GobgpApi_DeletePolicyAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePolicyAssignmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).GetBgp(ctx, in)
+		return srv.(GobgpApiServer).DeletePolicyAssignment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/GetBgp",
+		FullMethod: "/apipb.GobgpApi/DeletePolicyAssignment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).GetBgp(ctx, req.(*GetBgpRequest))
+		return srv.(GobgpApiServer).DeletePolicyAssignment(ctx, req.(*DeletePolicyAssignmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_WatchEvent_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(WatchEventRequest)
+func _GobgpApi_ListPolicyAssignment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListPolicyAssignmentRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(GobgpApiServer).WatchEvent(m, &gobgpApiWatchEventServer{stream})
+	return srv.(GobgpApiServer).ListPolicyAssignment(m, &gobgpApiListPolicyAssignmentServer{stream})
 }
 
-type GobgpApi_WatchEventServer interface {
-	Send(*WatchEventResponse) error
+type GobgpApi_ListPolicyAssignmentServer interface {
+	Send(*ListPolicyAssignmentResponse) error
 	grpc.ServerStream
 }
 
-type gobgpApiWatchEventServer struct {
+type gobgpApiListPolicyAssignmentServer struct {
 	grpc.ServerStream
 }
 
-func (x *gobgpApiWatchEventServer) Send(m *WatchEventResponse) error {
+func (x *gobgpApiListPolicyAssignmentServer) Send(m *ListPolicyAssignmentResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _GobgpApi_AddPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPeerRequest)
+func _GobgpApi_SetPolicyAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPolicyAssignmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).AddPeer(ctx, in)
+		return srv.(GobgpApiServer).SetPolicyAssignment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/AddPeer",
+		FullMethod: "/apipb.GobgpApi/SetPolicyAssignment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).AddPeer(ctx, req.(*AddPeerRequest))
+		return srv.(GobgpApiServer).SetPolicyAssignment(ctx, req.(*SetPolicyAssignmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_DeletePeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePeerRequest)
+func _GobgpApi_ReplacePolicyAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplacePolicyAssignmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).DeletePeer(ctx, in)
+		return srv.(GobgpApiServer).ReplacePolicyAssignment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/DeletePeer",
+		FullMethod: "/apipb.GobgpApi/ReplacePolicyAssignment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).DeletePeer(ctx, req.(*DeletePeerRequest))
+		return srv.(GobgpApiServer).ReplacePolicyAssignment(ctx, req.(*ReplacePolicyAssignmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_ListPeer_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListPeerRequest)
+func _GobgpApi_SimulatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulatePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).SimulatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/SimulatePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).SimulatePolicy(ctx, req.(*SimulatePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_AddRpki_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRpkiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).AddRpki(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/AddRpki",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).AddRpki(ctx, req.(*AddRpkiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_DeleteRpki_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRpkiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).DeleteRpki(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/DeleteRpki",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).DeleteRpki(ctx, req.(*DeleteRpkiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_ListRpki_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRpkiRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(GobgpApiServer).ListPeer(m, &gobgpApiListPeerServer{stream})
+	return srv.(GobgpApiServer).ListRpki(m, &gobgpApiListRpkiServer{stream})
 }
 
-type GobgpApi_ListPeerServer interface {
-	Send(*ListPeerResponse) error
+type GobgpApi_ListRpkiServer interface {
+	Send(*ListRpkiResponse) error
 	grpc.ServerStream
 }
 
-type gobgpApiListPeerServer struct {
+type gobgpApiListRpkiServer struct {
 	grpc.ServerStream
 }
 
-func (x *gobgpApiListPeerServer) Send(m *ListPeerResponse) error {
+func (x *gobgpApiListRpkiServer) Send(m *ListRpkiResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _GobgpApi_UpdatePeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePeerRequest)
+func _GobgpApi_EnableRpki_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableRpkiRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).UpdatePeer(ctx, in)
+		return srv.(GobgpApiServer).EnableRpki(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/UpdatePeer",
+		FullMethod: "/apipb.GobgpApi/EnableRpki",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).UpdatePeer(ctx, req.(*UpdatePeerRequest))
+		return srv.(GobgpApiServer).EnableRpki(ctx, req.(*EnableRpkiRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_ResetPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResetPeerRequest)
+func _GobgpApi_DisableRpki_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableRpkiRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).ResetPeer(ctx, in)
+		return srv.(GobgpApiServer).DisableRpki(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/ResetPeer",
+		FullMethod: "/apipb.GobgpApi/DisableRpki",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).ResetPeer(ctx, req.(*ResetPeerRequest))
+		return srv.(GobgpApiServer).DisableRpki(ctx, req.(*DisableRpkiRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_ShutdownPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ShutdownPeerRequest)
+func _GobgpApi_ResetRpki_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetRpkiRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).ShutdownPeer(ctx, in)
+		return srv.(GobgpApiServer).ResetRpki(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/ShutdownPeer",
+		FullMethod: "/apipb.GobgpApi/ResetRpki",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).ShutdownPeer(ctx, req.(*ShutdownPeerRequest))
+		return srv.(GobgpApiServer).ResetRpki(ctx, req.(*ResetRpkiRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_EnablePeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EnablePeerRequest)
+func _GobgpApi_ListRpkiTable_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRpkiTableRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GobgpApiServer).ListRpkiTable(m, &gobgpApiListRpkiTableServer{stream})
+}
+
+type GobgpApi_ListRpkiTableServer interface {
+	Send(*ListRpkiTableResponse) error
+	grpc.ServerStream
+}
+
+type gobgpApiListRpkiTableServer struct {
+	grpc.ServerStream
+}
+
+func (x *gobgpApiListRpkiTableServer) Send(m *ListRpkiTableResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GobgpApi_SetRpkiSlurm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRpkiSlurmRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).EnablePeer(ctx, in)
+		return srv.(GobgpApiServer).SetRpkiSlurm(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/EnablePeer",
+		FullMethod: "/apipb.GobgpApi/SetRpkiSlurm",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).EnablePeer(ctx, req.(*EnablePeerRequest))
+		return srv.(GobgpApiServer).SetRpkiSlurm(ctx, req.(*SetRpkiSlurmRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_DisablePeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DisablePeerRequest)
+func _GobgpApi_EnableZebra_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableZebraRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).DisablePeer(ctx, in)
+		return srv.(GobgpApiServer).EnableZebra(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/DisablePeer",
+		FullMethod: "/apipb.GobgpApi/EnableZebra",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).DisablePeer(ctx, req.(*DisablePeerRequest))
+		return srv.(GobgpApiServer).EnableZebra(ctx, req.(*EnableZebraRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_AddPeerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPeerGroupRequest)
+func _GobgpApi_EnableNetlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableNetlinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).AddPeerGroup(ctx, in)
+		return srv.(GobgpApiServer).EnableNetlink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/AddPeerGroup",
+		FullMethod: "/apipb.GobgpApi/EnableNetlink",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).AddPeerGroup(ctx, req.(*AddPeerGroupRequest))
+		return srv.(GobgpApiServer).EnableNetlink(ctx, req.(*EnableNetlinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_DeletePeerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePeerGroupRequest)
+func _GobgpApi_DisableNetlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableNetlinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).DisableNetlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/DisableNetlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).DisableNetlink(ctx, req.(*DisableNetlinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_EnablePeeringDb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnablePeeringDbRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).EnablePeeringDb(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/EnablePeeringDb",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).EnablePeeringDb(ctx, req.(*EnablePeeringDbRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_DisablePeeringDb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisablePeeringDbRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).DeletePeerGroup(ctx, in)
+		return srv.(GobgpApiServer).DisablePeeringDb(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/DeletePeerGroup",
+		FullMethod: "/apipb.GobgpApi/DisablePeeringDb",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).DeletePeerGroup(ctx, req.(*DeletePeerGroupRequest))
+		return srv.(GobgpApiServer).DisablePeeringDb(ctx, req.(*DisablePeeringDbRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_ListPeerGroup_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListPeerGroupRequest)
+func _GobgpApi_ListPeeringDbRecord_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListPeeringDbRecordRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(GobgpApiServer).ListPeerGroup(m, &gobgpApiListPeerGroupServer{stream})
+	return srv.(GobgpApiServer).ListPeeringDbRecord(m, &gobgpApiListPeeringDbRecordServer{stream})
 }
 
-type GobgpApi_ListPeerGroupServer interface {
-	Send(*ListPeerGroupResponse) error
+type GobgpApi_ListPeeringDbRecordServer interface {
+	Send(*ListPeeringDbRecordResponse) error
 	grpc.ServerStream
 }
 
-type gobgpApiListPeerGroupServer struct {
+type gobgpApiListPeeringDbRecordServer struct {
 	grpc.ServerStream
 }
 
-func (x *gobgpApiListPeerGroupServer) Send(m *ListPeerGroupResponse) error {
+func (x *gobgpApiListPeeringDbRecordServer) Send(m *ListPeeringDbRecordResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _GobgpApi_UpdatePeerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePeerGroupRequest)
+func _GobgpApi_SetSrv6Locator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSrv6LocatorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).UpdatePeerGroup(ctx, in)
+		return srv.(GobgpApiServer).SetSrv6Locator(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/UpdatePeerGroup",
+		FullMethod: "/apipb.GobgpApi/SetSrv6Locator",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).UpdatePeerGroup(ctx, req.(*UpdatePeerGroupRequest))
+		return srv.(GobgpApiServer).SetSrv6Locator(ctx, req.(*SetSrv6LocatorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_AddDynamicNeighbor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddDynamicNeighborRequest)
+func _GobgpApi_EnableMrt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableMrtRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).AddDynamicNeighbor(ctx, in)
+		return srv.(GobgpApiServer).EnableMrt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/AddDynamicNeighbor",
+		FullMethod: "/apipb.GobgpApi/EnableMrt",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).AddDynamicNeighbor(ctx, req.(*AddDynamicNeighborRequest))
+		return srv.(GobgpApiServer).EnableMrt(ctx, req.(*EnableMrtRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_ListDynamicNeighbor_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListDynamicNeighborRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(GobgpApiServer).ListDynamicNeighbor(m, &gobgpApiListDynamicNeighborServer{stream})
-}
-
-type GobgpApi_ListDynamicNeighborServer interface {
-	Send(*ListDynamicNeighborResponse) error
-	grpc.ServerStream
-}
-
-type gobgpApiListDynamicNeighborServer struct {
-	grpc.ServerStream
-}
-
-func (x *gobgpApiListDynamicNeighborServer) Send(m *ListDynamicNeighborResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GobgpApi_DeleteDynamicNeighbor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteDynamicNeighborRequest)
+func _GobgpApi_DisableMrt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableMrtRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).DeleteDynamicNeighbor(ctx, in)
+		return srv.(GobgpApiServer).DisableMrt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/DeleteDynamicNeighbor",
+		FullMethod: "/apipb.GobgpApi/DisableMrt",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).DeleteDynamicNeighbor(ctx, req.(*DeleteDynamicNeighborRequest))
+		return srv.(GobgpApiServer).DisableMrt(ctx, req.(*DisableMrtRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_AddPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPathRequest)
+func _GobgpApi_AddBmp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBmpRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).AddPath(ctx, in)
+		return srv.(GobgpApiServer).AddBmp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/AddPath",
+		FullMethod: "/apipb.GobgpApi/AddBmp",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).AddPath(ctx, req.(*AddPathRequest))
+		return srv.(GobgpApiServer).AddBmp(ctx, req.(*AddBmpRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_DeletePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePathRequest)
+func _GobgpApi_DeleteBmp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBmpRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).DeletePath(ctx, in)
+		return srv.(GobgpApiServer).DeleteBmp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/DeletePath",
+		FullMethod: "/apipb.GobgpApi/DeleteBmp",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).DeletePath(ctx, req.(*DeletePathRequest))
+		return srv.(GobgpApiServer).DeleteBmp(ctx, req.(*DeleteBmpRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_ListPath_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListPathRequest)
+func _GobgpApi_ListBmp_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListBmpRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(GobgpApiServer).ListPath(m, &gobgpApiListPathServer{stream})
-}
-
-type GobgpApi_ListPathServer interface {
-	Send(*ListPathResponse) error
-	grpc.ServerStream
-}
-
-type gobgpApiListPathServer struct {
-	grpc.ServerStream
-}
-
-func (x *gobgpApiListPathServer) Send(m *ListPathResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GobgpApi_AddPathStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(GobgpApiServer).AddPathStream(&gobgpApiAddPathStreamServer{stream})
+	return srv.(GobgpApiServer).ListBmp(m, &gobgpApiListBmpServer{stream})
 }
 
-type GobgpApi_AddPathStreamServer interface {
-	SendAndClose(*emptypb.Empty) error
-	Recv() (*AddPathStreamRequest, error)
+type GobgpApi_ListBmpServer interface {
+	Send(*ListBmpResponse) error
 	grpc.ServerStream
 }
 
-type gobgpApiAddPathStreamServer struct {
+type gobgpApiListBmpServer struct {
 	grpc.ServerStream
 }
 
-func (x *gobgpApiAddPathStreamServer) SendAndClose(m *emptypb.Empty) error {
+func (x *gobgpApiListBmpServer) Send(m *ListBmpResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func (x *gobgpApiAddPathStreamServer) Recv() (*AddPathStreamRequest, error) {
-	m := new(AddPathStreamRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func _GobgpApi_GetTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTableRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GobgpApiServer).GetTable(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/GetTable",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).GetTable(ctx, req.(*GetTableRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _GobgpApi_AddVrf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddVrfRequest)
+func _GobgpApi_AddKafkaExporter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddKafkaExporterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).AddVrf(ctx, in)
+		return srv.(GobgpApiServer).AddKafkaExporter(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/AddVrf",
+		FullMethod: "/apipb.GobgpApi/AddKafkaExporter",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).AddVrf(ctx, req.(*AddVrfRequest))
+		return srv.(GobgpApiServer).AddKafkaExporter(ctx, req.(*AddKafkaExporterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_DeleteVrf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteVrfRequest)
+func _GobgpApi_DeleteKafkaExporter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteKafkaExporterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).DeleteVrf(ctx, in)
+		return srv.(GobgpApiServer).DeleteKafkaExporter(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/DeleteVrf",
+		FullMethod: "/apipb.GobgpApi/DeleteKafkaExporter",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).DeleteVrf(ctx, req.(*DeleteVrfRequest))
+		return srv.(GobgpApiServer).DeleteKafkaExporter(ctx, req.(*DeleteKafkaExporterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_ListVrf_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListVrfRequest)
+func _GobgpApi_ListKafkaExporter_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListKafkaExporterRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(GobgpApiServer).ListVrf(m, &gobgpApiListVrfServer{stream})
+	return srv.(GobgpApiServer).ListKafkaExporter(m, &gobgpApiListKafkaExporterServer{stream})
 }
 
-type GobgpApi_ListVrfServer interface {
-	Send(*ListVrfResponse) error
+type GobgpApi_ListKafkaExporterServer interface {
+	Send(*ListKafkaExporterResponse) error
 	grpc.ServerStream
 }
 
-type gobgpApiListVrfServer struct {
+type gobgpApiListKafkaExporterServer struct {
 	grpc.ServerStream
 }
 
-func (x *gobgpApiListVrfServer) Send(m *ListVrfResponse) error {
+func (x *gobgpApiListKafkaExporterServer) Send(m *ListKafkaExporterResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _GobgpApi_AddPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPolicyRequest)
+func _GobgpApi_AddIrrFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddIrrFilterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).AddPolicy(ctx, in)
+		return srv.(GobgpApiServer).AddIrrFilter(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/AddPolicy",
+		FullMethod: "/apipb.GobgpApi/AddIrrFilter",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).AddPolicy(ctx, req.(*AddPolicyRequest))
+		return srv.(GobgpApiServer).AddIrrFilter(ctx, req.(*AddIrrFilterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_DeletePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePolicyRequest)
+func _GobgpApi_DeleteIrrFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIrrFilterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).DeletePolicy(ctx, in)
+		return srv.(GobgpApiServer).DeleteIrrFilter(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/DeletePolicy",
+		FullMethod: "/apipb.GobgpApi/DeleteIrrFilter",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).DeletePolicy(ctx, req.(*DeletePolicyRequest))
+		return srv.(GobgpApiServer).DeleteIrrFilter(ctx, req.(*DeleteIrrFilterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_ListPolicy_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListPolicyRequest)
+func _GobgpApi_ListIrrFilter_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListIrrFilterRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(GobgpApiServer).ListPolicy(m, &gobgpApiListPolicyServer{stream})
+	return srv.(GobgpApiServer).ListIrrFilter(m, &gobgpApiListIrrFilterServer{stream})
 }
 
-type GobgpApi_ListPolicyServer interface {
-	Send(*ListPolicyResponse) error
+type GobgpApi_ListIrrFilterServer interface {
+	Send(*ListIrrFilterResponse) error
 	grpc.ServerStream
 }
 
-type gobgpApiListPolicyServer struct {
+type gobgpApiListIrrFilterServer struct {
 	grpc.ServerStream
 }
 
-func (x *gobgpApiListPolicyServer) Send(m *ListPolicyResponse) error {
+func (x *gobgpApiListIrrFilterServer) Send(m *ListIrrFilterResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _GobgpApi_SetPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetPoliciesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GobgpApiServer).SetPolicies(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/SetPolicies",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).SetPolicies(ctx, req.(*SetPoliciesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _GobgpApi_AddDefinedSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddDefinedSetRequest)
+func _GobgpApi_AddAggregate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAggregateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).AddDefinedSet(ctx, in)
+		return srv.(GobgpApiServer).AddAggregate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/AddDefinedSet",
+		FullMethod: "/apipb.GobgpApi/AddAggregate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).AddDefinedSet(ctx, req.(*AddDefinedSetRequest))
+		return srv.(GobgpApiServer).AddAggregate(ctx, req.(*AddAggregateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_DeleteDefinedSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteDefinedSetRequest)
+func _GobgpApi_DeleteAggregate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAggregateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).DeleteDefinedSet(ctx, in)
+		return srv.(GobgpApiServer).DeleteAggregate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/DeleteDefinedSet",
+		FullMethod: "/apipb.GobgpApi/DeleteAggregate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).DeleteDefinedSet(ctx, req.(*DeleteDefinedSetRequest))
+		return srv.(GobgpApiServer).DeleteAggregate(ctx, req.(*DeleteAggregateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_ListDefinedSet_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListDefinedSetRequest)
+func _GobgpApi_ListAggregate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListAggregateRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(GobgpApiServer).ListDefinedSet(m, &gobgpApiListDefinedSetServer{stream})
+	return srv.(GobgpApiServer).ListAggregate(m, &gobgpApiListAggregateServer{stream})
 }
 
-type GobgpApi_ListDefinedSetServer interface {
-	Send(*ListDefinedSetResponse) error
+type GobgpApi_ListAggregateServer interface {
+	Send(*ListAggregateResponse) error
 	grpc.ServerStream
 }
 
-type gobgpApiListDefinedSetServer struct {
+type gobgpApiListAggregateServer struct {
 	grpc.ServerStream
 }
 
-func (x *gobgpApiListDefinedSetServer) Send(m *ListDefinedSetResponse) error {
+func (x *gobgpApiListAggregateServer) Send(m *ListAggregateResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _GobgpApi_AddStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddStatementRequest)
+func _GobgpApi_AddVrfLeak_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddVrfLeakRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).AddStatement(ctx, in)
+		return srv.(GobgpApiServer).AddVrfLeak(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/AddStatement",
+		FullMethod: "/apipb.GobgpApi/AddVrfLeak",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).AddStatement(ctx, req.(*AddStatementRequest))
+		return srv.(GobgpApiServer).AddVrfLeak(ctx, req.(*AddVrfLeakRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_DeleteStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteStatementRequest)
+func _GobgpApi_DeleteVrfLeak_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVrfLeakRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).DeleteStatement(ctx, in)
+		return srv.(GobgpApiServer).DeleteVrfLeak(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/DeleteStatement",
+		FullMethod: "/apipb.GobgpApi/DeleteVrfLeak",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).DeleteStatement(ctx, req.(*DeleteStatementRequest))
+		return srv.(GobgpApiServer).DeleteVrfLeak(ctx, req.(*DeleteVrfLeakRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_ListStatement_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListStatementRequest)
+func _GobgpApi_ListVrfLeak_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListVrfLeakRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(GobgpApiServer).ListStatement(m, &gobgpApiListStatementServer{stream})
+	return srv.(GobgpApiServer).ListVrfLeak(m, &gobgpApiListVrfLeakServer{stream})
 }
 
-type GobgpApi_ListStatementServer interface {
-	Send(*ListStatementResponse) error
+type GobgpApi_ListVrfLeakServer interface {
+	Send(*ListVrfLeakResponse) error
 	grpc.ServerStream
 }
 
-type gobgpApiListStatementServer struct {
+type gobgpApiListVrfLeakServer struct {
 	grpc.ServerStream
 }
 
-func (x *gobgpApiListStatementServer) Send(m *ListStatementResponse) error {
+func (x *gobgpApiListVrfLeakServer) Send(m *ListVrfLeakResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _GobgpApi_AddPolicyAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPolicyAssignmentRequest)
+func _GobgpApi_AddOrrVantagePoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrrVantagePointRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).AddPolicyAssignment(ctx, in)
+		return srv.(GobgpApiServer).AddOrrVantagePoint(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/AddPolicyAssignment",
+		FullMethod: "/apipb.GobgpApi/AddOrrVantagePoint",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).AddPolicyAssignment(ctx, req.(*AddPolicyAssignmentRequest))
+		return srv.(GobgpApiServer).AddOrrVantagePoint(ctx, req.(*AddOrrVantagePointRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_DeletePolicyAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePolicyAssignmentRequest)
+func _GobgpApi_DeleteOrrVantagePoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrrVantagePointRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).DeletePolicyAssignment(ctx, in)
+		return srv.(GobgpApiServer).DeleteOrrVantagePoint(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/DeletePolicyAssignment",
+		FullMethod: "/apipb.GobgpApi/DeleteOrrVantagePoint",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).DeletePolicyAssignment(ctx, req.(*DeletePolicyAssignmentRequest))
+		return srv.(GobgpApiServer).DeleteOrrVantagePoint(ctx, req.(*DeleteOrrVantagePointRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_ListPolicyAssignment_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListPolicyAssignmentRequest)
+func _GobgpApi_ListOrrVantagePoint_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListOrrVantagePointRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(GobgpApiServer).ListPolicyAssignment(m, &gobgpApiListPolicyAssignmentServer{stream})
+	return srv.(GobgpApiServer).ListOrrVantagePoint(m, &gobgpApiListOrrVantagePointServer{stream})
 }
 
-type GobgpApi_ListPolicyAssignmentServer interface {
-	Send(*ListPolicyAssignmentResponse) error
+type GobgpApi_ListOrrVantagePointServer interface {
+	Send(*ListOrrVantagePointResponse) error
 	grpc.ServerStream
 }
 
-type gobgpApiListPolicyAssignmentServer struct {
+type gobgpApiListOrrVantagePointServer struct {
 	grpc.ServerStream
 }
 
-func (x *gobgpApiListPolicyAssignmentServer) Send(m *ListPolicyAssignmentResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GobgpApi_SetPolicyAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetPolicyAssignmentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GobgpApiServer).SetPolicyAssignment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/SetPolicyAssignment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).SetPolicyAssignment(ctx, req.(*SetPolicyAssignmentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (x *gobgpApiListOrrVantagePointServer) Send(m *ListOrrVantagePointResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func _GobgpApi_AddRpki_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddRpkiRequest)
+func _GobgpApi_AddEvpnVpws_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEvpnVpwsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).AddRpki(ctx, in)
+		return srv.(GobgpApiServer).AddEvpnVpws(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/AddRpki",
+		FullMethod: "/apipb.GobgpApi/AddEvpnVpws",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).AddRpki(ctx, req.(*AddRpkiRequest))
+		return srv.(GobgpApiServer).AddEvpnVpws(ctx, req.(*AddEvpnVpwsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_DeleteRpki_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRpkiRequest)
+func _GobgpApi_DeleteEvpnVpws_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEvpnVpwsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).DeleteRpki(ctx, in)
+		return srv.(GobgpApiServer).DeleteEvpnVpws(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/DeleteRpki",
+		FullMethod: "/apipb.GobgpApi/DeleteEvpnVpws",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).DeleteRpki(ctx, req.(*DeleteRpkiRequest))
+		return srv.(GobgpApiServer).DeleteEvpnVpws(ctx, req.(*DeleteEvpnVpwsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_ListRpki_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListRpkiRequest)
+func _GobgpApi_ListEvpnVpws_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListEvpnVpwsRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(GobgpApiServer).ListRpki(m, &gobgpApiListRpkiServer{stream})
+	return srv.(GobgpApiServer).ListEvpnVpws(m, &gobgpApiListEvpnVpwsServer{stream})
 }
 
-type GobgpApi_ListRpkiServer interface {
-	Send(*ListRpkiResponse) error
+type GobgpApi_ListEvpnVpwsServer interface {
+	Send(*ListEvpnVpwsResponse) error
 	grpc.ServerStream
 }
 
-type gobgpApiListRpkiServer struct {
+type gobgpApiListEvpnVpwsServer struct {
 	grpc.ServerStream
 }
 
-func (x *gobgpApiListRpkiServer) Send(m *ListRpkiResponse) error {
+func (x *gobgpApiListEvpnVpwsServer) Send(m *ListEvpnVpwsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _GobgpApi_EnableRpki_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EnableRpkiRequest)
+func _GobgpApi_AddRtbh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRtbhRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).EnableRpki(ctx, in)
+		return srv.(GobgpApiServer).AddRtbh(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/EnableRpki",
+		FullMethod: "/apipb.GobgpApi/AddRtbh",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).EnableRpki(ctx, req.(*EnableRpkiRequest))
+		return srv.(GobgpApiServer).AddRtbh(ctx, req.(*AddRtbhRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_DisableRpki_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DisableRpkiRequest)
+func _GobgpApi_DeleteRtbh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRtbhRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).DisableRpki(ctx, in)
+		return srv.(GobgpApiServer).DeleteRtbh(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/DisableRpki",
+		FullMethod: "/apipb.GobgpApi/DeleteRtbh",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).DisableRpki(ctx, req.(*DisableRpkiRequest))
+		return srv.(GobgpApiServer).DeleteRtbh(ctx, req.(*DeleteRtbhRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_ResetRpki_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResetRpkiRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GobgpApiServer).ResetRpki(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/ResetRpki",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).ResetRpki(ctx, req.(*ResetRpkiRequest))
+func _GobgpApi_ListRtbh_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRtbhRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(GobgpApiServer).ListRtbh(m, &gobgpApiListRtbhServer{stream})
 }
 
-func _GobgpApi_ListRpkiTable_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListRpkiTableRequest)
+type GobgpApi_ListRtbhServer interface {
+	Send(*ListRtbhResponse) error
+	grpc.ServerStream
+}
+
+type gobgpApiListRtbhServer struct {
+	grpc.ServerStream
+}
+
+func (x *gobgpApiListRtbhServer) Send(m *ListRtbhResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GobgpApi_ListPrefixActivity_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListPrefixActivityRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(GobgpApiServer).ListRpkiTable(m, &gobgpApiListRpkiTableServer{stream})
+	return srv.(GobgpApiServer).ListPrefixActivity(m, &gobgpApiListPrefixActivityServer{stream})
 }
 
-type GobgpApi_ListRpkiTableServer interface {
-	Send(*ListRpkiTableResponse) error
+type GobgpApi_ListPrefixActivityServer interface {
+	Send(*ListPrefixActivityResponse) error
 	grpc.ServerStream
 }
 
-type gobgpApiListRpkiTableServer struct {
+type gobgpApiListPrefixActivityServer struct {
 	grpc.ServerStream
 }
 
-func (x *gobgpApiListRpkiTableServer) Send(m *ListRpkiTableResponse) error {
+func (x *gobgpApiListPrefixActivityServer) Send(m *ListPrefixActivityResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _GobgpApi_EnableZebra_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EnableZebraRequest)
+func _GobgpApi_GetPathHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPathHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).EnableZebra(ctx, in)
+		return srv.(GobgpApiServer).GetPathHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/EnableZebra",
+		FullMethod: "/apipb.GobgpApi/GetPathHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).EnableZebra(ctx, req.(*EnableZebraRequest))
+		return srv.(GobgpApiServer).GetPathHistory(ctx, req.(*GetPathHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_EnableMrt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EnableMrtRequest)
+func _GobgpApi_GetRibSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRibSummaryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).EnableMrt(ctx, in)
+		return srv.(GobgpApiServer).GetRibSummary(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/EnableMrt",
+		FullMethod: "/apipb.GobgpApi/GetRibSummary",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).EnableMrt(ctx, req.(*EnableMrtRequest))
+		return srv.(GobgpApiServer).GetRibSummary(ctx, req.(*GetRibSummaryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_DisableMrt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DisableMrtRequest)
+func _GobgpApi_ListRibDiff_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRibDiffRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GobgpApiServer).ListRibDiff(m, &gobgpApiListRibDiffServer{stream})
+}
+
+type GobgpApi_ListRibDiffServer interface {
+	Send(*ListRibDiffResponse) error
+	grpc.ServerStream
+}
+
+type gobgpApiListRibDiffServer struct {
+	grpc.ServerStream
+}
+
+func (x *gobgpApiListRibDiffServer) Send(m *ListRibDiffResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GobgpApi_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).DisableMrt(ctx, in)
+		return srv.(GobgpApiServer).SetLogLevel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/DisableMrt",
+		FullMethod: "/apipb.GobgpApi/SetLogLevel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).DisableMrt(ctx, req.(*DisableMrtRequest))
+		return srv.(GobgpApiServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_AddBmp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddBmpRequest)
+func _GobgpApi_GetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogLevelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).AddBmp(ctx, in)
+		return srv.(GobgpApiServer).GetLogLevel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/AddBmp",
+		FullMethod: "/apipb.GobgpApi/GetLogLevel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).AddBmp(ctx, req.(*AddBmpRequest))
+		return srv.(GobgpApiServer).GetLogLevel(ctx, req.(*GetLogLevelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_DeleteBmp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteBmpRequest)
+func _GobgpApi_StartDebugCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartDebugCaptureRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).DeleteBmp(ctx, in)
+		return srv.(GobgpApiServer).StartDebugCapture(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/DeleteBmp",
+		FullMethod: "/apipb.GobgpApi/StartDebugCapture",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).DeleteBmp(ctx, req.(*DeleteBmpRequest))
+		return srv.(GobgpApiServer).StartDebugCapture(ctx, req.(*StartDebugCaptureRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GobgpApi_ListBmp_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListBmpRequest)
+func _GobgpApi_StopDebugCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopDebugCaptureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GobgpApiServer).StopDebugCapture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apipb.GobgpApi/StopDebugCapture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GobgpApiServer).StopDebugCapture(ctx, req.(*StopDebugCaptureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GobgpApi_ListDebugCapture_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListDebugCaptureRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(GobgpApiServer).ListBmp(m, &gobgpApiListBmpServer{stream})
+	return srv.(GobgpApiServer).ListDebugCapture(m, &gobgpApiListDebugCaptureServer{stream})
 }
 
-type GobgpApi_ListBmpServer interface {
-	Send(*ListBmpResponse) error
+type GobgpApi_ListDebugCaptureServer interface {
+	Send(*ListDebugCaptureResponse) error
 	grpc.ServerStream
 }
 
-type gobgpApiListBmpServer struct {
+type gobgpApiListDebugCaptureServer struct {
 	grpc.ServerStream
 }
 
-func (x *gobgpApiListBmpServer) Send(m *ListBmpResponse) error {
+func (x *gobgpApiListDebugCaptureServer) Send(m *ListDebugCaptureResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _GobgpApi_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetLogLevelRequest)
+func _GobgpApi_GetApiVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApiVersionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GobgpApiServer).SetLogLevel(ctx, in)
+		return srv.(GobgpApiServer).GetApiVersion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/apipb.GobgpApi/SetLogLevel",
+		FullMethod: "/apipb.GobgpApi/GetApiVersion",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GobgpApiServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+		return srv.(GobgpApiServer).GetApiVersion(ctx, req.(*GetApiVersionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2211,6 +4377,14 @@ var GobgpApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePeer",
 			Handler:    _GobgpApi_DeletePeer_Handler,
 		},
+		{
+			MethodName: "ListPeerAggregated",
+			Handler:    _GobgpApi_ListPeerAggregated_Handler,
+		},
+		{
+			MethodName: "GetEffectivePeerConfig",
+			Handler:    _GobgpApi_GetEffectivePeerConfig_Handler,
+		},
 		{
 			MethodName: "UpdatePeer",
 			Handler:    _GobgpApi_UpdatePeer_Handler,
@@ -2231,6 +4405,10 @@ var GobgpApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DisablePeer",
 			Handler:    _GobgpApi_DisablePeer_Handler,
 		},
+		{
+			MethodName: "GracefulShutdownPeer",
+			Handler:    _GobgpApi_GracefulShutdownPeer_Handler,
+		},
 		{
 			MethodName: "AddPeerGroup",
 			Handler:    _GobgpApi_AddPeerGroup_Handler,
@@ -2259,6 +4437,14 @@ var GobgpApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePath",
 			Handler:    _GobgpApi_DeletePath_Handler,
 		},
+		{
+			MethodName: "ListPathAggregated",
+			Handler:    _GobgpApi_ListPathAggregated_Handler,
+		},
+		{
+			MethodName: "ExtendPathLifetime",
+			Handler:    _GobgpApi_ExtendPathLifetime_Handler,
+		},
 		{
 			MethodName: "GetTable",
 			Handler:    _GobgpApi_GetTable_Handler,
@@ -2271,6 +4457,10 @@ var GobgpApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteVrf",
 			Handler:    _GobgpApi_DeleteVrf_Handler,
 		},
+		{
+			MethodName: "ClearEvpnFrozenMac",
+			Handler:    _GobgpApi_ClearEvpnFrozenMac_Handler,
+		},
 		{
 			MethodName: "AddPolicy",
 			Handler:    _GobgpApi_AddPolicy_Handler,
@@ -2283,6 +4473,34 @@ var GobgpApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetPolicies",
 			Handler:    _GobgpApi_SetPolicies_Handler,
 		},
+		{
+			MethodName: "ReplacePolicies",
+			Handler:    _GobgpApi_ReplacePolicies_Handler,
+		},
+		{
+			MethodName: "StageConfig",
+			Handler:    _GobgpApi_StageConfig_Handler,
+		},
+		{
+			MethodName: "DiscardConfig",
+			Handler:    _GobgpApi_DiscardConfig_Handler,
+		},
+		{
+			MethodName: "CommitConfig",
+			Handler:    _GobgpApi_CommitConfig_Handler,
+		},
+		{
+			MethodName: "ConfirmConfig",
+			Handler:    _GobgpApi_ConfirmConfig_Handler,
+		},
+		{
+			MethodName: "RollbackConfig",
+			Handler:    _GobgpApi_RollbackConfig_Handler,
+		},
+		{
+			MethodName: "GetConfigTransaction",
+			Handler:    _GobgpApi_GetConfigTransaction_Handler,
+		},
 		{
 			MethodName: "AddDefinedSet",
 			Handler:    _GobgpApi_AddDefinedSet_Handler,
@@ -2291,6 +4509,10 @@ var GobgpApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDefinedSet",
 			Handler:    _GobgpApi_DeleteDefinedSet_Handler,
 		},
+		{
+			MethodName: "UpdateDefinedSet",
+			Handler:    _GobgpApi_UpdateDefinedSet_Handler,
+		},
 		{
 			MethodName: "AddStatement",
 			Handler:    _GobgpApi_AddStatement_Handler,
@@ -2311,6 +4533,14 @@ var GobgpApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetPolicyAssignment",
 			Handler:    _GobgpApi_SetPolicyAssignment_Handler,
 		},
+		{
+			MethodName: "ReplacePolicyAssignment",
+			Handler:    _GobgpApi_ReplacePolicyAssignment_Handler,
+		},
+		{
+			MethodName: "SimulatePolicy",
+			Handler:    _GobgpApi_SimulatePolicy_Handler,
+		},
 		{
 			MethodName: "AddRpki",
 			Handler:    _GobgpApi_AddRpki_Handler,
@@ -2331,10 +4561,34 @@ var GobgpApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetRpki",
 			Handler:    _GobgpApi_ResetRpki_Handler,
 		},
+		{
+			MethodName: "SetRpkiSlurm",
+			Handler:    _GobgpApi_SetRpkiSlurm_Handler,
+		},
 		{
 			MethodName: "EnableZebra",
 			Handler:    _GobgpApi_EnableZebra_Handler,
 		},
+		{
+			MethodName: "EnableNetlink",
+			Handler:    _GobgpApi_EnableNetlink_Handler,
+		},
+		{
+			MethodName: "DisableNetlink",
+			Handler:    _GobgpApi_DisableNetlink_Handler,
+		},
+		{
+			MethodName: "EnablePeeringDb",
+			Handler:    _GobgpApi_EnablePeeringDb_Handler,
+		},
+		{
+			MethodName: "DisablePeeringDb",
+			Handler:    _GobgpApi_DisablePeeringDb_Handler,
+		},
+		{
+			MethodName: "SetSrv6Locator",
+			Handler:    _GobgpApi_SetSrv6Locator_Handler,
+		},
 		{
 			MethodName: "EnableMrt",
 			Handler:    _GobgpApi_EnableMrt_Handler,
@@ -2351,10 +4605,90 @@ var GobgpApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBmp",
 			Handler:    _GobgpApi_DeleteBmp_Handler,
 		},
+		{
+			MethodName: "AddKafkaExporter",
+			Handler:    _GobgpApi_AddKafkaExporter_Handler,
+		},
+		{
+			MethodName: "DeleteKafkaExporter",
+			Handler:    _GobgpApi_DeleteKafkaExporter_Handler,
+		},
+		{
+			MethodName: "AddIrrFilter",
+			Handler:    _GobgpApi_AddIrrFilter_Handler,
+		},
+		{
+			MethodName: "DeleteIrrFilter",
+			Handler:    _GobgpApi_DeleteIrrFilter_Handler,
+		},
+		{
+			MethodName: "AddAggregate",
+			Handler:    _GobgpApi_AddAggregate_Handler,
+		},
+		{
+			MethodName: "DeleteAggregate",
+			Handler:    _GobgpApi_DeleteAggregate_Handler,
+		},
+		{
+			MethodName: "AddVrfLeak",
+			Handler:    _GobgpApi_AddVrfLeak_Handler,
+		},
+		{
+			MethodName: "DeleteVrfLeak",
+			Handler:    _GobgpApi_DeleteVrfLeak_Handler,
+		},
+		{
+			MethodName: "AddOrrVantagePoint",
+			Handler:    _GobgpApi_AddOrrVantagePoint_Handler,
+		},
+		{
+			MethodName: "DeleteOrrVantagePoint",
+			Handler:    _GobgpApi_DeleteOrrVantagePoint_Handler,
+		},
+		{
+			MethodName: "AddEvpnVpws",
+			Handler:    _GobgpApi_AddEvpnVpws_Handler,
+		},
+		{
+			MethodName: "DeleteEvpnVpws",
+			Handler:    _GobgpApi_DeleteEvpnVpws_Handler,
+		},
+		{
+			MethodName: "AddRtbh",
+			Handler:    _GobgpApi_AddRtbh_Handler,
+		},
+		{
+			MethodName: "DeleteRtbh",
+			Handler:    _GobgpApi_DeleteRtbh_Handler,
+		},
+		{
+			MethodName: "GetPathHistory",
+			Handler:    _GobgpApi_GetPathHistory_Handler,
+		},
+		{
+			MethodName: "GetRibSummary",
+			Handler:    _GobgpApi_GetRibSummary_Handler,
+		},
 		{
 			MethodName: "SetLogLevel",
 			Handler:    _GobgpApi_SetLogLevel_Handler,
 		},
+		{
+			MethodName: "GetLogLevel",
+			Handler:    _GobgpApi_GetLogLevel_Handler,
+		},
+		{
+			MethodName: "StartDebugCapture",
+			Handler:    _GobgpApi_StartDebugCapture_Handler,
+		},
+		{
+			MethodName: "StopDebugCapture",
+			Handler:    _GobgpApi_StopDebugCapture_Handler,
+		},
+		{
+			MethodName: "GetApiVersion",
+			Handler:    _GobgpApi_GetApiVersion_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2392,6 +4726,21 @@ var GobgpApi_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GobgpApi_ListVrf_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListEvpnDesignatedForwarder",
+			Handler:       _GobgpApi_ListEvpnDesignatedForwarder_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListEvpnFrozenMac",
+			Handler:       _GobgpApi_ListEvpnFrozenMac_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListColorBinding",
+			Handler:       _GobgpApi_ListColorBinding_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ListPolicy",
 			Handler:       _GobgpApi_ListPolicy_Handler,
@@ -2422,11 +4771,66 @@ var GobgpApi_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GobgpApi_ListRpkiTable_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListPeeringDbRecord",
+			Handler:       _GobgpApi_ListPeeringDbRecord_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ListBmp",
 			Handler:       _GobgpApi_ListBmp_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListKafkaExporter",
+			Handler:       _GobgpApi_ListKafkaExporter_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListIrrFilter",
+			Handler:       _GobgpApi_ListIrrFilter_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListAggregate",
+			Handler:       _GobgpApi_ListAggregate_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListVrfLeak",
+			Handler:       _GobgpApi_ListVrfLeak_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListOrrVantagePoint",
+			Handler:       _GobgpApi_ListOrrVantagePoint_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListEvpnVpws",
+			Handler:       _GobgpApi_ListEvpnVpws_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListRtbh",
+			Handler:       _GobgpApi_ListRtbh_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListPrefixActivity",
+			Handler:       _GobgpApi_ListPrefixActivity_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListRibDiff",
+			Handler:       _GobgpApi_ListRibDiff_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListDebugCapture",
+			Handler:       _GobgpApi_ListDebugCapture_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "gobgp.proto",
 }
@@ -0,0 +1,94 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation files
+// (the "Software"), to deal in the Software without restriction,
+// including without limitation the rights to use, copy, modify, merge,
+// publish, distribute, sublicense, and/or sell copies of the Software,
+// and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package apipb
+
+import "strings"
+
+// The version of the API. The major version follows the one of the Go
+// module and changes only when services, methods, messages, fields or enum
+// values are removed, renumbered or renamed. The minor version is
+// incremented when they are added.
+const (
+	VersionMajor = 3
+	VersionMinor = 1
+)
+
+// DeprecatedServiceNames are the full names of the GobgpApi service in the
+// former versions of the API. The server translates the calls to them into
+// the ones to GobgpApi_ServiceDesc. The messages of the methods kept since
+// then are wire compatible except for the type URLs of the Any fields,
+// which have the package name of the service.
+var DeprecatedServiceNames = []string{
+	// GoBGP 2.x
+	"gobgpapi.GobgpApi",
+}
+
+// TranslateDeprecatedMethod returns the full method name of GobgpApi
+// corresponding to the one of a deprecated service, or false if fullMethod
+// isn't of a deprecated service.
+func TranslateDeprecatedMethod(fullMethod string) (string, bool) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return "", false
+	}
+	for _, name := range DeprecatedServiceNames {
+		if service == name {
+			return "/" + GobgpApi_ServiceDesc.ServiceName + "/" + method, true
+		}
+	}
+	return "", false
+}
+
+const typeURLPrefix = "type.googleapis.com/"
+
+// currentPackage is the proto package of GobgpApi.
+var currentPackage = GobgpApi_ServiceDesc.ServiceName[:strings.LastIndex(GobgpApi_ServiceDesc.ServiceName, ".")]
+
+// TranslateDeprecatedTypeURL returns the type URL of the current package
+// for the one of the package of a deprecated service, e.g.
+// "type.googleapis.com/gobgpapi.OriginAttribute" for
+// "type.googleapis.com/apipb.OriginAttribute". The other type URLs are
+// returned as they are.
+func TranslateDeprecatedTypeURL(typeURL string) string {
+	name, ok := strings.CutPrefix(typeURL, typeURLPrefix)
+	if !ok {
+		return typeURL
+	}
+	for _, service := range DeprecatedServiceNames {
+		pkg := service[:strings.LastIndex(service, ".")]
+		if msg, ok := strings.CutPrefix(name, pkg+"."); ok {
+			return typeURLPrefix + currentPackage + "." + msg
+		}
+	}
+	return typeURL
+}
+
+// DeprecatedTypeURL returns the type URL of the package of the deprecated
+// service for the one of the current package. It's the reverse of
+// TranslateDeprecatedTypeURL.
+func DeprecatedTypeURL(typeURL, service string) string {
+	msg, ok := strings.CutPrefix(typeURL, typeURLPrefix+currentPackage+".")
+	if !ok {
+		return typeURL
+	}
+	return typeURLPrefix + service[:strings.LastIndex(service, ".")] + "." + msg
+}
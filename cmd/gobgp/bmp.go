@@ -42,8 +42,8 @@ func showStations() error {
 		}
 		stations = append(stations, rsp.Station)
 	}
-	format := "%-23s %-6s %-10s\n"
-	fmt.Printf(format, "Session", "State", "Uptime")
+	format := "%-23s %-7s %-6s %-10s %10s %10s\n"
+	fmt.Printf(format, "Session", "Mode", "State", "Uptime", "Sent", "Dropped")
 	for _, r := range stations {
 		s := "Down"
 		uptime := "Never"
@@ -56,7 +56,11 @@ func showStations() error {
 				s = "Down"
 			}
 		}
-		fmt.Printf(format, net.JoinHostPort(r.Conf.Address, fmt.Sprintf("%d", r.Conf.Port)), s, uptime)
+		mode := "active"
+		if r.Conf.Mode == api.AddBmpRequest_PASSIVE {
+			mode = "passive"
+		}
+		fmt.Printf(format, net.JoinHostPort(r.Conf.Address, fmt.Sprintf("%d", r.Conf.Port)), mode, s, uptime, fmt.Sprint(r.State.Sent), fmt.Sprint(r.State.Dropped))
 	}
 
 	return nil
@@ -110,11 +114,42 @@ func modBmpServer(cmdType string, args []string) error {
 				return fmt.Errorf("invalid bmp policy type. valid type is {pre|post|both|local-rib|all}")
 			}
 		}
+		var mode api.AddBmpRequest_Mode
+		switch bmpOpts.Mode {
+		case "", "active":
+			mode = api.AddBmpRequest_ACTIVE
+		case "passive":
+			mode = api.AddBmpRequest_PASSIVE
+		default:
+			return fmt.Errorf("invalid bmp mode. valid mode is {active|passive}")
+		}
+		var transport api.AddBmpRequest_Transport
+		switch bmpOpts.Transport {
+		case "", "tcp":
+			transport = api.AddBmpRequest_TCP
+		case "tls":
+			transport = api.AddBmpRequest_TLS
+		default:
+			return fmt.Errorf("invalid bmp transport. valid transport is {tcp|tls}")
+		}
 		_, err = client.AddBmp(ctx, &api.AddBmpRequest{
 			Address:           address,
 			Port:              port,
 			Policy:            policyType,
 			StatisticsTimeout: int32(statisticsTimeout),
+			Mode:              mode,
+			LocalAddress:      bmpOpts.LocalAddress,
+			AuthPassword:      bmpOpts.AuthPassword,
+			Transport:         transport,
+			Tls: &api.BMPTLSConf{
+				CaFile:     bmpOpts.CaFile,
+				CertFile:   bmpOpts.CertFile,
+				KeyFile:    bmpOpts.KeyFile,
+				ServerName: bmpOpts.ServerName,
+			},
+			RouteMirroring:          bmpOpts.RouteMirroring,
+			RouteMirroringNeighbors: bmpOpts.RouteMirroringNeighbors,
+			RouteMirroringRateLimit: bmpOpts.RouteMirroringRateLimit,
 		})
 	case cmdDel:
 		_, err = client.DeleteBmp(ctx, &api.DeleteBmpRequest{
@@ -145,6 +180,17 @@ func newBmpCmd() *cobra.Command {
 		}
 		if w == cmdAdd {
 			subcmd.PersistentFlags().IntVarP(&bmpOpts.StatisticsTimeout, "statistics-timeout", "s", 0, "Timeout of statistics report")
+			subcmd.PersistentFlags().StringVarP(&bmpOpts.Mode, "mode", "", "active", "active or passive")
+			subcmd.PersistentFlags().StringVarP(&bmpOpts.LocalAddress, "local-address", "", "", "Address on which the passive mode listens")
+			subcmd.PersistentFlags().StringVarP(&bmpOpts.AuthPassword, "auth-password", "", "", "Password of the TCP MD5 signature option")
+			subcmd.PersistentFlags().StringVarP(&bmpOpts.Transport, "transport", "", "tcp", "tcp or tls")
+			subcmd.PersistentFlags().StringVarP(&bmpOpts.CaFile, "ca-file", "", "", "CA certificates file to verify the BMP server")
+			subcmd.PersistentFlags().StringVarP(&bmpOpts.CertFile, "cert-file", "", "", "Certificate file for TLS")
+			subcmd.PersistentFlags().StringVarP(&bmpOpts.KeyFile, "key-file", "", "", "Key file for TLS")
+			subcmd.PersistentFlags().StringVarP(&bmpOpts.ServerName, "server-name", "", "", "Server name to verify the certificate of the BMP server")
+			subcmd.PersistentFlags().BoolVarP(&bmpOpts.RouteMirroring, "route-mirroring", "", false, "Mirror the received BGP messages")
+			subcmd.PersistentFlags().StringSliceVarP(&bmpOpts.RouteMirroringNeighbors, "route-mirroring-neighbors", "", nil, "Comma-separated neighbors whose messages are mirrored, all if omitted")
+			subcmd.PersistentFlags().Uint32VarP(&bmpOpts.RouteMirroringRateLimit, "route-mirroring-rate-limit", "", 0, "Maximum number of the mirrored messages per second, unlimited if 0")
 		}
 		bmpCmd.AddCommand(subcmd)
 	}
@@ -50,6 +50,8 @@ const (
 	cmdDel            = "del"
 	cmdAll            = "all"
 	cmdSet            = "set"
+	cmdReplace        = "replace"
+	cmdSimulate       = "simulate"
 	cmdLocal          = "local"
 	cmdAdjIn          = "adj-in"
 	cmdAdjOut         = "adj-out"
@@ -60,6 +62,8 @@ const (
 	cmdShutdown       = "shutdown"
 	cmdEnable         = "enable"
 	cmdDisable        = "disable"
+	cmdGshut          = "graceful-shutdown"
+	cmdActivity       = "activity"
 	cmdPrefix         = "prefix"
 	cmdAspath         = "as-path"
 	cmdCommunity      = "community"
@@ -72,6 +76,7 @@ const (
 	cmdRPKI           = "rpki"
 	cmdRPKITable      = "table"
 	cmdRPKIServer     = "server"
+	cmdRPKISlurm      = "slurm"
 	cmdVRF            = "vrf"
 	cmdAccepted       = "accepted"
 	cmdRejected       = "rejected"
@@ -82,6 +87,8 @@ const (
 	cmdBMP            = "bmp"
 	cmdLargecommunity = "large-community"
 	cmdSummary        = "summary"
+	cmdHistory        = "history"
+	cmdDiff           = "diff"
 	cmdLogLevel       = "log-level"
 	cmdPanic          = "panic"
 	cmdFatal          = "fatal"
@@ -90,6 +97,15 @@ const (
 	cmdInfo           = "info"
 	cmdDebug          = "debug"
 	cmdTrace          = "trace"
+	cmdUnset          = "unset"
+	cmdModule         = "module"
+	cmdConfig         = "config"
+	cmdShell          = "shell"
+	cmdCapture        = "capture"
+	cmdStart          = "start"
+	cmdStop           = "stop"
+	cmdRtbh           = "rtbh"
+	cmdOrr            = "orr"
 )
 
 const (
@@ -103,23 +119,66 @@ var subOpts struct {
 }
 
 var neighborsOpts struct {
-	Reason    string `short:"r" long:"reason" description:"specifying communication field on Cease NOTIFICATION message with Administrative Shutdown subcode"`
-	Transport string `short:"t" long:"transport" description:"specifying a transport protocol"`
+	Reason       string `short:"r" long:"reason" description:"specifying communication field on Cease NOTIFICATION message with Administrative Shutdown subcode"`
+	Transport    string `short:"t" long:"transport" description:"specifying a transport protocol"`
+	DrainTime    uint32 `long:"drain-time" description:"seconds before the neighbor is disabled in graceful shutdown"`
+	Cancel       bool   `long:"cancel" description:"end graceful shutdown without disabling the neighbor"`
+	Window       uint32 `long:"window" description:"seconds of the window to count the changes of the prefixes"`
+	Limit        uint32 `long:"limit" description:"number of the most active prefixes to show"`
+	Size         uint32 `long:"size" description:"number of the latest messages kept by debug capture"`
+	File         string `long:"file" description:"file which debug capture writes the messages to"`
+	Format       string `long:"format" description:"format of the debug capture file, pcapng or raw"`
+	RotationSize uint64 `long:"rotation-size" description:"bytes of the debug capture file to rotate it"`
+}
+
+var policyAssignmentOpts struct {
+	DryRun bool `long:"dry-run" description:"Count the routes whose disposition would change without replacing"`
 }
 
 var mrtOpts struct {
-	Filename    string `long:"filename" description:"MRT file name"`
-	RecordCount int64  `long:"count" description:"Number of records to inject"`
-	RecordSkip  int64  `long:"skip" description:"Number of records to skip before injecting"`
-	QueueSize   int    `long:"batch-size" description:"Maximum number of updates to keep queued"`
-	Best        bool   `long:"only-best" description:"only keep best path routes"`
-	SkipV4      bool   `long:"no-ipv4" description:"Skip importing IPv4 routes"`
-	SkipV6      bool   `long:"no-ipv4" description:"Skip importing IPv6 routes"`
-	NextHop     net.IP `long:"nexthop" description:"Rewrite nexthop"`
+	Filename    string  `long:"filename" description:"MRT file name"`
+	RecordCount int64   `long:"count" description:"Number of records to inject"`
+	RecordSkip  int64   `long:"skip" description:"Number of records to skip before injecting"`
+	QueueSize   int     `long:"batch-size" description:"Maximum number of updates to keep queued"`
+	Best        bool    `long:"only-best" description:"only keep best path routes"`
+	SkipV4      bool    `long:"no-ipv4" description:"Skip importing IPv4 routes"`
+	SkipV6      bool    `long:"no-ipv4" description:"Skip importing IPv6 routes"`
+	NextHop     net.IP  `long:"nexthop" description:"Rewrite nexthop"`
+	PeerIndex   []uint  `long:"peer-index" description:"Indexes of the peers whose entries are injected"`
+	Speed       float64 `long:"speed" description:"Multiplier of the pace of the record timestamps"`
+	DryRun      bool    `long:"dry-run" description:"Parse the records without injecting"`
+}
+
+var modPathOpts struct {
+	FromFile  string `long:"from-file" description:"file of the routes, one per line, or - for stdin"`
+	BatchSize int    `long:"batch-size" description:"number of the routes sent in a request"`
+}
+
+var rtbhOpts struct {
+	Communities []string      `long:"community" description:"communities attached besides blackhole and no-export"`
+	Nexthop     string        `long:"nexthop" description:"next hop of the black hole route"`
+	Lifetime    time.Duration `long:"lifetime" description:"duration after which the route is withdrawn"`
+	Requester   string        `long:"requester" description:"who requests the route"`
+	Reason      string        `long:"reason" description:"why the route is requested"`
+}
+
+var ribDiffOpts struct {
+	IgnoredAttributes []string `long:"ignore-attribute" description:"path attributes not compared"`
 }
 
 var bmpOpts struct {
-	StatisticsTimeout int `short:"s" long:"statistics-timeout" description:"Interval for Statistics Report"`
+	StatisticsTimeout       int      `short:"s" long:"statistics-timeout" description:"Interval for Statistics Report"`
+	Mode                    string   `long:"mode" description:"active or passive"`
+	LocalAddress            string   `long:"local-address" description:"Address on which the passive mode listens"`
+	AuthPassword            string   `long:"auth-password" description:"Password of the TCP MD5 signature option"`
+	Transport               string   `long:"transport" description:"tcp or tls"`
+	CaFile                  string   `long:"ca-file" description:"CA certificates file to verify the BMP server"`
+	CertFile                string   `long:"cert-file" description:"Certificate file for TLS"`
+	KeyFile                 string   `long:"key-file" description:"Key file for TLS"`
+	ServerName              string   `long:"server-name" description:"Server name to verify the certificate of the BMP server"`
+	RouteMirroring          bool     `long:"route-mirroring" description:"Mirror the received BGP messages"`
+	RouteMirroringNeighbors []string `long:"route-mirroring-neighbors" description:"Neighbors whose messages are mirrored"`
+	RouteMirroringRateLimit uint32   `long:"route-mirroring-rate-limit" description:"Maximum number of the mirrored messages per second"`
 }
 
 func formatTimedelta(t time.Time) string {
@@ -421,6 +480,38 @@ var (
 		Afi:  api.Family_AFI_IP6,
 		Safi: api.Family_SAFI_MUP,
 	}
+	ipv4MVPN = &api.Family{
+		Afi:  api.Family_AFI_IP,
+		Safi: api.Family_SAFI_MCAST_VPN,
+	}
+	ipv6MVPN = &api.Family{
+		Afi:  api.Family_AFI_IP6,
+		Safi: api.Family_SAFI_MCAST_VPN,
+	}
+	ipv4CT = &api.Family{
+		Afi:  api.Family_AFI_IP,
+		Safi: api.Family_SAFI_CLASSFUL_TRANSPORT,
+	}
+	ipv6CT = &api.Family{
+		Afi:  api.Family_AFI_IP6,
+		Safi: api.Family_SAFI_CLASSFUL_TRANSPORT,
+	}
+	ipv4CAR = &api.Family{
+		Afi:  api.Family_AFI_IP,
+		Safi: api.Family_SAFI_CAR,
+	}
+	ipv6CAR = &api.Family{
+		Afi:  api.Family_AFI_IP6,
+		Safi: api.Family_SAFI_CAR,
+	}
+	ipv4SRPolicy = &api.Family{
+		Afi:  api.Family_AFI_IP,
+		Safi: api.Family_SAFI_SR_POLICY,
+	}
+	ipv6SRPolicy = &api.Family{
+		Afi:  api.Family_AFI_IP6,
+		Safi: api.Family_SAFI_SR_POLICY,
+	}
 )
 
 func checkAddressFamily(def *api.Family) (*api.Family, error) {
@@ -467,6 +558,22 @@ func checkAddressFamily(def *api.Family) (*api.Family, error) {
 		f = ipv4MUP
 	case "ipv6-mup", "mup-ipv6", "mup6":
 		f = ipv6MUP
+	case "ipv4-mvpn", "mvpn-ipv4", "mvpn4":
+		f = ipv4MVPN
+	case "ipv6-mvpn", "mvpn-ipv6", "mvpn6":
+		f = ipv6MVPN
+	case "ipv4-ct", "ct-ipv4", "ct4":
+		f = ipv4CT
+	case "ipv6-ct", "ct-ipv6", "ct6":
+		f = ipv6CT
+	case "ipv4-car", "car-ipv4", "car4":
+		f = ipv4CAR
+	case "ipv6-car", "car-ipv6", "car6":
+		f = ipv6CAR
+	case "ipv4-srpolicy", "srpolicy4":
+		f = ipv4SRPolicy
+	case "ipv6-srpolicy", "srpolicy6":
+		f = ipv6SRPolicy
 	case "":
 		f = def
 	default:
@@ -488,6 +595,9 @@ func printError(err error) {
 
 func exitWithError(err error) {
 	printError(err)
+	if inShell {
+		panic(shellCommandFailed{})
+	}
 	os.Exit(1)
 }
 
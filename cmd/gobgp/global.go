@@ -16,12 +16,15 @@
 package main
 
 import (
+	"bufio"
 	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net"
 	"net/netip"
+	"os"
 	"regexp"
 	"sort"
 	"strconv"
@@ -29,6 +32,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	api "github.com/osrg/gobgp/v3/api"
 	"github.com/osrg/gobgp/v3/internal/pkg/table"
@@ -57,6 +61,10 @@ const (
 	ctColor
 	ctLb
 	ctMup
+	ctDFElection
+	ctInterfaceSet
+	ctL2Attributes
+	ctTransportClass
 )
 
 var extCommNameMap = map[extCommType]string{
@@ -77,6 +85,10 @@ var extCommNameMap = map[extCommType]string{
 	ctColor:          "color",
 	ctLb:             "lb",
 	ctMup:            "mup",
+	ctDFElection:     "df-election",
+	ctInterfaceSet:   "interface-set",
+	ctL2Attributes:   "l2-attributes",
+	ctTransportClass: "transport-class",
 }
 
 var extCommValueMap = map[string]extCommType{
@@ -97,6 +109,10 @@ var extCommValueMap = map[string]extCommType{
 	extCommNameMap[ctColor]:          ctColor,
 	extCommNameMap[ctLb]:             ctLb,
 	extCommNameMap[ctMup]:            ctMup,
+	extCommNameMap[ctDFElection]:     ctDFElection,
+	extCommNameMap[ctInterfaceSet]:   ctInterfaceSet,
+	extCommNameMap[ctL2Attributes]:   ctL2Attributes,
+	extCommNameMap[ctTransportClass]: ctTransportClass,
 }
 
 func rateLimitParser(args []string) ([]bgp.ExtendedCommunityInterface, error) {
@@ -150,12 +166,27 @@ func markParser(args []string) ([]bgp.ExtendedCommunityInterface, error) {
 		return nil, fmt.Errorf("invalid mark")
 	}
 	dscp, err := strconv.ParseUint(args[1], 10, 8)
-	if err != nil {
+	if err != nil || dscp > 63 {
 		return nil, fmt.Errorf("invalid mark")
 	}
 	return []bgp.ExtendedCommunityInterface{bgp.NewTrafficRemarkExtended(uint8(dscp))}, nil
 }
 
+func interfaceSetParser(args []string) ([]bgp.ExtendedCommunityInterface, error) {
+	if len(args) < 2 || args[0] != extCommNameMap[ctInterfaceSet] {
+		return nil, fmt.Errorf("invalid interface-set")
+	}
+	exts := make([]bgp.ExtendedCommunityInterface, 0, len(args[1:]))
+	for _, arg := range args[1:] {
+		e, err := bgp.ParseInterfaceSetExtended(arg)
+		if err != nil {
+			return nil, err
+		}
+		exts = append(exts, e)
+	}
+	return exts, nil
+}
+
 func actionParser(args []string) ([]bgp.ExtendedCommunityInterface, error) {
 	if len(args) < 2 || args[0] != extCommNameMap[ctAction] {
 		return nil, fmt.Errorf("invalid action")
@@ -249,6 +280,32 @@ func esiLabelParser(args []string) ([]bgp.ExtendedCommunityInterface, error) {
 	return []bgp.ExtendedCommunityInterface{o}, nil
 }
 
+func l2AttributesParser(args []string) ([]bgp.ExtendedCommunityInterface, error) {
+	if len(args) < 2 || args[0] != extCommNameMap[ctL2Attributes] {
+		return nil, fmt.Errorf("invalid l2-attributes")
+	}
+	mtu, err := strconv.ParseUint(args[1], 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid l2-attributes mtu: %s", args[1])
+	}
+	var flags bgp.EvpnControlFlag
+	for _, arg := range args[2:] {
+		switch arg {
+		case "control-word":
+			flags |= bgp.CONTROL_WORD
+		case "flow-label":
+			flags |= bgp.FLOW_LABEL
+		case "primary-pe":
+			flags |= bgp.PRIMARY_PE
+		case "backup-pe":
+			flags |= bgp.BACKUP_PE
+		default:
+			return nil, fmt.Errorf("invalid l2-attributes flag: %s", arg)
+		}
+	}
+	return []bgp.ExtendedCommunityInterface{bgp.NewLayer2AttributesExtended(flags, uint16(mtu))}, nil
+}
+
 func routerMacParser(args []string) ([]bgp.ExtendedCommunityInterface, error) {
 	if len(args) < 2 || args[0] != extCommNameMap[ctRouterMAC] {
 		return nil, fmt.Errorf("invalid router's mac")
@@ -318,16 +375,51 @@ func mupParser(args []string) ([]bgp.ExtendedCommunityInterface, error) {
 	if len(args) != 2 || args[0] != extCommNameMap[ctMup] {
 		return nil, fmt.Errorf("invalid mup")
 	}
-	a := strings.Split(args[1], ":")
-	sid2, err := strconv.ParseUint(a[0], 10, 16)
+	e, err := bgp.ParseMUPExtended(args[1])
 	if err != nil {
-		return nil, fmt.Errorf("invalid mup segment ID")
+		return nil, err
+	}
+	return []bgp.ExtendedCommunityInterface{e}, nil
+}
+
+func dfElectionParser(args []string) ([]bgp.ExtendedCommunityInterface, error) {
+	if len(args) < 2 || args[0] != extCommNameMap[ctDFElection] {
+		return nil, fmt.Errorf("invalid df-election")
+	}
+	var algorithm bgp.DFAlgorithm
+	switch args[1] {
+	case "default":
+		algorithm = bgp.DF_ALGORITHM_DEFAULT
+	case "hrw":
+		algorithm = bgp.DF_ALGORITHM_HRW
+	default:
+		return nil, fmt.Errorf("invalid df-election algorithm: %s", args[1])
 	}
-	sid4, err := strconv.ParseUint(a[1], 10, 32)
+	var bitmap uint16
+	if len(args) > 2 {
+		if args[2] != "ac-df" {
+			return nil, fmt.Errorf("invalid df-election")
+		}
+		bitmap |= bgp.DF_ELECTION_CAPABILITY_AC_DF
+	}
+	return []bgp.ExtendedCommunityInterface{bgp.NewDFElectionExtended(algorithm, bitmap)}, nil
+}
+
+func transportClassParser(args []string) ([]bgp.ExtendedCommunityInterface, error) {
+	if len(args) < 2 || len(args) > 3 || args[0] != extCommNameMap[ctTransportClass] {
+		return nil, fmt.Errorf("invalid transport-class")
+	}
+	tc, err := strconv.ParseUint(args[1], 10, 32)
 	if err != nil {
-		return nil, fmt.Errorf("invalid mup segment ID")
+		return nil, fmt.Errorf("invalid transport-class")
 	}
-	return []bgp.ExtendedCommunityInterface{bgp.NewMUPExtended(uint16(sid2), uint32(sid4))}, nil
+	if len(args) == 3 {
+		if args[2] != "non-transitive" {
+			return nil, fmt.Errorf("invalid transport-class")
+		}
+		return []bgp.ExtendedCommunityInterface{bgp.NewNonTransitiveTransportClassExtended(uint32(tc))}, nil
+	}
+	return []bgp.ExtendedCommunityInterface{bgp.NewTransportClassExtended(uint32(tc))}, nil
 }
 
 var extCommParserMap = map[extCommType]func([]string) ([]bgp.ExtendedCommunityInterface, error){
@@ -348,6 +440,10 @@ var extCommParserMap = map[extCommType]func([]string) ([]bgp.ExtendedCommunityIn
 	ctColor:          colorParser,
 	ctLb:             lbParser,
 	ctMup:            mupParser,
+	ctDFElection:     dfElectionParser,
+	ctInterfaceSet:   interfaceSetParser,
+	ctL2Attributes:   l2AttributesParser,
+	ctTransportClass: transportClassParser,
 }
 
 func parseExtendedCommunities(args []string) ([]bgp.ExtendedCommunityInterface, error) {
@@ -458,19 +554,20 @@ func parseFlowSpecArgs(rf bgp.RouteFamily, args []string) (bgp.AddrPrefixInterfa
 
 func parseEvpnEthernetAutoDiscoveryArgs(args []string) (bgp.AddrPrefixInterface, []string, error) {
 	// Format:
-	// esi <esi> etag <etag> label <label> rd <rd> [rt <rt>...] [encap <encap type>] [esi-label <esi-label> [single-active | all-active]]
+	// esi <esi> etag <etag> label <label> rd <rd> [rt <rt>...] [encap <encap type>] [esi-label <esi-label> [single-active | all-active]] [l2-attributes <mtu> [control-word] [flow-label] [primary-pe | backup-pe]]
 	req := 8
 	if len(args) < req {
 		return nil, nil, fmt.Errorf("%d args required at least, but got %d", req, len(args))
 	}
 	m, err := extractReserved(args, map[string]int{
-		"esi":       paramList,
-		"etag":      paramSingle,
-		"label":     paramSingle,
-		"rd":        paramSingle,
-		"rt":        paramList,
-		"encap":     paramSingle,
-		"esi-label": paramList})
+		"esi":           paramList,
+		"etag":          paramSingle,
+		"label":         paramSingle,
+		"rd":            paramSingle,
+		"rt":            paramList,
+		"encap":         paramSingle,
+		"esi-label":     paramList,
+		"l2-attributes": paramList})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -514,6 +611,10 @@ func parseEvpnEthernetAutoDiscoveryArgs(args []string) (bgp.AddrPrefixInterface,
 		extcomms = append(extcomms, "esi-label")
 		extcomms = append(extcomms, m["esi-label"]...)
 	}
+	if len(m["l2-attributes"]) > 0 {
+		extcomms = append(extcomms, "l2-attributes")
+		extcomms = append(extcomms, m["l2-attributes"]...)
+	}
 
 	r := &bgp.EVPNEthernetAutoDiscoveryRoute{
 		RD:    rd,
@@ -725,16 +826,17 @@ func parseEvpnMulticastArgs(args []string) (bgp.AddrPrefixInterface, []string, e
 
 func parseEvpnEthernetSegmentArgs(args []string) (bgp.AddrPrefixInterface, []string, error) {
 	// Format:
-	// <ip address> esi <esi> rd <rd> [rt <rt>...] [encap <encap type>]
+	// <ip address> esi <esi> rd <rd> [rt <rt>...] [encap <encap type>] [df-election <algorithm> [ac-df]]
 	req := 5
 	if len(args) < req {
 		return nil, nil, fmt.Errorf("%d args required at least, but got %d", req, len(args))
 	}
 	m, err := extractReserved(args, map[string]int{
-		"esi":   paramList,
-		"rd":    paramSingle,
-		"rt":    paramList,
-		"encap": paramSingle})
+		"esi":         paramList,
+		"rd":          paramSingle,
+		"rt":          paramList,
+		"encap":       paramSingle,
+		"df-election": paramList})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -777,6 +879,10 @@ func parseEvpnEthernetSegmentArgs(args []string) (bgp.AddrPrefixInterface, []str
 	if len(m["encap"]) > 0 {
 		extcomms = append(extcomms, "encap", m["encap"][0])
 	}
+	if len(m["df-election"]) > 0 {
+		extcomms = append(extcomms, "df-election")
+		extcomms = append(extcomms, m["df-election"]...)
+	}
 
 	r := &bgp.EVPNEthernetSegmentRoute{
 		RD:              rd,
@@ -929,6 +1035,130 @@ func parseEvpnIPMSIArgs(args []string) (bgp.AddrPrefixInterface, []string, error
 	return bgp.NewEVPNNLRI(bgp.EVPN_I_PMSI, r), extcomms, nil
 }
 
+func parseEvpnMulticastFlags(args []string) (uint8, error) {
+	var flags uint8
+	for _, arg := range args {
+		switch arg {
+		case "v1":
+			flags |= bgp.EVPN_MULTICAST_FLAG_V1
+		case "v2":
+			flags |= bgp.EVPN_MULTICAST_FLAG_V2
+		case "v3":
+			flags |= bgp.EVPN_MULTICAST_FLAG_V3
+		case "exclude":
+			flags |= bgp.EVPN_MULTICAST_FLAG_EXCLUDE
+		default:
+			return 0, fmt.Errorf("invalid flag: %s", arg)
+		}
+	}
+	return flags, nil
+}
+
+func parseEvpnIgmpProxyArgs(routeType uint8, args []string) (bgp.AddrPrefixInterface, []string, error) {
+	// Format:
+	// smet:
+	// <group address> [source <source address>] etag <etag> ip <ip address> rd <rd> [rt <rt>...] [encap <encap type>] [flags <flag>...]
+	// join-sync:
+	// <group address> [source <source address>] esi <esi> etag <etag> ip <ip address> rd <rd> [rt <rt>...] [encap <encap type>] [flags <flag>...]
+	// leave-sync:
+	// <group address> [source <source address>] esi <esi> etag <etag> ip <ip address> sync <sync number> [max-response-time <time>] rd <rd> [rt <rt>...] [encap <encap type>] [flags <flag>...]
+	m, err := extractReserved(args, map[string]int{
+		"source":            paramSingle,
+		"esi":               paramList,
+		"etag":              paramSingle,
+		"ip":                paramSingle,
+		"sync":              paramSingle,
+		"max-response-time": paramSingle,
+		"rd":                paramSingle,
+		"rt":                paramList,
+		"encap":             paramSingle,
+		"flags":             paramList})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(m[""]) < 1 {
+		return nil, nil, fmt.Errorf("specify group address")
+	}
+	required := []string{"etag", "ip", "rd"}
+	switch routeType {
+	case bgp.EVPN_MULTICAST_JOIN_SYNCH:
+		required = append(required, "esi")
+	case bgp.EVPN_MULTICAST_LEAVE_SYNCH:
+		required = append(required, "esi", "sync")
+	}
+	for _, f := range required {
+		if len(m[f]) == 0 {
+			return nil, nil, fmt.Errorf("specify %s", f)
+		}
+	}
+
+	group := m[""][0]
+	if ip := net.ParseIP(group); ip == nil || !ip.IsMulticast() {
+		return nil, nil, fmt.Errorf("invalid group address: %s", group)
+	}
+	source := ""
+	if len(m["source"]) > 0 {
+		source = m["source"][0]
+		if net.ParseIP(source) == nil {
+			return nil, nil, fmt.Errorf("invalid source address: %s", source)
+		}
+	}
+	ip := m["ip"][0]
+	if net.ParseIP(ip) == nil {
+		return nil, nil, fmt.Errorf("invalid ip address: %s", ip)
+	}
+
+	e, err := strconv.ParseUint(m["etag"][0], 10, 32)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid etag: %s: %s", m["etag"][0], err)
+	}
+	etag := uint32(e)
+
+	rd, err := bgp.ParseRouteDistinguisher(m["rd"][0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	flags, err := parseEvpnMulticastFlags(m["flags"])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	extcomms := make([]string, 0)
+	if len(m["rt"]) > 0 {
+		extcomms = append(extcomms, "rt")
+		extcomms = append(extcomms, m["rt"]...)
+	}
+	if len(m["encap"]) > 0 {
+		extcomms = append(extcomms, "encap", m["encap"][0])
+	}
+
+	if routeType == bgp.EVPN_SELECTIVE_MULTICAST_ETHERNET_TAG {
+		return bgp.NewEVPNSelectiveMulticastEthernetTagRoute(rd, etag, source, group, ip, flags), extcomms, nil
+	}
+
+	esi, err := bgp.ParseEthernetSegmentIdentifier(m["esi"])
+	if err != nil {
+		return nil, nil, err
+	}
+	if routeType == bgp.EVPN_MULTICAST_JOIN_SYNCH {
+		return bgp.NewEVPNMulticastJoinSynchRoute(rd, esi, etag, source, group, ip, flags), extcomms, nil
+	}
+
+	sync, err := strconv.ParseUint(m["sync"][0], 10, 32)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid sync number: %s: %s", m["sync"][0], err)
+	}
+	var maxResponseTime uint64
+	if len(m["max-response-time"]) > 0 {
+		maxResponseTime, err = strconv.ParseUint(m["max-response-time"][0], 10, 8)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid max-response-time: %s: %s", m["max-response-time"][0], err)
+		}
+	}
+	return bgp.NewEVPNMulticastLeaveSynchRoute(rd, esi, etag, source, group, ip, uint32(sync), uint8(maxResponseTime), flags), extcomms, nil
+}
+
 func parseEvpnArgs(args []string) (bgp.AddrPrefixInterface, []string, error) {
 	if len(args) < 1 {
 		return nil, nil, fmt.Errorf("lack of args. need 1 but %d", len(args))
@@ -948,8 +1178,14 @@ func parseEvpnArgs(args []string) (bgp.AddrPrefixInterface, []string, error) {
 		return parseEvpnIPPrefixArgs(args)
 	case "i-pmsi":
 		return parseEvpnIPMSIArgs(args)
-	}
-	return nil, nil, fmt.Errorf("invalid subtype. expect [macadv|multicast|prefix] but %s", subtype)
+	case "smet":
+		return parseEvpnIgmpProxyArgs(bgp.EVPN_SELECTIVE_MULTICAST_ETHERNET_TAG, args)
+	case "join-sync":
+		return parseEvpnIgmpProxyArgs(bgp.EVPN_MULTICAST_JOIN_SYNCH, args)
+	case "leave-sync":
+		return parseEvpnIgmpProxyArgs(bgp.EVPN_MULTICAST_LEAVE_SYNCH, args)
+	}
+	return nil, nil, fmt.Errorf("invalid subtype. expect [macadv|multicast|prefix|smet|join-sync|leave-sync] but %s", subtype)
 }
 
 func parseMUPInterworkSegmentDiscoveryRouteArgs(args []string, afi uint16, nexthop string) (bgp.AddrPrefixInterface, *bgp.PathAttributePrefixSID, []string, error) {
@@ -1302,6 +1538,213 @@ func parseMUPArgs(args []string, afi uint16, nexthop string) (bgp.AddrPrefixInte
 	return nil, nil, nil, fmt.Errorf("invalid subtype. expect [isd|dsd|t1st|t2st] but %s", subtype)
 }
 
+// parseMVPNAddr parses the multicast source or group, "*" for the wildcard.
+func parseMVPNAddr(s string) (netip.Addr, error) {
+	if s == "*" {
+		return netip.Addr{}, nil
+	}
+	return netip.ParseAddr(s)
+}
+
+func parseMVPNArgs(args []string, afi uint16) (bgp.AddrPrefixInterface, []string, error) {
+	// Format:
+	// intra-as-i-pmsi-ad <originator> rd <rd> [rt <rt>...]
+	// inter-as-i-pmsi-ad <source as> rd <rd> [rt <rt>...]
+	// s-pmsi-ad <source> <group> originator <originator> rd <rd> [rt <rt>...]
+	// leaf-ad <originator> [rt <rt>...] key <route key>
+	// source-active-ad <source> <group> rd <rd> [rt <rt>...]
+	// shared-tree-join <rp> <group> source-as <source as> rd <rd> [rt <rt>...]
+	// source-tree-join <source> <group> source-as <source as> rd <rd> [rt <rt>...]
+	if len(args) < 1 {
+		return nil, nil, fmt.Errorf("lack of args. need 1 but %d", len(args))
+	}
+	subtype := args[0]
+	args = args[1:]
+	var key []string
+	if subtype == "leaf-ad" {
+		for i, arg := range args {
+			if arg == "key" {
+				args, key = args[:i], args[i+1:]
+				break
+			}
+		}
+		if len(key) == 0 {
+			return nil, nil, fmt.Errorf("specify key")
+		}
+	}
+	m, err := extractReserved(args, map[string]int{
+		"rd":         paramSingle,
+		"rt":         paramList,
+		"originator": paramSingle,
+		"source-as":  paramSingle,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	var values []string
+	var required []string
+	switch subtype {
+	case "intra-as-i-pmsi-ad", "inter-as-i-pmsi-ad":
+		values = []string{subtype}
+		required = []string{"rd"}
+	case "s-pmsi-ad":
+		values = []string{"source", "group"}
+		required = []string{"originator", "rd"}
+	case "leaf-ad":
+		values = []string{"originator"}
+	case "source-active-ad":
+		values = []string{"source", "group"}
+		required = []string{"rd"}
+	case "shared-tree-join":
+		values = []string{"rp", "group"}
+		required = []string{"source-as", "rd"}
+	case "source-tree-join":
+		values = []string{"source", "group"}
+		required = []string{"source-as", "rd"}
+	default:
+		return nil, nil, fmt.Errorf("invalid subtype. expect [intra-as-i-pmsi-ad|inter-as-i-pmsi-ad|s-pmsi-ad|leaf-ad|source-active-ad|shared-tree-join|source-tree-join] but %s", subtype)
+	}
+	if len(m[""]) != len(values) {
+		return nil, nil, fmt.Errorf("specify %s", strings.Join(values, " and "))
+	}
+	for _, f := range required {
+		if len(m[f]) == 0 {
+			return nil, nil, fmt.Errorf("specify %s", f)
+		}
+	}
+	var rd bgp.RouteDistinguisherInterface
+	if len(m["rd"]) > 0 {
+		if rd, err = bgp.ParseRouteDistinguisher(m["rd"][0]); err != nil {
+			return nil, nil, err
+		}
+	}
+	var sourceAS uint64
+	if len(m["source-as"]) > 0 {
+		if sourceAS, err = strconv.ParseUint(m["source-as"][0], 10, 32); err != nil {
+			return nil, nil, fmt.Errorf("invalid source-as: %s", m["source-as"][0])
+		}
+	}
+	var originator netip.Addr
+	if len(m["originator"]) > 0 {
+		if originator, err = netip.ParseAddr(m["originator"][0]); err != nil {
+			return nil, nil, err
+		}
+	}
+	extcomms := make([]string, 0)
+	if len(m["rt"]) > 0 {
+		extcomms = append(extcomms, "rt")
+		extcomms = append(extcomms, m["rt"]...)
+	}
+
+	var nlri *bgp.MVPNNLRI
+	switch subtype {
+	case "intra-as-i-pmsi-ad":
+		if originator, err = netip.ParseAddr(m[""][0]); err != nil {
+			return nil, nil, err
+		}
+		nlri = bgp.NewMVPNIntraASIPMSIADRoute(afi, rd, originator)
+	case "inter-as-i-pmsi-ad":
+		if sourceAS, err = strconv.ParseUint(m[""][0], 10, 32); err != nil {
+			return nil, nil, fmt.Errorf("invalid source as: %s", m[""][0])
+		}
+		nlri = bgp.NewMVPNInterASIPMSIADRoute(afi, rd, uint32(sourceAS))
+	case "leaf-ad":
+		if originator, err = netip.ParseAddr(m[""][0]); err != nil {
+			return nil, nil, err
+		}
+		k, keyExtcomms, err := parseMVPNArgs(key, afi)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid key: %s", err)
+		}
+		if len(keyExtcomms) > 0 {
+			return nil, nil, fmt.Errorf("specify rt before key")
+		}
+		nlri = bgp.NewMVPNLeafADRoute(afi, k.(*bgp.MVPNNLRI), originator)
+	default:
+		source, err := parseMVPNAddr(m[""][0])
+		if err != nil {
+			return nil, nil, err
+		}
+		group, err := parseMVPNAddr(m[""][1])
+		if err != nil {
+			return nil, nil, err
+		}
+		switch subtype {
+		case "s-pmsi-ad":
+			nlri = bgp.NewMVPNSPMSIADRoute(afi, rd, source, group, originator)
+		case "source-active-ad":
+			if !source.IsValid() || !group.IsValid() {
+				return nil, nil, fmt.Errorf("wildcard isn't allowed for %s", subtype)
+			}
+			nlri = bgp.NewMVPNSourceActiveADRoute(afi, rd, source, group)
+		case "shared-tree-join":
+			if !source.IsValid() {
+				return nil, nil, fmt.Errorf("specify rp")
+			}
+			nlri = bgp.NewMVPNSharedTreeJoinRoute(afi, rd, uint32(sourceAS), source, group)
+		case "source-tree-join":
+			nlri = bgp.NewMVPNSourceTreeJoinRoute(afi, rd, uint32(sourceAS), source, group)
+		}
+	}
+	return nlri, extcomms, nil
+}
+
+func parseCARArgs(args []string, afi uint16) (bgp.AddrPrefixInterface, []string, error) {
+	// Format:
+	// <prefix> color <color> [label <label>] [label-index <index>] [srv6-sid <sid>] [identifier <id>] [rt <rt>...]
+	if len(args) < 3 {
+		return nil, nil, fmt.Errorf("lack of args. need 3 but %d", len(args))
+	}
+	length, addr, color, err := bgp.ParseCARPrefix(strings.Join(args[:3], " "))
+	if err != nil {
+		return nil, nil, err
+	}
+	if (afi == bgp.AFI_IP) != (addr.To4() != nil) {
+		return nil, nil, fmt.Errorf("invalid prefix: %s", args[0])
+	}
+	args = args[3:]
+	tlvs := make([]bgp.CARTLVInterface, 0)
+	var id *uint32
+loop:
+	for len(args) > 1 {
+		switch args[0] {
+		case "label":
+			mpls, err := bgp.ParseMPLSLabelStack(args[1])
+			if err != nil {
+				return nil, nil, err
+			}
+			tlvs = append(tlvs, &bgp.CARLabelTLV{Labels: mpls.Labels})
+		case "label-index":
+			index, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid label index: %s", args[1])
+			}
+			tlvs = append(tlvs, &bgp.CARLabelIndexTLV{LabelIndex: uint32(index)})
+		case "srv6-sid":
+			sid := net.ParseIP(args[1])
+			if sid == nil || sid.To4() != nil {
+				return nil, nil, fmt.Errorf("invalid srv6 sid: %s", args[1])
+			}
+			tlvs = append(tlvs, &bgp.CARSRv6SIDTLV{SID: sid})
+		case "identifier":
+			v, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid format")
+			}
+			i := uint32(v)
+			id = &i
+		default:
+			break loop
+		}
+		args = args[2:]
+	}
+	nlri := bgp.NewCARColorAwareRoute(afi, length, addr.String(), color, tlvs...)
+	if id != nil {
+		nlri.SetPathIdentifier(*id)
+	}
+	return nlri, args, nil
+}
+
 func parseLsLinkProtocol(args []string, afi uint16) (bgp.AddrPrefixInterface, *bgp.PathAttributeLs, error) {
 	if len(args) < 2 {
 		return nil, nil, fmt.Errorf("lack of protocolType")
@@ -1561,6 +2004,144 @@ func parseLsArgs(args []string, afi uint16) (bgp.AddrPrefixInterface, *bgp.PathA
 	return nil, nil, fmt.Errorf("invalid nlriType. expect [link] but %s", nlriType)
 }
 
+func parseSRPolicySID(s string) ([]byte, error) {
+	if ip := net.ParseIP(s); ip != nil && ip.To4() == nil {
+		return ip.To16(), nil
+	}
+	label, err := strconv.ParseUint(s, 10, 20)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sid: %s", s)
+	}
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, uint32(label))
+	return b, nil
+}
+
+func parseSRPolicyArgs(args []string, afi uint16) (bgp.AddrPrefixInterface, *bgp.PathAttributeTunnelEncap, []string, error) {
+	// Format:
+	// <endpoint> color <color> [distinguisher <distinguisher>] [preference <preference>] [priority <priority>]
+	// [name <name>] [bsid <sid>] [enlp <enlp>] [segment-list [weight <weight>] <sid>...]... [rt <rt>...]
+	if len(args) < 3 {
+		return nil, nil, nil, fmt.Errorf("3 args required at least, but got %d", len(args))
+	}
+	endpoint := net.ParseIP(args[0])
+	if endpoint == nil || (afi == bgp.AFI_IP) != (endpoint.To4() != nil) {
+		return nil, nil, nil, fmt.Errorf("invalid endpoint: %s", args[0])
+	}
+	args = args[1:]
+
+	var extcomms []string
+	for i, arg := range args {
+		if arg == "rt" {
+			extcomms = args[i:]
+			args = args[:i]
+			break
+		}
+	}
+	lists := make([][]string, 0)
+	for i := len(args) - 1; i >= 0; i-- {
+		if args[i] == "segment-list" {
+			lists = append([][]string{args[i+1:]}, lists...)
+			args = args[:i]
+		}
+	}
+	m, err := extractReserved(args, map[string]int{
+		"color":         paramSingle,
+		"distinguisher": paramSingle,
+		"preference":    paramSingle,
+		"priority":      paramSingle,
+		"name":          paramSingle,
+		"bsid":          paramSingle,
+		"enlp":          paramSingle,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(m[""]) > 0 {
+		return nil, nil, nil, fmt.Errorf("invalid args: %s", strings.Join(m[""], " "))
+	}
+	if len(m["color"]) == 0 {
+		return nil, nil, nil, fmt.Errorf("specify color")
+	}
+	color, err := strconv.ParseUint(m["color"][0], 10, 32)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid color: %s", m["color"][0])
+	}
+	// RFC 9830 defaults the preference to 100
+	var distinguisher, priority uint64
+	preference := uint64(100)
+	for k, v := range map[string]*uint64{"distinguisher": &distinguisher, "preference": &preference} {
+		if len(m[k]) > 0 {
+			if *v, err = strconv.ParseUint(m[k][0], 10, 32); err != nil {
+				return nil, nil, nil, fmt.Errorf("invalid %s: %s", k, m[k][0])
+			}
+		}
+	}
+
+	subTlvs := []bgp.TunnelEncapSubTLVInterface{bgp.NewTunnelEncapSubTLVSRPreference(0, uint32(preference))}
+	if len(m["priority"]) > 0 {
+		if priority, err = strconv.ParseUint(m["priority"][0], 10, 8); err != nil {
+			return nil, nil, nil, fmt.Errorf("invalid priority: %s", m["priority"][0])
+		}
+		subTlvs = append(subTlvs, bgp.NewTunnelEncapSubTLVSRPriority(uint8(priority)))
+	}
+	if len(m["name"]) > 0 {
+		subTlvs = append(subTlvs, bgp.NewTunnelEncapSubTLVSRCandidatePathName(m["name"][0]))
+	}
+	if len(m["bsid"]) > 0 {
+		sid, err := parseSRPolicySID(m["bsid"][0])
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		bsid, err := bgp.NewBSID(sid)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		subTlvs = append(subTlvs, bgp.NewTunnelEncapSubTLVSRBSID(0, bsid))
+	}
+	if len(m["enlp"]) > 0 {
+		enlp, err := strconv.ParseUint(m["enlp"][0], 10, 8)
+		if err != nil || enlp < uint64(bgp.ENLPType1) || enlp > uint64(bgp.ENLPType4) {
+			return nil, nil, nil, fmt.Errorf("invalid enlp: %s", m["enlp"][0])
+		}
+		subTlvs = append(subTlvs, bgp.NewTunnelEncapSubTLVSRENLP(0, bgp.SRENLPValue(enlp)))
+	}
+	for _, l := range lists {
+		weight := uint64(1)
+		if len(l) > 1 && l[0] == "weight" {
+			if weight, err = strconv.ParseUint(l[1], 10, 32); err != nil {
+				return nil, nil, nil, fmt.Errorf("invalid weight: %s", l[1])
+			}
+			l = l[2:]
+		}
+		if len(l) == 0 {
+			return nil, nil, nil, fmt.Errorf("specify segments of segment-list")
+		}
+		segments := make([]bgp.TunnelEncapSubTLVInterface, 0, len(l))
+		for _, s := range l {
+			sid, err := parseSRPolicySID(s)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			if len(sid) == net.IPv6len {
+				segments = append(segments, bgp.NewSegmentTypeB(0, sid, nil))
+			} else {
+				segments = append(segments, bgp.NewSegmentTypeA(0, binary.BigEndian.Uint32(sid)))
+			}
+		}
+		subTlvs = append(subTlvs, bgp.NewTunnelEncapSubTLVSRSegmentList(uint32(weight), segments))
+	}
+
+	var nlri bgp.AddrPrefixInterface
+	if afi == bgp.AFI_IP {
+		nlri = bgp.NewSRPolicyIPv4(bgp.SRPolicyIPv4NLRILen, uint32(distinguisher), uint32(color), endpoint.To4())
+	} else {
+		nlri = bgp.NewSRPolicyIPv6(bgp.SRPolicyIPv6NLRILen, uint32(distinguisher), uint32(color), endpoint.To16())
+	}
+	tunnel := bgp.NewPathAttributeTunnelEncap([]*bgp.TunnelEncapTLV{bgp.NewTunnelEncapTLV(bgp.TUNNEL_TYPE_SR_POLICY, subTlvs)})
+	return nlri, tunnel, extcomms, nil
+}
+
 func extractOrigin(args []string) ([]string, bgp.PathAttributeInterface, error) {
 	typ := bgp.BGP_ORIGIN_ATTR_TYPE_INCOMPLETE
 	for idx, arg := range args {
@@ -1803,6 +2384,7 @@ func parsePath(rf bgp.RouteFamily, args []string) (*api.Path, error) {
 	var extcomms []string
 	var psid *bgp.PathAttributePrefixSID
 	var ls *bgp.PathAttributeLs
+	var tunnel *bgp.PathAttributeTunnelEncap
 	var err error
 	attrs := make([]bgp.PathAttributeInterface, 0, 1)
 
@@ -1867,7 +2449,7 @@ func parsePath(rf bgp.RouteFamily, args []string) (*api.Path, error) {
 			extcomms = args[1:]
 		}
 
-	case bgp.RF_IPv4_VPN, bgp.RF_IPv6_VPN:
+	case bgp.RF_IPv4_VPN, bgp.RF_IPv6_VPN, bgp.RF_CT_IPv4, bgp.RF_CT_IPv6:
 		if len(args) < 5 || args[1] != "label" || args[3] != "rd" {
 			return nil, fmt.Errorf("invalid format")
 		}
@@ -1888,16 +2470,23 @@ func parsePath(rf bgp.RouteFamily, args []string) (*api.Path, error) {
 			return nil, err
 		}
 
-		if rf == bgp.RF_IPv4_VPN {
+		if rf == bgp.RF_IPv4_VPN || rf == bgp.RF_CT_IPv4 {
 			if ip.To4() == nil {
 				return nil, fmt.Errorf("invalid ipv4 prefix")
 			}
+		} else if ip.To16() == nil {
+			return nil, fmt.Errorf("invalid ipv6 prefix")
+		}
+
+		switch rf {
+		case bgp.RF_IPv4_VPN:
 			nlri = bgp.NewLabeledVPNIPAddrPrefix(uint8(ones), ip.String(), *mpls, rd)
-		} else {
-			if ip.To16() == nil {
-				return nil, fmt.Errorf("invalid ipv6 prefix")
-			}
+		case bgp.RF_IPv6_VPN:
 			nlri = bgp.NewLabeledVPNIPv6AddrPrefix(uint8(ones), ip.String(), *mpls, rd)
+		case bgp.RF_CT_IPv4:
+			nlri = bgp.NewCTIPAddrPrefix(uint8(ones), ip.String(), *mpls, rd)
+		case bgp.RF_CT_IPv6:
+			nlri = bgp.NewCTIPv6AddrPrefix(uint8(ones), ip.String(), *mpls, rd)
 		}
 
 		args = args[5:]
@@ -1964,8 +2553,20 @@ func parsePath(rf bgp.RouteFamily, args []string) (*api.Path, error) {
 		nlri, psid, extcomms, err = parseMUPArgs(args, bgp.AFI_IP, nexthop)
 	case bgp.RF_MUP_IPv6:
 		nlri, psid, extcomms, err = parseMUPArgs(args, bgp.AFI_IP6, nexthop)
+	case bgp.RF_MVPN_IPv4:
+		nlri, extcomms, err = parseMVPNArgs(args, bgp.AFI_IP)
+	case bgp.RF_MVPN_IPv6:
+		nlri, extcomms, err = parseMVPNArgs(args, bgp.AFI_IP6)
+	case bgp.RF_CAR_IPv4:
+		nlri, extcomms, err = parseCARArgs(args, bgp.AFI_IP)
+	case bgp.RF_CAR_IPv6:
+		nlri, extcomms, err = parseCARArgs(args, bgp.AFI_IP6)
 	case bgp.RF_LS:
 		nlri, ls, err = parseLsArgs(args, bgp.AFI_LS)
+	case bgp.RF_SR_POLICY_IPv4:
+		nlri, tunnel, extcomms, err = parseSRPolicyArgs(args, bgp.AFI_IP)
+	case bgp.RF_SR_POLICY_IPv6:
+		nlri, tunnel, extcomms, err = parseSRPolicyArgs(args, bgp.AFI_IP6)
 	default:
 		return nil, fmt.Errorf("unsupported route family: %s", rf)
 	}
@@ -1975,6 +2576,9 @@ func parsePath(rf bgp.RouteFamily, args []string) (*api.Path, error) {
 	if ls != nil {
 		attrs = append(attrs, ls)
 	}
+	if tunnel != nil {
+		attrs = append(attrs, tunnel)
+	}
 
 	if rf == bgp.RF_IPv4_UC && net.ParseIP(nexthop).To4() != nil {
 		attrs = append(attrs, bgp.NewPathAttributeNextHop(nexthop))
@@ -2025,6 +2629,12 @@ func modPath(resource string, name, modtype string, args []string) error {
 	if err != nil {
 		return err
 	}
+	if modPathOpts.FromFile != "" {
+		if len(args) != 0 {
+			return fmt.Errorf("usage: gobgp %s rib %s --from-file <FILE> [--batch-size <NUM>] [-a <address family>]", resource, modtype)
+		}
+		return modPathFromFile(resource, name, modtype, f)
+	}
 	rf := apiutil.ToRouteFamily(f)
 	path, err := parsePath(rf, args)
 	if err != nil {
@@ -2057,7 +2667,7 @@ func modPath(resource string, name, modtype string, args []string) error {
 		baseHelpMsgFmt := fmt.Sprintf(`error: %s
 usage: %s rib -a %%s %s <PREFIX> %%s [origin { igp | egp | incomplete }] [aspath <ASPATH>] [nexthop <ADDRESS>] [med <NUM>] [local-pref <NUM>] [community <COMMUNITY>] [aigp metric <NUM>] [large-community <LARGE_COMMUNITY>] [aggregator <AGGREGATOR>]
     <ASPATH>: <AS>[,<AS>],
-    <COMMUNITY>: xxx:xxx|internet|planned-shut|accept-own|route-filter-translated-v4|route-filter-v4|route-filter-translated-v6|route-filter-v6|llgr-stale|no-llgr|blackhole|no-export|no-advertise|no-export-subconfed|no-peer,
+    <COMMUNITY>: xxx:xxx|internet|graceful-shutdown|accept-own|route-filter-translated-v4|route-filter-v4|route-filter-translated-v6|route-filter-v6|llgr-stale|no-llgr|blackhole|no-export|no-advertise|no-export-subconfed|no-peer,
     <LARGE_COMMUNITY>: xxx:xxx:xxx[,<LARGE_COMMUNITY>],
     <AGGREGATOR>: <AS>:<ADDRESS>`,
 			err,
@@ -2070,6 +2680,10 @@ usage: %s rib -a %%s %s <PREFIX> %%s [origin { igp | egp | incomplete }] [aspath
 		helpErrMap[bgp.RF_IPv6_UC] = fmt.Errorf(baseHelpMsgFmt, "ipv6", "[identifier <VALUE>]")
 		helpErrMap[bgp.RF_IPv4_VPN] = fmt.Errorf(baseHelpMsgFmt, "vpnv4", "label <LABEL> rd <RD> [rt <RT>]")
 		helpErrMap[bgp.RF_IPv6_VPN] = fmt.Errorf(baseHelpMsgFmt, "vpnv6", "label <LABEL> rd <RD> [rt <RT>]")
+		helpErrMap[bgp.RF_CT_IPv4] = fmt.Errorf(baseHelpMsgFmt, "ipv4-ct", "label <LABEL> rd <RD> [rt <RT>] [transport-class <TC> [non-transitive]]")
+		helpErrMap[bgp.RF_CT_IPv6] = fmt.Errorf(baseHelpMsgFmt, "ipv6-ct", "label <LABEL> rd <RD> [rt <RT>] [transport-class <TC> [non-transitive]]")
+		helpErrMap[bgp.RF_CAR_IPv4] = fmt.Errorf(baseHelpMsgFmt, "ipv4-car", "color <COLOR> [label <LABEL>[/<LABEL>...]] [label-index <INDEX>] [srv6-sid <SID>] [identifier <VALUE>] [rt <RT>]")
+		helpErrMap[bgp.RF_CAR_IPv6] = fmt.Errorf(baseHelpMsgFmt, "ipv6-car", "color <COLOR> [label <LABEL>[/<LABEL>...]] [label-index <INDEX>] [srv6-sid <SID>] [identifier <VALUE>] [rt <RT>]")
 		helpErrMap[bgp.RF_IPv4_MPLS] = fmt.Errorf(baseHelpMsgFmt, "ipv4-mpls", "<LABEL>")
 		helpErrMap[bgp.RF_IPv6_MPLS] = fmt.Errorf(baseHelpMsgFmt, "ipv6-mpls", "<LABEL>")
 
@@ -2079,9 +2693,12 @@ usage: %s rib -a %%s %s%%s match <MATCH> then <THEN>%%s%%s%%s
                %s |
                %s <RATE> [as <AS>] |
                %s <RT> |
-               %s <DEC_NUM> |
-               %s { sample | terminal | sample-terminal } }...
-    <RT> : xxx:yyy, xxx.xxx.xxx.xxx:yyy, xxxx::xxxx:yyy, xxx.xxx:yyy`,
+               %s <DSCP> |
+               %s { sample | terminal | sample-terminal } |
+               %s <INTERFACE_SET>... }...
+    <RT> : xxx:yyy, xxx.xxx.xxx.xxx:yyy, xxxx::xxxx:yyy, xxx.xxx:yyy
+    <DSCP> : 0-63
+    <INTERFACE_SET> : <AS>:<GROUP_ID>[:{ in | out | both }]`,
 			err,
 			cmdstr,
 			// <address family>
@@ -2096,10 +2713,11 @@ usage: %s rib -a %%s %s%%s match <MATCH> then <THEN>%%s%%s%%s
 			extCommNameMap[ctRedirect],
 			extCommNameMap[ctMark],
 			extCommNameMap[ctAction],
+			extCommNameMap[ctInterfaceSet],
 		)
 		baseFsMatchExpr := fmt.Sprintf(`
-    <MATCH> : { %s <PREFIX> [<OFFSET>] |
-                %s <PREFIX> [<OFFSET>] |
+    <MATCH> : { %s <PREFIX>%%s |
+                %s <PREFIX>%%s |
                 %s <PROTOCOLS>... |
                 %s <FRAGMENTS>... |
                 %s <TCP_FLAGS>... |
@@ -2113,7 +2731,7 @@ usage: %s rib -a %%s %s%%s match <MATCH> then <THEN>%%s%%s%%s
     <PROTOCOLS> : [&] [<|<=|>|>=|==|!=] <PROTOCOL>
     <PROTOCOL> : %s
     <FRAGMENTS> : [&] [=|!|!=] <FRAGMENT>
-    <FRAGMENT> : dont-fragment, is-fragment, first-fragment, last-fragment, not-a-fragment
+    <FRAGMENT> : %%s
     <TCP_FLAGS> : [&] [=|!|!=] <TCP_FLAG>
     <TCP_FLAG> : %s%%s
     <ITEM> : [&] [<|<=|>|>=|==|!=] <DEC_NUM>`,
@@ -2134,11 +2752,15 @@ usage: %s rib -a %%s %s%%s match <MATCH> then <THEN>%%s%%s%%s
 			tcpFlags,
 			// <additional help messages if exists>
 		)
-		ipv4FsMatchExpr := fmt.Sprintf(baseFsMatchExpr, "", "")
-		ipv6FsMatchExpr := fmt.Sprintf(baseFsMatchExpr, fmt.Sprintf(`|
+		// the prefix offset is only for IPv6 and IPv6 doesn't have the don't
+		// fragment bit
+		fragments := "dont-fragment, is-fragment, first-fragment, last-fragment, not-a-fragment"
+		ipv6Fragments := "is-fragment, first-fragment, last-fragment, not-a-fragment"
+		ipv4FsMatchExpr := fmt.Sprintf(baseFsMatchExpr, "", "", "", fragments, "")
+		ipv6FsMatchExpr := fmt.Sprintf(baseFsMatchExpr, " [<OFFSET>]", " [<OFFSET>]", fmt.Sprintf(`|
                 %s <ITEM>... `,
-			bgp.FlowSpecNameMap[bgp.FLOW_SPEC_TYPE_LABEL]), "")
-		l2vpnFsMatchExpr := fmt.Sprintf(baseFsMatchExpr, fmt.Sprintf(`|
+			bgp.FlowSpecNameMap[bgp.FLOW_SPEC_TYPE_LABEL]), ipv6Fragments, "")
+		l2vpnFsMatchExpr := fmt.Sprintf(baseFsMatchExpr, "", "", fmt.Sprintf(`|
                 %s <ITEM>... |
                 %s <MAC_ADDRESS> |
                 %s <MAC_ADDRESS> |
@@ -2162,7 +2784,7 @@ usage: %s rib -a %%s %s%%s match <MATCH> then <THEN>%%s%%s%%s
 			bgp.FlowSpecNameMap[bgp.FLOW_SPEC_TYPE_VID],
 			bgp.FlowSpecNameMap[bgp.FLOW_SPEC_TYPE_COS],
 			bgp.FlowSpecNameMap[bgp.FLOW_SPEC_TYPE_INNER_VID],
-			bgp.FlowSpecNameMap[bgp.FLOW_SPEC_TYPE_INNER_COS]), fmt.Sprintf(`
+			bgp.FlowSpecNameMap[bgp.FLOW_SPEC_TYPE_INNER_COS]), fragments, fmt.Sprintf(`
     <ETHER_TYPES> : [&] [<|<=|>|>=|==|!=] <ETHER_TYPE>
     <ETHER_TYPE> : %s`,
 			etherTypes))
@@ -2172,12 +2794,15 @@ usage: %s rib -a %%s %s%%s match <MATCH> then <THEN>%%s%%s%%s
 		helpErrMap[bgp.RF_FS_IPv6_VPN] = fmt.Errorf(fsHelpMsgFmt, "ipv6-l3vpn-flowspec", " rd <RD>", " [rt <RT>]", rdHelpMsgFmt, ipv6FsMatchExpr)
 		helpErrMap[bgp.RF_FS_L2_VPN] = fmt.Errorf(fsHelpMsgFmt, "l2vpn-flowspec", " rd <RD>", " [rt <RT>]", rdHelpMsgFmt, l2vpnFsMatchExpr)
 		helpErrMap[bgp.RF_EVPN] = fmt.Errorf(`error: %s
-usage: %s rib %s { a-d <A-D> | macadv <MACADV> | multicast <MULTICAST> | esi <ESI> | prefix <PREFIX> } -a evpn
-    <A-D>       : esi <esi> etag <etag> label <label> rd <rd> [rt <rt>...] [encap <encap type>] [esi-label <esi-label> [single-active | all-active]]
+usage: %s rib %s { a-d <A-D> | macadv <MACADV> | multicast <MULTICAST> | esi <ESI> | prefix <PREFIX> | smet <SMET> | join-sync <JOIN> | leave-sync <LEAVE> } -a evpn
+    <A-D>       : esi <esi> etag <etag> label <label> rd <rd> [rt <rt>...] [encap <encap type>] [esi-label <esi-label> [single-active | all-active]] [l2-attributes <mtu> [control-word] [flow-label] [primary-pe | backup-pe]]
     <MACADV>    : <mac address> <ip address> [esi <esi>] etag <etag> label <label> rd <rd> [rt <rt>...] [encap <encap type>] [router-mac <mac address>] [default-gateway]
     <MULTICAST> : <ip address> etag <etag> rd <rd> [rt <rt>...] [encap <encap type>] [pmsi <type> [leaf-info-required] <label> <tunnel-id>]
-    <ESI>       : <ip address> esi <esi> rd <rd> [rt <rt>...] [encap <encap type>]
-    <PREFIX>    : <ip prefix> [gw <gateway>] [esi <esi>] etag <etag> [label <label>] rd <rd> [rt <rt>...] [encap <encap type>] [router-mac <mac address>]`,
+    <ESI>       : <ip address> esi <esi> rd <rd> [rt <rt>...] [encap <encap type>] [df-election <default|hrw> [ac-df]]
+    <PREFIX>    : <ip prefix> [gw <gateway>] [esi <esi>] etag <etag> [label <label>] rd <rd> [rt <rt>...] [encap <encap type>] [router-mac <mac address>]
+    <SMET>      : <group address> [source <source address>] etag <etag> ip <ip address> rd <rd> [rt <rt>...] [encap <encap type>] [flags { v1 | v2 | v3 | exclude }...]
+    <JOIN>      : <group address> [source <source address>] esi <esi> etag <etag> ip <ip address> rd <rd> [rt <rt>...] [encap <encap type>] [flags { v1 | v2 | v3 | exclude }...]
+    <LEAVE>     : <group address> [source <source address>] esi <esi> etag <etag> ip <ip address> sync <sync number> [max-response-time <time>] rd <rd> [rt <rt>...] [encap <encap type>] [flags { v1 | v2 | v3 | exclude }...]`,
 			err,
 			cmdstr,
 			modtype,
@@ -2202,12 +2827,33 @@ usage: %s rib %s { isd <ISD> | dsd <DSD> | t1st <T1ST> | t2st <T2ST> } -a mup-ip
 			cmdstr,
 			modtype,
 		)
+		mvpnHelpMsgFmt := `error: %s
+usage: %s rib %s { intra-as-i-pmsi-ad <INTRA> | inter-as-i-pmsi-ad <INTER> | s-pmsi-ad <S-PMSI> | leaf-ad <LEAF> | source-active-ad <SA> | shared-tree-join <SHARED> | source-tree-join <SOURCE> } -a %s
+    <INTRA>  : <originator> rd <rd> [rt <rt>...] [pmsi <type> [leaf-info-required] <label> <tunnel-id>]
+    <INTER>  : <source as> rd <rd> [rt <rt>...] [pmsi <type> [leaf-info-required] <label> <tunnel-id>]
+    <S-PMSI> : { <source> | * } { <group> | * } originator <originator> rd <rd> [rt <rt>...] [pmsi <type> [leaf-info-required] <label> <tunnel-id>]
+    <LEAF>   : <originator> [rt <rt>...] key { s-pmsi-ad <S-PMSI> | inter-as-i-pmsi-ad <INTER> }
+    <SA>     : <source> <group> rd <rd> [rt <rt>...]
+    <SHARED> : <rp> { <group> | * } source-as <source as> rd <rd> [rt <rt>...]
+    <SOURCE> : { <source> | * } { <group> | * } source-as <source as> rd <rd> [rt <rt>...]`
+		helpErrMap[bgp.RF_MVPN_IPv4] = fmt.Errorf(mvpnHelpMsgFmt, err, cmdstr, modtype, "ipv4-mvpn")
+		helpErrMap[bgp.RF_MVPN_IPv6] = fmt.Errorf(mvpnHelpMsgFmt, err, cmdstr, modtype, "ipv6-mvpn")
 		helpErrMap[bgp.RF_OPAQUE] = fmt.Errorf(`error: %s
 usage: %s rib %s key <KEY> [value <VALUE>]`,
 			err,
 			cmdstr,
 			modtype,
 		)
+		srPolicyHelpMsgFmt := fmt.Sprintf(`error: %s
+usage: %s rib -a %%s %s <ENDPOINT> color <COLOR> [distinguisher <DISTINGUISHER>] [preference <PREFERENCE>] [priority <PRIORITY>] [name <NAME>] [bsid <SID>] [enlp <ENLP>] [segment-list [weight <WEIGHT>] <SID>...]... [rt <RT>...] [nexthop <ADDRESS>]
+    <SID>  : <LABEL> | <SRv6 SID>
+    <ENLP> : 1-4`,
+			err,
+			cmdstr,
+			modtype,
+		)
+		helpErrMap[bgp.RF_SR_POLICY_IPv4] = fmt.Errorf(srPolicyHelpMsgFmt, "ipv4-srpolicy")
+		helpErrMap[bgp.RF_SR_POLICY_IPv6] = fmt.Errorf(srPolicyHelpMsgFmt, "ipv6-srpolicy")
 		if err, ok := helpErrMap[rf]; ok {
 			return err
 		}
@@ -2235,6 +2881,94 @@ usage: %s rib %s key <KEY> [value <VALUE>]`,
 	return err
 }
 
+const defaultModPathBatchSize = 1000
+
+// readPaths reads the routes from r, one per line, and calls fn with them
+// in batches of batchSize. A line is either the arguments of "rib add",
+// e.g. "10.0.0.0/24 nexthop 10.0.0.1 med 10", or the JSON of api.Path.
+// The blank lines and the ones starting with '#' are skipped.
+func readPaths(r io.Reader, rf bgp.RouteFamily, batchSize int, fn func([]*api.Path) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	paths := make([]*api.Path, 0, batchSize)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		var path *api.Path
+		if strings.HasPrefix(line, "{") {
+			path = &api.Path{}
+			if err := protojson.Unmarshal([]byte(line), path); err != nil {
+				return fmt.Errorf("line %d: %s", n, err)
+			}
+			if path.Family == nil {
+				path.Family = apiutil.ToApiFamily(bgp.RouteFamilyToAfiSafi(rf))
+			}
+		} else {
+			var err error
+			if path, err = parsePath(rf, strings.Fields(line)); err != nil {
+				return fmt.Errorf("line %d: %s", n, err)
+			}
+		}
+		paths = append(paths, path)
+		if len(paths) == batchSize {
+			if err := fn(paths); err != nil {
+				return err
+			}
+			paths = make([]*api.Path, 0, batchSize)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if len(paths) > 0 {
+		return fn(paths)
+	}
+	return nil
+}
+
+func modPathFromFile(resource string, name, modtype string, f *api.Family) error {
+	r := os.Stdin
+	if modPathOpts.FromFile != "-" {
+		file, err := os.Open(modPathOpts.FromFile)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		r = file
+	}
+	batchSize := modPathOpts.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultModPathBatchSize
+	}
+
+	t := api.TableType_GLOBAL
+	if resource == cmdVRF {
+		t = api.TableType_VRF
+	}
+	stream, err := client.AddPathStream(ctx)
+	if err != nil {
+		return err
+	}
+	err = readPaths(r, apiutil.ToRouteFamily(f), batchSize, func(paths []*api.Path) error {
+		for _, path := range paths {
+			path.IsWithdraw = modtype == cmdDel
+		}
+		return stream.Send(&api.AddPathStreamRequest{
+			TableType: t,
+			VrfId:     name,
+			Paths:     paths,
+		})
+	})
+	if err != nil {
+		stream.CloseSend()
+		return err
+	}
+	_, err = stream.CloseAndRecv()
+	return err
+}
+
 func showGlobalConfig() error {
 	r, err := client.GetBgp(ctx, &api.GetBgpRequest{})
 	if err != nil {
@@ -2254,18 +2988,27 @@ func showGlobalConfig() error {
 	if g.UseMultiplePaths {
 		fmt.Printf("Multipath: enabled")
 	}
+	if c := g.Confederation; c != nil && c.Enabled {
+		members := make([]string, 0, len(c.MemberAsList))
+		for _, as := range c.MemberAsList {
+			members = append(members, fmt.Sprint(as))
+		}
+		fmt.Printf("Confederation: identifier %d, member ASes %s\n", c.Identifier, strings.Join(members, " "))
+	}
 	return nil
 }
 
 func modGlobalConfig(args []string) error {
 	m, err := extractReserved(args, map[string]int{
-		"as":               paramSingle,
-		"router-id":        paramSingle,
-		"listen-port":      paramSingle,
-		"listen-addresses": paramList,
-		"use-multipath":    paramFlag})
+		"as":                    paramSingle,
+		"router-id":             paramSingle,
+		"listen-port":           paramSingle,
+		"listen-addresses":      paramList,
+		"use-multipath":         paramFlag,
+		"confederation-id":      paramSingle,
+		"confederation-members": paramList})
 	if err != nil || len(m["as"]) != 1 || len(m["router-id"]) != 1 {
-		return fmt.Errorf("usage: gobgp global as <VALUE> router-id <VALUE> [use-multipath] [listen-port <VALUE>] [listen-addresses <VALUE>...]")
+		return fmt.Errorf("usage: gobgp global as <VALUE> router-id <VALUE> [use-multipath] [listen-port <VALUE>] [listen-addresses <VALUE>...] [confederation-id <VALUE> [confederation-members <VALUE>...]]")
 	}
 	asn, err := strconv.ParseUint(m["as"][0], 10, 32)
 	if err != nil {
@@ -2288,6 +3031,25 @@ func modGlobalConfig(args []string) error {
 	if _, ok := m["use-multipath"]; ok {
 		useMultipath = true
 	}
+	var confed *api.Confederation
+	if len(m["confederation-id"]) > 0 {
+		identifier, err := strconv.ParseUint(m["confederation-id"][0], 10, 32)
+		if err != nil {
+			return err
+		}
+		confed = &api.Confederation{
+			Enabled:      true,
+			Identifier:   uint32(identifier),
+			MemberAsList: make([]uint32, 0, len(m["confederation-members"])),
+		}
+		for _, a := range m["confederation-members"] {
+			as, err := strconv.ParseUint(a, 10, 32)
+			if err != nil {
+				return err
+			}
+			confed.MemberAsList = append(confed.MemberAsList, uint32(as))
+		}
+	}
 	_, err = client.StartBgp(ctx, &api.StartBgpRequest{
 		Global: &api.Global{
 			Asn:              uint32(asn),
@@ -2295,11 +3057,124 @@ func modGlobalConfig(args []string) error {
 			ListenPort:       int32(port),
 			ListenAddresses:  m["listen-addresses"],
 			UseMultiplePaths: useMultipath,
+			Confederation:    confed,
 		},
 	})
 	return err
 }
 
+func formatBytes(n uint64) string {
+	units := []string{"B", "KB", "MB", "GB"}
+	v := float64(n)
+	i := 0
+	for v >= 1024 && i < len(units)-1 {
+		v /= 1024
+		i++
+	}
+	if i == 0 {
+		return fmt.Sprintf("%d %s", n, units[i])
+	}
+	return fmt.Sprintf("%.1f %s", v, units[i])
+}
+
+func showGlobalRibSummary() error {
+	r := &api.GetRibSummaryRequest{}
+	if subOpts.AddressFamily != "" {
+		family, err := checkAddressFamily(ipv4UC)
+		if err != nil {
+			return err
+		}
+		r.Families = []*api.Family{family}
+	}
+	rsp, err := client.GetRibSummary(ctx, r)
+	if err != nil {
+		return err
+	}
+	if globalOpts.Json {
+		j, _ := json.Marshal(rsp.Summaries)
+		fmt.Println(string(j))
+		return nil
+	}
+
+	for i, s := range rsp.Summaries {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("Table %s\n", apiutil.ToRouteFamily(s.Family))
+		fmt.Printf("Destination: %d, Path: %d\n", s.NumDestination, s.NumPath)
+		fmt.Printf("Attribute sets: %d, Memory: %s (estimated)\n", s.NumAttributeSet, formatBytes(s.Memory))
+		if len(s.Peers) == 0 {
+			continue
+		}
+		neighborLen := len("Neighbor")
+		for _, p := range s.Peers {
+			if len(p.Neighbor) > neighborLen {
+				neighborLen = len(p.Neighbor)
+			}
+		}
+		format := fmt.Sprintf("%%-%ds  %%10s  %%10s\n", neighborLen)
+		fmt.Printf(format, "Neighbor", "Paths", "Best")
+		for _, p := range s.Peers {
+			fmt.Printf(format, p.Neighbor, fmt.Sprint(p.NumPath), fmt.Sprint(p.NumBest))
+		}
+	}
+	return nil
+}
+
+func showPathHistory(args []string) error {
+	if len(args) != 1 && (len(args) != 3 || args[1] != "neighbor") {
+		return fmt.Errorf("usage: gobgp global rib %s <prefix> [neighbor <neighbor address>] [-a <address family>]", cmdHistory)
+	}
+	family, err := checkAddressFamily(ipv4UC)
+	if err != nil {
+		return err
+	}
+	r := &api.GetPathHistoryRequest{
+		Family: family,
+		Prefix: args[0],
+	}
+	if len(args) == 3 {
+		r.Neighbor = args[2]
+	}
+	rsp, err := client.GetPathHistory(ctx, r)
+	if err != nil {
+		return err
+	}
+	if globalOpts.Json {
+		j, _ := json.Marshal(rsp.Events)
+		fmt.Println(string(j))
+		return nil
+	}
+	if len(rsp.Events) == 0 {
+		fmt.Println("no history")
+		return nil
+	}
+
+	format := "%-25s %-16s %-20s %-20s %-20s %s\n"
+	fmt.Printf(format, "Time", "Event", "Neighbor", "Next Hop", "AS_PATH", "Attrs")
+	for _, e := range rsp.Events {
+		nexthop, aspath, attrs := "", "", ""
+		if e.Type != api.PathEvent_WITHDRAW {
+			nlri, _ := apiutil.GetNativeNlri(e.Path)
+			l, _ := apiutil.GetNativePathAttributes(e.Path)
+			for _, a := range l {
+				switch a := a.(type) {
+				case *bgp.PathAttributeAsPath:
+					aspath = a.String()
+				case *bgp.PathAttributeNextHop:
+					nexthop = a.Value.String()
+				case *bgp.PathAttributeMpReachNLRI:
+					nexthop = a.Nexthop.String()
+				}
+			}
+			attrs = getPathAttributeString(nlri, l)
+		}
+		event := strings.ToLower(strings.ReplaceAll(e.Type.String(), "_", "-"))
+		fmt.Printf(format, e.Timestamp.AsTime().Local().Format(time.RFC3339), event, e.Neighbor, nexthop, aspath, attrs)
+	}
+	return nil
+}
+
 func newGlobalCmd() *cobra.Command {
 	globalCmd := &cobra.Command{
 		Use: cmdGlobal,
@@ -2337,6 +3212,8 @@ func newGlobalCmd() *cobra.Command {
 				}
 			},
 		}
+		cmd.Flags().StringVarP(&modPathOpts.FromFile, "from-file", "", "", "file of the routes, one per line, or - for stdin")
+		cmd.Flags().IntVarP(&modPathOpts.BatchSize, "batch-size", "", defaultModPathBatchSize, "number of the routes sent in a request")
 		ribCmd.AddCommand(cmd)
 
 		if v == cmdDel {
@@ -2362,13 +3239,22 @@ func newGlobalCmd() *cobra.Command {
 	summaryCmd := &cobra.Command{
 		Use: cmdSummary,
 		Run: func(cmd *cobra.Command, args []string) {
-			if err := showRibInfo(cmdGlobal, ""); err != nil {
+			if err := showGlobalRibSummary(); err != nil {
 				exitWithError(err)
 			}
 		},
 	}
 	ribCmd.AddCommand(summaryCmd)
 
+	ribCmd.AddCommand(&cobra.Command{
+		Use: cmdHistory,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := showPathHistory(args); err != nil {
+				exitWithError(err)
+			}
+		},
+	})
+
 	policyCmd := &cobra.Command{
 		Use: cmdPolicy,
 		Run: func(cmd *cobra.Command, args []string) {
@@ -2393,7 +3279,7 @@ func newGlobalCmd() *cobra.Command {
 			},
 		}
 
-		for _, w := range []string{cmdAdd, cmdDel, cmdSet} {
+		for _, w := range []string{cmdAdd, cmdDel, cmdSet, cmdReplace} {
 			subcmd := &cobra.Command{
 				Use: w,
 				Run: func(subcmd *cobra.Command, args []string) {
@@ -2403,9 +3289,23 @@ func newGlobalCmd() *cobra.Command {
 					}
 				},
 			}
+			if w == cmdReplace {
+				subcmd.Flags().BoolVarP(&policyAssignmentOpts.DryRun, "dry-run", "", false, "count the routes whose disposition would change without replacing")
+			}
 			cmd.AddCommand(subcmd)
 		}
 
+		simulateCmd := &cobra.Command{
+			Use: cmdSimulate,
+			Run: func(subcmd *cobra.Command, args []string) {
+				if err := simulateGlobalPolicy(cmd.Use, args); err != nil {
+					exitWithError(err)
+				}
+			},
+		}
+		simulateCmd.Flags().StringVarP(&subOpts.AddressFamily, "address-family", "a", "", "address family")
+		cmd.AddCommand(simulateCmd)
+
 		policyCmd.AddCommand(cmd)
 	}
 
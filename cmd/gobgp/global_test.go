@@ -19,6 +19,7 @@ import (
 	"strings"
 	"testing"
 
+	api "github.com/osrg/gobgp/v3/api"
 	"github.com/osrg/gobgp/v3/pkg/apiutil"
 	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
 	"github.com/stretchr/testify/assert"
@@ -44,11 +45,16 @@ func Test_ParseEvpnPath(t *testing.T) {
 		path string
 	}{
 		{"Ethernet Auto-Discovery", "a-d esi LACP aa:bb:cc:dd:ee:ff 100 etag 200 label 300 rd 1.1.1.1:65000 rt 65000:200 encap vxlan esi-label 400 single-active"},
+		{"Ethernet Auto-Discovery per EVI for VPWS", "a-d esi single-homed etag 100 label 300 rd 1.1.1.1:65000 rt 65000:200 l2-attributes 1500 control-word flow-label"},
 		{"MAC/IP Advertisement", "macadv aa:bb:cc:dd:ee:ff 10.0.0.1 esi AS 65000 100 etag 200 label 300 rd 1.1.1.1:65000 rt 65000:400 encap vxlan default-gateway"},
 		{"I-PMSI", "i-pmsi etag 100 rd 1.1.1.1:65000 rt 65000:200 encap vxlan pmsi ingress-repl 100 1.1.1.1"},
 		{"IP Prefix", "prefix 10.0.0.0/24 172.16.0.1 esi MSTP aa:aa:aa:aa:aa:aa 100 etag 200 label 300 rd 1.1.1.1:65000 rt 65000:200 encap vxlan router-mac bb:bb:bb:bb:bb:bb"},
 		{"Multicast", "multicast 10.0.0.1 etag 100 rd 1.1.1.1:65000 rt 65000:200 encap vxlan pmsi ingress-repl 100 1.1.1.1"},
 		{"Ethernet Segment Identifier", "esi 10.0.0.1 esi MAC aa:bb:cc:dd:ee:ff 100 rd 1.1.1.1:65000 rt 65000:200 encap vxlan"},
+		{"Selective Multicast Ethernet Tag", "smet 239.1.1.1 etag 100 ip 10.0.0.1 rd 1.1.1.1:65000 rt 65000:200 encap vxlan flags v2"},
+		{"Multicast Join Synch", "join-sync 239.1.1.1 source 10.1.1.1 esi LACP aa:bb:cc:dd:ee:ff 100 etag 100 ip 10.0.0.1 rd 1.1.1.1:65000 rt 65000:200 flags v3 exclude"},
+		{"Multicast Leave Synch", "leave-sync ff0e::1 esi AS 65000 100 etag 100 ip 2001:db8::1 sync 1 max-response-time 10 rd 1.1.1.1:65000 rt 65000:200"},
+		{"Ethernet Segment Identifier with DF Election", "esi 10.0.0.1 esi MAC aa:bb:cc:dd:ee:ff 100 rd 1.1.1.1:65000 rt 65000:200 df-election hrw ac-df"},
 	}
 
 	for _, tt := range tests {
@@ -65,3 +71,183 @@ func Test_ParseEvpnPath(t *testing.T) {
 		})
 	}
 }
+
+func Test_ParseMVPNPath(t *testing.T) {
+	var tests = []struct {
+		name string
+		rf   bgp.RouteFamily
+		path string
+		nlri string
+	}{
+		{"Intra-AS I-PMSI A-D", bgp.RF_MVPN_IPv4, "intra-as-i-pmsi-ad 10.0.0.1 rd 65000:100 rt 65000:100 pmsi pim-sm 0 10.0.0.1,239.0.0.1", "[type:intra-as-i-pmsi-ad][rd:65000:100][originator:10.0.0.1]"},
+		{"Inter-AS I-PMSI A-D", bgp.RF_MVPN_IPv4, "inter-as-i-pmsi-ad 65001 rd 65000:100 rt 65000:100", "[type:inter-as-i-pmsi-ad][rd:65000:100][source-as:65001]"},
+		{"S-PMSI A-D", bgp.RF_MVPN_IPv4, "s-pmsi-ad 10.1.1.1 232.1.1.1 originator 10.0.0.1 rd 65000:100 rt 65000:100 pmsi pim-ssm leaf-info-required 0 10.0.0.1,232.0.0.1", "[type:s-pmsi-ad][rd:65000:100][source:10.1.1.1][group:232.1.1.1][originator:10.0.0.1]"},
+		{"Leaf A-D", bgp.RF_MVPN_IPv4, "leaf-ad 10.0.0.2 rt 10.0.0.1:0 key s-pmsi-ad * * originator 10.0.0.1 rd 65000:100", "[type:leaf-ad][route-key:[type:s-pmsi-ad][rd:65000:100][source:*][group:*][originator:10.0.0.1]][originator:10.0.0.2]"},
+		{"Source Active A-D", bgp.RF_MVPN_IPv6, "source-active-ad 2001:db8::1 ff3e::1 rd 65000:100 rt 65000:100", "[type:source-active-ad][rd:65000:100][source:2001:db8::1][group:ff3e::1]"},
+		{"Shared Tree Join", bgp.RF_MVPN_IPv4, "shared-tree-join 10.0.0.100 239.1.1.1 source-as 65000 rd 65000:100 rt 10.0.0.1:1", "[type:shared-tree-join][rd:65000:100][source-as:65000][source:10.0.0.100][group:239.1.1.1]"},
+		{"Source Tree Join", bgp.RF_MVPN_IPv4, "source-tree-join 10.1.1.1 232.1.1.1 source-as 65000 rd 65000:100 rt 10.0.0.1:1", "[type:source-tree-join][rd:65000:100][source-as:65000][source:10.1.1.1][group:232.1.1.1]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			path, err := parsePath(tt.rf, strings.Split(tt.path, " "))
+			assert.NoError(err)
+			nlri, _ := apiutil.GetNativeNlri(path)
+			assert.Equal(tt.nlri, nlri.String())
+		})
+	}
+
+	for _, buf := range []string{
+		"intra-as-i-pmsi-ad 10.0.0.1",
+		"s-pmsi-ad 10.1.1.1 originator 10.0.0.1 rd 65000:100",
+		"leaf-ad 10.0.0.2 rt 10.0.0.1:0",
+		"source-active-ad * 239.1.1.1 rd 65000:100",
+		"source-tree-join 10.1.1.1 232.1.1.1 rd 65000:100",
+		"unknown 10.0.0.1 rd 65000:100",
+	} {
+		_, err := parsePath(bgp.RF_MVPN_IPv4, strings.Split(buf, " "))
+		assert.Error(t, err, buf)
+	}
+}
+
+func Test_ParseCTPath(t *testing.T) {
+	assert := assert.New(t)
+
+	path, err := parsePath(bgp.RF_CT_IPv4, strings.Split("10.0.0.1/32 label 100 rd 65000:100 transport-class 100 nexthop 10.0.0.1", " "))
+	assert.NoError(err)
+	nlri, _ := apiutil.GetNativeNlri(path)
+	assert.IsType(&bgp.CTIPAddrPrefix{}, nlri)
+	assert.Equal("65000:100:10.0.0.1/32", nlri.String())
+	attrs, _ := apiutil.GetNativePathAttributes(path)
+	found := false
+	for _, a := range attrs {
+		if e, ok := a.(*bgp.PathAttributeExtendedCommunities); ok {
+			assert.Equal(bgp.NewTransportClassExtended(100), e.Value[0])
+			found = true
+		}
+	}
+	assert.True(found)
+
+	path, err = parsePath(bgp.RF_CT_IPv6, strings.Split("2001:db8::1/128 label 100 rd 65000:100 transport-class 200 non-transitive", " "))
+	assert.NoError(err)
+	nlri, _ = apiutil.GetNativeNlri(path)
+	assert.IsType(&bgp.CTIPv6AddrPrefix{}, nlri)
+
+	_, err = parsePath(bgp.RF_CT_IPv4, strings.Split("2001:db8::1/128 label 100 rd 65000:100", " "))
+	assert.Error(err)
+	_, err = parsePath(bgp.RF_CT_IPv4, strings.Split("10.0.0.1/32 label 100 rd 65000:100 transport-class gold", " "))
+	assert.Error(err)
+}
+
+func Test_ParseCARPath(t *testing.T) {
+	assert := assert.New(t)
+
+	path, err := parsePath(bgp.RF_CAR_IPv4, strings.Split("10.0.0.1/32 color 100 label 16001/24000 label-index 1 rt 65000:100 nexthop 10.0.0.1", " "))
+	assert.NoError(err)
+	nlri, _ := apiutil.GetNativeNlri(path)
+	assert.Equal(bgp.NewCARColorAwareRoute(bgp.AFI_IP, 32, "10.0.0.1", 100,
+		&bgp.CARLabelTLV{Labels: []uint32{16001, 24000}},
+		&bgp.CARLabelIndexTLV{LabelIndex: 1}), nlri)
+	attrs, _ := apiutil.GetNativePathAttributes(path)
+	found := false
+	for _, a := range attrs {
+		if _, ok := a.(*bgp.PathAttributeExtendedCommunities); ok {
+			found = true
+		}
+	}
+	assert.True(found)
+
+	path, err = parsePath(bgp.RF_CAR_IPv6, strings.Split("2001:db8::1/128 color 200 srv6-sid fc00:0:1:: identifier 10", " "))
+	assert.NoError(err)
+	nlri, _ = apiutil.GetNativeNlri(path)
+	assert.Equal("[prefix:2001:db8::1/128][color:200]", nlri.String())
+	assert.Equal(uint32(10), path.Identifier)
+
+	for _, args := range []string{
+		"10.0.0.1/32",
+		"10.0.0.1/32 colour 100",
+		"2001:db8::1/128 color 100",
+		"10.0.0.1/32 color 100 label-index gold",
+		"10.0.0.1/32 color 100 srv6-sid 10.0.0.1",
+	} {
+		_, err = parsePath(bgp.RF_CAR_IPv4, strings.Split(args, " "))
+		assert.Error(err, args)
+	}
+}
+
+func Test_ParseFlowSpecActions(t *testing.T) {
+	assert := assert.New(t)
+	exts, err := parseExtendedCommunities(strings.Split("mark 10 interface-set 65000:100:in 65000:200", " "))
+	assert.Nil(err)
+	assert.Equal([]bgp.ExtendedCommunityInterface{
+		bgp.NewTrafficRemarkExtended(10),
+		bgp.NewInterfaceSetExtended(65000, 100, true, false),
+		bgp.NewInterfaceSetExtended(65000, 200, true, true),
+	}, exts)
+
+	_, err = parseExtendedCommunities(strings.Split("mark 64", " "))
+	assert.NotNil(err)
+	_, err = parseExtendedCommunities(strings.Split("interface-set 65000:100:up", " "))
+	assert.NotNil(err)
+}
+
+func Test_ParseSRPolicyPath(t *testing.T) {
+	assert := assert.New(t)
+	buf := "10.0.0.2 color 100 distinguisher 2 priority 10 name cp1 bsid 24000 enlp 4 segment-list weight 2 16001 16002 segment-list 2001:db8::1 rt 10.0.0.2:0 nexthop 192.168.0.1"
+
+	path, err := parsePath(bgp.RF_SR_POLICY_IPv4, strings.Split(buf, " "))
+	assert.NoError(err)
+	nlri, _ := apiutil.GetNativeNlri(path)
+	assert.Equal(uint32(100), nlri.(*bgp.SRPolicyIPv4).Color)
+	attrs, _ := apiutil.GetNativePathAttributes(path)
+	assert.Len(attrs, 4)
+	tunnel := attrs[3].(*bgp.PathAttributeTunnelEncap)
+	assert.Equal("{TunnelEncap: {sr-policy: {Flags: 0x00, Preference: 100}, {Priority: 10}, {Candidate Path Name: cp1}, {S-Flag: false, I-Flag: false, BSID: 24000}, {Flags: 0x00, ENLP Type: 4}, {Weight: {Flags: 0x00, Weight: 2},Segment List: [ {V-flag: false, A-flag:, false S-flag: false, B-flag: false, Label: 16001 TC: 0 S: false TTL: 0},{V-flag: false, A-flag:, false S-flag: false, B-flag: false, Label: 16002 TC: 0 S: false TTL: 0}, ] }, {Weight: {Flags: 0x00, Weight: 1},Segment List: [ {V-flag: false, A-flag:, false S-flag: false, B-flag: false, Sid: 2001:db8::1}, ] }}}", tunnel.String())
+
+	for _, buf := range []string{
+		"10.0.0.2 distinguisher 2",
+		"2001:db8::2 color 100",
+		"10.0.0.2 color 100 enlp 5",
+		"10.0.0.2 color 100 segment-list weight 2",
+		"10.0.0.2 color 100 segment-list 1048576",
+	} {
+		_, err = parsePath(bgp.RF_SR_POLICY_IPv4, strings.Split(buf, " "))
+		assert.Error(err, buf)
+	}
+}
+
+func Test_ReadPaths(t *testing.T) {
+	assert := assert.New(t)
+	input := `# routes
+10.0.0.0/24 nexthop 10.0.0.1 med 10
+
+{"nlri": {"@type": "type.googleapis.com/apipb.IPAddressPrefix", "prefix": "10.0.1.0", "prefixLen": 24}, "pattrs": [{"@type": "type.googleapis.com/apipb.OriginAttribute"}, {"@type": "type.googleapis.com/apipb.NextHopAttribute", "nextHop": "10.0.0.1"}]}
+10.0.2.0/24
+`
+	batches := make([][]*api.Path, 0)
+	err := readPaths(strings.NewReader(input), bgp.RF_IPv4_UC, 2, func(paths []*api.Path) error {
+		batches = append(batches, paths)
+		return nil
+	})
+	assert.Nil(err)
+	assert.Equal(2, len(batches))
+	assert.Equal(2, len(batches[0]))
+	assert.Equal(1, len(batches[1]))
+
+	prefixes := make([]string, 0)
+	for _, b := range batches {
+		for _, p := range b {
+			assert.Equal(api.Family_AFI_IP, p.Family.Afi)
+			nlri, err := apiutil.GetNativeNlri(p)
+			assert.Nil(err)
+			prefixes = append(prefixes, nlri.String())
+		}
+	}
+	assert.Equal([]string{"10.0.0.0/24", "10.0.1.0/24", "10.0.2.0/24"}, prefixes)
+
+	err = readPaths(strings.NewReader("10.0.0.0/24\nfoo\n"), bgp.RF_IPv4_UC, 2, func(paths []*api.Path) error {
+		return nil
+	})
+	assert.ErrorContains(err, "line 2")
+}
@@ -16,15 +16,33 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	api "github.com/osrg/gobgp/v3/api"
 	"github.com/spf13/cobra"
 )
 
+func parseLogModule(args []string) (string, error) {
+	switch {
+	case len(args) == 0:
+		return "", nil
+	case len(args) == 2 && args[0] == cmdModule:
+		return args[1], nil
+	}
+	return "", fmt.Errorf("usage: gobgp log-level <level> [module <module>]")
+}
+
 func modLogLevelServer(cmdType string, args []string) error {
 	var level api.SetLogLevelRequest_Level
 
+	module, err := parseLogModule(args)
+	if err != nil {
+		return err
+	}
+	unset := false
 	switch cmdType {
 	case cmdPanic:
 		level = api.SetLogLevelRequest_PANIC
@@ -40,16 +58,52 @@ func modLogLevelServer(cmdType string, args []string) error {
 		level = api.SetLogLevelRequest_DEBUG
 	case cmdTrace:
 		level = api.SetLogLevelRequest_TRACE
+	case cmdUnset:
+		if module == "" {
+			return fmt.Errorf("usage: gobgp log-level unset module <module>")
+		}
+		unset = true
 	default:
 		return fmt.Errorf("invalid log level: %s", cmdType)
 	}
-	_, err := client.SetLogLevel(ctx, &api.SetLogLevelRequest{Level: level})
+	_, err = client.SetLogLevel(ctx, &api.SetLogLevelRequest{
+		Level:  level,
+		Module: module,
+		Unset:  unset,
+	})
 	return err
 }
 
+func showLogLevel() error {
+	rsp, err := client.GetLogLevel(ctx, &api.GetLogLevelRequest{})
+	if err != nil {
+		return err
+	}
+	if globalOpts.Json {
+		j, _ := json.Marshal(rsp)
+		fmt.Println(string(j))
+		return nil
+	}
+	fmt.Printf("global: %s\n", strings.ToLower(rsp.Level.String()))
+	modules := make([]string, 0, len(rsp.Modules))
+	for module := range rsp.Modules {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+	for _, module := range modules {
+		fmt.Printf("%s: %s\n", module, strings.ToLower(rsp.Modules[module].String()))
+	}
+	return nil
+}
+
 func newLogLevelCmd() *cobra.Command {
 	logLevelCmd := &cobra.Command{
 		Use: cmdLogLevel,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := showLogLevel(); err != nil {
+				exitWithError(err)
+			}
+		},
 	}
 	cmds := []string{
 		cmdPanic,
@@ -59,6 +113,7 @@ func newLogLevelCmd() *cobra.Command {
 		cmdInfo,
 		cmdDebug,
 		cmdTrace,
+		cmdUnset,
 	}
 
 	for _, cmd := range cmds {
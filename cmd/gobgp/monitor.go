@@ -20,9 +20,13 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"os"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	api "github.com/osrg/gobgp/v3/api"
 	"github.com/osrg/gobgp/v3/pkg/apiutil"
@@ -73,32 +77,219 @@ func makeMonitorRouteArgs(p *api.Path, showIdentifier bgp.BGPAddPathMode) []inte
 	return pathStr
 }
 
-func monitorRoute(pathList []*api.Path, showIdentifier bgp.BGPAddPathMode) {
-	pathStrs := make([][]interface{}, len(pathList))
+const (
+	ansiReset  = "\x1b[0m"
+	ansiRed    = "\x1b[31m"
+	ansiGreen  = "\x1b[32m"
+	ansiYellow = "\x1b[33m"
+)
+
+// monitorPathAttributes returns the string representations of the path
+// attributes keyed by the type. The next hop in MP_REACH_NLRI is represented
+// as the NEXT_HOP attribute.
+func monitorPathAttributes(attrs []bgp.PathAttributeInterface) map[bgp.BGPAttrType]string {
+	m := make(map[bgp.BGPAttrType]string, len(attrs))
+	for _, attr := range attrs {
+		switch a := attr.(type) {
+		case *bgp.PathAttributeMpReachNLRI:
+			m[bgp.BGP_ATTR_TYPE_NEXT_HOP] = "{Nexthop: " + a.Nexthop.String() + "}"
+		default:
+			m[attr.GetType()] = attr.String()
+		}
+	}
+	return m
+}
+
+// diffPathAttributes returns the attributes removed from old and added in cur
+// in the order of the attribute type. A modified attribute is in both.
+func diffPathAttributes(old, cur map[bgp.BGPAttrType]string) (removed, added []string) {
+	types := make([]bgp.BGPAttrType, 0, len(old)+len(cur))
+	for t := range old {
+		types = append(types, t)
+	}
+	for t := range cur {
+		if _, ok := old[t]; !ok {
+			types = append(types, t)
+		}
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	for _, t := range types {
+		o, oldOk := old[t]
+		n, newOk := cur[t]
+		if oldOk && newOk && o == n {
+			continue
+		}
+		if oldOk {
+			removed = append(removed, o)
+		}
+		if newOk {
+			added = append(added, n)
+		}
+	}
+	return removed, added
+}
+
+// routeMonitor prints the monitored routes. It remembers the attributes of
+// the advertised routes to show the changes when they are re-advertised.
+type routeMonitor struct {
+	out            io.Writer
+	color          bool
+	compact        bool
+	showIdentifier bgp.BGPAddPathMode
+	// perPeer distinguishes the routes by the neighbor and the path
+	// identifier as well as the NLRI, for Adj-RIB-In.
+	perPeer bool
+	routes  map[string]map[bgp.BGPAttrType]string
+}
+
+func newRouteMonitor(out io.Writer, color, compact, perPeer bool) *routeMonitor {
+	return &routeMonitor{
+		out:            out,
+		color:          color,
+		compact:        compact,
+		showIdentifier: bgp.BGP_ADD_PATH_NONE,
+		perPeer:        perPeer,
+		routes:         make(map[string]map[bgp.BGPAttrType]string),
+	}
+}
+
+func (m *routeMonitor) colorize(s, color string) string {
+	if !m.color {
+		return s
+	}
+	return color + s + ansiReset
+}
+
+func (m *routeMonitor) key(p *api.Path, nlri bgp.AddrPrefixInterface) string {
+	if m.perPeer {
+		return fmt.Sprintf("%s|%d|%s", p.NeighborIp, p.Identifier, nlri)
+	}
+	return nlri.String()
+}
+
+func (m *routeMonitor) monitor(timestamp time.Time, pathList []*api.Path) {
+	format := timestamp.UTC().Format(time.RFC3339)
+	for _, p := range pathList {
+		nlri, err := apiutil.GetNativeNlri(p)
+		if err != nil {
+			continue
+		}
+		attrs, _ := apiutil.GetNativePathAttributes(p)
+		key := m.key(p, nlri)
+		old, readvertised := m.routes[key]
+		var removed, added []string
+		if p.IsWithdraw {
+			delete(m.routes, key)
+		} else {
+			current := monitorPathAttributes(attrs)
+			m.routes[key] = current
+			if readvertised {
+				removed, added = diffPathAttributes(old, current)
+			}
+		}
+
+		if m.compact {
+			m.printCompact(format, p, nlri, attrs, readvertised, removed, added)
+			continue
+		}
+
+		pathStr := makeMonitorRouteArgs(p, m.showIdentifier)
+		title := pathStr[0].(string)
+		if p.IsWithdraw {
+			pathStr[0] = m.colorize(title, ansiRed)
+		} else if readvertised {
+			pathStr[0] = m.colorize(title, ansiYellow)
+		} else {
+			pathStr[0] = m.colorize(title, ansiGreen)
+		}
+		if m.showIdentifier == bgp.BGP_ADD_PATH_NONE {
+			fmt.Fprintf(m.out, format+" [%s] %s via %s aspath [%s] attrs %s\n", pathStr...)
+		} else {
+			fmt.Fprintf(m.out, format+" [%s] %d:%s via %s aspath [%s] attrs %s\n", pathStr...)
+		}
+		for _, a := range removed {
+			fmt.Fprintln(m.out, m.colorize("    - "+a, ansiRed))
+		}
+		for _, a := range added {
+			fmt.Fprintln(m.out, m.colorize("    + "+a, ansiGreen))
+		}
+	}
+}
 
-	for i, p := range pathList {
-		pathStrs[i] = makeMonitorRouteArgs(p, showIdentifier)
+func (m *routeMonitor) printCompact(timestamp string, p *api.Path, nlri bgp.AddrPrefixInterface, attrs []bgp.PathAttributeInterface, readvertised bool, removed, added []string) {
+	prefix := nlri.String()
+	if m.showIdentifier != bgp.BGP_ADD_PATH_NONE {
+		prefix = fmt.Sprintf("%d:%s", p.GetIdentifier(), prefix)
+	}
+	switch {
+	case p.IsWithdraw:
+		fmt.Fprintf(m.out, "%s %s %s\n", timestamp, m.colorize("-", ansiRed), prefix)
+	case readvertised:
+		changes := make([]string, 0, len(removed)+len(added))
+		for _, a := range removed {
+			changes = append(changes, m.colorize("-"+a, ansiRed))
+		}
+		for _, a := range added {
+			changes = append(changes, m.colorize("+"+a, ansiGreen))
+		}
+		fmt.Fprintln(m.out, strings.TrimRight(fmt.Sprintf("%s %s %s %s", timestamp, m.colorize("~", ansiYellow), prefix, strings.Join(changes, " ")), " "))
+	default:
+		nexthop := "fictitious"
+		if n := getNextHopFromPathAttributes(attrs); n != nil {
+			nexthop = n.String()
+		}
+		aspath := ""
+		for _, attr := range attrs {
+			if a, ok := attr.(*bgp.PathAttributeAsPath); ok {
+				aspath = bgp.AsPathString(a)
+			}
+		}
+		fmt.Fprintf(m.out, "%s %s %s via %s [%s]\n", timestamp, m.colorize("+", ansiGreen), prefix, nexthop, aspath)
 	}
+}
 
-	format := time.Now().UTC().Format(time.RFC3339)
-	if showIdentifier == bgp.BGP_ADD_PATH_NONE {
-		format += " [%s] %s via %s aspath [%s] attrs %s\n"
-	} else {
-		format += " [%s] %d:%s via %s aspath [%s] attrs %s\n"
+// parseMonitorSince parses the time to replay the events from, which is
+// either an RFC 3339 timestamp or a duration before now like "10m".
+func parseMonitorSince(s string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
 	}
-	for _, pathStr := range pathStrs {
-		fmt.Printf(format, pathStr...)
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return time.Time{}, fmt.Errorf("invalid time %q, expected an RFC 3339 timestamp or a duration like 10m", s)
+	}
+	return now.Add(-d), nil
+}
+
+func eventTimestamp(r *api.WatchEventResponse) time.Time {
+	if r.Timestamp != nil {
+		return r.Timestamp.AsTime()
 	}
+	return time.Now()
 }
 
 func newMonitorCmd() *cobra.Command {
 
 	var current bool
 	var batchSize uint32
+	var color, compact bool
+	var since string
+
+	sinceTimestamp := func() *timestamppb.Timestamp {
+		if since == "" {
+			return nil
+		}
+		t, err := parseMonitorSince(since, time.Now())
+		if err != nil {
+			exitWithError(err)
+		}
+		return timestamppb.New(t)
+	}
 
 	monitor := func(recver interface {
 		Recv() (*api.WatchEventResponse, error)
-	}, showIdentifier bgp.BGPAddPathMode) {
+	}, perPeer bool) {
+		m := newRouteMonitor(os.Stdout, color, compact, perPeer)
 		for {
 			r, err := recver.Recv()
 			if err == io.EOF {
@@ -111,7 +302,7 @@ func newMonitorCmd() *cobra.Command {
 					j, _ := json.Marshal(apiutil.NewDestination(&api.Destination{Paths: t.Paths}))
 					fmt.Println(string(j))
 				} else {
-					monitorRoute(t.Paths, bgp.BGP_ADD_PATH_NONE)
+					m.monitor(eventTimestamp(r), t.Paths)
 				}
 			}
 		}
@@ -134,11 +325,12 @@ func newMonitorCmd() *cobra.Command {
 					},
 				},
 				BatchSize: batchSize,
+				Since:     sinceTimestamp(),
 			})
 			if err != nil {
 				exitWithError(err)
 			}
-			monitor(recver, bgp.BGP_ADD_PATH_NONE)
+			monitor(recver, false)
 		},
 	}
 	ribCmd.PersistentFlags().StringVarP(&subOpts.AddressFamily, "address-family", "a", "", "address family")
@@ -157,7 +349,8 @@ func newMonitorCmd() *cobra.Command {
 				name = args[0]
 			}
 			stream, err := client.WatchEvent(ctx, &api.WatchEventRequest{
-				Peer: &api.WatchEventRequest_Peer{},
+				Peer:  &api.WatchEventRequest_Peer{},
+				Since: sinceTimestamp(),
 			})
 			if err != nil {
 				exitWithError(err)
@@ -180,7 +373,20 @@ func newMonitorCmd() *cobra.Command {
 							if s.Conf.NeighborInterface != "" {
 								addr = fmt.Sprintf("%s(%s)", addr, s.Conf.NeighborInterface)
 							}
-							fmt.Printf("%s [NEIGH] %s fsm: %s admin: %s\n", time.Now().UTC().Format(time.RFC3339), addr, s.State.SessionState, s.State.AdminState)
+							state := s.State.SessionState.String()
+							if color {
+								if s.State.SessionState == api.PeerState_ESTABLISHED {
+									state = ansiGreen + state + ansiReset
+								} else {
+									state = ansiYellow + state + ansiReset
+								}
+							}
+							timestamp := eventTimestamp(r).UTC().Format(time.RFC3339)
+							if compact {
+								fmt.Printf("%s %s %s %s\n", timestamp, addr, state, s.State.AdminState)
+							} else {
+								fmt.Printf("%s [NEIGH] %s fsm: %s admin: %s\n", timestamp, addr, state, s.State.AdminState)
+							}
 						}
 					}
 				}
@@ -197,6 +403,9 @@ func newMonitorCmd() *cobra.Command {
 					exitWithError(fmt.Errorf("invalid ip address: %s", args[0]))
 				}
 			}
+			if since != "" {
+				exitWithError(fmt.Errorf("--since isn't supported for Adj-RIB-In"))
+			}
 			_, err := checkAddressFamily(ipv4UC)
 			if err != nil {
 				exitWithError(err)
@@ -214,7 +423,7 @@ func newMonitorCmd() *cobra.Command {
 			if err != nil {
 				exitWithError(err)
 			}
-			monitor(recver, bgp.BGP_ADD_PATH_RECEIVE)
+			monitor(recver, true)
 		},
 	}
 	adjInCmd.PersistentFlags().StringVarP(&subOpts.AddressFamily, "address-family", "a", "", "address family")
@@ -228,6 +437,9 @@ func newMonitorCmd() *cobra.Command {
 
 	monitorCmd.PersistentFlags().BoolVarP(&current, "current", "", false, "dump current contents")
 	monitorCmd.PersistentFlags().Uint32VarP(&batchSize, "batch-size", "", 0, "max paths per event message")
+	monitorCmd.PersistentFlags().BoolVarP(&color, "color", "", false, "colorize the output")
+	monitorCmd.PersistentFlags().BoolVarP(&compact, "compact", "", false, "show each event in a compact line")
+	monitorCmd.PersistentFlags().StringVarP(&since, "since", "", "", "replay the events from the backlog of the server since the time (RFC 3339 timestamp or duration before now like 10m)")
 
 	return monitorCmd
 }
@@ -0,0 +1,104 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func newMonitorTestPath(t *testing.T, args string, withdraw bool) *api.Path {
+	p, err := parsePath(bgp.RF_IPv4_UC, strings.Split(args, " "))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.IsWithdraw = withdraw
+	return p
+}
+
+func Test_diffPathAttributes(t *testing.T) {
+	assert := assert.New(t)
+
+	removed, added := diffPathAttributes(
+		map[bgp.BGPAttrType]string{
+			bgp.BGP_ATTR_TYPE_NEXT_HOP:         "{Nexthop: 10.0.0.1}",
+			bgp.BGP_ATTR_TYPE_MULTI_EXIT_DISC:  "{Med: 10}",
+			bgp.BGP_ATTR_TYPE_LOCAL_PREF:       "{LocalPref: 100}",
+			bgp.BGP_ATTR_TYPE_ATOMIC_AGGREGATE: "{AtomicAggregate}",
+		},
+		map[bgp.BGPAttrType]string{
+			bgp.BGP_ATTR_TYPE_NEXT_HOP:        "{Nexthop: 10.0.0.1}",
+			bgp.BGP_ATTR_TYPE_MULTI_EXIT_DISC: "{Med: 20}",
+			bgp.BGP_ATTR_TYPE_LOCAL_PREF:      "{LocalPref: 100}",
+			bgp.BGP_ATTR_TYPE_COMMUNITIES:     "{Communities: 65000:100}",
+		})
+	assert.Equal([]string{"{Med: 10}", "{AtomicAggregate}"}, removed)
+	assert.Equal([]string{"{Med: 20}", "{Communities: 65000:100}"}, added)
+}
+
+func Test_routeMonitor(t *testing.T) {
+	assert := assert.New(t)
+
+	timestamp := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	paths := []*api.Path{
+		newMonitorTestPath(t, "10.0.0.0/24 nexthop 10.0.0.1 med 10", false),
+		newMonitorTestPath(t, "10.0.0.0/24 nexthop 10.0.0.2 med 10", false),
+		newMonitorTestPath(t, "10.0.0.0/24", true),
+	}
+
+	out := &bytes.Buffer{}
+	newRouteMonitor(out, false, true, false).monitor(timestamp, paths)
+	assert.Equal(`2026-01-02T03:04:05Z + 10.0.0.0/24 via 10.0.0.1 []
+2026-01-02T03:04:05Z ~ 10.0.0.0/24 -{Nexthop: 10.0.0.1} +{Nexthop: 10.0.0.2}
+2026-01-02T03:04:05Z - 10.0.0.0/24
+`, out.String())
+
+	out.Reset()
+	newRouteMonitor(out, false, false, false).monitor(timestamp, paths[:2])
+	lines := strings.Split(out.String(), "\n")
+	assert.Len(lines, 5)
+	assert.True(strings.HasPrefix(lines[0], "2026-01-02T03:04:05Z [ROUTE] 10.0.0.0/24 via 10.0.0.1"))
+	assert.True(strings.HasPrefix(lines[1], "2026-01-02T03:04:05Z [ROUTE] 10.0.0.0/24 via 10.0.0.2"))
+	assert.Equal("    - {Nexthop: 10.0.0.1}", lines[2])
+	assert.Equal("    + {Nexthop: 10.0.0.2}", lines[3])
+
+	out.Reset()
+	newRouteMonitor(out, true, true, false).monitor(timestamp, paths[2:])
+	assert.Equal("2026-01-02T03:04:05Z "+ansiRed+"-"+ansiReset+" 10.0.0.0/24\n", out.String())
+}
+
+func Test_parseMonitorSince(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	since, err := parseMonitorSince("2026-01-02T03:00:00Z", now)
+	assert.Nil(err)
+	assert.Equal(time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC), since)
+	since, err = parseMonitorSince("10m", now)
+	assert.Nil(err)
+	assert.Equal(now.Add(-10*time.Minute), since)
+	_, err = parseMonitorSince("yesterday", now)
+	assert.NotNil(err)
+	_, err = parseMonitorSince("-10m", now)
+	assert.NotNil(err)
+}
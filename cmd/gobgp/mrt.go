@@ -20,6 +20,8 @@ import (
 	"compress/gzip"
 	"fmt"
 	"io"
+	"math"
+	"net"
 	"os"
 	"strconv"
 	"strings"
@@ -33,6 +35,275 @@ import (
 	"github.com/osrg/gobgp/v3/pkg/packet/mrt"
 )
 
+// mrtInjector converts the MRT records to the paths injected into the
+// global table.
+type mrtInjector struct {
+	peers     []*mrt.Peer
+	peerIndex map[uint16]struct{}
+	nexthop   net.IP
+	skipV4    bool
+	skipV6    bool
+	best      bool
+}
+
+func newMrtInjector() *mrtInjector {
+	i := &mrtInjector{
+		nexthop: mrtOpts.NextHop,
+		skipV4:  mrtOpts.SkipV4,
+		skipV6:  mrtOpts.SkipV6,
+		best:    mrtOpts.Best,
+	}
+	if len(mrtOpts.PeerIndex) > 0 {
+		i.peerIndex = make(map[uint16]struct{}, len(mrtOpts.PeerIndex))
+		for _, idx := range mrtOpts.PeerIndex {
+			i.peerIndex[uint16(idx)] = struct{}{}
+		}
+	}
+	return i
+}
+
+// paths returns the paths carried by the record. The records which
+// carry no routes, like PEER_INDEX_TABLE or BGP4MP_STATE_CHANGE,
+// return no paths.
+func (i *mrtInjector) paths(msg *mrt.MRTMessage) ([]*api.Path, error) {
+	switch body := msg.Body.(type) {
+	case *mrt.PeerIndexTable:
+		i.peers = body.Peers
+	case *mrt.Rib:
+		return i.ribPaths(body)
+	case *mrt.BGP4MPMessage:
+		return i.bgp4mpPaths(body, msg.Header.GetTime())
+	}
+	return nil, nil
+}
+
+func (i *mrtInjector) skip(nlri bgp.AddrPrefixInterface) bool {
+	switch nlri.AFI() {
+	case bgp.AFI_IP:
+		return i.skipV4
+	case bgp.AFI_IP6:
+		return i.skipV6
+	}
+	return false
+}
+
+// attrs returns the path attributes of nlri. The nexthop is carried by
+// NEXT_HOP for IPv4 unicast and by MP_REACH_NLRI only including nlri for
+// the others.
+func (i *mrtInjector) attrs(nlri bgp.AddrPrefixInterface, attrs []bgp.PathAttributeInterface, nexthop net.IP) []bgp.PathAttributeInterface {
+	if i.nexthop != nil {
+		nexthop = i.nexthop
+	}
+	l := make([]bgp.PathAttributeInterface, 0, len(attrs)+1)
+	for _, attr := range attrs {
+		switch attr.GetType() {
+		case bgp.BGP_ATTR_TYPE_NEXT_HOP, bgp.BGP_ATTR_TYPE_MP_REACH_NLRI, bgp.BGP_ATTR_TYPE_MP_UNREACH_NLRI:
+			continue
+		}
+		l = append(l, attr)
+	}
+	if nexthop == nil {
+		return l
+	}
+	if nlri.AFI() == bgp.AFI_IP && nlri.SAFI() == bgp.SAFI_UNICAST {
+		return append(l, bgp.NewPathAttributeNextHop(nexthop.String()))
+	}
+	return append(l, bgp.NewPathAttributeMpReachNLRI(nexthop.String(), []bgp.AddrPrefixInterface{nlri}))
+}
+
+func (i *mrtInjector) ribPaths(rib *mrt.Rib) ([]*api.Path, error) {
+	if i.peers == nil {
+		return nil, fmt.Errorf("not found PEER_INDEX_TABLE")
+	}
+	nlri := rib.Prefix
+	if i.skip(nlri) {
+		return nil, nil
+	}
+
+	paths := make([]*api.Path, 0, len(rib.Entries))
+	for _, e := range rib.Entries {
+		if len(i.peers) <= int(e.PeerIndex) {
+			return nil, fmt.Errorf("invalid peer index: %d (PEER_INDEX_TABLE has only %d peers)", e.PeerIndex, len(i.peers))
+		}
+		if i.peerIndex != nil {
+			if _, ok := i.peerIndex[e.PeerIndex]; !ok {
+				continue
+			}
+		}
+
+		var nexthop net.IP
+		for _, attr := range e.PathAttributes {
+			switch a := attr.(type) {
+			case *bgp.PathAttributeNextHop:
+				nexthop = a.Value
+			case *bgp.PathAttributeMpReachNLRI:
+				nexthop = a.Nexthop
+			}
+		}
+		path, err := apiutil.NewPath(nlri, false, i.attrs(nlri, e.PathAttributes, nexthop), time.Unix(int64(e.OriginatedTime), 0))
+		if err != nil {
+			return nil, err
+		}
+		path.Identifier = e.PathIdentifier
+		path.SourceAsn = i.peers[e.PeerIndex].AS
+		path.SourceId = i.peers[e.PeerIndex].BgpId.String()
+
+		// TODO: compare here if mrtOpts.Best is enabled
+		paths = append(paths, path)
+	}
+
+	// TODO: calculate properly if necessary.
+	if i.best && len(paths) > 1 {
+		paths = paths[:1]
+	}
+	return paths, nil
+}
+
+func (i *mrtInjector) bgp4mpPaths(m *mrt.BGP4MPMessage, t time.Time) ([]*api.Path, error) {
+	// the messages sent by the dumping router aren't routes it learned
+	if m.IsLocal() || m.BGPMessage.Header.Type != bgp.BGP_MSG_UPDATE {
+		return nil, nil
+	}
+	update := m.BGPMessage.Body.(*bgp.BGPUpdate)
+
+	var nexthop net.IP
+	var reach *bgp.PathAttributeMpReachNLRI
+	var unreach *bgp.PathAttributeMpUnreachNLRI
+	for _, attr := range update.PathAttributes {
+		switch a := attr.(type) {
+		case *bgp.PathAttributeNextHop:
+			nexthop = a.Value
+		case *bgp.PathAttributeMpReachNLRI:
+			reach = a
+		case *bgp.PathAttributeMpUnreachNLRI:
+			unreach = a
+		}
+	}
+
+	paths := make([]*api.Path, 0, len(update.NLRI)+len(update.WithdrawnRoutes))
+	add := func(nlri bgp.AddrPrefixInterface, isWithdraw bool, nexthop net.IP) error {
+		if i.skip(nlri) {
+			return nil
+		}
+		var attrs []bgp.PathAttributeInterface
+		if !isWithdraw {
+			attrs = i.attrs(nlri, update.PathAttributes, nexthop)
+		}
+		path, err := apiutil.NewPath(nlri, isWithdraw, attrs, t)
+		if err != nil {
+			return err
+		}
+		path.SourceAsn = m.PeerAS
+		path.SourceId = m.PeerIpAddress.String()
+		paths = append(paths, path)
+		return nil
+	}
+
+	for _, nlri := range update.WithdrawnRoutes {
+		if err := add(nlri, true, nil); err != nil {
+			return nil, err
+		}
+	}
+	if unreach != nil {
+		for _, nlri := range unreach.Value {
+			if err := add(nlri, true, nil); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, nlri := range update.NLRI {
+		if err := add(nlri, false, nexthop); err != nil {
+			return nil, err
+		}
+	}
+	if reach != nil {
+		for _, nlri := range reach.Value {
+			if err := add(nlri, false, reach.Nexthop); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return paths, nil
+}
+
+// readMrt calls fn with each record read from reader until fn returns
+// false. The records which fail to be parsed are passed with the error.
+func readMrt(reader io.Reader, fn func(idx, offset int64, msg *mrt.MRTMessage, err error) bool) error {
+	var offset int64
+	for idx := int64(0); ; idx++ {
+		buf := make([]byte, mrt.MRT_COMMON_HEADER_LEN)
+		_, err := io.ReadFull(reader, buf)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to read: %s", err)
+		}
+
+		h := &mrt.MRTHeader{}
+		err = h.DecodeFromBytes(buf)
+		if err != nil {
+			return fmt.Errorf("failed to parse")
+		}
+
+		buf = make([]byte, h.Len)
+		_, err = io.ReadFull(reader, buf)
+		if err != nil {
+			return fmt.Errorf("failed to read")
+		}
+
+		msg, err := mrt.ParseMRTBody(h, buf)
+		if !fn(idx, offset, msg, err) {
+			return nil
+		}
+		offset += int64(mrt.MRT_COMMON_HEADER_LEN) + int64(h.Len)
+	}
+}
+
+// dryRunMrt parses all the records without injecting them and reports
+// the records which can't be injected.
+func dryRunMrt(reader io.Reader, injector *mrtInjector) error {
+	var records, paths, errors int64
+	err := readMrt(reader, func(idx, offset int64, msg *mrt.MRTMessage, err error) bool {
+		records++
+		if err == nil {
+			var l []*api.Path
+			l, err = injector.paths(msg)
+			paths += int64(len(l))
+		}
+		if err != nil {
+			errors++
+			fmt.Printf("record %d (offset %d): %s\n", idx, offset, err)
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d records, %d paths, %d errors\n", records, paths, errors)
+	if errors > 0 {
+		return fmt.Errorf("found %d invalid records", errors)
+	}
+	return nil
+}
+
+// mrtPacer sleeps to inject the records at the pace recorded in their
+// timestamps multiplied by speed.
+type mrtPacer struct {
+	speed float64
+	first time.Time
+	start time.Time
+}
+
+func (p *mrtPacer) wait(t time.Time) {
+	if p.first.IsZero() {
+		p.first = t
+		p.start = time.Now()
+		return
+	}
+	d := time.Duration(float64(t.Sub(p.first)) / p.speed)
+	time.Sleep(time.Until(p.start.Add(d)))
+}
+
 func injectMrt() error {
 	var reader io.Reader
 	fileReader, err := os.Open(mrtOpts.Filename)
@@ -53,6 +324,16 @@ func injectMrt() error {
 		reader = fileReader
 	}
 
+	for _, idx := range mrtOpts.PeerIndex {
+		if idx > math.MaxUint16 {
+			return fmt.Errorf("invalid peer index: %d", idx)
+		}
+	}
+	injector := newMrtInjector()
+	if mrtOpts.DryRun {
+		return dryRunMrt(reader, injector)
+	}
+
 	if mrtOpts.NextHop != nil && !mrtOpts.SkipV4 && !mrtOpts.SkipV6 {
 		fmt.Println("You should probably specify either --no-ipv4 or --no-ipv6 when overwriting nexthop, unless your dump contains only one type of routes")
 	}
@@ -61,127 +342,49 @@ func injectMrt() error {
 	if mrtOpts.QueueSize < 1 {
 		return fmt.Errorf("specified queue size is smaller than 1, refusing to run with unbounded memory usage")
 	}
+	if mrtOpts.Speed < 0 {
+		return fmt.Errorf("specified speed is negative")
+	}
+	var pacer *mrtPacer
+	if mrtOpts.Speed > 0 {
+		pacer = &mrtPacer{speed: mrtOpts.Speed}
+	}
 
 	ch := make(chan []*api.Path, mrtOpts.QueueSize)
 	go func() {
-
-		var peers []*mrt.Peer
-		for {
-			buf := make([]byte, mrt.MRT_COMMON_HEADER_LEN)
-			_, err := io.ReadFull(reader, buf)
-			if err == io.EOF {
-				break
-			} else if err != nil {
-				exitWithError(fmt.Errorf("failed to read: %s", err))
-			}
-
-			h := &mrt.MRTHeader{}
-			err = h.DecodeFromBytes(buf)
-			if err != nil {
-				exitWithError(fmt.Errorf("failed to parse"))
-			}
-
-			buf = make([]byte, h.Len)
-			_, err = io.ReadFull(reader, buf)
-			if err != nil {
-				exitWithError(fmt.Errorf("failed to read"))
-			}
-
-			msg, err := mrt.ParseMRTBody(h, buf)
+		err := readMrt(reader, func(_, _ int64, msg *mrt.MRTMessage, err error) bool {
 			if err != nil {
 				printError(fmt.Errorf("failed to parse: %s", err))
-				continue
+				return true
 			}
 
 			if globalOpts.Debug {
 				fmt.Println(msg)
 			}
 
-			if msg.Header.Type == mrt.TABLE_DUMPv2 {
-				subType := mrt.MRTSubTypeTableDumpv2(msg.Header.SubType)
-				switch subType {
-				case mrt.PEER_INDEX_TABLE:
-					peers = msg.Body.(*mrt.PeerIndexTable).Peers
-					continue
-				case mrt.RIB_IPV4_UNICAST, mrt.RIB_IPV4_UNICAST_ADDPATH:
-					if mrtOpts.SkipV4 {
-						continue
-					}
-				case mrt.RIB_IPV6_UNICAST, mrt.RIB_IPV6_UNICAST_ADDPATH:
-					if mrtOpts.SkipV6 {
-						continue
-					}
-				case mrt.GEO_PEER_TABLE:
-					fmt.Printf("WARNING: Skipping GEO_PEER_TABLE: %s", msg.Body.(*mrt.GeoPeerTable))
-				default:
-					exitWithError(fmt.Errorf("unsupported subType: %v", subType))
-				}
-
-				if peers == nil {
-					exitWithError(fmt.Errorf("not found PEER_INDEX_TABLE"))
-				}
-
-				rib := msg.Body.(*mrt.Rib)
-				nlri := rib.Prefix
-
-				paths := make([]*api.Path, 0, len(rib.Entries))
-
-				for _, e := range rib.Entries {
-					if len(peers) <= int(e.PeerIndex) {
-						exitWithError(fmt.Errorf("invalid peer index: %d (PEER_INDEX_TABLE has only %d peers)", e.PeerIndex, len(peers)))
-					}
-					//t := time.Unix(int64(e.OriginatedTime), 0)
-
-					var attrs []bgp.PathAttributeInterface
-					switch subType {
-					case mrt.RIB_IPV4_UNICAST, mrt.RIB_IPV4_UNICAST_ADDPATH:
-						if mrtOpts.NextHop != nil {
-							for i, attr := range e.PathAttributes {
-								if attr.GetType() == bgp.BGP_ATTR_TYPE_NEXT_HOP {
-									e.PathAttributes[i] = bgp.NewPathAttributeNextHop(mrtOpts.NextHop.String())
-									break
-								}
-							}
-						}
-						attrs = e.PathAttributes
-					default:
-						attrs = make([]bgp.PathAttributeInterface, 0, len(e.PathAttributes))
-						for _, attr := range e.PathAttributes {
-							if attr.GetType() != bgp.BGP_ATTR_TYPE_MP_REACH_NLRI {
-								attrs = append(attrs, attr)
-							} else {
-								a := attr.(*bgp.PathAttributeMpReachNLRI)
-								nexthop := a.Nexthop.String()
-								if mrtOpts.NextHop != nil {
-									nexthop = mrtOpts.NextHop.String()
-								}
-								attrs = append(attrs, bgp.NewPathAttributeMpReachNLRI(nexthop, []bgp.AddrPrefixInterface{nlri}))
-							}
-						}
-					}
-
-					path, _ := apiutil.NewPath(nlri, false, attrs, time.Unix(int64(e.OriginatedTime), 0))
-					path.SourceAsn = peers[e.PeerIndex].AS
-					path.SourceId = peers[e.PeerIndex].BgpId.String()
-
-					// TODO: compare here if mrtOpts.Best is enabled
-					paths = append(paths, path)
-				}
-
-				// TODO: calculate properly if necessary.
-				if mrtOpts.Best {
-					paths = []*api.Path{paths[0]}
-				}
-
-				if idx >= mrtOpts.RecordSkip {
-					ch <- paths
-				}
+			if g, ok := msg.Body.(*mrt.GeoPeerTable); ok {
+				fmt.Printf("WARNING: Skipping GEO_PEER_TABLE: %s", g)
+			}
+			paths, err := injector.paths(msg)
+			if err != nil {
+				exitWithError(err)
+			}
+			if len(paths) == 0 {
+				return true
+			}
 
-				idx += 1
-				if idx == mrtOpts.RecordCount+mrtOpts.RecordSkip {
-					break
+			if idx >= mrtOpts.RecordSkip {
+				if pacer != nil {
+					pacer.wait(msg.Header.GetTime())
 				}
+				ch <- paths
 			}
+
+			idx += 1
+			return idx != mrtOpts.RecordCount+mrtOpts.RecordSkip
+		})
+		if err != nil {
+			exitWithError(err)
 		}
 
 		close(ch)
@@ -201,7 +404,8 @@ func injectMrt() error {
 			return fmt.Errorf("failed to send: %s", err)
 		}
 	}
-	return nil
+	_, err = stream.CloseAndRecv()
+	return err
 }
 
 func newMrtCmd() *cobra.Command {
@@ -250,5 +454,8 @@ func newMrtCmd() *cobra.Command {
 	mrtCmd.PersistentFlags().BoolVarP(&mrtOpts.SkipV6, "no-ipv6", "", false, "Do not import IPv6 routes")
 	mrtCmd.PersistentFlags().IntVarP(&mrtOpts.QueueSize, "queue-size", "", 1<<10, "Maximum number of updates to keep queued")
 	mrtCmd.PersistentFlags().IPVarP(&mrtOpts.NextHop, "nexthop", "", nil, "Overwrite nexthop")
+	mrtCmd.PersistentFlags().UintSliceVarP(&mrtOpts.PeerIndex, "peer-index", "", nil, "Inject only the TABLE_DUMPv2 entries of the peers with the indexes")
+	mrtCmd.PersistentFlags().Float64VarP(&mrtOpts.Speed, "speed", "", 0, "Inject at the pace of the record timestamps multiplied by the value, 0 injects as fast as possible")
+	mrtCmd.PersistentFlags().BoolVarP(&mrtOpts.DryRun, "dry-run", "", false, "Parse the records and report the errors without injecting")
 	return mrtCmd
 }
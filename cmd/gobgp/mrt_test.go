@@ -0,0 +1,143 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/apiutil"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+	"github.com/osrg/gobgp/v3/pkg/packet/mrt"
+)
+
+func serializeMrt(t *testing.T, buf *bytes.Buffer, typ mrt.MRTType, subtype mrt.MRTSubTyper, body mrt.Body) {
+	m, err := mrt.NewMRTMessage(uint32(time.Now().Unix()), typ, subtype, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := m.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf.Write(b)
+}
+
+func readMrtPaths(t *testing.T, injector *mrtInjector, buf *bytes.Buffer) [][]*api.Path {
+	l := [][]*api.Path{}
+	err := readMrt(buf, func(_, _ int64, msg *mrt.MRTMessage, err error) bool {
+		if err != nil {
+			t.Fatal(err)
+		}
+		paths, err := injector.paths(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(paths) > 0 {
+			l = append(l, paths)
+		}
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return l
+}
+
+func Test_MrtInjectTableDumpAddPath(t *testing.T) {
+	assert := assert.New(t)
+
+	var buf bytes.Buffer
+	serializeMrt(t, &buf, mrt.TABLE_DUMPv2, mrt.PEER_INDEX_TABLE, mrt.NewPeerIndexTable("1.1.1.1", "", []*mrt.Peer{
+		mrt.NewPeer("10.0.0.1", "10.0.0.1", 65001, true),
+		mrt.NewPeer("10.0.0.2", "2001:db8::2", 65002, true),
+	}))
+	attrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeMpReachNLRI("2001:db8::1", nil),
+	}
+	serializeMrt(t, &buf, mrt.TABLE_DUMPv2, mrt.RIB_IPV6_UNICAST_ADDPATH, mrt.NewRib(1, bgp.NewIPv6AddrPrefix(64, "2001:db8:1::"), []*mrt.RibEntry{
+		mrt.NewRibEntry(0, uint32(time.Now().Unix()), 10, attrs, true),
+		mrt.NewRibEntry(1, uint32(time.Now().Unix()), 20, attrs, true),
+	}))
+	b := buf.Bytes()
+
+	l := readMrtPaths(t, newMrtInjector(), bytes.NewBuffer(b))
+	assert.Len(l, 1)
+	assert.Len(l[0], 2)
+	assert.Equal(uint32(10), l[0][0].Identifier)
+	assert.Equal(uint32(65001), l[0][0].SourceAsn)
+	assert.Equal(uint32(20), l[0][1].Identifier)
+	assert.Equal("10.0.0.2", l[0][1].SourceId)
+	attrs, err := apiutil.GetNativePathAttributes(l[0][1])
+	assert.NoError(err)
+	reach := attrs[1].(*bgp.PathAttributeMpReachNLRI)
+	assert.Equal("2001:db8::1", reach.Nexthop.String())
+	assert.Equal("2001:db8:1::/64", reach.Value[0].String())
+
+	injector := newMrtInjector()
+	injector.peerIndex = map[uint16]struct{}{1: {}}
+	l = readMrtPaths(t, injector, bytes.NewBuffer(b))
+	assert.Len(l, 1)
+	assert.Len(l[0], 1)
+	assert.Equal(uint32(65002), l[0][0].SourceAsn)
+
+	injector = newMrtInjector()
+	injector.skipV6 = true
+	assert.Empty(readMrtPaths(t, injector, bytes.NewBuffer(b)))
+}
+
+func Test_MrtInjectBgp4mp(t *testing.T) {
+	assert := assert.New(t)
+
+	var buf bytes.Buffer
+	withdrawn := bgp.NewIPAddrPrefix(24, "10.0.1.0")
+	prefix := bgp.NewIPv6AddrPrefix(64, "2001:db8:1::")
+	update := bgp.NewBGPUpdateMessage([]*bgp.IPAddrPrefix{withdrawn}, []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeNextHop("10.0.0.2"),
+		bgp.NewPathAttributeMpReachNLRI("2001:db8::2", []bgp.AddrPrefixInterface{prefix}),
+	}, []*bgp.IPAddrPrefix{bgp.NewIPAddrPrefix(24, "10.0.0.0")})
+	serializeMrt(t, &buf, mrt.BGP4MP, mrt.MESSAGE_AS4, mrt.NewBGP4MPMessage(65002, 65001, 0, "10.0.0.2", "10.0.0.1", true, update))
+	// sent by the dumping router
+	serializeMrt(t, &buf, mrt.BGP4MP, mrt.MESSAGE_AS4_LOCAL, mrt.NewBGP4MPMessageLocal(65001, 65002, 0, "10.0.0.1", "10.0.0.2", true, update))
+
+	l := readMrtPaths(t, newMrtInjector(), &buf)
+	assert.Len(l, 1)
+	assert.Len(l[0], 3)
+	assert.True(l[0][0].IsWithdraw)
+	assert.Equal(uint32(65002), l[0][0].SourceAsn)
+	assert.Equal("10.0.0.2", l[0][0].SourceId)
+
+	for i, nexthop := range []string{"10.0.0.2", "2001:db8::2"} {
+		path := l[0][i+1]
+		assert.False(path.IsWithdraw)
+		attrs, err := apiutil.GetNativePathAttributes(path)
+		assert.NoError(err)
+		assert.Len(attrs, 2)
+		switch a := attrs[1].(type) {
+		case *bgp.PathAttributeNextHop:
+			assert.Equal(nexthop, a.Value.String())
+		case *bgp.PathAttributeMpReachNLRI:
+			assert.Equal(nexthop, a.Nexthop.String())
+			assert.Len(a.Value, 1)
+		}
+	}
+}
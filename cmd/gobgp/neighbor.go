@@ -278,16 +278,47 @@ func showNeighbor(args []string) error {
 		id = p.State.RouterId
 	}
 	fmt.Printf("  BGP version 4, remote router ID %s\n", id)
+	if p.Conf.OrrVantagePoint != "" {
+		fmt.Printf("  ORR vantage point: %s\n", p.Conf.OrrVantagePoint)
+	}
 	fmt.Printf("  BGP state = %s", p.State.SessionState)
 	if p.Timers.State.Uptime != nil {
 		fmt.Printf(", up for %s\n", formatTimedelta(p.Timers.State.Uptime.AsTime()))
 	} else {
 		fmt.Print("\n")
 	}
+	if p.State.GracefulShutdown {
+		fmt.Println("  Graceful shutdown (RFC 8326) in progress")
+	}
 	fmt.Printf("  BGP OutQ = %d, Flops = %d\n", p.State.Queues.Output, p.State.Flops)
+	if p.State.SlowPeerCount > 0 {
+		slow := "no"
+		if p.State.SlowPeer {
+			slow = "yes"
+		}
+		fmt.Printf("  Slow peer: %s, detected %d times, %d paths dropped\n", slow, p.State.SlowPeerCount, p.State.SlowPeerDropped)
+	}
+	if l := p.UpdateRateLimit; l != nil && (l.MessagesPerSecond > 0 || l.PrefixesPerSecond > 0) {
+		limit := func(n uint32) string {
+			if n == 0 {
+				return "unlimited"
+			}
+			return fmt.Sprintf("%d/s", n)
+		}
+		fmt.Printf("  Update rate limit: messages %s, prefixes %s\n", limit(l.MessagesPerSecond), limit(l.PrefixesPerSecond))
+	}
 	fmt.Printf("  Local address is %s, local ASN: %s\n", p.Transport.LocalAddress, getLocalASN(p))
+	if p.State.SessionState == api.PeerState_ESTABLISHED && !p.State.FourOctetAs {
+		fmt.Println("  Two-octet AS numbers with AS_TRANS (RFC 6793)")
+	}
 	fmt.Printf("  Hold time is %d, keepalive interval is %d seconds\n", int(p.Timers.State.NegotiatedHoldTime), int(p.Timers.State.KeepaliveInterval))
 	fmt.Printf("  Configured hold time is %d, keepalive interval is %d seconds\n", int(p.Timers.Config.HoldTime), int(p.Timers.Config.KeepaliveInterval))
+	if p.Timers.Config.IdleHoldTimeMax > 0 {
+		fmt.Printf("  Idle hold backoff is %d to %d seconds, %d consecutive failures, idle hold time is %.2f seconds\n", p.Timers.Config.IdleHoldTimeMin, p.Timers.Config.IdleHoldTimeMax, p.Timers.State.ConsecutiveFailures, p.Timers.State.IdleHoldTime)
+	}
+	if t := p.Timers.State.PeerDownConvergenceTime; t > 0 {
+		fmt.Printf("  Routes were withdrawn in %.3f seconds at the last peer down\n", t)
+	}
 
 	elems := make([]string, 0, 3)
 	if as := p.Conf.AllowOwnAsn; as > 0 {
@@ -473,6 +504,24 @@ func showNeighbor(args []string) error {
 					fmt.Printf("         %s:\t%s\n", item.RouteFamily, item.Mode)
 				}
 			}
+		case bgp.BGP_CAP_OUTBOUND_ROUTE_FILTERING:
+			fmt.Printf("    %s:\t%s\n", c.Code(), support)
+			if m := lookup(c, lcaps); m != nil {
+				fmt.Println("      Local:")
+				for _, item := range m.(*bgp.CapOutboundRouteFiltering).Tuples {
+					for _, e := range item.Entries {
+						fmt.Printf("         %s:\t%s %s\n", item.RouteFamily, e.Type, e.Mode)
+					}
+				}
+			}
+			if m := lookup(c, rcaps); m != nil {
+				fmt.Println("      Remote:")
+				for _, item := range m.(*bgp.CapOutboundRouteFiltering).Tuples {
+					for _, e := range item.Entries {
+						fmt.Printf("         %s:\t%s %s\n", item.RouteFamily, e.Type, e.Mode)
+					}
+				}
+			}
 		case bgp.BGP_CAP_FQDN:
 			fmt.Printf("    %s:\t%s\n", c.Code(), support)
 			if m := lookup(c, lcaps); m != nil {
@@ -510,11 +559,44 @@ func showNeighbor(args []string) error {
 	fmt.Printf("    Route Refresh: %10d %10d\n", p.State.Messages.Sent.Refresh, p.State.Messages.Received.Refresh)
 	fmt.Printf("    Discarded:     %10d %10d\n", p.State.Messages.Sent.Discarded, p.State.Messages.Received.Discarded)
 	fmt.Printf("    Total:         %10d %10d\n", p.State.Messages.Sent.Total, p.State.Messages.Received.Total)
+	if p.State.ErroneousUpdateMessages > 0 {
+		fmt.Printf("  Treat-as-withdraw:   %10d\n", p.State.ErroneousUpdateMessages)
+		types := make([]uint32, 0, len(p.State.TreatAsWithdrawAttributes))
+		for t := range p.State.TreatAsWithdrawAttributes {
+			types = append(types, t)
+		}
+		sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+		for _, t := range types {
+			name := "UNKNOWN"
+			if t != 0 {
+				name = bgp.BGPAttrType(t).String()
+			}
+			fmt.Printf("    %-32s %10d\n", name, p.State.TreatAsWithdrawAttributes[t])
+		}
+	}
 	fmt.Print("  Route statistics:\n")
 	fmt.Printf("    Advertised:    %10d\n", advertised)
 	fmt.Printf("    Received:      %10d\n", received)
 	fmt.Printf("    Accepted:      %10d\n", accepted)
 	first := true
+	for _, a := range p.AfiSafis {
+		if a.State == nil || a.State.UpdateStatistics == nil {
+			continue
+		}
+		if first {
+			fmt.Println("  Update statistics:")
+			first = false
+		}
+		stats := a.State.UpdateStatistics
+		rf := apiutil.ToRouteFamily(a.State.Family)
+		fmt.Printf("    %s:\n", bgp.AddressFamilyNameMap[rf])
+		fmt.Printf("      Updates:           %10d\n", stats.Updates)
+		fmt.Printf("      Withdrawals:       %10d\n", stats.Withdrawals)
+		fmt.Printf("      Duplicates:        %10d\n", stats.Duplicates)
+		fmt.Printf("      Attribute changes: %10d\n", stats.AttributeChanges)
+		fmt.Printf("      Flaps:             %10d\n", stats.Flaps)
+	}
+	first = true
 	for _, a := range p.AfiSafis {
 		limit := a.PrefixLimits
 		if limit != nil && limit.MaxPrefixes > 0 {
@@ -531,6 +613,80 @@ func showNeighbor(args []string) error {
 			}
 		}
 	}
+	first = true
+	for _, a := range p.AfiSafis {
+		orf := a.PrefixOrf
+		if orf == nil || orf.State == nil || orf.State.Mode == api.PrefixOrfConfig_NONE {
+			continue
+		}
+		if first {
+			fmt.Println("  Prefix ORF:")
+			first = false
+		}
+		rf := apiutil.ToRouteFamily(a.Config.Family)
+		fmt.Printf("    %s:\tmode %s", bgp.AddressFamilyNameMap[rf], strings.ToLower(orf.State.Mode.String()))
+		if orf.State.PrefixSet != "" {
+			fmt.Printf(", prefix-set %s", orf.State.PrefixSet)
+		}
+		fmt.Printf(", sent %d entries, received %d entries\n", orf.State.SentEntries, orf.State.ReceivedEntries)
+	}
+	first = true
+	for _, a := range p.AfiSafis {
+		mrai := a.MinimumAdvertisementInterval
+		if mrai == nil || mrai.State == nil || mrai.State.Interval == 0 {
+			continue
+		}
+		if first {
+			fmt.Println("  Minimum Advertisement Interval:")
+			first = false
+		}
+		rf := apiutil.ToRouteFamily(a.Config.Family)
+		fmt.Printf("    %s:\t%g seconds, %d paths coalesced\n", bgp.AddressFamilyNameMap[rf], mrai.State.Interval, mrai.State.Coalesced)
+	}
+	first = true
+	for _, a := range p.AfiSafis {
+		lp := a.LoopPrevention
+		if lp == nil || lp.Config == nil || (lp.Config.AllowOwnAsn == 0 && !lp.Config.ReplacePeerAsn) {
+			continue
+		}
+		if first {
+			fmt.Println("  Loop Prevention:")
+			first = false
+		}
+		elems := make([]string, 0, 2)
+		if lp.State.AllowOwnAsn > 0 {
+			elems = append(elems, fmt.Sprintf("Allow Own AS: %d", lp.State.AllowOwnAsn))
+		}
+		if lp.State.ReplacePeerAsn {
+			elems = append(elems, "Replace peer AS: enabled")
+		}
+		rf := apiutil.ToRouteFamily(a.Config.Family)
+		fmt.Printf("    %s:\t%s\n", bgp.AddressFamilyNameMap[rf], strings.Join(elems, ", "))
+	}
+	first = true
+	for _, a := range p.AfiSafis {
+		nh := a.NextHopOptions
+		if nh == nil || nh.State == nil {
+			continue
+		}
+		var s string
+		switch {
+		case nh.State.NextHopSelfForce:
+			s = "self (force)"
+		case nh.State.NextHopSelf:
+			s = "self"
+		case nh.State.NextHopUnchanged:
+			s = "unchanged"
+		default:
+			continue
+		}
+		if first {
+			fmt.Println("  Next Hop:")
+			first = false
+		}
+		rf := apiutil.ToRouteFamily(a.Config.Family)
+		fmt.Printf("    %s:\t%s\n", bgp.AddressFamilyNameMap[rf], s)
+	}
 	return nil
 }
 
@@ -553,6 +709,8 @@ func getPathSymbolString(p *api.Path, idx int, showBest bool) string {
 	if showBest {
 		if p.Best && !p.IsNexthopInvalid {
 			symbols += "*>"
+		} else if p.Multipath && !p.IsNexthopInvalid {
+			symbols += "*="
 		} else {
 			symbols += "* "
 		}
@@ -581,11 +739,36 @@ func getPathAttributeString(nlri bgp.AddrPrefixInterface, attrs []bgp.PathAttrib
 			if route.GWIPAddress != nil {
 				s = append(s, fmt.Sprintf("[GW: %s]", route.GWIPAddress.String()))
 			}
+		case *bgp.EVPNSelectiveMulticastEthernetTagRoute:
+			s = append(s, fmt.Sprintf("[Flags: %s]", evpnMulticastFlagsString(route.Flags)))
+		case *bgp.EVPNMulticastJoinSynchRoute:
+			s = append(s, fmt.Sprintf("[Flags: %s]", evpnMulticastFlagsString(route.Flags)))
+		case *bgp.EVPNMulticastLeaveSynchRoute:
+			s = append(s, fmt.Sprintf("[Max Response Time: %d]", route.MaxResponseTime))
+			s = append(s, fmt.Sprintf("[Flags: %s]", evpnMulticastFlagsString(route.Flags)))
 		}
 	}
 	return fmt.Sprint(s)
 }
 
+func evpnMulticastFlagsString(flags uint8) string {
+	l := make([]string, 0, 4)
+	for _, f := range []struct {
+		flag uint8
+		name string
+	}{
+		{bgp.EVPN_MULTICAST_FLAG_V1, "v1"},
+		{bgp.EVPN_MULTICAST_FLAG_V2, "v2"},
+		{bgp.EVPN_MULTICAST_FLAG_V3, "v3"},
+		{bgp.EVPN_MULTICAST_FLAG_EXCLUDE, "exclude"},
+	} {
+		if flags&f.flag != 0 {
+			l = append(l, f.name)
+		}
+	}
+	return strings.Join(l, "|")
+}
+
 func makeShowRouteArgs(p *api.Path, idx int, now time.Time, showAge, showBest, showLabel, showMUP, showSendMaxFiltered bool, showIdentifier bgp.BGPAddPathMode) []interface{} {
 	nlri, _ := apiutil.GetNativeNlri(p)
 
@@ -879,10 +1062,47 @@ func showNeighborRib(r string, name string, args []string) error {
 	}
 	rf := apiutil.ToRouteFamily(family)
 	switch rf {
-	case bgp.RF_IPv4_MPLS, bgp.RF_IPv6_MPLS, bgp.RF_IPv4_VPN, bgp.RF_IPv6_VPN, bgp.RF_EVPN:
+	case bgp.RF_IPv4_MPLS, bgp.RF_IPv6_MPLS, bgp.RF_IPv4_VPN, bgp.RF_IPv6_VPN, bgp.RF_CT_IPv4, bgp.RF_CT_IPv6, bgp.RF_CAR_IPv4, bgp.RF_CAR_IPv6, bgp.RF_EVPN:
 		showLabel = true
 	}
 
+	var pathFilter, pageToken string
+	var pageSize uint64
+	sortType := api.ListPathRequest_PREFIX
+	for i := 0; i < len(args); {
+		switch args[i] {
+		case "filter", "page-size", "page-token", "sort":
+		default:
+			i++
+			continue
+		}
+		if i+1 >= len(args) {
+			return fmt.Errorf("no %s value specified", args[i])
+		}
+		switch v := args[i+1]; args[i] {
+		case "filter":
+			pathFilter = v
+		case "page-size":
+			if pageSize, err = strconv.ParseUint(v, 10, 64); err != nil || pageSize == 0 {
+				return fmt.Errorf("invalid page size: %s", v)
+			}
+		case "page-token":
+			pageToken = v
+		case "sort":
+			switch v {
+			case "prefix":
+				sortType = api.ListPathRequest_PREFIX
+			case "age":
+				sortType = api.ListPathRequest_AGE
+			case "precedence":
+				sortType = api.ListPathRequest_PRECEDENCE
+			default:
+				return fmt.Errorf("invalid sort type: %s", v)
+			}
+		}
+		args = append(args[:i:i], args[i+2:]...)
+	}
+
 	var filter []*api.TableLookupPrefix
 	if len(args) > 0 {
 		target := args[0]
@@ -895,6 +1115,17 @@ func showNeighborRib(r string, name string, args []string) error {
 			if target == "t1st" {
 				showMUP = true
 			}
+		case bgp.RF_MVPN_IPv4, bgp.RF_MVPN_IPv6:
+			// Uses target as MVPN Route Type string or route key
+		case bgp.RF_CAR_IPv4, bgp.RF_CAR_IPv6:
+			// Uses target as "<prefix> [color <color>]"
+			if _, _, err = parseCIDRorIP(target); err != nil {
+				return err
+			}
+			if len(args) > 2 && args[1] == "color" {
+				target = strings.Join(args[:3], " ")
+				args = args[2:]
+			}
 		default:
 			if _, _, err = parseCIDRorIP(target); err != nil {
 				return err
@@ -909,7 +1140,7 @@ func showNeighborRib(r string, name string, args []string) error {
 				option = api.TableLookupPrefix_SHORTER
 			} else if args[0] == "rd" {
 				switch rf {
-				case bgp.RF_IPv4_VPN, bgp.RF_IPv6_VPN:
+				case bgp.RF_IPv4_VPN, bgp.RF_IPv6_VPN, bgp.RF_CT_IPv4, bgp.RF_CT_IPv6:
 				case bgp.RF_EVPN:
 					return fmt.Errorf("route distinguisher option for %q family is not implemented yet", rf)
 				default:
@@ -965,13 +1196,17 @@ func showNeighborRib(r string, name string, args []string) error {
 		Family:    family,
 		Name:      name,
 		Prefixes:  filter,
-		SortType:  api.ListPathRequest_PREFIX,
+		SortType:  sortType,
+		Filter:    pathFilter,
+		PageSize:  pageSize,
+		PageToken: pageToken,
 	})
 	if err != nil {
 		return err
 	}
 
 	rib := make([]*api.Destination, 0)
+	var nextPageToken string
 	for {
 		r, err := stream.Recv()
 		if err == io.EOF {
@@ -980,6 +1215,9 @@ func showNeighborRib(r string, name string, args []string) error {
 			return err
 		}
 		rib = append(rib, r.Destination)
+		if r.NextPageToken != "" {
+			nextPageToken = r.NextPageToken
+		}
 	}
 
 	switch r {
@@ -1028,8 +1266,8 @@ func showNeighborRib(r string, name string, args []string) error {
 	} else {
 		// show RIB
 		var dsts []*api.Destination
-		switch rf {
-		case bgp.RF_IPv4_UC, bgp.RF_IPv6_UC:
+		switch {
+		case (rf == bgp.RF_IPv4_UC || rf == bgp.RF_IPv6_UC) && sortType == api.ListPathRequest_PREFIX:
 			type d struct {
 				prefix net.IP
 				dst    *api.Destination
@@ -1076,6 +1314,9 @@ func showNeighborRib(r string, name string, args []string) error {
 		}
 		if len(dsts) > 0 {
 			showRoute(dsts, showAge, showBest, showLabel, showMUP, showSendMaxFiltered, showIdentifier)
+			if nextPageToken != "" {
+				fmt.Printf("Next page token: %s\n", nextPageToken)
+			}
 		} else {
 			fmt.Println("Network not in table")
 		}
@@ -1131,10 +1372,179 @@ func stateChangeNeighbor(cmd string, remoteIP string, args []string) error {
 			Address: remoteIP,
 		})
 		return err
+	case cmdGshut:
+		_, err := client.GracefulShutdownPeer(ctx, &api.GracefulShutdownPeerRequest{
+			Address:       remoteIP,
+			DrainTime:     neighborsOpts.DrainTime,
+			Communication: neighborsOpts.Reason,
+			Cancel:        neighborsOpts.Cancel,
+		})
+		return err
+	}
+	return nil
+}
+
+func showNeighborActivity(remoteIP string) error {
+	var family *api.Family
+	if subOpts.AddressFamily != "" {
+		f, err := checkAddressFamily(&api.Family{})
+		if err != nil {
+			return err
+		}
+		family = f
+	}
+	if remoteIP == "all" {
+		remoteIP = ""
+	}
+	stream, err := client.ListPrefixActivity(ctx, &api.ListPrefixActivityRequest{
+		Window:   neighborsOpts.Window,
+		Limit:    neighborsOpts.Limit,
+		Family:   family,
+		Neighbor: remoteIP,
+	})
+	if err != nil {
+		return err
+	}
+	l := make([]*api.PrefixActivity, 0)
+	for {
+		rsp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		l = append(l, rsp.Activity)
+	}
+
+	if globalOpts.Json {
+		j, _ := json.Marshal(l)
+		fmt.Println(string(j))
+		return nil
+	}
+	if globalOpts.Quiet {
+		for _, a := range l {
+			fmt.Println(a.Prefix)
+		}
+		return nil
+	}
+
+	prefixLen, neighborLen := len("Prefix"), len("Neighbor")
+	for _, a := range l {
+		if len(a.Prefix) > prefixLen {
+			prefixLen = len(a.Prefix)
+		}
+		if len(a.Neighbor) > neighborLen {
+			neighborLen = len(a.Neighbor)
+		}
+	}
+	format := fmt.Sprintf("%%-%ds  %%-%ds  %%10s  %%11s  %%10s  %%17s  %%10s\n", prefixLen, neighborLen)
+	fmt.Printf(format, "Prefix", "Neighbor", "Updates", "Withdrawals", "Duplicates", "Attribute changes", "Flaps")
+	for _, a := range l {
+		fmt.Printf(format, a.Prefix, a.Neighbor, fmt.Sprint(a.Updates), fmt.Sprint(a.Withdrawals), fmt.Sprint(a.Duplicates), fmt.Sprint(a.AttributeChanges), fmt.Sprint(a.Flaps))
+	}
+	return nil
+}
+
+func showNeighborConfig(remoteIP string) error {
+	rsp, err := client.GetEffectivePeerConfig(ctx, &api.GetEffectivePeerConfigRequest{
+		Address: remoteIP,
+	})
+	if err != nil {
+		return err
+	}
+
+	if globalOpts.Json {
+		j, _ := json.Marshal(rsp)
+		fmt.Println(string(j))
+		return nil
+	}
+
+	pathLen, valueLen := len("Path"), len("Value")
+	for _, s := range rsp.Sources {
+		if len(s.Path) > pathLen {
+			pathLen = len(s.Path)
+		}
+		if len(s.Value) > valueLen {
+			valueLen = len(s.Value)
+		}
+	}
+	format := fmt.Sprintf("%%-%ds  %%-%ds  %%s\n", pathLen, valueLen)
+	fmt.Printf(format, "Path", "Value", "Origin")
+	for _, s := range rsp.Sources {
+		origin := strings.ToLower(strings.Replace(s.Origin.String(), "_", "-", -1))
+		if s.Origin == api.PeerConfigSource_PEER_GROUP {
+			origin = fmt.Sprintf("%s %s", origin, s.PeerGroup)
+		}
+		fmt.Printf(format, s.Path, s.Value, origin)
+	}
+	return nil
+}
+
+func showNeighborCapture(remoteIP string) error {
+	stream, err := client.ListDebugCapture(ctx, &api.ListDebugCaptureRequest{
+		Address: remoteIP,
+	})
+	if err != nil {
+		return err
+	}
+	l := make([]*api.DebugCaptureMessage, 0)
+	for {
+		rsp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		l = append(l, rsp.Message)
+	}
+
+	if globalOpts.Json {
+		j, _ := json.Marshal(l)
+		fmt.Println(string(j))
+		return nil
+	}
+
+	for _, m := range l {
+		dir := "RECV"
+		if m.Direction == api.DebugCaptureMessage_SENT {
+			dir = "SENT"
+		}
+		fmt.Printf("%s %s\n", m.Timestamp.AsTime().Local().Format(time.RFC3339Nano), dir)
+		if m.Json != "" {
+			fmt.Printf("  %s\n", m.Json)
+		}
+		fmt.Printf("  %s\n", m.Hex)
 	}
 	return nil
 }
 
+func modNeighborCapture(cmd, remoteIP string) error {
+	var err error
+	switch cmd {
+	case cmdStart:
+		format := api.StartDebugCaptureRequest_PCAPNG
+		switch strings.ToLower(neighborsOpts.Format) {
+		case "", "pcapng":
+		case "raw":
+			format = api.StartDebugCaptureRequest_RAW
+		default:
+			return fmt.Errorf("invalid capture format: %s", neighborsOpts.Format)
+		}
+		_, err = client.StartDebugCapture(ctx, &api.StartDebugCaptureRequest{
+			Address:      remoteIP,
+			Size:         neighborsOpts.Size,
+			File:         neighborsOpts.File,
+			Format:       format,
+			RotationSize: neighborsOpts.RotationSize,
+		})
+	case cmdStop:
+		_, err = client.StopDebugCapture(ctx, &api.StopDebugCaptureRequest{
+			Address: remoteIP,
+		})
+	}
+	return err
+}
+
 func showNeighborPolicy(remoteIP, policyType string, indent int) error {
 	var err error
 	var dir api.PolicyDirection
@@ -1223,7 +1633,7 @@ func modNeighborPolicy(remoteIP, policyType, cmdType string, args []string) erro
 
 	var err error
 	switch cmdType {
-	case cmdAdd, cmdSet:
+	case cmdAdd, cmdSet, cmdReplace:
 		if len(args) < 1 {
 			return fmt.Errorf("%s <policy name>... [default {%s|%s}]", usage, "accept", "reject")
 		}
@@ -1249,6 +1659,15 @@ func modNeighborPolicy(remoteIP, policyType, cmdType string, args []string) erro
 		_, err = client.SetPolicyAssignment(ctx, &api.SetPolicyAssignmentRequest{
 			Assignment: assign,
 		})
+	case cmdReplace:
+		var rsp *api.ReplacePolicyAssignmentResponse
+		rsp, err = client.ReplacePolicyAssignment(ctx, &api.ReplacePolicyAssignmentRequest{
+			Assignment: assign,
+			DryRun:     policyAssignmentOpts.DryRun,
+		})
+		if err == nil {
+			showPolicyAssignmentChanges(rsp)
+		}
 	case cmdDel:
 		all := false
 		if len(args) == 0 {
@@ -1262,6 +1681,79 @@ func modNeighborPolicy(remoteIP, policyType, cmdType string, args []string) erro
 	return err
 }
 
+func showPolicyAssignmentChanges(rsp *api.ReplacePolicyAssignmentResponse) {
+	if globalOpts.Json {
+		j, _ := json.Marshal(rsp)
+		fmt.Println(string(j))
+		return
+	}
+	if globalOpts.Quiet {
+		return
+	}
+	fmt.Printf("Accepted: %d\n", rsp.Accepted)
+	fmt.Printf("Rejected: %d\n", rsp.Rejected)
+	fmt.Printf("Modified: %d\n", rsp.Modified)
+}
+
+func simulateGlobalPolicy(policyType string, args []string) error {
+	usage := fmt.Sprintf("usage: gobgp global policy %s %s <prefix> [<policy name>... [default {%s|%s}]] [-a <address family>]", policyType, cmdSimulate, "accept", "reject")
+	if len(args) < 1 {
+		return fmt.Errorf("%s", usage)
+	}
+	family, err := checkAddressFamily(ipv4UC)
+	if err != nil {
+		return err
+	}
+	assign := &api.PolicyAssignment{
+		Name: globalRIBName,
+	}
+	switch policyType {
+	case cmdImport:
+		assign.Direction = api.PolicyDirection_IMPORT
+	case cmdExport:
+		assign.Direction = api.PolicyDirection_EXPORT
+	}
+	prefix := args[0]
+	args, assign.DefaultAction, err = extractDefaultAction(args[1:])
+	if err != nil {
+		return fmt.Errorf("%s\n%s", err, usage)
+	}
+	for _, name := range args {
+		assign.Policies = append(assign.Policies, &api.Policy{Name: name})
+	}
+	rsp, err := client.SimulatePolicy(ctx, &api.SimulatePolicyRequest{
+		TableType:  api.TableType_GLOBAL,
+		Family:     family,
+		Prefix:     prefix,
+		Assignment: assign,
+	})
+	if err != nil {
+		return err
+	}
+	if globalOpts.Json {
+		j, _ := json.Marshal(rsp)
+		fmt.Println(string(j))
+		return nil
+	}
+
+	if len(rsp.Statements) > 0 {
+		format := "%-20s %-20s %-7s %s\n"
+		fmt.Printf(format, "Policy", "Statement", "Matched", "Actions")
+		for _, st := range rsp.Statements {
+			fmt.Printf(format, st.Policy, st.Statement, strconv.FormatBool(st.Matched), strings.Join(st.Actions, ", "))
+		}
+	}
+	result := strings.ToLower(rsp.Action.String())
+	if rsp.DefaultAction {
+		result += " (default)"
+	}
+	fmt.Printf("Result: %s\n", result)
+	if rsp.Path != nil {
+		showRoute([]*api.Destination{{Paths: []*api.Path{rsp.Path}}}, false, false, false, false, false, bgp.BGP_ADD_PATH_NONE)
+	}
+	return nil
+}
+
 func modNeighbor(cmdType string, args []string) error {
 	params := map[string]int{
 		"interface": paramSingle,
@@ -1283,7 +1775,10 @@ func modNeighbor(cmdType string, args []string) error {
 		params["remove-private-as"] = paramSingle
 		params["replace-peer-as"] = paramFlag
 		params["ebgp-multihop-ttl"] = paramSingle
-		usage += " [ local-as <VALUE> | family <address-families-list> | vrf <vrf-name> | route-reflector-client [<cluster-id>] | route-server-client | allow-own-as <num> | remove-private-as (all|replace) | replace-peer-as | ebgp-multihop-ttl <ttl>]"
+		params["ttl-min"] = paramSingle
+		params["next-hop-self"] = paramSingle
+		params["next-hop-unchanged"] = paramFlag
+		usage += " [ local-as <VALUE> | family <address-families-list> | vrf <vrf-name> | route-reflector-client [<cluster-id>] | route-server-client | allow-own-as <num> | remove-private-as (all|replace) | replace-peer-as | ebgp-multihop-ttl <ttl> | ttl-min <ttl> | next-hop-self [force] | next-hop-unchanged ]"
 	}
 
 	m, err := extractReserved(args, params)
@@ -1395,6 +1890,19 @@ func modNeighbor(cmdType string, args []string) error {
 		if _, ok := m["replace-peer-as"]; ok {
 			peer.Conf.ReplacePeerAsn = true
 		}
+		if option, ok := m["next-hop-self"]; ok {
+			if len(option) == 1 {
+				if option[0] != "force" {
+					return fmt.Errorf("invalid next-hop-self value: force")
+				}
+				peer.Conf.NextHopSelfForce = true
+			} else {
+				peer.Conf.NextHopSelf = true
+			}
+		}
+		if _, ok := m["next-hop-unchanged"]; ok {
+			peer.Conf.NextHopUnchanged = true
+		}
 		if len(m["ebgp-multihop-ttl"]) == 1 {
 			ttl, err := strconv.ParseUint(m["ebgp-multihop-ttl"][0], 10, 32)
 			if err != nil {
@@ -1405,6 +1913,16 @@ func modNeighbor(cmdType string, args []string) error {
 				MultihopTtl: uint32(ttl),
 			}
 		}
+		if len(m["ttl-min"]) == 1 {
+			ttl, err := strconv.ParseUint(m["ttl-min"][0], 10, 8)
+			if err != nil {
+				return err
+			}
+			peer.TtlSecurity = &api.TtlSecurity{
+				Enabled: true,
+				TtlMin:  uint32(ttl),
+			}
+		}
 		return nil
 	}
 
@@ -1450,7 +1968,7 @@ func newNeighborCmd() *cobra.Command {
 	c := make([]cmds, 0, 3)
 	c = append(c, cmds{[]string{cmdLocal, cmdAdjIn, cmdAdjOut, cmdAccepted, cmdRejected}, showNeighborRib})
 	c = append(c, cmds{[]string{cmdReset, cmdSoftReset, cmdSoftResetIn, cmdSoftResetOut}, resetNeighbor})
-	c = append(c, cmds{[]string{cmdShutdown, cmdEnable, cmdDisable}, stateChangeNeighbor})
+	c = append(c, cmds{[]string{cmdShutdown, cmdEnable, cmdDisable, cmdGshut}, stateChangeNeighbor})
 
 	for _, v := range c {
 		f := v.f
@@ -1460,7 +1978,7 @@ func newNeighborCmd() *cobra.Command {
 				Run: func(cmd *cobra.Command, args []string) {
 					addr := ""
 					switch name {
-					case cmdReset, cmdSoftReset, cmdSoftResetIn, cmdSoftResetOut, cmdShutdown:
+					case cmdReset, cmdSoftReset, cmdSoftResetIn, cmdSoftResetOut, cmdShutdown, cmdGshut:
 						if args[len(args)-1] == "all" {
 							addr = "all"
 						}
@@ -1480,6 +1998,9 @@ func newNeighborCmd() *cobra.Command {
 			}
 			neighborCmdImpl.AddCommand(c)
 			switch name {
+			case cmdGshut:
+				c.Flags().Uint32VarP(&neighborsOpts.DrainTime, "drain-time", "", 0, "seconds before the neighbor is disabled, 60 if 0")
+				c.Flags().BoolVarP(&neighborsOpts.Cancel, "cancel", "", false, "end graceful shutdown without disabling the neighbor")
 			case cmdLocal, cmdAdjIn, cmdAdjOut:
 				n := name
 				c.AddCommand(&cobra.Command{
@@ -1526,7 +2047,7 @@ func newNeighborCmd() *cobra.Command {
 			},
 		}
 
-		for _, w := range []string{cmdAdd, cmdDel, cmdSet} {
+		for _, w := range []string{cmdAdd, cmdDel, cmdSet, cmdReplace} {
 			subcmd := &cobra.Command{
 				Use: w,
 				Run: func(subcmd *cobra.Command, args []string) {
@@ -1541,6 +2062,9 @@ func newNeighborCmd() *cobra.Command {
 					}
 				},
 			}
+			if w == cmdReplace {
+				subcmd.Flags().BoolVarP(&policyAssignmentOpts.DryRun, "dry-run", "", false, "count the routes whose disposition would change without replacing")
+			}
 			cmd.AddCommand(subcmd)
 		}
 
@@ -1550,6 +2074,74 @@ func newNeighborCmd() *cobra.Command {
 
 	neighborCmdImpl.AddCommand(policyCmd)
 
+	activityCmd := &cobra.Command{
+		Use: cmdActivity,
+		Run: func(cmd *cobra.Command, args []string) {
+			addr := args[0]
+			if addr != "all" {
+				l, err := getNeighbors(addr, false)
+				if err != nil {
+					exitWithError(err)
+				}
+				addr = l[0].State.NeighborAddress
+			}
+			if err := showNeighborActivity(addr); err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+	activityCmd.Flags().Uint32VarP(&neighborsOpts.Window, "window", "", 0, "seconds of the window to count the changes of the prefixes, 300 if 0")
+	activityCmd.Flags().Uint32VarP(&neighborsOpts.Limit, "limit", "", 0, "number of the most active prefixes to show, 10 if 0")
+	neighborCmdImpl.AddCommand(activityCmd)
+
+	captureCmd := &cobra.Command{
+		Use: cmdCapture,
+		Run: func(cmd *cobra.Command, args []string) {
+			l, err := getNeighbors(args[0], false)
+			if err != nil {
+				exitWithError(err)
+			}
+			if err := showNeighborCapture(l[0].State.NeighborAddress); err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+	for _, v := range []string{cmdStart, cmdStop} {
+		subcmd := &cobra.Command{
+			Use: v,
+			Run: func(cmd *cobra.Command, args []string) {
+				l, err := getNeighbors(args[len(args)-1], false)
+				if err != nil {
+					exitWithError(err)
+				}
+				if err := modNeighborCapture(cmd.Use, l[0].State.NeighborAddress); err != nil {
+					exitWithError(err)
+				}
+			},
+		}
+		if v == cmdStart {
+			subcmd.Flags().Uint32VarP(&neighborsOpts.Size, "size", "", 0, "number of the latest messages kept, 1000 if 0")
+			subcmd.Flags().StringVarP(&neighborsOpts.File, "file", "", "", "write the messages also to the file")
+			subcmd.Flags().StringVarP(&neighborsOpts.Format, "format", "", "pcapng", "format of the file, pcapng or raw")
+			subcmd.Flags().Uint64VarP(&neighborsOpts.RotationSize, "rotation-size", "", 0, "rotate the file when its size would exceed the bytes, disabled if 0")
+		}
+		captureCmd.AddCommand(subcmd)
+	}
+	neighborCmdImpl.AddCommand(captureCmd)
+
+	neighborCmdImpl.AddCommand(&cobra.Command{
+		Use: cmdConfig,
+		Run: func(cmd *cobra.Command, args []string) {
+			l, err := getNeighbors(args[0], false)
+			if err != nil {
+				exitWithError(err)
+			}
+			if err := showNeighborConfig(l[0].State.NeighborAddress); err != nil {
+				exitWithError(err)
+			}
+		},
+	})
+
 	neighborCmd := &cobra.Command{
 		Use: cmdNeighbor,
 		Run: func(cmd *cobra.Command, args []string) {
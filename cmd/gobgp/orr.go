@@ -0,0 +1,132 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	api "github.com/osrg/gobgp/v3/api"
+)
+
+func parseOrrVantagePoint(args []string) (*api.OrrVantagePoint, error) {
+	if len(args) == 0 || len(args)%2 != 1 {
+		return nil, fmt.Errorf("usage: gobgp orr %s <name> [<prefix> <cost>...]", cmdAdd)
+	}
+	vp := &api.OrrVantagePoint{
+		Name: args[0],
+	}
+	for i := 1; i < len(args); i += 2 {
+		cost, err := strconv.ParseUint(args[i+1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid igp cost: %s", args[i+1])
+		}
+		vp.IgpCosts = append(vp.IgpCosts, &api.OrrIgpCost{
+			Prefix: args[i],
+			Cost:   uint32(cost),
+		})
+	}
+	return vp, nil
+}
+
+func showOrrVantagePoint(args []string) error {
+	name := ""
+	if len(args) > 0 {
+		name = args[0]
+	}
+	stream, err := client.ListOrrVantagePoint(ctx, &api.ListOrrVantagePointRequest{Name: name})
+	if err != nil {
+		return err
+	}
+	l := make([]*api.ListOrrVantagePointResponse, 0)
+	for {
+		rsp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		l = append(l, rsp)
+	}
+	sort.Slice(l, func(i, j int) bool {
+		return l[i].VantagePoint.Name < l[j].VantagePoint.Name
+	})
+	if globalOpts.Json {
+		j, _ := json.Marshal(l)
+		fmt.Println(string(j))
+		return nil
+	}
+	if globalOpts.Quiet {
+		for _, r := range l {
+			fmt.Println(r.VantagePoint.Name)
+		}
+		return nil
+	}
+	for _, r := range l {
+		fmt.Printf("Vantage Point: %s\n", r.VantagePoint.Name)
+		fmt.Printf("  Peers: %s\n", strings.Join(r.Peers, ", "))
+		fmt.Printf("  IGP Costs:\n")
+		for _, c := range r.VantagePoint.IgpCosts {
+			fmt.Printf("    %-44s %d\n", c.Prefix, c.Cost)
+		}
+	}
+	return nil
+}
+
+func newOrrCmd() *cobra.Command {
+	orrCmd := &cobra.Command{
+		Use: cmdOrr,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := showOrrVantagePoint(args); err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	addCmd := &cobra.Command{
+		Use: cmdAdd,
+		Run: func(cmd *cobra.Command, args []string) {
+			vp, err := parseOrrVantagePoint(args)
+			if err == nil {
+				_, err = client.AddOrrVantagePoint(ctx, &api.AddOrrVantagePointRequest{VantagePoint: vp})
+			}
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	delCmd := &cobra.Command{
+		Use: cmdDel,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				exitWithError(fmt.Errorf("usage: gobgp orr %s <name>", cmdDel))
+			}
+			if _, err := client.DeleteOrrVantagePoint(ctx, &api.DeleteOrrVantagePointRequest{Name: args[0]}); err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	orrCmd.AddCommand(addCmd, delCmd)
+	return orrCmd
+}
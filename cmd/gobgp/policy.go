@@ -92,6 +92,20 @@ func prettyString(v interface{}) string {
 			return fmt.Sprintf("+%d", a.Value)
 		}
 		return fmt.Sprintf("%d", a.Value)
+	case *api.AigpAction:
+		if a.Type == api.AigpAction_MOD && a.Value > 0 {
+			return fmt.Sprintf("+%d", a.Value)
+		}
+		return fmt.Sprintf("%d", a.Value)
+	case *api.LinkBandwidthAction:
+		bw := strconv.FormatFloat(float64(a.Bandwidth), 'f', -1, 32)
+		if a.Cumulative {
+			bw = "cumulative"
+		}
+		if a.Transitive {
+			return bw + " transitive"
+		}
+		return bw
 	case *api.LocalPrefAction:
 		return fmt.Sprintf("%d", a.Value)
 	case *api.NexthopAction:
@@ -105,7 +119,17 @@ func prettyString(v interface{}) string {
 		}
 		return a.Address
 	case *api.AsPrependAction:
-		return fmt.Sprintf("prepend %d %d times", a.Asn, a.Repeat)
+		asn := fmt.Sprintf("%d", a.Asn)
+		switch {
+		case a.UseLeftMost:
+			asn = "last-as"
+		case a.UsePeerAs:
+			asn = "peer-as"
+		}
+		if a.RepeatCommunity != "" {
+			return fmt.Sprintf("prepend %s %d times or as many times as %s", asn, a.Repeat, a.RepeatCommunity)
+		}
+		return fmt.Sprintf("prepend %s %d times", asn, a.Repeat)
 	case *api.OriginAction:
 		return fmt.Sprintf("%v", a.GetOrigin())
 	}
@@ -475,6 +499,12 @@ func printStatement(indent int, s *api.Statement) {
 	if c.AfiSafiIn != nil {
 		fmt.Printf("%sAFI SAFI In: %s\n", ind, c.AfiSafiIn)
 	}
+	if c.FlowSpecMatch != "" {
+		fmt.Printf("%sFlowSpec: %s\n", ind, c.FlowSpecMatch)
+	}
+	if len(c.PathIdentifierIn) > 0 {
+		fmt.Printf("%sPath Identifier In: %v\n", ind, c.PathIdentifierIn)
+	}
 
 	fmt.Printf("%sActions:\n", sIndent(indent+2))
 	a := s.Actions
@@ -490,6 +520,12 @@ func printStatement(indent int, s *api.Statement) {
 	if a.Med != nil {
 		fmt.Println(ind, "MED: ", prettyString(a.Med))
 	}
+	if a.Aigp != nil {
+		fmt.Println(ind, "AIGP: ", prettyString(a.Aigp))
+	}
+	if a.LinkBandwidth != nil {
+		fmt.Println(ind, "LinkBandwidth: ", prettyString(a.LinkBandwidth))
+	}
 	if a.LocalPref != nil {
 		fmt.Println(ind, "LocalPref: ", prettyString(a.LocalPref))
 	}
@@ -642,7 +678,7 @@ func modCondition(name, op string, args []string) error {
 	}
 	usage := fmt.Sprintf("usage: gobgp policy statement %s %s condition", name, op)
 	if len(args) < 1 {
-		return fmt.Errorf("%s { prefix | neighbor | as-path | community | ext-community | large-community | as-path-length | rpki | route-type | next-hop-in-list | afi-safi-in }", usage)
+		return fmt.Errorf("%s { prefix | neighbor | as-path | community | ext-community | large-community | as-path-length | rpki | route-type | next-hop-in-list | afi-safi-in | flowspec | path-identifier-in }", usage)
 	}
 	typ := args[0]
 	args = args[1:]
@@ -815,8 +851,26 @@ func modCondition(name, op string, args []string) error {
 			afiSafisInList = append(afiSafisInList, apiutil.ToApiFamily(afi, safi))
 		}
 		stmt.Conditions.AfiSafiIn = afiSafisInList
+	case "flowspec":
+		if len(args) < 1 {
+			return fmt.Errorf("%s flowspec <MATCH>...", usage)
+		}
+		stmt.Conditions.FlowSpecMatch = strings.Join(args, " ")
+	case "path-identifier-in":
+		if len(args) < 1 {
+			return fmt.Errorf("%s path-identifier-in <ID>...", usage)
+		}
+		ids := make([]uint32, 0, len(args))
+		for _, arg := range args {
+			id, err := strconv.ParseUint(arg, 10, 32)
+			if err != nil {
+				return err
+			}
+			ids = append(ids, uint32(id))
+		}
+		stmt.Conditions.PathIdentifierIn = ids
 	default:
-		return fmt.Errorf("%s { prefix | neighbor | as-path | community | ext-community | large-community | as-path-length | rpki | route-type | next-hop-in-list | afi-safi-in }", usage)
+		return fmt.Errorf("%s { prefix | neighbor | as-path | community | ext-community | large-community | as-path-length | rpki | route-type | next-hop-in-list | afi-safi-in | flowspec | path-identifier-in }", usage)
 	}
 
 	var err error
@@ -842,7 +896,7 @@ func modAction(name, op string, args []string) error {
 	}
 	usage := fmt.Sprintf("usage: gobgp policy statement %s %s action", name, op)
 	if len(args) < 1 {
-		return fmt.Errorf("%s { reject | accept | community | ext-community | large-community | med | local-pref | as-prepend | next-hop }", usage)
+		return fmt.Errorf("%s { reject | accept | community | ext-community | large-community | med | aigp | link-bandwidth | local-pref | as-prepend | next-hop }", usage)
 	}
 	typ := args[0]
 	args = args[1:]
@@ -921,6 +975,42 @@ func modAction(name, op string, args []string) error {
 		default:
 			return fmt.Errorf("%s med { add | sub | set } <value>", usage)
 		}
+	case "aigp":
+		stmt.Actions.Aigp = &api.AigpAction{}
+		if len(args) < 2 {
+			return fmt.Errorf("%s aigp { add | sub | set } <value>", usage)
+		}
+		aigp, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return err
+		}
+		stmt.Actions.Aigp.Value = aigp
+		switch strings.ToLower(args[0]) {
+		case "add":
+			stmt.Actions.Aigp.Type = api.AigpAction_MOD
+		case "sub":
+			stmt.Actions.Aigp.Type = api.AigpAction_MOD
+			stmt.Actions.Aigp.Value = -1 * stmt.Actions.Aigp.Value
+		case "set":
+			stmt.Actions.Aigp.Type = api.AigpAction_REPLACE
+		default:
+			return fmt.Errorf("%s aigp { add | sub | set } <value>", usage)
+		}
+	case "link-bandwidth":
+		stmt.Actions.LinkBandwidth = &api.LinkBandwidthAction{}
+		if len(args) < 1 || len(args) > 2 || (len(args) == 2 && args[1] != "transitive") {
+			return fmt.Errorf("%s link-bandwidth { <bytes per second> | cumulative } [transitive]", usage)
+		}
+		if args[0] == "cumulative" {
+			stmt.Actions.LinkBandwidth.Cumulative = true
+		} else {
+			bw, err := strconv.ParseFloat(args[0], 32)
+			if err != nil || bw < 0 {
+				return fmt.Errorf("invalid link bandwidth: %s", args[0])
+			}
+			stmt.Actions.LinkBandwidth.Bandwidth = float32(bw)
+		}
+		stmt.Actions.LinkBandwidth.Transitive = len(args) == 2
 	case "local-pref":
 		stmt.Actions.LocalPref = &api.LocalPrefAction{}
 		if len(args) < 1 {
@@ -933,16 +1023,29 @@ func modAction(name, op string, args []string) error {
 		stmt.Actions.LocalPref.Value = uint32(value)
 	case "as-prepend":
 		stmt.Actions.AsPrepend = &api.AsPrependAction{}
-		if len(args) < 2 {
-			return fmt.Errorf("%s as-prepend { <asn> | last-as } <repeat-value>", usage)
+		if len(args) != 2 && !(len(args) == 4 && args[2] == "community") {
+			return fmt.Errorf("%s as-prepend { <asn> | last-as | peer-as } <repeat-value> [community <community>]", usage)
+		}
+		switch args[0] {
+		case "last-as":
+			stmt.Actions.AsPrepend.UseLeftMost = true
+		case "peer-as":
+			stmt.Actions.AsPrepend.UsePeerAs = true
+		default:
+			asn, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+			stmt.Actions.AsPrepend.Asn = uint32(asn)
 		}
-		asn, _ := strconv.ParseUint(args[0], 10, 32)
-		stmt.Actions.AsPrepend.Asn = uint32(asn)
 		repeat, err := strconv.ParseUint(args[1], 10, 8)
 		if err != nil {
 			return err
 		}
 		stmt.Actions.AsPrepend.Repeat = uint32(repeat)
+		if len(args) == 4 {
+			stmt.Actions.AsPrepend.RepeatCommunity = args[3]
+		}
 	case "next-hop":
 		stmt.Actions.Nexthop = &api.NexthopAction{}
 		if len(args) != 1 {
@@ -0,0 +1,163 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/apiutil"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+var attributeTypeNames = map[string]bgp.BGPAttrType{
+	"origin":           bgp.BGP_ATTR_TYPE_ORIGIN,
+	"as-path":          bgp.BGP_ATTR_TYPE_AS_PATH,
+	"next-hop":         bgp.BGP_ATTR_TYPE_NEXT_HOP,
+	"med":              bgp.BGP_ATTR_TYPE_MULTI_EXIT_DISC,
+	"local-pref":       bgp.BGP_ATTR_TYPE_LOCAL_PREF,
+	"atomic-aggregate": bgp.BGP_ATTR_TYPE_ATOMIC_AGGREGATE,
+	"aggregator":       bgp.BGP_ATTR_TYPE_AGGREGATOR,
+	"community":        bgp.BGP_ATTR_TYPE_COMMUNITIES,
+	"originator-id":    bgp.BGP_ATTR_TYPE_ORIGINATOR_ID,
+	"cluster-list":     bgp.BGP_ATTR_TYPE_CLUSTER_LIST,
+	"ext-community":    bgp.BGP_ATTR_TYPE_EXTENDED_COMMUNITIES,
+	"aigp":             bgp.BGP_ATTR_TYPE_AIGP,
+	"large-community":  bgp.BGP_ATTR_TYPE_LARGE_COMMUNITY,
+}
+
+// parseAttributeType parses the name or the number of the path attribute
+// type.
+func parseAttributeType(s string) (uint32, error) {
+	if t, y := attributeTypeNames[s]; y {
+		return uint32(t), nil
+	}
+	t, err := strconv.ParseUint(s, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid path attribute type: %s", s)
+	}
+	return uint32(t), nil
+}
+
+func formatDiffPath(p *api.Path) string {
+	nlri, _ := apiutil.GetNativeNlri(p)
+	attrs, _ := apiutil.GetNativePathAttributes(p)
+	nexthop, aspath := "", ""
+	for _, a := range attrs {
+		switch a := a.(type) {
+		case *bgp.PathAttributeAsPath:
+			aspath = a.String()
+		case *bgp.PathAttributeNextHop:
+			nexthop = a.Value.String()
+		case *bgp.PathAttributeMpReachNLRI:
+			nexthop = a.Nexthop.String()
+		}
+	}
+	return fmt.Sprintf("%s [%s] %s", nexthop, aspath, getPathAttributeString(nlri, attrs))
+}
+
+func showRibDiff(args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("usage: gobgp rib %s <neighbor address> <neighbor address> [--ignore-attribute <type>...] [-a <address family>]", cmdDiff)
+	}
+	family, err := checkAddressFamily(ipv4UC)
+	if err != nil {
+		return err
+	}
+	r := &api.ListRibDiffRequest{
+		NeighborA: args[0],
+		NeighborB: args[1],
+		Family:    family,
+	}
+	for _, s := range ribDiffOpts.IgnoredAttributes {
+		t, err := parseAttributeType(s)
+		if err != nil {
+			return err
+		}
+		r.IgnoredAttributes = append(r.IgnoredAttributes, t)
+	}
+	stream, err := client.ListRibDiff(ctx, r)
+	if err != nil {
+		return err
+	}
+	l := make([]*api.RibDiff, 0)
+	for {
+		rsp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		l = append(l, rsp.Diff)
+	}
+
+	if globalOpts.Json {
+		j, _ := json.Marshal(l)
+		fmt.Println(string(j))
+		return nil
+	}
+	if globalOpts.Quiet {
+		for _, d := range l {
+			fmt.Println(d.Prefix)
+		}
+		return nil
+	}
+
+	var onlyA, onlyB, different int
+	for _, d := range l {
+		switch d.Type {
+		case api.RibDiff_ONLY_A:
+			onlyA++
+			fmt.Printf("%s only in %s\n", d.Prefix, args[0])
+			fmt.Printf("    %s\n", formatDiffPath(d.PathA))
+		case api.RibDiff_ONLY_B:
+			onlyB++
+			fmt.Printf("%s only in %s\n", d.Prefix, args[1])
+			fmt.Printf("    %s\n", formatDiffPath(d.PathB))
+		case api.RibDiff_DIFFERENT:
+			different++
+			fmt.Printf("%s different\n", d.Prefix)
+			fmt.Printf("    %s: %s\n", args[0], formatDiffPath(d.PathA))
+			fmt.Printf("    %s: %s\n", args[1], formatDiffPath(d.PathB))
+		}
+	}
+	fmt.Printf("Only in %s: %d, Only in %s: %d, Different: %d\n", args[0], onlyA, args[1], onlyB, different)
+	return nil
+}
+
+func newRibCmd() *cobra.Command {
+	ribCmd := &cobra.Command{
+		Use: cmdRib,
+	}
+	ribCmd.PersistentFlags().StringVarP(&subOpts.AddressFamily, "address-family", "a", "", "address family")
+
+	diffCmd := &cobra.Command{
+		Use: cmdDiff,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := showRibDiff(args); err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+	diffCmd.Flags().StringSliceVarP(&ribDiffOpts.IgnoredAttributes, "ignore-attribute", "", nil, "comma-separated path attributes not compared, names such as next-hop and med or numbers")
+	ribCmd.AddCommand(diffCmd)
+	return ribCmd
+}
@@ -21,14 +21,18 @@ import (
 	_ "net/http/pprof"
 	"strconv"
 
-	api "github.com/osrg/gobgp/v3/api"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/server"
 )
 
 var globalOpts struct {
 	Host           string
 	Port           int
 	Target         string
+	Instance       string
 	Debug          bool
 	Quiet          bool
 	Json           bool
@@ -61,9 +65,13 @@ func newRootCmd() *cobra.Command {
 				}()
 			}
 
-			if !globalOpts.GenCmpl {
+			// the commands in the shell use the client of the shell
+			if !globalOpts.GenCmpl && client == nil {
 				var err error
 				ctx = context.Background()
+				if globalOpts.Instance != "" {
+					ctx = metadata.AppendToOutgoingContext(ctx, server.GrpcInstanceMetadataKey, globalOpts.Instance)
+				}
 				client, cancel, err = newClient(ctx)
 				if err != nil {
 					cancel()
@@ -89,6 +97,7 @@ func newRootCmd() *cobra.Command {
 	rootCmd.PersistentFlags().StringVarP(&globalOpts.Host, "host", "u", "127.0.0.1", "host")
 	rootCmd.PersistentFlags().IntVarP(&globalOpts.Port, "port", "p", 50051, "port")
 	rootCmd.PersistentFlags().StringVarP(&globalOpts.Target, "target", "", "", "alternative to host/port when using UDS. Ex: unix:///var/run/go-bgp.sock if running gobgpd with a UDS socket.")
+	rootCmd.PersistentFlags().StringVarP(&globalOpts.Instance, "instance", "", "", "name of the BGP instance to operate on when gobgpd runs several instances")
 	rootCmd.PersistentFlags().BoolVarP(&globalOpts.Json, "json", "j", false, "use json format to output format")
 	rootCmd.PersistentFlags().BoolVarP(&globalOpts.Debug, "debug", "d", false, "use debug")
 	rootCmd.PersistentFlags().BoolVarP(&globalOpts.Quiet, "quiet", "q", false, "use quiet")
@@ -109,6 +118,10 @@ func newRootCmd() *cobra.Command {
 	rpkiCmd := newRPKICmd()
 	bmpCmd := newBmpCmd()
 	logLevelCmd := newLogLevelCmd()
-	rootCmd.AddCommand(globalCmd, neighborCmd, vrfCmd, policyCmd, monitorCmd, mrtCmd, rpkiCmd, bmpCmd, logLevelCmd)
+	shellCmd := newShellCmd()
+	ribCmd := newRibCmd()
+	rtbhCmd := newRtbhCmd()
+	orrCmd := newOrrCmd()
+	rootCmd.AddCommand(globalCmd, neighborCmd, vrfCmd, policyCmd, monitorCmd, mrtCmd, rpkiCmd, bmpCmd, logLevelCmd, shellCmd, ribCmd, rtbhCmd, orrCmd)
 	return rootCmd
 }
@@ -20,6 +20,7 @@ import (
 	"io"
 	"net"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -66,6 +67,8 @@ func showRPKIServer(args []string) error {
 			}
 			fmt.Printf("Session: %s, State: %s\n", r.Conf.Address, up)
 			fmt.Println("  Port:", r.Conf.RemotePort)
+			fmt.Println("  Transport:", strings.ToLower(r.Conf.Transport.String()))
+			fmt.Println("  Preference:", r.Conf.Preference)
 			fmt.Println("  Serial:", r.State.Serial)
 			fmt.Printf("  Prefix: %d/%d\n", r.State.PrefixIpv4, r.State.PrefixIpv6)
 			fmt.Printf("  Record: %d/%d\n", r.State.RecordIpv4, r.State.RecordIpv6)
@@ -125,7 +128,11 @@ func showRPKITable(args []string) error {
 			IP:   net.ParseIP(r.GetPrefix()),
 			Mask: net.CIDRMask(int(r.GetPrefixlen()), bits),
 		}
-		fmt.Printf(format, n.String(), fmt.Sprint(r.Maxlen), fmt.Sprint(r.Asn), net.JoinHostPort(r.Conf.Address, strconv.Itoa(int(r.Conf.RemotePort))))
+		src := r.Conf.Address
+		if r.Conf.RemotePort != 0 {
+			src = net.JoinHostPort(r.Conf.Address, strconv.Itoa(int(r.Conf.RemotePort)))
+		}
+		fmt.Printf(format, n.String(), fmt.Sprint(r.Maxlen), fmt.Sprint(r.Asn), src)
 	}
 	return nil
 }
@@ -193,5 +200,25 @@ func newRPKICmd() *cobra.Command {
 	}
 	tableCmd.PersistentFlags().StringVarP(&subOpts.AddressFamily, "address-family", "a", "", "address family")
 	rpkiCmd.AddCommand(tableCmd)
+
+	slurmCmd := &cobra.Command{
+		Use: cmdRPKISlurm,
+		Run: func(cmd *cobra.Command, args []string) {
+			var file string
+			switch {
+			case len(args) == 2 && args[0] == "load":
+				file = args[1]
+			case len(args) == 1 && args[0] == "clear":
+			default:
+				exitWithError(fmt.Errorf("usage: gobgp rpki slurm [load <file>|clear]"))
+			}
+			if _, err := client.SetRpkiSlurm(ctx, &api.SetRpkiSlurmRequest{
+				File: file,
+			}); err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+	rpkiCmd.AddCommand(slurmCmd)
 	return rpkiCmd
 }
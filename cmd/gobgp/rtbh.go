@@ -0,0 +1,147 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+)
+
+func parseRtbh(args []string) (*api.Rtbh, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("usage: gobgp rtbh %s <prefix> [--community <COMMUNITY>[,<COMMUNITY>...]] [--nexthop <ADDRESS>] [--lifetime <DURATION>] [--requester <NAME>] [--reason <TEXT>]", cmdAdd)
+	}
+	r := &api.Rtbh{
+		Prefix:    args[0],
+		Nexthop:   rtbhOpts.Nexthop,
+		Lifetime:  uint32(rtbhOpts.Lifetime / time.Second),
+		Requester: rtbhOpts.Requester,
+		Reason:    rtbhOpts.Reason,
+	}
+	if rtbhOpts.Lifetime > 0 && r.Lifetime == 0 {
+		return nil, fmt.Errorf("lifetime shorter than a second: %s", rtbhOpts.Lifetime)
+	}
+	for _, arg := range rtbhOpts.Communities {
+		c, err := table.ParseCommunity(arg)
+		if err != nil {
+			return nil, err
+		}
+		r.Communities = append(r.Communities, c)
+	}
+	return r, nil
+}
+
+func showRtbh(args []string) error {
+	prefix := ""
+	if len(args) > 0 {
+		prefix = args[0]
+	}
+	stream, err := client.ListRtbh(ctx, &api.ListRtbhRequest{Prefix: prefix})
+	if err != nil {
+		return err
+	}
+	l := make([]*api.Rtbh, 0)
+	for {
+		rsp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		l = append(l, rsp.Rtbh)
+	}
+	if globalOpts.Json {
+		j, _ := json.Marshal(l)
+		fmt.Println(string(j))
+		return nil
+	}
+	if globalOpts.Quiet {
+		for _, r := range l {
+			fmt.Println(r.Prefix)
+		}
+		return nil
+	}
+	format := "%-44s %-16s %-10s %-10s %-24s %-16s %s\n"
+	fmt.Printf(format, "Prefix", "Next Hop", "Age", "Expires", "Communities", "Requester", "Reason")
+	for _, r := range l {
+		expires := "never"
+		if r.Lifetime > 0 {
+			expires = (time.Duration(r.RemainingLifetime) * time.Second).String()
+		}
+		comms := make([]string, 0, len(r.Communities))
+		for _, c := range r.Communities {
+			comms = append(comms, fmt.Sprintf("%d:%d", c>>16, c&0xffff))
+		}
+		fmt.Printf(format, r.Prefix, r.Nexthop, formatTimedelta(r.Created.AsTime()), expires, strings.Join(comms, ","), r.Requester, r.Reason)
+	}
+	return nil
+}
+
+func newRtbhCmd() *cobra.Command {
+	rtbhCmd := &cobra.Command{
+		Use: cmdRtbh,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := showRtbh(args); err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	addCmd := &cobra.Command{
+		Use: cmdAdd,
+		Run: func(cmd *cobra.Command, args []string) {
+			r, err := parseRtbh(args)
+			if err == nil {
+				_, err = client.AddRtbh(ctx, &api.AddRtbhRequest{Rtbh: r})
+			}
+			if err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+	addCmd.Flags().StringSliceVarP(&rtbhOpts.Communities, "community", "", nil, "communities attached besides blackhole and no-export")
+	addCmd.Flags().StringVarP(&rtbhOpts.Nexthop, "nexthop", "", "", "next hop, 192.0.2.1 or 100::1 by default")
+	addCmd.Flags().DurationVarP(&rtbhOpts.Lifetime, "lifetime", "", 0, "duration after which the route is withdrawn")
+	addCmd.Flags().StringVarP(&rtbhOpts.Requester, "requester", "", "", "who requests the route")
+	addCmd.Flags().StringVarP(&rtbhOpts.Reason, "reason", "", "", "why the route is requested")
+
+	delCmd := &cobra.Command{
+		Use: cmdDel,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				exitWithError(fmt.Errorf("usage: gobgp rtbh %s { <prefix> | %s }", cmdDel, cmdAll))
+			}
+			prefix := args[0]
+			if prefix == cmdAll {
+				prefix = ""
+			}
+			if _, err := client.DeleteRtbh(ctx, &api.DeleteRtbhRequest{Prefix: prefix}); err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+
+	rtbhCmd.AddCommand(addCmd, delCmd)
+	return rtbhCmd
+}
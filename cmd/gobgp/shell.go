@@ -0,0 +1,485 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	api "github.com/osrg/gobgp/v3/api"
+)
+
+const (
+	shellCd      = "cd"
+	shellHistory = "history"
+	shellHelp    = "help"
+	shellExit    = "exit"
+	shellQuit    = "quit"
+)
+
+var shellBuiltins = []string{shellCd, shellExit, shellHelp, shellHistory, shellQuit}
+
+// the words following "neighbor <address>" and "vrf <name>", which
+// are not in the command tree.
+var (
+	neighborShellCmds = []string{cmdLocal, cmdAdjIn, cmdAdjOut, cmdAccepted, cmdRejected, cmdReset, cmdSoftReset, cmdSoftResetIn, cmdSoftResetOut, cmdShutdown, cmdEnable, cmdDisable, cmdGshut, cmdPolicy, cmdConfig}
+	vrfShellCmds      = []string{cmdRib, cmdNeighbor}
+)
+
+// shellCommandFailed is panicked by exitWithError in the shell to go
+// back to the prompt instead of exiting.
+type shellCommandFailed struct{}
+
+// true while a command runs in the shell
+var inShell bool
+
+type shell struct {
+	// the words put before the commands, pushed by cd
+	context [][]string
+	history []string
+	// sets the global options back to the ones given to the shell
+	restoreOpts func()
+	ctx         context.Context
+	root        *cobra.Command
+	out         io.Writer
+
+	// fetch the names from the daemon for the completion
+	neighbors func() []string
+	vrfs      func() []string
+	policies  func() []string
+}
+
+func newShell(out io.Writer) *shell {
+	sh := &shell{
+		ctx:       ctx,
+		out:       out,
+		neighbors: shellNeighbors,
+		vrfs:      shellVrfs,
+		policies:  shellPolicies,
+	}
+	opts := globalOpts
+	sh.restoreOpts = func() {
+		globalOpts = opts
+	}
+	sh.root = sh.newRootCmd()
+	return sh
+}
+
+// newRootCmd returns a new command tree with the options given to the
+// shell, which the commands in the shell start with.
+func (sh *shell) newRootCmd() *cobra.Command {
+	cmd := newRootCmd()
+	sh.restoreOpts()
+	return cmd
+}
+
+func (sh *shell) prompt() string {
+	if len(sh.context) == 0 {
+		return "gobgp> "
+	}
+	return fmt.Sprintf("gobgp(%s)> ", strings.Join(sh.contextWords(), " "))
+}
+
+func (sh *shell) contextWords() []string {
+	l := make([]string, 0, len(sh.context)*2)
+	for _, words := range sh.context {
+		l = append(l, words...)
+	}
+	return l
+}
+
+func (sh *shell) run(in *os.File) error {
+	fd := int(in.Fd())
+	if !term.IsTerminal(fd) {
+		scanner := bufio.NewScanner(in)
+		for scanner.Scan() {
+			if !sh.handle(scanner.Text()) {
+				return nil
+			}
+		}
+		return scanner.Err()
+	}
+
+	t := term.NewTerminal(struct {
+		io.Reader
+		io.Writer
+	}{in, sh.out}, sh.prompt())
+	t.AutoCompleteCallback = func(line string, pos int, key rune) (string, int, bool) {
+		if key != '\t' {
+			return "", 0, false
+		}
+		newLine, newPos, candidates := sh.complete(line, pos)
+		if len(candidates) > 1 {
+			fmt.Fprintln(t, strings.Join(candidates, "  "))
+		}
+		return newLine, newPos, true
+	}
+	for {
+		if width, height, err := term.GetSize(fd); err == nil {
+			t.SetSize(width, height)
+		}
+		t.SetPrompt(sh.prompt())
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			return err
+		}
+		line, err := t.ReadLine()
+		term.Restore(fd, state)
+		if err == io.EOF {
+			fmt.Fprintln(sh.out)
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if !sh.handle(line) {
+			return nil
+		}
+	}
+}
+
+// handle runs a line, and returns false if the shell exits.
+func (sh *shell) handle(line string) bool {
+	words, err := splitShellLine(line)
+	if err != nil {
+		printError(err)
+		return true
+	}
+	if len(words) == 0 {
+		return true
+	}
+	sh.history = append(sh.history, line)
+
+	switch words[0] {
+	case shellExit, shellQuit:
+		return false
+	case shellCd:
+		if err := sh.cd(words[1:]); err != nil {
+			printError(err)
+		}
+	case shellHistory:
+		for i, l := range sh.history {
+			fmt.Fprintf(sh.out, "%5d  %s\n", i+1, l)
+		}
+	case shellHelp:
+		fmt.Fprintln(sh.out, "Shell commands:")
+		fmt.Fprintln(sh.out, "  cd <command> [<name>]  run the following commands under the command, e.g. cd neighbor 10.0.0.1")
+		fmt.Fprintln(sh.out, "  cd ..                  leave the last cd")
+		fmt.Fprintln(sh.out, "  cd                     leave all the cd")
+		fmt.Fprintln(sh.out, "  history                show the commands run in the shell")
+		fmt.Fprintln(sh.out, "  exit, quit             exit the shell")
+		fmt.Fprintln(sh.out)
+		sh.execute(append(sh.contextWords(), "--help"))
+	default:
+		if len(sh.context) == 0 && words[0] == cmdShell {
+			printError(fmt.Errorf("already in the shell"))
+			return true
+		}
+		sh.execute(append(sh.contextWords(), words...))
+	}
+	return true
+}
+
+func (sh *shell) cd(words []string) error {
+	switch {
+	case len(words) == 0 || len(words) == 1 && words[0] == "/":
+		sh.context = nil
+	case len(words) == 1 && words[0] == "..":
+		if len(sh.context) > 0 {
+			sh.context = sh.context[:len(sh.context)-1]
+		}
+	default:
+		args := append(sh.contextWords(), words...)
+		if cmd, _, err := sh.root.Find(args); err != nil || cmd == sh.root {
+			return fmt.Errorf("unknown command: %s", strings.Join(words, " "))
+		}
+		sh.context = append(sh.context, words)
+	}
+	return nil
+}
+
+// execute runs the command of args like gobgp does. The command is
+// cancelled by SIGINT.
+func (sh *shell) execute(args []string) {
+	c, cancel := context.WithCancel(sh.ctx)
+	defer cancel()
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt)
+	defer signal.Stop(sigCh)
+	go func() {
+		select {
+		case <-sigCh:
+			cancel()
+		case <-c.Done():
+		}
+	}()
+
+	ctx = c
+	inShell = true
+	defer func() {
+		ctx = sh.ctx
+		inShell = false
+		if r := recover(); r != nil {
+			if _, ok := r.(shellCommandFailed); !ok {
+				panic(r)
+			}
+		}
+	}()
+	cmd := sh.newRootCmd()
+	cmd.SetArgs(args)
+	cmd.Execute()
+}
+
+// complete completes the word before pos in line, and returns the new
+// line, the new position and the candidates for the word.
+func (sh *shell) complete(line string, pos int) (string, int, []string) {
+	prefix := line[:pos]
+	words, err := splitShellLine(prefix)
+	if err != nil {
+		return line, pos, nil
+	}
+	last := ""
+	if len(words) > 0 && !strings.HasSuffix(prefix, " ") {
+		last = words[len(words)-1]
+		words = words[:len(words)-1]
+	}
+
+	var candidates []string
+	switch {
+	case len(words) == 0:
+		candidates = append(sh.candidates(sh.contextWords()), shellBuiltins...)
+	case words[0] == shellCd:
+		candidates = sh.candidates(append(sh.contextWords(), words[1:]...))
+	case isShellBuiltin(words[0]):
+	default:
+		candidates = sh.candidates(append(sh.contextWords(), words...))
+	}
+
+	matches := make([]string, 0, len(candidates))
+	seen := make(map[string]struct{}, len(candidates))
+	for _, c := range candidates {
+		if _, ok := seen[c]; !ok && strings.HasPrefix(c, last) {
+			seen[c] = struct{}{}
+			matches = append(matches, c)
+		}
+	}
+	sort.Strings(matches)
+	if len(matches) == 0 {
+		return line, pos, nil
+	}
+
+	completion := matches[0]
+	if len(matches) == 1 {
+		completion += " "
+	} else {
+		for _, m := range matches[1:] {
+			for !strings.HasPrefix(m, completion) {
+				completion = completion[:len(completion)-1]
+			}
+		}
+	}
+	newPrefix := prefix[:len(prefix)-len(last)] + completion
+	return newPrefix + line[pos:], len(newPrefix), matches
+}
+
+// candidates returns the words which can follow words.
+func (sh *shell) candidates(words []string) []string {
+	cmd := sh.root
+	path := make([]string, 0, len(words))
+	i := 0
+	for ; i < len(words); i++ {
+		sub := findShellSubCmd(cmd, words[i])
+		if sub == nil {
+			break
+		}
+		cmd = sub
+		path = append(path, sub.Name())
+	}
+	args := words[i:]
+
+	switch strings.Join(path, " ") {
+	case cmdNeighbor:
+		switch {
+		case len(args) == 0:
+			return append(subCmdNames(cmd), sh.neighbors()...)
+		case len(args) == 1:
+			return neighborShellCmds
+		case len(args) == 2:
+			switch args[1] {
+			case cmdLocal, cmdAdjIn, cmdAdjOut:
+				return []string{cmdSummary}
+			case cmdPolicy:
+				return []string{cmdImport, cmdExport}
+			}
+		case len(args) == 3 && args[1] == cmdPolicy:
+			return []string{cmdAdd, cmdDel, cmdSet}
+		case len(args) >= 4 && args[1] == cmdPolicy:
+			return sh.policies()
+		}
+		return nil
+	case cmdVRF:
+		switch {
+		case len(args) == 0:
+			return append(subCmdNames(cmd), sh.vrfs()...)
+		case len(args) == 1:
+			return vrfShellCmds
+		case len(args) == 2 && args[1] == cmdRib:
+			return []string{cmdAdd, cmdDel}
+		}
+		return nil
+	case cmdPolicy:
+		if len(args) == 0 {
+			return append(subCmdNames(cmd), sh.policies()...)
+		}
+		return nil
+	case cmdPolicy + " " + cmdDel:
+		return sh.policies()
+	}
+
+	// e.g. global policy import add
+	if p := cmd.Parent(); p != nil && (p.Name() == cmdImport || p.Name() == cmdExport) {
+		return sh.policies()
+	}
+	if len(args) == 0 {
+		return subCmdNames(cmd)
+	}
+	return nil
+}
+
+func isShellBuiltin(word string) bool {
+	for _, b := range shellBuiltins {
+		if word == b {
+			return true
+		}
+	}
+	return false
+}
+
+func findShellSubCmd(cmd *cobra.Command, name string) *cobra.Command {
+	for _, sub := range cmd.Commands() {
+		if sub.Name() == name || sub.HasAlias(name) {
+			return sub
+		}
+	}
+	return nil
+}
+
+func subCmdNames(cmd *cobra.Command) []string {
+	l := make([]string, 0, len(cmd.Commands()))
+	for _, sub := range cmd.Commands() {
+		if sub.IsAvailableCommand() {
+			l = append(l, sub.Name())
+		}
+	}
+	return l
+}
+
+// splitShellLine splits line into the words separated by the spaces,
+// where the quoted spaces don't separate the words.
+func splitShellLine(line string) ([]string, error) {
+	words := make([]string, 0)
+	var word strings.Builder
+	inWord := false
+	var quote rune
+	for _, c := range line {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else {
+				word.WriteRune(c)
+			}
+		case c == '"' || c == '\'':
+			quote = c
+			inWord = true
+		case c == ' ' || c == '\t':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(c)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote: %s", line)
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+func shellNeighbors() []string {
+	peers, err := getNeighbors("", false)
+	if err != nil {
+		return nil
+	}
+	l := make([]string, 0, len(peers))
+	for _, p := range peers {
+		l = append(l, p.State.NeighborAddress)
+	}
+	return l
+}
+
+func shellVrfs() []string {
+	vrfs, err := getVrfs()
+	if err != nil {
+		return nil
+	}
+	l := make([]string, 0, len(vrfs))
+	for _, v := range vrfs {
+		l = append(l, v.Name)
+	}
+	return l
+}
+
+func shellPolicies() []string {
+	stream, err := client.ListPolicy(ctx, &api.ListPolicyRequest{})
+	if err != nil {
+		return nil
+	}
+	l := make([]string, 0)
+	for {
+		r, err := stream.Recv()
+		if err != nil {
+			break
+		}
+		l = append(l, r.Policy.Name)
+	}
+	return l
+}
+
+func newShellCmd() *cobra.Command {
+	return &cobra.Command{
+		Use: cmdShell,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := newShell(os.Stdout).run(os.Stdin); err != nil {
+				exitWithError(err)
+			}
+		},
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SplitShellLine(t *testing.T) {
+	assert := assert.New(t)
+
+	words, err := splitShellLine(`  neighbor 10.0.0.1  shutdown -r "planned  maintenance" ''`)
+	assert.NoError(err)
+	assert.Equal([]string{"neighbor", "10.0.0.1", "shutdown", "-r", "planned  maintenance", ""}, words)
+
+	_, err = splitShellLine(`neighbor shutdown -r "planned`)
+	assert.Error(err)
+}
+
+func newTestShell() (*shell, *bytes.Buffer) {
+	out := &bytes.Buffer{}
+	sh := newShell(out)
+	sh.neighbors = func() []string {
+		return []string{"10.0.0.1", "10.0.0.2", "2001:db8::1"}
+	}
+	sh.vrfs = func() []string {
+		return []string{"blue", "red"}
+	}
+	sh.policies = func() []string {
+		return []string{"policy1", "policy2", "reject-all"}
+	}
+	return sh, out
+}
+
+func Test_ShellCd(t *testing.T) {
+	assert := assert.New(t)
+	sh, _ := newTestShell()
+
+	assert.Equal("gobgp> ", sh.prompt())
+	assert.NoError(sh.cd([]string{"neighbor", "10.0.0.1"}))
+	assert.Equal("gobgp(neighbor 10.0.0.1)> ", sh.prompt())
+	assert.NoError(sh.cd([]string{"policy"}))
+	assert.Equal("gobgp(neighbor 10.0.0.1 policy)> ", sh.prompt())
+	assert.NoError(sh.cd([]string{".."}))
+	assert.Equal([]string{"neighbor", "10.0.0.1"}, sh.contextWords())
+	assert.NoError(sh.cd(nil))
+	assert.Equal("gobgp> ", sh.prompt())
+
+	assert.Error(sh.cd([]string{"neighbour", "10.0.0.1"}))
+	assert.Equal("gobgp> ", sh.prompt())
+
+	assert.True(sh.handle("cd vrf red"))
+	assert.Equal("gobgp(vrf red)> ", sh.prompt())
+	assert.False(sh.handle("exit"))
+}
+
+func Test_ShellComplete(t *testing.T) {
+	assert := assert.New(t)
+	sh, _ := newTestShell()
+
+	complete := func(line string) (string, []string) {
+		newLine, newPos, candidates := sh.complete(line, len(line))
+		assert.Equal(len(newLine), newPos)
+		return newLine, candidates
+	}
+
+	line, _ := complete("nei")
+	assert.Equal("neighbor ", line)
+
+	line, candidates := complete("neighbor 10")
+	assert.Equal("neighbor 10.0.0.", line)
+	assert.Equal([]string{"10.0.0.1", "10.0.0.2"}, candidates)
+
+	line, _ = complete("neighbor 10.0.0.2 adj-")
+	assert.Equal("neighbor 10.0.0.2 adj-", line)
+	line, _ = complete("neighbor 10.0.0.2 adj-o")
+	assert.Equal("neighbor 10.0.0.2 adj-out ", line)
+
+	line, _ = complete("neighbor 10.0.0.2 policy import add p")
+	assert.Equal("neighbor 10.0.0.2 policy import add policy", line)
+	line, _ = complete("global policy export set rej")
+	assert.Equal("global policy export set reject-all ", line)
+
+	line, _ = complete("vrf r")
+	assert.Equal("vrf red ", line)
+	line, _ = complete("vrf red r")
+	assert.Equal("vrf red rib ", line)
+
+	// the completion in the middle of the line
+	newLine, newPos, _ := sh.complete("vrf r rib", 5)
+	assert.Equal("vrf red  rib", newLine)
+	assert.Equal(8, newPos)
+
+	line, _ = complete("cd neighbor 2001")
+	assert.Equal("cd neighbor 2001:db8::1 ", line)
+
+	line, _ = complete("his")
+	assert.Equal("history ", line)
+
+	// the context is put before the line
+	assert.NoError(sh.cd([]string{"neighbor", "10.0.0.1"}))
+	line, _ = complete("soft")
+	assert.Equal("softreset", line)
+	line, _ = complete("policy ex")
+	assert.Equal("policy export ", line)
+}
+
+func Test_ShellHistory(t *testing.T) {
+	assert := assert.New(t)
+	sh, out := newTestShell()
+
+	sh.handle("cd neighbor 10.0.0.1")
+	sh.handle("  ")
+	sh.handle("cd ..")
+	sh.handle("history")
+	assert.Equal("    1  cd neighbor 10.0.0.1\n    2  cd ..\n    3  history\n", out.String())
+}
@@ -123,12 +123,13 @@ func modVrf(typ string, args []string) error {
 	switch typ {
 	case cmdAdd:
 		a, err := extractReserved(args, map[string]int{
-			"rd": paramSingle,
-			"rt": paramList,
-			"id": paramSingle})
+			"rd":        paramSingle,
+			"rt":        paramList,
+			"id":        paramSingle,
+			"router-id": paramSingle})
 		if err != nil || len(a[""]) != 1 || len(a["rd"]) != 1 || len(a["rt"]) < 2 {
 			//lint:ignore ST1005 cli example
-			return fmt.Errorf("usage: gobgp vrf add <vrf name> [ id <id> ] rd <rd> rt { import | export | both } <rt>...")
+			return fmt.Errorf("usage: gobgp vrf add <vrf name> [ id <id> ] [ router-id <router id> ] rd <rd> rt { import | export | both } <rt>...")
 		}
 		name := a[""][0]
 		var rd bgp.RouteDistinguisherInterface
@@ -158,7 +159,7 @@ func modVrf(typ string, args []string) error {
 				exportRt = append(exportRt, rt)
 			default:
 				//lint:ignore ST1005 cli example
-				return fmt.Errorf("usage: gobgp vrf add <vrf name> [ id <id> ] rd <rd> rt { import | export | both } <rt>...")
+				return fmt.Errorf("usage: gobgp vrf add <vrf name> [ id <id> ] [ router-id <router id> ] rd <rd> rt { import | export | both } <rt>...")
 			}
 		}
 		var id uint64
@@ -168,6 +169,10 @@ func modVrf(typ string, args []string) error {
 				return err
 			}
 		}
+		var routerID string
+		if len(a["router-id"]) > 0 {
+			routerID = a["router-id"][0]
+		}
 		v, _ := apiutil.MarshalRD(rd)
 		irt, _ := apiutil.MarshalRTs(importRt)
 		ert, _ := apiutil.MarshalRTs(exportRt)
@@ -179,6 +184,7 @@ func modVrf(typ string, args []string) error {
 				ImportRt: irt,
 				ExportRt: ert,
 				Id:       uint32(id),
+				RouterId: routerID,
 			},
 		})
 		return err
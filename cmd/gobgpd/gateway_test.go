@@ -0,0 +1,97 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/server"
+)
+
+func TestRESTGateway(t *testing.T) {
+	assert := assert.New(t)
+
+	sock := "unix://" + filepath.Join(t.TempDir(), "gobgp.sock")
+	s := server.NewBgpServer(server.GrpcListenAddress(sock))
+	go s.Serve()
+	defer s.Stop()
+	err := s.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        1,
+			RouterId:   "1.1.1.1",
+			ListenPort: -1,
+		},
+	})
+	assert.Nil(err)
+
+	gateway, err := newRESTGateway(context.Background(), sock)
+	assert.Nil(err)
+	ts := httptest.NewServer(gateway)
+	defer ts.Close()
+
+	do := func(method, path, body string) (int, string) {
+		req, err := http.NewRequest(method, ts.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+		b, _ := io.ReadAll(res.Body)
+		return res.StatusCode, string(b)
+	}
+
+	code, body := do(http.MethodGet, "/v1/bgp", "")
+	assert.Equal(http.StatusOK, code)
+	assert.Contains(body, `"router_id":"1.1.1.1"`)
+
+	code, _ = do(http.MethodPost, "/v1/peers", `{"peer": {"conf": {"neighbor_address": "10.0.0.2", "peer_asn": 2}}}`)
+	assert.Equal(http.StatusOK, code)
+	code, body = do(http.MethodGet, "/v1/peers", "")
+	assert.Equal(http.StatusOK, code)
+	assert.Contains(body, `"neighbor_address":"10.0.0.2"`)
+	code, _ = do(http.MethodDelete, "/v1/peers/10.0.0.2", "")
+	assert.Equal(http.StatusOK, code)
+	code, _ = do(http.MethodDelete, "/v1/peers/10.0.0.2", "")
+	assert.NotEqual(http.StatusOK, code)
+
+	code, body = do(http.MethodPost, "/v1/paths", `{
+		"table_type": "GLOBAL",
+		"path": {
+			"family": {"afi": "AFI_IP", "safi": "SAFI_UNICAST"},
+			"nlri": {"@type": "type.googleapis.com/apipb.IPAddressPrefix", "prefix": "10.0.0.0", "prefix_len": 24},
+			"pattrs": [
+				{"@type": "type.googleapis.com/apipb.OriginAttribute", "origin": 0},
+				{"@type": "type.googleapis.com/apipb.NextHopAttribute", "next_hop": "10.0.0.1"}
+			]
+		}
+	}`)
+	assert.Equal(http.StatusOK, code, body)
+	code, body = do(http.MethodGet, "/v1/paths?table_type=GLOBAL&family.afi=AFI_IP&family.safi=SAFI_UNICAST", "")
+	assert.Equal(http.StatusOK, code)
+	assert.Contains(body, `"prefix":"10.0.0.0/24"`)
+}
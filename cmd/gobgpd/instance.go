@@ -0,0 +1,103 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/osrg/gobgp/v3/pkg/config"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/server"
+)
+
+// instance is an additional BgpServer running in this process. It has its
+// own config file and is reachable through the gRPC API of the default
+// BgpServer under its name.
+type instance struct {
+	name       string
+	configFile string
+	parent     *server.BgpServer
+	bgpServer  *server.BgpServer
+	config     *oc.BgpConfigSet
+}
+
+func newInstance(parent *server.BgpServer, arg, configType string, isGracefulRestart bool, serverOpts []server.ServerOption) (*instance, error) {
+	name, configFile, found := strings.Cut(arg, ":")
+	if !found || name == "" || configFile == "" {
+		return nil, fmt.Errorf("invalid instance %q, expected <name>:<config file>", arg)
+	}
+	c, err := config.ReadConfigFile(configFile, configType)
+	if err != nil {
+		return nil, err
+	}
+
+	i := &instance{
+		name:       name,
+		configFile: configFile,
+		parent:     parent,
+		bgpServer:  server.NewBgpServer(serverOpts...),
+	}
+	go i.bgpServer.Serve()
+	if err := parent.AddGrpcInstance(name, i.bgpServer); err != nil {
+		i.bgpServer.Stop()
+		return nil, err
+	}
+	i.config, err = config.InitialConfig(context.Background(), i.bgpServer, c, isGracefulRestart)
+	if err != nil {
+		i.stop()
+		return nil, err
+	}
+	logger.WithFields(logrus.Fields{
+		"Topic":    "Config",
+		"Instance": name,
+	}).Infof("Started instance from %s", configFile)
+	return i, nil
+}
+
+func (i *instance) reload(configType string) {
+	logger.WithFields(logrus.Fields{
+		"Topic":    "Config",
+		"Instance": i.name,
+	}).Info("Reload the config file")
+	c, err := config.ReadConfigFile(i.configFile, configType)
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"Topic":    "Config",
+			"Instance": i.name,
+			"Error":    err,
+		}).Warningf("Can't read config file %s", i.configFile)
+		return
+	}
+	updated, err := config.UpdateConfig(context.Background(), i.bgpServer, i.config, c)
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"Topic":    "Config",
+			"Instance": i.name,
+			"Error":    err,
+		}).Warningf("Failed to update config %s", i.configFile)
+		return
+	}
+	i.config = updated
+}
+
+func (i *instance) stop() {
+	i.parent.DeleteGrpcInstance(i.name)
+	i.bgpServer.Stop()
+}
@@ -27,6 +27,7 @@ import (
 	"os"
 	"os/signal"
 	"runtime"
+	"strings"
 	"syscall"
 	"time"
 
@@ -41,9 +42,12 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
+	api "github.com/osrg/gobgp/v3/api"
 	"github.com/osrg/gobgp/v3/internal/pkg/metrics"
 	"github.com/osrg/gobgp/v3/internal/pkg/version"
 	"github.com/osrg/gobgp/v3/pkg/config"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/log"
 	"github.com/osrg/gobgp/v3/pkg/server"
 )
 
@@ -54,27 +58,41 @@ func main() {
 	signal.Notify(sigCh, syscall.SIGTERM, syscall.SIGINT)
 
 	var opts struct {
-		ConfigFile       string `short:"f" long:"config-file" description:"specifying a config file"`
-		ConfigType       string `short:"t" long:"config-type" description:"specifying config type (toml, yaml, json)" default:"toml"`
-		ConfigAutoReload bool   `short:"a" long:"config-auto-reload" description:"activate config auto reload on changes"`
-		LogLevel         string `short:"l" long:"log-level" description:"specifying log level"`
-		LogPlain         bool   `short:"p" long:"log-plain" description:"use plain format for logging (json by default)"`
-		UseSyslog        string `short:"s" long:"syslog" description:"use syslogd"`
-		Facility         string `long:"syslog-facility" description:"specify syslog facility"`
-		DisableStdlog    bool   `long:"disable-stdlog" description:"disable standard logging"`
-		CPUs             int    `long:"cpus" description:"specify the number of CPUs to be used"`
-		GrpcHosts        string `long:"api-hosts" description:"specify the hosts that gobgpd listens on" default:":50051"`
-		GracefulRestart  bool   `short:"r" long:"graceful-restart" description:"flag restart-state in graceful-restart capability"`
-		Dry              bool   `short:"d" long:"dry-run" description:"check configuration"`
-		PProfHost        string `long:"pprof-host" description:"specify the host that gobgpd listens on for pprof and metrics" default:"localhost:6060"`
-		PProfDisable     bool   `long:"pprof-disable" description:"disable pprof profiling"`
-		MetricsPath      string `long:"metrics-path" description:"specify path for prometheus metrics, empty value disables them" default:"/metrics"`
-		UseSdNotify      bool   `long:"sdnotify" description:"use sd_notify protocol"`
-		TLS              bool   `long:"tls" description:"enable TLS authentication for gRPC API"`
-		TLSCertFile      string `long:"tls-cert-file" description:"The TLS cert file"`
-		TLSKeyFile       string `long:"tls-key-file" description:"The TLS key file"`
-		TLSClientCAFile  string `long:"tls-client-ca-file" description:"Optional TLS client CA file to authenticate clients against"`
-		Version          bool   `long:"version" description:"show version number"`
+		ConfigFile         string        `short:"f" long:"config-file" description:"specifying a config file"`
+		ConfigType         string        `short:"t" long:"config-type" description:"specifying config type (toml, yaml, json)" default:"toml"`
+		ConfigAutoReload   bool          `short:"a" long:"config-auto-reload" description:"activate config auto reload on changes"`
+		LogLevel           string        `short:"l" long:"log-level" description:"specifying log level"`
+		ModuleLogLevels    []string      `long:"module-log-level" description:"specify the log level of the module (fsm, table, policy, zebra, bmp) as <module>=<level> (can be repeated)"`
+		LogPlain           bool          `short:"p" long:"log-plain" description:"use plain format for logging (json by default)"`
+		UseSyslog          string        `short:"s" long:"syslog" description:"use syslogd"`
+		Facility           string        `long:"syslog-facility" description:"specify syslog facility"`
+		DisableStdlog      bool          `long:"disable-stdlog" description:"disable standard logging"`
+		CPUs               int           `long:"cpus" description:"specify the number of CPUs to be used"`
+		BestPathWorkers    int           `long:"best-path-workers" description:"specify the number of goroutines applying the import policy and computing the best paths concurrently, 0 uses all the CPUs and 1 disables the concurrency"`
+		GrpcHosts          string        `long:"api-hosts" description:"specify the hosts that gobgpd listens on" default:":50051"`
+		GracefulRestart    bool          `short:"r" long:"graceful-restart" description:"flag restart-state in graceful-restart capability"`
+		Dry                bool          `short:"d" long:"dry-run" description:"check configuration"`
+		ValidateConfig     bool          `long:"validate-config" description:"validate the config file, report all the problems found with their locations and exit"`
+		PProfHost          string        `long:"pprof-host" description:"specify the host that gobgpd listens on for pprof and metrics" default:"localhost:6060"`
+		PProfDisable       bool          `long:"pprof-disable" description:"disable pprof profiling"`
+		MetricsPath        string        `long:"metrics-path" description:"specify path for prometheus metrics, empty value disables them" default:"/metrics"`
+		UseSdNotify        bool          `long:"sdnotify" description:"use sd_notify protocol"`
+		TLS                bool          `long:"tls" description:"enable TLS authentication for gRPC API"`
+		TLSCertFile        string        `long:"tls-cert-file" description:"The TLS cert file"`
+		TLSKeyFile         string        `long:"tls-key-file" description:"The TLS key file"`
+		TLSClientCAFile    string        `long:"tls-client-ca-file" description:"Optional TLS client CA file to authenticate clients against"`
+		TLSReadOnly        []string      `long:"tls-read-only-client" description:"allow the client of the certificate identity (common name or subject alternative name, * for any) to call only the read-only gRPC APIs (can be repeated)"`
+		TLSReadWrite       []string      `long:"tls-read-write-client" description:"allow the client of the certificate identity (common name or subject alternative name, * for any) to call all the gRPC APIs (can be repeated)"`
+		RestHost           string        `long:"rest-host" description:"specify the host that gobgpd listens on for the REST/JSON gateway of the gRPC API, empty value disables it"`
+		EventBacklogSize   int           `long:"event-backlog-size" description:"specify the number of the latest best path and peer state events kept for replaying to the watchers, 0 disables it" default:"1000"`
+		EventJournalFile   string        `long:"event-journal-file" description:"specify the file to which the events kept for replaying are written so that they survive restarts"`
+		PathHistorySize    int           `long:"path-history-size" description:"specify the number of the latest events of the received paths kept per prefix for GetPathHistory, 0 disables it"`
+		RtbhIPv4MinLength  int           `long:"rtbh-ipv4-min-prefix-length" description:"specify the shortest prefix length of the IPv4 remotely triggered black hole routes" default:"24"`
+		RtbhIPv6MinLength  int           `long:"rtbh-ipv6-min-prefix-length" description:"specify the shortest prefix length of the IPv6 remotely triggered black hole routes" default:"48"`
+		ConvergenceTimeout time.Duration `long:"initial-convergence-timeout" description:"specify the time after loading the config after which the initial convergence is regarded as done for the gRPC health readiness, 0 waits for all the neighbors"`
+		Version            bool          `long:"version" description:"show version number"`
+		Instances          []string      `long:"instance" description:"run an additional BGP instance reachable via gRPC, specified as <name>:<config file> (can be repeated)"`
+		StaticRoutes       string        `long:"static-routes" description:"specify a route file or a directory of route files (yaml, json) whose routes are originated and kept in sync with the files"`
 	}
 	_, err := flags.Parse(&opts)
 	if err != nil {
@@ -144,6 +162,26 @@ func main() {
 		logger.SetFormatter(&logrus.JSONFormatter{})
 	}
 
+	if opts.ValidateConfig {
+		errs := config.ValidateConfigFile(opts.ConfigFile, opts.ConfigType)
+		for _, e := range errs {
+			logger.WithFields(logrus.Fields{
+				"Topic":  "Config",
+				"File":   opts.ConfigFile,
+				"Key":    e.Key,
+				"Line":   e.Line,
+				"Column": e.Column,
+			}).Error(e.Message)
+		}
+		if len(errs) > 0 {
+			os.Exit(1)
+		}
+		logger.WithFields(logrus.Fields{
+			"Topic": "Config",
+		}).Infof("The config file %s is valid", opts.ConfigFile)
+		os.Exit(0)
+	}
+
 	if opts.Dry {
 		c, err := config.ReadConfigFile(opts.ConfigFile, opts.ConfigType)
 		if err != nil {
@@ -188,6 +226,25 @@ func main() {
 		grpcOpts = append(grpcOpts, grpc.Creds(creds))
 	}
 
+	if len(opts.TLSReadOnly) > 0 || len(opts.TLSReadWrite) > 0 {
+		if !opts.TLS || len(opts.TLSClientCAFile) == 0 {
+			logger.Fatal("The client authorization requires --tls and --tls-client-ca-file")
+		}
+		clients := make(map[string]server.GrpcAccessLevel)
+		for _, id := range opts.TLSReadOnly {
+			clients[id] = server.GrpcAccessReadOnly
+		}
+		for _, id := range opts.TLSReadWrite {
+			clients[id] = server.GrpcAccessReadWrite
+		}
+		authorizer := server.NewGrpcAuthorizer(clients)
+		grpcOpts = append(
+			grpcOpts,
+			grpc.ChainUnaryInterceptor(authorizer.UnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(authorizer.StreamServerInterceptor()),
+		)
+	}
+
 	if opts.MetricsPath != "" {
 		grpcOpts = append(
 			grpcOpts,
@@ -197,10 +254,42 @@ func main() {
 	}
 
 	logger.Info("gobgpd started")
-	bgpServer := server.NewBgpServer(server.GrpcListenAddress(opts.GrpcHosts), server.GrpcOption(grpcOpts), server.LoggerOption(&builtinLogger{logger: logger}))
+	// the options shared with the instances
+	serverOpts := []server.ServerOption{server.LoggerOption(&builtinLogger{logger: logger}), server.EventBacklogSize(opts.EventBacklogSize), server.BestPathWorkers(opts.BestPathWorkers), server.PathHistorySize(opts.PathHistorySize), server.RtbhMinPrefixLength(opts.RtbhIPv4MinLength, opts.RtbhIPv6MinLength)}
+	bgpServer := server.NewBgpServer(append([]server.ServerOption{server.GrpcListenAddress(opts.GrpcHosts), server.GrpcOption(grpcOpts), server.EventJournalFile(opts.EventJournalFile), server.InitialConvergenceTimeout(opts.ConvergenceTimeout)}, serverOpts...)...)
+	for _, arg := range opts.ModuleLogLevels {
+		if err := setModuleLogLevel(bgpServer, arg); err != nil {
+			logger.Fatalf("Failed to set the log level of the module: %v", err)
+		}
+	}
 	prometheus.MustRegister(metrics.NewBgpCollector(bgpServer))
 	go bgpServer.Serve()
 
+	if opts.RestHost != "" {
+		if opts.TLS {
+			logger.Fatal("The REST gateway can't be used with --tls")
+		}
+		gateway, err := newRESTGateway(context.Background(), opts.GrpcHosts)
+		if err != nil {
+			logger.Fatalf("Failed to start the REST gateway: %v", err)
+		}
+		go func() {
+			logger.Println(http.ListenAndServe(opts.RestHost, gateway))
+		}()
+	}
+
+	instances := make([]*instance, 0, len(opts.Instances))
+	for _, arg := range opts.Instances {
+		i, err := newInstance(bgpServer, arg, opts.ConfigType, opts.GracefulRestart, serverOpts)
+		if err != nil {
+			logger.WithFields(logrus.Fields{
+				"Topic": "Config",
+				"Error": err,
+			}).Fatalf("Failed to start instance %s", arg)
+		}
+		instances = append(instances, i)
+	}
+
 	if opts.UseSdNotify {
 		if status, err := daemon.SdNotify(false, daemon.SdNotifyReady); !status {
 			if err != nil {
@@ -211,31 +300,57 @@ func main() {
 		}
 	}
 
+	if opts.StaticRoutes != "" && opts.ConfigFile == "" {
+		logger.Fatal("--static-routes requires --config-file")
+	}
+
 	if opts.ConfigFile == "" {
+		bgpServer.ConfigLoaded()
+	}
+	if opts.ConfigFile == "" && len(instances) == 0 {
 		<-sigCh
-		stopServer(bgpServer, opts.UseSdNotify)
+		stopServer(bgpServer, instances, opts.UseSdNotify)
 		return
 	}
 
 	signal.Notify(sigCh, syscall.SIGHUP)
 
-	initialConfig, err := config.ReadConfigFile(opts.ConfigFile, opts.ConfigType)
-	if err != nil {
+	var currentConfig *oc.BgpConfigSet
+	if opts.ConfigFile != "" {
+		initialConfig, err := config.ReadConfigFile(opts.ConfigFile, opts.ConfigType)
+		if err != nil {
+			logger.WithFields(logrus.Fields{
+				"Topic": "Config",
+				"Error": err,
+			}).Fatalf("Can't read config file %s", opts.ConfigFile)
+		}
 		logger.WithFields(logrus.Fields{
 			"Topic": "Config",
-			"Error": err,
-		}).Fatalf("Can't read config file %s", opts.ConfigFile)
+		}).Info("Finished reading the config file")
+
+		currentConfig, err = config.InitialConfig(context.Background(), bgpServer, initialConfig, opts.GracefulRestart)
+		if err != nil {
+			logger.WithFields(logrus.Fields{
+				"Topic": "Config",
+				"Error": err,
+			}).Fatalf("Failed to apply initial configuration %s", opts.ConfigFile)
+		}
+		bgpServer.ConfigLoaded()
 	}
-	logger.WithFields(logrus.Fields{
-		"Topic": "Config",
-	}).Info("Finished reading the config file")
 
-	currentConfig, err := config.InitialConfig(context.Background(), bgpServer, initialConfig, opts.GracefulRestart)
-	if err != nil {
+	var staticRoutes *config.StaticRouteInjector
+	if opts.StaticRoutes != "" {
+		staticRoutes = config.NewStaticRouteInjector(bgpServer, opts.StaticRoutes)
+		syncStaticRoutes(staticRoutes)
+		if err := staticRoutes.Watch(); err != nil {
+			logger.WithFields(logrus.Fields{
+				"Topic": "Config",
+				"Error": err,
+			}).Fatalf("Can't watch static routes %s", opts.StaticRoutes)
+		}
 		logger.WithFields(logrus.Fields{
 			"Topic": "Config",
-			"Error": err,
-		}).Fatalf("Failed to apply initial configuration %s", opts.ConfigFile)
+		}).Infof("Watching for changes of static routes %s", opts.StaticRoutes)
 	}
 
 	if opts.ConfigAutoReload {
@@ -247,7 +362,7 @@ func main() {
 		// To prevent abusive reloads, we ignore any event in a 100ms window
 		rateLimiter := rate.Sometimes{Interval: 100 * time.Millisecond}
 
-		config.WatchConfigFile(opts.ConfigFile, opts.ConfigType, func() {
+		reload := func() {
 			rateLimiter.Do(func() {
 				logger.WithFields(logrus.Fields{
 					"Topic": "Config",
@@ -255,15 +370,31 @@ func main() {
 
 				sigCh <- syscall.SIGHUP
 			})
-		})
+		}
+		if opts.ConfigFile != "" {
+			config.WatchConfigFile(opts.ConfigFile, opts.ConfigType, reload)
+		}
+		for _, i := range instances {
+			config.WatchConfigFile(i.configFile, opts.ConfigType, reload)
+		}
 	}
 
 	for sig := range sigCh {
 		if sig != syscall.SIGHUP {
-			stopServer(bgpServer, opts.UseSdNotify)
+			stopServer(bgpServer, instances, opts.UseSdNotify)
 			return
 		}
 
+		for _, i := range instances {
+			i.reload(opts.ConfigType)
+		}
+		if staticRoutes != nil {
+			syncStaticRoutes(staticRoutes)
+		}
+		if opts.ConfigFile == "" {
+			continue
+		}
+
 		logger.WithFields(logrus.Fields{
 			"Topic": "Config",
 		}).Info("Reload the config file")
@@ -287,9 +418,38 @@ func main() {
 	}
 }
 
-func stopServer(bgpServer *server.BgpServer, useSdNotify bool) {
+// setModuleLogLevel sets the log level of the module specified as
+// <module>=<level>.
+func setModuleLogLevel(bgpServer *server.BgpServer, arg string) error {
+	elems := strings.SplitN(arg, "=", 2)
+	if len(elems) != 2 {
+		return fmt.Errorf("invalid module log level: %s", arg)
+	}
+	level, err := log.ParseLogLevel(elems[1])
+	if err != nil {
+		return err
+	}
+	return bgpServer.SetLogLevel(context.Background(), &api.SetLogLevelRequest{
+		Level:  api.SetLogLevelRequest_Level(level),
+		Module: elems[0],
+	})
+}
+
+func syncStaticRoutes(staticRoutes *config.StaticRouteInjector) {
+	if err := staticRoutes.Sync(context.Background()); err != nil {
+		logger.WithFields(logrus.Fields{
+			"Topic": "Config",
+			"Error": err,
+		}).Warn("Failed to synchronize static routes")
+	}
+}
+
+func stopServer(bgpServer *server.BgpServer, instances []*instance, useSdNotify bool) {
 	logger.Info("stopping gobgpd server")
 
+	for _, i := range instances {
+		i.stop()
+	}
 	bgpServer.Stop()
 	if useSdNotify {
 		daemon.SdNotify(false, daemon.SdNotifyStopping)
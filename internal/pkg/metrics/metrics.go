@@ -41,6 +41,11 @@ var (
 
 	bgpPeerStateDesc = prometheus.NewDesc("bgp_peer_state", "State of the BGP session with peer", peerStateLabels, nil)
 
+	bgpPeerDownConvergenceSecondsDesc = prometheus.NewDesc("bgp_peer_down_convergence_seconds", "Time taken to withdraw the routes from peer when the session went down last time", peerLabels, nil)
+
+	bgpPeerInputQueueDesc  = prometheus.NewDesc("bgp_peer_input_queue", "Number of messages received from peer and queued to be processed", peerLabels, nil)
+	bgpPeerOutputQueueDesc = prometheus.NewDesc("bgp_peer_output_queue", "Number of messages queued to be sent to peer", peerLabels, nil)
+
 	bgpRoutesReceivedDesc = prometheus.NewDesc(
 		"bgp_routes_received",
 		"Number of routes received from peer",
@@ -85,6 +90,10 @@ func (c *bgpCollector) Describe(out chan<- *prometheus.Desc) {
 
 	out <- bgpPeerStateDesc
 
+	out <- bgpPeerDownConvergenceSecondsDesc
+	out <- bgpPeerInputQueueDesc
+	out <- bgpPeerOutputQueueDesc
+
 	out <- bgpRoutesReceivedDesc
 	out <- bgpRoutesAcceptedDesc
 	out <- bgpRoutesAdvertisedDesc
@@ -130,6 +139,16 @@ func (c *bgpCollector) Collect(out chan<- prometheus.Metric) {
 			peerState.GetAdminState().String(),
 		)
 
+		out <- prometheus.MustNewConstMetric(
+			bgpPeerDownConvergenceSecondsDesc,
+			prometheus.GaugeValue,
+			p.GetTimers().GetState().GetPeerDownConvergenceTime(),
+			peerAddr,
+		)
+
+		out <- prometheus.MustNewConstMetric(bgpPeerInputQueueDesc, prometheus.GaugeValue, float64(peerState.GetQueues().GetInput()), peerAddr)
+		out <- prometheus.MustNewConstMetric(bgpPeerOutputQueueDesc, prometheus.GaugeValue, float64(peerState.GetQueues().GetOutput()), peerAddr)
+
 		for _, afiSafi := range p.GetAfiSafis() {
 			if !afiSafi.GetConfig().GetEnabled() {
 				continue
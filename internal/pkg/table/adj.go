@@ -16,16 +16,66 @@
 package table
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 
 	"github.com/osrg/gobgp/v3/pkg/log"
 	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
 )
 
+// UpdateType is the type of the change of the Adj-RIB-In made by a path.
+type UpdateType uint8
+
+const (
+	UPDATE_TYPE_NONE UpdateType = iota
+	// the announcement of a new path
+	UPDATE_TYPE_ANNOUNCEMENT
+	// the announcement with the same attributes as the current path
+	UPDATE_TYPE_DUPLICATE
+	// the announcement replacing the current path with the different
+	// attributes
+	UPDATE_TYPE_ATTRIBUTE_CHANGE
+	// the withdrawal of an unknown path
+	UPDATE_TYPE_WITHDRAWAL
+	// the withdrawal of the current path
+	UPDATE_TYPE_FLAP
+)
+
+// UpdateStatistics is the counters of the paths received from a peer,
+// accumulated over the sessions.
+type UpdateStatistics struct {
+	Updates          uint64
+	Withdrawals      uint64
+	Duplicates       uint64
+	AttributeChanges uint64
+	Flaps            uint64
+}
+
+// Add counts the change of the type.
+func (s *UpdateStatistics) Add(t UpdateType) {
+	switch t {
+	case UPDATE_TYPE_ANNOUNCEMENT:
+		s.Updates++
+	case UPDATE_TYPE_DUPLICATE:
+		s.Updates++
+		s.Duplicates++
+	case UPDATE_TYPE_ATTRIBUTE_CHANGE:
+		s.Updates++
+		s.AttributeChanges++
+	case UPDATE_TYPE_WITHDRAWAL:
+		s.Withdrawals++
+	case UPDATE_TYPE_FLAP:
+		s.Withdrawals++
+		s.Flaps++
+	}
+}
+
 type AdjRib struct {
-	accepted map[bgp.RouteFamily]int
-	table    map[bgp.RouteFamily]*Table
-	logger   log.Logger
+	accepted   map[bgp.RouteFamily]int
+	statistics map[bgp.RouteFamily]*UpdateStatistics
+	table      map[bgp.RouteFamily]*Table
+	logger     log.Logger
 }
 
 func NewAdjRib(logger log.Logger, rfList []bgp.RouteFamily) *AdjRib {
@@ -34,14 +84,18 @@ func NewAdjRib(logger log.Logger, rfList []bgp.RouteFamily) *AdjRib {
 		m[f] = NewTable(logger, f)
 	}
 	return &AdjRib{
-		table:    m,
-		accepted: make(map[bgp.RouteFamily]int),
-		logger:   logger,
+		table:      m,
+		accepted:   make(map[bgp.RouteFamily]int),
+		statistics: make(map[bgp.RouteFamily]*UpdateStatistics),
+		logger:     logger,
 	}
 }
 
-func (adj *AdjRib) Update(pathList []*Path) {
-	for _, path := range pathList {
+// Update updates the paths and returns the types of the changes made by
+// them, where the i-th type is of pathList[i].
+func (adj *AdjRib) Update(pathList []*Path) []UpdateType {
+	types := make([]UpdateType, len(pathList))
+	for n, path := range pathList {
 		if path == nil || path.IsEOR() {
 			continue
 		}
@@ -61,7 +115,9 @@ func (adj *AdjRib) Update(pathList []*Path) {
 		}
 
 		if path.IsWithdraw {
+			types[n] = UPDATE_TYPE_WITHDRAWAL
 			if idx != -1 {
+				types[n] = UPDATE_TYPE_FLAP
 				d.knownPathList = append(d.knownPathList[:idx], d.knownPathList[idx+1:]...)
 				if len(d.knownPathList) == 0 {
 					t.deleteDest(d)
@@ -72,13 +128,16 @@ func (adj *AdjRib) Update(pathList []*Path) {
 			}
 			path.SetDropped(true)
 		} else {
+			types[n] = UPDATE_TYPE_ANNOUNCEMENT
 			if idx != -1 {
+				types[n] = UPDATE_TYPE_ATTRIBUTE_CHANGE
 				if old.IsRejected() && !path.IsRejected() {
 					adj.accepted[rf]++
 				} else if !old.IsRejected() && path.IsRejected() {
 					adj.accepted[rf]--
 				}
 				if old.Equal(path) {
+					types[n] = UPDATE_TYPE_DUPLICATE
 					path.setTimestamp(old.GetTimestamp())
 				}
 				d.knownPathList[idx] = path
@@ -89,7 +148,22 @@ func (adj *AdjRib) Update(pathList []*Path) {
 				}
 			}
 		}
+		s, y := adj.statistics[rf]
+		if !y {
+			s = &UpdateStatistics{}
+			adj.statistics[rf] = s
+		}
+		s.Add(types[n])
+	}
+	return types
+}
+
+// Statistics returns the counters of the paths of the family.
+func (adj *AdjRib) Statistics(rf bgp.RouteFamily) UpdateStatistics {
+	if s, y := adj.statistics[rf]; y {
+		return *s
 	}
+	return UpdateStatistics{}
 }
 
 /*
@@ -172,6 +246,16 @@ func (adj *AdjRib) Drop(rfList []bgp.RouteFamily) []*Path {
 	return l
 }
 
+// AddFamilies adds the empty tables of the families which the AdjRib
+// doesn't have yet.
+func (adj *AdjRib) AddFamilies(rfList []bgp.RouteFamily) {
+	for _, rf := range rfList {
+		if _, ok := adj.table[rf]; !ok {
+			adj.table[rf] = NewTable(adj.logger, rf)
+		}
+	}
+}
+
 func (adj *AdjRib) DropStale(rfList []bgp.RouteFamily) []*Path {
 	pathList := make([]*Path, 0, adj.Count(rfList))
 	adj.walk(rfList, func(d *Destination) bool {
@@ -239,6 +323,123 @@ func (adj *AdjRib) Select(family bgp.RouteFamily, accepted bool, option ...Table
 	return t.Select(option...)
 }
 
+type AdjRibDiffType uint8
+
+const (
+	// the path only in the left Adj-RIB
+	ADJ_RIB_DIFF_ONLY_LEFT AdjRibDiffType = iota
+	// the path only in the right Adj-RIB
+	ADJ_RIB_DIFF_ONLY_RIGHT
+	// the paths with the different attributes
+	ADJ_RIB_DIFF_DIFFERENT
+)
+
+// AdjRibDiff is the difference of the paths of a prefix with the same
+// path identifier between two Adj-RIBs.
+type AdjRibDiff struct {
+	Type  AdjRibDiffType
+	Left  *Path
+	Right *Path
+}
+
+// comparedAttrs returns the serialized path attributes other than the
+// ignored ones. The next hop is compared regardless of MP_REACH_NLRI.
+func comparedAttrs(path *Path, ignored map[bgp.BGPAttrType]struct{}) map[bgp.BGPAttrType][]byte {
+	m := make(map[bgp.BGPAttrType][]byte)
+	for _, a := range path.GetPathAttrs() {
+		t := a.GetType()
+		if _, y := ignored[t]; y || t == bgp.BGP_ATTR_TYPE_MP_REACH_NLRI || t == bgp.BGP_ATTR_TYPE_NEXT_HOP {
+			continue
+		}
+		b, _ := a.Serialize()
+		m[t] = b
+	}
+	if _, y := ignored[bgp.BGP_ATTR_TYPE_NEXT_HOP]; !y {
+		m[bgp.BGP_ATTR_TYPE_NEXT_HOP] = []byte(path.GetNexthop().String())
+	}
+	return m
+}
+
+func sameAttrs(l, r *Path, ignored map[bgp.BGPAttrType]struct{}) bool {
+	lm, rm := comparedAttrs(l, ignored), comparedAttrs(r, ignored)
+	if len(lm) != len(rm) {
+		return false
+	}
+	for t, b := range lm {
+		if !bytes.Equal(b, rm[t]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Diff returns the paths of the family only in either Adj-RIB or with the
+// different attributes other than the ignored ones, sorted by the prefixes
+// and the path identifiers.
+func (adj *AdjRib) Diff(other *AdjRib, family bgp.RouteFamily, ignored map[bgp.BGPAttrType]struct{}) ([]*AdjRibDiff, error) {
+	lt, ok := adj.table[family]
+	if !ok {
+		return nil, fmt.Errorf("%s unsupported", family)
+	}
+	rt, ok := other.table[family]
+	if !ok {
+		return nil, fmt.Errorf("%s unsupported", family)
+	}
+	l := make([]*AdjRibDiff, 0)
+	for key, ld := range lt.destinations {
+		var right []*Path
+		if rd, y := rt.destinations[key]; y {
+			right = rd.knownPathList
+		}
+		matched := make(map[uint32]struct{})
+		for _, lp := range ld.knownPathList {
+			id := lp.GetNlri().PathIdentifier()
+			var rp *Path
+			for _, p := range right {
+				if p.GetNlri().PathIdentifier() == id {
+					rp = p
+					break
+				}
+			}
+			if rp == nil {
+				l = append(l, &AdjRibDiff{Type: ADJ_RIB_DIFF_ONLY_LEFT, Left: lp})
+				continue
+			}
+			matched[id] = struct{}{}
+			if !sameAttrs(lp, rp, ignored) {
+				l = append(l, &AdjRibDiff{Type: ADJ_RIB_DIFF_DIFFERENT, Left: lp, Right: rp})
+			}
+		}
+		for _, rp := range right {
+			if _, y := matched[rp.GetNlri().PathIdentifier()]; !y {
+				l = append(l, &AdjRibDiff{Type: ADJ_RIB_DIFF_ONLY_RIGHT, Right: rp})
+			}
+		}
+	}
+	for key, rd := range rt.destinations {
+		if _, y := lt.destinations[key]; y {
+			continue
+		}
+		for _, rp := range rd.knownPathList {
+			l = append(l, &AdjRibDiff{Type: ADJ_RIB_DIFF_ONLY_RIGHT, Right: rp})
+		}
+	}
+	path := func(d *AdjRibDiff) *Path {
+		if d.Left != nil {
+			return d.Left
+		}
+		return d.Right
+	}
+	sort.Slice(l, func(i, j int) bool {
+		ni, nj := path(l[i]).GetNlri(), path(l[j]).GetNlri()
+		if ni.String() != nj.String() {
+			return ni.String() < nj.String()
+		}
+		return ni.PathIdentifier() < nj.PathIdentifier()
+	})
+	return l, nil
+}
+
 func (adj *AdjRib) TableInfo(family bgp.RouteFamily) (*TableInfo, error) {
 	if _, ok := adj.table[family]; !ok {
 		return nil, fmt.Errorf("%s unsupported", family)
@@ -162,3 +162,72 @@ func TestLLGRStale(t *testing.T) {
 	assert.Equal(t, adj.Accepted([]bgp.RouteFamily{family}), 1)
 	assert.Equal(t, 2, len(adj.table[family].destinations))
 }
+
+func TestUpdateStatistics(t *testing.T) {
+	assert := assert.New(t)
+	pi := &PeerInfo{}
+	attrs := []bgp.PathAttributeInterface{bgp.NewPathAttributeOrigin(0)}
+	nlri := bgp.NewIPAddrPrefix(24, "20.20.20.0")
+	p1 := NewPath(pi, nlri, false, attrs, time.Now(), false)
+	family := p1.GetRouteFamily()
+
+	adj := NewAdjRib(logger, []bgp.RouteFamily{family})
+	assert.Equal([]UpdateType{UPDATE_TYPE_ANNOUNCEMENT}, adj.Update([]*Path{p1}))
+	p2 := NewPath(pi, nlri, false, attrs, time.Now(), false)
+	assert.Equal([]UpdateType{UPDATE_TYPE_DUPLICATE}, adj.Update([]*Path{p2}))
+	p3 := NewPath(pi, nlri, false, []bgp.PathAttributeInterface{bgp.NewPathAttributeOrigin(2)}, time.Now(), false)
+	assert.Equal([]UpdateType{UPDATE_TYPE_ATTRIBUTE_CHANGE}, adj.Update([]*Path{p3}))
+	assert.Equal([]UpdateType{UPDATE_TYPE_FLAP, UPDATE_TYPE_WITHDRAWAL}, adj.Update([]*Path{p3.Clone(true), p3.Clone(true)}))
+
+	assert.Equal(UpdateStatistics{
+		Updates:          3,
+		Withdrawals:      2,
+		Duplicates:       1,
+		AttributeChanges: 1,
+		Flaps:            1,
+	}, adj.Statistics(family))
+	assert.Equal(UpdateStatistics{}, adj.Statistics(bgp.RF_IPv6_UC))
+}
+
+func TestAdjRibDiff(t *testing.T) {
+	assert := assert.New(t)
+	path := func(prefix string, med uint32, nexthop string) *Path {
+		return NewPath(&PeerInfo{}, bgp.NewIPAddrPrefix(24, prefix), false, []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeMultiExitDisc(med),
+			bgp.NewPathAttributeNextHop(nexthop),
+		}, time.Now(), false)
+	}
+	families := []bgp.RouteFamily{bgp.RF_IPv4_UC}
+	a := NewAdjRib(logger, families)
+	a.Update([]*Path{
+		path("10.0.1.0", 0, "1.1.1.1"),
+		path("10.0.2.0", 0, "1.1.1.1"),
+		path("10.0.3.0", 0, "1.1.1.1"),
+	})
+	b := NewAdjRib(logger, families)
+	b.Update([]*Path{
+		path("10.0.2.0", 0, "2.2.2.2"),
+		path("10.0.3.0", 10, "2.2.2.2"),
+		path("10.0.4.0", 0, "2.2.2.2"),
+	})
+
+	l, err := a.Diff(b, bgp.RF_IPv4_UC, map[bgp.BGPAttrType]struct{}{bgp.BGP_ATTR_TYPE_NEXT_HOP: {}})
+	assert.NoError(err)
+	assert.Len(l, 3)
+	assert.Equal(ADJ_RIB_DIFF_ONLY_LEFT, l[0].Type)
+	assert.Equal("10.0.1.0/24", l[0].Left.GetNlri().String())
+	assert.Nil(l[0].Right)
+	assert.Equal(ADJ_RIB_DIFF_DIFFERENT, l[1].Type)
+	assert.Equal("10.0.3.0/24", l[1].Left.GetNlri().String())
+	assert.Equal(ADJ_RIB_DIFF_ONLY_RIGHT, l[2].Type)
+	assert.Equal("10.0.4.0/24", l[2].Right.GetNlri().String())
+
+	// the next hops differ too
+	l, err = a.Diff(b, bgp.RF_IPv4_UC, nil)
+	assert.NoError(err)
+	assert.Len(l, 4)
+
+	_, err = a.Diff(b, bgp.RF_IPv6_UC, nil)
+	assert.Error(err)
+}
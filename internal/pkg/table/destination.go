@@ -31,6 +31,52 @@ import (
 var SelectionOptions oc.RouteSelectionOptionsConfig
 var UseMultiplePaths oc.UseMultiplePathsConfig
 
+// MultiPathOptions is the multipath option of each route family enabling
+// multipath. The other route families use multipath, without the limit,
+// only if UseMultiplePaths is enabled.
+var MultiPathOptions map[bgp.RouteFamily]MultiPathOption
+
+// MultiPathOption is the option of the equal-cost best paths used
+// together.
+type MultiPathOption struct {
+	Enabled bool
+	// the paths of different AS_PATHs of the same length are used together
+	// (as-path multipath-relax). Otherwise, the AS_PATHs must be the same.
+	Relax bool
+	// the maximum numbers of the eBGP and iBGP paths, unlimited if zero
+	EbgpMaximumPaths uint32
+	IbgpMaximumPaths uint32
+}
+
+func NewMultiPathOption(c *oc.UseMultiplePaths) MultiPathOption {
+	return MultiPathOption{
+		Enabled:          c.Config.Enabled,
+		Relax:            c.Ebgp.Config.AllowMultipleAs,
+		EbgpMaximumPaths: c.Ebgp.Config.MaximumPaths,
+		IbgpMaximumPaths: c.Ibgp.Config.MaximumPaths,
+	}
+}
+
+func GetMultiPathOption(rf bgp.RouteFamily) MultiPathOption {
+	if o, y := MultiPathOptions[rf]; y {
+		return o
+	}
+	return MultiPathOption{Enabled: UseMultiplePaths.Enabled}
+}
+
+// MultiPathEnabled returns true if multipath is enabled for any route
+// family.
+func MultiPathEnabled() bool {
+	return UseMultiplePaths.Enabled || len(MultiPathOptions) > 0
+}
+
+func (o MultiPathOption) maximumPaths(best *Path) int {
+	if best.IsIBGP() {
+		return int(o.IbgpMaximumPaths)
+	}
+	return int(o.EbgpMaximumPaths)
+}
+
 type BestPathReason uint8
 
 const (
@@ -50,6 +96,8 @@ const (
 	BPR_OLDER
 	BPR_NON_LLGR_STALE
 	BPR_NEIGH_ADDR
+	BPR_AIGP
+	BPR_MAC_MOBILITY
 )
 
 var BestPathReasonStringMap = map[BestPathReason]string{
@@ -69,6 +117,8 @@ var BestPathReasonStringMap = map[BestPathReason]string{
 	BPR_OLDER:              "Older",
 	BPR_NON_LLGR_STALE:     "no LLGR Stale",
 	BPR_NEIGH_ADDR:         "Neighbor Address",
+	BPR_AIGP:               "AIGP",
+	BPR_MAC_MOBILITY:       "MAC Mobility",
 }
 
 func (r *BestPathReason) String() string {
@@ -217,6 +267,49 @@ func (dd *Destination) GetBestPath(id string, as uint32) *Path {
 	return p
 }
 
+// IGPCostFunc returns the IGP cost to the next hop from a vantage point.
+// Returns false if the cost is unknown.
+type IGPCostFunc func(nexthop net.IP) (uint32, bool)
+
+// getOptimalBestPath returns the best path from the point of view of the
+// vantage point whose IGP costs to the next hops are given by cost
+// (RFC9107). pathList is expected to be sorted in best path order. The
+// path of the lowest cost is selected among the ones tied with the best
+// path up to the IGP cost step. The paths of the unknown cost are the
+// least preferred and the global order is kept for the same costs.
+func getOptimalBestPath(pathList []*Path, cost IGPCostFunc) *Path {
+	if len(pathList) == 0 {
+		return nil
+	}
+	best := pathList[0]
+	if best.IsNexthopInvalid || best.GetRouteFamily() == bgp.RF_RTC_UC {
+		return best
+	}
+	bestCost, known := cost(best.GetNexthop())
+	for _, p := range pathList[1:] {
+		if p.IsNexthopInvalid {
+			continue
+		}
+		if better, _ := compareBeforeIGPCost(pathList[0], p); better != nil {
+			continue
+		}
+		if c, y := cost(p.GetNexthop()); y && (!known || c < bestCost) {
+			best, bestCost, known = p, c, true
+		}
+	}
+	return best
+}
+
+// GetOptimalBestPath returns the best path from the point of view of the
+// vantage point given by cost.
+func (dd *Destination) GetOptimalBestPath(cost IGPCostFunc) *Path {
+	p := getOptimalBestPath(dd.knownPathList, cost)
+	if p == nil || p.IsNexthopInvalid {
+		return nil
+	}
+	return p
+}
+
 func (dd *Destination) GetMultiBestPath(id string) []*Path {
 	return getMultiBestPath(id, dd.knownPathList)
 }
@@ -388,7 +481,9 @@ func (dst *Destination) sort() BestPathReason {
 		//	-`path2`: second path to compare
 		//
 		//	Best path processing will involve following steps:
-		//	1.  Select a path with a reachable next hop.
+		//	1.  Select a path with a reachable next hop. For the EVPN MAC/IP
+		//	Advertisement routes, select the route with the sticky flag or the
+		//	higher MAC Mobility sequence number next.
 		//	2.  Select the path with the highest weight.
 		//	3.  If path weights are the same, select the path with the highest
 		//	local preference value.
@@ -398,12 +493,13 @@ func (dst *Destination) sort() BestPathReason {
 		//	6.  If all paths have the same AS-path length, select the path based
 		//	on origin: IGP is preferred over EGP; EGP is preferred over
 		//	Incomplete.
-		//	7.  If the origins are the same, select the path with lowest MED
-		//	value.
-		//	8.  If the paths have the same MED values, select the path learned
+		//	7.  If the origins are the same and AIGP is enabled, select the
+		//	path with the lowest AIGP metric.
+		//	8.  Select the path with lowest MED value.
+		//	9.  If the paths have the same MED values, select the path learned
 		//	via EBGP over one learned via IBGP.
-		//	9.  Select the route with the lowest IGP cost to the next hop.
-		//	10. Select the route received from the peer with the lowest BGP
+		//	10. Select the route with the lowest IGP cost to the next hop.
+		//	11. Select the route received from the peer with the lowest BGP
 		//	router ID.
 		//
 		//	Returns None if best-path among given paths cannot be computed else best
@@ -414,46 +510,8 @@ func (dst *Destination) sort() BestPathReason {
 		path1 := dst.knownPathList[i]
 		path2 := dst.knownPathList[j]
 
-		var better *Path
-
-		// draft-uttaro-idr-bgp-persistence-02
-		if better == nil {
-			better = compareByLLGRStaleCommunity(path1, path2)
-			reason = BPR_NON_LLGR_STALE
-		}
-		// Follow best path calculation algorithm steps.
-		// compare by reachability
-		if better == nil {
-			better = compareByReachableNexthop(path1, path2)
-			reason = BPR_REACHABLE_NEXT_HOP
-		}
-
-		// compareByHighestWeight was a no-op and was removed.
-
-		if better == nil {
-			better = compareByLocalPref(path1, path2)
-			reason = BPR_LOCAL_PREF
-		}
-		if better == nil {
-			better = compareByLocalOrigin(path1, path2)
-			reason = BPR_LOCAL_ORIGIN
-		}
-		if better == nil {
-			better = compareByASPath(path1, path2)
-			reason = BPR_ASPATH
-		}
-		if better == nil {
-			better = compareByOrigin(path1, path2)
-			reason = BPR_ORIGIN
-		}
-		if better == nil {
-			better = compareByMED(path1, path2)
-			reason = BPR_MED
-		}
-		if better == nil {
-			better = compareByASNumber(path1, path2)
-			reason = BPR_ASN
-		}
+		better, r := compareBeforeIGPCost(path1, path2)
+		reason = r
 
 		// compareByIGPCost was a no-op and was removed.
 
@@ -478,6 +536,48 @@ func (dst *Destination) sort() BestPathReason {
 	return reason
 }
 
+// compareBeforeIGPCost compares the paths by the steps of the best path
+// selection preceding the IGP cost to the next hop. Returns nil with the
+// reason of the last step if the paths are tied.
+func compareBeforeIGPCost(path1, path2 *Path) (*Path, BestPathReason) {
+	// draft-uttaro-idr-bgp-persistence-02
+	if better := compareByLLGRStaleCommunity(path1, path2); better != nil {
+		return better, BPR_NON_LLGR_STALE
+	}
+	// Follow best path calculation algorithm steps.
+	// compare by reachability
+	if better := compareByReachableNexthop(path1, path2); better != nil {
+		return better, BPR_REACHABLE_NEXT_HOP
+	}
+	if better := compareByMacMobility(path1, path2); better != nil {
+		return better, BPR_MAC_MOBILITY
+	}
+
+	// compareByHighestWeight was a no-op and was removed.
+
+	if better := compareByLocalPref(path1, path2); better != nil {
+		return better, BPR_LOCAL_PREF
+	}
+	if better := compareByLocalOrigin(path1, path2); better != nil {
+		return better, BPR_LOCAL_ORIGIN
+	}
+	if better := compareByASPath(path1, path2); better != nil {
+		return better, BPR_ASPATH
+	}
+	if better := compareByOrigin(path1, path2); better != nil {
+		return better, BPR_ORIGIN
+	}
+	if SelectionOptions.EnableAigp {
+		if better := compareByAIGP(path1, path2); better != nil {
+			return better, BPR_AIGP
+		}
+	}
+	if better := compareByMED(path1, path2); better != nil {
+		return better, BPR_MED
+	}
+	return compareByASNumber(path1, path2), BPR_ASN
+}
+
 type Update struct {
 	KnownPathList    []*Path
 	OldKnownPathList []*Path
@@ -486,13 +586,57 @@ type Update struct {
 func getMultiBestPath(id string, pathList []*Path) []*Path {
 	list := make([]*Path, 0, len(pathList))
 	var best *Path
+	var option MultiPathOption
 	for _, p := range pathList {
-		if !p.IsNexthopInvalid {
-			if best == nil {
-				best = p
-				list = append(list, p)
-			} else if best.Compare(p) == 0 {
-				list = append(list, p)
+		if p.IsNexthopInvalid {
+			continue
+		}
+		if best == nil {
+			best = p
+			option = GetMultiPathOption(p.GetRouteFamily())
+			list = append(list, p)
+			if !option.Enabled {
+				break
+			}
+			continue
+		}
+		if max := option.maximumPaths(best); max > 0 && len(list) >= max {
+			break
+		}
+		if best.Compare(p) == 0 && (option.Relax || best.GetAsString() == p.GetAsString()) {
+			list = append(list, p)
+		}
+	}
+	return list
+}
+
+// SelectAddPathBest returns the paths to advertise to an ADD-PATH peer in
+// the "N best" and "best-external" send modes. pathList is expected to be
+// sorted in best path order. filter is applied to every candidate and
+// returns nil for the paths which must not be advertised to the peer.
+//
+// At most max paths are selected. If bestExternal is true, only the best
+// path and, unless the best path itself was learned from an external peer,
+// the best external path are selected.
+func SelectAddPathBest(id string, as uint32, pathList []*Path, max int, bestExternal bool, filter func(*Path) *Path) []*Path {
+	isExternal := func(p *Path) bool {
+		return !p.IsLocal() && !p.IsIBGP()
+	}
+	list := make([]*Path, 0, max)
+	for _, p := range pathList {
+		if len(list) >= max {
+			break
+		}
+		if rsFilter(id, as, p) || p.IsNexthopInvalid {
+			continue
+		}
+		if bestExternal && len(list) > 0 && !isExternal(p) {
+			continue
+		}
+		if f := filter(p); f != nil {
+			list = append(list, f)
+			if bestExternal && isExternal(p) {
+				break
 			}
 		}
 	}
@@ -565,21 +709,10 @@ func (u *Update) GetChanges(id string, as uint32, peerDown bool) (*Path, *Path,
 
 	var multi []*Path
 
-	if id == GLOBAL_RIB_NAME && UseMultiplePaths.Enabled {
-		diff := func(lhs, rhs []*Path) bool {
-			if len(lhs) != len(rhs) {
-				return true
-			}
-			for idx, l := range lhs {
-				if !l.Equal(rhs[idx]) {
-					return true
-				}
-			}
-			return false
-		}
+	if id == GLOBAL_RIB_NAME && MultiPathEnabled() {
 		oldM := getMultiBestPath(id, u.OldKnownPathList)
 		newM := getMultiBestPath(id, u.KnownPathList)
-		if diff(oldM, newM) {
+		if pathListChanged(oldM, newM) {
 			multi = newM
 			if len(newM) == 0 {
 				multi = []*Path{best}
@@ -589,6 +722,57 @@ func (u *Update) GetChanges(id string, as uint32, peerDown bool) (*Path, *Path,
 	return best, old, multi
 }
 
+// GetOptimalChanges is the same as GetChanges in the global RIB but returns
+// the best paths from the point of view of the vantage point given by cost.
+func (u *Update) GetOptimalChanges(cost IGPCostFunc) (*Path, *Path) {
+	old := getOptimalBestPath(u.OldKnownPathList, cost)
+	best := getOptimalBestPath(u.KnownPathList, cost)
+	if best != nil && best.Equal(old) {
+		if best.GetRouteFamily() == bgp.RF_RTC_UC {
+			return best, old
+		}
+		if best.IsNexthopInvalid != old.IsNexthopInvalid {
+			if best.IsNexthopInvalid {
+				return best.Clone(true), old
+			}
+			return best, old
+		}
+		return nil, old
+	}
+	if best == nil {
+		if old == nil {
+			return nil, nil
+		}
+		return old.Clone(true), old
+	}
+	return best, old
+}
+
+// GetMultiPathChangedBest returns the best path if it's unchanged while
+// the multipaths have changed.
+func (u *Update) GetMultiPathChangedBest(id string) *Path {
+	best := getBestPath(id, 0, u.KnownPathList)
+	if best == nil || !best.Equal(getBestPath(id, 0, u.OldKnownPathList)) {
+		return nil
+	}
+	if pathListChanged(getMultiBestPath(id, u.OldKnownPathList), getMultiBestPath(id, u.KnownPathList)) {
+		return best
+	}
+	return nil
+}
+
+func pathListChanged(lhs, rhs []*Path) bool {
+	if len(lhs) != len(rhs) {
+		return true
+	}
+	for idx, l := range lhs {
+		if !l.Equal(rhs[idx]) {
+			return true
+		}
+	}
+	return false
+}
+
 func compareByLLGRStaleCommunity(path1, path2 *Path) *Path {
 	p1 := path1.IsLLGRStale()
 	p2 := path2.IsLLGRStale()
@@ -615,6 +799,42 @@ func compareByReachableNexthop(path1, path2 *Path) *Path {
 	return nil
 }
 
+func compareByMacMobility(path1, path2 *Path) *Path {
+	// RFC 7432 15.1.
+	// The MAC/IP Advertisement route with the higher sequence number of
+	// the MAC Mobility extended community is preferred, and the one with
+	// the sticky (static) flag is preferred over the others (15.2). A
+	// route without the community has the lowest sequence number.
+	if !isMacIPAdvertisement(path1) || !isMacIPAdvertisement(path2) {
+		return nil
+	}
+	m1 := path1.GetMacMobility()
+	m2 := path2.GetMacMobility()
+	switch {
+	case m1 == nil && m2 == nil:
+		return nil
+	case m1 == nil:
+		return path2
+	case m2 == nil:
+		return path1
+	case m1.IsSticky != m2.IsSticky:
+		if m1.IsSticky {
+			return path1
+		}
+		return path2
+	case m1.Sequence > m2.Sequence:
+		return path1
+	case m1.Sequence < m2.Sequence:
+		return path2
+	}
+	return nil
+}
+
+func isMacIPAdvertisement(path *Path) bool {
+	nlri, ok := path.GetNlri().(*bgp.EVPNNLRI)
+	return ok && nlri.RouteType == bgp.EVPN_ROUTE_TYPE_MAC_IP_ADVERTISEMENT
+}
+
 func compareByLocalPref(path1, path2 *Path) *Path {
 	//	Selects a path with highest local-preference.
 	//
@@ -707,6 +927,27 @@ func compareByOrigin(path1, path2 *Path) *Path {
 	}
 }
 
+func compareByAIGP(path1, path2 *Path) *Path {
+	// RFC 7311 4.
+	// A path with the AIGP attribute is preferred over one without it and
+	// the path with the lowest AIGP metric is preferred among them.
+	aigp1, err1 := path1.GetAigp()
+	aigp2, err2 := path2.GetAigp()
+	switch {
+	case err1 != nil && err2 != nil:
+		return nil
+	case err1 != nil:
+		return path2
+	case err2 != nil:
+		return path1
+	case aigp1 < aigp2:
+		return path1
+	case aigp1 > aigp2:
+		return path2
+	}
+	return nil
+}
+
 func compareByMED(path1, path2 *Path) *Path {
 	//	Select the path based with lowest MED value.
 	//
@@ -916,18 +1157,10 @@ func (d *Destination) Select(option ...DestinationSelectOption) *Destination {
 		if best {
 			if !mp {
 				paths = []*Path{paths[0]}
-			} else {
-				ps := make([]*Path, 0, len(paths))
-				var best *Path
-				for _, p := range paths {
-					if best == nil {
-						best = p
-						ps = append(ps, p)
-					} else if best.Compare(p) == 0 {
-						ps = append(ps, p)
-					}
-				}
+			} else if ps := getMultiBestPath(id, paths); len(ps) > 0 {
 				paths = ps
+			} else {
+				paths = []*Path{paths[0]}
 			}
 		}
 	}
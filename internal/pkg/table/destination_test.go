@@ -16,8 +16,7 @@
 package table
 
 import (
-	//"fmt"
-
+	"fmt"
 	"net"
 	"testing"
 	"time"
@@ -196,6 +195,82 @@ func TestMedTieBreaker(t *testing.T) {
 	assert.Equal(t, compareByMED(p5, p6), p5)
 }
 
+func TestAigpTieBreaker(t *testing.T) {
+	nlri := bgp.NewIPAddrPrefix(24, "10.10.0.0")
+	updateMsg := func(med uint32, aigp []uint64) *bgp.BGPMessage {
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAs4PathParam(2, []uint32{65001})}),
+			bgp.NewPathAttributeNextHop("10.0.0.1"),
+			bgp.NewPathAttributeMultiExitDisc(med),
+		}
+		for _, m := range aigp {
+			attrs = append(attrs, bgp.NewPathAttributeAigp([]bgp.AigpTLVInterface{bgp.NewAigpTLVIgpMetric(m)}))
+		}
+		return bgp.NewBGPUpdateMessage(nil, attrs, []*bgp.IPAddrPrefix{nlri})
+	}
+	peer1 := &PeerInfo{AS: 2, LocalAS: 1, Address: net.IP{1, 1, 1, 1}, ID: net.IP{1, 1, 1, 1}}
+	path1 := ProcessMessage(updateMsg(0, []uint64{100}), peer1, time.Now())[0]
+	peer2 := &PeerInfo{AS: 2, LocalAS: 1, Address: net.IP{2, 2, 2, 2}, ID: net.IP{2, 2, 2, 2}}
+	path2 := ProcessMessage(updateMsg(10, []uint64{10}), peer2, time.Now())[0]
+	peer3 := &PeerInfo{AS: 2, LocalAS: 1, Address: net.IP{3, 3, 3, 3}, ID: net.IP{3, 3, 3, 3}}
+	path3 := ProcessMessage(updateMsg(0, nil), peer3, time.Now())[0]
+
+	assert.Equal(t, path2, compareByAIGP(path1, path2))
+	// a path with the attribute is preferred
+	assert.Equal(t, path1, compareByAIGP(path1, path3))
+	assert.Equal(t, path2, compareByAIGP(path3, path2))
+	assert.Nil(t, compareByAIGP(path3, path3))
+
+	// MED decides without the option
+	d := NewDestination(nlri, 0, path1, path2)
+	assert.Equal(t, BPR_MED, d.sort())
+	assert.Equal(t, path1, d.knownPathList[0])
+
+	SelectionOptions.EnableAigp = true
+	defer func() {
+		SelectionOptions.EnableAigp = false
+	}()
+	d = NewDestination(nlri, 0, path1, path2)
+	assert.Equal(t, BPR_AIGP, d.sort())
+	assert.Equal(t, path2, d.knownPathList[0])
+}
+
+func TestMacMobilityTieBreaker(t *testing.T) {
+	nlri := bgp.NewEVPNMacIPAdvertisementRoute(bgp.NewRouteDistinguisherTwoOctetAS(65000, 1), bgp.EthernetSegmentIdentifier{}, 0, "aa:bb:cc:dd:ee:ff", "", []uint32{100})
+	newPath := func(id byte, localPref uint32, m *bgp.MacMobilityExtended) *Path {
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeLocalPref(localPref),
+		}
+		if m != nil {
+			attrs = append(attrs, bgp.NewPathAttributeExtendedCommunities([]bgp.ExtendedCommunityInterface{m}))
+		}
+		peer := &PeerInfo{AS: 1, LocalAS: 1, Address: net.IP{id, id, id, id}, ID: net.IP{id, id, id, id}}
+		return NewPath(peer, nlri, false, attrs, time.Now(), false)
+	}
+	path1 := newPath(1, 200, nil)
+	path2 := newPath(2, 100, &bgp.MacMobilityExtended{Sequence: 0})
+	path3 := newPath(3, 100, &bgp.MacMobilityExtended{Sequence: 2})
+	path4 := newPath(4, 100, &bgp.MacMobilityExtended{Sequence: 1, IsSticky: true})
+
+	assert.Equal(t, path2, compareByMacMobility(path1, path2))
+	assert.Equal(t, path3, compareByMacMobility(path2, path3))
+	assert.Equal(t, path4, compareByMacMobility(path3, path4))
+	assert.Nil(t, compareByMacMobility(path3, path3))
+
+	// the sequence number is compared before the local preference
+	d := NewDestination(nlri, 0, path1, path2, path3)
+	assert.Equal(t, BPR_MAC_MOBILITY, d.sort())
+	assert.Equal(t, path3, d.knownPathList[0])
+
+	// not for the other routes
+	prefix := bgp.NewIPAddrPrefix(24, "10.10.0.0")
+	path5 := NewPath(nil, prefix, false, path1.GetPathAttrs(), time.Now(), false)
+	path6 := NewPath(nil, prefix, false, path3.GetPathAttrs(), time.Now(), false)
+	assert.Nil(t, compareByMacMobility(path5, path6))
+}
+
 func TestTimeTieBreaker(t *testing.T) {
 	origin := bgp.NewPathAttributeOrigin(0)
 	aspathParam := []bgp.AsPathParamInterface{bgp.NewAs4PathParam(2, []uint32{65001})}
@@ -397,6 +472,43 @@ func TestMultipath(t *testing.T) {
 	UseMultiplePaths.Enabled = false
 }
 
+func TestMultipathOption(t *testing.T) {
+	nlri := bgp.NewIPAddrPrefix(24, "10.10.10.0")
+	d := NewDestination(nlri, 0)
+	for i, as := range []uint32{1, 2, 3} {
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAs4PathParam(2, []uint32{as, 65000})}),
+			bgp.NewPathAttributeNextHop(fmt.Sprintf("192.168.150.%d", i+1)),
+		}
+		peer := &PeerInfo{AS: as, Address: net.IP{byte(as), 1, 1, 1}, ID: net.IP{byte(as), 1, 1, 1}}
+		d.Calculate(logger, NewPath(peer, nlri, false, attrs, time.Now(), false))
+	}
+	defer func() {
+		MultiPathOptions = nil
+	}()
+
+	// disabled
+	MultiPathOptions = map[bgp.RouteFamily]MultiPathOption{bgp.RF_IPv4_UC: {}}
+	assert.Len(t, d.GetMultiBestPath(GLOBAL_RIB_NAME), 1)
+
+	// the AS_PATHs differ
+	MultiPathOptions = map[bgp.RouteFamily]MultiPathOption{bgp.RF_IPv4_UC: {Enabled: true}}
+	assert.Len(t, d.GetMultiBestPath(GLOBAL_RIB_NAME), 1)
+
+	MultiPathOptions = map[bgp.RouteFamily]MultiPathOption{bgp.RF_IPv4_UC: {Enabled: true, Relax: true}}
+	assert.Len(t, d.GetMultiBestPath(GLOBAL_RIB_NAME), 3)
+
+	MultiPathOptions = map[bgp.RouteFamily]MultiPathOption{bgp.RF_IPv4_UC: {Enabled: true, Relax: true, EbgpMaximumPaths: 2}}
+	l := d.GetMultiBestPath(GLOBAL_RIB_NAME)
+	assert.Len(t, l, 2)
+	assert.Equal(t, d.knownPathList[0], l[0])
+
+	// the limit of iBGP doesn't apply
+	MultiPathOptions = map[bgp.RouteFamily]MultiPathOption{bgp.RF_IPv4_UC: {Enabled: true, Relax: true, IbgpMaximumPaths: 2}}
+	assert.Len(t, d.GetMultiBestPath(GLOBAL_RIB_NAME), 3)
+}
+
 func TestIdMap(t *testing.T) {
 	d := NewDestination(bgp.NewIPAddrPrefix(24, "10.10.0.101"), 64)
 	for i := 0; ; i++ {
@@ -433,3 +545,130 @@ func TestGetWithdrawnPath(t *testing.T) {
 	assert.Equal(t, len(l), 2)
 	assert.Equal(t, l[0].GetNlri(), p1.GetNlri())
 }
+
+func TestAddPathReceivedIdentifiers(t *testing.T) {
+	attrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeNextHop("10.0.0.1"),
+	}
+	peer := &PeerInfo{AS: 65001, LocalAS: 65000, Address: net.IP{10, 0, 0, 1}, ID: net.IP{10, 0, 0, 1}}
+
+	newPath := func(id uint32, withdraw bool) *Path {
+		nlri := bgp.NewIPAddrPrefix(24, "10.10.0.0")
+		nlri.SetPathIdentifier(id)
+		return NewPath(peer, nlri, withdraw, attrs, time.Now(), false)
+	}
+
+	d := NewDestination(bgp.NewIPAddrPrefix(24, "10.10.0.0"), 0)
+	d.Calculate(logger, newPath(1, false))
+	d.Calculate(logger, newPath(2, false))
+	// paths from the same peer with distinct path identifiers are kept
+	assert.Equal(t, 2, len(d.knownPathList))
+
+	// re-advertisement with the same identifier replaces the path
+	d.Calculate(logger, newPath(2, false))
+	assert.Equal(t, 2, len(d.knownPathList))
+
+	d.Calculate(logger, newPath(1, true))
+	assert.Equal(t, 1, len(d.knownPathList))
+	assert.Equal(t, uint32(2), d.knownPathList[0].GetNlri().PathIdentifier())
+}
+
+func TestSelectAddPathBest(t *testing.T) {
+	newPath := func(peer *PeerInfo, localPref uint32) *Path {
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop(peer.Address.String()),
+			bgp.NewPathAttributeLocalPref(localPref),
+		}
+		return NewPath(peer, bgp.NewIPAddrPrefix(24, "10.10.0.0"), false, attrs, time.Now(), false)
+	}
+	ibgp1 := &PeerInfo{AS: 65000, LocalAS: 65000, Address: net.IP{10, 0, 0, 1}, ID: net.IP{10, 0, 0, 1}}
+	ibgp2 := &PeerInfo{AS: 65000, LocalAS: 65000, Address: net.IP{10, 0, 0, 2}, ID: net.IP{10, 0, 0, 2}}
+	ebgp := &PeerInfo{AS: 65001, LocalAS: 65000, Address: net.IP{10, 0, 0, 3}, ID: net.IP{10, 0, 0, 3}}
+
+	p1 := newPath(ibgp1, 300)
+	p2 := newPath(ibgp2, 200)
+	p3 := newPath(ebgp, 100)
+
+	d := NewDestination(bgp.NewIPAddrPrefix(24, "10.10.0.0"), 0)
+	d.Calculate(logger, p3)
+	d.Calculate(logger, p1)
+	d.Calculate(logger, p2)
+
+	all := func(p *Path) *Path { return p }
+
+	l := SelectAddPathBest(GLOBAL_RIB_NAME, 0, d.knownPathList, 2, false, all)
+	assert.Equal(t, []*Path{p1, p2}, l)
+
+	l = SelectAddPathBest(GLOBAL_RIB_NAME, 0, d.knownPathList, 8, false, all)
+	assert.Equal(t, []*Path{p1, p2, p3}, l)
+
+	l = SelectAddPathBest(GLOBAL_RIB_NAME, 0, d.knownPathList, 8, true, all)
+	assert.Equal(t, []*Path{p1, p3}, l)
+
+	// the paths filtered out are not counted
+	l = SelectAddPathBest(GLOBAL_RIB_NAME, 0, d.knownPathList, 2, false, func(p *Path) *Path {
+		if p == p1 {
+			return nil
+		}
+		return p
+	})
+	assert.Equal(t, []*Path{p2, p3}, l)
+
+	// the best path is external, so nothing else is selected
+	p4 := newPath(&PeerInfo{AS: 65002, LocalAS: 65000, Address: net.IP{10, 0, 0, 4}, ID: net.IP{10, 0, 0, 4}}, 400)
+	d.Calculate(logger, p4)
+	l = SelectAddPathBest(GLOBAL_RIB_NAME, 0, d.knownPathList, 8, true, all)
+	assert.Equal(t, []*Path{p4}, l)
+}
+
+func TestOptimalBestPath(t *testing.T) {
+	newPath := func(peer *PeerInfo, localPref uint32) *Path {
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop(peer.Address.String()),
+			bgp.NewPathAttributeLocalPref(localPref),
+		}
+		return NewPath(peer, bgp.NewIPAddrPrefix(24, "10.10.0.0"), false, attrs, time.Now(), false)
+	}
+	ibgp1 := &PeerInfo{AS: 65000, LocalAS: 65000, Address: net.IP{10, 0, 0, 1}, ID: net.IP{10, 0, 0, 1}}
+	ibgp2 := &PeerInfo{AS: 65000, LocalAS: 65000, Address: net.IP{10, 0, 0, 2}, ID: net.IP{10, 0, 0, 2}}
+	ibgp3 := &PeerInfo{AS: 65000, LocalAS: 65000, Address: net.IP{10, 0, 0, 3}, ID: net.IP{10, 0, 0, 3}}
+
+	p1 := newPath(ibgp1, 100)
+	p2 := newPath(ibgp2, 100)
+	p3 := newPath(ibgp3, 50)
+
+	d := NewDestination(bgp.NewIPAddrPrefix(24, "10.10.0.0"), 0)
+	d.Calculate(logger, p1)
+	d.Calculate(logger, p2)
+	d.Calculate(logger, p3)
+	assert.Equal(t, p1, d.GetBestPath(GLOBAL_RIB_NAME, 0))
+
+	costs := func(m map[string]uint32) IGPCostFunc {
+		return func(nexthop net.IP) (uint32, bool) {
+			c, y := m[nexthop.String()]
+			return c, y
+		}
+	}
+
+	// the lowest cost among the paths tied up to the IGP cost
+	assert.Equal(t, p2, d.GetOptimalBestPath(costs(map[string]uint32{"10.0.0.1": 20, "10.0.0.2": 10, "10.0.0.3": 1})))
+	// the path of the unknown cost is the least preferred
+	assert.Equal(t, p2, d.GetOptimalBestPath(costs(map[string]uint32{"10.0.0.2": 10})))
+	// the global order is kept for the same and the unknown costs
+	assert.Equal(t, p1, d.GetOptimalBestPath(costs(map[string]uint32{"10.0.0.1": 10, "10.0.0.2": 10})))
+	assert.Equal(t, p1, d.GetOptimalBestPath(costs(nil)))
+
+	u := &Update{
+		KnownPathList:    d.GetAllKnownPathList(),
+		OldKnownPathList: []*Path{p1, p3},
+	}
+	best, old := u.GetOptimalChanges(costs(map[string]uint32{"10.0.0.1": 20, "10.0.0.2": 10}))
+	assert.Equal(t, p2, best)
+	assert.Equal(t, p1, old)
+	best, old = u.GetOptimalChanges(costs(map[string]uint32{"10.0.0.1": 10, "10.0.0.2": 20}))
+	assert.Nil(t, best)
+	assert.Equal(t, p1, old)
+}
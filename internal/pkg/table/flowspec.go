@@ -0,0 +1,124 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table
+
+import (
+	"sort"
+
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func isFlowSpecFamily(rf bgp.RouteFamily) bool {
+	switch rf {
+	case bgp.RF_FS_IPv4_UC, bgp.RF_FS_IPv6_UC, bgp.RF_FS_IPv4_VPN, bgp.RF_FS_IPv6_VPN, bgp.RF_FS_L2_VPN:
+		return true
+	}
+	return false
+}
+
+func flowSpecNLRI(nlri bgp.AddrPrefixInterface) *bgp.FlowSpecNLRI {
+	switch n := nlri.(type) {
+	case *bgp.FlowSpecIPv4Unicast:
+		return &n.FlowSpecNLRI
+	case *bgp.FlowSpecIPv6Unicast:
+		return &n.FlowSpecNLRI
+	case *bgp.FlowSpecIPv4VPN:
+		return &n.FlowSpecNLRI
+	case *bgp.FlowSpecIPv6VPN:
+		return &n.FlowSpecNLRI
+	case *bgp.FlowSpecL2VPN:
+		return &n.FlowSpecNLRI
+	}
+	return nil
+}
+
+type flowSpecEntry struct {
+	key  string
+	nlri *bgp.FlowSpecNLRI
+	dst  *Destination
+}
+
+// flowSpecIndex holds the destinations of a flowspec table in the order of
+// precedence (RFC 8955 5.1), the highest first. A rule is inserted and
+// deleted with a binary search instead of sorting the whole table.
+type flowSpecIndex struct {
+	entries []*flowSpecEntry
+}
+
+// search returns the range of the entries having the same precedence as
+// the nlri.
+func (idx *flowSpecIndex) search(nlri *bgp.FlowSpecNLRI) (int, int) {
+	i := sort.Search(len(idx.entries), func(i int) bool {
+		r, _ := bgp.CompareFlowSpecNLRI(idx.entries[i].nlri, nlri)
+		return r <= 0
+	})
+	j := i
+	for j < len(idx.entries) {
+		if r, _ := bgp.CompareFlowSpecNLRI(idx.entries[j].nlri, nlri); r != 0 {
+			break
+		}
+		j++
+	}
+	return i, j
+}
+
+func (idx *flowSpecIndex) set(key string, dst *Destination) {
+	nlri := flowSpecNLRI(dst.GetNlri())
+	if nlri == nil {
+		return
+	}
+	i, j := idx.search(nlri)
+	for k := i; k < j; k++ {
+		if idx.entries[k].key == key {
+			idx.entries[k].dst = dst
+			return
+		}
+	}
+	idx.entries = append(idx.entries, nil)
+	copy(idx.entries[j+1:], idx.entries[j:])
+	idx.entries[j] = &flowSpecEntry{key: key, nlri: nlri, dst: dst}
+}
+
+func (idx *flowSpecIndex) delete(key string, dst *Destination) {
+	nlri := flowSpecNLRI(dst.GetNlri())
+	if nlri == nil {
+		return
+	}
+	i, j := idx.search(nlri)
+	for k := i; k < j; k++ {
+		if idx.entries[k].key == key {
+			idx.entries = append(idx.entries[:k], idx.entries[k+1:]...)
+			return
+		}
+	}
+}
+
+// GetFlowSpecDestinations returns the destinations of the flowspec rules
+// having any path in the order of precedence, the highest first, or nil if
+// the table isn't of a flowspec family.
+func (t *Table) GetFlowSpecDestinations() []*Destination {
+	if t.flowSpec == nil {
+		return nil
+	}
+	l := make([]*Destination, 0, len(t.flowSpec.entries))
+	for _, e := range t.flowSpec.entries {
+		if len(e.dst.knownPathList) == 0 {
+			continue
+		}
+		l = append(l, e.dst)
+	}
+	return l
+}
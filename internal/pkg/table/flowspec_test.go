@@ -0,0 +1,85 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func TestFlowSpecOrder(t *testing.T) {
+	assert := assert.New(t)
+	peer := &PeerInfo{AS: 1, Address: net.IP{1, 1, 1, 1}}
+	path := func(rule string, withdraw bool) *Path {
+		cmp, err := bgp.ParseFlowSpecComponents(bgp.RF_FS_IPv4_UC, rule)
+		require.NoError(t, err)
+		nlri := bgp.NewFlowSpecIPv4Unicast(cmp)
+		return NewPath(peer, nlri, withdraw, []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeMpReachNLRI("0.0.0.0", []bgp.AddrPrefixInterface{nlri}),
+		}, time.Now(), false)
+	}
+	rules := func(tbl *Table) []string {
+		l := make([]string, 0)
+		for _, d := range tbl.GetFlowSpecDestinations() {
+			l = append(l, d.GetNlri().String())
+		}
+		return l
+	}
+
+	tm := NewTableManager(logger, []bgp.RouteFamily{bgp.RF_FS_IPv4_UC, bgp.RF_IPv4_UC})
+	for _, rule := range []string{
+		"destination 10.0.0.0/8",
+		"source 10.0.0.1/32",
+		"destination 10.0.1.0/24 protocol tcp",
+		"destination 10.0.0.0/24",
+		"destination 10.0.1.0/24",
+		"destination 10.0.0.0/24",
+	} {
+		tm.Update(path(rule, false))
+	}
+	tbl := tm.Tables[bgp.RF_FS_IPv4_UC]
+	expected := []string{
+		"[destination: 10.0.0.0/24]",
+		"[destination: 10.0.1.0/24][protocol: ==tcp]",
+		"[destination: 10.0.1.0/24]",
+		"[destination: 10.0.0.0/8]",
+		"[source: 10.0.0.1/32]",
+	}
+	assert.Equal(expected, rules(tbl))
+	assert.Len(tbl.GetDestinations(), len(expected))
+
+	tm.Update(path("destination 10.0.1.0/24 protocol tcp", true))
+	assert.Equal([]string{
+		"[destination: 10.0.0.0/24]",
+		"[destination: 10.0.1.0/24]",
+		"[destination: 10.0.0.0/8]",
+		"[source: 10.0.0.1/32]",
+	}, rules(tbl))
+
+	// the selected table holds the order too
+	r, err := tbl.Select()
+	assert.NoError(err)
+	assert.Equal(rules(tbl), rules(r))
+
+	assert.Nil(tm.Tables[bgp.RF_IPv4_UC].GetFlowSpecDestinations())
+}
@@ -24,18 +24,52 @@ import (
 	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
 )
 
+// DiscardAs4PathAttrs removes the AS4_PATH and AS4_AGGREGATOR attributes
+// received from the NEW BGP speaker.
+//
+// RFC 6793 3. Protocol Extensions
+//
+// A NEW BGP speaker MUST NOT send the AS4_PATH and AS4_AGGREGATOR attributes
+// to another NEW BGP speaker. If a NEW BGP speaker receives them from
+// another NEW BGP speaker, it MUST discard them and continue processing the
+// UPDATE message.
+func DiscardAs4PathAttrs(logger log.Logger, msg *bgp.BGPUpdate) {
+	attrs := make([]bgp.PathAttributeInterface, 0, len(msg.PathAttributes))
+	for _, attr := range msg.PathAttributes {
+		switch attr.(type) {
+		case *bgp.PathAttributeAs4Path, *bgp.PathAttributeAs4Aggregator:
+			logger.Warn(fmt.Sprintf("%s received from NEW BGP speaker. discard", attr.GetType()),
+				log.Fields{
+					"Topic": "Table"})
+			continue
+		}
+		attrs = append(attrs, attr)
+	}
+	msg.PathAttributes = attrs
+}
+
+// UpdatePathAttrs2ByteAs converts the AS_PATH attribute to the one of the
+// 2-octet AS numbers with AS_TRANS for the OLD BGP speaker, and adds the
+// AS4_PATH attribute duplicating the AS path information if it contains the
+// 4-octet AS numbers.
 func UpdatePathAttrs2ByteAs(msg *bgp.BGPUpdate) error {
 	ps := msg.PathAttributes
-	msg.PathAttributes = make([]bgp.PathAttributeInterface, len(ps))
-	copy(msg.PathAttributes, ps)
+	msg.PathAttributes = make([]bgp.PathAttributeInterface, 0, len(ps)+1)
 	var asAttr *bgp.PathAttributeAsPath
 	idx := 0
-	for i, attr := range msg.PathAttributes {
-		if a, ok := attr.(*bgp.PathAttributeAsPath); ok {
-			asAttr = a
-			idx = i
-			break
+	for _, attr := range ps {
+		switch a := attr.(type) {
+		case *bgp.PathAttributeAsPath:
+			if asAttr == nil {
+				asAttr = a
+				idx = len(msg.PathAttributes)
+			}
+		case *bgp.PathAttributeAs4Path:
+			// not to duplicate the attribute, the one from the path is
+			// replaced with the one constructed here.
+			continue
 		}
+		msg.PathAttributes = append(msg.PathAttributes, attr)
 	}
 
 	if asAttr == nil {
@@ -49,9 +83,13 @@ func UpdatePathAttrs2ByteAs(msg *bgp.BGPUpdate) error {
 		segType := param.GetType()
 		asList := param.GetAS()
 		as2Path := make([]uint16, 0, len(asList))
+		confed := segType == bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SEQ || segType == bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SET
 		for _, as := range asList {
 			if as > (1<<16)-1 {
-				mkAs4 = true
+				// the confederation segments aren't in AS4_PATH
+				if !confed {
+					mkAs4 = true
+				}
 				as2Path = append(as2Path, bgp.AS_TRANS)
 			} else {
 				as2Path = append(as2Path, uint16(as))
@@ -64,10 +102,7 @@ func UpdatePathAttrs2ByteAs(msg *bgp.BGPUpdate) error {
 		// Whenever the AS path information contains the AS_CONFED_SEQUENCE or
 		// AS_CONFED_SET path segment, the NEW BGP speaker MUST exclude such
 		// path segments from the AS4_PATH attribute being constructed.
-		switch segType {
-		case bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SEQ, bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SET:
-			// pass
-		default:
+		if !confed {
 			if as4param, ok := param.(*bgp.As4PathParam); ok {
 				as4Params = append(as4Params, as4param)
 			}
@@ -80,13 +115,22 @@ func UpdatePathAttrs2ByteAs(msg *bgp.BGPUpdate) error {
 	return nil
 }
 
+// UpdatePathAttrs4ByteAs converts the AS_PATH attribute to the one of the
+// 4-octet AS numbers, and reconstructs the AS path information with the
+// AS4_PATH attribute received from the OLD BGP speaker.
 func UpdatePathAttrs4ByteAs(logger log.Logger, msg *bgp.BGPUpdate) error {
 	var asAttr *bgp.PathAttributeAsPath
 	var as4Attr *bgp.PathAttributeAs4Path
+	var aggAttr *bgp.PathAttributeAggregator
+	hasAgg4 := false
 	asAttrPos := 0
 	as4AttrPos := 0
 	for i, attr := range msg.PathAttributes {
 		switch a := attr.(type) {
+		case *bgp.PathAttributeAggregator:
+			aggAttr = a
+		case *bgp.PathAttributeAs4Aggregator:
+			hasAgg4 = true
 		case *bgp.PathAttributeAsPath:
 			asAttr = a
 			for j, param := range asAttr.Value {
@@ -116,17 +160,26 @@ func UpdatePathAttrs4ByteAs(logger log.Logger, msg *bgp.BGPUpdate) error {
 		return nil
 	}
 
+	// RFC 6793 4.2.3. Processing Received Updates
+	//
+	// If the AGGREGATOR attribute contains an AS number other than AS_TRANS,
+	// then the AS4_AGGREGATOR attribute and the AS4_PATH attribute (if
+	// present) MUST be ignored, and the AGGREGATOR attribute and the AS_PATH
+	// attribute are taken as the information about the aggregating node and
+	// the AS path, respectively.
+	if aggAttr != nil && hasAgg4 && aggAttr.Value.AS != uint32(bgp.AS_TRANS) {
+		logger.Warn("AGGREGATOR doesn't contain AS_TRANS. ignore AS4_PATH",
+			log.Fields{
+				"Topic": "Table"})
+		return nil
+	}
+
+	// the AS numbers are counted as the route selection does, where the
+	// AS_CONFED_SEQUENCE and AS_CONFED_SET segments aren't counted (RFC 5065).
 	asLen := 0
-	asConfedLen := 0
 	asParams := make([]bgp.AsPathParamInterface, 0, len(asAttr.Value))
 	for _, param := range asAttr.Value {
 		asLen += param.ASLen()
-		switch param.GetType() {
-		case bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SET:
-			asConfedLen++
-		case bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SEQ:
-			asConfedLen += len(param.GetAS())
-		}
 		asParams = append(asParams, param)
 	}
 
@@ -160,32 +213,46 @@ func UpdatePathAttrs4ByteAs(logger log.Logger, msg *bgp.BGPUpdate) error {
 		}
 	}
 
-	if asLen+asConfedLen < as4Len {
+	if asLen < as4Len {
 		logger.Warn("AS4_PATH is longer than AS_PATH. ignore AS4_PATH",
 			log.Fields{
 				"Topic": "Table"})
 		return nil
 	}
 
-	keepNum := asLen + asConfedLen - as4Len
+	keepNum := asLen - as4Len
 
+	// RFC 6793 4.2.3. Processing Received Updates
+	//
+	// Note that a valid AS_CONFED_SEQUENCE or AS_CONFED_SET path segment
+	// SHALL be prepended if it is either the leading path segment or is
+	// adjacent to a path segment that is prepended.
 	newParams := make([]bgp.AsPathParamInterface, 0, len(asAttr.Value))
 	for _, param := range asParams {
-		if keepNum-param.ASLen() >= 0 {
+		n := param.ASLen()
+		if keepNum == 0 {
+			switch param.GetType() {
+			case bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SEQ, bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SET:
+				newParams = append(newParams, param)
+				continue
+			}
+			break
+		}
+		if n <= keepNum {
 			newParams = append(newParams, param)
-			keepNum -= param.ASLen()
+			keepNum -= n
 		} else {
 			// only SEQ param reaches here
 			newParams = append(newParams, bgp.NewAs4PathParam(param.GetType(), param.GetAS()[:keepNum]))
 			keepNum = 0
 		}
-
-		if keepNum <= 0 {
-			break
-		}
 	}
 
 	for _, param := range as4Params {
+		if len(newParams) == 0 {
+			newParams = append(newParams, param)
+			continue
+		}
 		lastParam := newParams[len(newParams)-1]
 		lastParamAS := lastParam.GetAS()
 		paramType := param.GetType()
@@ -209,11 +276,18 @@ func UpdatePathAttrs4ByteAs(logger log.Logger, msg *bgp.BGPUpdate) error {
 	return nil
 }
 
+// UpdatePathAggregator2ByteAs converts the AGGREGATOR attribute to the one
+// of the 2-octet AS number with AS_TRANS for the OLD BGP speaker, and adds
+// the AS4_AGGREGATOR attribute duplicating it if the AS number is 4-octet.
 func UpdatePathAggregator2ByteAs(msg *bgp.BGPUpdate) {
 	as := uint32(0)
 	var addr string
-	for i, attr := range msg.PathAttributes {
-		switch agg := attr.(type) {
+	for i := 0; i < len(msg.PathAttributes); i++ {
+		switch agg := msg.PathAttributes[i].(type) {
+		case *bgp.PathAttributeAs4Aggregator:
+			// not to duplicate the attribute
+			msg.PathAttributes = append(msg.PathAttributes[:i:i], msg.PathAttributes[i+1:]...)
+			i--
 		case *bgp.PathAttributeAggregator:
 			addr = agg.Value.Address.String()
 			if agg.Value.AS > (1<<16)-1 {
@@ -258,7 +332,13 @@ func UpdatePathAggregator4ByteAs(msg *bgp.BGPUpdate) error {
 
 	if agg4Attr != nil {
 		msg.PathAttributes = append(msg.PathAttributes[:agg4AttrPos], msg.PathAttributes[agg4AttrPos+1:]...)
-		aggAttr.Value.AS = agg4Attr.Value.AS
+		// RFC 6793 4.2.3. Processing Received Updates
+		//
+		// If the AGGREGATOR attribute contains an AS number other than
+		// AS_TRANS, then the AS4_AGGREGATOR attribute MUST be ignored.
+		if aggAttr.Value.AS == uint32(bgp.AS_TRANS) {
+			aggAttr.Value.AS = agg4Attr.Value.AS
+		}
 	}
 	return nil
 }
@@ -288,7 +368,9 @@ type packer struct {
 
 type packerMP struct {
 	packer
-	paths       []*Path
+	// the paths with the same attributes and next hop, in order
+	cages       []*cage
+	cageMap     map[string]*cage
 	withdrawals []*Path
 }
 
@@ -305,15 +387,32 @@ func (p *packerMP) add(path *Path) {
 		return
 	}
 
-	p.paths = append(p.paths, path)
+	// MP_REACH_NLRI is built for each message
+	attrsB := bytes.NewBuffer(make([]byte, 0))
+	for _, v := range path.GetPathAttrs() {
+		if v.GetType() == bgp.BGP_ATTR_TYPE_MP_REACH_NLRI {
+			continue
+		}
+		b, _ := v.Serialize()
+		attrsB.Write(b)
+	}
+	attrsB.WriteString(path.GetNexthop().String())
+
+	if c, y := p.cageMap[attrsB.String()]; y {
+		c.paths = append(c.paths, path)
+	} else {
+		c := newCage(attrsB.Bytes(), path)
+		p.cageMap[attrsB.String()] = c
+		p.cages = append(p.cages, c)
+	}
 }
 
-func createMPReachMessage(path *Path) *bgp.BGPMessage {
+func createMPReachMessage(path *Path, nlris []bgp.AddrPrefixInterface) *bgp.BGPMessage {
 	oattrs := path.GetPathAttrs()
 	attrs := make([]bgp.PathAttributeInterface, 0, len(oattrs))
 	for _, a := range oattrs {
 		if a.GetType() == bgp.BGP_ATTR_TYPE_MP_REACH_NLRI {
-			attrs = append(attrs, bgp.NewPathAttributeMpReachNLRI(path.GetNexthop().String(), []bgp.AddrPrefixInterface{path.GetNlri()}))
+			attrs = append(attrs, bgp.NewPathAttributeMpReachNLRI(path.GetNexthop().String(), nlris))
 		} else {
 			attrs = append(attrs, a)
 		}
@@ -322,15 +421,55 @@ func createMPReachMessage(path *Path) *bgp.BGPMessage {
 }
 
 func (p *packerMP) pack(options ...*bgp.MarshallingOption) []*bgp.BGPMessage {
+	addpathNLRILen := 0
+	if bgp.IsAddPathEnabled(false, p.packer.family, options) {
+		addpathNLRILen = 4
+	}
+	// Header + Update (WithdrawnRoutesLen + TotalPathAttributeLen)
+	maxLen := bgp.GetMaxMessageLength(bgp.BGP_MSG_UPDATE, options) - (19 + 2 + 2)
+
+	// split the paths into the NLRI lists which fit in maxNLRILen bytes
+	loop := func(maxNLRILen int, paths []*Path, cb func([]bgp.AddrPrefixInterface)) {
+		nlris := make([]bgp.AddrPrefixInterface, 0, len(paths))
+		l := 0
+		for _, path := range paths {
+			nlriLen := path.GetNlri().Len() + addpathNLRILen
+			if len(nlris) > 0 && l+nlriLen > maxNLRILen {
+				cb(nlris)
+				nlris = make([]bgp.AddrPrefixInterface, 0, len(paths))
+				l = 0
+			}
+			nlris = append(nlris, path.GetNlri())
+			l += nlriLen
+		}
+		if len(nlris) > 0 {
+			cb(nlris)
+		}
+	}
+
 	msgs := make([]*bgp.BGPMessage, 0, p.packer.total)
 
-	for _, path := range p.withdrawals {
-		nlris := []bgp.AddrPrefixInterface{path.GetNlri()}
+	// MP_UNREACH_NLRI header with the extended length + AFI(2) + SAFI(1)
+	loop(maxLen-(4+3), p.withdrawals, func(nlris []bgp.AddrPrefixInterface) {
 		msgs = append(msgs, bgp.NewBGPUpdateMessage(nil, []bgp.PathAttributeInterface{bgp.NewPathAttributeMpUnreachNLRI(nlris)}, nil))
-	}
+	})
+
+	for _, c := range p.cages {
+		path := c.paths[0]
+		attrsLen := 0
+		for _, a := range path.GetPathAttrs() {
+			if a.GetType() != bgp.BGP_ATTR_TYPE_MP_REACH_NLRI {
+				attrsLen += a.Len()
+			}
+		}
+		// MP_REACH_NLRI header with the extended length + AFI(2) + SAFI(1)
+		// + Nexthop + Reserved(1)
+		mp := bgp.NewPathAttributeMpReachNLRI(path.GetNexthop().String(), []bgp.AddrPrefixInterface{path.GetNlri()})
+		mpLen := 4 + int(mp.Length) - path.GetNlri().Len()
 
-	for _, path := range p.paths {
-		msgs = append(msgs, createMPReachMessage(path))
+		loop(maxLen-attrsLen-mpLen, c.paths, func(nlris []bgp.AddrPrefixInterface) {
+			msgs = append(msgs, createMPReachMessage(path, nlris))
+		})
 	}
 
 	if p.eof {
@@ -344,8 +483,9 @@ func newPackerMP(f bgp.RouteFamily) *packerMP {
 		packer: packer{
 			family: f,
 		},
+		cages:       make([]*cage, 0),
+		cageMap:     make(map[string]*cage),
 		withdrawals: make([]*Path, 0),
-		paths:       make([]*Path, 0),
 	}
 }
 
@@ -419,7 +559,7 @@ func (p *packerV4) pack(options ...*bgp.MarshallingOption) []*bgp.BGPMessage {
 	// TotalPathAttributeLen + attributes + maxlen of NLRI).
 	// the max size of NLRI is 5bytes (plus 4bytes with addpath enabled)
 	maxNLRIs := func(attrsLen int) int {
-		return (bgp.BGP_MAX_MESSAGE_LENGTH - (19 + 2 + 2 + attrsLen)) / (5 + addpathNLRILen)
+		return (bgp.GetMaxMessageLength(bgp.BGP_MSG_UPDATE, options) - (19 + 2 + 2 + attrsLen)) / (5 + addpathNLRILen)
 	}
 
 	loop := func(attrsLen int, paths []*Path, cb func([]*bgp.IPAddrPrefix)) {
@@ -473,7 +613,7 @@ func (p *packerV4) pack(options ...*bgp.MarshallingOption) []*bgp.BGPMessage {
 	}
 
 	for _, path := range p.mpPaths {
-		msgs = append(msgs, createMPReachMessage(path))
+		msgs = append(msgs, createMPReachMessage(path, []bgp.AddrPrefixInterface{path.GetNlri()}))
 	}
 
 	if p.eof {
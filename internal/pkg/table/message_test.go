@@ -17,6 +17,7 @@ package table
 
 import (
 	"fmt"
+	"net"
 	"reflect"
 	"testing"
 	"time"
@@ -404,6 +405,133 @@ func TestASPathAs4TransMultipleLargeParams(t *testing.T) {
 	}
 }
 
+// before:
+//
+//	as-path  : [65001,65002], 23456, 100
+//	as4-path : 400000, 100
+//
+// expected result:
+//
+//	as-path  : [65001,65002], 400000, 100
+func TestAsPathAs4TransConfed(t *testing.T) {
+	params := []bgp.AsPathParamInterface{
+		bgp.NewAsPathParam(bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SEQ, []uint16{65001, 65002}),
+		bgp.NewAsPathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint16{bgp.AS_TRANS, 100}),
+	}
+	aspath := bgp.NewPathAttributeAsPath(params)
+	param4s := []*bgp.As4PathParam{bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint32{400000, 100})}
+	as4path := bgp.NewPathAttributeAs4Path(param4s)
+	msg := bgp.NewBGPUpdateMessage(nil, []bgp.PathAttributeInterface{aspath, as4path}, nil).Body.(*bgp.BGPUpdate)
+	UpdatePathAttrs4ByteAs(logger, msg)
+	assert.Equal(t, len(msg.PathAttributes), 1)
+	assert.Equal(t, "{AsPath: [65001,65002] 400000 100}", msg.PathAttributes[0].(*bgp.PathAttributeAsPath).String())
+}
+
+// before:
+//
+//	as-path  : 200, [65001,65002], 23456, 100
+//	as4-path : [65003], 400000, 100
+//
+// expected result:
+//
+//	as-path  : 200, [65001,65002], 400000, 100
+func TestAsPathAs4TransConfedAdjacent(t *testing.T) {
+	params := []bgp.AsPathParamInterface{
+		bgp.NewAsPathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint16{200}),
+		bgp.NewAsPathParam(bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SEQ, []uint16{65001, 65002}),
+		bgp.NewAsPathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint16{bgp.AS_TRANS, 100}),
+	}
+	aspath := bgp.NewPathAttributeAsPath(params)
+	param4s := []*bgp.As4PathParam{
+		// discarded
+		bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SEQ, []uint32{65003}),
+		bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint32{400000, 100}),
+	}
+	as4path := bgp.NewPathAttributeAs4Path(param4s)
+	msg := bgp.NewBGPUpdateMessage(nil, []bgp.PathAttributeInterface{aspath, as4path}, nil).Body.(*bgp.BGPUpdate)
+	UpdatePathAttrs4ByteAs(logger, msg)
+	assert.Equal(t, len(msg.PathAttributes), 1)
+	assert.Equal(t, "{AsPath: 200 [65001,65002] 400000 100}", msg.PathAttributes[0].(*bgp.PathAttributeAsPath).String())
+}
+
+// before:
+//
+//	as-path    : 23456, 100
+//	as4-path   : 400000, 100
+//	aggregator : 200
+//	as4-aggregator : 300000
+//
+// expected result:
+//
+//	as-path    : 23456, 100
+//	aggregator : 200
+func TestAsPathAs4TransAggregatorNotAsTrans(t *testing.T) {
+	addr := "192.168.0.1"
+	aspath := bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAsPathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint16{bgp.AS_TRANS, 100})})
+	as4path := bgp.NewPathAttributeAs4Path([]*bgp.As4PathParam{bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint32{400000, 100})})
+	msg := bgp.NewBGPUpdateMessage(nil, []bgp.PathAttributeInterface{
+		aspath, as4path, bgp.NewPathAttributeAggregator(uint16(200), addr), bgp.NewPathAttributeAs4Aggregator(300000, addr),
+	}, nil).Body.(*bgp.BGPUpdate)
+	assert.Nil(t, UpdatePathAttrs4ByteAs(logger, msg))
+	assert.Nil(t, UpdatePathAggregator4ByteAs(msg))
+	assert.Equal(t, len(msg.PathAttributes), 2)
+	assert.Equal(t, "{AsPath: 23456 100}", msg.PathAttributes[0].(*bgp.PathAttributeAsPath).String())
+	assert.Equal(t, uint32(200), msg.PathAttributes[1].(*bgp.PathAttributeAggregator).Value.AS)
+}
+
+func TestDiscardAs4PathAttrs(t *testing.T) {
+	addr := "192.168.0.1"
+	aspath := bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint32{23456, 100})})
+	as4path := bgp.NewPathAttributeAs4Path([]*bgp.As4PathParam{bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint32{400000, 100})})
+	msg := bgp.NewBGPUpdateMessage(nil, []bgp.PathAttributeInterface{
+		aspath, as4path, bgp.NewPathAttributeAggregator(uint32(bgp.AS_TRANS), addr), bgp.NewPathAttributeAs4Aggregator(300000, addr),
+	}, nil).Body.(*bgp.BGPUpdate)
+	DiscardAs4PathAttrs(logger, msg)
+	assert.Nil(t, UpdatePathAttrs4ByteAs(logger, msg))
+	assert.Nil(t, UpdatePathAggregator4ByteAs(msg))
+	assert.Equal(t, len(msg.PathAttributes), 2)
+	assert.Equal(t, "{AsPath: 23456 100}", msg.PathAttributes[0].(*bgp.PathAttributeAsPath).String())
+	assert.Equal(t, uint32(bgp.AS_TRANS), msg.PathAttributes[1].(*bgp.PathAttributeAggregator).Value.AS)
+}
+
+// before:
+//
+//	as-path  : [400001], 100
+//	as4-path : 400000, 100 (from the path)
+//
+// expected result:
+//
+//	as-path  : [23456], 100
+func TestAsPathAs2TransDuplicate(t *testing.T) {
+	params := []bgp.AsPathParamInterface{
+		bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SEQ, []uint32{400001}),
+		bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint32{100}),
+	}
+	aspath := bgp.NewPathAttributeAsPath(params)
+	as4path := bgp.NewPathAttributeAs4Path([]*bgp.As4PathParam{bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint32{400000, 100})})
+	msg := bgp.NewBGPUpdateMessage(nil, []bgp.PathAttributeInterface{aspath, as4path}, nil).Body.(*bgp.BGPUpdate)
+	UpdatePathAttrs2ByteAs(msg)
+	assert.Equal(t, len(msg.PathAttributes), 1)
+	assert.Equal(t, []uint32{bgp.AS_TRANS}, msg.PathAttributes[0].(*bgp.PathAttributeAsPath).Value[0].GetAS())
+	assert.Equal(t, []uint32{100}, msg.PathAttributes[0].(*bgp.PathAttributeAsPath).Value[1].GetAS())
+
+	// the AS4_PATH from the path is replaced
+	params[1] = bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint32{400002, 100})
+	msg = bgp.NewBGPUpdateMessage(nil, []bgp.PathAttributeInterface{bgp.NewPathAttributeAsPath(params), as4path}, nil).Body.(*bgp.BGPUpdate)
+	UpdatePathAttrs2ByteAs(msg)
+	assert.Equal(t, len(msg.PathAttributes), 2)
+	assert.Equal(t, []uint32{bgp.AS_TRANS}, msg.PathAttributes[0].(*bgp.PathAttributeAsPath).Value[0].GetAS())
+	assert.Equal(t, []uint32{bgp.AS_TRANS, 100}, msg.PathAttributes[0].(*bgp.PathAttributeAsPath).Value[1].GetAS())
+	assert.Equal(t, []uint32{400002, 100}, msg.PathAttributes[1].(*bgp.PathAttributeAs4Path).Value[0].AS)
+
+	addr := "192.168.0.1"
+	msg = bgp.NewBGPUpdateMessage(nil, []bgp.PathAttributeInterface{bgp.NewPathAttributeAggregator(uint32(400000), addr), bgp.NewPathAttributeAs4Aggregator(300000, addr)}, nil).Body.(*bgp.BGPUpdate)
+	UpdatePathAggregator2ByteAs(msg)
+	assert.Equal(t, len(msg.PathAttributes), 2)
+	assert.Equal(t, uint32(bgp.AS_TRANS), msg.PathAttributes[0].(*bgp.PathAttributeAggregator).Value.AS)
+	assert.Equal(t, uint32(400000), msg.PathAttributes[1].(*bgp.PathAttributeAs4Aggregator).Value.AS)
+}
+
 func TestAggregator4BytesASes(t *testing.T) {
 	getAggr := func(msg *bgp.BGPUpdate) *bgp.PathAttributeAggregator {
 		for _, attr := range msg.PathAttributes {
@@ -656,6 +784,82 @@ func TestNotMergeV4NLRIs(t *testing.T) {
 	assert.Equal(t, len(msgs), 2)
 }
 
+func TestMergeMPNLRIs(t *testing.T) {
+	aspath1 := []bgp.AsPathParamInterface{
+		bgp.NewAs4PathParam(2, []uint32{100}),
+	}
+
+	nr := 1024
+	paths := make([]*Path, 0, nr)
+	addrs := make([]string, 0, nr)
+	for i := 0; i < nr; i++ {
+		addrs = append(addrs, net.ParseIP(fmt.Sprintf("2001:db8::%x:%x", i>>8&0xff, i&0xff)).String())
+		nlri := []bgp.AddrPrefixInterface{bgp.NewIPv6AddrPrefix(128, addrs[i])}
+		// the next hop differs for the last half
+		nexthop := "2001:db8::1"
+		if i >= nr/2 {
+			nexthop = "2001:db8::2"
+		}
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeAsPath(aspath1),
+			bgp.NewPathAttributeMpReachNLRI(nexthop, nlri),
+		}
+		msg := bgp.NewBGPUpdateMessage(nil, attrs, nil)
+		paths = append(paths, ProcessMessage(msg, peerR1(), time.Now())...)
+	}
+	msgs := CreateUpdateMsgFromPaths(paths)
+	assert.True(t, len(msgs) < nr/32)
+
+	l := make([]string, 0, nr)
+	for _, msg := range msgs {
+		u := msg.Body.(*bgp.BGPUpdate)
+		assert.Equal(t, 3, len(u.PathAttributes))
+		mp := u.PathAttributes[2].(*bgp.PathAttributeMpReachNLRI)
+		for _, nlri := range mp.Value {
+			l = append(l, nlri.(*bgp.IPv6AddrPrefix).Prefix.String())
+			assert.Equal(t, mp.Nexthop.String(), paths[len(l)-1].GetNexthop().String())
+		}
+	}
+	assert.Equal(t, addrs, l)
+
+	for _, msg := range msgs {
+		d, err := msg.Serialize()
+		assert.NoError(t, err)
+		assert.True(t, len(d) <= bgp.BGP_MAX_MESSAGE_LENGTH)
+	}
+}
+
+func TestMergeMPWithdraw(t *testing.T) {
+	nr := 1024
+	paths := make([]*Path, 0, nr)
+	addrs := make([]string, 0, nr)
+	for i := 0; i < nr; i++ {
+		addrs = append(addrs, net.ParseIP(fmt.Sprintf("2001:db8::%x:%x", i>>8&0xff, i&0xff)).String())
+		nlri := []bgp.AddrPrefixInterface{bgp.NewIPv6AddrPrefix(128, addrs[i])}
+		msg := bgp.NewBGPUpdateMessage(nil, []bgp.PathAttributeInterface{bgp.NewPathAttributeMpUnreachNLRI(nlri)}, nil)
+		paths = append(paths, ProcessMessage(msg, peerR1(), time.Now())...)
+	}
+	msgs := CreateUpdateMsgFromPaths(paths)
+	assert.True(t, len(msgs) < nr/32)
+
+	l := make([]string, 0, nr)
+	for _, msg := range msgs {
+		u := msg.Body.(*bgp.BGPUpdate)
+		assert.Equal(t, 1, len(u.PathAttributes))
+		for _, nlri := range u.PathAttributes[0].(*bgp.PathAttributeMpUnreachNLRI).Value {
+			l = append(l, nlri.(*bgp.IPv6AddrPrefix).Prefix.String())
+		}
+	}
+	assert.Equal(t, addrs, l)
+
+	for _, msg := range msgs {
+		d, err := msg.Serialize()
+		assert.NoError(t, err)
+		assert.True(t, len(d) <= bgp.BGP_MAX_MESSAGE_LENGTH)
+	}
+}
+
 func TestMergeV4Withdraw(t *testing.T) {
 	nr := 1024
 	paths := make([]*Path, 0, nr)
@@ -694,3 +898,28 @@ func TestMergeV4Withdraw(t *testing.T) {
 		assert.True(t, len(d) < bgp.BGP_MAX_MESSAGE_LENGTH)
 	}
 }
+
+func TestMergeExtendedMessage(t *testing.T) {
+	nr := 4096
+	paths := make([]*Path, 0, nr)
+	for i := 0; i < nr; i++ {
+		nlri := []*bgp.IPAddrPrefix{bgp.NewIPAddrPrefix(32, fmt.Sprintf("1.1.%d.%d", i>>8&0xff, i&0xff))}
+		msg := bgp.NewBGPUpdateMessage(nlri, nil, nil)
+		paths = append(paths, ProcessMessage(msg, peerR1(), time.Now())...)
+	}
+	assert.True(t, len(CreateUpdateMsgFromPaths(paths)) > 1)
+
+	options := &bgp.MarshallingOption{ExtendedMessage: true}
+	msgs := CreateUpdateMsgFromPaths(paths, options)
+	assert.Equal(t, 1, len(msgs))
+	assert.Equal(t, nr, len(msgs[0].Body.(*bgp.BGPUpdate).WithdrawnRoutes))
+
+	d, err := msgs[0].Serialize(options)
+	assert.NoError(t, err)
+	assert.True(t, len(d) > bgp.BGP_MAX_MESSAGE_LENGTH)
+	assert.True(t, len(d) <= bgp.BGP_MAX_EXTENDED_MESSAGE_LENGTH)
+	// too long without the capability
+	msgs[0].Header.Len = 0
+	_, err = msgs[0].Serialize()
+	assert.Error(t, err)
+}
@@ -90,6 +90,7 @@ type originInfo struct {
 	isFromExternal     bool
 	eor                bool
 	stale              bool
+	acceptOwn          bool
 }
 
 type RpkiValidationReasonType string
@@ -218,6 +219,20 @@ func cloneAsPath(asAttr *bgp.PathAttributeAsPath) *bgp.PathAttributeAsPath {
 	return bgp.NewPathAttributeAsPath(newASparams)
 }
 
+// nextHopOptions returns the next-hop-options of the neighbor for the family.
+func nextHopOptions(peer *oc.Neighbor, family bgp.RouteFamily) oc.NextHopOptionsState {
+	for _, a := range peer.AfiSafis {
+		if a.State.Family == family {
+			return a.NextHopOptions.State
+		}
+	}
+	return oc.NextHopOptionsState{
+		NextHopSelf:      peer.Config.NextHopSelf,
+		NextHopSelfForce: peer.Config.NextHopSelfForce,
+		NextHopUnchanged: peer.Config.NextHopUnchanged,
+	}
+}
+
 func UpdatePathAttrs(logger log.Logger, global *oc.Global, peer *oc.Neighbor, info *PeerInfo, original *Path) *Path {
 	if peer.RouteServer.Config.RouteServerClient {
 		return original
@@ -242,9 +257,16 @@ func UpdatePathAttrs(logger log.Logger, global *oc.Global, peer *oc.Neighbor, in
 
 	localAddress := info.LocalAddress
 	nexthop := path.GetNexthop()
+	nhOptions := nextHopOptions(peer, path.GetRouteFamily())
 	if peer.State.PeerType == oc.PEER_TYPE_EXTERNAL {
+		// RFC 5065: the NEXT_HOP, MED and LOCAL_PREF attributes are
+		// preserved across the member ASes within the confederation.
+		confed := peer.IsConfederationMember(global)
+
 		// NEXTHOP handling
-		if !path.IsLocal() || nexthop.IsUnspecified() {
+		// next-hop-unchanged keeps the next hop for the route reflector
+		// over eBGP multihop.
+		if (!path.IsLocal() && !confed && !nhOptions.NextHopUnchanged) || nexthop.IsUnspecified() {
 			path.SetNexthop(localAddress)
 		}
 
@@ -252,23 +274,40 @@ func UpdatePathAttrs(logger log.Logger, global *oc.Global, peer *oc.Neighbor, in
 		path.RemovePrivateAS(peer.Config.LocalAs, peer.State.RemovePrivateAs)
 
 		// AS_PATH handling
-		confed := peer.IsConfederationMember(global)
+		// RFC7705 3.2. Replace AS: the AS of the router is prepended
+		// before the local-as unless replaced.
+		if peer.Config.LocalAsMigration && !peer.Config.LocalAsReplaceAs {
+			path.PrependAsn(global.Config.As, 1, false)
+		}
 		path.PrependAsn(peer.Config.LocalAs, 1, confed)
 		if !confed {
 			path.removeConfedAs()
+			// draft-ietf-idr-link-bandwidth 3.
+			// The non-transitive link bandwidth extended community isn't
+			// sent across the AS boundary unless set by the export policy.
+			path.RemoveLinkBandwidth(false)
 		}
 
 		// MED Handling
-		if med := path.getPathAttr(bgp.BGP_ATTR_TYPE_MULTI_EXIT_DISC); med != nil && !path.IsLocal() {
+		if med := path.getPathAttr(bgp.BGP_ATTR_TYPE_MULTI_EXIT_DISC); med != nil && !path.IsLocal() && !confed {
 			path.delPathAttr(bgp.BGP_ATTR_TYPE_MULTI_EXIT_DISC)
 		}
 
+		if confed && path.getPathAttr(bgp.BGP_ATTR_TYPE_LOCAL_PREF) == nil {
+			path.setPathAttr(bgp.NewPathAttributeLocalPref(DEFAULT_LOCAL_PREF))
+		}
+
 	} else if peer.State.PeerType == oc.PEER_TYPE_INTERNAL {
 		// NEXTHOP handling for iBGP
 		// if the path generated locally set local address as nexthop.
-		// if not, don't modify it.
-		// TODO: NEXT-HOP-SELF support
-		if path.IsLocal() && nexthop.IsUnspecified() {
+		// if not, don't modify it unless next-hop-self is configured
+		// for the path learned from eBGP, or next-hop-self-force for
+		// any path including the reflected one.
+		if path.IsLocal() {
+			if nexthop.IsUnspecified() {
+				path.SetNexthop(localAddress)
+			}
+		} else if nhOptions.NextHopSelfForce || (nhOptions.NextHopSelf && !path.IsIBGP()) {
 			path.SetNexthop(localAddress)
 		}
 
@@ -400,6 +439,16 @@ func (path *Path) IsStale() bool {
 	return path.OriginInfo().stale
 }
 
+// SetAcceptOwn marks the path of the own origin accepted with the
+// ACCEPT_OWN community (RFC 7611), not to be imported into the source VRF.
+func (path *Path) SetAcceptOwn(y bool) {
+	path.OriginInfo().acceptOwn = y
+}
+
+func (path *Path) IsAcceptOwn() bool {
+	return path.OriginInfo().acceptOwn
+}
+
 func (path *Path) IsRejected() bool {
 	return path.rejected
 }
@@ -934,6 +983,16 @@ func (path *Path) GetRouteTargets() []bgp.ExtendedCommunityInterface {
 	return rts
 }
 
+// GetMacMobility returns the MAC Mobility extended community or nil.
+func (path *Path) GetMacMobility() *bgp.MacMobilityExtended {
+	for _, ec := range path.GetExtCommunities() {
+		if m, ok := ec.(*bgp.MacMobilityExtended); ok {
+			return m
+		}
+	}
+	return nil
+}
+
 func (path *Path) GetLargeCommunities() []*bgp.LargeCommunity {
 	if a := path.getPathAttr(bgp.BGP_ATTR_TYPE_LARGE_COMMUNITY); a != nil {
 		v := a.(*bgp.PathAttributeLargeCommunities).Values
@@ -997,6 +1056,94 @@ func (path *Path) SetMed(med int64, doReplace bool) error {
 	return nil
 }
 
+// GetAigp returns the IGP metric of the AIGP attribute.
+func (path *Path) GetAigp() (uint64, error) {
+	if attr := path.getPathAttr(bgp.BGP_ATTR_TYPE_AIGP); attr != nil {
+		for _, t := range attr.(*bgp.PathAttributeAigp).Values {
+			if m, ok := t.(*bgp.AigpTLVIgpMetric); ok {
+				return m.Metric, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("no aigp path attr")
+}
+
+// SetAigp replaces the IGP metric of the AIGP attribute or adds metric to
+// it. The metric isn't added to a path without the attribute.
+func (path *Path) SetAigp(metric int64, doReplace bool) error {
+	v := uint64(metric)
+	if !doReplace {
+		m, err := path.GetAigp()
+		if err != nil {
+			return nil
+		}
+		if metric >= 0 {
+			if m > math.MaxUint64-uint64(metric) {
+				return fmt.Errorf("aigp value invalid. it's overflow threshold: %v+%v", m, metric)
+			}
+			v = m + uint64(metric)
+		} else {
+			if m < uint64(-metric) {
+				return fmt.Errorf("aigp value invalid. it's underflow threshold: %v%v", m, metric)
+			}
+			v = m - uint64(-metric)
+		}
+	} else if metric < 0 {
+		return fmt.Errorf("aigp value invalid: %v", metric)
+	}
+	path.setPathAttr(bgp.NewPathAttributeAigp([]bgp.AigpTLVInterface{bgp.NewAigpTLVIgpMetric(v)}))
+	return nil
+}
+
+func (path *Path) RemoveAigp() {
+	if path.getPathAttr(bgp.BGP_ATTR_TYPE_AIGP) != nil {
+		path.delPathAttr(bgp.BGP_ATTR_TYPE_AIGP)
+	}
+}
+
+// GetLinkBandwidth returns the bandwidth of the link bandwidth extended
+// community, in bytes per second.
+func (path *Path) GetLinkBandwidth() (float32, bool) {
+	for _, ec := range path.GetExtCommunities() {
+		if lb, ok := ec.(*bgp.LinkBandwidthExtended); ok {
+			return lb.Bandwidth, true
+		}
+	}
+	return 0, false
+}
+
+// SetLinkBandwidth replaces the link bandwidth extended communities with
+// the one.
+func (path *Path) SetLinkBandwidth(lb *bgp.LinkBandwidthExtended) {
+	path.RemoveLinkBandwidth(true)
+	path.SetExtCommunities([]bgp.ExtendedCommunityInterface{lb}, false)
+}
+
+// RemoveLinkBandwidth removes the non-transitive link bandwidth extended
+// communities, and the transitive ones too if transitive is true.
+func (path *Path) RemoveLinkBandwidth(transitive bool) {
+	attr := path.getPathAttr(bgp.BGP_ATTR_TYPE_EXTENDED_COMMUNITIES)
+	if attr == nil {
+		return
+	}
+	l := attr.(*bgp.PathAttributeExtendedCommunities).Value
+	exts := make([]bgp.ExtendedCommunityInterface, 0, len(l))
+	for _, ec := range l {
+		if lb, ok := ec.(*bgp.LinkBandwidthExtended); ok && (transitive || !lb.IsTransitive) {
+			continue
+		}
+		exts = append(exts, ec)
+	}
+	if len(exts) == len(l) {
+		return
+	}
+	if len(exts) == 0 {
+		path.delPathAttr(bgp.BGP_ATTR_TYPE_EXTENDED_COMMUNITIES)
+		return
+	}
+	path.setPathAttr(bgp.NewPathAttributeExtendedCommunities(exts))
+}
+
 func (path *Path) RemoveLocalPref() {
 	if path.getPathAttr(bgp.BGP_ATTR_TYPE_LOCAL_PREF) != nil {
 		path.delPathAttr(bgp.BGP_ATTR_TYPE_LOCAL_PREF)
@@ -1033,6 +1180,10 @@ func (path *Path) GetLocalPref() (uint32, error) {
 	return lp, nil
 }
 
+func (path *Path) SetLocalPref(lp uint32) {
+	path.setPathAttr(bgp.NewPathAttributeLocalPref(lp))
+}
+
 func (lhs *Path) Equal(rhs *Path) bool {
 	if rhs == nil {
 		return false
@@ -1115,12 +1266,16 @@ func (lhs *Path) Compare(rhs *Path) int {
 
 func (v *Vrf) ToGlobalPath(path *Path) error {
 	nlri := path.GetNlri()
+	label, prefixSID := v.vpnLabel()
 	switch rf := path.GetRouteFamily(); rf {
 	case bgp.RF_IPv4_UC:
 		n := nlri.(*bgp.IPAddrPrefix)
 		pathIdentifier := path.GetNlri().PathIdentifier()
-		path.OriginInfo().nlri = bgp.NewLabeledVPNIPAddrPrefix(n.Length, n.Prefix.String(), *bgp.NewMPLSLabelStack(v.MplsLabel), v.Rd)
+		path.OriginInfo().nlri = bgp.NewLabeledVPNIPAddrPrefix(n.Length, n.Prefix.String(), *bgp.NewMPLSLabelStack(label), v.Rd)
 		path.GetNlri().SetPathIdentifier(pathIdentifier)
+		if prefixSID != nil {
+			path.setPathAttr(prefixSID)
+		}
 	case bgp.RF_FS_IPv4_UC:
 		n := nlri.(*bgp.FlowSpecIPv4Unicast)
 		pathIdentifier := path.GetNlri().PathIdentifier()
@@ -1129,8 +1284,11 @@ func (v *Vrf) ToGlobalPath(path *Path) error {
 	case bgp.RF_IPv6_UC:
 		n := nlri.(*bgp.IPv6AddrPrefix)
 		pathIdentifier := path.GetNlri().PathIdentifier()
-		path.OriginInfo().nlri = bgp.NewLabeledVPNIPv6AddrPrefix(n.Length, n.Prefix.String(), *bgp.NewMPLSLabelStack(v.MplsLabel), v.Rd)
+		path.OriginInfo().nlri = bgp.NewLabeledVPNIPv6AddrPrefix(n.Length, n.Prefix.String(), *bgp.NewMPLSLabelStack(label), v.Rd)
 		path.GetNlri().SetPathIdentifier(pathIdentifier)
+		if prefixSID != nil {
+			path.setPathAttr(prefixSID)
+		}
 	case bgp.RF_FS_IPv6_UC:
 		n := nlri.(*bgp.FlowSpecIPv6Unicast)
 		pathIdentifier := path.GetNlri().PathIdentifier()
@@ -1167,14 +1325,19 @@ func (p *Path) ToGlobal(vrf *Vrf) *Path {
 	nlri := p.GetNlri()
 	nh := p.GetNexthop()
 	pathId := nlri.PathIdentifier()
+	var prefixSID *bgp.PathAttributePrefixSID
 	switch rf := p.GetRouteFamily(); rf {
 	case bgp.RF_IPv4_UC:
 		n := nlri.(*bgp.IPAddrPrefix)
-		nlri = bgp.NewLabeledVPNIPAddrPrefix(n.Length, n.Prefix.String(), *bgp.NewMPLSLabelStack(vrf.MplsLabel), vrf.Rd)
+		var label uint32
+		label, prefixSID = vrf.vpnLabel()
+		nlri = bgp.NewLabeledVPNIPAddrPrefix(n.Length, n.Prefix.String(), *bgp.NewMPLSLabelStack(label), vrf.Rd)
 		nlri.SetPathIdentifier(pathId)
 	case bgp.RF_IPv6_UC:
 		n := nlri.(*bgp.IPv6AddrPrefix)
-		nlri = bgp.NewLabeledVPNIPv6AddrPrefix(n.Length, n.Prefix.String(), *bgp.NewMPLSLabelStack(vrf.MplsLabel), vrf.Rd)
+		var label uint32
+		label, prefixSID = vrf.vpnLabel()
+		nlri = bgp.NewLabeledVPNIPv6AddrPrefix(n.Length, n.Prefix.String(), *bgp.NewMPLSLabelStack(label), vrf.Rd)
 		nlri.SetPathIdentifier(pathId)
 	case bgp.RF_EVPN:
 		n := nlri.(*bgp.EVPNNLRI)
@@ -1225,6 +1388,9 @@ func (p *Path) ToGlobal(vrf *Vrf) *Path {
 	path.SetExtCommunities(vrf.ExportRt, false)
 	path.delPathAttr(bgp.BGP_ATTR_TYPE_NEXT_HOP)
 	path.setPathAttr(bgp.NewPathAttributeMpReachNLRI(nh.String(), []bgp.AddrPrefixInterface{nlri}))
+	if prefixSID != nil {
+		path.setPathAttr(prefixSID)
+	}
 	return path
 }
 
@@ -1333,6 +1499,16 @@ func nlriToIPNet(nlri bgp.AddrPrefixInterface) *net.IPNet {
 			IP:   net.IP(T.Prefix.To16()),
 			Mask: net.CIDRMask(int(T.Length)-T.Labels.Len()*8-T.RD.Len()*8, 128),
 		}
+	case *bgp.CTIPAddrPrefix:
+		return &net.IPNet{
+			IP:   net.IP(T.Prefix.To4()),
+			Mask: net.CIDRMask(int(T.Length)-T.Labels.Len()*8-T.RD.Len()*8, 32),
+		}
+	case *bgp.CTIPv6AddrPrefix:
+		return &net.IPNet{
+			IP:   net.IP(T.Prefix.To16()),
+			Mask: net.CIDRMask(int(T.Length)-T.Labels.Len()*8-T.RD.Len()*8, 128),
+		}
 	}
 	return nil
 }
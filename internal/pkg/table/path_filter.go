@@ -0,0 +1,349 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// PathFilter is a filter expression evaluated against the paths in a RIB,
+// for example:
+//
+//	aspath ~ "_65001$" and (community ~ 65000:100 or localpref >= 200)
+//
+// The conditions are:
+//
+//	prefix ~ REGEXP            the prefix of the NLRI matches
+//	aspath ~ REGEXP            the AS_PATH matches, "_" as in as-path-set
+//	community ~ COMMUNITY      any community matches, as in community-set
+//	large-community ~ LARGE    any large community matches, as in
+//	                           large-community-set
+//	med OP NUMBER              OP is one of ==, !=, <, <=, > and >=
+//	localpref OP NUMBER
+//
+// "!~" negates "~". Conditions are combined with "and", "or", "not" and
+// parentheses. A value can be quoted with '"' or "'" to include spaces or
+// parentheses.
+type PathFilter struct {
+	expr pathFilterNode
+}
+
+// Match returns true if path satisfies f.
+func (f *PathFilter) Match(path *Path) bool {
+	return f.expr.match(path)
+}
+
+type pathFilterNode interface {
+	match(*Path) bool
+}
+
+type pathFilterAnd struct{ lhs, rhs pathFilterNode }
+
+func (n *pathFilterAnd) match(path *Path) bool { return n.lhs.match(path) && n.rhs.match(path) }
+
+type pathFilterOr struct{ lhs, rhs pathFilterNode }
+
+func (n *pathFilterOr) match(path *Path) bool { return n.lhs.match(path) || n.rhs.match(path) }
+
+type pathFilterNot struct{ node pathFilterNode }
+
+func (n *pathFilterNot) match(path *Path) bool { return !n.node.match(path) }
+
+type pathFilterRegexp struct {
+	exp    *regexp.Regexp
+	values func(*Path) []string
+	invert bool
+}
+
+func (n *pathFilterRegexp) match(path *Path) bool {
+	for _, v := range n.values(path) {
+		if n.exp.MatchString(v) {
+			return !n.invert
+		}
+	}
+	return n.invert
+}
+
+type pathFilterNumber struct {
+	op    string
+	value uint32
+	get   func(*Path) (uint32, error)
+}
+
+func (n *pathFilterNumber) match(path *Path) bool {
+	v, err := n.get(path)
+	if err != nil {
+		// the attribute doesn't exist
+		return false
+	}
+	switch n.op {
+	case "==":
+		return v == n.value
+	case "!=":
+		return v != n.value
+	case "<":
+		return v < n.value
+	case "<=":
+		return v <= n.value
+	case ">":
+		return v > n.value
+	case ">=":
+		return v >= n.value
+	}
+	return false
+}
+
+type pathFilterToken struct {
+	value string
+	// quoted string, never a keyword nor an operator
+	quoted bool
+}
+
+func tokenizePathFilter(s string) ([]pathFilterToken, error) {
+	tokens := make([]pathFilterToken, 0)
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case unicode.IsSpace(rune(c)):
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, pathFilterToken{value: string(c)})
+			i++
+		case c == '"':
+			// only \" is unescaped to keep the backslashes in regular
+			// expressions
+			var b strings.Builder
+			j := i + 1
+			for ; j < len(s) && s[j] != '"'; j++ {
+				if s[j] == '\\' && j+1 < len(s) && s[j+1] == '"' {
+					j++
+				}
+				b.WriteByte(s[j])
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("unterminated string at %d", i)
+			}
+			tokens = append(tokens, pathFilterToken{value: b.String(), quoted: true})
+			i = j + 1
+		case c == '\'':
+			j := strings.IndexByte(s[i+1:], '\'')
+			if j < 0 {
+				return nil, fmt.Errorf("unterminated string at %d", i)
+			}
+			tokens = append(tokens, pathFilterToken{value: s[i+1 : i+1+j], quoted: true})
+			i += j + 2
+		case strings.ContainsRune("~!=<>", rune(c)):
+			j := i + 1
+			for ; j < len(s) && strings.ContainsRune("~=", rune(s[j])); j++ {
+			}
+			tokens = append(tokens, pathFilterToken{value: s[i:j]})
+			i = j
+		default:
+			j := i
+			for ; j < len(s) && !unicode.IsSpace(rune(s[j])) && !strings.ContainsRune("()~!=<>", rune(s[j])); j++ {
+			}
+			tokens = append(tokens, pathFilterToken{value: s[i:j]})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+type pathFilterParser struct {
+	tokens []pathFilterToken
+	pos    int
+}
+
+func (p *pathFilterParser) peek() (pathFilterToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return pathFilterToken{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *pathFilterParser) next() (pathFilterToken, error) {
+	t, ok := p.peek()
+	if !ok {
+		return t, fmt.Errorf("unexpected end of filter")
+	}
+	p.pos++
+	return t, nil
+}
+
+func (p *pathFilterParser) keyword(k string) bool {
+	if t, ok := p.peek(); ok && !t.quoted && strings.ToLower(t.value) == k {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *pathFilterParser) parseOr() (pathFilterNode, error) {
+	lhs, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("or") {
+		rhs, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		lhs = &pathFilterOr{lhs: lhs, rhs: rhs}
+	}
+	return lhs, nil
+}
+
+func (p *pathFilterParser) parseAnd() (pathFilterNode, error) {
+	lhs, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("and") {
+		rhs, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		lhs = &pathFilterAnd{lhs: lhs, rhs: rhs}
+	}
+	return lhs, nil
+}
+
+func (p *pathFilterParser) parseUnary() (pathFilterNode, error) {
+	if p.keyword("not") {
+		n, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &pathFilterNot{node: n}, nil
+	}
+	if p.keyword("(") {
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.keyword(")") {
+			return nil, fmt.Errorf("missing ')'")
+		}
+		return n, nil
+	}
+	return p.parseCondition()
+}
+
+func (p *pathFilterParser) parseCondition() (pathFilterNode, error) {
+	field, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	op, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	value, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if op.quoted {
+		return nil, fmt.Errorf("invalid operator %q", op.value)
+	}
+
+	name := strings.ToLower(field.value)
+	switch name {
+	case "prefix", "aspath", "community", "large-community":
+		if op.value != "~" && op.value != "!~" {
+			return nil, fmt.Errorf("invalid operator %q for %s", op.value, name)
+		}
+		n := &pathFilterRegexp{invert: op.value == "!~"}
+		switch name {
+		case "prefix":
+			n.exp, err = regexp.Compile(value.value)
+			n.values = func(path *Path) []string {
+				return []string{path.GetNlri().String()}
+			}
+		case "aspath":
+			n.exp, err = regexp.Compile(strings.Replace(value.value, "_", ASPATH_REGEXP_MAGIC, -1))
+			n.values = func(path *Path) []string {
+				return []string{path.GetAsString()}
+			}
+		case "community":
+			n.exp, err = ParseCommunityRegexp(value.value)
+			n.values = func(path *Path) []string {
+				cs := path.GetCommunities()
+				l := make([]string, 0, len(cs))
+				for _, c := range cs {
+					l = append(l, fmt.Sprintf("%d:%d", c>>16, c&0x0000ffff))
+				}
+				return l
+			}
+		case "large-community":
+			n.exp, err = ParseLargeCommunityRegexp(value.value)
+			n.values = func(path *Path) []string {
+				cs := path.GetLargeCommunities()
+				l := make([]string, 0, len(cs))
+				for _, c := range cs {
+					l = append(l, c.String())
+				}
+				return l
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", name, value.value, err)
+		}
+		return n, nil
+	case "med", "localpref":
+		switch op.value {
+		case "==", "!=", "<", "<=", ">", ">=":
+		default:
+			return nil, fmt.Errorf("invalid operator %q for %s", op.value, name)
+		}
+		v, err := strconv.ParseUint(value.value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q", name, value.value)
+		}
+		n := &pathFilterNumber{op: op.value, value: uint32(v)}
+		if name == "med" {
+			n.get = func(path *Path) (uint32, error) { return path.GetMed() }
+		} else {
+			n.get = func(path *Path) (uint32, error) { return path.GetLocalPref() }
+		}
+		return n, nil
+	}
+	return nil, fmt.Errorf("unknown filter condition %q", field.value)
+}
+
+// ParsePathFilter parses the filter expression s. See PathFilter for the
+// syntax.
+func ParsePathFilter(s string) (*PathFilter, error) {
+	tokens, err := tokenizePathFilter(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty filter")
+	}
+	p := &pathFilterParser{tokens: tokens}
+	n, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t, ok := p.peek(); ok {
+		return nil, fmt.Errorf("unexpected %q", t.value)
+	}
+	return &PathFilter{expr: n}, nil
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func TestPathFilter(t *testing.T) {
+	assert := assert.New(t)
+
+	peer := &PeerInfo{AS: 65001, Address: net.ParseIP("10.0.0.1")}
+	pathAttributes := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAs4PathParam(2, []uint32{65001, 65002})}),
+		bgp.NewPathAttributeNextHop("10.0.0.1"),
+		bgp.NewPathAttributeMultiExitDisc(100),
+		bgp.NewPathAttributeCommunities([]uint32{65000<<16 | 100, 0xffffff01}),
+		bgp.NewPathAttributeLargeCommunities([]*bgp.LargeCommunity{bgp.NewLargeCommunity(65000, 1, 2)}),
+	}
+	nlri := []*bgp.IPAddrPrefix{bgp.NewIPAddrPrefix(24, "10.10.0.0")}
+	path := ProcessMessage(bgp.NewBGPUpdateMessage(nil, pathAttributes, nlri), peer, time.Now())[0]
+
+	for _, c := range []struct {
+		expr  string
+		match bool
+	}{
+		{`prefix ~ "^10\.10\."`, true},
+		{`prefix !~ ^10\.10\.`, false},
+		{`aspath ~ "_65002$"`, true},
+		{`aspath ~ "^65002_"`, false},
+		{`community ~ 65000:100`, true},
+		{`community ~ no-export`, true},
+		{`community ~ 65000:200`, false},
+		{`community ~ "^65000:.*"`, true},
+		{`large-community ~ 65000:*:2`, true},
+		{`large-community ~ 65000:2:*`, false},
+		{`med == 100`, true},
+		{`med < 100`, false},
+		{`med >= 100`, true},
+		{`localpref == 100`, true},
+		{`localpref > 100`, false},
+		{`aspath ~ _65001_ and med <= 50`, false},
+		{`aspath ~ _65001_ AND (med <= 50 or community ~ 65000:100)`, true},
+		{`not community ~ 65000:100`, false},
+		{`not (med != 100) and not localpref < 100`, true},
+	} {
+		f, err := ParsePathFilter(c.expr)
+		if !assert.Nil(err, c.expr) {
+			continue
+		}
+		assert.Equal(c.match, f.Match(path), c.expr)
+	}
+
+	// MED isn't compared for the path without MED
+	path = ProcessMessage(bgp.NewBGPUpdateMessage(nil, pathAttributes[:3], nlri), peer, time.Now())[0]
+	f, _ := ParsePathFilter("med != 100")
+	assert.False(f.Match(path))
+	f, _ = ParsePathFilter("not med == 100")
+	assert.True(f.Match(path))
+
+	for _, expr := range []string{
+		``,
+		`med`,
+		`med ~ 100`,
+		`med == abc`,
+		`aspath == 65001`,
+		`prefix ~ "("`,
+		`nexthop ~ 10.0.0.1`,
+		`(med == 100`,
+		`med == 100)`,
+		`med == 100 community ~ 65000:100`,
+		`prefix ~ "unterminated`,
+	} {
+		_, err := ParsePathFilter(expr)
+		assert.NotNil(err, expr)
+	}
+}
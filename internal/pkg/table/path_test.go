@@ -365,6 +365,124 @@ func TestReplaceAS(t *testing.T) {
 	assert.Equal(t, list[3], uint32(2))
 }
 
+func TestUpdatePathAttrsConfederation(t *testing.T) {
+	assert := assert.New(t)
+	global := &oc.Global{
+		Config: oc.GlobalConfig{As: 65001, RouterId: "10.0.0.1"},
+		Confederation: oc.Confederation{
+			Config: oc.ConfederationConfig{Enabled: true, Identifier: 30, MemberAsList: []uint32{65002}},
+		},
+	}
+	source := &PeerInfo{AS: 65001, ID: net.ParseIP("10.0.0.3"), Address: net.ParseIP("10.0.0.3")}
+	path := NewPath(source, bgp.NewIPAddrPrefix(24, "30.30.30.0"), false, []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint32{100})}),
+		bgp.NewPathAttributeNextHop("10.0.0.3"),
+		bgp.NewPathAttributeMultiExitDisc(10),
+	}, time.Now(), false)
+	info := &PeerInfo{LocalAddress: net.ParseIP("10.0.0.1")}
+	neighbor := func(peerAs, localAs uint32) *oc.Neighbor {
+		return &oc.Neighbor{
+			Config: oc.NeighborConfig{PeerAs: peerAs, LocalAs: localAs},
+			State:  oc.NeighborState{PeerType: oc.PEER_TYPE_EXTERNAL},
+		}
+	}
+
+	// the NEXT_HOP and MED are preserved and LOCAL_PREF is attached for
+	// the member AS
+	p := UpdatePathAttrs(logger, global, neighbor(65002, 65001), info, path)
+	assert.Equal("10.0.0.3", p.GetNexthop().String())
+	med, err := p.GetMed()
+	assert.NoError(err)
+	assert.Equal(uint32(10), med)
+	lp, err := p.GetLocalPref()
+	assert.NoError(err)
+	assert.Equal(uint32(DEFAULT_LOCAL_PREF), lp)
+	assert.Equal("(65001) 100", p.GetAsString())
+	assert.Equal(1, p.GetAsPathLen())
+
+	// the confederation segments are removed outside the confederation
+	p = UpdatePathAttrs(logger, global, neighbor(200, 30), info, p)
+	assert.Equal("10.0.0.1", p.GetNexthop().String())
+	_, err = p.GetMed()
+	assert.Error(err)
+	assert.Equal("30 100", p.GetAsString())
+}
+
+func TestUpdatePathAttrsLocalAsMigration(t *testing.T) {
+	assert := assert.New(t)
+	global := &oc.Global{Config: oc.GlobalConfig{As: 65001, RouterId: "10.0.0.1"}}
+	source := &PeerInfo{AS: 65001, ID: net.ParseIP("10.0.0.3"), Address: net.ParseIP("10.0.0.3")}
+	path := NewPath(source, bgp.NewIPAddrPrefix(24, "30.30.30.0"), false, []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint32{100})}),
+		bgp.NewPathAttributeNextHop("10.0.0.3"),
+	}, time.Now(), false)
+	info := &PeerInfo{LocalAddress: net.ParseIP("10.0.0.1")}
+	neighbor := &oc.Neighbor{
+		Config: oc.NeighborConfig{PeerAs: 200, LocalAs: 65100},
+		State:  oc.NeighborState{PeerType: oc.PEER_TYPE_EXTERNAL},
+	}
+
+	assert.Equal("65100 100", UpdatePathAttrs(logger, global, neighbor, info, path).GetAsString())
+	neighbor.Config.LocalAsMigration = true
+	assert.Equal("65100 65001 100", UpdatePathAttrs(logger, global, neighbor, info, path).GetAsString())
+	neighbor.Config.LocalAsReplaceAs = true
+	assert.Equal("65100 100", UpdatePathAttrs(logger, global, neighbor, info, path).GetAsString())
+}
+
+func TestUpdatePathAttrsNextHopOptions(t *testing.T) {
+	assert := assert.New(t)
+	global := &oc.Global{Config: oc.GlobalConfig{As: 65001, RouterId: "10.0.0.1"}}
+	newPath := func(as uint32, nexthop string) *Path {
+		source := &PeerInfo{AS: as, LocalAS: 65001, ID: net.ParseIP(nexthop), Address: net.ParseIP(nexthop)}
+		return NewPath(source, bgp.NewIPAddrPrefix(24, "30.30.30.0"), false, []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint32{100})}),
+			bgp.NewPathAttributeNextHop(nexthop),
+		}, time.Now(), false)
+	}
+	ebgpPath := newPath(100, "10.0.0.2")
+	ibgpPath := newPath(65001, "10.0.0.3")
+	info := &PeerInfo{LocalAddress: net.ParseIP("10.0.0.1")}
+	neighbor := func(peerAs uint32, options oc.NextHopOptionsState) *oc.Neighbor {
+		peerType := oc.PEER_TYPE_INTERNAL
+		if peerAs != 65001 {
+			peerType = oc.PEER_TYPE_EXTERNAL
+		}
+		return &oc.Neighbor{
+			Config: oc.NeighborConfig{PeerAs: peerAs, LocalAs: 65001},
+			State:  oc.NeighborState{PeerType: peerType},
+			AfiSafis: []oc.AfiSafi{{
+				State:          oc.AfiSafiState{Family: bgp.RF_IPv4_UC},
+				NextHopOptions: oc.NextHopOptions{State: options},
+			}},
+		}
+	}
+
+	n := neighbor(65001, oc.NextHopOptionsState{})
+	assert.Equal("10.0.0.2", UpdatePathAttrs(logger, global, n, info, ebgpPath).GetNexthop().String())
+	assert.Equal("10.0.0.3", UpdatePathAttrs(logger, global, n, info, ibgpPath).GetNexthop().String())
+
+	// only the path learned from eBGP without force
+	n = neighbor(65001, oc.NextHopOptionsState{NextHopSelf: true})
+	assert.Equal("10.0.0.1", UpdatePathAttrs(logger, global, n, info, ebgpPath).GetNexthop().String())
+	assert.Equal("10.0.0.3", UpdatePathAttrs(logger, global, n, info, ibgpPath).GetNexthop().String())
+
+	n = neighbor(65001, oc.NextHopOptionsState{NextHopSelfForce: true})
+	assert.Equal("10.0.0.1", UpdatePathAttrs(logger, global, n, info, ebgpPath).GetNexthop().String())
+	assert.Equal("10.0.0.1", UpdatePathAttrs(logger, global, n, info, ibgpPath).GetNexthop().String())
+
+	// the options of the other family don't matter
+	n.AfiSafis[0].State.Family = bgp.RF_IPv4_VPN
+	assert.Equal("10.0.0.3", UpdatePathAttrs(logger, global, n, info, ibgpPath).GetNexthop().String())
+
+	n = neighbor(200, oc.NextHopOptionsState{})
+	assert.Equal("10.0.0.1", UpdatePathAttrs(logger, global, n, info, ibgpPath).GetNexthop().String())
+	n = neighbor(200, oc.NextHopOptionsState{NextHopUnchanged: true})
+	assert.Equal("10.0.0.3", UpdatePathAttrs(logger, global, n, info, ibgpPath).GetNexthop().String())
+}
+
 func TestNLRIToIPNet(t *testing.T) {
 	_, n1, _ := net.ParseCIDR("30.30.30.0/24")
 	ipNet := nlriToIPNet(bgp.NewIPAddrPrefix(24, "30.30.30.0"))
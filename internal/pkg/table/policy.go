@@ -18,6 +18,7 @@ package table
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"net"
 	"reflect"
 	"regexp"
@@ -37,6 +38,9 @@ type PolicyOptions struct {
 	Info       *PeerInfo
 	OldNextHop net.IP
 	Validate   func(*Path) *Validation
+	// MultiPath returns the multipaths of the destination of the path in
+	// the global RIB.
+	MultiPath func(*Path) []*Path
 }
 
 type DefinedType int
@@ -128,6 +132,13 @@ const (
 	MED_ACTION_REPLACE
 )
 
+type AigpActionType int
+
+const (
+	AIGP_ACTION_MOD AigpActionType = iota
+	AIGP_ACTION_REPLACE
+)
+
 var CommunityOptionNameMap = map[oc.BgpSetCommunityOptionType]string{
 	oc.BGP_SET_COMMUNITY_OPTION_TYPE_ADD:     "add",
 	oc.BGP_SET_COMMUNITY_OPTION_TYPE_REMOVE:  "remove",
@@ -156,6 +167,8 @@ const (
 	CONDITION_AFI_SAFI_IN
 	CONDITION_COMMUNITY_COUNT
 	CONDITION_ORIGIN
+	CONDITION_FLOWSPEC
+	CONDITION_PATH_IDENTIFIER
 )
 
 type ActionType int
@@ -170,8 +183,23 @@ const (
 	ACTION_LOCAL_PREF
 	ACTION_LARGE_COMMUNITY
 	ACTION_ORIGIN
+	ACTION_AIGP
+	ACTION_LINK_BANDWIDTH
 )
 
+var actionTypeToString = map[ActionType]string{
+	ACTION_COMMUNITY:       "community",
+	ACTION_EXT_COMMUNITY:   "ext-community",
+	ACTION_MED:             "med",
+	ACTION_AS_PATH_PREPEND: "as-prepend",
+	ACTION_NEXTHOP:         "next-hop",
+	ACTION_LOCAL_PREF:      "local-pref",
+	ACTION_LARGE_COMMUNITY: "large-community",
+	ACTION_ORIGIN:          "origin",
+	ACTION_AIGP:            "aigp",
+	ACTION_LINK_BANDWIDTH:  "link-bandwidth",
+}
+
 func NewMatchOption(c interface{}) (MatchOption, error) {
 	switch t := c.(type) {
 	case oc.MatchSetOptionsType:
@@ -1094,10 +1122,8 @@ func ParseCommunity(arg string) (uint32, error) {
 		snd, _ := strconv.ParseUint(elems[2], 10, 16)
 		return uint32(fst<<16 | snd), nil
 	}
-	for i, v := range bgp.WellKnownCommunityNameMap {
-		if arg == v {
-			return uint32(i), nil
-		}
+	if v, y := bgp.WellKnownCommunityValueMap[arg]; y {
+		return uint32(v), nil
 	}
 	return 0, fmt.Errorf("failed to parse %s as community", arg)
 }
@@ -1113,8 +1139,8 @@ func ParseExtCommunity(arg string) (bgp.ExtendedCommunityInterface, error) {
 		r = r || s == bgp.VALIDATION_STATE_NOT_FOUND.String()
 		return r || s == bgp.VALIDATION_STATE_INVALID.String()
 	}
-	if len(elems) < 2 && (len(elems) < 1 && !isValidationState(elems[0])) {
-		return nil, fmt.Errorf("invalid ext-community (rt|soo|encap|lb):<value> | valid | not-found | invalid")
+	if len(elems) < 2 && !isValidationState(elems[0]) {
+		return nil, fmt.Errorf("invalid ext-community (rt|soo|encap|lb|mark|interface-set):<value> | valid | not-found | invalid")
 	}
 	if isValidationState(elems[0]) {
 		subtype = bgp.EC_SUBTYPE_ORIGIN_VALIDATION
@@ -1129,8 +1155,20 @@ func ParseExtCommunity(arg string) (bgp.ExtendedCommunityInterface, error) {
 			subtype = bgp.EC_SUBTYPE_ENCAPSULATION
 		case "lb":
 			subtype = bgp.EC_SUBTYPE_LINK_BANDWIDTH
+		case "mark":
+			dscp, err := strconv.ParseUint(elems[1], 10, 8)
+			if err != nil || dscp > 63 {
+				return nil, fmt.Errorf("invalid dscp: %s", elems[1])
+			}
+			return bgp.NewTrafficRemarkExtended(uint8(dscp)), nil
+		case "interface-set":
+			e, err := bgp.ParseInterfaceSetExtended(elems[1])
+			if err != nil {
+				return nil, err
+			}
+			return e, nil
 		default:
-			return nil, fmt.Errorf("invalid ext-community (rt|soo|encap|lb):<value> | valid | not-found | invalid")
+			return nil, fmt.Errorf("invalid ext-community (rt|soo|encap|lb|mark|interface-set):<value> | valid | not-found | invalid")
 		}
 		value = elems[1]
 	}
@@ -1149,10 +1187,8 @@ func ParseCommunityRegexp(arg string) (*regexp.Regexp, error) {
 		return regexp.Compile(fmt.Sprintf("^%s$", arg))
 	}
 
-	for i, v := range bgp.WellKnownCommunityNameMap {
-		if strings.Replace(strings.ToLower(arg), "_", "-", -1) == v {
-			return regexp.Compile(fmt.Sprintf("^%d:%d$", i>>16, i&0x0000ffff))
-		}
+	if i, y := bgp.WellKnownCommunityValueMap[strings.Replace(strings.ToLower(arg), "_", "-", -1)]; y {
+		return regexp.Compile(fmt.Sprintf("^%d:%d$", i>>16, i&0x0000ffff))
 	}
 
 	return regexp.Compile(arg)
@@ -1315,8 +1351,28 @@ func (s *LargeCommunitySet) MarshalJSON() ([]byte, error) {
 }
 
 var _regexpCommunityLarge = regexp.MustCompile(`\d+:\d+:\d+`)
+var _regexpLargeCommunityField = regexp.MustCompile(`^\d+$`)
 
+// ParseLargeCommunityRegexp parses a large community or a regular expression
+// matching large communities. Each of the three fields of a large community
+// can be "*" matching any value or a regular expression matching the field,
+// e.g. "65000:*:1[0-9]".
 func ParseLargeCommunityRegexp(arg string) (*regexp.Regexp, error) {
+	if fields := strings.Split(arg, ":"); len(fields) == 3 && !strings.ContainsAny(arg, "^$") {
+		for i, f := range fields {
+			switch {
+			case f == "*":
+				fields[i] = `\d+`
+			case !_regexpLargeCommunityField.MatchString(f):
+				fields[i] = "(" + f + ")"
+			}
+		}
+		exp, err := regexp.Compile(fmt.Sprintf("^%s$", strings.Join(fields, ":")))
+		if err != nil {
+			return nil, fmt.Errorf("invalid large-community format: %v", err)
+		}
+		return exp, nil
+	}
 	if _regexpCommunityLarge.MatchString(arg) {
 		return regexp.Compile(fmt.Sprintf("^%s$", arg))
 	}
@@ -2042,6 +2098,157 @@ func NewOriginCondition(origin oc.BgpOriginAttrType) (*OriginCondition, error) {
 	}, nil
 }
 
+// FlowSpecCondition matches the flowspec routes having all the components of
+// the rule. The prefixes of the route must be within the ones of the rule and
+// the other components must be the same. The rule is parsed for IPv4 and
+// IPv6, and the one of the route's address family is used.
+type FlowSpecCondition struct {
+	rule string
+	ipv4 []bgp.FlowSpecComponentInterface
+	ipv6 []bgp.FlowSpecComponentInterface
+}
+
+func (c *FlowSpecCondition) Type() ConditionType {
+	return CONDITION_FLOWSPEC
+}
+
+func (c *FlowSpecCondition) Set() DefinedSet {
+	return nil
+}
+
+func (c *FlowSpecCondition) Name() string { return "" }
+
+func (c *FlowSpecCondition) String() string {
+	return c.rule
+}
+
+func flowSpecPrefixContains(x, y bgp.FlowSpecComponentInterface) bool {
+	prefix := func(c bgp.FlowSpecComponentInterface) (*net.IPNet, uint8) {
+		switch v := c.(type) {
+		case *bgp.FlowSpecDestinationPrefix:
+			return prefixToIPNet(v.Prefix), 0
+		case *bgp.FlowSpecSourcePrefix:
+			return prefixToIPNet(v.Prefix), 0
+		case *bgp.FlowSpecDestinationPrefix6:
+			return prefixToIPNet(v.Prefix), v.Offset
+		case *bgp.FlowSpecSourcePrefix6:
+			return prefixToIPNet(v.Prefix), v.Offset
+		}
+		return nil, 0
+	}
+	n, o := prefix(x)
+	m, p := prefix(y)
+	if n == nil || m == nil || o != p {
+		return false
+	}
+	l, _ := n.Mask.Size()
+	k, _ := m.Mask.Size()
+	return l <= k && n.Contains(m.IP)
+}
+
+func (c *FlowSpecCondition) Evaluate(path *Path, _ *PolicyOptions) bool {
+	nlri := flowSpecNLRI(path.GetNlri())
+	if nlri == nil {
+		return false
+	}
+	var components []bgp.FlowSpecComponentInterface
+	switch path.GetRouteFamily() {
+	case bgp.RF_FS_IPv4_UC, bgp.RF_FS_IPv4_VPN:
+		components = c.ipv4
+	case bgp.RF_FS_IPv6_UC, bgp.RF_FS_IPv6_VPN:
+		components = c.ipv6
+	}
+	if components == nil {
+		return false
+	}
+	for _, x := range components {
+		matched := false
+		for _, y := range nlri.Value {
+			if x.Type() != y.Type() {
+				continue
+			}
+			switch x.Type() {
+			case bgp.FLOW_SPEC_TYPE_DST_PREFIX, bgp.FLOW_SPEC_TYPE_SRC_PREFIX:
+				matched = flowSpecPrefixContains(x, y)
+			default:
+				matched = x.String() == y.String()
+			}
+			break
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func prefixToIPNet(p bgp.AddrPrefixInterface) *net.IPNet {
+	_, n, err := net.ParseCIDR(p.String())
+	if err != nil {
+		return nil
+	}
+	return n
+}
+
+func NewFlowSpecCondition(rule string) (*FlowSpecCondition, error) {
+	if rule == "" {
+		return nil, nil
+	}
+	ipv4, err4 := bgp.ParseFlowSpecComponents(bgp.RF_FS_IPv4_UC, rule)
+	ipv6, err6 := bgp.ParseFlowSpecComponents(bgp.RF_FS_IPv6_UC, rule)
+	if err4 != nil && err6 != nil {
+		return nil, fmt.Errorf("invalid flowspec rule %q: %v", rule, err4)
+	}
+	return &FlowSpecCondition{
+		rule: rule,
+		ipv4: ipv4,
+		ipv6: ipv6,
+	}, nil
+}
+
+// PathIdentifierCondition matches the routes received with one of the
+// ADD-PATH path identifiers of the list.
+type PathIdentifierCondition struct {
+	ids []uint32
+}
+
+func (c *PathIdentifierCondition) Type() ConditionType {
+	return CONDITION_PATH_IDENTIFIER
+}
+
+func (c *PathIdentifierCondition) Set() DefinedSet {
+	return nil
+}
+
+func (c *PathIdentifierCondition) Name() string { return "" }
+
+func (c *PathIdentifierCondition) String() string {
+	ids := make([]string, 0, len(c.ids))
+	for _, id := range c.ids {
+		ids = append(ids, strconv.FormatUint(uint64(id), 10))
+	}
+	return strings.Join(ids, ",")
+}
+
+func (c *PathIdentifierCondition) Evaluate(path *Path, _ *PolicyOptions) bool {
+	id := path.GetNlri().PathIdentifier()
+	for _, x := range c.ids {
+		if x == id {
+			return true
+		}
+	}
+	return false
+}
+
+func NewPathIdentifierCondition(ids []uint32) (*PathIdentifierCondition, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return &PathIdentifierCondition{
+		ids: ids,
+	}, nil
+}
+
 type AfiSafiInCondition struct {
 	routeFamilies []bgp.RouteFamily
 }
@@ -2330,7 +2537,18 @@ func (a *ExtCommunityAction) ToConfig() *oc.SetExtCommunity {
 		}
 	}
 	for idx, c := range a.list {
-		cs = append(cs, f(idx, c.String()))
+		switch v := c.(type) {
+		case *bgp.TrafficRemarkExtended:
+			cs = append(cs, fmt.Sprintf("mark:%d", v.DSCP))
+		case *bgp.InterfaceSetExtended:
+			arg := fmt.Sprintf("interface-set:%d:%d", v.AS, v.GroupID)
+			if d := v.Direction(); d != "" {
+				arg += ":" + d
+			}
+			cs = append(cs, arg)
+		default:
+			cs = append(cs, f(idx, c.String()))
+		}
 	}
 	for idx, exp := range a.removeList {
 		cs = append(cs, f(idx, exp.String()))
@@ -2395,36 +2613,103 @@ func NewExtCommunityAction(c oc.SetExtCommunity) (*ExtCommunityAction, error) {
 	}, nil
 }
 
+// Fields of large community templates which are replaced with the ASN of
+// the peer or the local ASN when the action is applied, e.g.
+// "local-as:100:peer-as".
+const (
+	largeCommunityFieldPeerAS  = "peer-as"
+	largeCommunityFieldLocalAS = "local-as"
+)
+
+func isLargeCommunityTemplate(arg string) bool {
+	for _, f := range strings.Split(arg, ":") {
+		if f == largeCommunityFieldPeerAS || f == largeCommunityFieldLocalAS {
+			return true
+		}
+	}
+	return false
+}
+
+func expandLargeCommunityTemplate(arg string, info *PeerInfo) string {
+	fields := strings.Split(arg, ":")
+	for i, f := range fields {
+		switch f {
+		case largeCommunityFieldPeerAS:
+			fields[i] = strconv.FormatUint(uint64(info.AS), 10)
+		case largeCommunityFieldLocalAS:
+			fields[i] = strconv.FormatUint(uint64(info.LocalAS), 10)
+		}
+	}
+	return strings.Join(fields, ":")
+}
+
 type LargeCommunityAction struct {
 	action     oc.BgpSetCommunityOptionType
 	list       []*bgp.LargeCommunity
 	removeList []*regexp.Regexp
+	templates  []string
 }
 
 func (a *LargeCommunityAction) Type() ActionType {
 	return ACTION_LARGE_COMMUNITY
 }
 
-func (a *LargeCommunityAction) Apply(path *Path, _ *PolicyOptions) (*Path, error) {
+// expand returns the large communities and the regular expressions of the
+// action including the templates expanded for the peer.
+func (a *LargeCommunityAction) expand(info *PeerInfo) ([]*bgp.LargeCommunity, []*regexp.Regexp, error) {
+	if len(a.templates) == 0 || info == nil {
+		return a.list, a.removeList, nil
+	}
+	list := append(make([]*bgp.LargeCommunity, 0, len(a.list)+len(a.templates)), a.list...)
+	removeList := append(make([]*regexp.Regexp, 0, len(a.removeList)+len(a.templates)), a.removeList...)
+	for _, t := range a.templates {
+		x := expandLargeCommunityTemplate(t, info)
+		if a.action == oc.BGP_SET_COMMUNITY_OPTION_TYPE_REMOVE {
+			exp, err := ParseLargeCommunityRegexp(x)
+			if err != nil {
+				return nil, nil, err
+			}
+			removeList = append(removeList, exp)
+		} else {
+			comm, err := bgp.ParseLargeCommunity(x)
+			if err != nil {
+				return nil, nil, err
+			}
+			list = append(list, comm)
+		}
+	}
+	return list, removeList, nil
+}
+
+func (a *LargeCommunityAction) Apply(path *Path, options *PolicyOptions) (*Path, error) {
+	var info *PeerInfo
+	if options != nil {
+		info = options.Info
+	}
+	list, removeList, err := a.expand(info)
+	if err != nil {
+		return path, err
+	}
 	switch a.action {
 	case oc.BGP_SET_COMMUNITY_OPTION_TYPE_ADD:
-		path.SetLargeCommunities(a.list, false)
+		path.SetLargeCommunities(list, false)
 	case oc.BGP_SET_COMMUNITY_OPTION_TYPE_REMOVE:
-		RegexpRemoveLargeCommunities(path, a.removeList)
+		RegexpRemoveLargeCommunities(path, removeList)
 	case oc.BGP_SET_COMMUNITY_OPTION_TYPE_REPLACE:
-		path.SetLargeCommunities(a.list, true)
+		path.SetLargeCommunities(list, true)
 	}
 	return path, nil
 }
 
 func (a *LargeCommunityAction) ToConfig() *oc.SetLargeCommunity {
-	cs := make([]string, 0, len(a.list)+len(a.removeList))
+	cs := make([]string, 0, len(a.list)+len(a.removeList)+len(a.templates))
 	for _, comm := range a.list {
 		cs = append(cs, comm.String())
 	}
 	for _, exp := range a.removeList {
 		cs = append(cs, exp.String())
 	}
+	cs = append(cs, a.templates...)
 	return &oc.SetLargeCommunity{
 		SetLargeCommunityMethod: oc.SetLargeCommunityMethod{CommunitiesList: cs},
 		Options:                 oc.BgpSetCommunityOptionType(a.action),
@@ -2451,13 +2736,27 @@ func NewLargeCommunityAction(c oc.SetLargeCommunity) (*LargeCommunityAction, err
 	}
 	var list []*bgp.LargeCommunity
 	var removeList []*regexp.Regexp
+	var templates []string
 	if a == oc.BGP_SET_COMMUNITY_OPTION_TYPE_REMOVE {
 		removeList = make([]*regexp.Regexp, 0, len(c.SetLargeCommunityMethod.CommunitiesList))
 	} else {
 		list = make([]*bgp.LargeCommunity, 0, len(c.SetLargeCommunityMethod.CommunitiesList))
 	}
 	for _, x := range c.SetLargeCommunityMethod.CommunitiesList {
-		if a == oc.BGP_SET_COMMUNITY_OPTION_TYPE_REMOVE {
+		if isLargeCommunityTemplate(x) {
+			// validate the template with zero ASNs
+			y := expandLargeCommunityTemplate(x, &PeerInfo{})
+			var err error
+			if a == oc.BGP_SET_COMMUNITY_OPTION_TYPE_REMOVE {
+				_, err = ParseLargeCommunityRegexp(y)
+			} else {
+				_, err = bgp.ParseLargeCommunity(y)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("invalid large-community template %s: %v", x, err)
+			}
+			templates = append(templates, x)
+		} else if a == oc.BGP_SET_COMMUNITY_OPTION_TYPE_REMOVE {
 			exp, err := ParseLargeCommunityRegexp(x)
 			if err != nil {
 				return nil, err
@@ -2475,6 +2774,7 @@ func NewLargeCommunityAction(c oc.SetLargeCommunity) (*LargeCommunityAction, err
 		action:     a,
 		list:       list,
 		removeList: removeList,
+		templates:  templates,
 	}, nil
 
 }
@@ -2544,6 +2844,167 @@ func NewMedActionFromApiStruct(action MedActionType, value int64) *MedAction {
 	return &MedAction{action: action, value: value}
 }
 
+type AigpAction struct {
+	value  int64
+	action AigpActionType
+}
+
+func (a *AigpAction) Type() ActionType {
+	return ACTION_AIGP
+}
+
+func (a *AigpAction) Apply(path *Path, _ *PolicyOptions) (*Path, error) {
+	var err error
+	switch a.action {
+	case AIGP_ACTION_MOD:
+		err = path.SetAigp(a.value, false)
+	case AIGP_ACTION_REPLACE:
+		err = path.SetAigp(a.value, true)
+	}
+	if err != nil {
+		return path, err
+	}
+	return path, nil
+}
+
+func (a *AigpAction) ToConfig() oc.BgpSetMedType {
+	if a.action == AIGP_ACTION_MOD && a.value > 0 {
+		return oc.BgpSetMedType(fmt.Sprintf("+%d", a.value))
+	}
+	return oc.BgpSetMedType(fmt.Sprintf("%d", a.value))
+}
+
+func (a *AigpAction) String() string {
+	return string(a.ToConfig())
+}
+
+func (a *AigpAction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.ToConfig())
+}
+
+func NewAigpAction(c oc.BgpSetMedType) (*AigpAction, error) {
+	if string(c) == "" {
+		return nil, nil
+	}
+
+	elems := _regexpParseMedAction.FindStringSubmatch(string(c))
+	if len(elems) != 3 {
+		return nil, fmt.Errorf("invalid aigp action format")
+	}
+	action := AIGP_ACTION_REPLACE
+	switch elems[1] {
+	case "+", "-":
+		action = AIGP_ACTION_MOD
+	}
+	value, err := strconv.ParseInt(string(c), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid aigp action value: %s", c)
+	}
+	return &AigpAction{
+		value:  value,
+		action: action,
+	}, nil
+}
+
+func NewAigpActionFromApiStruct(action AigpActionType, value int64) *AigpAction {
+	return &AigpAction{action: action, value: value}
+}
+
+// LinkBandwidthAction sets the link bandwidth extended community. In the
+// cumulative mode, the bandwidth is the sum of the ones of the multipaths,
+// which is re-generated when the multipaths change.
+type LinkBandwidthAction struct {
+	bandwidth  float32
+	cumulative bool
+	transitive bool
+}
+
+func (a *LinkBandwidthAction) Type() ActionType {
+	return ACTION_LINK_BANDWIDTH
+}
+
+func (a *LinkBandwidthAction) Apply(path *Path, options *PolicyOptions) (*Path, error) {
+	bw := a.bandwidth
+	if a.cumulative {
+		bw = 0
+		paths := []*Path{path}
+		if options != nil && options.MultiPath != nil {
+			if l := options.MultiPath(path); len(l) > 0 {
+				paths = l
+			}
+		}
+		for _, p := range paths {
+			if b, y := p.GetLinkBandwidth(); y {
+				bw += b
+			}
+		}
+		if bw == 0 {
+			return path, nil
+		}
+	}
+	as := uint16(bgp.AS_TRANS)
+	if options != nil && options.Info != nil && options.Info.LocalAS <= 0xffff {
+		as = uint16(options.Info.LocalAS)
+	}
+	if a.transitive {
+		path.SetLinkBandwidth(bgp.NewTransitiveLinkBandwidthExtended(as, bw))
+	} else {
+		path.SetLinkBandwidth(bgp.NewLinkBandwidthExtended(as, bw))
+	}
+	return path, nil
+}
+
+func (a *LinkBandwidthAction) ToConfig() *oc.SetLinkBandwidth {
+	c := &oc.SetLinkBandwidth{
+		Bandwidth:  "cumulative",
+		Transitive: a.transitive,
+	}
+	if !a.cumulative {
+		c.Bandwidth = strconv.FormatFloat(float64(a.bandwidth), 'f', -1, 32)
+	}
+	return c
+}
+
+func (a *LinkBandwidthAction) String() string {
+	c := a.ToConfig()
+	if c.Transitive {
+		return c.Bandwidth + " transitive"
+	}
+	return c.Bandwidth
+}
+
+func (a *LinkBandwidthAction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.ToConfig())
+}
+
+func NewLinkBandwidthAction(c oc.SetLinkBandwidth) (*LinkBandwidthAction, error) {
+	if c.Bandwidth == "" {
+		return nil, nil
+	}
+	if c.Bandwidth == "cumulative" {
+		return &LinkBandwidthAction{
+			cumulative: true,
+			transitive: c.Transitive,
+		}, nil
+	}
+	bw, err := strconv.ParseFloat(c.Bandwidth, 32)
+	if err != nil || bw < 0 {
+		return nil, fmt.Errorf("invalid link bandwidth action value: %s", c.Bandwidth)
+	}
+	return &LinkBandwidthAction{
+		bandwidth:  float32(bw),
+		transitive: c.Transitive,
+	}, nil
+}
+
+func NewLinkBandwidthActionFromApiStruct(bandwidth float32, cumulative, transitive bool) *LinkBandwidthAction {
+	return &LinkBandwidthAction{
+		bandwidth:  bandwidth,
+		cumulative: cumulative,
+		transitive: transitive,
+	}
+}
+
 type LocalPrefAction struct {
 	value uint32
 }
@@ -2617,18 +3078,90 @@ func NewOriginAction(value oc.BgpOriginAttrType) (*OriginAction, error) {
 }
 
 type AsPathPrependAction struct {
-	asn         uint32
-	useLeftMost bool
-	repeat      uint8
+	asn             uint32
+	useLeftMost     bool
+	usePeerAs       bool
+	repeat          uint8
+	repeatCommunity string
 }
 
 func (a *AsPathPrependAction) Type() ActionType {
 	return ACTION_AS_PATH_PREPEND
 }
 
+func parseRepeatCommunity(arg string) ([]string, error) {
+	fields := strings.Split(arg, ":")
+	if len(fields) != 2 && len(fields) != 3 {
+		return nil, fmt.Errorf("invalid repeat community %s", arg)
+	}
+	wildcard := 0
+	for _, f := range fields {
+		switch f {
+		case "*":
+			wildcard++
+		case largeCommunityFieldPeerAS, largeCommunityFieldLocalAS:
+		default:
+			if _, err := strconv.ParseUint(f, 10, 32); err != nil {
+				return nil, fmt.Errorf("invalid repeat community %s", arg)
+			}
+		}
+	}
+	if wildcard != 1 {
+		return nil, fmt.Errorf("repeat community %s must have one '*' field", arg)
+	}
+	return fields, nil
+}
+
+// repeatFromCommunity returns the value of the "*" field of the first
+// community matching the repeat community of the action, e.g. 3 for
+// "65000:3" with "65000:*".
+func (a *AsPathPrependAction) repeatFromCommunity(path *Path, info *PeerInfo) (uint32, bool) {
+	fields, err := parseRepeatCommunity(a.repeatCommunity)
+	if err != nil {
+		return 0, false
+	}
+	if isLargeCommunityTemplate(a.repeatCommunity) {
+		if info == nil {
+			return 0, false
+		}
+		fields = strings.Split(expandLargeCommunityTemplate(a.repeatCommunity, info), ":")
+	}
+	match := func(values []uint32) (uint32, bool) {
+		var repeat uint32
+		for i, f := range fields {
+			if f == "*" {
+				repeat = values[i]
+			} else if v, _ := strconv.ParseUint(f, 10, 32); uint32(v) != values[i] {
+				return 0, false
+			}
+		}
+		return repeat, true
+	}
+	if len(fields) == 2 {
+		for _, c := range path.GetCommunities() {
+			if repeat, ok := match([]uint32{c >> 16, c & 0xffff}); ok {
+				return repeat, true
+			}
+		}
+		return 0, false
+	}
+	for _, c := range path.GetLargeCommunities() {
+		if repeat, ok := match([]uint32{c.ASN, c.LocalData1, c.LocalData2}); ok {
+			return repeat, true
+		}
+	}
+	return 0, false
+}
+
 func (a *AsPathPrependAction) Apply(path *Path, option *PolicyOptions) (*Path, error) {
+	var info *PeerInfo
+	if option != nil {
+		info = option.Info
+	}
+
 	var asn uint32
-	if a.useLeftMost {
+	switch {
+	case a.useLeftMost:
 		aspath := path.GetAsSeqList()
 		if len(aspath) == 0 {
 			return path, nil
@@ -2637,12 +3170,30 @@ func (a *AsPathPrependAction) Apply(path *Path, option *PolicyOptions) (*Path, e
 		if asn == 0 {
 			return path, nil
 		}
-	} else {
+	case a.usePeerAs:
+		if info == nil || info.AS == 0 {
+			return path, nil
+		}
+		asn = info.AS
+	default:
 		asn = a.asn
 	}
 
-	confed := option != nil && option.Info != nil && option.Info.Confederation
-	path.PrependAsn(asn, a.repeat, confed)
+	repeat := a.repeat
+	if a.repeatCommunity != "" {
+		if n, ok := a.repeatFromCommunity(path, info); ok {
+			if n > math.MaxUint8 {
+				n = math.MaxUint8
+			}
+			repeat = uint8(n)
+		}
+	}
+	if repeat == 0 {
+		return path, nil
+	}
+
+	confed := info != nil && info.Confederation
+	path.PrependAsn(asn, repeat, confed)
 
 	return path, nil
 }
@@ -2651,16 +3202,23 @@ func (a *AsPathPrependAction) ToConfig() *oc.SetAsPathPrepend {
 	return &oc.SetAsPathPrepend{
 		RepeatN: uint8(a.repeat),
 		As: func() string {
-			if a.useLeftMost {
+			switch {
+			case a.useLeftMost:
 				return "last-as"
+			case a.usePeerAs:
+				return "peer-as"
 			}
 			return fmt.Sprintf("%d", a.asn)
 		}(),
+		RepeatNCommunity: a.repeatCommunity,
 	}
 }
 
 func (a *AsPathPrependAction) String() string {
 	c := a.ToConfig()
+	if c.RepeatNCommunity != "" {
+		return fmt.Sprintf("prepend %s %d times or as many times as %s", c.As, c.RepeatN, c.RepeatNCommunity)
+	}
 	return fmt.Sprintf("prepend %s %d times", c.As, c.RepeatN)
 }
 
@@ -2672,16 +3230,24 @@ func (a *AsPathPrependAction) MarshalJSON() ([]byte, error) {
 // If ASN cannot be parsed, nil will be returned.
 func NewAsPathPrependAction(action oc.SetAsPathPrepend) (*AsPathPrependAction, error) {
 	a := &AsPathPrependAction{
-		repeat: action.RepeatN,
+		repeat:          action.RepeatN,
+		repeatCommunity: action.RepeatNCommunity,
+	}
+	if a.repeatCommunity != "" {
+		if _, err := parseRepeatCommunity(a.repeatCommunity); err != nil {
+			return nil, err
+		}
 	}
 	switch action.As {
 	case "":
-		if a.repeat == 0 {
+		if a.repeat == 0 && a.repeatCommunity == "" {
 			return nil, nil
 		}
 		return nil, fmt.Errorf("specify as to prepend")
 	case "last-as":
 		a.useLeftMost = true
+	case "peer-as":
+		a.usePeerAs = true
 	default:
 		asn, err := strconv.ParseUint(action.As, 10, 32)
 		if err != nil {
@@ -2789,7 +3355,15 @@ func (s *Statement) Evaluate(p *Path, options *PolicyOptions) bool {
 }
 
 func (s *Statement) Apply(logger log.Logger, path *Path, options *PolicyOptions) (RouteType, *Path) {
+	return s.apply(logger, path, options, nil)
+}
+
+// apply is Apply recording the evaluation in trace if it's not nil.
+func (s *Statement) apply(logger log.Logger, path *Path, options *PolicyOptions, trace *StatementResult) (RouteType, *Path) {
 	result := s.Evaluate(path, options)
+	if trace != nil {
+		trace.Matched = result
+	}
 	if result {
 		if len(s.ModActions) != 0 {
 			// apply all modification actions
@@ -2803,12 +3377,18 @@ func (s *Statement) Apply(logger log.Logger, path *Path, options *PolicyOptions)
 							"Topic": "policy",
 							"Error": err})
 				}
+				if trace != nil {
+					trace.Actions = append(trace.Actions, fmt.Sprintf("%s %s", actionTypeToString[action.Type()], action))
+				}
 			}
 		}
 		//Routing action
 		if s.RouteAction == nil || reflect.ValueOf(s.RouteAction).IsNil() {
 			return ROUTE_TYPE_NONE, path
 		}
+		if trace != nil {
+			trace.Actions = append(trace.Actions, s.RouteAction.String())
+		}
 		p, _ := s.RouteAction.Apply(path, options)
 		if p == nil {
 			return ROUTE_TYPE_REJECT, path
@@ -2849,6 +3429,10 @@ func (s *Statement) ToConfig() *oc.Statement {
 					cond.BgpConditions.RouteType = v.typ
 				case *OriginCondition:
 					cond.BgpConditions.OriginEq = v.origin
+				case *FlowSpecCondition:
+					cond.BgpConditions.FlowSpecMatch = v.rule
+				case *PathIdentifierCondition:
+					cond.BgpConditions.PathIdentifierInList = v.ids
 				case *AfiSafiInCondition:
 					res := make([]oc.AfiSafiType, 0, len(v.routeFamilies))
 					for _, rf := range v.routeFamilies {
@@ -2889,6 +3473,10 @@ func (s *Statement) ToConfig() *oc.Statement {
 					act.BgpActions.SetNextHop = v.ToConfig()
 				case *OriginAction:
 					act.BgpActions.SetRouteOrigin = v.ToConfig()
+				case *AigpAction:
+					act.BgpActions.SetAigp = v.ToConfig()
+				case *LinkBandwidthAction:
+					act.BgpActions.SetLinkBandwidth = *v.ToConfig()
 				}
 			}
 			return act
@@ -3067,6 +3655,12 @@ func NewStatement(c oc.Statement) (*Statement, error) {
 		func() (Condition, error) {
 			return NewAfiSafiInCondition(c.Conditions.BgpConditions.AfiSafiInList)
 		},
+		func() (Condition, error) {
+			return NewFlowSpecCondition(c.Conditions.BgpConditions.FlowSpecMatch)
+		},
+		func() (Condition, error) {
+			return NewPathIdentifierCondition(c.Conditions.BgpConditions.PathIdentifierInList)
+		},
 	}
 	cs = make([]Condition, 0, len(cfs))
 	for _, f := range cfs {
@@ -3107,6 +3701,12 @@ func NewStatement(c oc.Statement) (*Statement, error) {
 		func() (Action, error) {
 			return NewOriginAction(c.Actions.BgpActions.SetRouteOrigin)
 		},
+		func() (Action, error) {
+			return NewAigpAction(c.Actions.BgpActions.SetAigp)
+		},
+		func() (Action, error) {
+			return NewLinkBandwidthAction(c.Actions.BgpActions.SetLinkBandwidth)
+		},
 	}
 	as = make([]Action, 0, len(afs))
 	for _, f := range afs {
@@ -3135,9 +3735,21 @@ type Policy struct {
 // If a condition match, then this function stops evaluation and
 // subsequent conditions are skipped.
 func (p *Policy) Apply(logger log.Logger, path *Path, options *PolicyOptions) (RouteType, *Path) {
+	return p.apply(logger, path, options, nil)
+}
+
+func (p *Policy) apply(logger log.Logger, path *Path, options *PolicyOptions, trace *PolicyResult) (RouteType, *Path) {
 	for _, stmt := range p.Statements {
+		var st *StatementResult
+		if trace != nil {
+			st = &StatementResult{
+				Policy:    p.Name,
+				Statement: stmt.Name,
+			}
+			trace.Statements = append(trace.Statements, st)
+		}
 		var result RouteType
-		result, path = stmt.Apply(logger, path, options)
+		result, path = stmt.apply(logger, path, options, st)
 		if result != ROUTE_TYPE_NONE {
 			return result, path
 		}
@@ -3252,8 +3864,11 @@ type RoutingPolicy struct {
 	policyMap     map[string]*Policy
 	statementMap  map[string]*Statement
 	assignmentMap map[string]*Assignment
-	mu            sync.RWMutex
-	logger        log.Logger
+	// incremented on every change of a defined set. kept after the set
+	// is deleted so that the version doesn't go back if it's created again.
+	definedSetVersion map[DefinedType]map[string]uint64
+	mu                sync.RWMutex
+	logger            log.Logger
 }
 
 func (r *RoutingPolicy) ApplyPolicy(id string, dir PolicyDirection, before *Path, options *PolicyOptions) *Path {
@@ -3264,20 +3879,82 @@ func (r *RoutingPolicy) ApplyPolicy(id string, dir PolicyDirection, before *Path
 	if before.IsWithdraw {
 		return before
 	}
-	result := ROUTE_TYPE_NONE
-	after := before
 
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	for _, p := range r.getPolicy(id, dir) {
-		result, after = p.Apply(r.logger, after, options)
+	return r.applyPolicies(r.getPolicy(id, dir), r.getDefaultPolicy(id, dir), before, options, nil)
+}
+
+// ApplyPolicyAssignment applies the policies of the assignment to the path
+// in the same way as ApplyPolicy does with the assignment set to a.Name.
+func (r *RoutingPolicy) ApplyPolicyAssignment(a *PolicyAssignment, before *Path, options *PolicyOptions) *Path {
+	if before == nil {
+		return nil
+	}
+
+	if before.IsWithdraw {
+		return before
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.applyPolicies(a.Policies, a.Default, before, options, nil)
+}
+
+// StatementResult records the evaluation of a statement by SimulatePolicy.
+type StatementResult struct {
+	Policy    string
+	Statement string
+	Matched   bool
+	// String representations of the actions applied to the path in order.
+	Actions []string
+}
+
+// PolicyResult is the evaluation trace returned by SimulatePolicy.
+type PolicyResult struct {
+	// The statements evaluated until the route disposition was decided.
+	Statements []*StatementResult
+	Result     RouteType
+	// True if no statement decided the disposition and the default
+	// action of the assignment was used.
+	Default bool
+	// The path after the policies, or nil if it was rejected.
+	Path *Path
+}
+
+// SimulatePolicy applies the policies of the assignment to the path like
+// ApplyPolicyAssignment and returns which statements matched and which
+// actions were applied.
+func (r *RoutingPolicy) SimulatePolicy(a *PolicyAssignment, before *Path, options *PolicyOptions) *PolicyResult {
+	trace := &PolicyResult{}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	trace.Path = r.applyPolicies(a.Policies, a.Default, before, options, trace)
+	return trace
+}
+
+func (r *RoutingPolicy) applyPolicies(policies []*Policy, def RouteType, before *Path, options *PolicyOptions, trace *PolicyResult) *Path {
+	result := ROUTE_TYPE_NONE
+	after := before
+
+	for _, p := range policies {
+		result, after = p.apply(r.logger, after, options, trace)
 		if result != ROUTE_TYPE_NONE {
 			break
 		}
 	}
 	if result == ROUTE_TYPE_NONE {
-		result = r.getDefaultPolicy(id, dir)
+		result = def
+		if trace != nil {
+			trace.Default = true
+		}
+	}
+	if trace != nil {
+		trace.Result = result
 	}
 	switch result {
 	case ROUTE_TYPE_ACCEPT:
@@ -3579,6 +4256,11 @@ func (r *RoutingPolicy) reload(c oc.RoutingPolicy) error {
 	}
 
 	r.definedSetMap = dmap
+	for typ, m := range dmap {
+		for name := range m {
+			r.incDefinedSetVersion(typ, name)
+		}
+	}
 	r.policyMap = pmap
 	r.statementMap = smap
 	r.assignmentMap = make(map[string]*Assignment)
@@ -3588,6 +4270,21 @@ func (r *RoutingPolicy) reload(c oc.RoutingPolicy) error {
 	return nil
 }
 
+// HasCumulativeLinkBandwidthAction returns true if any statement has the
+// link bandwidth action in the cumulative mode.
+func (r *RoutingPolicy) HasCumulativeLinkBandwidthAction() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, st := range r.statementMap {
+		for _, a := range st.ModActions {
+			if lb, ok := a.(*LinkBandwidthAction); ok && lb.cumulative {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (r *RoutingPolicy) GetDefinedSet(typ DefinedType, name string) (*oc.DefinedSets, error) {
 	dl, err := func() (DefinedSetList, error) {
 		r.mu.RLock()
@@ -3657,6 +4354,7 @@ func (r *RoutingPolicy) AddDefinedSet(s DefinedSet, replace bool) error {
 			m[s.Name()] = s
 		}
 	}
+	r.incDefinedSetVersion(s.Type(), s.Name())
 	return nil
 }
 
@@ -3680,10 +4378,237 @@ func (r *RoutingPolicy) DeleteDefinedSet(a DefinedSet, all bool) (err error) {
 		} else {
 			err = d.Remove(a)
 		}
+		if err == nil {
+			r.incDefinedSetVersion(a.Type(), a.Name())
+		}
 	}
 	return err
 }
 
+func (r *RoutingPolicy) incDefinedSetVersion(typ DefinedType, name string) uint64 {
+	m, ok := r.definedSetVersion[typ]
+	if !ok {
+		m = make(map[string]uint64)
+		r.definedSetVersion[typ] = m
+	}
+	m[name]++
+	return m[name]
+}
+
+// GetDefinedSetVersions returns the versions of the defined sets, which
+// are incremented on every change of the sets.
+func (r *RoutingPolicy) GetDefinedSetVersions() map[DefinedType]map[string]uint64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	versions := make(map[DefinedType]map[string]uint64, len(r.definedSetVersion))
+	for typ, m := range r.definedSetVersion {
+		versions[typ] = make(map[string]uint64, len(m))
+		for name, v := range m {
+			versions[typ][name] = v
+		}
+	}
+	return versions
+}
+
+// DefinedSetVersion returns the version of the defined set, which is
+// incremented on every change of the set.
+func (r *RoutingPolicy) DefinedSetVersion(typ DefinedType, name string) uint64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.definedSetVersion[typ][name]
+}
+
+// MatchPrefixSet returns true if the prefix of the path matches the prefix
+// set.
+func (r *RoutingPolicy) MatchPrefixSet(name string, path *Path) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	d, ok := r.definedSetMap[DEFINED_TYPE_PREFIX][name]
+	if !ok {
+		return false, fmt.Errorf("not found prefix set %s", name)
+	}
+	c := &PrefixCondition{set: d.(*PrefixSet)}
+	return c.Evaluate(path, nil), nil
+}
+
+// DefinedSetUpdate is an incremental update of a defined set created by
+// NewDefinedSetUpdate.
+type DefinedSetUpdate struct {
+	// The assignments whose policies refer to the set. Policies and
+	// Default aren't set.
+	Assignments []*PolicyAssignment
+	add         DefinedSet
+	remove      DefinedSet
+	// true if the update might change the result of the policies for the
+	// paths which don't match any of the added or removed entries.
+	all bool
+}
+
+// NewDefinedSetUpdate returns the update which adds the entries of add to
+// and removes the entries of remove from the defined set, which must be
+// of the same type and name. add or remove can be nil. It fails if
+// version isn't zero and differs from the current version of the set.
+func (r *RoutingPolicy) NewDefinedSetUpdate(add, remove DefinedSet, version uint64) (*DefinedSetUpdate, error) {
+	if add == nil && remove == nil {
+		return nil, fmt.Errorf("nothing to update")
+	}
+	set := add
+	if set == nil {
+		set = remove
+	} else if remove != nil && (add.Type() != remove.Type() || add.Name() != remove.Name()) {
+		return nil, fmt.Errorf("can't update different defined-sets at once")
+	}
+	typ, name := set.Type(), set.Name()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	m, ok := r.definedSetMap[typ]
+	if !ok {
+		return nil, fmt.Errorf("invalid defined-set type: %d", typ)
+	}
+	if version != 0 && version != r.definedSetVersion[typ][name] {
+		return nil, fmt.Errorf("defined-set %s was updated: version %d", name, r.definedSetVersion[typ][name])
+	}
+	cur, ok := m[name]
+	if !ok && remove != nil {
+		return nil, fmt.Errorf("not found defined-set: %s", name)
+	}
+
+	u := &DefinedSetUpdate{
+		add:    add,
+		remove: remove,
+	}
+	switch typ {
+	case DEFINED_TYPE_PREFIX, DEFINED_TYPE_AS_PATH, DEFINED_TYPE_COMMUNITY, DEFINED_TYPE_EXT_COMMUNITY, DEFINED_TYPE_LARGE_COMMUNITY:
+	case DEFINED_TYPE_NEIGHBOR:
+		// an empty neighbor set matches any neighbor
+		u.all = cur == nil || len(cur.List()) == 0 || (remove != nil && len(remove.List()) >= len(cur.List()))
+	default:
+		u.all = true
+	}
+
+	names := make([]string, 0, len(r.assignmentMap))
+	for id := range r.assignmentMap {
+		names = append(names, id)
+	}
+	sort.Strings(names)
+	for _, id := range names {
+		for _, dir := range []PolicyDirection{POLICY_DIRECTION_IMPORT, POLICY_DIRECTION_EXPORT} {
+			refer := false
+			for _, p := range r.getPolicy(id, dir) {
+				for _, st := range p.Statements {
+					for _, c := range st.Conditions {
+						if c.Set() == nil || c.Set().Type() != typ || c.Set().Name() != name {
+							continue
+						}
+						refer = true
+						if o, ok := c.(interface{ Option() MatchOption }); ok && o.Option() == MATCH_OPTION_ALL {
+							u.all = true
+						}
+					}
+				}
+			}
+			if refer {
+				u.Assignments = append(u.Assignments, &PolicyAssignment{
+					Name: id,
+					Type: dir,
+				})
+			}
+		}
+	}
+	return u, nil
+}
+
+// Affects returns false if the update doesn't change the result of the
+// policies for the path. options must be the ones for the policies.
+func (u *DefinedSetUpdate) Affects(path *Path, options *PolicyOptions) bool {
+	if u.all {
+		return true
+	}
+	for _, set := range []DefinedSet{u.add, u.remove} {
+		if set != nil && definedSetEntryMatch(set, path, options) {
+			return true
+		}
+	}
+	return false
+}
+
+// definedSetEntryMatch returns true if any entry of the set matches the
+// path regardless of the match options and the mask length ranges.
+func definedSetEntryMatch(set DefinedSet, path *Path, options *PolicyOptions) bool {
+	switch s := set.(type) {
+	case *PrefixSet:
+		if s.tree.Size() == 0 {
+			return false
+		}
+		pathAfi, _ := bgp.RouteFamilyToAfiSafi(path.GetRouteFamily())
+		setAfi, _ := bgp.RouteFamilyToAfiSafi(s.family)
+		if pathAfi != setAfi {
+			return false
+		}
+		r := nlriToIPNet(path.GetNlri())
+		if r == nil {
+			return false
+		}
+		_, ps, _ := s.tree.Match(r)
+		return ps != nil
+	case *NeighborSet:
+		if len(s.list) == 0 {
+			return false
+		}
+		return (&NeighborCondition{set: s, option: MATCH_OPTION_ANY}).Evaluate(path, options)
+	case *AsPathSet:
+		return (&AsPathCondition{set: s, option: MATCH_OPTION_ANY}).Evaluate(path, options)
+	case *CommunitySet:
+		return (&CommunityCondition{set: s, option: MATCH_OPTION_ANY}).Evaluate(path, options)
+	case *ExtCommunitySet:
+		return (&ExtCommunityCondition{set: s, option: MATCH_OPTION_ANY}).Evaluate(path, options)
+	case *LargeCommunitySet:
+		return (&LargeCommunityCondition{set: s, option: MATCH_OPTION_ANY}).Evaluate(path, options)
+	}
+	return true
+}
+
+// UpdateDefinedSet applies the update made by NewDefinedSetUpdate and
+// returns the new version of the set. The entries are removed after the
+// entries are added. The set is created if it doesn't exist.
+func (r *RoutingPolicy) UpdateDefinedSet(u *DefinedSetUpdate, version uint64) (uint64, error) {
+	set := u.add
+	if set == nil {
+		set = u.remove
+	}
+	typ, name := set.Type(), set.Name()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if version != 0 && version != r.definedSetVersion[typ][name] {
+		return 0, fmt.Errorf("defined-set %s was updated: version %d", name, r.definedSetVersion[typ][name])
+	}
+	m := r.definedSetMap[typ]
+	cur, ok := m[name]
+	if u.add != nil {
+		if !ok {
+			m[name] = u.add
+			cur = u.add
+		} else if err := cur.Append(u.add); err != nil {
+			return 0, err
+		}
+	} else if !ok {
+		return 0, fmt.Errorf("not found defined-set: %s", name)
+	}
+	if u.remove != nil {
+		if err := cur.Remove(u.remove); err != nil {
+			return 0, err
+		}
+	}
+	return r.incDefinedSetVersion(typ, name), nil
+}
+
 func (r *RoutingPolicy) GetStatement(name string) []*oc.Statement {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
@@ -3953,26 +4878,31 @@ func (r *RoutingPolicy) DeletePolicyAssignment(id string, dir PolicyDirection, p
 	return err
 }
 
-func (r *RoutingPolicy) SetPolicyAssignment(id string, dir PolicyDirection, policies []*oc.PolicyDefinition, def RouteType) (err error) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
+func (r *RoutingPolicy) lookupPolicies(policies []*oc.PolicyDefinition) ([]*Policy, error) {
 	ps := make([]*Policy, 0, len(policies))
 	seen := make(map[string]bool)
 	for _, x := range policies {
 		p, ok := r.policyMap[x.Name]
 		if !ok {
-			err = fmt.Errorf("not found policy %s", x.Name)
-			return
+			return nil, fmt.Errorf("not found policy %s", x.Name)
 		}
 		if seen[x.Name] {
-			err = fmt.Errorf("duplicated policy %s", x.Name)
-			return
+			return nil, fmt.Errorf("duplicated policy %s", x.Name)
 		}
 		seen[x.Name] = true
 		ps = append(ps, p)
 	}
-	r.getPolicy(id, dir)
+	return ps, nil
+}
+
+func (r *RoutingPolicy) SetPolicyAssignment(id string, dir PolicyDirection, policies []*oc.PolicyDefinition, def RouteType) (err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ps, err := r.lookupPolicies(policies)
+	if err != nil {
+		return err
+	}
 	err = r.setPolicy(id, dir, ps)
 	if err == nil && def != ROUTE_TYPE_NONE {
 		err = r.setDefaultPolicy(id, dir, def)
@@ -3980,6 +4910,28 @@ func (r *RoutingPolicy) SetPolicyAssignment(id string, dir PolicyDirection, poli
 	return err
 }
 
+// NewPolicyAssignment returns the assignment which SetPolicyAssignment
+// would make with the same arguments, without changing the current one.
+// The current default action is kept if def is ROUTE_TYPE_NONE.
+func (r *RoutingPolicy) NewPolicyAssignment(id string, dir PolicyDirection, policies []*oc.PolicyDefinition, def RouteType) (*PolicyAssignment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ps, err := r.lookupPolicies(policies)
+	if err != nil {
+		return nil, err
+	}
+	if def == ROUTE_TYPE_NONE {
+		def = r.getDefaultPolicy(id, dir)
+	}
+	return &PolicyAssignment{
+		Name:     id,
+		Type:     dir,
+		Policies: ps,
+		Default:  def,
+	}, nil
+}
+
 func (r *RoutingPolicy) Initialize() error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -4040,17 +4992,128 @@ func (r *RoutingPolicy) Reset(rp *oc.RoutingPolicy, ap map[string]oc.ApplyPolicy
 	return nil
 }
 
+// Replace replaces the defined sets, the policies and the assignments all
+// together, where ap has the assignments of all the tables. Unlike Reset,
+// nothing is changed if any of them is invalid, e.g. refers to the defined
+// set or the policy which doesn't exist. If dryRun is true, they are only
+// validated.
+func (r *RoutingPolicy) Replace(rp *oc.RoutingPolicy, ap map[string]oc.ApplyPolicy, dryRun bool) error {
+	if rp == nil {
+		return fmt.Errorf("routing Policy is nil in call to Replace")
+	}
+
+	n := NewRoutingPolicy(r.logger)
+	if err := n.reload(*rp); err != nil {
+		return err
+	}
+	for id, c := range ap {
+		for _, dir := range []PolicyDirection{POLICY_DIRECTION_IMPORT, POLICY_DIRECTION_EXPORT} {
+			ps, def, err := n.getAssignmentFromConfig(dir, c)
+			if err != nil {
+				return fmt.Errorf("invalid %s policy assignment of %s: %w", dir, id, err)
+			}
+			n.setDefaultPolicy(id, dir, def)
+			n.setPolicy(id, dir, ps)
+		}
+	}
+	if dryRun {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.swap(&PolicySnapshot{
+		definedSetMap: n.definedSetMap,
+		policyMap:     n.policyMap,
+		statementMap:  n.statementMap,
+		assignmentMap: n.assignmentMap,
+	})
+	return nil
+}
+
+func (r *RoutingPolicy) swap(p *PolicySnapshot) {
+	// the removed sets are changed too
+	for _, m := range []DefinedSetMap{r.definedSetMap, p.definedSetMap} {
+		for typ, sets := range m {
+			for name := range sets {
+				r.incDefinedSetVersion(typ, name)
+			}
+		}
+	}
+	r.definedSetMap = p.definedSetMap
+	r.policyMap = p.policyMap
+	r.statementMap = p.statementMap
+	r.assignmentMap = p.assignmentMap
+}
+
+// PolicySnapshot is the copy of the defined sets, the policies and the
+// assignments taken by Snapshot.
+type PolicySnapshot struct {
+	definedSetMap DefinedSetMap
+	policyMap     map[string]*Policy
+	statementMap  map[string]*Statement
+	assignmentMap map[string]*Assignment
+}
+
+// Snapshot copies the defined sets, the policies and the assignments to be
+// restored by Restore after they are replaced by Replace. The defined sets
+// and the policies themselves aren't copied because Replace creates new
+// ones.
+func (r *RoutingPolicy) Snapshot() *PolicySnapshot {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	p := &PolicySnapshot{
+		definedSetMap: make(DefinedSetMap, len(r.definedSetMap)),
+		policyMap:     make(map[string]*Policy, len(r.policyMap)),
+		statementMap:  make(map[string]*Statement, len(r.statementMap)),
+		assignmentMap: make(map[string]*Assignment, len(r.assignmentMap)),
+	}
+	for typ, sets := range r.definedSetMap {
+		m := make(map[string]DefinedSet, len(sets))
+		for name, set := range sets {
+			m[name] = set
+		}
+		p.definedSetMap[typ] = m
+	}
+	for name, policy := range r.policyMap {
+		p.policyMap[name] = policy
+	}
+	for name, st := range r.statementMap {
+		p.statementMap[name] = st
+	}
+	// changed in place by SetPeerPolicy
+	for id, a := range r.assignmentMap {
+		c := *a
+		p.assignmentMap[id] = &c
+	}
+	return p
+}
+
+// Restore replaces the defined sets, the policies and the assignments with
+// the snapshot.
+func (r *RoutingPolicy) Restore(p *PolicySnapshot) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.swap(p)
+}
+
 func NewRoutingPolicy(logger log.Logger) *RoutingPolicy {
 	return &RoutingPolicy{
-		definedSetMap: make(map[DefinedType]map[string]DefinedSet),
-		policyMap:     make(map[string]*Policy),
-		statementMap:  make(map[string]*Statement),
-		assignmentMap: make(map[string]*Assignment),
-		logger:        logger,
+		definedSetMap:     make(map[DefinedType]map[string]DefinedSet),
+		policyMap:         make(map[string]*Policy),
+		statementMap:      make(map[string]*Statement),
+		assignmentMap:     make(map[string]*Assignment),
+		definedSetVersion: make(map[DefinedType]map[string]uint64),
+		logger:            logger,
 	}
 }
 
 func CanImportToVrf(v *Vrf, path *Path) bool {
+	// RFC7611 2. ACCEPT_OWN Community
+	// the route isn't imported into the VRF from which it was originated.
+	if path.IsAcceptOwn() && v.isSource(path) {
+		return false
+	}
 	f := func(arg []bgp.ExtendedCommunityInterface) []string {
 		ret := make([]string, 0, len(arg))
 		for _, a := range arg {
@@ -4158,6 +5221,8 @@ func toStatementApi(s *oc.Statement) *api.Statement {
 		}
 		cs.AfiSafiIn = afiSafiIn
 	}
+	cs.FlowSpecMatch = s.Conditions.BgpConditions.FlowSpecMatch
+	cs.PathIdentifierIn = s.Conditions.BgpConditions.PathIdentifierInList
 	cs.RpkiResult = int32(s.Conditions.BgpConditions.RpkiValidationResult.ToInt())
 	as := &api.Actions{
 		RouteAction: func() api.RouteAction {
@@ -4206,15 +5271,21 @@ func toStatementApi(s *oc.Statement) *api.Statement {
 			}
 			var asn uint64
 			useleft := false
-			if s.Actions.BgpActions.SetAsPathPrepend.As != "last-as" {
-				asn, _ = strconv.ParseUint(s.Actions.BgpActions.SetAsPathPrepend.As, 10, 32)
-			} else {
+			usepeer := false
+			switch s.Actions.BgpActions.SetAsPathPrepend.As {
+			case "last-as":
 				useleft = true
+			case "peer-as":
+				usepeer = true
+			default:
+				asn, _ = strconv.ParseUint(s.Actions.BgpActions.SetAsPathPrepend.As, 10, 32)
 			}
 			return &api.AsPrependAction{
-				Asn:         uint32(asn),
-				Repeat:      uint32(s.Actions.BgpActions.SetAsPathPrepend.RepeatN),
-				UseLeftMost: useleft,
+				Asn:             uint32(asn),
+				Repeat:          uint32(s.Actions.BgpActions.SetAsPathPrepend.RepeatN),
+				UseLeftMost:     useleft,
+				UsePeerAs:       usepeer,
+				RepeatCommunity: s.Actions.BgpActions.SetAsPathPrepend.RepeatNCommunity,
 			}
 		}(),
 		ExtCommunity: func() *api.CommunityAction {
@@ -4281,6 +5352,43 @@ func toStatementApi(s *oc.Statement) *api.Statement {
 			}
 			return &api.OriginAction{Origin: apiOrigin}
 		}(),
+		Aigp: func() *api.AigpAction {
+			aigpStr := strings.TrimSpace(string(s.Actions.BgpActions.SetAigp))
+			if len(aigpStr) == 0 {
+				return nil
+			}
+			matches := _regexpMedActionType.FindStringSubmatch(aigpStr)
+			if len(matches) == 0 {
+				return nil
+			}
+			action := api.AigpAction_REPLACE
+			switch matches[1] {
+			case "+", "-":
+				action = api.AigpAction_MOD
+			}
+			value, err := strconv.ParseInt(matches[1]+matches[2], 10, 64)
+			if err != nil {
+				return nil
+			}
+			return &api.AigpAction{
+				Value: value,
+				Type:  action,
+			}
+		}(),
+		LinkBandwidth: func() *api.LinkBandwidthAction {
+			c := s.Actions.BgpActions.SetLinkBandwidth
+			if c.Bandwidth == "" {
+				return nil
+			}
+			if c.Bandwidth == "cumulative" {
+				return &api.LinkBandwidthAction{Cumulative: true, Transitive: c.Transitive}
+			}
+			bw, err := strconv.ParseFloat(c.Bandwidth, 32)
+			if err != nil {
+				return nil
+			}
+			return &api.LinkBandwidthAction{Bandwidth: float32(bw), Transitive: c.Transitive}
+		}(),
 	}
 	return &api.Statement{
 		Name:       s.Name,
@@ -591,6 +591,95 @@ func TestOriginConditionEvaluate(t *testing.T) {
 
 }
 
+func TestFlowSpecConditionEvaluate(t *testing.T) {
+	assert := assert.New(t)
+	peer := &PeerInfo{AS: 65001, Address: net.ParseIP("10.0.0.1")}
+	newPath := func(rf bgp.RouteFamily, rule string) *Path {
+		cmp, err := bgp.ParseFlowSpecComponents(rf, rule)
+		assert.NoError(err)
+		var nlri bgp.AddrPrefixInterface
+		if rf == bgp.RF_FS_IPv4_UC {
+			nlri = bgp.NewFlowSpecIPv4Unicast(cmp)
+		} else {
+			nlri = bgp.NewFlowSpecIPv6Unicast(cmp)
+		}
+		return NewPath(peer, nlri, false, []bgp.PathAttributeInterface{bgp.NewPathAttributeOrigin(0)}, time.Now(), false)
+	}
+
+	c, err := NewFlowSpecCondition("destination 2001:db8::/32 protocol tcp")
+	assert.NoError(err)
+	assert.True(c.Evaluate(newPath(bgp.RF_FS_IPv6_UC, "destination 2001:db8:1::/48 protocol tcp destination-port ==80"), nil))
+	assert.False(c.Evaluate(newPath(bgp.RF_FS_IPv6_UC, "destination 2001:db9::/48 protocol tcp"), nil))
+	assert.False(c.Evaluate(newPath(bgp.RF_FS_IPv6_UC, "destination 2001::/16 protocol tcp"), nil))
+	assert.False(c.Evaluate(newPath(bgp.RF_FS_IPv6_UC, "destination 2001:db8:1::/48 protocol udp"), nil))
+	assert.False(c.Evaluate(newPath(bgp.RF_FS_IPv6_UC, "protocol tcp"), nil))
+	assert.False(c.Evaluate(newPath(bgp.RF_FS_IPv4_UC, "destination 10.0.0.0/24 protocol tcp"), nil))
+
+	// the rule without the prefixes matches the both families
+	c, err = NewFlowSpecCondition("protocol tcp")
+	assert.NoError(err)
+	assert.True(c.Evaluate(newPath(bgp.RF_FS_IPv6_UC, "destination 2001:db8:1::/48 protocol tcp"), nil))
+	assert.True(c.Evaluate(newPath(bgp.RF_FS_IPv4_UC, "destination 10.0.0.0/24 protocol tcp"), nil))
+
+	c, err = NewFlowSpecCondition("label ==12")
+	assert.NoError(err)
+	assert.True(c.Evaluate(newPath(bgp.RF_FS_IPv6_UC, "source 2001:db8::1 label ==12"), nil))
+	assert.False(c.Evaluate(newPath(bgp.RF_FS_IPv6_UC, "source 2001:db8::1 label ==13"), nil))
+
+	_, err = NewFlowSpecCondition("destination foo")
+	assert.Error(err)
+
+	// the condition is kept in the configuration
+	s, err := NewStatement(oc.Statement{
+		Name: "st",
+		Conditions: oc.Conditions{
+			BgpConditions: oc.BgpConditions{FlowSpecMatch: "protocol tcp"},
+		},
+	})
+	assert.NoError(err)
+	assert.Equal("protocol tcp", s.ToConfig().Conditions.BgpConditions.FlowSpecMatch)
+}
+
+func TestPathIdentifierConditionEvaluate(t *testing.T) {
+	assert := assert.New(t)
+	peer := &PeerInfo{AS: 65001, Address: net.ParseIP("10.0.0.1")}
+	newPath := func(id uint32) *Path {
+		nlri := bgp.NewIPAddrPrefix(24, "10.10.0.0")
+		nlri.SetPathIdentifier(id)
+		return NewPath(peer, nlri, false, []bgp.PathAttributeInterface{bgp.NewPathAttributeOrigin(0)}, time.Now(), false)
+	}
+
+	c, err := NewPathIdentifierCondition([]uint32{1, 3})
+	assert.NoError(err)
+	assert.True(c.Evaluate(newPath(1), nil))
+	assert.True(c.Evaluate(newPath(3), nil))
+	assert.False(c.Evaluate(newPath(2), nil))
+	assert.False(c.Evaluate(newPath(0), nil))
+
+	// a route received without ADD-PATH has the identifier 0
+	c, err = NewPathIdentifierCondition([]uint32{0})
+	assert.NoError(err)
+	assert.True(c.Evaluate(newPath(0), nil))
+
+	c, err = NewPathIdentifierCondition(nil)
+	assert.NoError(err)
+	assert.Nil(c)
+
+	s, err := NewStatement(oc.Statement{
+		Name: "st",
+		Conditions: oc.Conditions{
+			BgpConditions: oc.BgpConditions{PathIdentifierInList: []uint32{1, 3}},
+		},
+		Actions: oc.Actions{RouteDisposition: oc.ROUTE_DISPOSITION_REJECT_ROUTE},
+	})
+	assert.NoError(err)
+	assert.Equal([]uint32{1, 3}, s.ToConfig().Conditions.BgpConditions.PathIdentifierInList)
+	matched, _ := s.Apply(nil, newPath(3), nil)
+	assert.Equal(ROUTE_TYPE_REJECT, matched)
+	matched, _ = s.Apply(nil, newPath(2), nil)
+	assert.Equal(ROUTE_TYPE_NONE, matched)
+}
+
 func TestPolicyMatchAndAcceptNextHop(t *testing.T) {
 	// create path
 	peer := &PeerInfo{AS: 65001, Address: net.ParseIP("10.0.0.1")}
@@ -3014,6 +3103,49 @@ func TestPolicyAsPathPrependLastAs(t *testing.T) {
 	assert.Equal([]uint32{65002, 65002, 65002, 65002, 65002, 65002, 65001, 65000}, newPath.GetAsSeqList())
 }
 
+func TestAsPathPrependActionPeerAsAndRepeatCommunity(t *testing.T) {
+	assert := assert.New(t)
+	newPath := func(attrs ...bgp.PathAttributeInterface) *Path {
+		attrs = append(attrs, bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint32{65001})}))
+		return NewPath(nil, bgp.NewIPAddrPrefix(24, "10.10.0.0"), false, attrs, time.Now(), false)
+	}
+	options := &PolicyOptions{Info: &PeerInfo{AS: 65002, LocalAS: 65000}}
+
+	a, err := NewAsPathPrependAction(oc.SetAsPathPrepend{As: "peer-as", RepeatN: 2})
+	assert.NoError(err)
+	p, _ := a.Apply(newPath(), options)
+	assert.Equal([]uint32{65002, 65002, 65001}, p.GetAsSeqList())
+	assert.Equal("peer-as", a.ToConfig().As)
+
+	// no peer information
+	p, _ = a.Apply(newPath(), nil)
+	assert.Equal([]uint32{65001}, p.GetAsSeqList())
+
+	a, err = NewAsPathPrependAction(oc.SetAsPathPrepend{As: "last-as", RepeatN: 1, RepeatNCommunity: "65000:*"})
+	assert.NoError(err)
+	p, _ = a.Apply(newPath(bgp.NewPathAttributeCommunities([]uint32{65100<<16 | 5, 65000<<16 | 3})), options)
+	assert.Equal([]uint32{65001, 65001, 65001, 65001}, p.GetAsSeqList())
+	// repeat-n is used if no community matches
+	p, _ = a.Apply(newPath(bgp.NewPathAttributeCommunities([]uint32{65100<<16 | 5})), options)
+	assert.Equal([]uint32{65001, 65001}, p.GetAsSeqList())
+
+	a, err = NewAsPathPrependAction(oc.SetAsPathPrepend{As: "last-as", RepeatNCommunity: "65000:*:peer-as"})
+	assert.NoError(err)
+	lcs := bgp.NewPathAttributeLargeCommunities([]*bgp.LargeCommunity{
+		bgp.NewLargeCommunity(65000, 3, 65003),
+		bgp.NewLargeCommunity(65000, 2, 65002),
+	})
+	p, _ = a.Apply(newPath(lcs), options)
+	assert.Equal([]uint32{65001, 65001, 65001}, p.GetAsSeqList())
+	p, _ = a.Apply(newPath(lcs), &PolicyOptions{Info: &PeerInfo{AS: 65004, LocalAS: 65000}})
+	assert.Equal([]uint32{65001}, p.GetAsSeqList())
+
+	for _, c := range []string{"65000", "65000:1", "*:*", "65000:*:*", "65000:x:*"} {
+		_, err = NewAsPathPrependAction(oc.SetAsPathPrepend{As: "last-as", RepeatNCommunity: c})
+		assert.Error(err, c)
+	}
+}
+
 func TestPolicyAs4PathPrepend(t *testing.T) {
 
 	assert := assert.New(t)
@@ -3246,6 +3378,113 @@ func TestOriginAction(t *testing.T) {
 	assert.Equal(t, int(lp.Value), oc.BGP_ORIGIN_ATTR_TYPE_IGP.ToInt())
 }
 
+func TestAigpAction(t *testing.T) {
+	assert := assert.New(t)
+	nlri := bgp.NewIPAddrPrefix(24, "10.0.0.0")
+	attrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeNextHop("10.0.0.1"),
+	}
+	path := NewPath(nil, nlri, false, attrs, time.Now(), false)
+
+	// nothing to add to
+	action, err := NewAigpAction("+10")
+	assert.NoError(err)
+	assert.Equal(oc.BgpSetMedType("+10"), action.ToConfig())
+	p, err := action.Apply(path, nil)
+	assert.NoError(err)
+	_, err = p.GetAigp()
+	assert.Error(err)
+
+	action, err = NewAigpAction("100")
+	assert.NoError(err)
+	p, err = action.Apply(path, nil)
+	assert.NoError(err)
+	aigp, err := p.GetAigp()
+	assert.NoError(err)
+	assert.Equal(uint64(100), aigp)
+
+	action, err = NewAigpAction("+10")
+	assert.NoError(err)
+	p, _ = action.Apply(p, nil)
+	aigp, _ = p.GetAigp()
+	assert.Equal(uint64(110), aigp)
+
+	action, err = NewAigpAction("-20")
+	assert.NoError(err)
+	assert.Equal(oc.BgpSetMedType("-20"), action.ToConfig())
+	p, _ = action.Apply(p, nil)
+	aigp, _ = p.GetAigp()
+	assert.Equal(uint64(90), aigp)
+
+	action, err = NewAigpAction("-100")
+	assert.NoError(err)
+	_, err = action.Apply(p, nil)
+	assert.Error(err)
+
+	_, err = NewAigpAction("igp")
+	assert.Error(err)
+}
+
+func TestLinkBandwidthAction(t *testing.T) {
+	assert := assert.New(t)
+	newPath := func(nexthop string, bw float32) *Path {
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop(nexthop),
+		}
+		if bw > 0 {
+			attrs = append(attrs, bgp.NewPathAttributeExtendedCommunities([]bgp.ExtendedCommunityInterface{
+				bgp.NewLinkBandwidthExtended(65000, bw),
+				bgp.NewTwoOctetAsSpecificExtended(bgp.EC_SUBTYPE_ROUTE_TARGET, 65000, 100, true),
+			}))
+		}
+		return NewPath(nil, bgp.NewIPAddrPrefix(24, "10.0.0.0"), false, attrs, time.Now(), false)
+	}
+	options := &PolicyOptions{Info: &PeerInfo{LocalAS: 65001}}
+
+	action, err := NewLinkBandwidthAction(oc.SetLinkBandwidth{Bandwidth: "1250000"})
+	assert.NoError(err)
+	assert.Equal(&oc.SetLinkBandwidth{Bandwidth: "1250000"}, action.ToConfig())
+	p, err := action.Apply(newPath("10.0.0.1", 0), options)
+	assert.NoError(err)
+	exts := p.GetExtCommunities()
+	assert.Len(exts, 1)
+	assert.Equal(bgp.NewLinkBandwidthExtended(65001, 1250000), exts[0])
+
+	// the existing one is replaced with the transitive one
+	action, err = NewLinkBandwidthAction(oc.SetLinkBandwidth{Bandwidth: "2500000", Transitive: true})
+	assert.NoError(err)
+	p, _ = action.Apply(newPath("10.0.0.1", 1000), options)
+	exts = p.GetExtCommunities()
+	assert.Len(exts, 2)
+	assert.Equal(bgp.NewTransitiveLinkBandwidthExtended(65001, 2500000), exts[1])
+
+	// AS_TRANS with the four-octet local AS
+	p, _ = action.Apply(newPath("10.0.0.1", 0), &PolicyOptions{Info: &PeerInfo{LocalAS: 4200000000}})
+	assert.Equal(uint16(bgp.AS_TRANS), p.GetExtCommunities()[0].(*bgp.LinkBandwidthExtended).AS)
+
+	// the sum of the bandwidths of the multipaths
+	action, err = NewLinkBandwidthAction(oc.SetLinkBandwidth{Bandwidth: "cumulative"})
+	assert.NoError(err)
+	assert.Equal("cumulative", action.String())
+	multi := []*Path{newPath("10.0.0.1", 1000), newPath("10.0.0.2", 3000), newPath("10.0.0.3", 0)}
+	options.MultiPath = func(*Path) []*Path { return multi }
+	p, _ = action.Apply(newPath("10.0.0.1", 1000), options)
+	bw, y := p.GetLinkBandwidth()
+	assert.True(y)
+	assert.Equal(float32(4000), bw)
+
+	// nothing is set without the bandwidths
+	options.MultiPath = nil
+	p, _ = action.Apply(newPath("10.0.0.1", 0), options)
+	_, y = p.GetLinkBandwidth()
+	assert.False(y)
+
+	_, err = NewLinkBandwidthAction(oc.SetLinkBandwidth{Bandwidth: "fast"})
+	assert.Error(err)
+}
+
 func createStatement(name, psname, nsname string, accept bool) oc.Statement {
 	c := oc.Conditions{
 		MatchPrefixSet: oc.MatchPrefixSet{
@@ -3628,6 +3867,80 @@ func TestLargeCommunitiesMatchClearAction(t *testing.T) {
 	assert.Equal(t, lc, p.GetLargeCommunities())
 }
 
+func TestLargeCommunityFieldMatch(t *testing.T) {
+	for _, tt := range []struct {
+		exp   string
+		comm  string
+		match bool
+	}{
+		{"100:200:300", "100:200:300", true},
+		{"100:200:300", "1100:200:300", false},
+		{"100:*:300", "100:12345:300", true},
+		{"100:*:300", "100:200:301", false},
+		{"*:*:*", "1:2:3", true},
+		{"100:2[0-9]:*", "100:25:1", true},
+		{"100:2[0-9]:*", "100:250:1", false},
+		{"100|200:1:*", "200:1:5", true},
+		{"^100:", "100:1:1", true},
+	} {
+		exp, err := ParseLargeCommunityRegexp(tt.exp)
+		assert.NoError(t, err, tt.exp)
+		assert.Equal(t, tt.match, exp.MatchString(tt.comm), "%s %s", tt.exp, tt.comm)
+	}
+
+	exp, err := ParseLargeCommunityRegexp("100:200:300")
+	assert.NoError(t, err)
+	assert.Equal(t, "^100:200:300$", exp.String())
+
+	_, err = ParseLargeCommunityRegexp("100:[:300")
+	assert.Error(t, err)
+}
+
+func TestLargeCommunityTemplateAction(t *testing.T) {
+	coms := []*bgp.LargeCommunity{
+		{ASN: 65000, LocalData1: 1, LocalData2: 65100},
+		{ASN: 65000, LocalData1: 1, LocalData2: 65200},
+	}
+	p := NewPath(nil, nil, false, []bgp.PathAttributeInterface{bgp.NewPathAttributeLargeCommunities(coms)}, time.Time{}, false)
+	options := &PolicyOptions{
+		Info: &PeerInfo{AS: 65100, LocalAS: 65000},
+	}
+
+	a, err := NewLargeCommunityAction(oc.SetLargeCommunity{
+		SetLargeCommunityMethod: oc.SetLargeCommunityMethod{
+			CommunitiesList: []string{"local-as:*:peer-as"},
+		},
+		Options: oc.BGP_SET_COMMUNITY_OPTION_TYPE_REMOVE,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"local-as:*:peer-as"}, a.ToConfig().SetLargeCommunityMethod.CommunitiesList)
+	p, err = a.Apply(p, options)
+	assert.NoError(t, err)
+	assert.Equal(t, []*bgp.LargeCommunity{coms[1]}, p.GetLargeCommunities())
+
+	a, err = NewLargeCommunityAction(oc.SetLargeCommunity{
+		SetLargeCommunityMethod: oc.SetLargeCommunityMethod{
+			CommunitiesList: []string{"100:100:100", "local-as:2:peer-as"},
+		},
+		Options: oc.BGP_SET_COMMUNITY_OPTION_TYPE_REPLACE,
+	})
+	assert.NoError(t, err)
+	p, err = a.Apply(p, options)
+	assert.NoError(t, err)
+	assert.Equal(t, []*bgp.LargeCommunity{
+		{ASN: 100, LocalData1: 100, LocalData2: 100},
+		{ASN: 65000, LocalData1: 2, LocalData2: 65100},
+	}, p.GetLargeCommunities())
+
+	_, err = NewLargeCommunityAction(oc.SetLargeCommunity{
+		SetLargeCommunityMethod: oc.SetLargeCommunityMethod{
+			CommunitiesList: []string{"peer-as:*:1"},
+		},
+		Options: oc.BGP_SET_COMMUNITY_OPTION_TYPE_ADD,
+	})
+	assert.Error(t, err)
+}
+
 func TestAfiSafiInMatchPath(t *testing.T) {
 	condition, err := NewAfiSafiInCondition([]oc.AfiSafiType{oc.AFI_SAFI_TYPE_L3VPN_IPV4_UNICAST, oc.AFI_SAFI_TYPE_L3VPN_IPV6_UNICAST})
 	require.NoError(t, err)
@@ -3708,6 +4021,129 @@ func TestMultipleStatementPolicy(t *testing.T) {
 	assert.Equal(t, localPref, uint32(100))
 }
 
+func TestSimulatePolicy(t *testing.T) {
+	r := NewRoutingPolicy(logger)
+	ds := oc.DefinedSets{}
+	ds.PrefixSets = []oc.PrefixSet{createPrefixSet("ps1", "10.20.0.0/16", "16..24")}
+	s1 := createStatement("s1", "ps1", "", false)
+	s2 := oc.Statement{
+		Name: "s2",
+		Actions: oc.Actions{
+			RouteDisposition: oc.ROUTE_DISPOSITION_ACCEPT_ROUTE,
+			BgpActions: oc.BgpActions{
+				SetMed: "+100",
+			},
+		},
+	}
+	s3 := oc.Statement{
+		Name: "s3",
+		Actions: oc.Actions{
+			BgpActions: oc.BgpActions{
+				SetLocalPref: 100,
+			},
+		},
+	}
+	err := r.reload(createRoutingPolicy(ds, createPolicyDefinition("p1", s1, s2), createPolicyDefinition("p2", s3)))
+	assert.Nil(t, err)
+
+	newPath := func(prefix string) *Path {
+		pattrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop("10.0.0.1"),
+		}
+		return NewPath(nil, bgp.NewIPAddrPrefix(24, prefix), false, pattrs, time.Now(), false)
+	}
+
+	a, err := r.NewPolicyAssignment("", POLICY_DIRECTION_IMPORT, []*oc.PolicyDefinition{{Name: "p1"}}, ROUTE_TYPE_REJECT)
+	assert.Nil(t, err)
+	result := r.SimulatePolicy(a, newPath("10.10.0.0"), &PolicyOptions{})
+	assert.Equal(t, ROUTE_TYPE_ACCEPT, result.Result)
+	assert.False(t, result.Default)
+	assert.Len(t, result.Statements, 2)
+	assert.Equal(t, &StatementResult{Policy: "p1", Statement: "s1"}, result.Statements[0])
+	assert.True(t, result.Statements[1].Matched)
+	assert.Equal(t, []string{"med +100", "accept"}, result.Statements[1].Actions)
+	med, _ := result.Path.GetMed()
+	assert.Equal(t, uint32(100), med)
+
+	result = r.SimulatePolicy(a, newPath("10.20.0.0"), &PolicyOptions{})
+	assert.Equal(t, ROUTE_TYPE_REJECT, result.Result)
+	assert.Len(t, result.Statements, 1)
+	assert.Equal(t, []string{"reject"}, result.Statements[0].Actions)
+	assert.Nil(t, result.Path)
+
+	a, err = r.NewPolicyAssignment("", POLICY_DIRECTION_IMPORT, []*oc.PolicyDefinition{{Name: "p2"}}, ROUTE_TYPE_REJECT)
+	assert.Nil(t, err)
+	result = r.SimulatePolicy(a, newPath("10.10.0.0"), &PolicyOptions{})
+	assert.Equal(t, ROUTE_TYPE_REJECT, result.Result)
+	assert.True(t, result.Default)
+	assert.Len(t, result.Statements[0].Actions, 1)
+	assert.Nil(t, result.Path)
+}
+
+func TestUpdateDefinedSet(t *testing.T) {
+	r := NewRoutingPolicy(logger)
+	ds := oc.DefinedSets{}
+	ds.PrefixSets = []oc.PrefixSet{createPrefixSet("ps1", "10.10.0.0/16", "16..24")}
+	ds.NeighborSets = []oc.NeighborSet{createNeighborSet("ns1", "10.0.0.1")}
+	err := r.reload(createRoutingPolicy(ds, createPolicyDefinition("p1", createStatement("s1", "ps1", "ns1", false))))
+	assert.Nil(t, err)
+	err = r.AddPolicyAssignment(GLOBAL_RIB_NAME, POLICY_DIRECTION_IMPORT, []*oc.PolicyDefinition{{Name: "p1"}}, ROUTE_TYPE_ACCEPT)
+	assert.Nil(t, err)
+
+	newPath := func(prefix string) *Path {
+		pattrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop("10.0.0.1"),
+		}
+		return NewPath(nil, bgp.NewIPAddrPrefix(24, prefix), false, pattrs, time.Now(), false)
+	}
+	options := &PolicyOptions{Info: &PeerInfo{Address: net.ParseIP("10.0.0.1")}}
+	version := r.GetDefinedSetVersions()[DEFINED_TYPE_PREFIX]["ps1"]
+	assert.Equal(t, uint64(1), version)
+
+	add, err := NewPrefixSet(oc.PrefixSet{
+		PrefixSetName: "ps1",
+		PrefixList:    []oc.Prefix{{IpPrefix: "10.20.0.0/16", MasklengthRange: "16..24"}},
+	})
+	assert.Nil(t, err)
+	u, err := r.NewDefinedSetUpdate(add, nil, version)
+	assert.Nil(t, err)
+	assert.Equal(t, []*PolicyAssignment{{Name: GLOBAL_RIB_NAME, Type: POLICY_DIRECTION_IMPORT}}, u.Assignments)
+	assert.True(t, u.Affects(newPath("10.20.1.0"), options))
+	assert.False(t, u.Affects(newPath("10.10.1.0"), options))
+	assert.False(t, u.Affects(newPath("10.30.1.0"), options))
+
+	assert.NotNil(t, r.ApplyPolicy(GLOBAL_RIB_NAME, POLICY_DIRECTION_IMPORT, newPath("10.20.1.0"), options))
+	version, err = r.UpdateDefinedSet(u, version)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), version)
+	assert.Nil(t, r.ApplyPolicy(GLOBAL_RIB_NAME, POLICY_DIRECTION_IMPORT, newPath("10.20.1.0"), options))
+
+	// stale version
+	_, err = r.NewDefinedSetUpdate(add, nil, 1)
+	assert.NotNil(t, err)
+	_, err = r.UpdateDefinedSet(u, 1)
+	assert.NotNil(t, err)
+
+	// removing the last neighbor makes the set match any neighbor
+	remove, err := NewNeighborSet(oc.NeighborSet{
+		NeighborSetName:  "ns1",
+		NeighborInfoList: []string{"10.0.0.1"},
+	})
+	assert.Nil(t, err)
+	u, err = r.NewDefinedSetUpdate(nil, remove, 0)
+	assert.Nil(t, err)
+	assert.True(t, u.Affects(newPath("10.30.1.0"), &PolicyOptions{Info: &PeerInfo{Address: net.ParseIP("10.0.0.2")}}))
+
+	_, err = r.NewDefinedSetUpdate(nil, remove, 0)
+	assert.Nil(t, err)
+	_, err = r.NewDefinedSetUpdate(add, remove, 0)
+	assert.NotNil(t, err)
+	_, err = r.NewDefinedSetUpdate(nil, nil, 0)
+	assert.NotNil(t, err)
+}
+
 func TestNewSingleAsPathMatch(t *testing.T) {
 	r := NewSingleAsPathMatch("^65100_")
 	assert.Equal(t, r.mode, LEFT_MOST)
@@ -3718,3 +4154,51 @@ func TestNewSingleAsPathMatch(t *testing.T) {
 	r = NewSingleAsPathMatch("^65100$")
 	assert.Equal(t, r.mode, ONLY)
 }
+
+func TestFlowSpecExtCommunityAction(t *testing.T) {
+	assert := assert.New(t)
+	p := NewPath(nil, nil, false, []bgp.PathAttributeInterface{}, time.Time{}, false)
+
+	a, err := NewExtCommunityAction(oc.SetExtCommunity{
+		SetExtCommunityMethod: oc.SetExtCommunityMethod{
+			CommunitiesList: []string{"mark:46", "interface-set:65000:100:in", "rt:65000:1"},
+		},
+		Options: string(oc.BGP_SET_COMMUNITY_OPTION_TYPE_ADD),
+	})
+	assert.NoError(err)
+	assert.Equal([]string{"mark:46", "interface-set:65000:100:in", "rt:65000:1"}, a.ToConfig().SetExtCommunityMethod.CommunitiesList)
+	p, err = a.Apply(p, nil)
+	assert.NoError(err)
+	assert.Equal([]bgp.ExtendedCommunityInterface{
+		bgp.NewTrafficRemarkExtended(46),
+		bgp.NewInterfaceSetExtended(65000, 100, true, false),
+		bgp.NewTwoOctetAsSpecificExtended(bgp.EC_SUBTYPE_ROUTE_TARGET, 65000, 1, true),
+	}, p.GetExtCommunities())
+
+	for _, c := range []string{"mark:64", "mark", "interface-set:65000:16384"} {
+		_, err = ParseExtCommunity(c)
+		assert.Error(err, c)
+	}
+}
+
+func TestCanImportToVrfAcceptOwn(t *testing.T) {
+	assert := assert.New(t)
+	rt, _ := bgp.ParseRouteTarget("100:1")
+	rd1, _ := bgp.ParseRouteDistinguisher("100:1")
+	rd2, _ := bgp.ParseRouteDistinguisher("100:2")
+	vrf1 := &Vrf{Name: "vrf1", Rd: rd1, ImportRt: []bgp.ExtendedCommunityInterface{rt}}
+	vrf2 := &Vrf{Name: "vrf2", Rd: rd2, ImportRt: []bgp.ExtendedCommunityInterface{rt}}
+
+	labels := bgp.NewMPLSLabelStack(100)
+	nlri := bgp.NewLabeledVPNIPAddrPrefix(24, "10.0.0.0", *labels, rd1)
+	path := NewPath(nil, nlri, false, []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeExtendedCommunities([]bgp.ExtendedCommunityInterface{rt}),
+	}, time.Now(), false)
+	assert.True(CanImportToVrf(vrf1, path))
+	assert.True(CanImportToVrf(vrf2, path))
+
+	// not imported into the source VRF
+	path.SetAcceptOwn(true)
+	assert.False(CanImportToVrf(vrf1, path))
+	assert.True(CanImportToVrf(vrf2, path))
+}
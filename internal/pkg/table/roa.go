@@ -70,6 +70,7 @@ func (r *roaBucket) GetEntries() []*ROA {
 
 type ROATable struct {
 	trees  map[bgp.RouteFamily]*critbitgo.Net
+	slurm  *SLURM
 	logger log.Logger
 }
 
@@ -83,6 +84,38 @@ func NewROATable(logger log.Logger) *ROATable {
 	}
 }
 
+// SetSLURM replaces the local exceptions. The prefix filters hide the ROAs
+// from the cache servers and the prefix assertions are added to the table.
+// nil removes them.
+func (rt *ROATable) SetSLURM(slurm *SLURM) {
+	rt.DeleteAll(SLURMSource)
+	rt.slurm = slurm
+	if slurm == nil {
+		return
+	}
+	for i := range slurm.LocallyAddedAssertions.PrefixAssertions {
+		rt.Add(slurm.LocallyAddedAssertions.PrefixAssertions[i].roa())
+	}
+}
+
+func (rt *ROATable) SLURM() *SLURM {
+	return rt.slurm
+}
+
+// filtered returns true if the ROA is removed by the SLURM prefix filters.
+// The locally added ROAs aren't filtered (RFC 8416 4.2).
+func (rt *ROATable) filtered(roa *ROA) bool {
+	if rt.slurm == nil || roa.Src == SLURMSource {
+		return false
+	}
+	for i := range rt.slurm.ValidationOutputFilters.PrefixFilters {
+		if rt.slurm.ValidationOutputFilters.PrefixFilters[i].match(roa) {
+			return true
+		}
+	}
+	return false
+}
+
 func (rt *ROATable) roa2tree(roa *ROA) *critbitgo.Net {
 	tree := rt.trees[bgp.RF_IPv4_UC]
 	if roa.Family == bgp.AFI_IP6 {
@@ -221,6 +254,9 @@ func (rt *ROATable) Validate(path *Path) *Validation {
 	tree.WalkMatch(r, func(r *net.IPNet, v interface{}) bool {
 		bucket, _ = v.(*roaBucket)
 		for _, r := range bucket.entries {
+			if rt.filtered(r) {
+				continue
+			}
 			if prefixLen <= int(r.MaxLen) {
 				if r.AS != 0 && r.AS == as {
 					validation.Matched = append(validation.Matched, r)
@@ -260,7 +296,9 @@ func (rt *ROATable) Info(family bgp.RouteFamily) (map[string]uint32, map[string]
 			b, _ := v.(*roaBucket)
 			tmpRecords := make(map[string]uint32)
 			for _, roa := range b.entries {
-				tmpRecords[roa.Src]++
+				if !rt.filtered(roa) {
+					tmpRecords[roa.Src]++
+				}
 			}
 
 			for src, r := range tmpRecords {
@@ -290,7 +328,11 @@ func (rt *ROATable) List(family bgp.RouteFamily) ([]*ROA, error) {
 		if tree, ok := rt.trees[rf]; ok {
 			tree.Walk(nil, func(_ *net.IPNet, v interface{}) bool {
 				b, _ := v.(*roaBucket)
-				l = append(l, b.entries...)
+				for _, roa := range b.entries {
+					if !rt.filtered(roa) {
+						l = append(l, roa)
+					}
+				}
 				return true
 			})
 		}
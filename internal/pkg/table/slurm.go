@@ -0,0 +1,149 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"os"
+
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+// SLURMSource is the source of the ROAs locally added by the SLURM file.
+const SLURMSource = "slurm"
+
+// SLURM is the local exceptions to the RPKI data (RFC 8416). Only the
+// prefix filters and the prefix assertions are used for the origin
+// validation. The BGPsec filters and assertions are accepted but ignored.
+type SLURM struct {
+	SlurmVersion            int `json:"slurmVersion"`
+	ValidationOutputFilters struct {
+		PrefixFilters []SLURMPrefixFilter `json:"prefixFilters"`
+		BgpsecFilters []json.RawMessage   `json:"bgpsecFilters"`
+	} `json:"validationOutputFilters"`
+	LocallyAddedAssertions struct {
+		PrefixAssertions []SLURMPrefixAssertion `json:"prefixAssertions"`
+		BgpsecAssertions []json.RawMessage      `json:"bgpsecAssertions"`
+	} `json:"locallyAddedAssertions"`
+}
+
+// SLURMPrefixFilter removes the ROAs covered by Prefix and of ASN. Either
+// of them can be omitted.
+type SLURMPrefixFilter struct {
+	Prefix  string  `json:"prefix,omitempty"`
+	ASN     *uint32 `json:"asn,omitempty"`
+	Comment string  `json:"comment,omitempty"`
+
+	prefix netip.Prefix
+}
+
+// SLURMPrefixAssertion adds the ROA.
+type SLURMPrefixAssertion struct {
+	Prefix          string `json:"prefix"`
+	ASN             uint32 `json:"asn"`
+	MaxPrefixLength uint8  `json:"maxPrefixLength,omitempty"`
+	Comment         string `json:"comment,omitempty"`
+
+	prefix netip.Prefix
+}
+
+func parseSLURMPrefix(s string) (netip.Prefix, error) {
+	p, err := netip.ParsePrefix(s)
+	if err != nil {
+		return p, err
+	}
+	if p != p.Masked() {
+		return p, fmt.Errorf("host bits are set in %s", s)
+	}
+	return p, nil
+}
+
+// ParseSLURM parses the SLURM file in the JSON format.
+func ParseSLURM(data []byte) (*SLURM, error) {
+	s := &SLURM{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, err
+	}
+	if s.SlurmVersion != 1 {
+		return nil, fmt.Errorf("unsupported slurm version: %d", s.SlurmVersion)
+	}
+	for i := range s.ValidationOutputFilters.PrefixFilters {
+		f := &s.ValidationOutputFilters.PrefixFilters[i]
+		if f.Prefix == "" && f.ASN == nil {
+			return nil, fmt.Errorf("prefix filter must have prefix or asn")
+		}
+		if f.Prefix != "" {
+			p, err := parseSLURMPrefix(f.Prefix)
+			if err != nil {
+				return nil, fmt.Errorf("invalid prefix filter: %v", err)
+			}
+			f.prefix = p
+		}
+	}
+	for i := range s.LocallyAddedAssertions.PrefixAssertions {
+		a := &s.LocallyAddedAssertions.PrefixAssertions[i]
+		p, err := parseSLURMPrefix(a.Prefix)
+		if err != nil {
+			return nil, fmt.Errorf("invalid prefix assertion: %v", err)
+		}
+		a.prefix = p
+		if a.MaxPrefixLength != 0 && (int(a.MaxPrefixLength) < p.Bits() || int(a.MaxPrefixLength) > p.Addr().BitLen()) {
+			return nil, fmt.Errorf("invalid max prefix length %d of prefix assertion %s", a.MaxPrefixLength, a.Prefix)
+		}
+	}
+	return s, nil
+}
+
+// LoadSLURM reads the SLURM file.
+func LoadSLURM(path string) (*SLURM, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSLURM(data)
+}
+
+// match returns true if the filter removes the ROA (RFC 8416 3.3.1).
+func (f *SLURMPrefixFilter) match(roa *ROA) bool {
+	if f.ASN != nil && *f.ASN != roa.AS {
+		return false
+	}
+	if f.prefix.IsValid() {
+		addr, ok := netip.AddrFromSlice(roa.Network.IP)
+		if !ok {
+			return false
+		}
+		ones, _ := roa.Network.Mask.Size()
+		if addr.Unmap().BitLen() != f.prefix.Addr().BitLen() || ones < f.prefix.Bits() || !f.prefix.Contains(addr.Unmap()) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *SLURMPrefixAssertion) roa() *ROA {
+	family := bgp.AFI_IP
+	if a.prefix.Addr().Is6() {
+		family = bgp.AFI_IP6
+	}
+	maxLen := a.MaxPrefixLength
+	if maxLen == 0 {
+		maxLen = uint8(a.prefix.Bits())
+	}
+	return NewROA(family, a.prefix.Addr().AsSlice(), uint8(a.prefix.Bits()), maxLen, a.ASN, SLURMSource)
+}
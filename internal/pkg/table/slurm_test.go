@@ -0,0 +1,126 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+// the example of RFC 8416 3.5 without the BGPsec entries
+const testSLURM = `{
+  "slurmVersion": 1,
+  "validationOutputFilters": {
+    "prefixFilters": [
+      {
+        "prefix": "192.0.2.0/24",
+        "comment": "All VRPs encompassed by prefix"
+      },
+      {
+        "asn": 64496,
+        "comment": "All VRPs matching ASN"
+      },
+      {
+        "prefix": "198.51.100.0/24",
+        "asn": 64497,
+        "comment": "All VRPs encompassed by prefix, matching ASN"
+      }
+    ],
+    "bgpsecFilters": []
+  },
+  "locallyAddedAssertions": {
+    "prefixAssertions": [
+      {
+        "asn": 64496,
+        "prefix": "198.51.100.0/24",
+        "comment": "My other important route"
+      },
+      {
+        "asn": 64496,
+        "prefix": "2001:db8::/32",
+        "maxPrefixLength": 48,
+        "comment": "My other important de-aggregated routes"
+      }
+    ],
+    "bgpsecAssertions": []
+  }
+}`
+
+func TestParseSLURM(t *testing.T) {
+	assert := assert.New(t)
+
+	s, err := ParseSLURM([]byte(testSLURM))
+	assert.Nil(err)
+	assert.Len(s.ValidationOutputFilters.PrefixFilters, 3)
+	assert.Len(s.LocallyAddedAssertions.PrefixAssertions, 2)
+
+	for _, data := range []string{
+		`{"slurmVersion": 2}`,
+		`{"slurmVersion": 1, "validationOutputFilters": {"prefixFilters": [{"comment": "empty"}]}}`,
+		`{"slurmVersion": 1, "validationOutputFilters": {"prefixFilters": [{"prefix": "192.0.2.1/24"}]}}`,
+		`{"slurmVersion": 1, "locallyAddedAssertions": {"prefixAssertions": [{"asn": 1, "prefix": "192.0.2.0/24", "maxPrefixLength": 16}]}}`,
+		`{"slurmVersion": 1, "locallyAddedAssertions": {"prefixAssertions": [{"asn": 1, "prefix": "192.0.2.0/24", "maxPrefixLength": 33}]}}`,
+	} {
+		_, err := ParseSLURM([]byte(data))
+		assert.Error(err, data)
+	}
+}
+
+func TestROATableSLURM(t *testing.T) {
+	assert := assert.New(t)
+
+	table := NewROATable(logger)
+	table.Add(NewROA(bgp.AFI_IP, net.ParseIP("192.0.2.0").To4(), 24, 24, 100, "10.0.0.1:323"))
+	table.Add(NewROA(bgp.AFI_IP, net.ParseIP("192.0.0.0").To4(), 16, 24, 100, "10.0.0.1:323"))
+	table.Add(NewROA(bgp.AFI_IP, net.ParseIP("203.0.113.0").To4(), 24, 24, 64496, "10.0.0.1:323"))
+	table.Add(NewROA(bgp.AFI_IP, net.ParseIP("198.51.100.0").To4(), 24, 24, 64497, "10.0.0.1:323"))
+
+	s, err := ParseSLURM([]byte(testSLURM))
+	assert.Nil(err)
+	table.SetSLURM(s)
+
+	// covered by the filter of 192.0.2.0/24, but not 192.0.0.0/16
+	assert.Equal(oc.RPKI_VALIDATION_RESULT_TYPE_INVALID, validateOne(table, "192.0.2.0/24", "200"))
+	assert.Equal(oc.RPKI_VALIDATION_RESULT_TYPE_VALID, validateOne(table, "192.0.2.0/24", "100"))
+	l, _ := table.List(bgp.RF_IPv4_UC)
+	for _, roa := range l {
+		assert.NotEqual("192.0.2.0/24", roa.Network.String())
+	}
+
+	// filtered by the ASN
+	assert.Equal(oc.RPKI_VALIDATION_RESULT_TYPE_NOT_FOUND, validateOne(table, "203.0.113.0/24", "64496"))
+
+	// filtered by the prefix and the ASN, and then asserted
+	assert.Equal(oc.RPKI_VALIDATION_RESULT_TYPE_VALID, validateOne(table, "198.51.100.0/24", "64496"))
+	assert.Equal(oc.RPKI_VALIDATION_RESULT_TYPE_INVALID, validateOne(table, "198.51.100.0/24", "64497"))
+	assert.Equal(oc.RPKI_VALIDATION_RESULT_TYPE_VALID, validateOne(table, "2001:db8:1::/48", "64496"))
+	assert.Equal(oc.RPKI_VALIDATION_RESULT_TYPE_INVALID, validateOne(table, "2001:db8:1:1::/64", "64496"))
+
+	records, _ := table.Info(bgp.RF_IPv4_UC)
+	assert.Equal(uint32(1), records["10.0.0.1:323"])
+	assert.Equal(uint32(1), records[SLURMSource])
+
+	table.SetSLURM(nil)
+	assert.Equal(oc.RPKI_VALIDATION_RESULT_TYPE_VALID, validateOne(table, "203.0.113.0/24", "64496"))
+	assert.Equal(oc.RPKI_VALIDATION_RESULT_TYPE_NOT_FOUND, validateOne(table, "2001:db8:1::/48", "64496"))
+	records, _ = table.Info(bgp.RF_IPv4_UC)
+	assert.Equal(uint32(4), records["10.0.0.1:323"])
+}
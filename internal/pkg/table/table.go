@@ -23,6 +23,7 @@ import (
 	"strings"
 	"unsafe"
 
+	farm "github.com/dgryski/go-farm"
 	"github.com/k-sone/critbitgo"
 
 	"github.com/osrg/gobgp/v3/pkg/log"
@@ -61,6 +62,9 @@ type Table struct {
 	// this is a map[rt, MAC address]map[prefix]struct{}
 	// this holds a map for a set of prefixes.
 	macIndex map[string]map[string]struct{}
+	// the destinations in the order of precedence, only for the flowspec
+	// families
+	flowSpec *flowSpecIndex
 }
 
 func NewTable(logger log.Logger, rf bgp.RouteFamily, dsts ...*Destination) *Table {
@@ -70,6 +74,9 @@ func NewTable(logger log.Logger, rf bgp.RouteFamily, dsts ...*Destination) *Tabl
 		logger:       logger,
 		macIndex:     make(map[string]map[string]struct{}),
 	}
+	if isFlowSpecFamily(rf) {
+		t.flowSpec = &flowSpecIndex{}
+	}
 	for _, dst := range dsts {
 		t.setDestination(dst)
 	}
@@ -95,6 +102,8 @@ func (t *Table) deletePathsByVrf(vrf *Vrf) []*Path {
 				rd = v.RD()
 			case *bgp.MUPNLRI:
 				rd = v.RD()
+			case *bgp.MVPNNLRI:
+				rd = v.RD()
 			default:
 				return pathList
 			}
@@ -113,11 +122,10 @@ func (t *Table) deleteRTCPathsByVrf(vrf *Vrf, vrfs map[string]*Vrf) []*Path {
 		return pathList
 	}
 	for _, target := range vrf.ImportRt {
-		lhs := target.String()
 		for _, dest := range t.destinations {
 			nlri := dest.GetNlri().(*bgp.RouteTargetMembershipNLRI)
-			rhs := nlri.RouteTarget.String()
-			if lhs == rhs && isLastTargetUser(vrfs, target) {
+			// the default route target received doesn't match
+			if SameRouteTarget(target, nlri.RouteTarget) && isLastTargetUser(vrfs, target) {
 				for _, p := range dest.knownPathList {
 					if p.IsLocal() {
 						pathList = append(pathList, p.Clone(true))
@@ -130,19 +138,29 @@ func (t *Table) deleteRTCPathsByVrf(vrf *Vrf, vrfs map[string]*Vrf) []*Path {
 	return pathList
 }
 
-func (t *Table) deleteDest(dest *Destination) {
+// canDeleteDest returns false if the path local identifiers of dest are
+// still in use.
+func canDeleteDest(dest *Destination) bool {
 	count := 0
 	for _, v := range dest.localIdMap.bitmap {
 		count += bits.OnesCount64(v)
 	}
-	if len(dest.localIdMap.bitmap) != 0 && count != 1 {
+	return len(dest.localIdMap.bitmap) == 0 || count == 1
+}
+
+func (t *Table) deleteDest(dest *Destination) {
+	if !canDeleteDest(dest) {
 		return
 	}
 	destinations := t.GetDestinations()
-	delete(destinations, t.tableKey(dest.GetNlri()))
+	key := t.tableKey(dest.GetNlri())
+	delete(destinations, key)
 	if len(destinations) == 0 {
 		t.destinations = make(map[string]*Destination)
 	}
+	if t.flowSpec != nil {
+		t.flowSpec.delete(key, dest)
+	}
 
 	if nlri, ok := dest.nlri.(*bgp.EVPNNLRI); ok {
 		if macadv, ok := nlri.RouteTypeData.(*bgp.EVPNMacIPAdvertisementRoute); ok {
@@ -294,7 +312,7 @@ func (t *Table) GetLongerPrefixDestinations(key string) ([]*Destination, error)
 			results = append(results, v.(*Destination))
 			return true
 		})
-	case bgp.RF_IPv4_VPN, bgp.RF_IPv6_VPN:
+	case bgp.RF_IPv4_VPN, bgp.RF_IPv6_VPN, bgp.RF_CT_IPv4, bgp.RF_CT_IPv6:
 		prefixRd, _, network, err := bgp.ParseVPNPrefix(key)
 		if err != nil {
 			return nil, err
@@ -309,6 +327,10 @@ func (t *Table) GetLongerPrefixDestinations(key string) ([]*Destination, error)
 				dstRD = dst.nlri.(*bgp.LabeledVPNIPAddrPrefix).RD
 			case bgp.RF_IPv6_VPN:
 				dstRD = dst.nlri.(*bgp.LabeledVPNIPv6AddrPrefix).RD
+			case bgp.RF_CT_IPv4:
+				dstRD = dst.nlri.(*bgp.CTIPAddrPrefix).RD
+			case bgp.RF_CT_IPv6:
+				dstRD = dst.nlri.(*bgp.CTIPv6AddrPrefix).RD
 			}
 
 			if prefixRd.String() != dstRD.String() {
@@ -363,6 +385,12 @@ func (t *Table) GetEvpnDestinationsWithRouteType(typ string) ([]*Destination, er
 		routeType = bgp.EVPN_ETHERNET_SEGMENT_ROUTE
 	case "prefix":
 		routeType = bgp.EVPN_IP_PREFIX
+	case "smet":
+		routeType = bgp.EVPN_SELECTIVE_MULTICAST_ETHERNET_TAG
+	case "join-sync":
+		routeType = bgp.EVPN_MULTICAST_JOIN_SYNCH
+	case "leave-sync":
+		routeType = bgp.EVPN_MULTICAST_LEAVE_SYNCH
 	default:
 		return nil, fmt.Errorf("unsupported evpn route type: %s", typ)
 	}
@@ -420,9 +448,73 @@ func (t *Table) GetMUPDestinationsWithRouteType(p string) ([]*Destination, error
 	return results, nil
 }
 
+// GetMVPNDestinationsWithRouteType returns the destinations of the MCAST-VPN
+// route type or the route key.
+func (t *Table) GetMVPNDestinationsWithRouteType(p string) ([]*Destination, error) {
+	var routeType uint8
+	switch strings.ToLower(p) {
+	case "intra-as-i-pmsi-ad":
+		routeType = bgp.MVPN_ROUTE_TYPE_INTRA_AS_I_PMSI_AD
+	case "inter-as-i-pmsi-ad":
+		routeType = bgp.MVPN_ROUTE_TYPE_INTER_AS_I_PMSI_AD
+	case "s-pmsi-ad":
+		routeType = bgp.MVPN_ROUTE_TYPE_S_PMSI_AD
+	case "leaf-ad":
+		routeType = bgp.MVPN_ROUTE_TYPE_LEAF_AD
+	case "source-active-ad":
+		routeType = bgp.MVPN_ROUTE_TYPE_SOURCE_ACTIVE_AD
+	case "shared-tree-join":
+		routeType = bgp.MVPN_ROUTE_TYPE_SHARED_TREE_JOIN
+	case "source-tree-join":
+		routeType = bgp.MVPN_ROUTE_TYPE_SOURCE_TREE_JOIN
+	default:
+		// use prefix as route key
+	}
+	destinations := t.GetDestinations()
+	results := make([]*Destination, 0, len(destinations))
+	for _, dst := range destinations {
+		if nlri, ok := dst.nlri.(*bgp.MVPNNLRI); !ok {
+			return nil, fmt.Errorf("invalid mvpn nlri type detected: %T", dst.nlri)
+		} else if nlri.RouteType == routeType || nlri.String() == p {
+			results = append(results, dst)
+		}
+	}
+	return results, nil
+}
+
+// GetCARDestinations returns the destinations of the CAR routes of the
+// "<prefix> color <color>" key, or of all the colors if the color is
+// omitted.
+func (t *Table) GetCARDestinations(p string) ([]*Destination, error) {
+	key := p
+	if length, addr, color, err := bgp.ParseCARPrefix(p); err == nil {
+		afi, _ := bgp.RouteFamilyToAfiSafi(t.routeFamily)
+		key = bgp.NewCARColorAwareRoute(afi, length, addr.String(), color).String()
+	} else if _, _, err := net.ParseCIDR(p); err != nil {
+		return nil, err
+	}
+	destinations := t.GetDestinations()
+	results := make([]*Destination, 0, len(destinations))
+	for _, dst := range destinations {
+		nlri, ok := dst.nlri.(*bgp.CARNLRI)
+		if !ok {
+			return nil, fmt.Errorf("invalid car nlri type: %T", dst.nlri)
+		}
+		if nlri.String() == key {
+			results = append(results, dst)
+		} else if k, ok := nlri.Key.(*bgp.CARColorAwareRouteKey); ok && fmt.Sprintf("%s/%d", k.Prefix, k.Length) == p {
+			results = append(results, dst)
+		}
+	}
+	return results, nil
+}
+
 func (t *Table) setDestination(dst *Destination) {
 	tableKey := t.tableKey(dst.nlri)
 	t.destinations[tableKey] = dst
+	if t.flowSpec != nil {
+		t.flowSpec.set(tableKey, dst)
+	}
 
 	if nlri, ok := dst.nlri.(*bgp.EVPNNLRI); ok {
 		if macadv, ok := nlri.RouteTypeData.(*bgp.EVPNMacIPAdvertisementRoute); ok {
@@ -486,6 +578,17 @@ func (t *Table) Bests(id string, as uint32) []*Path {
 	return paths
 }
 
+func (t *Table) OptimalBests(cost IGPCostFunc) []*Path {
+	paths := make([]*Path, 0, len(t.destinations))
+	for _, dst := range t.destinations {
+		path := dst.GetOptimalBestPath(cost)
+		if path != nil {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
 func (t *Table) MultiBests(id string) [][]*Path {
 	paths := make([][]*Path, 0, len(t.destinations))
 	for _, dst := range t.destinations {
@@ -548,6 +651,9 @@ func (t *Table) Select(option ...TableSelectOption) (*Table, error) {
 		destinations: make(map[string]*Destination),
 		macIndex:     make(map[string]map[string]struct{}),
 	}
+	if t.flowSpec != nil {
+		r.flowSpec = &flowSpecIndex{}
+	}
 
 	if len(prefixes) != 0 {
 		switch t.routeFamily {
@@ -619,16 +725,10 @@ func (t *Table) Select(option ...TableSelectOption) (*Table, error) {
 					}
 				}
 			}
-		case bgp.RF_IPv4_VPN, bgp.RF_IPv6_VPN:
+		case bgp.RF_IPv4_VPN, bgp.RF_IPv6_VPN, bgp.RF_CT_IPv4, bgp.RF_CT_IPv6:
 			f := func(prefixStr string) error {
-				var nlri bgp.AddrPrefixInterface
-				var err error
-
-				if t.routeFamily == bgp.RF_IPv4_VPN {
-					nlri, err = bgp.NewPrefixFromRouteFamily(bgp.AFI_IP, bgp.SAFI_MPLS_VPN, prefixStr)
-				} else {
-					nlri, err = bgp.NewPrefixFromRouteFamily(bgp.AFI_IP6, bgp.SAFI_MPLS_VPN, prefixStr)
-				}
+				afi, safi := bgp.RouteFamilyToAfiSafi(t.routeFamily)
+				nlri, err := bgp.NewPrefixFromRouteFamily(afi, safi, prefixStr)
 				if err != nil {
 					return fmt.Errorf("failed to create prefix: %w", err)
 				}
@@ -746,6 +846,30 @@ func (t *Table) Select(option ...TableSelectOption) (*Table, error) {
 					}
 				}
 			}
+		case bgp.RF_MVPN_IPv4, bgp.RF_MVPN_IPv6:
+			for _, p := range prefixes {
+				ds, err := t.GetMVPNDestinationsWithRouteType(p.Prefix)
+				if err != nil {
+					return nil, err
+				}
+				for _, dst := range ds {
+					if d := dst.Select(dOption); d != nil {
+						r.setDestination(d)
+					}
+				}
+			}
+		case bgp.RF_CAR_IPv4, bgp.RF_CAR_IPv6:
+			for _, p := range prefixes {
+				ds, err := t.GetCARDestinations(p.Prefix)
+				if err != nil {
+					return nil, err
+				}
+				for _, dst := range ds {
+					if d := dst.Select(dOption); d != nil {
+						r.setDestination(d)
+					}
+				}
+			}
 		default:
 			return nil, fmt.Errorf("route filtering is not supported for this family")
 		}
@@ -811,3 +935,79 @@ func (t *Table) Info(option ...TableInfoOptions) *TableInfo {
 		NumPath:        numP,
 	}
 }
+
+// TablePeerSummary is the contribution of a peer to the table.
+type TablePeerSummary struct {
+	NumPath int
+	NumBest int
+}
+
+// TableSummary is the breakdown of the paths in the table.
+type TableSummary struct {
+	NumDestination int
+	NumPath        int
+	// the number of the distinct sets of the path attributes as received
+	NumAttributeSet int
+	// the estimated bytes of the destinations, the paths and the path
+	// attributes
+	Memory uint64
+	// keyed by the addresses of the peers, "local" for the local paths
+	Peers map[string]*TablePeerSummary
+}
+
+// Summary returns the breakdown of the paths in the global RIB.
+func (t *Table) Summary() *TableSummary {
+	s := &TableSummary{
+		Peers: make(map[string]*TablePeerSummary),
+	}
+	sets := make(map[uint32]struct{})
+	for _, d := range t.destinations {
+		paths := d.GetKnownPathList(GLOBAL_RIB_NAME, 0)
+		if len(paths) == 0 {
+			continue
+		}
+		s.NumDestination++
+		s.Memory += uint64(unsafe.Sizeof(Destination{})) + uint64(d.GetNlri().Len())
+		best := d.GetBestPath(GLOBAL_RIB_NAME, 0)
+		for _, p := range paths {
+			s.NumPath++
+			s.Memory += uint64(unsafe.Sizeof(Path{}) + unsafe.Sizeof(originInfo{}))
+			key := "local"
+			if !p.IsLocal() {
+				key = p.GetSource().Address.String()
+			}
+			peer, y := s.Peers[key]
+			if !y {
+				peer = &TablePeerSummary{}
+				s.Peers[key] = peer
+			}
+			peer.NumPath++
+			if p == best {
+				peer.NumBest++
+			}
+
+			hash := p.GetHash()
+			attrs := p.GetPathAttrs()
+			if hash == 0 {
+				// the paths not received from the peers
+				b := make([]byte, 0)
+				for _, a := range attrs {
+					if a.GetType() == bgp.BGP_ATTR_TYPE_MP_REACH_NLRI {
+						continue
+					}
+					buf, _ := a.Serialize()
+					b = append(b, buf...)
+				}
+				hash = farm.Hash32(b)
+			}
+			if _, y := sets[hash]; !y {
+				sets[hash] = struct{}{}
+				for _, a := range attrs {
+					s.Memory += uint64(a.Len())
+				}
+			}
+		}
+	}
+	s.NumAttributeSet = len(sets)
+	return s
+}
@@ -19,6 +19,7 @@ import (
 	"bytes"
 	"fmt"
 	"net"
+	"sync"
 	"time"
 
 	farm "github.com/dgryski/go-farm"
@@ -208,6 +209,136 @@ func (manager *TableManager) Update(newPath *Path) []*Update {
 	return updates
 }
 
+// UpdateBatch is Update for the paths in pathList. The best paths of the
+// different destinations are computed concurrently by up to workers
+// goroutines, each of which handles the destinations with the same hash
+// of the prefix. The paths for the same destination are processed in
+// their order in pathList so the result is the same as calling Update for
+// each path in order. The i-th element of the result is the updates for
+// pathList[i].
+func (manager *TableManager) UpdateBatch(pathList []*Path, workers int) [][]*Update {
+	updates := make([][]*Update, len(pathList))
+	if workers > len(pathList) {
+		workers = len(pathList)
+	}
+	serial := workers < 2
+	for _, path := range pathList {
+		// the MAC mobility handling looks up the other destinations
+		if path != nil && path.GetRouteFamily() == bgp.RF_EVPN {
+			serial = true
+			break
+		}
+	}
+	if serial {
+		for i, path := range pathList {
+			updates[i] = manager.Update(path)
+		}
+		return updates
+	}
+
+	type batchDest struct {
+		table *Table
+		// in the table before the batch
+		orig *Destination
+		// nil if deleted by the last path
+		dest  *Destination
+		paths []int
+	}
+	dests := make(map[*Table]map[string]*batchDest)
+	shards := make([][]*batchDest, workers)
+	for i, path := range pathList {
+		if path == nil || path.IsEOR() {
+			continue
+		}
+		t, ok := manager.Tables[path.GetRouteFamily()]
+		if !ok {
+			continue
+		}
+		t.validatePath(path)
+		if _, ok := dests[t]; !ok {
+			dests[t] = make(map[string]*batchDest)
+		}
+		key := t.tableKey(path.GetNlri())
+		d, ok := dests[t][key]
+		if !ok {
+			dest := t.getOrCreateDest(path.GetNlri(), 64)
+			d = &batchDest{table: t, orig: dest, dest: dest}
+			dests[t][key] = d
+			shard := farm.Hash32([]byte(key)) % uint32(workers)
+			shards[shard] = append(shards[shard], d)
+		}
+		d.paths = append(d.paths, i)
+	}
+
+	// the table maps are modified only by this goroutine, the workers
+	// touch only their own destinations.
+	var wg sync.WaitGroup
+	for _, shard := range shards {
+		if len(shard) == 0 {
+			continue
+		}
+		wg.Add(1)
+		go func(shard []*batchDest) {
+			defer wg.Done()
+			for _, d := range shard {
+				for _, i := range d.paths {
+					if d.dest == nil {
+						// Update would create it again
+						d.dest = NewDestination(pathList[i].GetNlri(), 64)
+					}
+					updates[i] = []*Update{d.dest.Calculate(manager.logger, pathList[i])}
+					if len(d.dest.knownPathList) == 0 && canDeleteDest(d.dest) {
+						d.dest = nil
+					}
+				}
+			}
+		}(shard)
+	}
+	wg.Wait()
+
+	for _, shard := range shards {
+		for _, d := range shard {
+			if d.dest == nil {
+				d.table.deleteDest(d.orig)
+			} else if d.dest != d.orig {
+				d.table.setDestination(d.dest)
+			}
+		}
+	}
+	return updates
+}
+
+// WithdrawBatch is UpdateBatch for the withdrawals in pathList, which
+// merges the updates per destination. Each of the returned updates holds
+// the known paths of a destination before and after all the withdrawals
+// to the destination, and the returned path of the same index is the
+// last of the withdrawals.
+func (manager *TableManager) WithdrawBatch(pathList []*Path, workers int) ([]*Path, []*Update) {
+	paths := make([]*Path, 0, len(pathList))
+	updates := make([]*Update, 0, len(pathList))
+	index := make(map[*Table]map[string]int)
+	for i, u := range manager.UpdateBatch(pathList, workers) {
+		if len(u) == 0 {
+			continue
+		}
+		path := pathList[i]
+		t := manager.Tables[path.GetRouteFamily()]
+		if _, ok := index[t]; !ok {
+			index[t] = make(map[string]int)
+		}
+		key := t.tableKey(path.GetNlri())
+		if j, ok := index[t][key]; ok {
+			paths[j] = path
+			updates[j].KnownPathList = u[0].KnownPathList
+			continue
+		}
+		index[t][key] = len(updates)
+		paths = append(paths, path)
+		updates = append(updates, u[0])
+	}
+	return paths, updates
+}
+
 // EVPN MAC MOBILITY HANDLING
 //
 // RFC7432 15. MAC Mobility
@@ -232,13 +363,9 @@ func (manager *TableManager) handleMacMobility(path *Path) []*Path {
 	f := func(p *Path) (bgp.EthernetSegmentIdentifier, uint32, net.HardwareAddr, int, net.IP) {
 		nlri := p.GetNlri().(*bgp.EVPNNLRI)
 		d := nlri.RouteTypeData.(*bgp.EVPNMacIPAdvertisementRoute)
-		ecs := p.GetExtCommunities()
 		seq := -1
-		for _, ec := range ecs {
-			if t, st := ec.GetTypes(); t == bgp.EC_TYPE_EVPN && st == bgp.EC_SUBTYPE_MAC_MOBILITY {
-				seq = int(ec.(*bgp.MacMobilityExtended).Sequence)
-				break
-			}
+		if m := p.GetMacMobility(); m != nil {
+			seq = int(m.Sequence)
 		}
 		return d.ESI, d.ETag, d.MacAddress, seq, p.GetSource().Address
 	}
@@ -304,8 +431,21 @@ func (manager *TableManager) GetBestPathList(id string, as uint32, rfList []bgp.
 	return paths
 }
 
+// GetOptimalBestPathList returns the best paths in the global RIB from the
+// point of view of the vantage point given by cost.
+func (manager *TableManager) GetOptimalBestPathList(rfList []bgp.RouteFamily, cost IGPCostFunc) []*Path {
+	if SelectionOptions.DisableBestPathSelection {
+		return nil
+	}
+	paths := make([]*Path, 0, manager.getDestinationCount(rfList))
+	for _, t := range manager.tables(rfList...) {
+		paths = append(paths, t.OptimalBests(cost)...)
+	}
+	return paths
+}
+
 func (manager *TableManager) GetBestMultiPathList(id string, rfList []bgp.RouteFamily) [][]*Path {
-	if !UseMultiplePaths.Enabled || SelectionOptions.DisableBestPathSelection {
+	if !MultiPathEnabled() || SelectionOptions.DisableBestPathSelection {
 		// Note: If multi path not enabled or best path selection disabled,
 		// there is no best multi path.
 		return nil
@@ -372,3 +512,83 @@ func (manager *TableManager) GetDestination(path *Path) *Destination {
 	}
 	return t.GetDestination(path.GetNlri())
 }
+
+func flowSpecDestinationPrefix(nlri bgp.AddrPrefixInterface) (bgp.RouteFamily, bgp.AddrPrefixInterface) {
+	switch n := nlri.(type) {
+	case *bgp.FlowSpecIPv4Unicast:
+		for _, v := range n.Value {
+			if p, ok := v.(*bgp.FlowSpecDestinationPrefix); ok {
+				return bgp.RF_IPv4_UC, p.Prefix
+			}
+		}
+		return bgp.RF_IPv4_UC, nil
+	case *bgp.FlowSpecIPv6Unicast:
+		for _, v := range n.Value {
+			if p, ok := v.(*bgp.FlowSpecDestinationPrefix6); ok {
+				return bgp.RF_IPv6_UC, p.Prefix
+			}
+		}
+		return bgp.RF_IPv6_UC, nil
+	}
+	return bgp.RouteFamily(0), nil
+}
+
+// IsFlowSpecFeasible validates a flowspec route against the unicast routes
+// in the table as described in RFC 8955 6. The route is feasible if
+//
+//	a) it has a destination prefix component,
+//	b) it is received from the same neighbor as the best-match unicast
+//	   route for the destination prefix, and
+//	c) no more-specific unicast routes than the destination prefix are
+//	   received from a neighbor AS other than the one of the best-match
+//	   unicast route.
+//
+// Routes of other families are always feasible.
+func (manager *TableManager) IsFlowSpecFeasible(path *Path) bool {
+	family, dst := flowSpecDestinationPrefix(path.GetNlri())
+	if family == bgp.RouteFamily(0) {
+		return true
+	}
+	if dst == nil {
+		return false
+	}
+	t, ok := manager.Tables[family]
+	if !ok {
+		return false
+	}
+	_, prefix, err := net.ParseCIDR(dst.String())
+	if err != nil {
+		return false
+	}
+	afi, _ := bgp.RouteFamilyToAfiSafi(family)
+	ones, bits := prefix.Mask.Size()
+
+	var best *Path
+	for i := ones; i >= 0 && best == nil; i-- {
+		p := &net.IPNet{
+			IP:   prefix.IP.Mask(net.CIDRMask(i, bits)),
+			Mask: net.CIDRMask(i, bits),
+		}
+		nlri, err := bgp.NewPrefixFromRouteFamily(afi, bgp.SAFI_UNICAST, p.String())
+		if err != nil {
+			return false
+		}
+		if d := t.GetDestination(nlri); d != nil {
+			best = d.GetBestPath(GLOBAL_RIB_NAME, 0)
+		}
+	}
+	if best == nil || best.IsLocal() || !best.GetSource().Address.Equal(path.GetSource().Address) {
+		return false
+	}
+
+	dsts, err := t.GetLongerPrefixDestinations(prefix.String())
+	if err != nil {
+		return false
+	}
+	for _, d := range dsts {
+		if p := d.GetBestPath(GLOBAL_RIB_NAME, 0); p != nil && !p.IsLocal() && p.GetSource().AS != best.GetSource().AS {
+			return false
+		}
+	}
+	return true
+}
@@ -16,8 +16,9 @@
 package table
 
 import (
-	_ "fmt"
+	"fmt"
 	"net"
+	"runtime"
 	"testing"
 	"time"
 
@@ -2283,3 +2284,244 @@ func update_fromR2viaR1_ipv6() *bgp.BGPMessage {
 	return bgp.NewBGPUpdateMessage(nil, pathAttributes, nil)
 
 }
+
+func TestIsFlowSpecFeasible(t *testing.T) {
+	assert := assert.New(t)
+	tm := NewTableManager(logger, []bgp.RouteFamily{bgp.RF_IPv4_UC, bgp.RF_FS_IPv4_UC})
+
+	peer1 := peerR2()
+	peer2 := &PeerInfo{
+		AS:      65200,
+		LocalAS: 65000,
+		Address: net.ParseIP("10.0.0.4").To4(),
+	}
+	unicast := func(source *PeerInfo, prefix string, length uint8) {
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			createAsPathAttribute([]uint32{source.AS}),
+			bgp.NewPathAttributeNextHop(source.Address.String()),
+		}
+		tm.Update(NewPath(source, bgp.NewIPAddrPrefix(length, prefix), false, attrs, time.Now(), false))
+	}
+	flowspec := func(source *PeerInfo, dst *bgp.IPAddrPrefix) *Path {
+		var v []bgp.FlowSpecComponentInterface
+		if dst != nil {
+			v = append(v, bgp.NewFlowSpecDestinationPrefix(dst))
+		}
+		v = append(v, bgp.NewFlowSpecComponent(bgp.FLOW_SPEC_TYPE_IP_PROTO, []*bgp.FlowSpecComponentItem{bgp.NewFlowSpecComponentItem(bgp.DEC_NUM_OP_EQ, 6)}))
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			createAsPathAttribute([]uint32{source.AS}),
+			createMpReach("0.0.0.0", []bgp.AddrPrefixInterface{bgp.NewFlowSpecIPv4Unicast(v)}),
+		}
+		return NewPath(source, bgp.NewFlowSpecIPv4Unicast(v), false, attrs, time.Now(), false)
+	}
+
+	unicast(peer1, "10.0.0.0", 8)
+
+	// received from the neighbor of the best-match unicast route
+	assert.True(tm.IsFlowSpecFeasible(flowspec(peer1, bgp.NewIPAddrPrefix(16, "10.1.0.0"))))
+	// received from another neighbor
+	assert.False(tm.IsFlowSpecFeasible(flowspec(peer2, bgp.NewIPAddrPrefix(16, "10.1.0.0"))))
+	// no destination prefix component
+	assert.False(tm.IsFlowSpecFeasible(flowspec(peer1, nil)))
+	// no unicast route for the destination prefix
+	assert.False(tm.IsFlowSpecFeasible(flowspec(peer1, bgp.NewIPAddrPrefix(24, "192.168.0.0"))))
+
+	// more-specific unicast route from another neighbor AS
+	unicast(peer2, "10.1.1.0", 24)
+	assert.False(tm.IsFlowSpecFeasible(flowspec(peer1, bgp.NewIPAddrPrefix(16, "10.1.0.0"))))
+	assert.True(tm.IsFlowSpecFeasible(flowspec(peer1, bgp.NewIPAddrPrefix(16, "10.2.0.0"))))
+	assert.True(tm.IsFlowSpecFeasible(flowspec(peer2, bgp.NewIPAddrPrefix(32, "10.1.1.1"))))
+}
+
+func updateBatchTestPaths(prefixes int) []*Path {
+	attrs := func(localPref uint32) []bgp.PathAttributeInterface {
+		return []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint32{65100})}),
+			bgp.NewPathAttributeNextHop("10.0.0.1"),
+			bgp.NewPathAttributeLocalPref(localPref),
+		}
+	}
+	nlri := func(i int) bgp.AddrPrefixInterface {
+		return bgp.NewIPAddrPrefix(24, fmt.Sprintf("10.%d.%d.0", i/256, i%256))
+	}
+	now := time.Now()
+	l := make([]*Path, 0, prefixes*4)
+	for i := 0; i < prefixes; i++ {
+		l = append(l, NewPath(peerR1(), nlri(i), false, attrs(100), now, false))
+	}
+	for i := 0; i < prefixes; i++ {
+		l = append(l, NewPath(peerR2(), nlri(i), false, attrs(200), now, false))
+	}
+	for i := 0; i < prefixes; i++ {
+		switch i % 4 {
+		case 1:
+			// the best path is withdrawn
+			l = append(l, NewPath(peerR2(), nlri(i), true, attrs(200), now, false))
+		case 2:
+			// the destination is deleted and created again
+			l = append(l, NewPath(peerR1(), nlri(i), true, attrs(100), now, false))
+			l = append(l, NewPath(peerR2(), nlri(i), true, attrs(200), now, false))
+			l = append(l, NewPath(peerR3(), nlri(i), false, attrs(150), now, false))
+		case 3:
+			// implicit withdrawal
+			l = append(l, NewPath(peerR1(), nlri(i), false, attrs(300), now, false))
+		}
+	}
+	return l
+}
+
+func TestUpdateBatch(t *testing.T) {
+	assert := assert.New(t)
+
+	paths := updateBatchTestPaths(256)
+	serial := NewTableManager(logger, []bgp.RouteFamily{bgp.RF_IPv4_UC})
+	expected := make([][]*Update, 0, len(paths))
+	for _, path := range paths {
+		expected = append(expected, serial.Update(path))
+	}
+
+	paths = updateBatchTestPaths(256)
+	batch := NewTableManager(logger, []bgp.RouteFamily{bgp.RF_IPv4_UC})
+	// the paths for the same destination span the batches
+	updates := batch.UpdateBatch(paths[:300], 4)
+	updates = append(updates, batch.UpdateBatch(paths[300:], 4)...)
+
+	pathKey := func(p *Path) string {
+		if p == nil {
+			return ""
+		}
+		return fmt.Sprintf("%s %s %d %v", p.GetNlri(), p.GetSource().Address, p.GetNlri().PathLocalIdentifier(), p.IsWithdraw)
+	}
+	pathKeys := func(l []*Path) []string {
+		keys := make([]string, 0, len(l))
+		for _, p := range l {
+			keys = append(keys, pathKey(p))
+		}
+		return keys
+	}
+	assert.Equal(len(expected), len(updates))
+	for i := range expected {
+		if !assert.Equal(len(expected[i]), len(updates[i])) {
+			continue
+		}
+		for j := range expected[i] {
+			assert.Equal(pathKeys(expected[i][j].KnownPathList), pathKeys(updates[i][j].KnownPathList))
+			assert.Equal(pathKeys(expected[i][j].OldKnownPathList), pathKeys(updates[i][j].OldKnownPathList))
+			b1, o1, _ := expected[i][j].GetChanges(GLOBAL_RIB_NAME, 0, false)
+			b2, o2, _ := updates[i][j].GetChanges(GLOBAL_RIB_NAME, 0, false)
+			assert.Equal(pathKey(b1), pathKey(b2))
+			assert.Equal(pathKey(o1), pathKey(o2))
+		}
+	}
+
+	d1 := serial.Tables[bgp.RF_IPv4_UC].GetDestinations()
+	d2 := batch.Tables[bgp.RF_IPv4_UC].GetDestinations()
+	assert.Equal(len(d1), len(d2))
+	for key, dst := range d1 {
+		if assert.Contains(d2, key) {
+			assert.Equal(pathKeys(dst.knownPathList), pathKeys(d2[key].knownPathList))
+		}
+	}
+}
+
+func TestWithdrawBatch(t *testing.T) {
+	assert := assert.New(t)
+
+	attrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeNextHop("10.0.0.1"),
+	}
+	path := func(peer *PeerInfo, i int, id uint32, withdraw bool) *Path {
+		nlri := bgp.NewIPAddrPrefix(24, fmt.Sprintf("10.%d.%d.0", i/256, i%256))
+		nlri.SetPathIdentifier(id)
+		p := NewPath(peer, nlri, withdraw, attrs, time.Now(), false)
+		// dropped from the adj-rib-in at the peer down
+		p.SetDropped(withdraw)
+		return p
+	}
+
+	manager := NewTableManager(logger, []bgp.RouteFamily{bgp.RF_IPv4_UC})
+	withdrawals := make([]*Path, 0, 512)
+	for i := 0; i < 256; i++ {
+		// two paths from peerR1 with add-path
+		manager.Update(path(peerR1(), i, 1, false))
+		manager.Update(path(peerR1(), i, 2, false))
+		if i%2 == 0 {
+			manager.Update(path(peerR2(), i, 0, false))
+		}
+		withdrawals = append(withdrawals, path(peerR1(), i, 1, true), path(peerR1(), i, 2, true))
+	}
+
+	paths, updates := manager.WithdrawBatch(withdrawals, 4)
+	assert.Equal(256, len(paths))
+	assert.Equal(256, len(updates))
+	for i, u := range updates {
+		// the last withdrawal for the destination
+		assert.Equal(withdrawals[2*i+1], paths[i])
+		assert.Equal(2, len(u.GetWithdrawnPath()))
+		if i%2 == 0 {
+			assert.Equal(3, len(u.OldKnownPathList))
+			if assert.Equal(1, len(u.KnownPathList)) {
+				assert.True(u.KnownPathList[0].GetSource().Equal(peerR2()))
+			}
+		} else {
+			assert.Equal(2, len(u.OldKnownPathList))
+			assert.Equal(0, len(u.KnownPathList))
+		}
+	}
+	assert.Equal(128, len(manager.Tables[bgp.RF_IPv4_UC].GetDestinations()))
+}
+
+func benchmarkUpdateBatch(b *testing.B, workers int) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		paths := updateBatchTestPaths(65536)
+		manager := NewTableManager(logger, []bgp.RouteFamily{bgp.RF_IPv4_UC})
+		b.StartTimer()
+		for j := 0; j < len(paths); j += 4096 {
+			end := j + 4096
+			if end > len(paths) {
+				end = len(paths)
+			}
+			manager.UpdateBatch(paths[j:end], workers)
+		}
+	}
+}
+
+func BenchmarkUpdateBatchSerial(b *testing.B) {
+	benchmarkUpdateBatch(b, 1)
+}
+
+func BenchmarkUpdateBatchParallel(b *testing.B) {
+	benchmarkUpdateBatch(b, runtime.GOMAXPROCS(0))
+}
+
+func TestDeleteVrfWithDefaultRouteTarget(t *testing.T) {
+	assert := assert.New(t)
+	tm := NewTableManager(logger, []bgp.RouteFamily{bgp.RF_IPv4_VPN, bgp.RF_RTC_UC})
+
+	rd, _ := bgp.ParseRouteDistinguisher("65000:1")
+	rt, _ := bgp.ParseRouteTarget("65000:1")
+	local := &PeerInfo{AS: 65000, LocalID: net.ParseIP("10.0.0.1")}
+	paths, err := tm.AddVrf("vrf1", 1, rd, []bgp.ExtendedCommunityInterface{rt}, []bgp.ExtendedCommunityInterface{rt}, local)
+	assert.NoError(err)
+	assert.Len(paths, 1)
+	tm.Update(paths[0])
+
+	// the default route target received from the peer
+	peer := &PeerInfo{AS: 65001, Address: net.ParseIP("10.0.0.2")}
+	nlri := bgp.NewRouteTargetMembershipNLRI(65001, nil)
+	tm.Update(NewPath(peer, nlri, false, []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeMpReachNLRI("10.0.0.2", []bgp.AddrPrefixInterface{nlri}),
+	}, time.Now(), false))
+
+	paths, err = tm.DeleteVrf("vrf1")
+	assert.NoError(err)
+	assert.Len(paths, 1)
+	assert.True(paths[0].IsWithdraw)
+	assert.True(SameRouteTarget(rt, paths[0].GetNlri().(*bgp.RouteTargetMembershipNLRI).RouteTarget))
+}
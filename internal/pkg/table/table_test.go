@@ -16,6 +16,7 @@
 package table
 
 import (
+	"net"
 	"testing"
 	"time"
 
@@ -473,3 +474,42 @@ func updateMsgT3() *bgp.BGPMessage {
 	withdrawnRoutes := []*bgp.IPAddrPrefix{w1}
 	return bgp.NewBGPUpdateMessage(withdrawnRoutes, pathAttributes, nlri)
 }
+
+func TestTableSummary(t *testing.T) {
+	assert := assert.New(t)
+	peer1 := &PeerInfo{AS: 1, Address: net.IP{1, 1, 1, 1}}
+	peer2 := &PeerInfo{AS: 2, Address: net.IP{2, 2, 2, 2}}
+	attrs := func(as uint32) []bgp.PathAttributeInterface {
+		return []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint32{as})}),
+		}
+	}
+
+	tm := NewTableManager(logger, []bgp.RouteFamily{bgp.RF_IPv4_UC})
+	// the paths from peer1 with the same attributes
+	for _, prefix := range []string{"10.0.1.0", "10.0.2.0"} {
+		p := NewPath(peer1, bgp.NewIPAddrPrefix(24, prefix), false, attrs(1), time.Now(), false)
+		p.SetHash(1)
+		tm.Update(p)
+	}
+	// the longer AS_PATH than peer1
+	p := NewPath(peer2, bgp.NewIPAddrPrefix(24, "10.0.1.0"), false, []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint32{2, 3})}),
+	}, time.Now(), false)
+	p.SetHash(2)
+	tm.Update(p)
+	tm.Update(NewPath(&PeerInfo{}, bgp.NewIPAddrPrefix(24, "10.0.3.0"), false, attrs(0), time.Now(), false))
+
+	s := tm.Tables[bgp.RF_IPv4_UC].Summary()
+	assert.Equal(3, s.NumDestination)
+	assert.Equal(4, s.NumPath)
+	assert.Equal(3, s.NumAttributeSet)
+	assert.NotZero(s.Memory)
+	assert.Equal(map[string]*TablePeerSummary{
+		"1.1.1.1": {NumPath: 2, NumBest: 2},
+		"2.2.2.2": {NumPath: 1, NumBest: 0},
+		"local":   {NumPath: 1, NumBest: 1},
+	}, s.Peers)
+}
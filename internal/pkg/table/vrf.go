@@ -16,6 +16,9 @@
 package table
 
 import (
+	"bytes"
+	"net"
+
 	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
 )
 
@@ -26,6 +29,10 @@ type Vrf struct {
 	ImportRt  []bgp.ExtendedCommunityInterface
 	ExportRt  []bgp.ExtendedCommunityInterface
 	MplsLabel uint32
+	SRv6SID   *bgp.SRv6SID
+	// the BGP Identifier toward the neighbors in the VRF, nil for the
+	// global router ID
+	RouterId net.IP
 }
 
 func (v *Vrf) Clone() *Vrf {
@@ -40,16 +47,94 @@ func (v *Vrf) Clone() *Vrf {
 		ImportRt:  f(v.ImportRt),
 		ExportRt:  f(v.ExportRt),
 		MplsLabel: v.MplsLabel,
+		SRv6SID:   v.SRv6SID,
+		RouterId:  v.RouterId,
+	}
+}
+
+// isSource returns true if the VPN path carries the route distinguisher of
+// the VRF.
+func (v *Vrf) isSource(path *Path) bool {
+	var rd bgp.RouteDistinguisherInterface
+	switch n := path.GetNlri().(type) {
+	case *bgp.LabeledVPNIPAddrPrefix:
+		rd = n.RD
+	case *bgp.LabeledVPNIPv6AddrPrefix:
+		rd = n.RD
+	}
+	return rd != nil && v.Rd != nil && rd.String() == v.Rd.String()
+}
+
+// vpnLabel returns the label carried in the VPN NLRI exported from the VRF,
+// and the Prefix SID attribute to be attached if the SRv6 SID is allocated.
+func (v *Vrf) vpnLabel() (uint32, *bgp.PathAttributePrefixSID) {
+	if v.SRv6SID == nil {
+		return v.MplsLabel, nil
 	}
+	attr, label := v.SRv6SID.PrefixSID(bgp.TLVTypeSRv6L3Service)
+	return label, attr
 }
 
 func isLastTargetUser(vrfs map[string]*Vrf, target bgp.ExtendedCommunityInterface) bool {
 	for _, vrf := range vrfs {
 		for _, rt := range vrf.ImportRt {
-			if target.String() == rt.String() {
+			if SameRouteTarget(target, rt) {
 				return false
 			}
 		}
 	}
 	return true
 }
+
+// IsRouteTargetConstrained returns whether the advertisement of the paths
+// of the family is constrained by the Route Target membership
+// (RFC 4684), that is, the family of the VPN paths with Route Targets.
+func IsRouteTargetConstrained(family bgp.RouteFamily) bool {
+	switch family {
+	case bgp.RF_IPv4_VPN, bgp.RF_IPv6_VPN, bgp.RF_IPv4_VPN_MC, bgp.RF_IPv6_VPN_MC,
+		bgp.RF_VPLS, bgp.RF_EVPN, bgp.RF_FS_IPv4_VPN, bgp.RF_FS_IPv6_VPN, bgp.RF_FS_L2_VPN:
+		return true
+	}
+	return false
+}
+
+func isRouteTarget(ec bgp.ExtendedCommunityInterface) bool {
+	typ, subtype := ec.GetTypes()
+	switch typ {
+	case bgp.EC_TYPE_TRANSITIVE_TWO_OCTET_AS_SPECIFIC, bgp.EC_TYPE_TRANSITIVE_IP4_SPECIFIC, bgp.EC_TYPE_TRANSITIVE_FOUR_OCTET_AS_SPECIFIC:
+		return subtype == bgp.EC_SUBTYPE_ROUTE_TARGET
+	}
+	return false
+}
+
+// SameRouteTarget returns whether the Route Targets of the Route Target
+// membership NLRIs are the same, where nil is the default Route Target.
+func SameRouteTarget(lhs, rhs bgp.ExtendedCommunityInterface) bool {
+	if lhs == nil || rhs == nil {
+		return lhs == nil && rhs == nil
+	}
+	l, err := lhs.Serialize()
+	if err != nil {
+		return false
+	}
+	r, err := rhs.Serialize()
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(l, r)
+}
+
+// MatchRouteTarget returns whether the path has the Route Target of the
+// Route Target membership NLRI. The default one without the Route Target
+// matches all the paths, including the ones without Route Targets.
+func MatchRouteTarget(nlri *bgp.RouteTargetMembershipNLRI, path *Path) bool {
+	if nlri.RouteTarget == nil {
+		return true
+	}
+	for _, ec := range path.GetExtCommunities() {
+		if isRouteTarget(ec) && SameRouteTarget(ec, nlri.RouteTarget) {
+			return true
+		}
+	}
+	return false
+}
@@ -19,6 +19,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
 	"net"
 	"net/netip"
 
@@ -143,7 +144,7 @@ func UnmarshalAttribute(an *apb.Any) (bgp.PathAttributeInterface, error) {
 			}
 			id = bgp.NewIngressReplTunnelID(ip.String())
 		default:
-			id = bgp.NewDefaultPmsiTunnelID(a.Id)
+			id = bgp.ParsePmsiTunnelID(typ, a.Id)
 		}
 		return bgp.NewPathAttributePmsiTunnel(typ, isLeafInfoRequired, a.Label, id), nil
 	case *api.TunnelEncapAttribute:
@@ -167,6 +168,23 @@ func UnmarshalAttribute(an *apb.Any) (bgp.PathAttributeInterface, error) {
 					subTlv = bgp.NewTunnelEncapSubTLVEgressEndpoint(sv.Address)
 				case *api.TunnelEncapSubTLVUDPDestPort:
 					subTlv = bgp.NewTunnelEncapSubTLVUDPDestPort(uint16(sv.Port))
+				case *api.TunnelEncapSubTLVDSField:
+					subTlv = bgp.NewTunnelEncapSubTLVDSField(uint8(sv.DsField))
+				case *api.TunnelEncapSubTLVEmbeddedLabelHandling:
+					subTlv = bgp.NewTunnelEncapSubTLVEmbeddedLabelHandling(uint8(sv.Handling))
+				case *api.TunnelEncapSubTLVMPLSLabelStack:
+					entries := make([]bgp.MPLSLabelStackEntry, 0, len(sv.Entries))
+					for _, e := range sv.Entries {
+						entries = append(entries, bgp.MPLSLabelStackEntry{
+							Label:         e.Label,
+							TrafficClass:  uint8(e.TrafficClass),
+							BottomOfStack: e.BottomOfStack,
+							TTL:           uint8(e.Ttl),
+						})
+					}
+					subTlv = bgp.NewTunnelEncapSubTLVMPLSLabelStack(entries)
+				case *api.TunnelEncapSubTLVPrefixSID:
+					subTlv = bgp.NewTunnelEncapSubTLVPrefixSID(uint16(sv.Flags), sv.LabelIndex)
 				case *api.TunnelEncapSubTLVSRPreference:
 					subTlv = bgp.NewTunnelEncapSubTLVSRPreference(sv.Flags, sv.Preference)
 				case *api.TunnelEncapSubTLVSRPriority:
@@ -548,6 +566,14 @@ func NewEthernetSegmentIdentifierFromNative(a *bgp.EthernetSegmentIdentifier) (*
 	}, nil
 }
 
+// multicastSourceString returns the empty string for any source.
+func multicastSourceString(ip net.IP) string {
+	if ip == nil {
+		return ""
+	}
+	return ip.String()
+}
+
 func unmarshalESI(a *api.EthernetSegmentIdentifier) (*bgp.EthernetSegmentIdentifier, error) {
 	return &bgp.EthernetSegmentIdentifier{
 		Type:  bgp.ESIType(a.Type),
@@ -786,6 +812,29 @@ func MarshalLsPrefixV6NLRI(n *bgp.LsPrefixV6NLRI) (*apb.Any, error) {
 	return a, nil
 }
 
+func MarshalLsSrPolicyCandidatePathNLRI(n *bgp.LsSrPolicyCandidatePathNLRI) (*apb.Any, error) {
+	ln, err := MarshalLsNodeDescriptor(n.LocalNodeDesc.(*bgp.LsTLVNodeDescriptor).Extract())
+	if err != nil {
+		return nil, err
+	}
+	cp := n.CandidatePathDesc.(*bgp.LsTLVSrPolicyCandidatePathDescriptor).Extract()
+
+	nlri := &api.LsSrPolicyCandidatePathNLRI{
+		LocalNode: ln,
+		CandidatePathDescriptor: &api.LsSrPolicyCandidatePathDescriptor{
+			ProtocolOrigin:    uint32(cp.ProtocolOrigin),
+			Endpoint:          cp.Endpoint.String(),
+			Color:             cp.Color,
+			OriginatorAsn:     cp.OriginatorASN,
+			OriginatorAddress: cp.OriginatorAddress.String(),
+			Discriminator:     cp.Discriminator,
+		},
+	}
+	a, _ := apb.New(nlri)
+
+	return a, nil
+}
+
 func MarshalLsBgpPeerSegmentSid(n *bgp.LsBgpPeerSegmentSID) (*api.LsBgpPeerSegmentSID, error) {
 	flags := &api.LsBgpPeerSegmentSIDFlags{
 		Value:      n.Flags.Value,
@@ -818,6 +867,117 @@ func UnmarshalLsBgpPeerSegmentSid(a *api.LsBgpPeerSegmentSID) (*bgp.LsBgpPeerSeg
 	return sid, nil
 }
 
+func MarshalLsAttributeSrPolicy(n *bgp.LsAttributeSrPolicy) *api.LsAttributeSrPolicy {
+	a := &api.LsAttributeSrPolicy{
+		CandidatePathName: stringOrDefault(n.CandidatePathName),
+		PolicyName:        stringOrDefault(n.PolicyName),
+	}
+	if n.BindingSID != nil {
+		a.BindingSid = &api.LsSrBindingSID{
+			Flags: &api.LsSrBindingSIDFlags{
+				Srv6:        n.BindingSID.Flags.SRv6,
+				Allocated:   n.BindingSID.Flags.Allocated,
+				Unavailable: n.BindingSID.Flags.Unavailable,
+				Local:       n.BindingSID.Flags.Local,
+				Fallback:    n.BindingSID.Flags.Fallback,
+			},
+			BindingSid:          n.BindingSID.BindingSID,
+			SpecifiedBindingSid: n.BindingSID.SpecifiedBindingSID,
+		}
+	}
+	if n.CandidatePathState != nil {
+		a.CandidatePathState = &api.LsSrCandidatePathState{
+			Priority: uint32(n.CandidatePathState.Priority),
+			Flags: &api.LsSrCandidatePathStateFlags{
+				Shutdown:  n.CandidatePathState.Flags.Shutdown,
+				Active:    n.CandidatePathState.Flags.Active,
+				Backup:    n.CandidatePathState.Flags.Backup,
+				Evaluated: n.CandidatePathState.Flags.Evaluated,
+				Valid:     n.CandidatePathState.Flags.Valid,
+				Delegated: n.CandidatePathState.Flags.Delegated,
+			},
+			Preference: n.CandidatePathState.Preference,
+		}
+	}
+	for _, l := range n.SegmentLists {
+		segmentList := &api.LsSrSegmentList{
+			Flags:     uint32(l.Flags),
+			Mtid:      uint32(l.MTID),
+			Algorithm: uint32(l.Algorithm),
+			Weight:    l.Weight,
+		}
+		for _, seg := range l.Segments {
+			segmentList.Segments = append(segmentList.Segments, &api.LsSrSegment{
+				Type:              uint32(seg.Type),
+				Flags:             uint32(seg.Flags),
+				Sid:               seg.SID,
+				SegmentDescriptor: seg.Descriptor,
+			})
+		}
+		a.SegmentLists = append(a.SegmentLists, segmentList)
+	}
+	return a
+}
+
+func UnmarshalLsAttributeSrPolicy(a *api.LsAttributeSrPolicy) *bgp.LsAttributeSrPolicy {
+	n := &bgp.LsAttributeSrPolicy{}
+	if a.BindingSid != nil {
+		n.BindingSID = &bgp.LsSrBindingSID{
+			BindingSID:          a.BindingSid.BindingSid,
+			SpecifiedBindingSID: a.BindingSid.SpecifiedBindingSid,
+		}
+		if f := a.BindingSid.Flags; f != nil {
+			n.BindingSID.Flags = bgp.LsSrBindingSIDFlags{
+				SRv6:        f.Srv6,
+				Allocated:   f.Allocated,
+				Unavailable: f.Unavailable,
+				Local:       f.Local,
+				Fallback:    f.Fallback,
+			}
+		}
+	}
+	if a.CandidatePathState != nil {
+		n.CandidatePathState = &bgp.LsSrCandidatePathState{
+			Priority:   uint8(a.CandidatePathState.Priority),
+			Preference: a.CandidatePathState.Preference,
+		}
+		if f := a.CandidatePathState.Flags; f != nil {
+			n.CandidatePathState.Flags = bgp.LsSrCandidatePathStateFlags{
+				Shutdown:  f.Shutdown,
+				Active:    f.Active,
+				Backup:    f.Backup,
+				Evaluated: f.Evaluated,
+				Valid:     f.Valid,
+				Delegated: f.Delegated,
+			}
+		}
+	}
+	if a.CandidatePathName != "" {
+		n.CandidatePathName = &a.CandidatePathName
+	}
+	if a.PolicyName != "" {
+		n.PolicyName = &a.PolicyName
+	}
+	for _, l := range a.SegmentLists {
+		segmentList := &bgp.LsSrSegmentList{
+			Flags:     uint16(l.Flags),
+			MTID:      uint16(l.Mtid),
+			Algorithm: uint8(l.Algorithm),
+			Weight:    l.Weight,
+		}
+		for _, seg := range l.Segments {
+			segmentList.Segments = append(segmentList.Segments, bgp.LsSrSegment{
+				Type:       uint8(seg.Type),
+				Flags:      uint16(seg.Flags),
+				SID:        seg.Sid,
+				Descriptor: seg.SegmentDescriptor,
+			})
+		}
+		n.SegmentLists = append(n.SegmentLists, segmentList)
+	}
+	return n
+}
+
 func UnmarshalLsNodeDescriptor(nd *api.LsNodeDescriptor) (*bgp.LsNodeDescriptor, error) {
 	return &bgp.LsNodeDescriptor{
 		Asn:                    nd.Asn,
@@ -1045,6 +1205,11 @@ func UnmarshalLsAttribute(a *api.LsAttribute) (*bgp.LsAttribute, error) {
 		lsAttr.BgpPeerSegment = lsAttributeBgpPeerSegment
 	}
 
+	// For AttributeSrPolicy
+	if a.SrPolicy != nil {
+		lsAttr.SrPolicy = UnmarshalLsAttributeSrPolicy(a.SrPolicy)
+	}
+
 	return lsAttr, nil
 }
 
@@ -1172,6 +1337,57 @@ func MarshalNLRI(value bgp.AddrPrefixInterface) (*apb.Any, error) {
 				Label:       r.Label,
 				GwAddress:   r.GWIPAddress.String(),
 			}
+		case *bgp.EVPNSelectiveMulticastEthernetTagRoute:
+			rd, err := MarshalRD(r.RD)
+			if err != nil {
+				return nil, err
+			}
+			nlri = &api.EVPNSelectiveMulticastEthernetTagRoute{
+				Rd:                rd,
+				EthernetTag:       r.ETag,
+				SourceAddress:     multicastSourceString(r.SourceAddress),
+				GroupAddress:      r.GroupAddress.String(),
+				OriginatorAddress: r.OriginatorAddress.String(),
+				Flags:             uint32(r.Flags),
+			}
+		case *bgp.EVPNMulticastJoinSynchRoute:
+			rd, err := MarshalRD(r.RD)
+			if err != nil {
+				return nil, err
+			}
+			esi, err := NewEthernetSegmentIdentifierFromNative(&r.ESI)
+			if err != nil {
+				return nil, err
+			}
+			nlri = &api.EVPNMulticastJoinSynchRoute{
+				Rd:                rd,
+				Esi:               esi,
+				EthernetTag:       r.ETag,
+				SourceAddress:     multicastSourceString(r.SourceAddress),
+				GroupAddress:      r.GroupAddress.String(),
+				OriginatorAddress: r.OriginatorAddress.String(),
+				Flags:             uint32(r.Flags),
+			}
+		case *bgp.EVPNMulticastLeaveSynchRoute:
+			rd, err := MarshalRD(r.RD)
+			if err != nil {
+				return nil, err
+			}
+			esi, err := NewEthernetSegmentIdentifierFromNative(&r.ESI)
+			if err != nil {
+				return nil, err
+			}
+			nlri = &api.EVPNMulticastLeaveSynchRoute{
+				Rd:                rd,
+				Esi:               esi,
+				EthernetTag:       r.ETag,
+				SourceAddress:     multicastSourceString(r.SourceAddress),
+				GroupAddress:      r.GroupAddress.String(),
+				OriginatorAddress: r.OriginatorAddress.String(),
+				SyncNumber:        r.SyncNumber,
+				MaxResponseTime:   uint32(r.MaxResponseTime),
+				Flags:             uint32(r.Flags),
+			}
 		}
 	case *bgp.LabeledVPNIPAddrPrefix:
 		rd, err := MarshalRD(v.RD)
@@ -1195,6 +1411,28 @@ func MarshalNLRI(value bgp.AddrPrefixInterface) (*apb.Any, error) {
 			PrefixLen: uint32(v.IPPrefixLen()),
 			Prefix:    v.Prefix.String(),
 		}
+	case *bgp.CTIPAddrPrefix:
+		rd, err := MarshalRD(v.RD)
+		if err != nil {
+			return nil, err
+		}
+		nlri = &api.LabeledVPNIPAddressPrefix{
+			Labels:    v.Labels.Labels,
+			Rd:        rd,
+			PrefixLen: uint32(v.IPPrefixLen()),
+			Prefix:    v.Prefix.String(),
+		}
+	case *bgp.CTIPv6AddrPrefix:
+		rd, err := MarshalRD(v.RD)
+		if err != nil {
+			return nil, err
+		}
+		nlri = &api.LabeledVPNIPAddressPrefix{
+			Labels:    v.Labels.Labels,
+			Rd:        rd,
+			PrefixLen: uint32(v.IPPrefixLen()),
+			Prefix:    v.Prefix.String(),
+		}
 	case *bgp.RouteTargetMembershipNLRI:
 		rt, err := MarshalRT(v.RouteTarget)
 		if err != nil {
@@ -1312,17 +1550,30 @@ func MarshalNLRI(value bgp.AddrPrefixInterface) (*apb.Any, error) {
 				ProtocolId: api.LsProtocolID(n.ProtocolID),
 				Identifier: n.Identifier,
 			}
+
+		case *bgp.LsSrPolicyCandidatePathNLRI:
+			node, err := MarshalLsSrPolicyCandidatePathNLRI(n)
+			if err != nil {
+				return nil, err
+			}
+			nlri = &api.LsAddrPrefix{
+				Type:       api.LsNLRIType_LS_NLRI_SR_POLICY_CANDIDATE_PATH,
+				Nlri:       node,
+				Length:     uint32(n.Length),
+				ProtocolId: api.LsProtocolID(n.ProtocolID),
+				Identifier: n.Identifier,
+			}
 		}
 	case *bgp.SRPolicyIPv4:
 		nlri = &api.SRPolicyNLRI{
-			Length:        uint32(v.Length),
+			Length:        uint32(v.Length) * 8,
 			Distinguisher: v.Distinguisher,
 			Color:         v.Color,
 			Endpoint:      v.Endpoint,
 		}
 	case *bgp.SRPolicyIPv6:
 		nlri = &api.SRPolicyNLRI{
-			Length:        uint32(v.Length),
+			Length:        uint32(v.Length) * 8,
 			Distinguisher: v.Distinguisher,
 			Color:         v.Color,
 			Endpoint:      v.Endpoint,
@@ -1381,12 +1632,298 @@ func MarshalNLRI(value bgp.AddrPrefixInterface) (*apb.Any, error) {
 			}
 			nlri = ar
 		}
+	case *bgp.MVPNNLRI:
+		switch r := v.RouteTypeData.(type) {
+		case *bgp.MVPNIntraASIPMSIADRoute:
+			rd, err := MarshalRD(r.RD)
+			if err != nil {
+				return nil, err
+			}
+			nlri = &api.MVPNIntraASIPMSIADRoute{
+				Rd:                rd,
+				OriginatorAddress: r.OriginatorAddress.String(),
+			}
+		case *bgp.MVPNInterASIPMSIADRoute:
+			rd, err := MarshalRD(r.RD)
+			if err != nil {
+				return nil, err
+			}
+			nlri = &api.MVPNInterASIPMSIADRoute{
+				Rd:       rd,
+				SourceAs: r.SourceAS,
+			}
+		case *bgp.MVPNSPMSIADRoute:
+			rd, err := MarshalRD(r.RD)
+			if err != nil {
+				return nil, err
+			}
+			nlri = &api.MVPNSPMSIADRoute{
+				Rd:                rd,
+				SourceAddress:     mvpnAddrString(r.SourceAddress),
+				GroupAddress:      mvpnAddrString(r.GroupAddress),
+				OriginatorAddress: r.OriginatorAddress.String(),
+			}
+		case *bgp.MVPNLeafADRoute:
+			key, err := MarshalNLRI(r.RouteKey)
+			if err != nil {
+				return nil, err
+			}
+			nlri = &api.MVPNLeafADRoute{
+				RouteKey:          key,
+				OriginatorAddress: r.OriginatorAddress.String(),
+			}
+		case *bgp.MVPNSourceActiveADRoute:
+			rd, err := MarshalRD(r.RD)
+			if err != nil {
+				return nil, err
+			}
+			nlri = &api.MVPNSourceActiveADRoute{
+				Rd:            rd,
+				SourceAddress: r.SourceAddress.String(),
+				GroupAddress:  r.GroupAddress.String(),
+			}
+		case *bgp.MVPNSharedTreeJoinRoute:
+			rd, err := MarshalRD(r.RD)
+			if err != nil {
+				return nil, err
+			}
+			nlri = &api.MVPNSharedTreeJoinRoute{
+				Rd:           rd,
+				SourceAs:     r.SourceAS,
+				RpAddress:    mvpnAddrString(r.SourceAddress),
+				GroupAddress: mvpnAddrString(r.GroupAddress),
+			}
+		case *bgp.MVPNSourceTreeJoinRoute:
+			rd, err := MarshalRD(r.RD)
+			if err != nil {
+				return nil, err
+			}
+			nlri = &api.MVPNSourceTreeJoinRoute{
+				Rd:            rd,
+				SourceAs:      r.SourceAS,
+				SourceAddress: mvpnAddrString(r.SourceAddress),
+				GroupAddress:  mvpnAddrString(r.GroupAddress),
+			}
+		}
+	case *bgp.CARNLRI:
+		tlvs, err := marshalCARTLVs(v.TLVs)
+		if err != nil {
+			return nil, err
+		}
+		switch k := v.Key.(type) {
+		case *bgp.CARColorAwareRouteKey:
+			nlri = &api.CARColorAwareRoute{
+				Prefix:    k.Prefix.String(),
+				PrefixLen: uint32(k.Length),
+				Color:     k.Color,
+				Tlvs:      tlvs,
+			}
+		case *bgp.CARUnknownKey:
+			nlri = &api.CARUnknownRoute{
+				Type: uint32(v.NLRIType),
+				Key:  k.Value,
+				Tlvs: tlvs,
+			}
+		}
 	}
 
 	an, _ := apb.New(nlri)
 	return an, nil
 }
 
+func marshalCARTLVs(tlvs []bgp.CARTLVInterface) ([]*apb.Any, error) {
+	l := make([]*apb.Any, 0, len(tlvs))
+	for _, tlv := range tlvs {
+		var m proto.Message
+		switch t := tlv.(type) {
+		case *bgp.CARLabelTLV:
+			m = &api.CARLabelTLV{
+				Labels: t.Labels,
+			}
+		case *bgp.CARLabelIndexTLV:
+			m = &api.CARLabelIndexTLV{
+				Flags:      uint32(t.Flags),
+				LabelIndex: t.LabelIndex,
+			}
+		case *bgp.CARSRv6SIDTLV:
+			m = &api.CARSRv6SIDTLV{
+				Sid: t.SID.String(),
+			}
+		case *bgp.CARUnknownTLV:
+			m = &api.CARUnknownTLV{
+				Type:  uint32(t.TLVType),
+				Value: t.Value,
+			}
+		default:
+			return nil, fmt.Errorf("invalid car tlv type: %T", tlv)
+		}
+		a, err := apb.New(m)
+		if err != nil {
+			return nil, err
+		}
+		l = append(l, a)
+	}
+	return l, nil
+}
+
+func unmarshalCARTLVs(values []*apb.Any) ([]bgp.CARTLVInterface, error) {
+	l := make([]bgp.CARTLVInterface, 0, len(values))
+	for _, an := range values {
+		value, err := an.UnmarshalNew()
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal car tlv: %s", err)
+		}
+		switch v := value.(type) {
+		case *api.CARLabelTLV:
+			if len(v.Labels) == 0 {
+				return nil, fmt.Errorf("empty car label tlv")
+			}
+			l = append(l, &bgp.CARLabelTLV{Labels: v.Labels})
+		case *api.CARLabelIndexTLV:
+			if v.Flags > math.MaxUint16 {
+				return nil, fmt.Errorf("invalid car label index flags: %d", v.Flags)
+			}
+			l = append(l, &bgp.CARLabelIndexTLV{Flags: uint16(v.Flags), LabelIndex: v.LabelIndex})
+		case *api.CARSRv6SIDTLV:
+			sid := net.ParseIP(v.Sid)
+			if sid == nil || sid.To4() != nil {
+				return nil, fmt.Errorf("invalid srv6 sid: %s", v.Sid)
+			}
+			l = append(l, &bgp.CARSRv6SIDTLV{SID: sid})
+		case *api.CARUnknownTLV:
+			if v.Type > math.MaxUint8 {
+				return nil, fmt.Errorf("invalid car tlv type: %d", v.Type)
+			}
+			l = append(l, &bgp.CARUnknownTLV{TLVType: bgp.CARTLVType(v.Type), Value: v.Value})
+		default:
+			return nil, fmt.Errorf("invalid car tlv type: %T", v)
+		}
+	}
+	return l, nil
+}
+
+// mvpnAddrString returns the empty string for the wildcard.
+func mvpnAddrString(addr netip.Addr) string {
+	if !addr.IsValid() {
+		return ""
+	}
+	return addr.String()
+}
+
+// parseMVPNAddr returns the wildcard for the empty string.
+func parseMVPNAddr(s string) (netip.Addr, error) {
+	if s == "" {
+		return netip.Addr{}, nil
+	}
+	return netip.ParseAddr(s)
+}
+
+func unmarshalMVPNNLRI(rf bgp.RouteFamily, value proto.Message) (*bgp.MVPNNLRI, error) {
+	afi, safi := bgp.RouteFamilyToAfiSafi(rf)
+	if safi != bgp.SAFI_MCAST_VPN {
+		return nil, nil
+	}
+	switch v := value.(type) {
+	case *api.MVPNIntraASIPMSIADRoute:
+		rd, err := UnmarshalRD(v.Rd)
+		if err != nil {
+			return nil, err
+		}
+		originator, err := netip.ParseAddr(v.OriginatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		return bgp.NewMVPNIntraASIPMSIADRoute(afi, rd, originator), nil
+	case *api.MVPNInterASIPMSIADRoute:
+		rd, err := UnmarshalRD(v.Rd)
+		if err != nil {
+			return nil, err
+		}
+		return bgp.NewMVPNInterASIPMSIADRoute(afi, rd, v.SourceAs), nil
+	case *api.MVPNSPMSIADRoute:
+		rd, err := UnmarshalRD(v.Rd)
+		if err != nil {
+			return nil, err
+		}
+		source, err := parseMVPNAddr(v.SourceAddress)
+		if err != nil {
+			return nil, err
+		}
+		group, err := parseMVPNAddr(v.GroupAddress)
+		if err != nil {
+			return nil, err
+		}
+		originator, err := netip.ParseAddr(v.OriginatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		return bgp.NewMVPNSPMSIADRoute(afi, rd, source, group, originator), nil
+	case *api.MVPNLeafADRoute:
+		if v.RouteKey == nil {
+			return nil, fmt.Errorf("leaf a-d route has no route key")
+		}
+		k, err := v.RouteKey.UnmarshalNew()
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal route key: %s", err)
+		}
+		key, err := unmarshalMVPNNLRI(rf, k)
+		if err != nil {
+			return nil, err
+		}
+		if key == nil {
+			return nil, fmt.Errorf("invalid route key type: %T", k)
+		}
+		originator, err := netip.ParseAddr(v.OriginatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		return bgp.NewMVPNLeafADRoute(afi, key, originator), nil
+	case *api.MVPNSourceActiveADRoute:
+		rd, err := UnmarshalRD(v.Rd)
+		if err != nil {
+			return nil, err
+		}
+		source, err := netip.ParseAddr(v.SourceAddress)
+		if err != nil {
+			return nil, err
+		}
+		group, err := netip.ParseAddr(v.GroupAddress)
+		if err != nil {
+			return nil, err
+		}
+		return bgp.NewMVPNSourceActiveADRoute(afi, rd, source, group), nil
+	case *api.MVPNSharedTreeJoinRoute:
+		rd, err := UnmarshalRD(v.Rd)
+		if err != nil {
+			return nil, err
+		}
+		rp, err := netip.ParseAddr(v.RpAddress)
+		if err != nil {
+			return nil, err
+		}
+		group, err := parseMVPNAddr(v.GroupAddress)
+		if err != nil {
+			return nil, err
+		}
+		return bgp.NewMVPNSharedTreeJoinRoute(afi, rd, v.SourceAs, rp, group), nil
+	case *api.MVPNSourceTreeJoinRoute:
+		rd, err := UnmarshalRD(v.Rd)
+		if err != nil {
+			return nil, err
+		}
+		source, err := parseMVPNAddr(v.SourceAddress)
+		if err != nil {
+			return nil, err
+		}
+		group, err := parseMVPNAddr(v.GroupAddress)
+		if err != nil {
+			return nil, err
+		}
+		return bgp.NewMVPNSourceTreeJoinRoute(afi, rd, v.SourceAs, source, group), nil
+	}
+	return nil, nil
+}
+
 func MarshalNLRIs(values []bgp.AddrPrefixInterface) ([]*apb.Any, error) {
 	nlris := make([]*apb.Any, 0, len(values))
 	for _, value := range values {
@@ -1498,6 +2035,38 @@ func UnmarshalNLRI(rf bgp.RouteFamily, an *apb.Any) (bgp.AddrPrefixInterface, er
 			}
 			nlri = bgp.NewEVPNIPPrefixRoute(rd, *esi, v.EthernetTag, uint8(v.IpPrefixLen), v.IpPrefix, v.GwAddress, v.Label)
 		}
+	case *api.EVPNSelectiveMulticastEthernetTagRoute:
+		if rf == bgp.RF_EVPN {
+			rd, err := UnmarshalRD(v.Rd)
+			if err != nil {
+				return nil, err
+			}
+			nlri = bgp.NewEVPNSelectiveMulticastEthernetTagRoute(rd, v.EthernetTag, v.SourceAddress, v.GroupAddress, v.OriginatorAddress, uint8(v.Flags))
+		}
+	case *api.EVPNMulticastJoinSynchRoute:
+		if rf == bgp.RF_EVPN {
+			rd, err := UnmarshalRD(v.Rd)
+			if err != nil {
+				return nil, err
+			}
+			esi, err := unmarshalESI(v.Esi)
+			if err != nil {
+				return nil, err
+			}
+			nlri = bgp.NewEVPNMulticastJoinSynchRoute(rd, *esi, v.EthernetTag, v.SourceAddress, v.GroupAddress, v.OriginatorAddress, uint8(v.Flags))
+		}
+	case *api.EVPNMulticastLeaveSynchRoute:
+		if rf == bgp.RF_EVPN {
+			rd, err := UnmarshalRD(v.Rd)
+			if err != nil {
+				return nil, err
+			}
+			esi, err := unmarshalESI(v.Esi)
+			if err != nil {
+				return nil, err
+			}
+			nlri = bgp.NewEVPNMulticastLeaveSynchRoute(rd, *esi, v.EthernetTag, v.SourceAddress, v.GroupAddress, v.OriginatorAddress, v.SyncNumber, uint8(v.MaxResponseTime), uint8(v.Flags))
+		}
 	case *api.SRPolicyNLRI:
 		switch rf {
 		case bgp.RF_SR_POLICY_IPv4:
@@ -1515,6 +2084,10 @@ func UnmarshalNLRI(rf bgp.RouteFamily, an *apb.Any) (bgp.AddrPrefixInterface, er
 			nlri = bgp.NewLabeledVPNIPAddrPrefix(uint8(v.PrefixLen), v.Prefix, *bgp.NewMPLSLabelStack(v.Labels...), rd)
 		case bgp.RF_IPv6_VPN:
 			nlri = bgp.NewLabeledVPNIPv6AddrPrefix(uint8(v.PrefixLen), v.Prefix, *bgp.NewMPLSLabelStack(v.Labels...), rd)
+		case bgp.RF_CT_IPv4:
+			nlri = bgp.NewCTIPAddrPrefix(uint8(v.PrefixLen), v.Prefix, *bgp.NewMPLSLabelStack(v.Labels...), rd)
+		case bgp.RF_CT_IPv6:
+			nlri = bgp.NewCTIPv6AddrPrefix(uint8(v.PrefixLen), v.Prefix, *bgp.NewMPLSLabelStack(v.Labels...), rd)
 		}
 	case *api.RouteTargetMembershipNLRI:
 		rt, err := UnmarshalRT(v.Rt)
@@ -1614,6 +2187,53 @@ func UnmarshalNLRI(rf bgp.RouteFamily, an *apb.Any) (bgp.AddrPrefixInterface, er
 			return nil, fmt.Errorf("invalid teid: %x", v.Teid)
 		}
 		nlri = bgp.NewMUPType2SessionTransformedRoute(rd, uint8(v.EndpointAddressLength), ea, teid)
+	case *api.MVPNIntraASIPMSIADRoute, *api.MVPNInterASIPMSIADRoute, *api.MVPNSPMSIADRoute,
+		*api.MVPNLeafADRoute, *api.MVPNSourceActiveADRoute, *api.MVPNSharedTreeJoinRoute,
+		*api.MVPNSourceTreeJoinRoute:
+		n, err := unmarshalMVPNNLRI(rf, v)
+		if err != nil {
+			return nil, err
+		}
+		if n != nil {
+			nlri = n
+		}
+	case *api.CARColorAwareRoute:
+		afi, safi := bgp.RouteFamilyToAfiSafi(rf)
+		if safi != bgp.SAFI_CAR {
+			break
+		}
+		ip := net.ParseIP(v.Prefix)
+		if ip == nil || (afi == bgp.AFI_IP) != (ip.To4() != nil) {
+			return nil, fmt.Errorf("invalid prefix: %s", v.Prefix)
+		}
+		maxLen := uint32(32)
+		if afi == bgp.AFI_IP6 {
+			maxLen = 128
+		}
+		if v.PrefixLen > maxLen {
+			return nil, fmt.Errorf("invalid prefix length: %d", v.PrefixLen)
+		}
+		tlvs, err := unmarshalCARTLVs(v.Tlvs)
+		if err != nil {
+			return nil, err
+		}
+		nlri = bgp.NewCARColorAwareRoute(afi, uint8(v.PrefixLen), v.Prefix, v.Color, tlvs...)
+	case *api.CARUnknownRoute:
+		afi, safi := bgp.RouteFamilyToAfiSafi(rf)
+		if safi != bgp.SAFI_CAR {
+			break
+		}
+		if v.Type > math.MaxUint8 {
+			return nil, fmt.Errorf("invalid car nlri type: %d", v.Type)
+		}
+		if v.Type == uint32(bgp.CAR_NLRI_TYPE_COLOR_AWARE_ROUTE) {
+			return nil, fmt.Errorf("use CARColorAwareRoute for car nlri type %d", v.Type)
+		}
+		tlvs, err := unmarshalCARTLVs(v.Tlvs)
+		if err != nil {
+			return nil, err
+		}
+		nlri = bgp.NewCARNLRI(afi, uint8(v.Type), &bgp.CARUnknownKey{Value: v.Key}, tlvs)
 	case *api.LsAddrPrefix:
 		unmarshaledNlri, _ := v.Nlri.UnmarshalNew()
 		switch tp := unmarshaledNlri.(type) {
@@ -1726,6 +2346,46 @@ func UnmarshalNLRI(rf bgp.RouteFamily, an *apb.Any) (bgp.AddrPrefixInterface, er
 				},
 			}
 
+		case *api.LsSrPolicyCandidatePathNLRI:
+			lnd, err := UnmarshalLsNodeDescriptor(tp.LocalNode)
+			if err != nil {
+				return nil, err
+			}
+			lndTLV := bgp.NewLsTLVNodeDescriptor(lnd, bgp.LS_TLV_LOCAL_NODE_DESC)
+
+			cpd := tp.CandidatePathDescriptor
+			if cpd == nil {
+				return nil, fmt.Errorf("candidate path descriptor missing")
+			}
+			endpoint := net.ParseIP(cpd.Endpoint)
+			originator := net.ParseIP(cpd.OriginatorAddress)
+			if endpoint == nil || originator == nil {
+				return nil, fmt.Errorf("invalid candidate path descriptor: %v", cpd)
+			}
+			cpdTLV := bgp.NewLsTLVSrPolicyCandidatePathDescriptor(&bgp.LsSrPolicyCandidatePathDescriptor{
+				ProtocolOrigin:    bgp.LsSrPolicyProtocolOrigin(cpd.ProtocolOrigin),
+				Endpoint:          endpoint,
+				Color:             cpd.Color,
+				OriginatorASN:     cpd.OriginatorAsn,
+				OriginatorAddress: originator,
+				Discriminator:     cpd.Discriminator,
+			})
+
+			nlri = &bgp.LsAddrPrefix{
+				Type:   bgp.LS_NLRI_TYPE_SR_POLICY_CANDIDATE_PATH,
+				Length: uint16(v.Length),
+				NLRI: &bgp.LsSrPolicyCandidatePathNLRI{
+					LocalNodeDesc:     &lndTLV,
+					CandidatePathDesc: cpdTLV,
+					LsNLRI: bgp.LsNLRI{
+						NLRIType:   bgp.LsNLRIType(v.Type),
+						Length:     uint16(v.Length),
+						ProtocolID: bgp.LsProtocolID(v.ProtocolId),
+						Identifier: v.Identifier,
+					},
+				},
+			}
+
 		default:
 			return nil, fmt.Errorf("unknown LS prefix type %v", tp)
 		}
@@ -1889,8 +2549,9 @@ func NewExtendedCommunitiesAttributeFromNative(a *bgp.PathAttributeExtendedCommu
 			}
 		case *bgp.LinkBandwidthExtended:
 			community = &api.LinkBandwidthExtended{
-				Asn:       uint32(v.AS),
-				Bandwidth: v.Bandwidth,
+				Asn:          uint32(v.AS),
+				Bandwidth:    v.Bandwidth,
+				IsTransitive: v.IsTransitive,
 			}
 		case *bgp.ColorExtended:
 			community = &api.ColorExtended{
@@ -1925,6 +2586,20 @@ func NewExtendedCommunitiesAttributeFromNative(a *bgp.PathAttributeExtendedCommu
 			community = &api.RouterMacExtended{
 				Mac: v.Mac.String(),
 			}
+		case *bgp.Layer2AttributesExtended:
+			community = &api.Layer2AttributesExtended{
+				CiLabel:     v.HasCILabel,
+				FlowLabel:   v.HasFlowLabel,
+				ControlWord: v.HasControlWord,
+				PrimaryPe:   v.IsPrimaryPe,
+				BackupPe:    v.IsBackupPe,
+				Mtu:         uint32(v.Mtu),
+			}
+		case *bgp.DFElectionExtended:
+			community = &api.DFElectionExtended{
+				Algorithm: uint32(v.Algorithm),
+				Bitmap:    uint32(v.Bitmap),
+			}
 		case *bgp.TrafficRateExtended:
 			community = &api.TrafficRateExtended{
 				Asn:  uint32(v.AS),
@@ -1954,6 +2629,13 @@ func NewExtendedCommunitiesAttributeFromNative(a *bgp.PathAttributeExtendedCommu
 			community = &api.TrafficRemarkExtended{
 				Dscp: uint32(v.DSCP),
 			}
+		case *bgp.InterfaceSetExtended:
+			community = &api.InterfaceSetExtended{
+				Asn:      v.AS,
+				GroupId:  uint32(v.GroupID),
+				Inbound:  v.Inbound,
+				Outbound: v.Outbound,
+			}
 		case *bgp.MUPExtended:
 			community = &api.MUPExtended{
 				SubType:    uint32(v.SubType),
@@ -1965,6 +2647,11 @@ func NewExtendedCommunitiesAttributeFromNative(a *bgp.PathAttributeExtendedCommu
 				ControlFlags: uint32(v.ControlFlags),
 				Mtu:          uint32(v.MTU),
 			}
+		case *bgp.TransportClassExtended:
+			community = &api.TransportClassExtended{
+				TransportClass: v.TransportClass,
+				IsTransitive:   v.IsTransitive,
+			}
 		case *bgp.UnknownExtended:
 			community = &api.UnknownExtended{
 				Type:  uint32(v.Type),
@@ -1999,7 +2686,11 @@ func unmarshalExComm(a *api.ExtendedCommunitiesAttribute) (*bgp.PathAttributeExt
 		case *api.ValidationExtended:
 			community = bgp.NewValidationExtended(bgp.ValidationState(v.State))
 		case *api.LinkBandwidthExtended:
-			community = bgp.NewLinkBandwidthExtended(uint16(v.Asn), v.Bandwidth)
+			if v.IsTransitive {
+				community = bgp.NewTransitiveLinkBandwidthExtended(uint16(v.Asn), v.Bandwidth)
+			} else {
+				community = bgp.NewLinkBandwidthExtended(uint16(v.Asn), v.Bandwidth)
+			}
 		case *api.ColorExtended:
 			community = bgp.NewColorExtended(v.Color)
 		case *api.EncapExtended:
@@ -2016,6 +2707,17 @@ func unmarshalExComm(a *api.ExtendedCommunitiesAttribute) (*bgp.PathAttributeExt
 			community = bgp.NewMacMobilityExtended(v.SequenceNum, v.IsSticky)
 		case *api.RouterMacExtended:
 			community = bgp.NewRoutersMacExtended(v.Mac)
+		case *api.Layer2AttributesExtended:
+			community = &bgp.Layer2AttributesExtended{
+				HasCILabel:     v.CiLabel,
+				HasFlowLabel:   v.FlowLabel,
+				HasControlWord: v.ControlWord,
+				IsPrimaryPe:    v.PrimaryPe,
+				IsBackupPe:     v.BackupPe,
+				Mtu:            uint16(v.Mtu),
+			}
+		case *api.DFElectionExtended:
+			community = bgp.NewDFElectionExtended(bgp.DFAlgorithm(v.Algorithm), uint16(v.Bitmap))
 		case *api.TrafficRateExtended:
 			community = bgp.NewTrafficRateExtended(uint16(v.Asn), v.Rate)
 		case *api.TrafficActionExtended:
@@ -2028,10 +2730,17 @@ func unmarshalExComm(a *api.ExtendedCommunitiesAttribute) (*bgp.PathAttributeExt
 			community = bgp.NewRedirectFourOctetAsSpecificExtended(v.Asn, uint16(v.LocalAdmin))
 		case *api.TrafficRemarkExtended:
 			community = bgp.NewTrafficRemarkExtended(uint8(v.Dscp))
+		case *api.InterfaceSetExtended:
+			community = bgp.NewInterfaceSetExtended(v.Asn, uint16(v.GroupId), v.Inbound, v.Outbound)
 		case *api.MUPExtended:
-			community = bgp.NewMUPExtended(uint16(v.SegmentId2), v.SegmentId4)
+			community = bgp.NewMUPExtendedWithSubType(bgp.ExtendedCommunityAttrSubType(v.SubType), uint16(v.SegmentId2), v.SegmentId4)
 		case *api.VPLSExtended:
 			community = bgp.NewVPLSExtended(uint8(v.ControlFlags), uint16(v.Mtu))
+		case *api.TransportClassExtended:
+			community = &bgp.TransportClassExtended{
+				TransportClass: v.TransportClass,
+				IsTransitive:   v.IsTransitive,
+			}
 		case *api.UnknownExtended:
 			community = bgp.NewUnknownExtended(bgp.ExtendedCommunityAttrType(v.Type), v.Value)
 		}
@@ -2098,13 +2807,43 @@ func NewTunnelEncapAttributeFromNative(a *bgp.PathAttributeTunnelEncap) (*api.Tu
 					Color: sv.Color,
 				}
 			case *bgp.TunnelEncapSubTLVEgressEndpoint:
+				var address string
+				if sv.Address != nil {
+					address = sv.Address.String()
+				}
 				subTlv = &api.TunnelEncapSubTLVEgressEndpoint{
-					Address: sv.Address.String(),
+					Address: address,
 				}
 			case *bgp.TunnelEncapSubTLVUDPDestPort:
 				subTlv = &api.TunnelEncapSubTLVUDPDestPort{
 					Port: uint32(sv.UDPDestPort),
 				}
+			case *bgp.TunnelEncapSubTLVDSField:
+				subTlv = &api.TunnelEncapSubTLVDSField{
+					DsField: uint32(sv.DSField),
+				}
+			case *bgp.TunnelEncapSubTLVEmbeddedLabelHandling:
+				subTlv = &api.TunnelEncapSubTLVEmbeddedLabelHandling{
+					Handling: uint32(sv.Handling),
+				}
+			case *bgp.TunnelEncapSubTLVMPLSLabelStack:
+				entries := make([]*api.MPLSLabelStackEntry, 0, len(sv.Entries))
+				for _, e := range sv.Entries {
+					entries = append(entries, &api.MPLSLabelStackEntry{
+						Label:         e.Label,
+						TrafficClass:  uint32(e.TrafficClass),
+						BottomOfStack: e.BottomOfStack,
+						Ttl:           uint32(e.TTL),
+					})
+				}
+				subTlv = &api.TunnelEncapSubTLVMPLSLabelStack{
+					Entries: entries,
+				}
+			case *bgp.TunnelEncapSubTLVPrefixSID:
+				subTlv = &api.TunnelEncapSubTLVPrefixSID{
+					Flags:      uint32(sv.Flags),
+					LabelIndex: sv.LabelIndex,
+				}
 			case *bgp.TunnelEncapSubTLVUnknown:
 				subTlv = &api.TunnelEncapSubTLVUnknown{
 					Type:  uint32(sv.Type),
@@ -2310,6 +3049,10 @@ func NewLsAttributeFromNative(a *bgp.PathAttributeLs) (*api.LsAttribute, error)
 		BgpPeerSegment: bgpPeerSegment,
 	}
 
+	if attr.SrPolicy != nil {
+		apiAttr.SrPolicy = MarshalLsAttributeSrPolicy(attr.SrPolicy)
+	}
+
 	if attr.Node.Flags != nil {
 		apiAttr.Node.Flags = &api.LsNodeFlags{
 			Overload: attr.Node.Flags.Overload,
@@ -2565,10 +3308,15 @@ func UnmarshalPathAttributes(values []*apb.Any) ([]bgp.PathAttributeInterface, e
 // MarshalSRBSID marshals SR Policy Binding SID Sub TLV structure
 func MarshalSRBSID(bsid *bgp.TunnelEncapSubTLVSRBSID) (*apb.Any, error) {
 	var r proto.Message
-	s := &api.SRBindingSID{
-		Sid: make([]byte, len(bsid.BSID.Value)),
+	s := &api.SRBindingSID{}
+	if bsid.BSID != nil {
+		s.Sid = make([]byte, len(bsid.BSID.Value))
+		copy(s.Sid, bsid.BSID.Value)
+		// the label without the unused bits, as bgp.NewBSID expects
+		if len(s.Sid) == 4 {
+			binary.BigEndian.PutUint32(s.Sid, binary.BigEndian.Uint32(s.Sid)>>12)
+		}
 	}
-	copy(s.Sid, bsid.BSID.Value)
 	s.SFlag = bsid.Flags&0x80 == 0x80
 	s.IFlag = bsid.Flags&0x40 == 0x40
 	r = s
@@ -2595,14 +3343,7 @@ func UnmarshalSRBSID(bsid *apb.Any) (bgp.TunnelEncapSubTLVInterface, error) {
 		if v.IFlag {
 			flags += 0x40
 		}
-		return &bgp.TunnelEncapSubTLVSRBSID{
-			TunnelEncapSubTLV: bgp.TunnelEncapSubTLV{
-				Type:   bgp.ENCAP_SUBTLV_TYPE_SRBINDING_SID,
-				Length: uint16(2 + b.Len()),
-			},
-			BSID:  b,
-			Flags: flags,
-		}, nil
+		return bgp.NewTunnelEncapSubTLVSRBSID(flags, b), nil
 	case *api.SRv6BindingSID:
 		b, err := bgp.NewBSID(v.Sid)
 		if err != nil {
@@ -2633,6 +3374,98 @@ func UnmarshalSRBSID(bsid *apb.Any) (bgp.TunnelEncapSubTLVInterface, error) {
 	}
 }
 
+func marshalSegmentFlags(flags uint8) *api.SegmentFlags {
+	return &api.SegmentFlags{
+		VFlag: flags&0x80 == 0x80,
+		AFlag: flags&0x40 == 0x40,
+		SFlag: flags&0x20 == 0x20,
+		BFlag: flags&0x10 == 0x10,
+	}
+}
+
+func unmarshalSegmentFlags(f *api.SegmentFlags) uint8 {
+	var flags uint8
+	if f.GetVFlag() {
+		flags |= 0x80
+	}
+	if f.GetAFlag() {
+		flags |= 0x40
+	}
+	if f.GetSFlag() {
+		flags |= 0x20
+	}
+	if f.GetBFlag() {
+		flags |= 0x10
+	}
+	return flags
+}
+
+func marshalSRv6EBS(ebs *bgp.SRv6EndpointBehaviorStructure) *api.SRv6EndPointBehavior {
+	if ebs == nil {
+		return nil
+	}
+	return &api.SRv6EndPointBehavior{
+		Behavior: api.SRv6Behavior(ebs.Behavior),
+		BlockLen: uint32(ebs.BlockLen),
+		NodeLen:  uint32(ebs.NodeLen),
+		FuncLen:  uint32(ebs.FuncLen),
+		ArgLen:   uint32(ebs.ArgLen),
+	}
+}
+
+func unmarshalSRv6EBS(ebs *api.SRv6EndPointBehavior) *bgp.SRv6EndpointBehaviorStructure {
+	if ebs == nil {
+		return nil
+	}
+	return &bgp.SRv6EndpointBehaviorStructure{
+		Behavior: bgp.SRBehavior(ebs.Behavior),
+		BlockLen: uint8(ebs.BlockLen),
+		NodeLen:  uint8(ebs.NodeLen),
+		FuncLen:  uint8(ebs.FuncLen),
+		ArgLen:   uint8(ebs.ArgLen),
+	}
+}
+
+func marshalSegmentLabel(label *uint32) uint32 {
+	if label == nil {
+		return 0
+	}
+	return *label
+}
+
+func unmarshalSegmentLabel(label uint32) *uint32 {
+	if label == 0 {
+		return nil
+	}
+	return &label
+}
+
+// unmarshalSegmentSRv6SID returns the optional SRv6 SID and SRv6 Endpoint
+// Behavior and SID Structure of the segments of type I to K.
+func unmarshalSegmentSRv6SID(sid []byte, ebs *api.SRv6EndPointBehavior) ([]byte, *bgp.SRv6EndpointBehaviorStructure, error) {
+	switch len(sid) {
+	case 0:
+		return nil, nil, nil
+	case 16:
+		return sid, unmarshalSRv6EBS(ebs), nil
+	}
+	return nil, nil, fmt.Errorf("invalid SRv6 SID length %d", len(sid))
+}
+
+func parseSegmentAddress(s string, v4 bool) (net.IP, error) {
+	ip := net.ParseIP(s)
+	if v4 {
+		if ip = ip.To4(); ip == nil {
+			return nil, fmt.Errorf("invalid IPv4 address %s of SR Policy Segment", s)
+		}
+		return ip, nil
+	}
+	if ip == nil || ip.To4() != nil {
+		return nil, fmt.Errorf("invalid IPv6 address %s of SR Policy Segment", s)
+	}
+	return ip.To16(), nil
+}
+
 // MarshalSRSegments marshals a slice of SR Policy Segment List
 func MarshalSRSegments(segs []bgp.TunnelEncapSubTLVInterface) ([]*apb.Any, error) {
 	anyList := make([]*apb.Any, 0, len(segs))
@@ -2642,34 +3475,86 @@ func MarshalSRSegments(segs []bgp.TunnelEncapSubTLVInterface) ([]*apb.Any, error
 		case *bgp.SegmentTypeA:
 			r = &api.SegmentTypeA{
 				Label: s.Label,
-				Flags: &api.SegmentFlags{
-					VFlag: s.Flags&0x80 == 0x80,
-					AFlag: s.Flags&0x40 == 0x40,
-					SFlag: s.Flags&0x20 == 0x20,
-					BFlag: s.Flags&0x10 == 0x10,
-				},
+				Flags: marshalSegmentFlags(s.Flags),
 			}
 		case *bgp.SegmentTypeB:
-			flags := &api.SegmentFlags{
-				VFlag: s.Flags&0x80 == 0x80,
-				AFlag: s.Flags&0x40 == 0x40,
-				SFlag: s.Flags&0x20 == 0x20,
-				BFlag: s.Flags&0x10 == 0x10,
-			}
-			segment := &api.SegmentTypeB{
-				Flags: flags,
-				Sid:   s.SID,
-			}
-			if s.SRv6EBS != nil {
-				segment.EndpointBehaviorStructure = &api.SRv6EndPointBehavior{
-					Behavior: api.SRv6Behavior(s.SRv6EBS.Behavior),
-					BlockLen: uint32(s.SRv6EBS.BlockLen),
-					NodeLen:  uint32(s.SRv6EBS.NodeLen),
-					FuncLen:  uint32(s.SRv6EBS.FuncLen),
-					ArgLen:   uint32(s.SRv6EBS.ArgLen),
-				}
+			r = &api.SegmentTypeB{
+				Flags:                     marshalSegmentFlags(s.Flags),
+				Sid:                       s.SID,
+				EndpointBehaviorStructure: marshalSRv6EBS(s.SRv6EBS),
+			}
+		case *bgp.SegmentTypeC:
+			r = &api.SegmentTypeC{
+				Flags:     marshalSegmentFlags(s.Flags),
+				Algorithm: uint32(s.Algorithm),
+				Address:   s.Address.String(),
+				Label:     marshalSegmentLabel(s.Label),
+			}
+		case *bgp.SegmentTypeD:
+			r = &api.SegmentTypeD{
+				Flags:     marshalSegmentFlags(s.Flags),
+				Algorithm: uint32(s.Algorithm),
+				Address:   s.Address.String(),
+				Label:     marshalSegmentLabel(s.Label),
+			}
+		case *bgp.SegmentTypeE:
+			r = &api.SegmentTypeE{
+				Flags:            marshalSegmentFlags(s.Flags),
+				LocalInterfaceId: s.LocalInterfaceID,
+				Address:          s.Address.String(),
+				Label:            marshalSegmentLabel(s.Label),
+			}
+		case *bgp.SegmentTypeF:
+			r = &api.SegmentTypeF{
+				Flags:         marshalSegmentFlags(s.Flags),
+				LocalAddress:  s.LocalAddress.String(),
+				RemoteAddress: s.RemoteAddress.String(),
+				Label:         marshalSegmentLabel(s.Label),
+			}
+		case *bgp.SegmentTypeG:
+			r = &api.SegmentTypeG{
+				Flags:             marshalSegmentFlags(s.Flags),
+				LocalInterfaceId:  s.LocalInterfaceID,
+				LocalAddress:      s.LocalAddress.String(),
+				RemoteInterfaceId: s.RemoteInterfaceID,
+				RemoteAddress:     s.RemoteAddress.String(),
+				Label:             marshalSegmentLabel(s.Label),
+			}
+		case *bgp.SegmentTypeH:
+			r = &api.SegmentTypeH{
+				Flags:         marshalSegmentFlags(s.Flags),
+				LocalAddress:  s.LocalAddress.String(),
+				RemoteAddress: s.RemoteAddress.String(),
+				Label:         marshalSegmentLabel(s.Label),
+			}
+		case *bgp.SegmentTypeI:
+			r = &api.SegmentTypeI{
+				Flags:                     marshalSegmentFlags(s.Flags),
+				Algorithm:                 uint32(s.Algorithm),
+				Address:                   s.Address.String(),
+				Sid:                       s.SID,
+				EndpointBehaviorStructure: marshalSRv6EBS(s.SRv6EBS),
+			}
+		case *bgp.SegmentTypeJ:
+			r = &api.SegmentTypeJ{
+				Flags:                     marshalSegmentFlags(s.Flags),
+				Algorithm:                 uint32(s.Algorithm),
+				LocalInterfaceId:          s.LocalInterfaceID,
+				LocalAddress:              s.LocalAddress.String(),
+				RemoteInterfaceId:         s.RemoteInterfaceID,
+				RemoteAddress:             s.RemoteAddress.String(),
+				Sid:                       s.SID,
+				EndpointBehaviorStructure: marshalSRv6EBS(s.SRv6EBS),
+			}
+		case *bgp.SegmentTypeK:
+			r = &api.SegmentTypeK{
+				Flags:                     marshalSegmentFlags(s.Flags),
+				Algorithm:                 uint32(s.Algorithm),
+				LocalAddress:              s.LocalAddress.String(),
+				RemoteAddress:             s.RemoteAddress.String(),
+				Sid:                       s.SID,
+				EndpointBehaviorStructure: marshalSRv6EBS(s.SRv6EBS),
 			}
-			r = segment
 		default:
 			// Unrecognize Segment type, skip it
 			continue
@@ -2693,57 +3578,179 @@ func UnmarshalSRSegments(s []*apb.Any) ([]bgp.TunnelEncapSubTLVInterface, error)
 		}
 		switch v := value.(type) {
 		case *api.SegmentTypeA:
-			seg := &bgp.SegmentTypeA{
+			segments[i] = &bgp.SegmentTypeA{
 				TunnelEncapSubTLV: bgp.TunnelEncapSubTLV{
 					Type:   bgp.EncapSubTLVType(bgp.TypeA),
 					Length: 6,
 				},
+				Flags: unmarshalSegmentFlags(v.Flags),
 				Label: v.Label,
 			}
-			if v.Flags.VFlag {
-				seg.Flags += 0x80
-			}
-			if v.Flags.AFlag {
-				seg.Flags += 0x40
-			}
-			if v.Flags.SFlag {
-				seg.Flags += 0x20
-			}
-			if v.Flags.BFlag {
-				seg.Flags += 0x10
-			}
-			segments[i] = seg
 		case *api.SegmentTypeB:
 			seg := &bgp.SegmentTypeB{
 				TunnelEncapSubTLV: bgp.TunnelEncapSubTLV{
 					Type:   bgp.EncapSubTLVType(bgp.TypeB),
 					Length: 18,
 				},
-				SID: v.GetSid(),
+				Flags:   unmarshalSegmentFlags(v.Flags),
+				SID:     v.GetSid(),
+				SRv6EBS: unmarshalSRv6EBS(v.EndpointBehaviorStructure),
 			}
-			if v.Flags.VFlag {
-				seg.Flags += 0x80
+			if seg.SRv6EBS != nil {
+				seg.Length = 26
 			}
-			if v.Flags.AFlag {
-				seg.Flags += 0x40
+			segments[i] = seg
+		case *api.SegmentTypeC:
+			address, err := parseSegmentAddress(v.Address, true)
+			if err != nil {
+				return nil, err
 			}
-			if v.Flags.SFlag {
-				seg.Flags += 0x20
+			segments[i] = &bgp.SegmentTypeC{
+				TunnelEncapSubTLV: bgp.TunnelEncapSubTLV{Type: bgp.EncapSubTLVType(bgp.TypeC)},
+				Flags:             unmarshalSegmentFlags(v.Flags),
+				Algorithm:         uint8(v.Algorithm),
+				Address:           address,
+				Label:             unmarshalSegmentLabel(v.Label),
 			}
-			if v.Flags.BFlag {
-				seg.Flags += 0x10
+		case *api.SegmentTypeD:
+			address, err := parseSegmentAddress(v.Address, false)
+			if err != nil {
+				return nil, err
 			}
-			if v.EndpointBehaviorStructure != nil {
-				ebs := v.GetEndpointBehaviorStructure()
-				seg.SRv6EBS = &bgp.SRv6EndpointBehaviorStructure{
-					Behavior: bgp.SRBehavior(ebs.Behavior),
-					BlockLen: uint8(ebs.BlockLen),
-					NodeLen:  uint8(ebs.NodeLen),
-					FuncLen:  uint8(ebs.FuncLen),
-					ArgLen:   uint8(ebs.ArgLen),
-				}
+			segments[i] = &bgp.SegmentTypeD{
+				TunnelEncapSubTLV: bgp.TunnelEncapSubTLV{Type: bgp.EncapSubTLVType(bgp.TypeD)},
+				Flags:             unmarshalSegmentFlags(v.Flags),
+				Algorithm:         uint8(v.Algorithm),
+				Address:           address,
+				Label:             unmarshalSegmentLabel(v.Label),
 			}
-			segments[i] = seg
+		case *api.SegmentTypeE:
+			address, err := parseSegmentAddress(v.Address, true)
+			if err != nil {
+				return nil, err
+			}
+			segments[i] = &bgp.SegmentTypeE{
+				TunnelEncapSubTLV: bgp.TunnelEncapSubTLV{Type: bgp.EncapSubTLVType(bgp.TypeE)},
+				Flags:             unmarshalSegmentFlags(v.Flags),
+				LocalInterfaceID:  v.LocalInterfaceId,
+				Address:           address,
+				Label:             unmarshalSegmentLabel(v.Label),
+			}
+		case *api.SegmentTypeF:
+			local, err := parseSegmentAddress(v.LocalAddress, true)
+			if err != nil {
+				return nil, err
+			}
+			remote, err := parseSegmentAddress(v.RemoteAddress, true)
+			if err != nil {
+				return nil, err
+			}
+			segments[i] = &bgp.SegmentTypeF{
+				TunnelEncapSubTLV: bgp.TunnelEncapSubTLV{Type: bgp.EncapSubTLVType(bgp.TypeF)},
+				Flags:             unmarshalSegmentFlags(v.Flags),
+				LocalAddress:      local,
+				RemoteAddress:     remote,
+				Label:             unmarshalSegmentLabel(v.Label),
+			}
+		case *api.SegmentTypeG:
+			local, err := parseSegmentAddress(v.LocalAddress, false)
+			if err != nil {
+				return nil, err
+			}
+			remote, err := parseSegmentAddress(v.RemoteAddress, false)
+			if err != nil {
+				return nil, err
+			}
+			segments[i] = &bgp.SegmentTypeG{
+				TunnelEncapSubTLV: bgp.TunnelEncapSubTLV{Type: bgp.EncapSubTLVType(bgp.TypeG)},
+				Flags:             unmarshalSegmentFlags(v.Flags),
+				LocalInterfaceID:  v.LocalInterfaceId,
+				LocalAddress:      local,
+				RemoteInterfaceID: v.RemoteInterfaceId,
+				RemoteAddress:     remote,
+				Label:             unmarshalSegmentLabel(v.Label),
+			}
+		case *api.SegmentTypeH:
+			local, err := parseSegmentAddress(v.LocalAddress, false)
+			if err != nil {
+				return nil, err
+			}
+			remote, err := parseSegmentAddress(v.RemoteAddress, false)
+			if err != nil {
+				return nil, err
+			}
+			segments[i] = &bgp.SegmentTypeH{
+				TunnelEncapSubTLV: bgp.TunnelEncapSubTLV{Type: bgp.EncapSubTLVType(bgp.TypeH)},
+				Flags:             unmarshalSegmentFlags(v.Flags),
+				LocalAddress:      local,
+				RemoteAddress:     remote,
+				Label:             unmarshalSegmentLabel(v.Label),
+			}
+		case *api.SegmentTypeI:
+			address, err := parseSegmentAddress(v.Address, false)
+			if err != nil {
+				return nil, err
+			}
+			sid, ebs, err := unmarshalSegmentSRv6SID(v.Sid, v.EndpointBehaviorStructure)
+			if err != nil {
+				return nil, err
+			}
+			segments[i] = &bgp.SegmentTypeI{
+				TunnelEncapSubTLV: bgp.TunnelEncapSubTLV{Type: bgp.EncapSubTLVType(bgp.TypeI)},
+				Flags:             unmarshalSegmentFlags(v.Flags),
+				Algorithm:         uint8(v.Algorithm),
+				Address:           address,
+				SID:               sid,
+				SRv6EBS:           ebs,
+			}
+		case *api.SegmentTypeJ:
+			local, err := parseSegmentAddress(v.LocalAddress, false)
+			if err != nil {
+				return nil, err
+			}
+			remote, err := parseSegmentAddress(v.RemoteAddress, false)
+			if err != nil {
+				return nil, err
+			}
+			sid, ebs, err := unmarshalSegmentSRv6SID(v.Sid, v.EndpointBehaviorStructure)
+			if err != nil {
+				return nil, err
+			}
+			segments[i] = &bgp.SegmentTypeJ{
+				TunnelEncapSubTLV: bgp.TunnelEncapSubTLV{Type: bgp.EncapSubTLVType(bgp.TypeJ)},
+				Flags:             unmarshalSegmentFlags(v.Flags),
+				Algorithm:         uint8(v.Algorithm),
+				LocalInterfaceID:  v.LocalInterfaceId,
+				LocalAddress:      local,
+				RemoteInterfaceID: v.RemoteInterfaceId,
+				RemoteAddress:     remote,
+				SID:               sid,
+				SRv6EBS:           ebs,
+			}
+		case *api.SegmentTypeK:
+			local, err := parseSegmentAddress(v.LocalAddress, false)
+			if err != nil {
+				return nil, err
+			}
+			remote, err := parseSegmentAddress(v.RemoteAddress, false)
+			if err != nil {
+				return nil, err
+			}
+			sid, ebs, err := unmarshalSegmentSRv6SID(v.Sid, v.EndpointBehaviorStructure)
+			if err != nil {
+				return nil, err
+			}
+			segments[i] = &bgp.SegmentTypeK{
+				TunnelEncapSubTLV: bgp.TunnelEncapSubTLV{Type: bgp.EncapSubTLVType(bgp.TypeK)},
+				Flags:             unmarshalSegmentFlags(v.Flags),
+				Algorithm:         uint8(v.Algorithm),
+				LocalAddress:      local,
+				RemoteAddress:     remote,
+				SID:               sid,
+				SRv6EBS:           ebs,
+			}
+		default:
+			return nil, fmt.Errorf("unknown SR Policy Segment type %T", v)
 		}
 	}
 	return segments, nil
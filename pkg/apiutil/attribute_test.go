@@ -742,6 +742,167 @@ func Test_MpReachNLRIAttribute_EVPN_Prefix_Route(t *testing.T) {
 	}
 }
 
+func Test_MpReachNLRIAttribute_EVPN_Multicast_Routes(t *testing.T) {
+	assert := assert.New(t)
+
+	nlris := make([]*apb.Any, 0, 3)
+	rd, err := apb.New(&api.RouteDistinguisherIPAddress{
+		Admin:    "1.1.1.1",
+		Assigned: 100,
+	})
+	assert.Nil(err)
+	esi := &api.EthernetSegmentIdentifier{
+		Type:  0,
+		Value: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9},
+	}
+	a, err := apb.New(&api.EVPNSelectiveMulticastEthernetTagRoute{
+		Rd:                rd,
+		EthernetTag:       100,
+		GroupAddress:      "239.1.1.1",
+		OriginatorAddress: "192.168.1.1",
+		Flags:             2,
+	})
+	assert.Nil(err)
+	nlris = append(nlris, a)
+	a, err = apb.New(&api.EVPNMulticastJoinSynchRoute{
+		Rd:                rd,
+		Esi:               esi,
+		EthernetTag:       100,
+		SourceAddress:     "10.1.1.1",
+		GroupAddress:      "239.1.1.1",
+		OriginatorAddress: "192.168.1.1",
+		Flags:             4,
+	})
+	assert.Nil(err)
+	nlris = append(nlris, a)
+	a, err = apb.New(&api.EVPNMulticastLeaveSynchRoute{
+		Rd:                rd,
+		Esi:               esi,
+		EthernetTag:       100,
+		SourceAddress:     "2001:db8::1",
+		GroupAddress:      "ff0e::1",
+		OriginatorAddress: "2001:db8::2",
+		SyncNumber:        1,
+		MaxResponseTime:   10,
+		Flags:             12,
+	})
+	assert.Nil(err)
+	nlris = append(nlris, a)
+
+	input := &api.MpReachNLRIAttribute{
+		Family: &api.Family{
+			Afi:  api.Family_AFI_L2VPN,
+			Safi: api.Family_SAFI_EVPN,
+		},
+		NextHops: []string{"192.168.1.1"},
+		Nlris:    nlris,
+	}
+
+	a, err = apb.New(input)
+	assert.Nil(err)
+	n, err := UnmarshalAttribute(a)
+	assert.Nil(err)
+
+	output, _ := NewMpReachNLRIAttributeFromNative(n.(*bgp.PathAttributeMpReachNLRI))
+	assert.Equal(input.Family.Afi, output.Family.Afi)
+	assert.Equal(input.Family.Safi, output.Family.Safi)
+	assert.Equal(input.NextHops, output.NextHops)
+	assert.Equal(3, len(output.Nlris))
+	for idx, inputNLRI := range input.Nlris {
+		outputNLRI := output.Nlris[idx]
+		assert.Equal(inputNLRI.TypeUrl, outputNLRI.TypeUrl)
+		assert.Equal(inputNLRI.Value, outputNLRI.Value)
+	}
+}
+
+func Test_MpReachNLRIAttribute_MVPN_Routes(t *testing.T) {
+	assert := assert.New(t)
+
+	nlris := make([]*apb.Any, 0, 7)
+	rd, err := apb.New(&api.RouteDistinguisherTwoOctetASN{
+		Admin:    65000,
+		Assigned: 100,
+	})
+	assert.Nil(err)
+	a, err := apb.New(&api.MVPNIntraASIPMSIADRoute{
+		Rd:                rd,
+		OriginatorAddress: "192.168.1.1",
+	})
+	assert.Nil(err)
+	nlris = append(nlris, a)
+	a, err = apb.New(&api.MVPNInterASIPMSIADRoute{
+		Rd:       rd,
+		SourceAs: 65001,
+	})
+	assert.Nil(err)
+	nlris = append(nlris, a)
+	key, err := apb.New(&api.MVPNSPMSIADRoute{
+		Rd:                rd,
+		SourceAddress:     "10.1.1.1",
+		GroupAddress:      "232.1.1.1",
+		OriginatorAddress: "192.168.1.1",
+	})
+	assert.Nil(err)
+	nlris = append(nlris, key)
+	a, err = apb.New(&api.MVPNLeafADRoute{
+		RouteKey:          key,
+		OriginatorAddress: "192.168.1.2",
+	})
+	assert.Nil(err)
+	nlris = append(nlris, a)
+	a, err = apb.New(&api.MVPNSourceActiveADRoute{
+		Rd:            rd,
+		SourceAddress: "10.1.1.1",
+		GroupAddress:  "239.1.1.1",
+	})
+	assert.Nil(err)
+	nlris = append(nlris, a)
+	a, err = apb.New(&api.MVPNSharedTreeJoinRoute{
+		Rd:           rd,
+		SourceAs:     65000,
+		RpAddress:    "10.0.0.1",
+		GroupAddress: "239.1.1.1",
+	})
+	assert.Nil(err)
+	nlris = append(nlris, a)
+	// (C-*, C-*) wildcard
+	a, err = apb.New(&api.MVPNSourceTreeJoinRoute{
+		Rd:       rd,
+		SourceAs: 65000,
+	})
+	assert.Nil(err)
+	nlris = append(nlris, a)
+
+	input := &api.MpReachNLRIAttribute{
+		Family: &api.Family{
+			Afi:  api.Family_AFI_IP,
+			Safi: api.Family_SAFI_MCAST_VPN,
+		},
+		NextHops: []string{"192.168.1.1"},
+		Nlris:    nlris,
+	}
+
+	a, err = apb.New(input)
+	assert.Nil(err)
+	n, err := UnmarshalAttribute(a)
+	assert.Nil(err)
+
+	output, _ := NewMpReachNLRIAttributeFromNative(n.(*bgp.PathAttributeMpReachNLRI))
+	assert.Equal(input.Family.Afi, output.Family.Afi)
+	assert.Equal(input.Family.Safi, output.Family.Safi)
+	assert.Equal(input.NextHops, output.NextHops)
+	assert.Equal(7, len(output.Nlris))
+	for idx, inputNLRI := range input.Nlris {
+		outputNLRI := output.Nlris[idx]
+		assert.Equal(inputNLRI.TypeUrl, outputNLRI.TypeUrl)
+		assert.Equal(inputNLRI.Value, outputNLRI.Value)
+	}
+
+	// only for the MCAST-VPN families
+	_, err = UnmarshalNLRI(bgp.RF_IPv4_UC, nlris[0])
+	assert.NotNil(err)
+}
+
 func Test_MpReachNLRIAttribute_IPv4_VPN(t *testing.T) {
 	assert := assert.New(t)
 
@@ -830,6 +991,113 @@ func Test_MpReachNLRIAttribute_IPv6_VPN(t *testing.T) {
 	}
 }
 
+func Test_MpReachNLRIAttribute_CT(t *testing.T) {
+	assert := assert.New(t)
+
+	rd, err := apb.New(&api.RouteDistinguisherTwoOctetASN{
+		Admin:    65000,
+		Assigned: 100,
+	})
+	assert.Nil(err)
+	for _, tt := range []struct {
+		afi     api.Family_Afi
+		prefix  string
+		len     uint32
+		nexthop string
+	}{
+		{api.Family_AFI_IP, "10.0.0.1", 32, "192.168.1.1"},
+		{api.Family_AFI_IP6, "2001:db8::1", 128, "2001:db8::ffff"},
+	} {
+		a, err := apb.New(&api.LabeledVPNIPAddressPrefix{
+			Labels:    []uint32{100},
+			Rd:        rd,
+			PrefixLen: tt.len,
+			Prefix:    tt.prefix,
+		})
+		assert.Nil(err)
+
+		input := &api.MpReachNLRIAttribute{
+			Family: &api.Family{
+				Afi:  tt.afi,
+				Safi: api.Family_SAFI_CLASSFUL_TRANSPORT,
+			},
+			NextHops: []string{tt.nexthop},
+			Nlris:    []*apb.Any{a},
+		}
+
+		a, err = apb.New(input)
+		assert.Nil(err)
+		n, err := UnmarshalAttribute(a)
+		assert.Nil(err)
+
+		output, _ := NewMpReachNLRIAttributeFromNative(n.(*bgp.PathAttributeMpReachNLRI))
+		assert.Equal(input.Family.Afi, output.Family.Afi)
+		assert.Equal(input.Family.Safi, output.Family.Safi)
+		assert.Equal(input.NextHops, output.NextHops)
+		assert.Equal(1, len(output.Nlris))
+		assert.Equal(input.Nlris[0].TypeUrl, output.Nlris[0].TypeUrl)
+		assert.Equal(input.Nlris[0].Value, output.Nlris[0].Value)
+	}
+}
+
+func Test_MpReachNLRIAttribute_CAR(t *testing.T) {
+	assert := assert.New(t)
+
+	label, err := apb.New(&api.CARLabelTLV{Labels: []uint32{16001}})
+	assert.Nil(err)
+	index, err := apb.New(&api.CARLabelIndexTLV{LabelIndex: 1})
+	assert.Nil(err)
+	sid, err := apb.New(&api.CARSRv6SIDTLV{Sid: "fc00:0:1::"})
+	assert.Nil(err)
+	unknown, err := apb.New(&api.CARUnknownTLV{Type: 9, Value: []byte{1}})
+	assert.Nil(err)
+	for _, tt := range []struct {
+		afi     api.Family_Afi
+		nlri    proto.Message
+		nexthop string
+	}{
+		{api.Family_AFI_IP, &api.CARColorAwareRoute{Prefix: "10.0.0.1", PrefixLen: 32, Color: 100, Tlvs: []*apb.Any{label, index}}, "192.168.1.1"},
+		{api.Family_AFI_IP6, &api.CARColorAwareRoute{Prefix: "2001:db8::1", PrefixLen: 128, Color: 100, Tlvs: []*apb.Any{sid, unknown}}, "2001:db8::ffff"},
+		{api.Family_AFI_IP, &api.CARUnknownRoute{Type: 2, Key: []byte{1, 2, 3}, Tlvs: []*apb.Any{label}}, "192.168.1.1"},
+	} {
+		a, err := apb.New(tt.nlri)
+		assert.Nil(err)
+
+		input := &api.MpReachNLRIAttribute{
+			Family: &api.Family{
+				Afi:  tt.afi,
+				Safi: api.Family_SAFI_CAR,
+			},
+			NextHops: []string{tt.nexthop},
+			Nlris:    []*apb.Any{a},
+		}
+
+		a, err = apb.New(input)
+		assert.Nil(err)
+		n, err := UnmarshalAttribute(a)
+		assert.Nil(err)
+
+		output, _ := NewMpReachNLRIAttributeFromNative(n.(*bgp.PathAttributeMpReachNLRI))
+		assert.Equal(input.Family.Afi, output.Family.Afi)
+		assert.Equal(input.Family.Safi, output.Family.Safi)
+		assert.Equal(input.NextHops, output.NextHops)
+		assert.Equal(1, len(output.Nlris))
+		assert.Equal(input.Nlris[0].TypeUrl, output.Nlris[0].TypeUrl)
+		assert.Equal(input.Nlris[0].Value, output.Nlris[0].Value)
+	}
+
+	for _, nlri := range []proto.Message{
+		&api.CARColorAwareRoute{Prefix: "2001:db8::1", PrefixLen: 128, Color: 100},
+		&api.CARColorAwareRoute{Prefix: "10.0.0.1", PrefixLen: 33, Color: 100},
+		&api.CARUnknownRoute{Type: 1},
+	} {
+		a, err := apb.New(nlri)
+		assert.Nil(err)
+		_, err = UnmarshalNLRI(bgp.RF_CAR_IPv4, a)
+		assert.Error(err, nlri)
+	}
+}
+
 func Test_MpReachNLRIAttribute_RTC_UC(t *testing.T) {
 	assert := assert.New(t)
 
@@ -1521,6 +1789,19 @@ func Test_ExtendedCommunitiesAttribute(t *testing.T) {
 	})
 	assert.Nil(err)
 	communities = append(communities, a)
+	a, err = apb.New(&api.Layer2AttributesExtended{
+		ControlWord: true,
+		PrimaryPe:   true,
+		Mtu:         1500,
+	})
+	assert.Nil(err)
+	communities = append(communities, a)
+	a, err = apb.New(&api.DFElectionExtended{
+		Algorithm: 1, // HRW
+		Bitmap:    0x4000,
+	})
+	assert.Nil(err)
+	communities = append(communities, a)
 	a, err = apb.New(&api.TrafficRateExtended{
 		Asn:  65004,
 		Rate: 100.0,
@@ -1556,6 +1837,13 @@ func Test_ExtendedCommunitiesAttribute(t *testing.T) {
 	})
 	assert.Nil(err)
 	communities = append(communities, a)
+	a, err = apb.New(&api.InterfaceSetExtended{
+		Asn:     65008,
+		GroupId: 800,
+		Inbound: true,
+	})
+	assert.Nil(err)
+	communities = append(communities, a)
 	a, err = apb.New(&api.MUPExtended{
 		SegmentId2: 10,
 		SegmentId4: 100,
@@ -1580,6 +1868,12 @@ func Test_ExtendedCommunitiesAttribute(t *testing.T) {
 	})
 	assert.Nil(err)
 	communities = append(communities, a)
+	a, err = apb.New(&api.TransportClassExtended{
+		TransportClass: 100,
+		IsTransitive:   true,
+	})
+	assert.Nil(err)
+	communities = append(communities, a)
 
 	input := &api.ExtendedCommunitiesAttribute{
 		Communities: communities,
@@ -1591,7 +1885,7 @@ func Test_ExtendedCommunitiesAttribute(t *testing.T) {
 	assert.Nil(err)
 
 	output, _ := NewExtendedCommunitiesAttributeFromNative(n.(*bgp.PathAttributeExtendedCommunities))
-	assert.Equal(22, len(output.Communities))
+	assert.Equal(26, len(output.Communities))
 	for idx, inputCommunity := range input.Communities {
 		outputCommunity := output.Communities[idx]
 		assert.Equal(inputCommunity.TypeUrl, outputCommunity.TypeUrl)
@@ -1667,6 +1961,26 @@ func Test_PmsiTunnelAttribute(t *testing.T) {
 	assert.Equal(input.Id, output.Id)
 }
 
+func Test_PmsiTunnelAttribute_PimTree(t *testing.T) {
+	assert := assert.New(t)
+
+	input := &api.PmsiTunnelAttribute{
+		Type:  3, // PIM_SSM_TREE
+		Label: 0,
+		Id:    append(net.ParseIP("10.0.0.1").To4(), net.ParseIP("232.1.1.1").To4()...),
+	}
+
+	a, err := apb.New(input)
+	assert.Nil(err)
+	n, err := UnmarshalAttribute(a)
+	assert.Nil(err)
+	assert.Equal(bgp.NewPimTunnelID("10.0.0.1", "232.1.1.1").String(), n.(*bgp.PathAttributePmsiTunnel).TunnelID.String())
+
+	output, _ := NewPmsiTunnelAttributeFromNative(n.(*bgp.PathAttributePmsiTunnel))
+	assert.Equal(input.Type, output.Type)
+	assert.Equal(input.Id, output.Id)
+}
+
 func Test_TunnelEncapAttribute(t *testing.T) {
 	assert := assert.New(t)
 
@@ -1692,6 +2006,34 @@ func Test_TunnelEncapAttribute(t *testing.T) {
 	})
 	assert.Nil(err)
 	subTlvs = append(subTlvs, a)
+	a, err = apb.New(&api.TunnelEncapSubTLVEgressEndpoint{
+		Address: "2001:db8::1",
+	})
+	assert.Nil(err)
+	subTlvs = append(subTlvs, a)
+	a, err = apb.New(&api.TunnelEncapSubTLVDSField{
+		DsField: 0x28,
+	})
+	assert.Nil(err)
+	subTlvs = append(subTlvs, a)
+	a, err = apb.New(&api.TunnelEncapSubTLVEmbeddedLabelHandling{
+		Handling: 2,
+	})
+	assert.Nil(err)
+	subTlvs = append(subTlvs, a)
+	a, err = apb.New(&api.TunnelEncapSubTLVMPLSLabelStack{
+		Entries: []*api.MPLSLabelStackEntry{
+			{Label: 16001, TrafficClass: 5, Ttl: 64},
+			{Label: 24000, BottomOfStack: true, Ttl: 255},
+		},
+	})
+	assert.Nil(err)
+	subTlvs = append(subTlvs, a)
+	a, err = apb.New(&api.TunnelEncapSubTLVPrefixSID{
+		LabelIndex: 1001,
+	})
+	assert.Nil(err)
+	subTlvs = append(subTlvs, a)
 	a, err = apb.New(&api.TunnelEncapSubTLVUnknown{
 		Type:  0xff, // Max of uint8
 		Value: []byte{0x55, 0x66, 0x77, 0x88},
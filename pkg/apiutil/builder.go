@@ -0,0 +1,487 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiutil
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	apb "google.golang.org/protobuf/types/known/anypb"
+
+	api "github.com/osrg/gobgp/v3/api"
+)
+
+// The helpers in this file build the API messages carrying
+// google.protobuf.Any fields from their typed contents, and extract the
+// typed contents back. Every message is checked against the types the
+// server accepts in that field, so a wrong type is reported when the
+// message is built instead of when the path is added.
+
+func packAnys(name string, msgs []proto.Message, valid func(proto.Message) bool) ([]*apb.Any, error) {
+	values := make([]*apb.Any, 0, len(msgs))
+	for _, m := range msgs {
+		if m == nil || !valid(m) {
+			return nil, fmt.Errorf("invalid %s type: %T", name, m)
+		}
+		a, err := apb.New(m)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal %s: %s", name, err)
+		}
+		values = append(values, a)
+	}
+	return values, nil
+}
+
+func unpackAnys(name string, values []*apb.Any, valid func(proto.Message) bool) ([]proto.Message, error) {
+	msgs := make([]proto.Message, 0, len(values))
+	for _, a := range values {
+		m, err := a.UnmarshalNew()
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s: %s", name, err)
+		}
+		if !valid(m) {
+			return nil, fmt.Errorf("invalid %s type: %T", name, m)
+		}
+		msgs = append(msgs, m)
+	}
+	return msgs, nil
+}
+
+func isPathAttribute(m proto.Message) bool {
+	switch m.(type) {
+	case *api.OriginAttribute, *api.AsPathAttribute, *api.NextHopAttribute,
+		*api.MultiExitDiscAttribute, *api.LocalPrefAttribute, *api.AtomicAggregateAttribute,
+		*api.AggregatorAttribute, *api.CommunitiesAttribute, *api.OriginatorIdAttribute,
+		*api.ClusterListAttribute, *api.MpReachNLRIAttribute, *api.MpUnreachNLRIAttribute,
+		*api.ExtendedCommunitiesAttribute, *api.As4PathAttribute, *api.As4AggregatorAttribute,
+		*api.PmsiTunnelAttribute, *api.TunnelEncapAttribute, *api.IP6ExtendedCommunitiesAttribute,
+		*api.AigpAttribute, *api.LargeCommunitiesAttribute, *api.PrefixSID, *api.LsAttribute,
+		*api.UnknownAttribute:
+		return true
+	}
+	return false
+}
+
+func isNLRI(m proto.Message) bool {
+	switch m.(type) {
+	case *api.IPAddressPrefix, *api.LabeledIPAddressPrefix, *api.EncapsulationNLRI,
+		*api.VPLSNLRI, *api.EVPNEthernetAutoDiscoveryRoute, *api.EVPNMACIPAdvertisementRoute,
+		*api.EVPNInclusiveMulticastEthernetTagRoute, *api.EVPNEthernetSegmentRoute,
+		*api.EVPNIPPrefixRoute, *api.EVPNSelectiveMulticastEthernetTagRoute,
+		*api.EVPNMulticastJoinSynchRoute, *api.EVPNMulticastLeaveSynchRoute,
+		*api.SRPolicyNLRI, *api.LabeledVPNIPAddressPrefix,
+		*api.RouteTargetMembershipNLRI, *api.FlowSpecNLRI, *api.VPNFlowSpecNLRI,
+		*api.MUPInterworkSegmentDiscoveryRoute, *api.MUPDirectSegmentDiscoveryRoute,
+		*api.MUPType1SessionTransformedRoute, *api.MUPType2SessionTransformedRoute,
+		*api.MVPNIntraASIPMSIADRoute, *api.MVPNInterASIPMSIADRoute, *api.MVPNSPMSIADRoute,
+		*api.MVPNLeafADRoute, *api.MVPNSourceActiveADRoute, *api.MVPNSharedTreeJoinRoute,
+		*api.MVPNSourceTreeJoinRoute, *api.CARColorAwareRoute, *api.CARUnknownRoute, *api.LsAddrPrefix:
+		return true
+	}
+	return false
+}
+
+func isLsNLRI(m proto.Message) bool {
+	switch m.(type) {
+	case *api.LsNodeNLRI, *api.LsLinkNLRI, *api.LsPrefixV4NLRI, *api.LsPrefixV6NLRI,
+		*api.LsSrPolicyCandidatePathNLRI:
+		return true
+	}
+	return false
+}
+
+func isExtendedCommunity(m proto.Message) bool {
+	switch m.(type) {
+	case *api.TwoOctetAsSpecificExtended, *api.IPv4AddressSpecificExtended,
+		*api.FourOctetAsSpecificExtended, *api.ValidationExtended, *api.LinkBandwidthExtended,
+		*api.ColorExtended, *api.EncapExtended, *api.DefaultGatewayExtended, *api.OpaqueExtended,
+		*api.ESILabelExtended, *api.ESImportRouteTarget, *api.MacMobilityExtended,
+		*api.RouterMacExtended, *api.Layer2AttributesExtended, *api.DFElectionExtended,
+		*api.TrafficRateExtended, *api.TrafficActionExtended, *api.RedirectTwoOctetAsSpecificExtended,
+		*api.RedirectIPv4AddressSpecificExtended, *api.RedirectFourOctetAsSpecificExtended,
+		*api.TrafficRemarkExtended, *api.InterfaceSetExtended, *api.MUPExtended, *api.VPLSExtended,
+		*api.TransportClassExtended, *api.UnknownExtended:
+		return true
+	}
+	return false
+}
+
+func isIP6ExtendedCommunity(m proto.Message) bool {
+	switch m.(type) {
+	case *api.IPv6AddressSpecificExtended, *api.RedirectIPv6AddressSpecificExtended:
+		return true
+	}
+	return false
+}
+
+func isAigpTLV(m proto.Message) bool {
+	switch m.(type) {
+	case *api.AigpTLVIGPMetric, *api.AigpTLVUnknown:
+		return true
+	}
+	return false
+}
+
+func isPrefixSIDTLV(m proto.Message) bool {
+	switch m.(type) {
+	case *api.SRv6L3ServiceTLV, *api.SRv6L2ServiceTLV:
+		return true
+	}
+	return false
+}
+
+func isSRv6InformationSubTLV(m proto.Message) bool {
+	_, ok := m.(*api.SRv6InformationSubTLV)
+	return ok
+}
+
+func isSRv6StructureSubSubTLV(m proto.Message) bool {
+	_, ok := m.(*api.SRv6StructureSubSubTLV)
+	return ok
+}
+
+func isTunnelEncapSubTLV(m proto.Message) bool {
+	switch m.(type) {
+	case *api.TunnelEncapSubTLVEncapsulation, *api.TunnelEncapSubTLVProtocol,
+		*api.TunnelEncapSubTLVColor, *api.TunnelEncapSubTLVEgressEndpoint,
+		*api.TunnelEncapSubTLVUDPDestPort, *api.TunnelEncapSubTLVDSField,
+		*api.TunnelEncapSubTLVEmbeddedLabelHandling, *api.TunnelEncapSubTLVMPLSLabelStack,
+		*api.TunnelEncapSubTLVPrefixSID, *api.TunnelEncapSubTLVSRPreference,
+		*api.TunnelEncapSubTLVSRPriority, *api.TunnelEncapSubTLVSRCandidatePathName,
+		*api.TunnelEncapSubTLVSRENLP, *api.TunnelEncapSubTLVSRBindingSID,
+		*api.TunnelEncapSubTLVSRSegmentList, *api.TunnelEncapSubTLVUnknown:
+		return true
+	}
+	return false
+}
+
+func isSRBindingSID(m proto.Message) bool {
+	switch m.(type) {
+	case *api.SRBindingSID, *api.SRv6BindingSID:
+		return true
+	}
+	return false
+}
+
+func isSRSegment(m proto.Message) bool {
+	switch m.(type) {
+	case *api.SegmentTypeA, *api.SegmentTypeB, *api.SegmentTypeC, *api.SegmentTypeD,
+		*api.SegmentTypeE, *api.SegmentTypeF, *api.SegmentTypeG, *api.SegmentTypeH,
+		*api.SegmentTypeI, *api.SegmentTypeJ, *api.SegmentTypeK:
+		return true
+	}
+	return false
+}
+
+// NewPathAttributes returns the path attributes to be set to api.Path.Pattrs.
+func NewPathAttributes(attrs ...proto.Message) ([]*apb.Any, error) {
+	return packAnys("path attribute", attrs, isPathAttribute)
+}
+
+// GetPathAttributes returns the path attributes carried in api.Path.Pattrs.
+func GetPathAttributes(values []*apb.Any) ([]proto.Message, error) {
+	return unpackAnys("path attribute", values, isPathAttribute)
+}
+
+// NewNLRI returns the NLRI to be set to api.Path.Nlri.
+func NewNLRI(nlri proto.Message) (*apb.Any, error) {
+	values, err := packAnys("nlri", []proto.Message{nlri}, isNLRI)
+	if err != nil {
+		return nil, err
+	}
+	return values[0], nil
+}
+
+// GetNLRI returns the NLRI carried in api.Path.Nlri.
+func GetNLRI(value *apb.Any) (proto.Message, error) {
+	msgs, err := unpackAnys("nlri", []*apb.Any{value}, isNLRI)
+	if err != nil {
+		return nil, err
+	}
+	return msgs[0], nil
+}
+
+func NewMpReachNLRIAttribute(family *api.Family, nexthops []string, nlris ...proto.Message) (*api.MpReachNLRIAttribute, error) {
+	values, err := packAnys("nlri", nlris, isNLRI)
+	if err != nil {
+		return nil, err
+	}
+	return &api.MpReachNLRIAttribute{
+		Family:   family,
+		NextHops: nexthops,
+		Nlris:    values,
+	}, nil
+}
+
+func GetMpReachNLRIs(a *api.MpReachNLRIAttribute) ([]proto.Message, error) {
+	return unpackAnys("nlri", a.Nlris, isNLRI)
+}
+
+func NewMpUnreachNLRIAttribute(family *api.Family, nlris ...proto.Message) (*api.MpUnreachNLRIAttribute, error) {
+	values, err := packAnys("nlri", nlris, isNLRI)
+	if err != nil {
+		return nil, err
+	}
+	return &api.MpUnreachNLRIAttribute{
+		Family: family,
+		Nlris:  values,
+	}, nil
+}
+
+func GetMpUnreachNLRIs(a *api.MpUnreachNLRIAttribute) ([]proto.Message, error) {
+	return unpackAnys("nlri", a.Nlris, isNLRI)
+}
+
+// NewLsAddrPrefix returns the BGP-LS NLRI wrapping one of LsNodeNLRI,
+// LsLinkNLRI, LsPrefixV4NLRI, LsPrefixV6NLRI or LsSrPolicyCandidatePathNLRI.
+// The NLRI type is derived from the wrapped message.
+func NewLsAddrPrefix(protocolID api.LsProtocolID, identifier uint64, nlri proto.Message) (*api.LsAddrPrefix, error) {
+	values, err := packAnys("bgp-ls nlri", []proto.Message{nlri}, isLsNLRI)
+	if err != nil {
+		return nil, err
+	}
+	var typ api.LsNLRIType
+	switch nlri.(type) {
+	case *api.LsNodeNLRI:
+		typ = api.LsNLRIType_LS_NLRI_NODE
+	case *api.LsLinkNLRI:
+		typ = api.LsNLRIType_LS_NLRI_LINK
+	case *api.LsPrefixV4NLRI:
+		typ = api.LsNLRIType_LS_NLRI_PREFIX_V4
+	case *api.LsPrefixV6NLRI:
+		typ = api.LsNLRIType_LS_NLRI_PREFIX_V6
+	case *api.LsSrPolicyCandidatePathNLRI:
+		typ = api.LsNLRIType_LS_NLRI_SR_POLICY_CANDIDATE_PATH
+	}
+	return &api.LsAddrPrefix{
+		Type:       typ,
+		Nlri:       values[0],
+		ProtocolId: protocolID,
+		Identifier: identifier,
+	}, nil
+}
+
+func GetLsNLRI(a *api.LsAddrPrefix) (proto.Message, error) {
+	msgs, err := unpackAnys("bgp-ls nlri", []*apb.Any{a.Nlri}, isLsNLRI)
+	if err != nil {
+		return nil, err
+	}
+	return msgs[0], nil
+}
+
+func NewExtendedCommunitiesAttribute(communities ...proto.Message) (*api.ExtendedCommunitiesAttribute, error) {
+	values, err := packAnys("extended community", communities, isExtendedCommunity)
+	if err != nil {
+		return nil, err
+	}
+	return &api.ExtendedCommunitiesAttribute{Communities: values}, nil
+}
+
+func GetExtendedCommunities(a *api.ExtendedCommunitiesAttribute) ([]proto.Message, error) {
+	return unpackAnys("extended community", a.Communities, isExtendedCommunity)
+}
+
+func NewIP6ExtendedCommunitiesAttribute(communities ...proto.Message) (*api.IP6ExtendedCommunitiesAttribute, error) {
+	values, err := packAnys("ipv6 extended community", communities, isIP6ExtendedCommunity)
+	if err != nil {
+		return nil, err
+	}
+	return &api.IP6ExtendedCommunitiesAttribute{Communities: values}, nil
+}
+
+func GetIP6ExtendedCommunities(a *api.IP6ExtendedCommunitiesAttribute) ([]proto.Message, error) {
+	return unpackAnys("ipv6 extended community", a.Communities, isIP6ExtendedCommunity)
+}
+
+func NewAigpAttribute(tlvs ...proto.Message) (*api.AigpAttribute, error) {
+	values, err := packAnys("aigp attribute tlv", tlvs, isAigpTLV)
+	if err != nil {
+		return nil, err
+	}
+	return &api.AigpAttribute{Tlvs: values}, nil
+}
+
+func GetAigpTLVs(a *api.AigpAttribute) ([]proto.Message, error) {
+	return unpackAnys("aigp attribute tlv", a.Tlvs, isAigpTLV)
+}
+
+// GetAigpIGPMetric returns the metric of the first IGP metric TLV and
+// whether the attribute has one.
+func GetAigpIGPMetric(a *api.AigpAttribute) (uint64, bool, error) {
+	tlvs, err := GetAigpTLVs(a)
+	if err != nil {
+		return 0, false, err
+	}
+	for _, tlv := range tlvs {
+		if m, ok := tlv.(*api.AigpTLVIGPMetric); ok {
+			return m.Metric, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// NewPrefixSIDAttribute returns the Prefix SID attribute carrying
+// SRv6L3ServiceTLV and SRv6L2ServiceTLV.
+func NewPrefixSIDAttribute(tlvs ...proto.Message) (*api.PrefixSID, error) {
+	values, err := packAnys("prefix sid tlv", tlvs, isPrefixSIDTLV)
+	if err != nil {
+		return nil, err
+	}
+	return &api.PrefixSID{Tlvs: values}, nil
+}
+
+func GetPrefixSIDTLVs(a *api.PrefixSID) ([]proto.Message, error) {
+	return unpackAnys("prefix sid tlv", a.Tlvs, isPrefixSIDTLV)
+}
+
+// The SRv6 sub TLVs and sub sub TLVs are keyed by their type. Type 1 is
+// the only type defined for both of them.
+func newSRv6TLVs(name string, msgs []proto.Message, valid func(proto.Message) bool) (map[uint32]*api.SRv6TLV, error) {
+	values, err := packAnys(name, msgs, valid)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[uint32]*api.SRv6TLV)
+	if len(values) > 0 {
+		m[1] = &api.SRv6TLV{Tlv: values}
+	}
+	return m, nil
+}
+
+func getSRv6TLVs(name string, m map[uint32]*api.SRv6TLV, valid func(proto.Message) bool) ([]proto.Message, error) {
+	msgs := make([]proto.Message, 0)
+	for t, tlv := range m {
+		if t != 1 {
+			return nil, fmt.Errorf("unknown or not implemented %s type: %d", name, t)
+		}
+		l, err := unpackAnys(name, tlv.Tlv, valid)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, l...)
+	}
+	return msgs, nil
+}
+
+func NewSRv6L3ServiceTLV(infos ...*api.SRv6InformationSubTLV) (*api.SRv6L3ServiceTLV, error) {
+	subTlvs, err := NewSRv6InformationSubTLVs(infos...)
+	if err != nil {
+		return nil, err
+	}
+	return &api.SRv6L3ServiceTLV{SubTlvs: subTlvs}, nil
+}
+
+func NewSRv6L2ServiceTLV(infos ...*api.SRv6InformationSubTLV) (*api.SRv6L2ServiceTLV, error) {
+	subTlvs, err := NewSRv6InformationSubTLVs(infos...)
+	if err != nil {
+		return nil, err
+	}
+	return &api.SRv6L2ServiceTLV{SubTlvs: subTlvs}, nil
+}
+
+// NewSRv6InformationSubTLVs returns the sub TLVs to be set to
+// SRv6L3ServiceTLV.SubTlvs or SRv6L2ServiceTLV.SubTlvs.
+func NewSRv6InformationSubTLVs(infos ...*api.SRv6InformationSubTLV) (map[uint32]*api.SRv6TLV, error) {
+	msgs := make([]proto.Message, 0, len(infos))
+	for _, info := range infos {
+		msgs = append(msgs, info)
+	}
+	return newSRv6TLVs("srv6 service sub tlv", msgs, isSRv6InformationSubTLV)
+}
+
+func GetSRv6InformationSubTLVs(m map[uint32]*api.SRv6TLV) ([]*api.SRv6InformationSubTLV, error) {
+	msgs, err := getSRv6TLVs("srv6 service sub tlv", m, isSRv6InformationSubTLV)
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]*api.SRv6InformationSubTLV, 0, len(msgs))
+	for _, msg := range msgs {
+		infos = append(infos, msg.(*api.SRv6InformationSubTLV))
+	}
+	return infos, nil
+}
+
+// NewSRv6StructureSubSubTLVs returns the sub sub TLVs to be set to
+// SRv6InformationSubTLV.SubSubTlvs.
+func NewSRv6StructureSubSubTLVs(structures ...*api.SRv6StructureSubSubTLV) (map[uint32]*api.SRv6TLV, error) {
+	msgs := make([]proto.Message, 0, len(structures))
+	for _, s := range structures {
+		msgs = append(msgs, s)
+	}
+	return newSRv6TLVs("srv6 service sub sub tlv", msgs, isSRv6StructureSubSubTLV)
+}
+
+func GetSRv6StructureSubSubTLVs(m map[uint32]*api.SRv6TLV) ([]*api.SRv6StructureSubSubTLV, error) {
+	msgs, err := getSRv6TLVs("srv6 service sub sub tlv", m, isSRv6StructureSubSubTLV)
+	if err != nil {
+		return nil, err
+	}
+	structures := make([]*api.SRv6StructureSubSubTLV, 0, len(msgs))
+	for _, msg := range msgs {
+		structures = append(structures, msg.(*api.SRv6StructureSubSubTLV))
+	}
+	return structures, nil
+}
+
+func NewTunnelEncapTLV(typ uint32, subTlvs ...proto.Message) (*api.TunnelEncapTLV, error) {
+	values, err := packAnys("tunnel encapsulation attribute sub tlv", subTlvs, isTunnelEncapSubTLV)
+	if err != nil {
+		return nil, err
+	}
+	return &api.TunnelEncapTLV{
+		Type: typ,
+		Tlvs: values,
+	}, nil
+}
+
+func GetTunnelEncapSubTLVs(tlv *api.TunnelEncapTLV) ([]proto.Message, error) {
+	return unpackAnys("tunnel encapsulation attribute sub tlv", tlv.Tlvs, isTunnelEncapSubTLV)
+}
+
+// NewTunnelEncapSubTLVSRBindingSID returns the binding SID sub TLV
+// wrapping either SRBindingSID or SRv6BindingSID.
+func NewTunnelEncapSubTLVSRBindingSID(bsid proto.Message) (*api.TunnelEncapSubTLVSRBindingSID, error) {
+	values, err := packAnys("binding sid", []proto.Message{bsid}, isSRBindingSID)
+	if err != nil {
+		return nil, err
+	}
+	return &api.TunnelEncapSubTLVSRBindingSID{Bsid: values[0]}, nil
+}
+
+func GetSRBindingSID(tlv *api.TunnelEncapSubTLVSRBindingSID) (proto.Message, error) {
+	msgs, err := unpackAnys("binding sid", []*apb.Any{tlv.Bsid}, isSRBindingSID)
+	if err != nil {
+		return nil, err
+	}
+	return msgs[0], nil
+}
+
+// NewTunnelEncapSubTLVSRSegmentList returns the segment list sub TLV
+// with segments of SegmentTypeA to SegmentTypeK.
+func NewTunnelEncapSubTLVSRSegmentList(weight *api.SRWeight, segments ...proto.Message) (*api.TunnelEncapSubTLVSRSegmentList, error) {
+	values, err := packAnys("segment", segments, isSRSegment)
+	if err != nil {
+		return nil, err
+	}
+	return &api.TunnelEncapSubTLVSRSegmentList{
+		Weight:   weight,
+		Segments: values,
+	}, nil
+}
+
+func GetSRSegments(tlv *api.TunnelEncapSubTLVSRSegmentList) ([]proto.Message, error) {
+	return unpackAnys("segment", tlv.Segments, isSRSegment)
+}
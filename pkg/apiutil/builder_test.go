@@ -0,0 +1,248 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiutil
+
+import (
+	"net"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	apb "google.golang.org/protobuf/types/known/anypb"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+	"github.com/stretchr/testify/assert"
+)
+
+// roundTrip converts the attribute to the native one and back.
+func roundTrip(t *testing.T, attr proto.Message) proto.Message {
+	a, err := apb.New(attr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, err := UnmarshalAttribute(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := MarshalPathAttributes([]bgp.PathAttributeInterface{n})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msgs, err := GetPathAttributes(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return msgs[0]
+}
+
+func Test_BuildPathAttributes(t *testing.T) {
+	assert := assert.New(t)
+
+	input := []proto.Message{
+		&api.OriginAttribute{Origin: 0},
+		&api.NextHopAttribute{NextHop: "10.0.0.1"},
+		&api.AsPathAttribute{Segments: []*api.AsSegment{{Type: 2, Numbers: []uint32{65001, 65002}}}},
+	}
+	values, err := NewPathAttributes(input...)
+	assert.NoError(err)
+	attrs, err := UnmarshalPathAttributes(values)
+	assert.NoError(err)
+	values, err = MarshalPathAttributes(attrs)
+	assert.NoError(err)
+	output, err := GetPathAttributes(values)
+	assert.NoError(err)
+	assert.Equal(len(input), len(output))
+	for i := range input {
+		assert.True(proto.Equal(input[i], output[i]), "%v != %v", input[i], output[i])
+	}
+
+	_, err = NewPathAttributes(&api.LsAttribute{Node: &api.LsAttributeNode{Name: "node1"}})
+	assert.NoError(err)
+	_, err = NewPathAttributes(&api.IPAddressPrefix{})
+	assert.Error(err)
+	_, err = NewPathAttributes(nil)
+	assert.Error(err)
+	nlri, _ := apb.New(&api.IPAddressPrefix{})
+	_, err = GetPathAttributes([]*apb.Any{nlri})
+	assert.Error(err)
+}
+
+func Test_BuildMpReachNLRIAttribute(t *testing.T) {
+	assert := assert.New(t)
+
+	prefix := &api.IPAddressPrefix{Prefix: "2001:db8::", PrefixLen: 64}
+	family := &api.Family{Afi: api.Family_AFI_IP6, Safi: api.Family_SAFI_UNICAST}
+	input, err := NewMpReachNLRIAttribute(family, []string{"2001:db8::1"}, prefix)
+	assert.NoError(err)
+	output := roundTrip(t, input).(*api.MpReachNLRIAttribute)
+	nlris, err := GetMpReachNLRIs(output)
+	assert.NoError(err)
+	assert.Equal(1, len(nlris))
+	assert.True(proto.Equal(prefix, nlris[0]))
+
+	_, err = NewMpReachNLRIAttribute(family, nil, &api.OriginAttribute{})
+	assert.Error(err)
+
+	node := &api.LsNodeNLRI{LocalNode: &api.LsNodeDescriptor{Asn: 65000, IgpRouterId: "1.1.1.1"}}
+	p, err := NewLsAddrPrefix(api.LsProtocolID_LS_PROTOCOL_OSPF_V2, 1, node)
+	assert.NoError(err)
+	assert.Equal(api.LsNLRIType_LS_NLRI_NODE, p.Type)
+	n, err := GetLsNLRI(p)
+	assert.NoError(err)
+	assert.True(proto.Equal(node, n))
+	_, err = NewLsAddrPrefix(api.LsProtocolID_LS_PROTOCOL_OSPF_V2, 1, prefix)
+	assert.Error(err)
+}
+
+func Test_BuildExtendedCommunitiesAttribute(t *testing.T) {
+	assert := assert.New(t)
+
+	input := []proto.Message{
+		&api.TwoOctetAsSpecificExtended{IsTransitive: true, SubType: 0x02, Asn: 65001, LocalAdmin: 100},
+		&api.ColorExtended{Color: 100},
+	}
+	attr, err := NewExtendedCommunitiesAttribute(input...)
+	assert.NoError(err)
+	output, err := GetExtendedCommunities(roundTrip(t, attr).(*api.ExtendedCommunitiesAttribute))
+	assert.NoError(err)
+	assert.Equal(len(input), len(output))
+	for i := range input {
+		assert.True(proto.Equal(input[i], output[i]), "%v != %v", input[i], output[i])
+	}
+	_, err = NewExtendedCommunitiesAttribute(&api.IPv6AddressSpecificExtended{})
+	assert.Error(err)
+
+	ip6 := &api.IPv6AddressSpecificExtended{IsTransitive: true, SubType: 0x02, Address: "2001:db8::1", LocalAdmin: 100}
+	attr6, err := NewIP6ExtendedCommunitiesAttribute(ip6)
+	assert.NoError(err)
+	output, err = GetIP6ExtendedCommunities(roundTrip(t, attr6).(*api.IP6ExtendedCommunitiesAttribute))
+	assert.NoError(err)
+	assert.Equal(1, len(output))
+	assert.True(proto.Equal(ip6, output[0]))
+}
+
+func Test_BuildAigpAttribute(t *testing.T) {
+	assert := assert.New(t)
+
+	attr, err := NewAigpAttribute(&api.AigpTLVIGPMetric{Metric: 100})
+	assert.NoError(err)
+	metric, ok, err := GetAigpIGPMetric(roundTrip(t, attr).(*api.AigpAttribute))
+	assert.NoError(err)
+	assert.True(ok)
+	assert.Equal(uint64(100), metric)
+
+	attr, err = NewAigpAttribute(&api.AigpTLVUnknown{Type: 2, Value: []byte{1, 2}})
+	assert.NoError(err)
+	tlvs, err := GetAigpTLVs(attr)
+	assert.NoError(err)
+	assert.Equal(1, len(tlvs))
+	_, ok, err = GetAigpIGPMetric(attr)
+	assert.NoError(err)
+	assert.False(ok)
+
+	_, err = NewAigpAttribute(&api.OriginAttribute{})
+	assert.Error(err)
+}
+
+func Test_BuildPrefixSIDAttribute(t *testing.T) {
+	assert := assert.New(t)
+
+	structure := &api.SRv6StructureSubSubTLV{
+		LocatorBlockLength: 40,
+		LocatorNodeLength:  24,
+		FunctionLength:     16,
+	}
+	subSubTlvs, err := NewSRv6StructureSubSubTLVs(structure)
+	assert.NoError(err)
+	info := &api.SRv6InformationSubTLV{
+		Sid:              net.ParseIP("2001:db8:1:1::"),
+		Flags:            &api.SRv6SIDFlags{},
+		EndpointBehavior: uint32(api.SRv6Behavior_END_DT4),
+		SubSubTlvs:       subSubTlvs,
+	}
+	l3, err := NewSRv6L3ServiceTLV(info)
+	assert.NoError(err)
+	attr, err := NewPrefixSIDAttribute(l3)
+	assert.NoError(err)
+
+	tlvs, err := GetPrefixSIDTLVs(roundTrip(t, attr).(*api.PrefixSID))
+	assert.NoError(err)
+	assert.Equal(1, len(tlvs))
+	infos, err := GetSRv6InformationSubTLVs(tlvs[0].(*api.SRv6L3ServiceTLV).SubTlvs)
+	assert.NoError(err)
+	assert.Equal(1, len(infos))
+	assert.True(proto.Equal(info, infos[0]))
+	structures, err := GetSRv6StructureSubSubTLVs(infos[0].SubSubTlvs)
+	assert.NoError(err)
+	assert.Equal(1, len(structures))
+	assert.True(proto.Equal(structure, structures[0]))
+
+	l2, err := NewSRv6L2ServiceTLV(info)
+	assert.NoError(err)
+	_, err = NewPrefixSIDAttribute(l2)
+	assert.NoError(err)
+
+	_, err = NewPrefixSIDAttribute(info)
+	assert.Error(err)
+	_, err = GetSRv6InformationSubTLVs(map[uint32]*api.SRv6TLV{2: {}})
+	assert.Error(err)
+}
+
+func Test_BuildTunnelEncapAttribute(t *testing.T) {
+	assert := assert.New(t)
+
+	bsid, err := NewTunnelEncapSubTLVSRBindingSID(&api.SRBindingSID{
+		SFlag: true,
+		Sid:   []byte{0x00, 0x01, 0x11, 0x00},
+	})
+	assert.NoError(err)
+	segments, err := NewTunnelEncapSubTLVSRSegmentList(
+		&api.SRWeight{Weight: 10},
+		&api.SegmentTypeA{Flags: &api.SegmentFlags{}, Label: 16001},
+		&api.SegmentTypeA{Flags: &api.SegmentFlags{}, Label: 16002},
+	)
+	assert.NoError(err)
+	input := []proto.Message{
+		&api.TunnelEncapSubTLVColor{Color: 100},
+		bsid,
+		segments,
+	}
+	tlv, err := NewTunnelEncapTLV(uint32(bgp.TUNNEL_TYPE_SR_POLICY), input...)
+	assert.NoError(err)
+
+	output := roundTrip(t, &api.TunnelEncapAttribute{Tlvs: []*api.TunnelEncapTLV{tlv}}).(*api.TunnelEncapAttribute)
+	assert.Equal(1, len(output.Tlvs))
+	assert.Equal(tlv.Type, output.Tlvs[0].Type)
+	subTlvs, err := GetTunnelEncapSubTLVs(output.Tlvs[0])
+	assert.NoError(err)
+	assert.Equal(len(input), len(subTlvs))
+	assert.True(proto.Equal(input[0], subTlvs[0]))
+
+	b, err := GetSRBindingSID(subTlvs[1].(*api.TunnelEncapSubTLVSRBindingSID))
+	assert.NoError(err)
+	assert.True(b.(*api.SRBindingSID).SFlag)
+	l, err := GetSRSegments(subTlvs[2].(*api.TunnelEncapSubTLVSRSegmentList))
+	assert.NoError(err)
+	assert.Equal(2, len(l))
+	assert.Equal(uint32(16002), l[1].(*api.SegmentTypeA).Label)
+
+	_, err = NewTunnelEncapTLV(uint32(bgp.TUNNEL_TYPE_SR_POLICY), &api.SegmentTypeA{})
+	assert.Error(err)
+	_, err = NewTunnelEncapSubTLVSRBindingSID(&api.SegmentTypeA{})
+	assert.Error(err)
+	_, err = NewTunnelEncapSubTLVSRSegmentList(nil, &api.SRBindingSID{})
+	assert.Error(err)
+}
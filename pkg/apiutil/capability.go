@@ -35,6 +35,27 @@ func NewRouteRefreshCapability(a *bgp.CapRouteRefresh) *api.RouteRefreshCapabili
 	return &api.RouteRefreshCapability{}
 }
 
+func NewOutboundRouteFilteringCapability(a *bgp.CapOutboundRouteFiltering) *api.OutboundRouteFilteringCapability {
+	tuples := make([]*api.OutboundRouteFilteringCapabilityTuple, 0, len(a.Tuples))
+	for _, t := range a.Tuples {
+		afi, safi := bgp.RouteFamilyToAfiSafi(t.RouteFamily)
+		entries := make([]*api.OutboundRouteFilteringCapabilityEntry, 0, len(t.Entries))
+		for _, e := range t.Entries {
+			entries = append(entries, &api.OutboundRouteFilteringCapabilityEntry{
+				Type: uint32(e.Type),
+				Mode: api.OutboundRouteFilteringCapabilityEntry_Mode(e.Mode),
+			})
+		}
+		tuples = append(tuples, &api.OutboundRouteFilteringCapabilityTuple{
+			Family:  ToApiFamily(afi, safi),
+			Entries: entries,
+		})
+	}
+	return &api.OutboundRouteFilteringCapability{
+		Tuples: tuples,
+	}
+}
+
 func NewCarryingLabelInfoCapability(a *bgp.CapCarryingLabelInfo) *api.CarryingLabelInfoCapability {
 	return &api.CarryingLabelInfoCapability{}
 }
@@ -91,6 +112,20 @@ func NewEnhancedRouteRefreshCapability(a *bgp.CapEnhancedRouteRefresh) *api.Enha
 	return &api.EnhancedRouteRefreshCapability{}
 }
 
+func NewExtendedMessageCapability(a *bgp.CapExtendedMessage) *api.ExtendedMessageCapability {
+	return &api.ExtendedMessageCapability{}
+}
+
+func NewDynamicCapability(a *bgp.CapDynamic) *api.DynamicCapability {
+	codes := make([]uint32, 0, len(a.CapCodes))
+	for _, c := range a.CapCodes {
+		codes = append(codes, uint32(c))
+	}
+	return &api.DynamicCapability{
+		Codes: codes,
+	}
+}
+
 func NewLongLivedGracefulRestartCapability(a *bgp.CapLongLivedGracefulRestart) *api.LongLivedGracefulRestartCapability {
 	tuples := make([]*api.LongLivedGracefulRestartCapabilityTuple, 0, len(a.Tuples))
 	for _, t := range a.Tuples {
@@ -136,6 +171,8 @@ func MarshalCapability(value bgp.ParameterCapabilityInterface) (*apb.Any, error)
 		m = NewMultiProtocolCapability(n)
 	case *bgp.CapRouteRefresh:
 		m = NewRouteRefreshCapability(n)
+	case *bgp.CapOutboundRouteFiltering:
+		m = NewOutboundRouteFilteringCapability(n)
 	case *bgp.CapCarryingLabelInfo:
 		m = NewCarryingLabelInfoCapability(n)
 	case *bgp.CapExtendedNexthop:
@@ -148,6 +185,10 @@ func MarshalCapability(value bgp.ParameterCapabilityInterface) (*apb.Any, error)
 		m = NewAddPathCapability(n)
 	case *bgp.CapEnhancedRouteRefresh:
 		m = NewEnhancedRouteRefreshCapability(n)
+	case *bgp.CapExtendedMessage:
+		m = NewExtendedMessageCapability(n)
+	case *bgp.CapDynamic:
+		m = NewDynamicCapability(n)
 	case *bgp.CapLongLivedGracefulRestart:
 		m = NewLongLivedGracefulRestartCapability(n)
 	case *bgp.CapRouteRefreshCisco:
@@ -186,6 +227,19 @@ func unmarshalCapability(a *apb.Any) (bgp.ParameterCapabilityInterface, error) {
 		return bgp.NewCapMultiProtocol(ToRouteFamily(a.Family)), nil
 	case *api.RouteRefreshCapability:
 		return bgp.NewCapRouteRefresh(), nil
+	case *api.OutboundRouteFilteringCapability:
+		tuples := make([]*bgp.CapOutboundRouteFilteringTuple, 0, len(a.Tuples))
+		for _, t := range a.Tuples {
+			entries := make([]*bgp.CapORFEntry, 0, len(t.Entries))
+			for _, e := range t.Entries {
+				entries = append(entries, &bgp.CapORFEntry{
+					Type: bgp.BGPORFType(e.Type),
+					Mode: bgp.BGPORFMode(e.Mode),
+				})
+			}
+			tuples = append(tuples, bgp.NewCapOutboundRouteFilteringTuple(ToRouteFamily(t.Family), entries))
+		}
+		return bgp.NewCapOutboundRouteFiltering(tuples), nil
 	case *api.CarryingLabelInfoCapability:
 		return bgp.NewCapCarryingLabelInfo(), nil
 	case *api.ExtendedNexthopCapability:
@@ -231,6 +285,14 @@ func unmarshalCapability(a *apb.Any) (bgp.ParameterCapabilityInterface, error) {
 		return bgp.NewCapAddPath(tuples), nil
 	case *api.EnhancedRouteRefreshCapability:
 		return bgp.NewCapEnhancedRouteRefresh(), nil
+	case *api.ExtendedMessageCapability:
+		return bgp.NewCapExtendedMessage(), nil
+	case *api.DynamicCapability:
+		codes := make([]bgp.BGPCapabilityCode, 0, len(a.Codes))
+		for _, c := range a.Codes {
+			codes = append(codes, bgp.BGPCapabilityCode(c))
+		}
+		return bgp.NewCapDynamic(codes), nil
 	case *api.LongLivedGracefulRestartCapability:
 		tuples := make([]*bgp.CapLongLivedGracefulRestartTuple, 0, len(a.Tuples))
 		for _, t := range a.Tuples {
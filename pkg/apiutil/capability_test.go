@@ -203,6 +203,39 @@ func Test_EnhancedRouteRefreshCapability(t *testing.T) {
 	assert.True(proto.Equal(input, output))
 }
 
+func Test_ExtendedMessageCapability(t *testing.T) {
+	assert := assert.New(t)
+
+	input := &api.ExtendedMessageCapability{}
+
+	a, err := apb.New(input)
+	assert.Nil(err)
+	n, err := unmarshalCapability(a)
+	assert.Nil(err)
+
+	output := NewExtendedMessageCapability(n.(*bgp.CapExtendedMessage))
+	assert.True(proto.Equal(input, output))
+}
+
+func Test_DynamicCapability(t *testing.T) {
+	assert := assert.New(t)
+
+	input := &api.DynamicCapability{
+		Codes: []uint32{uint32(bgp.BGP_CAP_MULTIPROTOCOL)},
+	}
+
+	a, err := apb.New(input)
+	assert.Nil(err)
+	n, err := unmarshalCapability(a)
+	assert.Nil(err)
+
+	c := n.(*bgp.CapDynamic)
+	assert.Equal([]bgp.BGPCapabilityCode{bgp.BGP_CAP_MULTIPROTOCOL}, c.CapCodes)
+
+	output := NewDynamicCapability(c)
+	assert.True(proto.Equal(input, output))
+}
+
 func Test_LongLivedGracefulRestartCapability(t *testing.T) {
 	assert := assert.New(t)
 
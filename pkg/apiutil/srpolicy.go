@@ -0,0 +1,114 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiutil
+
+import (
+	"fmt"
+	"net"
+
+	"google.golang.org/protobuf/proto"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+// SRPolicyCandidatePath is a candidate path of an SR Policy (RFC 9830) to
+// be originated with NewSRPolicyPath.
+type SRPolicyCandidatePath struct {
+	Distinguisher uint32
+	Color         uint32
+	// Endpoint is an IPv4 or IPv6 address, which also selects the
+	// address family of the path.
+	Endpoint   string
+	Preference uint32
+	// Priority, Name, BindingSID and ENLP are omitted when zero.
+	Priority uint32
+	Name     string
+	// BindingSID is either SRBindingSID or SRv6BindingSID.
+	BindingSID   proto.Message
+	ENLP         api.ENLPType
+	SegmentLists []*api.TunnelEncapSubTLVSRSegmentList
+}
+
+// NewSRPolicyPath returns the path advertising the candidate path with the
+// next hop. The attributes are added to the path, typically the extended
+// communities attribute with the route target of the headend, which RFC
+// 9830 requires unless the path carries the NO_ADVERTISE community.
+func NewSRPolicyPath(cp *SRPolicyCandidatePath, nexthop string, attrs ...proto.Message) (*api.Path, error) {
+	endpoint := net.ParseIP(cp.Endpoint)
+	if endpoint == nil {
+		return nil, fmt.Errorf("invalid endpoint %s of SR Policy", cp.Endpoint)
+	}
+	family := &api.Family{Afi: api.Family_AFI_IP6, Safi: api.Family_SAFI_SR_POLICY}
+	nlri := &api.SRPolicyNLRI{
+		Length:        bgp.SRPolicyIPv6NLRILen,
+		Distinguisher: cp.Distinguisher,
+		Color:         cp.Color,
+		Endpoint:      endpoint.To16(),
+	}
+	if v4 := endpoint.To4(); v4 != nil {
+		family.Afi = api.Family_AFI_IP
+		nlri.Length = bgp.SRPolicyIPv4NLRILen
+		nlri.Endpoint = v4
+	}
+
+	subTlvs := []proto.Message{&api.TunnelEncapSubTLVSRPreference{Preference: cp.Preference}}
+	if cp.Priority != 0 {
+		subTlvs = append(subTlvs, &api.TunnelEncapSubTLVSRPriority{Priority: cp.Priority})
+	}
+	if cp.Name != "" {
+		subTlvs = append(subTlvs, &api.TunnelEncapSubTLVSRCandidatePathName{CandidatePathName: cp.Name})
+	}
+	if cp.BindingSID != nil {
+		bsid, err := NewTunnelEncapSubTLVSRBindingSID(cp.BindingSID)
+		if err != nil {
+			return nil, err
+		}
+		subTlvs = append(subTlvs, bsid)
+	}
+	if cp.ENLP != api.ENLPType_Reserved {
+		subTlvs = append(subTlvs, &api.TunnelEncapSubTLVSRENLP{Enlp: cp.ENLP})
+	}
+	for _, l := range cp.SegmentLists {
+		subTlvs = append(subTlvs, l)
+	}
+	tlv, err := NewTunnelEncapTLV(uint32(bgp.TUNNEL_TYPE_SR_POLICY), subTlvs...)
+	if err != nil {
+		return nil, err
+	}
+
+	mpReach, err := NewMpReachNLRIAttribute(family, []string{nexthop}, nlri)
+	if err != nil {
+		return nil, err
+	}
+	pattrs, err := NewPathAttributes(append([]proto.Message{
+		&api.OriginAttribute{Origin: uint32(bgp.BGP_ORIGIN_ATTR_TYPE_IGP)},
+		mpReach,
+		&api.TunnelEncapAttribute{Tlvs: []*api.TunnelEncapTLV{tlv}},
+	}, attrs...)...)
+	if err != nil {
+		return nil, err
+	}
+	n, err := NewNLRI(nlri)
+	if err != nil {
+		return nil, err
+	}
+	return &api.Path{
+		Nlri:   n,
+		Pattrs: pattrs,
+		Family: family,
+	}, nil
+}
@@ -0,0 +1,92 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiutil
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func Test_NewSRPolicyPath(t *testing.T) {
+	assert := assert.New(t)
+
+	segments, err := NewTunnelEncapSubTLVSRSegmentList(
+		&api.SRWeight{Weight: 10},
+		&api.SegmentTypeA{Flags: &api.SegmentFlags{}, Label: 16001 << 12},
+		&api.SegmentTypeC{Flags: &api.SegmentFlags{AFlag: true}, Algorithm: 128, Address: "10.0.0.3"},
+		&api.SegmentTypeK{Flags: &api.SegmentFlags{}, LocalAddress: "2001:db8::1", RemoteAddress: "2001:db8::2", Sid: net.ParseIP("2001:db8:1::1")},
+	)
+	require.NoError(t, err)
+	rt, err := NewExtendedCommunitiesAttribute(&api.IPv4AddressSpecificExtended{
+		IsTransitive: true,
+		SubType:      uint32(bgp.EC_SUBTYPE_ROUTE_TARGET),
+		Address:      "10.0.0.2",
+	})
+	require.NoError(t, err)
+	path, err := NewSRPolicyPath(&SRPolicyCandidatePath{
+		Distinguisher: 2,
+		Color:         100,
+		Endpoint:      "10.0.0.2",
+		Preference:    200,
+		Name:          "cp1",
+		BindingSID:    &api.SRBindingSID{SFlag: true, Sid: []byte{0x00, 0x00, 0x5d, 0xc0}},
+		ENLP:          api.ENLPType_Type4,
+		SegmentLists:  []*api.TunnelEncapSubTLVSRSegmentList{segments},
+	}, "192.168.0.1", rt)
+	require.NoError(t, err)
+	assert.Equal(bgp.RF_SR_POLICY_IPv4, ToRouteFamily(path.Family))
+
+	nlri, err := GetNativeNlri(path)
+	require.NoError(t, err)
+	assert.Equal("{ Length: 12 (bytes), Distinguisher: 2, Color 100, Endpoint: 10.0.0.2 }", nlri.String())
+	attrs, err := GetNativePathAttributes(path)
+	require.NoError(t, err)
+	assert.Len(attrs, 4)
+	assert.Equal("192.168.0.1", attrs[1].(*bgp.PathAttributeMpReachNLRI).Nexthop.String())
+	tlv := attrs[2].(*bgp.PathAttributeTunnelEncap).Value[0]
+	assert.Equal(bgp.TUNNEL_TYPE_SR_POLICY, tlv.Type)
+	assert.Len(tlv.Value, 5)
+	assert.Equal(uint32(200), tlv.Value[0].(*bgp.TunnelEncapSubTLVSRPreference).Preference)
+	assert.Equal(bgp.ENLPType4, tlv.Value[3].(*bgp.TunnelEncapSubTLVSRENLP).ENLP)
+	l := tlv.Value[4].(*bgp.TunnelEncapSubTLVSRSegmentList)
+	assert.Len(l.Segments, 3)
+	assert.Equal("cp1", tlv.Value[1].(*bgp.TunnelEncapSubTLVSRCandidatePathName).CandidatePathName)
+	assert.Equal("24000", tlv.Value[2].(*bgp.TunnelEncapSubTLVSRBSID).BSID.String())
+	assert.Equal("{V-flag: false, A-flag: true, S-flag: false, B-flag: false, Algorithm: 128, Node: 10.0.0.3, Label: n/a}", l.Segments[1].String())
+
+	// the attribute decodes back from the wire
+	buf, err := attrs[2].Serialize()
+	require.NoError(t, err)
+	a, err := bgp.GetPathAttribute(buf)
+	require.NoError(t, err)
+	require.NoError(t, a.DecodeFromBytes(buf))
+	assert.Equal(attrs[2].String(), a.String())
+
+	path, err = NewSRPolicyPath(&SRPolicyCandidatePath{Endpoint: "2001:db8::2"}, "2001:db8::100")
+	require.NoError(t, err)
+	assert.Equal(bgp.RF_SR_POLICY_IPv6, ToRouteFamily(path.Family))
+
+	_, err = NewSRPolicyPath(&SRPolicyCandidatePath{Endpoint: "invalid"}, "192.168.0.1")
+	assert.Error(err)
+	_, err = NewSRPolicyPath(&SRPolicyCandidatePath{Endpoint: "10.0.0.2", BindingSID: &api.SegmentTypeA{}}, "192.168.0.1")
+	assert.Error(err)
+}
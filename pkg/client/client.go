@@ -0,0 +1,228 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package client provides the Go client of the gobgpd gRPC API with the
+// typed methods taking and returning the native structures of
+// pkg/packet/bgp instead of the protobuf messages.
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	api "github.com/osrg/gobgp/v3/api"
+)
+
+// RetryPolicy is the policy to retry the calls failed with the transient
+// errors, e.g. while gobgpd is restarting. The calls are attempted up to
+// MaxAttempts times with the exponential backoff from InitialBackoff to
+// MaxBackoff. The calls aren't retried with MaxAttempts less than 2.
+// Only the read-only calls, Get* and List*, are retried unless a call is
+// made with Retry, since a failed call may have been done by gobgpd.
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// the status codes retried, codes.Unavailable if empty
+	Codes []codes.Code
+}
+
+// DefaultRetryPolicy retries the calls for about 10 seconds.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    6,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     4 * time.Second,
+}
+
+func (p *RetryPolicy) retryable(err error) bool {
+	code := status.Code(err)
+	if len(p.Codes) == 0 {
+		return code == codes.Unavailable
+	}
+	for _, c := range p.Codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// permanentError is the error not to be retried.
+type permanentError struct {
+	error
+}
+
+func (p *RetryPolicy) do(ctx context.Context, f func() error) error {
+	backoff := p.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		err := f()
+		if e, ok := err.(permanentError); ok {
+			return e.error
+		}
+		if err == nil || attempt >= p.MaxAttempts || !p.retryable(err) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+			backoff = p.MaxBackoff
+		}
+	}
+}
+
+// retryCallOption is the CallOption returned by Retry.
+type retryCallOption struct {
+	grpc.EmptyCallOption
+}
+
+// Retry is the CallOption to retry the call which isn't read-only, e.g.
+// AddPath, with the retry policy of the client. The call may be done twice
+// if gobgpd fails to respond after doing it.
+func Retry() grpc.CallOption {
+	return retryCallOption{}
+}
+
+// isReadOnlyMethod returns true if method only reads the state of gobgpd,
+// which is safe to call again.
+func isReadOnlyMethod(method string) bool {
+	name := method[strings.LastIndex(method, "/")+1:]
+	return strings.HasPrefix(name, "Get") || strings.HasPrefix(name, "List")
+}
+
+func (p *RetryPolicy) unaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	retry := isReadOnlyMethod(method)
+	for _, o := range opts {
+		if _, ok := o.(retryCallOption); ok {
+			retry = true
+		}
+	}
+	if !retry {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	return p.do(ctx, func() error {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	})
+}
+
+type options struct {
+	tlsConfig   *tls.Config
+	dialOptions []grpc.DialOption
+	retry       RetryPolicy
+}
+
+type ClientOption func(*options)
+
+// WithTLSConfig connects to gobgpd with TLS. The connection is insecure
+// without this option.
+func WithTLSConfig(c *tls.Config) ClientOption {
+	return func(o *options) {
+		o.tlsConfig = c
+	}
+}
+
+// WithDialOptions appends the options to dial gobgpd, e.g. for the
+// authentication.
+func WithDialOptions(opts ...grpc.DialOption) ClientOption {
+	return func(o *options) {
+		o.dialOptions = append(o.dialOptions, opts...)
+	}
+}
+
+// WithRetryPolicy replaces DefaultRetryPolicy. RetryPolicy{} disables the
+// retries.
+func WithRetryPolicy(p RetryPolicy) ClientOption {
+	return func(o *options) {
+		o.retry = p
+	}
+}
+
+// Client is the client of gobgpd. The methods are safe for the concurrent
+// use.
+type Client struct {
+	conn  *grpc.ClientConn
+	api   api.GobgpApiClient
+	retry RetryPolicy
+}
+
+// New returns the client of gobgpd at target, e.g. "localhost:50051" or
+// "unix:///var/run/gobgp.sock". New doesn't wait for the connection; the
+// connection is established by the first call and reestablished after
+// gobgpd restarts.
+func New(target string, opt ...ClientOption) (*Client, error) {
+	opts := options{
+		retry: DefaultRetryPolicy,
+	}
+	for _, o := range opt {
+		o(&opts)
+	}
+	c := &Client{
+		retry: opts.retry,
+	}
+	dialOpts := make([]grpc.DialOption, 0, len(opts.dialOptions)+2)
+	if opts.tlsConfig != nil {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(opts.tlsConfig)))
+	} else {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	dialOpts = append(dialOpts, grpc.WithChainUnaryInterceptor(c.retry.unaryInterceptor))
+	dialOpts = append(dialOpts, opts.dialOptions...)
+	conn, err := grpc.Dial(target, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	c.conn = conn
+	c.api = api.NewGobgpApiClient(conn)
+	return c, nil
+}
+
+// API returns the raw gRPC client for the methods without the typed
+// counterparts. The read-only unary calls and the ones with Retry are
+// retried with the retry policy of the client.
+func (c *Client) API() api.GobgpApiClient {
+	return c.api
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// stream calls f, which receives the messages of a server streaming call,
+// with the retry policy until f calls received for the first message, not
+// to deliver the messages twice.
+func (c *Client) stream(ctx context.Context, f func(ctx context.Context, received func()) error) error {
+	return c.retry.do(ctx, func() error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		done := false
+		if err := f(ctx, func() { done = true }); err != nil {
+			if done {
+				return permanentError{err}
+			}
+			return err
+		}
+		return nil
+	})
+}
@@ -0,0 +1,315 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+	"github.com/osrg/gobgp/v3/pkg/server"
+)
+
+func startServer(t *testing.T, sock string) *server.BgpServer {
+	s := server.NewBgpServer(server.GrpcListenAddress("unix://" + sock))
+	go s.Serve()
+	require.NoError(t, s.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        65001,
+			RouterId:   "1.1.1.1",
+			ListenPort: -1,
+		},
+	}))
+	return s
+}
+
+func newTestClient(t *testing.T) *Client {
+	sock := filepath.Join(t.TempDir(), "gobgp.sock")
+	s := startServer(t, sock)
+	t.Cleanup(s.Stop)
+	c, err := New("unix://" + sock)
+	require.NoError(t, err)
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestRetryPolicy(t *testing.T) {
+	assert := assert.New(t)
+
+	p := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+	n := 0
+	err := p.do(context.Background(), func() error {
+		n++
+		return status.Error(codes.Unavailable, "")
+	})
+	assert.Equal(codes.Unavailable, status.Code(err))
+	assert.Equal(3, n)
+
+	n = 0
+	err = p.do(context.Background(), func() error {
+		n++
+		return status.Error(codes.InvalidArgument, "")
+	})
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+	assert.Equal(1, n)
+
+	n = 0
+	err = p.do(context.Background(), func() error {
+		n++
+		return permanentError{status.Error(codes.Unavailable, "")}
+	})
+	assert.Equal(codes.Unavailable, status.Code(err))
+	assert.Equal(1, n)
+
+	p.Codes = []codes.Code{codes.ResourceExhausted}
+	n = 0
+	err = p.do(context.Background(), func() error {
+		if n++; n < 2 {
+			return status.Error(codes.ResourceExhausted, "")
+		}
+		return nil
+	})
+	assert.NoError(err)
+	assert.Equal(2, n)
+
+	// no retries
+	p = RetryPolicy{}
+	n = 0
+	p.do(context.Background(), func() error {
+		n++
+		return status.Error(codes.Unavailable, "")
+	})
+	assert.Equal(1, n)
+}
+
+func TestRetryReadOnlyMethods(t *testing.T) {
+	assert := assert.New(t)
+
+	p := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+	call := func(method string, opts ...grpc.CallOption) int {
+		n := 0
+		err := p.unaryInterceptor(context.Background(), method, nil, nil, nil, func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+			n++
+			return status.Error(codes.Unavailable, "")
+		}, opts...)
+		assert.Equal(codes.Unavailable, status.Code(err))
+		return n
+	}
+	assert.Equal(3, call("/apipb.GobgpApi/GetBgp"))
+	assert.Equal(3, call("/apipb.GobgpApi/ListPeer"))
+	// the call which isn't read-only may have been done
+	for _, m := range []string{"AddPath", "DeletePath", "AddPeer", "StartBgp"} {
+		assert.Equal(1, call("/apipb.GobgpApi/"+m), m)
+	}
+	assert.Equal(3, call("/apipb.GobgpApi/AddPath", Retry()))
+}
+
+func TestRetryUntilServerStarts(t *testing.T) {
+	sock := filepath.Join(t.TempDir(), "gobgp.sock")
+	c, err := New("unix://"+sock, WithRetryPolicy(RetryPolicy{
+		MaxAttempts:    20,
+		InitialBackoff: 50 * time.Millisecond,
+		MaxBackoff:     100 * time.Millisecond,
+	}))
+	require.NoError(t, err)
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	ch := make(chan *Global)
+	go func() {
+		g, err := c.GetGlobal(ctx)
+		assert.NoError(t, err)
+		ch <- g
+	}()
+	time.Sleep(200 * time.Millisecond)
+	s := startServer(t, sock)
+	defer s.Stop()
+	g := <-ch
+	require.NotNil(t, g)
+	assert.Equal(t, uint32(65001), g.ASN)
+	assert.Equal(t, "1.1.1.1", g.RouterID.String())
+}
+
+func TestRoute(t *testing.T) {
+	assert := assert.New(t)
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	r4 := Route{
+		Nlri: bgp.NewIPAddrPrefix(24, "10.0.0.0"),
+		Attrs: []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(bgp.BGP_ORIGIN_ATTR_TYPE_IGP),
+			bgp.NewPathAttributeNextHop("10.0.0.1"),
+			bgp.NewPathAttributeCommunities([]uint32{100}),
+		},
+	}
+	uuid, err := c.AddRoute(ctx, r4)
+	assert.NoError(err)
+	assert.NotEmpty(uuid)
+	r6 := Route{
+		Nlri: bgp.NewIPv6AddrPrefix(64, "2001:db8::"),
+		Attrs: []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(bgp.BGP_ORIGIN_ATTR_TYPE_IGP),
+			bgp.NewPathAttributeNextHop("2001:db8::1"),
+		},
+	}
+	_, err = c.AddRoute(ctx, r6)
+	assert.NoError(err)
+	_, err = c.AddRoute(ctx, Route{})
+	assert.Error(err)
+
+	dsts, err := c.ListRoutes(ctx, RouteQuery{Family: bgp.RF_IPv4_UC})
+	assert.NoError(err)
+	assert.Len(dsts, 1)
+	assert.Len(dsts[0], 1)
+	r := dsts[0][0]
+	assert.Equal("10.0.0.0/24", r.Nlri.String())
+	assert.Equal(bgp.RF_IPv4_UC, r.Family())
+	assert.Equal("10.0.0.1", r.NextHop().String())
+	assert.True(r.Best)
+	found := false
+	for _, a := range r.Attrs {
+		if c, ok := a.(*bgp.PathAttributeCommunities); ok {
+			assert.Equal([]uint32{100}, c.Value)
+			found = true
+		}
+	}
+	assert.True(found)
+
+	dsts, err = c.ListRoutes(ctx, RouteQuery{Family: bgp.RF_IPv6_UC, Prefixes: []string{"2001:db8::/32"}, MoreSpecifics: true})
+	assert.NoError(err)
+	assert.Len(dsts, 1)
+	assert.Equal("2001:db8::1", dsts[0][0].NextHop().String())
+	dsts, err = c.ListRoutes(ctx, RouteQuery{Family: bgp.RF_IPv6_UC, Prefixes: []string{"2001:db8::/32"}})
+	assert.NoError(err)
+	assert.Len(dsts, 0)
+
+	assert.NoError(c.DeleteRoute(ctx, r6))
+	assert.NoError(c.DeleteRouteByUUID(ctx, uuid))
+	for _, rf := range []bgp.RouteFamily{bgp.RF_IPv4_UC, bgp.RF_IPv6_UC} {
+		dsts, err = c.ListRoutes(ctx, RouteQuery{Family: rf})
+		assert.NoError(err)
+		assert.Len(dsts, 0)
+	}
+}
+
+func TestWatchBestRoutes(t *testing.T) {
+	assert := assert.New(t)
+	c := newTestClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r := Route{
+		Nlri: bgp.NewIPAddrPrefix(24, "10.0.0.0"),
+		Attrs: []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(bgp.BGP_ORIGIN_ATTR_TYPE_IGP),
+			bgp.NewPathAttributeNextHop("10.0.0.1"),
+		},
+	}
+	_, err := c.AddRoute(ctx, r)
+	assert.NoError(err)
+
+	ch := make(chan *Route, 8)
+	errCh := make(chan error, 1)
+	wctx, wcancel := context.WithCancel(ctx)
+	go func() {
+		errCh <- c.WatchBestRoutes(wctx, func(l []*Route) {
+			for _, r := range l {
+				ch <- r
+			}
+		})
+	}()
+
+	// the current best route first
+	select {
+	case got := <-ch:
+		assert.Equal("10.0.0.0/24", got.Nlri.String())
+		assert.False(got.Withdrawal)
+	case <-ctx.Done():
+		t.Fatal("no current best route")
+	}
+
+	assert.NoError(c.DeleteRoute(ctx, r))
+	select {
+	case got := <-ch:
+		assert.Equal("10.0.0.0/24", got.Nlri.String())
+		assert.True(got.Withdrawal)
+	case <-ctx.Done():
+		t.Fatal("no withdrawal")
+	}
+
+	wcancel()
+	assert.ErrorIs(<-errCh, context.Canceled)
+}
+
+func TestNeighbor(t *testing.T) {
+	assert := assert.New(t)
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	assert.NoError(c.AddNeighbor(ctx, Neighbor{
+		Address:     net.ParseIP("10.0.0.2"),
+		PeerASN:     65002,
+		Description: "peer1",
+		Families:    []bgp.RouteFamily{bgp.RF_IPv4_UC, bgp.RF_IPv6_UC},
+	}))
+	assert.NoError(c.AddNeighbor(ctx, Neighbor{
+		Address: net.ParseIP("10.0.0.3"),
+		PeerASN: 65003,
+	}))
+
+	l, err := c.ListNeighbors(ctx, nil)
+	assert.NoError(err)
+	assert.Len(l, 2)
+
+	l, err = c.ListNeighbors(ctx, net.ParseIP("10.0.0.2"))
+	assert.NoError(err)
+	assert.Len(l, 1)
+	n := l[0]
+	assert.Equal("10.0.0.2", n.Address.String())
+	assert.Equal(uint32(65002), n.PeerASN)
+	assert.Equal(uint32(65001), n.LocalASN)
+	assert.Equal("peer1", n.Description)
+	assert.ElementsMatch([]bgp.RouteFamily{bgp.RF_IPv4_UC, bgp.RF_IPv6_UC}, n.Families)
+	assert.NotEqual(bgp.BGP_FSM_ESTABLISHED, n.State)
+	assert.True(n.Uptime.IsZero())
+
+	assert.NoError(c.DeleteNeighbor(ctx, net.ParseIP("10.0.0.2")))
+	assert.Error(c.DeleteNeighbor(ctx, net.ParseIP("10.0.0.2")))
+	l, err = c.ListNeighbors(ctx, nil)
+	assert.NoError(err)
+	assert.Len(l, 1)
+	assert.Equal("10.0.0.3", l[0].Address.String())
+}
+
+func TestToFSMState(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(bgp.BGP_FSM_IDLE, toFSMState(api.PeerState_UNKNOWN))
+	assert.Equal(bgp.BGP_FSM_IDLE, toFSMState(api.PeerState_IDLE))
+	assert.Equal(bgp.BGP_FSM_OPENCONFIRM, toFSMState(api.PeerState_OPENCONFIRM))
+	assert.Equal(bgp.BGP_FSM_ESTABLISHED, toFSMState(api.PeerState_ESTABLISHED))
+}
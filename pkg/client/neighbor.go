@@ -0,0 +1,170 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"io"
+	"net"
+	"time"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/apiutil"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+// Global is the global configuration of gobgpd.
+type Global struct {
+	ASN        uint32
+	RouterID   net.IP
+	ListenPort int32
+}
+
+func (c *Client) GetGlobal(ctx context.Context) (*Global, error) {
+	rsp, err := c.api.GetBgp(ctx, &api.GetBgpRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &Global{
+		ASN:        rsp.Global.Asn,
+		RouterID:   net.ParseIP(rsp.Global.RouterId),
+		ListenPort: rsp.Global.ListenPort,
+	}, nil
+}
+
+// Neighbor is a BGP neighbor. Use API() for the configuration not
+// covered.
+type Neighbor struct {
+	Address     net.IP
+	PeerASN     uint32
+	LocalASN    uint32
+	Description string
+	// the default families of the address if empty
+	Families []bgp.RouteFamily
+
+	// the following fields are set only by ListNeighbors
+	State    bgp.FSMState
+	RouterID net.IP
+	Uptime   time.Time
+	// the numbers of the received and accepted routes
+	Received map[bgp.RouteFamily]uint64
+	Accepted map[bgp.RouteFamily]uint64
+}
+
+func (n *Neighbor) toApiPeer() *api.Peer {
+	p := &api.Peer{
+		Conf: &api.PeerConf{
+			NeighborAddress: n.Address.String(),
+			PeerAsn:         n.PeerASN,
+			LocalAsn:        n.LocalASN,
+			Description:     n.Description,
+		},
+		AfiSafis: make([]*api.AfiSafi, 0, len(n.Families)),
+	}
+	for _, rf := range n.Families {
+		p.AfiSafis = append(p.AfiSafis, &api.AfiSafi{
+			Config: &api.AfiSafiConfig{
+				Family:  apiutil.ToApiFamily(bgp.RouteFamilyToAfiSafi(rf)),
+				Enabled: true,
+			},
+		})
+	}
+	return p
+}
+
+// the session states of the API are shifted by one from bgp.FSMState for
+// UNKNOWN
+func toFSMState(s api.PeerState_SessionState) bgp.FSMState {
+	if s == api.PeerState_UNKNOWN {
+		return bgp.BGP_FSM_IDLE
+	}
+	return bgp.FSMState(s - 1)
+}
+
+func newNeighborFromApiPeer(p *api.Peer) *Neighbor {
+	n := &Neighbor{
+		Address:     net.ParseIP(p.Conf.NeighborAddress),
+		PeerASN:     p.Conf.PeerAsn,
+		LocalASN:    p.Conf.LocalAsn,
+		Description: p.Conf.Description,
+		Families:    make([]bgp.RouteFamily, 0, len(p.AfiSafis)),
+		Received:    make(map[bgp.RouteFamily]uint64),
+		Accepted:    make(map[bgp.RouteFamily]uint64),
+	}
+	if p.State != nil {
+		n.State = toFSMState(p.State.SessionState)
+		n.RouterID = net.ParseIP(p.State.RouterId)
+	}
+	if p.Timers != nil && p.Timers.State != nil && p.Timers.State.Uptime != nil && n.State == bgp.BGP_FSM_ESTABLISHED {
+		n.Uptime = p.Timers.State.Uptime.AsTime()
+	}
+	for _, a := range p.AfiSafis {
+		if a.Config == nil || a.Config.Family == nil {
+			continue
+		}
+		rf := apiutil.ToRouteFamily(a.Config.Family)
+		n.Families = append(n.Families, rf)
+		if a.State != nil {
+			n.Received[rf] = a.State.Received
+			n.Accepted[rf] = a.State.Accepted
+		}
+	}
+	return n
+}
+
+func (c *Client) AddNeighbor(ctx context.Context, n Neighbor) error {
+	_, err := c.api.AddPeer(ctx, &api.AddPeerRequest{
+		Peer: n.toApiPeer(),
+	})
+	return err
+}
+
+func (c *Client) DeleteNeighbor(ctx context.Context, address net.IP) error {
+	_, err := c.api.DeletePeer(ctx, &api.DeletePeerRequest{
+		Address: address.String(),
+	})
+	return err
+}
+
+// ListNeighbors returns the neighbor of address, all the neighbors if nil.
+func (c *Client) ListNeighbors(ctx context.Context, address net.IP) ([]*Neighbor, error) {
+	req := &api.ListPeerRequest{}
+	if address != nil {
+		req.Address = address.String()
+	}
+	var l []*Neighbor
+	err := c.stream(ctx, func(ctx context.Context, received func()) error {
+		stream, err := c.api.ListPeer(ctx, req)
+		if err != nil {
+			return err
+		}
+		l = make([]*Neighbor, 0)
+		for {
+			r, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			received()
+			l = append(l, newNeighborFromApiPeer(r.Peer))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
@@ -0,0 +1,259 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/apiutil"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+// Route is a path of the RIBs. The next hop is given by
+// bgp.PathAttributeNextHop or bgp.PathAttributeMpReachNLRI in Attrs for
+// any family.
+type Route struct {
+	Nlri  bgp.AddrPrefixInterface
+	Attrs []bgp.PathAttributeInterface
+	// the VRF to add the route to or to delete the route from, the global
+	// RIB if empty
+	Vrf string
+
+	// the following fields are set only by the methods listing the routes
+	Best       bool
+	Age        time.Time
+	Stale      bool
+	Filtered   bool
+	Withdrawal bool
+	NeighborIP net.IP
+	SourceID   net.IP
+}
+
+// Family returns the route family of the NLRI.
+func (r *Route) Family() bgp.RouteFamily {
+	return bgp.AfiSafiToRouteFamily(r.Nlri.AFI(), r.Nlri.SAFI())
+}
+
+// NextHop returns the next hop in the attributes, nil if none.
+func (r *Route) NextHop() net.IP {
+	for _, a := range r.Attrs {
+		switch a := a.(type) {
+		case *bgp.PathAttributeNextHop:
+			return a.Value
+		case *bgp.PathAttributeMpReachNLRI:
+			return a.Nexthop
+		}
+	}
+	return nil
+}
+
+func (r *Route) toApiPath() (*api.Path, error) {
+	if r.Nlri == nil {
+		return nil, fmt.Errorf("nlri not specified")
+	}
+	return apiutil.NewPath(r.Nlri, r.Withdrawal, r.Attrs, time.Now())
+}
+
+func newRouteFromApiPath(p *api.Path) (*Route, error) {
+	nlri, err := apiutil.GetNativeNlri(p)
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := apiutil.GetNativePathAttributes(p)
+	if err != nil {
+		return nil, err
+	}
+	r := &Route{
+		Nlri:       nlri,
+		Attrs:      attrs,
+		Best:       p.Best,
+		Stale:      p.Stale,
+		Filtered:   p.Filtered,
+		Withdrawal: p.IsWithdraw,
+		NeighborIP: net.ParseIP(p.NeighborIp),
+		SourceID:   net.ParseIP(p.SourceId),
+	}
+	if p.Age != nil {
+		r.Age = p.Age.AsTime()
+	}
+	return r, nil
+}
+
+func tableType(vrf string) api.TableType {
+	if vrf != "" {
+		return api.TableType_VRF
+	}
+	return api.TableType_GLOBAL
+}
+
+// AddRoute adds the route to the global RIB or the VRF, and returns the
+// UUID to delete it with DeleteRouteByUUID.
+func (c *Client) AddRoute(ctx context.Context, r Route) ([]byte, error) {
+	p, err := r.toApiPath()
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := c.api.AddPath(ctx, &api.AddPathRequest{
+		TableType: tableType(r.Vrf),
+		VrfId:     r.Vrf,
+		Path:      p,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Uuid, nil
+}
+
+// DeleteRoute deletes the route added with the same NLRI. The attributes
+// are ignored.
+func (c *Client) DeleteRoute(ctx context.Context, r Route) error {
+	p, err := r.toApiPath()
+	if err != nil {
+		return err
+	}
+	_, err = c.api.DeletePath(ctx, &api.DeletePathRequest{
+		TableType: tableType(r.Vrf),
+		VrfId:     r.Vrf,
+		Family:    p.Family,
+		Path:      p,
+	})
+	return err
+}
+
+func (c *Client) DeleteRouteByUUID(ctx context.Context, uuid []byte) error {
+	_, err := c.api.DeletePath(ctx, &api.DeletePathRequest{
+		TableType: api.TableType_GLOBAL,
+		Uuid:      uuid,
+	})
+	return err
+}
+
+// RouteQuery selects the routes listed by ListRoutes.
+type RouteQuery struct {
+	// GLOBAL if zero
+	TableType api.TableType
+	// the neighbor address for ADJ_IN and ADJ_OUT, or the VRF name
+	Name   string
+	Family bgp.RouteFamily
+	// the prefixes to look up, all the routes if empty
+	Prefixes []string
+	// the more specific routes of Prefixes too
+	MoreSpecifics bool
+	// only the best routes
+	BestOnly bool
+}
+
+// ListRoutes returns the routes selected by q, grouped by the
+// destinations in the order of gobgpd.
+func (c *Client) ListRoutes(ctx context.Context, q RouteQuery) ([][]*Route, error) {
+	req := &api.ListPathRequest{
+		TableType: q.TableType,
+		Name:      q.Name,
+		Family:    apiutil.ToApiFamily(bgp.RouteFamilyToAfiSafi(q.Family)),
+		Prefixes:  make([]*api.TableLookupPrefix, 0, len(q.Prefixes)),
+	}
+	for _, prefix := range q.Prefixes {
+		l := &api.TableLookupPrefix{Prefix: prefix}
+		if q.MoreSpecifics {
+			l.Type = api.TableLookupPrefix_LONGER
+		}
+		req.Prefixes = append(req.Prefixes, l)
+	}
+	var dsts [][]*Route
+	err := c.stream(ctx, func(ctx context.Context, received func()) error {
+		stream, err := c.api.ListPath(ctx, req)
+		if err != nil {
+			return err
+		}
+		dsts = make([][]*Route, 0)
+		for {
+			r, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			received()
+			routes := make([]*Route, 0, len(r.Destination.Paths))
+			for _, p := range r.Destination.Paths {
+				if q.BestOnly && !p.Best {
+					continue
+				}
+				route, err := newRouteFromApiPath(p)
+				if err != nil {
+					return err
+				}
+				routes = append(routes, route)
+			}
+			if len(routes) > 0 {
+				dsts = append(dsts, routes)
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dsts, nil
+}
+
+// WatchBestRoutes calls f with the changes of the best routes of the global
+// RIB, the current ones first, until ctx is done or the connection is lost.
+// The routes no longer the best are given with Withdrawal.
+func (c *Client) WatchBestRoutes(ctx context.Context, f func([]*Route)) error {
+	return c.stream(ctx, func(ctx context.Context, received func()) error {
+		stream, err := c.api.WatchEvent(ctx, &api.WatchEventRequest{
+			Table: &api.WatchEventRequest_Table{
+				Filters: []*api.WatchEventRequest_Table_Filter{
+					{
+						Type: api.WatchEventRequest_Table_Filter_BEST,
+						Init: true,
+					},
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		for {
+			r, err := stream.Recv()
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				return err
+			}
+			received()
+			t := r.GetTable()
+			if t == nil {
+				continue
+			}
+			routes := make([]*Route, 0, len(t.Paths))
+			for _, p := range t.Paths {
+				route, err := newRouteFromApiPath(p)
+				if err != nil {
+					return err
+				}
+				routes = append(routes, route)
+			}
+			f(routes)
+		}
+	})
+}
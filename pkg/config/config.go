@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"strings"
 
 	apb "google.golang.org/protobuf/types/known/anypb"
 
@@ -20,6 +21,12 @@ func ReadConfigFile(configFile, configType string) (*oc.BgpConfigSet, error) {
 	return oc.ReadConfigfile(configFile, configType)
 }
 
+// ValidateConfigFile checks a config file and returns all the problems
+// found with their locations, nil if the file is valid.
+func ValidateConfigFile(configFile, configType string) []*oc.ConfigError {
+	return oc.ValidateConfigFile(configFile, configType)
+}
+
 // WatchConfigFile calls the callback function anytime an update to the
 // config file is detected.
 func WatchConfigFile(configFile, configType string, callBack func()) {
@@ -157,6 +164,7 @@ func addDynamicNeighbors(ctx context.Context, bgpServer *server.BgpServer, dynam
 			DynamicNeighbor: &api.DynamicNeighbor{
 				Prefix:    dn.Config.Prefix,
 				PeerGroup: dn.Config.PeerGroup,
+				Interface: dn.Config.Interface,
 			},
 		}); err != nil {
 			bgpServer.Log().Warn("Failed to add Dynamic Neighbor to PeerGroup",
@@ -194,7 +202,8 @@ func deleteNeighbors(ctx context.Context, bgpServer *server.BgpServer, deleted [
 				"Topic": "config",
 				"Key":   p.State.NeighborAddress})
 		if err := bgpServer.DeletePeer(ctx, &api.DeletePeerRequest{
-			Address: p.State.NeighborAddress,
+			Address:   p.State.NeighborAddress,
+			Interface: p.Config.NeighborInterface,
 		}); err != nil {
 			bgpServer.Log().Warn("Failed to delete Peer",
 				log.Fields{
@@ -226,6 +235,254 @@ func updateNeighbors(ctx context.Context, bgpServer *server.BgpServer, updated [
 	return false
 }
 
+func addAggregates(ctx context.Context, bgpServer *server.BgpServer, added []oc.Aggregate) {
+	for _, a := range added {
+		if err := bgpServer.AddAggregate(ctx, &api.AddAggregateRequest{
+			Aggregate: &api.Aggregate{
+				Prefix:            a.Config.Prefix,
+				AsSet:             a.Config.AsSet,
+				SummaryOnly:       a.Config.SummaryOnly,
+				SuppressNeighbors: a.Config.SuppressNeighborList,
+			},
+		}); err != nil {
+			bgpServer.Log().Warn("failed to add aggregate",
+				log.Fields{
+					"Topic":  "config",
+					"Prefix": a.Config.Prefix,
+					"Error":  err})
+		}
+	}
+}
+
+// updateAggregates deletes the aggregates removed or changed in newConfig
+// and adds the ones added or changed.
+func updateAggregates(ctx context.Context, bgpServer *server.BgpServer, c, newConfig *oc.BgpConfigSet) {
+	current := make(map[string]*oc.Aggregate)
+	for i, a := range c.Aggregates {
+		current[a.Config.Prefix] = &c.Aggregates[i]
+	}
+	added := make([]oc.Aggregate, 0)
+	for _, a := range newConfig.Aggregates {
+		if old, y := current[a.Config.Prefix]; y {
+			delete(current, a.Config.Prefix)
+			if old.Equal(&a) {
+				continue
+			}
+			current[a.Config.Prefix] = old
+		}
+		added = append(added, a)
+	}
+	for prefix := range current {
+		if err := bgpServer.DeleteAggregate(ctx, &api.DeleteAggregateRequest{
+			Prefix: prefix,
+		}); err != nil {
+			bgpServer.Log().Warn("failed to delete aggregate",
+				log.Fields{
+					"Topic":  "config",
+					"Prefix": prefix,
+					"Error":  err})
+		}
+	}
+	addAggregates(ctx, bgpServer, added)
+}
+
+func addVrfLeaks(ctx context.Context, bgpServer *server.BgpServer, added []oc.VrfLeak) {
+	for _, l := range added {
+		def := api.RouteAction_ACCEPT
+		if l.Config.DefaultPolicy == oc.DEFAULT_POLICY_TYPE_REJECT_ROUTE {
+			def = api.RouteAction_REJECT
+		}
+		if err := bgpServer.AddVrfLeak(ctx, &api.AddVrfLeakRequest{
+			Leak: &api.VrfLeak{
+				Name:           l.Config.Name,
+				SourceVrf:      l.Config.SourceVrf,
+				DestinationVrf: l.Config.DestinationVrf,
+				Policies:       l.Config.PolicyList,
+				DefaultAction:  def,
+			},
+		}); err != nil {
+			bgpServer.Log().Warn("failed to add vrf leak",
+				log.Fields{
+					"Topic": "config",
+					"Name":  l.Config.Name,
+					"Error": err})
+		}
+	}
+}
+
+// updateVrfLeaks deletes the vrf leaks removed or changed in newConfig
+// and adds the ones added or changed.
+func updateVrfLeaks(ctx context.Context, bgpServer *server.BgpServer, c, newConfig *oc.BgpConfigSet) {
+	current := make(map[string]*oc.VrfLeak)
+	for i, l := range c.VrfLeaks {
+		current[l.Config.Name] = &c.VrfLeaks[i]
+	}
+	added := make([]oc.VrfLeak, 0)
+	for _, l := range newConfig.VrfLeaks {
+		if old, y := current[l.Config.Name]; y {
+			delete(current, l.Config.Name)
+			if old.Equal(&l) {
+				continue
+			}
+			current[l.Config.Name] = old
+		}
+		added = append(added, l)
+	}
+	for name := range current {
+		if err := bgpServer.DeleteVrfLeak(ctx, &api.DeleteVrfLeakRequest{
+			Name: name,
+		}); err != nil {
+			bgpServer.Log().Warn("failed to delete vrf leak",
+				log.Fields{
+					"Topic": "config",
+					"Name":  name,
+					"Error": err})
+		}
+	}
+	addVrfLeaks(ctx, bgpServer, added)
+}
+
+func addOrrVantagePoints(ctx context.Context, bgpServer *server.BgpServer, added []oc.OrrVantagePoint) {
+	for _, vp := range added {
+		costs := make([]*api.OrrIgpCost, 0, len(vp.Config.IgpCostList))
+		for _, c := range vp.Config.IgpCostList {
+			costs = append(costs, &api.OrrIgpCost{
+				Prefix: c.Prefix,
+				Cost:   c.Cost,
+			})
+		}
+		if err := bgpServer.AddOrrVantagePoint(ctx, &api.AddOrrVantagePointRequest{
+			VantagePoint: &api.OrrVantagePoint{
+				Name:     vp.Config.Name,
+				IgpCosts: costs,
+			},
+		}); err != nil {
+			bgpServer.Log().Warn("failed to add orr vantage point",
+				log.Fields{
+					"Topic": "config",
+					"Name":  vp.Config.Name,
+					"Error": err})
+		}
+	}
+}
+
+// updateOrrVantagePoints deletes the vantage points removed in newConfig
+// and adds the ones added or changed, which replaces the IGP costs.
+func updateOrrVantagePoints(ctx context.Context, bgpServer *server.BgpServer, c, newConfig *oc.BgpConfigSet) {
+	current := make(map[string]*oc.OrrVantagePoint)
+	for i, vp := range c.OrrVantagePoints {
+		current[vp.Config.Name] = &c.OrrVantagePoints[i]
+	}
+	added := make([]oc.OrrVantagePoint, 0)
+	for _, vp := range newConfig.OrrVantagePoints {
+		old, y := current[vp.Config.Name]
+		delete(current, vp.Config.Name)
+		if y && old.Equal(&vp) {
+			continue
+		}
+		added = append(added, vp)
+	}
+	addOrrVantagePoints(ctx, bgpServer, added)
+	for name := range current {
+		if err := bgpServer.DeleteOrrVantagePoint(ctx, &api.DeleteOrrVantagePointRequest{
+			Name: name,
+		}); err != nil {
+			bgpServer.Log().Warn("failed to delete orr vantage point",
+				log.Fields{
+					"Topic": "config",
+					"Name":  name,
+					"Error": err})
+		}
+	}
+}
+
+func newEvpnVpwsFromConfigStruct(c *oc.EvpnVpwsInstanceConfig) (*api.EvpnVpws, error) {
+	rd, err := bgp.ParseRouteDistinguisher(c.Rd)
+	if err != nil {
+		return nil, err
+	}
+	a, err := apiutil.MarshalRD(rd)
+	if err != nil {
+		return nil, err
+	}
+	rts, err := marshalRouteTargets(c.RouteTargetList)
+	if err != nil {
+		return nil, err
+	}
+	esi, err := bgp.ParseEthernetSegmentIdentifier(strings.Fields(c.Esi))
+	if err != nil {
+		return nil, err
+	}
+	e, err := apiutil.NewEthernetSegmentIdentifierFromNative(&esi)
+	if err != nil {
+		return nil, err
+	}
+	b, err := apb.New(e)
+	if err != nil {
+		return nil, err
+	}
+	return &api.EvpnVpws{
+		Name:            c.Name,
+		Rd:              a,
+		RouteTargets:    rts,
+		Esi:             b,
+		LocalServiceId:  c.LocalServiceId,
+		RemoteServiceId: c.RemoteServiceId,
+		Label:           c.Label,
+		ControlWord:     c.ControlWord,
+		FlowLabel:       c.FlowLabel,
+		Mtu:             uint32(c.Mtu),
+	}, nil
+}
+
+func addEvpnVpws(ctx context.Context, bgpServer *server.BgpServer, added []oc.EvpnVpwsInstance) {
+	for _, v := range added {
+		vpws, err := newEvpnVpwsFromConfigStruct(&v.Config)
+		if err == nil {
+			err = bgpServer.AddEvpnVpws(ctx, &api.AddEvpnVpwsRequest{Vpws: vpws})
+		}
+		if err != nil {
+			bgpServer.Log().Warn("failed to add evpn vpws",
+				log.Fields{
+					"Topic": "config",
+					"Name":  v.Config.Name,
+					"Error": err})
+		}
+	}
+}
+
+// updateEvpnVpws deletes the evpn vpws instances removed or changed in
+// newConfig and adds the ones added or changed.
+func updateEvpnVpws(ctx context.Context, bgpServer *server.BgpServer, c, newConfig *oc.BgpConfigSet) {
+	current := make(map[string]*oc.EvpnVpwsInstance)
+	for i, v := range c.EvpnVpws {
+		current[v.Config.Name] = &c.EvpnVpws[i]
+	}
+	added := make([]oc.EvpnVpwsInstance, 0)
+	for _, v := range newConfig.EvpnVpws {
+		if old, y := current[v.Config.Name]; y {
+			delete(current, v.Config.Name)
+			if old.Equal(&v) {
+				continue
+			}
+			current[v.Config.Name] = old
+		}
+		added = append(added, v)
+	}
+	for name := range current {
+		if err := bgpServer.DeleteEvpnVpws(ctx, &api.DeleteEvpnVpwsRequest{
+			Name: name,
+		}); err != nil {
+			bgpServer.Log().Warn("failed to delete evpn vpws",
+				log.Fields{
+					"Topic": "config",
+					"Name":  name,
+					"Error": err})
+		}
+	}
+	addEvpnVpws(ctx, bgpServer, added)
+}
+
 // InitialConfig applies initial configuration to a pristine gobgp instance. It
 // can only be called once for an instance. Subsequent changes to the
 // configuration can be applied using UpdateConfig. The BgpConfigSet can be
@@ -257,16 +514,88 @@ func InitialConfig(ctx context.Context, bgpServer *server.BgpServer, newConfig *
 		}
 	}
 
+	if newConfig.Netlink.Config.Enabled {
+		families := make([]*api.Family, 0, len(newConfig.Netlink.Config.FamilyList))
+		for _, f := range newConfig.Netlink.Config.FamilyList {
+			rf, err := bgp.GetRouteFamily(f)
+			if err != nil {
+				bgpServer.Log().Fatal("failed to set netlink config",
+					log.Fields{"Topic": "config", "Error": err})
+			}
+			afi, safi := bgp.RouteFamilyToAfiSafi(rf)
+			families = append(families, apiutil.ToApiFamily(afi, safi))
+		}
+		if err := bgpServer.EnableNetlink(ctx, &api.EnableNetlinkRequest{
+			Families:      families,
+			TableId:       newConfig.Netlink.Config.TableId,
+			Protocol:      uint32(newConfig.Netlink.Config.Protocol),
+			Metric:        newConfig.Netlink.Config.Metric,
+			ReconcileTime: uint32(newConfig.Netlink.Config.ReconcileTime),
+		}); err != nil {
+			bgpServer.Log().Fatal("failed to set netlink config",
+				log.Fields{"Topic": "config", "Error": err})
+		}
+	}
+
+	if newConfig.Srv6.Config.Locator != "" {
+		if err := bgpServer.SetSrv6Locator(ctx, &api.SetSrv6LocatorRequest{
+			Prefix:         newConfig.Srv6.Config.Locator,
+			BlockLength:    uint32(newConfig.Srv6.Config.LocatorBlockLength),
+			NodeLength:     uint32(newConfig.Srv6.Config.LocatorNodeLength),
+			FunctionLength: uint32(newConfig.Srv6.Config.FunctionLength),
+			ArgumentLength: uint32(newConfig.Srv6.Config.ArgumentLength),
+			Transposition:  newConfig.Srv6.Config.Transposition,
+		}); err != nil {
+			bgpServer.Log().Fatal("failed to set srv6 config",
+				log.Fields{"Topic": "config", "Error": err})
+		}
+	}
+
+	if newConfig.Peeringdb.Config.Enabled {
+		if err := bgpServer.EnablePeeringDb(ctx, &api.EnablePeeringDbRequest{
+			Url:                 newConfig.Peeringdb.Config.Url,
+			ApiKey:              newConfig.Peeringdb.Config.ApiKey,
+			RefreshInterval:     newConfig.Peeringdb.Config.RefreshInterval,
+			Multiplier:          newConfig.Peeringdb.Config.Multiplier,
+			WarningThresholdPct: uint32(newConfig.Peeringdb.Config.WarningThresholdPct),
+		}); err != nil {
+			bgpServer.Log().Fatal("failed to set peeringdb config",
+				log.Fields{"Topic": "config", "Error": err})
+		}
+	}
+
 	if len(newConfig.Collector.Config.Url) > 0 {
 		bgpServer.Log().Fatal("collector feature is not supported",
 			log.Fields{"Topic": "config"})
 	}
 
+	if newConfig.RpkiSlurm.Config.File != "" {
+		if err := bgpServer.SetRpkiSlurm(ctx, &api.SetRpkiSlurmRequest{
+			File: newConfig.RpkiSlurm.Config.File,
+		}); err != nil {
+			bgpServer.Log().Fatal("failed to set rpki slurm config",
+				log.Fields{"Topic": "config", "Error": err})
+		}
+	}
 	for _, c := range newConfig.RpkiServers {
 		if err := bgpServer.AddRpki(ctx, &api.AddRpkiRequest{
-			Address:  c.Config.Address,
-			Port:     c.Config.Port,
-			Lifetime: c.Config.RecordLifetime,
+			Address:    c.Config.Address,
+			Port:       c.Config.Port,
+			Lifetime:   c.Config.RecordLifetime,
+			Transport:  api.RPKIConf_Transport(c.Config.Transport.ToInt()),
+			Preference: uint32(c.Config.Preference),
+			Tls: &api.RPKITLSConf{
+				CaFile:     c.Config.TlsCaFile,
+				CertFile:   c.Config.TlsCertFile,
+				KeyFile:    c.Config.TlsKeyFile,
+				ServerName: c.Config.TlsServerName,
+			},
+			Ssh: &api.RPKISSHConf{
+				Username:       c.Config.SshUsername,
+				Password:       c.Config.SshPassword,
+				PrivateKeyFile: c.Config.SshPrivateKeyFile,
+				KnownHostsFile: c.Config.SshKnownHostsFile,
+			},
 		}); err != nil {
 			bgpServer.Log().Fatal("failed to set rpki config",
 				log.Fields{"Topic": "config", "Error": err})
@@ -280,11 +609,39 @@ func InitialConfig(ctx context.Context, bgpServer *server.BgpServer, newConfig *
 			SysDescr:          c.Config.SysDescr,
 			Policy:            api.AddBmpRequest_MonitoringPolicy(c.Config.RouteMonitoringPolicy.ToInt()),
 			StatisticsTimeout: int32(c.Config.StatisticsTimeout),
+			Mode:              api.AddBmpRequest_Mode(c.Config.Mode.ToInt()),
+			LocalAddress:      c.Config.LocalAddress,
+			AuthPassword:      c.Config.AuthPassword,
+			Transport:         api.AddBmpRequest_Transport(c.Config.Transport.ToInt()),
+			Tls: &api.BMPTLSConf{
+				CaFile:     c.Config.TlsCaFile,
+				CertFile:   c.Config.TlsCertFile,
+				KeyFile:    c.Config.TlsKeyFile,
+				ServerName: c.Config.TlsServerName,
+			},
+			RouteMirroring:          c.Config.RouteMirroringEnabled,
+			RouteMirroringNeighbors: c.Config.RouteMirroringNeighborList,
+			RouteMirroringRateLimit: c.Config.RouteMirroringRateLimit,
 		}); err != nil {
 			bgpServer.Log().Fatal("failed to set bmp config",
 				log.Fields{"Topic": "config", "Error": err})
 		}
 	}
+	for _, c := range newConfig.KafkaExporters {
+		if err := bgpServer.AddKafkaExporter(ctx, &api.AddKafkaExporterRequest{
+			Exporter: &api.KafkaExporter{
+				Name:         c.Config.Name,
+				Brokers:      c.Config.BrokerList,
+				Topic:        c.Config.Topic,
+				Encoding:     api.KafkaExporter_Encoding(c.Config.Encoding.ToInt()),
+				PartitionKey: api.KafkaExporter_PartitionKey(c.Config.PartitionKey.ToInt()),
+				Policy:       api.AddBmpRequest_MonitoringPolicy(c.Config.RouteMonitoringPolicy.ToInt()),
+			},
+		}); err != nil {
+			bgpServer.Log().Fatal("failed to set kafka exporter config",
+				log.Fields{"Topic": "config", "Error": err})
+		}
+	}
 	for _, vrf := range newConfig.Vrfs {
 		rd, err := bgp.ParseRouteDistinguisher(vrf.Config.Rd)
 		if err != nil {
@@ -315,6 +672,7 @@ func InitialConfig(ctx context.Context, bgpServer *server.BgpServer, newConfig *
 				Id:       uint32(vrf.Config.Id),
 				ImportRt: importRtList,
 				ExportRt: exportRtList,
+				RouterId: vrf.Config.RouterId,
 			},
 		}); err != nil {
 			bgpServer.Log().Fatal("failed to set vrf config",
@@ -322,7 +680,7 @@ func InitialConfig(ctx context.Context, bgpServer *server.BgpServer, newConfig *
 		}
 	}
 	for _, c := range newConfig.MrtDump {
-		if len(c.Config.FileName) == 0 {
+		if len(c.Config.FileName) == 0 && len(c.Config.StreamAddress) == 0 {
 			continue
 		}
 		if err := bgpServer.EnableMrt(ctx, &api.EnableMrtRequest{
@@ -330,6 +688,7 @@ func InitialConfig(ctx context.Context, bgpServer *server.BgpServer, newConfig *
 			Filename:         c.Config.FileName,
 			DumpInterval:     c.Config.DumpInterval,
 			RotationInterval: c.Config.RotationInterval,
+			StreamAddress:    c.Config.StreamAddress,
 		}); err != nil {
 			bgpServer.Log().Fatal("failed to set mrt config",
 				log.Fields{"Topic": "config", "Error": err})
@@ -349,6 +708,34 @@ func InitialConfig(ctx context.Context, bgpServer *server.BgpServer, newConfig *
 
 	assignGlobalpolicy(ctx, bgpServer, &newConfig.Global.ApplyPolicy.Config)
 
+	// the defined sets of the irr filters are updated after the sets in the config are set
+	for _, c := range newConfig.IrrFilters {
+		if err := bgpServer.AddIrrFilter(ctx, &api.AddIrrFilterRequest{
+			Filter: &api.IrrFilter{
+				Name:            c.Config.Name,
+				AsSet:           c.Config.AsSet,
+				Server:          c.Config.ServerAddress,
+				Sources:         c.Config.SourceList,
+				RefreshInterval: c.Config.RefreshInterval,
+				Ipv4PrefixSet:   c.Config.Ipv4PrefixSet,
+				Ipv6PrefixSet:   c.Config.Ipv6PrefixSet,
+				AsPathSet:       c.Config.AsPathSet,
+				Ipv4MaxLength:   uint32(c.Config.Ipv4MaxLength),
+				Ipv6MaxLength:   uint32(c.Config.Ipv6MaxLength),
+				MaxPrefixes:     c.Config.MaxPrefixes,
+				MaxAsns:         c.Config.MaxAsns,
+			},
+		}); err != nil {
+			bgpServer.Log().Fatal("failed to set irr filter config",
+				log.Fields{"Topic": "config", "Error": err})
+		}
+	}
+
+	addAggregates(ctx, bgpServer, newConfig.Aggregates)
+	addVrfLeaks(ctx, bgpServer, newConfig.VrfLeaks)
+	addOrrVantagePoints(ctx, bgpServer, newConfig.OrrVantagePoints)
+	addEvpnVpws(ctx, bgpServer, newConfig.EvpnVpws)
+
 	added := newConfig.Neighbors
 	addedPg := newConfig.PeerGroups
 	if isGracefulRestart {
@@ -398,6 +785,25 @@ func UpdateConfig(ctx context.Context, bgpServer *server.BgpServer, c, newConfig
 		updatePolicy = true
 	}
 
+	// the SLURM file is reloaded as its content may have been changed
+	if newConfig.RpkiSlurm.Config.File != "" || c.RpkiSlurm.Config.File != "" {
+		if err := bgpServer.SetRpkiSlurm(ctx, &api.SetRpkiSlurmRequest{
+			File: newConfig.RpkiSlurm.Config.File,
+		}); err != nil {
+			bgpServer.Log().Warn("failed to update rpki slurm config",
+				log.Fields{
+					"Topic": "config",
+					"Error": err})
+		} else {
+			updatePolicy = true
+		}
+	}
+
+	updateAggregates(ctx, bgpServer, c, newConfig)
+	updateVrfLeaks(ctx, bgpServer, c, newConfig)
+	updateOrrVantagePoints(ctx, bgpServer, c, newConfig)
+	updateEvpnVpws(ctx, bgpServer, c, newConfig)
+
 	addPeerGroups(ctx, bgpServer, addedPg)
 	deletePeerGroups(ctx, bgpServer, deletedPg)
 	needsSoftResetIn := updatePeerGroups(ctx, bgpServer, updatedPg)
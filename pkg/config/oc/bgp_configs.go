@@ -266,6 +266,12 @@ const (
 	AFI_SAFI_TYPE_LS                    AfiSafiType = "ls"
 	AFI_SAFI_TYPE_IPV4_MUP              AfiSafiType = "ipv4-mup"
 	AFI_SAFI_TYPE_IPV6_MUP              AfiSafiType = "ipv6-mup"
+	AFI_SAFI_TYPE_IPV4_MVPN             AfiSafiType = "ipv4-mvpn"
+	AFI_SAFI_TYPE_IPV6_MVPN             AfiSafiType = "ipv6-mvpn"
+	AFI_SAFI_TYPE_IPV4_CT               AfiSafiType = "ipv4-ct"
+	AFI_SAFI_TYPE_IPV6_CT               AfiSafiType = "ipv6-ct"
+	AFI_SAFI_TYPE_IPV4_CAR              AfiSafiType = "ipv4-car"
+	AFI_SAFI_TYPE_IPV6_CAR              AfiSafiType = "ipv6-car"
 )
 
 var AfiSafiTypeToIntMap = map[AfiSafiType]int{
@@ -295,6 +301,12 @@ var AfiSafiTypeToIntMap = map[AfiSafiType]int{
 	AFI_SAFI_TYPE_LS:                    23,
 	AFI_SAFI_TYPE_IPV4_MUP:              24,
 	AFI_SAFI_TYPE_IPV6_MUP:              25,
+	AFI_SAFI_TYPE_IPV4_MVPN:             26,
+	AFI_SAFI_TYPE_IPV6_MVPN:             27,
+	AFI_SAFI_TYPE_IPV4_CT:               28,
+	AFI_SAFI_TYPE_IPV6_CT:               29,
+	AFI_SAFI_TYPE_IPV4_CAR:              30,
+	AFI_SAFI_TYPE_IPV6_CAR:              31,
 }
 
 var IntToAfiSafiTypeMap = map[int]AfiSafiType{
@@ -324,6 +336,12 @@ var IntToAfiSafiTypeMap = map[int]AfiSafiType{
 	23: AFI_SAFI_TYPE_LS,
 	24: AFI_SAFI_TYPE_IPV4_MUP,
 	25: AFI_SAFI_TYPE_IPV6_MUP,
+	26: AFI_SAFI_TYPE_IPV4_MVPN,
+	27: AFI_SAFI_TYPE_IPV6_MVPN,
+	28: AFI_SAFI_TYPE_IPV4_CT,
+	29: AFI_SAFI_TYPE_IPV6_CT,
+	30: AFI_SAFI_TYPE_IPV4_CAR,
+	31: AFI_SAFI_TYPE_IPV6_CAR,
 }
 
 func (v AfiSafiType) Validate() error {
@@ -904,6 +922,114 @@ func (v BgpSetCommunityOptionType) ToInt() int {
 	return i
 }
 
+// typedef for identity gobgp:add-paths-send-mode-type.
+type AddPathsSendModeType string
+
+const (
+	ADD_PATHS_SEND_MODE_TYPE_N_PATHS       AddPathsSendModeType = "n-paths"
+	ADD_PATHS_SEND_MODE_TYPE_N_BEST        AddPathsSendModeType = "n-best"
+	ADD_PATHS_SEND_MODE_TYPE_BEST_EXTERNAL AddPathsSendModeType = "best-external"
+)
+
+var AddPathsSendModeTypeToIntMap = map[AddPathsSendModeType]int{
+	ADD_PATHS_SEND_MODE_TYPE_N_PATHS:       0,
+	ADD_PATHS_SEND_MODE_TYPE_N_BEST:        1,
+	ADD_PATHS_SEND_MODE_TYPE_BEST_EXTERNAL: 2,
+}
+
+var IntToAddPathsSendModeTypeMap = map[int]AddPathsSendModeType{
+	0: ADD_PATHS_SEND_MODE_TYPE_N_PATHS,
+	1: ADD_PATHS_SEND_MODE_TYPE_N_BEST,
+	2: ADD_PATHS_SEND_MODE_TYPE_BEST_EXTERNAL,
+}
+
+func (v AddPathsSendModeType) Validate() error {
+	if _, ok := AddPathsSendModeTypeToIntMap[v]; !ok {
+		return fmt.Errorf("invalid AddPathsSendModeType: %s", v)
+	}
+	return nil
+}
+
+func (v AddPathsSendModeType) ToInt() int {
+	i, ok := AddPathsSendModeTypeToIntMap[v]
+	if !ok {
+		return -1
+	}
+	return i
+}
+
+// typedef for identity gobgp:transport-protocol-type.
+type TransportProtocolType string
+
+const (
+	TRANSPORT_PROTOCOL_TYPE_TCP  TransportProtocolType = "tcp"
+	TRANSPORT_PROTOCOL_TYPE_QUIC TransportProtocolType = "quic"
+)
+
+var TransportProtocolTypeToIntMap = map[TransportProtocolType]int{
+	TRANSPORT_PROTOCOL_TYPE_TCP:  0,
+	TRANSPORT_PROTOCOL_TYPE_QUIC: 1,
+}
+
+var IntToTransportProtocolTypeMap = map[int]TransportProtocolType{
+	0: TRANSPORT_PROTOCOL_TYPE_TCP,
+	1: TRANSPORT_PROTOCOL_TYPE_QUIC,
+}
+
+func (v TransportProtocolType) Validate() error {
+	if _, ok := TransportProtocolTypeToIntMap[v]; !ok {
+		return fmt.Errorf("invalid TransportProtocolType: %s", v)
+	}
+	return nil
+}
+
+func (v TransportProtocolType) ToInt() int {
+	i, ok := TransportProtocolTypeToIntMap[v]
+	if !ok {
+		return -1
+	}
+	return i
+}
+
+// typedef for identity gobgp:prefix-orf-mode-type.
+type PrefixOrfModeType string
+
+const (
+	PREFIX_ORF_MODE_TYPE_NONE    PrefixOrfModeType = "none"
+	PREFIX_ORF_MODE_TYPE_RECEIVE PrefixOrfModeType = "receive"
+	PREFIX_ORF_MODE_TYPE_SEND    PrefixOrfModeType = "send"
+	PREFIX_ORF_MODE_TYPE_BOTH    PrefixOrfModeType = "both"
+)
+
+var PrefixOrfModeTypeToIntMap = map[PrefixOrfModeType]int{
+	PREFIX_ORF_MODE_TYPE_NONE:    0,
+	PREFIX_ORF_MODE_TYPE_RECEIVE: 1,
+	PREFIX_ORF_MODE_TYPE_SEND:    2,
+	PREFIX_ORF_MODE_TYPE_BOTH:    3,
+}
+
+var IntToPrefixOrfModeTypeMap = map[int]PrefixOrfModeType{
+	0: PREFIX_ORF_MODE_TYPE_NONE,
+	1: PREFIX_ORF_MODE_TYPE_RECEIVE,
+	2: PREFIX_ORF_MODE_TYPE_SEND,
+	3: PREFIX_ORF_MODE_TYPE_BOTH,
+}
+
+func (v PrefixOrfModeType) Validate() error {
+	if _, ok := PrefixOrfModeTypeToIntMap[v]; !ok {
+		return fmt.Errorf("invalid PrefixOrfModeType: %s", v)
+	}
+	return nil
+}
+
+func (v PrefixOrfModeType) ToInt() int {
+	i, ok := PrefixOrfModeTypeToIntMap[v]
+	if !ok {
+		return -1
+	}
+	return i
+}
+
 // typedef for identity gobgp:bmp-route-monitoring-policy-type.
 type BmpRouteMonitoringPolicyType string
 
@@ -946,6 +1072,143 @@ func (v BmpRouteMonitoringPolicyType) ToInt() int {
 	return i
 }
 
+// typedef for identity gobgp:kafka-encoding-type.
+// indicate the encoding of the messages published to Kafka.
+type KafkaEncodingType string
+
+const (
+	KAFKA_ENCODING_TYPE_JSON     KafkaEncodingType = "json"
+	KAFKA_ENCODING_TYPE_PROTOBUF KafkaEncodingType = "protobuf"
+)
+
+var KafkaEncodingTypeToIntMap = map[KafkaEncodingType]int{
+	KAFKA_ENCODING_TYPE_JSON:     0,
+	KAFKA_ENCODING_TYPE_PROTOBUF: 1,
+}
+
+var IntToKafkaEncodingTypeMap = map[int]KafkaEncodingType{
+	0: KAFKA_ENCODING_TYPE_JSON,
+	1: KAFKA_ENCODING_TYPE_PROTOBUF,
+}
+
+func (v KafkaEncodingType) Validate() error {
+	if _, ok := KafkaEncodingTypeToIntMap[v]; !ok {
+		return fmt.Errorf("invalid KafkaEncodingType: %s", v)
+	}
+	return nil
+}
+
+func (v KafkaEncodingType) ToInt() int {
+	i, ok := KafkaEncodingTypeToIntMap[v]
+	if !ok {
+		return -1
+	}
+	return i
+}
+
+// typedef for identity gobgp:kafka-partition-key-type.
+// indicate the key of the messages published to Kafka, by which
+// the messages are partitioned.
+type KafkaPartitionKeyType string
+
+const (
+	KAFKA_PARTITION_KEY_TYPE_PREFIX KafkaPartitionKeyType = "prefix"
+	KAFKA_PARTITION_KEY_TYPE_PEER   KafkaPartitionKeyType = "peer"
+)
+
+var KafkaPartitionKeyTypeToIntMap = map[KafkaPartitionKeyType]int{
+	KAFKA_PARTITION_KEY_TYPE_PREFIX: 0,
+	KAFKA_PARTITION_KEY_TYPE_PEER:   1,
+}
+
+var IntToKafkaPartitionKeyTypeMap = map[int]KafkaPartitionKeyType{
+	0: KAFKA_PARTITION_KEY_TYPE_PREFIX,
+	1: KAFKA_PARTITION_KEY_TYPE_PEER,
+}
+
+func (v KafkaPartitionKeyType) Validate() error {
+	if _, ok := KafkaPartitionKeyTypeToIntMap[v]; !ok {
+		return fmt.Errorf("invalid KafkaPartitionKeyType: %s", v)
+	}
+	return nil
+}
+
+func (v KafkaPartitionKeyType) ToInt() int {
+	i, ok := KafkaPartitionKeyTypeToIntMap[v]
+	if !ok {
+		return -1
+	}
+	return i
+}
+
+// typedef for identity gobgp:conditional-advertisement-condition-type.
+// indicate whether the paths are advertised while the condition
+// prefixes exist or don't exist in the Loc-RIB.
+type ConditionalAdvertisementConditionType string
+
+const (
+	CONDITIONAL_ADVERTISEMENT_CONDITION_TYPE_NON_EXIST ConditionalAdvertisementConditionType = "non-exist"
+	CONDITIONAL_ADVERTISEMENT_CONDITION_TYPE_EXIST     ConditionalAdvertisementConditionType = "exist"
+)
+
+var ConditionalAdvertisementConditionTypeToIntMap = map[ConditionalAdvertisementConditionType]int{
+	CONDITIONAL_ADVERTISEMENT_CONDITION_TYPE_NON_EXIST: 0,
+	CONDITIONAL_ADVERTISEMENT_CONDITION_TYPE_EXIST:     1,
+}
+
+var IntToConditionalAdvertisementConditionTypeMap = map[int]ConditionalAdvertisementConditionType{
+	0: CONDITIONAL_ADVERTISEMENT_CONDITION_TYPE_NON_EXIST,
+	1: CONDITIONAL_ADVERTISEMENT_CONDITION_TYPE_EXIST,
+}
+
+func (v ConditionalAdvertisementConditionType) Validate() error {
+	if _, ok := ConditionalAdvertisementConditionTypeToIntMap[v]; !ok {
+		return fmt.Errorf("invalid ConditionalAdvertisementConditionType: %s", v)
+	}
+	return nil
+}
+
+func (v ConditionalAdvertisementConditionType) ToInt() int {
+	i, ok := ConditionalAdvertisementConditionTypeToIntMap[v]
+	if !ok {
+		return -1
+	}
+	return i
+}
+
+// typedef for identity gobgp:vrf-rd-type.
+type VrfRdType string
+
+const (
+	VRF_RD_TYPE_IP_ADDRESS   VrfRdType = "ip-address"
+	VRF_RD_TYPE_TWO_OCTET_AS VrfRdType = "two-octet-as"
+)
+
+var VrfRdTypeToIntMap = map[VrfRdType]int{
+	VRF_RD_TYPE_IP_ADDRESS:   0,
+	VRF_RD_TYPE_TWO_OCTET_AS: 1,
+}
+
+var IntToVrfRdTypeMap = map[int]VrfRdType{
+	0: VRF_RD_TYPE_IP_ADDRESS,
+	1: VRF_RD_TYPE_TWO_OCTET_AS,
+}
+
+func (v VrfRdType) Validate() error {
+	if _, ok := VrfRdTypeToIntMap[v]; !ok {
+		return fmt.Errorf("invalid VrfRdType: %s", v)
+	}
+	return nil
+}
+
+func (v VrfRdType) ToInt() int {
+	i, ok := VrfRdTypeToIntMap[v]
+	if !ok {
+		return -1
+	}
+	return i
+}
+
 // typedef for identity gobgp:mrt-type.
 type MrtType string
 
@@ -979,6 +1242,111 @@ func (v MrtType) ToInt() int {
 	return i
 }
 
+// typedef for identity gobgp:bmp-mode-type.
+// indicate which side initiates the BMP session.
+type BmpModeType string
+
+const (
+	BMP_MODE_TYPE_ACTIVE  BmpModeType = "active"
+	BMP_MODE_TYPE_PASSIVE BmpModeType = "passive"
+)
+
+var BmpModeTypeToIntMap = map[BmpModeType]int{
+	BMP_MODE_TYPE_ACTIVE:  0,
+	BMP_MODE_TYPE_PASSIVE: 1,
+}
+
+var IntToBmpModeTypeMap = map[int]BmpModeType{
+	0: BMP_MODE_TYPE_ACTIVE,
+	1: BMP_MODE_TYPE_PASSIVE,
+}
+
+func (v BmpModeType) Validate() error {
+	if _, ok := BmpModeTypeToIntMap[v]; !ok {
+		return fmt.Errorf("invalid BmpModeType: %s", v)
+	}
+	return nil
+}
+
+func (v BmpModeType) ToInt() int {
+	i, ok := BmpModeTypeToIntMap[v]
+	if !ok {
+		return -1
+	}
+	return i
+}
+
+// typedef for identity gobgp:bmp-transport-type.
+// indicate the transport of the BMP session.
+type BmpTransportType string
+
+const (
+	BMP_TRANSPORT_TYPE_TCP BmpTransportType = "tcp"
+	BMP_TRANSPORT_TYPE_TLS BmpTransportType = "tls"
+)
+
+var BmpTransportTypeToIntMap = map[BmpTransportType]int{
+	BMP_TRANSPORT_TYPE_TCP: 0,
+	BMP_TRANSPORT_TYPE_TLS: 1,
+}
+
+var IntToBmpTransportTypeMap = map[int]BmpTransportType{
+	0: BMP_TRANSPORT_TYPE_TCP,
+	1: BMP_TRANSPORT_TYPE_TLS,
+}
+
+func (v BmpTransportType) Validate() error {
+	if _, ok := BmpTransportTypeToIntMap[v]; !ok {
+		return fmt.Errorf("invalid BmpTransportType: %s", v)
+	}
+	return nil
+}
+
+func (v BmpTransportType) ToInt() int {
+	i, ok := BmpTransportTypeToIntMap[v]
+	if !ok {
+		return -1
+	}
+	return i
+}
+
+// typedef for identity gobgp:rpki-transport-type.
+// indicate the transport of the RTR session.
+type RpkiTransportType string
+
+const (
+	RPKI_TRANSPORT_TYPE_TCP RpkiTransportType = "tcp"
+	RPKI_TRANSPORT_TYPE_TLS RpkiTransportType = "tls"
+	RPKI_TRANSPORT_TYPE_SSH RpkiTransportType = "ssh"
+)
+
+var RpkiTransportTypeToIntMap = map[RpkiTransportType]int{
+	RPKI_TRANSPORT_TYPE_TCP: 0,
+	RPKI_TRANSPORT_TYPE_TLS: 1,
+	RPKI_TRANSPORT_TYPE_SSH: 2,
+}
+
+var IntToRpkiTransportTypeMap = map[int]RpkiTransportType{
+	0: RPKI_TRANSPORT_TYPE_TCP,
+	1: RPKI_TRANSPORT_TYPE_TLS,
+	2: RPKI_TRANSPORT_TYPE_SSH,
+}
+
+func (v RpkiTransportType) Validate() error {
+	if _, ok := RpkiTransportTypeToIntMap[v]; !ok {
+		return fmt.Errorf("invalid RpkiTransportType: %s", v)
+	}
+	return nil
+}
+
+func (v RpkiTransportType) ToInt() int {
+	i, ok := RpkiTransportTypeToIntMap[v]
+	if !ok {
+		return -1
+	}
+	return i
+}
+
 // typedef for identity gobgp:rpki-validation-result-type.
 // indicate the validation result of RPKI based on ROA.
 type RpkiValidationResultType string
@@ -1025,6 +1393,8 @@ type DynamicNeighborState struct {
 	Prefix string `mapstructure:"prefix" json:"prefix,omitempty"`
 	// original -> gobgp:peer-group
 	PeerGroup string `mapstructure:"peer-group" json:"peer-group,omitempty"`
+	// original -> gobgp:interface
+	Interface string `mapstructure:"interface" json:"interface,omitempty"`
 }
 
 // struct for container gobgp:config.
@@ -1033,6 +1403,8 @@ type DynamicNeighborConfig struct {
 	Prefix string `mapstructure:"prefix" json:"prefix,omitempty"`
 	// original -> gobgp:peer-group
 	PeerGroup string `mapstructure:"peer-group" json:"peer-group,omitempty"`
+	// original -> gobgp:interface
+	Interface string `mapstructure:"interface" json:"interface,omitempty"`
 }
 
 func (lhs *DynamicNeighborConfig) Equal(rhs *DynamicNeighborConfig) bool {
@@ -1045,6 +1417,9 @@ func (lhs *DynamicNeighborConfig) Equal(rhs *DynamicNeighborConfig) bool {
 	if lhs.PeerGroup != rhs.PeerGroup {
 		return false
 	}
+	if lhs.Interface != rhs.Interface {
+		return false
+	}
 	return true
 }
 
@@ -1235,158 +1610,1358 @@ func (lhs *Zebra) Equal(rhs *Zebra) bool {
 	return true
 }
 
-// struct for container gobgp:config.
-type MrtConfig struct {
-	// original -> gobgp:dump-type
-	DumpType MrtType `mapstructure:"dump-type" json:"dump-type,omitempty"`
-	// original -> gobgp:file-name
-	// Configures a file name to be written.
-	FileName string `mapstructure:"file-name" json:"file-name,omitempty"`
-	// original -> gobgp:table-name
-	// specify the table name with route server setup.
-	TableName string `mapstructure:"table-name" json:"table-name,omitempty"`
-	// original -> gobgp:dump-interval
-	DumpInterval uint64 `mapstructure:"dump-interval" json:"dump-interval,omitempty"`
-	// original -> gobgp:rotation-interval
-	RotationInterval uint64 `mapstructure:"rotation-interval" json:"rotation-interval,omitempty"`
+// struct for container gobgp:state.
+type NetlinkState struct {
+	// original -> gobgp:enabled
+	// gobgp:enabled's original type is boolean.
+	// Configure enabling to install best paths into the Linux
+	// routing table via netlink.
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty"`
+	// original -> gobgp:family
+	// Address families to be installed. ipv4-unicast and
+	// ipv6-unicast can be used. Default is both.
+	FamilyList []string `mapstructure:"family-list" json:"family-list,omitempty"`
+	// original -> gobgp:table-id
+	// Configure the routing table ID. Default is 254 (main).
+	TableId uint32 `mapstructure:"table-id" json:"table-id,omitempty"`
+	// original -> gobgp:protocol
+	// Configure the route protocol number. Default is 186 (bgp).
+	Protocol uint8 `mapstructure:"protocol" json:"protocol,omitempty"`
+	// original -> gobgp:metric
+	// Configure the route metric.
+	Metric uint32 `mapstructure:"metric" json:"metric,omitempty"`
+	// original -> gobgp:reconcile-time
+	// Configure the time in seconds after which routes left in the
+	// table by a previous run are removed unless reinstalled.
+	// Default is 60.
+	ReconcileTime uint16 `mapstructure:"reconcile-time" json:"reconcile-time,omitempty"`
 }
 
-func (lhs *MrtConfig) Equal(rhs *MrtConfig) bool {
+// struct for container gobgp:config.
+type NetlinkConfig struct {
+	// original -> gobgp:enabled
+	// gobgp:enabled's original type is boolean.
+	// Configure enabling to install best paths into the Linux
+	// routing table via netlink.
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty"`
+	// original -> gobgp:family
+	// Address families to be installed. ipv4-unicast and
+	// ipv6-unicast can be used. Default is both.
+	FamilyList []string `mapstructure:"family-list" json:"family-list,omitempty"`
+	// original -> gobgp:table-id
+	// Configure the routing table ID. Default is 254 (main).
+	TableId uint32 `mapstructure:"table-id" json:"table-id,omitempty"`
+	// original -> gobgp:protocol
+	// Configure the route protocol number. Default is 186 (bgp).
+	Protocol uint8 `mapstructure:"protocol" json:"protocol,omitempty"`
+	// original -> gobgp:metric
+	// Configure the route metric.
+	Metric uint32 `mapstructure:"metric" json:"metric,omitempty"`
+	// original -> gobgp:reconcile-time
+	// Configure the time in seconds after which routes left in the
+	// table by a previous run are removed unless reinstalled.
+	// Default is 60.
+	ReconcileTime uint16 `mapstructure:"reconcile-time" json:"reconcile-time,omitempty"`
+}
+
+func (lhs *NetlinkConfig) Equal(rhs *NetlinkConfig) bool {
 	if lhs == nil || rhs == nil {
 		return false
 	}
-	if lhs.DumpType != rhs.DumpType {
+	if lhs.Enabled != rhs.Enabled {
 		return false
 	}
-	if lhs.FileName != rhs.FileName {
+	if len(lhs.FamilyList) != len(rhs.FamilyList) {
 		return false
 	}
-	if lhs.TableName != rhs.TableName {
+	for idx, l := range lhs.FamilyList {
+		if l != rhs.FamilyList[idx] {
+			return false
+		}
+	}
+	if lhs.TableId != rhs.TableId {
 		return false
 	}
-	if lhs.DumpInterval != rhs.DumpInterval {
+	if lhs.Protocol != rhs.Protocol {
 		return false
 	}
-	if lhs.RotationInterval != rhs.RotationInterval {
+	if lhs.Metric != rhs.Metric {
+		return false
+	}
+	if lhs.ReconcileTime != rhs.ReconcileTime {
 		return false
 	}
 	return true
 }
 
-// struct for container gobgp:mrt.
-type Mrt struct {
-	// original -> gobgp:file-name
-	// original -> gobgp:mrt-config
-	Config MrtConfig `mapstructure:"config" json:"config,omitempty"`
+// struct for container gobgp:netlink.
+type Netlink struct {
+	// original -> gobgp:netlink-config
+	Config NetlinkConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:netlink-state
+	State NetlinkState `mapstructure:"state" json:"state,omitempty"`
+}
+
+func (lhs *Netlink) Equal(rhs *Netlink) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:state.
+type PeeringdbState struct {
+	// original -> gobgp:enabled
+	// gobgp:enabled's original type is boolean.
+	// Configure enabling to set the max prefixes of the neighbors
+	// from PeeringDB.
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty"`
+	// original -> gobgp:url
+	// URL of the PeeringDB API. Default is
+	// https://www.peeringdb.com/api.
+	Url string `mapstructure:"url" json:"url,omitempty"`
+	// original -> gobgp:api-key
+	// API key of PeeringDB.
+	ApiKey string `mapstructure:"api-key" json:"api-key,omitempty"`
+	// original -> gobgp:refresh-interval
+	// Interval in seconds between the lookups. Default is 86400.
+	RefreshInterval uint32 `mapstructure:"refresh-interval" json:"refresh-interval,omitempty"`
+	// original -> gobgp:multiplier
+	// The max prefixes are set to the numbers of the prefixes in
+	// the records multiplied by this. Default is 1.2.
+	Multiplier float64 `mapstructure:"multiplier" json:"multiplier,omitempty"`
+	// original -> gobgp:warning-threshold-pct
+	// Log a warning when the number of the received prefixes
+	// exceeds this percentage of the record. Default is 90.
+	WarningThresholdPct uint8 `mapstructure:"warning-threshold-pct" json:"warning-threshold-pct,omitempty"`
+}
+
+// struct for container gobgp:config.
+type PeeringdbConfig struct {
+	// original -> gobgp:enabled
+	// gobgp:enabled's original type is boolean.
+	// Configure enabling to set the max prefixes of the neighbors
+	// from PeeringDB.
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty"`
+	// original -> gobgp:url
+	// URL of the PeeringDB API. Default is
+	// https://www.peeringdb.com/api.
+	Url string `mapstructure:"url" json:"url,omitempty"`
+	// original -> gobgp:api-key
+	// API key of PeeringDB.
+	ApiKey string `mapstructure:"api-key" json:"api-key,omitempty"`
+	// original -> gobgp:refresh-interval
+	// Interval in seconds between the lookups. Default is 86400.
+	RefreshInterval uint32 `mapstructure:"refresh-interval" json:"refresh-interval,omitempty"`
+	// original -> gobgp:multiplier
+	// The max prefixes are set to the numbers of the prefixes in
+	// the records multiplied by this. Default is 1.2.
+	Multiplier float64 `mapstructure:"multiplier" json:"multiplier,omitempty"`
+	// original -> gobgp:warning-threshold-pct
+	// Log a warning when the number of the received prefixes
+	// exceeds this percentage of the record. Default is 90.
+	WarningThresholdPct uint8 `mapstructure:"warning-threshold-pct" json:"warning-threshold-pct,omitempty"`
+}
+
+func (lhs *PeeringdbConfig) Equal(rhs *PeeringdbConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Enabled != rhs.Enabled {
+		return false
+	}
+	if lhs.Url != rhs.Url {
+		return false
+	}
+	if lhs.ApiKey != rhs.ApiKey {
+		return false
+	}
+	if lhs.RefreshInterval != rhs.RefreshInterval {
+		return false
+	}
+	if lhs.Multiplier != rhs.Multiplier {
+		return false
+	}
+	if lhs.WarningThresholdPct != rhs.WarningThresholdPct {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:peeringdb.
+type Peeringdb struct {
+	// original -> gobgp:peeringdb-config
+	Config PeeringdbConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:peeringdb-state
+	State PeeringdbState `mapstructure:"state" json:"state,omitempty"`
+}
+
+func (lhs *Peeringdb) Equal(rhs *Peeringdb) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:state.
+type RpkiSlurmState struct {
+	// original -> gobgp:file
+	// Configure the SLURM file (RFC 8416) of the local
+	// exceptions to the RPKI data.
+	File string `mapstructure:"file" json:"file,omitempty"`
+}
+
+// struct for container gobgp:config.
+type RpkiSlurmConfig struct {
+	// original -> gobgp:file
+	// Configure the SLURM file (RFC 8416) of the local
+	// exceptions to the RPKI data.
+	File string `mapstructure:"file" json:"file,omitempty"`
+}
+
+func (lhs *RpkiSlurmConfig) Equal(rhs *RpkiSlurmConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.File != rhs.File {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:rpki-slurm.
+type RpkiSlurm struct {
+	// original -> gobgp:rpki-slurm-config
+	Config RpkiSlurmConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:rpki-slurm-state
+	State RpkiSlurmState `mapstructure:"state" json:"state,omitempty"`
+}
+
+func (lhs *RpkiSlurm) Equal(rhs *RpkiSlurm) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:state.
+type Srv6State struct {
+	// original -> gobgp:locator
+	// Configure the SRv6 locator from which the SID of each
+	// VRF is allocated. The prefix length must be the sum of
+	// locator-block-length and locator-node-length.
+	Locator string `mapstructure:"locator" json:"locator,omitempty"`
+	// original -> gobgp:locator-block-length
+	// Configure the length of the SRv6 SID locator block.
+	LocatorBlockLength uint8 `mapstructure:"locator-block-length" json:"locator-block-length,omitempty"`
+	// original -> gobgp:locator-node-length
+	// Configure the length of the SRv6 SID locator node.
+	LocatorNodeLength uint8 `mapstructure:"locator-node-length" json:"locator-node-length,omitempty"`
+	// original -> gobgp:function-length
+	// Configure the length of the SRv6 SID function.
+	FunctionLength uint8 `mapstructure:"function-length" json:"function-length,omitempty"`
+	// original -> gobgp:argument-length
+	// Configure the length of the SRv6 SID argument.
+	ArgumentLength uint8 `mapstructure:"argument-length" json:"argument-length,omitempty"`
+	// original -> gobgp:transposition
+	// gobgp:transposition's original type is boolean.
+	// Configure transposing the SRv6 SID function into the
+	// label of the VPN routes.
+	Transposition bool `mapstructure:"transposition" json:"transposition,omitempty"`
+}
+
+// struct for container gobgp:config.
+type Srv6Config struct {
+	// original -> gobgp:locator
+	// Configure the SRv6 locator from which the SID of each
+	// VRF is allocated. The prefix length must be the sum of
+	// locator-block-length and locator-node-length.
+	Locator string `mapstructure:"locator" json:"locator,omitempty"`
+	// original -> gobgp:locator-block-length
+	// Configure the length of the SRv6 SID locator block.
+	LocatorBlockLength uint8 `mapstructure:"locator-block-length" json:"locator-block-length,omitempty"`
+	// original -> gobgp:locator-node-length
+	// Configure the length of the SRv6 SID locator node.
+	LocatorNodeLength uint8 `mapstructure:"locator-node-length" json:"locator-node-length,omitempty"`
+	// original -> gobgp:function-length
+	// Configure the length of the SRv6 SID function.
+	FunctionLength uint8 `mapstructure:"function-length" json:"function-length,omitempty"`
+	// original -> gobgp:argument-length
+	// Configure the length of the SRv6 SID argument.
+	ArgumentLength uint8 `mapstructure:"argument-length" json:"argument-length,omitempty"`
+	// original -> gobgp:transposition
+	// gobgp:transposition's original type is boolean.
+	// Configure transposing the SRv6 SID function into the
+	// label of the VPN routes.
+	Transposition bool `mapstructure:"transposition" json:"transposition,omitempty"`
+}
+
+func (lhs *Srv6Config) Equal(rhs *Srv6Config) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Locator != rhs.Locator {
+		return false
+	}
+	if lhs.LocatorBlockLength != rhs.LocatorBlockLength {
+		return false
+	}
+	if lhs.LocatorNodeLength != rhs.LocatorNodeLength {
+		return false
+	}
+	if lhs.FunctionLength != rhs.FunctionLength {
+		return false
+	}
+	if lhs.ArgumentLength != rhs.ArgumentLength {
+		return false
+	}
+	if lhs.Transposition != rhs.Transposition {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:srv6.
+type Srv6 struct {
+	// original -> gobgp:srv6-config
+	Config Srv6Config `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:srv6-state
+	State Srv6State `mapstructure:"state" json:"state,omitempty"`
+}
+
+func (lhs *Srv6) Equal(rhs *Srv6) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:config.
+type MrtConfig struct {
+	// original -> gobgp:dump-type
+	DumpType MrtType `mapstructure:"dump-type" json:"dump-type,omitempty"`
+	// original -> gobgp:file-name
+	// Configures a file name to be written.
+	FileName string `mapstructure:"file-name" json:"file-name,omitempty"`
+	// original -> gobgp:table-name
+	// specify the table name with route server setup.
+	TableName string `mapstructure:"table-name" json:"table-name,omitempty"`
+	// original -> gobgp:dump-interval
+	DumpInterval uint64 `mapstructure:"dump-interval" json:"dump-interval,omitempty"`
+	// original -> gobgp:rotation-interval
+	RotationInterval uint64 `mapstructure:"rotation-interval" json:"rotation-interval,omitempty"`
+	// original -> gobgp:stream-address
+	// Configures an address on which the BGP4MP messages are
+	// streamed to the connected clients instead of being written
+	// to a file, host:port for TCP or unix://path for a Unix domain
+	// socket.
+	StreamAddress string `mapstructure:"stream-address" json:"stream-address,omitempty"`
+}
+
+func (lhs *MrtConfig) Equal(rhs *MrtConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.DumpType != rhs.DumpType {
+		return false
+	}
+	if lhs.FileName != rhs.FileName {
+		return false
+	}
+	if lhs.TableName != rhs.TableName {
+		return false
+	}
+	if lhs.DumpInterval != rhs.DumpInterval {
+		return false
+	}
+	if lhs.RotationInterval != rhs.RotationInterval {
+		return false
+	}
+	if lhs.StreamAddress != rhs.StreamAddress {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:mrt.
+type Mrt struct {
+	// original -> gobgp:file-name
+	// original -> gobgp:mrt-config
+	Config MrtConfig `mapstructure:"config" json:"config,omitempty"`
+}
+
+func (lhs *Mrt) Equal(rhs *Mrt) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:state.
+// Configured states of VRF.
+type VrfState struct {
+	// original -> gobgp:name
+	// Unique name among all VRF instances.
+	Name string `mapstructure:"name" json:"name,omitempty"`
+	// original -> gobgp:id
+	// Unique identifier among all VRF instances.
+	Id uint32 `mapstructure:"id" json:"id,omitempty"`
+	// original -> gobgp:rd
+	// Route Distinguisher for this VRF.
+	Rd string `mapstructure:"rd" json:"rd,omitempty"`
+	// original -> gobgp:router-id
+	// gobgp:router-id's original type is inet:ipv4-address.
+	// Router ID of this VRF used as the BGP Identifier toward the
+	// neighbors in this VRF and for the derived Route Distinguisher.
+	// The global router ID is used if empty.
+	RouterId string `mapstructure:"router-id" json:"router-id,omitempty"`
+	// original -> gobgp:import-rt
+	// List of import Route Targets for this VRF.
+	ImportRtList []string `mapstructure:"import-rt-list" json:"import-rt-list,omitempty"`
+	// original -> gobgp:export-rt
+	// List of export Route Targets for this VRF.
+	ExportRtList []string `mapstructure:"export-rt-list" json:"export-rt-list,omitempty"`
+}
+
+// struct for container gobgp:config.
+// Configuration parameters for VRF.
+type VrfConfig struct {
+	// original -> gobgp:name
+	// Unique name among all VRF instances.
+	Name string `mapstructure:"name" json:"name,omitempty"`
+	// original -> gobgp:id
+	// Unique identifier among all VRF instances.
+	Id uint32 `mapstructure:"id" json:"id,omitempty"`
+	// original -> gobgp:rd
+	// Route Distinguisher for this VRF.
+	Rd string `mapstructure:"rd" json:"rd,omitempty"`
+	// original -> gobgp:router-id
+	// gobgp:router-id's original type is inet:ipv4-address.
+	// Router ID of this VRF used as the BGP Identifier toward the
+	// neighbors in this VRF and for the derived Route Distinguisher.
+	// The global router ID is used if empty.
+	RouterId string `mapstructure:"router-id" json:"router-id,omitempty"`
+	// original -> gobgp:import-rt
+	// List of import Route Targets for this VRF.
+	ImportRtList []string `mapstructure:"import-rt-list" json:"import-rt-list,omitempty"`
+	// original -> gobgp:export-rt
+	// List of export Route Targets for this VRF.
+	ExportRtList []string `mapstructure:"export-rt-list" json:"export-rt-list,omitempty"`
+	// original -> gobgp:both-rt
+	// List of both import and export Route Targets for this VRF. Each
+	// configuration for import and export Route Targets will be preferred.
+	BothRtList []string `mapstructure:"both-rt-list" json:"both-rt-list,omitempty"`
+	// original -> gobgp:rd-index
+	// Index of the Route Distinguisher derived if rd is empty. The id
+	// of this VRF is used if zero.
+	RdIndex uint32 `mapstructure:"rd-index" json:"rd-index,omitempty"`
+	// original -> gobgp:rd-type
+	// Type of the Route Distinguisher derived if rd is empty.
+	RdType VrfRdType `mapstructure:"rd-type" json:"rd-type,omitempty"`
+}
+
+func (lhs *VrfConfig) Equal(rhs *VrfConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Name != rhs.Name {
+		return false
+	}
+	if lhs.Id != rhs.Id {
+		return false
+	}
+	if lhs.Rd != rhs.Rd {
+		return false
+	}
+	if lhs.RouterId != rhs.RouterId {
+		return false
+	}
+	if len(lhs.ImportRtList) != len(rhs.ImportRtList) {
+		return false
+	}
+	for idx, l := range lhs.ImportRtList {
+		if l != rhs.ImportRtList[idx] {
+			return false
+		}
+	}
+	if len(lhs.ExportRtList) != len(rhs.ExportRtList) {
+		return false
+	}
+	for idx, l := range lhs.ExportRtList {
+		if l != rhs.ExportRtList[idx] {
+			return false
+		}
+	}
+	if len(lhs.BothRtList) != len(rhs.BothRtList) {
+		return false
+	}
+	for idx, l := range lhs.BothRtList {
+		if l != rhs.BothRtList[idx] {
+			return false
+		}
+	}
+	if lhs.RdIndex != rhs.RdIndex {
+		return false
+	}
+	if lhs.RdType != rhs.RdType {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:vrf.
+// VRF instance configurations on the local system.
+type Vrf struct {
+	// original -> gobgp:name
+	// original -> gobgp:vrf-config
+	// Configuration parameters for VRF.
+	Config VrfConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:vrf-state
+	// Configured states of VRF.
+	State VrfState `mapstructure:"state" json:"state,omitempty"`
+}
+
+func (lhs *Vrf) Equal(rhs *Vrf) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:state.
+// Configuration parameters relating to BMP server.
+type BmpServerState struct {
+	// original -> gobgp:address
+	// gobgp:address's original type is inet:ip-address.
+	// Reference to the address of the BMP server used as
+	// a key in the BMP server list.
+	Address string `mapstructure:"address" json:"address,omitempty"`
+	// original -> gobgp:port
+	// Reference to the port of the BMP server.
+	Port uint32 `mapstructure:"port" json:"port,omitempty"`
+	// original -> gobgp:route-monitoring-policy
+	RouteMonitoringPolicy BmpRouteMonitoringPolicyType `mapstructure:"route-monitoring-policy" json:"route-monitoring-policy,omitempty"`
+	// original -> gobgp:statistics-timeout
+	// Interval seconds of statistics messages sent to BMP server.
+	StatisticsTimeout uint16 `mapstructure:"statistics-timeout" json:"statistics-timeout,omitempty"`
+	// original -> gobgp:route-mirroring-enabled
+	// gobgp:route-mirroring-enabled's original type is boolean.
+	// Enable feature for mirroring of received BGP messages
+	// mainly for debugging purpose.
+	RouteMirroringEnabled bool `mapstructure:"route-mirroring-enabled" json:"route-mirroring-enabled,omitempty"`
+	// original -> gobgp:route-mirroring-neighbor
+	// original type is list of inet:ip-address
+	// List of the neighbors whose received BGP messages are mirrored.
+	// The messages from all the neighbors are mirrored if omitted.
+	RouteMirroringNeighborList []string `mapstructure:"route-mirroring-neighbor-list" json:"route-mirroring-neighbor-list,omitempty"`
+	// original -> gobgp:route-mirroring-rate-limit
+	// Maximum number of the mirrored BGP messages per second. The
+	// collector is notified of the messages dropped over the limit.
+	// Unlimited if omitted.
+	RouteMirroringRateLimit uint32 `mapstructure:"route-mirroring-rate-limit" json:"route-mirroring-rate-limit,omitempty"`
+	// original -> gobgp:sys-name
+	// Reference to the SysName of the BMP server.
+	SysName string `mapstructure:"sys-name" json:"sys-name,omitempty"`
+	// original -> gobgp:sys-descr
+	// Reference to the SysDescr of the BMP server.
+	SysDescr string `mapstructure:"sys-descr" json:"sys-descr,omitempty"`
+	// original -> gobgp:mode
+	// In the active mode, the BMP session is initiated to the BMP
+	// server. In the passive mode, the connection from the BMP server
+	// of the address is accepted on the port.
+	Mode BmpModeType `mapstructure:"mode" json:"mode,omitempty"`
+	// original -> gobgp:local-address
+	// gobgp:local-address's original type is inet:ip-address.
+	// Address on which the passive mode listens. Any address if
+	// omitted.
+	LocalAddress string `mapstructure:"local-address" json:"local-address,omitempty"`
+	// original -> gobgp:auth-password
+	// Password of the TCP MD5 signature option.
+	AuthPassword string `mapstructure:"auth-password" json:"auth-password,omitempty"`
+	// original -> gobgp:transport
+	// Transport of the BMP session. tcp and tls can be used.
+	Transport BmpTransportType `mapstructure:"transport" json:"transport,omitempty"`
+	// original -> gobgp:tls-ca-file
+	// CA certificates file to verify the BMP server. In the active
+	// mode, the system CA certificates are used if omitted. In the
+	// passive mode, the client certificate is required if set.
+	TlsCaFile string `mapstructure:"tls-ca-file" json:"tls-ca-file,omitempty"`
+	// original -> gobgp:tls-cert-file
+	// Certificate file for the TLS transport, mandatory in the
+	// passive mode.
+	TlsCertFile string `mapstructure:"tls-cert-file" json:"tls-cert-file,omitempty"`
+	// original -> gobgp:tls-key-file
+	// Key file for the TLS transport, mandatory in the passive mode.
+	TlsKeyFile string `mapstructure:"tls-key-file" json:"tls-key-file,omitempty"`
+	// original -> gobgp:tls-server-name
+	// Server name to verify the certificate of the BMP server in the
+	// active mode. The address is used if omitted.
+	TlsServerName string `mapstructure:"tls-server-name" json:"tls-server-name,omitempty"`
+}
+
+// struct for container gobgp:config.
+// Configuration parameters relating to BMP server.
+type BmpServerConfig struct {
+	// original -> gobgp:address
+	// gobgp:address's original type is inet:ip-address.
+	// Reference to the address of the BMP server used as
+	// a key in the BMP server list.
+	Address string `mapstructure:"address" json:"address,omitempty"`
+	// original -> gobgp:port
+	// Reference to the port of the BMP server.
+	Port uint32 `mapstructure:"port" json:"port,omitempty"`
+	// original -> gobgp:route-monitoring-policy
+	RouteMonitoringPolicy BmpRouteMonitoringPolicyType `mapstructure:"route-monitoring-policy" json:"route-monitoring-policy,omitempty"`
+	// original -> gobgp:statistics-timeout
+	// Interval seconds of statistics messages sent to BMP server.
+	StatisticsTimeout uint16 `mapstructure:"statistics-timeout" json:"statistics-timeout,omitempty"`
+	// original -> gobgp:route-mirroring-enabled
+	// gobgp:route-mirroring-enabled's original type is boolean.
+	// Enable feature for mirroring of received BGP messages
+	// mainly for debugging purpose.
+	RouteMirroringEnabled bool `mapstructure:"route-mirroring-enabled" json:"route-mirroring-enabled,omitempty"`
+	// original -> gobgp:route-mirroring-neighbor
+	// original type is list of inet:ip-address
+	// List of the neighbors whose received BGP messages are mirrored.
+	// The messages from all the neighbors are mirrored if omitted.
+	RouteMirroringNeighborList []string `mapstructure:"route-mirroring-neighbor-list" json:"route-mirroring-neighbor-list,omitempty"`
+	// original -> gobgp:route-mirroring-rate-limit
+	// Maximum number of the mirrored BGP messages per second. The
+	// collector is notified of the messages dropped over the limit.
+	// Unlimited if omitted.
+	RouteMirroringRateLimit uint32 `mapstructure:"route-mirroring-rate-limit" json:"route-mirroring-rate-limit,omitempty"`
+	// original -> gobgp:sys-name
+	// Reference to the SysName of the BMP server.
+	SysName string `mapstructure:"sys-name" json:"sys-name,omitempty"`
+	// original -> gobgp:sys-descr
+	// Reference to the SysDescr of the BMP server.
+	SysDescr string `mapstructure:"sys-descr" json:"sys-descr,omitempty"`
+	// original -> gobgp:mode
+	// In the active mode, the BMP session is initiated to the BMP
+	// server. In the passive mode, the connection from the BMP server
+	// of the address is accepted on the port.
+	Mode BmpModeType `mapstructure:"mode" json:"mode,omitempty"`
+	// original -> gobgp:local-address
+	// gobgp:local-address's original type is inet:ip-address.
+	// Address on which the passive mode listens. Any address if
+	// omitted.
+	LocalAddress string `mapstructure:"local-address" json:"local-address,omitempty"`
+	// original -> gobgp:auth-password
+	// Password of the TCP MD5 signature option.
+	AuthPassword string `mapstructure:"auth-password" json:"auth-password,omitempty"`
+	// original -> gobgp:transport
+	// Transport of the BMP session. tcp and tls can be used.
+	Transport BmpTransportType `mapstructure:"transport" json:"transport,omitempty"`
+	// original -> gobgp:tls-ca-file
+	// CA certificates file to verify the BMP server. In the active
+	// mode, the system CA certificates are used if omitted. In the
+	// passive mode, the client certificate is required if set.
+	TlsCaFile string `mapstructure:"tls-ca-file" json:"tls-ca-file,omitempty"`
+	// original -> gobgp:tls-cert-file
+	// Certificate file for the TLS transport, mandatory in the
+	// passive mode.
+	TlsCertFile string `mapstructure:"tls-cert-file" json:"tls-cert-file,omitempty"`
+	// original -> gobgp:tls-key-file
+	// Key file for the TLS transport, mandatory in the passive mode.
+	TlsKeyFile string `mapstructure:"tls-key-file" json:"tls-key-file,omitempty"`
+	// original -> gobgp:tls-server-name
+	// Server name to verify the certificate of the BMP server in the
+	// active mode. The address is used if omitted.
+	TlsServerName string `mapstructure:"tls-server-name" json:"tls-server-name,omitempty"`
+}
+
+func (lhs *BmpServerConfig) Equal(rhs *BmpServerConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Address != rhs.Address {
+		return false
+	}
+	if lhs.Port != rhs.Port {
+		return false
+	}
+	if lhs.RouteMonitoringPolicy != rhs.RouteMonitoringPolicy {
+		return false
+	}
+	if lhs.StatisticsTimeout != rhs.StatisticsTimeout {
+		return false
+	}
+	if lhs.RouteMirroringEnabled != rhs.RouteMirroringEnabled {
+		return false
+	}
+	if len(lhs.RouteMirroringNeighborList) != len(rhs.RouteMirroringNeighborList) {
+		return false
+	}
+	for idx, l := range lhs.RouteMirroringNeighborList {
+		if l != rhs.RouteMirroringNeighborList[idx] {
+			return false
+		}
+	}
+	if lhs.RouteMirroringRateLimit != rhs.RouteMirroringRateLimit {
+		return false
+	}
+	if lhs.SysName != rhs.SysName {
+		return false
+	}
+	if lhs.SysDescr != rhs.SysDescr {
+		return false
+	}
+	if lhs.Mode != rhs.Mode {
+		return false
+	}
+	if lhs.LocalAddress != rhs.LocalAddress {
+		return false
+	}
+	if lhs.AuthPassword != rhs.AuthPassword {
+		return false
+	}
+	if lhs.Transport != rhs.Transport {
+		return false
+	}
+	if lhs.TlsCaFile != rhs.TlsCaFile {
+		return false
+	}
+	if lhs.TlsCertFile != rhs.TlsCertFile {
+		return false
+	}
+	if lhs.TlsKeyFile != rhs.TlsKeyFile {
+		return false
+	}
+	if lhs.TlsServerName != rhs.TlsServerName {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:bmp-server.
+// List of BMP servers configured on the local system.
+type BmpServer struct {
+	// original -> gobgp:address
+	// original -> gobgp:bmp-server-config
+	// Configuration parameters relating to BMP server.
+	Config BmpServerConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:bmp-server-state
+	// Configuration parameters relating to BMP server.
+	State BmpServerState `mapstructure:"state" json:"state,omitempty"`
+}
+
+func (lhs *BmpServer) Equal(rhs *BmpServer) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:state.
+// Configuration parameters relating to Kafka exporter.
+type KafkaExporterState struct {
+	// original -> gobgp:name
+	// Name of the Kafka exporter used as a key in the Kafka
+	// exporter list.
+	Name string `mapstructure:"name" json:"name,omitempty"`
+	// original -> gobgp:broker
+	// List of the addresses of the Kafka brokers.
+	BrokerList []string `mapstructure:"broker-list" json:"broker-list,omitempty"`
+	// original -> gobgp:topic
+	// Topic to which the route events are published.
+	Topic string `mapstructure:"topic" json:"topic,omitempty"`
+	// original -> gobgp:encoding
+	Encoding KafkaEncodingType `mapstructure:"encoding" json:"encoding,omitempty"`
+	// original -> gobgp:partition-key
+	PartitionKey KafkaPartitionKeyType `mapstructure:"partition-key" json:"partition-key,omitempty"`
+	// original -> gobgp:route-monitoring-policy
+	RouteMonitoringPolicy BmpRouteMonitoringPolicyType `mapstructure:"route-monitoring-policy" json:"route-monitoring-policy,omitempty"`
+}
+
+// struct for container gobgp:config.
+// Configuration parameters relating to Kafka exporter.
+type KafkaExporterConfig struct {
+	// original -> gobgp:name
+	// Name of the Kafka exporter used as a key in the Kafka
+	// exporter list.
+	Name string `mapstructure:"name" json:"name,omitempty"`
+	// original -> gobgp:broker
+	// List of the addresses of the Kafka brokers.
+	BrokerList []string `mapstructure:"broker-list" json:"broker-list,omitempty"`
+	// original -> gobgp:topic
+	// Topic to which the route events are published.
+	Topic string `mapstructure:"topic" json:"topic,omitempty"`
+	// original -> gobgp:encoding
+	Encoding KafkaEncodingType `mapstructure:"encoding" json:"encoding,omitempty"`
+	// original -> gobgp:partition-key
+	PartitionKey KafkaPartitionKeyType `mapstructure:"partition-key" json:"partition-key,omitempty"`
+	// original -> gobgp:route-monitoring-policy
+	RouteMonitoringPolicy BmpRouteMonitoringPolicyType `mapstructure:"route-monitoring-policy" json:"route-monitoring-policy,omitempty"`
+}
+
+func (lhs *KafkaExporterConfig) Equal(rhs *KafkaExporterConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Name != rhs.Name {
+		return false
+	}
+	if len(lhs.BrokerList) != len(rhs.BrokerList) {
+		return false
+	}
+	for idx, l := range lhs.BrokerList {
+		if l != rhs.BrokerList[idx] {
+			return false
+		}
+	}
+	if lhs.Topic != rhs.Topic {
+		return false
+	}
+	if lhs.Encoding != rhs.Encoding {
+		return false
+	}
+	if lhs.PartitionKey != rhs.PartitionKey {
+		return false
+	}
+	if lhs.RouteMonitoringPolicy != rhs.RouteMonitoringPolicy {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:kafka-exporter.
+// List of Kafka exporters configured on the local system.
+type KafkaExporter struct {
+	// original -> gobgp:name
+	// original -> gobgp:kafka-exporter-config
+	// Configuration parameters relating to Kafka exporter.
+	Config KafkaExporterConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:kafka-exporter-state
+	// Configuration parameters relating to Kafka exporter.
+	State KafkaExporterState `mapstructure:"state" json:"state,omitempty"`
+}
+
+func (lhs *KafkaExporter) Equal(rhs *KafkaExporter) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:state.
+// Configuration parameters relating to IRR filter.
+type IrrFilterState struct {
+	// original -> gobgp:name
+	// Name of the IRR filter used as a key in the IRR filter
+	// list.
+	Name string `mapstructure:"name" json:"name,omitempty"`
+	// original -> gobgp:as-set
+	// As-set or AS number expanded to the defined sets.
+	AsSet string `mapstructure:"as-set" json:"as-set,omitempty"`
+	// original -> gobgp:server-address
+	// Address of the IRRd server.
+	ServerAddress string `mapstructure:"server-address" json:"server-address,omitempty"`
+	// original -> gobgp:source
+	// List of the IRR databases queried.
+	SourceList []string `mapstructure:"source-list" json:"source-list,omitempty"`
+	// original -> gobgp:refresh-interval
+	// Interval in seconds between the queries.
+	RefreshInterval uint32 `mapstructure:"refresh-interval" json:"refresh-interval,omitempty"`
+	// original -> gobgp:ipv4-prefix-set
+	// Name of the prefix set of the IPv4 route objects.
+	Ipv4PrefixSet string `mapstructure:"ipv4-prefix-set" json:"ipv4-prefix-set,omitempty"`
+	// original -> gobgp:ipv6-prefix-set
+	// Name of the prefix set of the IPv6 route objects.
+	Ipv6PrefixSet string `mapstructure:"ipv6-prefix-set" json:"ipv6-prefix-set,omitempty"`
+	// original -> gobgp:as-path-set
+	// Name of the as-path set of the origin AS numbers.
+	AsPathSet string `mapstructure:"as-path-set" json:"as-path-set,omitempty"`
+	// original -> gobgp:ipv4-max-length
+	// Longest mask length accepted for the IPv4 route objects.
+	Ipv4MaxLength uint8 `mapstructure:"ipv4-max-length" json:"ipv4-max-length,omitempty"`
+	// original -> gobgp:ipv6-max-length
+	// Longest mask length accepted for the IPv6 route objects.
+	Ipv6MaxLength uint8 `mapstructure:"ipv6-max-length" json:"ipv6-max-length,omitempty"`
+	// original -> gobgp:max-prefixes
+	// Maximum number of the prefixes accepted in the result.
+	MaxPrefixes uint32 `mapstructure:"max-prefixes" json:"max-prefixes,omitempty"`
+	// original -> gobgp:max-asns
+	// Maximum number of the AS numbers accepted in the result.
+	MaxAsns uint32 `mapstructure:"max-asns" json:"max-asns,omitempty"`
+}
+
+// struct for container gobgp:config.
+// Configuration parameters relating to IRR filter.
+type IrrFilterConfig struct {
+	// original -> gobgp:name
+	// Name of the IRR filter used as a key in the IRR filter
+	// list.
+	Name string `mapstructure:"name" json:"name,omitempty"`
+	// original -> gobgp:as-set
+	// As-set or AS number expanded to the defined sets.
+	AsSet string `mapstructure:"as-set" json:"as-set,omitempty"`
+	// original -> gobgp:server-address
+	// Address of the IRRd server.
+	ServerAddress string `mapstructure:"server-address" json:"server-address,omitempty"`
+	// original -> gobgp:source
+	// List of the IRR databases queried.
+	SourceList []string `mapstructure:"source-list" json:"source-list,omitempty"`
+	// original -> gobgp:refresh-interval
+	// Interval in seconds between the queries.
+	RefreshInterval uint32 `mapstructure:"refresh-interval" json:"refresh-interval,omitempty"`
+	// original -> gobgp:ipv4-prefix-set
+	// Name of the prefix set of the IPv4 route objects.
+	Ipv4PrefixSet string `mapstructure:"ipv4-prefix-set" json:"ipv4-prefix-set,omitempty"`
+	// original -> gobgp:ipv6-prefix-set
+	// Name of the prefix set of the IPv6 route objects.
+	Ipv6PrefixSet string `mapstructure:"ipv6-prefix-set" json:"ipv6-prefix-set,omitempty"`
+	// original -> gobgp:as-path-set
+	// Name of the as-path set of the origin AS numbers.
+	AsPathSet string `mapstructure:"as-path-set" json:"as-path-set,omitempty"`
+	// original -> gobgp:ipv4-max-length
+	// Longest mask length accepted for the IPv4 route objects.
+	Ipv4MaxLength uint8 `mapstructure:"ipv4-max-length" json:"ipv4-max-length,omitempty"`
+	// original -> gobgp:ipv6-max-length
+	// Longest mask length accepted for the IPv6 route objects.
+	Ipv6MaxLength uint8 `mapstructure:"ipv6-max-length" json:"ipv6-max-length,omitempty"`
+	// original -> gobgp:max-prefixes
+	// Maximum number of the prefixes accepted in the result.
+	MaxPrefixes uint32 `mapstructure:"max-prefixes" json:"max-prefixes,omitempty"`
+	// original -> gobgp:max-asns
+	// Maximum number of the AS numbers accepted in the result.
+	MaxAsns uint32 `mapstructure:"max-asns" json:"max-asns,omitempty"`
+}
+
+func (lhs *IrrFilterConfig) Equal(rhs *IrrFilterConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Name != rhs.Name {
+		return false
+	}
+	if lhs.AsSet != rhs.AsSet {
+		return false
+	}
+	if lhs.ServerAddress != rhs.ServerAddress {
+		return false
+	}
+	if len(lhs.SourceList) != len(rhs.SourceList) {
+		return false
+	}
+	for idx, l := range lhs.SourceList {
+		if l != rhs.SourceList[idx] {
+			return false
+		}
+	}
+	if lhs.RefreshInterval != rhs.RefreshInterval {
+		return false
+	}
+	if lhs.Ipv4PrefixSet != rhs.Ipv4PrefixSet {
+		return false
+	}
+	if lhs.Ipv6PrefixSet != rhs.Ipv6PrefixSet {
+		return false
+	}
+	if lhs.AsPathSet != rhs.AsPathSet {
+		return false
+	}
+	if lhs.Ipv4MaxLength != rhs.Ipv4MaxLength {
+		return false
+	}
+	if lhs.Ipv6MaxLength != rhs.Ipv6MaxLength {
+		return false
+	}
+	if lhs.MaxPrefixes != rhs.MaxPrefixes {
+		return false
+	}
+	if lhs.MaxAsns != rhs.MaxAsns {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:irr-filter.
+// List of IRR filters configured on the local system.
+type IrrFilter struct {
+	// original -> gobgp:name
+	// original -> gobgp:irr-filter-config
+	// Configuration parameters relating to IRR filter.
+	Config IrrFilterConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:irr-filter-state
+	// Configuration parameters relating to IRR filter.
+	State IrrFilterState `mapstructure:"state" json:"state,omitempty"`
+}
+
+func (lhs *IrrFilter) Equal(rhs *IrrFilter) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:state.
+// Configuration parameters relating to VRF route leak.
+type VrfLeakState struct {
+	// original -> gobgp:name
+	// Name of the route leak used as a key in the route leak list.
+	Name string `mapstructure:"name" json:"name,omitempty"`
+	// original -> gobgp:source-vrf
+	// Name of the VRF whose best routes are leaked. The global RIB
+	// if empty.
+	SourceVrf string `mapstructure:"source-vrf" json:"source-vrf,omitempty"`
+	// original -> gobgp:destination-vrf
+	// Name of the VRF into which the routes are leaked. The global
+	// RIB if empty.
+	DestinationVrf string `mapstructure:"destination-vrf" json:"destination-vrf,omitempty"`
+	// original -> gobgp:policy
+	// list of policy names in sequence to be applied on leaking
+	// a route.
+	PolicyList []string `mapstructure:"policy-list" json:"policy-list,omitempty"`
+	// original -> gobgp:default-policy
+	// explicitly set a default policy if no policy definition
+	// in the policy chain is satisfied.
+	DefaultPolicy DefaultPolicyType `mapstructure:"default-policy" json:"default-policy,omitempty"`
+	// original -> gobgp:leaked
+	// Number of the routes leaked into the destination.
+	Leaked uint32 `mapstructure:"leaked" json:"leaked,omitempty"`
+}
+
+// struct for container gobgp:config.
+// Configuration parameters relating to VRF route leak.
+type VrfLeakConfig struct {
+	// original -> gobgp:name
+	// Name of the route leak used as a key in the route leak list.
+	Name string `mapstructure:"name" json:"name,omitempty"`
+	// original -> gobgp:source-vrf
+	// Name of the VRF whose best routes are leaked. The global RIB
+	// if empty.
+	SourceVrf string `mapstructure:"source-vrf" json:"source-vrf,omitempty"`
+	// original -> gobgp:destination-vrf
+	// Name of the VRF into which the routes are leaked. The global
+	// RIB if empty.
+	DestinationVrf string `mapstructure:"destination-vrf" json:"destination-vrf,omitempty"`
+	// original -> gobgp:policy
+	// list of policy names in sequence to be applied on leaking
+	// a route.
+	PolicyList []string `mapstructure:"policy-list" json:"policy-list,omitempty"`
+	// original -> gobgp:default-policy
+	// explicitly set a default policy if no policy definition
+	// in the policy chain is satisfied.
+	DefaultPolicy DefaultPolicyType `mapstructure:"default-policy" json:"default-policy,omitempty"`
+}
+
+func (lhs *VrfLeakConfig) Equal(rhs *VrfLeakConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Name != rhs.Name {
+		return false
+	}
+	if lhs.SourceVrf != rhs.SourceVrf {
+		return false
+	}
+	if lhs.DestinationVrf != rhs.DestinationVrf {
+		return false
+	}
+	if len(lhs.PolicyList) != len(rhs.PolicyList) {
+		return false
+	}
+	for idx, l := range lhs.PolicyList {
+		if l != rhs.PolicyList[idx] {
+			return false
+		}
+	}
+	if lhs.DefaultPolicy != rhs.DefaultPolicy {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:vrf-leak.
+// List of VRF route leaks configured on the local system.
+type VrfLeak struct {
+	// original -> gobgp:name
+	// original -> gobgp:vrf-leak-config
+	// Configuration parameters relating to VRF route leak.
+	Config VrfLeakConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:vrf-leak-state
+	// Configuration parameters relating to VRF route leak.
+	State VrfLeakState `mapstructure:"state" json:"state,omitempty"`
+}
+
+func (lhs *VrfLeak) Equal(rhs *VrfLeak) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:state.
+// Configuration parameters relating to EVPN VPWS service instance.
+type EvpnVpwsInstanceState struct {
+	// original -> gobgp:name
+	// Name of the VPWS service instance used as a key in the
+	// instance list.
+	Name string `mapstructure:"name" json:"name,omitempty"`
+	// original -> gobgp:rd
+	// Route Distinguisher of the Ethernet A-D per EVI route.
+	Rd string `mapstructure:"rd" json:"rd,omitempty"`
+	// original -> gobgp:route-target
+	// Route Targets to export the Ethernet A-D per EVI route with
+	// and to import the remote one with.
+	RouteTargetList []string `mapstructure:"route-target-list" json:"route-target-list,omitempty"`
+	// original -> gobgp:esi
+	// Ethernet Segment Identifier of the attachment circuit.
+	// single-homed if empty.
+	Esi string `mapstructure:"esi" json:"esi,omitempty"`
+	// original -> gobgp:local-service-id
+	// Ethernet Tag of the local Ethernet A-D per EVI route.
+	LocalServiceId uint32 `mapstructure:"local-service-id" json:"local-service-id,omitempty"`
+	// original -> gobgp:remote-service-id
+	// Ethernet Tag of the remote Ethernet A-D per EVI route.
+	RemoteServiceId uint32 `mapstructure:"remote-service-id" json:"remote-service-id,omitempty"`
+	// original -> gobgp:label
+	// MPLS label or VNI to receive the pseudowire traffic with.
+	Label uint32 `mapstructure:"label" json:"label,omitempty"`
+	// original -> gobgp:control-word
+	// Require the control word in the pseudowire traffic.
+	ControlWord bool `mapstructure:"control-word" json:"control-word,omitempty"`
+	// original -> gobgp:flow-label
+	// Require the flow label in the pseudowire traffic.
+	FlowLabel bool `mapstructure:"flow-label" json:"flow-label,omitempty"`
+	// original -> gobgp:mtu
+	// MTU of the attachment circuit. The remote MTU isn't checked
+	// if zero.
+	Mtu uint16 `mapstructure:"mtu" json:"mtu,omitempty"`
+	// original -> gobgp:up
+	// The remote Ethernet A-D per EVI route is received and the
+	// MTUs match.
+	Up bool `mapstructure:"up" json:"up,omitempty"`
+}
+
+// struct for container gobgp:config.
+// Configuration parameters relating to EVPN VPWS service instance.
+type EvpnVpwsInstanceConfig struct {
+	// original -> gobgp:name
+	// Name of the VPWS service instance used as a key in the
+	// instance list.
+	Name string `mapstructure:"name" json:"name,omitempty"`
+	// original -> gobgp:rd
+	// Route Distinguisher of the Ethernet A-D per EVI route.
+	Rd string `mapstructure:"rd" json:"rd,omitempty"`
+	// original -> gobgp:route-target
+	// Route Targets to export the Ethernet A-D per EVI route with
+	// and to import the remote one with.
+	RouteTargetList []string `mapstructure:"route-target-list" json:"route-target-list,omitempty"`
+	// original -> gobgp:esi
+	// Ethernet Segment Identifier of the attachment circuit.
+	// single-homed if empty.
+	Esi string `mapstructure:"esi" json:"esi,omitempty"`
+	// original -> gobgp:local-service-id
+	// Ethernet Tag of the local Ethernet A-D per EVI route.
+	LocalServiceId uint32 `mapstructure:"local-service-id" json:"local-service-id,omitempty"`
+	// original -> gobgp:remote-service-id
+	// Ethernet Tag of the remote Ethernet A-D per EVI route.
+	RemoteServiceId uint32 `mapstructure:"remote-service-id" json:"remote-service-id,omitempty"`
+	// original -> gobgp:label
+	// MPLS label or VNI to receive the pseudowire traffic with.
+	Label uint32 `mapstructure:"label" json:"label,omitempty"`
+	// original -> gobgp:control-word
+	// Require the control word in the pseudowire traffic.
+	ControlWord bool `mapstructure:"control-word" json:"control-word,omitempty"`
+	// original -> gobgp:flow-label
+	// Require the flow label in the pseudowire traffic.
+	FlowLabel bool `mapstructure:"flow-label" json:"flow-label,omitempty"`
+	// original -> gobgp:mtu
+	// MTU of the attachment circuit. The remote MTU isn't checked
+	// if zero.
+	Mtu uint16 `mapstructure:"mtu" json:"mtu,omitempty"`
+}
+
+func (lhs *EvpnVpwsInstanceConfig) Equal(rhs *EvpnVpwsInstanceConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Name != rhs.Name {
+		return false
+	}
+	if lhs.Rd != rhs.Rd {
+		return false
+	}
+	if len(lhs.RouteTargetList) != len(rhs.RouteTargetList) {
+		return false
+	}
+	for idx, l := range lhs.RouteTargetList {
+		if l != rhs.RouteTargetList[idx] {
+			return false
+		}
+	}
+	if lhs.Esi != rhs.Esi {
+		return false
+	}
+	if lhs.LocalServiceId != rhs.LocalServiceId {
+		return false
+	}
+	if lhs.RemoteServiceId != rhs.RemoteServiceId {
+		return false
+	}
+	if lhs.Label != rhs.Label {
+		return false
+	}
+	if lhs.ControlWord != rhs.ControlWord {
+		return false
+	}
+	if lhs.FlowLabel != rhs.FlowLabel {
+		return false
+	}
+	if lhs.Mtu != rhs.Mtu {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:evpn-vpws-instance.
+// List of EVPN VPWS service instances configured on the local
+// system.
+type EvpnVpwsInstance struct {
+	// original -> gobgp:name
+	// original -> gobgp:evpn-vpws-instance-config
+	// Configuration parameters relating to EVPN VPWS service instance.
+	Config EvpnVpwsInstanceConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:evpn-vpws-instance-state
+	// Configuration parameters relating to EVPN VPWS service instance.
+	State EvpnVpwsInstanceState `mapstructure:"state" json:"state,omitempty"`
+}
+
+func (lhs *EvpnVpwsInstance) Equal(rhs *EvpnVpwsInstance) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:igp-cost.
+// List of the IGP costs to the next hops from the vantage point.
+type IgpCost struct {
+	// original -> gobgp:prefix
+	// gobgp:prefix's original type is inet:ip-prefix.
+	// Prefix of the next hops. The cost of the longest matching
+	// prefix is used.
+	Prefix string `mapstructure:"prefix" json:"prefix,omitempty"`
+	// original -> gobgp:cost
+	// IGP cost to the next hops from the vantage point.
+	Cost uint32 `mapstructure:"cost" json:"cost,omitempty"`
 }
 
-func (lhs *Mrt) Equal(rhs *Mrt) bool {
+func (lhs *IgpCost) Equal(rhs *IgpCost) bool {
 	if lhs == nil || rhs == nil {
 		return false
 	}
-	if !lhs.Config.Equal(&(rhs.Config)) {
+	if lhs.Prefix != rhs.Prefix {
+		return false
+	}
+	if lhs.Cost != rhs.Cost {
 		return false
 	}
 	return true
 }
 
 // struct for container gobgp:state.
-// Configured states of VRF.
-type VrfState struct {
+// Configuration parameters relating to optimal route reflection
+// vantage point.
+type OrrVantagePointState struct {
 	// original -> gobgp:name
-	// Unique name among all VRF instances.
+	// Name of the vantage point used as a key in the vantage point
+	// list.
 	Name string `mapstructure:"name" json:"name,omitempty"`
-	// original -> gobgp:id
-	// Unique identifier among all VRF instances.
-	Id uint32 `mapstructure:"id" json:"id,omitempty"`
-	// original -> gobgp:rd
-	// Route Distinguisher for this VRF.
-	Rd string `mapstructure:"rd" json:"rd,omitempty"`
-	// original -> gobgp:import-rt
-	// List of import Route Targets for this VRF.
-	ImportRtList []string `mapstructure:"import-rt-list" json:"import-rt-list,omitempty"`
-	// original -> gobgp:export-rt
-	// List of export Route Targets for this VRF.
-	ExportRtList []string `mapstructure:"export-rt-list" json:"export-rt-list,omitempty"`
+	// original -> gobgp:igp-cost
+	// List of the IGP costs to the next hops from the vantage point.
+	IgpCostList []IgpCost `mapstructure:"igp-cost-list" json:"igp-cost-list,omitempty"`
 }
 
 // struct for container gobgp:config.
-// Configuration parameters for VRF.
-type VrfConfig struct {
+// Configuration parameters relating to optimal route reflection
+// vantage point.
+type OrrVantagePointConfig struct {
 	// original -> gobgp:name
-	// Unique name among all VRF instances.
+	// Name of the vantage point used as a key in the vantage point
+	// list.
 	Name string `mapstructure:"name" json:"name,omitempty"`
-	// original -> gobgp:id
-	// Unique identifier among all VRF instances.
-	Id uint32 `mapstructure:"id" json:"id,omitempty"`
-	// original -> gobgp:rd
-	// Route Distinguisher for this VRF.
-	Rd string `mapstructure:"rd" json:"rd,omitempty"`
-	// original -> gobgp:import-rt
-	// List of import Route Targets for this VRF.
-	ImportRtList []string `mapstructure:"import-rt-list" json:"import-rt-list,omitempty"`
-	// original -> gobgp:export-rt
-	// List of export Route Targets for this VRF.
-	ExportRtList []string `mapstructure:"export-rt-list" json:"export-rt-list,omitempty"`
-	// original -> gobgp:both-rt
-	// List of both import and export Route Targets for this VRF. Each
-	// configuration for import and export Route Targets will be preferred.
-	BothRtList []string `mapstructure:"both-rt-list" json:"both-rt-list,omitempty"`
+	// original -> gobgp:igp-cost
+	// List of the IGP costs to the next hops from the vantage point.
+	IgpCostList []IgpCost `mapstructure:"igp-cost-list" json:"igp-cost-list,omitempty"`
 }
 
-func (lhs *VrfConfig) Equal(rhs *VrfConfig) bool {
+func (lhs *OrrVantagePointConfig) Equal(rhs *OrrVantagePointConfig) bool {
 	if lhs == nil || rhs == nil {
 		return false
 	}
 	if lhs.Name != rhs.Name {
 		return false
 	}
-	if lhs.Id != rhs.Id {
-		return false
-	}
-	if lhs.Rd != rhs.Rd {
-		return false
-	}
-	if len(lhs.ImportRtList) != len(rhs.ImportRtList) {
-		return false
-	}
-	for idx, l := range lhs.ImportRtList {
-		if l != rhs.ImportRtList[idx] {
-			return false
-		}
-	}
-	if len(lhs.ExportRtList) != len(rhs.ExportRtList) {
+	if len(lhs.IgpCostList) != len(rhs.IgpCostList) {
 		return false
 	}
-	for idx, l := range lhs.ExportRtList {
-		if l != rhs.ExportRtList[idx] {
-			return false
+	{
+		lmap := make(map[string]*IgpCost)
+		for i, l := range lhs.IgpCostList {
+			lmap[mapkey(i, string(l.Prefix))] = &lhs.IgpCostList[i]
 		}
-	}
-	if len(lhs.BothRtList) != len(rhs.BothRtList) {
-		return false
-	}
-	for idx, l := range lhs.BothRtList {
-		if l != rhs.BothRtList[idx] {
-			return false
+		for i, r := range rhs.IgpCostList {
+			if l, y := lmap[mapkey(i, string(r.Prefix))]; !y {
+				return false
+			} else if !r.Equal(l) {
+				return false
+			}
 		}
 	}
 	return true
 }
 
-// struct for container gobgp:vrf.
-// VRF instance configurations on the local system.
-type Vrf struct {
+// struct for container gobgp:orr-vantage-point.
+// List of optimal route reflection vantage points configured on
+// the local system.
+type OrrVantagePoint struct {
 	// original -> gobgp:name
-	// original -> gobgp:vrf-config
-	// Configuration parameters for VRF.
-	Config VrfConfig `mapstructure:"config" json:"config,omitempty"`
-	// original -> gobgp:vrf-state
-	// Configured states of VRF.
-	State VrfState `mapstructure:"state" json:"state,omitempty"`
+	// original -> gobgp:orr-vantage-point-config
+	// Configuration parameters relating to optimal route reflection
+	// vantage point.
+	Config OrrVantagePointConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:orr-vantage-point-state
+	// Configuration parameters relating to optimal route reflection
+	// vantage point.
+	State OrrVantagePointState `mapstructure:"state" json:"state,omitempty"`
 }
 
-func (lhs *Vrf) Equal(rhs *Vrf) bool {
+func (lhs *OrrVantagePoint) Equal(rhs *OrrVantagePoint) bool {
 	if lhs == nil || rhs == nil {
 		return false
 	}
@@ -1397,104 +2972,93 @@ func (lhs *Vrf) Equal(rhs *Vrf) bool {
 }
 
 // struct for container gobgp:state.
-// Configuration parameters relating to BMP server.
-type BmpServerState struct {
-	// original -> gobgp:address
-	// gobgp:address's original type is inet:ip-address.
-	// Reference to the address of the BMP server used as
-	// a key in the BMP server list.
-	Address string `mapstructure:"address" json:"address,omitempty"`
-	// original -> gobgp:port
-	// Reference to the port of the BMP server.
-	Port uint32 `mapstructure:"port" json:"port,omitempty"`
-	// original -> gobgp:route-monitoring-policy
-	RouteMonitoringPolicy BmpRouteMonitoringPolicyType `mapstructure:"route-monitoring-policy" json:"route-monitoring-policy,omitempty"`
-	// original -> gobgp:statistics-timeout
-	// Interval seconds of statistics messages sent to BMP server.
-	StatisticsTimeout uint16 `mapstructure:"statistics-timeout" json:"statistics-timeout,omitempty"`
-	// original -> gobgp:route-mirroring-enabled
-	// gobgp:route-mirroring-enabled's original type is boolean.
-	// Enable feature for mirroring of received BGP messages
-	// mainly for debugging purpose.
-	RouteMirroringEnabled bool `mapstructure:"route-mirroring-enabled" json:"route-mirroring-enabled,omitempty"`
-	// original -> gobgp:sys-name
-	// Reference to the SysName of the BMP server.
-	SysName string `mapstructure:"sys-name" json:"sys-name,omitempty"`
-	// original -> gobgp:sys-descr
-	// Reference to the SysDescr of the BMP server.
-	SysDescr string `mapstructure:"sys-descr" json:"sys-descr,omitempty"`
+// Configuration parameters relating to aggregate.
+type AggregateState struct {
+	// original -> gobgp:prefix
+	// gobgp:prefix's original type is inet:ip-prefix.
+	// Prefix of the aggregate used as a key in the aggregate list.
+	Prefix string `mapstructure:"prefix" json:"prefix,omitempty"`
+	// original -> gobgp:as-set
+	// gobgp:as-set's original type is boolean.
+	// Generate the AS_SET of the AS numbers of the contributing
+	// routes instead of the ATOMIC_AGGREGATE.
+	AsSet bool `mapstructure:"as-set" json:"as-set,omitempty"`
+	// original -> gobgp:summary-only
+	// gobgp:summary-only's original type is boolean.
+	// Suppress the more-specific routes toward all the neighbors.
+	SummaryOnly bool `mapstructure:"summary-only" json:"summary-only,omitempty"`
+	// original -> gobgp:suppress-neighbor
+	// List of the neighbors toward which the more-specific routes
+	// are suppressed.
+	SuppressNeighborList []string `mapstructure:"suppress-neighbor-list" json:"suppress-neighbor-list,omitempty"`
+	// original -> gobgp:contributors
+	// Number of the routes contributing to the aggregate.
+	Contributors uint32 `mapstructure:"contributors" json:"contributors,omitempty"`
+	// original -> gobgp:installed
+	// gobgp:installed's original type is boolean.
+	// The aggregate is installed in the global RIB.
+	Installed bool `mapstructure:"installed" json:"installed,omitempty"`
 }
 
 // struct for container gobgp:config.
-// Configuration parameters relating to BMP server.
-type BmpServerConfig struct {
-	// original -> gobgp:address
-	// gobgp:address's original type is inet:ip-address.
-	// Reference to the address of the BMP server used as
-	// a key in the BMP server list.
-	Address string `mapstructure:"address" json:"address,omitempty"`
-	// original -> gobgp:port
-	// Reference to the port of the BMP server.
-	Port uint32 `mapstructure:"port" json:"port,omitempty"`
-	// original -> gobgp:route-monitoring-policy
-	RouteMonitoringPolicy BmpRouteMonitoringPolicyType `mapstructure:"route-monitoring-policy" json:"route-monitoring-policy,omitempty"`
-	// original -> gobgp:statistics-timeout
-	// Interval seconds of statistics messages sent to BMP server.
-	StatisticsTimeout uint16 `mapstructure:"statistics-timeout" json:"statistics-timeout,omitempty"`
-	// original -> gobgp:route-mirroring-enabled
-	// gobgp:route-mirroring-enabled's original type is boolean.
-	// Enable feature for mirroring of received BGP messages
-	// mainly for debugging purpose.
-	RouteMirroringEnabled bool `mapstructure:"route-mirroring-enabled" json:"route-mirroring-enabled,omitempty"`
-	// original -> gobgp:sys-name
-	// Reference to the SysName of the BMP server.
-	SysName string `mapstructure:"sys-name" json:"sys-name,omitempty"`
-	// original -> gobgp:sys-descr
-	// Reference to the SysDescr of the BMP server.
-	SysDescr string `mapstructure:"sys-descr" json:"sys-descr,omitempty"`
-}
-
-func (lhs *BmpServerConfig) Equal(rhs *BmpServerConfig) bool {
+// Configuration parameters relating to aggregate.
+type AggregateConfig struct {
+	// original -> gobgp:prefix
+	// gobgp:prefix's original type is inet:ip-prefix.
+	// Prefix of the aggregate used as a key in the aggregate list.
+	Prefix string `mapstructure:"prefix" json:"prefix,omitempty"`
+	// original -> gobgp:as-set
+	// gobgp:as-set's original type is boolean.
+	// Generate the AS_SET of the AS numbers of the contributing
+	// routes instead of the ATOMIC_AGGREGATE.
+	AsSet bool `mapstructure:"as-set" json:"as-set,omitempty"`
+	// original -> gobgp:summary-only
+	// gobgp:summary-only's original type is boolean.
+	// Suppress the more-specific routes toward all the neighbors.
+	SummaryOnly bool `mapstructure:"summary-only" json:"summary-only,omitempty"`
+	// original -> gobgp:suppress-neighbor
+	// List of the neighbors toward which the more-specific routes
+	// are suppressed.
+	SuppressNeighborList []string `mapstructure:"suppress-neighbor-list" json:"suppress-neighbor-list,omitempty"`
+}
+
+func (lhs *AggregateConfig) Equal(rhs *AggregateConfig) bool {
 	if lhs == nil || rhs == nil {
 		return false
 	}
-	if lhs.Address != rhs.Address {
-		return false
-	}
-	if lhs.Port != rhs.Port {
-		return false
-	}
-	if lhs.RouteMonitoringPolicy != rhs.RouteMonitoringPolicy {
+	if lhs.Prefix != rhs.Prefix {
 		return false
 	}
-	if lhs.StatisticsTimeout != rhs.StatisticsTimeout {
+	if lhs.AsSet != rhs.AsSet {
 		return false
 	}
-	if lhs.RouteMirroringEnabled != rhs.RouteMirroringEnabled {
+	if lhs.SummaryOnly != rhs.SummaryOnly {
 		return false
 	}
-	if lhs.SysName != rhs.SysName {
+	if len(lhs.SuppressNeighborList) != len(rhs.SuppressNeighborList) {
 		return false
 	}
-	if lhs.SysDescr != rhs.SysDescr {
-		return false
+	for idx, l := range lhs.SuppressNeighborList {
+		if l != rhs.SuppressNeighborList[idx] {
+			return false
+		}
 	}
 	return true
 }
 
-// struct for container gobgp:bmp-server.
-// List of BMP servers configured on the local system.
-type BmpServer struct {
-	// original -> gobgp:address
-	// original -> gobgp:bmp-server-config
-	// Configuration parameters relating to BMP server.
-	Config BmpServerConfig `mapstructure:"config" json:"config,omitempty"`
-	// original -> gobgp:bmp-server-state
-	// Configuration parameters relating to BMP server.
-	State BmpServerState `mapstructure:"state" json:"state,omitempty"`
+// struct for container gobgp:aggregate.
+// List of aggregates configured on the local system.
+type Aggregate struct {
+	// original -> gobgp:prefix
+	// original -> gobgp:aggregate-config
+	// Configuration parameters relating to aggregate.
+	Config AggregateConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:aggregate-state
+	// Configuration parameters relating to aggregate.
+	State AggregateState `mapstructure:"state" json:"state,omitempty"`
 }
 
-func (lhs *BmpServer) Equal(rhs *BmpServer) bool {
+func (lhs *Aggregate) Equal(rhs *Aggregate) bool {
 	if lhs == nil || rhs == nil {
 		return false
 	}
@@ -1671,6 +3235,35 @@ type RpkiServerConfig struct {
 	// RPKI server has a static preference.
 	// Higher the preference values indicates a higher priority RPKI server.
 	Preference uint8 `mapstructure:"preference" json:"preference,omitempty"`
+	// original -> gobgp:transport
+	// Transport of the RTR session. tcp, tls and ssh can be used.
+	Transport RpkiTransportType `mapstructure:"transport" json:"transport,omitempty"`
+	// original -> gobgp:tls-ca-file
+	// CA certificates file to verify the RPKI server. The system
+	// CA certificates are used if omitted.
+	TlsCaFile string `mapstructure:"tls-ca-file" json:"tls-ca-file,omitempty"`
+	// original -> gobgp:tls-cert-file
+	// Client certificate file for the TLS transport.
+	TlsCertFile string `mapstructure:"tls-cert-file" json:"tls-cert-file,omitempty"`
+	// original -> gobgp:tls-key-file
+	// Client key file for the TLS transport.
+	TlsKeyFile string `mapstructure:"tls-key-file" json:"tls-key-file,omitempty"`
+	// original -> gobgp:tls-server-name
+	// Server name to verify the certificate of the RPKI server.
+	// The address is used if omitted.
+	TlsServerName string `mapstructure:"tls-server-name" json:"tls-server-name,omitempty"`
+	// original -> gobgp:ssh-username
+	// Username for the SSH transport.
+	SshUsername string `mapstructure:"ssh-username" json:"ssh-username,omitempty"`
+	// original -> gobgp:ssh-password
+	// Password for the SSH transport.
+	SshPassword string `mapstructure:"ssh-password" json:"ssh-password,omitempty"`
+	// original -> gobgp:ssh-private-key-file
+	// Private key file for the SSH transport.
+	SshPrivateKeyFile string `mapstructure:"ssh-private-key-file" json:"ssh-private-key-file,omitempty"`
+	// original -> gobgp:ssh-known-hosts-file
+	// known_hosts file to verify the host key of the RPKI server.
+	SshKnownHostsFile string `mapstructure:"ssh-known-hosts-file" json:"ssh-known-hosts-file,omitempty"`
 }
 
 func (lhs *RpkiServerConfig) Equal(rhs *RpkiServerConfig) bool {
@@ -1695,6 +3288,33 @@ func (lhs *RpkiServerConfig) Equal(rhs *RpkiServerConfig) bool {
 	if lhs.Preference != rhs.Preference {
 		return false
 	}
+	if lhs.Transport != rhs.Transport {
+		return false
+	}
+	if lhs.TlsCaFile != rhs.TlsCaFile {
+		return false
+	}
+	if lhs.TlsCertFile != rhs.TlsCertFile {
+		return false
+	}
+	if lhs.TlsKeyFile != rhs.TlsKeyFile {
+		return false
+	}
+	if lhs.TlsServerName != rhs.TlsServerName {
+		return false
+	}
+	if lhs.SshUsername != rhs.SshUsername {
+		return false
+	}
+	if lhs.SshPassword != rhs.SshPassword {
+		return false
+	}
+	if lhs.SshPrivateKeyFile != rhs.SshPrivateKeyFile {
+		return false
+	}
+	if lhs.SshKnownHostsFile != rhs.SshKnownHostsFile {
+		return false
+	}
 	return true
 }
 
@@ -1814,6 +3434,86 @@ type PeerGroupConfig struct {
 	// original -> gobgp:send-software-version
 	// gobgp:send-software-version's original type is boolean.
 	SendSoftwareVersion bool `mapstructure:"send-software-version" json:"send-software-version,omitempty"`
+	// original -> gobgp:flowspec-validation
+	// gobgp:flowspec-validation's original type is boolean.
+	FlowspecValidation bool `mapstructure:"flowspec-validation" json:"flowspec-validation,omitempty"`
+	// original -> gobgp:send-aigp
+	// gobgp:send-aigp's original type is boolean.
+	// Send the AIGP attribute (RFC 7311) to the neighbor. The
+	// attribute is removed from the advertised routes if false.
+	SendAigp bool `mapstructure:"send-aigp" json:"send-aigp,omitempty"`
+	// original -> gobgp:receive-aigp
+	// gobgp:receive-aigp's original type is boolean.
+	// Accept the AIGP attribute (RFC 7311) from the neighbor. The
+	// attribute is ignored and not propagated if false.
+	ReceiveAigp bool `mapstructure:"receive-aigp" json:"receive-aigp,omitempty"`
+	// original -> gobgp:peeringdb-max-prefix
+	// gobgp:peeringdb-max-prefix's original type is boolean.
+	// Set the max prefixes of IPv4 and IPv6 unicast from the
+	// PeeringDB record of the neighbor.
+	PeeringdbMaxPrefix bool `mapstructure:"peeringdb-max-prefix" json:"peeringdb-max-prefix,omitempty"`
+	// original -> gobgp:dynamic-capability
+	// gobgp:dynamic-capability's original type is boolean.
+	// Advertise the Dynamic Capability so that the address families
+	// are added or removed without resetting the session.
+	DynamicCapability bool `mapstructure:"dynamic-capability" json:"dynamic-capability,omitempty"`
+	// original -> gobgp:auth-password-source
+	// Retrieve the MD5 authentication password from the external
+	// source, exec:<command>, file:<path> or env:<name>, at the
+	// session establishment instead of auth-password.
+	AuthPasswordSource string `mapstructure:"auth-password-source" json:"auth-password-source,omitempty"`
+	// original -> gobgp:disable-four-octet-as
+	// gobgp:disable-four-octet-as's original type is boolean.
+	// Don't advertise the Four-octet AS Number Capability so that
+	// the AS numbers are sent with AS_TRANS and the AS4_PATH and
+	// AS4_AGGREGATOR attributes as to the 2-octet only speaker,
+	// for the interoperability test (RFC 6793).
+	DisableFourOctetAs bool `mapstructure:"disable-four-octet-as" json:"disable-four-octet-as,omitempty"`
+	// original -> gobgp:accept-own
+	// gobgp:accept-own's original type is boolean.
+	// Accept the VPN routes of the own origin carrying the
+	// ACCEPT_OWN community from the neighbor, the route reflector,
+	// to import them into the VRFs other than the source one
+	// (RFC 7611).
+	AcceptOwn bool `mapstructure:"accept-own" json:"accept-own,omitempty"`
+	// original -> gobgp:local-as-migration
+	// gobgp:local-as-migration's original type is boolean.
+	// Apply the Local AS mechanism (RFC 7705) with local-as
+	// different from the AS of the router: the local-as is
+	// prepended to the AS_PATH of the received routes, and both of
+	// the AS of the router and the local-as are prepended to the
+	// advertised ones.
+	LocalAsMigration bool `mapstructure:"local-as-migration" json:"local-as-migration,omitempty"`
+	// original -> gobgp:local-as-no-prepend
+	// gobgp:local-as-no-prepend's original type is boolean.
+	// Don't prepend the local-as to the AS_PATH of the received
+	// routes with local-as-migration.
+	LocalAsNoPrepend bool `mapstructure:"local-as-no-prepend" json:"local-as-no-prepend,omitempty"`
+	// original -> gobgp:local-as-replace-as
+	// gobgp:local-as-replace-as's original type is boolean.
+	// Prepend only the local-as, not the AS of the router, to the
+	// AS_PATH of the advertised routes with local-as-migration.
+	LocalAsReplaceAs bool `mapstructure:"local-as-replace-as" json:"local-as-replace-as,omitempty"`
+	// original -> gobgp:next-hop-self
+	// gobgp:next-hop-self's original type is boolean.
+	// Set the local address to the NEXT_HOP of the routes learned
+	// from the eBGP neighbors advertised to the iBGP neighbor.
+	NextHopSelf bool `mapstructure:"next-hop-self" json:"next-hop-self,omitempty"`
+	// original -> gobgp:next-hop-self-force
+	// gobgp:next-hop-self-force's original type is boolean.
+	// Set the local address to the NEXT_HOP of all the routes
+	// advertised to the iBGP neighbor, including the ones learned
+	// from the iBGP neighbors and reflected.
+	NextHopSelfForce bool `mapstructure:"next-hop-self-force" json:"next-hop-self-force,omitempty"`
+	// original -> gobgp:next-hop-unchanged
+	// gobgp:next-hop-unchanged's original type is boolean.
+	// Don't change the NEXT_HOP of the routes advertised to the
+	// eBGP neighbor, e.g. the route reflector over eBGP multihop.
+	NextHopUnchanged bool `mapstructure:"next-hop-unchanged" json:"next-hop-unchanged,omitempty"`
+	// original -> gobgp:orr-vantage-point
+	// Name of the optimal route reflection vantage point from which
+	// the best paths advertised to the iBGP neighbor are selected.
+	OrrVantagePoint string `mapstructure:"orr-vantage-point" json:"orr-vantage-point,omitempty"`
 }
 
 func (lhs *PeerGroupConfig) Equal(rhs *PeerGroupConfig) bool {
@@ -1850,6 +3550,51 @@ func (lhs *PeerGroupConfig) Equal(rhs *PeerGroupConfig) bool {
 	if lhs.SendSoftwareVersion != rhs.SendSoftwareVersion {
 		return false
 	}
+	if lhs.FlowspecValidation != rhs.FlowspecValidation {
+		return false
+	}
+	if lhs.SendAigp != rhs.SendAigp {
+		return false
+	}
+	if lhs.ReceiveAigp != rhs.ReceiveAigp {
+		return false
+	}
+	if lhs.PeeringdbMaxPrefix != rhs.PeeringdbMaxPrefix {
+		return false
+	}
+	if lhs.DynamicCapability != rhs.DynamicCapability {
+		return false
+	}
+	if lhs.AuthPasswordSource != rhs.AuthPasswordSource {
+		return false
+	}
+	if lhs.DisableFourOctetAs != rhs.DisableFourOctetAs {
+		return false
+	}
+	if lhs.AcceptOwn != rhs.AcceptOwn {
+		return false
+	}
+	if lhs.LocalAsMigration != rhs.LocalAsMigration {
+		return false
+	}
+	if lhs.LocalAsNoPrepend != rhs.LocalAsNoPrepend {
+		return false
+	}
+	if lhs.LocalAsReplaceAs != rhs.LocalAsReplaceAs {
+		return false
+	}
+	if lhs.NextHopSelf != rhs.NextHopSelf {
+		return false
+	}
+	if lhs.NextHopSelfForce != rhs.NextHopSelfForce {
+		return false
+	}
+	if lhs.NextHopUnchanged != rhs.NextHopUnchanged {
+		return false
+	}
+	if lhs.OrrVantagePoint != rhs.OrrVantagePoint {
+		return false
+	}
 	return true
 }
 
@@ -1916,6 +3661,10 @@ type PeerGroup struct {
 	// original -> gobgp:ttl-security
 	// Configure TTL Security feature.
 	TtlSecurity TtlSecurity `mapstructure:"ttl-security" json:"ttl-security,omitempty"`
+
+	// original -> gobgp:update-rate-limit
+	// Limit the rate of the UPDATE messages sent to the neighbor.
+	UpdateRateLimit UpdateRateLimit `mapstructure:"update-rate-limit" json:"update-rate-limit,omitempty"`
 }
 
 func (lhs *PeerGroup) Equal(rhs *PeerGroup) bool {
@@ -1980,6 +3729,9 @@ func (lhs *PeerGroup) Equal(rhs *PeerGroup) bool {
 	if !lhs.TtlSecurity.Equal(&(rhs.TtlSecurity)) {
 		return false
 	}
+	if !lhs.UpdateRateLimit.Equal(&(rhs.UpdateRateLimit)) {
+		return false
+	}
 	return true
 }
 
@@ -2041,6 +3793,66 @@ func (lhs *TtlSecurity) Equal(rhs *TtlSecurity) bool {
 	return true
 }
 
+// struct for container gobgp:state.
+// State information for the update rate limit.
+type UpdateRateLimitState struct {
+	// original -> gobgp:messages-per-second
+	// The maximum number of the UPDATE messages sent per second,
+	// unlimited if zero.
+	MessagesPerSecond uint32 `mapstructure:"messages-per-second" json:"messages-per-second,omitempty"`
+	// original -> gobgp:prefixes-per-second
+	// The maximum number of the prefixes advertised and withdrawn
+	// per second, unlimited if zero.
+	PrefixesPerSecond uint32 `mapstructure:"prefixes-per-second" json:"prefixes-per-second,omitempty"`
+}
+
+// struct for container gobgp:config.
+// Configuration parameters for the update rate limit.
+type UpdateRateLimitConfig struct {
+	// original -> gobgp:messages-per-second
+	// The maximum number of the UPDATE messages sent per second,
+	// unlimited if zero.
+	MessagesPerSecond uint32 `mapstructure:"messages-per-second" json:"messages-per-second,omitempty"`
+	// original -> gobgp:prefixes-per-second
+	// The maximum number of the prefixes advertised and withdrawn
+	// per second, unlimited if zero.
+	PrefixesPerSecond uint32 `mapstructure:"prefixes-per-second" json:"prefixes-per-second,omitempty"`
+}
+
+func (lhs *UpdateRateLimitConfig) Equal(rhs *UpdateRateLimitConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.MessagesPerSecond != rhs.MessagesPerSecond {
+		return false
+	}
+	if lhs.PrefixesPerSecond != rhs.PrefixesPerSecond {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:update-rate-limit.
+// Limit the rate of the UPDATE messages sent to the neighbor.
+type UpdateRateLimit struct {
+	// original -> gobgp:update-rate-limit-config
+	// Configuration parameters for the update rate limit.
+	Config UpdateRateLimitConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:update-rate-limit-state
+	// State information for the update rate limit.
+	State UpdateRateLimitState `mapstructure:"state" json:"state,omitempty"`
+}
+
+func (lhs *UpdateRateLimit) Equal(rhs *UpdateRateLimit) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
 // struct for container gobgp:state.
 // State information relating to route server
 // client(s) used for the BGP neighbor.
@@ -2152,6 +3964,10 @@ type AddPathsState struct {
 	// The maximum number of paths to advertise to neighbors
 	// for a single NLRI.
 	SendMax uint8 `mapstructure:"send-max" json:"send-max,omitempty"`
+	// original -> gobgp:send-mode
+	// Selection mode of the paths to advertise to neighbors
+	// for a single NLRI.
+	SendMode AddPathsSendModeType `mapstructure:"send-mode" json:"send-mode,omitempty"`
 }
 
 // struct for container bgp:config.
@@ -2166,6 +3982,10 @@ type AddPathsConfig struct {
 	// The maximum number of paths to advertise to neighbors
 	// for a single NLRI.
 	SendMax uint8 `mapstructure:"send-max" json:"send-max,omitempty"`
+	// original -> gobgp:send-mode
+	// Selection mode of the paths to advertise to neighbors
+	// for a single NLRI.
+	SendMode AddPathsSendModeType `mapstructure:"send-mode" json:"send-mode,omitempty"`
 }
 
 func (lhs *AddPathsConfig) Equal(rhs *AddPathsConfig) bool {
@@ -2178,6 +3998,9 @@ func (lhs *AddPathsConfig) Equal(rhs *AddPathsConfig) bool {
 	if lhs.SendMax != rhs.SendMax {
 		return false
 	}
+	if lhs.SendMode != rhs.SendMode {
+		return false
+	}
 	return true
 }
 
@@ -2566,6 +4389,11 @@ type TransportState struct {
 	// Remote port being used by the peer for the TCP session
 	// supporting the BGP session.
 	RemotePort uint16 `mapstructure:"remote-port" json:"remote-port,omitempty"`
+	// original -> gobgp:active-mode
+	// gobgp:active-mode's original type is boolean.
+	// Only initiate the BGP session to the neighbor and reject
+	// the connections from the neighbor.
+	ActiveMode bool `mapstructure:"active-mode" json:"active-mode,omitempty"`
 }
 
 // struct for container bgp:config.
@@ -2599,12 +4427,29 @@ type TransportConfig struct {
 	// original -> gobgp:remote-port
 	// gobgp:remote-port's original type is inet:port-number.
 	RemotePort uint16 `mapstructure:"remote-port" json:"remote-port,omitempty"`
+	// original -> gobgp:active-mode
+	// gobgp:active-mode's original type is boolean.
+	// Only initiate the BGP session to the neighbor and reject
+	// the connections from the neighbor.
+	ActiveMode bool `mapstructure:"active-mode" json:"active-mode,omitempty"`
 	// original -> gobgp:ttl
 	// TTL value for BGP packets.
 	Ttl uint8 `mapstructure:"ttl" json:"ttl,omitempty"`
 	// original -> gobgp:bind-interface
 	// Interface name for binding.
 	BindInterface string `mapstructure:"bind-interface" json:"bind-interface,omitempty"`
+	// original -> gobgp:transport-protocol
+	// Transport protocol for the BGP session.
+	TransportProtocol TransportProtocolType `mapstructure:"transport-protocol" json:"transport-protocol,omitempty"`
+	// original -> gobgp:quic-ca-file
+	// CA certificates to verify the QUIC certificate of the
+	// neighbor. The certificate isn't verified if empty.
+	QuicCaFile string `mapstructure:"quic-ca-file" json:"quic-ca-file,omitempty"`
+	// original -> gobgp:vrf
+	// Linux VRF device the session socket is bound to. The
+	// routes learned from the neighbor are installed into the
+	// routing table of the VRF.
+	Vrf string `mapstructure:"vrf" json:"vrf,omitempty"`
 }
 
 func (lhs *TransportConfig) Equal(rhs *TransportConfig) bool {
@@ -2629,12 +4474,24 @@ func (lhs *TransportConfig) Equal(rhs *TransportConfig) bool {
 	if lhs.RemotePort != rhs.RemotePort {
 		return false
 	}
+	if lhs.ActiveMode != rhs.ActiveMode {
+		return false
+	}
 	if lhs.Ttl != rhs.Ttl {
 		return false
 	}
 	if lhs.BindInterface != rhs.BindInterface {
 		return false
 	}
+	if lhs.TransportProtocol != rhs.TransportProtocol {
+		return false
+	}
+	if lhs.QuicCaFile != rhs.QuicCaFile {
+		return false
+	}
+	if lhs.Vrf != rhs.Vrf {
+		return false
+	}
 	return true
 }
 
@@ -2707,6 +4564,29 @@ type TimersState struct {
 	// Time interval in seconds that a BGP session will be
 	// in idle state after neighbor reset operation.
 	IdleHoldTimeAfterReset float64 `mapstructure:"idle-hold-time-after-reset" json:"idle-hold-time-after-reset,omitempty"`
+	// original -> gobgp:idle-hold-time-min
+	// gobgp:idle-hold-time-min's original type is decimal64.
+	// Time interval in seconds that a BGP session will be
+	// in idle state after the first session failure when
+	// the idle hold backoff is enabled.
+	IdleHoldTimeMin float64 `mapstructure:"idle-hold-time-min" json:"idle-hold-time-min,omitempty"`
+	// original -> gobgp:idle-hold-time-max
+	// gobgp:idle-hold-time-max's original type is decimal64.
+	// Upper bound in seconds of the idle hold time which is
+	// doubled on every consecutive session failure. Zero
+	// disables the idle hold backoff.
+	IdleHoldTimeMax float64 `mapstructure:"idle-hold-time-max" json:"idle-hold-time-max,omitempty"`
+	// original -> gobgp:idle-hold-time-jitter
+	// gobgp:idle-hold-time-jitter's original type is decimal64.
+	// Fraction by which the idle hold time is randomly
+	// reduced, from 0 to 1.
+	IdleHoldTimeJitter float64 `mapstructure:"idle-hold-time-jitter" json:"idle-hold-time-jitter,omitempty"`
+	// original -> gobgp:connect-retry-jitter
+	// gobgp:connect-retry-jitter's original type is decimal64.
+	// Fraction by which the connect retry time is randomly
+	// reduced, from 0 to 1. 0.25 if not set, as suggested by
+	// RFC 4271.
+	ConnectRetryJitter float64 `mapstructure:"connect-retry-jitter" json:"connect-retry-jitter,omitempty"`
 	// original -> gobgp:downtime
 	// gobgp:downtime's original type is yang:timeticks.
 	// This timer determines the amount of time since the
@@ -2716,6 +4596,21 @@ type TimersState struct {
 	// The number of seconds elapsed since January 1, 1970 UTC
 	// last time the BGP session received an UPDATE message.
 	UpdateRecvTime int64 `mapstructure:"update-recv-time" json:"update-recv-time,omitempty"`
+	// original -> gobgp:consecutive-failures
+	// The number of consecutive session failures counted by
+	// the idle hold backoff.
+	ConsecutiveFailures uint32 `mapstructure:"consecutive-failures" json:"consecutive-failures,omitempty"`
+	// original -> gobgp:idle-hold-time
+	// gobgp:idle-hold-time's original type is decimal64.
+	// The idle hold time in seconds computed by the idle hold
+	// backoff for the last session failure.
+	IdleHoldTime float64 `mapstructure:"idle-hold-time" json:"idle-hold-time,omitempty"`
+	// original -> gobgp:peer-down-convergence-time
+	// gobgp:peer-down-convergence-time's original type is decimal64.
+	// Time in seconds taken to withdraw the routes received
+	// from the neighbor when the BGP session went down last
+	// time.
+	PeerDownConvergenceTime float64 `mapstructure:"peer-down-convergence-time" json:"peer-down-convergence-time,omitempty"`
 }
 
 // struct for container bgp:config.
@@ -2754,6 +4649,29 @@ type TimersConfig struct {
 	// Time interval in seconds that a BGP session will be
 	// in idle state after neighbor reset operation.
 	IdleHoldTimeAfterReset float64 `mapstructure:"idle-hold-time-after-reset" json:"idle-hold-time-after-reset,omitempty"`
+	// original -> gobgp:idle-hold-time-min
+	// gobgp:idle-hold-time-min's original type is decimal64.
+	// Time interval in seconds that a BGP session will be
+	// in idle state after the first session failure when
+	// the idle hold backoff is enabled.
+	IdleHoldTimeMin float64 `mapstructure:"idle-hold-time-min" json:"idle-hold-time-min,omitempty"`
+	// original -> gobgp:idle-hold-time-max
+	// gobgp:idle-hold-time-max's original type is decimal64.
+	// Upper bound in seconds of the idle hold time which is
+	// doubled on every consecutive session failure. Zero
+	// disables the idle hold backoff.
+	IdleHoldTimeMax float64 `mapstructure:"idle-hold-time-max" json:"idle-hold-time-max,omitempty"`
+	// original -> gobgp:idle-hold-time-jitter
+	// gobgp:idle-hold-time-jitter's original type is decimal64.
+	// Fraction by which the idle hold time is randomly
+	// reduced, from 0 to 1.
+	IdleHoldTimeJitter float64 `mapstructure:"idle-hold-time-jitter" json:"idle-hold-time-jitter,omitempty"`
+	// original -> gobgp:connect-retry-jitter
+	// gobgp:connect-retry-jitter's original type is decimal64.
+	// Fraction by which the connect retry time is randomly
+	// reduced, from 0 to 1. 0.25 if not set, as suggested by
+	// RFC 4271.
+	ConnectRetryJitter float64 `mapstructure:"connect-retry-jitter" json:"connect-retry-jitter,omitempty"`
 }
 
 func (lhs *TimersConfig) Equal(rhs *TimersConfig) bool {
@@ -2775,6 +4693,18 @@ func (lhs *TimersConfig) Equal(rhs *TimersConfig) bool {
 	if lhs.IdleHoldTimeAfterReset != rhs.IdleHoldTimeAfterReset {
 		return false
 	}
+	if lhs.IdleHoldTimeMin != rhs.IdleHoldTimeMin {
+		return false
+	}
+	if lhs.IdleHoldTimeMax != rhs.IdleHoldTimeMax {
+		return false
+	}
+	if lhs.IdleHoldTimeJitter != rhs.IdleHoldTimeJitter {
+		return false
+	}
+	if lhs.ConnectRetryJitter != rhs.ConnectRetryJitter {
+		return false
+	}
 	return true
 }
 
@@ -3125,6 +5055,27 @@ type NeighborState struct {
 	// original -> gobgp:flops
 	// The number of flip-flops.
 	Flops uint32 `mapstructure:"flops" json:"flops,omitempty"`
+	// original -> gobgp:graceful-shutdown
+	// gobgp:graceful-shutdown's original type is boolean.
+	// True while the neighbor is in RFC 8326 graceful shutdown.
+	GracefulShutdown bool `mapstructure:"graceful-shutdown" json:"graceful-shutdown,omitempty"`
+	// original -> gobgp:slow-peer
+	// gobgp:slow-peer's original type is boolean.
+	// True while the outgoing paths to the neighbor are buffered
+	// because it doesn't consume them fast enough.
+	SlowPeer bool `mapstructure:"slow-peer" json:"slow-peer,omitempty"`
+	// original -> gobgp:slow-peer-count
+	// The number of the times the neighbor became slow.
+	SlowPeerCount uint32 `mapstructure:"slow-peer-count" json:"slow-peer-count,omitempty"`
+	// original -> gobgp:slow-peer-dropped
+	// The number of the buffered paths replaced by the later ones
+	// for the same NLRI while the neighbor is slow.
+	SlowPeerDropped uint64 `mapstructure:"slow-peer-dropped" json:"slow-peer-dropped,omitempty"`
+	// original -> gobgp:four-octet-as
+	// gobgp:four-octet-as's original type is boolean.
+	// True if the AS numbers are four-octet with the neighbor, that
+	// is, both advertised the Four-octet AS Number Capability.
+	FourOctetAs bool `mapstructure:"four-octet-as" json:"four-octet-as,omitempty"`
 	// original -> gobgp:neighbor-interface
 	NeighborInterface string `mapstructure:"neighbor-interface" json:"neighbor-interface,omitempty"`
 	// original -> gobgp:vrf
@@ -3178,6 +5129,86 @@ type NeighborConfig struct {
 	// original -> gobgp:send-software-version
 	// gobgp:send-software-version's original type is boolean.
 	SendSoftwareVersion bool `mapstructure:"send-software-version" json:"send-software-version,omitempty"`
+	// original -> gobgp:flowspec-validation
+	// gobgp:flowspec-validation's original type is boolean.
+	FlowspecValidation bool `mapstructure:"flowspec-validation" json:"flowspec-validation,omitempty"`
+	// original -> gobgp:send-aigp
+	// gobgp:send-aigp's original type is boolean.
+	// Send the AIGP attribute (RFC 7311) to the neighbor. The
+	// attribute is removed from the advertised routes if false.
+	SendAigp bool `mapstructure:"send-aigp" json:"send-aigp,omitempty"`
+	// original -> gobgp:receive-aigp
+	// gobgp:receive-aigp's original type is boolean.
+	// Accept the AIGP attribute (RFC 7311) from the neighbor. The
+	// attribute is ignored and not propagated if false.
+	ReceiveAigp bool `mapstructure:"receive-aigp" json:"receive-aigp,omitempty"`
+	// original -> gobgp:peeringdb-max-prefix
+	// gobgp:peeringdb-max-prefix's original type is boolean.
+	// Set the max prefixes of IPv4 and IPv6 unicast from the
+	// PeeringDB record of the neighbor.
+	PeeringdbMaxPrefix bool `mapstructure:"peeringdb-max-prefix" json:"peeringdb-max-prefix,omitempty"`
+	// original -> gobgp:dynamic-capability
+	// gobgp:dynamic-capability's original type is boolean.
+	// Advertise the Dynamic Capability so that the address families
+	// are added or removed without resetting the session.
+	DynamicCapability bool `mapstructure:"dynamic-capability" json:"dynamic-capability,omitempty"`
+	// original -> gobgp:auth-password-source
+	// Retrieve the MD5 authentication password from the external
+	// source, exec:<command>, file:<path> or env:<name>, at the
+	// session establishment instead of auth-password.
+	AuthPasswordSource string `mapstructure:"auth-password-source" json:"auth-password-source,omitempty"`
+	// original -> gobgp:disable-four-octet-as
+	// gobgp:disable-four-octet-as's original type is boolean.
+	// Don't advertise the Four-octet AS Number Capability so that
+	// the AS numbers are sent with AS_TRANS and the AS4_PATH and
+	// AS4_AGGREGATOR attributes as to the 2-octet only speaker,
+	// for the interoperability test (RFC 6793).
+	DisableFourOctetAs bool `mapstructure:"disable-four-octet-as" json:"disable-four-octet-as,omitempty"`
+	// original -> gobgp:accept-own
+	// gobgp:accept-own's original type is boolean.
+	// Accept the VPN routes of the own origin carrying the
+	// ACCEPT_OWN community from the neighbor, the route reflector,
+	// to import them into the VRFs other than the source one
+	// (RFC 7611).
+	AcceptOwn bool `mapstructure:"accept-own" json:"accept-own,omitempty"`
+	// original -> gobgp:local-as-migration
+	// gobgp:local-as-migration's original type is boolean.
+	// Apply the Local AS mechanism (RFC 7705) with local-as
+	// different from the AS of the router: the local-as is
+	// prepended to the AS_PATH of the received routes, and both of
+	// the AS of the router and the local-as are prepended to the
+	// advertised ones.
+	LocalAsMigration bool `mapstructure:"local-as-migration" json:"local-as-migration,omitempty"`
+	// original -> gobgp:local-as-no-prepend
+	// gobgp:local-as-no-prepend's original type is boolean.
+	// Don't prepend the local-as to the AS_PATH of the received
+	// routes with local-as-migration.
+	LocalAsNoPrepend bool `mapstructure:"local-as-no-prepend" json:"local-as-no-prepend,omitempty"`
+	// original -> gobgp:local-as-replace-as
+	// gobgp:local-as-replace-as's original type is boolean.
+	// Prepend only the local-as, not the AS of the router, to the
+	// AS_PATH of the advertised routes with local-as-migration.
+	LocalAsReplaceAs bool `mapstructure:"local-as-replace-as" json:"local-as-replace-as,omitempty"`
+	// original -> gobgp:next-hop-self
+	// gobgp:next-hop-self's original type is boolean.
+	// Set the local address to the NEXT_HOP of the routes learned
+	// from the eBGP neighbors advertised to the iBGP neighbor.
+	NextHopSelf bool `mapstructure:"next-hop-self" json:"next-hop-self,omitempty"`
+	// original -> gobgp:next-hop-self-force
+	// gobgp:next-hop-self-force's original type is boolean.
+	// Set the local address to the NEXT_HOP of all the routes
+	// advertised to the iBGP neighbor, including the ones learned
+	// from the iBGP neighbors and reflected.
+	NextHopSelfForce bool `mapstructure:"next-hop-self-force" json:"next-hop-self-force,omitempty"`
+	// original -> gobgp:next-hop-unchanged
+	// gobgp:next-hop-unchanged's original type is boolean.
+	// Don't change the NEXT_HOP of the routes advertised to the
+	// eBGP neighbor, e.g. the route reflector over eBGP multihop.
+	NextHopUnchanged bool `mapstructure:"next-hop-unchanged" json:"next-hop-unchanged,omitempty"`
+	// original -> gobgp:orr-vantage-point
+	// Name of the optimal route reflection vantage point from which
+	// the best paths advertised to the iBGP neighbor are selected.
+	OrrVantagePoint string `mapstructure:"orr-vantage-point" json:"orr-vantage-point,omitempty"`
 	// original -> bgp:neighbor-address
 	// bgp:neighbor-address's original type is inet:ip-address.
 	// Address of the BGP peer, either in IPv4 or IPv6.
@@ -3238,6 +5269,51 @@ func (lhs *NeighborConfig) Equal(rhs *NeighborConfig) bool {
 	if lhs.SendSoftwareVersion != rhs.SendSoftwareVersion {
 		return false
 	}
+	if lhs.FlowspecValidation != rhs.FlowspecValidation {
+		return false
+	}
+	if lhs.SendAigp != rhs.SendAigp {
+		return false
+	}
+	if lhs.ReceiveAigp != rhs.ReceiveAigp {
+		return false
+	}
+	if lhs.PeeringdbMaxPrefix != rhs.PeeringdbMaxPrefix {
+		return false
+	}
+	if lhs.DynamicCapability != rhs.DynamicCapability {
+		return false
+	}
+	if lhs.AuthPasswordSource != rhs.AuthPasswordSource {
+		return false
+	}
+	if lhs.DisableFourOctetAs != rhs.DisableFourOctetAs {
+		return false
+	}
+	if lhs.AcceptOwn != rhs.AcceptOwn {
+		return false
+	}
+	if lhs.LocalAsMigration != rhs.LocalAsMigration {
+		return false
+	}
+	if lhs.LocalAsNoPrepend != rhs.LocalAsNoPrepend {
+		return false
+	}
+	if lhs.LocalAsReplaceAs != rhs.LocalAsReplaceAs {
+		return false
+	}
+	if lhs.NextHopSelf != rhs.NextHopSelf {
+		return false
+	}
+	if lhs.NextHopSelfForce != rhs.NextHopSelfForce {
+		return false
+	}
+	if lhs.NextHopUnchanged != rhs.NextHopUnchanged {
+		return false
+	}
+	if lhs.OrrVantagePoint != rhs.OrrVantagePoint {
+		return false
+	}
 	return true
 }
 
@@ -3304,6 +5380,10 @@ type Neighbor struct {
 	// original -> gobgp:ttl-security
 	// Configure TTL Security feature.
 	TtlSecurity TtlSecurity `mapstructure:"ttl-security" json:"ttl-security,omitempty"`
+
+	// original -> gobgp:update-rate-limit
+	// Limit the rate of the UPDATE messages sent to the neighbor.
+	UpdateRateLimit UpdateRateLimit `mapstructure:"update-rate-limit" json:"update-rate-limit,omitempty"`
 }
 
 func (lhs *Neighbor) Equal(rhs *Neighbor) bool {
@@ -3368,6 +5448,9 @@ func (lhs *Neighbor) Equal(rhs *Neighbor) bool {
 	if !lhs.TtlSecurity.Equal(&(rhs.TtlSecurity)) {
 		return false
 	}
+	if !lhs.UpdateRateLimit.Equal(&(rhs.UpdateRateLimit)) {
+		return false
+	}
 	return true
 }
 
@@ -3933,6 +6016,79 @@ func (lhs *ApplyPolicyConfig) Equal(rhs *ApplyPolicyConfig) bool {
 	return true
 }
 
+// struct for container gobgp:state.
+// State information relating to conditional advertisement.
+type ConditionalAdvertisementState struct {
+	// original -> gobgp:advertise-prefix-set
+	// Name of the prefix set of the paths advertised conditionally.
+	AdvertisePrefixSet string `mapstructure:"advertise-prefix-set" json:"advertise-prefix-set,omitempty"`
+	// original -> gobgp:condition-prefix-set
+	// Name of the prefix set looked up in the Loc-RIB.
+	ConditionPrefixSet string `mapstructure:"condition-prefix-set" json:"condition-prefix-set,omitempty"`
+	// original -> gobgp:condition
+	// Advertise the paths while the condition prefixes exist or don't
+	// exist in the Loc-RIB.
+	Condition ConditionalAdvertisementConditionType `mapstructure:"condition" json:"condition,omitempty"`
+	// original -> gobgp:advertised
+	// gobgp:advertised's original type is boolean.
+	// The condition is met and the paths are advertised.
+	Advertised bool `mapstructure:"advertised" json:"advertised,omitempty"`
+}
+
+// struct for container gobgp:config.
+// Configuration parameters relating to conditional advertisement.
+type ConditionalAdvertisementConfig struct {
+	// original -> gobgp:advertise-prefix-set
+	// Name of the prefix set of the paths advertised conditionally.
+	AdvertisePrefixSet string `mapstructure:"advertise-prefix-set" json:"advertise-prefix-set,omitempty"`
+	// original -> gobgp:condition-prefix-set
+	// Name of the prefix set looked up in the Loc-RIB.
+	ConditionPrefixSet string `mapstructure:"condition-prefix-set" json:"condition-prefix-set,omitempty"`
+	// original -> gobgp:condition
+	// Advertise the paths while the condition prefixes exist or don't
+	// exist in the Loc-RIB.
+	Condition ConditionalAdvertisementConditionType `mapstructure:"condition" json:"condition,omitempty"`
+}
+
+func (lhs *ConditionalAdvertisementConfig) Equal(rhs *ConditionalAdvertisementConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.AdvertisePrefixSet != rhs.AdvertisePrefixSet {
+		return false
+	}
+	if lhs.ConditionPrefixSet != rhs.ConditionPrefixSet {
+		return false
+	}
+	if lhs.Condition != rhs.Condition {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:conditional-advertisement.
+// List of the paths advertised to the neighbor only while the
+// condition is met.
+type ConditionalAdvertisement struct {
+	// original -> gobgp:advertise-prefix-set
+	// original -> gobgp:conditional-advertisement-config
+	// Configuration parameters relating to conditional advertisement.
+	Config ConditionalAdvertisementConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:conditional-advertisement-state
+	// State information relating to conditional advertisement.
+	State ConditionalAdvertisementState `mapstructure:"state" json:"state,omitempty"`
+}
+
+func (lhs *ConditionalAdvertisement) Equal(rhs *ConditionalAdvertisement) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
 // struct for container rpol:apply-policy.
 // Anchor point for routing policies in the model.
 // Import and export policies are with respect to the local
@@ -3945,9 +6101,265 @@ type ApplyPolicy struct {
 	// original -> rpol:apply-policy-state
 	// Operational state for routing policy.
 	State ApplyPolicyState `mapstructure:"state" json:"state,omitempty"`
+	// original -> gobgp:conditional-advertisements
+	// Paths advertised to the neighbor only while the condition is
+	// met.
+	ConditionalAdvertisements []ConditionalAdvertisement `mapstructure:"conditional-advertisements" json:"conditional-advertisements,omitempty"`
+}
+
+func (lhs *ApplyPolicy) Equal(rhs *ApplyPolicy) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	if len(lhs.ConditionalAdvertisements) != len(rhs.ConditionalAdvertisements) {
+		return false
+	}
+	{
+		lmap := make(map[string]*ConditionalAdvertisement)
+		for i, l := range lhs.ConditionalAdvertisements {
+			lmap[mapkey(i, string(l.Config.AdvertisePrefixSet))] = &lhs.ConditionalAdvertisements[i]
+		}
+		for i, r := range rhs.ConditionalAdvertisements {
+			if l, y := lmap[mapkey(i, string(r.Config.AdvertisePrefixSet))]; !y {
+				return false
+			} else if !r.Equal(l) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// struct for container bgp-mp:state.
+// State information relating to the AFI-SAFI.
+type AfiSafiState struct {
+	// original -> bgp-mp:afi-safi-name
+	// AFI,SAFI.
+	AfiSafiName AfiSafiType `mapstructure:"afi-safi-name" json:"afi-safi-name,omitempty"`
+	// original -> bgp-mp:enabled
+	// bgp-mp:enabled's original type is boolean.
+	// This leaf indicates whether the IPv4 Unicast AFI,SAFI is
+	// enabled for the neighbour or group.
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty"`
+	// original -> bgp-op:total-paths
+	// Total number of BGP paths within the context.
+	TotalPaths uint32 `mapstructure:"total-paths" json:"total-paths,omitempty"`
+	// original -> bgp-op:total-prefixes
+	// .
+	TotalPrefixes uint32 `mapstructure:"total-prefixes" json:"total-prefixes,omitempty"`
+	// original -> gobgp:family
+	// gobgp:family's original type is route-family.
+	// Address family value of AFI-SAFI pair translated from afi-safi-name.
+	Family bgp.RouteFamily `mapstructure:"family" json:"family,omitempty"`
+}
+
+// struct for container bgp-mp:config.
+// Configuration parameters for the AFI-SAFI.
+type AfiSafiConfig struct {
+	// original -> bgp-mp:afi-safi-name
+	// AFI,SAFI.
+	AfiSafiName AfiSafiType `mapstructure:"afi-safi-name" json:"afi-safi-name,omitempty"`
+	// original -> bgp-mp:enabled
+	// bgp-mp:enabled's original type is boolean.
+	// This leaf indicates whether the IPv4 Unicast AFI,SAFI is
+	// enabled for the neighbour or group.
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty"`
+}
+
+func (lhs *AfiSafiConfig) Equal(rhs *AfiSafiConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.AfiSafiName != rhs.AfiSafiName {
+		return false
+	}
+	if lhs.Enabled != rhs.Enabled {
+		return false
+	}
+	return true
+}
+
+// struct for container bgp-mp:state.
+// State information for BGP graceful-restart.
+type MpGracefulRestartState struct {
+	// original -> bgp-mp:enabled
+	// bgp-mp:enabled's original type is boolean.
+	// This leaf indicates whether graceful-restart is enabled for
+	// this AFI-SAFI.
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty"`
+	// original -> bgp-op:received
+	// bgp-op:received's original type is boolean.
+	// This leaf indicates whether the neighbor advertised the
+	// ability to support graceful-restart for this AFI-SAFI.
+	Received bool `mapstructure:"received" json:"received,omitempty"`
+	// original -> bgp-op:advertised
+	// bgp-op:advertised's original type is boolean.
+	// This leaf indicates whether the ability to support
+	// graceful-restart has been advertised to the peer.
+	Advertised bool `mapstructure:"advertised" json:"advertised,omitempty"`
+	// original -> gobgp:end-of-rib-received
+	// gobgp:end-of-rib-received's original type is boolean.
+	EndOfRibReceived bool `mapstructure:"end-of-rib-received" json:"end-of-rib-received,omitempty"`
+	// original -> gobgp:end-of-rib-sent
+	// gobgp:end-of-rib-sent's original type is boolean.
+	EndOfRibSent bool `mapstructure:"end-of-rib-sent" json:"end-of-rib-sent,omitempty"`
+}
+
+// struct for container bgp-mp:config.
+// Configuration options for BGP graceful-restart.
+type MpGracefulRestartConfig struct {
+	// original -> bgp-mp:enabled
+	// bgp-mp:enabled's original type is boolean.
+	// This leaf indicates whether graceful-restart is enabled for
+	// this AFI-SAFI.
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty"`
+}
+
+func (lhs *MpGracefulRestartConfig) Equal(rhs *MpGracefulRestartConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Enabled != rhs.Enabled {
+		return false
+	}
+	return true
+}
+
+// struct for container bgp-mp:graceful-restart.
+// Parameters relating to BGP graceful-restart.
+type MpGracefulRestart struct {
+	// original -> bgp-mp:mp-graceful-restart-config
+	// Configuration options for BGP graceful-restart.
+	Config MpGracefulRestartConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> bgp-mp:mp-graceful-restart-state
+	// State information for BGP graceful-restart.
+	State MpGracefulRestartState `mapstructure:"state" json:"state,omitempty"`
+}
+
+func (lhs *MpGracefulRestart) Equal(rhs *MpGracefulRestart) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:state.
+type PrefixOrfState struct {
+	// original -> gobgp:mode
+	// Whether the address prefix ORF entries are sent to and/or
+	// received from the neighbor.
+	Mode PrefixOrfModeType `mapstructure:"mode" json:"mode,omitempty"`
+	// original -> gobgp:prefix-set
+	// Name of the prefix-set sent to the neighbor as the address
+	// prefix ORF entries.
+	PrefixSet string `mapstructure:"prefix-set" json:"prefix-set,omitempty"`
+	// original -> gobgp:sent-entries
+	// Number of the address prefix ORF entries sent to the neighbor.
+	SentEntries uint32 `mapstructure:"sent-entries" json:"sent-entries,omitempty"`
+	// original -> gobgp:received-entries
+	// Number of the address prefix ORF entries received from the
+	// neighbor.
+	ReceivedEntries uint32 `mapstructure:"received-entries" json:"received-entries,omitempty"`
+}
+
+// struct for container gobgp:config.
+type PrefixOrfConfig struct {
+	// original -> gobgp:mode
+	// Whether the address prefix ORF entries are sent to and/or
+	// received from the neighbor.
+	Mode PrefixOrfModeType `mapstructure:"mode" json:"mode,omitempty"`
+	// original -> gobgp:prefix-set
+	// Name of the prefix-set sent to the neighbor as the address
+	// prefix ORF entries.
+	PrefixSet string `mapstructure:"prefix-set" json:"prefix-set,omitempty"`
+}
+
+func (lhs *PrefixOrfConfig) Equal(rhs *PrefixOrfConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Mode != rhs.Mode {
+		return false
+	}
+	if lhs.PrefixSet != rhs.PrefixSet {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:prefix-orf.
+// Address prefix based Outbound Route Filtering (RFC 5291, 5292)
+// configuration options related to a particular AFI-SAFI.
+type PrefixOrf struct {
+	// original -> gobgp:prefix-orf-config
+	Config PrefixOrfConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:prefix-orf-state
+	State PrefixOrfState `mapstructure:"state" json:"state,omitempty"`
+}
+
+func (lhs *PrefixOrf) Equal(rhs *PrefixOrf) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:state.
+type MinimumAdvertisementIntervalState struct {
+	// original -> gobgp:interval
+	// gobgp:interval's original type is decimal64.
+	// Minimum time in seconds which must elapse between the UPDATE
+	// messages advertising the paths of this AFI-SAFI to the
+	// neighbor, the minimum-advertisement-interval timer of the
+	// neighbor if zero.
+	Interval float64 `mapstructure:"interval" json:"interval,omitempty"`
+	// original -> gobgp:coalesced
+	// Number of the paths replaced by the later ones for the same
+	// NLRI before they were advertised.
+	Coalesced uint64 `mapstructure:"coalesced" json:"coalesced,omitempty"`
+}
+
+// struct for container gobgp:config.
+type MinimumAdvertisementIntervalConfig struct {
+	// original -> gobgp:interval
+	// gobgp:interval's original type is decimal64.
+	// Minimum time in seconds which must elapse between the UPDATE
+	// messages advertising the paths of this AFI-SAFI to the
+	// neighbor, the minimum-advertisement-interval timer of the
+	// neighbor if zero.
+	Interval float64 `mapstructure:"interval" json:"interval,omitempty"`
+}
+
+func (lhs *MinimumAdvertisementIntervalConfig) Equal(rhs *MinimumAdvertisementIntervalConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Interval != rhs.Interval {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:minimum-advertisement-interval.
+// MinRouteAdvertisementInterval (RFC 4271) configuration options
+// related to a particular AFI-SAFI.
+type MinimumAdvertisementInterval struct {
+	// original -> gobgp:minimum-advertisement-interval-config
+	Config MinimumAdvertisementIntervalConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:minimum-advertisement-interval-state
+	State MinimumAdvertisementIntervalState `mapstructure:"state" json:"state,omitempty"`
 }
 
-func (lhs *ApplyPolicy) Equal(rhs *ApplyPolicy) bool {
+func (lhs *MinimumAdvertisementInterval) Equal(rhs *MinimumAdvertisementInterval) bool {
 	if lhs == nil || rhs == nil {
 		return false
 	}
@@ -3957,113 +6369,138 @@ func (lhs *ApplyPolicy) Equal(rhs *ApplyPolicy) bool {
 	return true
 }
 
-// struct for container bgp-mp:state.
-// State information relating to the AFI-SAFI.
-type AfiSafiState struct {
-	// original -> bgp-mp:afi-safi-name
-	// AFI,SAFI.
-	AfiSafiName AfiSafiType `mapstructure:"afi-safi-name" json:"afi-safi-name,omitempty"`
-	// original -> bgp-mp:enabled
-	// bgp-mp:enabled's original type is boolean.
-	// This leaf indicates whether the IPv4 Unicast AFI,SAFI is
-	// enabled for the neighbour or group.
-	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty"`
-	// original -> bgp-op:total-paths
-	// Total number of BGP paths within the context.
-	TotalPaths uint32 `mapstructure:"total-paths" json:"total-paths,omitempty"`
-	// original -> bgp-op:total-prefixes
-	// .
-	TotalPrefixes uint32 `mapstructure:"total-prefixes" json:"total-prefixes,omitempty"`
-	// original -> gobgp:family
-	// gobgp:family's original type is route-family.
-	// Address family value of AFI-SAFI pair translated from afi-safi-name.
-	Family bgp.RouteFamily `mapstructure:"family" json:"family,omitempty"`
+// struct for container gobgp:state.
+type LoopPreventionState struct {
+	// original -> gobgp:allow-own-as
+	// Accept the routes of this AFI-SAFI with the own AS in the
+	// AS_PATH up to the number of times (allowas-in), the
+	// allow-own-as of the as-path-options of the neighbor if zero.
+	AllowOwnAs uint8 `mapstructure:"allow-own-as" json:"allow-own-as,omitempty"`
+	// original -> gobgp:replace-peer-as
+	// gobgp:replace-peer-as's original type is boolean.
+	// Replace the AS of the neighbor with the local AS in the
+	// AS_PATH of the routes of this AFI-SAFI advertised to the
+	// neighbor (as-override), or with the replace-peer-as of the
+	// as-path-options of the neighbor.
+	ReplacePeerAs bool `mapstructure:"replace-peer-as" json:"replace-peer-as,omitempty"`
 }
 
-// struct for container bgp-mp:config.
-// Configuration parameters for the AFI-SAFI.
-type AfiSafiConfig struct {
-	// original -> bgp-mp:afi-safi-name
-	// AFI,SAFI.
-	AfiSafiName AfiSafiType `mapstructure:"afi-safi-name" json:"afi-safi-name,omitempty"`
-	// original -> bgp-mp:enabled
-	// bgp-mp:enabled's original type is boolean.
-	// This leaf indicates whether the IPv4 Unicast AFI,SAFI is
-	// enabled for the neighbour or group.
-	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty"`
+// struct for container gobgp:config.
+type LoopPreventionConfig struct {
+	// original -> gobgp:allow-own-as
+	// Accept the routes of this AFI-SAFI with the own AS in the
+	// AS_PATH up to the number of times (allowas-in), the
+	// allow-own-as of the as-path-options of the neighbor if zero.
+	AllowOwnAs uint8 `mapstructure:"allow-own-as" json:"allow-own-as,omitempty"`
+	// original -> gobgp:replace-peer-as
+	// gobgp:replace-peer-as's original type is boolean.
+	// Replace the AS of the neighbor with the local AS in the
+	// AS_PATH of the routes of this AFI-SAFI advertised to the
+	// neighbor (as-override), or with the replace-peer-as of the
+	// as-path-options of the neighbor.
+	ReplacePeerAs bool `mapstructure:"replace-peer-as" json:"replace-peer-as,omitempty"`
 }
 
-func (lhs *AfiSafiConfig) Equal(rhs *AfiSafiConfig) bool {
+func (lhs *LoopPreventionConfig) Equal(rhs *LoopPreventionConfig) bool {
 	if lhs == nil || rhs == nil {
 		return false
 	}
-	if lhs.AfiSafiName != rhs.AfiSafiName {
+	if lhs.AllowOwnAs != rhs.AllowOwnAs {
 		return false
 	}
-	if lhs.Enabled != rhs.Enabled {
+	if lhs.ReplacePeerAs != rhs.ReplacePeerAs {
 		return false
 	}
 	return true
 }
 
-// struct for container bgp-mp:state.
-// State information for BGP graceful-restart.
-type MpGracefulRestartState struct {
-	// original -> bgp-mp:enabled
-	// bgp-mp:enabled's original type is boolean.
-	// This leaf indicates whether graceful-restart is enabled for
-	// this AFI-SAFI.
-	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty"`
-	// original -> bgp-op:received
-	// bgp-op:received's original type is boolean.
-	// This leaf indicates whether the neighbor advertised the
-	// ability to support graceful-restart for this AFI-SAFI.
-	Received bool `mapstructure:"received" json:"received,omitempty"`
-	// original -> bgp-op:advertised
-	// bgp-op:advertised's original type is boolean.
-	// This leaf indicates whether the ability to support
-	// graceful-restart has been advertised to the peer.
-	Advertised bool `mapstructure:"advertised" json:"advertised,omitempty"`
-	// original -> gobgp:end-of-rib-received
-	// gobgp:end-of-rib-received's original type is boolean.
-	EndOfRibReceived bool `mapstructure:"end-of-rib-received" json:"end-of-rib-received,omitempty"`
-	// original -> gobgp:end-of-rib-sent
-	// gobgp:end-of-rib-sent's original type is boolean.
-	EndOfRibSent bool `mapstructure:"end-of-rib-sent" json:"end-of-rib-sent,omitempty"`
+// struct for container gobgp:loop-prevention.
+// AS_PATH loop prevention options related to a particular
+// AFI-SAFI, typically for the CEs of the MPLS-VPN sharing the AS.
+type LoopPrevention struct {
+	// original -> gobgp:loop-prevention-config
+	Config LoopPreventionConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:loop-prevention-state
+	State LoopPreventionState `mapstructure:"state" json:"state,omitempty"`
 }
 
-// struct for container bgp-mp:config.
-// Configuration options for BGP graceful-restart.
-type MpGracefulRestartConfig struct {
-	// original -> bgp-mp:enabled
-	// bgp-mp:enabled's original type is boolean.
-	// This leaf indicates whether graceful-restart is enabled for
-	// this AFI-SAFI.
-	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty"`
+func (lhs *LoopPrevention) Equal(rhs *LoopPrevention) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
 }
 
-func (lhs *MpGracefulRestartConfig) Equal(rhs *MpGracefulRestartConfig) bool {
+// struct for container gobgp:state.
+type NextHopOptionsState struct {
+	// original -> gobgp:next-hop-self
+	// gobgp:next-hop-self's original type is boolean.
+	// Set the local address to the NEXT_HOP of the routes learned
+	// from the eBGP neighbors advertised to the iBGP neighbor.
+	NextHopSelf bool `mapstructure:"next-hop-self" json:"next-hop-self,omitempty"`
+	// original -> gobgp:next-hop-self-force
+	// gobgp:next-hop-self-force's original type is boolean.
+	// Set the local address to the NEXT_HOP of all the routes
+	// advertised to the iBGP neighbor, including the ones learned
+	// from the iBGP neighbors and reflected.
+	NextHopSelfForce bool `mapstructure:"next-hop-self-force" json:"next-hop-self-force,omitempty"`
+	// original -> gobgp:next-hop-unchanged
+	// gobgp:next-hop-unchanged's original type is boolean.
+	// Don't change the NEXT_HOP of the routes advertised to the
+	// eBGP neighbor, e.g. the route reflector over eBGP multihop.
+	NextHopUnchanged bool `mapstructure:"next-hop-unchanged" json:"next-hop-unchanged,omitempty"`
+}
+
+// struct for container gobgp:config.
+type NextHopOptionsConfig struct {
+	// original -> gobgp:next-hop-self
+	// gobgp:next-hop-self's original type is boolean.
+	// Set the local address to the NEXT_HOP of the routes learned
+	// from the eBGP neighbors advertised to the iBGP neighbor.
+	NextHopSelf bool `mapstructure:"next-hop-self" json:"next-hop-self,omitempty"`
+	// original -> gobgp:next-hop-self-force
+	// gobgp:next-hop-self-force's original type is boolean.
+	// Set the local address to the NEXT_HOP of all the routes
+	// advertised to the iBGP neighbor, including the ones learned
+	// from the iBGP neighbors and reflected.
+	NextHopSelfForce bool `mapstructure:"next-hop-self-force" json:"next-hop-self-force,omitempty"`
+	// original -> gobgp:next-hop-unchanged
+	// gobgp:next-hop-unchanged's original type is boolean.
+	// Don't change the NEXT_HOP of the routes advertised to the
+	// eBGP neighbor, e.g. the route reflector over eBGP multihop.
+	NextHopUnchanged bool `mapstructure:"next-hop-unchanged" json:"next-hop-unchanged,omitempty"`
+}
+
+func (lhs *NextHopOptionsConfig) Equal(rhs *NextHopOptionsConfig) bool {
 	if lhs == nil || rhs == nil {
 		return false
 	}
-	if lhs.Enabled != rhs.Enabled {
+	if lhs.NextHopSelf != rhs.NextHopSelf {
+		return false
+	}
+	if lhs.NextHopSelfForce != rhs.NextHopSelfForce {
+		return false
+	}
+	if lhs.NextHopUnchanged != rhs.NextHopUnchanged {
 		return false
 	}
 	return true
 }
 
-// struct for container bgp-mp:graceful-restart.
-// Parameters relating to BGP graceful-restart.
-type MpGracefulRestart struct {
-	// original -> bgp-mp:mp-graceful-restart-config
-	// Configuration options for BGP graceful-restart.
-	Config MpGracefulRestartConfig `mapstructure:"config" json:"config,omitempty"`
-	// original -> bgp-mp:mp-graceful-restart-state
-	// State information for BGP graceful-restart.
-	State MpGracefulRestartState `mapstructure:"state" json:"state,omitempty"`
+// struct for container gobgp:next-hop-options.
+// NEXT_HOP options related to a particular AFI-SAFI, enabled if
+// enabled either for the AFI-SAFI or for the neighbor.
+type NextHopOptions struct {
+	// original -> gobgp:next-hop-options-config
+	Config NextHopOptionsConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:next-hop-options-state
+	State NextHopOptionsState `mapstructure:"state" json:"state,omitempty"`
 }
 
-func (lhs *MpGracefulRestart) Equal(rhs *MpGracefulRestart) bool {
+func (lhs *NextHopOptions) Equal(rhs *NextHopOptions) bool {
 	if lhs == nil || rhs == nil {
 		return false
 	}
@@ -4141,6 +6578,22 @@ type AfiSafi struct {
 	// original -> gobgp:add-paths
 	// add-paths configuration options related to a particular AFI-SAFI.
 	AddPaths AddPaths `mapstructure:"add-paths" json:"add-paths,omitempty"`
+	// original -> gobgp:prefix-orf
+	// Address prefix based Outbound Route Filtering (RFC 5291, 5292)
+	// configuration options related to a particular AFI-SAFI.
+	PrefixOrf PrefixOrf `mapstructure:"prefix-orf" json:"prefix-orf,omitempty"`
+	// original -> gobgp:minimum-advertisement-interval
+	// MinRouteAdvertisementInterval (RFC 4271) configuration options
+	// related to a particular AFI-SAFI.
+	MinimumAdvertisementInterval MinimumAdvertisementInterval `mapstructure:"minimum-advertisement-interval" json:"minimum-advertisement-interval,omitempty"`
+	// original -> gobgp:loop-prevention
+	// AS_PATH loop prevention options related to a particular
+	// AFI-SAFI, typically for the CEs of the MPLS-VPN sharing the AS.
+	LoopPrevention LoopPrevention `mapstructure:"loop-prevention" json:"loop-prevention,omitempty"`
+	// original -> gobgp:next-hop-options
+	// NEXT_HOP options related to a particular AFI-SAFI, enabled if
+	// enabled either for the AFI-SAFI or for the neighbor.
+	NextHopOptions NextHopOptions `mapstructure:"next-hop-options" json:"next-hop-options,omitempty"`
 }
 
 func (lhs *AfiSafi) Equal(rhs *AfiSafi) bool {
@@ -4204,6 +6657,18 @@ func (lhs *AfiSafi) Equal(rhs *AfiSafi) bool {
 	if !lhs.AddPaths.Equal(&(rhs.AddPaths)) {
 		return false
 	}
+	if !lhs.PrefixOrf.Equal(&(rhs.PrefixOrf)) {
+		return false
+	}
+	if !lhs.MinimumAdvertisementInterval.Equal(&(rhs.MinimumAdvertisementInterval)) {
+		return false
+	}
+	if !lhs.LoopPrevention.Equal(&(rhs.LoopPrevention)) {
+		return false
+	}
+	if !lhs.NextHopOptions.Equal(&(rhs.NextHopOptions)) {
+		return false
+	}
 	return true
 }
 
@@ -4773,47 +7238,352 @@ type RouteSelectionOptionsConfig struct {
 	DisableBestPathSelection bool `mapstructure:"disable-best-path-selection" json:"disable-best-path-selection,omitempty"`
 }
 
-func (lhs *RouteSelectionOptionsConfig) Equal(rhs *RouteSelectionOptionsConfig) bool {
+func (lhs *RouteSelectionOptionsConfig) Equal(rhs *RouteSelectionOptionsConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.AlwaysCompareMed != rhs.AlwaysCompareMed {
+		return false
+	}
+	if lhs.IgnoreAsPathLength != rhs.IgnoreAsPathLength {
+		return false
+	}
+	if lhs.ExternalCompareRouterId != rhs.ExternalCompareRouterId {
+		return false
+	}
+	if lhs.AdvertiseInactiveRoutes != rhs.AdvertiseInactiveRoutes {
+		return false
+	}
+	if lhs.EnableAigp != rhs.EnableAigp {
+		return false
+	}
+	if lhs.IgnoreNextHopIgpMetric != rhs.IgnoreNextHopIgpMetric {
+		return false
+	}
+	if lhs.DisableBestPathSelection != rhs.DisableBestPathSelection {
+		return false
+	}
+	return true
+}
+
+// struct for container bgp-mp:route-selection-options.
+// Parameters relating to options for route selection.
+type RouteSelectionOptions struct {
+	// original -> bgp-mp:route-selection-options-config
+	// Configuration parameters relating to route selection
+	// options.
+	Config RouteSelectionOptionsConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> bgp-mp:route-selection-options-state
+	// State information for the route selection options.
+	State RouteSelectionOptionsState `mapstructure:"state" json:"state,omitempty"`
+}
+
+func (lhs *RouteSelectionOptions) Equal(rhs *RouteSelectionOptions) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
+}
+
+// struct for container bgp:state.
+// State information relating to the global BGP router.
+type GlobalState struct {
+	// original -> bgp:as
+	// bgp:as's original type is inet:as-number.
+	// Local autonomous system number of the router.  Uses
+	// the 32-bit as-number type from the model in RFC 6991.
+	As uint32 `mapstructure:"as" json:"as,omitempty"`
+	// original -> bgp:router-id
+	// bgp:router-id's original type is inet:ipv4-address.
+	// Router id of the router, expressed as an
+	// 32-bit value, IPv4 address.
+	RouterId string `mapstructure:"router-id" json:"router-id,omitempty"`
+	// original -> bgp-op:total-paths
+	// Total number of BGP paths within the context.
+	TotalPaths uint32 `mapstructure:"total-paths" json:"total-paths,omitempty"`
+	// original -> bgp-op:total-prefixes
+	// .
+	TotalPrefixes uint32 `mapstructure:"total-prefixes" json:"total-prefixes,omitempty"`
+	// original -> gobgp:port
+	Port int32 `mapstructure:"port" json:"port,omitempty"`
+	// original -> gobgp:local-address
+	LocalAddressList []string `mapstructure:"local-address-list" json:"local-address-list,omitempty"`
+	// original -> gobgp:quic-port
+	// UDP port to accept the experimental BGP over QUIC
+	// sessions. Disabled if zero.
+	QuicPort int32 `mapstructure:"quic-port" json:"quic-port,omitempty"`
+	// original -> gobgp:quic-cert-file
+	// Certificate for the QUIC listener. A self-signed
+	// certificate is generated if empty.
+	QuicCertFile string `mapstructure:"quic-cert-file" json:"quic-cert-file,omitempty"`
+	// original -> gobgp:quic-key-file
+	QuicKeyFile string `mapstructure:"quic-key-file" json:"quic-key-file,omitempty"`
+}
+
+// struct for container bgp:config.
+// Configuration parameters relating to the global BGP router.
+type GlobalConfig struct {
+	// original -> bgp:as
+	// bgp:as's original type is inet:as-number.
+	// Local autonomous system number of the router.  Uses
+	// the 32-bit as-number type from the model in RFC 6991.
+	As uint32 `mapstructure:"as" json:"as,omitempty"`
+	// original -> bgp:router-id
+	// bgp:router-id's original type is inet:ipv4-address.
+	// Router id of the router, expressed as an
+	// 32-bit value, IPv4 address.
+	RouterId string `mapstructure:"router-id" json:"router-id,omitempty"`
+	// original -> gobgp:port
+	Port int32 `mapstructure:"port" json:"port,omitempty"`
+	// original -> gobgp:local-address
+	LocalAddressList []string `mapstructure:"local-address-list" json:"local-address-list,omitempty"`
+	// original -> gobgp:quic-port
+	// UDP port to accept the experimental BGP over QUIC
+	// sessions. Disabled if zero.
+	QuicPort int32 `mapstructure:"quic-port" json:"quic-port,omitempty"`
+	// original -> gobgp:quic-cert-file
+	// Certificate for the QUIC listener. A self-signed
+	// certificate is generated if empty.
+	QuicCertFile string `mapstructure:"quic-cert-file" json:"quic-cert-file,omitempty"`
+	// original -> gobgp:quic-key-file
+	QuicKeyFile string `mapstructure:"quic-key-file" json:"quic-key-file,omitempty"`
+}
+
+func (lhs *GlobalConfig) Equal(rhs *GlobalConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.As != rhs.As {
+		return false
+	}
+	if lhs.RouterId != rhs.RouterId {
+		return false
+	}
+	if lhs.Port != rhs.Port {
+		return false
+	}
+	if len(lhs.LocalAddressList) != len(rhs.LocalAddressList) {
+		return false
+	}
+	for idx, l := range lhs.LocalAddressList {
+		if l != rhs.LocalAddressList[idx] {
+			return false
+		}
+	}
+	if lhs.QuicPort != rhs.QuicPort {
+		return false
+	}
+	if lhs.QuicCertFile != rhs.QuicCertFile {
+		return false
+	}
+	if lhs.QuicKeyFile != rhs.QuicKeyFile {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:state.
+// State information relating to the listener.
+type ListenerState struct {
+	// original -> gobgp:address
+	// Address to listen on.
+	Address string `mapstructure:"address" json:"address,omitempty"`
+	// original -> gobgp:port
+	// TCP port to listen on. The global port is used if zero.
+	Port int32 `mapstructure:"port" json:"port,omitempty"`
+	// original -> gobgp:auth-required
+	// gobgp:auth-required's original type is boolean.
+	// Accept connections only from the neighbors configured
+	// with the TCP MD5 authentication password.
+	AuthRequired bool `mapstructure:"auth-required" json:"auth-required,omitempty"`
+	// original -> gobgp:bind-to-device
+	// Name of the interface or the VRF device the listener is
+	// bound to.
+	BindToDevice string `mapstructure:"bind-to-device" json:"bind-to-device,omitempty"`
+	// original -> gobgp:network-namespace
+	// Name of the network namespace the listener is opened in.
+	NetworkNamespace string `mapstructure:"network-namespace" json:"network-namespace,omitempty"`
+}
+
+// struct for container gobgp:config.
+// Configuration parameters relating to the listener.
+type ListenerConfig struct {
+	// original -> gobgp:address
+	// Address to listen on.
+	Address string `mapstructure:"address" json:"address,omitempty"`
+	// original -> gobgp:port
+	// TCP port to listen on. The global port is used if zero.
+	Port int32 `mapstructure:"port" json:"port,omitempty"`
+	// original -> gobgp:auth-required
+	// gobgp:auth-required's original type is boolean.
+	// Accept connections only from the neighbors configured
+	// with the TCP MD5 authentication password.
+	AuthRequired bool `mapstructure:"auth-required" json:"auth-required,omitempty"`
+	// original -> gobgp:bind-to-device
+	// Name of the interface or the VRF device the listener is
+	// bound to.
+	BindToDevice string `mapstructure:"bind-to-device" json:"bind-to-device,omitempty"`
+	// original -> gobgp:network-namespace
+	// Name of the network namespace the listener is opened in.
+	NetworkNamespace string `mapstructure:"network-namespace" json:"network-namespace,omitempty"`
+}
+
+func (lhs *ListenerConfig) Equal(rhs *ListenerConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Address != rhs.Address {
+		return false
+	}
+	if lhs.Port != rhs.Port {
+		return false
+	}
+	if lhs.AuthRequired != rhs.AuthRequired {
+		return false
+	}
+	if lhs.BindToDevice != rhs.BindToDevice {
+		return false
+	}
+	if lhs.NetworkNamespace != rhs.NetworkNamespace {
+		return false
+	}
+	return true
+}
+
+// struct for container gobgp:listener.
+// List of additional listen sockets.
+type Listener struct {
+	// original -> gobgp:address
+	// original -> gobgp:listener-config
+	// Configuration parameters relating to the listener.
+	Config ListenerConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:listener-state
+	// State information relating to the listener.
+	State ListenerState `mapstructure:"state" json:"state,omitempty"`
+}
+
+func (lhs *Listener) Equal(rhs *Listener) bool {
 	if lhs == nil || rhs == nil {
 		return false
 	}
-	if lhs.AlwaysCompareMed != rhs.AlwaysCompareMed {
+	if !lhs.Config.Equal(&(rhs.Config)) {
 		return false
 	}
-	if lhs.IgnoreAsPathLength != rhs.IgnoreAsPathLength {
+	return true
+}
+
+// struct for container gobgp:state.
+// State information relating to the EVPN MAC Mobility.
+type EvpnMacMobilityState struct {
+	// original -> gobgp:duplicate-mac-detection
+	// gobgp:duplicate-mac-detection's original type is boolean.
+	// Enable the duplicate MAC detection of RFC 7432 15.1. A MAC
+	// address moving max-moves times within interval seconds is
+	// frozen, that is, neither the local nor the remote MAC/IP
+	// Advertisement routes for it are processed any more.
+	DuplicateMacDetection bool `mapstructure:"duplicate-mac-detection" json:"duplicate-mac-detection,omitempty"`
+	// original -> gobgp:max-moves
+	// Number of the moves to detect a duplicate MAC address.
+	MaxMoves uint32 `mapstructure:"max-moves" json:"max-moves,omitempty"`
+	// original -> gobgp:interval
+	// Interval in seconds the moves are counted in.
+	Interval uint32 `mapstructure:"interval" json:"interval,omitempty"`
+	// original -> gobgp:freeze-time
+	// Time in seconds a duplicate MAC address is frozen for. It is
+	// frozen until cleared if zero.
+	FreezeTime uint32 `mapstructure:"freeze-time" json:"freeze-time,omitempty"`
+}
+
+// struct for container gobgp:config.
+// Configuration parameters relating to the EVPN MAC Mobility.
+type EvpnMacMobilityConfig struct {
+	// original -> gobgp:duplicate-mac-detection
+	// gobgp:duplicate-mac-detection's original type is boolean.
+	// Enable the duplicate MAC detection of RFC 7432 15.1. A MAC
+	// address moving max-moves times within interval seconds is
+	// frozen, that is, neither the local nor the remote MAC/IP
+	// Advertisement routes for it are processed any more.
+	DuplicateMacDetection bool `mapstructure:"duplicate-mac-detection" json:"duplicate-mac-detection,omitempty"`
+	// original -> gobgp:max-moves
+	// Number of the moves to detect a duplicate MAC address.
+	MaxMoves uint32 `mapstructure:"max-moves" json:"max-moves,omitempty"`
+	// original -> gobgp:interval
+	// Interval in seconds the moves are counted in.
+	Interval uint32 `mapstructure:"interval" json:"interval,omitempty"`
+	// original -> gobgp:freeze-time
+	// Time in seconds a duplicate MAC address is frozen for. It is
+	// frozen until cleared if zero.
+	FreezeTime uint32 `mapstructure:"freeze-time" json:"freeze-time,omitempty"`
+}
+
+func (lhs *EvpnMacMobilityConfig) Equal(rhs *EvpnMacMobilityConfig) bool {
+	if lhs == nil || rhs == nil {
 		return false
 	}
-	if lhs.ExternalCompareRouterId != rhs.ExternalCompareRouterId {
+	if lhs.DuplicateMacDetection != rhs.DuplicateMacDetection {
 		return false
 	}
-	if lhs.AdvertiseInactiveRoutes != rhs.AdvertiseInactiveRoutes {
+	if lhs.MaxMoves != rhs.MaxMoves {
 		return false
 	}
-	if lhs.EnableAigp != rhs.EnableAigp {
+	if lhs.Interval != rhs.Interval {
 		return false
 	}
-	if lhs.IgnoreNextHopIgpMetric != rhs.IgnoreNextHopIgpMetric {
+	if lhs.FreezeTime != rhs.FreezeTime {
 		return false
 	}
-	if lhs.DisableBestPathSelection != rhs.DisableBestPathSelection {
+	return true
+}
+
+// struct for container gobgp:state.
+// State information relating to the color based route resolution.
+type ColorResolutionState struct {
+	// original -> gobgp:enabled
+	// gobgp:enabled's original type is boolean.
+	// Resolve the IPv4 and IPv6 unicast and VPN routes with the
+	// Color Extended Community over the SR policies of the same
+	// color and the endpoint of the nexthop. The routes without
+	// such SR policy are marked as unresolved.
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty"`
+}
+
+// struct for container gobgp:config.
+// Configuration parameters relating to the color based route resolution.
+type ColorResolutionConfig struct {
+	// original -> gobgp:enabled
+	// gobgp:enabled's original type is boolean.
+	// Resolve the IPv4 and IPv6 unicast and VPN routes with the
+	// Color Extended Community over the SR policies of the same
+	// color and the endpoint of the nexthop. The routes without
+	// such SR policy are marked as unresolved.
+	Enabled bool `mapstructure:"enabled" json:"enabled,omitempty"`
+}
+
+func (lhs *ColorResolutionConfig) Equal(rhs *ColorResolutionConfig) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Enabled != rhs.Enabled {
 		return false
 	}
 	return true
 }
 
-// struct for container bgp-mp:route-selection-options.
-// Parameters relating to options for route selection.
-type RouteSelectionOptions struct {
-	// original -> bgp-mp:route-selection-options-config
-	// Configuration parameters relating to route selection
-	// options.
-	Config RouteSelectionOptionsConfig `mapstructure:"config" json:"config,omitempty"`
-	// original -> bgp-mp:route-selection-options-state
-	// State information for the route selection options.
-	State RouteSelectionOptionsState `mapstructure:"state" json:"state,omitempty"`
+// struct for container gobgp:color-resolution.
+// Parameters relating to the color based route resolution.
+type ColorResolution struct {
+	// original -> gobgp:color-resolution-config
+	// Configuration parameters relating to the color based route resolution.
+	Config ColorResolutionConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:color-resolution-state
+	// State information relating to the color based route resolution.
+	State ColorResolutionState `mapstructure:"state" json:"state,omitempty"`
 }
 
-func (lhs *RouteSelectionOptions) Equal(rhs *RouteSelectionOptions) bool {
+func (lhs *ColorResolution) Equal(rhs *ColorResolution) bool {
 	if lhs == nil || rhs == nil {
 		return false
 	}
@@ -4823,70 +7593,66 @@ func (lhs *RouteSelectionOptions) Equal(rhs *RouteSelectionOptions) bool {
 	return true
 }
 
-// struct for container bgp:state.
-// State information relating to the global BGP router.
-type GlobalState struct {
-	// original -> bgp:as
-	// bgp:as's original type is inet:as-number.
-	// Local autonomous system number of the router.  Uses
-	// the 32-bit as-number type from the model in RFC 6991.
-	As uint32 `mapstructure:"as" json:"as,omitempty"`
-	// original -> bgp:router-id
-	// bgp:router-id's original type is inet:ipv4-address.
-	// Router id of the router, expressed as an
-	// 32-bit value, IPv4 address.
-	RouterId string `mapstructure:"router-id" json:"router-id,omitempty"`
-	// original -> bgp-op:total-paths
-	// Total number of BGP paths within the context.
-	TotalPaths uint32 `mapstructure:"total-paths" json:"total-paths,omitempty"`
-	// original -> bgp-op:total-prefixes
-	// .
-	TotalPrefixes uint32 `mapstructure:"total-prefixes" json:"total-prefixes,omitempty"`
-	// original -> gobgp:port
-	Port int32 `mapstructure:"port" json:"port,omitempty"`
-	// original -> gobgp:local-address
-	LocalAddressList []string `mapstructure:"local-address-list" json:"local-address-list,omitempty"`
+// struct for container gobgp:evpn-mac-mobility.
+// Parameters relating to the EVPN MAC Mobility.
+type EvpnMacMobility struct {
+	// original -> gobgp:evpn-mac-mobility-config
+	// Configuration parameters relating to the EVPN MAC Mobility.
+	Config EvpnMacMobilityConfig `mapstructure:"config" json:"config,omitempty"`
+	// original -> gobgp:evpn-mac-mobility-state
+	// State information relating to the EVPN MAC Mobility.
+	State EvpnMacMobilityState `mapstructure:"state" json:"state,omitempty"`
 }
 
-// struct for container bgp:config.
-// Configuration parameters relating to the global BGP router.
-type GlobalConfig struct {
-	// original -> bgp:as
-	// bgp:as's original type is inet:as-number.
-	// Local autonomous system number of the router.  Uses
-	// the 32-bit as-number type from the model in RFC 6991.
-	As uint32 `mapstructure:"as" json:"as,omitempty"`
-	// original -> bgp:router-id
-	// bgp:router-id's original type is inet:ipv4-address.
-	// Router id of the router, expressed as an
-	// 32-bit value, IPv4 address.
-	RouterId string `mapstructure:"router-id" json:"router-id,omitempty"`
-	// original -> gobgp:port
-	Port int32 `mapstructure:"port" json:"port,omitempty"`
-	// original -> gobgp:local-address
-	LocalAddressList []string `mapstructure:"local-address-list" json:"local-address-list,omitempty"`
+func (lhs *EvpnMacMobility) Equal(rhs *EvpnMacMobility) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if !lhs.Config.Equal(&(rhs.Config)) {
+		return false
+	}
+	return true
 }
 
-func (lhs *GlobalConfig) Equal(rhs *GlobalConfig) bool {
+// struct for container gobgp:peer-defaults.
+// Default parameters of the neighbors, used when neither the
+// neighbor nor its peer group configures them.
+type PeerDefaults struct {
+	// original -> bgp:timers
+	// Timers related to a BGP neighbor or group.
+	Timers Timers `mapstructure:"timers" json:"timers,omitempty"`
+	// original -> bgp:transport
+	// Transport session parameters for the BGP neighbor or group.
+	Transport Transport `mapstructure:"transport" json:"transport,omitempty"`
+	// original -> bgp:ebgp-multihop
+	// eBGP multi-hop parameters for the BGP neighbor or group.
+	EbgpMultihop EbgpMultihop `mapstructure:"ebgp-multihop" json:"ebgp-multihop,omitempty"`
+	// original -> bgp:graceful-restart
+	// Parameters relating the graceful restart mechanism for BGP.
+	GracefulRestart GracefulRestart `mapstructure:"graceful-restart" json:"graceful-restart,omitempty"`
+	// original -> gobgp:ttl-security
+	// Configure TTL Security feature.
+	TtlSecurity TtlSecurity `mapstructure:"ttl-security" json:"ttl-security,omitempty"`
+}
+
+func (lhs *PeerDefaults) Equal(rhs *PeerDefaults) bool {
 	if lhs == nil || rhs == nil {
 		return false
 	}
-	if lhs.As != rhs.As {
+	if !lhs.Timers.Equal(&(rhs.Timers)) {
 		return false
 	}
-	if lhs.RouterId != rhs.RouterId {
+	if !lhs.Transport.Equal(&(rhs.Transport)) {
 		return false
 	}
-	if lhs.Port != rhs.Port {
+	if !lhs.EbgpMultihop.Equal(&(rhs.EbgpMultihop)) {
 		return false
 	}
-	if len(lhs.LocalAddressList) != len(rhs.LocalAddressList) {
+	if !lhs.GracefulRestart.Equal(&(rhs.GracefulRestart)) {
 		return false
 	}
-	for idx, l := range lhs.LocalAddressList {
-		if l != rhs.LocalAddressList[idx] {
-			return false
-		}
+	if !lhs.TtlSecurity.Equal(&(rhs.TtlSecurity)) {
+		return false
 	}
 	return true
 }
@@ -4928,6 +7694,19 @@ type Global struct {
 	// routing table, i.e., export (send) and import (receive),
 	// depending on the context.
 	ApplyPolicy ApplyPolicy `mapstructure:"apply-policy" json:"apply-policy,omitempty"`
+	// original -> gobgp:listeners
+	// Additional listen sockets with their own options.
+	Listeners []Listener `mapstructure:"listeners" json:"listeners,omitempty"`
+	// original -> gobgp:peer-defaults
+	// Default parameters of the neighbors, used when neither the
+	// neighbor nor its peer group configures them.
+	PeerDefaults PeerDefaults `mapstructure:"peer-defaults" json:"peer-defaults,omitempty"`
+	// original -> gobgp:evpn-mac-mobility
+	// Parameters relating to the EVPN MAC Mobility.
+	EvpnMacMobility EvpnMacMobility `mapstructure:"evpn-mac-mobility" json:"evpn-mac-mobility,omitempty"`
+	// original -> gobgp:color-resolution
+	// Parameters relating to the color based route resolution.
+	ColorResolution ColorResolution `mapstructure:"color-resolution" json:"color-resolution,omitempty"`
 }
 
 func (lhs *Global) Equal(rhs *Global) bool {
@@ -4971,6 +7750,31 @@ func (lhs *Global) Equal(rhs *Global) bool {
 	if !lhs.ApplyPolicy.Equal(&(rhs.ApplyPolicy)) {
 		return false
 	}
+	if len(lhs.Listeners) != len(rhs.Listeners) {
+		return false
+	}
+	{
+		lmap := make(map[string]*Listener)
+		for i, l := range lhs.Listeners {
+			lmap[mapkey(i, string(l.Config.Address))] = &lhs.Listeners[i]
+		}
+		for i, r := range rhs.Listeners {
+			if l, y := lmap[mapkey(i, string(r.Config.Address))]; !y {
+				return false
+			} else if !r.Equal(l) {
+				return false
+			}
+		}
+	}
+	if !lhs.PeerDefaults.Equal(&(rhs.PeerDefaults)) {
+		return false
+	}
+	if !lhs.EvpnMacMobility.Equal(&(rhs.EvpnMacMobility)) {
+		return false
+	}
+	if !lhs.ColorResolution.Equal(&(rhs.ColorResolution)) {
+		return false
+	}
 	return true
 }
 
@@ -4988,14 +7792,34 @@ type Bgp struct {
 	PeerGroups []PeerGroup `mapstructure:"peer-groups" json:"peer-groups,omitempty"`
 	// original -> gobgp:rpki-servers
 	RpkiServers []RpkiServer `mapstructure:"rpki-servers" json:"rpki-servers,omitempty"`
+	// original -> gobgp:rpki-slurm
+	RpkiSlurm RpkiSlurm `mapstructure:"rpki-slurm" json:"rpki-slurm,omitempty"`
 	// original -> gobgp:bmp-servers
 	BmpServers []BmpServer `mapstructure:"bmp-servers" json:"bmp-servers,omitempty"`
+	// original -> gobgp:kafka-exporters
+	KafkaExporters []KafkaExporter `mapstructure:"kafka-exporters" json:"kafka-exporters,omitempty"`
+	// original -> gobgp:irr-filters
+	IrrFilters []IrrFilter `mapstructure:"irr-filters" json:"irr-filters,omitempty"`
+	// original -> gobgp:aggregates
+	Aggregates []Aggregate `mapstructure:"aggregates" json:"aggregates,omitempty"`
 	// original -> gobgp:vrfs
 	Vrfs []Vrf `mapstructure:"vrfs" json:"vrfs,omitempty"`
+	// original -> gobgp:vrf-leaks
+	VrfLeaks []VrfLeak `mapstructure:"vrf-leaks" json:"vrf-leaks,omitempty"`
+	// original -> gobgp:orr-vantage-points
+	OrrVantagePoints []OrrVantagePoint `mapstructure:"orr-vantage-points" json:"orr-vantage-points,omitempty"`
+	// original -> gobgp:evpn-vpws
+	EvpnVpws []EvpnVpwsInstance `mapstructure:"evpn-vpws" json:"evpn-vpws,omitempty"`
 	// original -> gobgp:mrt-dump
 	MrtDump []Mrt `mapstructure:"mrt-dump" json:"mrt-dump,omitempty"`
 	// original -> gobgp:zebra
 	Zebra Zebra `mapstructure:"zebra" json:"zebra,omitempty"`
+	// original -> gobgp:netlink
+	Netlink Netlink `mapstructure:"netlink" json:"netlink,omitempty"`
+	// original -> gobgp:peeringdb
+	Peeringdb Peeringdb `mapstructure:"peeringdb" json:"peeringdb,omitempty"`
+	// original -> gobgp:srv6
+	Srv6 Srv6 `mapstructure:"srv6" json:"srv6,omitempty"`
 	// original -> gobgp:collector
 	Collector Collector `mapstructure:"collector" json:"collector,omitempty"`
 	// original -> gobgp:dynamic-neighbors
@@ -5057,6 +7881,9 @@ func (lhs *Bgp) Equal(rhs *Bgp) bool {
 			}
 		}
 	}
+	if !lhs.RpkiSlurm.Equal(&(rhs.RpkiSlurm)) {
+		return false
+	}
 	if len(lhs.BmpServers) != len(rhs.BmpServers) {
 		return false
 	}
@@ -5073,6 +7900,54 @@ func (lhs *Bgp) Equal(rhs *Bgp) bool {
 			}
 		}
 	}
+	if len(lhs.KafkaExporters) != len(rhs.KafkaExporters) {
+		return false
+	}
+	{
+		lmap := make(map[string]*KafkaExporter)
+		for i, l := range lhs.KafkaExporters {
+			lmap[mapkey(i, string(l.Config.Name))] = &lhs.KafkaExporters[i]
+		}
+		for i, r := range rhs.KafkaExporters {
+			if l, y := lmap[mapkey(i, string(r.Config.Name))]; !y {
+				return false
+			} else if !r.Equal(l) {
+				return false
+			}
+		}
+	}
+	if len(lhs.IrrFilters) != len(rhs.IrrFilters) {
+		return false
+	}
+	{
+		lmap := make(map[string]*IrrFilter)
+		for i, l := range lhs.IrrFilters {
+			lmap[mapkey(i, string(l.Config.Name))] = &lhs.IrrFilters[i]
+		}
+		for i, r := range rhs.IrrFilters {
+			if l, y := lmap[mapkey(i, string(r.Config.Name))]; !y {
+				return false
+			} else if !r.Equal(l) {
+				return false
+			}
+		}
+	}
+	if len(lhs.Aggregates) != len(rhs.Aggregates) {
+		return false
+	}
+	{
+		lmap := make(map[string]*Aggregate)
+		for i, l := range lhs.Aggregates {
+			lmap[mapkey(i, string(l.Config.Prefix))] = &lhs.Aggregates[i]
+		}
+		for i, r := range rhs.Aggregates {
+			if l, y := lmap[mapkey(i, string(r.Config.Prefix))]; !y {
+				return false
+			} else if !r.Equal(l) {
+				return false
+			}
+		}
+	}
 	if len(lhs.Vrfs) != len(rhs.Vrfs) {
 		return false
 	}
@@ -5089,6 +7964,54 @@ func (lhs *Bgp) Equal(rhs *Bgp) bool {
 			}
 		}
 	}
+	if len(lhs.VrfLeaks) != len(rhs.VrfLeaks) {
+		return false
+	}
+	{
+		lmap := make(map[string]*VrfLeak)
+		for i, l := range lhs.VrfLeaks {
+			lmap[mapkey(i, string(l.Config.Name))] = &lhs.VrfLeaks[i]
+		}
+		for i, r := range rhs.VrfLeaks {
+			if l, y := lmap[mapkey(i, string(r.Config.Name))]; !y {
+				return false
+			} else if !r.Equal(l) {
+				return false
+			}
+		}
+	}
+	if len(lhs.OrrVantagePoints) != len(rhs.OrrVantagePoints) {
+		return false
+	}
+	{
+		lmap := make(map[string]*OrrVantagePoint)
+		for i, l := range lhs.OrrVantagePoints {
+			lmap[mapkey(i, string(l.Config.Name))] = &lhs.OrrVantagePoints[i]
+		}
+		for i, r := range rhs.OrrVantagePoints {
+			if l, y := lmap[mapkey(i, string(r.Config.Name))]; !y {
+				return false
+			} else if !r.Equal(l) {
+				return false
+			}
+		}
+	}
+	if len(lhs.EvpnVpws) != len(rhs.EvpnVpws) {
+		return false
+	}
+	{
+		lmap := make(map[string]*EvpnVpwsInstance)
+		for i, l := range lhs.EvpnVpws {
+			lmap[mapkey(i, string(l.Config.Name))] = &lhs.EvpnVpws[i]
+		}
+		for i, r := range rhs.EvpnVpws {
+			if l, y := lmap[mapkey(i, string(r.Config.Name))]; !y {
+				return false
+			} else if !r.Equal(l) {
+				return false
+			}
+		}
+	}
 	if len(lhs.MrtDump) != len(rhs.MrtDump) {
 		return false
 	}
@@ -5108,6 +8031,15 @@ func (lhs *Bgp) Equal(rhs *Bgp) bool {
 	if !lhs.Zebra.Equal(&(rhs.Zebra)) {
 		return false
 	}
+	if !lhs.Netlink.Equal(&(rhs.Netlink)) {
+		return false
+	}
+	if !lhs.Peeringdb.Equal(&(rhs.Peeringdb)) {
+		return false
+	}
+	if !lhs.Srv6.Equal(&(rhs.Srv6)) {
+		return false
+	}
 	if !lhs.Collector.Equal(&(rhs.Collector)) {
 		return false
 	}
@@ -5297,6 +8229,35 @@ func (lhs *SetCommunity) Equal(rhs *SetCommunity) bool {
 	return true
 }
 
+// struct for container gobgp:set-link-bandwidth.
+// action to set the link bandwidth extended community.
+type SetLinkBandwidth struct {
+	// original -> gobgp:bandwidth
+	// gobgp:bandwidth's original type is union.
+	// link bandwidth in bytes per second or 'cumulative'
+	// which means the sum of the bandwidths of the
+	// multipaths.
+	Bandwidth string `mapstructure:"bandwidth" json:"bandwidth,omitempty"`
+	// original -> gobgp:transitive
+	// gobgp:transitive's original type is boolean.
+	// use the transitive link bandwidth extended community
+	// instead of the non-transitive one.
+	Transitive bool `mapstructure:"transitive" json:"transitive,omitempty"`
+}
+
+func (lhs *SetLinkBandwidth) Equal(rhs *SetLinkBandwidth) bool {
+	if lhs == nil || rhs == nil {
+		return false
+	}
+	if lhs.Bandwidth != rhs.Bandwidth {
+		return false
+	}
+	if lhs.Transitive != rhs.Transitive {
+		return false
+	}
+	return true
+}
+
 // struct for container bgp-pol:set-as-path-prepend.
 // action to prepend local AS number to the AS-path a
 // specified number of times.
@@ -5307,9 +8268,14 @@ type SetAsPathPrepend struct {
 	RepeatN uint8 `mapstructure:"repeat-n" json:"repeat-n,omitempty"`
 	// original -> gobgp:as
 	// gobgp:as's original type is union.
-	// autonomous system number or 'last-as' which means
-	// the leftmost as number in the AS-path to be prepended.
+	// autonomous system number, 'last-as' which means
+	// the leftmost as number in the AS-path or 'peer-as'
+	// which means the as number of the neighbor to be prepended.
 	As string `mapstructure:"as" json:"as,omitempty"`
+	// original -> gobgp:repeat-n-community
+	// community or large community pattern whose '*' field
+	// gives the number of times to prepend, e.g. '65000:*'.
+	RepeatNCommunity string `mapstructure:"repeat-n-community" json:"repeat-n-community,omitempty"`
 }
 
 func (lhs *SetAsPathPrepend) Equal(rhs *SetAsPathPrepend) bool {
@@ -5322,6 +8288,9 @@ func (lhs *SetAsPathPrepend) Equal(rhs *SetAsPathPrepend) bool {
 	if lhs.As != rhs.As {
 		return false
 	}
+	if lhs.RepeatNCommunity != rhs.RepeatNCommunity {
+		return false
+	}
 	return true
 }
 
@@ -5359,6 +8328,14 @@ type BgpActions struct {
 	SetMed BgpSetMedType `mapstructure:"set-med" json:"set-med,omitempty"`
 	// original -> gobgp:set-large-community
 	SetLargeCommunity SetLargeCommunity `mapstructure:"set-large-community" json:"set-large-community,omitempty"`
+	// original -> gobgp:set-aigp
+	// set the IGP metric of the AIGP attribute. A value
+	// with a sign is added to the metric of the existing
+	// attribute.
+	SetAigp BgpSetMedType `mapstructure:"set-aigp" json:"set-aigp,omitempty"`
+	// original -> gobgp:set-link-bandwidth
+	// action to set the link bandwidth extended community.
+	SetLinkBandwidth SetLinkBandwidth `mapstructure:"set-link-bandwidth" json:"set-link-bandwidth,omitempty"`
 }
 
 func (lhs *BgpActions) Equal(rhs *BgpActions) bool {
@@ -5389,6 +8366,12 @@ func (lhs *BgpActions) Equal(rhs *BgpActions) bool {
 	if !lhs.SetLargeCommunity.Equal(&(rhs.SetLargeCommunity)) {
 		return false
 	}
+	if lhs.SetAigp != rhs.SetAigp {
+		return false
+	}
+	if !lhs.SetLinkBandwidth.Equal(&(rhs.SetLinkBandwidth)) {
+		return false
+	}
 	return true
 }
 
@@ -5650,6 +8633,12 @@ type BgpConditions struct {
 	RpkiValidationResult RpkiValidationResultType `mapstructure:"rpki-validation-result" json:"rpki-validation-result,omitempty"`
 	// original -> gobgp:match-large-community-set
 	MatchLargeCommunitySet MatchLargeCommunitySet `mapstructure:"match-large-community-set" json:"match-large-community-set,omitempty"`
+	// original -> gobgp:flow-spec-match
+	// The flowspec rule whose components the flowspec routes have.
+	FlowSpecMatch string `mapstructure:"flow-spec-match" json:"flow-spec-match,omitempty"`
+	// original -> gobgp:path-identifier-in-list
+	// List of ADD-PATH path identifiers received with the route.
+	PathIdentifierInList []uint32 `mapstructure:"path-identifier-in-list" json:"path-identifier-in-list,omitempty"`
 }
 
 func (lhs *BgpConditions) Equal(rhs *BgpConditions) bool {
@@ -5705,6 +8694,17 @@ func (lhs *BgpConditions) Equal(rhs *BgpConditions) bool {
 	if !lhs.MatchLargeCommunitySet.Equal(&(rhs.MatchLargeCommunitySet)) {
 		return false
 	}
+	if lhs.FlowSpecMatch != rhs.FlowSpecMatch {
+		return false
+	}
+	if len(lhs.PathIdentifierInList) != len(rhs.PathIdentifierInList) {
+		return false
+	}
+	for idx, l := range lhs.PathIdentifierInList {
+		if l != rhs.PathIdentifierInList[idx] {
+			return false
+		}
+	}
 	return true
 }
 
@@ -7,6 +7,7 @@ import (
 	"net"
 	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/osrg/gobgp/v3/internal/pkg/version"
 	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
@@ -17,9 +18,21 @@ import (
 )
 
 const (
-	DEFAULT_HOLDTIME                  = 90
-	DEFAULT_IDLE_HOLDTIME_AFTER_RESET = 30
-	DEFAULT_CONNECT_RETRY             = 120
+	DEFAULT_HOLDTIME                        = 90
+	DEFAULT_IDLE_HOLDTIME_AFTER_RESET       = 30
+	DEFAULT_IDLE_HOLDTIME_MIN               = 5
+	DEFAULT_CONNECT_RETRY                   = 120
+	DEFAULT_CONNECT_RETRY_JITTER            = 0.25
+	DEFAULT_IRR_SERVER_ADDRESS              = "rr.ntt.net:43"
+	DEFAULT_IRR_REFRESH_INTERVAL            = 3600
+	DEFAULT_IRR_MAX_PREFIXES                = 100000
+	DEFAULT_IRR_MAX_ASNS                    = 10000
+	DEFAULT_PEERINGDB_URL                   = "https://www.peeringdb.com/api"
+	DEFAULT_PEERINGDB_REFRESH_INTERVAL      = 86400
+	DEFAULT_PEERINGDB_MULTIPLIER            = 1.2
+	DEFAULT_PEERINGDB_WARNING_THRESHOLD_PCT = 90
+	DEFAULT_EVPN_MAC_MAX_MOVES              = 5
+	DEFAULT_EVPN_MAC_MOVE_INTERVAL          = 180
 )
 
 var forcedOverwrittenConfig = []string{
@@ -76,6 +89,12 @@ func setDefaultNeighborConfigValuesWithViper(v *viper.Viper, n *Neighbor, g *Glo
 			return err
 		}
 	}
+	if v.IsSet("neighbor") {
+		overwriteNeighborConfigWithPeerDefaults(n, &g.PeerDefaults, v)
+	} else {
+		// the neighbor read from the configuration file is passed again
+		overwriteNeighborConfigWithPeerDefaults(n, &g.PeerDefaults, neighborConfiguredFields(n))
+	}
 
 	if n.Config.LocalAs == 0 {
 		n.Config.LocalAs = g.Config.As
@@ -126,39 +145,111 @@ func setDefaultNeighborConfigValuesWithViper(v *viper.Viper, n *Neighbor, g *Glo
 	if !v.IsSet("neighbor.timers.config.idle-hold-time-after-reset") && n.Timers.Config.IdleHoldTimeAfterReset == 0 {
 		n.Timers.Config.IdleHoldTimeAfterReset = float64(DEFAULT_IDLE_HOLDTIME_AFTER_RESET)
 	}
+	if n.Timers.Config.IdleHoldTimeMax > 0 {
+		if !v.IsSet("neighbor.timers.config.idle-hold-time-min") && n.Timers.Config.IdleHoldTimeMin == 0 {
+			n.Timers.Config.IdleHoldTimeMin = float64(DEFAULT_IDLE_HOLDTIME_MIN)
+		}
+		if n.Timers.Config.IdleHoldTimeMin > n.Timers.Config.IdleHoldTimeMax {
+			return fmt.Errorf("idle-hold-time-min %v is larger than idle-hold-time-max %v", n.Timers.Config.IdleHoldTimeMin, n.Timers.Config.IdleHoldTimeMax)
+		}
+	}
+	if n.Timers.Config.IdleHoldTimeJitter < 0 || n.Timers.Config.IdleHoldTimeJitter > 1 {
+		return fmt.Errorf("idle-hold-time-jitter must be between 0 and 1: %v", n.Timers.Config.IdleHoldTimeJitter)
+	}
+	if !v.IsSet("neighbor.timers.config.connect-retry-jitter") && n.Timers.Config.ConnectRetryJitter == 0 {
+		n.Timers.Config.ConnectRetryJitter = DEFAULT_CONNECT_RETRY_JITTER
+	}
+	if n.Timers.Config.ConnectRetryJitter < 0 || n.Timers.Config.ConnectRetryJitter > 1 {
+		return fmt.Errorf("connect-retry-jitter must be between 0 and 1: %v", n.Timers.Config.ConnectRetryJitter)
+	}
+	if n.Transport.Config.ActiveMode && n.Transport.Config.PassiveMode {
+		return fmt.Errorf("can't set both active-mode and passive-mode")
+	}
 
 	if n.Config.NeighborInterface != "" {
 		if n.RouteServer.Config.RouteServerClient {
 			return fmt.Errorf("configuring route server client as unnumbered peer is not supported")
 		}
-		addr, err := GetIPv6LinkLocalNeighborAddress(n.Config.NeighborInterface)
-		if err != nil {
-			return err
+		// the neighbor which isn't in the neighbor table yet is
+		// discovered when the peer connects to it.
+		if addr, err := GetIPv6LinkLocalNeighborAddress(n.Config.NeighborInterface); err == nil {
+			n.State.NeighborAddress = addr
 		}
-		n.State.NeighborAddress = addr
 	}
 
 	if n.Transport.Config.LocalAddress == "" {
-		if n.State.NeighborAddress == "" {
-			return fmt.Errorf("no neighbor address/interface specified")
-		}
-		ipAddr, err := net.ResolveIPAddr("ip", n.State.NeighborAddress)
-		if err != nil {
-			return err
+		localAddress := "::"
+		zone := n.Config.NeighborInterface
+		if zone == "" {
+			if n.State.NeighborAddress == "" {
+				return fmt.Errorf("no neighbor address/interface specified")
+			}
+			ipAddr, err := net.ResolveIPAddr("ip", n.State.NeighborAddress)
+			if err != nil {
+				return err
+			}
+			if ipAddr.IP.To4() != nil {
+				localAddress = "0.0.0.0"
+			}
+			zone = ipAddr.Zone
 		}
-		localAddress := "0.0.0.0"
-		if ipAddr.IP.To4() == nil {
-			localAddress = "::"
-			if ipAddr.Zone != "" {
-				localAddress, err = getIPv6LinkLocalAddress(ipAddr.Zone)
-				if err != nil {
-					return err
-				}
+		if zone != "" {
+			var err error
+			localAddress, err = getIPv6LinkLocalAddress(zone)
+			if err != nil {
+				return err
 			}
 		}
 		n.Transport.Config.LocalAddress = localAddress
 	}
 
+	if n.Transport.Config.TransportProtocol == "" {
+		n.Transport.Config.TransportProtocol = TRANSPORT_PROTOCOL_TYPE_TCP
+	} else if err := n.Transport.Config.TransportProtocol.Validate(); err != nil {
+		return err
+	}
+	if n.Transport.Config.TransportProtocol == TRANSPORT_PROTOCOL_TYPE_QUIC {
+		if n.Config.AuthPassword != "" || n.Config.AuthPasswordSource != "" {
+			return fmt.Errorf("auth-password isn't supported with the quic transport")
+		}
+		if n.TtlSecurity.Config.Enabled {
+			return fmt.Errorf("ttl-security isn't supported with the quic transport")
+		}
+		if n.Transport.Config.Vrf != "" {
+			return fmt.Errorf("vrf isn't supported with the quic transport")
+		}
+	}
+	if n.Config.DisableFourOctetAs && n.Config.LocalAs > (1<<16)-1 {
+		return fmt.Errorf("disable-four-octet-as requires the two-octet local-as")
+	}
+	if n.Config.LocalAsMigration {
+		if n.Config.PeerType != PEER_TYPE_EXTERNAL || n.Config.LocalAs == g.Config.As {
+			return fmt.Errorf("local-as-migration requires the eBGP neighbor with local-as different from the global AS")
+		}
+		if g.Confederation.Config.Enabled {
+			return fmt.Errorf("local-as-migration isn't supported with the confederation")
+		}
+	} else if n.Config.LocalAsNoPrepend || n.Config.LocalAsReplaceAs {
+		return fmt.Errorf("local-as-no-prepend and local-as-replace-as require local-as-migration")
+	}
+	if n.Config.OrrVantagePoint != "" {
+		if n.Config.PeerType != PEER_TYPE_INTERNAL {
+			return fmt.Errorf("orr-vantage-point requires the iBGP neighbor")
+		}
+		if n.RouteServer.Config.RouteServerClient {
+			return fmt.Errorf("orr-vantage-point isn't supported with route-server-client")
+		}
+	}
+	if n.Transport.Config.Vrf != "" && n.Transport.Config.BindInterface != "" {
+		return fmt.Errorf("bind-interface and vrf can't be configured together")
+	}
+
+	if n.AddPaths.Config.SendMode == "" {
+		n.AddPaths.Config.SendMode = ADD_PATHS_SEND_MODE_TYPE_N_PATHS
+	} else if err := n.AddPaths.Config.SendMode.Validate(); err != nil {
+		return err
+	}
+
 	if len(n.AfiSafis) == 0 {
 		if n.Config.NeighborInterface != "" {
 			n.AfiSafis = []AfiSafi{
@@ -177,6 +268,8 @@ func setDefaultNeighborConfigValuesWithViper(v *viper.Viper, n *Neighbor, g *Glo
 			n.AfiSafis[i].AddPaths.State.Receive = n.AddPaths.Config.Receive
 			n.AfiSafis[i].AddPaths.Config.SendMax = n.AddPaths.Config.SendMax
 			n.AfiSafis[i].AddPaths.State.SendMax = n.AddPaths.Config.SendMax
+			n.AfiSafis[i].AddPaths.Config.SendMode = n.AddPaths.Config.SendMode
+			n.AfiSafis[i].AddPaths.State.SendMode = n.AddPaths.Config.SendMode
 		}
 	} else {
 		afs, err := extractArray(v.Get("neighbor.afi-safis"))
@@ -210,6 +303,65 @@ func setDefaultNeighborConfigValuesWithViper(v *viper.Viper, n *Neighbor, g *Glo
 				}
 			}
 			n.AfiSafis[i].AddPaths.State.SendMax = n.AfiSafis[i].AddPaths.Config.SendMax
+			if !vv.IsSet("afi-safi.add-paths.config.send-mode") && n.AfiSafis[i].AddPaths.Config.SendMode == "" {
+				n.AfiSafis[i].AddPaths.Config.SendMode = n.AddPaths.Config.SendMode
+			}
+			if err := n.AfiSafis[i].AddPaths.Config.SendMode.Validate(); err != nil {
+				return err
+			}
+			n.AfiSafis[i].AddPaths.State.SendMode = n.AfiSafis[i].AddPaths.Config.SendMode
+			if n.AfiSafis[i].PrefixOrf.Config.Mode == "" {
+				n.AfiSafis[i].PrefixOrf.Config.Mode = PREFIX_ORF_MODE_TYPE_NONE
+			} else if err := n.AfiSafis[i].PrefixOrf.Config.Mode.Validate(); err != nil {
+				return err
+			}
+			if n.AfiSafis[i].PrefixOrf.Config.Mode != PREFIX_ORF_MODE_TYPE_NONE && rf != bgp.RF_IPv4_UC && rf != bgp.RF_IPv6_UC {
+				return fmt.Errorf("prefix-orf isn't supported for %s", rf)
+			}
+			n.AfiSafis[i].PrefixOrf.State.Mode = n.AfiSafis[i].PrefixOrf.Config.Mode
+			n.AfiSafis[i].PrefixOrf.State.PrefixSet = n.AfiSafis[i].PrefixOrf.Config.PrefixSet
+		}
+	}
+
+	if n.Timers.Config.MinimumAdvertisementInterval < 0 {
+		return fmt.Errorf("negative minimum-advertisement-interval: %v", n.Timers.Config.MinimumAdvertisementInterval)
+	}
+	for i := range n.AfiSafis {
+		mrai := &n.AfiSafis[i].MinimumAdvertisementInterval
+		if mrai.Config.Interval < 0 {
+			return fmt.Errorf("negative minimum-advertisement-interval for %s: %v", n.AfiSafis[i].Config.AfiSafiName, mrai.Config.Interval)
+		}
+		mrai.State.Interval = mrai.Config.Interval
+		if mrai.State.Interval == 0 {
+			mrai.State.Interval = n.Timers.Config.MinimumAdvertisementInterval
+		}
+
+		ap := &n.AfiSafis[i].AddPaths
+		if ap.Config.SendMode == ADD_PATHS_SEND_MODE_TYPE_BEST_EXTERNAL && ap.Config.SendMax == 1 {
+			return fmt.Errorf("send-mode best-external for %s needs send-max of 2 or more to advertise the external path", n.AfiSafis[i].Config.AfiSafiName)
+		}
+
+		lp := &n.AfiSafis[i].LoopPrevention
+		if lp.Config.ReplacePeerAs && n.Config.PeerType == PEER_TYPE_INTERNAL {
+			return fmt.Errorf("can't set replace-peer-as for iBGP peer")
+		}
+		lp.State.AllowOwnAs = lp.Config.AllowOwnAs
+		if lp.State.AllowOwnAs == 0 {
+			lp.State.AllowOwnAs = n.AsPathOptions.State.AllowOwnAs
+		}
+		lp.State.ReplacePeerAs = lp.Config.ReplacePeerAs || n.AsPathOptions.State.ReplacePeerAs
+
+		nh := &n.AfiSafis[i].NextHopOptions
+		nh.State.NextHopSelf = nh.Config.NextHopSelf || n.Config.NextHopSelf
+		nh.State.NextHopSelfForce = nh.Config.NextHopSelfForce || n.Config.NextHopSelfForce
+		nh.State.NextHopUnchanged = nh.Config.NextHopUnchanged || n.Config.NextHopUnchanged
+		if nh.State.NextHopUnchanged {
+			if n.Config.PeerType == PEER_TYPE_INTERNAL {
+				return fmt.Errorf("can't set next-hop-unchanged for iBGP peer")
+			}
+			if nh.State.NextHopSelf || nh.State.NextHopSelfForce {
+				return fmt.Errorf("next-hop-unchanged and next-hop-self can't be configured together for %s", n.AfiSafis[i].Config.AfiSafiName)
+			}
 		}
 	}
 
@@ -232,10 +384,22 @@ func setDefaultNeighborConfigValuesWithViper(v *viper.Viper, n *Neighbor, g *Glo
 		}
 	}
 
-	if n.EbgpMultihop.Config.Enabled {
-		if n.TtlSecurity.Config.Enabled {
-			return fmt.Errorf("ebgp-multihop and ttl-security are mututally exclusive")
+	if n.EbgpMultihop.Config.Enabled && n.TtlSecurity.Config.Enabled {
+		// RFC 5082 3. GTSM Procedure
+		// the packets from the neighbor n hops away arrive with TTL 256 - n
+		multihopTtl := int(n.EbgpMultihop.Config.MultihopTtl)
+		ttlMin := int(n.TtlSecurity.Config.TtlMin)
+		switch {
+		case multihopTtl == 0 && ttlMin == 0:
+			return fmt.Errorf("multihop-ttl or ttl-min is required to configure ebgp-multihop and ttl-security together")
+		case multihopTtl == 0:
+			n.EbgpMultihop.Config.MultihopTtl = uint8(256 - ttlMin)
+		case ttlMin == 0:
+			n.TtlSecurity.Config.TtlMin = uint8(256 - multihopTtl)
+		case multihopTtl+ttlMin > 256:
+			return fmt.Errorf("ttl-min %d rejects the neighbor %d hops away allowed by ebgp-multihop", ttlMin, multihopTtl)
 		}
+	} else if n.EbgpMultihop.Config.Enabled {
 		if n.EbgpMultihop.Config.MultihopTtl == 0 {
 			n.EbgpMultihop.Config.MultihopTtl = 255
 		}
@@ -244,6 +408,9 @@ func setDefaultNeighborConfigValuesWithViper(v *viper.Viper, n *Neighbor, g *Glo
 			n.TtlSecurity.Config.TtlMin = 255
 		}
 	}
+	if n.TtlSecurity.Config.Enabled && n.Transport.Config.Ttl != 0 && n.Transport.Config.Ttl != 255 {
+		return fmt.Errorf("ttl %d conflicts with ttl-security which sends with TTL 255", n.Transport.Config.Ttl)
+	}
 
 	if n.RouteReflector.Config.RouteReflectorClient {
 		if n.RouteReflector.Config.RouteReflectorClusterId == "" {
@@ -262,6 +429,18 @@ func setDefaultNeighborConfigValuesWithViper(v *viper.Viper, n *Neighbor, g *Glo
 		}
 	}
 
+	for i := range n.ApplyPolicy.ConditionalAdvertisements {
+		c := &n.ApplyPolicy.ConditionalAdvertisements[i].Config
+		if c.AdvertisePrefixSet == "" || c.ConditionPrefixSet == "" {
+			return fmt.Errorf("conditional advertisement needs advertise-prefix-set and condition-prefix-set")
+		}
+		if c.Condition == "" {
+			c.Condition = CONDITIONAL_ADVERTISEMENT_CONDITION_TYPE_NON_EXIST
+		} else if err := c.Condition.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -280,6 +459,42 @@ func SetDefaultGlobalConfigValues(g *Global) error {
 	if len(g.Config.LocalAddressList) == 0 {
 		g.Config.LocalAddressList = []string{"0.0.0.0", "::"}
 	}
+
+	if c := g.Confederation.Config; c.Enabled {
+		if c.Identifier == 0 || c.Identifier == g.Config.As {
+			return fmt.Errorf("invalid confederation identifier: %d", c.Identifier)
+		}
+		for _, as := range c.MemberAsList {
+			if as == c.Identifier {
+				return fmt.Errorf("confederation identifier %d in member as list", as)
+			}
+		}
+	}
+
+	for i := range g.Listeners {
+		l := &g.Listeners[i].Config
+		if net.ParseIP(l.Address) == nil {
+			return fmt.Errorf("invalid listener address: %s", l.Address)
+		}
+		if l.Port == 0 {
+			l.Port = g.Config.Port
+			if l.Port < 0 {
+				l.Port = bgp.BGP_PORT
+			}
+		}
+		if l.Port < 0 || l.Port > 65535 {
+			return fmt.Errorf("invalid listener port: %d", l.Port)
+		}
+	}
+
+	if m := &g.EvpnMacMobility.Config; m.DuplicateMacDetection {
+		if m.MaxMoves == 0 {
+			m.MaxMoves = DEFAULT_EVPN_MAC_MAX_MOVES
+		}
+		if m.Interval == 0 {
+			m.Interval = DEFAULT_EVPN_MAC_MOVE_INTERVAL
+		}
+	}
 	return nil
 }
 
@@ -292,9 +507,18 @@ func setDefaultVrfConfigValues(v *Vrf) error {
 		return fmt.Errorf("specify vrf name")
 	}
 
-	_, err := bgp.ParseRouteDistinguisher(v.Config.Rd)
-	if err != nil {
-		return fmt.Errorf("invalid rd for vrf %s: %s", v.Config.Name, v.Config.Rd)
+	if v.Config.Rd != "" {
+		if _, err := bgp.ParseRouteDistinguisher(v.Config.Rd); err != nil {
+			return fmt.Errorf("invalid rd for vrf %s: %s", v.Config.Name, v.Config.Rd)
+		}
+	}
+	if v.Config.RouterId != "" {
+		if ip := net.ParseIP(v.Config.RouterId); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("invalid router-id for vrf %s: %s", v.Config.Name, v.Config.RouterId)
+		}
+	}
+	if v.Config.RdType == "" {
+		v.Config.RdType = VRF_RD_TYPE_IP_ADDRESS
 	}
 
 	if len(v.Config.ImportRtList) == 0 {
@@ -320,6 +544,34 @@ func setDefaultVrfConfigValues(v *Vrf) error {
 	return nil
 }
 
+// deriveVrfRd returns the RD of the type of the VRF made of the router ID,
+// or the global AS number, and the index.
+func deriveVrfRd(v *VrfConfig, g *GlobalConfig) (string, error) {
+	index := v.RdIndex
+	if index == 0 {
+		index = v.Id
+	}
+	switch v.RdType {
+	case VRF_RD_TYPE_TWO_OCTET_AS:
+		if g.As > math.MaxUint16 {
+			return "", fmt.Errorf("global as %d of vrf %s doesn't fit in rd type 0", g.As, v.Name)
+		}
+		return fmt.Sprintf("%d:%d", g.As, index), nil
+	default:
+		id := v.RouterId
+		if id == "" {
+			id = g.RouterId
+		}
+		if id == "" {
+			return "", fmt.Errorf("no router-id to derive rd for vrf %s", v.Name)
+		}
+		if index > math.MaxUint16 {
+			return "", fmt.Errorf("rd index %d of vrf %s doesn't fit in rd type 1", index, v.Name)
+		}
+		return fmt.Sprintf("%s:%d", id, index), nil
+	}
+}
+
 func SetDefaultConfigValues(b *BgpConfigSet) error {
 	return setDefaultConfigValuesWithViper(nil, b)
 }
@@ -367,9 +619,85 @@ func setDefaultConfigValuesWithViper(v *viper.Viper, b *BgpConfigSet) error {
 		if server.Config.StatisticsTimeout != 0 && server.Config.StatisticsTimeout < 15 {
 			return fmt.Errorf("too small statistics-timeout value: %d", server.Config.StatisticsTimeout)
 		}
+		if server.Config.Mode == "" {
+			server.Config.Mode = BMP_MODE_TYPE_ACTIVE
+		} else if err := server.Config.Mode.Validate(); err != nil {
+			return err
+		}
+		if server.Config.Transport == "" {
+			server.Config.Transport = BMP_TRANSPORT_TYPE_TCP
+		} else if err := server.Config.Transport.Validate(); err != nil {
+			return err
+		}
 		b.BmpServers[idx] = server
 	}
 
+	for idx, exporter := range b.KafkaExporters {
+		if exporter.Config.Name == "" {
+			return fmt.Errorf("kafka exporter name is missing")
+		}
+		if len(exporter.Config.BrokerList) == 0 || exporter.Config.Topic == "" {
+			return fmt.Errorf("kafka exporter %s needs brokers and topic", exporter.Config.Name)
+		}
+		if exporter.Config.Encoding == "" {
+			exporter.Config.Encoding = KAFKA_ENCODING_TYPE_JSON
+		}
+		if exporter.Config.PartitionKey == "" {
+			exporter.Config.PartitionKey = KAFKA_PARTITION_KEY_TYPE_PREFIX
+		}
+		if exporter.Config.RouteMonitoringPolicy == "" {
+			exporter.Config.RouteMonitoringPolicy = BMP_ROUTE_MONITORING_POLICY_TYPE_PRE_POLICY
+		}
+		b.KafkaExporters[idx] = exporter
+	}
+
+	for idx, filter := range b.IrrFilters {
+		if filter.Config.Name == "" {
+			return fmt.Errorf("irr filter name is missing")
+		}
+		if filter.Config.AsSet == "" {
+			return fmt.Errorf("irr filter %s needs as-set", filter.Config.Name)
+		}
+		if filter.Config.Ipv4PrefixSet == "" && filter.Config.Ipv6PrefixSet == "" && filter.Config.AsPathSet == "" {
+			return fmt.Errorf("irr filter %s needs any of ipv4-prefix-set, ipv6-prefix-set and as-path-set", filter.Config.Name)
+		}
+		if filter.Config.Ipv4MaxLength > 32 || filter.Config.Ipv6MaxLength > 128 {
+			return fmt.Errorf("irr filter %s has invalid max-length", filter.Config.Name)
+		}
+		if filter.Config.ServerAddress == "" {
+			filter.Config.ServerAddress = DEFAULT_IRR_SERVER_ADDRESS
+		}
+		if filter.Config.RefreshInterval == 0 {
+			filter.Config.RefreshInterval = DEFAULT_IRR_REFRESH_INTERVAL
+		}
+		if filter.Config.MaxPrefixes == 0 {
+			filter.Config.MaxPrefixes = DEFAULT_IRR_MAX_PREFIXES
+		}
+		if filter.Config.MaxAsns == 0 {
+			filter.Config.MaxAsns = DEFAULT_IRR_MAX_ASNS
+		}
+		b.IrrFilters[idx] = filter
+	}
+
+	aggregates := make(map[string]struct{})
+	for idx, aggregate := range b.Aggregates {
+		_, prefix, err := net.ParseCIDR(aggregate.Config.Prefix)
+		if err != nil {
+			return fmt.Errorf("invalid aggregate prefix: %s", aggregate.Config.Prefix)
+		}
+		aggregate.Config.Prefix = prefix.String()
+		if _, y := aggregates[aggregate.Config.Prefix]; y {
+			return fmt.Errorf("duplicate aggregate prefix: %s", aggregate.Config.Prefix)
+		}
+		aggregates[aggregate.Config.Prefix] = struct{}{}
+		for _, n := range aggregate.Config.SuppressNeighborList {
+			if net.ParseIP(n) == nil {
+				return fmt.Errorf("invalid neighbor address of aggregate %s: %s", aggregate.Config.Prefix, n)
+			}
+		}
+		b.Aggregates[idx] = aggregate
+	}
+
 	vrfNames := make(map[string]struct{})
 	vrfIDs := make(map[uint32]struct{})
 	for idx, vrf := range b.Vrfs {
@@ -404,6 +732,99 @@ func setDefaultConfigValuesWithViper(v *viper.Viper, b *BgpConfigSet) error {
 		}
 		b.Vrfs[idx] = vrf
 	}
+	// Derive RDs after the identifiers are assigned
+	rds := make(map[string]string)
+	for idx, vrf := range b.Vrfs {
+		if vrf.Config.Rd == "" {
+			rd, err := deriveVrfRd(&vrf.Config, &b.Global.Config)
+			if err != nil {
+				return err
+			}
+			vrf.Config.Rd = rd
+		}
+		rd, err := bgp.ParseRouteDistinguisher(vrf.Config.Rd)
+		if err != nil {
+			return fmt.Errorf("invalid rd for vrf %s: %s", vrf.Config.Name, vrf.Config.Rd)
+		}
+		if name, ok := rds[rd.String()]; ok {
+			return fmt.Errorf("duplicated rd %s of vrf %s and %s", rd, name, vrf.Config.Name)
+		}
+		rds[rd.String()] = vrf.Config.Name
+		b.Vrfs[idx] = vrf
+	}
+
+	leaks := make(map[string]struct{})
+	for idx, leak := range b.VrfLeaks {
+		c := &leak.Config
+		if c.Name == "" {
+			return fmt.Errorf("vrf leak name is empty")
+		}
+		if _, y := leaks[c.Name]; y {
+			return fmt.Errorf("duplicate vrf leak name: %s", c.Name)
+		}
+		leaks[c.Name] = struct{}{}
+		for _, name := range []string{c.SourceVrf, c.DestinationVrf} {
+			if _, y := vrfNames[name]; name != "" && !y {
+				return fmt.Errorf("vrf %s of vrf leak %s isn't found", name, c.Name)
+			}
+		}
+		if c.SourceVrf == c.DestinationVrf {
+			return fmt.Errorf("vrf leak %s has the same source and destination", c.Name)
+		}
+		if c.DefaultPolicy == "" {
+			c.DefaultPolicy = DEFAULT_POLICY_TYPE_ACCEPT_ROUTE
+		}
+		b.VrfLeaks[idx] = leak
+	}
+
+	vantagePoints := make(map[string]struct{})
+	for idx, vp := range b.OrrVantagePoints {
+		c := &vp.Config
+		if c.Name == "" {
+			return fmt.Errorf("orr vantage point name is empty")
+		}
+		if _, y := vantagePoints[c.Name]; y {
+			return fmt.Errorf("duplicate orr vantage point name: %s", c.Name)
+		}
+		vantagePoints[c.Name] = struct{}{}
+		prefixes := make(map[string]struct{})
+		for _, cost := range c.IgpCostList {
+			_, prefix, err := net.ParseCIDR(cost.Prefix)
+			if err != nil {
+				return fmt.Errorf("invalid igp cost prefix of orr vantage point %s: %v", c.Name, err)
+			}
+			if _, y := prefixes[prefix.String()]; y {
+				return fmt.Errorf("duplicate igp cost prefix of orr vantage point %s: %s", c.Name, cost.Prefix)
+			}
+			prefixes[prefix.String()] = struct{}{}
+		}
+		vp.State.Name = c.Name
+		vp.State.IgpCostList = c.IgpCostList
+		b.OrrVantagePoints[idx] = vp
+	}
+
+	vpws := make(map[string]struct{})
+	for _, v := range b.EvpnVpws {
+		c := &v.Config
+		if c.Name == "" {
+			return fmt.Errorf("evpn vpws name is empty")
+		}
+		if _, y := vpws[c.Name]; y {
+			return fmt.Errorf("duplicate evpn vpws name: %s", c.Name)
+		}
+		vpws[c.Name] = struct{}{}
+		if _, err := bgp.ParseRouteDistinguisher(c.Rd); err != nil {
+			return fmt.Errorf("invalid rd of evpn vpws %s: %v", c.Name, err)
+		}
+		for _, rt := range c.RouteTargetList {
+			if _, err := bgp.ParseRouteTarget(rt); err != nil {
+				return fmt.Errorf("invalid route target of evpn vpws %s: %v", c.Name, err)
+			}
+		}
+		if _, err := bgp.ParseEthernetSegmentIdentifier(strings.Fields(c.Esi)); err != nil {
+			return fmt.Errorf("invalid esi of evpn vpws %s: %v", c.Name, err)
+		}
+	}
 
 	if b.Zebra.Config.Url == "" {
 		b.Zebra.Config.Url = "unix:/var/run/quagga/zserv.api"
@@ -421,6 +842,36 @@ func setDefaultConfigValuesWithViper(v *viper.Viper, b *BgpConfigSet) error {
 		b.Zebra.Config.NexthopTriggerDelay = 5
 	}
 
+	if len(b.Netlink.Config.FamilyList) == 0 {
+		b.Netlink.Config.FamilyList = []string{string(AFI_SAFI_TYPE_IPV4_UNICAST), string(AFI_SAFI_TYPE_IPV6_UNICAST)}
+	}
+	if b.Netlink.Config.TableId == 0 {
+		b.Netlink.Config.TableId = 254
+	}
+	if b.Netlink.Config.Protocol == 0 {
+		b.Netlink.Config.Protocol = 186
+	}
+	if b.Netlink.Config.ReconcileTime == 0 {
+		b.Netlink.Config.ReconcileTime = 60
+	}
+
+	if b.Peeringdb.Config.Url == "" {
+		b.Peeringdb.Config.Url = DEFAULT_PEERINGDB_URL
+	}
+	if b.Peeringdb.Config.RefreshInterval == 0 {
+		b.Peeringdb.Config.RefreshInterval = DEFAULT_PEERINGDB_REFRESH_INTERVAL
+	}
+	if b.Peeringdb.Config.Multiplier == 0 {
+		b.Peeringdb.Config.Multiplier = DEFAULT_PEERINGDB_MULTIPLIER
+	} else if b.Peeringdb.Config.Multiplier < 1 {
+		return fmt.Errorf("peeringdb multiplier must be 1 or more: %v", b.Peeringdb.Config.Multiplier)
+	}
+	if b.Peeringdb.Config.WarningThresholdPct == 0 {
+		b.Peeringdb.Config.WarningThresholdPct = DEFAULT_PEERINGDB_WARNING_THRESHOLD_PCT
+	} else if b.Peeringdb.Config.WarningThresholdPct > 100 {
+		return fmt.Errorf("peeringdb warning-threshold-pct must be 100 or less: %d", b.Peeringdb.Config.WarningThresholdPct)
+	}
+
 	list, err := extractArray(v.Get("neighbors"))
 	if err != nil {
 		return err
@@ -437,12 +888,13 @@ func setDefaultConfigValuesWithViper(v *viper.Viper, b *BgpConfigSet) error {
 			return nil
 		}
 
-		if pg != nil {
-			identifier := vv.Get("neighbor.config.neighbor-address")
-			if identifier == nil {
-				identifier = vv.Get("neighbor.config.neighbor-interface")
-			}
-			RegisterConfiguredFields(identifier.(string), list[idx])
+		// also used to tell where the effective values come from
+		identifier := vv.Get("neighbor.config.neighbor-address")
+		if identifier == nil {
+			identifier = vv.Get("neighbor.config.neighbor-interface")
+		}
+		if addr, ok := identifier.(string); ok {
+			RegisterConfiguredFields(addr, list[idx])
 		}
 
 		if err := setDefaultNeighborConfigValuesWithViper(vv, &n, &b.Global, pg); err != nil {
@@ -451,6 +903,12 @@ func setDefaultConfigValuesWithViper(v *viper.Viper, b *BgpConfigSet) error {
 		b.Neighbors[idx] = n
 	}
 
+	for _, n := range b.Neighbors {
+		if _, y := vantagePoints[n.Config.OrrVantagePoint]; n.Config.OrrVantagePoint != "" && !y {
+			return fmt.Errorf("orr vantage point %s of neighbor %s isn't found", n.Config.OrrVantagePoint, n.State.NeighborAddress)
+		}
+	}
+
 	for _, d := range b.DynamicNeighbors {
 		if err := d.validate(b); err != nil {
 			return err
@@ -458,8 +916,21 @@ func setDefaultConfigValuesWithViper(v *viper.Viper, b *BgpConfigSet) error {
 	}
 
 	for idx, r := range b.RpkiServers {
+		if r.Config.Transport == "" {
+			b.RpkiServers[idx].Config.Transport = RPKI_TRANSPORT_TYPE_TCP
+		}
+		if err := b.RpkiServers[idx].Config.Transport.Validate(); err != nil {
+			return err
+		}
 		if r.Config.Port == 0 {
-			b.RpkiServers[idx].Config.Port = rtr.RPKI_DEFAULT_PORT
+			switch b.RpkiServers[idx].Config.Transport {
+			case RPKI_TRANSPORT_TYPE_TLS:
+				b.RpkiServers[idx].Config.Port = rtr.RPKI_DEFAULT_TLS_PORT
+			case RPKI_TRANSPORT_TYPE_SSH:
+				b.RpkiServers[idx].Config.Port = rtr.RPKI_DEFAULT_SSH_PORT
+			default:
+				b.RpkiServers[idx].Config.Port = rtr.RPKI_DEFAULT_PORT
+			}
 		}
 	}
 
@@ -482,7 +953,7 @@ func setDefaultConfigValuesWithViper(v *viper.Viper, b *BgpConfigSet) error {
 	return nil
 }
 
-func OverwriteNeighborConfigWithPeerGroup(c *Neighbor, pg *PeerGroup) error {
+func neighborConfiguredFields(c *Neighbor) *viper.Viper {
 	v := viper.New()
 
 	val, ok := configuredFields[c.Config.NeighborAddress]
@@ -491,6 +962,11 @@ func OverwriteNeighborConfigWithPeerGroup(c *Neighbor, pg *PeerGroup) error {
 	} else {
 		v.Set("neighbor.config.peer-group", c.Config.PeerGroup)
 	}
+	return v
+}
+
+func OverwriteNeighborConfigWithPeerGroup(c *Neighbor, pg *PeerGroup) error {
+	v := neighborConfiguredFields(c)
 
 	overwriteConfig(&c.Config, &pg.Config, "neighbor.config", v)
 	overwriteConfig(&c.Timers.Config, &pg.Timers.Config, "neighbor.timers.config", v)
@@ -501,19 +977,34 @@ func OverwriteNeighborConfigWithPeerGroup(c *Neighbor, pg *PeerGroup) error {
 	overwriteConfig(&c.RouteReflector.Config, &pg.RouteReflector.Config, "neighbor.route-reflector.config", v)
 	overwriteConfig(&c.AsPathOptions.Config, &pg.AsPathOptions.Config, "neighbor.as-path-options.config", v)
 	overwriteConfig(&c.AddPaths.Config, &pg.AddPaths.Config, "neighbor.add-paths.config", v)
-	overwriteConfig(&c.GracefulRestart.Config, &pg.GracefulRestart.Config, "neighbor.gradeful-restart.config", v)
+	overwriteConfig(&c.GracefulRestart.Config, &pg.GracefulRestart.Config, "neighbor.graceful-restart.config", v)
 	overwriteConfig(&c.ApplyPolicy.Config, &pg.ApplyPolicy.Config, "neighbor.apply-policy.config", v)
 	overwriteConfig(&c.UseMultiplePaths.Config, &pg.UseMultiplePaths.Config, "neighbor.use-multiple-paths.config", v)
 	overwriteConfig(&c.RouteServer.Config, &pg.RouteServer.Config, "neighbor.route-server.config", v)
 	overwriteConfig(&c.TtlSecurity.Config, &pg.TtlSecurity.Config, "neighbor.ttl-security.config", v)
+	overwriteConfig(&c.UpdateRateLimit.Config, &pg.UpdateRateLimit.Config, "neighbor.update-rate-limit.config", v)
 
 	if !v.IsSet("neighbor.afi-safis") {
 		c.AfiSafis = append([]AfiSafi{}, pg.AfiSafis...)
 	}
+	if !v.IsSet("neighbor.apply-policy.conditional-advertisements") && len(c.ApplyPolicy.ConditionalAdvertisements) == 0 {
+		c.ApplyPolicy.ConditionalAdvertisements = append([]ConditionalAdvertisement{}, pg.ApplyPolicy.ConditionalAdvertisements...)
+	}
 
 	return nil
 }
 
+// isOverwrittenByPeerGroup returns true if the neighbor configuration tag
+// is inherited from the peer group.
+func isOverwrittenByPeerGroup(tag string, v *viper.Viper) bool {
+	for _, t := range forcedOverwrittenConfig {
+		if t == tag {
+			return true
+		}
+	}
+	return !v.IsSet(tag)
+}
+
 func overwriteConfig(c, pg interface{}, tagPrefix string, v *viper.Viper) {
 	nValue := reflect.Indirect(reflect.ValueOf(c))
 	nType := reflect.Indirect(nValue).Type()
@@ -523,15 +1014,151 @@ func overwriteConfig(c, pg interface{}, tagPrefix string, v *viper.Viper) {
 	for i := 0; i < pgType.NumField(); i++ {
 		field := pgType.Field(i).Name
 		tag := tagPrefix + "." + nType.Field(i).Tag.Get("mapstructure")
-		if func() bool {
-			for _, t := range forcedOverwrittenConfig {
-				if t == tag {
-					return true
+		if isOverwrittenByPeerGroup(tag, v) {
+			nValue.FieldByName(field).Set(pgValue.FieldByName(field))
+		}
+	}
+}
+
+// overwriteConfigWithDefaults sets the fields of c, which are neither
+// configured nor inherited from the peer group, to the values of d.
+func overwriteConfigWithDefaults(c, d interface{}, tagPrefix string, v *viper.Viper) {
+	nValue := reflect.ValueOf(c).Elem()
+	nType := nValue.Type()
+	dValue := reflect.ValueOf(d).Elem()
+
+	for i := 0; i < nType.NumField(); i++ {
+		tag := tagPrefix + "." + nType.Field(i).Tag.Get("mapstructure")
+		if !v.IsSet(tag) && nValue.Field(i).IsZero() {
+			nValue.Field(i).Set(dValue.Field(i))
+		}
+	}
+}
+
+func overwriteNeighborConfigWithPeerDefaults(c *Neighbor, d *PeerDefaults, v *viper.Viper) {
+	overwriteConfigWithDefaults(&c.Timers.Config, &d.Timers.Config, "neighbor.timers.config", v)
+	overwriteConfigWithDefaults(&c.Transport.Config, &d.Transport.Config, "neighbor.transport.config", v)
+	overwriteConfigWithDefaults(&c.EbgpMultihop.Config, &d.EbgpMultihop.Config, "neighbor.ebgp-multihop.config", v)
+	overwriteConfigWithDefaults(&c.GracefulRestart.Config, &d.GracefulRestart.Config, "neighbor.graceful-restart.config", v)
+	overwriteConfigWithDefaults(&c.TtlSecurity.Config, &d.TtlSecurity.Config, "neighbor.ttl-security.config", v)
+}
+
+// NeighborConfigOrigin is where the effective value of a neighbor
+// configuration comes from.
+type NeighborConfigOrigin int
+
+const (
+	NEIGHBOR_CONFIG_ORIGIN_NEIGHBOR NeighborConfigOrigin = iota
+	NEIGHBOR_CONFIG_ORIGIN_PEER_GROUP
+	NEIGHBOR_CONFIG_ORIGIN_PEER_DEFAULTS
+	// the built-in default or a value derived from the other ones
+	NEIGHBOR_CONFIG_ORIGIN_DEFAULT
+)
+
+func (o NeighborConfigOrigin) String() string {
+	switch o {
+	case NEIGHBOR_CONFIG_ORIGIN_NEIGHBOR:
+		return "neighbor"
+	case NEIGHBOR_CONFIG_ORIGIN_PEER_GROUP:
+		return "peer-group"
+	case NEIGHBOR_CONFIG_ORIGIN_PEER_DEFAULTS:
+		return "peer-defaults"
+	case NEIGHBOR_CONFIG_ORIGIN_DEFAULT:
+		return "default"
+	}
+	return fmt.Sprintf("NeighborConfigOrigin(%d)", o)
+}
+
+// NeighborConfigSource is the effective value of a neighbor configuration
+// leaf and where it comes from.
+type NeighborConfigSource struct {
+	// e.g. "timers.config.hold-time"
+	Path   string
+	Value  interface{}
+	Origin NeighborConfigOrigin
+}
+
+// the sections a neighbor inherits from its peer group
+var neighborConfigSections = []struct {
+	field string
+	path  string
+}{
+	{"", "config"},
+	{"Timers", "timers.config"},
+	{"Transport", "transport.config"},
+	{"ErrorHandling", "error-handling.config"},
+	{"LoggingOptions", "logging-options.config"},
+	{"EbgpMultihop", "ebgp-multihop.config"},
+	{"RouteReflector", "route-reflector.config"},
+	{"AsPathOptions", "as-path-options.config"},
+	{"AddPaths", "add-paths.config"},
+	{"GracefulRestart", "graceful-restart.config"},
+	{"ApplyPolicy", "apply-policy.config"},
+	{"UseMultiplePaths", "use-multiple-paths.config"},
+	{"RouteServer", "route-server.config"},
+	{"TtlSecurity", "ttl-security.config"},
+	{"UpdateRateLimit", "update-rate-limit.config"},
+}
+
+func configSection(v reflect.Value, field string) reflect.Value {
+	if field != "" {
+		v = v.FieldByName(field)
+		if !v.IsValid() {
+			return v
+		}
+	}
+	return v.FieldByName("Config")
+}
+
+// NeighborConfigSources returns where the effective values of n, the
+// neighbor configuration after SetDefaultNeighborConfigValues, come from.
+// raw is the neighbor configuration before it. The leaves without values
+// are omitted.
+func NeighborConfigSources(raw, n *Neighbor, pg *PeerGroup, g *Global) []NeighborConfigSource {
+	v := neighborConfiguredFields(raw)
+	_, configured := configuredFields[raw.Config.NeighborAddress]
+
+	l := make([]NeighborConfigSource, 0)
+	for _, s := range neighborConfigSections {
+		nValue := configSection(reflect.ValueOf(n).Elem(), s.field)
+		rawValue := configSection(reflect.ValueOf(raw).Elem(), s.field)
+		var pgValue reflect.Value
+		if pg != nil {
+			pgValue = configSection(reflect.ValueOf(pg).Elem(), s.field)
+		}
+		dValue := configSection(reflect.ValueOf(&g.PeerDefaults).Elem(), s.field)
+
+		nType := nValue.Type()
+		for i := 0; i < nType.NumField(); i++ {
+			value := nValue.Field(i)
+			if value.IsZero() {
+				continue
+			}
+			name := nType.Field(i).Tag.Get("mapstructure")
+			tag := "neighbor." + s.path + "." + name
+
+			// the value before the defaults are set
+			var inherited reflect.Value
+			origin := NEIGHBOR_CONFIG_ORIGIN_DEFAULT
+			if pg != nil && i < pgValue.NumField() && isOverwrittenByPeerGroup(tag, v) {
+				inherited = pgValue.Field(i)
+				origin = NEIGHBOR_CONFIG_ORIGIN_PEER_GROUP
+			} else if (configured && v.IsSet(tag)) || (!configured && !rawValue.Field(i).IsZero()) {
+				inherited = rawValue.Field(i)
+				origin = NEIGHBOR_CONFIG_ORIGIN_NEIGHBOR
+			}
+			if !inherited.IsValid() || !reflect.DeepEqual(inherited.Interface(), value.Interface()) {
+				origin = NEIGHBOR_CONFIG_ORIGIN_DEFAULT
+				if dValue.IsValid() && reflect.DeepEqual(dValue.Field(i).Interface(), value.Interface()) {
+					origin = NEIGHBOR_CONFIG_ORIGIN_PEER_DEFAULTS
 				}
 			}
-			return false
-		}() || !v.IsSet(tag) {
-			nValue.FieldByName(field).Set(pgValue.FieldByName(field))
+			l = append(l, NeighborConfigSource{
+				Path:   s.path + "." + name,
+				Value:  value.Interface(),
+				Origin: origin,
+			})
 		}
 	}
+	return l
 }
@@ -20,39 +20,95 @@ package oc
 import (
 	"fmt"
 	"net"
+	"os"
 
 	"github.com/vishvananda/netlink"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv6"
 )
 
+// GetIPv6LinkLocalNeighborAddress returns the link-local address of the
+// neighbor on the point-to-point interface. When the neighbor isn't in the
+// neighbor table, it's solicited and an error is returned without waiting for
+// the reply; the caller tries again later.
 func GetIPv6LinkLocalNeighborAddress(ifname string) (string, error) {
 	ifi, err := net.InterfaceByName(ifname)
 	if err != nil {
 		return "", err
 	}
-	neighs, err := netlink.NeighList(ifi.Index, netlink.FAMILY_V6)
+	return ipv6LinkLocalNeighborAddress(ifi, ipv6LinkLocalNeighbors, probeIPv6LinkLocalNeighbors)
+}
+
+func ipv6LinkLocalNeighborAddress(ifi *net.Interface, neighbors func(*net.Interface) ([]net.IP, error), probe func(*net.Interface) error) (string, error) {
+	addrs, err := neighbors(ifi)
 	if err != nil {
 		return "", err
 	}
-	cnt := 0
-	var addr net.IP
+	switch len(addrs) {
+	case 0:
+		// The neighbor isn't in the neighbor table yet. Solicit it
+		// with an echo request to all-nodes; the reply adds it to the
+		// table for the next try.
+		if err := probe(ifi); err != nil {
+			return "", fmt.Errorf("failed to probe ipv6 link-local neighbor on %s: %w", ifi.Name, err)
+		}
+		return "", fmt.Errorf("no ipv6 link-local neighbor found on %s", ifi.Name)
+	case 1:
+		return fmt.Sprintf("%s%%%s", addrs[0], ifi.Name), nil
+	}
+	return "", fmt.Errorf("found %d link-local neighbors. only support p2p link", len(addrs))
+}
+
+func ipv6LinkLocalNeighbors(ifi *net.Interface) ([]net.IP, error) {
+	neighs, err := netlink.NeighList(ifi.Index, netlink.FAMILY_V6)
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]net.IP, 0, len(neighs))
 	for _, neigh := range neighs {
 		local, err := isLocalLinkLocalAddress(ifi.Index, neigh.IP)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		if neigh.State&netlink.NUD_FAILED == 0 && neigh.IP.IsLinkLocalUnicast() && !local {
-			addr = neigh.IP
-			cnt++
+			addrs = append(addrs, neigh.IP)
 		}
 	}
+	return addrs, nil
+}
 
-	if cnt == 0 {
-		return "", fmt.Errorf("no ipv6 link-local neighbor found")
-	} else if cnt > 1 {
-		return "", fmt.Errorf("found %d link-local neighbors. only support p2p link", cnt)
+// probeIPv6LinkLocalNeighbors sends an ICMPv6 echo request to the
+// link-local all-nodes address on the interface. The neighbor's reply
+// makes the kernel add its link-local address to the neighbor table.
+// The unprivileged ICMP socket is used if net.ipv4.ping_group_range allows
+// it, and the raw socket, which needs CAP_NET_RAW, otherwise.
+func probeIPv6LinkLocalNeighbors(ifi *net.Interface) error {
+	msg := icmp.Message{
+		Type: ipv6.ICMPTypeEchoRequest,
+		Body: &icmp.Echo{
+			ID:   os.Getpid() & 0xffff,
+			Seq:  1,
+			Data: []byte("gobgp"),
+		},
+	}
+	b, err := msg.Marshal(nil)
+	if err != nil {
+		return err
 	}
 
-	return fmt.Sprintf("%s%%%s", addr, ifname), nil
+	conn, err := icmp.ListenPacket("udp6", "::")
+	if err == nil {
+		defer conn.Close()
+		_, err = conn.WriteTo(b, &net.UDPAddr{IP: net.IPv6linklocalallnodes, Zone: ifi.Name})
+		return err
+	}
+	conn, rawErr := icmp.ListenPacket("ip6:ipv6-icmp", "::")
+	if rawErr != nil {
+		return fmt.Errorf("%v, %v", err, rawErr)
+	}
+	defer conn.Close()
+	_, err = conn.WriteTo(b, &net.IPAddr{IP: net.IPv6linklocalallnodes, Zone: ifi.Name})
+	return err
 }
 
 func isLocalLinkLocalAddress(ifindex int, addr net.IP) (bool, error) {
@@ -0,0 +1,71 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//go:build linux
+// +build linux
+
+package oc
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPv6LinkLocalNeighborAddress(t *testing.T) {
+	assert := assert.New(t)
+	ifi := &net.Interface{Index: 2, Name: "eth0"}
+	neighbors := func(addrs ...string) func(*net.Interface) ([]net.IP, error) {
+		return func(*net.Interface) ([]net.IP, error) {
+			l := make([]net.IP, 0, len(addrs))
+			for _, a := range addrs {
+				l = append(l, net.ParseIP(a))
+			}
+			return l, nil
+		}
+	}
+	probed := 0
+	probe := func(err error) func(*net.Interface) error {
+		return func(*net.Interface) error {
+			probed++
+			return err
+		}
+	}
+
+	addr, err := ipv6LinkLocalNeighborAddress(ifi, neighbors("fe80::1"), probe(nil))
+	assert.NoError(err)
+	assert.Equal("fe80::1%eth0", addr)
+	assert.Equal(0, probed)
+
+	// the neighbor is solicited without waiting for the reply
+	_, err = ipv6LinkLocalNeighborAddress(ifi, neighbors(), probe(nil))
+	assert.EqualError(err, "no ipv6 link-local neighbor found on eth0")
+	assert.Equal(1, probed)
+
+	// the error of the probe is returned as it is
+	probeErr := errors.New("socket: operation not permitted")
+	_, err = ipv6LinkLocalNeighborAddress(ifi, neighbors(), probe(probeErr))
+	assert.ErrorIs(err, probeErr)
+	assert.Equal(2, probed)
+
+	_, err = ipv6LinkLocalNeighborAddress(ifi, neighbors("fe80::1", "fe80::2"), probe(nil))
+	assert.Error(err)
+	assert.Equal(2, probed)
+
+	neighErr := errors.New("netlink error")
+	_, err = ipv6LinkLocalNeighborAddress(ifi, func(*net.Interface) ([]net.IP, error) { return nil, neighErr }, probe(nil))
+	assert.ErrorIs(err, neighErr)
+}
@@ -0,0 +1,386 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeerDefaults(t *testing.T) {
+	assert := assert.New(t)
+
+	conf := `
+[global.config]
+  as = 65000
+  router-id = "10.0.0.1"
+[global.peer-defaults.timers.config]
+  hold-time = 30
+  connect-retry = 10
+[global.peer-defaults.transport.config]
+  passive-mode = true
+
+[[peer-groups]]
+  [peer-groups.config]
+    peer-group-name = "pg"
+    peer-as = 65001
+  [peer-groups.timers.config]
+    hold-time = 60
+
+[[neighbors]]
+  [neighbors.config]
+    neighbor-address = "10.0.0.2"
+    peer-as = 65002
+  [neighbors.timers.config]
+    connect-retry = 5
+  [neighbors.transport.config]
+    passive-mode = false
+
+[[neighbors]]
+  [neighbors.config]
+    neighbor-address = "10.0.0.3"
+    peer-group = "pg"
+`
+	v := viper.New()
+	v.SetConfigType("toml")
+	assert.NoError(v.ReadConfig(strings.NewReader(conf)))
+	c := &BgpConfigSet{}
+	assert.NoError(v.UnmarshalExact(c))
+	assert.NoError(setDefaultConfigValuesWithViper(v, c))
+
+	n := c.Neighbors[0]
+	// from the peer defaults
+	assert.Equal(float64(30), n.Timers.Config.HoldTime)
+	// from the neighbor
+	assert.Equal(float64(5), n.Timers.Config.ConnectRetry)
+	assert.False(n.Transport.Config.PassiveMode)
+
+	n = c.Neighbors[1]
+	// from the peer group
+	assert.Equal(float64(60), n.Timers.Config.HoldTime)
+	// from the peer defaults
+	assert.Equal(float64(10), n.Timers.Config.ConnectRetry)
+	assert.True(n.Transport.Config.PassiveMode)
+
+	raw := Neighbor{
+		Config: NeighborConfig{
+			NeighborAddress: "10.0.0.3",
+			PeerGroup:       "pg",
+		},
+	}
+	origins := make(map[string]NeighborConfigOrigin)
+	for _, s := range NeighborConfigSources(&raw, &n, &c.PeerGroups[0], &c.Global) {
+		origins[s.Path] = s.Origin
+	}
+	assert.Equal(NEIGHBOR_CONFIG_ORIGIN_NEIGHBOR, origins["config.neighbor-address"])
+	assert.Equal(NEIGHBOR_CONFIG_ORIGIN_PEER_GROUP, origins["config.peer-as"])
+	assert.Equal(NEIGHBOR_CONFIG_ORIGIN_PEER_GROUP, origins["timers.config.hold-time"])
+	assert.Equal(NEIGHBOR_CONFIG_ORIGIN_PEER_DEFAULTS, origins["timers.config.connect-retry"])
+	assert.Equal(NEIGHBOR_CONFIG_ORIGIN_PEER_DEFAULTS, origins["transport.config.passive-mode"])
+	assert.Equal(NEIGHBOR_CONFIG_ORIGIN_DEFAULT, origins["timers.config.keepalive-interval"])
+	assert.Equal(NEIGHBOR_CONFIG_ORIGIN_DEFAULT, origins["config.local-as"])
+}
+
+func TestTtlSecurityDefaults(t *testing.T) {
+	assert := assert.New(t)
+
+	g := &Global{Config: GlobalConfig{As: 65000, RouterId: "10.0.0.1"}}
+	newNeighbor := func(multihopTtl, ttlMin, ttl uint8) *Neighbor {
+		return &Neighbor{
+			Config: NeighborConfig{
+				NeighborAddress: "10.0.0.2",
+				PeerAs:          65001,
+			},
+			EbgpMultihop: EbgpMultihop{Config: EbgpMultihopConfig{Enabled: multihopTtl != 0, MultihopTtl: multihopTtl}},
+			TtlSecurity:  TtlSecurity{Config: TtlSecurityConfig{Enabled: true, TtlMin: ttlMin}},
+			Transport:    Transport{Config: TransportConfig{Ttl: ttl}},
+		}
+	}
+
+	n := newNeighbor(0, 0, 0)
+	assert.NoError(SetDefaultNeighborConfigValues(n, nil, g))
+	assert.Equal(uint8(255), n.TtlSecurity.Config.TtlMin)
+
+	// the neighbor 3 hops away
+	n = newNeighbor(3, 0, 0)
+	assert.NoError(SetDefaultNeighborConfigValues(n, nil, g))
+	assert.Equal(uint8(253), n.TtlSecurity.Config.TtlMin)
+
+	n = newNeighbor(0, 250, 0)
+	n.EbgpMultihop.Config.Enabled = true
+	assert.NoError(SetDefaultNeighborConfigValues(n, nil, g))
+	assert.Equal(uint8(6), n.EbgpMultihop.Config.MultihopTtl)
+
+	n = newNeighbor(0, 0, 0)
+	n.EbgpMultihop.Config.Enabled = true
+	assert.Error(SetDefaultNeighborConfigValues(n, nil, g))
+
+	n = newNeighbor(3, 250, 0)
+	assert.NoError(SetDefaultNeighborConfigValues(n, nil, g))
+
+	// ttl-min 255 rejects the neighbor 3 hops away
+	n = newNeighbor(3, 255, 0)
+	assert.Error(SetDefaultNeighborConfigValues(n, nil, g))
+
+	n = newNeighbor(0, 0, 64)
+	assert.Error(SetDefaultNeighborConfigValues(n, nil, g))
+}
+
+func TestMinimumAdvertisementIntervalDefaults(t *testing.T) {
+	assert := assert.New(t)
+
+	g := &Global{Config: GlobalConfig{As: 65000, RouterId: "10.0.0.1"}}
+	newNeighbor := func(interval float64) *Neighbor {
+		return &Neighbor{
+			Config: NeighborConfig{
+				NeighborAddress: "10.0.0.2",
+				PeerAs:          65001,
+			},
+			Timers: Timers{Config: TimersConfig{MinimumAdvertisementInterval: 30}},
+			AfiSafis: []AfiSafi{
+				{Config: AfiSafiConfig{AfiSafiName: AFI_SAFI_TYPE_IPV4_UNICAST}},
+				{
+					Config:                       AfiSafiConfig{AfiSafiName: AFI_SAFI_TYPE_IPV6_UNICAST},
+					MinimumAdvertisementInterval: MinimumAdvertisementInterval{Config: MinimumAdvertisementIntervalConfig{Interval: interval}},
+				},
+			},
+		}
+	}
+
+	n := newNeighbor(0.5)
+	assert.NoError(SetDefaultNeighborConfigValues(n, nil, g))
+	// the timer of the neighbor if not configured for the family
+	assert.Equal(float64(30), n.AfiSafis[0].MinimumAdvertisementInterval.State.Interval)
+	assert.Equal(0.5, n.AfiSafis[1].MinimumAdvertisementInterval.State.Interval)
+
+	assert.Error(SetDefaultNeighborConfigValues(newNeighbor(-1), nil, g))
+}
+
+func TestConnectionModeDefaults(t *testing.T) {
+	assert := assert.New(t)
+
+	g := &Global{Config: GlobalConfig{As: 65000, RouterId: "10.0.0.1"}}
+	newNeighbor := func(jitter float64, active, passive bool) *Neighbor {
+		return &Neighbor{
+			Config: NeighborConfig{
+				NeighborAddress: "10.0.0.2",
+				PeerAs:          65001,
+			},
+			Timers:    Timers{Config: TimersConfig{ConnectRetryJitter: jitter}},
+			Transport: Transport{Config: TransportConfig{ActiveMode: active, PassiveMode: passive}},
+		}
+	}
+
+	n := newNeighbor(0, true, false)
+	assert.NoError(SetDefaultNeighborConfigValues(n, nil, g))
+	assert.Equal(DEFAULT_CONNECT_RETRY_JITTER, n.Timers.Config.ConnectRetryJitter)
+
+	n = newNeighbor(0.5, false, true)
+	assert.NoError(SetDefaultNeighborConfigValues(n, nil, g))
+	assert.Equal(0.5, n.Timers.Config.ConnectRetryJitter)
+
+	assert.Error(SetDefaultNeighborConfigValues(newNeighbor(1.5, false, false), nil, g))
+	assert.Error(SetDefaultNeighborConfigValues(newNeighbor(0, true, true), nil, g))
+}
+
+func TestUnnumberedNeighborDefaults(t *testing.T) {
+	assert := assert.New(t)
+
+	g := &Global{Config: GlobalConfig{As: 65000, RouterId: "10.0.0.1"}}
+	newNeighbor := func() *Neighbor {
+		return &Neighbor{
+			Config: NeighborConfig{
+				NeighborInterface: "bgptest0",
+				PeerAs:            65001,
+			},
+			Transport: Transport{Config: TransportConfig{LocalAddress: "::"}},
+		}
+	}
+
+	// the neighbor not in the neighbor table yet is discovered later
+	n := newNeighbor()
+	assert.NoError(SetDefaultNeighborConfigValues(n, nil, g))
+	assert.Equal("", n.State.NeighborAddress)
+	assert.Len(n.AfiSafis, 2)
+
+	n = newNeighbor()
+	n.RouteServer.Config.RouteServerClient = true
+	assert.Error(SetDefaultNeighborConfigValues(n, nil, g))
+}
+
+func TestAddPathsSendModeDefaults(t *testing.T) {
+	assert := assert.New(t)
+
+	g := &Global{Config: GlobalConfig{As: 65000, RouterId: "10.0.0.1"}}
+	newNeighbor := func(mode AddPathsSendModeType, max uint8) *Neighbor {
+		return &Neighbor{
+			Config: NeighborConfig{
+				NeighborAddress: "10.0.0.2",
+				PeerAs:          65001,
+			},
+			AddPaths: AddPaths{Config: AddPathsConfig{SendMode: mode, SendMax: max}},
+		}
+	}
+
+	n := newNeighbor("", 1)
+	assert.NoError(SetDefaultNeighborConfigValues(n, nil, g))
+	assert.Equal(ADD_PATHS_SEND_MODE_TYPE_N_PATHS, n.AfiSafis[0].AddPaths.State.SendMode)
+
+	n = newNeighbor(ADD_PATHS_SEND_MODE_TYPE_BEST_EXTERNAL, 2)
+	assert.NoError(SetDefaultNeighborConfigValues(n, nil, g))
+	assert.Equal(ADD_PATHS_SEND_MODE_TYPE_BEST_EXTERNAL, n.AfiSafis[0].AddPaths.State.SendMode)
+
+	// the external path is never advertised with send-max 1
+	assert.Error(SetDefaultNeighborConfigValues(newNeighbor(ADD_PATHS_SEND_MODE_TYPE_BEST_EXTERNAL, 1), nil, g))
+}
+
+func TestLoopPreventionDefaults(t *testing.T) {
+	assert := assert.New(t)
+
+	g := &Global{Config: GlobalConfig{As: 65000, RouterId: "10.0.0.1"}}
+	newNeighbor := func(peerAs uint32) *Neighbor {
+		return &Neighbor{
+			Config: NeighborConfig{
+				NeighborAddress: "10.0.0.2",
+				PeerAs:          peerAs,
+			},
+			AsPathOptions: AsPathOptions{Config: AsPathOptionsConfig{AllowOwnAs: 1}},
+			AfiSafis: []AfiSafi{
+				{Config: AfiSafiConfig{AfiSafiName: AFI_SAFI_TYPE_IPV4_UNICAST}},
+				{
+					Config:         AfiSafiConfig{AfiSafiName: AFI_SAFI_TYPE_L3VPN_IPV4_UNICAST},
+					LoopPrevention: LoopPrevention{Config: LoopPreventionConfig{AllowOwnAs: 3, ReplacePeerAs: true}},
+				},
+			},
+		}
+	}
+
+	n := newNeighbor(65001)
+	assert.NoError(SetDefaultNeighborConfigValues(n, nil, g))
+	// the as-path-options of the neighbor if not configured for the family
+	assert.Equal(uint8(1), n.AfiSafis[0].LoopPrevention.State.AllowOwnAs)
+	assert.False(n.AfiSafis[0].LoopPrevention.State.ReplacePeerAs)
+	assert.Equal(uint8(3), n.AfiSafis[1].LoopPrevention.State.AllowOwnAs)
+	assert.True(n.AfiSafis[1].LoopPrevention.State.ReplacePeerAs)
+
+	assert.Error(SetDefaultNeighborConfigValues(newNeighbor(65000), nil, g))
+}
+
+func TestNextHopOptionsDefaults(t *testing.T) {
+	assert := assert.New(t)
+
+	g := &Global{Config: GlobalConfig{As: 65000, RouterId: "10.0.0.1"}}
+	newNeighbor := func(peerAs uint32, config NeighborConfig, options NextHopOptionsConfig) *Neighbor {
+		config.NeighborAddress = "10.0.0.2"
+		config.PeerAs = peerAs
+		return &Neighbor{
+			Config: config,
+			AfiSafis: []AfiSafi{
+				{Config: AfiSafiConfig{AfiSafiName: AFI_SAFI_TYPE_IPV4_UNICAST}},
+				{
+					Config:         AfiSafiConfig{AfiSafiName: AFI_SAFI_TYPE_L3VPN_IPV4_UNICAST},
+					NextHopOptions: NextHopOptions{Config: options},
+				},
+			},
+		}
+	}
+
+	// enabled for all the families with the neighbor config
+	n := newNeighbor(65000, NeighborConfig{NextHopSelf: true}, NextHopOptionsConfig{NextHopSelfForce: true})
+	assert.NoError(SetDefaultNeighborConfigValues(n, nil, g))
+	assert.Equal(NextHopOptionsState{NextHopSelf: true}, n.AfiSafis[0].NextHopOptions.State)
+	assert.Equal(NextHopOptionsState{NextHopSelf: true, NextHopSelfForce: true}, n.AfiSafis[1].NextHopOptions.State)
+
+	assert.Error(SetDefaultNeighborConfigValues(newNeighbor(65000, NeighborConfig{}, NextHopOptionsConfig{NextHopUnchanged: true}), nil, g))
+	assert.Error(SetDefaultNeighborConfigValues(newNeighbor(65001, NeighborConfig{NextHopSelf: true}, NextHopOptionsConfig{NextHopUnchanged: true}), nil, g))
+	assert.NoError(SetDefaultNeighborConfigValues(newNeighbor(65001, NeighborConfig{}, NextHopOptionsConfig{NextHopUnchanged: true}), nil, g))
+}
+
+func TestVrfRdDerivation(t *testing.T) {
+	assert := assert.New(t)
+
+	newConfig := func(vrfs ...VrfConfig) *BgpConfigSet {
+		b := &BgpConfigSet{
+			Global: Global{Config: GlobalConfig{As: 65000, RouterId: "10.0.0.1"}},
+		}
+		for _, v := range vrfs {
+			v.BothRtList = []string{"65000:1"}
+			b.Vrfs = append(b.Vrfs, Vrf{Config: v})
+		}
+		return b
+	}
+
+	b := newConfig(
+		VrfConfig{Name: "vrf1", Rd: "65000:1"},
+		VrfConfig{Name: "vrf2"},
+		VrfConfig{Name: "vrf3", RdIndex: 300, RouterId: "10.0.0.3"},
+		VrfConfig{Name: "vrf4", Id: 10, RdType: VRF_RD_TYPE_TWO_OCTET_AS},
+	)
+	assert.NoError(SetDefaultConfigValues(b))
+	rds := make([]string, 0, len(b.Vrfs))
+	for _, v := range b.Vrfs {
+		rds = append(rds, v.Config.Rd)
+	}
+	// the index is the id of the VRF if omitted
+	assert.Equal([]string{"65000:1", "10.0.0.1:2", "10.0.0.3:300", "65000:10"}, rds)
+
+	// collides with the configured one
+	b = newConfig(
+		VrfConfig{Name: "vrf1", Rd: "10.0.0.1:2"},
+		VrfConfig{Name: "vrf2", Id: 2},
+	)
+	err := SetDefaultConfigValues(b)
+	assert.Error(err)
+	assert.True(strings.Contains(err.Error(), "duplicated rd"))
+
+	b = newConfig(VrfConfig{Name: "vrf1", RdIndex: 70000})
+	assert.Error(SetDefaultConfigValues(b))
+
+	b = newConfig(VrfConfig{Name: "vrf1", RdType: VRF_RD_TYPE_TWO_OCTET_AS})
+	b.Global.Config.As = 4200000000
+	assert.Error(SetDefaultConfigValues(b))
+}
+
+func TestOrrVantagePointDefaults(t *testing.T) {
+	assert := assert.New(t)
+
+	newConfig := func(peerAs uint32, vantagePoint string, costs ...IgpCost) *BgpConfigSet {
+		return &BgpConfigSet{
+			Global: Global{Config: GlobalConfig{As: 65000, RouterId: "10.0.0.1"}},
+			Neighbors: []Neighbor{{
+				Config: NeighborConfig{NeighborAddress: "10.0.0.2", PeerAs: peerAs, OrrVantagePoint: vantagePoint},
+			}},
+			OrrVantagePoints: []OrrVantagePoint{{
+				Config: OrrVantagePointConfig{Name: "pop1", IgpCostList: costs},
+			}},
+		}
+	}
+
+	b := newConfig(65000, "pop1", IgpCost{Prefix: "10.0.1.0/24", Cost: 10})
+	assert.NoError(SetDefaultConfigValues(b))
+	assert.Equal(b.OrrVantagePoints[0].Config.IgpCostList, b.OrrVantagePoints[0].State.IgpCostList)
+
+	// unknown vantage point
+	assert.Error(SetDefaultConfigValues(newConfig(65000, "pop2")))
+	// eBGP neighbor
+	assert.Error(SetDefaultConfigValues(newConfig(65001, "pop1")))
+	// invalid and duplicate prefixes
+	assert.Error(SetDefaultConfigValues(newConfig(65000, "pop1", IgpCost{Prefix: "10.0.1.1"})))
+	assert.Error(SetDefaultConfigValues(newConfig(65000, "pop1", IgpCost{Prefix: "10.0.1.0/24"}, IgpCost{Prefix: "10.0.1.1/24"})))
+}
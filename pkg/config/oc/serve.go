@@ -1,6 +1,9 @@
 package oc
 
 import (
+	"bytes"
+	"os"
+
 	"github.com/fsnotify/fsnotify"
 	"github.com/spf13/viper"
 
@@ -12,10 +15,20 @@ type BgpConfigSet struct {
 	Neighbors         []Neighbor         `mapstructure:"neighbors"`
 	PeerGroups        []PeerGroup        `mapstructure:"peer-groups"`
 	RpkiServers       []RpkiServer       `mapstructure:"rpki-servers"`
+	RpkiSlurm         RpkiSlurm          `mapstructure:"rpki-slurm"`
 	BmpServers        []BmpServer        `mapstructure:"bmp-servers"`
+	KafkaExporters    []KafkaExporter    `mapstructure:"kafka-exporters"`
+	IrrFilters        []IrrFilter        `mapstructure:"irr-filters"`
+	Aggregates        []Aggregate        `mapstructure:"aggregates"`
 	Vrfs              []Vrf              `mapstructure:"vrfs"`
+	VrfLeaks          []VrfLeak          `mapstructure:"vrf-leaks"`
+	OrrVantagePoints  []OrrVantagePoint  `mapstructure:"orr-vantage-points"`
+	EvpnVpws          []EvpnVpwsInstance `mapstructure:"evpn-vpws"`
 	MrtDump           []Mrt              `mapstructure:"mrt-dump"`
 	Zebra             Zebra              `mapstructure:"zebra"`
+	Netlink           Netlink            `mapstructure:"netlink"`
+	Peeringdb         Peeringdb          `mapstructure:"peeringdb"`
+	Srv6              Srv6               `mapstructure:"srv6"`
 	Collector         Collector          `mapstructure:"collector"`
 	DefinedSets       DefinedSets        `mapstructure:"defined-sets"`
 	PolicyDefinitions []PolicyDefinition `mapstructure:"policy-definitions"`
@@ -26,12 +39,19 @@ func ReadConfigfile(path, format string) (*BgpConfigSet, error) {
 	// Update config file type, if detectable
 	format = detectConfigFileType(path, format)
 
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	// viper reports neither all the problems nor where they are
+	if errs := validateConfig(data, format); len(errs) > 0 {
+		return nil, &ConfigValidationError{Errors: errs}
+	}
+
 	config := &BgpConfigSet{}
 	v := viper.New()
-	v.SetConfigFile(path)
 	v.SetConfigType(format)
-	var err error
-	if err = v.ReadInConfig(); err != nil {
+	if err = v.ReadConfig(bytes.NewReader(data)); err != nil {
 		return nil, err
 	}
 	if err = v.UnmarshalExact(config); err != nil {
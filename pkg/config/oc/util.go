@@ -107,9 +107,13 @@ func (d *DynamicNeighbor) validate(b *BgpConfigSet) error {
 	if _, err := b.getPeerGroup(d.Config.PeerGroup); err != nil {
 		return err
 	}
-	if _, _, err := net.ParseCIDR(d.Config.Prefix); err != nil {
+	addr, _, err := net.ParseCIDR(d.Config.Prefix)
+	if err != nil {
 		return fmt.Errorf("invalid dynamic neighbor prefix %s", d.Config.Prefix)
 	}
+	if d.Config.Interface != "" && (addr.To4() != nil || !addr.IsLinkLocalUnicast()) {
+		return fmt.Errorf("dynamic neighbor prefix %s with interface %s must be IPv6 link-local", d.Config.Prefix, d.Config.Interface)
+	}
 	return nil
 }
 
@@ -189,7 +193,12 @@ func (c AfiSafis) ToRfList() ([]bgp.RouteFamily, error) {
 
 func inSlice(n Neighbor, b []Neighbor) int {
 	for i, nb := range b {
-		if nb.State.NeighborAddress == n.State.NeighborAddress {
+		// the address of the unnumbered neighbor may not be discovered
+		if n.Config.NeighborInterface != "" || nb.Config.NeighborInterface != "" {
+			if nb.Config.NeighborInterface == n.Config.NeighborInterface {
+				return i
+			}
+		} else if nb.State.NeighborAddress == n.State.NeighborAddress {
 			return i
 		}
 	}
@@ -205,6 +214,10 @@ func existPeerGroup(n string, b []PeerGroup) int {
 	return -1
 }
 
+func isSameAfiSafi(x, y *AfiSafi) bool {
+	return x.Config.Equal(&y.Config) && x.AddPaths.Config.Equal(&y.AddPaths.Config) && x.PrefixOrf.Config.Mode == y.PrefixOrf.Config.Mode && x.MpGracefulRestart.Config.Equal(&y.MpGracefulRestart.Config)
+}
+
 func isAfiSafiChanged(x, y []AfiSafi) bool {
 	if len(x) != len(y) {
 		return true
@@ -214,13 +227,41 @@ func isAfiSafiChanged(x, y []AfiSafi) bool {
 		m[string(e.Config.AfiSafiName)] = x[i]
 	}
 	for _, e := range y {
-		if v, ok := m[string(e.Config.AfiSafiName)]; !ok || !v.Config.Equal(&e.Config) || !v.AddPaths.Config.Equal(&e.AddPaths.Config) || !v.MpGracefulRestart.Config.Equal(&e.MpGracefulRestart.Config) {
+		if v, ok := m[string(e.Config.AfiSafiName)]; !ok || !isSameAfiSafi(&v, &e) {
 			return true
 		}
 	}
 	return false
 }
 
+// AfiSafiRevisions returns the address families added to x and removed
+// from x by y. ok is false if any of the families in both x and y is
+// changed in the way which needs to resend the OPEN message.
+func AfiSafiRevisions(x, y []AfiSafi) (added, removed []AfiSafi, ok bool) {
+	m := make(map[string]AfiSafi)
+	for i, e := range x {
+		m[string(e.Config.AfiSafiName)] = x[i]
+	}
+	for _, e := range y {
+		n := string(e.Config.AfiSafiName)
+		v, found := m[n]
+		if !found {
+			added = append(added, e)
+			continue
+		}
+		if !isSameAfiSafi(&v, &e) {
+			return nil, nil, false
+		}
+		delete(m, n)
+	}
+	for _, e := range x {
+		if _, found := m[string(e.Config.AfiSafiName)]; found {
+			removed = append(removed, e)
+		}
+	}
+	return added, removed, true
+}
+
 func (n *Neighbor) NeedsResendOpenMessage(new *Neighbor) bool {
 	return !n.Config.Equal(&new.Config) ||
 		!n.Transport.Config.Equal(&new.Transport.Config) ||
@@ -324,6 +365,14 @@ func newApplyPolicyFromConfigStruct(c *ApplyPolicy) *api.ApplyPolicy {
 	for _, pname := range c.Config.ExportPolicyList {
 		applyPolicy.ExportPolicy.Policies = append(applyPolicy.ExportPolicy.Policies, &api.Policy{Name: pname})
 	}
+	for _, ca := range c.ConditionalAdvertisements {
+		applyPolicy.ConditionalAdvertisements = append(applyPolicy.ConditionalAdvertisements, &api.ConditionalAdvertisement{
+			AdvertisePrefixSet: ca.Config.AdvertisePrefixSet,
+			ConditionPrefixSet: ca.Config.ConditionPrefixSet,
+			Condition:          api.ConditionalAdvertisement_Condition(ca.Config.Condition.ToInt()),
+			Advertised:         ca.State.Advertised,
+		})
+	}
 
 	return applyPolicy
 }
@@ -360,8 +409,64 @@ func newLongLivedGracefulRestartFromConfigStruct(c *LongLivedGracefulRestart) *a
 func newAddPathsFromConfigStruct(c *AddPaths) *api.AddPaths {
 	return &api.AddPaths{
 		Config: &api.AddPathsConfig{
-			Receive: c.Config.Receive,
-			SendMax: uint32(c.Config.SendMax),
+			Receive:  c.Config.Receive,
+			SendMax:  uint32(c.Config.SendMax),
+			SendMode: api.AddPathsConfig_SendMode(c.Config.SendMode.ToInt()),
+		},
+	}
+}
+
+func newPrefixOrfFromConfigStruct(c *PrefixOrf) *api.PrefixOrf {
+	return &api.PrefixOrf{
+		Config: &api.PrefixOrfConfig{
+			Mode:      api.PrefixOrfConfig_Mode(c.Config.Mode.ToInt()),
+			PrefixSet: c.Config.PrefixSet,
+		},
+		State: &api.PrefixOrfState{
+			Mode:            api.PrefixOrfConfig_Mode(c.State.Mode.ToInt()),
+			PrefixSet:       c.State.PrefixSet,
+			SentEntries:     c.State.SentEntries,
+			ReceivedEntries: c.State.ReceivedEntries,
+		},
+	}
+}
+
+func newNextHopOptionsFromConfigStruct(c *NextHopOptions) *api.NextHopOptions {
+	return &api.NextHopOptions{
+		Config: &api.NextHopOptionsConfig{
+			NextHopSelf:      c.Config.NextHopSelf,
+			NextHopSelfForce: c.Config.NextHopSelfForce,
+			NextHopUnchanged: c.Config.NextHopUnchanged,
+		},
+		State: &api.NextHopOptionsState{
+			NextHopSelf:      c.State.NextHopSelf,
+			NextHopSelfForce: c.State.NextHopSelfForce,
+			NextHopUnchanged: c.State.NextHopUnchanged,
+		},
+	}
+}
+
+func newLoopPreventionFromConfigStruct(c *LoopPrevention) *api.LoopPrevention {
+	return &api.LoopPrevention{
+		Config: &api.LoopPreventionConfig{
+			AllowOwnAsn:    uint32(c.Config.AllowOwnAs),
+			ReplacePeerAsn: c.Config.ReplacePeerAs,
+		},
+		State: &api.LoopPreventionState{
+			AllowOwnAsn:    uint32(c.State.AllowOwnAs),
+			ReplacePeerAsn: c.State.ReplacePeerAs,
+		},
+	}
+}
+
+func newMinimumAdvertisementIntervalFromConfigStruct(c *MinimumAdvertisementInterval) *api.MinimumAdvertisementInterval {
+	return &api.MinimumAdvertisementInterval{
+		Config: &api.MinimumAdvertisementIntervalConfig{
+			Interval: c.Config.Interval,
+		},
+		State: &api.MinimumAdvertisementIntervalState{
+			Interval:  c.State.Interval,
+			Coalesced: c.State.Coalesced,
 		},
 	}
 }
@@ -424,6 +529,11 @@ func newAfiSafiFromConfigStruct(c *AfiSafi) *api.AfiSafi {
 		RouteTargetMembership:    newRouteTargetMembershipFromConfigStruct(&c.RouteTargetMembership),
 		LongLivedGracefulRestart: newLongLivedGracefulRestartFromConfigStruct(&c.LongLivedGracefulRestart),
 		AddPaths:                 newAddPathsFromConfigStruct(&c.AddPaths),
+		PrefixOrf:                newPrefixOrfFromConfigStruct(&c.PrefixOrf),
+
+		MinimumAdvertisementInterval: newMinimumAdvertisementIntervalFromConfigStruct(&c.MinimumAdvertisementInterval),
+		LoopPrevention:               newLoopPreventionFromConfigStruct(&c.LoopPrevention),
+		NextHopOptions:               newNextHopOptionsFromConfigStruct(&c.NextHopOptions),
 	}
 }
 
@@ -481,6 +591,21 @@ func NewPeerFromConfigStruct(pconf *Neighbor) *api.Peer {
 			ReplacePeerAsn:      pconf.AsPathOptions.Config.ReplacePeerAs,
 			AdminDown:           pconf.Config.AdminDown,
 			SendSoftwareVersion: pconf.Config.SendSoftwareVersion,
+			FlowspecValidation:  pconf.Config.FlowspecValidation,
+			SendAigp:            pconf.Config.SendAigp,
+			ReceiveAigp:         pconf.Config.ReceiveAigp,
+			PeeringdbMaxPrefix:  pconf.Config.PeeringdbMaxPrefix,
+			DynamicCapability:   pconf.Config.DynamicCapability,
+			AuthPasswordSource:  pconf.Config.AuthPasswordSource,
+			DisableFourOctetAs:  pconf.Config.DisableFourOctetAs,
+			AcceptOwn:           pconf.Config.AcceptOwn,
+			LocalAsMigration:    pconf.Config.LocalAsMigration,
+			LocalAsNoPrepend:    pconf.Config.LocalAsNoPrepend,
+			LocalAsReplaceAs:    pconf.Config.LocalAsReplaceAs,
+			NextHopSelf:         pconf.Config.NextHopSelf,
+			NextHopSelfForce:    pconf.Config.NextHopSelfForce,
+			NextHopUnchanged:    pconf.Config.NextHopUnchanged,
+			OrrVantagePoint:     pconf.Config.OrrVantagePoint,
 		},
 		State: &api.PeerState{
 			SessionState: api.PeerState_SessionState(api.PeerState_SessionState_value[strings.ToUpper(string(s.SessionState))]),
@@ -511,11 +636,21 @@ func NewPeerFromConfigStruct(pconf *Neighbor) *api.Peer {
 			LocalAsn:        s.LocalAs,
 			Type:            api.PeerType(s.PeerType.ToInt()),
 			NeighborAddress: pconf.State.NeighborAddress,
-			Queues:          &api.Queues{},
-			RemoteCap:       remoteCap,
-			LocalCap:        localCap,
-			RouterId:        s.RemoteRouterId,
-			Flops:           s.Flops,
+			Queues: &api.Queues{
+				Input:  s.Queues.Input,
+				Output: s.Queues.Output,
+			},
+			RemoteCap: remoteCap,
+			LocalCap:  localCap,
+			RouterId:  s.RemoteRouterId,
+			Flops:     s.Flops,
+
+			ErroneousUpdateMessages: pconf.ErrorHandling.State.ErroneousUpdateMessages,
+			GracefulShutdown:        s.GracefulShutdown,
+			SlowPeer:                s.SlowPeer,
+			SlowPeerCount:           s.SlowPeerCount,
+			SlowPeerDropped:         s.SlowPeerDropped,
+			FourOctetAs:             s.FourOctetAs,
 		},
 		EbgpMultihop: &api.EbgpMultihop{
 			Enabled:     pconf.EbgpMultihop.Config.Enabled,
@@ -525,18 +660,29 @@ func NewPeerFromConfigStruct(pconf *Neighbor) *api.Peer {
 			Enabled: pconf.TtlSecurity.Config.Enabled,
 			TtlMin:  uint32(pconf.TtlSecurity.Config.TtlMin),
 		},
+		UpdateRateLimit: &api.UpdateRateLimit{
+			MessagesPerSecond: pconf.UpdateRateLimit.Config.MessagesPerSecond,
+			PrefixesPerSecond: pconf.UpdateRateLimit.Config.PrefixesPerSecond,
+		},
 		Timers: &api.Timers{
 			Config: &api.TimersConfig{
 				ConnectRetry:           uint64(timer.Config.ConnectRetry),
 				HoldTime:               uint64(timer.Config.HoldTime),
 				KeepaliveInterval:      uint64(timer.Config.KeepaliveInterval),
 				IdleHoldTimeAfterReset: uint64(timer.Config.IdleHoldTimeAfterReset),
+				IdleHoldTimeMin:        uint64(timer.Config.IdleHoldTimeMin),
+				IdleHoldTimeMax:        uint64(timer.Config.IdleHoldTimeMax),
+				IdleHoldTimeJitter:     timer.Config.IdleHoldTimeJitter,
+				ConnectRetryJitter:     timer.Config.ConnectRetryJitter,
 			},
 			State: &api.TimersState{
-				KeepaliveInterval:  uint64(timer.State.KeepaliveInterval),
-				NegotiatedHoldTime: uint64(timer.State.NegotiatedHoldTime),
-				Uptime:             ProtoTimestamp(timer.State.Uptime),
-				Downtime:           ProtoTimestamp(timer.State.Downtime),
+				KeepaliveInterval:       uint64(timer.State.KeepaliveInterval),
+				NegotiatedHoldTime:      uint64(timer.State.NegotiatedHoldTime),
+				Uptime:                  ProtoTimestamp(timer.State.Uptime),
+				Downtime:                ProtoTimestamp(timer.State.Downtime),
+				ConsecutiveFailures:     timer.State.ConsecutiveFailures,
+				IdleHoldTime:            timer.State.IdleHoldTime,
+				PeerDownConvergenceTime: timer.State.PeerDownConvergenceTime,
 			},
 		},
 		RouteReflector: &api.RouteReflector{
@@ -563,8 +709,12 @@ func NewPeerFromConfigStruct(pconf *Neighbor) *api.Peer {
 			LocalPort:     uint32(pconf.Transport.Config.LocalPort),
 			LocalAddress:  localAddress,
 			PassiveMode:   pconf.Transport.Config.PassiveMode,
+			ActiveMode:    pconf.Transport.Config.ActiveMode,
 			BindInterface: pconf.Transport.Config.BindInterface,
 			TcpMss:        uint32(pconf.Transport.Config.TcpMss),
+			Protocol:      api.Transport_Protocol(pconf.Transport.Config.TransportProtocol.ToInt()),
+			QuicCaFile:    pconf.Transport.Config.QuicCaFile,
+			Vrf:           pconf.Transport.Config.Vrf,
 		},
 		AfiSafis: afiSafis,
 	}
@@ -576,6 +726,7 @@ func NewPeerGroupFromConfigStruct(pconf *PeerGroup) *api.PeerGroup {
 		if afiSafi := newAfiSafiFromConfigStruct(&f); afiSafi != nil {
 			afiSafi.AddPaths.Config.Receive = pconf.AddPaths.Config.Receive
 			afiSafi.AddPaths.Config.SendMax = uint32(pconf.AddPaths.Config.SendMax)
+			afiSafi.AddPaths.Config.SendMode = api.AddPathsConfig_SendMode(pconf.AddPaths.Config.SendMode.ToInt())
 			afiSafis = append(afiSafis, afiSafi)
 		}
 	}
@@ -593,6 +744,21 @@ func NewPeerGroupFromConfigStruct(pconf *PeerGroup) *api.PeerGroup {
 			Description:         pconf.Config.Description,
 			PeerGroupName:       pconf.Config.PeerGroupName,
 			SendSoftwareVersion: pconf.Config.SendSoftwareVersion,
+			FlowspecValidation:  pconf.Config.FlowspecValidation,
+			SendAigp:            pconf.Config.SendAigp,
+			ReceiveAigp:         pconf.Config.ReceiveAigp,
+			PeeringdbMaxPrefix:  pconf.Config.PeeringdbMaxPrefix,
+			DynamicCapability:   pconf.Config.DynamicCapability,
+			AuthPasswordSource:  pconf.Config.AuthPasswordSource,
+			DisableFourOctetAs:  pconf.Config.DisableFourOctetAs,
+			AcceptOwn:           pconf.Config.AcceptOwn,
+			LocalAsMigration:    pconf.Config.LocalAsMigration,
+			LocalAsNoPrepend:    pconf.Config.LocalAsNoPrepend,
+			LocalAsReplaceAs:    pconf.Config.LocalAsReplaceAs,
+			NextHopSelf:         pconf.Config.NextHopSelf,
+			NextHopSelfForce:    pconf.Config.NextHopSelfForce,
+			NextHopUnchanged:    pconf.Config.NextHopUnchanged,
+			OrrVantagePoint:     pconf.Config.OrrVantagePoint,
 		},
 		Info: &api.PeerGroupState{
 			PeerAsn:       s.PeerAs,
@@ -608,18 +774,29 @@ func NewPeerGroupFromConfigStruct(pconf *PeerGroup) *api.PeerGroup {
 			Enabled: pconf.TtlSecurity.Config.Enabled,
 			TtlMin:  uint32(pconf.TtlSecurity.Config.TtlMin),
 		},
+		UpdateRateLimit: &api.UpdateRateLimit{
+			MessagesPerSecond: pconf.UpdateRateLimit.Config.MessagesPerSecond,
+			PrefixesPerSecond: pconf.UpdateRateLimit.Config.PrefixesPerSecond,
+		},
 		Timers: &api.Timers{
 			Config: &api.TimersConfig{
 				ConnectRetry:           uint64(timer.Config.ConnectRetry),
 				HoldTime:               uint64(timer.Config.HoldTime),
 				KeepaliveInterval:      uint64(timer.Config.KeepaliveInterval),
 				IdleHoldTimeAfterReset: uint64(timer.Config.IdleHoldTimeAfterReset),
+				IdleHoldTimeMin:        uint64(timer.Config.IdleHoldTimeMin),
+				IdleHoldTimeMax:        uint64(timer.Config.IdleHoldTimeMax),
+				IdleHoldTimeJitter:     timer.Config.IdleHoldTimeJitter,
+				ConnectRetryJitter:     timer.Config.ConnectRetryJitter,
 			},
 			State: &api.TimersState{
-				KeepaliveInterval:  uint64(timer.State.KeepaliveInterval),
-				NegotiatedHoldTime: uint64(timer.State.NegotiatedHoldTime),
-				Uptime:             ProtoTimestamp(timer.State.Uptime),
-				Downtime:           ProtoTimestamp(timer.State.Downtime),
+				KeepaliveInterval:       uint64(timer.State.KeepaliveInterval),
+				NegotiatedHoldTime:      uint64(timer.State.NegotiatedHoldTime),
+				Uptime:                  ProtoTimestamp(timer.State.Uptime),
+				Downtime:                ProtoTimestamp(timer.State.Downtime),
+				ConsecutiveFailures:     timer.State.ConsecutiveFailures,
+				IdleHoldTime:            timer.State.IdleHoldTime,
+				PeerDownConvergenceTime: timer.State.PeerDownConvergenceTime,
 			},
 		},
 		RouteReflector: &api.RouteReflector{
@@ -643,27 +820,105 @@ func NewPeerGroupFromConfigStruct(pconf *PeerGroup) *api.PeerGroup {
 			RemotePort:   uint32(pconf.Transport.Config.RemotePort),
 			LocalAddress: pconf.Transport.Config.LocalAddress,
 			PassiveMode:  pconf.Transport.Config.PassiveMode,
+			ActiveMode:   pconf.Transport.Config.ActiveMode,
 			TcpMss:       uint32(pconf.Transport.Config.TcpMss),
 		},
 		AfiSafis: afiSafis,
 	}
 }
 
+func newPeerDefaultsFromConfigStruct(d *PeerDefaults) *api.PeerDefaults {
+	protocol := api.Transport_TCP
+	if d.Transport.Config.TransportProtocol != "" {
+		protocol = api.Transport_Protocol(d.Transport.Config.TransportProtocol.ToInt())
+	}
+	return &api.PeerDefaults{
+		Timers: &api.Timers{
+			Config: &api.TimersConfig{
+				ConnectRetry:                 uint64(d.Timers.Config.ConnectRetry),
+				HoldTime:                     uint64(d.Timers.Config.HoldTime),
+				KeepaliveInterval:            uint64(d.Timers.Config.KeepaliveInterval),
+				MinimumAdvertisementInterval: uint64(d.Timers.Config.MinimumAdvertisementInterval),
+				IdleHoldTimeAfterReset:       uint64(d.Timers.Config.IdleHoldTimeAfterReset),
+				IdleHoldTimeMin:              uint64(d.Timers.Config.IdleHoldTimeMin),
+				IdleHoldTimeMax:              uint64(d.Timers.Config.IdleHoldTimeMax),
+				IdleHoldTimeJitter:           d.Timers.Config.IdleHoldTimeJitter,
+				ConnectRetryJitter:           d.Timers.Config.ConnectRetryJitter,
+			},
+		},
+		Transport: &api.Transport{
+			RemotePort:    uint32(d.Transport.Config.RemotePort),
+			LocalPort:     uint32(d.Transport.Config.LocalPort),
+			LocalAddress:  d.Transport.Config.LocalAddress,
+			PassiveMode:   d.Transport.Config.PassiveMode,
+			ActiveMode:    d.Transport.Config.ActiveMode,
+			BindInterface: d.Transport.Config.BindInterface,
+			TcpMss:        uint32(d.Transport.Config.TcpMss),
+			Protocol:      protocol,
+			QuicCaFile:    d.Transport.Config.QuicCaFile,
+			Vrf:           d.Transport.Config.Vrf,
+		},
+		EbgpMultihop: &api.EbgpMultihop{
+			Enabled:     d.EbgpMultihop.Config.Enabled,
+			MultihopTtl: uint32(d.EbgpMultihop.Config.MultihopTtl),
+		},
+		GracefulRestart: &api.GracefulRestart{
+			Enabled:             d.GracefulRestart.Config.Enabled,
+			RestartTime:         uint32(d.GracefulRestart.Config.RestartTime),
+			HelperOnly:          d.GracefulRestart.Config.HelperOnly,
+			DeferralTime:        uint32(d.GracefulRestart.Config.DeferralTime),
+			NotificationEnabled: d.GracefulRestart.Config.NotificationEnabled,
+			LonglivedEnabled:    d.GracefulRestart.Config.LongLivedEnabled,
+		},
+		TtlSecurity: &api.TtlSecurity{
+			Enabled: d.TtlSecurity.Config.Enabled,
+			TtlMin:  uint32(d.TtlSecurity.Config.TtlMin),
+		},
+	}
+}
+
 func NewGlobalFromConfigStruct(c *Global) *api.Global {
 	families := make([]uint32, 0, len(c.AfiSafis))
+	familyMultiplePaths := make([]*api.FamilyMultiplePaths, 0)
 	for _, f := range c.AfiSafis {
 		families = append(families, uint32(AfiSafiTypeToIntMap[f.Config.AfiSafiName]))
+		if f.UseMultiplePaths.Config.Enabled {
+			rf, err := bgp.GetRouteFamily(string(f.Config.AfiSafiName))
+			if err != nil {
+				continue
+			}
+			afi, safi := bgp.RouteFamilyToAfiSafi(rf)
+			familyMultiplePaths = append(familyMultiplePaths, &api.FamilyMultiplePaths{
+				Family:           apiutil.ToApiFamily(afi, safi),
+				UseMultiplePaths: newUseMultiplePathsFromConfigStruct(&f.UseMultiplePaths),
+			})
+		}
 	}
 
 	applyPolicy := newApplyPolicyFromConfigStruct(&c.ApplyPolicy)
 
+	listeners := make([]*api.Listener, 0, len(c.Listeners))
+	for _, l := range c.Listeners {
+		listeners = append(listeners, &api.Listener{
+			Address:          l.Config.Address,
+			Port:             l.Config.Port,
+			AuthRequired:     l.Config.AuthRequired,
+			BindToDevice:     l.Config.BindToDevice,
+			NetworkNamespace: l.Config.NetworkNamespace,
+		})
+	}
+
 	return &api.Global{
 		Asn:              c.Config.As,
 		RouterId:         c.Config.RouterId,
 		ListenPort:       c.Config.Port,
 		ListenAddresses:  c.Config.LocalAddressList,
+		QuicListenPort:   c.Config.QuicPort,
+		QuicCertFile:     c.Config.QuicCertFile,
+		QuicKeyFile:      c.Config.QuicKeyFile,
 		Families:         families,
 		UseMultiplePaths: c.UseMultiplePaths.Config.Enabled,
+		MultiplePaths:    newUseMultiplePathsFromConfigStruct(&c.UseMultiplePaths),
 		RouteSelectionOptions: &api.RouteSelectionOptionsConfig{
 			AlwaysCompareMed:         c.RouteSelectionOptions.Config.AlwaysCompareMed,
 			IgnoreAsPathLength:       c.RouteSelectionOptions.Config.IgnoreAsPathLength,
@@ -691,7 +946,19 @@ func NewGlobalFromConfigStruct(c *Global) *api.Global {
 			NotificationEnabled: c.GracefulRestart.Config.NotificationEnabled,
 			LonglivedEnabled:    c.GracefulRestart.Config.LongLivedEnabled,
 		},
-		ApplyPolicy: applyPolicy,
+		ApplyPolicy:         applyPolicy,
+		Listeners:           listeners,
+		PeerDefaults:        newPeerDefaultsFromConfigStruct(&c.PeerDefaults),
+		FamilyMultiplePaths: familyMultiplePaths,
+		EvpnMacMobility: &api.EvpnMacMobility{
+			DuplicateMacDetection: c.EvpnMacMobility.Config.DuplicateMacDetection,
+			MaxMoves:              c.EvpnMacMobility.Config.MaxMoves,
+			Interval:              c.EvpnMacMobility.Config.Interval,
+			FreezeTime:            c.EvpnMacMobility.Config.FreezeTime,
+		},
+		ColorResolution: &api.ColorResolution{
+			Enabled: c.ColorResolution.Config.Enabled,
+		},
 	}
 }
 
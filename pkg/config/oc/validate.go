@@ -0,0 +1,541 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/pelletier/go-toml/v2/unstable"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigError describes a problem found in a configuration file.
+type ConfigError struct {
+	// Key is the path to the offending value, for example
+	// "neighbors[0].config.peer-as", empty if unknown.
+	Key string
+	// Line and Column locate the problem in the file, starting at 1, zero
+	// if unknown.
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *ConfigError) Error() string {
+	var b strings.Builder
+	if e.Line > 0 && e.Column > 0 {
+		fmt.Fprintf(&b, "line %d, column %d: ", e.Line, e.Column)
+	} else if e.Line > 0 {
+		fmt.Fprintf(&b, "line %d: ", e.Line)
+	}
+	if e.Key != "" {
+		fmt.Fprintf(&b, "%s: ", e.Key)
+	}
+	b.WriteString(e.Message)
+	return b.String()
+}
+
+// ConfigValidationError is returned by ReadConfigfile when the
+// configuration file is malformed or doesn't match the configuration
+// schema.
+type ConfigValidationError struct {
+	Errors []*ConfigError
+}
+
+func (e *ConfigValidationError) Error() string {
+	l := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		l = append(l, err.Error())
+	}
+	return strings.Join(l, "; ")
+}
+
+// ValidateConfigFile reads the configuration file like ReadConfigfile and
+// returns all the problems found, nil if the file is valid.
+func ValidateConfigFile(path, format string) []*ConfigError {
+	_, err := ReadConfigfile(path, format)
+	if err == nil {
+		return nil
+	}
+	var verr *ConfigValidationError
+	if errors.As(err, &verr) {
+		return verr.Errors
+	}
+	return []*ConfigError{{Message: err.Error()}}
+}
+
+type configPosition struct {
+	line   int
+	column int
+}
+
+func offsetToConfigPosition(data []byte, offset int) configPosition {
+	if offset > len(data) {
+		offset = len(data)
+	}
+	lead := data[:offset]
+	return configPosition{
+		line:   bytes.Count(lead, []byte{'\n'}) + 1,
+		column: len(lead) - bytes.LastIndexByte(lead, '\n'),
+	}
+}
+
+func joinConfigKey(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func indexConfigKey(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
+
+// configDocument is a decoded configuration file with the positions of
+// the keys and the array elements, indexed by their paths.
+type configDocument struct {
+	tree      interface{}
+	positions map[string]configPosition
+	errors    []*ConfigError
+}
+
+func (d *configDocument) setPosition(path string, pos configPosition) {
+	if _, ok := d.positions[path]; !ok {
+		d.positions[path] = pos
+	}
+}
+
+func (d *configDocument) errorf(path, format string, args ...interface{}) {
+	e := &ConfigError{
+		Key:     path,
+		Message: fmt.Sprintf(format, args...),
+	}
+	// fall back on the closest ancestor, for example, TOML tables can be
+	// defined implicitly.
+	for p := path; p != ""; {
+		if pos, ok := d.positions[p]; ok {
+			e.Line, e.Column = pos.line, pos.column
+			break
+		}
+		i := strings.LastIndexAny(p, ".[")
+		if i < 0 {
+			break
+		}
+		p = p[:i]
+	}
+	d.errors = append(d.errors, e)
+}
+
+func parseTOMLConfig(data []byte) *configDocument {
+	d := &configDocument{positions: make(map[string]configPosition)}
+	tree := make(map[string]interface{})
+	if err := toml.Unmarshal(data, &tree); err != nil {
+		e := &ConfigError{Message: err.Error()}
+		var derr *toml.DecodeError
+		if errors.As(err, &derr) {
+			e.Line, e.Column = derr.Position()
+		}
+		d.errors = append(d.errors, e)
+		return d
+	}
+	d.tree = tree
+
+	p := &unstable.Parser{}
+	p.Reset(data)
+	position := func(n *unstable.Node) (configPosition, bool) {
+		if n.Raw.Length == 0 {
+			return configPosition{}, false
+		}
+		s := p.Shape(n.Raw)
+		return configPosition{line: s.Start.Line, column: s.Start.Column}, true
+	}
+	// the number of elements of the arrays of tables seen so far
+	arrays := make(map[string]int)
+	resolve := func(base string, n *unstable.Node, arrayTable bool) string {
+		path := base
+		it := n.Key()
+		for it.Next() {
+			k := it.Node()
+			path = joinConfigKey(path, string(k.Data))
+			if pos, ok := position(k); ok {
+				d.setPosition(path, pos)
+			}
+			if arrayTable && it.IsLast() {
+				arrays[path]++
+				path = indexConfigKey(path, arrays[path]-1)
+				if pos, ok := position(k); ok {
+					d.setPosition(path, pos)
+				}
+			} else if i, ok := arrays[path]; ok {
+				path = indexConfigKey(path, i-1)
+			}
+		}
+		return path
+	}
+	var value func(string, *unstable.Node)
+	value = func(path string, n *unstable.Node) {
+		switch n.Kind {
+		case unstable.InlineTable:
+			it := n.Children()
+			for it.Next() {
+				kv := it.Node()
+				value(resolve(path, kv, false), kv.Value())
+			}
+		case unstable.Array:
+			i := 0
+			it := n.Children()
+			for it.Next() {
+				e := it.Node()
+				if e.Kind == unstable.Comment {
+					continue
+				}
+				if pos, ok := position(e); ok {
+					d.setPosition(indexConfigKey(path, i), pos)
+				}
+				value(indexConfigKey(path, i), e)
+				i++
+			}
+		}
+	}
+
+	table := ""
+	for p.NextExpression() {
+		e := p.Expression()
+		switch e.Kind {
+		case unstable.Table:
+			table = resolve("", e, false)
+		case unstable.ArrayTable:
+			table = resolve("", e, true)
+		case unstable.KeyValue:
+			value(resolve(table, e, false), e.Value())
+		}
+	}
+	return d
+}
+
+var yamlErrorRegexp = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+
+func yamlConfigErrors(err error) []*ConfigError {
+	msgs := []string{err.Error()}
+	var terr *yaml.TypeError
+	if errors.As(err, &terr) {
+		msgs = terr.Errors
+	}
+	l := make([]*ConfigError, 0, len(msgs))
+	for _, msg := range msgs {
+		e := &ConfigError{Message: msg}
+		if m := yamlErrorRegexp.FindStringSubmatch(msg); m != nil {
+			e.Line, _ = strconv.Atoi(m[1])
+			e.Message = m[2]
+		}
+		l = append(l, e)
+	}
+	return l
+}
+
+func parseYAMLConfig(data []byte) *configDocument {
+	d := &configDocument{positions: make(map[string]configPosition)}
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		d.errors = yamlConfigErrors(err)
+		return d
+	}
+	if root.Kind == 0 {
+		// empty
+		return d
+	}
+	var tree interface{}
+	if err := root.Decode(&tree); err != nil {
+		d.errors = yamlConfigErrors(err)
+		return d
+	}
+	d.tree = tree
+
+	var walk func(string, *yaml.Node)
+	walk = func(path string, n *yaml.Node) {
+		switch n.Kind {
+		case yaml.DocumentNode:
+			for _, c := range n.Content {
+				walk(path, c)
+			}
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(n.Content); i += 2 {
+				k := joinConfigKey(path, n.Content[i].Value)
+				d.setPosition(k, configPosition{line: n.Content[i].Line, column: n.Content[i].Column})
+				walk(k, n.Content[i+1])
+			}
+		case yaml.SequenceNode:
+			for i, c := range n.Content {
+				k := indexConfigKey(path, i)
+				d.setPosition(k, configPosition{line: c.Line, column: c.Column})
+				walk(k, c)
+			}
+		}
+	}
+	walk("", &root)
+	return d
+}
+
+func parseJSONConfig(data []byte) *configDocument {
+	d := &configDocument{positions: make(map[string]configPosition)}
+	var tree interface{}
+	if err := json.Unmarshal(data, &tree); err != nil {
+		e := &ConfigError{Message: err.Error()}
+		var serr *json.SyntaxError
+		if errors.As(err, &serr) {
+			// Offset is just after the offending character
+			pos := offsetToConfigPosition(data, int(serr.Offset)-1)
+			e.Line, e.Column = pos.line, pos.column
+		}
+		d.errors = append(d.errors, e)
+		return d
+	}
+	d.tree = tree
+
+	// encoding/json silently takes the last one of the duplicated keys so
+	// check them here with the positions.
+	dec := json.NewDecoder(bytes.NewReader(data))
+	next := func() configPosition {
+		offset := int(dec.InputOffset())
+		for offset < len(data) && strings.IndexByte(" \t\r\n,:", data[offset]) >= 0 {
+			offset++
+		}
+		return offsetToConfigPosition(data, offset)
+	}
+	var walk func(string) error
+	walk = func(path string) error {
+		t, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch t {
+		case json.Delim('{'):
+			seen := make(map[string]bool)
+			for dec.More() {
+				pos := next()
+				t, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				k := joinConfigKey(path, t.(string))
+				d.setPosition(k, pos)
+				if seen[k] {
+					d.errors = append(d.errors, &ConfigError{
+						Key:     k,
+						Line:    pos.line,
+						Column:  pos.column,
+						Message: "duplicate key",
+					})
+				}
+				seen[k] = true
+				if err := walk(k); err != nil {
+					return err
+				}
+			}
+			_, err = dec.Token()
+		case json.Delim('['):
+			for i := 0; dec.More(); i++ {
+				k := indexConfigKey(path, i)
+				d.setPosition(k, next())
+				if err := walk(k); err != nil {
+					return err
+				}
+			}
+			_, err = dec.Token()
+		}
+		return err
+	}
+	if err := walk(""); err != nil {
+		d.errors = append(d.errors, &ConfigError{Message: err.Error()})
+	}
+	return d
+}
+
+// configStructFields returns the types of the fields of the struct type t
+// indexed by their lowercase keys. mapstructure matches keys case
+// insensitively.
+func configStructFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("mapstructure"), ",")[0]
+		if name == "-" {
+			continue
+		} else if name == "" {
+			name = f.Name
+		}
+		fields[strings.ToLower(name)] = f.Type
+	}
+	return fields
+}
+
+// configInteger converts v like mapstructure with WeaklyTypedInput.
+func configInteger(v interface{}) (*big.Int, bool) {
+	switch n := v.(type) {
+	case int:
+		return big.NewInt(int64(n)), true
+	case int64:
+		return big.NewInt(n), true
+	case uint64:
+		return new(big.Int).SetUint64(n), true
+	case float64:
+		if n != math.Trunc(n) || math.IsInf(n, 0) {
+			return nil, false
+		}
+		i, _ := big.NewFloat(n).Int(nil)
+		return i, true
+	case bool:
+		if n {
+			return big.NewInt(1), true
+		}
+		return big.NewInt(0), true
+	case string:
+		if n == "" {
+			return big.NewInt(0), true
+		}
+		return new(big.Int).SetString(n, 0)
+	}
+	return nil, false
+}
+
+func isConfigScalar(v interface{}) bool {
+	switch v.(type) {
+	case map[string]interface{}, map[interface{}]interface{}, []interface{}:
+		return false
+	}
+	return true
+}
+
+// validate checks the value v at path against the type t.
+func (d *configDocument) validate(t reflect.Type, v interface{}, path string) {
+	if v == nil {
+		// the zero value
+		return
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		m := make(map[string]interface{})
+		switch tree := v.(type) {
+		case map[string]interface{}:
+			m = tree
+		case map[interface{}]interface{}:
+			for k, v := range tree {
+				m[fmt.Sprint(k)] = v
+			}
+		default:
+			d.errorf(path, "expected a map")
+			return
+		}
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		fields := configStructFields(t)
+		for _, k := range keys {
+			key := joinConfigKey(path, k)
+			if ft, ok := fields[strings.ToLower(k)]; !ok {
+				d.errorf(key, "unknown key")
+			} else {
+				d.validate(ft, m[k], key)
+			}
+		}
+	case reflect.Slice:
+		l, ok := v.([]interface{})
+		if !ok {
+			// mapstructure converts a single value into a slice
+			d.validate(t.Elem(), v, path)
+			return
+		}
+		for i, e := range l {
+			d.validate(t.Elem(), e, indexConfigKey(path, i))
+		}
+	case reflect.Bool:
+		ok := isConfigScalar(v)
+		if s, isString := v.(string); isString && s != "" {
+			_, err := strconv.ParseBool(s)
+			ok = err == nil
+		}
+		if !ok {
+			d.errorf(path, "expected a boolean")
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, ok := configInteger(v)
+		if !ok {
+			d.errorf(path, "expected an integer")
+		} else if !n.IsInt64() || reflect.Zero(t).OverflowInt(n.Int64()) {
+			d.errorf(path, "%s is out of range for %s", n, t.Kind())
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, ok := configInteger(v)
+		if !ok {
+			d.errorf(path, "expected an integer")
+		} else if n.Sign() < 0 || !n.IsUint64() || reflect.Zero(t).OverflowUint(n.Uint64()) {
+			d.errorf(path, "%s is out of range for %s", n, t.Kind())
+		}
+	case reflect.Float32, reflect.Float64:
+		ok := isConfigScalar(v)
+		if s, isString := v.(string); isString && s != "" {
+			_, err := strconv.ParseFloat(s, 64)
+			ok = err == nil
+		}
+		if !ok {
+			d.errorf(path, "expected a number")
+		}
+	case reflect.String:
+		if !isConfigScalar(v) {
+			d.errorf(path, "expected a string")
+		}
+	}
+}
+
+// validateConfig parses the configuration file data in format and checks
+// it against BgpConfigSet. It returns nil for the formats which it
+// doesn't know, leaving them to viper.
+func validateConfig(data []byte, format string) []*ConfigError {
+	var d *configDocument
+	switch format {
+	case "toml":
+		d = parseTOMLConfig(data)
+	case "yaml", "yml":
+		d = parseYAMLConfig(data)
+	case "json":
+		d = parseJSONConfig(data)
+	default:
+		return nil
+	}
+	if d.tree != nil {
+		d.validate(reflect.TypeOf(BgpConfigSet{}), d.tree, "")
+	}
+	sort.SliceStable(d.errors, func(i, j int) bool {
+		if d.errors[i].Line != d.errors[j].Line {
+			return d.errors[i].Line < d.errors[j].Line
+		}
+		return d.errors[i].Column < d.errors[j].Column
+	})
+	return d.errors
+}
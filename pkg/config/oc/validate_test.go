@@ -0,0 +1,184 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oc
+
+import (
+	"os"
+	"path"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		data   string
+		errors []*ConfigError
+	}{
+		{
+			name:   "toml",
+			format: "toml",
+			data: `[global.config]
+  as = 64512
+  router-id = "192.168.255.1"
+
+[[neighbors]]
+  [neighbors.config]
+    neighbor-address = "10.0.255.1"
+    peer-as = 65001
+
+[[neighbors]]
+  [neighbors.config]
+    neighbor-address = "10.0.255.2"
+    peer-ass = 65002
+  [neighbors.timers.config]
+    hold-time = "ninety"
+  [[neighbors.afi-safis]]
+    [neighbors.afi-safis.config]
+      afi-safi-name = "ipv4-unicast"
+  [[neighbors.afi-safis]]
+    config = { afi-safi-name = "ipv6-unicast", enable = 1 }
+    prefix-limit.config.max-prefixes = -1
+`,
+			errors: []*ConfigError{
+				{Key: "neighbors[1].config.peer-ass", Line: 13, Column: 5, Message: "unknown key"},
+				{Key: "neighbors[1].timers.config.hold-time", Line: 15, Column: 5, Message: "expected a number"},
+				{Key: "neighbors[1].afi-safis[1].config.enable", Line: 20, Column: 48, Message: "unknown key"},
+				{Key: "neighbors[1].afi-safis[1].prefix-limit.config.max-prefixes", Line: 21, Column: 25, Message: "-1 is out of range for uint32"},
+			},
+		},
+		{
+			name:   "toml syntax",
+			format: "toml",
+			data: `[global.config]
+  as = 64512
+  router-id = 192.168.255.1
+`,
+			errors: []*ConfigError{
+				{Line: 3, Column: 22},
+			},
+		},
+		{
+			name:   "yaml",
+			format: "yaml",
+			data: `global:
+  config:
+    as: 64512
+    router-id: 192.168.255.1
+neighbors:
+  - config:
+      neighbor-address: 10.0.255.1
+      peer-as: 65001
+  - config:
+      neighbor-address: 10.0.255.2
+      peer-as: [65002]
+    transport:
+      config:
+        passive-mode: maybe
+  - unknown: 1
+`,
+			errors: []*ConfigError{
+				{Key: "neighbors[1].config.peer-as", Line: 11, Column: 7, Message: "expected an integer"},
+				{Key: "neighbors[1].transport.config.passive-mode", Line: 14, Column: 9, Message: "expected a boolean"},
+				{Key: "neighbors[2].unknown", Line: 15, Column: 5, Message: "unknown key"},
+			},
+		},
+		{
+			name:   "yaml syntax",
+			format: "yaml",
+			data:   "global:\n  config:\n    as: 64512\n\trouter-id: 192.168.255.1\n",
+			errors: []*ConfigError{
+				{Line: 3},
+			},
+		},
+		{
+			name:   "json",
+			format: "json",
+			data: `{
+  "global": {
+    "config": {"as": 64512, "router-id": "192.168.255.1", "as": 1}
+  },
+  "neighbors": [
+    {"config": {"neighbor-address": "10.0.255.1", "peer-as": 65001.5}},
+    {"Config": {"Neighbor-Address": "10.0.255.2", "PEER-AS": 65002}},
+    "10.0.255.3"
+  ]
+}
+`,
+			errors: []*ConfigError{
+				{Key: "global.config.as", Line: 3, Column: 59, Message: "duplicate key"},
+				{Key: "neighbors[0].config.peer-as", Line: 6, Column: 51, Message: "expected an integer"},
+				{Key: "neighbors[2]", Line: 8, Column: 5, Message: "expected a map"},
+			},
+		},
+		{
+			name:   "json syntax",
+			format: "json",
+			data: `{
+  "global": {
+    "config": {"as": 64512,}
+  }
+}
+`,
+			errors: []*ConfigError{
+				{Line: 3, Column: 28},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			errs := validateConfig([]byte(tt.data), tt.format)
+			if !assert.Len(errs, len(tt.errors), "%v", errs) {
+				return
+			}
+			for i, e := range tt.errors {
+				assert.Equal(e.Key, errs[i].Key)
+				assert.Equal(e.Line, errs[i].Line, errs[i].Error())
+				if e.Column > 0 {
+					assert.Equal(e.Column, errs[i].Column, errs[i].Error())
+				}
+				if e.Message != "" {
+					assert.Equal(e.Message, errs[i].Message)
+				} else {
+					assert.NotEmpty(errs[i].Message)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateConfigFile(t *testing.T) {
+	assert := assert.New(t)
+
+	_, f, _, _ := runtime.Caller(0)
+	fileMd := path.Join(path.Dir(f), "../../../docs/sources/configuration.md")
+	fileToml := path.Join(t.TempDir(), "gobgpd.example.toml")
+	assert.NoError(extractTomlFromMarkdown(fileMd, fileToml))
+	assert.Empty(ValidateConfigFile(fileToml, "toml"))
+
+	fileYaml := path.Join(t.TempDir(), "gobgpd.yml")
+	assert.NoError(os.WriteFile(fileYaml, []byte("global:\n  config:\n    as: 4294967296\n    router-id: 1.1.1.1\n"), 0644))
+	errs := ValidateConfigFile(fileYaml, "toml")
+	assert.Len(errs, 1)
+	assert.Equal("line 3, column 5: global.config.as: 4294967296 is out of range for uint32", errs[0].Error())
+
+	_, err := ReadConfigfile(fileYaml, "")
+	assert.EqualError(err, "line 3, column 5: global.config.as: 4294967296 is out of range for uint32")
+}
@@ -0,0 +1,475 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"gopkg.in/yaml.v3"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/apiutil"
+	"github.com/osrg/gobgp/v3/pkg/log"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+	"github.com/osrg/gobgp/v3/pkg/server"
+)
+
+// Writing a route file may trigger many events in quick succession, the
+// routes are synchronized once they settle.
+const staticRouteSyncDelay = 200 * time.Millisecond
+
+// RouteDefinition is a route written in a route file. The file is a YAML
+// or JSON document with the list of the routes under the routes key:
+//
+//	routes:
+//	  - prefix: 10.0.0.0/24
+//	    nexthop: 192.168.0.1
+//	    as-path: [65001]
+//	    communities: ["65000:100"]
+type RouteDefinition struct {
+	Prefix              string   `yaml:"prefix"`
+	Nexthop             string   `yaml:"nexthop"`
+	Vrf                 string   `yaml:"vrf"`
+	Origin              string   `yaml:"origin"`
+	AsPath              []uint32 `yaml:"as-path"`
+	Med                 *uint32  `yaml:"med"`
+	LocalPref           *uint32  `yaml:"local-pref"`
+	Communities         []string `yaml:"communities"`
+	ExtendedCommunities []string `yaml:"extended-communities"`
+	LargeCommunities    []string `yaml:"large-communities"`
+}
+
+type routeFile struct {
+	Routes []RouteDefinition `yaml:"routes"`
+}
+
+type staticRouteKey struct {
+	vrf    string
+	prefix string
+}
+
+func (k staticRouteKey) String() string {
+	if k.vrf == "" {
+		return k.prefix
+	}
+	return fmt.Sprintf("%s (vrf %s)", k.prefix, k.vrf)
+}
+
+// staticRoute is a route definition read from a route file and converted
+// to the path which is added to the RIB.
+type staticRoute struct {
+	key  staticRouteKey
+	file string
+	def  RouteDefinition
+	path *api.Path
+}
+
+func (r *staticRoute) tableType() api.TableType {
+	if r.key.vrf == "" {
+		return api.TableType_GLOBAL
+	}
+	return api.TableType_VRF
+}
+
+func newStaticRoute(file string, def RouteDefinition) (*staticRoute, error) {
+	ip, ipNet, err := net.ParseCIDR(def.Prefix)
+	if err != nil {
+		return nil, fmt.Errorf("invalid prefix %q", def.Prefix)
+	}
+	ones, _ := ipNet.Mask.Size()
+	isV4 := ip.To4() != nil
+
+	nexthop := def.Nexthop
+	if nexthop == "" {
+		nexthop = "0.0.0.0"
+		if !isV4 {
+			nexthop = "::"
+		}
+	} else if n := net.ParseIP(nexthop); n == nil || (n.To4() != nil) != isV4 {
+		return nil, fmt.Errorf("invalid nexthop %q for %s", def.Nexthop, def.Prefix)
+	}
+
+	var origin uint8
+	switch strings.ToLower(def.Origin) {
+	case "", "igp":
+		origin = bgp.BGP_ORIGIN_ATTR_TYPE_IGP
+	case "egp":
+		origin = bgp.BGP_ORIGIN_ATTR_TYPE_EGP
+	case "incomplete":
+		origin = bgp.BGP_ORIGIN_ATTR_TYPE_INCOMPLETE
+	default:
+		return nil, fmt.Errorf("invalid origin %q, expected igp, egp or incomplete", def.Origin)
+	}
+
+	var nlri bgp.AddrPrefixInterface
+	attrs := []bgp.PathAttributeInterface{bgp.NewPathAttributeOrigin(origin)}
+	if isV4 {
+		nlri = bgp.NewIPAddrPrefix(uint8(ones), ipNet.IP.String())
+		attrs = append(attrs, bgp.NewPathAttributeNextHop(nexthop))
+	} else {
+		nlri = bgp.NewIPv6AddrPrefix(uint8(ones), ipNet.IP.String())
+		attrs = append(attrs, bgp.NewPathAttributeMpReachNLRI(nexthop, []bgp.AddrPrefixInterface{nlri}))
+	}
+	if len(def.AsPath) > 0 {
+		attrs = append(attrs, bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{
+			bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, def.AsPath),
+		}))
+	}
+	if def.Med != nil {
+		attrs = append(attrs, bgp.NewPathAttributeMultiExitDisc(*def.Med))
+	}
+	if def.LocalPref != nil {
+		attrs = append(attrs, bgp.NewPathAttributeLocalPref(*def.LocalPref))
+	}
+	if len(def.Communities) > 0 {
+		l := make([]uint32, 0, len(def.Communities))
+		for _, c := range def.Communities {
+			v, err := table.ParseCommunity(c)
+			if err != nil {
+				return nil, err
+			}
+			l = append(l, v)
+		}
+		attrs = append(attrs, bgp.NewPathAttributeCommunities(l))
+	}
+	if len(def.ExtendedCommunities) > 0 {
+		l := make([]bgp.ExtendedCommunityInterface, 0, len(def.ExtendedCommunities))
+		for _, c := range def.ExtendedCommunities {
+			v, err := table.ParseExtCommunity(c)
+			if err != nil {
+				return nil, err
+			}
+			l = append(l, v)
+		}
+		attrs = append(attrs, bgp.NewPathAttributeExtendedCommunities(l))
+	}
+	if len(def.LargeCommunities) > 0 {
+		l := make([]*bgp.LargeCommunity, 0, len(def.LargeCommunities))
+		for _, c := range def.LargeCommunities {
+			v, err := bgp.ParseLargeCommunity(c)
+			if err != nil {
+				return nil, err
+			}
+			l = append(l, v)
+		}
+		attrs = append(attrs, bgp.NewPathAttributeLargeCommunities(l))
+	}
+
+	path, err := apiutil.NewPath(nlri, false, attrs, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &staticRoute{
+		key:  staticRouteKey{vrf: def.Vrf, prefix: nlri.String()},
+		file: file,
+		def:  def,
+		path: path,
+	}, nil
+}
+
+// ReadRouteFile parses a route file and returns the route definitions in
+// it. Both YAML and JSON are accepted.
+func ReadRouteFile(path string) ([]RouteDefinition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	d := yaml.NewDecoder(bytes.NewReader(data))
+	d.KnownFields(true)
+	f := routeFile{}
+	if err := d.Decode(&f); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return f.Routes, nil
+}
+
+func readStaticRoutes(path string) ([]*staticRoute, error) {
+	defs, err := ReadRouteFile(path)
+	if err != nil {
+		return nil, err
+	}
+	routes := make([]*staticRoute, 0, len(defs))
+	for i, def := range defs {
+		r, err := newStaticRoute(path, def)
+		if err != nil {
+			return nil, fmt.Errorf("%s: routes[%d]: %w", path, i, err)
+		}
+		routes = append(routes, r)
+	}
+	return routes, nil
+}
+
+func isRouteFile(name string) bool {
+	if strings.HasPrefix(name, ".") {
+		return false
+	}
+	switch filepath.Ext(name) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+	return false
+}
+
+// StaticRouteInjector originates the routes defined in a route file, or in
+// the route files of a directory, and keeps the RIB in sync with them: the
+// routes added to the files are added, the modified ones are updated and
+// the removed ones are withdrawn.
+type StaticRouteInjector struct {
+	bgpServer *server.BgpServer
+	path      string
+
+	mu      sync.Mutex
+	routes  map[staticRouteKey]*staticRoute
+	watcher *fsnotify.Watcher
+}
+
+// NewStaticRouteInjector returns a StaticRouteInjector for the route file
+// or the directory of the route files (*.yaml, *.yml and *.json) at path.
+// No route is added until Sync is called.
+func NewStaticRouteInjector(bgpServer *server.BgpServer, path string) *StaticRouteInjector {
+	return &StaticRouteInjector{
+		bgpServer: bgpServer,
+		path:      filepath.Clean(path),
+		routes:    make(map[staticRouteKey]*staticRoute),
+	}
+}
+
+func (i *StaticRouteInjector) isDir() bool {
+	info, err := os.Stat(i.path)
+	return err == nil && info.IsDir()
+}
+
+func (i *StaticRouteInjector) routeFiles() ([]string, error) {
+	info, err := os.Stat(i.path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{i.path}, nil
+	}
+	entries, err := os.ReadDir(i.path)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() && isRouteFile(e.Name()) {
+			files = append(files, filepath.Join(i.path, e.Name()))
+		}
+	}
+	return files, nil
+}
+
+// Sync reads the route files and adds, updates and withdraws the routes so
+// that the RIB matches them. The routes of a file which can't be read or
+// has an invalid route are left as they are until the file is fixed or
+// removed. The returned error joins all the problems found.
+func (i *StaticRouteInjector) Sync(ctx context.Context) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	files, err := i.routeFiles()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	routes := make(map[staticRouteKey]*staticRoute, len(i.routes))
+	for _, f := range files {
+		l, err := readStaticRoutes(f)
+		if err != nil {
+			errs = append(errs, err)
+			for k, r := range i.routes {
+				if r.file == f {
+					routes[k] = r
+				}
+			}
+			continue
+		}
+		for _, r := range l {
+			if prev, ok := routes[r.key]; ok {
+				errs = append(errs, fmt.Errorf("%s: %s is already defined in %s", f, r.key, prev.file))
+				continue
+			}
+			routes[r.key] = r
+		}
+	}
+
+	var added, updated, withdrawn int
+	for _, k := range sortedStaticRouteKeys(i.routes) {
+		r := i.routes[k]
+		if _, ok := routes[k]; ok {
+			continue
+		}
+		if err := i.bgpServer.DeletePath(ctx, &api.DeletePathRequest{
+			TableType: r.tableType(),
+			VrfId:     r.key.vrf,
+			Path:      r.path,
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to withdraw %s: %w", k, err))
+			routes[k] = r
+			continue
+		}
+		withdrawn++
+	}
+	for _, k := range sortedStaticRouteKeys(routes) {
+		r := routes[k]
+		prev, ok := i.routes[k]
+		if ok && reflect.DeepEqual(prev.def, r.def) {
+			continue
+		}
+		if _, err := i.bgpServer.AddPath(ctx, &api.AddPathRequest{
+			TableType: r.tableType(),
+			VrfId:     r.key.vrf,
+			Path:      r.path,
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to add %s: %w", k, err))
+			if ok {
+				routes[k] = prev
+			} else {
+				delete(routes, k)
+			}
+			continue
+		}
+		if ok {
+			updated++
+		} else {
+			added++
+		}
+	}
+	i.routes = routes
+
+	if added+updated+withdrawn > 0 {
+		i.bgpServer.Log().Info("Synchronized static routes",
+			log.Fields{
+				"Topic":     "config",
+				"Key":       i.path,
+				"Added":     added,
+				"Updated":   updated,
+				"Withdrawn": withdrawn})
+	}
+	return errors.Join(errs...)
+}
+
+func sortedStaticRouteKeys(m map[staticRouteKey]*staticRoute) []staticRouteKey {
+	keys := make([]staticRouteKey, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].vrf != keys[j].vrf {
+			return keys[i].vrf < keys[j].vrf
+		}
+		return keys[i].prefix < keys[j].prefix
+	})
+	return keys
+}
+
+// Watch starts watching the route files and calls Sync whenever they are
+// changed until Stop is called.
+func (i *StaticRouteInjector) Watch() error {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	// files are often replaced by renaming, watch the directory rather
+	// than the file itself
+	dir := i.path
+	if !i.isDir() {
+		dir = filepath.Dir(i.path)
+	}
+	if err := w.Add(dir); err != nil {
+		w.Close()
+		return err
+	}
+
+	i.mu.Lock()
+	i.watcher = w
+	i.mu.Unlock()
+	go i.watch(w)
+	return nil
+}
+
+func (i *StaticRouteInjector) isWatched(name string) bool {
+	name = filepath.Clean(name)
+	if name == i.path {
+		return true
+	}
+	return filepath.Dir(name) == i.path && isRouteFile(filepath.Base(name))
+}
+
+func (i *StaticRouteInjector) watch(w *fsnotify.Watcher) {
+	timer := time.NewTimer(staticRouteSyncDelay)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case ev, ok := <-w.Events:
+			if !ok {
+				return
+			}
+			if ev.Op == fsnotify.Chmod || !i.isWatched(ev.Name) {
+				continue
+			}
+			timer.Reset(staticRouteSyncDelay)
+		case err, ok := <-w.Errors:
+			if !ok {
+				return
+			}
+			i.bgpServer.Log().Warn("Failed to watch static route files",
+				log.Fields{
+					"Topic": "config",
+					"Key":   i.path,
+					"Error": err})
+		case <-timer.C:
+			if err := i.Sync(context.Background()); err != nil {
+				i.bgpServer.Log().Warn("Failed to synchronize static routes",
+					log.Fields{
+						"Topic": "config",
+						"Key":   i.path,
+						"Error": err})
+			}
+		}
+	}
+}
+
+// Stop stops watching the route files. The routes are left in the RIB.
+func (i *StaticRouteInjector) Stop() {
+	i.mu.Lock()
+	w := i.watcher
+	i.watcher = nil
+	i.mu.Unlock()
+	// Close waits for the pending events to be received, which a running
+	// Sync holding the lock would block
+	if w != nil {
+		w.Close()
+	}
+}
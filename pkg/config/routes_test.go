@@ -0,0 +1,165 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/apiutil"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+	"github.com/osrg/gobgp/v3/pkg/server"
+)
+
+func newStaticRouteTestServer(t *testing.T) *server.BgpServer {
+	s := server.NewBgpServer()
+	go s.Serve()
+	err := s.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        1,
+			RouterId:   "1.1.1.1",
+			ListenPort: -1,
+		},
+	})
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		s.StopBgp(context.Background(), &api.StopBgpRequest{})
+		s.Stop()
+	})
+	return s
+}
+
+// listStaticRoutes returns the MED of the global paths by the prefixes,
+// -1 for the paths without MED.
+func listStaticRoutes(t *testing.T, s *server.BgpServer, family *api.Family) map[string]int64 {
+	routes := make(map[string]int64)
+	err := s.ListPath(context.Background(), &api.ListPathRequest{
+		TableType: api.TableType_GLOBAL,
+		Family:    family,
+	}, func(d *api.Destination) {
+		attrs, err := apiutil.GetNativePathAttributes(d.Paths[0])
+		assert.NoError(t, err)
+		routes[d.Prefix] = -1
+		for _, a := range attrs {
+			if med, ok := a.(*bgp.PathAttributeMultiExitDisc); ok {
+				routes[d.Prefix] = int64(med.Value)
+			}
+		}
+	})
+	assert.NoError(t, err)
+	return routes
+}
+
+var ipv4UC = &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST}
+
+func TestStaticRouteInjectorSync(t *testing.T) {
+	assert := assert.New(t)
+	s := newStaticRouteTestServer(t)
+	dir := t.TempDir()
+	write := func(name, data string) {
+		assert.NoError(os.WriteFile(filepath.Join(dir, name), []byte(data), 0644))
+	}
+
+	write("a.yaml", `routes:
+  - prefix: 10.0.0.0/24
+    nexthop: 192.168.0.1
+    as-path: [65001, 65002]
+    communities: ["65001:100", "no-export"]
+  - prefix: 10.0.1.0/24
+    med: 10
+`)
+	write("b.json", `{"routes": [{"prefix": "2001:db8::/32", "nexthop": "2001:db8::1", "large-communities": ["65001:1:2"]}]}`)
+	write("README.md", "not a route file")
+
+	i := NewStaticRouteInjector(s, dir)
+	assert.NoError(i.Sync(context.Background()))
+	assert.Equal(map[string]int64{"10.0.0.0/24": -1, "10.0.1.0/24": 10}, listStaticRoutes(t, s, ipv4UC))
+	assert.Equal(map[string]int64{"2001:db8::/32": -1}, listStaticRoutes(t, s, &api.Family{Afi: api.Family_AFI_IP6, Safi: api.Family_SAFI_UNICAST}))
+
+	// update, add and withdraw
+	write("a.yaml", `routes:
+  - prefix: 10.0.1.0/24
+    med: 20
+  - prefix: 10.0.2.0/24
+`)
+	assert.NoError(i.Sync(context.Background()))
+	assert.Equal(map[string]int64{"10.0.1.0/24": 20, "10.0.2.0/24": -1}, listStaticRoutes(t, s, ipv4UC))
+
+	// the routes of a broken file are kept
+	write("a.yaml", `routes:
+  - prefix: 10.0.1.0/24
+    med: thirty
+`)
+	assert.ErrorContains(i.Sync(context.Background()), "a.yaml")
+	assert.Equal(map[string]int64{"10.0.1.0/24": 20, "10.0.2.0/24": -1}, listStaticRoutes(t, s, ipv4UC))
+
+	write("a.yaml", `routes:
+  - prefix: 10.0.1.0/24
+    nexthop: 2001:db8::1
+`)
+	assert.ErrorContains(i.Sync(context.Background()), "a.yaml: routes[0]: invalid nexthop")
+	assert.Equal(map[string]int64{"10.0.1.0/24": 20, "10.0.2.0/24": -1}, listStaticRoutes(t, s, ipv4UC))
+
+	// the first definition wins
+	write("c.yml", `routes:
+  - prefix: 10.0.3.0/24
+    med: 3
+  - prefix: 10.0.2.0/24
+    med: 2
+`)
+	err := i.Sync(context.Background())
+	assert.ErrorContains(err, "c.yml: 10.0.2.0/24 is already defined in")
+	assert.Equal(map[string]int64{"10.0.1.0/24": 20, "10.0.2.0/24": -1, "10.0.3.0/24": 3}, listStaticRoutes(t, s, ipv4UC))
+
+	// the routes of a removed file are withdrawn
+	assert.NoError(os.Remove(filepath.Join(dir, "a.yaml")))
+	assert.NoError(os.Remove(filepath.Join(dir, "b.json")))
+	assert.NoError(i.Sync(context.Background()))
+	assert.Equal(map[string]int64{"10.0.2.0/24": 2, "10.0.3.0/24": 3}, listStaticRoutes(t, s, ipv4UC))
+	assert.Empty(listStaticRoutes(t, s, &api.Family{Afi: api.Family_AFI_IP6, Safi: api.Family_SAFI_UNICAST}))
+}
+
+func TestStaticRouteInjectorWatch(t *testing.T) {
+	assert := assert.New(t)
+	s := newStaticRouteTestServer(t)
+	file := filepath.Join(t.TempDir(), "routes.yaml")
+	assert.NoError(os.WriteFile(file, []byte("routes:\n  - prefix: 10.0.0.0/24\n"), 0644))
+
+	i := NewStaticRouteInjector(s, file)
+	assert.NoError(i.Sync(context.Background()))
+	assert.NoError(i.Watch())
+	defer i.Stop()
+
+	// replace the file like editors do
+	tmp := file + ".tmp"
+	assert.NoError(os.WriteFile(tmp, []byte("routes:\n  - prefix: 10.0.1.0/24\n    med: 1\n"), 0644))
+	assert.NoError(os.Rename(tmp, file))
+	assert.Eventually(func() bool {
+		routes := listStaticRoutes(t, s, ipv4UC)
+		return len(routes) == 1 && routes["10.0.1.0/24"] == 1
+	}, 5*time.Second, 50*time.Millisecond)
+
+	assert.NoError(os.WriteFile(file, nil, 0644))
+	assert.Eventually(func() bool {
+		return len(listStaticRoutes(t, s, ipv4UC)) == 0
+	}, 5*time.Second, 50*time.Millisecond)
+}
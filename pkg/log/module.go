@@ -0,0 +1,226 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// The modules whose log levels can be set apart from the global one.
+const (
+	ModuleFsm    = "fsm"
+	ModuleTable  = "table"
+	ModulePolicy = "policy"
+	ModuleZebra  = "zebra"
+	ModuleBmp    = "bmp"
+)
+
+var Modules = []string{ModuleFsm, ModuleTable, ModulePolicy, ModuleZebra, ModuleBmp}
+
+func isModule(module string) bool {
+	for _, m := range Modules {
+		if m == module {
+			return true
+		}
+	}
+	return false
+}
+
+var levelNames = []string{"panic", "fatal", "error", "warn", "info", "debug", "trace"}
+
+func (l LogLevel) String() string {
+	if int(l) < len(levelNames) {
+		return levelNames[l]
+	}
+	return fmt.Sprintf("LogLevel(%d)", l)
+}
+
+// ParseLogLevel parses the name of the level like "debug".
+func ParseLogLevel(s string) (LogLevel, error) {
+	for i, name := range levelNames {
+		if strings.EqualFold(s, name) {
+			return LogLevel(i), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid log level: %s", s)
+}
+
+// ModuleLevels holds the global log level and the levels of the modules,
+// and gives the loggers of the modules writing to the backend logger. A
+// module without its own level follows the global one. The level of the
+// backend is kept at the most verbose of them so that the loggers of the
+// modules can filter the messages by themselves.
+type ModuleLevels struct {
+	mu      sync.RWMutex
+	backend Logger
+	level   LogLevel
+	modules map[string]LogLevel
+}
+
+// NewModuleLevels returns the levels whose global level is the one of the
+// backend.
+func NewModuleLevels(backend Logger) *ModuleLevels {
+	return &ModuleLevels{
+		backend: backend,
+		level:   backend.GetLevel(),
+		modules: make(map[string]LogLevel),
+	}
+}
+
+func (m *ModuleLevels) updateBackend() {
+	most := m.level
+	for _, l := range m.modules {
+		if l > most {
+			most = l
+		}
+	}
+	if m.backend.GetLevel() != most {
+		m.backend.SetLevel(most)
+	}
+}
+
+// SetLevel sets the level of the module, or the global level if the module
+// is empty.
+func (m *ModuleLevels) SetLevel(module string, level LogLevel) error {
+	if module != "" && !isModule(module) {
+		return fmt.Errorf("unknown log module: %s", module)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if module == "" {
+		m.level = level
+	} else {
+		m.modules[module] = level
+	}
+	m.updateBackend()
+	return nil
+}
+
+// UnsetLevel makes the module follow the global level.
+func (m *ModuleLevels) UnsetLevel(module string) error {
+	if !isModule(module) {
+		return fmt.Errorf("unknown log module: %s", module)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.modules, module)
+	m.updateBackend()
+	return nil
+}
+
+// Level returns the level of the module, or the global level if the module
+// is empty or doesn't have its own level.
+func (m *ModuleLevels) Level(module string) LogLevel {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if l, y := m.modules[module]; y {
+		return l
+	}
+	return m.level
+}
+
+// Levels returns the global level and the levels set to the modules.
+func (m *ModuleLevels) Levels() (LogLevel, map[string]LogLevel) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	modules := make(map[string]LogLevel, len(m.modules))
+	for module, l := range m.modules {
+		modules[module] = l
+	}
+	return m.level, modules
+}
+
+func (m *ModuleLevels) String() string {
+	level, modules := m.Levels()
+	l := make([]string, 0, len(modules)+1)
+	l = append(l, level.String())
+	for module, level := range modules {
+		l = append(l, module+"="+level.String())
+	}
+	sort.Strings(l[1:])
+	return strings.Join(l, ",")
+}
+
+// Logger returns the logger of the module, or of the rest of the modules
+// if the module is empty. The messages of a module have the "Module" field.
+func (m *ModuleLevels) Logger(module string) Logger {
+	return &moduleLogger{levels: m, module: module}
+}
+
+type moduleLogger struct {
+	levels *ModuleLevels
+	module string
+}
+
+func (l *moduleLogger) fields(fields Fields) Fields {
+	if l.module == "" {
+		return fields
+	}
+	f := make(Fields, len(fields)+1)
+	for k, v := range fields {
+		f[k] = v
+	}
+	f["Module"] = l.module
+	return f
+}
+
+func (l *moduleLogger) enabled(level LogLevel) bool {
+	return level <= l.levels.Level(l.module)
+}
+
+func (l *moduleLogger) Panic(msg string, fields Fields) {
+	l.levels.backend.Panic(msg, l.fields(fields))
+}
+
+func (l *moduleLogger) Fatal(msg string, fields Fields) {
+	l.levels.backend.Fatal(msg, l.fields(fields))
+}
+
+func (l *moduleLogger) Error(msg string, fields Fields) {
+	if l.enabled(ErrorLevel) {
+		l.levels.backend.Error(msg, l.fields(fields))
+	}
+}
+
+func (l *moduleLogger) Warn(msg string, fields Fields) {
+	if l.enabled(WarnLevel) {
+		l.levels.backend.Warn(msg, l.fields(fields))
+	}
+}
+
+func (l *moduleLogger) Info(msg string, fields Fields) {
+	if l.enabled(InfoLevel) {
+		l.levels.backend.Info(msg, l.fields(fields))
+	}
+}
+
+func (l *moduleLogger) Debug(msg string, fields Fields) {
+	if l.enabled(DebugLevel) {
+		l.levels.backend.Debug(msg, l.fields(fields))
+	}
+}
+
+// SetLevel sets the level of the module, or the global level.
+func (l *moduleLogger) SetLevel(level LogLevel) {
+	l.levels.SetLevel(l.module, level)
+}
+
+func (l *moduleLogger) GetLevel() LogLevel {
+	return l.levels.Level(l.module)
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordLogger struct {
+	level LogLevel
+	msgs  []string
+}
+
+func (l *recordLogger) record(level LogLevel, msg string, fields Fields) {
+	if level > l.level {
+		return
+	}
+	if m, y := fields["Module"]; y {
+		msg = m.(string) + ": " + msg
+	}
+	l.msgs = append(l.msgs, msg)
+}
+
+func (l *recordLogger) Panic(msg string, fields Fields) { l.record(PanicLevel, msg, fields) }
+func (l *recordLogger) Fatal(msg string, fields Fields) { l.record(FatalLevel, msg, fields) }
+func (l *recordLogger) Error(msg string, fields Fields) { l.record(ErrorLevel, msg, fields) }
+func (l *recordLogger) Warn(msg string, fields Fields)  { l.record(WarnLevel, msg, fields) }
+func (l *recordLogger) Info(msg string, fields Fields)  { l.record(InfoLevel, msg, fields) }
+func (l *recordLogger) Debug(msg string, fields Fields) { l.record(DebugLevel, msg, fields) }
+func (l *recordLogger) SetLevel(level LogLevel)         { l.level = level }
+func (l *recordLogger) GetLevel() LogLevel              { return l.level }
+
+func TestModuleLevels(t *testing.T) {
+	assert := assert.New(t)
+	backend := &recordLogger{level: InfoLevel}
+	levels := NewModuleLevels(backend)
+	global := levels.Logger("")
+	fsm := levels.Logger(ModuleFsm)
+	table := levels.Logger(ModuleTable)
+
+	assert.NoError(levels.SetLevel(ModuleFsm, DebugLevel))
+	assert.Equal(DebugLevel, backend.GetLevel())
+	global.Debug("global debug", nil)
+	fsm.Debug("fsm debug", nil)
+	table.Debug("table debug", nil)
+	table.Info("table info", Fields{"Topic": "Table"})
+	assert.Equal([]string{"fsm: fsm debug", "table: table info"}, backend.msgs)
+	assert.Equal(DebugLevel, fsm.GetLevel())
+	assert.Equal(InfoLevel, table.GetLevel())
+
+	// the modules without their own levels follow the global one
+	backend.msgs = nil
+	global.SetLevel(WarnLevel)
+	assert.NoError(levels.SetLevel(ModuleTable, ErrorLevel))
+	assert.NoError(levels.UnsetLevel(ModuleFsm))
+	assert.Equal(WarnLevel, backend.GetLevel())
+	fsm.Info("fsm info", nil)
+	fsm.Warn("fsm warn", nil)
+	table.Warn("table warn", nil)
+	global.Warn("global warn", nil)
+	assert.Equal([]string{"fsm: fsm warn", "global warn"}, backend.msgs)
+	assert.Equal("warn,table=error", levels.String())
+
+	assert.Error(levels.SetLevel("unknown", DebugLevel))
+	assert.Error(levels.UnsetLevel(""))
+
+	l, err := ParseLogLevel("DEBUG")
+	assert.NoError(err)
+	assert.Equal(DebugLevel, l)
+	_, err = ParseLogLevel("verbose")
+	assert.Error(err)
+}
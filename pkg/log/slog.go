@@ -0,0 +1,106 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.21
+
+package log
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"sort"
+	"sync/atomic"
+)
+
+// SlogLogger is the Logger writing to a log/slog logger. The fields are
+// written as the attributes sorted by the keys.
+type SlogLogger struct {
+	logger *slog.Logger
+	level  atomic.Uint32
+}
+
+func NewSlogLogger(logger *slog.Logger) *SlogLogger {
+	l := &SlogLogger{logger: logger}
+	l.level.Store(uint32(InfoLevel))
+	return l
+}
+
+func slogLevel(level LogLevel) slog.Level {
+	switch level {
+	case PanicLevel, FatalLevel, ErrorLevel:
+		return slog.LevelError
+	case WarnLevel:
+		return slog.LevelWarn
+	case InfoLevel:
+		return slog.LevelInfo
+	case DebugLevel:
+		return slog.LevelDebug
+	}
+	return slog.LevelDebug - 4
+}
+
+func (l *SlogLogger) log(level LogLevel, msg string, fields Fields) {
+	if level > l.GetLevel() {
+		return
+	}
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	attrs := make([]slog.Attr, 0, len(keys))
+	for _, k := range keys {
+		attrs = append(attrs, slog.Any(k, fields[k]))
+	}
+	l.logger.LogAttrs(context.Background(), slogLevel(level), msg, attrs...)
+}
+
+func (l *SlogLogger) Panic(msg string, fields Fields) {
+	l.log(PanicLevel, msg, fields)
+	panic(fmt.Sprintf("%s %v", msg, fields))
+}
+
+func (l *SlogLogger) Fatal(msg string, fields Fields) {
+	l.log(FatalLevel, msg, fields)
+	os.Exit(1)
+}
+
+func (l *SlogLogger) Error(msg string, fields Fields) {
+	l.log(ErrorLevel, msg, fields)
+}
+
+func (l *SlogLogger) Warn(msg string, fields Fields) {
+	l.log(WarnLevel, msg, fields)
+}
+
+func (l *SlogLogger) Info(msg string, fields Fields) {
+	l.log(InfoLevel, msg, fields)
+}
+
+func (l *SlogLogger) Debug(msg string, fields Fields) {
+	l.log(DebugLevel, msg, fields)
+}
+
+// SetLevel sets the level of the messages passed to the slog logger, whose
+// handler may filter them further.
+func (l *SlogLogger) SetLevel(level LogLevel) {
+	l.level.Store(uint32(level))
+}
+
+func (l *SlogLogger) GetLevel() LogLevel {
+	return LogLevel(l.level.Load())
+}
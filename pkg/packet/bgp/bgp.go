@@ -35,6 +35,43 @@ type MarshallingOption struct {
 	AddPath        map[RouteFamily]BGPAddPathMode
 	Attributes     map[BGPAttrType]bool
 	ImplicitPrefix AddrPrefixInterface
+	// Lenient makes the decoding of UPDATE messages go on past the
+	// malformed path attributes as far as RFC 7606 allows, and collects
+	// their errors in MessageError.Errors.
+	Lenient bool
+	// ExtendedMessage allows UPDATE, NOTIFICATION and ROUTE-REFRESH
+	// messages up to 65535 bytes (RFC 8654).
+	ExtendedMessage bool
+}
+
+// IsLenient returns true if the lenient decoding is enabled.
+func IsLenient(options []*MarshallingOption) bool {
+	for _, opt := range options {
+		if opt != nil && opt.Lenient {
+			return true
+		}
+	}
+	return false
+}
+
+// IsExtendedMessage returns true if the extended message capability is
+// negotiated.
+func IsExtendedMessage(options []*MarshallingOption) bool {
+	for _, opt := range options {
+		if opt != nil && opt.ExtendedMessage {
+			return true
+		}
+	}
+	return false
+}
+
+// GetMaxMessageLength returns the maximum length of the BGP message of
+// the given type. OPEN and KEEPALIVE messages are never extended.
+func GetMaxMessageLength(t uint8, options []*MarshallingOption) int {
+	if t != BGP_MSG_OPEN && t != BGP_MSG_KEEPALIVE && IsExtendedMessage(options) {
+		return BGP_MAX_EXTENDED_MESSAGE_LENGTH
+	}
+	return BGP_MAX_MESSAGE_LENGTH
 }
 
 // GetImplicitPrefix gets the implicit prefix associated with decoding/serialisation. This is used for
@@ -89,11 +126,14 @@ const (
 	SAFI_UNICAST                  = 1
 	SAFI_MULTICAST                = 2
 	SAFI_MPLS_LABEL               = 4
+	SAFI_MCAST_VPN                = 5
 	SAFI_ENCAPSULATION            = 7
 	SAFI_VPLS                     = 65
 	SAFI_EVPN                     = 70
 	SAFI_LS                       = 71
 	SAFI_SRPOLICY                 = 73
+	SAFI_CLASSFUL_TRANSPORT       = 76
+	SAFI_CAR                      = 83
 	SAFI_MUP                      = 85
 	SAFI_MPLS_VPN                 = 128
 	SAFI_MPLS_VPN_MULTICAST       = 129
@@ -140,7 +180,9 @@ const (
 	EC_TYPE_TRANSITIVE_QOS_MARKING                ExtendedCommunityAttrType = 0x04
 	EC_TYPE_COS_CAPABILITY                        ExtendedCommunityAttrType = 0x05
 	EC_TYPE_EVPN                                  ExtendedCommunityAttrType = 0x06
+	EC_TYPE_FLOWSPEC_TRANSITIVE                   ExtendedCommunityAttrType = 0x07 // draft-ietf-idr-flowspec-interfaceset
 	EC_TYPE_FLOWSPEC_REDIRECT_MIRROR              ExtendedCommunityAttrType = 0x08
+	EC_TYPE_TRANSITIVE_TRANSPORT_CLASS            ExtendedCommunityAttrType = 0x0a // draft-ietf-idr-bgp-ct
 	EC_TYPE_MUP                                   ExtendedCommunityAttrType = 0x0c
 	EC_TYPE_NON_TRANSITIVE_TWO_OCTET_AS_SPECIFIC  ExtendedCommunityAttrType = 0x40
 	EC_TYPE_NON_TRANSITIVE_LINK_BANDWIDTH         ExtendedCommunityAttrType = 0x40
@@ -149,6 +191,7 @@ const (
 	EC_TYPE_NON_TRANSITIVE_FOUR_OCTET_AS_SPECIFIC ExtendedCommunityAttrType = 0x42
 	EC_TYPE_NON_TRANSITIVE_OPAQUE                 ExtendedCommunityAttrType = 0x43
 	EC_TYPE_NON_TRANSITIVE_QOS_MARKING            ExtendedCommunityAttrType = 0x44
+	EC_TYPE_NON_TRANSITIVE_TRANSPORT_CLASS        ExtendedCommunityAttrType = 0x4a // draft-ietf-idr-bgp-ct
 	EC_TYPE_GENERIC_TRANSITIVE_EXPERIMENTAL       ExtendedCommunityAttrType = 0x80
 	EC_TYPE_GENERIC_TRANSITIVE_EXPERIMENTAL2      ExtendedCommunityAttrType = 0x81 // RFC7674
 	EC_TYPE_GENERIC_TRANSITIVE_EXPERIMENTAL3      ExtendedCommunityAttrType = 0x82 // RFC7674
@@ -189,11 +232,14 @@ const (
 	EC_SUBTYPE_L2_INFO                 ExtendedCommunityAttrSubType = 0x0A // EC_TYPE: 0x80
 	EC_SUBTYPE_FLOWSPEC_REDIRECT_IP6   ExtendedCommunityAttrSubType = 0x0B // EC_TYPE: 0x80
 
+	EC_SUBTYPE_FLOWSPEC_INTERFACE_SET ExtendedCommunityAttrSubType = 0x02 // EC_TYPE: 0x07
+
 	EC_SUBTYPE_MAC_MOBILITY  ExtendedCommunityAttrSubType = 0x00 // EC_TYPE: 0x06
 	EC_SUBTYPE_ESI_LABEL     ExtendedCommunityAttrSubType = 0x01 // EC_TYPE: 0x06
 	EC_SUBTYPE_ES_IMPORT     ExtendedCommunityAttrSubType = 0x02 // EC_TYPE: 0x06
 	EC_SUBTYPE_ROUTER_MAC    ExtendedCommunityAttrSubType = 0x03 // EC_TYPE: 0x06
 	EC_SUBTYPE_L2_ATTRIBUTES ExtendedCommunityAttrSubType = 0x04 // EC_TYPE: 0x06
+	EC_SUBTYPE_DF_ELECTION   ExtendedCommunityAttrSubType = 0x06 // EC_TYPE: 0x06
 
 	EC_SUBTYPE_UUID_BASED_RT ExtendedCommunityAttrSubType = 0x11
 )
@@ -335,7 +381,11 @@ const (
 	ENCAP_SUBTLV_TYPE_PROTOCOL              EncapSubTLVType = 2
 	ENCAP_SUBTLV_TYPE_COLOR                 EncapSubTLVType = 4
 	ENCAP_SUBTLV_TYPE_EGRESS_ENDPOINT       EncapSubTLVType = 6
+	ENCAP_SUBTLV_TYPE_DS_FIELD              EncapSubTLVType = 7
 	ENCAP_SUBTLV_TYPE_UDP_DEST_PORT         EncapSubTLVType = 8
+	ENCAP_SUBTLV_TYPE_EMBEDDED_LABEL        EncapSubTLVType = 9
+	ENCAP_SUBTLV_TYPE_MPLS_LABEL_STACK      EncapSubTLVType = 10
+	ENCAP_SUBTLV_TYPE_PREFIX_SID            EncapSubTLVType = 11
 	ENCAP_SUBTLV_TYPE_SRPREFERENCE          EncapSubTLVType = 12
 	ENCAP_SUBTLV_TYPE_SRBINDING_SID         EncapSubTLVType = 13
 	ENCAP_SUBTLV_TYPE_SRENLP                EncapSubTLVType = 14
@@ -351,6 +401,7 @@ const (
 	BGP_MSG_NOTIFICATION
 	BGP_MSG_KEEPALIVE
 	BGP_MSG_ROUTE_REFRESH
+	BGP_MSG_CAPABILITY
 )
 
 const (
@@ -362,10 +413,13 @@ type BGPCapabilityCode uint8
 const (
 	BGP_CAP_MULTIPROTOCOL               BGPCapabilityCode = 1
 	BGP_CAP_ROUTE_REFRESH               BGPCapabilityCode = 2
+	BGP_CAP_OUTBOUND_ROUTE_FILTERING    BGPCapabilityCode = 3
 	BGP_CAP_CARRYING_LABEL_INFO         BGPCapabilityCode = 4
 	BGP_CAP_EXTENDED_NEXTHOP            BGPCapabilityCode = 5
+	BGP_CAP_EXTENDED_MESSAGE            BGPCapabilityCode = 6
 	BGP_CAP_GRACEFUL_RESTART            BGPCapabilityCode = 64
 	BGP_CAP_FOUR_OCTET_AS_NUMBER        BGPCapabilityCode = 65
+	BGP_CAP_DYNAMIC                     BGPCapabilityCode = 67
 	BGP_CAP_ADD_PATH                    BGPCapabilityCode = 69
 	BGP_CAP_ENHANCED_ROUTE_REFRESH      BGPCapabilityCode = 70
 	BGP_CAP_LONG_LIVED_GRACEFUL_RESTART BGPCapabilityCode = 71
@@ -377,10 +431,13 @@ const (
 var CapNameMap = map[BGPCapabilityCode]string{
 	BGP_CAP_MULTIPROTOCOL:               "multiprotocol",
 	BGP_CAP_ROUTE_REFRESH:               "route-refresh",
+	BGP_CAP_OUTBOUND_ROUTE_FILTERING:    "outbound-route-filtering",
 	BGP_CAP_CARRYING_LABEL_INFO:         "carrying-label-info",
 	BGP_CAP_GRACEFUL_RESTART:            "graceful-restart",
 	BGP_CAP_EXTENDED_NEXTHOP:            "extended-nexthop",
+	BGP_CAP_EXTENDED_MESSAGE:            "extended-message",
 	BGP_CAP_FOUR_OCTET_AS_NUMBER:        "4-octet-as",
+	BGP_CAP_DYNAMIC:                     "dynamic",
 	BGP_CAP_ADD_PATH:                    "add-path",
 	BGP_CAP_ENHANCED_ROUTE_REFRESH:      "enhanced-route-refresh",
 	BGP_CAP_ROUTE_REFRESH_CISCO:         "cisco-route-refresh",
@@ -538,6 +595,224 @@ func NewCapRouteRefresh() *CapRouteRefresh {
 	}
 }
 
+// CapExtendedMessage is the BGP Extended Message Capability (RFC 8654).
+type CapExtendedMessage struct {
+	DefaultParameterCapability
+}
+
+func NewCapExtendedMessage() *CapExtendedMessage {
+	return &CapExtendedMessage{
+		DefaultParameterCapability{
+			CapCode: BGP_CAP_EXTENDED_MESSAGE,
+		},
+	}
+}
+
+// CapDynamic is the Dynamic Capability (draft-ietf-idr-dynamic-cap)
+// which lists the codes of the capabilities the speaker can revise with
+// the CAPABILITY message.
+type CapDynamic struct {
+	DefaultParameterCapability
+	CapCodes []BGPCapabilityCode
+}
+
+func (c *CapDynamic) DecodeFromBytes(data []byte) error {
+	if err := c.DefaultParameterCapability.DecodeFromBytes(data); err != nil {
+		return err
+	}
+	c.CapCodes = make([]BGPCapabilityCode, 0, c.CapLen)
+	for _, code := range data[2 : 2+c.CapLen] {
+		c.CapCodes = append(c.CapCodes, BGPCapabilityCode(code))
+	}
+	return nil
+}
+
+func (c *CapDynamic) Serialize() ([]byte, error) {
+	buf := make([]byte, 0, len(c.CapCodes))
+	for _, code := range c.CapCodes {
+		buf = append(buf, uint8(code))
+	}
+	c.DefaultParameterCapability.CapValue = buf
+	return c.DefaultParameterCapability.Serialize()
+}
+
+func (c *CapDynamic) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Code     BGPCapabilityCode   `json:"code"`
+		CapCodes []BGPCapabilityCode `json:"capabilities"`
+	}{
+		Code:     c.Code(),
+		CapCodes: c.CapCodes,
+	})
+}
+
+// IsDynamic returns true if the capability of the code can be revised.
+// The empty list means that any capability can be.
+func (c *CapDynamic) IsDynamic(code BGPCapabilityCode) bool {
+	if len(c.CapCodes) == 0 {
+		return true
+	}
+	for _, d := range c.CapCodes {
+		if d == code {
+			return true
+		}
+	}
+	return false
+}
+
+func NewCapDynamic(codes []BGPCapabilityCode) *CapDynamic {
+	return &CapDynamic{
+		DefaultParameterCapability{
+			CapCode: BGP_CAP_DYNAMIC,
+		},
+		codes,
+	}
+}
+
+type BGPORFType uint8
+
+const (
+	ORF_TYPE_ADDRESS_PREFIX BGPORFType = 64
+)
+
+func (t BGPORFType) String() string {
+	switch t {
+	case ORF_TYPE_ADDRESS_PREFIX:
+		return "address-prefix"
+	default:
+		return fmt.Sprintf("unknown(%d)", t)
+	}
+}
+
+type BGPORFMode uint8
+
+const (
+	BGP_ORF_NONE BGPORFMode = iota
+	BGP_ORF_RECEIVE
+	BGP_ORF_SEND
+	BGP_ORF_BOTH
+)
+
+func (m BGPORFMode) String() string {
+	switch m {
+	case BGP_ORF_NONE:
+		return "none"
+	case BGP_ORF_RECEIVE:
+		return "receive"
+	case BGP_ORF_SEND:
+		return "send"
+	case BGP_ORF_BOTH:
+		return "receive/send"
+	default:
+		return fmt.Sprintf("unknown(%d)", m)
+	}
+}
+
+type CapORFEntry struct {
+	Type BGPORFType `json:"type"`
+	Mode BGPORFMode `json:"mode"`
+}
+
+type CapOutboundRouteFilteringTuple struct {
+	RouteFamily RouteFamily
+	Entries     []*CapORFEntry
+}
+
+func (t *CapOutboundRouteFilteringTuple) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		RouteFamily RouteFamily    `json:"family"`
+		Entries     []*CapORFEntry `json:"entries"`
+	}{
+		RouteFamily: t.RouteFamily,
+		Entries:     t.Entries,
+	})
+}
+
+func NewCapOutboundRouteFilteringTuple(family RouteFamily, entries []*CapORFEntry) *CapOutboundRouteFilteringTuple {
+	return &CapOutboundRouteFilteringTuple{
+		RouteFamily: family,
+		Entries:     entries,
+	}
+}
+
+// CapOutboundRouteFiltering is the Outbound Route Filtering Capability
+// defined in RFC 5291.
+type CapOutboundRouteFiltering struct {
+	DefaultParameterCapability
+	Tuples []*CapOutboundRouteFilteringTuple
+}
+
+func (c *CapOutboundRouteFiltering) DecodeFromBytes(data []byte) error {
+	c.DefaultParameterCapability.DecodeFromBytes(data)
+	data = data[2:]
+	capLen := int(c.CapLen)
+	if len(data) < capLen {
+		return NewMessageError(BGP_ERROR_OPEN_MESSAGE_ERROR, BGP_ERROR_SUB_UNSUPPORTED_CAPABILITY, nil, "Not all CapabilityOutboundRouteFiltering bytes available")
+	}
+	data = data[:capLen]
+
+	c.Tuples = []*CapOutboundRouteFilteringTuple{}
+	for len(data) > 0 {
+		if len(data) < 5 {
+			return NewMessageError(BGP_ERROR_OPEN_MESSAGE_ERROR, BGP_ERROR_SUB_UNSUPPORTED_CAPABILITY, nil, "Not all CapabilityOutboundRouteFiltering bytes available")
+		}
+		t := &CapOutboundRouteFilteringTuple{
+			RouteFamily: AfiSafiToRouteFamily(binary.BigEndian.Uint16(data[:2]), data[3]),
+		}
+		num := int(data[4])
+		data = data[5:]
+		if len(data) < num*2 {
+			return NewMessageError(BGP_ERROR_OPEN_MESSAGE_ERROR, BGP_ERROR_SUB_UNSUPPORTED_CAPABILITY, nil, "Not all CapabilityOutboundRouteFiltering bytes available")
+		}
+		t.Entries = make([]*CapORFEntry, 0, num)
+		for i := 0; i < num; i++ {
+			t.Entries = append(t.Entries, &CapORFEntry{
+				Type: BGPORFType(data[0]),
+				Mode: BGPORFMode(data[1]),
+			})
+			data = data[2:]
+		}
+		c.Tuples = append(c.Tuples, t)
+	}
+	return nil
+}
+
+func (c *CapOutboundRouteFiltering) Serialize() ([]byte, error) {
+	buf := make([]byte, 0, 7*len(c.Tuples))
+	for _, t := range c.Tuples {
+		afi, safi := RouteFamilyToAfiSafi(t.RouteFamily)
+		b := make([]byte, 5, 5+2*len(t.Entries))
+		binary.BigEndian.PutUint16(b[0:2], afi)
+		b[3] = safi
+		b[4] = uint8(len(t.Entries))
+		for _, e := range t.Entries {
+			b = append(b, uint8(e.Type), uint8(e.Mode))
+		}
+		buf = append(buf, b...)
+	}
+	c.DefaultParameterCapability.CapValue = buf
+	return c.DefaultParameterCapability.Serialize()
+}
+
+func (c *CapOutboundRouteFiltering) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Code   BGPCapabilityCode                 `json:"code"`
+		Tuples []*CapOutboundRouteFilteringTuple `json:"tuples"`
+	}{
+		Code:   c.Code(),
+		Tuples: c.Tuples,
+	})
+}
+
+func NewCapOutboundRouteFiltering(tuples []*CapOutboundRouteFilteringTuple) *CapOutboundRouteFiltering {
+	return &CapOutboundRouteFiltering{
+		DefaultParameterCapability: DefaultParameterCapability{
+			CapCode: BGP_CAP_OUTBOUND_ROUTE_FILTERING,
+		},
+		Tuples: tuples,
+	}
+}
+
 type CapCarryingLabelInfo struct {
 	DefaultParameterCapability
 }
@@ -1157,14 +1432,20 @@ func DecodeCapability(data []byte) (ParameterCapabilityInterface, error) {
 		c = &CapMultiProtocol{}
 	case BGP_CAP_ROUTE_REFRESH:
 		c = &CapRouteRefresh{}
+	case BGP_CAP_OUTBOUND_ROUTE_FILTERING:
+		c = &CapOutboundRouteFiltering{}
 	case BGP_CAP_CARRYING_LABEL_INFO:
 		c = &CapCarryingLabelInfo{}
 	case BGP_CAP_EXTENDED_NEXTHOP:
 		c = &CapExtendedNexthop{}
+	case BGP_CAP_EXTENDED_MESSAGE:
+		c = &CapExtendedMessage{}
 	case BGP_CAP_GRACEFUL_RESTART:
 		c = &CapGracefulRestart{}
 	case BGP_CAP_FOUR_OCTET_AS_NUMBER:
 		c = &CapFourOctetASNumber{}
+	case BGP_CAP_DYNAMIC:
+		c = &CapDynamic{}
 	case BGP_CAP_ADD_PATH:
 		c = &CapAddPath{}
 	case BGP_CAP_ENHANCED_ROUTE_REFRESH:
@@ -1358,6 +1639,14 @@ func LabelString(nlri AddrPrefixInterface) string {
 		label = n.Labels.String()
 	case *LabeledVPNIPv6AddrPrefix:
 		label = n.Labels.String()
+	case *CTIPAddrPrefix:
+		label = n.Labels.String()
+	case *CTIPv6AddrPrefix:
+		label = n.Labels.String()
+	case *CARNLRI:
+		if labels := n.Labels(); len(labels) > 0 {
+			label = (&MPLSLabelStack{labels}).String()
+		}
 	case *EVPNNLRI:
 		switch route := n.RouteTypeData.(type) {
 		case *EVPNEthernetAutoDiscoveryRoute:
@@ -2015,6 +2304,12 @@ func (l *LabeledVPNIPAddrPrefix) DecodeFromBytes(data []byte, options ...*Marsha
 	if l.addrlen == 16 {
 		f = RF_IPv6_VPN
 	}
+	return l.decodeFromBytes(f, data, options...)
+}
+
+// decodeFromBytes decodes the RFC8277 label, RD and prefix encoding shared
+// with the other SAFIs; the family is used to look up the ADD-PATH mode.
+func (l *LabeledVPNIPAddrPrefix) decodeFromBytes(f RouteFamily, data []byte, options ...*MarshallingOption) error {
 	if IsAddPathEnabled(true, f, options) {
 		var err error
 		data, err = l.decodePathIdentifier(data)
@@ -2047,6 +2342,10 @@ func (l *LabeledVPNIPAddrPrefix) Serialize(options ...*MarshallingOption) ([]byt
 	if l.addrlen == 16 {
 		f = RF_IPv6_VPN
 	}
+	return l.serialize(f, options...)
+}
+
+func (l *LabeledVPNIPAddrPrefix) serialize(f RouteFamily, options ...*MarshallingOption) ([]byte, error) {
 	var buf []byte
 	if IsAddPathEnabled(false, f, options) {
 		var err error
@@ -3246,118 +3545,521 @@ func NewEVPNIPPrefixRoute(rd RouteDistinguisherInterface, esi EthernetSegmentIde
 	})
 }
 
-type EVPNIPMSIRoute struct {
-	RD   RouteDistinguisherInterface
-	ETag uint32
-	EC   ExtendedCommunityInterface
+// RFC9251 9. BGP Encoding
+//
+// The Flags field of the IGMP/MLD proxy routes.
+const (
+	EVPN_MULTICAST_FLAG_V1      = 1 << 0
+	EVPN_MULTICAST_FLAG_V2      = 1 << 1
+	EVPN_MULTICAST_FLAG_V3      = 1 << 2
+	EVPN_MULTICAST_FLAG_EXCLUDE = 1 << 3
+)
+
+// evpnMulticastAddrLen returns the length of the multicast source, group or
+// originating router's address field with the length octet.
+func evpnMulticastAddrLen(ip net.IP) int {
+	if ip == nil {
+		return 1
+	}
+	if ip.To4() != nil {
+		return 1 + net.IPv4len
+	}
+	return 1 + net.IPv6len
 }
 
-func (er *EVPNIPMSIRoute) Len() int {
-	// RD(8) + ETag(4) + EC(8)
-	return 20
+// decodeEVPNMulticastAddr decodes the address field with the length in
+// bits, and returns the rest of the data. The zero length is allowed only
+// if optional is true.
+func decodeEVPNMulticastAddr(data []byte, ip *net.IP, optional bool) ([]byte, error) {
+	if len(data) < 1 {
+		return nil, NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "address length is missing")
+	}
+	l := int(data[0])
+	switch {
+	case l == 0 && optional:
+		*ip = nil
+		return data[1:], nil
+	case l == 32 || l == 128:
+	default:
+		return nil, NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, fmt.Sprintf("Invalid IP address length: %d", l))
+	}
+	data = data[1:]
+	if len(data) < l/8 {
+		return nil, NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "not all address bytes available")
+	}
+	*ip = net.IP(append([]byte(nil), data[:l/8]...))
+	return data[l/8:], nil
 }
 
-func (er *EVPNIPMSIRoute) DecodeFromBytes(data []byte) error {
+func serializeEVPNMulticastAddr(buf []byte, ip net.IP) []byte {
+	if ip == nil {
+		return append(buf, 0)
+	}
+	if v4 := ip.To4(); v4 != nil {
+		return append(append(buf, 32), v4...)
+	}
+	return append(append(buf, 128), ip.To16()...)
+}
 
-	er.RD = GetRouteDistinguisher(data[0:8])
+func evpnMulticastSourceString(ip net.IP) string {
+	if ip == nil {
+		return "*"
+	}
+	return ip.String()
+}
+
+// parseEVPNMulticastAddr returns nil for the empty string.
+func parseEVPNMulticastAddr(s string) net.IP {
+	if s == "" {
+		return nil
+	}
+	ip := net.ParseIP(s)
+	if v4 := ip.To4(); v4 != nil {
+		return v4
+	}
+	return ip
+}
+
+// EVPNSelectiveMulticastEthernetTagRoute represents the Selective Multicast
+// Ethernet Tag (SMET) route of RFC9251 9.1. The source is nil for (*,G).
+type EVPNSelectiveMulticastEthernetTagRoute struct {
+	RD                RouteDistinguisherInterface
+	ETag              uint32
+	SourceAddress     net.IP
+	GroupAddress      net.IP
+	OriginatorAddress net.IP
+	Flags             uint8
+}
+
+func (er *EVPNSelectiveMulticastEthernetTagRoute) Len() int {
+	// RD(8) + ETag(4) + Source + Group + Originator + Flags(1)
+	return 13 + evpnMulticastAddrLen(er.SourceAddress) + evpnMulticastAddrLen(er.GroupAddress) + evpnMulticastAddrLen(er.OriginatorAddress)
+}
 
+func (er *EVPNSelectiveMulticastEthernetTagRoute) DecodeFromBytes(data []byte) error {
+	if len(data) < 12 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "invalid length of selective multicast ethernet tag route")
+	}
+	er.RD = GetRouteDistinguisher(data)
 	data = data[er.RD.Len():]
 	er.ETag = binary.BigEndian.Uint32(data[0:4])
-
-	data = data[4:]
-	ec, err := ParseExtended(data[0:8])
+	data, err := decodeEVPNMulticastAddrs(data[4:], &er.SourceAddress, &er.GroupAddress, &er.OriginatorAddress)
 	if err != nil {
-		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Parse extended community interface failed")
+		return err
 	}
-	er.EC = ec
+	if len(data) < 1 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "flags are missing")
+	}
+	er.Flags = data[0]
 	return nil
 }
 
-func (er *EVPNIPMSIRoute) Serialize() ([]byte, error) {
-	buf := make([]byte, 20)
-
-	if er.RD != nil {
-		tbuf, err := er.RD.Serialize()
-		if err != nil {
-			return nil, err
-		}
-		copy(buf[0:8], tbuf)
-	}
-
-	binary.BigEndian.PutUint32(buf[8:12], er.ETag)
-
-	ec, err := er.EC.Serialize()
+func (er *EVPNSelectiveMulticastEthernetTagRoute) Serialize() ([]byte, error) {
+	buf, err := serializeEVPNRD(er.RD)
 	if err != nil {
 		return nil, err
 	}
-
-	return append(buf, ec...), nil
+	buf = binary.BigEndian.AppendUint32(buf, er.ETag)
+	buf = serializeEVPNMulticastAddr(buf, er.SourceAddress)
+	buf = serializeEVPNMulticastAddr(buf, er.GroupAddress)
+	buf = serializeEVPNMulticastAddr(buf, er.OriginatorAddress)
+	return append(buf, er.Flags), nil
 }
 
-func (er *EVPNIPMSIRoute) String() string {
-	ec := "default"
-	if er.EC != nil {
-		ec = er.EC.String()
-	}
-	return fmt.Sprintf("[type:I-PMSI][rd:%s][etag:%d][EC:%s]", er.RD, er.ETag, ec)
+func (er *EVPNSelectiveMulticastEthernetTagRoute) String() string {
+	// RFC9251 9.1. Selective Multicast Ethernet Tag Route
+	// ...(snip)... For the purpose of BGP route key processing, all the
+	// fields are considered to be part of the prefix in the NLRI except
+	// for the one-octet Flags field.
+	return fmt.Sprintf("[type:smet][rd:%s][etag:%d][source:%s][group:%s][ip:%s]", er.RD, er.ETag, evpnMulticastSourceString(er.SourceAddress), er.GroupAddress, er.OriginatorAddress)
 }
 
-func (er *EVPNIPMSIRoute) MarshalJSON() ([]byte, error) {
+func (er *EVPNSelectiveMulticastEthernetTagRoute) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
-		RD   RouteDistinguisherInterface `json:"rd"`
-		ETag uint32                      `json:"etag"`
-		EC   string                      `json:"ec"`
+		RD                RouteDistinguisherInterface `json:"rd"`
+		ETag              uint32                      `json:"etag"`
+		SourceAddress     string                      `json:"source"`
+		GroupAddress      string                      `json:"group"`
+		OriginatorAddress string                      `json:"ip"`
+		Flags             uint8                       `json:"flags"`
 	}{
-		RD:   er.RD,
-		ETag: er.ETag,
-		EC:   er.EC.String(),
+		RD:                er.RD,
+		ETag:              er.ETag,
+		SourceAddress:     evpnMulticastSourceString(er.SourceAddress),
+		GroupAddress:      er.GroupAddress.String(),
+		OriginatorAddress: er.OriginatorAddress.String(),
+		Flags:             er.Flags,
 	})
 }
 
-func (er *EVPNIPMSIRoute) rd() RouteDistinguisherInterface {
+func (er *EVPNSelectiveMulticastEthernetTagRoute) rd() RouteDistinguisherInterface {
 	return er.RD
 }
 
-func NewEVPNIPMSIRoute(rd RouteDistinguisherInterface, etag uint32, ec ExtendedCommunityInterface) *EVPNNLRI {
-
-	return NewEVPNNLRI(EVPN_I_PMSI, &EVPNIPMSIRoute{
-		RD:   rd,
-		ETag: etag,
-		EC:   ec,
+func NewEVPNSelectiveMulticastEthernetTagRoute(rd RouteDistinguisherInterface, etag uint32, source, group, originator string, flags uint8) *EVPNNLRI {
+	return NewEVPNNLRI(EVPN_SELECTIVE_MULTICAST_ETHERNET_TAG, &EVPNSelectiveMulticastEthernetTagRoute{
+		RD:                rd,
+		ETag:              etag,
+		SourceAddress:     parseEVPNMulticastAddr(source),
+		GroupAddress:      parseEVPNMulticastAddr(group),
+		OriginatorAddress: parseEVPNMulticastAddr(originator),
+		Flags:             flags,
 	})
 }
 
-type EVPNRouteTypeInterface interface {
-	Len() int
-	DecodeFromBytes([]byte) error
-	Serialize() ([]byte, error)
-	String() string
-	rd() RouteDistinguisherInterface
-	MarshalJSON() ([]byte, error)
+// EVPNMulticastJoinSynchRoute represents the Multicast Join Synch route of
+// RFC9251 9.2, which synchronizes the IGMP/MLD Join states among the PEs
+// attached to the Ethernet Segment.
+type EVPNMulticastJoinSynchRoute struct {
+	RD                RouteDistinguisherInterface
+	ESI               EthernetSegmentIdentifier
+	ETag              uint32
+	SourceAddress     net.IP
+	GroupAddress      net.IP
+	OriginatorAddress net.IP
+	Flags             uint8
 }
 
-func getEVPNRouteType(t uint8) (EVPNRouteTypeInterface, error) {
-	switch t {
-	case EVPN_ROUTE_TYPE_ETHERNET_AUTO_DISCOVERY:
-		return &EVPNEthernetAutoDiscoveryRoute{}, nil
-	case EVPN_ROUTE_TYPE_MAC_IP_ADVERTISEMENT:
-		return &EVPNMacIPAdvertisementRoute{}, nil
-	case EVPN_INCLUSIVE_MULTICAST_ETHERNET_TAG:
-		return &EVPNMulticastEthernetTagRoute{}, nil
-	case EVPN_ETHERNET_SEGMENT_ROUTE:
-		return &EVPNEthernetSegmentRoute{}, nil
-	case EVPN_IP_PREFIX:
-		return &EVPNIPPrefixRoute{}, nil
-	}
-	return nil, NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, fmt.Sprintf("Unknown EVPN Route type: %d", t))
+func (er *EVPNMulticastJoinSynchRoute) Len() int {
+	// RD(8) + ESI(10) + ETag(4) + Source + Group + Originator + Flags(1)
+	return 23 + evpnMulticastAddrLen(er.SourceAddress) + evpnMulticastAddrLen(er.GroupAddress) + evpnMulticastAddrLen(er.OriginatorAddress)
 }
 
-const (
-	EVPN_ROUTE_TYPE_ETHERNET_AUTO_DISCOVERY = 1
-	EVPN_ROUTE_TYPE_MAC_IP_ADVERTISEMENT    = 2
-	EVPN_INCLUSIVE_MULTICAST_ETHERNET_TAG   = 3
-	EVPN_ETHERNET_SEGMENT_ROUTE             = 4
-	EVPN_IP_PREFIX                          = 5
+func (er *EVPNMulticastJoinSynchRoute) DecodeFromBytes(data []byte) error {
+	if len(data) < 22 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "invalid length of multicast join synch route")
+	}
+	er.RD = GetRouteDistinguisher(data)
+	data = data[er.RD.Len():]
+	if err := er.ESI.DecodeFromBytes(data); err != nil {
+		return err
+	}
+	er.ETag = binary.BigEndian.Uint32(data[10:14])
+	data, err := decodeEVPNMulticastAddrs(data[14:], &er.SourceAddress, &er.GroupAddress, &er.OriginatorAddress)
+	if err != nil {
+		return err
+	}
+	if len(data) < 1 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "flags are missing")
+	}
+	er.Flags = data[0]
+	return nil
+}
+
+func (er *EVPNMulticastJoinSynchRoute) Serialize() ([]byte, error) {
+	buf, err := serializeEVPNRD(er.RD)
+	if err != nil {
+		return nil, err
+	}
+	esi, err := er.ESI.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	buf = append(buf, esi...)
+	buf = binary.BigEndian.AppendUint32(buf, er.ETag)
+	buf = serializeEVPNMulticastAddr(buf, er.SourceAddress)
+	buf = serializeEVPNMulticastAddr(buf, er.GroupAddress)
+	buf = serializeEVPNMulticastAddr(buf, er.OriginatorAddress)
+	return append(buf, er.Flags), nil
+}
+
+func (er *EVPNMulticastJoinSynchRoute) String() string {
+	// RFC9251 9.2. Multicast Membership Report Synch Route
+	// ...(snip)... For the purpose of BGP route key processing, all the
+	// fields are considered to be part of the prefix in the NLRI except
+	// for the one-octet Flags field.
+	return fmt.Sprintf("[type:join-sync][rd:%s][esi:%s][etag:%d][source:%s][group:%s][ip:%s]", er.RD, er.ESI.String(), er.ETag, evpnMulticastSourceString(er.SourceAddress), er.GroupAddress, er.OriginatorAddress)
+}
+
+func (er *EVPNMulticastJoinSynchRoute) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		RD                RouteDistinguisherInterface `json:"rd"`
+		ESI               string                      `json:"esi"`
+		ETag              uint32                      `json:"etag"`
+		SourceAddress     string                      `json:"source"`
+		GroupAddress      string                      `json:"group"`
+		OriginatorAddress string                      `json:"ip"`
+		Flags             uint8                       `json:"flags"`
+	}{
+		RD:                er.RD,
+		ESI:               er.ESI.String(),
+		ETag:              er.ETag,
+		SourceAddress:     evpnMulticastSourceString(er.SourceAddress),
+		GroupAddress:      er.GroupAddress.String(),
+		OriginatorAddress: er.OriginatorAddress.String(),
+		Flags:             er.Flags,
+	})
+}
+
+func (er *EVPNMulticastJoinSynchRoute) rd() RouteDistinguisherInterface {
+	return er.RD
+}
+
+func NewEVPNMulticastJoinSynchRoute(rd RouteDistinguisherInterface, esi EthernetSegmentIdentifier, etag uint32, source, group, originator string, flags uint8) *EVPNNLRI {
+	return NewEVPNNLRI(EVPN_MULTICAST_JOIN_SYNCH, &EVPNMulticastJoinSynchRoute{
+		RD:                rd,
+		ESI:               esi,
+		ETag:              etag,
+		SourceAddress:     parseEVPNMulticastAddr(source),
+		GroupAddress:      parseEVPNMulticastAddr(group),
+		OriginatorAddress: parseEVPNMulticastAddr(originator),
+		Flags:             flags,
+	})
+}
+
+// EVPNMulticastLeaveSynchRoute represents the Multicast Leave Synch route
+// of RFC9251 9.3, which synchronizes the IGMP/MLD Leave states among the
+// PEs attached to the Ethernet Segment.
+type EVPNMulticastLeaveSynchRoute struct {
+	RD                RouteDistinguisherInterface
+	ESI               EthernetSegmentIdentifier
+	ETag              uint32
+	SourceAddress     net.IP
+	GroupAddress      net.IP
+	OriginatorAddress net.IP
+	SyncNumber        uint32
+	MaxResponseTime   uint8
+	Flags             uint8
+}
+
+func (er *EVPNMulticastLeaveSynchRoute) Len() int {
+	// RD(8) + ESI(10) + ETag(4) + Source + Group + Originator +
+	// Leave Group Synchronization #(4) + Maximum Response Time(1) + Flags(1)
+	return 28 + evpnMulticastAddrLen(er.SourceAddress) + evpnMulticastAddrLen(er.GroupAddress) + evpnMulticastAddrLen(er.OriginatorAddress)
+}
+
+func (er *EVPNMulticastLeaveSynchRoute) DecodeFromBytes(data []byte) error {
+	if len(data) < 22 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "invalid length of multicast leave synch route")
+	}
+	er.RD = GetRouteDistinguisher(data)
+	data = data[er.RD.Len():]
+	if err := er.ESI.DecodeFromBytes(data); err != nil {
+		return err
+	}
+	er.ETag = binary.BigEndian.Uint32(data[10:14])
+	data, err := decodeEVPNMulticastAddrs(data[14:], &er.SourceAddress, &er.GroupAddress, &er.OriginatorAddress)
+	if err != nil {
+		return err
+	}
+	if len(data) < 6 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "invalid length of multicast leave synch route")
+	}
+	er.SyncNumber = binary.BigEndian.Uint32(data[0:4])
+	er.MaxResponseTime = data[4]
+	er.Flags = data[5]
+	return nil
+}
+
+func (er *EVPNMulticastLeaveSynchRoute) Serialize() ([]byte, error) {
+	buf, err := serializeEVPNRD(er.RD)
+	if err != nil {
+		return nil, err
+	}
+	esi, err := er.ESI.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	buf = append(buf, esi...)
+	buf = binary.BigEndian.AppendUint32(buf, er.ETag)
+	buf = serializeEVPNMulticastAddr(buf, er.SourceAddress)
+	buf = serializeEVPNMulticastAddr(buf, er.GroupAddress)
+	buf = serializeEVPNMulticastAddr(buf, er.OriginatorAddress)
+	buf = binary.BigEndian.AppendUint32(buf, er.SyncNumber)
+	return append(buf, er.MaxResponseTime, er.Flags), nil
+}
+
+func (er *EVPNMulticastLeaveSynchRoute) String() string {
+	// RFC9251 9.3. Multicast Leave Synch Route
+	// ...(snip)... For the purpose of BGP route key processing, all the
+	// fields are considered to be part of the prefix in the NLRI except
+	// for the Maximum Response Time and the flags fields.
+	return fmt.Sprintf("[type:leave-sync][rd:%s][esi:%s][etag:%d][source:%s][group:%s][ip:%s][sync:%d]", er.RD, er.ESI.String(), er.ETag, evpnMulticastSourceString(er.SourceAddress), er.GroupAddress, er.OriginatorAddress, er.SyncNumber)
+}
+
+func (er *EVPNMulticastLeaveSynchRoute) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		RD                RouteDistinguisherInterface `json:"rd"`
+		ESI               string                      `json:"esi"`
+		ETag              uint32                      `json:"etag"`
+		SourceAddress     string                      `json:"source"`
+		GroupAddress      string                      `json:"group"`
+		OriginatorAddress string                      `json:"ip"`
+		SyncNumber        uint32                      `json:"sync"`
+		MaxResponseTime   uint8                       `json:"max-response-time"`
+		Flags             uint8                       `json:"flags"`
+	}{
+		RD:                er.RD,
+		ESI:               er.ESI.String(),
+		ETag:              er.ETag,
+		SourceAddress:     evpnMulticastSourceString(er.SourceAddress),
+		GroupAddress:      er.GroupAddress.String(),
+		OriginatorAddress: er.OriginatorAddress.String(),
+		SyncNumber:        er.SyncNumber,
+		MaxResponseTime:   er.MaxResponseTime,
+		Flags:             er.Flags,
+	})
+}
+
+func (er *EVPNMulticastLeaveSynchRoute) rd() RouteDistinguisherInterface {
+	return er.RD
+}
+
+func NewEVPNMulticastLeaveSynchRoute(rd RouteDistinguisherInterface, esi EthernetSegmentIdentifier, etag uint32, source, group, originator string, syncNumber uint32, maxResponseTime, flags uint8) *EVPNNLRI {
+	return NewEVPNNLRI(EVPN_MULTICAST_LEAVE_SYNCH, &EVPNMulticastLeaveSynchRoute{
+		RD:                rd,
+		ESI:               esi,
+		ETag:              etag,
+		SourceAddress:     parseEVPNMulticastAddr(source),
+		GroupAddress:      parseEVPNMulticastAddr(group),
+		OriginatorAddress: parseEVPNMulticastAddr(originator),
+		SyncNumber:        syncNumber,
+		MaxResponseTime:   maxResponseTime,
+		Flags:             flags,
+	})
+}
+
+// decodeEVPNMulticastAddrs decodes the multicast source, group and
+// originating router's address fields of the IGMP/MLD proxy routes.
+func decodeEVPNMulticastAddrs(data []byte, source, group, originator *net.IP) ([]byte, error) {
+	data, err := decodeEVPNMulticastAddr(data, source, true)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = decodeEVPNMulticastAddr(data, group, false); err != nil {
+		return nil, err
+	}
+	return decodeEVPNMulticastAddr(data, originator, false)
+}
+
+func serializeEVPNRD(rd RouteDistinguisherInterface) ([]byte, error) {
+	if rd == nil {
+		return make([]byte, 8), nil
+	}
+	return rd.Serialize()
+}
+
+type EVPNIPMSIRoute struct {
+	RD   RouteDistinguisherInterface
+	ETag uint32
+	EC   ExtendedCommunityInterface
+}
+
+func (er *EVPNIPMSIRoute) Len() int {
+	// RD(8) + ETag(4) + EC(8)
+	return 20
+}
+
+func (er *EVPNIPMSIRoute) DecodeFromBytes(data []byte) error {
+
+	er.RD = GetRouteDistinguisher(data[0:8])
+
+	data = data[er.RD.Len():]
+	er.ETag = binary.BigEndian.Uint32(data[0:4])
+
+	data = data[4:]
+	ec, err := ParseExtended(data[0:8])
+	if err != nil {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Parse extended community interface failed")
+	}
+	er.EC = ec
+	return nil
+}
+
+func (er *EVPNIPMSIRoute) Serialize() ([]byte, error) {
+	buf := make([]byte, 20)
+
+	if er.RD != nil {
+		tbuf, err := er.RD.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		copy(buf[0:8], tbuf)
+	}
+
+	binary.BigEndian.PutUint32(buf[8:12], er.ETag)
+
+	ec, err := er.EC.Serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(buf, ec...), nil
+}
+
+func (er *EVPNIPMSIRoute) String() string {
+	ec := "default"
+	if er.EC != nil {
+		ec = er.EC.String()
+	}
+	return fmt.Sprintf("[type:I-PMSI][rd:%s][etag:%d][EC:%s]", er.RD, er.ETag, ec)
+}
+
+func (er *EVPNIPMSIRoute) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		RD   RouteDistinguisherInterface `json:"rd"`
+		ETag uint32                      `json:"etag"`
+		EC   string                      `json:"ec"`
+	}{
+		RD:   er.RD,
+		ETag: er.ETag,
+		EC:   er.EC.String(),
+	})
+}
+
+func (er *EVPNIPMSIRoute) rd() RouteDistinguisherInterface {
+	return er.RD
+}
+
+func NewEVPNIPMSIRoute(rd RouteDistinguisherInterface, etag uint32, ec ExtendedCommunityInterface) *EVPNNLRI {
+
+	return NewEVPNNLRI(EVPN_I_PMSI, &EVPNIPMSIRoute{
+		RD:   rd,
+		ETag: etag,
+		EC:   ec,
+	})
+}
+
+type EVPNRouteTypeInterface interface {
+	Len() int
+	DecodeFromBytes([]byte) error
+	Serialize() ([]byte, error)
+	String() string
+	rd() RouteDistinguisherInterface
+	MarshalJSON() ([]byte, error)
+}
+
+func getEVPNRouteType(t uint8) (EVPNRouteTypeInterface, error) {
+	switch t {
+	case EVPN_ROUTE_TYPE_ETHERNET_AUTO_DISCOVERY:
+		return &EVPNEthernetAutoDiscoveryRoute{}, nil
+	case EVPN_ROUTE_TYPE_MAC_IP_ADVERTISEMENT:
+		return &EVPNMacIPAdvertisementRoute{}, nil
+	case EVPN_INCLUSIVE_MULTICAST_ETHERNET_TAG:
+		return &EVPNMulticastEthernetTagRoute{}, nil
+	case EVPN_ETHERNET_SEGMENT_ROUTE:
+		return &EVPNEthernetSegmentRoute{}, nil
+	case EVPN_IP_PREFIX:
+		return &EVPNIPPrefixRoute{}, nil
+	case EVPN_SELECTIVE_MULTICAST_ETHERNET_TAG:
+		return &EVPNSelectiveMulticastEthernetTagRoute{}, nil
+	case EVPN_MULTICAST_JOIN_SYNCH:
+		return &EVPNMulticastJoinSynchRoute{}, nil
+	case EVPN_MULTICAST_LEAVE_SYNCH:
+		return &EVPNMulticastLeaveSynchRoute{}, nil
+	}
+	return nil, NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, fmt.Sprintf("Unknown EVPN Route type: %d", t))
+}
+
+const (
+	EVPN_ROUTE_TYPE_ETHERNET_AUTO_DISCOVERY = 1
+	EVPN_ROUTE_TYPE_MAC_IP_ADVERTISEMENT    = 2
+	EVPN_INCLUSIVE_MULTICAST_ETHERNET_TAG   = 3
+	EVPN_ETHERNET_SEGMENT_ROUTE             = 4
+	EVPN_IP_PREFIX                          = 5
+	EVPN_SELECTIVE_MULTICAST_ETHERNET_TAG   = 6
+	EVPN_MULTICAST_JOIN_SYNCH               = 7
+	EVPN_MULTICAST_LEAVE_SYNCH              = 8
 	EVPN_I_PMSI                             = 9
 )
 
@@ -3851,6 +4553,14 @@ func flowSpecPrefixParser(rf RouteFamily, typ BGPFlowSpecType, args []string) (F
 				return nil, fmt.Errorf("invalid ipv6 prefix offset: %s", args[1])
 			}
 		}
+		if offset > prefixLen {
+			return nil, fmt.Errorf("ipv6 prefix offset %d exceeds prefix length %d", offset, prefixLen)
+		}
+		// the bits before the offset aren't matched
+		prefix = prefix.Mask(net.CIDRMask(int(prefixLen), 128))
+		for i := 0; i < int(offset); i++ {
+			prefix[i/8] &^= 0x80 >> (i % 8)
+		}
 		switch typ {
 		case FLOW_SPEC_TYPE_DST_PREFIX:
 			return NewFlowSpecDestinationPrefix6(NewIPv6AddrPrefix(uint8(prefixLen), prefix.String()), uint8(offset)), nil
@@ -3872,8 +4582,16 @@ func flowSpecIpProtoParser(_ RouteFamily, typ BGPFlowSpecType, args []string) (F
 	//   args := []string{"!=tcp", "&!=udp"}
 	args = normalizeFlowSpecOpValues(args)
 	s := strings.Join(args, " ")
-	for i, name := range ProtocolNameMap {
-		s = strings.Replace(s, name, fmt.Sprintf("%d", i), -1)
+	// replaces the longer names first not to replace "icmp" in "ipv6-icmp"
+	protocols := make([]Protocol, 0, len(ProtocolNameMap))
+	for i := range ProtocolNameMap {
+		protocols = append(protocols, i)
+	}
+	sort.Slice(protocols, func(i, j int) bool {
+		return len(ProtocolNameMap[protocols[i]]) > len(ProtocolNameMap[protocols[j]])
+	})
+	for _, i := range protocols {
+		s = strings.Replace(s, ProtocolNameMap[i], fmt.Sprintf("%d", i), -1)
 	}
 	args = strings.Split(s, " ")
 
@@ -3939,7 +4657,7 @@ func flowSpecDscpParser(_ RouteFamily, typ BGPFlowSpecType, args []string) (Flow
 	return parseFlowSpecNumericOpValues(typ, args, f)
 }
 
-func flowSpecFragmentParser(_ RouteFamily, typ BGPFlowSpecType, args []string) (FlowSpecComponentInterface, error) {
+func flowSpecFragmentParser(rf RouteFamily, typ BGPFlowSpecType, args []string) (FlowSpecComponentInterface, error) {
 	// args: List of pairs of Operator and Fragment flags
 	//
 	// Example:
@@ -3971,6 +4689,10 @@ func flowSpecFragmentParser(_ RouteFamily, typ BGPFlowSpecType, args []string) (
 				value |= uint64(flag)
 			}
 		}
+		// RFC 8956 3.6, IPv6 doesn't have the don't fragment bit
+		if afi, _ := RouteFamilyToAfiSafi(rf); afi == AFI_IP6 && value&FRAG_FLAG_DONT != 0 {
+			return nil, fmt.Errorf("%s is not supported for ipv6", FragmentFlagNameMap[FRAG_FLAG_DONT])
+		}
 		items = append(items, NewFlowSpecComponentItem(operand, value))
 	}
 
@@ -4251,24 +4973,75 @@ type flowSpecPrefix6 struct {
 	typ    BGPFlowSpecType
 }
 
-// draft-ietf-idr-flow-spec-v6-06
-// <type (1 octet), prefix length (1 octet), prefix offset(1 octet), prefix>
+// RFC 8956 3.1
+// <type (1 octet), length (1 octet), offset (1 octet), pattern (variable)>
+// The pattern is the bits from the offset to the length of the prefix, and
+// the bits before the offset are zero in Prefix.
 func (p *flowSpecPrefix6) DecodeFromBytes(data []byte, options ...*MarshallingOption) error {
+	if len(data) < 3 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "not all flowspec prefix bytes available")
+	}
 	p.typ = BGPFlowSpecType(data[0])
-	p.Offset = data[2]
-	prefix := append([]byte{data[1]}, data[3:]...)
-	return p.Prefix.DecodeFromBytes(prefix, options...)
+	length, offset := data[1], data[2]
+	if length > 128 || offset > length {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, fmt.Sprintf("invalid flowspec prefix length %d and offset %d", length, offset))
+	}
+	n := (int(length-offset) + 7) / 8
+	if len(data) < 3+n {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "not all flowspec prefix bytes available")
+	}
+	addr := make(net.IP, net.IPv6len)
+	copy(addr, shiftBitsRight(data[3:3+n], int(offset)))
+	p.Offset = offset
+	buf := append([]byte{length}, addr[:(int(length)+7)/8]...)
+	return p.Prefix.DecodeFromBytes(buf, options...)
 }
 
 func (p *flowSpecPrefix6) Serialize(options ...*MarshallingOption) ([]byte, error) {
-	buf := []byte{byte(p.Type())}
 	bbuf, err := p.Prefix.Serialize(options...)
 	if err != nil {
 		return nil, err
 	}
-	buf = append(buf, bbuf[0])
-	buf = append(buf, p.Offset)
-	return append(buf, bbuf[1:]...), nil
+	length := bbuf[0]
+	if p.Offset > length {
+		return nil, fmt.Errorf("flowspec prefix offset %d exceeds length %d", p.Offset, length)
+	}
+	addr := make([]byte, net.IPv6len)
+	copy(addr, bbuf[1:])
+	pattern := shiftBitsLeft(addr, int(p.Offset))[:(int(length-p.Offset)+7)/8]
+	if r := (length - p.Offset) % 8; r != 0 {
+		pattern[len(pattern)-1] &= ^byte(0) << (8 - r)
+	}
+	buf := []byte{byte(p.Type()), length, p.Offset}
+	return append(buf, pattern...), nil
+}
+
+// shiftBitsLeft returns the bytes shifted left by the bits, dropping the
+// leading bits.
+func shiftBitsLeft(b []byte, bits int) []byte {
+	r := make([]byte, len(b))
+	for i := range r {
+		for j := 0; j < 8; j++ {
+			k := i*8 + j + bits
+			if k/8 < len(b) && b[k/8]&(0x80>>(k%8)) != 0 {
+				r[i] |= 0x80 >> j
+			}
+		}
+	}
+	return r
+}
+
+// shiftBitsRight returns the bytes shifted right by the bits into 16 bytes.
+func shiftBitsRight(b []byte, bits int) []byte {
+	r := make([]byte, net.IPv6len)
+	for k := 0; k < len(b)*8; k++ {
+		if b[k/8]&(0x80>>(k%8)) != 0 {
+			if l := k + bits; l/8 < len(r) {
+				r[l/8] |= 0x80 >> (l % 8)
+			}
+		}
+	}
+	return r
 }
 
 func (p *flowSpecPrefix6) Len(options ...*MarshallingOption) int {
@@ -4281,6 +5054,9 @@ func (p *flowSpecPrefix6) Type() BGPFlowSpecType {
 }
 
 func (p *flowSpecPrefix6) String() string {
+	if p.Offset == 0 {
+		return fmt.Sprintf("[%s: %s]", p.Type(), p.Prefix.String())
+	}
 	return fmt.Sprintf("[%s: %s/%d]", p.Type(), p.Prefix.String(), p.Offset)
 }
 
@@ -4666,7 +5442,7 @@ func (n *FlowSpecNLRI) decodeFromBytes(rf RouteFamily, data []byte, options ...*
 	}
 	var length int
 	if (data[0]>>4) == 0xf && len(data) > 2 {
-		length = int(binary.BigEndian.Uint16(data[0:2]))
+		length = int(binary.BigEndian.Uint16(data[0:2]) & 0xfff)
 		data = data[2:]
 	} else if len(data) > 1 {
 		length = int(data[0])
@@ -4782,7 +5558,7 @@ func (n *FlowSpecNLRI) Serialize(options ...*MarshallingOption) ([]byte, error)
 	} else {
 		length -= 2
 		b := make([]byte, 2)
-		binary.BigEndian.PutUint16(buf, uint16(length))
+		binary.BigEndian.PutUint16(b, 0xf000|uint16(length))
 		buf = append(b, buf...)
 	}
 
@@ -4855,9 +5631,10 @@ func CompareFlowSpecNLRI(n, m *FlowSpecNLRI) (int, error) {
 	longer := n.Value
 	shorter := m.Value
 	invert := 1
-	if n.SAFI() == SAFI_FLOW_SPEC_VPN {
-		k, _ := n.Serialize()
-		l, _ := m.Serialize()
+	if n.SAFI() == SAFI_FLOW_SPEC_VPN && n.rd != nil && m.rd != nil {
+		// the rules of the same RD are compared
+		k, _ := n.rd.Serialize()
+		l, _ := m.rd.Serialize()
 		if result := bytes.Compare(k, l); result != 0 {
 			return result, nil
 		}
@@ -4876,14 +5653,14 @@ func CompareFlowSpecNLRI(n, m *FlowSpecNLRI) (int, error) {
 			return invert, nil
 		} else if v.Type() > w.Type() {
 			return invert * -1, nil
-		} else if v.Type() == FLOW_SPEC_TYPE_DST_PREFIX || v.Type() == FLOW_SPEC_TYPE_SRC_PREFIX {
+		} else if (v.Type() == FLOW_SPEC_TYPE_DST_PREFIX || v.Type() == FLOW_SPEC_TYPE_SRC_PREFIX) && (n.AFI() == AFI_IP || n.AFI() == AFI_IP6) {
 			// RFC5575 5.1
 			//
 			// For IP prefix values (IP destination and source prefix) precedence is
 			// given to the lowest IP value of the common prefix length; if the
 			// common prefix is equal, then the most specific prefix has precedence.
 			var p, q *IPAddrPrefixDefault
-			var pCommon, qCommon uint64
+			bits := 32
 			if n.AFI() == AFI_IP {
 				if v.Type() == FLOW_SPEC_TYPE_DST_PREFIX {
 					p = &v.(*FlowSpecDestinationPrefix).Prefix.(*IPAddrPrefix).IPAddrPrefixDefault
@@ -4892,40 +5669,40 @@ func CompareFlowSpecNLRI(n, m *FlowSpecNLRI) (int, error) {
 					p = &v.(*FlowSpecSourcePrefix).Prefix.(*IPAddrPrefix).IPAddrPrefixDefault
 					q = &w.(*FlowSpecSourcePrefix).Prefix.(*IPAddrPrefix).IPAddrPrefixDefault
 				}
-				min := p.Length
-				if q.Length < p.Length {
-					min = q.Length
-				}
-				pCommon = uint64(binary.BigEndian.Uint32([]byte(p.Prefix.To4())) >> (32 - min))
-				qCommon = uint64(binary.BigEndian.Uint32([]byte(q.Prefix.To4())) >> (32 - min))
-			} else if n.AFI() == AFI_IP6 {
+			} else {
+				var o, r uint8
 				if v.Type() == FLOW_SPEC_TYPE_DST_PREFIX {
 					p = &v.(*FlowSpecDestinationPrefix6).Prefix.(*IPv6AddrPrefix).IPAddrPrefixDefault
 					q = &w.(*FlowSpecDestinationPrefix6).Prefix.(*IPv6AddrPrefix).IPAddrPrefixDefault
+					o, r = v.(*FlowSpecDestinationPrefix6).Offset, w.(*FlowSpecDestinationPrefix6).Offset
 				} else {
 					p = &v.(*FlowSpecSourcePrefix6).Prefix.(*IPv6AddrPrefix).IPAddrPrefixDefault
 					q = &w.(*FlowSpecSourcePrefix6).Prefix.(*IPv6AddrPrefix).IPAddrPrefixDefault
+					o, r = v.(*FlowSpecSourcePrefix6).Offset, w.(*FlowSpecSourcePrefix6).Offset
 				}
-				min := uint(p.Length)
-				if q.Length < p.Length {
-					min = uint(q.Length)
-				}
-				var mask uint
-				if min-64 > 0 {
-					mask = min - 64
-				}
-				pCommon = binary.BigEndian.Uint64([]byte(p.Prefix.To16()[:8])) >> mask
-				qCommon = binary.BigEndian.Uint64([]byte(q.Prefix.To16()[:8])) >> mask
-				if pCommon == qCommon && mask == 0 {
-					mask = 64 - min
-					pCommon = binary.BigEndian.Uint64([]byte(p.Prefix.To16()[8:])) >> mask
-					qCommon = binary.BigEndian.Uint64([]byte(q.Prefix.To16()[8:])) >> mask
+				bits = 128
+				// RFC 8956 3.8.1, the lowest offset has precedence
+				if o < r {
+					return invert, nil
+				} else if o > r {
+					return invert * -1, nil
 				}
 			}
+			min := p.Length
+			if q.Length < p.Length {
+				min = q.Length
+			}
+			mask := net.CIDRMask(int(min), bits)
+			pp, qp := p.Prefix.To16(), q.Prefix.To16()
+			if bits == 32 {
+				pp, qp = p.Prefix.To4(), q.Prefix.To4()
+			}
+			// compares the common prefixes
+			result := bytes.Compare(pp.Mask(mask), qp.Mask(mask))
 
-			if pCommon < qCommon {
+			if result < 0 {
 				return invert, nil
-			} else if pCommon > qCommon {
+			} else if result > 0 {
 				return invert * -1, nil
 			} else if p.Length > q.Length {
 				return invert, nil
@@ -5142,6 +5919,7 @@ const (
 	LS_NLRI_TYPE_LINK
 	LS_NLRI_TYPE_PREFIX_IPV4
 	LS_NLRI_TYPE_PREFIX_IPV6
+	LS_NLRI_TYPE_SR_POLICY_CANDIDATE_PATH
 )
 
 type LsNLRIInterface interface {
@@ -5163,6 +5941,8 @@ const (
 	LS_PROTOCOL_STATIC
 	LS_PROTOCOL_OSPF_V3
 	LS_PROTOCOL_BGP
+	LS_PROTOCOL_RSVP_TE
+	LS_PROTOCOL_SEGMENT_ROUTING
 )
 
 func (l LsProtocolID) String() string {
@@ -5181,6 +5961,10 @@ func (l LsProtocolID) String() string {
 		return "OSPFv3"
 	case LS_PROTOCOL_BGP:
 		return "BGP"
+	case LS_PROTOCOL_RSVP_TE:
+		return "RSVP-TE"
+	case LS_PROTOCOL_SEGMENT_ROUTING:
+		return "SR"
 	default:
 		return fmt.Sprintf("LsProtocolID(%d)", uint8(l))
 	}
@@ -5865,6 +6649,8 @@ const (
 	LS_TLV_BGP_ROUTER_ID            = 516 // RFC9086
 	LS_TLV_BGP_CONFEDERATION_MEMBER = 517 // RFC9086
 
+	LS_TLV_SR_POLICY_CANDIDATE_PATH_DESC = 554 // RFC9857
+
 	LS_TLV_NODE_FLAG_BITS        = 1024
 	LS_TLV_OPAQUE_NODE_ATTR      = 1025
 	LS_TLV_NODE_NAME             = 1026
@@ -5910,6 +6696,15 @@ const (
 	LS_TLV_PREFIX_ATTRIBUTE_FLAGS = 1170 // draft-ietf-idr-bgp-ls-segment-routing-ext, TODO
 	LS_TLV_SOURCE_ROUTER_ID       = 1171 // draft-ietf-idr-bgp-ls-segment-routing-ext, TODO
 	LS_TLV_L2_BUNDLE_MEMBER_TLV   = 1172 // draft-ietf-idr-bgp-ls-segment-routing-ext, TODO
+
+	LS_TLV_SR_BINDING_SID              = 1201 // RFC9857
+	LS_TLV_SR_CANDIDATE_PATH_STATE     = 1202 // RFC9857
+	LS_TLV_SR_CANDIDATE_PATH_NAME      = 1203 // RFC9857
+	LS_TLV_SR_CANDIDATE_PATH_CONSTRAIN = 1204 // RFC9857, TODO
+	LS_TLV_SR_SEGMENT_LIST             = 1205 // RFC9857
+	LS_TLV_SR_SEGMENT                  = 1206 // RFC9857
+	LS_TLV_SR_SEGMENT_LIST_METRIC      = 1207 // RFC9857, TODO
+	LS_TLV_SR_POLICY_NAME              = 1213 // RFC9857
 )
 
 type LsTLVInterface interface {
@@ -6014,6 +6809,24 @@ func NewLsAttributeTLVs(lsAttr *LsAttribute) []LsTLVInterface {
 		tlvs = append(tlvs, NewLsTLVPeerSetSID(lsAttr.BgpPeerSegment.BgpPeerSetSid))
 	}
 
+	if srPolicy := lsAttr.SrPolicy; srPolicy != nil {
+		if srPolicy.BindingSID != nil {
+			tlvs = append(tlvs, NewLsTLVSrBindingSID(srPolicy.BindingSID))
+		}
+		if srPolicy.CandidatePathState != nil {
+			tlvs = append(tlvs, NewLsTLVSrCandidatePathState(srPolicy.CandidatePathState))
+		}
+		if srPolicy.CandidatePathName != nil {
+			tlvs = append(tlvs, NewLsTLVSrName(LS_TLV_SR_CANDIDATE_PATH_NAME, *srPolicy.CandidatePathName))
+		}
+		if srPolicy.PolicyName != nil {
+			tlvs = append(tlvs, NewLsTLVSrName(LS_TLV_SR_POLICY_NAME, *srPolicy.PolicyName))
+		}
+		for _, segmentList := range srPolicy.SegmentLists {
+			tlvs = append(tlvs, NewLsTLVSrSegmentList(segmentList))
+		}
+	}
+
 	return tlvs
 }
 
@@ -9146,6 +9959,12 @@ func (l *LsAddrPrefix) DecodeFromBytes(data []byte, options ...*MarshallingOptio
 		prefixv6.NLRIType = LS_NLRI_TYPE_PREFIX_IPV4
 		l.NLRI = prefixv6
 
+	case LS_NLRI_TYPE_SR_POLICY_CANDIDATE_PATH:
+		cp := &LsSrPolicyCandidatePathNLRI{}
+		cp.Length = l.Length
+		cp.NLRIType = LS_NLRI_TYPE_SR_POLICY_CANDIDATE_PATH
+		l.NLRI = cp
+
 	default:
 		return malformedAttrListErr("Unsupported BGP-LS NLRI")
 	}
@@ -9252,6 +10071,7 @@ type LsAttribute struct {
 	Link           LsAttributeLink           `json:"link"`
 	Prefix         LsAttributePrefix         `json:"prefix"`
 	BgpPeerSegment LsAttributeBgpPeerSegment `json:"bgp_peer_segment"`
+	SrPolicy       *LsAttributeSrPolicy      `json:"sr_policy,omitempty"`
 }
 
 type PathAttributeLs struct {
@@ -9261,6 +10081,7 @@ type PathAttributeLs struct {
 
 func (p *PathAttributeLs) Extract() *LsAttribute {
 	l := &LsAttribute{}
+	srPolicy := &LsAttributeSrPolicy{}
 
 	for _, tlv := range p.TLVs {
 		switch v := tlv.(type) {
@@ -9346,6 +10167,26 @@ func (p *PathAttributeLs) Extract() *LsAttribute {
 
 		case *LsTLVPeerSetSID:
 			l.BgpPeerSegment.BgpPeerSetSid = v.Extract()
+
+		case *LsTLVSrBindingSID:
+			srPolicy.BindingSID = v.Extract()
+			l.SrPolicy = srPolicy
+
+		case *LsTLVSrCandidatePathState:
+			srPolicy.CandidatePathState = v.Extract()
+			l.SrPolicy = srPolicy
+
+		case *LsTLVSrName:
+			if v.Type == LS_TLV_SR_POLICY_NAME {
+				srPolicy.PolicyName = &v.Name
+			} else {
+				srPolicy.CandidatePathName = &v.Name
+			}
+			l.SrPolicy = srPolicy
+
+		case *LsTLVSrSegmentList:
+			srPolicy.SegmentLists = append(srPolicy.SegmentLists, v.Extract())
+			l.SrPolicy = srPolicy
 		}
 	}
 
@@ -9457,6 +10298,19 @@ func (p *PathAttributeLs) DecodeFromBytes(data []byte, options ...*MarshallingOp
 		case LS_TLV_PEER_SET_SID:
 			tlv = &LsTLVPeerSetSID{}
 
+		// SR Policy related TLVs (https://www.rfc-editor.org/rfc/rfc9857.html#section-5.6)
+		case LS_TLV_SR_BINDING_SID:
+			tlv = &LsTLVSrBindingSID{}
+
+		case LS_TLV_SR_CANDIDATE_PATH_STATE:
+			tlv = &LsTLVSrCandidatePathState{}
+
+		case LS_TLV_SR_CANDIDATE_PATH_NAME, LS_TLV_SR_POLICY_NAME:
+			tlv = &LsTLVSrName{}
+
+		case LS_TLV_SR_SEGMENT_LIST:
+			tlv = &LsTLVSrSegmentList{}
+
 		default:
 			tlvs = tlvs[t.Len():]
 			continue
@@ -9555,6 +10409,12 @@ const (
 	RF_SR_POLICY_IPv6 RouteFamily = AFI_IP6<<16 | SAFI_SRPOLICY
 	RF_MUP_IPv4       RouteFamily = AFI_IP<<16 | SAFI_MUP
 	RF_MUP_IPv6       RouteFamily = AFI_IP6<<16 | SAFI_MUP
+	RF_MVPN_IPv4      RouteFamily = AFI_IP<<16 | SAFI_MCAST_VPN
+	RF_MVPN_IPv6      RouteFamily = AFI_IP6<<16 | SAFI_MCAST_VPN
+	RF_CT_IPv4        RouteFamily = AFI_IP<<16 | SAFI_CLASSFUL_TRANSPORT
+	RF_CT_IPv6        RouteFamily = AFI_IP6<<16 | SAFI_CLASSFUL_TRANSPORT
+	RF_CAR_IPv4       RouteFamily = AFI_IP<<16 | SAFI_CAR
+	RF_CAR_IPv6       RouteFamily = AFI_IP6<<16 | SAFI_CAR
 )
 
 var AddressFamilyNameMap = map[RouteFamily]string{
@@ -9584,6 +10444,12 @@ var AddressFamilyNameMap = map[RouteFamily]string{
 	RF_SR_POLICY_IPv6: "ipv6-srpolicy",
 	RF_MUP_IPv4:       "ipv4-mup",
 	RF_MUP_IPv6:       "ipv6-mup",
+	RF_MVPN_IPv4:      "ipv4-mvpn",
+	RF_MVPN_IPv6:      "ipv6-mvpn",
+	RF_CT_IPv4:        "ipv4-ct",
+	RF_CT_IPv6:        "ipv6-ct",
+	RF_CAR_IPv4:       "ipv4-car",
+	RF_CAR_IPv6:       "ipv6-car",
 }
 
 var AddressFamilyValueMap = map[string]RouteFamily{
@@ -9613,6 +10479,12 @@ var AddressFamilyValueMap = map[string]RouteFamily{
 	AddressFamilyNameMap[RF_SR_POLICY_IPv6]: RF_SR_POLICY_IPv6,
 	AddressFamilyNameMap[RF_MUP_IPv4]:       RF_MUP_IPv4,
 	AddressFamilyNameMap[RF_MUP_IPv6]:       RF_MUP_IPv6,
+	AddressFamilyNameMap[RF_MVPN_IPv4]:      RF_MVPN_IPv4,
+	AddressFamilyNameMap[RF_MVPN_IPv6]:      RF_MVPN_IPv6,
+	AddressFamilyNameMap[RF_CT_IPv4]:        RF_CT_IPv4,
+	AddressFamilyNameMap[RF_CT_IPv6]:        RF_CT_IPv6,
+	AddressFamilyNameMap[RF_CAR_IPv4]:       RF_CAR_IPv4,
+	AddressFamilyNameMap[RF_CAR_IPv6]:       RF_CAR_IPv6,
 }
 
 func GetRouteFamily(name string) (RouteFamily, error) {
@@ -9691,17 +10563,39 @@ func NewPrefixFromRouteFamily(afi uint16, safi uint8, prefixStr ...string) (pref
 			*NewMPLSLabelStack(),
 			rd,
 		)
-	case RF_IPv4_MPLS:
-		prefix = NewLabeledIPAddrPrefix(0, "", *NewMPLSLabelStack())
-	case RF_IPv6_MPLS:
-		prefix = NewLabeledIPv6AddrPrefix(0, "", *NewMPLSLabelStack())
-	case RF_EVPN:
-		prefix = NewEVPNNLRI(0, nil)
-	case RF_VPLS:
-		prefix = &VPLSNLRI{}
-
-	// TODO (sbezverk) Add processing SR Policy NLRI
-	case RF_SR_POLICY_IPv4:
+	case RF_CT_IPv4, RF_CT_IPv6:
+		if len(prefixStr) == 0 {
+			if family == RF_CT_IPv4 {
+				prefix = NewCTIPAddrPrefix(0, "", *NewMPLSLabelStack(), rdEOR)
+			} else {
+				prefix = NewCTIPv6AddrPrefix(0, "", *NewMPLSLabelStack(), rdEOR)
+			}
+			break
+		}
+
+		rd, addr, network, err := ParseVPNPrefix(prefixStr[0])
+		if err != nil {
+			return nil, err
+		}
+
+		length, _ := network.Mask.Size()
+
+		if family == RF_CT_IPv4 {
+			prefix = NewCTIPAddrPrefix(uint8(length), addr.String(), *NewMPLSLabelStack(), rd)
+		} else {
+			prefix = NewCTIPv6AddrPrefix(uint8(length), addr.String(), *NewMPLSLabelStack(), rd)
+		}
+	case RF_IPv4_MPLS:
+		prefix = NewLabeledIPAddrPrefix(0, "", *NewMPLSLabelStack())
+	case RF_IPv6_MPLS:
+		prefix = NewLabeledIPv6AddrPrefix(0, "", *NewMPLSLabelStack())
+	case RF_EVPN:
+		prefix = NewEVPNNLRI(0, nil)
+	case RF_VPLS:
+		prefix = &VPLSNLRI{}
+
+	// TODO (sbezverk) Add processing SR Policy NLRI
+	case RF_SR_POLICY_IPv4:
 		prefix = &SRPolicyIPv4{
 			SRPolicyNLRI: SRPolicyNLRI{
 				rf: RF_SR_POLICY_IPv4,
@@ -9737,6 +10631,20 @@ func NewPrefixFromRouteFamily(afi uint16, safi uint8, prefixStr ...string) (pref
 		prefix = NewMUPNLRI(AFI_IP, 0, 0, nil)
 	case RF_MUP_IPv6:
 		prefix = NewMUPNLRI(AFI_IP6, 0, 0, nil)
+	case RF_MVPN_IPv4:
+		prefix = NewMVPNNLRI(AFI_IP, 0, nil)
+	case RF_MVPN_IPv6:
+		prefix = NewMVPNNLRI(AFI_IP6, 0, nil)
+	case RF_CAR_IPv4, RF_CAR_IPv6:
+		if len(prefixStr) == 0 {
+			prefix = NewCARNLRI(afi, 0, nil, nil)
+			break
+		}
+		length, addr, color, err := ParseCARPrefix(prefixStr[0])
+		if err != nil {
+			return nil, err
+		}
+		prefix = NewCARColorAwareRoute(afi, length, addr.String(), color)
 	default:
 		err = fmt.Errorf("unknown route family. AFI: %d, SAFI: %d", afi, safi)
 	}
@@ -10826,7 +11734,7 @@ type WellKnownCommunity uint32
 
 const (
 	COMMUNITY_INTERNET                   WellKnownCommunity = 0x00000000
-	COMMUNITY_PLANNED_SHUT               WellKnownCommunity = 0xffff0000
+	COMMUNITY_GRACEFUL_SHUTDOWN          WellKnownCommunity = 0xffff0000
 	COMMUNITY_ACCEPT_OWN                 WellKnownCommunity = 0xffff0001
 	COMMUNITY_ROUTE_FILTER_TRANSLATED_v4 WellKnownCommunity = 0xffff0002
 	COMMUNITY_ROUTE_FILTER_v4            WellKnownCommunity = 0xffff0003
@@ -10839,11 +11747,14 @@ const (
 	COMMUNITY_NO_ADVERTISE               WellKnownCommunity = 0xffffff02
 	COMMUNITY_NO_EXPORT_SUBCONFED        WellKnownCommunity = 0xffffff03
 	COMMUNITY_NO_PEER                    WellKnownCommunity = 0xffffff04
+
+	// Deprecated: the name before RFC 8326.
+	COMMUNITY_PLANNED_SHUT = COMMUNITY_GRACEFUL_SHUTDOWN
 )
 
 var WellKnownCommunityNameMap = map[WellKnownCommunity]string{
 	COMMUNITY_INTERNET:                   "internet",
-	COMMUNITY_PLANNED_SHUT:               "planned-shut",
+	COMMUNITY_GRACEFUL_SHUTDOWN:          "graceful-shutdown",
 	COMMUNITY_ACCEPT_OWN:                 "accept-own",
 	COMMUNITY_ROUTE_FILTER_TRANSLATED_v4: "route-filter-translated-v4",
 	COMMUNITY_ROUTE_FILTER_v4:            "route-filter-v4",
@@ -10860,7 +11771,7 @@ var WellKnownCommunityNameMap = map[WellKnownCommunity]string{
 
 var WellKnownCommunityValueMap = map[string]WellKnownCommunity{
 	WellKnownCommunityNameMap[COMMUNITY_INTERNET]:                   COMMUNITY_INTERNET,
-	WellKnownCommunityNameMap[COMMUNITY_PLANNED_SHUT]:               COMMUNITY_PLANNED_SHUT,
+	WellKnownCommunityNameMap[COMMUNITY_GRACEFUL_SHUTDOWN]:          COMMUNITY_GRACEFUL_SHUTDOWN,
 	WellKnownCommunityNameMap[COMMUNITY_ACCEPT_OWN]:                 COMMUNITY_ACCEPT_OWN,
 	WellKnownCommunityNameMap[COMMUNITY_ROUTE_FILTER_TRANSLATED_v4]: COMMUNITY_ROUTE_FILTER_TRANSLATED_v4,
 	WellKnownCommunityNameMap[COMMUNITY_ROUTE_FILTER_v4]:            COMMUNITY_ROUTE_FILTER_v4,
@@ -10873,6 +11784,8 @@ var WellKnownCommunityValueMap = map[string]WellKnownCommunity{
 	WellKnownCommunityNameMap[COMMUNITY_NO_ADVERTISE]:               COMMUNITY_NO_ADVERTISE,
 	WellKnownCommunityNameMap[COMMUNITY_NO_EXPORT_SUBCONFED]:        COMMUNITY_NO_EXPORT_SUBCONFED,
 	WellKnownCommunityNameMap[COMMUNITY_NO_PEER]:                    COMMUNITY_NO_PEER,
+	// accepted for compatibility
+	"planned-shut": COMMUNITY_GRACEFUL_SHUTDOWN,
 }
 
 func (p *PathAttributeCommunities) String() string {
@@ -11789,9 +12702,15 @@ func NewValidationExtended(state ValidationState) *ValidationExtended {
 type LinkBandwidthExtended struct {
 	AS        uint16
 	Bandwidth float32
+	// draft-ietf-idr-link-bandwidth: the transitive two-octet AS specific
+	// type is used instead of the non-transitive one.
+	IsTransitive bool
 }
 
 func (e *LinkBandwidthExtended) GetTypes() (ExtendedCommunityAttrType, ExtendedCommunityAttrSubType) {
+	if e.IsTransitive {
+		return EC_TYPE_TRANSITIVE_TWO_OCTET_AS_SPECIFIC, EC_SUBTYPE_LINK_BANDWIDTH
+	}
 	return EC_TYPE_NON_TRANSITIVE_LINK_BANDWIDTH, EC_SUBTYPE_LINK_BANDWIDTH
 }
 
@@ -11831,6 +12750,14 @@ func NewLinkBandwidthExtended(as uint16, bw float32) *LinkBandwidthExtended {
 	}
 }
 
+func NewTransitiveLinkBandwidthExtended(as uint16, bw float32) *LinkBandwidthExtended {
+	return &LinkBandwidthExtended{
+		AS:           as,
+		Bandwidth:    bw,
+		IsTransitive: true,
+	}
+}
+
 type ColorExtended struct {
 	Color uint32
 }
@@ -12350,6 +13277,88 @@ func (e *Layer2AttributesExtended) GetTypes() (ExtendedCommunityAttrType, Extend
 	return EC_TYPE_EVPN, EC_SUBTYPE_L2_ATTRIBUTES
 }
 
+func NewLayer2AttributesExtended(flags EvpnControlFlag, mtu uint16) *Layer2AttributesExtended {
+	return &Layer2AttributesExtended{
+		HasCILabel:     flags&CI_LABEL > 0,
+		HasFlowLabel:   flags&FLOW_LABEL > 0,
+		HasControlWord: flags&CONTROL_WORD > 0,
+		IsPrimaryPe:    flags&PRIMARY_PE > 0,
+		IsBackupPe:     flags&BACKUP_PE > 0,
+		Mtu:            mtu,
+	}
+}
+
+// DFAlgorithm is the Designated Forwarder election algorithm of the DF
+// Election Extended Community (RFC 8584).
+type DFAlgorithm uint8
+
+const (
+	DF_ALGORITHM_DEFAULT DFAlgorithm = 0 // Service carving (RFC 7432)
+	DF_ALGORITHM_HRW     DFAlgorithm = 1 // Highest Random Weight (RFC 8584)
+)
+
+func (a DFAlgorithm) String() string {
+	switch a {
+	case DF_ALGORITHM_DEFAULT:
+		return "default"
+	case DF_ALGORITHM_HRW:
+		return "hrw"
+	}
+	return fmt.Sprintf("DFAlgorithm(%d)", uint8(a))
+}
+
+// DF_ELECTION_CAPABILITY_AC_DF is the AC-DF bit of the Bitmap field of the
+// DF Election Extended Community.
+const DF_ELECTION_CAPABILITY_AC_DF uint16 = 1 << 14
+
+type DFElectionExtended struct {
+	Algorithm DFAlgorithm
+	Bitmap    uint16
+}
+
+func (e *DFElectionExtended) Serialize() ([]byte, error) {
+	buf := make([]byte, 8)
+	buf[0] = byte(EC_TYPE_EVPN)
+	buf[1] = byte(EC_SUBTYPE_DF_ELECTION)
+	buf[2] = byte(e.Algorithm) & 0x1f
+	binary.BigEndian.PutUint16(buf[3:5], e.Bitmap)
+	return buf, nil
+}
+
+func (e *DFElectionExtended) String() string {
+	s := "df-election: " + e.Algorithm.String()
+	if e.Bitmap&DF_ELECTION_CAPABILITY_AC_DF > 0 {
+		s += ", ac-df"
+	}
+	return s
+}
+
+func (e *DFElectionExtended) MarshalJSON() ([]byte, error) {
+	t, s := e.GetTypes()
+	return json.Marshal(struct {
+		Type      ExtendedCommunityAttrType    `json:"type"`
+		Subtype   ExtendedCommunityAttrSubType `json:"subtype"`
+		Algorithm DFAlgorithm                  `json:"algorithm"`
+		Bitmap    uint16                       `json:"bitmap"`
+	}{
+		Type:      t,
+		Subtype:   s,
+		Algorithm: e.Algorithm,
+		Bitmap:    e.Bitmap,
+	})
+}
+
+func (e *DFElectionExtended) GetTypes() (ExtendedCommunityAttrType, ExtendedCommunityAttrSubType) {
+	return EC_TYPE_EVPN, EC_SUBTYPE_DF_ELECTION
+}
+
+func NewDFElectionExtended(algorithm DFAlgorithm, bitmap uint16) *DFElectionExtended {
+	return &DFElectionExtended{
+		Algorithm: algorithm,
+		Bitmap:    bitmap,
+	}
+}
+
 func parseEvpnExtended(data []byte) (ExtendedCommunityInterface, error) {
 	if ExtendedCommunityAttrType(data[0]) != EC_TYPE_EVPN {
 		return nil, NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, fmt.Sprintf("ext comm type is not EC_TYPE_EVPN: %d", data[0]))
@@ -12399,6 +13408,11 @@ func parseEvpnExtended(data []byte) (ExtendedCommunityInterface, error) {
 				Mtu:            binary.BigEndian.Uint16(data[4:6]),
 			}, nil
 		}
+	case EC_SUBTYPE_DF_ELECTION:
+		return &DFElectionExtended{
+			Algorithm: DFAlgorithm(data[2] & 0x1f),
+			Bitmap:    binary.BigEndian.Uint16(data[3:5]),
+		}, nil
 	}
 	return nil, NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, fmt.Sprintf("unknown evpn subtype: %d", subType))
 }
@@ -12672,6 +13686,131 @@ func NewTrafficRemarkExtended(dscp uint8) *TrafficRemarkExtended {
 	}
 }
 
+// InterfaceSetExtended restricts the flowspec rule to the interfaces in
+// the group of the AS, in the inbound and/or outbound direction
+// (draft-ietf-idr-flowspec-interfaceset).
+type InterfaceSetExtended struct {
+	AS       uint32
+	GroupID  uint16
+	Inbound  bool
+	Outbound bool
+}
+
+const (
+	interfaceSetOutbound   = 0x8000
+	interfaceSetInbound    = 0x4000
+	interfaceSetGroupIDMax = 0x3fff
+)
+
+func (e *InterfaceSetExtended) Serialize() ([]byte, error) {
+	if e.GroupID > interfaceSetGroupIDMax {
+		return nil, fmt.Errorf("invalid interface-set group id: %d", e.GroupID)
+	}
+	buf := make([]byte, 8)
+	buf[0] = byte(EC_TYPE_FLOWSPEC_TRANSITIVE)
+	buf[1] = byte(EC_SUBTYPE_FLOWSPEC_INTERFACE_SET)
+	binary.BigEndian.PutUint32(buf[2:6], e.AS)
+	v := e.GroupID
+	if e.Outbound {
+		v |= interfaceSetOutbound
+	}
+	if e.Inbound {
+		v |= interfaceSetInbound
+	}
+	binary.BigEndian.PutUint16(buf[6:8], v)
+	return buf, nil
+}
+
+// Direction returns "in", "out" or "both", or an empty string if neither
+// of the direction flags is set.
+func (e *InterfaceSetExtended) Direction() string {
+	switch {
+	case e.Inbound && e.Outbound:
+		return "both"
+	case e.Inbound:
+		return "in"
+	case e.Outbound:
+		return "out"
+	}
+	return ""
+}
+
+func (e *InterfaceSetExtended) String() string {
+	s := fmt.Sprintf("interface-set: %d:%d", e.AS, e.GroupID)
+	if d := e.Direction(); d != "" {
+		s += ":" + d
+	}
+	return s
+}
+
+func (e *InterfaceSetExtended) MarshalJSON() ([]byte, error) {
+	t, s := e.GetTypes()
+	return json.Marshal(struct {
+		Type     ExtendedCommunityAttrType    `json:"type"`
+		Subtype  ExtendedCommunityAttrSubType `json:"subtype"`
+		AS       uint32                       `json:"as"`
+		GroupID  uint16                       `json:"group_id"`
+		Inbound  bool                         `json:"inbound"`
+		Outbound bool                         `json:"outbound"`
+	}{t, s, e.AS, e.GroupID, e.Inbound, e.Outbound})
+}
+
+func (e *InterfaceSetExtended) GetTypes() (ExtendedCommunityAttrType, ExtendedCommunityAttrSubType) {
+	return EC_TYPE_FLOWSPEC_TRANSITIVE, EC_SUBTYPE_FLOWSPEC_INTERFACE_SET
+}
+
+func NewInterfaceSetExtended(as uint32, groupID uint16, inbound, outbound bool) *InterfaceSetExtended {
+	return &InterfaceSetExtended{
+		AS:       as,
+		GroupID:  groupID,
+		Inbound:  inbound,
+		Outbound: outbound,
+	}
+}
+
+// ParseInterfaceSetExtended parses "<AS>:<GROUP_ID>[:{in|out|both}]". Both
+// of the directions are set if omitted.
+func ParseInterfaceSetExtended(s string) (*InterfaceSetExtended, error) {
+	elems := strings.Split(s, ":")
+	if len(elems) != 2 && len(elems) != 3 {
+		return nil, fmt.Errorf("invalid interface-set: %s", s)
+	}
+	as, err := strconv.ParseUint(elems[0], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid interface-set as: %s", elems[0])
+	}
+	id, err := strconv.ParseUint(elems[1], 10, 16)
+	if err != nil || id > interfaceSetGroupIDMax {
+		return nil, fmt.Errorf("invalid interface-set group id: %s", elems[1])
+	}
+	inbound, outbound := true, true
+	if len(elems) == 3 {
+		switch elems[2] {
+		case "in":
+			outbound = false
+		case "out":
+			inbound = false
+		case "both":
+		default:
+			return nil, fmt.Errorf("invalid interface-set direction: %s", elems[2])
+		}
+	}
+	return NewInterfaceSetExtended(uint32(as), uint16(id), inbound, outbound), nil
+}
+
+func parseFlowSpecTransitiveExtended(data []byte) (ExtendedCommunityInterface, error) {
+	switch ExtendedCommunityAttrSubType(data[1]) {
+	case EC_SUBTYPE_FLOWSPEC_INTERFACE_SET:
+		as := binary.BigEndian.Uint32(data[2:6])
+		v := binary.BigEndian.Uint16(data[6:8])
+		return NewInterfaceSetExtended(as, v&interfaceSetGroupIDMax, v&interfaceSetInbound != 0, v&interfaceSetOutbound != 0), nil
+	}
+	return &UnknownExtended{
+		Type:  ExtendedCommunityAttrType(data[0]),
+		Value: data[1:8],
+	}, nil
+}
+
 func parseGenericTransitiveExperimentalExtended(data []byte) (ExtendedCommunityInterface, error) {
 	typ := ExtendedCommunityAttrType(data[0])
 	if typ != EC_TYPE_GENERIC_TRANSITIVE_EXPERIMENTAL && typ != EC_TYPE_GENERIC_TRANSITIVE_EXPERIMENTAL2 && typ != EC_TYPE_GENERIC_TRANSITIVE_EXPERIMENTAL3 {
@@ -12820,6 +13959,9 @@ func ParseExtended(data []byte) (ExtendedCommunityInterface, error) {
 		localAdmin := binary.BigEndian.Uint32(data[4:8])
 
 		if subtype == EC_SUBTYPE_LINK_BANDWIDTH {
+			if transitive {
+				return NewTransitiveLinkBandwidthExtended(as, math.Float32frombits(localAdmin)), nil
+			}
 			return NewLinkBandwidthExtended(as, math.Float32frombits(localAdmin)), nil
 		} else {
 			return NewTwoOctetAsSpecificExtended(subtype, as, localAdmin, transitive), nil
@@ -12845,10 +13987,14 @@ func ParseExtended(data []byte) (ExtendedCommunityInterface, error) {
 		return parseOpaqueExtended(data)
 	case EC_TYPE_EVPN:
 		return parseEvpnExtended(data)
+	case EC_TYPE_FLOWSPEC_TRANSITIVE:
+		return parseFlowSpecTransitiveExtended(data)
 	case EC_TYPE_GENERIC_TRANSITIVE_EXPERIMENTAL, EC_TYPE_GENERIC_TRANSITIVE_EXPERIMENTAL2, EC_TYPE_GENERIC_TRANSITIVE_EXPERIMENTAL3:
 		return parseGenericTransitiveExperimentalExtended(data)
 	case EC_TYPE_MUP:
 		return parseMUPExtended(data)
+	case EC_TYPE_TRANSITIVE_TRANSPORT_CLASS, EC_TYPE_NON_TRANSITIVE_TRANSPORT_CLASS:
+		return parseTransportClassExtended(data)
 	default:
 		return &UnknownExtended{
 			Type:  ExtendedCommunityAttrType(data[0]),
@@ -13453,6 +14599,249 @@ func NewTunnelEncapSubTLVUDPDestPort(port uint16) *TunnelEncapSubTLVUDPDestPort
 	}
 }
 
+type TunnelEncapSubTLVDSField struct {
+	TunnelEncapSubTLV
+	DSField uint8
+}
+
+func (t *TunnelEncapSubTLVDSField) DecodeFromBytes(data []byte) error {
+	value, err := t.TunnelEncapSubTLV.DecodeFromBytes(data)
+	if err != nil {
+		return err
+	}
+	if t.Length != 1 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Invalid TunnelEncapSubTLVDSField length")
+	}
+	t.DSField = value[0]
+	return nil
+}
+
+func (t *TunnelEncapSubTLVDSField) Serialize() ([]byte, error) {
+	return t.TunnelEncapSubTLV.Serialize([]byte{t.DSField})
+}
+
+func (t *TunnelEncapSubTLVDSField) String() string {
+	return fmt.Sprintf("{DSField: %d}", t.DSField)
+}
+
+func (t *TunnelEncapSubTLVDSField) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type    EncapSubTLVType `json:"type"`
+		DSField uint8           `json:"ds_field"`
+	}{
+		Type:    t.Type,
+		DSField: t.DSField,
+	})
+}
+
+func NewTunnelEncapSubTLVDSField(dsField uint8) *TunnelEncapSubTLVDSField {
+	return &TunnelEncapSubTLVDSField{
+		TunnelEncapSubTLV: TunnelEncapSubTLV{
+			Type: ENCAP_SUBTLV_TYPE_DS_FIELD,
+		},
+		DSField: dsField,
+	}
+}
+
+// Embedded Label Handling values defined in RFC 9012 Section 3.6
+const (
+	EMBEDDED_LABEL_HANDLING_PAYLOAD    uint8 = 1
+	EMBEDDED_LABEL_HANDLING_NO_PAYLOAD uint8 = 2
+)
+
+type TunnelEncapSubTLVEmbeddedLabelHandling struct {
+	TunnelEncapSubTLV
+	Handling uint8
+}
+
+func (t *TunnelEncapSubTLVEmbeddedLabelHandling) DecodeFromBytes(data []byte) error {
+	value, err := t.TunnelEncapSubTLV.DecodeFromBytes(data)
+	if err != nil {
+		return err
+	}
+	if t.Length != 1 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Invalid TunnelEncapSubTLVEmbeddedLabelHandling length")
+	}
+	t.Handling = value[0]
+	return nil
+}
+
+func (t *TunnelEncapSubTLVEmbeddedLabelHandling) Serialize() ([]byte, error) {
+	return t.TunnelEncapSubTLV.Serialize([]byte{t.Handling})
+}
+
+func (t *TunnelEncapSubTLVEmbeddedLabelHandling) String() string {
+	return fmt.Sprintf("{EmbeddedLabelHandling: %d}", t.Handling)
+}
+
+func (t *TunnelEncapSubTLVEmbeddedLabelHandling) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type     EncapSubTLVType `json:"type"`
+		Handling uint8           `json:"handling"`
+	}{
+		Type:     t.Type,
+		Handling: t.Handling,
+	})
+}
+
+func NewTunnelEncapSubTLVEmbeddedLabelHandling(handling uint8) *TunnelEncapSubTLVEmbeddedLabelHandling {
+	return &TunnelEncapSubTLVEmbeddedLabelHandling{
+		TunnelEncapSubTLV: TunnelEncapSubTLV{
+			Type: ENCAP_SUBTLV_TYPE_EMBEDDED_LABEL,
+		},
+		Handling: handling,
+	}
+}
+
+// MPLSLabelStackEntry is the label stack entry described in RFC 3032.
+type MPLSLabelStackEntry struct {
+	Label         uint32 `json:"label"`
+	TrafficClass  uint8  `json:"tc"`
+	BottomOfStack bool   `json:"s"`
+	TTL           uint8  `json:"ttl"`
+}
+
+func (e MPLSLabelStackEntry) String() string {
+	return fmt.Sprintf("%d/tc %d/s %t/ttl %d", e.Label, e.TrafficClass, e.BottomOfStack, e.TTL)
+}
+
+type TunnelEncapSubTLVMPLSLabelStack struct {
+	TunnelEncapSubTLV
+	Entries []MPLSLabelStackEntry
+}
+
+func (t *TunnelEncapSubTLVMPLSLabelStack) DecodeFromBytes(data []byte) error {
+	value, err := t.TunnelEncapSubTLV.DecodeFromBytes(data)
+	if err != nil {
+		return err
+	}
+	if t.Length%4 != 0 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Invalid TunnelEncapSubTLVMPLSLabelStack length")
+	}
+	t.Entries = make([]MPLSLabelStackEntry, 0, t.Length/4)
+	for ; len(value) >= 4; value = value[4:] {
+		v := binary.BigEndian.Uint32(value[0:4])
+		t.Entries = append(t.Entries, MPLSLabelStackEntry{
+			Label:         v >> 12,
+			TrafficClass:  uint8((v >> 9) & 0x07),
+			BottomOfStack: v&0x100 != 0,
+			TTL:           uint8(v & 0xff),
+		})
+	}
+	return nil
+}
+
+func (t *TunnelEncapSubTLVMPLSLabelStack) Serialize() ([]byte, error) {
+	buf := make([]byte, 4*len(t.Entries))
+	for i, e := range t.Entries {
+		v := (e.Label&0xfffff)<<12 | uint32(e.TrafficClass&0x07)<<9 | uint32(e.TTL)
+		if e.BottomOfStack {
+			v |= 0x100
+		}
+		binary.BigEndian.PutUint32(buf[4*i:], v)
+	}
+	return t.TunnelEncapSubTLV.Serialize(buf)
+}
+
+func (t *TunnelEncapSubTLVMPLSLabelStack) String() string {
+	entries := make([]string, 0, len(t.Entries))
+	for _, e := range t.Entries {
+		entries = append(entries, e.String())
+	}
+	return fmt.Sprintf("{MPLSLabelStack: [%s]}", strings.Join(entries, ", "))
+}
+
+func (t *TunnelEncapSubTLVMPLSLabelStack) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type    EncapSubTLVType       `json:"type"`
+		Entries []MPLSLabelStackEntry `json:"entries"`
+	}{
+		Type:    t.Type,
+		Entries: t.Entries,
+	})
+}
+
+func NewTunnelEncapSubTLVMPLSLabelStack(entries []MPLSLabelStackEntry) *TunnelEncapSubTLVMPLSLabelStack {
+	return &TunnelEncapSubTLVMPLSLabelStack{
+		TunnelEncapSubTLV: TunnelEncapSubTLV{
+			Type: ENCAP_SUBTLV_TYPE_MPLS_LABEL_STACK,
+		},
+		Entries: entries,
+	}
+}
+
+// TunnelEncapSubTLVPrefixSID carries the Label-Index TLV of the BGP
+// Prefix-SID attribute (RFC 8669) as described in RFC 9012 Section 3.7.
+// The other Prefix-SID TLVs are ignored.
+type TunnelEncapSubTLVPrefixSID struct {
+	TunnelEncapSubTLV
+	Flags      uint16
+	LabelIndex uint32
+}
+
+func (t *TunnelEncapSubTLVPrefixSID) DecodeFromBytes(data []byte) error {
+	value, err := t.TunnelEncapSubTLV.DecodeFromBytes(data)
+	if err != nil {
+		return err
+	}
+	found := false
+	for len(value) > 0 {
+		tlv := &TLV{}
+		v, err := tlv.DecodeFromBytes(value)
+		if err != nil {
+			return err
+		}
+		if tlv.Type == TLVTypeLavelIndex {
+			if tlv.Length != 7 {
+				return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Invalid TunnelEncapSubTLVPrefixSID Label-Index TLV length")
+			}
+			t.Flags = binary.BigEndian.Uint16(v[1:3])
+			t.LabelIndex = binary.BigEndian.Uint32(v[3:7])
+			found = true
+		}
+		value = value[tlv.Len():]
+	}
+	if !found {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "TunnelEncapSubTLVPrefixSID without Label-Index TLV")
+	}
+	return nil
+}
+
+func (t *TunnelEncapSubTLVPrefixSID) Serialize() ([]byte, error) {
+	buf := make([]byte, 10)
+	buf[0] = byte(TLVTypeLavelIndex)
+	binary.BigEndian.PutUint16(buf[1:3], 7)
+	binary.BigEndian.PutUint16(buf[4:6], t.Flags)
+	binary.BigEndian.PutUint32(buf[6:10], t.LabelIndex)
+	return t.TunnelEncapSubTLV.Serialize(buf)
+}
+
+func (t *TunnelEncapSubTLVPrefixSID) String() string {
+	return fmt.Sprintf("{PrefixSID: LabelIndex: %d}", t.LabelIndex)
+}
+
+func (t *TunnelEncapSubTLVPrefixSID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type       EncapSubTLVType `json:"type"`
+		Flags      uint16          `json:"flags"`
+		LabelIndex uint32          `json:"label_index"`
+	}{
+		Type:       t.Type,
+		Flags:      t.Flags,
+		LabelIndex: t.LabelIndex,
+	})
+}
+
+func NewTunnelEncapSubTLVPrefixSID(flags uint16, labelIndex uint32) *TunnelEncapSubTLVPrefixSID {
+	return &TunnelEncapSubTLVPrefixSID{
+		TunnelEncapSubTLV: TunnelEncapSubTLV{
+			Type: ENCAP_SUBTLV_TYPE_PREFIX_SID,
+		},
+		Flags:      flags,
+		LabelIndex: labelIndex,
+	}
+}
+
 type TunnelEncapTLV struct {
 	Type   TunnelType
 	Length uint16
@@ -13489,6 +14878,14 @@ func (t *TunnelEncapTLV) DecodeFromBytes(data []byte) error {
 			subTlv = &TunnelEncapSubTLVUDPDestPort{}
 		case ENCAP_SUBTLV_TYPE_EGRESS_ENDPOINT:
 			subTlv = &TunnelEncapSubTLVEgressEndpoint{}
+		case ENCAP_SUBTLV_TYPE_DS_FIELD:
+			subTlv = &TunnelEncapSubTLVDSField{}
+		case ENCAP_SUBTLV_TYPE_EMBEDDED_LABEL:
+			subTlv = &TunnelEncapSubTLVEmbeddedLabelHandling{}
+		case ENCAP_SUBTLV_TYPE_MPLS_LABEL_STACK:
+			subTlv = &TunnelEncapSubTLVMPLSLabelStack{}
+		case ENCAP_SUBTLV_TYPE_PREFIX_SID:
+			subTlv = &TunnelEncapSubTLVPrefixSID{}
 		case ENCAP_SUBTLV_TYPE_SRPREFERENCE:
 			subTlv = &TunnelEncapSubTLVSRPreference{}
 		case ENCAP_SUBTLV_TYPE_SRBINDING_SID:
@@ -13682,6 +15079,69 @@ func NewIngressReplTunnelID(value string) *IngressReplTunnelID {
 	}
 }
 
+// PimTunnelID represents the Tunnel Identifier of the PIM-SSM, PIM-SM and
+// BIDIR-PIM trees as described in RFC6514 5, the <Sender Address, P-Multicast
+// Group>.
+type PimTunnelID struct {
+	Sender net.IP
+	Group  net.IP
+}
+
+func (i *PimTunnelID) Len() int {
+	if i.Sender.To4() != nil {
+		return 2 * net.IPv4len
+	}
+	return 2 * net.IPv6len
+}
+
+func (i *PimTunnelID) Serialize() ([]byte, error) {
+	if sender := i.Sender.To4(); sender != nil {
+		group := i.Group.To4()
+		if group == nil {
+			return nil, fmt.Errorf("address families of pim tunnel sender and group differ: %s, %s", i.Sender, i.Group)
+		}
+		return append(append([]byte{}, sender...), group...), nil
+	}
+	if i.Group.To4() != nil {
+		return nil, fmt.Errorf("address families of pim tunnel sender and group differ: %s, %s", i.Sender, i.Group)
+	}
+	return append(append([]byte{}, i.Sender.To16()...), i.Group.To16()...), nil
+}
+
+func (i *PimTunnelID) String() string {
+	return fmt.Sprintf("%s,%s", i.Sender, i.Group)
+}
+
+func NewPimTunnelID(sender, group string) *PimTunnelID {
+	s := net.ParseIP(sender)
+	g := net.ParseIP(group)
+	if s == nil || g == nil {
+		return nil
+	}
+	return &PimTunnelID{
+		Sender: s,
+		Group:  g,
+	}
+}
+
+// ParsePmsiTunnelID decodes the Tunnel Identifier of the tunnel type.
+func ParsePmsiTunnelID(typ PmsiTunnelType, data []byte) PmsiTunnelIDInterface {
+	switch typ {
+	case PMSI_TUNNEL_TYPE_INGRESS_REPL:
+		return &IngressReplTunnelID{net.IP(data)}
+	case PMSI_TUNNEL_TYPE_PIM_SSM_TREE, PMSI_TUNNEL_TYPE_PIM_SM_TREE, PMSI_TUNNEL_TYPE_BIDIR_PIM_TREE:
+		switch len(data) {
+		case 2 * net.IPv4len, 2 * net.IPv6len:
+			l := len(data) / 2
+			return &PimTunnelID{
+				Sender: net.IP(append([]byte{}, data[:l]...)),
+				Group:  net.IP(append([]byte{}, data[l:]...)),
+			}
+		}
+	}
+	return &DefaultPmsiTunnelID{data}
+}
+
 type PathAttributePmsiTunnel struct {
 	PathAttribute
 	IsLeafInfoRequired bool
@@ -13709,12 +15169,7 @@ func (p *PathAttributePmsiTunnel) DecodeFromBytes(data []byte, options ...*Marsh
 		return err
 	}
 
-	switch p.TunnelType {
-	case PMSI_TUNNEL_TYPE_INGRESS_REPL:
-		p.TunnelID = &IngressReplTunnelID{net.IP(value[5:])}
-	default:
-		p.TunnelID = &DefaultPmsiTunnelID{value[5:]}
-	}
+	p.TunnelID = ParsePmsiTunnelID(p.TunnelType, value[5:])
 	return nil
 }
 
@@ -13786,6 +15241,7 @@ func NewPathAttributePmsiTunnel(typ PmsiTunnelType, isLeafInfoRequired bool, lab
 func ParsePmsiTunnel(args []string) (*PathAttributePmsiTunnel, error) {
 	// Format:
 	// "<type>" ["leaf-info-required"] "<label>" "<tunnel-id>"
+	// The tunnel-id of the PIM trees is "<sender>,<group>".
 	if len(args) < 3 {
 		return nil, fmt.Errorf("invalid pmsi tunnel arguments: %s", args)
 	}
@@ -13795,6 +15251,12 @@ func ParsePmsiTunnel(args []string) (*PathAttributePmsiTunnel, error) {
 	switch args[0] {
 	case "ingress-repl":
 		tunnelType = PMSI_TUNNEL_TYPE_INGRESS_REPL
+	case "pim-ssm-tree", "pim-ssm":
+		tunnelType = PMSI_TUNNEL_TYPE_PIM_SSM_TREE
+	case "pim-sm-tree", "pim-sm":
+		tunnelType = PMSI_TUNNEL_TYPE_PIM_SM_TREE
+	case "bidir-pim-tree", "bidir-pim":
+		tunnelType = PMSI_TUNNEL_TYPE_BIDIR_PIM_TREE
 	default:
 		typ, err := strconv.ParseUint(args[0], 10, 8)
 		if err != nil {
@@ -13823,6 +15285,16 @@ func ParsePmsiTunnel(args []string) (*PathAttributePmsiTunnel, error) {
 			return nil, fmt.Errorf("invalid pmsi tunnel identifier: %s", args[indx])
 		}
 		id = &IngressReplTunnelID{Value: ip}
+	case PMSI_TUNNEL_TYPE_PIM_SSM_TREE, PMSI_TUNNEL_TYPE_PIM_SM_TREE, PMSI_TUNNEL_TYPE_BIDIR_PIM_TREE:
+		elems := strings.Split(args[indx], ",")
+		if len(elems) != 2 {
+			return nil, fmt.Errorf("invalid pmsi tunnel identifier: %s", args[indx])
+		}
+		pim := NewPimTunnelID(elems[0], elems[1])
+		if pim == nil || (pim.Sender.To4() == nil) != (pim.Group.To4() == nil) {
+			return nil, fmt.Errorf("invalid pmsi tunnel identifier: %s", args[indx])
+		}
+		id = pim
 	default:
 		id = &DefaultPmsiTunnelID{Value: []byte(args[indx])}
 	}
@@ -14031,11 +15503,14 @@ func (p *PathAttributeAigp) DecodeFromBytes(data []byte, options ...*Marshalling
 	if err != nil {
 		return err
 	}
+	eCode := uint8(BGP_ERROR_UPDATE_MESSAGE_ERROR)
+	eSubCode := uint8(BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST)
+	hasMetric := false
 	for len(value) > 3 {
 		typ := value[0]
 		length := binary.BigEndian.Uint16(value[1:3])
 		if length <= 3 {
-			return NewMessageError(BGP_ERROR_MESSAGE_HEADER_ERROR, BGP_ERROR_SUB_BAD_MESSAGE_LENGTH, nil, "Malformed BGP message")
+			return NewMessageError(eCode, eSubCode, nil, "Aigp TLV length is too short")
 		}
 		if len(value) < int(length) {
 			break
@@ -14043,9 +15518,13 @@ func (p *PathAttributeAigp) DecodeFromBytes(data []byte, options ...*Marshalling
 		v := value[3:length]
 		switch AigpTLVType(typ) {
 		case AIGP_TLV_IGP_METRIC:
-			if len(v) < 8 {
-				break
+			// RFC 7311 3.1
+			// The length of the AIGP TLV is 11 and the attribute
+			// MUST NOT have more than one of them.
+			if length != 11 || hasMetric {
+				return NewMessageError(eCode, eSubCode, nil, "Aigp TLV is malformed")
 			}
+			hasMetric = true
 			metric := binary.BigEndian.Uint64(v)
 			p.Values = append(p.Values, NewAigpTLVIgpMetric(metric))
 		default:
@@ -14054,8 +15533,6 @@ func (p *PathAttributeAigp) DecodeFromBytes(data []byte, options ...*Marshalling
 		value = value[length:]
 	}
 	if len(value) != 0 {
-		eCode := uint8(BGP_ERROR_UPDATE_MESSAGE_ERROR)
-		eSubCode := uint8(BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST)
 		return NewMessageError(eCode, eSubCode, nil, "Aigp length is incorrect")
 	}
 	return nil
@@ -14448,15 +15925,22 @@ func (msg *BGPUpdate) DecodeFromBytes(data []byte, options ...*MarshallingOption
 	}
 	options = append(options, &o)
 
+	lenient := IsLenient(options)
+	var attrErrors []*MessageError
+	addError := func(e *MessageError) {
+		attrErrors = append(attrErrors, e)
+		if e.Stronger(strongestError) {
+			strongestError = e
+		}
+	}
+
 	msg.PathAttributes = []PathAttributeInterface{}
 	for pathlen := msg.TotalPathAttributeLen; pathlen > 0; {
 		var e error
 		if pathlen < 3 {
 			e = NewMessageErrorWithErrorHandling(
 				eCode, BGP_ERROR_SUB_ATTRIBUTE_LENGTH_ERROR, data, ERROR_HANDLING_TREAT_AS_WITHDRAW, nil, "insufficient data to decode")
-			if e.(*MessageError).Stronger(strongestError) {
-				strongestError = e
-			}
+			addError(e.(*MessageError))
 			data = data[pathlen:]
 			break
 		}
@@ -14466,24 +15950,33 @@ func (msg *BGPUpdate) DecodeFromBytes(data []byte, options ...*MarshallingOption
 		}
 		err = p.DecodeFromBytes(data, options...)
 		if err != nil {
+			if _, ok := err.(*MessageError); !ok {
+				err = NewMessageError(eCode, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, err.Error())
+			}
 			e = err.(*MessageError)
 			if e.(*MessageError).SubTypeCode == BGP_ERROR_SUB_ATTRIBUTE_FLAGS_ERROR {
 				e.(*MessageError).ErrorHandling = ERROR_HANDLING_TREAT_AS_WITHDRAW
 			} else {
 				e.(*MessageError).ErrorHandling = getErrorHandlingFromPathAttribute(p.GetType())
-				e.(*MessageError).ErrorAttribute = &p
-			}
-			if e.(*MessageError).Stronger(strongestError) {
-				strongestError = e
 			}
+			e.(*MessageError).ErrorAttribute = &p
+			addError(e.(*MessageError))
+		}
+		if lenient && p.Len(options...) > int(pathlen) {
+			// RFC 7606 4. the attribute overruns the path attributes;
+			// the rest of them is skipped and the NLRI field is still
+			// parsed to be treated as withdrawn.
+			e = NewMessageErrorWithErrorHandling(
+				eCode, BGP_ERROR_SUB_ATTRIBUTE_LENGTH_ERROR, data, ERROR_HANDLING_TREAT_AS_WITHDRAW, &p, "attribute length overruns path attributes")
+			addError(e.(*MessageError))
+			data = data[pathlen:]
+			break
 		}
 		pathlen -= uint16(p.Len(options...))
 		if len(data) < p.Len(options...) {
 			e = NewMessageErrorWithErrorHandling(
 				eCode, BGP_ERROR_SUB_ATTRIBUTE_LENGTH_ERROR, data, ERROR_HANDLING_TREAT_AS_WITHDRAW, nil, "attribute length is short")
-			if e.(*MessageError).Stronger(strongestError) {
-				strongestError = e
-			}
+			addError(e.(*MessageError))
 			return strongestError
 		}
 		data = data[p.Len(options...):]
@@ -14510,9 +16003,37 @@ func (msg *BGPUpdate) DecodeFromBytes(data []byte, options ...*MarshallingOption
 		msg.NLRI = append(msg.NLRI, n)
 	}
 
+	if len(msg.NLRI) == 0 && strongestError != nil {
+		// RFC 4760 3. NEXT_HOP is ignored without the NLRI field, so its
+		// errors don't withdraw the routes in MP_REACH_NLRI.
+		strongestError = nil
+		for _, e := range attrErrors {
+			if e.ErrorAttribute != nil && (*e.ErrorAttribute).GetType() == BGP_ATTR_TYPE_NEXT_HOP {
+				e.ErrorHandling = ERROR_HANDLING_ATTRIBUTE_DISCARD
+				msg.removeAttribute(BGP_ATTR_TYPE_NEXT_HOP)
+			}
+			if e.Stronger(strongestError) {
+				strongestError = e
+			}
+		}
+	}
+
+	if lenient && strongestError != nil {
+		strongestError.(*MessageError).Errors = attrErrors
+	}
 	return strongestError
 }
 
+func (msg *BGPUpdate) removeAttribute(t BGPAttrType) {
+	attrs := msg.PathAttributes[:0]
+	for _, a := range msg.PathAttributes {
+		if a.GetType() != t {
+			attrs = append(attrs, a)
+		}
+	}
+	msg.PathAttributes = attrs
+}
+
 func (msg *BGPUpdate) Serialize(options ...*MarshallingOption) ([]byte, error) {
 	wbuf := make([]byte, 2)
 	for _, w := range msg.WithdrawnRoutes {
@@ -14696,10 +16217,191 @@ func NewBGPKeepAliveMessage() *BGPMessage {
 	}
 }
 
+type ORFWhenToRefresh uint8
+
+const (
+	ORF_WHEN_TO_REFRESH_IMMEDIATE ORFWhenToRefresh = 1
+	ORF_WHEN_TO_REFRESH_DEFER     ORFWhenToRefresh = 2
+)
+
+type ORFAction uint8
+
+const (
+	ORF_ACTION_ADD ORFAction = iota
+	ORF_ACTION_REMOVE
+	ORF_ACTION_REMOVE_ALL
+)
+
+func (a ORFAction) String() string {
+	switch a {
+	case ORF_ACTION_ADD:
+		return "add"
+	case ORF_ACTION_REMOVE:
+		return "remove"
+	case ORF_ACTION_REMOVE_ALL:
+		return "remove-all"
+	default:
+		return fmt.Sprintf("unknown(%d)", a)
+	}
+}
+
+type ORFMatch uint8
+
+const (
+	ORF_MATCH_PERMIT ORFMatch = iota
+	ORF_MATCH_DENY
+)
+
+func (m ORFMatch) String() string {
+	switch m {
+	case ORF_MATCH_PERMIT:
+		return "permit"
+	case ORF_MATCH_DENY:
+		return "deny"
+	default:
+		return fmt.Sprintf("unknown(%d)", m)
+	}
+}
+
+// AddressPrefixORFEntry is the Address Prefix ORF entry defined in
+// RFC 5292. The type specific part is omitted for the REMOVE-ALL action.
+type AddressPrefixORFEntry struct {
+	Action   ORFAction
+	Match    ORFMatch
+	Sequence uint32
+	MinLen   uint8
+	MaxLen   uint8
+	Length   uint8
+	Prefix   net.IP
+}
+
+func (e *AddressPrefixORFEntry) decodeFromBytes(data []byte, afi uint16) (int, error) {
+	e.Action = ORFAction(data[0] >> 6)
+	e.Match = ORFMatch((data[0] >> 5) & 0x1)
+	if e.Action == ORF_ACTION_REMOVE_ALL {
+		return 1, nil
+	}
+	if len(data) < 8 {
+		return 0, NewMessageError(BGP_ERROR_ROUTE_REFRESH_MESSAGE_ERROR, BGP_ERROR_SUB_INVALID_MESSAGE_LENGTH, nil, "Not all Address Prefix ORF entry bytes available")
+	}
+	e.Sequence = binary.BigEndian.Uint32(data[1:5])
+	e.MinLen = data[5]
+	e.MaxLen = data[6]
+	e.Length = data[7]
+	addrLen := net.IPv4len
+	if afi == AFI_IP6 {
+		addrLen = net.IPv6len
+	}
+	byteLen := (int(e.Length) + 7) / 8
+	if int(e.Length) > addrLen*8 || len(data) < 8+byteLen {
+		return 0, NewMessageError(BGP_ERROR_ROUTE_REFRESH_MESSAGE_ERROR, BGP_ERROR_SUB_INVALID_MESSAGE_LENGTH, nil, "Not all Address Prefix ORF entry bytes available")
+	}
+	e.Prefix = make(net.IP, addrLen)
+	copy(e.Prefix, data[8:8+byteLen])
+	return 8 + byteLen, nil
+}
+
+func (e *AddressPrefixORFEntry) serialize() []byte {
+	common := uint8(e.Action)<<6 | (uint8(e.Match)&0x1)<<5
+	if e.Action == ORF_ACTION_REMOVE_ALL {
+		return []byte{common}
+	}
+	byteLen := (int(e.Length) + 7) / 8
+	buf := make([]byte, 8+byteLen)
+	buf[0] = common
+	binary.BigEndian.PutUint32(buf[1:5], e.Sequence)
+	buf[5] = e.MinLen
+	buf[6] = e.MaxLen
+	buf[7] = e.Length
+	prefix := e.Prefix
+	if p := prefix.To4(); p != nil {
+		prefix = p
+	}
+	copy(buf[8:], prefix)
+	if r := e.Length % 8; r != 0 {
+		buf[7+byteLen] &= ^uint8(0xff >> r)
+	}
+	return buf
+}
+
+func (e *AddressPrefixORFEntry) String() string {
+	if e.Action == ORF_ACTION_REMOVE_ALL {
+		return e.Action.String()
+	}
+	return fmt.Sprintf("%s seq %d %s %s/%d ge %d le %d", e.Action, e.Sequence, e.Match, e.Prefix, e.Length, e.MinLen, e.MaxLen)
+}
+
+func NewAddressPrefixORFEntry(action ORFAction, match ORFMatch, sequence uint32, prefix *net.IPNet, minLen, maxLen uint8) *AddressPrefixORFEntry {
+	e := &AddressPrefixORFEntry{
+		Action:   action,
+		Match:    match,
+		Sequence: sequence,
+		MinLen:   minLen,
+		MaxLen:   maxLen,
+	}
+	if prefix != nil {
+		ones, _ := prefix.Mask.Size()
+		e.Length = uint8(ones)
+		e.Prefix = prefix.IP
+	}
+	return e
+}
+
+// RouteRefreshORF is a set of the ORF entries of the same type carried in
+// the ROUTE-REFRESH message. The entries of the unknown type are kept as is.
+type RouteRefreshORF struct {
+	Type    BGPORFType
+	Entries []*AddressPrefixORFEntry
+	Value   []byte
+}
+
+func (o *RouteRefreshORF) decodeFromBytes(data []byte, afi uint16) error {
+	if o.Type != ORF_TYPE_ADDRESS_PREFIX {
+		o.Value = data
+		return nil
+	}
+	o.Entries = []*AddressPrefixORFEntry{}
+	for len(data) > 0 {
+		e := &AddressPrefixORFEntry{}
+		l, err := e.decodeFromBytes(data, afi)
+		if err != nil {
+			return err
+		}
+		o.Entries = append(o.Entries, e)
+		data = data[l:]
+	}
+	return nil
+}
+
+func (o *RouteRefreshORF) serialize() []byte {
+	value := o.Value
+	if o.Type == ORF_TYPE_ADDRESS_PREFIX {
+		value = make([]byte, 0)
+		for _, e := range o.Entries {
+			value = append(value, e.serialize()...)
+		}
+	}
+	buf := make([]byte, 3, 3+len(value))
+	buf[0] = uint8(o.Type)
+	binary.BigEndian.PutUint16(buf[1:3], uint16(len(value)))
+	return append(buf, value...)
+}
+
+func NewRouteRefreshAddressPrefixORF(entries []*AddressPrefixORFEntry) *RouteRefreshORF {
+	return &RouteRefreshORF{
+		Type:    ORF_TYPE_ADDRESS_PREFIX,
+		Entries: entries,
+	}
+}
+
 type BGPRouteRefresh struct {
 	AFI         uint16
 	Demarcation uint8
 	SAFI        uint8
+	// WhenToRefresh and ORFs are present only when the message carries
+	// the ORF entries (RFC 5291).
+	WhenToRefresh ORFWhenToRefresh
+	ORFs          []*RouteRefreshORF
 }
 
 func (msg *BGPRouteRefresh) DecodeFromBytes(data []byte, options ...*MarshallingOption) error {
@@ -14709,6 +16411,27 @@ func (msg *BGPRouteRefresh) DecodeFromBytes(data []byte, options ...*Marshalling
 	msg.AFI = binary.BigEndian.Uint16(data[0:2])
 	msg.Demarcation = data[2]
 	msg.SAFI = data[3]
+	data = data[4:]
+	if len(data) == 0 {
+		return nil
+	}
+	msg.WhenToRefresh = ORFWhenToRefresh(data[0])
+	data = data[1:]
+	for len(data) > 0 {
+		if len(data) < 3 {
+			return NewMessageError(BGP_ERROR_ROUTE_REFRESH_MESSAGE_ERROR, BGP_ERROR_SUB_INVALID_MESSAGE_LENGTH, nil, "Not all RouteRefresh ORF bytes available")
+		}
+		o := &RouteRefreshORF{Type: BGPORFType(data[0])}
+		l := int(binary.BigEndian.Uint16(data[1:3]))
+		if len(data) < 3+l {
+			return NewMessageError(BGP_ERROR_ROUTE_REFRESH_MESSAGE_ERROR, BGP_ERROR_SUB_INVALID_MESSAGE_LENGTH, nil, "Not all RouteRefresh ORF bytes available")
+		}
+		if err := o.decodeFromBytes(data[3:3+l], msg.AFI); err != nil {
+			return err
+		}
+		msg.ORFs = append(msg.ORFs, o)
+		data = data[3+l:]
+	}
 	return nil
 }
 
@@ -14717,13 +16440,118 @@ func (msg *BGPRouteRefresh) Serialize(options ...*MarshallingOption) ([]byte, er
 	binary.BigEndian.PutUint16(buf[0:2], msg.AFI)
 	buf[2] = msg.Demarcation
 	buf[3] = msg.SAFI
+	if msg.WhenToRefresh == 0 && len(msg.ORFs) == 0 {
+		return buf, nil
+	}
+	buf = append(buf, uint8(msg.WhenToRefresh))
+	for _, o := range msg.ORFs {
+		buf = append(buf, o.serialize()...)
+	}
 	return buf, nil
 }
 
 func NewBGPRouteRefreshMessage(afi uint16, demarcation uint8, safi uint8) *BGPMessage {
 	return &BGPMessage{
 		Header: BGPHeader{Type: BGP_MSG_ROUTE_REFRESH},
-		Body:   &BGPRouteRefresh{afi, demarcation, safi},
+		Body: &BGPRouteRefresh{
+			AFI:         afi,
+			Demarcation: demarcation,
+			SAFI:        safi,
+		},
+	}
+}
+
+// NewBGPRouteRefreshORFMessage returns the ROUTE-REFRESH message carrying
+// the ORF entries defined in RFC 5291.
+func NewBGPRouteRefreshORFMessage(afi uint16, safi uint8, when ORFWhenToRefresh, orfs []*RouteRefreshORF) *BGPMessage {
+	return &BGPMessage{
+		Header: BGPHeader{Type: BGP_MSG_ROUTE_REFRESH},
+		Body: &BGPRouteRefresh{
+			AFI:           afi,
+			SAFI:          safi,
+			WhenToRefresh: when,
+			ORFs:          orfs,
+		},
+	}
+}
+
+type BGPCapabilityAction uint8
+
+const (
+	BGP_CAPABILITY_ACTION_ADVERTISE BGPCapabilityAction = 0
+	BGP_CAPABILITY_ACTION_REMOVE    BGPCapabilityAction = 1
+)
+
+func (a BGPCapabilityAction) String() string {
+	switch a {
+	case BGP_CAPABILITY_ACTION_ADVERTISE:
+		return "advertise"
+	case BGP_CAPABILITY_ACTION_REMOVE:
+		return "remove"
+	default:
+		return fmt.Sprintf("unknown(%d)", a)
+	}
+}
+
+// BGPCapabilityRevision is the capability advertised or removed by the
+// CAPABILITY message.
+type BGPCapabilityRevision struct {
+	Action     BGPCapabilityAction
+	Capability ParameterCapabilityInterface
+}
+
+func NewBGPCapabilityRevision(action BGPCapabilityAction, c ParameterCapabilityInterface) *BGPCapabilityRevision {
+	return &BGPCapabilityRevision{
+		Action:     action,
+		Capability: c,
+	}
+}
+
+// BGPCapability is the CAPABILITY message (draft-ietf-idr-dynamic-cap)
+// which revises the capabilities of the established session. Each
+// revision is encoded as the action followed by the capability in the
+// same format as the one in the OPEN message.
+type BGPCapability struct {
+	Revisions []*BGPCapabilityRevision
+}
+
+func (msg *BGPCapability) DecodeFromBytes(data []byte, options ...*MarshallingOption) error {
+	if len(data) == 0 {
+		return NewMessageError(BGP_ERROR_MESSAGE_HEADER_ERROR, BGP_ERROR_SUB_BAD_MESSAGE_LENGTH, nil, "no capability in Capability message")
+	}
+	for len(data) > 0 {
+		if len(data) < 3 || len(data) < 3+int(data[2]) {
+			return NewMessageError(BGP_ERROR_MESSAGE_HEADER_ERROR, BGP_ERROR_SUB_BAD_MESSAGE_LENGTH, nil, "Not all Capability message bytes available")
+		}
+		c, err := DecodeCapability(data[1:])
+		if err != nil {
+			return err
+		}
+		msg.Revisions = append(msg.Revisions, NewBGPCapabilityRevision(BGPCapabilityAction(data[0]), c))
+		data = data[3+int(data[2]):]
+	}
+	return nil
+}
+
+func (msg *BGPCapability) Serialize(options ...*MarshallingOption) ([]byte, error) {
+	buf := make([]byte, 0)
+	for _, r := range msg.Revisions {
+		b, err := r.Capability.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, uint8(r.Action))
+		buf = append(buf, b...)
+	}
+	return buf, nil
+}
+
+func NewBGPCapabilityMessage(revisions []*BGPCapabilityRevision) *BGPMessage {
+	return &BGPMessage{
+		Header: BGPHeader{Type: BGP_MSG_CAPABILITY},
+		Body: &BGPCapability{
+			Revisions: revisions,
+		},
 	}
 }
 
@@ -14733,8 +16561,9 @@ type BGPBody interface {
 }
 
 const (
-	BGP_HEADER_LENGTH      = 19
-	BGP_MAX_MESSAGE_LENGTH = 4096
+	BGP_HEADER_LENGTH               = 19
+	BGP_MAX_MESSAGE_LENGTH          = 4096
+	BGP_MAX_EXTENDED_MESSAGE_LENGTH = 65535
 )
 
 type BGPHeader struct {
@@ -14790,6 +16619,8 @@ func parseBody(h *BGPHeader, data []byte, options ...*MarshallingOption) (*BGPMe
 		msg.Body = &BGPKeepAlive{}
 	case BGP_MSG_ROUTE_REFRESH:
 		msg.Body = &BGPRouteRefresh{}
+	case BGP_MSG_CAPABILITY:
+		msg.Body = &BGPCapability{}
 	default:
 		return nil, NewMessageError(BGP_ERROR_MESSAGE_HEADER_ERROR, BGP_ERROR_SUB_BAD_MESSAGE_TYPE, nil, "unknown message type")
 	}
@@ -14821,7 +16652,7 @@ func (msg *BGPMessage) Serialize(options ...*MarshallingOption) ([]byte, error)
 		return nil, err
 	}
 	if msg.Header.Len == 0 {
-		if BGP_HEADER_LENGTH+len(b) > BGP_MAX_MESSAGE_LENGTH {
+		if BGP_HEADER_LENGTH+len(b) > GetMaxMessageLength(msg.Header.Type, options) {
 			return nil, NewMessageError(0, 0, nil, fmt.Sprintf("too long message length %d", BGP_HEADER_LENGTH+len(b)))
 		}
 		msg.Header.Len = BGP_HEADER_LENGTH + uint16(len(b))
@@ -14850,7 +16681,8 @@ func getErrorHandlingFromPathAttribute(t BGPAttrType) ErrorHandling {
 	case BGP_ATTR_TYPE_AS_PATH:
 		return ERROR_HANDLING_TREAT_AS_WITHDRAW
 	case BGP_ATTR_TYPE_AS4_PATH:
-		return ERROR_HANDLING_TREAT_AS_WITHDRAW
+		// RFC 6793 6.
+		return ERROR_HANDLING_ATTRIBUTE_DISCARD
 	case BGP_ATTR_TYPE_NEXT_HOP:
 		return ERROR_HANDLING_TREAT_AS_WITHDRAW
 	case BGP_ATTR_TYPE_MULTI_EXIT_DISC:
@@ -14862,7 +16694,8 @@ func getErrorHandlingFromPathAttribute(t BGPAttrType) ErrorHandling {
 	case BGP_ATTR_TYPE_AGGREGATOR:
 		return ERROR_HANDLING_ATTRIBUTE_DISCARD
 	case BGP_ATTR_TYPE_AS4_AGGREGATOR:
-		return ERROR_HANDLING_TREAT_AS_WITHDRAW
+		// RFC 6793 6.
+		return ERROR_HANDLING_ATTRIBUTE_DISCARD
 	case BGP_ATTR_TYPE_COMMUNITIES:
 		return ERROR_HANDLING_TREAT_AS_WITHDRAW
 	case BGP_ATTR_TYPE_ORIGINATOR_ID:
@@ -14885,6 +16718,9 @@ func getErrorHandlingFromPathAttribute(t BGPAttrType) ErrorHandling {
 		return ERROR_HANDLING_ATTRIBUTE_DISCARD
 	case BGP_ATTR_TYPE_AIGP:
 		return ERROR_HANDLING_ATTRIBUTE_DISCARD
+	case BGP_ATTR_TYPE_PREFIX_SID:
+		// RFC 8669 6.
+		return ERROR_HANDLING_TREAT_AS_WITHDRAW
 	default:
 		return ERROR_HANDLING_ATTRIBUTE_DISCARD
 	}
@@ -14897,6 +16733,9 @@ type MessageError struct {
 	Message        string
 	ErrorHandling  ErrorHandling
 	ErrorAttribute *PathAttributeInterface
+	// Errors holds all the path attribute errors found by the lenient
+	// decoding of an UPDATE message, including this one.
+	Errors []*MessageError
 }
 
 func NewMessageError(typeCode, subTypeCode uint8, data []byte, msg string) error {
@@ -14998,6 +16837,10 @@ func (e *Layer2AttributesExtended) Flat() map[string]string {
 	return map[string]string{}
 }
 
+func (e *DFElectionExtended) Flat() map[string]string {
+	return map[string]string{}
+}
+
 func (e *TrafficRateExtended) Flat() map[string]string {
 	return map[string]string{}
 }
@@ -15006,6 +16849,10 @@ func (e *TrafficRemarkExtended) Flat() map[string]string {
 	return map[string]string{}
 }
 
+func (e *InterfaceSetExtended) Flat() map[string]string {
+	return map[string]string{}
+}
+
 func (e *RedirectIPv4AddressSpecificExtended) Flat() map[string]string {
 	return map[string]string{}
 }
@@ -310,6 +310,123 @@ func Test_MalformedUpdateMsg(t *testing.T) {
 	assert.Equal(ERROR_HANDLING_TREAT_AS_WITHDRAW, err.(*MessageError).ErrorHandling)
 }
 
+func Test_DecodeUpdateLenient(t *testing.T) {
+	assert := assert.New(t)
+
+	// AS_PATH overruns the path attributes
+	bufin := []byte{
+		0x00, 0x00, // Withdraws(0)
+		0x00, 0x16, // Attrs Len(22)
+		0x40, 0x01, 0x01, 0x00, // Attr(ORIGIN)
+		0x40, 0x03, 0x04, 0xc0, // Attr(NEXT_HOP)
+		0xa8, 0x01, 0x64,
+		0x40, 0x02, 0x17, // Attr(AS_PATH) - invalid length
+		0x02, 0x03, 0xfd, 0xe8,
+		0xfd, 0xe8, 0xfd, 0xe8,
+		0x08, 0x0a, // NLRI
+	}
+
+	u := &BGPUpdate{}
+	err := u.DecodeFromBytes(bufin)
+	assert.Error(err)
+	assert.Empty(u.NLRI)
+	assert.Nil(err.(*MessageError).Errors)
+
+	u = &BGPUpdate{}
+	err = u.DecodeFromBytes(bufin, &MarshallingOption{Lenient: true})
+	assert.Error(err)
+	assert.Equal(ERROR_HANDLING_TREAT_AS_WITHDRAW, err.(*MessageError).ErrorHandling)
+	assert.Len(err.(*MessageError).Errors, 2)
+	assert.Len(u.NLRI, 1)
+	assert.Equal("10.0.0.0/8", u.NLRI[0].String())
+	w := TreatAsWithdraw(u)
+	assert.Len(w.WithdrawnRoutes, 1)
+
+	// Invalid AGGREGATOR and MULTI_EXIT_DESC
+	bufin = []byte{
+		0x00, 0x00, // Withdraws(0)
+		0x00, 0x1e, // Attrs Len(30)
+		0xc0, 0x07, 0x05, 0x00, // Attr(AGGREGATOR) - invalid length
+		0x00, 0x00, 0x64, 0x00,
+		0x80, 0x04, 0x05, 0x00, // Attr(MULTI_EXIT_DESC)  - invalid length
+		0x00, 0x00, 0x00, 0x64,
+		0x40, 0x01, 0x01, 0x00, // Attr(ORIGIN)
+		0x40, 0x02, 0x00, // Attr(AS_PATH)
+		0x40, 0x03, 0x04, 0xc0, // Attr(NEXT_HOP)
+		0xa8, 0x01, 0x64,
+		0x20, 0xc8, 0xc8, 0xc8, // NLRI
+		0xc8,
+	}
+
+	u = &BGPUpdate{}
+	err = u.DecodeFromBytes(bufin, &MarshallingOption{Lenient: true})
+	assert.Error(err)
+	e := err.(*MessageError)
+	assert.Equal(ERROR_HANDLING_TREAT_AS_WITHDRAW, e.ErrorHandling)
+	assert.Len(e.Errors, 2)
+	assert.Equal(ERROR_HANDLING_ATTRIBUTE_DISCARD, e.Errors[0].ErrorHandling)
+	assert.Equal(BGP_ATTR_TYPE_AGGREGATOR, (*e.Errors[0].ErrorAttribute).GetType())
+	assert.Equal(BGP_ATTR_TYPE_MULTI_EXIT_DISC, (*e.Errors[1].ErrorAttribute).GetType())
+	// the discarded AGGREGATOR is removed
+	assert.Len(u.PathAttributes, 4)
+	assert.Len(u.NLRI, 1)
+}
+
+func Test_DecodeUpdateRFC7606(t *testing.T) {
+	assert := assert.New(t)
+
+	// Invalid NEXT_HOP without NLRI field
+	bufin := []byte{
+		0x00, 0x00, // Withdraws(0)
+		0x00, 0x2e, // Attrs Len(46)
+		0x40, 0x01, 0x01, 0x00, // Attr(ORIGIN)
+		0x40, 0x02, 0x00, // Attr(AS_PATH)
+		0x40, 0x03, 0x03, 0xc0, // Attr(NEXT_HOP) - invalid length
+		0xa8, 0x01,
+		0x80, 0x0e, 0x1e, // Attr(MP_REACH_NLRI)
+		0x00, 0x02, 0x01, 0x10, // afi(2), safi(1), nexthop length(16)
+		0x20, 0x01, 0x0d, 0xb8,
+		0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x01,
+		0x00,                   // SNPA(0)
+		0x40, 0x20, 0x01, 0x0d, // NLRI
+		0xb8, 0x00, 0x00, 0x00,
+		0x00,
+	}
+
+	u := &BGPUpdate{}
+	err := u.DecodeFromBytes(bufin, &MarshallingOption{Lenient: true})
+	assert.Error(err)
+	assert.Equal(ERROR_HANDLING_ATTRIBUTE_DISCARD, err.(*MessageError).ErrorHandling)
+	assert.Len(u.PathAttributes, 3)
+	for _, a := range u.PathAttributes {
+		assert.NotEqual(BGP_ATTR_TYPE_NEXT_HOP, a.GetType())
+	}
+
+	// Invalid AS4_PATH
+	bufin = []byte{
+		0x00, 0x00, // Withdraws(0)
+		0x00, 0x15, // Attrs Len(21)
+		0x40, 0x01, 0x01, 0x00, // Attr(ORIGIN)
+		0x40, 0x02, 0x00, // Attr(AS_PATH)
+		0x40, 0x03, 0x04, 0xc0, // Attr(NEXT_HOP)
+		0xa8, 0x01, 0x64,
+		0xc0, 0x11, 0x04, // Attr(AS4_PATH) - invalid segment length
+		0x02, 0x02, 0x00, 0x00,
+		0x08, 0x0a, // NLRI
+	}
+
+	u = &BGPUpdate{}
+	err = u.DecodeFromBytes(bufin, &MarshallingOption{Lenient: true})
+	assert.Error(err)
+	e := err.(*MessageError)
+	assert.Equal(ERROR_HANDLING_ATTRIBUTE_DISCARD, e.ErrorHandling)
+	assert.Equal(BGP_ATTR_TYPE_AS4_PATH, (*e.ErrorAttribute).GetType())
+	assert.Len(u.PathAttributes, 3)
+	assert.Len(u.NLRI, 1)
+}
+
 func Test_RFC5512(t *testing.T) {
 	assert := assert.New(t)
 
@@ -370,6 +487,45 @@ func Test_RFC5512(t *testing.T) {
 	assert.Equal("2001::1", n4.String())
 }
 
+func Test_RFC9012SubTLVs(t *testing.T) {
+	assert := assert.New(t)
+
+	tlv := NewTunnelEncapTLV(TUNNEL_TYPE_MPLS_IN_UDP, []TunnelEncapSubTLVInterface{
+		NewTunnelEncapSubTLVEgressEndpoint("2001:db8::1"),
+		NewTunnelEncapSubTLVDSField(0x28),
+		NewTunnelEncapSubTLVUDPDestPort(6635),
+		NewTunnelEncapSubTLVEmbeddedLabelHandling(EMBEDDED_LABEL_HANDLING_NO_PAYLOAD),
+		NewTunnelEncapSubTLVMPLSLabelStack([]MPLSLabelStackEntry{
+			{Label: 16001, TrafficClass: 5, TTL: 64},
+			{Label: 24000, BottomOfStack: true, TTL: 255},
+		}),
+		NewTunnelEncapSubTLVPrefixSID(0, 1001),
+	})
+	attr := NewPathAttributeTunnelEncap([]*TunnelEncapTLV{tlv})
+
+	buf1, err := attr.Serialize()
+	assert.Nil(err)
+	// label 24000, tc 0, s 1, ttl 255
+	assert.Contains(string(buf1), string([]byte{0x05, 0xdc, 0x01, 0xff}))
+	// Label-Index TLV of Prefix-SID
+	assert.Contains(string(buf1), string([]byte{0x0b, 0x0a, 0x01, 0x00, 0x07, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0xe9}))
+
+	p, err := GetPathAttribute(buf1)
+	assert.Nil(err)
+	assert.Nil(p.DecodeFromBytes(buf1))
+	decoded := p.(*PathAttributeTunnelEncap)
+	assert.Equal(attr.Value[0].Value, decoded.Value[0].Value)
+
+	buf2, err := p.Serialize()
+	assert.Nil(err)
+	assert.Equal(buf1, buf2)
+
+	// Prefix-SID sub-TLV without the Label-Index TLV
+	assert.Error((&TunnelEncapSubTLVPrefixSID{}).DecodeFromBytes([]byte{0x0b, 0x00}))
+	// MPLS Label Stack sub-TLV with a partial entry
+	assert.Error((&TunnelEncapSubTLVMPLSLabelStack{}).DecodeFromBytes([]byte{0x0a, 0x03, 0x00, 0x00, 0x00}))
+}
+
 func Test_ASLen(t *testing.T) {
 	assert := assert.New(t)
 
@@ -497,6 +653,7 @@ func Test_LinkBandwidthExtended(t *testing.T) {
 	assert := assert.New(t)
 	exts := make([]ExtendedCommunityInterface, 0)
 	exts = append(exts, NewLinkBandwidthExtended(65001, 125000.0))
+	exts = append(exts, NewTransitiveLinkBandwidthExtended(65002, 250000.0))
 	m1 := NewPathAttributeExtendedCommunities(exts)
 	buf1, err := m1.Serialize()
 	require.NoError(t, err)
@@ -505,10 +662,12 @@ func Test_LinkBandwidthExtended(t *testing.T) {
 	err = m2.DecodeFromBytes(buf1)
 	require.NoError(t, err)
 
-	_, err = m2.Serialize()
+	buf2, err := m2.Serialize()
 	require.NoError(t, err)
 
 	assert.Equal(m1, m2)
+	assert.Equal(buf1, buf2)
+	assert.Equal(byte(EC_TYPE_TRANSITIVE_TWO_OCTET_AS_SPECIFIC), buf2[len(buf2)-8])
 }
 
 func Test_FlowSpecExtended(t *testing.T) {
@@ -520,6 +679,8 @@ func Test_FlowSpecExtended(t *testing.T) {
 	exts = append(exts, NewRedirectIPv4AddressSpecificExtended("10.0.0.1", 1000))
 	exts = append(exts, NewRedirectFourOctetAsSpecificExtended(10000000, 1000))
 	exts = append(exts, NewTrafficRemarkExtended(10))
+	exts = append(exts, NewInterfaceSetExtended(65000, 100, true, false))
+	exts = append(exts, NewInterfaceSetExtended(4200000000, 0x3fff, true, true))
 	m1 := NewPathAttributeExtendedCommunities(exts)
 	buf1, err := m1.Serialize()
 	require.NoError(t, err)
@@ -534,6 +695,30 @@ func Test_FlowSpecExtended(t *testing.T) {
 	assert.Equal(m1, m2)
 }
 
+func Test_InterfaceSetExtended(t *testing.T) {
+	assert := assert.New(t)
+	buf, err := NewInterfaceSetExtended(65000, 100, false, true).Serialize()
+	require.NoError(t, err)
+	assert.Equal([]byte{0x07, 0x02, 0x00, 0x00, 0xfd, 0xe8, 0x80, 0x64}, buf)
+
+	_, err = NewInterfaceSetExtended(65000, 0x4000, true, true).Serialize()
+	assert.Error(err)
+
+	for _, s := range []string{"65000:100:in", "65000:100:out", "65000:100:both"} {
+		e, err := ParseInterfaceSetExtended(s)
+		require.NoError(t, err)
+		assert.Equal("interface-set: "+s, e.String())
+	}
+	e, err := ParseInterfaceSetExtended("65000:100")
+	require.NoError(t, err)
+	assert.True(e.Inbound)
+	assert.True(e.Outbound)
+	for _, s := range []string{"65000", "65000:16384", "65000:100:up", "x:100"} {
+		_, err = ParseInterfaceSetExtended(s)
+		assert.Error(err, s)
+	}
+}
+
 func Test_IP6FlowSpecExtended(t *testing.T) {
 	exts := make([]ExtendedCommunityInterface, 0)
 	exts = append(exts, NewRedirectIPv6AddressSpecificExtended("2001:db8::68", 1000))
@@ -553,8 +738,8 @@ func Test_IP6FlowSpecExtended(t *testing.T) {
 
 func Test_FlowSpecNlriv6(t *testing.T) {
 	cmp := make([]FlowSpecComponentInterface, 0)
-	cmp = append(cmp, NewFlowSpecDestinationPrefix6(NewIPv6AddrPrefix(64, "2001::"), 12))
-	cmp = append(cmp, NewFlowSpecSourcePrefix6(NewIPv6AddrPrefix(64, "2001::"), 12))
+	cmp = append(cmp, NewFlowSpecDestinationPrefix6(NewIPv6AddrPrefix(64, "1:db8::"), 12))
+	cmp = append(cmp, NewFlowSpecSourcePrefix6(NewIPv6AddrPrefix(64, "1:db8::"), 12))
 	item1 := NewFlowSpecComponentItem(DEC_NUM_OP_EQ, TCP)
 	cmp = append(cmp, NewFlowSpecComponent(FLOW_SPEC_TYPE_IP_PROTO, []*FlowSpecComponentItem{item1}))
 	item2 := NewFlowSpecComponentItem(DEC_NUM_OP_GT_EQ, 20)
@@ -590,6 +775,53 @@ func Test_FlowSpecNlriv6(t *testing.T) {
 	assert.Equal(t, n1, n2)
 }
 
+func Test_FlowSpecNlriv6PrefixOffset(t *testing.T) {
+	assert := assert.New(t)
+
+	// RFC 8956 3.1, only the bits from the offset to the length are encoded
+	cmp, err := ParseFlowSpecComponents(RF_FS_IPv6_UC, "destination 2001:db8:1::/64 32 source ::1:2:3:4/80 64")
+	assert.NoError(err)
+	assert.Equal("[destination: 0:0:1::/64/32][source: ::1:0:0:0/80/64]", NewFlowSpecIPv6Unicast(cmp).String())
+	buf, err := cmp[0].Serialize()
+	assert.NoError(err)
+	assert.Equal([]byte{byte(FLOW_SPEC_TYPE_DST_PREFIX), 64, 32, 0, 1, 0, 0}, buf)
+	buf, err = cmp[1].Serialize()
+	assert.NoError(err)
+	assert.Equal([]byte{byte(FLOW_SPEC_TYPE_SRC_PREFIX), 80, 64, 0, 1}, buf)
+	n1 := NewFlowSpecIPv6Unicast(cmp)
+	buf, err = n1.Serialize()
+	assert.NoError(err)
+	n2, err := NewPrefixFromRouteFamily(RouteFamilyToAfiSafi(RF_FS_IPv6_UC))
+	assert.NoError(err)
+	assert.NoError(n2.DecodeFromBytes(buf))
+	assert.Equal(n1.String(), n2.String())
+
+	// without the offset, the output is the same as IPv4
+	cmp, err = ParseFlowSpecComponents(RF_FS_IPv6_UC, "destination 2001:db8::/32 protocol ipv6-icmp icmp-type ==128 fragment is-fragment")
+	assert.NoError(err)
+	assert.Equal("[destination: 2001:db8::/32][protocol: ==ipv6-icmp][icmp-type: ==128][fragment: is-fragment]", NewFlowSpecIPv6Unicast(cmp).String())
+	_, err = ParseFlowSpecComponents(RF_FS_IPv6_UC, "destination 2001:db8::/32 64")
+	assert.Error(err)
+	_, err = ParseFlowSpecComponents(RF_FS_IPv6_UC, "fragment dont-fragment")
+	assert.Error(err)
+	_, err = ParseFlowSpecComponents(RF_FS_IPv4_UC, "protocol icmp")
+	assert.NoError(err)
+
+	// the two-octet length of the NLRI longer than 239 octets
+	items := make([]*FlowSpecComponentItem, 0, 100)
+	for i := 0; i < 100; i++ {
+		items = append(items, NewFlowSpecComponentItem(DEC_NUM_OP_EQ, uint64(1000+i)))
+	}
+	n1 = NewFlowSpecIPv6Unicast([]FlowSpecComponentInterface{NewFlowSpecComponent(FLOW_SPEC_TYPE_PORT, items)})
+	buf, err = n1.Serialize()
+	assert.NoError(err)
+	assert.Equal(byte(0xf0), buf[0]&0xf0)
+	assert.Equal(len(buf)-2, int(binary.BigEndian.Uint16(buf)&0xfff))
+	n2, _ = NewPrefixFromRouteFamily(RouteFamilyToAfiSafi(RF_FS_IPv6_UC))
+	assert.NoError(n2.DecodeFromBytes(buf))
+	assert.Equal(n1.String(), n2.String())
+}
+
 func Test_Aigp(t *testing.T) {
 	assert := assert.New(t)
 	m := NewAigpTLVIgpMetric(1000)
@@ -602,6 +834,17 @@ func Test_Aigp(t *testing.T) {
 	require.NoError(t, err)
 
 	assert.Equal(a1, a2)
+
+	// two IGP metric TLVs
+	a3 := NewPathAttributeAigp([]AigpTLVInterface{m, m})
+	buf3, err := a3.Serialize()
+	require.NoError(t, err)
+	err = NewPathAttributeAigp(nil).DecodeFromBytes(buf3)
+	assert.Error(err)
+
+	// wrong IGP metric TLV length
+	buf4 := []byte{0x80, 0x1a, 0x0a, 0x01, 0x00, 0x0a, 0, 0, 0, 0, 0, 0, 0}
+	assert.Error(NewPathAttributeAigp(nil).DecodeFromBytes(buf4))
 }
 
 func Test_FlowSpecNlriL2(t *testing.T) {
@@ -676,6 +919,39 @@ func Test_EVPNIPPrefixRoute(t *testing.T) {
 	assert.Equal(n1, n2)
 }
 
+func Test_EVPNMulticastRoutes(t *testing.T) {
+	assert := assert.New(t)
+	rd, _ := ParseRouteDistinguisher("100:100")
+	esi := EthernetSegmentIdentifier{Type: ESI_ARBITRARY, Value: make([]byte, 9)}
+	tests := []struct {
+		nlri *EVPNNLRI
+		str  string
+	}{
+		{
+			nlri: NewEVPNSelectiveMulticastEthernetTagRoute(rd, 10, "", "239.1.1.1", "10.0.0.1", EVPN_MULTICAST_FLAG_V2),
+			str:  "[type:smet][rd:100:100][etag:10][source:*][group:239.1.1.1][ip:10.0.0.1]",
+		},
+		{
+			nlri: NewEVPNMulticastJoinSynchRoute(rd, esi, 10, "10.1.1.1", "239.1.1.1", "10.0.0.1", EVPN_MULTICAST_FLAG_V3),
+			str:  "[type:join-sync][rd:100:100][esi:single-homed][etag:10][source:10.1.1.1][group:239.1.1.1][ip:10.0.0.1]",
+		},
+		{
+			nlri: NewEVPNMulticastLeaveSynchRoute(rd, esi, 10, "2001:db8::1", "ff0e::1", "2001:db8::2", 5, 10, EVPN_MULTICAST_FLAG_V3|EVPN_MULTICAST_FLAG_EXCLUDE),
+			str:  "[type:leave-sync][rd:100:100][esi:single-homed][etag:10][source:2001:db8::1][group:ff0e::1][ip:2001:db8::2][sync:5]",
+		},
+	}
+	for _, tt := range tests {
+		buf, err := tt.nlri.Serialize()
+		assert.NoError(err)
+		assert.Equal(tt.nlri.Len(), len(buf))
+		n, err := NewPrefixFromRouteFamily(RouteFamilyToAfiSafi(RF_EVPN))
+		assert.NoError(err)
+		assert.NoError(n.DecodeFromBytes(buf))
+		assert.Equal(tt.nlri, n)
+		assert.Equal(tt.str, n.String())
+	}
+}
+
 func Test_CapExtendedNexthop(t *testing.T) {
 	assert := assert.New(t)
 	tuple := NewCapExtendedNexthopTuple(RF_IPv4_UC, AFI_IP6)
@@ -688,6 +964,110 @@ func Test_CapExtendedNexthop(t *testing.T) {
 	assert.Equal(n1, n2)
 }
 
+func Test_CapOutboundRouteFiltering(t *testing.T) {
+	assert := assert.New(t)
+	entries := []*CapORFEntry{{Type: ORF_TYPE_ADDRESS_PREFIX, Mode: BGP_ORF_BOTH}}
+	n1 := NewCapOutboundRouteFiltering([]*CapOutboundRouteFilteringTuple{
+		NewCapOutboundRouteFilteringTuple(RF_IPv4_UC, entries),
+		NewCapOutboundRouteFilteringTuple(RF_IPv6_UC, entries),
+	})
+	buf1, err := n1.Serialize()
+	assert.Nil(err)
+	assert.Equal([]byte{3, 14, 0, 1, 0, 1, 1, 64, 3, 0, 2, 0, 1, 1, 64, 3}, buf1)
+	n2, err := DecodeCapability(buf1)
+	assert.Nil(err)
+
+	assert.Equal(n1, n2)
+
+	_, err = DecodeCapability([]byte{3, 6, 0, 1, 0, 1, 2, 64})
+	assert.NotNil(err)
+}
+
+func Test_RouteRefreshORF(t *testing.T) {
+	assert := assert.New(t)
+	_, p4, _ := net.ParseCIDR("10.1.0.0/16")
+	_, p6, _ := net.ParseCIDR("2001:db8::/33")
+	for _, tt := range []struct {
+		afi   uint16
+		entry *AddressPrefixORFEntry
+	}{
+		{AFI_IP, NewAddressPrefixORFEntry(ORF_ACTION_ADD, ORF_MATCH_PERMIT, 10, p4, 16, 24)},
+		{AFI_IP6, NewAddressPrefixORFEntry(ORF_ACTION_REMOVE, ORF_MATCH_DENY, 20, p6, 33, 48)},
+	} {
+		m1 := NewBGPRouteRefreshORFMessage(tt.afi, SAFI_UNICAST, ORF_WHEN_TO_REFRESH_IMMEDIATE, []*RouteRefreshORF{
+			NewRouteRefreshAddressPrefixORF([]*AddressPrefixORFEntry{
+				NewAddressPrefixORFEntry(ORF_ACTION_REMOVE_ALL, ORF_MATCH_PERMIT, 0, nil, 0, 0),
+				tt.entry,
+			}),
+		})
+		buf, err := m1.Serialize()
+		assert.Nil(err)
+		m2, err := ParseBGPMessage(buf)
+		assert.Nil(err)
+		rr := m2.Body.(*BGPRouteRefresh)
+		assert.Equal(ORF_WHEN_TO_REFRESH_IMMEDIATE, rr.WhenToRefresh)
+		assert.Len(rr.ORFs, 1)
+		assert.Equal(ORF_TYPE_ADDRESS_PREFIX, rr.ORFs[0].Type)
+		assert.Len(rr.ORFs[0].Entries, 2)
+		assert.Equal(ORF_ACTION_REMOVE_ALL, rr.ORFs[0].Entries[0].Action)
+		e := rr.ORFs[0].Entries[1]
+		assert.Equal(tt.entry.String(), e.String())
+		assert.Equal(tt.entry.Action, e.Action)
+		assert.Equal(tt.entry.Match, e.Match)
+		assert.Equal(tt.entry.Sequence, e.Sequence)
+	}
+
+	// the message without ORF is encoded as before
+	buf, err := NewBGPRouteRefreshMessage(AFI_IP, 0, SAFI_UNICAST).Body.Serialize()
+	assert.Nil(err)
+	assert.Equal([]byte{0, 1, 0, 1}, buf)
+
+	rr := &BGPRouteRefresh{}
+	assert.NotNil(rr.DecodeFromBytes([]byte{0, 1, 0, 1, 1, 64, 0, 8, 0, 0, 0, 0, 10}))
+}
+
+func Test_CapDynamic(t *testing.T) {
+	assert := assert.New(t)
+	buf, err := NewCapDynamic([]BGPCapabilityCode{BGP_CAP_MULTIPROTOCOL}).Serialize()
+	assert.Nil(err)
+	assert.Equal([]byte{67, 1, 1}, buf)
+	c, err := DecodeCapability(buf)
+	assert.Nil(err)
+	d := c.(*CapDynamic)
+	assert.Equal([]BGPCapabilityCode{BGP_CAP_MULTIPROTOCOL}, d.CapCodes)
+	assert.True(d.IsDynamic(BGP_CAP_MULTIPROTOCOL))
+	assert.False(d.IsDynamic(BGP_CAP_ADD_PATH))
+
+	// the empty list allows any capability
+	c, err = DecodeCapability([]byte{67, 0})
+	assert.Nil(err)
+	assert.True(c.(*CapDynamic).IsDynamic(BGP_CAP_ADD_PATH))
+}
+
+func Test_BGPCapability(t *testing.T) {
+	assert := assert.New(t)
+	m1 := NewBGPCapabilityMessage([]*BGPCapabilityRevision{
+		NewBGPCapabilityRevision(BGP_CAPABILITY_ACTION_ADVERTISE, NewCapMultiProtocol(RF_IPv6_UC)),
+		NewBGPCapabilityRevision(BGP_CAPABILITY_ACTION_REMOVE, NewCapMultiProtocol(RF_FS_IPv4_UC)),
+	})
+	buf, err := m1.Serialize()
+	assert.Nil(err)
+	assert.Equal([]byte{0, 1, 4, 0, 2, 0, 1, 1, 1, 4, 0, 1, 0, 133}, buf[BGP_HEADER_LENGTH:])
+	m2, err := ParseBGPMessage(buf)
+	assert.Nil(err)
+	assert.Equal(uint8(BGP_MSG_CAPABILITY), m2.Header.Type)
+	revisions := m2.Body.(*BGPCapability).Revisions
+	assert.Len(revisions, 2)
+	assert.Equal(BGP_CAPABILITY_ACTION_ADVERTISE, revisions[0].Action)
+	assert.Equal(RF_IPv6_UC, revisions[0].Capability.(*CapMultiProtocol).CapValue)
+	assert.Equal(BGP_CAPABILITY_ACTION_REMOVE, revisions[1].Action)
+	assert.Equal(RF_FS_IPv4_UC, revisions[1].Capability.(*CapMultiProtocol).CapValue)
+
+	m := &BGPCapability{}
+	assert.NotNil(m.DecodeFromBytes([]byte{}))
+	assert.NotNil(m.DecodeFromBytes([]byte{0, 1, 4, 0, 2, 0}))
+}
+
 func Test_AddPath(t *testing.T) {
 	assert := assert.New(t)
 	opt := &MarshallingOption{AddPath: map[RouteFamily]BGPAddPathMode{RF_IPv4_UC: BGP_ADD_PATH_BOTH}}
@@ -854,6 +1234,25 @@ func Test_CompareFlowSpecNLRI(t *testing.T) {
 	r, err = CompareFlowSpecNLRI(n3, n4)
 	assert.Nil(err)
 	assert.True(r < 0)
+
+	// the lowest value of the common prefix has precedence
+	cmp, _ = ParseFlowSpecComponents(RF_FS_IPv6_UC, "destination 2001:db8:1::/48")
+	n5 := &NewFlowSpecIPv6Unicast(cmp).FlowSpecNLRI
+	cmp, _ = ParseFlowSpecComponents(RF_FS_IPv6_UC, "destination 2001:db8:2::/48")
+	n6 := &NewFlowSpecIPv6Unicast(cmp).FlowSpecNLRI
+	r, err = CompareFlowSpecNLRI(n5, n6)
+	assert.Nil(err)
+	assert.True(r > 0)
+
+	// the components are compared in the same RD
+	rd := NewRouteDistinguisherTwoOctetAS(65000, 100)
+	cmp, _ = ParseFlowSpecComponents(RF_FS_IPv4_VPN, "destination 10.0.1.0/24")
+	n7 := &NewFlowSpecIPv4VPN(rd, cmp).FlowSpecNLRI
+	cmp, _ = ParseFlowSpecComponents(RF_FS_IPv4_VPN, "destination 10.0.0.0/16 protocol tcp")
+	n8 := &NewFlowSpecIPv4VPN(rd, cmp).FlowSpecNLRI
+	r, err = CompareFlowSpecNLRI(n7, n8)
+	assert.Nil(err)
+	assert.True(r > 0)
 }
 
 func Test_MpReachNLRIWithIPv4MappedIPv6Prefix(t *testing.T) {
@@ -3723,6 +4122,41 @@ func FuzzParseBGPMessage(f *testing.F) {
 	})
 }
 
+// FuzzDecodeUpdateLenient checks that the lenient decoding never panics
+// and that the UPDATE messages not causing a session reset can be
+// treated as withdraw and serialized.
+func FuzzDecodeUpdateLenient(f *testing.F) {
+	f.Add([]byte{
+		0x00, 0x00, 0x00, 0x16, 0x40, 0x01, 0x01, 0x00, 0x40, 0x03, 0x04, 0xc0, 0xa8, 0x01, 0x64,
+		0x40, 0x02, 0x17, 0x02, 0x03, 0xfd, 0xe8, 0xfd, 0xe8, 0xfd, 0xe8, 0x08, 0x0a,
+	})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		u := &BGPUpdate{}
+		err := u.DecodeFromBytes(data, &MarshallingOption{Lenient: true})
+		if err == nil {
+			return
+		}
+		e, ok := err.(*MessageError)
+		if !ok {
+			t.Fatalf("unexpected error type: %T", err)
+		}
+		if e.ErrorHandling == ERROR_HANDLING_SESSION_RESET {
+			return
+		}
+		for _, attrErr := range e.Errors {
+			if attrErr.Stronger(e) {
+				t.Fatalf("%s is stronger than %s", attrErr, e)
+			}
+		}
+		if e.ErrorHandling == ERROR_HANDLING_TREAT_AS_WITHDRAW {
+			if _, err := TreatAsWithdraw(u).Serialize(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+}
+
 func FuzzParseFlowSpecComponents(f *testing.F) {
 
 	f.Fuzz(func(t *testing.T, data string) {
@@ -0,0 +1,513 @@
+package bgp
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// BGP Color-Aware Routing (CAR) SAFI as described in
+// https://datatracker.ietf.org/doc/html/draft-ietf-idr-bgp-car
+//
+// The CAR NLRI is made of the key fields, which identify the route, and the
+// non-key TLVs, which carry the encapsulation of the route:
+//
+//	+-----------------------------------+
+//	|    NLRI Length (1 octet)          |
+//	+-----------------------------------+
+//	|    Key Length (1 octet)           |
+//	+-----------------------------------+
+//	|    NLRI Type (1 octet)            |
+//	+-----------------------------------+
+//	|    Type-specific Key Fields       |
+//	+-----------------------------------+
+//	|    Type-specific Non-Key TLVs     |
+//	+-----------------------------------+
+
+const (
+	CAR_NLRI_TYPE_COLOR_AWARE_ROUTE uint8 = 1
+)
+
+type CARTLVType uint8
+
+const (
+	CAR_TLV_TYPE_LABEL       CARTLVType = 1
+	CAR_TLV_TYPE_LABEL_INDEX CARTLVType = 2
+	CAR_TLV_TYPE_SRV6_SID    CARTLVType = 3
+)
+
+// CARKeyInterface is the type-specific key fields of the CAR NLRI.
+type CARKeyInterface interface {
+	DecodeFromBytes(data []byte, afi uint16) error
+	Serialize() ([]byte, error)
+	Len() int
+	String() string
+	MarshalJSON() ([]byte, error)
+}
+
+// CARTLVInterface is the non-key TLV of the CAR NLRI.
+type CARTLVInterface interface {
+	Type() CARTLVType
+	Serialize() ([]byte, error)
+	String() string
+	MarshalJSON() ([]byte, error)
+}
+
+type CARNLRI struct {
+	PrefixDefault
+	Afi      uint16
+	NLRIType uint8
+	Key      CARKeyInterface
+	TLVs     []CARTLVInterface
+}
+
+func (n *CARNLRI) family() RouteFamily {
+	return AfiSafiToRouteFamily(n.Afi, SAFI_CAR)
+}
+
+func (n *CARNLRI) DecodeFromBytes(data []byte, options ...*MarshallingOption) error {
+	if IsAddPathEnabled(true, n.family(), options) {
+		var err error
+		data, err = n.decodePathIdentifier(data)
+		if err != nil {
+			return err
+		}
+	}
+	if len(data) < 3 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Not all CAR NLRI bytes available")
+	}
+	nlriLen := int(data[0])
+	keyLen := int(data[1])
+	if len(data) < 1+nlriLen || nlriLen < 2+keyLen {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "bad CAR NLRI length")
+	}
+	n.NLRIType = data[2]
+	key := data[3 : 3+keyLen]
+	tlvs := data[3+keyLen : 1+nlriLen]
+	switch n.NLRIType {
+	case CAR_NLRI_TYPE_COLOR_AWARE_ROUTE:
+		n.Key = &CARColorAwareRouteKey{}
+	default:
+		n.Key = &CARUnknownKey{}
+	}
+	if err := n.Key.DecodeFromBytes(key, n.Afi); err != nil {
+		return err
+	}
+	n.TLVs = nil
+	for len(tlvs) > 0 {
+		if len(tlvs) < 2 || len(tlvs) < 2+int(tlvs[1]) {
+			return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Not all CAR NLRI TLV bytes available")
+		}
+		tlv, err := decodeCARTLV(CARTLVType(tlvs[0]), tlvs[2:2+int(tlvs[1])])
+		if err != nil {
+			return err
+		}
+		n.TLVs = append(n.TLVs, tlv)
+		tlvs = tlvs[2+int(tlvs[1]):]
+	}
+	return nil
+}
+
+func (n *CARNLRI) Serialize(options ...*MarshallingOption) ([]byte, error) {
+	var buf []byte
+	if IsAddPathEnabled(false, n.family(), options) {
+		var err error
+		buf, err = n.serializeIdentifier()
+		if err != nil {
+			return nil, err
+		}
+	}
+	key, err := n.Key.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	body := append([]byte{byte(len(key)), n.NLRIType}, key...)
+	for _, tlv := range n.TLVs {
+		tbuf, err := tlv.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		body = append(body, tbuf...)
+	}
+	if len(body) > 255 {
+		return nil, fmt.Errorf("too long CAR NLRI: %d bytes", len(body))
+	}
+	buf = append(buf, byte(len(body)))
+	return append(buf, body...), nil
+}
+
+func (n *CARNLRI) AFI() uint16 {
+	return n.Afi
+}
+
+func (n *CARNLRI) SAFI() uint8 {
+	return SAFI_CAR
+}
+
+func (n *CARNLRI) Len(options ...*MarshallingOption) int {
+	buf, _ := n.Serialize(options...)
+	return len(buf)
+}
+
+// String returns the key fields only, which identify the route.
+func (n *CARNLRI) String() string {
+	if n.Key == nil {
+		return fmt.Sprintf("[type:%d]", n.NLRIType)
+	}
+	if n.NLRIType == CAR_NLRI_TYPE_COLOR_AWARE_ROUTE {
+		return n.Key.String()
+	}
+	return fmt.Sprintf("[type:%d]%s", n.NLRIType, n.Key)
+}
+
+// TLVString returns the non-key TLVs.
+func (n *CARNLRI) TLVString() string {
+	l := make([]string, 0, len(n.TLVs))
+	for _, tlv := range n.TLVs {
+		l = append(l, tlv.String())
+	}
+	return strings.Join(l, "")
+}
+
+func (n *CARNLRI) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type uint8             `json:"type"`
+		Key  CARKeyInterface   `json:"key"`
+		TLVs []CARTLVInterface `json:"tlvs,omitempty"`
+	}{
+		Type: n.NLRIType,
+		Key:  n.Key,
+		TLVs: n.TLVs,
+	})
+}
+
+func (n *CARNLRI) Flat() map[string]string {
+	return map[string]string{}
+}
+
+// Labels returns the label stack of the Label TLV, or nil if absent.
+func (n *CARNLRI) Labels() []uint32 {
+	for _, tlv := range n.TLVs {
+		if t, ok := tlv.(*CARLabelTLV); ok {
+			return t.Labels
+		}
+	}
+	return nil
+}
+
+func NewCARNLRI(afi uint16, typ uint8, key CARKeyInterface, tlvs []CARTLVInterface) *CARNLRI {
+	return &CARNLRI{
+		Afi:      afi,
+		NLRIType: typ,
+		Key:      key,
+		TLVs:     tlvs,
+	}
+}
+
+// NewCARColorAwareRoute returns the Color-Aware Route NLRI, the type 1 CAR
+// NLRI keyed by the prefix and the color.
+func NewCARColorAwareRoute(afi uint16, length uint8, prefix string, color uint32, tlvs ...CARTLVInterface) *CARNLRI {
+	ip := net.ParseIP(prefix)
+	if afi == AFI_IP {
+		ip = ip.To4()
+	}
+	return NewCARNLRI(afi, CAR_NLRI_TYPE_COLOR_AWARE_ROUTE, &CARColorAwareRouteKey{
+		Length: length,
+		Prefix: ip,
+		Color:  color,
+	}, tlvs)
+}
+
+// ParseCARPrefix parses the "<prefix> color <color>" form of the
+// Color-Aware Route NLRI.
+func ParseCARPrefix(s string) (uint8, net.IP, uint32, error) {
+	elems := strings.Fields(s)
+	if len(elems) != 3 || elems[1] != "color" {
+		return 0, nil, 0, fmt.Errorf("invalid car prefix: %s", s)
+	}
+	addr, network, err := net.ParseCIDR(elems[0])
+	if err != nil {
+		return 0, nil, 0, err
+	}
+	color, err := strconv.ParseUint(elems[2], 10, 32)
+	if err != nil {
+		return 0, nil, 0, fmt.Errorf("invalid color: %s", elems[2])
+	}
+	length, _ := network.Mask.Size()
+	if addr.To4() != nil {
+		addr = addr.To4()
+	}
+	return uint8(length), addr, uint32(color), nil
+}
+
+// CARColorAwareRouteKey is the key of the Color-Aware Route NLRI, the IP
+// prefix of the endpoint and the color.
+type CARColorAwareRouteKey struct {
+	Length uint8
+	Prefix net.IP
+	Color  uint32
+}
+
+func (k *CARColorAwareRouteKey) DecodeFromBytes(data []byte, afi uint16) error {
+	addrlen := net.IPv4len
+	if afi == AFI_IP6 {
+		addrlen = net.IPv6len
+	}
+	if len(data) < 1 || int(data[0]) > addrlen*8 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "bad CAR prefix length")
+	}
+	k.Length = data[0]
+	plen := (int(k.Length) + 7) / 8
+	if len(data) != 1+plen+4 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "bad CAR color-aware route key length")
+	}
+	k.Prefix = make(net.IP, addrlen)
+	copy(k.Prefix, data[1:1+plen])
+	k.Color = binary.BigEndian.Uint32(data[1+plen:])
+	return nil
+}
+
+func (k *CARColorAwareRouteKey) Serialize() ([]byte, error) {
+	prefix := k.Prefix.To4()
+	if prefix == nil {
+		prefix = k.Prefix.To16()
+	}
+	plen := (int(k.Length) + 7) / 8
+	if plen > len(prefix) {
+		return nil, fmt.Errorf("invalid CAR prefix length: %d", k.Length)
+	}
+	buf := make([]byte, 1+plen+4)
+	buf[0] = k.Length
+	copy(buf[1:], prefix[:plen])
+	binary.BigEndian.PutUint32(buf[1+plen:], k.Color)
+	return buf, nil
+}
+
+func (k *CARColorAwareRouteKey) Len() int {
+	return 1 + (int(k.Length)+7)/8 + 4
+}
+
+func (k *CARColorAwareRouteKey) String() string {
+	return fmt.Sprintf("[prefix:%s/%d][color:%d]", k.Prefix, k.Length, k.Color)
+}
+
+func (k *CARColorAwareRouteKey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Prefix string `json:"prefix"`
+		Color  uint32 `json:"color"`
+	}{
+		Prefix: fmt.Sprintf("%s/%d", k.Prefix, k.Length),
+		Color:  k.Color,
+	})
+}
+
+// CARUnknownKey is the key of the CAR NLRI of the unknown types, which is
+// kept as is.
+type CARUnknownKey struct {
+	Value []byte
+}
+
+func (k *CARUnknownKey) DecodeFromBytes(data []byte, afi uint16) error {
+	k.Value = append([]byte{}, data...)
+	return nil
+}
+
+func (k *CARUnknownKey) Serialize() ([]byte, error) {
+	return k.Value, nil
+}
+
+func (k *CARUnknownKey) Len() int {
+	return len(k.Value)
+}
+
+func (k *CARUnknownKey) String() string {
+	return fmt.Sprintf("[key:%s]", hex.EncodeToString(k.Value))
+}
+
+func (k *CARUnknownKey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Value []byte `json:"value"`
+	}{
+		Value: k.Value,
+	})
+}
+
+func decodeCARTLV(typ CARTLVType, value []byte) (CARTLVInterface, error) {
+	switch typ {
+	case CAR_TLV_TYPE_LABEL:
+		if len(value) == 0 || len(value)%3 != 0 {
+			return nil, NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, fmt.Sprintf("bad CAR label TLV length: %d", len(value)))
+		}
+		labels := make([]uint32, 0, len(value)/3)
+		for i := 0; i < len(value); i += 3 {
+			labels = append(labels, (uint32(value[i])<<16|uint32(value[i+1])<<8|uint32(value[i+2]))>>4)
+		}
+		return &CARLabelTLV{Labels: labels}, nil
+	case CAR_TLV_TYPE_LABEL_INDEX:
+		if len(value) != 7 {
+			return nil, NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, fmt.Sprintf("bad CAR label index TLV length: %d", len(value)))
+		}
+		return &CARLabelIndexTLV{
+			Flags:      binary.BigEndian.Uint16(value[1:3]),
+			LabelIndex: binary.BigEndian.Uint32(value[3:7]),
+		}, nil
+	case CAR_TLV_TYPE_SRV6_SID:
+		if len(value) != net.IPv6len {
+			return nil, NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, fmt.Sprintf("bad CAR SRv6 SID TLV length: %d", len(value)))
+		}
+		return &CARSRv6SIDTLV{SID: net.IP(append([]byte{}, value...))}, nil
+	}
+	return &CARUnknownTLV{
+		TLVType: typ,
+		Value:   append([]byte{}, value...),
+	}, nil
+}
+
+func serializeCARTLV(typ CARTLVType, value []byte) ([]byte, error) {
+	if len(value) > 255 {
+		return nil, fmt.Errorf("too long CAR TLV: %d bytes", len(value))
+	}
+	return append([]byte{byte(typ), byte(len(value))}, value...), nil
+}
+
+// CARLabelTLV carries the label stack of the route. The bottom of stack bit
+// is set on the last label.
+type CARLabelTLV struct {
+	Labels []uint32
+}
+
+func (t *CARLabelTLV) Type() CARTLVType {
+	return CAR_TLV_TYPE_LABEL
+}
+
+func (t *CARLabelTLV) Serialize() ([]byte, error) {
+	if len(t.Labels) == 0 {
+		return nil, fmt.Errorf("empty CAR label TLV")
+	}
+	buf := make([]byte, 3*len(t.Labels))
+	for i, label := range t.Labels {
+		label = label << 4
+		if i == len(t.Labels)-1 {
+			label |= 1
+		}
+		buf[i*3] = byte(label >> 16)
+		buf[i*3+1] = byte(label >> 8)
+		buf[i*3+2] = byte(label)
+	}
+	return serializeCARTLV(t.Type(), buf)
+}
+
+func (t *CARLabelTLV) String() string {
+	l := make([]string, 0, len(t.Labels))
+	for _, label := range t.Labels {
+		l = append(l, strconv.FormatUint(uint64(label), 10))
+	}
+	return fmt.Sprintf("[label:%s]", strings.Join(l, ","))
+}
+
+func (t *CARLabelTLV) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type   CARTLVType `json:"type"`
+		Labels []uint32   `json:"labels"`
+	}{
+		Type:   t.Type(),
+		Labels: t.Labels,
+	})
+}
+
+// CARLabelIndexTLV carries the label index of the SR-MPLS prefix SID.
+type CARLabelIndexTLV struct {
+	Flags      uint16
+	LabelIndex uint32
+}
+
+func (t *CARLabelIndexTLV) Type() CARTLVType {
+	return CAR_TLV_TYPE_LABEL_INDEX
+}
+
+func (t *CARLabelIndexTLV) Serialize() ([]byte, error) {
+	buf := make([]byte, 7)
+	binary.BigEndian.PutUint16(buf[1:3], t.Flags)
+	binary.BigEndian.PutUint32(buf[3:7], t.LabelIndex)
+	return serializeCARTLV(t.Type(), buf)
+}
+
+func (t *CARLabelIndexTLV) String() string {
+	return fmt.Sprintf("[label-index:%d]", t.LabelIndex)
+}
+
+func (t *CARLabelIndexTLV) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type       CARTLVType `json:"type"`
+		Flags      uint16     `json:"flags"`
+		LabelIndex uint32     `json:"label_index"`
+	}{
+		Type:       t.Type(),
+		Flags:      t.Flags,
+		LabelIndex: t.LabelIndex,
+	})
+}
+
+// CARSRv6SIDTLV carries the SRv6 SID of the route.
+type CARSRv6SIDTLV struct {
+	SID net.IP
+}
+
+func (t *CARSRv6SIDTLV) Type() CARTLVType {
+	return CAR_TLV_TYPE_SRV6_SID
+}
+
+func (t *CARSRv6SIDTLV) Serialize() ([]byte, error) {
+	sid := t.SID.To16()
+	if sid == nil {
+		return nil, fmt.Errorf("invalid SRv6 SID: %s", t.SID)
+	}
+	return serializeCARTLV(t.Type(), sid)
+}
+
+func (t *CARSRv6SIDTLV) String() string {
+	return fmt.Sprintf("[srv6-sid:%s]", t.SID)
+}
+
+func (t *CARSRv6SIDTLV) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type CARTLVType `json:"type"`
+		SID  string     `json:"sid"`
+	}{
+		Type: t.Type(),
+		SID:  t.SID.String(),
+	})
+}
+
+// CARUnknownTLV is the non-key TLV of the unknown types, which is kept as
+// is.
+type CARUnknownTLV struct {
+	TLVType CARTLVType
+	Value   []byte
+}
+
+func (t *CARUnknownTLV) Type() CARTLVType {
+	return t.TLVType
+}
+
+func (t *CARUnknownTLV) Serialize() ([]byte, error) {
+	return serializeCARTLV(t.TLVType, t.Value)
+}
+
+func (t *CARUnknownTLV) String() string {
+	return fmt.Sprintf("[tlv-%d:%s]", t.TLVType, hex.EncodeToString(t.Value))
+}
+
+func (t *CARUnknownTLV) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type  CARTLVType `json:"type"`
+		Value []byte     `json:"value"`
+	}{
+		Type:  t.TLVType,
+		Value: t.Value,
+	})
+}
@@ -0,0 +1,99 @@
+package bgp
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CARNLRI(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		family RouteFamily
+		nlri   *CARNLRI
+		str    string
+	}{
+		{
+			RF_CAR_IPv4,
+			NewCARColorAwareRoute(AFI_IP, 32, "10.0.0.1", 100, &CARLabelTLV{Labels: []uint32{16001}}),
+			"[prefix:10.0.0.1/32][color:100]",
+		},
+		{
+			RF_CAR_IPv4,
+			NewCARColorAwareRoute(AFI_IP, 24, "10.0.1.0", 200, &CARLabelTLV{Labels: []uint32{16001, 24000}}, &CARLabelIndexTLV{LabelIndex: 1}),
+			"[prefix:10.0.1.0/24][color:200]",
+		},
+		{
+			RF_CAR_IPv6,
+			NewCARColorAwareRoute(AFI_IP6, 128, "2001:db8::1", 100, &CARSRv6SIDTLV{SID: net.ParseIP("fc00:0:1::")}),
+			"[prefix:2001:db8::1/128][color:100]",
+		},
+		{
+			// the unknown NLRI type and TLV are kept as is
+			RF_CAR_IPv4,
+			NewCARNLRI(AFI_IP, 2, &CARUnknownKey{Value: []byte{1, 2, 3}}, []CARTLVInterface{&CARUnknownTLV{TLVType: 9, Value: []byte{4}}}),
+			"[type:2][key:010203]",
+		},
+	}
+	for _, tt := range tests {
+		assert.Equal(tt.str, tt.nlri.String())
+		buf, err := tt.nlri.Serialize()
+		require.NoError(t, err)
+		assert.Equal(tt.nlri.Len(), len(buf))
+		assert.Equal(len(buf)-1, int(buf[0]))
+
+		n, err := NewPrefixFromRouteFamily(RouteFamilyToAfiSafi(tt.family))
+		require.NoError(t, err)
+		require.NoError(t, n.DecodeFromBytes(buf), tt.str)
+		assert.Equal(tt.nlri, n)
+	}
+
+	n, err := NewPrefixFromRouteFamily(AFI_IP, SAFI_CAR, "10.0.0.1/32 color 100")
+	require.NoError(t, err)
+	assert.Equal("[prefix:10.0.0.1/32][color:100]", n.String())
+	_, err = NewPrefixFromRouteFamily(AFI_IP, SAFI_CAR, "10.0.0.1/32")
+	assert.Error(err)
+	assert.Equal("[16001, 24000]", LabelString(tests[1].nlri))
+}
+
+func Test_CARNLRIAddPath(t *testing.T) {
+	assert := assert.New(t)
+	opt := &MarshallingOption{AddPath: map[RouteFamily]BGPAddPathMode{RF_CAR_IPv4: BGP_ADD_PATH_BOTH}}
+
+	nlri := NewCARColorAwareRoute(AFI_IP, 32, "10.0.0.1", 100, &CARLabelTLV{Labels: []uint32{16001}})
+	nlri.SetPathLocalIdentifier(10)
+	buf, err := nlri.Serialize(opt)
+	require.NoError(t, err)
+	assert.Equal([]byte{0, 0, 0, 10}, buf[:4])
+
+	n := NewCARNLRI(AFI_IP, 0, nil, nil)
+	require.NoError(t, n.DecodeFromBytes(buf, opt))
+	assert.Equal(uint32(10), n.PathIdentifier())
+	assert.Equal(nlri.String(), n.String())
+}
+
+func Test_CARNLRIMalformed(t *testing.T) {
+	assert := assert.New(t)
+	for _, buf := range [][]byte{
+		// no key
+		{1, 0},
+		// short NLRI
+		{10, 6, 1, 32, 10, 0, 0},
+		// key longer than the NLRI
+		{3, 6, 1, 32},
+		// too long prefix length
+		{11, 9, 1, 33, 10, 0, 0, 1, 0, 0, 0, 100},
+		// short color
+		{9, 7, 1, 32, 10, 0, 0, 1, 0, 0, 0},
+		// truncated TLV
+		{12, 9, 1, 32, 10, 0, 0, 1, 0, 0, 0, 100, 1},
+		// bad label TLV length
+		{15, 9, 1, 32, 10, 0, 0, 1, 0, 0, 0, 100, 1, 2, 0, 1},
+	} {
+		n := NewCARNLRI(AFI_IP, 0, nil, nil)
+		assert.Error(n.DecodeFromBytes(buf), buf)
+	}
+}
@@ -50,6 +50,7 @@ const (
 	RSVP             = 0x2e
 	GRE              = 0x2f
 	OSPF             = 0x59
+	ICMPV6           = 0x3a
 	IPIP             = 0x5e
 	PIM              = 0x67
 	SCTP             = 0x84
@@ -66,6 +67,7 @@ var ProtocolNameMap = map[Protocol]string{
 	RSVP:    "rsvp",
 	GRE:     "gre",
 	OSPF:    "ospf",
+	ICMPV6:  "ipv6-icmp",
 	IPIP:    "ipip",
 	PIM:     "pim",
 	SCTP:    "sctp",
@@ -0,0 +1,159 @@
+package bgp
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+)
+
+// Classful Transport (CT) SAFI as described in
+// https://datatracker.ietf.org/doc/html/draft-ietf-idr-bgp-ct
+//
+// The CT NLRI uses the RFC8277 encoding shared with the labeled VPN
+// families: a label stack, an RD and the IP prefix of the transport
+// endpoint. The transport class the route belongs to is carried by the
+// Transport Class Route Target Extended Community.
+
+const (
+	EC_SUBTYPE_TRANSPORT_CLASS_ROUTE_TARGET ExtendedCommunityAttrSubType = 0x02 // EC_TYPE: 0x0a, 0x4a
+)
+
+type CTIPAddrPrefix struct {
+	LabeledVPNIPAddrPrefix
+}
+
+func (l *CTIPAddrPrefix) DecodeFromBytes(data []byte, options ...*MarshallingOption) error {
+	return l.decodeFromBytes(RF_CT_IPv4, data, options...)
+}
+
+func (l *CTIPAddrPrefix) Serialize(options ...*MarshallingOption) ([]byte, error) {
+	return l.serialize(RF_CT_IPv4, options...)
+}
+
+func (l *CTIPAddrPrefix) SAFI() uint8 {
+	return SAFI_CLASSFUL_TRANSPORT
+}
+
+func NewCTIPAddrPrefix(length uint8, prefix string, label MPLSLabelStack, rd RouteDistinguisherInterface) *CTIPAddrPrefix {
+	return &CTIPAddrPrefix{
+		*NewLabeledVPNIPAddrPrefix(length, prefix, label, rd),
+	}
+}
+
+type CTIPv6AddrPrefix struct {
+	LabeledVPNIPAddrPrefix
+}
+
+func (l *CTIPv6AddrPrefix) DecodeFromBytes(data []byte, options ...*MarshallingOption) error {
+	return l.decodeFromBytes(RF_CT_IPv6, data, options...)
+}
+
+func (l *CTIPv6AddrPrefix) Serialize(options ...*MarshallingOption) ([]byte, error) {
+	return l.serialize(RF_CT_IPv6, options...)
+}
+
+func (l *CTIPv6AddrPrefix) AFI() uint16 {
+	return AFI_IP6
+}
+
+func (l *CTIPv6AddrPrefix) SAFI() uint8 {
+	return SAFI_CLASSFUL_TRANSPORT
+}
+
+func NewCTIPv6AddrPrefix(length uint8, prefix string, label MPLSLabelStack, rd RouteDistinguisherInterface) *CTIPv6AddrPrefix {
+	rdlen := 0
+	if rd != nil {
+		rdlen = rd.Len()
+	}
+	return &CTIPv6AddrPrefix{
+		LabeledVPNIPAddrPrefix{
+			IPAddrPrefixDefault{
+				Length: length + uint8(8*(label.Len()+rdlen)),
+				Prefix: net.ParseIP(prefix),
+			},
+			label,
+			rd,
+			16,
+		},
+	}
+}
+
+// TransportClassExtended represents the Transport Class Route Target
+// Extended Community. The value field is 2 reserved octets followed by the
+// 4 octets Transport Class ID.
+type TransportClassExtended struct {
+	TransportClass uint32
+	IsTransitive   bool
+}
+
+func (e *TransportClassExtended) Serialize() ([]byte, error) {
+	buf := make([]byte, 8)
+	typ, subType := e.GetTypes()
+	buf[0] = byte(typ)
+	buf[1] = byte(subType)
+	binary.BigEndian.PutUint32(buf[4:8], e.TransportClass)
+	return buf, nil
+}
+
+func (e *TransportClassExtended) String() string {
+	return strconv.FormatUint(uint64(e.TransportClass), 10)
+}
+
+func (e *TransportClassExtended) MarshalJSON() ([]byte, error) {
+	t, s := e.GetTypes()
+	return json.Marshal(struct {
+		Type           ExtendedCommunityAttrType    `json:"type"`
+		SubType        ExtendedCommunityAttrSubType `json:"subtype"`
+		TransportClass uint32                       `json:"transport_class"`
+	}{
+		Type:           t,
+		SubType:        s,
+		TransportClass: e.TransportClass,
+	})
+}
+
+func (e *TransportClassExtended) GetTypes() (ExtendedCommunityAttrType, ExtendedCommunityAttrSubType) {
+	if e.IsTransitive {
+		return EC_TYPE_TRANSITIVE_TRANSPORT_CLASS, EC_SUBTYPE_TRANSPORT_CLASS_ROUTE_TARGET
+	}
+	return EC_TYPE_NON_TRANSITIVE_TRANSPORT_CLASS, EC_SUBTYPE_TRANSPORT_CLASS_ROUTE_TARGET
+}
+
+func (e *TransportClassExtended) Flat() map[string]string {
+	return map[string]string{}
+}
+
+// NewTransportClassExtended returns the transitive Transport Class Route
+// Target Extended Community.
+func NewTransportClassExtended(tc uint32) *TransportClassExtended {
+	return &TransportClassExtended{
+		TransportClass: tc,
+		IsTransitive:   true,
+	}
+}
+
+func NewNonTransitiveTransportClassExtended(tc uint32) *TransportClassExtended {
+	return &TransportClassExtended{
+		TransportClass: tc,
+	}
+}
+
+func parseTransportClassExtended(data []byte) (ExtendedCommunityInterface, error) {
+	typ := ExtendedCommunityAttrType(data[0])
+	if typ != EC_TYPE_TRANSITIVE_TRANSPORT_CLASS && typ != EC_TYPE_NON_TRANSITIVE_TRANSPORT_CLASS {
+		return nil, NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, fmt.Sprintf("ext comm type is not transport class: %d", data[0]))
+	}
+	subType := ExtendedCommunityAttrSubType(data[1])
+	if subType != EC_SUBTYPE_TRANSPORT_CLASS_ROUTE_TARGET {
+		return &UnknownExtended{
+			Type:  typ,
+			Value: data[1:8],
+		}, nil
+	}
+	return &TransportClassExtended{
+		TransportClass: binary.BigEndian.Uint32(data[4:8]),
+		IsTransitive:   typ == EC_TYPE_TRANSITIVE_TRANSPORT_CLASS,
+	}, nil
+}
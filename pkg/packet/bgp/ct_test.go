@@ -0,0 +1,77 @@
+package bgp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CTIPAddrPrefix(t *testing.T) {
+	assert := assert.New(t)
+	rd, _ := ParseRouteDistinguisher("65000:100")
+
+	for _, tt := range []struct {
+		family RouteFamily
+		nlri   AddrPrefixInterface
+		str    string
+	}{
+		{RF_CT_IPv4, NewCTIPAddrPrefix(32, "10.0.0.1", *NewMPLSLabelStack(100), rd), "65000:100:10.0.0.1/32"},
+		{RF_CT_IPv6, NewCTIPv6AddrPrefix(128, "2001:db8::1", *NewMPLSLabelStack(100), rd), "65000:100:2001:db8::1/128"},
+	} {
+		afi, safi := RouteFamilyToAfiSafi(tt.family)
+		assert.Equal(afi, tt.nlri.AFI())
+		assert.Equal(safi, tt.nlri.SAFI())
+		assert.Equal(tt.str, tt.nlri.String())
+		assert.Equal("[100]", LabelString(tt.nlri))
+
+		buf, err := tt.nlri.Serialize()
+		require.NoError(t, err)
+		assert.Equal(tt.nlri.Len(), len(buf))
+
+		n, err := NewPrefixFromRouteFamily(afi, safi)
+		require.NoError(t, err)
+		require.NoError(t, n.DecodeFromBytes(buf))
+		assert.Equal(tt.nlri, n)
+
+		n, err = NewPrefixFromRouteFamily(afi, safi, tt.str)
+		require.NoError(t, err)
+		assert.Equal(tt.str, n.String())
+
+		// the ADD-PATH mode of the CT family doesn't apply to the labeled VPN
+		// family sharing the same encoding
+		tt.nlri.SetPathLocalIdentifier(10)
+		opt := &MarshallingOption{AddPath: map[RouteFamily]BGPAddPathMode{tt.family: BGP_ADD_PATH_BOTH}}
+		buf, err = tt.nlri.Serialize(opt)
+		require.NoError(t, err)
+		assert.Equal(tt.nlri.Len()+4, len(buf))
+
+		n, _ = NewPrefixFromRouteFamily(afi, safi)
+		require.NoError(t, n.DecodeFromBytes(buf, opt))
+		assert.Equal(uint32(10), n.PathIdentifier())
+		assert.Equal(tt.str, n.String())
+	}
+}
+
+func Test_TransportClassExtended(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, e := range []*TransportClassExtended{
+		NewTransportClassExtended(100),
+		NewNonTransitiveTransportClassExtended(200),
+	} {
+		buf, err := e.Serialize()
+		require.NoError(t, err)
+		typ, subType := e.GetTypes()
+		assert.Equal([]byte{byte(typ), byte(subType), 0, 0}, buf[:4])
+
+		c, err := ParseExtended(buf)
+		require.NoError(t, err)
+		assert.Equal(e, c)
+	}
+	assert.Equal("100", NewTransportClassExtended(100).String())
+
+	c, err := ParseExtended([]byte{byte(EC_TYPE_TRANSITIVE_TRANSPORT_CLASS), 0x01, 0, 0, 0, 0, 0, 1})
+	require.NoError(t, err)
+	assert.IsType(&UnknownExtended{}, c)
+}
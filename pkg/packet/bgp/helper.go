@@ -67,6 +67,7 @@ func NewTestBGPUpdateMessage() *BGPMessage {
 		NewESILabelExtended(1000, true),
 		NewESImportRouteTarget("11:22:33:44:55:66"),
 		NewMacMobilityExtended(123, false),
+		NewDFElectionExtended(DF_ALGORITHM_HRW, DF_ELECTION_CAPABILITY_AC_DF),
 	}
 
 	prefixes1 := []AddrPrefixInterface{
@@ -92,6 +93,9 @@ func NewTestBGPUpdateMessage() *BGPMessage {
 		NewEVPNMulticastEthernetTagRoute(NewRouteDistinguisherFourOctetAS(5, 6), 3, "192.2.1.2"),
 		NewEVPNEthernetSegmentRoute(NewRouteDistinguisherFourOctetAS(5, 6), EthernetSegmentIdentifier{ESI_ARBITRARY, make([]byte, 9)}, "192.2.1.1"),
 		NewEVPNIPPrefixRoute(NewRouteDistinguisherFourOctetAS(5, 6), EthernetSegmentIdentifier{ESI_ARBITRARY, make([]byte, 9)}, 5, 24, "192.2.1.0", "192.3.1.1", 5),
+		NewEVPNSelectiveMulticastEthernetTagRoute(NewRouteDistinguisherFourOctetAS(5, 6), 3, "", "239.1.1.1", "192.2.1.2", EVPN_MULTICAST_FLAG_V2),
+		NewEVPNMulticastJoinSynchRoute(NewRouteDistinguisherFourOctetAS(5, 6), EthernetSegmentIdentifier{ESI_ARBITRARY, make([]byte, 9)}, 3, "10.1.1.1", "239.1.1.1", "192.2.1.2", EVPN_MULTICAST_FLAG_V3),
+		NewEVPNMulticastLeaveSynchRoute(NewRouteDistinguisherFourOctetAS(5, 6), EthernetSegmentIdentifier{ESI_ARBITRARY, make([]byte, 9)}, 3, "2001:db8::1", "ff0e::1", "2001:db8::2", 1, 10, EVPN_MULTICAST_FLAG_V3|EVPN_MULTICAST_FLAG_EXCLUDE),
 	}
 
 	prefixes6 := []AddrPrefixInterface{NewVPLSNLRI(NewRouteDistinguisherFourOctetAS(5, 6), 101, 100, 10, 1000)}
@@ -0,0 +1,711 @@
+package bgp
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// The SR Policy Candidate Path NLRI and the TLVs of the SR policy state,
+// https://www.rfc-editor.org/rfc/rfc9857.html. The NLRI was called the TE
+// Policy NLRI in the earlier drafts.
+
+type LsSrPolicyProtocolOrigin uint8
+
+const (
+	LS_SR_POLICY_PROTOCOL_ORIGIN_PCEP   LsSrPolicyProtocolOrigin = 1
+	LS_SR_POLICY_PROTOCOL_ORIGIN_BGP    LsSrPolicyProtocolOrigin = 2
+	LS_SR_POLICY_PROTOCOL_ORIGIN_CONFIG LsSrPolicyProtocolOrigin = 3
+)
+
+func (o LsSrPolicyProtocolOrigin) String() string {
+	switch o {
+	case LS_SR_POLICY_PROTOCOL_ORIGIN_PCEP:
+		return "PCEP"
+	case LS_SR_POLICY_PROTOCOL_ORIGIN_BGP:
+		return "BGP"
+	case LS_SR_POLICY_PROTOCOL_ORIGIN_CONFIG:
+		return "CONFIG"
+	}
+	return fmt.Sprintf("LsSrPolicyProtocolOrigin(%d)", uint8(o))
+}
+
+func (o LsSrPolicyProtocolOrigin) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.String())
+}
+
+// LsSrSID is the SID of 4 octets, the label in the 20 leftmost bits, or of
+// 16 octets, the SRv6 SID.
+type LsSrSID []byte
+
+func NewLsSrMplsSID(label uint32) LsSrSID {
+	return binary.BigEndian.AppendUint32(nil, label<<12)
+}
+
+func (s LsSrSID) String() string {
+	switch len(s) {
+	case 4:
+		return strconv.FormatUint(uint64(binary.BigEndian.Uint32(s)>>12), 10)
+	case net.IPv6len:
+		return net.IP(s).String()
+	}
+	return ""
+}
+
+func (s LsSrSID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+type LsSrPolicyCandidatePathDescriptor struct {
+	ProtocolOrigin    LsSrPolicyProtocolOrigin `json:"protocol_origin"`
+	Endpoint          net.IP                   `json:"endpoint"`
+	Color             uint32                   `json:"color"`
+	OriginatorASN     uint32                   `json:"originator_asn"`
+	OriginatorAddress net.IP                   `json:"originator_address"`
+	Discriminator     uint32                   `json:"discriminator"`
+}
+
+type LsTLVSrPolicyCandidatePathDescriptor struct {
+	LsTLV
+	ProtocolOrigin    LsSrPolicyProtocolOrigin
+	Flags             uint8
+	Endpoint          net.IP
+	Color             uint32
+	OriginatorASN     uint32
+	OriginatorAddress net.IP
+	Discriminator     uint32
+}
+
+const (
+	lsSrPolicyCandidatePathFlagEndpointIPv6   = 0x80
+	lsSrPolicyCandidatePathFlagOriginatorIPv6 = 0x40
+)
+
+func NewLsTLVSrPolicyCandidatePathDescriptor(d *LsSrPolicyCandidatePathDescriptor) *LsTLVSrPolicyCandidatePathDescriptor {
+	l := &LsTLVSrPolicyCandidatePathDescriptor{
+		LsTLV: LsTLV{
+			Type:   LS_TLV_SR_POLICY_CANDIDATE_PATH_DESC,
+			Length: 4 + 4 + 4 + 4 + 4 + 4,
+		},
+		ProtocolOrigin:    d.ProtocolOrigin,
+		Endpoint:          d.Endpoint.To4(),
+		Color:             d.Color,
+		OriginatorASN:     d.OriginatorASN,
+		OriginatorAddress: d.OriginatorAddress.To4(),
+		Discriminator:     d.Discriminator,
+	}
+	if l.Endpoint == nil {
+		l.Endpoint = d.Endpoint.To16()
+		l.Flags |= lsSrPolicyCandidatePathFlagEndpointIPv6
+		l.Length += net.IPv6len - net.IPv4len
+	}
+	if l.OriginatorAddress == nil {
+		l.OriginatorAddress = d.OriginatorAddress.To16()
+		l.Flags |= lsSrPolicyCandidatePathFlagOriginatorIPv6
+		l.Length += net.IPv6len - net.IPv4len
+	}
+	return l
+}
+
+func (l *LsTLVSrPolicyCandidatePathDescriptor) DecodeFromBytes(data []byte) error {
+	value, err := l.LsTLV.DecodeFromBytes(data)
+	if err != nil {
+		return err
+	}
+
+	if l.Type != LS_TLV_SR_POLICY_CANDIDATE_PATH_DESC {
+		return malformedAttrListErr("Unexpected TLV type")
+	}
+
+	if len(value) < 4 {
+		return malformedAttrListErr("Incorrect SR Policy Candidate Path Descriptor length")
+	}
+	l.ProtocolOrigin = LsSrPolicyProtocolOrigin(value[0])
+	l.Flags = value[1]
+	endpointLen, originatorLen := net.IPv4len, net.IPv4len
+	if l.Flags&lsSrPolicyCandidatePathFlagEndpointIPv6 != 0 {
+		endpointLen = net.IPv6len
+	}
+	if l.Flags&lsSrPolicyCandidatePathFlagOriginatorIPv6 != 0 {
+		originatorLen = net.IPv6len
+	}
+	// https://www.rfc-editor.org/rfc/rfc9857.html#section-5.1
+	if len(value) != 4+endpointLen+4+4+originatorLen+4 {
+		return malformedAttrListErr("Incorrect SR Policy Candidate Path Descriptor length")
+	}
+	value = value[4:]
+	l.Endpoint = net.IP(value[:endpointLen])
+	value = value[endpointLen:]
+	l.Color = binary.BigEndian.Uint32(value[:4])
+	l.OriginatorASN = binary.BigEndian.Uint32(value[4:8])
+	l.OriginatorAddress = net.IP(value[8 : 8+originatorLen])
+	l.Discriminator = binary.BigEndian.Uint32(value[8+originatorLen:])
+
+	return nil
+}
+
+func (l *LsTLVSrPolicyCandidatePathDescriptor) Serialize() ([]byte, error) {
+	buf := []byte{uint8(l.ProtocolOrigin), l.Flags, 0, 0}
+	buf = append(buf, l.Endpoint...)
+	buf = binary.BigEndian.AppendUint32(buf, l.Color)
+	buf = binary.BigEndian.AppendUint32(buf, l.OriginatorASN)
+	buf = append(buf, l.OriginatorAddress...)
+	buf = binary.BigEndian.AppendUint32(buf, l.Discriminator)
+
+	return l.LsTLV.Serialize(buf)
+}
+
+func (l *LsTLVSrPolicyCandidatePathDescriptor) Extract() *LsSrPolicyCandidatePathDescriptor {
+	return &LsSrPolicyCandidatePathDescriptor{
+		ProtocolOrigin:    l.ProtocolOrigin,
+		Endpoint:          l.Endpoint,
+		Color:             l.Color,
+		OriginatorASN:     l.OriginatorASN,
+		OriginatorAddress: l.OriginatorAddress,
+		Discriminator:     l.Discriminator,
+	}
+}
+
+func (l *LsTLVSrPolicyCandidatePathDescriptor) String() string {
+	return fmt.Sprintf("{ENDPOINT: %v COLOR: %d ORIGIN: %v ORIGINATOR: %d:%v DISCRIMINATOR: %d}", l.Endpoint, l.Color, l.ProtocolOrigin, l.OriginatorASN, l.OriginatorAddress, l.Discriminator)
+}
+
+func (l *LsTLVSrPolicyCandidatePathDescriptor) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type LsTLVType `json:"type"`
+		LsSrPolicyCandidatePathDescriptor
+	}{
+		l.Type,
+		*l.Extract(),
+	})
+}
+
+// LsSrPolicyCandidatePathNLRI is the SR Policy Candidate Path NLRI, the
+// candidate path of the SR policy on the headend node.
+type LsSrPolicyCandidatePathNLRI struct {
+	LsNLRI
+	LocalNodeDesc     LsTLVInterface
+	CandidatePathDesc LsTLVInterface
+}
+
+func (l *LsSrPolicyCandidatePathNLRI) String() string {
+	if l.LocalNodeDesc == nil || l.CandidatePathDesc == nil {
+		return "SR_POLICY_CANDIDATE_PATH { EMPTY }"
+	}
+
+	local := l.LocalNodeDesc.(*LsTLVNodeDescriptor).Extract()
+	cp := l.CandidatePathDesc.(*LsTLVSrPolicyCandidatePathDescriptor)
+	return fmt.Sprintf("SR_POLICY_CANDIDATE_PATH { HEADEND: %v %v %v:%v }", local, cp, l.ProtocolID.String(), l.Identifier)
+}
+
+func (l *LsSrPolicyCandidatePathNLRI) DecodeFromBytes(data []byte) error {
+	if err := l.LsNLRI.DecodeFromBytes(data); err != nil {
+		return err
+	}
+
+	tlv := data[lsNLRIHdrLen:]
+	for len(tlv) >= tlvHdrLen {
+		sub := &LsTLV{}
+		if _, err := sub.DecodeFromBytes(tlv); err != nil {
+			return err
+		}
+
+		var subTLV LsTLVInterface
+		switch sub.Type {
+		case LS_TLV_LOCAL_NODE_DESC:
+			subTLV = &LsTLVNodeDescriptor{}
+		case LS_TLV_SR_POLICY_CANDIDATE_PATH_DESC:
+			subTLV = &LsTLVSrPolicyCandidatePathDescriptor{}
+		default:
+			// the other TE Policy descriptors aren't supported
+			tlv = tlv[sub.Len():]
+			continue
+		}
+
+		if err := subTLV.DecodeFromBytes(tlv); err != nil {
+			return err
+		}
+		tlv = tlv[subTLV.Len():]
+
+		switch sub.Type {
+		case LS_TLV_LOCAL_NODE_DESC:
+			l.LocalNodeDesc = subTLV
+		default:
+			l.CandidatePathDesc = subTLV
+		}
+	}
+
+	if l.LocalNodeDesc == nil || l.CandidatePathDesc == nil {
+		return malformedAttrListErr("Required TLV missing")
+	}
+
+	return nil
+}
+
+func (l *LsSrPolicyCandidatePathNLRI) Serialize() ([]byte, error) {
+	if l.LocalNodeDesc == nil || l.CandidatePathDesc == nil {
+		return nil, errors.New("required TLV missing")
+	}
+
+	buf, err := l.LocalNodeDesc.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	s, err := l.CandidatePathDesc.Serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	return l.LsNLRI.Serialize(append(buf, s...))
+}
+
+func (l *LsSrPolicyCandidatePathNLRI) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type          LsNLRIType                        `json:"type"`
+		LocalNode     LsNodeDescriptor                  `json:"local_node_desc"`
+		CandidatePath LsSrPolicyCandidatePathDescriptor `json:"candidate_path_desc"`
+	}{
+		Type:          l.Type(),
+		LocalNode:     *l.LocalNodeDesc.(*LsTLVNodeDescriptor).Extract(),
+		CandidatePath: *l.CandidatePathDesc.(*LsTLVSrPolicyCandidatePathDescriptor).Extract(),
+	})
+}
+
+type LsSrBindingSIDFlags struct {
+	SRv6        bool `json:"srv6"`
+	Allocated   bool `json:"allocated"`
+	Unavailable bool `json:"unavailable"`
+	Local       bool `json:"local"`
+	Fallback    bool `json:"fallback"`
+}
+
+func (f *LsSrBindingSIDFlags) FlagBits() uint16 {
+	var bits uint16
+	if f.SRv6 {
+		bits |= 1 << 15
+	}
+	if f.Allocated {
+		bits |= 1 << 14
+	}
+	if f.Unavailable {
+		bits |= 1 << 13
+	}
+	if f.Local {
+		bits |= 1 << 12
+	}
+	if f.Fallback {
+		bits |= 1 << 11
+	}
+	return bits
+}
+
+// LsSrBindingSID is the binding SID of the candidate path. The SIDs are the
+// labels of SR-MPLS or the IPv6 addresses of SRv6.
+type LsSrBindingSID struct {
+	Flags               LsSrBindingSIDFlags `json:"flags"`
+	BindingSID          LsSrSID             `json:"binding_sid"`
+	SpecifiedBindingSID LsSrSID             `json:"specified_binding_sid"`
+}
+
+type LsTLVSrBindingSID struct {
+	LsTLV
+	Flags               uint16
+	BindingSID          LsSrSID
+	SpecifiedBindingSID LsSrSID
+}
+
+func NewLsTLVSrBindingSID(b *LsSrBindingSID) *LsTLVSrBindingSID {
+	return &LsTLVSrBindingSID{
+		LsTLV: LsTLV{
+			Type:   LS_TLV_SR_BINDING_SID,
+			Length: uint16(4 + len(b.BindingSID) + len(b.SpecifiedBindingSID)),
+		},
+		Flags:               b.Flags.FlagBits(),
+		BindingSID:          b.BindingSID,
+		SpecifiedBindingSID: b.SpecifiedBindingSID,
+	}
+}
+
+func (l *LsTLVSrBindingSID) DecodeFromBytes(data []byte) error {
+	value, err := l.LsTLV.DecodeFromBytes(data)
+	if err != nil {
+		return err
+	}
+
+	if l.Type != LS_TLV_SR_BINDING_SID {
+		return malformedAttrListErr("Unexpected TLV type")
+	}
+
+	// https://www.rfc-editor.org/rfc/rfc9857.html#section-5.6.2
+	if len(value) != 4+2*4 && len(value) != 4+2*net.IPv6len {
+		return malformedAttrListErr("Incorrect SR Binding SID length")
+	}
+
+	l.Flags = binary.BigEndian.Uint16(value[:2])
+	n := (len(value) - 4) / 2
+	l.BindingSID = LsSrSID(value[4 : 4+n])
+	l.SpecifiedBindingSID = LsSrSID(value[4+n:])
+
+	return nil
+}
+
+func (l *LsTLVSrBindingSID) Serialize() ([]byte, error) {
+	buf := binary.BigEndian.AppendUint16(nil, l.Flags)
+	buf = append(buf, 0, 0)
+	buf = append(buf, l.BindingSID...)
+	buf = append(buf, l.SpecifiedBindingSID...)
+
+	return l.LsTLV.Serialize(buf)
+}
+
+func (l *LsTLVSrBindingSID) Extract() *LsSrBindingSID {
+	return &LsSrBindingSID{
+		Flags: LsSrBindingSIDFlags{
+			SRv6:        l.Flags&(1<<15) != 0,
+			Allocated:   l.Flags&(1<<14) != 0,
+			Unavailable: l.Flags&(1<<13) != 0,
+			Local:       l.Flags&(1<<12) != 0,
+			Fallback:    l.Flags&(1<<11) != 0,
+		},
+		BindingSID:          l.BindingSID,
+		SpecifiedBindingSID: l.SpecifiedBindingSID,
+	}
+}
+
+func (l *LsTLVSrBindingSID) String() string {
+	return fmt.Sprintf("{SR Binding SID: %v}", l.BindingSID)
+}
+
+func (l *LsTLVSrBindingSID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type LsTLVType `json:"type"`
+		LsSrBindingSID
+	}{
+		l.Type,
+		*l.Extract(),
+	})
+}
+
+type LsSrCandidatePathStateFlags struct {
+	Shutdown  bool `json:"shutdown"`
+	Active    bool `json:"active"`
+	Backup    bool `json:"backup"`
+	Evaluated bool `json:"evaluated"`
+	Valid     bool `json:"valid"`
+	Delegated bool `json:"delegated"`
+}
+
+func (f *LsSrCandidatePathStateFlags) FlagBits() uint16 {
+	var bits uint16
+	for i, b := range []bool{f.Shutdown, f.Active, f.Backup, f.Evaluated, f.Valid, f.Delegated} {
+		if b {
+			bits |= 1 << (15 - i)
+		}
+	}
+	return bits
+}
+
+type LsSrCandidatePathState struct {
+	Priority   uint8                       `json:"priority"`
+	Flags      LsSrCandidatePathStateFlags `json:"flags"`
+	Preference uint32                      `json:"preference"`
+}
+
+type LsTLVSrCandidatePathState struct {
+	LsTLV
+	Priority   uint8
+	Flags      uint16
+	Preference uint32
+}
+
+func NewLsTLVSrCandidatePathState(s *LsSrCandidatePathState) *LsTLVSrCandidatePathState {
+	return &LsTLVSrCandidatePathState{
+		LsTLV: LsTLV{
+			Type:   LS_TLV_SR_CANDIDATE_PATH_STATE,
+			Length: 8,
+		},
+		Priority:   s.Priority,
+		Flags:      s.Flags.FlagBits(),
+		Preference: s.Preference,
+	}
+}
+
+func (l *LsTLVSrCandidatePathState) DecodeFromBytes(data []byte) error {
+	value, err := l.LsTLV.DecodeFromBytes(data)
+	if err != nil {
+		return err
+	}
+
+	if l.Type != LS_TLV_SR_CANDIDATE_PATH_STATE {
+		return malformedAttrListErr("Unexpected TLV type")
+	}
+
+	// https://www.rfc-editor.org/rfc/rfc9857.html#section-5.6.1
+	if len(value) != 8 {
+		return malformedAttrListErr("Incorrect SR Candidate Path State length")
+	}
+
+	l.Priority = value[0]
+	l.Flags = binary.BigEndian.Uint16(value[2:4])
+	l.Preference = binary.BigEndian.Uint32(value[4:8])
+
+	return nil
+}
+
+func (l *LsTLVSrCandidatePathState) Serialize() ([]byte, error) {
+	buf := []byte{l.Priority, 0}
+	buf = binary.BigEndian.AppendUint16(buf, l.Flags)
+	buf = binary.BigEndian.AppendUint32(buf, l.Preference)
+
+	return l.LsTLV.Serialize(buf)
+}
+
+func (l *LsTLVSrCandidatePathState) Extract() *LsSrCandidatePathState {
+	return &LsSrCandidatePathState{
+		Priority: l.Priority,
+		Flags: LsSrCandidatePathStateFlags{
+			Shutdown:  l.Flags&(1<<15) != 0,
+			Active:    l.Flags&(1<<14) != 0,
+			Backup:    l.Flags&(1<<13) != 0,
+			Evaluated: l.Flags&(1<<12) != 0,
+			Valid:     l.Flags&(1<<11) != 0,
+			Delegated: l.Flags&(1<<10) != 0,
+		},
+		Preference: l.Preference,
+	}
+}
+
+func (l *LsTLVSrCandidatePathState) String() string {
+	return fmt.Sprintf("{SR Candidate Path State: priority %d, preference %d, flags %#04x}", l.Priority, l.Preference, l.Flags)
+}
+
+func (l *LsTLVSrCandidatePathState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type LsTLVType `json:"type"`
+		LsSrCandidatePathState
+	}{
+		l.Type,
+		*l.Extract(),
+	})
+}
+
+// LsTLVSrName is the SR Candidate Path Name TLV or the SR Policy Name TLV.
+type LsTLVSrName struct {
+	LsTLV
+	Name string
+}
+
+func NewLsTLVSrName(t LsTLVType, name string) *LsTLVSrName {
+	return &LsTLVSrName{
+		LsTLV: LsTLV{
+			Type:   t,
+			Length: uint16(len(name)),
+		},
+		Name: name,
+	}
+}
+
+func (l *LsTLVSrName) DecodeFromBytes(data []byte) error {
+	value, err := l.LsTLV.DecodeFromBytes(data)
+	if err != nil {
+		return err
+	}
+
+	if l.Type != LS_TLV_SR_CANDIDATE_PATH_NAME && l.Type != LS_TLV_SR_POLICY_NAME {
+		return malformedAttrListErr("Unexpected TLV type")
+	}
+
+	l.Name = string(value)
+
+	return nil
+}
+
+func (l *LsTLVSrName) Serialize() ([]byte, error) {
+	return l.LsTLV.Serialize([]byte(l.Name))
+}
+
+func (l *LsTLVSrName) String() string {
+	if l.Type == LS_TLV_SR_POLICY_NAME {
+		return fmt.Sprintf("{SR Policy Name: %s}", l.Name)
+	}
+	return fmt.Sprintf("{SR Candidate Path Name: %s}", l.Name)
+}
+
+func (l *LsTLVSrName) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type LsTLVType `json:"type"`
+		Name string    `json:"name"`
+	}{
+		Type: l.Type,
+		Name: l.Name,
+	})
+}
+
+// LsSrSegment is a segment of the segment list. The SID is the label of
+// SR-MPLS or the IPv6 address of SRv6, and the descriptor is kept as is.
+type LsSrSegment struct {
+	Type       uint8   `json:"type"`
+	Flags      uint16  `json:"flags"`
+	SID        LsSrSID `json:"sid"`
+	Descriptor []byte  `json:"descriptor,omitempty"`
+}
+
+// the segment types of SRv6, B and I to K
+func lsSrSegmentTypeIsSRv6(t uint8) bool {
+	return t == 2 || (t >= 9 && t <= 11)
+}
+
+type LsSrSegmentList struct {
+	Flags     uint16        `json:"flags"`
+	MTID      uint16        `json:"mtid"`
+	Algorithm uint8         `json:"algorithm"`
+	Weight    uint32        `json:"weight"`
+	Segments  []LsSrSegment `json:"segments"`
+}
+
+type LsTLVSrSegmentList struct {
+	LsTLV
+	Flags     uint16
+	MTID      uint16
+	Algorithm uint8
+	Weight    uint32
+	Segments  []LsSrSegment
+}
+
+func NewLsTLVSrSegmentList(s *LsSrSegmentList) *LsTLVSrSegmentList {
+	l := &LsTLVSrSegmentList{
+		LsTLV: LsTLV{
+			Type:   LS_TLV_SR_SEGMENT_LIST,
+			Length: 12,
+		},
+		Flags:     s.Flags,
+		MTID:      s.MTID,
+		Algorithm: s.Algorithm,
+		Weight:    s.Weight,
+		Segments:  s.Segments,
+	}
+	for _, seg := range s.Segments {
+		l.Length += uint16(tlvHdrLen + 4 + len(seg.SID) + len(seg.Descriptor))
+	}
+	return l
+}
+
+func (l *LsTLVSrSegmentList) DecodeFromBytes(data []byte) error {
+	value, err := l.LsTLV.DecodeFromBytes(data)
+	if err != nil {
+		return err
+	}
+
+	if l.Type != LS_TLV_SR_SEGMENT_LIST {
+		return malformedAttrListErr("Unexpected TLV type")
+	}
+
+	// https://www.rfc-editor.org/rfc/rfc9857.html#section-5.7.3
+	if len(value) < 12 {
+		return malformedAttrListErr("Incorrect SR Segment List length")
+	}
+
+	l.Flags = binary.BigEndian.Uint16(value[:2])
+	l.MTID = binary.BigEndian.Uint16(value[4:6])
+	l.Algorithm = value[6]
+	l.Weight = binary.BigEndian.Uint32(value[8:12])
+	l.Segments = nil
+
+	for sub := value[12:]; len(sub) >= tlvHdrLen; {
+		t := &LsTLV{}
+		v, err := t.DecodeFromBytes(sub)
+		if err != nil {
+			return err
+		}
+		sub = sub[t.Len():]
+		// the metrics aren't supported
+		if t.Type != LS_TLV_SR_SEGMENT {
+			continue
+		}
+		// https://www.rfc-editor.org/rfc/rfc9857.html#section-5.7.3.1
+		if len(v) < 4 {
+			return malformedAttrListErr("Incorrect SR Segment length")
+		}
+		seg := LsSrSegment{
+			Type:  v[0],
+			Flags: binary.BigEndian.Uint16(v[2:4]),
+		}
+		n := 4
+		if lsSrSegmentTypeIsSRv6(seg.Type) {
+			n = net.IPv6len
+		}
+		if len(v) < 4+n {
+			return malformedAttrListErr("Incorrect SR Segment length")
+		}
+		seg.SID = LsSrSID(v[4 : 4+n])
+		if len(v) > 4+n {
+			seg.Descriptor = v[4+n:]
+		}
+		l.Segments = append(l.Segments, seg)
+	}
+
+	return nil
+}
+
+func (l *LsTLVSrSegmentList) Serialize() ([]byte, error) {
+	buf := binary.BigEndian.AppendUint16(nil, l.Flags)
+	buf = append(buf, 0, 0)
+	buf = binary.BigEndian.AppendUint16(buf, l.MTID)
+	buf = append(buf, l.Algorithm, 0)
+	buf = binary.BigEndian.AppendUint32(buf, l.Weight)
+
+	for _, seg := range l.Segments {
+		v := []byte{seg.Type, 0}
+		v = binary.BigEndian.AppendUint16(v, seg.Flags)
+		v = append(v, seg.SID...)
+		v = append(v, seg.Descriptor...)
+		t := &LsTLV{Type: LS_TLV_SR_SEGMENT, Length: uint16(len(v))}
+		b, err := t.Serialize(v)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, b...)
+	}
+
+	return l.LsTLV.Serialize(buf)
+}
+
+func (l *LsTLVSrSegmentList) Extract() *LsSrSegmentList {
+	return &LsSrSegmentList{
+		Flags:     l.Flags,
+		MTID:      l.MTID,
+		Algorithm: l.Algorithm,
+		Weight:    l.Weight,
+		Segments:  l.Segments,
+	}
+}
+
+func (l *LsTLVSrSegmentList) String() string {
+	sids := make([]string, 0, len(l.Segments))
+	for _, seg := range l.Segments {
+		sids = append(sids, seg.SID.String())
+	}
+	return fmt.Sprintf("{SR Segment List: weight %d, segments [%s]}", l.Weight, strings.Join(sids, " "))
+}
+
+func (l *LsTLVSrSegmentList) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type LsTLVType `json:"type"`
+		LsSrSegmentList
+	}{
+		l.Type,
+		*l.Extract(),
+	})
+}
+
+type LsAttributeSrPolicy struct {
+	BindingSID         *LsSrBindingSID         `json:"binding_sid,omitempty"`
+	CandidatePathState *LsSrCandidatePathState `json:"candidate_path_state,omitempty"`
+	CandidatePathName  *string                 `json:"candidate_path_name,omitempty"`
+	PolicyName         *string                 `json:"policy_name,omitempty"`
+	SegmentLists       []*LsSrSegmentList      `json:"segment_lists,omitempty"`
+}
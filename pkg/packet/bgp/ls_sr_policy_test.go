@@ -0,0 +1,149 @@
+package bgp
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LsSrPolicyCandidatePathNLRI(t *testing.T) {
+	assert := assert.New(t)
+
+	// AS 65000, BGP-LS ID 1 and IGP router ID 1.1.1.1
+	lnd := LsTLVNodeDescriptor{}
+	require.NoError(t, lnd.DecodeFromBytes([]byte{0x01, 0x00, 0x00, 0x18, 0x02, 0x00, 0x00, 0x04, 0x00, 0x00, 0xfd, 0xe8, 0x02, 0x01, 0x00, 0x04, 0x00, 0x00, 0x00, 0x01, 0x02, 0x03, 0x00, 0x04, 0x01, 0x01, 0x01, 0x01}))
+	cp := &LsSrPolicyCandidatePathNLRI{
+		LsNLRI: LsNLRI{
+			NLRIType:   LS_NLRI_TYPE_SR_POLICY_CANDIDATE_PATH,
+			ProtocolID: LS_PROTOCOL_SEGMENT_ROUTING,
+			Identifier: 1,
+		},
+		LocalNodeDesc: &lnd,
+		CandidatePathDesc: NewLsTLVSrPolicyCandidatePathDescriptor(&LsSrPolicyCandidatePathDescriptor{
+			ProtocolOrigin:    LS_SR_POLICY_PROTOCOL_ORIGIN_BGP,
+			Endpoint:          net.ParseIP("2001:db8::1"),
+			Color:             100,
+			OriginatorASN:     65001,
+			OriginatorAddress: net.ParseIP("10.0.0.1"),
+			Discriminator:     2,
+		}),
+	}
+	buf, err := cp.Serialize()
+	require.NoError(t, err)
+	cp.Length = uint16(len(buf))
+	buf, err = (&LsAddrPrefix{Type: LS_NLRI_TYPE_SR_POLICY_CANDIDATE_PATH, Length: cp.Length, NLRI: cp}).Serialize()
+	require.NoError(t, err)
+
+	n, err := NewPrefixFromRouteFamily(AFI_LS, SAFI_LS)
+	require.NoError(t, err)
+	require.NoError(t, n.DecodeFromBytes(buf))
+	nlri := n.(*LsAddrPrefix).NLRI.(*LsSrPolicyCandidatePathNLRI)
+	assert.Equal(LsProtocolID(LS_PROTOCOL_SEGMENT_ROUTING), nlri.ProtocolID)
+	d := nlri.CandidatePathDesc.(*LsTLVSrPolicyCandidatePathDescriptor)
+	assert.Equal(uint8(lsSrPolicyCandidatePathFlagEndpointIPv6), d.Flags)
+	assert.Equal("SR_POLICY_CANDIDATE_PATH { HEADEND: {ASN: 65000, BGP LS ID: 1, OSPF AREA: 0, IGP ROUTER ID: 1.1.1.1} {ENDPOINT: 2001:db8::1 COLOR: 100 ORIGIN: BGP ORIGINATOR: 65001:10.0.0.1 DISCRIMINATOR: 2} SR:1 }", nlri.String())
+
+	j, err := json.Marshal(nlri)
+	assert.NoError(err)
+	assert.JSONEq(`{"type":5,"local_node_desc":{"asn":65000,"bgp_ls_id":1,"ospf_area_id":0,"pseudo_node":false,"igp_router_id":"1.1.1.1","bgp_router_id":"","bgp_confederation_member":0},"candidate_path_desc":{"protocol_origin":"BGP","endpoint":"2001:db8::1","color":100,"originator_asn":65001,"originator_address":"10.0.0.1","discriminator":2}}`, string(j))
+
+	s, err := n.Serialize()
+	assert.NoError(err)
+	assert.Equal(buf, s)
+
+	// the candidate path descriptor is required
+	c := &LsNodeNLRI{LsNLRI: cp.LsNLRI, LocalNodeDesc: &lnd}
+	buf, err = c.Serialize()
+	require.NoError(t, err)
+	assert.Error((&LsSrPolicyCandidatePathNLRI{}).DecodeFromBytes(buf))
+}
+
+func Test_LsTLVSrPolicyCandidatePathDescriptor(t *testing.T) {
+	assert := assert.New(t)
+
+	var tests = []struct {
+		in   []byte
+		want string
+		err  bool
+	}{
+		{[]byte{0x02, 0x2a, 0x00, 0x18, 0x03, 0x00, 0x00, 0x00, 0x0a, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x64, 0x00, 0x00, 0xfd, 0xe9, 0x0a, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x02},
+			`{"type":554,"protocol_origin":"CONFIG","endpoint":"10.0.0.2","color":100,"originator_asn":65001,"originator_address":"10.0.0.1","discriminator":2}`, false},
+		// the originator address is IPv6
+		{[]byte{0x02, 0x2a, 0x00, 0x18, 0x03, 0x40, 0x00, 0x00, 0x0a, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x64, 0x00, 0x00, 0xfd, 0xe9, 0x0a, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x02}, "", true},
+		{[]byte{0x02, 0x2a, 0x00, 0x02, 0x03, 0x00}, "", true},
+	}
+
+	for _, test := range tests {
+		tlv := LsTLVSrPolicyCandidatePathDescriptor{}
+		if test.err {
+			assert.Error(tlv.DecodeFromBytes(test.in))
+			continue
+		}
+		assert.NoError(tlv.DecodeFromBytes(test.in))
+
+		got, err := tlv.MarshalJSON()
+		assert.NoError(err)
+		assert.Equal(test.want, string(got))
+
+		s, err := tlv.Serialize()
+		assert.NoError(err)
+		assert.Equal(test.in, s)
+	}
+}
+
+func Test_PathAttributeLsSrPolicy(t *testing.T) {
+	assert := assert.New(t)
+
+	cpName, policyName := "cp1", "policy1"
+	attr := &LsAttribute{
+		SrPolicy: &LsAttributeSrPolicy{
+			BindingSID: &LsSrBindingSID{
+				Flags:               LsSrBindingSIDFlags{Allocated: true},
+				BindingSID:          NewLsSrMplsSID(24000),
+				SpecifiedBindingSID: NewLsSrMplsSID(24000),
+			},
+			CandidatePathState: &LsSrCandidatePathState{
+				Priority:   10,
+				Flags:      LsSrCandidatePathStateFlags{Active: true, Valid: true},
+				Preference: 200,
+			},
+			CandidatePathName: &cpName,
+			PolicyName:        &policyName,
+			SegmentLists: []*LsSrSegmentList{
+				{
+					Weight: 1,
+					Segments: []LsSrSegment{
+						{Type: 1, SID: NewLsSrMplsSID(16001)},
+						{Type: 2, SID: LsSrSID(net.ParseIP("2001:db8::100"))},
+					},
+				},
+			},
+		},
+	}
+	p := &PathAttributeLs{
+		PathAttribute: PathAttribute{
+			Flags: BGP_ATTR_FLAG_OPTIONAL,
+			Type:  BGP_ATTR_TYPE_LS,
+		},
+		TLVs: NewLsAttributeTLVs(attr),
+	}
+	buf, err := p.Serialize()
+	require.NoError(t, err)
+
+	p2 := &PathAttributeLs{}
+	require.NoError(t, p2.DecodeFromBytes(buf))
+	assert.Len(p2.TLVs, 5)
+	assert.Equal(attr.SrPolicy, p2.Extract().SrPolicy)
+	assert.Equal("{LsAttributes: {SR Binding SID: 24000} {SR Candidate Path State: priority 10, preference 200, flags 0x4800} {SR Candidate Path Name: cp1} {SR Policy Name: policy1} {SR Segment List: weight 1, segments [16001 2001:db8::100]} }", p2.String())
+
+	j, err := json.Marshal(p2.Extract().SrPolicy)
+	assert.NoError(err)
+	assert.JSONEq(`{"binding_sid":{"flags":{"srv6":false,"allocated":true,"unavailable":false,"local":false,"fallback":false},"binding_sid":"24000","specified_binding_sid":"24000"},"candidate_path_state":{"priority":10,"flags":{"shutdown":false,"active":true,"backup":false,"evaluated":false,"valid":true,"delegated":false},"preference":200},"candidate_path_name":"cp1","policy_name":"policy1","segment_lists":[{"flags":0,"mtid":0,"algorithm":0,"weight":1,"segments":[{"type":1,"flags":0,"sid":"16001"},{"type":2,"flags":0,"sid":"2001:db8::100"}]}]}`, string(j))
+
+	s, err := p2.Serialize()
+	assert.NoError(err)
+	assert.Equal(buf, s)
+}
@@ -5,10 +5,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/netip"
+	"strconv"
+	"strings"
 )
 
 // MUPExtended represents BGP MUP Extended Community as described in
 // https://datatracker.ietf.org/doc/html/draft-mpmz-bess-mup-safi-00#section-3.2
+// The Direct-Type Segment Identifier is the only sub-type registered by
+// the draft. The other sub-types are carried with the same value format
+// so that they are propagated instead of being treated as malformed.
 type MUPExtended struct {
 	SubType    ExtendedCommunityAttrSubType
 	SegmentID2 uint16
@@ -18,14 +23,17 @@ type MUPExtended struct {
 func (e *MUPExtended) Serialize() ([]byte, error) {
 	buf := make([]byte, 8)
 	buf[0] = byte(EC_TYPE_MUP)
-	buf[1] = byte(EC_SUBTYPE_MUP_DIRECT_SEG)
+	buf[1] = byte(e.SubType)
 	binary.BigEndian.PutUint16(buf[2:4], e.SegmentID2)
 	binary.BigEndian.PutUint32(buf[4:8], e.SegmentID4)
 	return buf, nil
 }
 
 func (e *MUPExtended) String() string {
-	return fmt.Sprintf("%d:%d", e.SegmentID2, e.SegmentID4)
+	if e.SubType == EC_SUBTYPE_MUP_DIRECT_SEG {
+		return fmt.Sprintf("%d:%d", e.SegmentID2, e.SegmentID4)
+	}
+	return fmt.Sprintf("%d:%d:%d", e.SubType, e.SegmentID2, e.SegmentID4)
 }
 
 func (e *MUPExtended) MarshalJSON() ([]byte, error) {
@@ -42,33 +50,65 @@ func (e *MUPExtended) MarshalJSON() ([]byte, error) {
 }
 
 func (e *MUPExtended) GetTypes() (ExtendedCommunityAttrType, ExtendedCommunityAttrSubType) {
-	return EC_TYPE_MUP, EC_SUBTYPE_MUP_DIRECT_SEG
+	return EC_TYPE_MUP, e.SubType
 }
 
 func (e *MUPExtended) Flat() map[string]string {
 	return map[string]string{}
 }
 
+// NewMUPExtended returns the Direct-Type Segment Identifier Extended
+// Community.
 func NewMUPExtended(sid2 uint16, sid4 uint32) *MUPExtended {
+	return NewMUPExtendedWithSubType(EC_SUBTYPE_MUP_DIRECT_SEG, sid2, sid4)
+}
+
+func NewMUPExtendedWithSubType(subType ExtendedCommunityAttrSubType, sid2 uint16, sid4 uint32) *MUPExtended {
 	return &MUPExtended{
-		SubType:    EC_SUBTYPE_MUP_DIRECT_SEG,
+		SubType:    subType,
 		SegmentID2: sid2,
 		SegmentID4: sid4,
 	}
 }
 
+// ParseMUPExtended parses the segment identifier in the form of
+// "<sid2>:<sid4>" for the Direct-Type Segment Identifier or
+// "<subtype>:<sid2>:<sid4>" for the other sub-types.
+func ParseMUPExtended(s string) (*MUPExtended, error) {
+	elems := strings.Split(s, ":")
+	subType := EC_SUBTYPE_MUP_DIRECT_SEG
+	switch len(elems) {
+	case 2:
+	case 3:
+		t, err := strconv.ParseUint(elems[0], 10, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid mup sub type: %s", elems[0])
+		}
+		subType = ExtendedCommunityAttrSubType(t)
+		elems = elems[1:]
+	default:
+		return nil, fmt.Errorf("invalid mup segment identifier: %s", s)
+	}
+	sid2, err := strconv.ParseUint(elems[0], 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mup segment identifier: %s", s)
+	}
+	sid4, err := strconv.ParseUint(elems[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mup segment identifier: %s", s)
+	}
+	return NewMUPExtendedWithSubType(subType, uint16(sid2), uint32(sid4)), nil
+}
+
 func parseMUPExtended(data []byte) (ExtendedCommunityInterface, error) {
 	typ := ExtendedCommunityAttrType(data[0])
 	if typ != EC_TYPE_MUP {
 		return nil, NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, fmt.Sprintf("ext comm type is not EC_TYPE_MUP: %d", data[0]))
 	}
 	subType := ExtendedCommunityAttrSubType(data[1])
-	if subType == EC_SUBTYPE_MUP_DIRECT_SEG {
-		sid2 := binary.BigEndian.Uint16(data[2:4])
-		sid4 := binary.BigEndian.Uint32(data[4:8])
-		return NewMUPExtended(sid2, sid4), nil
-	}
-	return nil, NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, fmt.Sprintf("unknown mup subtype: %d", subType))
+	sid2 := binary.BigEndian.Uint16(data[2:4])
+	sid4 := binary.BigEndian.Uint32(data[4:8])
+	return NewMUPExtendedWithSubType(subType, sid2, sid4), nil
 }
 
 // BGP MUP SAFI Architecture Type as described in
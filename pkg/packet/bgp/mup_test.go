@@ -26,6 +26,36 @@ func Test_MUPExtended(t *testing.T) {
 	assert.Equal(m1, m2)
 }
 
+func Test_MUPExtendedSubType(t *testing.T) {
+	assert := assert.New(t)
+
+	e, err := ParseMUPExtended("100:10000")
+	require.NoError(t, err)
+	assert.Equal(NewMUPExtended(100, 10000), e)
+	assert.Equal("100:10000", e.String())
+
+	e, err = ParseMUPExtended("1:100:10000")
+	require.NoError(t, err)
+	assert.Equal(NewMUPExtendedWithSubType(1, 100, 10000), e)
+	assert.Equal("1:100:10000", e.String())
+	typ, subType := e.GetTypes()
+	assert.Equal(EC_TYPE_MUP, typ)
+	assert.Equal(ExtendedCommunityAttrSubType(1), subType)
+
+	// the sub-type is kept on the wire
+	buf, err := e.Serialize()
+	require.NoError(t, err)
+	assert.Equal([]byte{0x0c, 0x01, 0x00, 0x64, 0x00, 0x00, 0x27, 0x10}, buf)
+	e2, err := ParseExtended(buf)
+	require.NoError(t, err)
+	assert.Equal(e, e2)
+
+	for _, s := range []string{"100", "1:2:3:4", "256:100:10000", "100:x"} {
+		_, err = ParseMUPExtended(s)
+		assert.Error(err, s)
+	}
+}
+
 func Test_MUPInterworkSegmentDiscoveryRouteIPv4(t *testing.T) {
 	assert := assert.New(t)
 	rd, _ := ParseRouteDistinguisher("100:100")
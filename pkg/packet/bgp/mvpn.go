@@ -0,0 +1,637 @@
+package bgp
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+)
+
+// BGP MCAST-VPN Route Type as described in
+// https://datatracker.ietf.org/doc/html/rfc6514#section-4
+const (
+	_ = iota
+	MVPN_ROUTE_TYPE_INTRA_AS_I_PMSI_AD
+	MVPN_ROUTE_TYPE_INTER_AS_I_PMSI_AD
+	MVPN_ROUTE_TYPE_S_PMSI_AD
+	MVPN_ROUTE_TYPE_LEAF_AD
+	MVPN_ROUTE_TYPE_SOURCE_ACTIVE_AD
+	MVPN_ROUTE_TYPE_SHARED_TREE_JOIN
+	MVPN_ROUTE_TYPE_SOURCE_TREE_JOIN
+)
+
+type MVPNRouteTypeInterface interface {
+	DecodeFromBytes([]byte, uint16) error
+	Serialize() ([]byte, error)
+	Len() int
+	String() string
+	MarshalJSON() ([]byte, error)
+	rd() RouteDistinguisherInterface
+}
+
+func getMVPNRouteType(t uint8) (MVPNRouteTypeInterface, error) {
+	switch t {
+	case MVPN_ROUTE_TYPE_INTRA_AS_I_PMSI_AD:
+		return &MVPNIntraASIPMSIADRoute{}, nil
+	case MVPN_ROUTE_TYPE_INTER_AS_I_PMSI_AD:
+		return &MVPNInterASIPMSIADRoute{}, nil
+	case MVPN_ROUTE_TYPE_S_PMSI_AD:
+		return &MVPNSPMSIADRoute{}, nil
+	case MVPN_ROUTE_TYPE_LEAF_AD:
+		return &MVPNLeafADRoute{}, nil
+	case MVPN_ROUTE_TYPE_SOURCE_ACTIVE_AD:
+		return &MVPNSourceActiveADRoute{}, nil
+	case MVPN_ROUTE_TYPE_SHARED_TREE_JOIN:
+		return &MVPNSharedTreeJoinRoute{}, nil
+	case MVPN_ROUTE_TYPE_SOURCE_TREE_JOIN:
+		return &MVPNSourceTreeJoinRoute{}, nil
+	}
+	return nil, NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, fmt.Sprintf("Unknown MVPN Route type: %d", t))
+}
+
+// MVPNNLRI represents the MCAST-VPN NLRI as described in
+// https://datatracker.ietf.org/doc/html/rfc6514#section-4
+type MVPNNLRI struct {
+	PrefixDefault
+	Afi           uint16
+	RouteType     uint8
+	Length        uint8
+	RouteTypeData MVPNRouteTypeInterface
+}
+
+func (n *MVPNNLRI) DecodeFromBytes(data []byte, options ...*MarshallingOption) error {
+	if len(data) < 2 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Not all MVPNNLRI bytes available")
+	}
+	n.RouteType = data[0]
+	n.Length = data[1]
+	data = data[2:]
+	if len(data) < int(n.Length) {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Not all MVPNNLRI Route type bytes available")
+	}
+	r, err := getMVPNRouteType(n.RouteType)
+	if err != nil {
+		return err
+	}
+	n.RouteTypeData = r
+	return n.RouteTypeData.DecodeFromBytes(data[:n.Length], n.Afi)
+}
+
+func (n *MVPNNLRI) Serialize(options ...*MarshallingOption) ([]byte, error) {
+	buf := []byte{n.RouteType, n.Length}
+	tbuf, err := n.RouteTypeData.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, tbuf...), nil
+}
+
+func (n *MVPNNLRI) AFI() uint16 {
+	return n.Afi
+}
+
+func (n *MVPNNLRI) SAFI() uint8 {
+	return SAFI_MCAST_VPN
+}
+
+func (n *MVPNNLRI) Len(options ...*MarshallingOption) int {
+	return int(n.Length) + 2
+}
+
+func (n *MVPNNLRI) String() string {
+	if n.RouteTypeData != nil {
+		return n.RouteTypeData.String()
+	}
+	return fmt.Sprintf("%d:%d", n.RouteType, n.Length)
+}
+
+func (n *MVPNNLRI) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		RouteType uint8                  `json:"route_type"`
+		Value     MVPNRouteTypeInterface `json:"value"`
+	}{
+		RouteType: n.RouteType,
+		Value:     n.RouteTypeData,
+	})
+}
+
+func (n *MVPNNLRI) RD() RouteDistinguisherInterface {
+	return n.RouteTypeData.rd()
+}
+
+func (n *MVPNNLRI) Flat() map[string]string {
+	return map[string]string{}
+}
+
+func NewMVPNNLRI(afi uint16, rt uint8, data MVPNRouteTypeInterface) *MVPNNLRI {
+	var l uint8
+	if data != nil {
+		l = uint8(data.Len())
+	}
+	return &MVPNNLRI{
+		Afi:           afi,
+		RouteType:     rt,
+		Length:        l,
+		RouteTypeData: data,
+	}
+}
+
+func decodeMVPNRD(data []byte) (RouteDistinguisherInterface, []byte, error) {
+	if len(data) < 8 {
+		return nil, nil, NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Not all RD bytes available")
+	}
+	rd := GetRouteDistinguisher(data)
+	return rd, data[rd.Len():], nil
+}
+
+func serializeMVPNRD(rd RouteDistinguisherInterface) ([]byte, error) {
+	if rd == nil {
+		return make([]byte, 8), nil
+	}
+	return rd.Serialize()
+}
+
+// decodeMVPNAddr decodes the multicast source or group field with the
+// length in bits. The zero length is the wildcard of RFC6625 and returns
+// the zero netip.Addr.
+func decodeMVPNAddr(data []byte) (netip.Addr, []byte, error) {
+	if len(data) < 1 {
+		return netip.Addr{}, nil, NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "address length is missing")
+	}
+	l := int(data[0])
+	data = data[1:]
+	switch l {
+	case 0:
+		return netip.Addr{}, data, nil
+	case 32, 128:
+	default:
+		return netip.Addr{}, nil, NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, fmt.Sprintf("Invalid IP address length: %d", l))
+	}
+	if len(data) < l/8 {
+		return netip.Addr{}, nil, NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "not all address bytes available")
+	}
+	addr, _ := netip.AddrFromSlice(data[:l/8])
+	return addr, data[l/8:], nil
+}
+
+func serializeMVPNAddr(buf []byte, addr netip.Addr) []byte {
+	if !addr.IsValid() {
+		return append(buf, 0)
+	}
+	return append(append(buf, uint8(addr.BitLen())), addr.AsSlice()...)
+}
+
+func mvpnAddrLen(addr netip.Addr) int {
+	return 1 + addr.BitLen()/8
+}
+
+func mvpnAddrString(addr netip.Addr) string {
+	if !addr.IsValid() {
+		return "*"
+	}
+	return addr.String()
+}
+
+// decodeMVPNOriginator decodes the Originating Router's IP Address, which
+// is the rest of the route. Its length isn't bound to the AFI (RFC6515).
+func decodeMVPNOriginator(data []byte) (netip.Addr, error) {
+	if len(data) != 4 && len(data) != 16 {
+		return netip.Addr{}, NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, fmt.Sprintf("Invalid Originating Router's IP Address length: %d", len(data)))
+	}
+	addr, _ := netip.AddrFromSlice(data)
+	return addr, nil
+}
+
+// MVPNIntraASIPMSIADRoute represents the Intra-AS I-PMSI A-D route as
+// described in https://datatracker.ietf.org/doc/html/rfc6514#section-4.1
+type MVPNIntraASIPMSIADRoute struct {
+	RD                RouteDistinguisherInterface
+	OriginatorAddress netip.Addr
+}
+
+func NewMVPNIntraASIPMSIADRoute(afi uint16, rd RouteDistinguisherInterface, originator netip.Addr) *MVPNNLRI {
+	return NewMVPNNLRI(afi, MVPN_ROUTE_TYPE_INTRA_AS_I_PMSI_AD, &MVPNIntraASIPMSIADRoute{
+		RD:                rd,
+		OriginatorAddress: originator,
+	})
+}
+
+func (r *MVPNIntraASIPMSIADRoute) DecodeFromBytes(data []byte, afi uint16) error {
+	var err error
+	if r.RD, data, err = decodeMVPNRD(data); err != nil {
+		return err
+	}
+	r.OriginatorAddress, err = decodeMVPNOriginator(data)
+	return err
+}
+
+func (r *MVPNIntraASIPMSIADRoute) Serialize() ([]byte, error) {
+	buf, err := serializeMVPNRD(r.RD)
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, r.OriginatorAddress.AsSlice()...), nil
+}
+
+func (r *MVPNIntraASIPMSIADRoute) Len() int {
+	// RD(8) + OriginatorAddress(4 or 16)
+	return 8 + r.OriginatorAddress.BitLen()/8
+}
+
+func (r *MVPNIntraASIPMSIADRoute) String() string {
+	return fmt.Sprintf("[type:intra-as-i-pmsi-ad][rd:%s][originator:%s]", r.RD, r.OriginatorAddress)
+}
+
+func (r *MVPNIntraASIPMSIADRoute) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		RD         RouteDistinguisherInterface `json:"rd"`
+		Originator string                      `json:"originator"`
+	}{
+		RD:         r.RD,
+		Originator: r.OriginatorAddress.String(),
+	})
+}
+
+func (r *MVPNIntraASIPMSIADRoute) rd() RouteDistinguisherInterface {
+	return r.RD
+}
+
+// MVPNInterASIPMSIADRoute represents the Inter-AS I-PMSI A-D route as
+// described in https://datatracker.ietf.org/doc/html/rfc6514#section-4.2
+type MVPNInterASIPMSIADRoute struct {
+	RD       RouteDistinguisherInterface
+	SourceAS uint32
+}
+
+func NewMVPNInterASIPMSIADRoute(afi uint16, rd RouteDistinguisherInterface, sourceAS uint32) *MVPNNLRI {
+	return NewMVPNNLRI(afi, MVPN_ROUTE_TYPE_INTER_AS_I_PMSI_AD, &MVPNInterASIPMSIADRoute{
+		RD:       rd,
+		SourceAS: sourceAS,
+	})
+}
+
+func (r *MVPNInterASIPMSIADRoute) DecodeFromBytes(data []byte, afi uint16) error {
+	var err error
+	if r.RD, data, err = decodeMVPNRD(data); err != nil {
+		return err
+	}
+	if len(data) != 4 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "invalid Inter-AS I-PMSI A-D Route length")
+	}
+	r.SourceAS = binary.BigEndian.Uint32(data)
+	return nil
+}
+
+func (r *MVPNInterASIPMSIADRoute) Serialize() ([]byte, error) {
+	buf, err := serializeMVPNRD(r.RD)
+	if err != nil {
+		return nil, err
+	}
+	return binary.BigEndian.AppendUint32(buf, r.SourceAS), nil
+}
+
+func (r *MVPNInterASIPMSIADRoute) Len() int {
+	// RD(8) + SourceAS(4)
+	return 12
+}
+
+func (r *MVPNInterASIPMSIADRoute) String() string {
+	return fmt.Sprintf("[type:inter-as-i-pmsi-ad][rd:%s][source-as:%d]", r.RD, r.SourceAS)
+}
+
+func (r *MVPNInterASIPMSIADRoute) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		RD       RouteDistinguisherInterface `json:"rd"`
+		SourceAS uint32                      `json:"source_as"`
+	}{
+		RD:       r.RD,
+		SourceAS: r.SourceAS,
+	})
+}
+
+func (r *MVPNInterASIPMSIADRoute) rd() RouteDistinguisherInterface {
+	return r.RD
+}
+
+// MVPNSPMSIADRoute represents the S-PMSI A-D route as described in
+// https://datatracker.ietf.org/doc/html/rfc6514#section-4.3
+// The zero source or group is the wildcard of RFC6625.
+type MVPNSPMSIADRoute struct {
+	RD                RouteDistinguisherInterface
+	SourceAddress     netip.Addr
+	GroupAddress      netip.Addr
+	OriginatorAddress netip.Addr
+}
+
+func NewMVPNSPMSIADRoute(afi uint16, rd RouteDistinguisherInterface, source, group, originator netip.Addr) *MVPNNLRI {
+	return NewMVPNNLRI(afi, MVPN_ROUTE_TYPE_S_PMSI_AD, &MVPNSPMSIADRoute{
+		RD:                rd,
+		SourceAddress:     source,
+		GroupAddress:      group,
+		OriginatorAddress: originator,
+	})
+}
+
+func (r *MVPNSPMSIADRoute) DecodeFromBytes(data []byte, afi uint16) error {
+	var err error
+	if r.RD, data, err = decodeMVPNRD(data); err != nil {
+		return err
+	}
+	if r.SourceAddress, data, err = decodeMVPNAddr(data); err != nil {
+		return err
+	}
+	if r.GroupAddress, data, err = decodeMVPNAddr(data); err != nil {
+		return err
+	}
+	r.OriginatorAddress, err = decodeMVPNOriginator(data)
+	return err
+}
+
+func (r *MVPNSPMSIADRoute) Serialize() ([]byte, error) {
+	buf, err := serializeMVPNRD(r.RD)
+	if err != nil {
+		return nil, err
+	}
+	buf = serializeMVPNAddr(buf, r.SourceAddress)
+	buf = serializeMVPNAddr(buf, r.GroupAddress)
+	return append(buf, r.OriginatorAddress.AsSlice()...), nil
+}
+
+func (r *MVPNSPMSIADRoute) Len() int {
+	// RD(8) + Source + Group + OriginatorAddress(4 or 16)
+	return 8 + mvpnAddrLen(r.SourceAddress) + mvpnAddrLen(r.GroupAddress) + r.OriginatorAddress.BitLen()/8
+}
+
+func (r *MVPNSPMSIADRoute) String() string {
+	return fmt.Sprintf("[type:s-pmsi-ad][rd:%s][source:%s][group:%s][originator:%s]", r.RD, mvpnAddrString(r.SourceAddress), mvpnAddrString(r.GroupAddress), r.OriginatorAddress)
+}
+
+func (r *MVPNSPMSIADRoute) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		RD         RouteDistinguisherInterface `json:"rd"`
+		Source     string                      `json:"source"`
+		Group      string                      `json:"group"`
+		Originator string                      `json:"originator"`
+	}{
+		RD:         r.RD,
+		Source:     mvpnAddrString(r.SourceAddress),
+		Group:      mvpnAddrString(r.GroupAddress),
+		Originator: r.OriginatorAddress.String(),
+	})
+}
+
+func (r *MVPNSPMSIADRoute) rd() RouteDistinguisherInterface {
+	return r.RD
+}
+
+// MVPNLeafADRoute represents the Leaf A-D route as described in
+// https://datatracker.ietf.org/doc/html/rfc6514#section-4.4
+// The Route Key is the NLRI of the route which the Leaf A-D route responds
+// to, typically the S-PMSI A-D route with the Leaf Information Required
+// flag of the PMSI Tunnel attribute set.
+type MVPNLeafADRoute struct {
+	RouteKey          *MVPNNLRI
+	OriginatorAddress netip.Addr
+}
+
+func NewMVPNLeafADRoute(afi uint16, key *MVPNNLRI, originator netip.Addr) *MVPNNLRI {
+	return NewMVPNNLRI(afi, MVPN_ROUTE_TYPE_LEAF_AD, &MVPNLeafADRoute{
+		RouteKey:          key,
+		OriginatorAddress: originator,
+	})
+}
+
+func (r *MVPNLeafADRoute) DecodeFromBytes(data []byte, afi uint16) error {
+	if len(data) < 2 || len(data) < 2+int(data[1]) {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "invalid Leaf A-D Route length")
+	}
+	key := &MVPNNLRI{Afi: afi}
+	if err := key.DecodeFromBytes(data); err != nil {
+		return err
+	}
+	r.RouteKey = key
+	var err error
+	r.OriginatorAddress, err = decodeMVPNOriginator(data[key.Len():])
+	return err
+}
+
+func (r *MVPNLeafADRoute) Serialize() ([]byte, error) {
+	if r.RouteKey == nil {
+		return nil, fmt.Errorf("leaf a-d route has no route key")
+	}
+	buf, err := r.RouteKey.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, r.OriginatorAddress.AsSlice()...), nil
+}
+
+func (r *MVPNLeafADRoute) Len() int {
+	// RouteKey + OriginatorAddress(4 or 16)
+	l := r.OriginatorAddress.BitLen() / 8
+	if r.RouteKey != nil {
+		l += r.RouteKey.Len()
+	}
+	return l
+}
+
+func (r *MVPNLeafADRoute) String() string {
+	return fmt.Sprintf("[type:leaf-ad][route-key:%s][originator:%s]", r.RouteKey, r.OriginatorAddress)
+}
+
+func (r *MVPNLeafADRoute) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		RouteKey   *MVPNNLRI `json:"route_key"`
+		Originator string    `json:"originator"`
+	}{
+		RouteKey:   r.RouteKey,
+		Originator: r.OriginatorAddress.String(),
+	})
+}
+
+// rd returns the RD of the Route Key as the Leaf A-D route has no RD of
+// its own.
+func (r *MVPNLeafADRoute) rd() RouteDistinguisherInterface {
+	if r.RouteKey == nil || r.RouteKey.RouteTypeData == nil {
+		return nil
+	}
+	return r.RouteKey.RD()
+}
+
+// MVPNSourceActiveADRoute represents the Source Active A-D route as
+// described in https://datatracker.ietf.org/doc/html/rfc6514#section-4.5
+type MVPNSourceActiveADRoute struct {
+	RD            RouteDistinguisherInterface
+	SourceAddress netip.Addr
+	GroupAddress  netip.Addr
+}
+
+func NewMVPNSourceActiveADRoute(afi uint16, rd RouteDistinguisherInterface, source, group netip.Addr) *MVPNNLRI {
+	return NewMVPNNLRI(afi, MVPN_ROUTE_TYPE_SOURCE_ACTIVE_AD, &MVPNSourceActiveADRoute{
+		RD:            rd,
+		SourceAddress: source,
+		GroupAddress:  group,
+	})
+}
+
+func (r *MVPNSourceActiveADRoute) DecodeFromBytes(data []byte, afi uint16) error {
+	var err error
+	if r.RD, data, err = decodeMVPNRD(data); err != nil {
+		return err
+	}
+	if r.SourceAddress, data, err = decodeMVPNAddr(data); err != nil {
+		return err
+	}
+	if r.GroupAddress, data, err = decodeMVPNAddr(data); err != nil {
+		return err
+	}
+	if len(data) != 0 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "invalid Source Active A-D Route length")
+	}
+	return nil
+}
+
+func (r *MVPNSourceActiveADRoute) Serialize() ([]byte, error) {
+	buf, err := serializeMVPNRD(r.RD)
+	if err != nil {
+		return nil, err
+	}
+	buf = serializeMVPNAddr(buf, r.SourceAddress)
+	return serializeMVPNAddr(buf, r.GroupAddress), nil
+}
+
+func (r *MVPNSourceActiveADRoute) Len() int {
+	// RD(8) + Source + Group
+	return 8 + mvpnAddrLen(r.SourceAddress) + mvpnAddrLen(r.GroupAddress)
+}
+
+func (r *MVPNSourceActiveADRoute) String() string {
+	return fmt.Sprintf("[type:source-active-ad][rd:%s][source:%s][group:%s]", r.RD, mvpnAddrString(r.SourceAddress), mvpnAddrString(r.GroupAddress))
+}
+
+func (r *MVPNSourceActiveADRoute) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		RD     RouteDistinguisherInterface `json:"rd"`
+		Source string                      `json:"source"`
+		Group  string                      `json:"group"`
+	}{
+		RD:     r.RD,
+		Source: mvpnAddrString(r.SourceAddress),
+		Group:  mvpnAddrString(r.GroupAddress),
+	})
+}
+
+func (r *MVPNSourceActiveADRoute) rd() RouteDistinguisherInterface {
+	return r.RD
+}
+
+// MVPNCMulticastRoute is the format shared by the C-multicast routes, the
+// Shared Tree Join and Source Tree Join routes, as described in
+// https://datatracker.ietf.org/doc/html/rfc6514#section-4.6
+// The source of the Shared Tree Join route is the C-RP address.
+type MVPNCMulticastRoute struct {
+	RD            RouteDistinguisherInterface
+	SourceAS      uint32
+	SourceAddress netip.Addr
+	GroupAddress  netip.Addr
+}
+
+func (r *MVPNCMulticastRoute) DecodeFromBytes(data []byte, afi uint16) error {
+	var err error
+	if r.RD, data, err = decodeMVPNRD(data); err != nil {
+		return err
+	}
+	if len(data) < 4 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "invalid C-multicast Route length")
+	}
+	r.SourceAS = binary.BigEndian.Uint32(data[0:4])
+	if r.SourceAddress, data, err = decodeMVPNAddr(data[4:]); err != nil {
+		return err
+	}
+	if r.GroupAddress, data, err = decodeMVPNAddr(data); err != nil {
+		return err
+	}
+	if len(data) != 0 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "invalid C-multicast Route length")
+	}
+	return nil
+}
+
+func (r *MVPNCMulticastRoute) Serialize() ([]byte, error) {
+	buf, err := serializeMVPNRD(r.RD)
+	if err != nil {
+		return nil, err
+	}
+	buf = binary.BigEndian.AppendUint32(buf, r.SourceAS)
+	buf = serializeMVPNAddr(buf, r.SourceAddress)
+	return serializeMVPNAddr(buf, r.GroupAddress), nil
+}
+
+func (r *MVPNCMulticastRoute) Len() int {
+	// RD(8) + SourceAS(4) + Source + Group
+	return 12 + mvpnAddrLen(r.SourceAddress) + mvpnAddrLen(r.GroupAddress)
+}
+
+func (r *MVPNCMulticastRoute) string(typ string) string {
+	return fmt.Sprintf("[type:%s][rd:%s][source-as:%d][source:%s][group:%s]", typ, r.RD, r.SourceAS, mvpnAddrString(r.SourceAddress), mvpnAddrString(r.GroupAddress))
+}
+
+func (r *MVPNCMulticastRoute) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		RD       RouteDistinguisherInterface `json:"rd"`
+		SourceAS uint32                      `json:"source_as"`
+		Source   string                      `json:"source"`
+		Group    string                      `json:"group"`
+	}{
+		RD:       r.RD,
+		SourceAS: r.SourceAS,
+		Source:   mvpnAddrString(r.SourceAddress),
+		Group:    mvpnAddrString(r.GroupAddress),
+	})
+}
+
+func (r *MVPNCMulticastRoute) rd() RouteDistinguisherInterface {
+	return r.RD
+}
+
+// MVPNSharedTreeJoinRoute represents the Shared Tree Join route, the
+// C-multicast route for (C-*, C-G).
+type MVPNSharedTreeJoinRoute struct {
+	MVPNCMulticastRoute
+}
+
+func NewMVPNSharedTreeJoinRoute(afi uint16, rd RouteDistinguisherInterface, sourceAS uint32, rp, group netip.Addr) *MVPNNLRI {
+	return NewMVPNNLRI(afi, MVPN_ROUTE_TYPE_SHARED_TREE_JOIN, &MVPNSharedTreeJoinRoute{
+		MVPNCMulticastRoute{
+			RD:            rd,
+			SourceAS:      sourceAS,
+			SourceAddress: rp,
+			GroupAddress:  group,
+		},
+	})
+}
+
+func (r *MVPNSharedTreeJoinRoute) String() string {
+	return r.string("shared-tree-join")
+}
+
+// MVPNSourceTreeJoinRoute represents the Source Tree Join route, the
+// C-multicast route for (C-S, C-G).
+type MVPNSourceTreeJoinRoute struct {
+	MVPNCMulticastRoute
+}
+
+func NewMVPNSourceTreeJoinRoute(afi uint16, rd RouteDistinguisherInterface, sourceAS uint32, source, group netip.Addr) *MVPNNLRI {
+	return NewMVPNNLRI(afi, MVPN_ROUTE_TYPE_SOURCE_TREE_JOIN, &MVPNSourceTreeJoinRoute{
+		MVPNCMulticastRoute{
+			RD:            rd,
+			SourceAS:      sourceAS,
+			SourceAddress: source,
+			GroupAddress:  group,
+		},
+	})
+}
+
+func (r *MVPNSourceTreeJoinRoute) String() string {
+	return r.string("source-tree-join")
+}
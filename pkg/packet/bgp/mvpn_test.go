@@ -0,0 +1,133 @@
+package bgp
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MVPNRoutes(t *testing.T) {
+	assert := assert.New(t)
+	rd, _ := ParseRouteDistinguisher("65000:100")
+	source := netip.MustParseAddr("10.1.1.1")
+	group := netip.MustParseAddr("232.1.1.1")
+	originator := netip.MustParseAddr("192.168.1.1")
+	spmsi := NewMVPNSPMSIADRoute(AFI_IP, rd, source, group, originator)
+
+	tests := []struct {
+		family RouteFamily
+		nlri   *MVPNNLRI
+		str    string
+	}{
+		{
+			RF_MVPN_IPv4,
+			NewMVPNIntraASIPMSIADRoute(AFI_IP, rd, originator),
+			"[type:intra-as-i-pmsi-ad][rd:65000:100][originator:192.168.1.1]",
+		},
+		{
+			RF_MVPN_IPv4,
+			NewMVPNInterASIPMSIADRoute(AFI_IP, rd, 65001),
+			"[type:inter-as-i-pmsi-ad][rd:65000:100][source-as:65001]",
+		},
+		{
+			RF_MVPN_IPv4,
+			spmsi,
+			"[type:s-pmsi-ad][rd:65000:100][source:10.1.1.1][group:232.1.1.1][originator:192.168.1.1]",
+		},
+		{
+			RF_MVPN_IPv4,
+			NewMVPNSPMSIADRoute(AFI_IP, rd, netip.Addr{}, netip.Addr{}, originator),
+			"[type:s-pmsi-ad][rd:65000:100][source:*][group:*][originator:192.168.1.1]",
+		},
+		{
+			RF_MVPN_IPv4,
+			NewMVPNLeafADRoute(AFI_IP, spmsi, netip.MustParseAddr("192.168.1.2")),
+			"[type:leaf-ad][route-key:[type:s-pmsi-ad][rd:65000:100][source:10.1.1.1][group:232.1.1.1][originator:192.168.1.1]][originator:192.168.1.2]",
+		},
+		{
+			RF_MVPN_IPv4,
+			NewMVPNSourceActiveADRoute(AFI_IP, rd, source, netip.MustParseAddr("239.1.1.1")),
+			"[type:source-active-ad][rd:65000:100][source:10.1.1.1][group:239.1.1.1]",
+		},
+		{
+			RF_MVPN_IPv4,
+			NewMVPNSharedTreeJoinRoute(AFI_IP, rd, 65000, netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("239.1.1.1")),
+			"[type:shared-tree-join][rd:65000:100][source-as:65000][source:10.0.0.1][group:239.1.1.1]",
+		},
+		{
+			RF_MVPN_IPv4,
+			NewMVPNSourceTreeJoinRoute(AFI_IP, rd, 65000, source, group),
+			"[type:source-tree-join][rd:65000:100][source-as:65000][source:10.1.1.1][group:232.1.1.1]",
+		},
+		{
+			// the IPv4 originator in the IPv6 MCAST-VPN (RFC6515)
+			RF_MVPN_IPv6,
+			NewMVPNSPMSIADRoute(AFI_IP6, rd, netip.MustParseAddr("2001:db8::1"), netip.MustParseAddr("ff3e::1"), originator),
+			"[type:s-pmsi-ad][rd:65000:100][source:2001:db8::1][group:ff3e::1][originator:192.168.1.1]",
+		},
+	}
+	for _, tt := range tests {
+		assert.Equal(tt.str, tt.nlri.String())
+		buf, err := tt.nlri.Serialize()
+		require.NoError(t, err)
+		assert.Equal(tt.nlri.Len(), len(buf))
+
+		n, err := NewPrefixFromRouteFamily(RouteFamilyToAfiSafi(tt.family))
+		require.NoError(t, err)
+		require.NoError(t, n.DecodeFromBytes(buf), tt.str)
+		assert.Equal(tt.nlri, n)
+		assert.Equal(rd.String(), n.(*MVPNNLRI).RD().String())
+	}
+}
+
+func Test_MVPNRoutesMalformed(t *testing.T) {
+	assert := assert.New(t)
+	for _, buf := range [][]byte{
+		// no route type data
+		{MVPN_ROUTE_TYPE_INTRA_AS_I_PMSI_AD, 12},
+		// short RD
+		{MVPN_ROUTE_TYPE_INTRA_AS_I_PMSI_AD, 4, 0, 0, 0, 1},
+		// 3 bytes originator
+		{MVPN_ROUTE_TYPE_INTRA_AS_I_PMSI_AD, 11, 0, 0, 0xfd, 0xe8, 0, 0, 0, 100, 1, 1, 1},
+		// invalid source length
+		{MVPN_ROUTE_TYPE_SOURCE_ACTIVE_AD, 11, 0, 0, 0xfd, 0xe8, 0, 0, 0, 100, 24, 1, 1},
+		// unknown route type
+		{8, 0},
+		// truncated route key
+		{MVPN_ROUTE_TYPE_LEAF_AD, 6, MVPN_ROUTE_TYPE_INTER_AS_I_PMSI_AD, 12, 0, 0, 0xfd, 0xe8},
+	} {
+		n := NewMVPNNLRI(AFI_IP, 0, nil)
+		assert.Error(n.DecodeFromBytes(buf), buf)
+	}
+}
+
+func Test_PmsiTunnelPimTree(t *testing.T) {
+	assert := assert.New(t)
+
+	p, err := ParsePmsiTunnel([]string{"pim-ssm", "0", "10.0.0.1,232.1.1.1"})
+	require.NoError(t, err)
+	assert.Equal(PMSI_TUNNEL_TYPE_PIM_SSM_TREE, p.TunnelType)
+	assert.Equal("{Pmsi: type: pim-ssm-tree, label: 0, tunnel-id: 10.0.0.1,232.1.1.1}", p.String())
+
+	buf, err := p.Serialize()
+	require.NoError(t, err)
+	p2 := &PathAttributePmsiTunnel{}
+	require.NoError(t, p2.DecodeFromBytes(buf))
+	assert.Equal(p.TunnelID.String(), p2.TunnelID.String())
+	assert.IsType(&PimTunnelID{}, p2.TunnelID)
+
+	p, err = ParsePmsiTunnel([]string{"bidir-pim", "leaf-info-required", "0", "2001:db8::1,ff3e::1"})
+	require.NoError(t, err)
+	assert.True(p.IsLeafInfoRequired)
+	assert.Equal(32, p.TunnelID.Len())
+
+	for _, args := range [][]string{
+		{"pim-sm", "0", "10.0.0.1"},
+		{"pim-sm", "0", "10.0.0.1,ff3e::1"},
+	} {
+		_, err = ParsePmsiTunnel(args)
+		assert.Error(err, args)
+	}
+}
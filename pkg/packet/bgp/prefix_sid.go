@@ -692,3 +692,118 @@ func (t *SRv6ServiceTLV) String() string {
 
 	return fmt.Sprintf("{SRv6 Service TLV: %s}", buf.String())
 }
+
+// SRv6SID represents the SRv6 SID advertised in the SRv6 Service TLV in the
+// structured form.
+type SRv6SID struct {
+	SID       netip.Addr
+	Behavior  SRBehavior
+	Structure *SRv6SIDStructureSubSubTLV
+}
+
+// Locator returns the locator part of the SID. The invalid prefix is
+// returned if the SID structure is unknown.
+func (s *SRv6SID) Locator() netip.Prefix {
+	if s.Structure == nil {
+		return netip.Prefix{}
+	}
+	p, err := s.SID.Prefix(int(s.Structure.LocatorBlockLength) + int(s.Structure.LocatorNodeLength))
+	if err != nil {
+		return netip.Prefix{}
+	}
+	return p
+}
+
+// PrefixSID returns the Prefix SID attribute which has the SRv6 Service TLV
+// of the type t for the SID, and the label to be carried in the NLRI.
+// If the transposition length of the SID structure is set, the transposed
+// bits are removed from the SID and carried in the label, otherwise the label
+// is Implicit NULL.
+// https://www.rfc-editor.org/rfc/rfc9252.html#section-4
+func (s *SRv6SID) PrefixSID(t TLVType) (*PathAttributePrefixSID, uint32) {
+	sid := s.SID.As16()
+	label := uint32(3) // Implicit NULL
+	var values []PrefixSIDTLVInterface
+	if st := s.Structure; st != nil {
+		if st.TranspositionLength > 0 {
+			label = transposeSRv6SID(sid[:], st.TranspositionOffset, st.TranspositionLength)
+		}
+		values = append(values, NewSRv6SIDStructureSubSubTLV(st.LocatorBlockLength, st.LocatorNodeLength, st.FunctionLength, st.ArgumentLength, st.TranspositionLength, st.TranspositionOffset))
+	}
+	return NewPathAttributePrefixSID(
+		NewSRv6ServiceTLV(t,
+			NewSRv6InformationSubTLV(netip.AddrFrom16(sid), s.Behavior, values...))), label
+}
+
+// SRv6ServiceSID returns the SID in the SRv6 Service TLV of the type t. The
+// transposed bits of the SID are restored from the label carried in the
+// NLRI. nil is returned if the attribute has no such TLV.
+func (p *PathAttributePrefixSID) SRv6ServiceSID(t TLVType, label uint32) *SRv6SID {
+	for _, tlv := range p.TLVs {
+		service, ok := tlv.(*SRv6ServiceTLV)
+		if !ok || service.Type != t {
+			continue
+		}
+		for _, stlv := range service.SubTLVs {
+			info, ok := stlv.(*SRv6InformationSubTLV)
+			if !ok || len(info.SID) != net.IPv6len {
+				continue
+			}
+			var sid [16]byte
+			copy(sid[:], info.SID)
+			s := &SRv6SID{Behavior: SRBehavior(info.EndpointBehavior)}
+			for _, sstlv := range info.SubSubTLVs {
+				if st, ok := sstlv.(*SRv6SIDStructureSubSubTLV); ok {
+					s.Structure = st
+					if st.TranspositionLength > 0 {
+						restoreSRv6SID(sid[:], label, st.TranspositionOffset, st.TranspositionLength)
+					}
+				}
+			}
+			s.SID = netip.AddrFrom16(sid)
+			return s
+		}
+	}
+	return nil
+}
+
+func validSRv6Transposition(offset, length uint8) bool {
+	return length <= 20 && int(offset)+int(length) <= net.IPv6len*8
+}
+
+// transposeSRv6SID clears the length bits of the SID at the bit offset and
+// returns them as the high-order bits of the 20 bits label.
+func transposeSRv6SID(sid []byte, offset, length uint8) uint32 {
+	if !validSRv6Transposition(offset, length) {
+		return 0
+	}
+	var bits uint32
+	for i := 0; i < int(length); i++ {
+		pos := int(offset) + i
+		mask := byte(0x80 >> (pos % 8))
+		bits <<= 1
+		if sid[pos/8]&mask != 0 {
+			bits |= 1
+		}
+		sid[pos/8] &^= mask
+	}
+	return bits << (20 - length)
+}
+
+// restoreSRv6SID writes the high-order length bits of the 20 bits label into
+// the SID at the bit offset.
+func restoreSRv6SID(sid []byte, label uint32, offset, length uint8) {
+	if !validSRv6Transposition(offset, length) {
+		return
+	}
+	bits := label >> (20 - length)
+	for i := 0; i < int(length); i++ {
+		pos := int(offset) + i
+		mask := byte(0x80 >> (pos % 8))
+		if bits&(1<<(int(length)-1-i)) != 0 {
+			sid[pos/8] |= mask
+		} else {
+			sid[pos/8] &^= mask
+		}
+	}
+}
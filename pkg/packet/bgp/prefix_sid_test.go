@@ -125,3 +125,76 @@ func TestNewPathAttributePrefixSID(t *testing.T) {
 		})
 	}
 }
+
+func TestSRv6SIDTransposition(t *testing.T) {
+	tests := []struct {
+		name      string
+		sid       *SRv6SID
+		wantSID   string
+		wantLabel uint32
+	}{
+		{
+			name: "without transposition",
+			sid: &SRv6SID{
+				SID:       netip.MustParseAddr("fd00:1:1:12::"),
+				Behavior:  END_DT46,
+				Structure: NewSRv6SIDStructureSubSubTLV(32, 16, 16, 0, 0, 0),
+			},
+			wantSID:   "fd00:1:1:12::",
+			wantLabel: 3,
+		},
+		{
+			name: "function transposed into label",
+			sid: &SRv6SID{
+				SID:       netip.MustParseAddr("fd00:1:1:12::"),
+				Behavior:  END_DT46,
+				Structure: NewSRv6SIDStructureSubSubTLV(32, 16, 16, 0, 16, 48),
+			},
+			wantSID:   "fd00:1:1::",
+			wantLabel: 0x120,
+		},
+		{
+			name: "unaligned transposition",
+			sid: &SRv6SID{
+				SID:       netip.MustParseAddr("fd00:1:1:fff0::"),
+				Behavior:  END_DT4,
+				Structure: NewSRv6SIDStructureSubSubTLV(40, 8, 12, 0, 12, 48),
+			},
+			wantSID:   "fd00:1:1::",
+			wantLabel: 0xfff00,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attr, label := tt.sid.PrefixSID(TLVTypeSRv6L3Service)
+			if label != tt.wantLabel {
+				t.Fatalf("got label %x want %x", label, tt.wantLabel)
+			}
+			buf, err := attr.Serialize()
+			if err != nil {
+				t.Fatalf("test failed with error: %+v", err)
+			}
+			decoded := &PathAttributePrefixSID{}
+			if err := decoded.DecodeFromBytes(buf); err != nil {
+				t.Fatalf("test failed with error: %+v", err)
+			}
+			info := decoded.TLVs[0].(*SRv6ServiceTLV).SubTLVs[0].(*SRv6InformationSubTLV)
+			if got := netip.AddrFrom16([16]byte(info.SID)).String(); got != tt.wantSID {
+				t.Fatalf("got sid %s want %s", got, tt.wantSID)
+			}
+			sid := decoded.SRv6ServiceSID(TLVTypeSRv6L3Service, label)
+			if sid == nil {
+				t.Fatal("no srv6 sid decoded")
+			}
+			if sid.SID != tt.sid.SID || sid.Behavior != tt.sid.Behavior {
+				t.Fatalf("got %s %s want %s %s", sid.SID, sid.Behavior, tt.sid.SID, tt.sid.Behavior)
+			}
+			if sid.Locator() != netip.MustParsePrefix("fd00:1:1::/48") {
+				t.Fatalf("got locator %s", sid.Locator())
+			}
+			if sid := decoded.SRv6ServiceSID(TLVTypeSRv6L2Service, label); sid != nil {
+				t.Fatalf("unexpected srv6 l2 service sid: %s", sid.SID)
+			}
+		})
+	}
+}
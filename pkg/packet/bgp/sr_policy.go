@@ -274,8 +274,11 @@ func (t *TunnelEncapSubTLVSRCandidatePathName) DecodeFromBytes(data []byte) erro
 	if err != nil {
 		return err
 	}
+	if len(value) < 1 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Malformed BGP message")
+	}
 	// Skip Reserved byte
-	t.CandidatePathName = string(value[1:t.TunnelEncapSubTLV.Len()])
+	t.CandidatePathName = string(value[1:])
 	return nil
 }
 
@@ -500,6 +503,21 @@ func (t *TunnelEncapSubTLVSRBSID) MarshalJSON() ([]byte, error) {
 	})
 }
 
+func NewTunnelEncapSubTLVSRBSID(flags uint8, bsid *BSID) *TunnelEncapSubTLVSRBSID {
+	l := 2
+	if bsid != nil {
+		l += bsid.Len()
+	}
+	return &TunnelEncapSubTLVSRBSID{
+		TunnelEncapSubTLV: TunnelEncapSubTLV{
+			Type:   ENCAP_SUBTLV_TYPE_SRBINDING_SID,
+			Length: uint16(l),
+		},
+		Flags: flags,
+		BSID:  bsid,
+	}
+}
+
 type TunnelEncapSubTLVSRv6BSID struct {
 	TunnelEncapSubTLV
 	Flags uint8
@@ -674,6 +692,18 @@ func (s *SegmentTypeA) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// NewSegmentTypeA returns the segment of the SR-MPLS label.
+func NewSegmentTypeA(flags uint8, label uint32) *SegmentTypeA {
+	return &SegmentTypeA{
+		TunnelEncapSubTLV: TunnelEncapSubTLV{
+			Type:   EncapSubTLVType(TypeA),
+			Length: 6,
+		},
+		Flags: flags,
+		Label: label << 12,
+	}
+}
+
 //go:generate go run internal/generate.go SRBehavior
 //go:generate stringer -type=SRBehavior
 type SRBehavior int32
@@ -801,6 +831,696 @@ func (s *SegmentTypeB) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// NewSegmentTypeB returns the segment of the SRv6 SID with the optional
+// SRv6 Endpoint Behavior and SID Structure.
+func NewSegmentTypeB(flags uint8, sid net.IP, ebs *SRv6EndpointBehaviorStructure) *SegmentTypeB {
+	l := 18
+	if ebs != nil {
+		l += 8
+	}
+	return &SegmentTypeB{
+		TunnelEncapSubTLV: TunnelEncapSubTLV{
+			Type:   EncapSubTLVType(TypeB),
+			Length: uint16(l),
+		},
+		Flags:   flags,
+		SID:     sid.To16(),
+		SRv6EBS: ebs,
+	}
+}
+
+func segmentFlagsString(flags uint8) string {
+	return fmt.Sprintf("V-flag: %t, A-flag: %t, S-flag: %t, B-flag: %t",
+		flags&0x80 == 0x80, flags&0x40 == 0x40, flags&0x20 == 0x20, flags&0x10 == 0x10)
+}
+
+// decodeSegmentLabel decodes the optional SR-MPLS SID following the fixed
+// part of the Segment sub-TLVs of type C to H.
+func decodeSegmentLabel(data []byte) (*uint32, error) {
+	switch len(data) {
+	case 0:
+		return nil, nil
+	case 4:
+		label := binary.BigEndian.Uint32(data)
+		return &label, nil
+	}
+	return nil, NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Malformed BGP message")
+}
+
+func serializeSegmentLabel(buf []byte, label *uint32) []byte {
+	if label == nil {
+		return buf
+	}
+	return binary.BigEndian.AppendUint32(buf, *label)
+}
+
+func segmentLabelString(label *uint32) string {
+	if label == nil {
+		return "n/a"
+	}
+	return strconv.Itoa(int(*label >> 12))
+}
+
+func segmentLabelJSON(label *uint32) *uint32 {
+	if label == nil {
+		return nil
+	}
+	l := *label >> 12
+	return &l
+}
+
+// decodeSegmentSRv6SID decodes the optional SRv6 SID and SRv6 Endpoint
+// Behavior and SID Structure following the fixed part of the Segment
+// sub-TLVs of type I to K.
+func decodeSegmentSRv6SID(data []byte) ([]byte, *SRv6EndpointBehaviorStructure, error) {
+	switch len(data) {
+	case 0:
+		return nil, nil, nil
+	case 16:
+		return data, nil, nil
+	case 24:
+		ebs := &SRv6EndpointBehaviorStructure{}
+		if err := ebs.DecodeFromBytes(data[16:]); err != nil {
+			return nil, nil, err
+		}
+		return data[:16], ebs, nil
+	}
+	return nil, nil, NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Malformed BGP message")
+}
+
+func serializeSegmentSRv6SID(buf []byte, sid []byte, ebs *SRv6EndpointBehaviorStructure) []byte {
+	if len(sid) == 0 {
+		return buf
+	}
+	buf = append(buf, net.IP(sid).To16()...)
+	if ebs != nil {
+		b, _ := ebs.Serialize()
+		buf = append(buf, b...)
+	}
+	return buf
+}
+
+func segmentSRv6SIDString(sid []byte, ebs *SRv6EndpointBehaviorStructure) string {
+	if len(sid) == 0 {
+		return "n/a"
+	}
+	if ebs == nil {
+		return net.IP(sid).String()
+	}
+	return fmt.Sprintf("%s, Ebs: %s", net.IP(sid).String(), ebs.String())
+}
+
+func segmentSRv6SIDJSON(sid []byte) string {
+	if len(sid) == 0 {
+		return ""
+	}
+	return net.IP(sid).String()
+}
+
+// SegmentTypeC encodes an IPv4 node address, SR Algorithm and an optional
+// SR-MPLS SID.
+type SegmentTypeC struct {
+	TunnelEncapSubTLV
+	Flags     uint8
+	Algorithm uint8
+	Address   net.IP
+	Label     *uint32
+}
+
+func (s *SegmentTypeC) DecodeFromBytes(data []byte) error {
+	value, err := s.TunnelEncapSubTLV.DecodeFromBytes(data)
+	if err != nil {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, err.Error())
+	}
+	if len(value) < 6 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Malformed BGP message")
+	}
+	s.Flags = value[0]
+	s.Algorithm = value[1]
+	s.Address = net.IP(value[2:6]).To4()
+	s.Label, err = decodeSegmentLabel(value[6:])
+	return err
+}
+
+func (s *SegmentTypeC) Serialize() ([]byte, error) {
+	buf := make([]byte, 6)
+	buf[0] = s.Flags
+	buf[1] = s.Algorithm
+	copy(buf[2:6], s.Address.To4())
+	return s.TunnelEncapSubTLV.Serialize(serializeSegmentLabel(buf, s.Label))
+}
+
+func (s *SegmentTypeC) String() string {
+	return fmt.Sprintf("{%s, Algorithm: %d, Node: %s, Label: %s}",
+		segmentFlagsString(s.Flags), s.Algorithm, s.Address, segmentLabelString(s.Label))
+}
+
+func (s *SegmentTypeC) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type      EncapSubTLVType `json:"type"`
+		VFlag     bool            `json:"v_flag"`
+		AFlag     bool            `json:"a_flag"`
+		SFlag     bool            `json:"s_flag"`
+		BFlag     bool            `json:"b_flag"`
+		Algorithm uint8           `json:"algorithm"`
+		Address   string          `json:"address"`
+		Label     *uint32         `json:"label,omitempty"`
+	}{
+		Type:      s.Type,
+		VFlag:     s.Flags&0x80 == 0x80,
+		AFlag:     s.Flags&0x40 == 0x40,
+		SFlag:     s.Flags&0x20 == 0x20,
+		BFlag:     s.Flags&0x10 == 0x10,
+		Algorithm: s.Algorithm,
+		Address:   s.Address.String(),
+		Label:     segmentLabelJSON(s.Label),
+	})
+}
+
+// SegmentTypeD encodes an IPv6 node address, SR Algorithm and an optional
+// SR-MPLS SID.
+type SegmentTypeD struct {
+	TunnelEncapSubTLV
+	Flags     uint8
+	Algorithm uint8
+	Address   net.IP
+	Label     *uint32
+}
+
+func (s *SegmentTypeD) DecodeFromBytes(data []byte) error {
+	value, err := s.TunnelEncapSubTLV.DecodeFromBytes(data)
+	if err != nil {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, err.Error())
+	}
+	if len(value) < 18 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Malformed BGP message")
+	}
+	s.Flags = value[0]
+	s.Algorithm = value[1]
+	s.Address = net.IP(value[2:18]).To16()
+	s.Label, err = decodeSegmentLabel(value[18:])
+	return err
+}
+
+func (s *SegmentTypeD) Serialize() ([]byte, error) {
+	buf := make([]byte, 18)
+	buf[0] = s.Flags
+	buf[1] = s.Algorithm
+	copy(buf[2:18], s.Address.To16())
+	return s.TunnelEncapSubTLV.Serialize(serializeSegmentLabel(buf, s.Label))
+}
+
+func (s *SegmentTypeD) String() string {
+	return fmt.Sprintf("{%s, Algorithm: %d, Node: %s, Label: %s}",
+		segmentFlagsString(s.Flags), s.Algorithm, s.Address, segmentLabelString(s.Label))
+}
+
+func (s *SegmentTypeD) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type      EncapSubTLVType `json:"type"`
+		VFlag     bool            `json:"v_flag"`
+		AFlag     bool            `json:"a_flag"`
+		SFlag     bool            `json:"s_flag"`
+		BFlag     bool            `json:"b_flag"`
+		Algorithm uint8           `json:"algorithm"`
+		Address   string          `json:"address"`
+		Label     *uint32         `json:"label,omitempty"`
+	}{
+		Type:      s.Type,
+		VFlag:     s.Flags&0x80 == 0x80,
+		AFlag:     s.Flags&0x40 == 0x40,
+		SFlag:     s.Flags&0x20 == 0x20,
+		BFlag:     s.Flags&0x10 == 0x10,
+		Algorithm: s.Algorithm,
+		Address:   s.Address.String(),
+		Label:     segmentLabelJSON(s.Label),
+	})
+}
+
+// SegmentTypeE encodes an IPv4 node address, a local interface identifier
+// and an optional SR-MPLS SID.
+type SegmentTypeE struct {
+	TunnelEncapSubTLV
+	Flags            uint8
+	LocalInterfaceID uint32
+	Address          net.IP
+	Label            *uint32
+}
+
+func (s *SegmentTypeE) DecodeFromBytes(data []byte) error {
+	value, err := s.TunnelEncapSubTLV.DecodeFromBytes(data)
+	if err != nil {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, err.Error())
+	}
+	if len(value) < 10 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Malformed BGP message")
+	}
+	s.Flags = value[0]
+	s.LocalInterfaceID = binary.BigEndian.Uint32(value[2:6])
+	s.Address = net.IP(value[6:10]).To4()
+	s.Label, err = decodeSegmentLabel(value[10:])
+	return err
+}
+
+func (s *SegmentTypeE) Serialize() ([]byte, error) {
+	buf := make([]byte, 10)
+	buf[0] = s.Flags
+	binary.BigEndian.PutUint32(buf[2:6], s.LocalInterfaceID)
+	copy(buf[6:10], s.Address.To4())
+	return s.TunnelEncapSubTLV.Serialize(serializeSegmentLabel(buf, s.Label))
+}
+
+func (s *SegmentTypeE) String() string {
+	return fmt.Sprintf("{%s, Local Interface ID: %d, Node: %s, Label: %s}",
+		segmentFlagsString(s.Flags), s.LocalInterfaceID, s.Address, segmentLabelString(s.Label))
+}
+
+func (s *SegmentTypeE) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type             EncapSubTLVType `json:"type"`
+		VFlag            bool            `json:"v_flag"`
+		AFlag            bool            `json:"a_flag"`
+		SFlag            bool            `json:"s_flag"`
+		BFlag            bool            `json:"b_flag"`
+		LocalInterfaceID uint32          `json:"local_interface_id"`
+		Address          string          `json:"address"`
+		Label            *uint32         `json:"label,omitempty"`
+	}{
+		Type:             s.Type,
+		VFlag:            s.Flags&0x80 == 0x80,
+		AFlag:            s.Flags&0x40 == 0x40,
+		SFlag:            s.Flags&0x20 == 0x20,
+		BFlag:            s.Flags&0x10 == 0x10,
+		LocalInterfaceID: s.LocalInterfaceID,
+		Address:          s.Address.String(),
+		Label:            segmentLabelJSON(s.Label),
+	})
+}
+
+// SegmentTypeF encodes an IPv4 adjacency local address, an IPv4 adjacency
+// remote address and an optional SR-MPLS SID.
+type SegmentTypeF struct {
+	TunnelEncapSubTLV
+	Flags         uint8
+	LocalAddress  net.IP
+	RemoteAddress net.IP
+	Label         *uint32
+}
+
+func (s *SegmentTypeF) DecodeFromBytes(data []byte) error {
+	value, err := s.TunnelEncapSubTLV.DecodeFromBytes(data)
+	if err != nil {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, err.Error())
+	}
+	if len(value) < 10 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Malformed BGP message")
+	}
+	s.Flags = value[0]
+	s.LocalAddress = net.IP(value[2:6]).To4()
+	s.RemoteAddress = net.IP(value[6:10]).To4()
+	s.Label, err = decodeSegmentLabel(value[10:])
+	return err
+}
+
+func (s *SegmentTypeF) Serialize() ([]byte, error) {
+	buf := make([]byte, 10)
+	buf[0] = s.Flags
+	copy(buf[2:6], s.LocalAddress.To4())
+	copy(buf[6:10], s.RemoteAddress.To4())
+	return s.TunnelEncapSubTLV.Serialize(serializeSegmentLabel(buf, s.Label))
+}
+
+func (s *SegmentTypeF) String() string {
+	return fmt.Sprintf("{%s, Local: %s, Remote: %s, Label: %s}",
+		segmentFlagsString(s.Flags), s.LocalAddress, s.RemoteAddress, segmentLabelString(s.Label))
+}
+
+func (s *SegmentTypeF) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type          EncapSubTLVType `json:"type"`
+		VFlag         bool            `json:"v_flag"`
+		AFlag         bool            `json:"a_flag"`
+		SFlag         bool            `json:"s_flag"`
+		BFlag         bool            `json:"b_flag"`
+		LocalAddress  string          `json:"local_address"`
+		RemoteAddress string          `json:"remote_address"`
+		Label         *uint32         `json:"label,omitempty"`
+	}{
+		Type:          s.Type,
+		VFlag:         s.Flags&0x80 == 0x80,
+		AFlag:         s.Flags&0x40 == 0x40,
+		SFlag:         s.Flags&0x20 == 0x20,
+		BFlag:         s.Flags&0x10 == 0x10,
+		LocalAddress:  s.LocalAddress.String(),
+		RemoteAddress: s.RemoteAddress.String(),
+		Label:         segmentLabelJSON(s.Label),
+	})
+}
+
+// SegmentTypeG encodes an IPv6 link local adjacency with a local interface
+// identifier, an IPv6 local node address, a remote interface identifier, an
+// IPv6 remote node address and an optional SR-MPLS SID.
+type SegmentTypeG struct {
+	TunnelEncapSubTLV
+	Flags             uint8
+	LocalInterfaceID  uint32
+	LocalAddress      net.IP
+	RemoteInterfaceID uint32
+	RemoteAddress     net.IP
+	Label             *uint32
+}
+
+func (s *SegmentTypeG) DecodeFromBytes(data []byte) error {
+	value, err := s.TunnelEncapSubTLV.DecodeFromBytes(data)
+	if err != nil {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, err.Error())
+	}
+	if len(value) < 42 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Malformed BGP message")
+	}
+	s.Flags = value[0]
+	s.LocalInterfaceID = binary.BigEndian.Uint32(value[2:6])
+	s.LocalAddress = net.IP(value[6:22]).To16()
+	s.RemoteInterfaceID = binary.BigEndian.Uint32(value[22:26])
+	s.RemoteAddress = net.IP(value[26:42]).To16()
+	s.Label, err = decodeSegmentLabel(value[42:])
+	return err
+}
+
+func (s *SegmentTypeG) Serialize() ([]byte, error) {
+	buf := make([]byte, 42)
+	buf[0] = s.Flags
+	binary.BigEndian.PutUint32(buf[2:6], s.LocalInterfaceID)
+	copy(buf[6:22], s.LocalAddress.To16())
+	binary.BigEndian.PutUint32(buf[22:26], s.RemoteInterfaceID)
+	copy(buf[26:42], s.RemoteAddress.To16())
+	return s.TunnelEncapSubTLV.Serialize(serializeSegmentLabel(buf, s.Label))
+}
+
+func (s *SegmentTypeG) String() string {
+	return fmt.Sprintf("{%s, Local: %s%%%d, Remote: %s%%%d, Label: %s}",
+		segmentFlagsString(s.Flags), s.LocalAddress, s.LocalInterfaceID, s.RemoteAddress, s.RemoteInterfaceID, segmentLabelString(s.Label))
+}
+
+func (s *SegmentTypeG) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type              EncapSubTLVType `json:"type"`
+		VFlag             bool            `json:"v_flag"`
+		AFlag             bool            `json:"a_flag"`
+		SFlag             bool            `json:"s_flag"`
+		BFlag             bool            `json:"b_flag"`
+		LocalInterfaceID  uint32          `json:"local_interface_id"`
+		LocalAddress      string          `json:"local_address"`
+		RemoteInterfaceID uint32          `json:"remote_interface_id"`
+		RemoteAddress     string          `json:"remote_address"`
+		Label             *uint32         `json:"label,omitempty"`
+	}{
+		Type:              s.Type,
+		VFlag:             s.Flags&0x80 == 0x80,
+		AFlag:             s.Flags&0x40 == 0x40,
+		SFlag:             s.Flags&0x20 == 0x20,
+		BFlag:             s.Flags&0x10 == 0x10,
+		LocalInterfaceID:  s.LocalInterfaceID,
+		LocalAddress:      s.LocalAddress.String(),
+		RemoteInterfaceID: s.RemoteInterfaceID,
+		RemoteAddress:     s.RemoteAddress.String(),
+		Label:             segmentLabelJSON(s.Label),
+	})
+}
+
+// SegmentTypeH encodes an IPv6 adjacency local address, an IPv6 adjacency
+// remote address and an optional SR-MPLS SID.
+type SegmentTypeH struct {
+	TunnelEncapSubTLV
+	Flags         uint8
+	LocalAddress  net.IP
+	RemoteAddress net.IP
+	Label         *uint32
+}
+
+func (s *SegmentTypeH) DecodeFromBytes(data []byte) error {
+	value, err := s.TunnelEncapSubTLV.DecodeFromBytes(data)
+	if err != nil {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, err.Error())
+	}
+	if len(value) < 34 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Malformed BGP message")
+	}
+	s.Flags = value[0]
+	s.LocalAddress = net.IP(value[2:18]).To16()
+	s.RemoteAddress = net.IP(value[18:34]).To16()
+	s.Label, err = decodeSegmentLabel(value[34:])
+	return err
+}
+
+func (s *SegmentTypeH) Serialize() ([]byte, error) {
+	buf := make([]byte, 34)
+	buf[0] = s.Flags
+	copy(buf[2:18], s.LocalAddress.To16())
+	copy(buf[18:34], s.RemoteAddress.To16())
+	return s.TunnelEncapSubTLV.Serialize(serializeSegmentLabel(buf, s.Label))
+}
+
+func (s *SegmentTypeH) String() string {
+	return fmt.Sprintf("{%s, Local: %s, Remote: %s, Label: %s}",
+		segmentFlagsString(s.Flags), s.LocalAddress, s.RemoteAddress, segmentLabelString(s.Label))
+}
+
+func (s *SegmentTypeH) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type          EncapSubTLVType `json:"type"`
+		VFlag         bool            `json:"v_flag"`
+		AFlag         bool            `json:"a_flag"`
+		SFlag         bool            `json:"s_flag"`
+		BFlag         bool            `json:"b_flag"`
+		LocalAddress  string          `json:"local_address"`
+		RemoteAddress string          `json:"remote_address"`
+		Label         *uint32         `json:"label,omitempty"`
+	}{
+		Type:          s.Type,
+		VFlag:         s.Flags&0x80 == 0x80,
+		AFlag:         s.Flags&0x40 == 0x40,
+		SFlag:         s.Flags&0x20 == 0x20,
+		BFlag:         s.Flags&0x10 == 0x10,
+		LocalAddress:  s.LocalAddress.String(),
+		RemoteAddress: s.RemoteAddress.String(),
+		Label:         segmentLabelJSON(s.Label),
+	})
+}
+
+// SegmentTypeI encodes an IPv6 node address, SR Algorithm and an optional
+// SRv6 SID with its SRv6 Endpoint Behavior and SID Structure.
+type SegmentTypeI struct {
+	TunnelEncapSubTLV
+	Flags     uint8
+	Algorithm uint8
+	Address   net.IP
+	SID       []byte
+	SRv6EBS   *SRv6EndpointBehaviorStructure
+}
+
+func (s *SegmentTypeI) DecodeFromBytes(data []byte) error {
+	value, err := s.TunnelEncapSubTLV.DecodeFromBytes(data)
+	if err != nil {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, err.Error())
+	}
+	if len(value) < 18 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Malformed BGP message")
+	}
+	s.Flags = value[0]
+	s.Algorithm = value[1]
+	s.Address = net.IP(value[2:18]).To16()
+	s.SID, s.SRv6EBS, err = decodeSegmentSRv6SID(value[18:])
+	return err
+}
+
+func (s *SegmentTypeI) Serialize() ([]byte, error) {
+	buf := make([]byte, 18)
+	buf[0] = s.Flags
+	buf[1] = s.Algorithm
+	copy(buf[2:18], s.Address.To16())
+	return s.TunnelEncapSubTLV.Serialize(serializeSegmentSRv6SID(buf, s.SID, s.SRv6EBS))
+}
+
+func (s *SegmentTypeI) String() string {
+	return fmt.Sprintf("{%s, Algorithm: %d, Node: %s, Sid: %s}",
+		segmentFlagsString(s.Flags), s.Algorithm, s.Address, segmentSRv6SIDString(s.SID, s.SRv6EBS))
+}
+
+func (s *SegmentTypeI) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type      EncapSubTLVType                `json:"type"`
+		VFlag     bool                           `json:"v_flag"`
+		AFlag     bool                           `json:"a_flag"`
+		SFlag     bool                           `json:"s_flag"`
+		BFlag     bool                           `json:"b_flag"`
+		Algorithm uint8                          `json:"algorithm"`
+		Address   string                         `json:"address"`
+		Sid       string                         `json:"sid,omitempty"`
+		SRv6EBS   *SRv6EndpointBehaviorStructure `json:"endpointBehaviorStructure,omitempty"`
+	}{
+		Type:      s.Type,
+		VFlag:     s.Flags&0x80 == 0x80,
+		AFlag:     s.Flags&0x40 == 0x40,
+		SFlag:     s.Flags&0x20 == 0x20,
+		BFlag:     s.Flags&0x10 == 0x10,
+		Algorithm: s.Algorithm,
+		Address:   s.Address.String(),
+		Sid:       segmentSRv6SIDJSON(s.SID),
+		SRv6EBS:   s.SRv6EBS,
+	})
+}
+
+// SegmentTypeJ encodes an IPv6 link local adjacency with SR Algorithm, a
+// local interface identifier, an IPv6 local node address, a remote
+// interface identifier, an IPv6 remote node address and an optional SRv6
+// SID with its SRv6 Endpoint Behavior and SID Structure.
+type SegmentTypeJ struct {
+	TunnelEncapSubTLV
+	Flags             uint8
+	Algorithm         uint8
+	LocalInterfaceID  uint32
+	LocalAddress      net.IP
+	RemoteInterfaceID uint32
+	RemoteAddress     net.IP
+	SID               []byte
+	SRv6EBS           *SRv6EndpointBehaviorStructure
+}
+
+func (s *SegmentTypeJ) DecodeFromBytes(data []byte) error {
+	value, err := s.TunnelEncapSubTLV.DecodeFromBytes(data)
+	if err != nil {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, err.Error())
+	}
+	if len(value) < 42 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Malformed BGP message")
+	}
+	s.Flags = value[0]
+	s.Algorithm = value[1]
+	s.LocalInterfaceID = binary.BigEndian.Uint32(value[2:6])
+	s.LocalAddress = net.IP(value[6:22]).To16()
+	s.RemoteInterfaceID = binary.BigEndian.Uint32(value[22:26])
+	s.RemoteAddress = net.IP(value[26:42]).To16()
+	s.SID, s.SRv6EBS, err = decodeSegmentSRv6SID(value[42:])
+	return err
+}
+
+func (s *SegmentTypeJ) Serialize() ([]byte, error) {
+	buf := make([]byte, 42)
+	buf[0] = s.Flags
+	buf[1] = s.Algorithm
+	binary.BigEndian.PutUint32(buf[2:6], s.LocalInterfaceID)
+	copy(buf[6:22], s.LocalAddress.To16())
+	binary.BigEndian.PutUint32(buf[22:26], s.RemoteInterfaceID)
+	copy(buf[26:42], s.RemoteAddress.To16())
+	return s.TunnelEncapSubTLV.Serialize(serializeSegmentSRv6SID(buf, s.SID, s.SRv6EBS))
+}
+
+func (s *SegmentTypeJ) String() string {
+	return fmt.Sprintf("{%s, Algorithm: %d, Local: %s%%%d, Remote: %s%%%d, Sid: %s}",
+		segmentFlagsString(s.Flags), s.Algorithm, s.LocalAddress, s.LocalInterfaceID, s.RemoteAddress, s.RemoteInterfaceID,
+		segmentSRv6SIDString(s.SID, s.SRv6EBS))
+}
+
+func (s *SegmentTypeJ) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type              EncapSubTLVType                `json:"type"`
+		VFlag             bool                           `json:"v_flag"`
+		AFlag             bool                           `json:"a_flag"`
+		SFlag             bool                           `json:"s_flag"`
+		BFlag             bool                           `json:"b_flag"`
+		Algorithm         uint8                          `json:"algorithm"`
+		LocalInterfaceID  uint32                         `json:"local_interface_id"`
+		LocalAddress      string                         `json:"local_address"`
+		RemoteInterfaceID uint32                         `json:"remote_interface_id"`
+		RemoteAddress     string                         `json:"remote_address"`
+		Sid               string                         `json:"sid,omitempty"`
+		SRv6EBS           *SRv6EndpointBehaviorStructure `json:"endpointBehaviorStructure,omitempty"`
+	}{
+		Type:              s.Type,
+		VFlag:             s.Flags&0x80 == 0x80,
+		AFlag:             s.Flags&0x40 == 0x40,
+		SFlag:             s.Flags&0x20 == 0x20,
+		BFlag:             s.Flags&0x10 == 0x10,
+		Algorithm:         s.Algorithm,
+		LocalInterfaceID:  s.LocalInterfaceID,
+		LocalAddress:      s.LocalAddress.String(),
+		RemoteInterfaceID: s.RemoteInterfaceID,
+		RemoteAddress:     s.RemoteAddress.String(),
+		Sid:               segmentSRv6SIDJSON(s.SID),
+		SRv6EBS:           s.SRv6EBS,
+	})
+}
+
+// SegmentTypeK encodes an IPv6 adjacency local address, an IPv6 adjacency
+// remote address, SR Algorithm and an optional SRv6 SID with its SRv6
+// Endpoint Behavior and SID Structure.
+type SegmentTypeK struct {
+	TunnelEncapSubTLV
+	Flags         uint8
+	Algorithm     uint8
+	LocalAddress  net.IP
+	RemoteAddress net.IP
+	SID           []byte
+	SRv6EBS       *SRv6EndpointBehaviorStructure
+}
+
+func (s *SegmentTypeK) DecodeFromBytes(data []byte) error {
+	value, err := s.TunnelEncapSubTLV.DecodeFromBytes(data)
+	if err != nil {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, err.Error())
+	}
+	if len(value) < 34 {
+		return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, "Malformed BGP message")
+	}
+	s.Flags = value[0]
+	s.Algorithm = value[1]
+	s.LocalAddress = net.IP(value[2:18]).To16()
+	s.RemoteAddress = net.IP(value[18:34]).To16()
+	s.SID, s.SRv6EBS, err = decodeSegmentSRv6SID(value[34:])
+	return err
+}
+
+func (s *SegmentTypeK) Serialize() ([]byte, error) {
+	buf := make([]byte, 34)
+	buf[0] = s.Flags
+	buf[1] = s.Algorithm
+	copy(buf[2:18], s.LocalAddress.To16())
+	copy(buf[18:34], s.RemoteAddress.To16())
+	return s.TunnelEncapSubTLV.Serialize(serializeSegmentSRv6SID(buf, s.SID, s.SRv6EBS))
+}
+
+func (s *SegmentTypeK) String() string {
+	return fmt.Sprintf("{%s, Algorithm: %d, Local: %s, Remote: %s, Sid: %s}",
+		segmentFlagsString(s.Flags), s.Algorithm, s.LocalAddress, s.RemoteAddress, segmentSRv6SIDString(s.SID, s.SRv6EBS))
+}
+
+func (s *SegmentTypeK) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type          EncapSubTLVType                `json:"type"`
+		VFlag         bool                           `json:"v_flag"`
+		AFlag         bool                           `json:"a_flag"`
+		SFlag         bool                           `json:"s_flag"`
+		BFlag         bool                           `json:"b_flag"`
+		Algorithm     uint8                          `json:"algorithm"`
+		LocalAddress  string                         `json:"local_address"`
+		RemoteAddress string                         `json:"remote_address"`
+		Sid           string                         `json:"sid,omitempty"`
+		SRv6EBS       *SRv6EndpointBehaviorStructure `json:"endpointBehaviorStructure,omitempty"`
+	}{
+		Type:          s.Type,
+		VFlag:         s.Flags&0x80 == 0x80,
+		AFlag:         s.Flags&0x40 == 0x40,
+		SFlag:         s.Flags&0x20 == 0x20,
+		BFlag:         s.Flags&0x10 == 0x10,
+		Algorithm:     s.Algorithm,
+		LocalAddress:  s.LocalAddress.String(),
+		RemoteAddress: s.RemoteAddress.String(),
+		Sid:           segmentSRv6SIDJSON(s.SID),
+		SRv6EBS:       s.SRv6EBS,
+	})
+}
+
 const (
 	// SegmentListSubTLVWeight defines code for Segment List's Weight sub-TLV
 	SegmentListSubTLVWeight = 9
@@ -812,6 +1532,31 @@ type TunnelEncapSubTLVSRSegmentList struct {
 	Segments []TunnelEncapSubTLVInterface
 }
 
+// NewTunnelEncapSubTLVSRSegmentList returns the segment list with the
+// weight sub-TLV.
+func NewTunnelEncapSubTLVSRSegmentList(weight uint32, segments []TunnelEncapSubTLVInterface) *TunnelEncapSubTLVSRSegmentList {
+	w := &SegmentListWeight{
+		TunnelEncapSubTLV: TunnelEncapSubTLV{
+			Type:   SegmentListSubTLVWeight,
+			Length: 6,
+		},
+		Weight: weight,
+	}
+	// the reserved byte, the weight and the segments
+	l := 1 + w.Len()
+	for _, s := range segments {
+		l += s.Len()
+	}
+	return &TunnelEncapSubTLVSRSegmentList{
+		TunnelEncapSubTLV: TunnelEncapSubTLV{
+			Type:   ENCAP_SUBTLV_TYPE_SRSEGMENT_LIST,
+			Length: uint16(l),
+		},
+		Weight:   w,
+		Segments: segments,
+	}
+}
+
 func (t *TunnelEncapSubTLVSRSegmentList) DecodeFromBytes(data []byte) error {
 	value, err := t.TunnelEncapSubTLV.DecodeFromBytes(data)
 	if err != nil {
@@ -837,37 +1582,33 @@ func (t *TunnelEncapSubTLVSRSegmentList) DecodeFromBytes(data []byte) error {
 			continue
 		case TypeA:
 			segment = &SegmentTypeA{}
-			if err := segment.DecodeFromBytes(value); err != nil {
-				return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, err.Error())
-			}
 		case TypeB:
 			segment = &SegmentTypeB{}
-			if err := segment.DecodeFromBytes(value); err != nil {
-				return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, err.Error())
-			}
 		case TypeC:
-			fallthrough
+			segment = &SegmentTypeC{}
 		case TypeD:
-			fallthrough
+			segment = &SegmentTypeD{}
 		case TypeE:
-			fallthrough
+			segment = &SegmentTypeE{}
 		case TypeF:
-			fallthrough
+			segment = &SegmentTypeF{}
 		case TypeG:
-			fallthrough
+			segment = &SegmentTypeG{}
 		case TypeH:
-			fallthrough
+			segment = &SegmentTypeH{}
 		case TypeI:
-			fallthrough
+			segment = &SegmentTypeI{}
 		case TypeJ:
-			fallthrough
+			segment = &SegmentTypeJ{}
 		case TypeK:
-			msg := fmt.Sprintf("Invalid SR Policy Segment SubTLV %d is not yet supported", value[0])
-			return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, msg)
+			segment = &SegmentTypeK{}
 		default:
 			msg := fmt.Sprintf("Invalid SR Policy Segment List SubTLV %d", value[0])
 			return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, msg)
 		}
+		if err := segment.DecodeFromBytes(value); err != nil {
+			return NewMessageError(BGP_ERROR_UPDATE_MESSAGE_ERROR, BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST, nil, err.Error())
+		}
 		segments = append(segments, segment)
 		p += segment.Len()
 		value = value[segment.Len():]
@@ -89,6 +89,7 @@ func TestBindingSIDRoundTrip(t *testing.T) {
 }
 
 func TestSegmentListRoundTrip(t *testing.T) {
+	label := uint32(16001 << 12)
 	tests := []struct {
 		name  string
 		input *TunnelEncapSubTLVSRSegmentList
@@ -253,8 +254,88 @@ func TestSegmentListRoundTrip(t *testing.T) {
 			},
 			fail: false,
 		},
+		{
+			name: "type C to H segments with and without SR-MPLS SID",
+			input: &TunnelEncapSubTLVSRSegmentList{
+				TunnelEncapSubTLV: TunnelEncapSubTLV{Type: ENCAP_SUBTLV_TYPE_SRSEGMENT_LIST},
+				Segments: []TunnelEncapSubTLVInterface{
+					&SegmentTypeC{
+						TunnelEncapSubTLV: TunnelEncapSubTLV{Type: EncapSubTLVType(TypeC)},
+						Flags:             0x40,
+						Algorithm:         128,
+						Address:           net.ParseIP("10.0.0.1").To4(),
+						Label:             &label,
+					},
+					&SegmentTypeD{
+						TunnelEncapSubTLV: TunnelEncapSubTLV{Type: EncapSubTLVType(TypeD)},
+						Address:           net.ParseIP("2001:1::1").To16(),
+					},
+					&SegmentTypeE{
+						TunnelEncapSubTLV: TunnelEncapSubTLV{Type: EncapSubTLVType(TypeE)},
+						LocalInterfaceID:  10,
+						Address:           net.ParseIP("10.0.0.1").To4(),
+						Label:             &label,
+					},
+					&SegmentTypeF{
+						TunnelEncapSubTLV: TunnelEncapSubTLV{Type: EncapSubTLVType(TypeF)},
+						LocalAddress:      net.ParseIP("10.0.0.1").To4(),
+						RemoteAddress:     net.ParseIP("10.0.0.2").To4(),
+					},
+					&SegmentTypeG{
+						TunnelEncapSubTLV: TunnelEncapSubTLV{Type: EncapSubTLVType(TypeG)},
+						LocalInterfaceID:  10,
+						LocalAddress:      net.ParseIP("fe80::1").To16(),
+						RemoteInterfaceID: 20,
+						RemoteAddress:     net.ParseIP("fe80::2").To16(),
+						Label:             &label,
+					},
+					&SegmentTypeH{
+						TunnelEncapSubTLV: TunnelEncapSubTLV{Type: EncapSubTLVType(TypeH)},
+						LocalAddress:      net.ParseIP("2001:1::1").To16(),
+						RemoteAddress:     net.ParseIP("2001:1::2").To16(),
+					},
+				},
+			},
+			fail: false,
+		},
+		{
+			name: "type I to K segments with and without SRv6 SID",
+			input: &TunnelEncapSubTLVSRSegmentList{
+				TunnelEncapSubTLV: TunnelEncapSubTLV{Type: ENCAP_SUBTLV_TYPE_SRSEGMENT_LIST},
+				Segments: []TunnelEncapSubTLVInterface{
+					&SegmentTypeI{
+						TunnelEncapSubTLV: TunnelEncapSubTLV{Type: EncapSubTLVType(TypeI)},
+						Address:           net.ParseIP("2001:1::1").To16(),
+					},
+					&SegmentTypeJ{
+						TunnelEncapSubTLV: TunnelEncapSubTLV{Type: EncapSubTLVType(TypeJ)},
+						Flags:             0x50,
+						Algorithm:         128,
+						LocalInterfaceID:  10,
+						LocalAddress:      net.ParseIP("fe80::1").To16(),
+						RemoteInterfaceID: 20,
+						RemoteAddress:     net.ParseIP("fe80::2").To16(),
+						SID:               net.ParseIP("2001:db8::1").To16(),
+						SRv6EBS: &SRv6EndpointBehaviorStructure{
+							Behavior: 39,
+							BlockLen: 32,
+							NodeLen:  16,
+							FuncLen:  16,
+						},
+					},
+					&SegmentTypeK{
+						TunnelEncapSubTLV: TunnelEncapSubTLV{Type: EncapSubTLVType(TypeK)},
+						LocalAddress:      net.ParseIP("2001:1::1").To16(),
+						RemoteAddress:     net.ParseIP("2001:1::2").To16(),
+						SID:               net.ParseIP("2001:db8::2").To16(),
+					},
+				},
+			},
+			fail: false,
+		},
 	}
 	for _, tt := range tests {
+
 		t.Run(tt.name, func(t *testing.T) {
 			b, err := tt.input.Serialize()
 			if err != nil && !tt.fail {
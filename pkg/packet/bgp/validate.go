@@ -30,13 +30,25 @@ func ValidateUpdateMsg(m *BGPUpdate, rfs map[RouteFamily]BGPAddPathMode, isEBGP
 		if _, ok := seen[a.GetType()]; !ok {
 			seen[a.GetType()] = a
 			newAttrs = append(newAttrs, a)
+			if a.GetType() == BGP_ATTR_TYPE_NEXT_HOP && len(m.NLRI) == 0 {
+				// RFC 4760 3. NEXT_HOP is ignored without the NLRI field.
+				continue
+			}
 			//check specific path attribute
 			ok, err := ValidateAttribute(a, rfs, isEBGP, isConfed, loopbackNextHopAllowed)
 			if !ok {
 				msgErr := err.(*MessageError)
+				if msgErr.ErrorAttribute == nil {
+					attr := a
+					msgErr.ErrorAttribute = &attr
+				}
 				if msgErr.ErrorHandling == ERROR_HANDLING_SESSION_RESET {
 					return false, err
-				} else if msgErr.Stronger(strongestError) {
+				}
+				if msgErr.ErrorHandling == ERROR_HANDLING_ATTRIBUTE_DISCARD {
+					newAttrs = newAttrs[:len(newAttrs)-1]
+				}
+				if msgErr.Stronger(strongestError) {
 					strongestError = err
 				}
 			}
@@ -89,6 +101,7 @@ func ValidateAttribute(a PathAttributeInterface, rfs map[RouteFamily]BGPAddPathM
 	eSubCodeBadNextHop := uint8(BGP_ERROR_SUB_INVALID_NEXT_HOP_ATTRIBUTE)
 	eSubCodeUnknown := uint8(BGP_ERROR_SUB_UNRECOGNIZED_WELL_KNOWN_ATTRIBUTE)
 	eSubCodeMalformedAspath := uint8(BGP_ERROR_SUB_MALFORMED_AS_PATH)
+	eSubCodeAttrList := uint8(BGP_ERROR_SUB_MALFORMED_ATTRIBUTE_LIST)
 
 	checkPrefix := func(l []AddrPrefixInterface) error {
 		for _, prefix := range l {
@@ -180,8 +193,12 @@ func ValidateAttribute(a PathAttributeInterface, rfs map[RouteFamily]BGPAddPathM
 	case *PathAttributeAsPath:
 		if isEBGP {
 			if isConfed {
-				if segType := p.Value[0].GetType(); segType != BGP_ASPATH_ATTR_TYPE_CONFED_SEQ {
-					return false, NewMessageError(eCode, eSubCodeMalformedAspath, nil, fmt.Sprintf("segment type is not confederation seq (%d)", segType))
+				if len(p.Value) > 0 {
+					if segType := p.Value[0].GetType(); segType != BGP_ASPATH_ATTR_TYPE_CONFED_SEQ {
+						// RFC 7606 7.2.
+						return false, NewMessageErrorWithErrorHandling(
+							eCode, eSubCodeMalformedAspath, nil, getErrorHandlingFromPathAttribute(p.GetType()), nil, fmt.Sprintf("segment type is not confederation seq (%d)", segType))
+					}
 				}
 			} else {
 				for _, param := range p.Value {
@@ -197,6 +214,16 @@ func ValidateAttribute(a PathAttributeInterface, rfs map[RouteFamily]BGPAddPathM
 				}
 			}
 		}
+	case *PathAttributeLocalPref, *PathAttributeOriginatorId, *PathAttributeClusterList:
+		// RFC 7606 7.5, 7.9 and 7.10. These are discarded when received
+		// from an external peer.
+		if isEBGP && !isConfed {
+			eMsg := fmt.Sprintf("%s received from external peer", a.GetType())
+			e := NewMessageErrorWithErrorHandling(eCode, eSubCodeAttrList, nil, ERROR_HANDLING_ATTRIBUTE_DISCARD, nil, eMsg)
+			if e.(*MessageError).Stronger(strongestError) {
+				strongestError = e
+			}
+		}
 	case *PathAttributeLargeCommunities:
 		uniq := make([]*LargeCommunity, 0, len(p.Values))
 		for _, x := range p.Values {
@@ -303,8 +330,8 @@ func validateAsPathValueBytes(data []byte) (bool, error) {
 	return false, NewMessageError(eCode, eSubCode, nil, "can't parse AS_PATH")
 }
 
-func ValidateBGPMessage(m *BGPMessage) error {
-	if m.Header.Len > BGP_MAX_MESSAGE_LENGTH {
+func ValidateBGPMessage(m *BGPMessage, options ...*MarshallingOption) error {
+	if int(m.Header.Len) > GetMaxMessageLength(m.Header.Type, options) {
 		buf := make([]byte, 2)
 		binary.BigEndian.PutUint16(buf, m.Header.Len)
 		return NewMessageError(BGP_ERROR_MESSAGE_HEADER_ERROR, BGP_ERROR_SUB_BAD_MESSAGE_LENGTH, buf, "too long length")
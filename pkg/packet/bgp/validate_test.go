@@ -359,6 +359,7 @@ func Test_Validate_aspath(t *testing.T) {
 	e = err.(*MessageError)
 	assert.Equal(uint8(BGP_ERROR_UPDATE_MESSAGE_ERROR), e.TypeCode)
 	assert.Equal(uint8(BGP_ERROR_SUB_MALFORMED_AS_PATH), e.SubTypeCode)
+	assert.Equal(ERROR_HANDLING_TREAT_AS_WITHDRAW, e.ErrorHandling)
 	assert.Nil(e.Data)
 
 	// CONFED_SEQ
@@ -390,6 +391,72 @@ func Test_Validate_aspath(t *testing.T) {
 	assert.Equal(true, res)
 }
 
+func Test_Validate_ebgp_discard(t *testing.T) {
+	assert := assert.New(t)
+	rfs := map[RouteFamily]BGPAddPathMode{RF_IPv4_UC: BGP_ADD_PATH_BOTH}
+
+	for _, attr := range []PathAttributeInterface{
+		NewPathAttributeLocalPref(100),
+		NewPathAttributeOriginatorId("10.0.0.1"),
+		NewPathAttributeClusterList([]string{"10.0.0.1"}),
+	} {
+		message := bgpupdate().Body.(*BGPUpdate)
+		message.PathAttributes = append(message.PathAttributes, attr)
+		res, err := ValidateUpdateMsg(message, rfs, false, false, false)
+		assert.True(res)
+		assert.NoError(err)
+		assert.Len(message.PathAttributes, 4)
+
+		// discarded when received from an external peer
+		res, err = ValidateUpdateMsg(message, rfs, true, false, false)
+		assert.False(res)
+		e := err.(*MessageError)
+		assert.Equal(ERROR_HANDLING_ATTRIBUTE_DISCARD, e.ErrorHandling)
+		assert.Equal(attr.GetType(), (*e.ErrorAttribute).GetType())
+		assert.Len(message.PathAttributes, 3)
+	}
+
+	// allowed in the confederation
+	message := bgpupdate().Body.(*BGPUpdate)
+	message.PathAttributes = []PathAttributeInterface{
+		NewPathAttributeOrigin(1),
+		NewPathAttributeAsPath([]AsPathParamInterface{
+			NewAsPathParam(BGP_ASPATH_ATTR_TYPE_CONFED_SEQ, []uint16{65001}),
+		}),
+		NewPathAttributeNextHop("192.168.1.1"),
+		NewPathAttributeLocalPref(100),
+	}
+	res, err := ValidateUpdateMsg(message, rfs, true, true, false)
+	assert.True(res)
+	assert.NoError(err)
+	assert.Len(message.PathAttributes, 4)
+}
+
+func Test_Validate_nexthop_without_nlri(t *testing.T) {
+	assert := assert.New(t)
+	message := bgpupdateV6().Body.(*BGPUpdate)
+	message.PathAttributes = append(message.PathAttributes, NewPathAttributeNextHop("0.0.0.1"))
+
+	res, err := ValidateUpdateMsg(message, map[RouteFamily]BGPAddPathMode{RF_IPv6_UC: BGP_ADD_PATH_BOTH}, false, false, false)
+	assert.True(res)
+	assert.NoError(err)
+}
+
+func Test_ValidateBGPMessage_extended(t *testing.T) {
+	assert := assert.New(t)
+	options := &MarshallingOption{ExtendedMessage: true}
+
+	update := &BGPMessage{Header: BGPHeader{Len: 8192, Type: BGP_MSG_UPDATE}}
+	assert.Error(ValidateBGPMessage(update))
+	assert.NoError(ValidateBGPMessage(update, options))
+
+	// OPEN and KEEPALIVE are never extended
+	open := &BGPMessage{Header: BGPHeader{Len: 8192, Type: BGP_MSG_OPEN}}
+	assert.Error(ValidateBGPMessage(open, options))
+	keepalive := &BGPMessage{Header: BGPHeader{Len: 8192, Type: BGP_MSG_KEEPALIVE}}
+	assert.Error(ValidateBGPMessage(keepalive, options))
+}
+
 func Test_Validate_flowspec(t *testing.T) {
 	assert := assert.New(t)
 	cmp := make([]FlowSpecComponentInterface, 0)
@@ -946,6 +946,7 @@ func NewBMPRouteMirroring(p BMPPeerHeader, info []BMPRouteMirrTLVInterface) *BMP
 }
 
 func (body *BMPRouteMirroring) ParseBody(msg *BMPMessage, data []byte, options ...*bgp.MarshallingOption) error {
+	errored := false
 	for len(data) >= 4 {
 		tl := BMPRouteMirrTLV{
 			Type:   binary.BigEndian.Uint16(data[0:2]),
@@ -965,7 +966,15 @@ func (body *BMPRouteMirroring) ParseBody(msg *BMPMessage, data []byte, options .
 			tlv = &BMPRouteMirrTLVUnknown{BMPRouteMirrTLV: tl}
 		}
 		if err := tlv.ParseValue(data); err != nil {
-			return err
+			if tl.Type != BMP_ROUTE_MIRRORING_TLV_TYPE_BGP_MSG || !errored {
+				return err
+			}
+			// the errored PDU is kept as it is
+			tlv = &BMPRouteMirrTLVUnknown{BMPRouteMirrTLV: tl}
+			tlv.ParseValue(data)
+		}
+		if t, ok := tlv.(*BMPRouteMirrTLV16); ok && t.Type == BMP_ROUTE_MIRRORING_TLV_TYPE_INFO && t.Value == BMP_ROUTE_MIRRORING_INFO_ERR_PDU {
+			errored = true
 		}
 		body.Info = append(body.Info, tlv)
 		data = data[tl.Length:]
@@ -16,6 +16,7 @@
 package bmp
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
@@ -147,6 +148,32 @@ func Test_RouteMirroring(t *testing.T) {
 	verify(t, s0)
 }
 
+func Test_RouteMirroringErroredPDU(t *testing.T) {
+	// an UPDATE message whose withdrawn routes length is too long
+	pdu := append(bytes.Repeat([]byte{0xff}, 16), 0x00, 0x17, 0x02, 0x00, 0x05, 0x00, 0x00)
+	p0 := NewBMPPeerHeader(0, 0, 1000, "10.0.0.1", 70000, "10.0.0.2", 1)
+	s0 := NewBMPRouteMirroring(
+		*p0,
+		[]BMPRouteMirrTLVInterface{
+			NewBMPRouteMirrTLV16(BMP_ROUTE_MIRRORING_TLV_TYPE_INFO, BMP_ROUTE_MIRRORING_INFO_ERR_PDU),
+			NewBMPRouteMirrTLVUnknown(BMP_ROUTE_MIRRORING_TLV_TYPE_BGP_MSG, pdu),
+		},
+	)
+	verify(t, s0)
+
+	// the BGP message has to be parsed unless it's flagged as errored
+	s1 := NewBMPRouteMirroring(
+		*p0,
+		[]BMPRouteMirrTLVInterface{
+			NewBMPRouteMirrTLVUnknown(BMP_ROUTE_MIRRORING_TLV_TYPE_BGP_MSG, pdu),
+		},
+	)
+	buf, err := s1.Serialize()
+	require.NoError(t, err)
+	_, err = ParseBMPMessage(buf)
+	assert.Error(t, err)
+}
+
 func Test_BogusHeader(t *testing.T) {
 	h, err := ParseBMPMessage(make([]byte, 10))
 	assert.Nil(t, h)
@@ -366,7 +366,7 @@ type RibEntry struct {
 var errNotAllRibEntryBytesAvailable = errors.New("not all RibEntry bytes are available")
 
 func (e *RibEntry) DecodeFromBytes(data []byte, prefix ...bgp.AddrPrefixInterface) ([]byte, error) {
-	if len(data) < 8 {
+	if len(data) < 8 || e.isAddPath && len(data) < 12 {
 		return nil, errNotAllRibEntryBytesAvailable
 	}
 	e.PeerIndex = binary.BigEndian.Uint16(data[:2])
@@ -810,6 +810,24 @@ type BGP4MPMessage struct {
 	isAddPath         bool
 }
 
+// addPathOption is the marshalling option of the BGP messages in the
+// *_ADDPATH subtypes, whose NLRIs of any family have the path
+// identifiers (RFC 8050).
+var addPathOption = func() *bgp.MarshallingOption {
+	m := make(map[bgp.RouteFamily]bgp.BGPAddPathMode, len(bgp.AddressFamilyValueMap))
+	for _, rf := range bgp.AddressFamilyValueMap {
+		m[rf] = bgp.BGP_ADD_PATH_BOTH
+	}
+	return &bgp.MarshallingOption{AddPath: m}
+}()
+
+func (m *BGP4MPMessage) options() []*bgp.MarshallingOption {
+	if m.isAddPath {
+		return []*bgp.MarshallingOption{addPathOption}
+	}
+	return nil
+}
+
 func (m *BGP4MPMessage) DecodeFromBytes(data []byte) error {
 	rest, err := m.decodeFromBytes(data)
 	if err != nil {
@@ -820,7 +838,7 @@ func (m *BGP4MPMessage) DecodeFromBytes(data []byte) error {
 		return fmt.Errorf("not all BGP4MPMessageAS4 bytes available")
 	}
 
-	msg, err := bgp.ParseBGPMessage(rest)
+	msg, err := bgp.ParseBGPMessage(rest, m.options()...)
 	if err != nil {
 		return err
 	}
@@ -836,13 +854,19 @@ func (m *BGP4MPMessage) Serialize() ([]byte, error) {
 	if m.BGPMessagePayload != nil {
 		return append(buf, m.BGPMessagePayload...), nil
 	}
-	bbuf, err := m.BGPMessage.Serialize()
+	bbuf, err := m.BGPMessage.Serialize(m.options()...)
 	if err != nil {
 		return nil, err
 	}
 	return append(buf, bbuf...), nil
 }
 
+// IsLocal returns true if the BGP message was sent by the local
+// speaker.
+func (m *BGP4MPMessage) IsLocal() bool {
+	return m.isLocal
+}
+
 func NewBGP4MPMessage(peeras, localas uint32, intfindex uint16, peerip, localip string, isAS4 bool, msg *bgp.BGPMessage) *BGP4MPMessage {
 	header, _ := newBGP4MPHeader(peeras, localas, intfindex, peerip, localip, isAS4)
 	return &BGP4MPMessage{
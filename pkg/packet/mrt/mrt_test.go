@@ -277,6 +277,39 @@ func TestMrtBgp4mpMessage(t *testing.T) {
 	assert.Equal(t, reflect.DeepEqual(m1, m2), true)
 }
 
+func TestMrtBgp4mpMessageAddPath(t *testing.T) {
+	p1 := bgp.NewIPAddrPrefix(24, "10.0.0.0")
+	p1.SetPathLocalIdentifier(10)
+	p2 := bgp.NewIPv6AddrPrefix(64, "2001:db8::")
+	p2.SetPathLocalIdentifier(20)
+	msg := bgp.NewBGPUpdateMessage(nil, []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeNextHop("192.168.0.1"),
+		bgp.NewPathAttributeMpReachNLRI("2001:db8::1", []bgp.AddrPrefixInterface{p2}),
+	}, []*bgp.IPAddrPrefix{p1})
+	m1 := NewBGP4MPMessageAddPath(65000, 65001, 1, "192.168.0.1", "192.168.0.2", true, msg)
+	mm, err := NewMRTMessage(1234, BGP4MP, MESSAGE_AS4_ADDPATH, m1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b1, err := mm.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &MRTHeader{}
+	if err := h.DecodeFromBytes(b1); err != nil {
+		t.Fatal(err)
+	}
+	m2, err := ParseMRTBody(h, b1[MRT_COMMON_HEADER_LEN:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := m2.Body.(*BGP4MPMessage).BGPMessage.Body.(*bgp.BGPUpdate)
+	assert.Equal(t, uint32(10), u.NLRI[0].PathIdentifier())
+	reach := u.PathAttributes[2].(*bgp.PathAttributeMpReachNLRI)
+	assert.Equal(t, uint32(20), reach.Value[0].PathIdentifier())
+}
+
 func TestMrtSplit(t *testing.T) {
 	var b bytes.Buffer
 	numwrite, numread := 10, 0
@@ -22,7 +22,11 @@ import (
 )
 
 const (
-	RPKI_DEFAULT_PORT = 323
+	RPKI_DEFAULT_PORT     = 323
+	RPKI_DEFAULT_TLS_PORT = 324
+	RPKI_DEFAULT_SSH_PORT = 22
+	// SSH subsystem name of the RTR protocol (RFC 6810 7.1)
+	RPKI_SSH_SUBSYSTEM = "rpki-rtr"
 )
 
 const (
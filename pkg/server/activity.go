@@ -0,0 +1,162 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"sort"
+	"time"
+
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+const (
+	activitySlotDuration  = time.Minute
+	activitySlots         = 60
+	defaultActivityWindow = 5 * time.Minute
+	defaultActivityLimit  = 10
+)
+
+type activityKey struct {
+	neighbor string
+	family   bgp.RouteFamily
+	prefix   string
+}
+
+type activitySlot struct {
+	start  time.Time
+	counts map[activityKey]*table.UpdateStatistics
+}
+
+type prefixActivityEntry struct {
+	activityKey
+	table.UpdateStatistics
+}
+
+func (e *prefixActivityEntry) total() uint64 {
+	return e.Updates + e.Withdrawals
+}
+
+// prefixActivity counts the changes of the prefixes received from the
+// peers in the slots of the sliding window, up to an hour. Not to count
+// the whole tables received when the sessions are established, the
+// announcements of a prefix are counted only after the other changes of
+// the prefix in the window.
+type prefixActivity struct {
+	slots [activitySlots]*activitySlot
+	// the last time of the changes other than the announcements
+	last map[activityKey]time.Time
+}
+
+func newPrefixActivity() *prefixActivity {
+	return &prefixActivity{
+		last: make(map[activityKey]time.Time),
+	}
+}
+
+func (a *prefixActivity) slot(now time.Time) *activitySlot {
+	start := now.Truncate(activitySlotDuration)
+	i := int(start.Unix()/int64(activitySlotDuration/time.Second)) % activitySlots
+	if a.slots[i] == nil || !a.slots[i].start.Equal(start) {
+		a.slots[i] = &activitySlot{
+			start:  start,
+			counts: make(map[activityKey]*table.UpdateStatistics),
+		}
+		// forget the prefixes which haven't changed in the retention
+		for k, t := range a.last {
+			if now.Sub(t) > activitySlots*activitySlotDuration {
+				delete(a.last, k)
+			}
+		}
+	}
+	return a.slots[i]
+}
+
+func (a *prefixActivity) record(neighbor string, pathList []*table.Path, types []table.UpdateType, now time.Time) {
+	var slot *activitySlot
+	for i, path := range pathList {
+		if types[i] == table.UPDATE_TYPE_NONE {
+			continue
+		}
+		k := activityKey{
+			neighbor: neighbor,
+			family:   path.GetRouteFamily(),
+			prefix:   path.GetNlri().String(),
+		}
+		if types[i] == table.UPDATE_TYPE_ANNOUNCEMENT {
+			if _, y := a.last[k]; !y {
+				continue
+			}
+		} else {
+			a.last[k] = now
+		}
+		if slot == nil {
+			slot = a.slot(now)
+		}
+		c, y := slot.counts[k]
+		if !y {
+			c = &table.UpdateStatistics{}
+			slot.counts[k] = c
+		}
+		c.Add(types[i])
+	}
+}
+
+// top returns the limit most active prefixes which match in the window,
+// sorted by the number of the updates and withdrawals.
+func (a *prefixActivity) top(window time.Duration, limit int, match func(activityKey) bool, now time.Time) []*prefixActivityEntry {
+	// the window is rounded up to the slots
+	n := (window + activitySlotDuration - 1) / activitySlotDuration
+	from := now.Truncate(activitySlotDuration).Add(-(n - 1) * activitySlotDuration)
+	m := make(map[activityKey]*prefixActivityEntry)
+	for _, slot := range a.slots {
+		if slot == nil || slot.start.Before(from) || slot.start.After(now) {
+			continue
+		}
+		for k, c := range slot.counts {
+			if !match(k) {
+				continue
+			}
+			e, y := m[k]
+			if !y {
+				e = &prefixActivityEntry{activityKey: k}
+				m[k] = e
+			}
+			e.Updates += c.Updates
+			e.Withdrawals += c.Withdrawals
+			e.Duplicates += c.Duplicates
+			e.AttributeChanges += c.AttributeChanges
+			e.Flaps += c.Flaps
+		}
+	}
+	l := make([]*prefixActivityEntry, 0, len(m))
+	for _, e := range m {
+		l = append(l, e)
+	}
+	sort.Slice(l, func(i, j int) bool {
+		if l[i].total() != l[j].total() {
+			return l[i].total() > l[j].total()
+		}
+		if l[i].prefix != l[j].prefix {
+			return l[i].prefix < l[j].prefix
+		}
+		return l[i].neighbor < l[j].neighbor
+	})
+	if len(l) > limit {
+		l = l[:limit]
+	}
+	return l
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func TestPrefixActivity(t *testing.T) {
+	assert := assert.New(t)
+
+	path := func(prefix string) *table.Path {
+		return table.NewPath(&table.PeerInfo{}, bgp.NewIPAddrPrefix(24, prefix), false, []bgp.PathAttributeInterface{bgp.NewPathAttributeOrigin(0)}, time.Now(), false)
+	}
+	p1 := path("10.0.1.0")
+	p2 := path("10.0.2.0")
+	all := func(activityKey) bool { return true }
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	a := newPrefixActivity()
+	// the announcements of the initial table aren't counted
+	a.record("10.0.0.1", []*table.Path{p1, p2}, []table.UpdateType{table.UPDATE_TYPE_ANNOUNCEMENT, table.UPDATE_TYPE_ANNOUNCEMENT}, now)
+	assert.Empty(a.top(defaultActivityWindow, defaultActivityLimit, all, now))
+
+	// 10.0.1.0/24 flaps twice and 10.0.2.0/24 changes the attributes once
+	for i := 0; i < 2; i++ {
+		now = now.Add(time.Minute)
+		a.record("10.0.0.1", []*table.Path{p1}, []table.UpdateType{table.UPDATE_TYPE_FLAP}, now)
+		a.record("10.0.0.1", []*table.Path{p1}, []table.UpdateType{table.UPDATE_TYPE_ANNOUNCEMENT}, now)
+	}
+	a.record("10.0.0.2", []*table.Path{p2}, []table.UpdateType{table.UPDATE_TYPE_ATTRIBUTE_CHANGE}, now)
+
+	l := a.top(defaultActivityWindow, defaultActivityLimit, all, now)
+	assert.Len(l, 2)
+	assert.Equal("10.0.1.0/24", l[0].prefix)
+	assert.Equal("10.0.0.1", l[0].neighbor)
+	assert.Equal(table.UpdateStatistics{Updates: 2, Withdrawals: 2, Flaps: 2}, l[0].UpdateStatistics)
+	assert.Equal("10.0.2.0/24", l[1].prefix)
+	assert.Equal(table.UpdateStatistics{Updates: 1, AttributeChanges: 1}, l[1].UpdateStatistics)
+
+	assert.Len(a.top(defaultActivityWindow, 1, all, now), 1)
+	l = a.top(defaultActivityWindow, defaultActivityLimit, func(k activityKey) bool { return k.neighbor == "10.0.0.2" }, now)
+	assert.Len(l, 1)
+	assert.Equal("10.0.2.0/24", l[0].prefix)
+
+	// only the changes in the last minute
+	l = a.top(30*time.Second, defaultActivityLimit, all, now)
+	assert.Equal(uint64(1), l[0].Flaps)
+
+	// the changes out of the window aren't counted
+	now = now.Add(10 * time.Minute)
+	assert.Empty(a.top(defaultActivityWindow, defaultActivityLimit, all, now))
+
+	// the slots and the prefixes are recycled after the retention
+	now = now.Add(time.Hour)
+	a.record("10.0.0.1", []*table.Path{p2}, []table.UpdateType{table.UPDATE_TYPE_WITHDRAWAL}, now)
+	assert.Len(a.top(defaultActivityWindow, defaultActivityLimit, all, now), 1)
+	assert.Len(a.last, 1)
+}
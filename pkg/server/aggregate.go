@@ -0,0 +1,359 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/log"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+// aggregate is an aggregate of the more-specific best paths in the global
+// RIB, installed while any of them exists (RFC 4271 9.2.2.2).
+type aggregate struct {
+	c        *oc.AggregateConfig
+	prefix   *net.IPNet
+	family   bgp.RouteFamily
+	suppress map[string]struct{}
+	// the best paths of the more-specific prefixes keyed by the prefixes
+	contributors map[string]*table.Path
+	// the path installed in the global RIB
+	path  *table.Path
+	dirty bool
+}
+
+// contains returns true if the prefix of the path is more specific than
+// the one of the aggregate.
+func (a *aggregate) contains(path *table.Path) bool {
+	if path.GetRouteFamily() != a.family {
+		return false
+	}
+	var ip net.IP
+	var length uint8
+	switch n := path.GetNlri().(type) {
+	case *bgp.IPAddrPrefix:
+		ip, length = n.Prefix, n.Length
+	case *bgp.IPv6AddrPrefix:
+		ip, length = n.Prefix, n.Length
+	default:
+		return false
+	}
+	ones, _ := a.prefix.Mask.Size()
+	return int(length) > ones && a.prefix.Contains(ip)
+}
+
+func (a *aggregate) suppressed(peer *peer) bool {
+	if a.c.SummaryOnly {
+		return true
+	}
+	_, y := a.suppress[peer.ID()]
+	return y
+}
+
+// pathAttrs returns the path attributes generated from the contributors.
+// AS_SET carries the AS numbers following the common leading AS_SEQUENCE
+// in the as-set mode. Otherwise, AS_PATH is empty and ATOMIC_AGGREGATE is
+// attached if the AS numbers of the contributors are lost.
+func (a *aggregate) pathAttrs(as uint32, routerID string) []bgp.PathAttributeInterface {
+	origin := uint8(bgp.BGP_ORIGIN_ATTR_TYPE_IGP)
+	atomic := false
+	var common []uint32
+	first := true
+	for _, path := range a.contributors {
+		if o, err := path.GetOrigin(); err == nil && o > origin {
+			// INCOMPLETE > EGP > IGP
+			origin = o
+		}
+		for _, attr := range path.GetPathAttrs() {
+			if attr.GetType() == bgp.BGP_ATTR_TYPE_ATOMIC_AGGREGATE {
+				atomic = true
+			}
+		}
+		var seq []uint32
+		if aspath := path.GetAsPath(); aspath != nil && len(aspath.Value) > 0 && aspath.Value[0].GetType() == bgp.BGP_ASPATH_ATTR_TYPE_SEQ {
+			seq = aspath.Value[0].GetAS()
+		}
+		if first {
+			common = seq
+			first = false
+			continue
+		}
+		i := 0
+		for i < len(common) && i < len(seq) && common[i] == seq[i] {
+			i++
+		}
+		common = common[:i]
+	}
+
+	params := make([]bgp.AsPathParamInterface, 0, 2)
+	if a.c.AsSet {
+		m := make(map[uint32]struct{})
+		for _, path := range a.contributors {
+			for _, as := range path.GetAsList()[len(common):] {
+				m[as] = struct{}{}
+			}
+		}
+		set := make([]uint32, 0, len(m))
+		for as := range m {
+			set = append(set, as)
+		}
+		sort.Slice(set, func(i, j int) bool { return set[i] < set[j] })
+		if len(common) > 0 {
+			params = append(params, bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, append([]uint32{}, common...)))
+		}
+		if len(set) > 0 {
+			params = append(params, bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SET, set))
+		}
+	} else {
+		for _, path := range a.contributors {
+			if path.GetAsPathLen() > 0 {
+				atomic = true
+				break
+			}
+		}
+	}
+
+	attrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(origin),
+		bgp.NewPathAttributeAsPath(params),
+	}
+	if a.family == bgp.RF_IPv4_UC {
+		attrs = append(attrs, bgp.NewPathAttributeNextHop("0.0.0.0"))
+	}
+	if atomic {
+		attrs = append(attrs, bgp.NewPathAttributeAtomicAggregate())
+	}
+	attrs = append(attrs, bgp.NewPathAttributeAggregator(as, routerID))
+	return attrs
+}
+
+func (a *aggregate) nlri() bgp.AddrPrefixInterface {
+	ones, _ := a.prefix.Mask.Size()
+	if a.family == bgp.RF_IPv6_UC {
+		return bgp.NewIPv6AddrPrefix(uint8(ones), a.prefix.IP.String())
+	}
+	return bgp.NewIPAddrPrefix(uint8(ones), a.prefix.IP.String())
+}
+
+func samePathAttrs(l, r []bgp.PathAttributeInterface) bool {
+	if len(l) != len(r) {
+		return false
+	}
+	for i := range l {
+		lb, err := l[i].Serialize()
+		if err != nil {
+			return false
+		}
+		rb, err := r[i].Serialize()
+		if err != nil || !bytes.Equal(lb, rb) {
+			return false
+		}
+	}
+	return true
+}
+
+type aggregateManager struct {
+	s          *BgpServer
+	aggregates map[string]*aggregate
+}
+
+func newAggregateManager(s *BgpServer) *aggregateManager {
+	return &aggregateManager{
+		s:          s,
+		aggregates: make(map[string]*aggregate),
+	}
+}
+
+func newAggregate(c *oc.AggregateConfig) (*aggregate, error) {
+	_, prefix, err := net.ParseCIDR(c.Prefix)
+	if err != nil {
+		return nil, fmt.Errorf("invalid aggregate prefix: %s", c.Prefix)
+	}
+	family := bgp.RF_IPv4_UC
+	if prefix.IP.To4() == nil {
+		family = bgp.RF_IPv6_UC
+	}
+	c.Prefix = prefix.String()
+	a := &aggregate{
+		c:            c,
+		prefix:       prefix,
+		family:       family,
+		suppress:     make(map[string]struct{}),
+		contributors: make(map[string]*table.Path),
+	}
+	for _, n := range c.SuppressNeighborList {
+		addr := net.ParseIP(n)
+		if addr == nil {
+			return nil, fmt.Errorf("invalid neighbor address of aggregate %s: %s", c.Prefix, n)
+		}
+		a.suppress[addr.String()] = struct{}{}
+	}
+	return a, nil
+}
+
+func (m *aggregateManager) addAggregate(c *oc.AggregateConfig) error {
+	a, err := newAggregate(c)
+	if err != nil {
+		return err
+	}
+	if _, y := m.aggregates[c.Prefix]; y {
+		return fmt.Errorf("aggregate %s is already configured", c.Prefix)
+	}
+	m.s.resendSuppressed(a, func() {
+		m.aggregates[c.Prefix] = a
+	})
+	for _, path := range m.s.globalRib.GetBestPathList(table.GLOBAL_RIB_NAME, 0, []bgp.RouteFamily{a.family}) {
+		a.update(path)
+	}
+	m.s.logger.Info("aggregate added",
+		log.Fields{
+			"Topic":        "Aggregate",
+			"Key":          c.Prefix,
+			"Contributors": len(a.contributors)})
+	return nil
+}
+
+func (m *aggregateManager) deleteAggregate(prefix string) error {
+	if _, p, err := net.ParseCIDR(prefix); err == nil {
+		prefix = p.String()
+	}
+	a, y := m.aggregates[prefix]
+	if !y {
+		return fmt.Errorf("aggregate %s isn't found", prefix)
+	}
+	m.s.resendSuppressed(a, func() {
+		delete(m.aggregates, prefix)
+	})
+	if a.path != nil {
+		m.s.propagateUpdate(nil, []*table.Path{a.path.Clone(true)})
+		a.path = nil
+	}
+	m.s.logger.Info("aggregate deleted",
+		log.Fields{
+			"Topic": "Aggregate",
+			"Key":   prefix})
+	return nil
+}
+
+// update updates the contributors with the change of the best path of a
+// more-specific prefix.
+func (a *aggregate) update(path *table.Path) {
+	if path == nil || !a.contains(path) {
+		return
+	}
+	key := path.GetNlri().String()
+	if path.IsWithdraw {
+		if _, y := a.contributors[key]; !y {
+			return
+		}
+		delete(a.contributors, key)
+	} else {
+		a.contributors[key] = path
+	}
+	a.dirty = true
+}
+
+// update updates the contributors of the aggregates with the changes of
+// the best paths in the global RIB.
+func (m *aggregateManager) update(bestList []*table.Path) {
+	if len(m.aggregates) == 0 {
+		return
+	}
+	for _, path := range bestList {
+		for _, a := range m.aggregates {
+			a.update(path)
+		}
+	}
+}
+
+// flush installs, updates or withdraws the aggregates whose contributors
+// have changed. An aggregate may contribute to a less-specific one, so
+// this is repeated until no aggregate changes.
+func (m *aggregateManager) flush() {
+	for {
+		var dirty []*aggregate
+		for _, a := range m.aggregates {
+			if a.dirty {
+				a.dirty = false
+				dirty = append(dirty, a)
+			}
+		}
+		if len(dirty) == 0 {
+			return
+		}
+		for _, a := range dirty {
+			m.install(a)
+		}
+	}
+}
+
+func (m *aggregateManager) install(a *aggregate) {
+	if len(a.contributors) == 0 {
+		if a.path != nil {
+			m.s.propagateUpdate(nil, []*table.Path{a.path.Clone(true)})
+			a.path = nil
+		}
+		return
+	}
+	g := m.s.bgpConfig.Global.Config
+	attrs := a.pathAttrs(g.As, g.RouterId)
+	if a.path != nil && samePathAttrs(a.path.GetPathAttrs(), attrs) {
+		return
+	}
+	if a.family == bgp.RF_IPv6_UC {
+		attrs = append(attrs, bgp.NewPathAttributeMpReachNLRI("::", []bgp.AddrPrefixInterface{a.nlri()}))
+	}
+	// not to implicitly withdraw the path of the same prefix added via the
+	// API, the source differs from the one of the API.
+	id := net.ParseIP(g.RouterId).To4()
+	a.path = table.NewPath(&table.PeerInfo{ID: id, LocalID: id}, a.nlri(), false, attrs, time.Now(), false)
+	m.s.propagateUpdate(nil, []*table.Path{a.path})
+}
+
+// suppressed returns true if the path is a more-specific route of an
+// aggregate suppressed toward the peer.
+func (m *aggregateManager) suppressed(peer *peer, path *table.Path) bool {
+	if len(m.aggregates) == 0 || path == nil || peer.isRouteServerClient() {
+		return false
+	}
+	for _, a := range m.aggregates {
+		if a.contains(path) && a.suppressed(peer) {
+			return true
+		}
+	}
+	return false
+}
+
+// resendSuppressed sends the more-specific routes of the aggregate that
+// change applies or removes the suppression of to the peers.
+func (s *BgpServer) resendSuppressed(a *aggregate, change func()) {
+	peers := make([]*peer, 0)
+	if a.c.SummaryOnly || len(a.suppress) > 0 {
+		for _, peer := range s.neighborMap {
+			if !peer.isRouteServerClient() && a.suppressed(peer) {
+				peers = append(peers, peer)
+			}
+		}
+	}
+	s.reevaluateExports(peers, a.contains, change)
+}
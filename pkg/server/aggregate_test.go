@@ -0,0 +1,171 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/apiutil"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func TestAggregate(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s1 := runNewServer(t, 1, "1.1.1.1", 10195)
+	defer s1.StopBgp(ctx, &api.StopBgpRequest{})
+	s2 := runNewServer(t, 2, "2.2.2.2", 20195)
+	defer s2.StopBgp(ctx, &api.StopBgpRequest{})
+
+	if err := peerServers(t, ctx, []*BgpServer{s1, s2}, []oc.AfiSafiType{oc.AFI_SAFI_TYPE_IPV4_UNICAST}); err != nil {
+		t.Fatal(err)
+	}
+	addPath := func(s *BgpServer, prefix string) []byte {
+		path, _ := apiutil.NewPath(bgp.NewIPAddrPrefix(24, prefix), false, []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop(s.bgpConfig.Global.Config.RouterId),
+		}, time.Now())
+		rsp, err := s.AddPath(ctx, &api.AddPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Path:      path,
+		})
+		assert.NoError(err)
+		return rsp.Uuid
+	}
+	// returns the prefixes in the global RIB
+	prefixes := func(s *BgpServer) []string {
+		l := make([]string, 0)
+		s.ListPath(ctx, &api.ListPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Family:    &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST},
+		}, func(d *api.Destination) {
+			l = append(l, d.Prefix)
+		})
+		sort.Strings(l)
+		return l
+	}
+	// returns the attributes of the best path of the prefix
+	attributes := func(s *BgpServer, prefix string) map[bgp.BGPAttrType]bgp.PathAttributeInterface {
+		var attrs map[bgp.BGPAttrType]bgp.PathAttributeInterface
+		s.ListPath(ctx, &api.ListPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Family:    &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST},
+			Prefixes:  []*api.TableLookupPrefix{{Prefix: prefix}},
+		}, func(d *api.Destination) {
+			attrs = make(map[bgp.BGPAttrType]bgp.PathAttributeInterface)
+			for _, a := range d.Paths[0].Pattrs {
+				attr, _ := apiutil.UnmarshalAttribute(a)
+				attrs[attr.GetType()] = attr
+			}
+		})
+		return attrs
+	}
+	aggregate := func(s *BgpServer, prefix string) *api.ListAggregateResponse {
+		var rsp *api.ListAggregateResponse
+		s.ListAggregate(ctx, &api.ListAggregateRequest{Prefix: prefix}, func(r *api.ListAggregateResponse) {
+			rsp = r
+		})
+		return rsp
+	}
+
+	addPath(s1, "10.0.1.0")
+	addPath(s1, "10.0.2.0")
+	assert.Eventually(func() bool {
+		return strings.Join(prefixes(s2), " ") == "10.0.1.0/24 10.0.2.0/24"
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// the more-specific routes are suppressed in the summary-only mode
+	err := s1.AddAggregate(ctx, &api.AddAggregateRequest{
+		Aggregate: &api.Aggregate{Prefix: "10.0.0.0/16", SummaryOnly: true},
+	})
+	assert.NoError(err)
+	assert.Error(s1.AddAggregate(ctx, &api.AddAggregateRequest{
+		Aggregate: &api.Aggregate{Prefix: "10.0.0.0/16"},
+	}))
+	rsp := aggregate(s1, "10.0.0.0/16")
+	assert.Equal(uint32(2), rsp.Contributors)
+	assert.True(rsp.Installed)
+	assert.Eventually(func() bool {
+		return strings.Join(prefixes(s2), " ") == "10.0.0.0/16"
+	}, 10*time.Second, 100*time.Millisecond)
+	attrs := attributes(s2, "10.0.0.0/16")
+	assert.Equal(uint32(1), attrs[bgp.BGP_ATTR_TYPE_AGGREGATOR].(*bgp.PathAttributeAggregator).Value.AS)
+	assert.Equal("1.1.1.1", attrs[bgp.BGP_ATTR_TYPE_AGGREGATOR].(*bgp.PathAttributeAggregator).Value.Address.String())
+	assert.NotContains(attrs, bgp.BGP_ATTR_TYPE_ATOMIC_AGGREGATE)
+
+	// the more-specific routes are advertised again
+	err = s1.DeleteAggregate(ctx, &api.DeleteAggregateRequest{Prefix: "10.0.0.0/16"})
+	assert.NoError(err)
+	assert.Eventually(func() bool {
+		return strings.Join(prefixes(s2), " ") == "10.0.1.0/24 10.0.2.0/24"
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// ATOMIC_AGGREGATE is attached as the AS numbers are lost
+	uuid := addPath(s2, "172.16.1.0")
+	assert.Eventually(func() bool {
+		return attributes(s1, "172.16.1.0/24") != nil
+	}, 10*time.Second, 100*time.Millisecond)
+	err = s1.AddAggregate(ctx, &api.AddAggregateRequest{
+		Aggregate: &api.Aggregate{Prefix: "172.16.0.0/16"},
+	})
+	assert.NoError(err)
+	attrs = attributes(s1, "172.16.0.0/16")
+	assert.Contains(attrs, bgp.BGP_ATTR_TYPE_ATOMIC_AGGREGATE)
+	assert.Empty(attrs[bgp.BGP_ATTR_TYPE_AS_PATH].(*bgp.PathAttributeAsPath).Value)
+
+	// AS_SET carries the AS numbers following the common AS_SEQUENCE
+	// instead
+	local := addPath(s1, "172.16.2.0")
+	assert.NoError(s1.DeleteAggregate(ctx, &api.DeleteAggregateRequest{Prefix: "172.16.0.0/16"}))
+	err = s1.AddAggregate(ctx, &api.AddAggregateRequest{
+		Aggregate: &api.Aggregate{Prefix: "172.16.0.0/16", AsSet: true},
+	})
+	assert.NoError(err)
+	attrs = attributes(s1, "172.16.0.0/16")
+	assert.NotContains(attrs, bgp.BGP_ATTR_TYPE_ATOMIC_AGGREGATE)
+	aspath := attrs[bgp.BGP_ATTR_TYPE_AS_PATH].(*bgp.PathAttributeAsPath).Value
+	assert.Len(aspath, 1)
+	assert.Equal(uint8(bgp.BGP_ASPATH_ATTR_TYPE_SET), aspath[0].GetType())
+	assert.Equal([]uint32{2}, aspath[0].GetAS())
+
+	// the aggregate is updated and withdrawn with the last contributing
+	// route
+	err = s2.DeletePath(ctx, &api.DeletePathRequest{
+		TableType: api.TableType_GLOBAL,
+		Uuid:      uuid,
+	})
+	assert.NoError(err)
+	assert.Eventually(func() bool {
+		attrs := attributes(s1, "172.16.0.0/16")
+		return len(attrs[bgp.BGP_ATTR_TYPE_AS_PATH].(*bgp.PathAttributeAsPath).Value) == 0
+	}, 10*time.Second, 100*time.Millisecond)
+	err = s1.DeletePath(ctx, &api.DeletePathRequest{
+		TableType: api.TableType_GLOBAL,
+		Uuid:      local,
+	})
+	assert.NoError(err)
+	assert.False(aggregate(s1, "172.16.0.0/16").Installed)
+	assert.Nil(attributes(s1, "172.16.0.0/16"))
+}
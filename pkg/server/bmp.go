@@ -17,12 +17,20 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"io"
 	"net"
+	"os"
 	"strconv"
 	"sync/atomic"
+	"syscall"
 	"time"
 
+	"golang.org/x/time/rate"
+
 	api "github.com/osrg/gobgp/v3/api"
 	"github.com/osrg/gobgp/v3/internal/pkg/table"
 	"github.com/osrg/gobgp/v3/pkg/config/oc"
@@ -82,203 +90,486 @@ func (r ribout) update(p *table.Path) bool {
 	return true
 }
 
-func (b *bmpClient) tryConnect() *net.TCPConn {
-	interval := 1
-	for {
-		b.s.logger.Debug("Connecting to BMP server",
-			log.Fields{
-				"Topic": "bmp",
-				"Key":   b.host})
-		conn, err := net.Dial("tcp", b.host)
+const (
+	bmpMinReconnectInterval = time.Second
+	bmpMaxReconnectInterval = time.Minute
+	// The messages waiting to be written to the BMP server.
+	bmpSendQueueSize = 8192
+	// The messages are dropped once the BMP server hasn't read any message
+	// for this duration, until it starts reading again.
+	bmpSendStallTimeout = 30 * time.Second
+	// The termination message is given up if it can't be written for this
+	// duration.
+	bmpTerminationTimeout = 5 * time.Second
+)
+
+func newBmpTLSConfig(c *oc.BmpServerConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		ServerName: c.TlsServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if tlsConfig.ServerName == "" {
+		tlsConfig.ServerName = c.Address
+	}
+	var pool *x509.CertPool
+	if c.TlsCaFile != "" {
+		pem, err := os.ReadFile(c.TlsCaFile)
 		if err != nil {
+			return nil, fmt.Errorf("failed to read tls ca file: %v", err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no valid certificate in tls ca file: %s", c.TlsCaFile)
+		}
+	}
+	if c.TlsCertFile != "" || c.TlsKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.TlsCertFile, c.TlsKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load tls certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	if c.Mode == oc.BMP_MODE_TYPE_PASSIVE {
+		if len(tlsConfig.Certificates) == 0 {
+			return nil, fmt.Errorf("tls certificate and key are required in the passive mode")
+		}
+		if pool != nil {
+			tlsConfig.ClientCAs = pool
+			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	} else {
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
+
+func (b *bmpClient) dial() (net.Conn, error) {
+	d := &net.Dialer{}
+	if b.c.AuthPassword != "" {
+		d.Control = func(network, address string, c syscall.RawConn) error {
+			return dialerControl(b.logger, network, address, c, 0, 0, 0, b.c.AuthPassword, "")
+		}
+	}
+	if b.tlsConfig != nil {
+		td := &tls.Dialer{NetDialer: d, Config: b.tlsConfig}
+		return td.DialContext(b.ctx, "tcp", b.host)
+	}
+	return d.DialContext(b.ctx, "tcp", b.host)
+}
+
+// listen starts accepting the connection from the BMP server in the
+// passive mode.
+func (b *bmpClient) listen() error {
+	collector := net.ParseIP(b.c.Address)
+	if collector == nil {
+		return fmt.Errorf("bmp server address must be an ip address in the passive mode: %s", b.c.Address)
+	}
+	l, err := net.Listen("tcp", net.JoinHostPort(b.c.LocalAddress, strconv.Itoa(int(b.c.Port))))
+	if err != nil {
+		return err
+	}
+	if b.c.AuthPassword != "" {
+		if err := setTCPMD5SigSockopt(l.(*net.TCPListener), b.c.Address, b.c.AuthPassword); err != nil {
+			l.Close()
+			return err
+		}
+	}
+	b.listener = l
+	b.accepted = make(chan net.Conn)
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				if errors.Is(err, net.ErrClosed) {
+					return
+				}
+				b.logger.Warn("failed to accept bmp server connection",
+					log.Fields{
+						"Topic": "bmp",
+						"Key":   b.host,
+						"Error": err})
+				time.Sleep(bmpMinReconnectInterval)
+				continue
+			}
+			if addr, ok := conn.RemoteAddr().(*net.TCPAddr); !ok || !addr.IP.Equal(collector) {
+				b.logger.Warn("rejected a connection from unknown bmp server",
+					log.Fields{
+						"Topic":  "bmp",
+						"Key":    b.host,
+						"Remote": conn.RemoteAddr().String()})
+				conn.Close()
+				continue
+			}
+			if b.tlsConfig != nil {
+				conn = tls.Server(conn, b.tlsConfig)
+			}
 			select {
-			case <-b.dead:
-				return nil
-			default:
+			case b.accepted <- conn:
+			case <-b.ctx.Done():
+				conn.Close()
+				return
 			}
-			time.Sleep(time.Duration(interval) * time.Second)
-			if interval < 30 {
-				interval *= 2
+		}
+	}()
+	return nil
+}
+
+func (b *bmpClient) nextRetryInterval() time.Duration {
+	d := b.retryInterval
+	b.retryInterval *= 2
+	if b.retryInterval > bmpMaxReconnectInterval {
+		b.retryInterval = bmpMaxReconnectInterval
+	}
+	return d
+}
+
+// connect returns the connection to the BMP server, or nil when the client
+// is stopped. In the active mode, the connection is initiated after delay
+// and retried with exponential backoff.
+func (b *bmpClient) connect(delay time.Duration) net.Conn {
+	if b.listener != nil {
+		select {
+		case conn := <-b.accepted:
+			b.logger.Debug("Accepted BMP server connection",
+				log.Fields{
+					"Topic": "bmp",
+					"Key":   b.host})
+			return conn
+		case <-b.ctx.Done():
+			return nil
+		}
+	}
+	for {
+		if delay > 0 {
+			t := time.NewTimer(delay)
+			select {
+			case <-b.ctx.Done():
+				t.Stop()
+				return nil
+			case <-t.C:
 			}
-		} else {
-			b.s.logger.Debug("Connected to BMP server",
+		}
+		b.logger.Debug("Connecting to BMP server",
+			log.Fields{
+				"Topic": "bmp",
+				"Key":   b.host})
+		conn, err := b.dial()
+		if err == nil {
+			b.logger.Debug("Connected to BMP server",
 				log.Fields{
 					"Topic": "bmp",
 					"Key":   b.host})
-			return conn.(*net.TCPConn)
+			return conn
+		}
+		select {
+		case <-b.ctx.Done():
+			return nil
+		default:
 		}
+		delay = b.nextRetryInterval()
+		b.logger.Debug("failed to connect to BMP server",
+			log.Fields{
+				"Topic": "bmp",
+				"Key":   b.host,
+				"Error": err,
+				"Retry": delay})
 	}
 }
 
 func (b *bmpClient) Stop() {
-	close(b.dead)
+	b.cancel()
+	if b.listener != nil {
+		b.listener.Close()
+	}
 }
 
 func (b *bmpClient) loop() {
+	var delay time.Duration
 	for {
-		conn := b.tryConnect()
+		conn := b.connect(delay)
 		if conn == nil {
-			break
+			return
 		}
-		atomic.StoreInt64(&b.uptime, time.Now().Unix())
-
-		if func() bool {
-			defer func() {
-				atomic.StoreInt64(&b.downtime, time.Now().Unix())
-			}()
-			ops := []watchOption{watchPeer()}
-			if b.c.RouteMonitoringPolicy == oc.BMP_ROUTE_MONITORING_POLICY_TYPE_BOTH {
-				b.s.logger.Warn("both option for route-monitoring-policy is obsoleted", log.Fields{"Topic": "bmp"})
-			}
-			if b.c.RouteMonitoringPolicy == oc.BMP_ROUTE_MONITORING_POLICY_TYPE_PRE_POLICY || b.c.RouteMonitoringPolicy == oc.BMP_ROUTE_MONITORING_POLICY_TYPE_ALL {
-				ops = append(ops, watchUpdate(true, "", ""))
-			}
-			if b.c.RouteMonitoringPolicy == oc.BMP_ROUTE_MONITORING_POLICY_TYPE_POST_POLICY || b.c.RouteMonitoringPolicy == oc.BMP_ROUTE_MONITORING_POLICY_TYPE_ALL {
-				ops = append(ops, watchPostUpdate(true, "", ""))
-			}
-			if b.c.RouteMonitoringPolicy == oc.BMP_ROUTE_MONITORING_POLICY_TYPE_LOCAL_RIB || b.c.RouteMonitoringPolicy == oc.BMP_ROUTE_MONITORING_POLICY_TYPE_ALL {
-				ops = append(ops, watchBestPath(true))
-			}
-			if b.c.RouteMirroringEnabled {
-				ops = append(ops, watchMessage(false))
-			}
-			w := b.s.watch(ops...)
-			defer w.Stop()
-
-			var tickerCh <-chan time.Time
-			if b.c.StatisticsTimeout == 0 {
-				b.s.logger.Debug("statistics reports disabled", log.Fields{"Topic": "bmp"})
-			} else {
-				t := time.NewTicker(time.Duration(b.c.StatisticsTimeout) * time.Second)
-				defer t.Stop()
-				tickerCh = t.C
-			}
+		uptime := time.Now()
+		atomic.StoreInt64(&b.uptime, uptime.Unix())
+		stopped := b.serve(conn)
+		atomic.StoreInt64(&b.downtime, time.Now().Unix())
+		if stopped {
+			return
+		}
+		// a BMP server closing the sessions right after accepting them
+		// isn't reconnected to in a tight loop
+		if time.Since(uptime) < bmpMaxReconnectInterval {
+			delay = b.nextRetryInterval()
+		} else {
+			b.retryInterval = bmpMinReconnectInterval
+			delay = 0
+		}
+	}
+}
 
-			write := func(msg *bmp.BMPMessage) error {
-				buf, _ := msg.Serialize()
-				_, err := conn.Write(buf)
-				if err != nil {
-					b.s.logger.Warn("failed to write to bmp server",
-						log.Fields{
-							"Topic": "bmp",
-							"Key":   b.host})
+// serve sends the BMP messages over the connection until it fails, or
+// the client is stopped in which case true is returned.
+func (b *bmpClient) serve(conn net.Conn) (stopped bool) {
+	// the messages are written by another goroutine so that a BMP server
+	// reading slowly doesn't make the events pile up
+	queue := make(chan []byte, bmpSendQueueSize)
+	failed := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		var err error
+		for buf := range queue {
+			if err == nil {
+				if _, err = conn.Write(buf); err == nil {
+					atomic.AddUint64(&b.sent, 1)
+					continue
 				}
-				return err
+				b.logger.Warn("failed to write to bmp server",
+					log.Fields{
+						"Topic": "bmp",
+						"Key":   b.host,
+						"Error": err})
+				close(failed)
 			}
+			atomic.AddUint64(&b.dropped, 1)
+		}
+	}()
+	// nothing is expected from the BMP server, reading detects that it
+	// closes the connection while there is nothing to write
+	closed := make(chan struct{})
+	go func() {
+		io.Copy(io.Discard, conn)
+		close(closed)
+	}()
+	defer func() {
+		if stopped {
+			conn.SetWriteDeadline(time.Now().Add(bmpTerminationTimeout))
+		} else {
+			conn.Close()
+		}
+		close(queue)
+		<-done
+		conn.Close()
+	}()
 
-			tlv := []bmp.BMPInfoTLVInterface{
-				bmp.NewBMPInfoTLVString(bmp.BMP_INIT_TLV_TYPE_SYS_NAME, b.c.SysName),
-				bmp.NewBMPInfoTLVString(bmp.BMP_INIT_TLV_TYPE_SYS_DESCR, b.c.SysDescr),
+	errWriteFailed := fmt.Errorf("failed to write to bmp server")
+	stalled := false
+	write := func(msg *bmp.BMPMessage) error {
+		select {
+		case <-failed:
+			return errWriteFailed
+		default:
+		}
+		buf, _ := msg.Serialize()
+		select {
+		case queue <- buf:
+			stalled = false
+			return nil
+		default:
+		}
+		if !stalled {
+			t := time.NewTimer(bmpSendStallTimeout)
+			defer t.Stop()
+			select {
+			case queue <- buf:
+				return nil
+			case <-failed:
+				return errWriteFailed
+			case <-b.ctx.Done():
+			case <-t.C:
+				stalled = true
+				b.logger.Warn("bmp server doesn't read messages, dropping them",
+					log.Fields{
+						"Topic": "bmp",
+						"Key":   b.host})
 			}
+		}
+		atomic.AddUint64(&b.dropped, 1)
+		return nil
+	}
 
-			if err := write(bmp.NewBMPInitiation(tlv)); err != nil {
-				return false
-			}
+	ops := []watchOption{watchPeer()}
+	if b.c.RouteMonitoringPolicy == oc.BMP_ROUTE_MONITORING_POLICY_TYPE_BOTH {
+		b.logger.Warn("both option for route-monitoring-policy is obsoleted", log.Fields{"Topic": "bmp"})
+	}
+	if b.c.RouteMonitoringPolicy == oc.BMP_ROUTE_MONITORING_POLICY_TYPE_PRE_POLICY || b.c.RouteMonitoringPolicy == oc.BMP_ROUTE_MONITORING_POLICY_TYPE_ALL {
+		ops = append(ops, watchUpdate(true, "", ""))
+	}
+	if b.c.RouteMonitoringPolicy == oc.BMP_ROUTE_MONITORING_POLICY_TYPE_POST_POLICY || b.c.RouteMonitoringPolicy == oc.BMP_ROUTE_MONITORING_POLICY_TYPE_ALL {
+		ops = append(ops, watchPostUpdate(true, "", ""))
+	}
+	if b.c.RouteMonitoringPolicy == oc.BMP_ROUTE_MONITORING_POLICY_TYPE_LOCAL_RIB || b.c.RouteMonitoringPolicy == oc.BMP_ROUTE_MONITORING_POLICY_TYPE_ALL {
+		ops = append(ops, watchBestPath(true))
+	}
+	var mirroring *bmpRouteMirroring
+	if b.c.RouteMirroringEnabled {
+		ops = append(ops, watchMessage(false))
+		mirroring = newBmpRouteMirroring(b.c)
+	}
+	w := b.s.watch(ops...)
+	defer w.Stop()
 
-			for {
-				select {
-				case ev := <-w.Event():
-					switch msg := ev.(type) {
-					case *watchEventUpdate:
-						info := &table.PeerInfo{
-							Address: msg.PeerAddress,
-							AS:      msg.PeerAS,
-							ID:      msg.PeerID,
-						}
-						if msg.Payload == nil {
-							var pathList []*table.Path
-							if msg.Init {
-								pathList = msg.PathList
-							} else {
-								for _, p := range msg.PathList {
-									if b.ribout.update(p) {
-										pathList = append(pathList, p)
-									}
-								}
-							}
-							for _, path := range pathList {
-								for _, u := range table.CreateUpdateMsgFromPaths([]*table.Path{path}) {
-									payload, _ := u.Serialize()
-									if err := write(bmpPeerRoute(bmp.BMP_PEER_TYPE_GLOBAL, msg.PostPolicy, 0, true, info, path.GetTimestamp().Unix(), payload)); err != nil {
-										return false
-									}
-								}
-							}
-						} else if err := write(bmpPeerRoute(bmp.BMP_PEER_TYPE_GLOBAL, msg.PostPolicy, 0, msg.FourBytesAs, info, msg.Timestamp.Unix(), msg.Payload)); err != nil {
-							return false
-						}
-					case *watchEventBestPath:
-						info := &table.PeerInfo{
-							Address: net.ParseIP("0.0.0.0").To4(),
-							AS:      b.s.bgpConfig.Global.Config.As,
-							ID:      net.ParseIP(b.s.bgpConfig.Global.Config.RouterId).To4(),
-						}
+	var tickerCh <-chan time.Time
+	if b.c.StatisticsTimeout == 0 {
+		b.logger.Debug("statistics reports disabled", log.Fields{"Topic": "bmp"})
+	} else {
+		t := time.NewTicker(time.Duration(b.c.StatisticsTimeout) * time.Second)
+		defer t.Stop()
+		tickerCh = t.C
+	}
+
+	tlv := []bmp.BMPInfoTLVInterface{
+		bmp.NewBMPInfoTLVString(bmp.BMP_INIT_TLV_TYPE_SYS_NAME, b.c.SysName),
+		bmp.NewBMPInfoTLVString(bmp.BMP_INIT_TLV_TYPE_SYS_DESCR, b.c.SysDescr),
+	}
+
+	if err := write(bmp.NewBMPInitiation(tlv)); err != nil {
+		return false
+	}
+
+	for {
+		select {
+		case ev := <-w.Event():
+			switch msg := ev.(type) {
+			case *watchEventUpdate:
+				info := &table.PeerInfo{
+					Address: msg.PeerAddress,
+					AS:      msg.PeerAS,
+					ID:      msg.PeerID,
+				}
+				if msg.Payload == nil {
+					var pathList []*table.Path
+					if msg.Init {
+						pathList = msg.PathList
+					} else {
 						for _, p := range msg.PathList {
-							u := table.CreateUpdateMsgFromPaths([]*table.Path{p})[0]
-							if payload, err := u.Serialize(); err != nil {
-								return false
-							} else if err = write(bmpPeerRoute(bmp.BMP_PEER_TYPE_LOCAL_RIB, false, 0, true, info, p.GetTimestamp().Unix(), payload)); err != nil {
-								return false
+							if b.ribout.update(p) {
+								pathList = append(pathList, p)
 							}
 						}
-					case *watchEventPeer:
-						if msg.Type != PEER_EVENT_END_OF_INIT {
-							if msg.State == bgp.BGP_FSM_ESTABLISHED {
-								if err := write(bmpPeerUp(msg, bmp.BMP_PEER_TYPE_GLOBAL, false, 0)); err != nil {
-									return false
-								}
-							} else if msg.Type != PEER_EVENT_INIT && msg.OldState == bgp.BGP_FSM_ESTABLISHED {
-								if err := write(bmpPeerDown(msg, bmp.BMP_PEER_TYPE_GLOBAL, false, 0)); err != nil {
-									return false
-								}
+					}
+					for _, path := range pathList {
+						for _, u := range table.CreateUpdateMsgFromPaths([]*table.Path{path}) {
+							payload, _ := u.Serialize()
+							if err := write(bmpPeerRoute(bmp.BMP_PEER_TYPE_GLOBAL, msg.PostPolicy, 0, true, info, path.GetTimestamp().Unix(), payload)); err != nil {
+								return false
 							}
 						}
-					case *watchEventMessage:
-						info := &table.PeerInfo{
-							Address: msg.PeerAddress,
-							AS:      msg.PeerAS,
-							ID:      msg.PeerID,
+					}
+				} else if err := write(bmpPeerRoute(bmp.BMP_PEER_TYPE_GLOBAL, msg.PostPolicy, 0, msg.FourBytesAs, info, msg.Timestamp.Unix(), msg.Payload)); err != nil {
+					return false
+				}
+			case *watchEventBestPath:
+				info := &table.PeerInfo{
+					Address: net.ParseIP("0.0.0.0").To4(),
+					AS:      b.s.bgpConfig.Global.Config.As,
+					ID:      net.ParseIP(b.s.bgpConfig.Global.Config.RouterId).To4(),
+				}
+				for _, p := range msg.PathList {
+					u := table.CreateUpdateMsgFromPaths([]*table.Path{p})[0]
+					if payload, err := u.Serialize(); err != nil {
+						return false
+					} else if err = write(bmpPeerRoute(bmp.BMP_PEER_TYPE_LOCAL_RIB, false, 0, true, info, p.GetTimestamp().Unix(), payload)); err != nil {
+						return false
+					}
+				}
+			case *watchEventPeer:
+				if msg.Type != PEER_EVENT_END_OF_INIT {
+					if msg.State == bgp.BGP_FSM_ESTABLISHED {
+						if err := write(bmpPeerUp(msg, bmp.BMP_PEER_TYPE_GLOBAL, false, 0)); err != nil {
+							return false
 						}
-						if err := write(bmpPeerRouteMirroring(bmp.BMP_PEER_TYPE_GLOBAL, 0, info, msg.Timestamp.Unix(), msg.Message)); err != nil {
+					} else if msg.Type != PEER_EVENT_INIT && msg.OldState == bgp.BGP_FSM_ESTABLISHED {
+						if err := write(bmpPeerDown(msg, bmp.BMP_PEER_TYPE_GLOBAL, false, 0)); err != nil {
 							return false
 						}
 					}
-				case <-tickerCh:
-					var err error
-					b.s.ListPeer(context.Background(), &api.ListPeerRequest{EnableAdvertised: true},
-						func(peer *api.Peer) {
-							if err == nil && peer.State.SessionState == api.PeerState_ESTABLISHED {
-								err = write(bmpPeerStats(bmp.BMP_PEER_TYPE_GLOBAL, 0, time.Now().Unix(), peer))
-							}
-						})
-					if err != nil {
-						return false
-					}
-				case <-b.dead:
-					term := bmp.NewBMPTermination([]bmp.BMPTermTLVInterface{
-						bmp.NewBMPTermTLV16(bmp.BMP_TERM_TLV_TYPE_REASON, bmp.BMP_TERM_REASON_PERMANENTLY_ADMIN),
-					})
-					if err := write(term); err != nil {
+				}
+			case *watchEventMessage:
+				ok, lost := mirroring.mirror(msg.PeerAddress)
+				if !ok {
+					break
+				}
+				info := &table.PeerInfo{
+					Address: msg.PeerAddress,
+					AS:      msg.PeerAS,
+					ID:      msg.PeerID,
+				}
+				if lost {
+					if err := write(bmpPeerRouteMirroring(bmp.BMP_PEER_TYPE_GLOBAL, 0, msg.FourBytesAs, info, msg.Timestamp.Unix(), []bmp.BMPRouteMirrTLVInterface{
+						bmp.NewBMPRouteMirrTLV16(bmp.BMP_ROUTE_MIRRORING_TLV_TYPE_INFO, bmp.BMP_ROUTE_MIRRORING_INFO_MSG_LOST),
+					})); err != nil {
 						return false
 					}
-					conn.Close()
-					return true
+				}
+				tlv := make([]bmp.BMPRouteMirrTLVInterface, 0, 2)
+				if msg.ErroredPDU {
+					tlv = append(tlv, bmp.NewBMPRouteMirrTLV16(bmp.BMP_ROUTE_MIRRORING_TLV_TYPE_INFO, bmp.BMP_ROUTE_MIRRORING_INFO_ERR_PDU))
+				}
+				// RFC7854: BGP Message TLV MUST occur last in the list of TLVs
+				if len(msg.Payload) > 0 {
+					// mirror the message as received, which might not be parsed
+					tlv = append(tlv, bmp.NewBMPRouteMirrTLVUnknown(bmp.BMP_ROUTE_MIRRORING_TLV_TYPE_BGP_MSG, msg.Payload))
+				} else {
+					tlv = append(tlv, bmp.NewBMPRouteMirrTLVBGPMsg(bmp.BMP_ROUTE_MIRRORING_TLV_TYPE_BGP_MSG, msg.Message))
+				}
+				if err := write(bmpPeerRouteMirroring(bmp.BMP_PEER_TYPE_GLOBAL, 0, msg.FourBytesAs, info, msg.Timestamp.Unix(), tlv)); err != nil {
+					return false
 				}
 			}
-		}() {
-			return
+		case <-tickerCh:
+			var err error
+			b.s.ListPeer(context.Background(), &api.ListPeerRequest{EnableAdvertised: true},
+				func(peer *api.Peer) {
+					if err == nil && peer.State.SessionState == api.PeerState_ESTABLISHED {
+						err = write(bmpPeerStats(bmp.BMP_PEER_TYPE_GLOBAL, 0, time.Now().Unix(), peer))
+					}
+				})
+			if err != nil {
+				return false
+			}
+		case <-failed:
+			return false
+		case conn := <-b.accepted:
+			b.logger.Warn("rejected a connection since bmp session is already established",
+				log.Fields{
+					"Topic": "bmp",
+					"Key":   b.host})
+			conn.Close()
+		case <-closed:
+			b.logger.Warn("bmp server closed the connection",
+				log.Fields{
+					"Topic": "bmp",
+					"Key":   b.host})
+			return false
+		case <-b.ctx.Done():
+			term := bmp.NewBMPTermination([]bmp.BMPTermTLVInterface{
+				bmp.NewBMPTermTLV16(bmp.BMP_TERM_TLV_TYPE_REASON, bmp.BMP_TERM_REASON_PERMANENTLY_ADMIN),
+			})
+			write(term)
+			return true
 		}
 	}
 }
 
 type bmpClient struct {
-	s        *BgpServer
-	dead     chan struct{}
-	host     string
-	c        *oc.BmpServerConfig
-	ribout   ribout
-	uptime   int64
-	downtime int64
+	s             *BgpServer
+	logger        log.Logger
+	ctx           context.Context
+	cancel        context.CancelFunc
+	host          string
+	c             *oc.BmpServerConfig
+	tlsConfig     *tls.Config
+	listener      net.Listener
+	accepted      chan net.Conn
+	retryInterval time.Duration
+	ribout        ribout
+	uptime        int64
+	downtime      int64
+	sent          uint64
+	dropped       uint64
 }
 
 func bmpPeerUp(ev *watchEventPeer, t uint8, policy bool, pd uint64) *bmp.BMPMessage {
@@ -348,16 +639,57 @@ func bmpPeerStats(peerType uint8, peerDist uint64, timestamp int64, peer *api.Pe
 	)
 }
 
-func bmpPeerRouteMirroring(peerType uint8, peerDist uint64, peerInfo *table.PeerInfo, timestamp int64, msg *bgp.BGPMessage) *bmp.BMPMessage {
+func bmpPeerRouteMirroring(peerType uint8, peerDist uint64, fourBytesAs bool, peerInfo *table.PeerInfo, timestamp int64, tlv []bmp.BMPRouteMirrTLVInterface) *bmp.BMPMessage {
 	var peerFlags uint8 = 0
+	if !fourBytesAs {
+		peerFlags |= bmp.BMP_PEER_FLAG_TWO_AS
+	}
 	ph := bmp.NewBMPPeerHeader(peerType, peerFlags, peerDist, peerInfo.Address.String(), peerInfo.AS, peerInfo.ID.String(), float64(timestamp))
-	return bmp.NewBMPRouteMirroring(
-		*ph,
-		[]bmp.BMPRouteMirrTLVInterface{
-			// RFC7854: BGP Message TLV MUST occur last in the list of TLVs
-			bmp.NewBMPRouteMirrTLVBGPMsg(bmp.BMP_ROUTE_MIRRORING_TLV_TYPE_BGP_MSG, msg),
-		},
-	)
+	return bmp.NewBMPRouteMirroring(*ph, tlv)
+}
+
+// bmpRouteMirroring selects the received messages mirrored to the BMP
+// server and limits their rate.
+type bmpRouteMirroring struct {
+	neighbors map[string]struct{}
+	limiter   *rate.Limiter
+	// the neighbors whose messages have been dropped by the rate limit
+	lost map[string]struct{}
+}
+
+func newBmpRouteMirroring(c *oc.BmpServerConfig) *bmpRouteMirroring {
+	m := &bmpRouteMirroring{
+		lost: make(map[string]struct{}),
+	}
+	if len(c.RouteMirroringNeighborList) > 0 {
+		m.neighbors = make(map[string]struct{}, len(c.RouteMirroringNeighborList))
+		for _, n := range c.RouteMirroringNeighborList {
+			m.neighbors[net.ParseIP(n).String()] = struct{}{}
+		}
+	}
+	if c.RouteMirroringRateLimit > 0 {
+		m.limiter = rate.NewLimiter(rate.Limit(c.RouteMirroringRateLimit), int(c.RouteMirroringRateLimit))
+	}
+	return m
+}
+
+// mirror returns whether the message received from the neighbor is
+// mirrored, and whether the messages from the neighbor have been lost
+// since the last mirrored one.
+func (m *bmpRouteMirroring) mirror(addr net.IP) (ok, lost bool) {
+	key := addr.String()
+	if m.neighbors != nil {
+		if _, y := m.neighbors[key]; !y {
+			return false, false
+		}
+	}
+	if m.limiter != nil && !m.limiter.Allow() {
+		m.lost[key] = struct{}{}
+		return false, false
+	}
+	_, lost = m.lost[key]
+	delete(m.lost, key)
+	return true, lost
 }
 
 func (b *bmpClientManager) addServer(c *oc.BmpServerConfig) error {
@@ -365,14 +697,30 @@ func (b *bmpClientManager) addServer(c *oc.BmpServerConfig) error {
 	if _, y := b.clientMap[host]; y {
 		return fmt.Errorf("bmp client %s is already configured", host)
 	}
-	b.clientMap[host] = &bmpClient{
-		s:      b.s,
-		dead:   make(chan struct{}),
-		host:   host,
-		c:      c,
-		ribout: newribout(),
+	client := &bmpClient{
+		s:             b.s,
+		logger:        b.s.logLevels.Logger(log.ModuleBmp),
+		host:          host,
+		c:             c,
+		retryInterval: bmpMinReconnectInterval,
+		ribout:        newribout(),
+	}
+	if c.Transport == oc.BMP_TRANSPORT_TYPE_TLS {
+		tlsConfig, err := newBmpTLSConfig(c)
+		if err != nil {
+			return err
+		}
+		client.tlsConfig = tlsConfig
+	}
+	client.ctx, client.cancel = context.WithCancel(context.Background())
+	if c.Mode == oc.BMP_MODE_TYPE_PASSIVE {
+		if err := client.listen(); err != nil {
+			client.cancel()
+			return err
+		}
 	}
-	go b.clientMap[host].loop()
+	b.clientMap[host] = client
+	go client.loop()
 	return nil
 }
 
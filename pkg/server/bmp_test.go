@@ -0,0 +1,268 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/packet/bmp"
+)
+
+func readBmpMessage(t *testing.T, conn net.Conn) *bmp.BMPMessage {
+	conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	header := make([]byte, bmp.BMP_HEADER_SIZE)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, binary.BigEndian.Uint32(header[1:5]))
+	copy(buf, header)
+	if _, err := io.ReadFull(conn, buf[bmp.BMP_HEADER_SIZE:]); err != nil {
+		t.Fatal(err)
+	}
+	msg, err := bmp.ParseBMPMessage(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return msg
+}
+
+func newBmpTestServer(t *testing.T) *BgpServer {
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        1,
+			RouterId:   "1.1.1.1",
+			ListenPort: -1,
+		},
+	})
+	assert.NoError(t, err)
+	t.Cleanup(s.Stop)
+	return s
+}
+
+func listBmpStation(t *testing.T, s *BgpServer) *api.ListBmpResponse_BmpStation {
+	var station *api.ListBmpResponse_BmpStation
+	err := s.ListBmp(context.Background(), &api.ListBmpRequest{}, func(st *api.ListBmpResponse_BmpStation) {
+		station = st
+	})
+	assert.NoError(t, err)
+	return station
+}
+
+func TestBmpActiveTLS(t *testing.T) {
+	assert := assert.New(t)
+	s := newBmpTestServer(t)
+
+	caFile, cert := newTestCertificate(t, t.TempDir())
+	l, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{cert}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	port := l.Addr().(*net.TCPAddr).Port
+
+	err = s.AddBmp(context.Background(), &api.AddBmpRequest{
+		Address:   "127.0.0.1",
+		Port:      uint32(port),
+		Transport: api.AddBmpRequest_TLS,
+		Tls:       &api.BMPTLSConf{CaFile: caFile, ServerName: "rpki.example"},
+	})
+	assert.NoError(err)
+
+	conn, err := l.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	assert.Equal(uint8(bmp.BMP_MSG_INITIATION), readBmpMessage(t, conn).Header.Type)
+
+	station := listBmpStation(t, s)
+	assert.Equal(api.AddBmpRequest_ACTIVE, station.Conf.Mode)
+	assert.Equal(api.AddBmpRequest_TLS, station.Conf.Transport)
+	assert.Eventually(func() bool {
+		return listBmpStation(t, s).State.Sent > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.NoError(s.DeleteBmp(context.Background(), &api.DeleteBmpRequest{
+		Address: "127.0.0.1",
+		Port:    uint32(port),
+	}))
+	assert.Equal(uint8(bmp.BMP_MSG_TERMINATION), readBmpMessage(t, conn).Header.Type)
+}
+
+func TestBmpPassiveTLS(t *testing.T) {
+	assert := assert.New(t)
+	s := newBmpTestServer(t)
+
+	dir := t.TempDir()
+	caFile, cert := newTestCertificate(t, dir)
+	der, err := x509.MarshalECPrivateKey(cert.PrivateKey.(*ecdsa.PrivateKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyFile := filepath.Join(dir, "key.pem")
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := l.Addr().(*net.TCPAddr).Port
+	l.Close()
+
+	// the certificate is mandatory
+	err = s.AddBmp(context.Background(), &api.AddBmpRequest{
+		Address:   "127.0.0.1",
+		Port:      uint32(port),
+		Mode:      api.AddBmpRequest_PASSIVE,
+		Transport: api.AddBmpRequest_TLS,
+	})
+	assert.Error(err)
+
+	err = s.AddBmp(context.Background(), &api.AddBmpRequest{
+		Address:      "127.0.0.1",
+		Port:         uint32(port),
+		Mode:         api.AddBmpRequest_PASSIVE,
+		LocalAddress: "127.0.0.1",
+		Transport:    api.AddBmpRequest_TLS,
+		Tls:          &api.BMPTLSConf{CertFile: caFile, KeyFile: keyFile},
+	})
+	assert.NoError(err)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(mustParseCertificate(t, cert.Certificate[0]))
+	dial := func() *tls.Conn {
+		conn, err := tls.Dial("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)), &tls.Config{
+			RootCAs:    pool,
+			ServerName: "rpki.example",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return conn
+	}
+	conn := dial()
+	defer conn.Close()
+	assert.Equal(uint8(bmp.BMP_MSG_INITIATION), readBmpMessage(t, conn).Header.Type)
+	assert.Equal(api.AddBmpRequest_PASSIVE, listBmpStation(t, s).Conf.Mode)
+
+	// only one session is served at a time
+	conn2, err := net.Dial("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn2.Close()
+	conn2.SetReadDeadline(time.Now().Add(10 * time.Second))
+	_, err = conn2.Read(make([]byte, 1))
+	assert.Error(err)
+
+	// the collector reconnects
+	conn.Close()
+	assert.Eventually(func() bool {
+		st := listBmpStation(t, s).State
+		return st.Downtime.AsTime().Unix() >= st.Uptime.AsTime().Unix()
+	}, 5*time.Second, 10*time.Millisecond)
+	conn = dial()
+	defer conn.Close()
+	assert.Equal(uint8(bmp.BMP_MSG_INITIATION), readBmpMessage(t, conn).Header.Type)
+
+	assert.NoError(s.DeleteBmp(context.Background(), &api.DeleteBmpRequest{
+		Address: "127.0.0.1",
+		Port:    uint32(port),
+	}))
+	assert.Equal(uint8(bmp.BMP_MSG_TERMINATION), readBmpMessage(t, conn).Header.Type)
+}
+
+func mustParseCertificate(t *testing.T, der []byte) *x509.Certificate {
+	c, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestBmpRetryInterval(t *testing.T) {
+	assert := assert.New(t)
+
+	b := &bmpClient{retryInterval: bmpMinReconnectInterval}
+	l := []time.Duration{}
+	for i := 0; i < 8; i++ {
+		l = append(l, b.nextRetryInterval())
+	}
+	assert.Equal([]time.Duration{
+		time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second,
+		16 * time.Second, 32 * time.Second, time.Minute, time.Minute,
+	}, l)
+
+	_, err := newBmpTLSConfig(&oc.BmpServerConfig{Mode: oc.BMP_MODE_TYPE_ACTIVE})
+	assert.NoError(err)
+	_, err = newBmpTLSConfig(&oc.BmpServerConfig{Mode: oc.BMP_MODE_TYPE_PASSIVE})
+	assert.Error(err)
+	_, err = newBmpTLSConfig(&oc.BmpServerConfig{TlsCaFile: "/nonexistent"})
+	assert.Error(err)
+}
+
+func TestBmpRouteMirroring(t *testing.T) {
+	assert := assert.New(t)
+
+	m := newBmpRouteMirroring(&oc.BmpServerConfig{
+		RouteMirroringNeighborList: []string{"10.0.0.1", "2001:db8::1"},
+		RouteMirroringRateLimit:    2,
+	})
+	mirror := func(addr string) []bool {
+		ok, lost := m.mirror(net.ParseIP(addr))
+		return []bool{ok, lost}
+	}
+	assert.Equal([]bool{false, false}, mirror("10.0.0.2"))
+	assert.Equal([]bool{true, false}, mirror("10.0.0.1"))
+	assert.Equal([]bool{true, false}, mirror("2001:db8::1"))
+	// over the limit
+	assert.Equal([]bool{false, false}, mirror("10.0.0.1"))
+	assert.Eventually(func() bool {
+		ok, _ := m.mirror(net.ParseIP("2001:db8::1"))
+		return ok
+	}, 5*time.Second, 100*time.Millisecond)
+	assert.Eventually(func() bool {
+		ok, lost := m.mirror(net.ParseIP("10.0.0.1").To4())
+		assert.True(!ok || lost)
+		return ok
+	}, 5*time.Second, 100*time.Millisecond)
+	assert.Empty(m.lost)
+
+	m = newBmpRouteMirroring(&oc.BmpServerConfig{})
+	for i := 0; i < 100; i++ {
+		assert.Equal([]bool{true, false}, mirror("10.0.0.2"))
+	}
+}
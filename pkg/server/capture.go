@@ -0,0 +1,149 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/log"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+const (
+	debugCaptureDefaultSize = 1000
+	debugCaptureMaxSize     = 100000
+)
+
+type capturedMessage struct {
+	timestamp time.Time
+	isIn      bool
+	data      []byte
+	// the options in effect when the message was sent or received, to
+	// decode the message
+	options *bgp.MarshallingOption
+}
+
+func (m *capturedMessage) toApi() *api.DebugCaptureMessage {
+	msg := &api.DebugCaptureMessage{
+		Timestamp: timestamppb.New(m.timestamp),
+		Direction: api.DebugCaptureMessage_SENT,
+		Hex:       hex.EncodeToString(m.data),
+	}
+	if m.isIn {
+		msg.Direction = api.DebugCaptureMessage_RECEIVED
+	}
+	if b, err := bgp.ParseBGPMessage(m.data, m.options); err == nil {
+		if j, err := json.Marshal(b); err == nil {
+			msg.Json = string(j)
+		}
+	}
+	return msg
+}
+
+// debugCapture keeps the latest BGP messages sent to and received from a
+// neighbor in a ring buffer, and writes them to the file if any. The
+// messages are added by the goroutines of the fsm and decoded only when
+// listed.
+type debugCapture struct {
+	mu       sync.Mutex
+	logger   log.Logger
+	address  string
+	size     int
+	messages []capturedMessage
+	// the index of the oldest message once the buffer is full
+	next int
+	file *captureFile
+}
+
+func newDebugCapture(logger log.Logger, address string, size int, file *captureFile) *debugCapture {
+	if size == 0 {
+		size = debugCaptureDefaultSize
+	}
+	return &debugCapture{
+		logger:   logger,
+		address:  address,
+		size:     size,
+		messages: make([]capturedMessage, 0, size),
+		file:     file,
+	}
+}
+
+// add records the message of the session between the local and the remote
+// addresses.
+func (c *debugCapture) add(data []byte, isIn bool, options *bgp.MarshallingOption, local, remote net.Addr) {
+	m := capturedMessage{
+		timestamp: time.Now(),
+		isIn:      isIn,
+		data:      append([]byte(nil), data...),
+		options:   options,
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.file != nil {
+		if err := c.file.write(&m, newCaptureEndpoint(local), newCaptureEndpoint(remote)); err != nil {
+			c.logger.Warn("failed to write debug capture file, stopped writing",
+				log.Fields{
+					"Topic": "Peer",
+					"Key":   c.address,
+					"File":  c.file.name,
+					"Error": err})
+			c.file.close()
+			c.file = nil
+		}
+	}
+	if len(c.messages) < c.size {
+		c.messages = append(c.messages, m)
+		return
+	}
+	c.messages[c.next] = m
+	c.next = (c.next + 1) % c.size
+}
+
+// close closes the file if any.
+func (c *debugCapture) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.file != nil {
+		c.file.close()
+		c.file = nil
+	}
+}
+
+// fileName returns the name of the file written, or empty if none.
+func (c *debugCapture) fileName() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.file == nil {
+		return ""
+	}
+	return c.file.name
+}
+
+// list returns the messages, oldest first.
+func (c *debugCapture) list() []capturedMessage {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	l := make([]capturedMessage, 0, len(c.messages))
+	l = append(l, c.messages[c.next:]...)
+	return append(l, c.messages[:c.next]...)
+}
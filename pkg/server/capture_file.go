@@ -0,0 +1,249 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	api "github.com/osrg/gobgp/v3/api"
+)
+
+const (
+	// the number of the old files kept on the rotation
+	captureFileRotated = 5
+
+	pcapngSectionHeaderBlock   = 0x0a0d0d0a
+	pcapngInterfaceDescBlock   = 0x00000001
+	pcapngEnhancedPacketBlock  = 0x00000006
+	pcapngByteOrderMagic       = 0x1a2b3c4d
+	pcapngLinkTypeRaw          = 101
+	pcapngOptionEnd            = 0
+	pcapngOptionIfTsresol      = 9
+	pcapngTsresolNanosecond    = 9
+	captureTcpMaxSegmentLength = 65535 - 20 - 20
+)
+
+// captureEndpoint is the address and the port of the BGP session written
+// in the fake IP and TCP headers.
+type captureEndpoint struct {
+	ip   net.IP
+	port uint16
+}
+
+func newCaptureEndpoint(addr net.Addr) captureEndpoint {
+	host, port := hostport(addr)
+	if i := strings.IndexByte(host, '%'); i >= 0 {
+		host = host[:i]
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		ip = net.IPv4zero
+	}
+	return captureEndpoint{ip: ip, port: port}
+}
+
+// captureFile writes the captured messages of a neighbor to the file in
+// pcapng or the raw format, rotated by the size.
+type captureFile struct {
+	name         string
+	format       api.StartDebugCaptureRequest_Format
+	rotationSize uint64
+	file         *os.File
+	size         uint64
+	// the size of the pcapng header at the beginning of the file
+	headerSize uint64
+	// the next TCP sequence numbers of the received and the sent messages
+	seq [2]uint32
+}
+
+func newCaptureFile(name string, format api.StartDebugCaptureRequest_Format, rotationSize uint64) (*captureFile, error) {
+	switch format {
+	case api.StartDebugCaptureRequest_PCAPNG, api.StartDebugCaptureRequest_RAW:
+	default:
+		return nil, fmt.Errorf("unknown capture format: %v", format)
+	}
+	f := &captureFile{
+		name:         name,
+		format:       format,
+		rotationSize: rotationSize,
+	}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *captureFile) open() error {
+	file, err := os.OpenFile(f.name, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	f.file = file
+	f.size = 0
+	if f.format == api.StartDebugCaptureRequest_PCAPNG {
+		if err := f.writeBytes(pcapngHeader()); err != nil {
+			return err
+		}
+	}
+	f.headerSize = f.size
+	return nil
+}
+
+func (f *captureFile) rotate() error {
+	f.file.Close()
+	for i := captureFileRotated - 1; i > 0; i-- {
+		os.Rename(fmt.Sprintf("%s.%d", f.name, i), fmt.Sprintf("%s.%d", f.name, i+1))
+	}
+	if err := os.Rename(f.name, f.name+".1"); err != nil {
+		return err
+	}
+	return f.open()
+}
+
+func (f *captureFile) writeBytes(b []byte) error {
+	_, err := f.file.Write(b)
+	f.size += uint64(len(b))
+	return err
+}
+
+func (f *captureFile) write(m *capturedMessage, local, remote captureEndpoint) error {
+	var b []byte
+	if f.format == api.StartDebugCaptureRequest_RAW {
+		b = make([]byte, 13, 13+len(m.data))
+		binary.BigEndian.PutUint64(b, uint64(m.timestamp.UnixNano()))
+		if !m.isIn {
+			b[8] = 1
+		}
+		binary.BigEndian.PutUint32(b[9:], uint32(len(m.data)))
+		b = append(b, m.data...)
+	} else {
+		src, dst, dir := local, remote, 1
+		if m.isIn {
+			src, dst, dir = remote, local, 0
+		}
+		ts := uint64(m.timestamp.UnixNano())
+		for data := m.data; len(data) > 0; {
+			n := len(data)
+			if n > captureTcpMaxSegmentLength {
+				n = captureTcpMaxSegmentLength
+			}
+			packet := fakeTcpPacket(src, dst, f.seq[dir], f.seq[1-dir], data[:n])
+			b = append(b, pcapngPacket(ts, packet)...)
+			f.seq[dir] += uint32(n)
+			data = data[n:]
+		}
+	}
+	if f.rotationSize > 0 && f.size > f.headerSize && f.size+uint64(len(b)) > f.rotationSize {
+		if err := f.rotate(); err != nil {
+			return err
+		}
+	}
+	return f.writeBytes(b)
+}
+
+func (f *captureFile) close() {
+	f.file.Close()
+}
+
+func pcapngBlock(typ uint32, body []byte) []byte {
+	padded := (len(body) + 3) &^ 3
+	l := uint32(12 + padded)
+	b := make([]byte, l)
+	binary.LittleEndian.PutUint32(b, typ)
+	binary.LittleEndian.PutUint32(b[4:], l)
+	copy(b[8:], body)
+	binary.LittleEndian.PutUint32(b[l-4:], l)
+	return b
+}
+
+// pcapngHeader returns the section header block and the interface
+// description block of the raw IP packets with the timestamps in
+// nanoseconds.
+func pcapngHeader() []byte {
+	shb := make([]byte, 16)
+	binary.LittleEndian.PutUint32(shb, pcapngByteOrderMagic)
+	binary.LittleEndian.PutUint16(shb[4:], 1)
+	binary.LittleEndian.PutUint16(shb[6:], 0)
+	// the section length isn't specified
+	binary.LittleEndian.PutUint64(shb[8:], ^uint64(0))
+
+	idb := make([]byte, 20)
+	binary.LittleEndian.PutUint16(idb, pcapngLinkTypeRaw)
+	binary.LittleEndian.PutUint16(idb[8:], pcapngOptionIfTsresol)
+	binary.LittleEndian.PutUint16(idb[10:], 1)
+	idb[12] = pcapngTsresolNanosecond
+	binary.LittleEndian.PutUint16(idb[16:], pcapngOptionEnd)
+
+	return append(pcapngBlock(pcapngSectionHeaderBlock, shb), pcapngBlock(pcapngInterfaceDescBlock, idb)...)
+}
+
+func pcapngPacket(ts uint64, packet []byte) []byte {
+	body := make([]byte, 20+len(packet))
+	binary.LittleEndian.PutUint32(body[4:], uint32(ts>>32))
+	binary.LittleEndian.PutUint32(body[8:], uint32(ts))
+	binary.LittleEndian.PutUint32(body[12:], uint32(len(packet)))
+	binary.LittleEndian.PutUint32(body[16:], uint32(len(packet)))
+	copy(body[20:], packet)
+	return pcapngBlock(pcapngEnhancedPacketBlock, body)
+}
+
+// fakeTcpPacket returns the IPv4 or IPv6 packet of the TCP segment with the
+// PSH and ACK flags carrying the data. The TCP checksum isn't calculated.
+func fakeTcpPacket(src, dst captureEndpoint, seq, ack uint32, data []byte) []byte {
+	tcp := make([]byte, 20, 20+len(data))
+	binary.BigEndian.PutUint16(tcp, src.port)
+	binary.BigEndian.PutUint16(tcp[2:], dst.port)
+	binary.BigEndian.PutUint32(tcp[4:], seq)
+	binary.BigEndian.PutUint32(tcp[8:], ack)
+	tcp[12] = 5 << 4
+	tcp[13] = 0x18
+	binary.BigEndian.PutUint16(tcp[14:], 65535)
+	tcp = append(tcp, data...)
+
+	src4, dst4 := src.ip.To4(), dst.ip.To4()
+	if src4 != nil && dst4 != nil {
+		ip := make([]byte, 20, 20+len(tcp))
+		ip[0] = 0x45
+		binary.BigEndian.PutUint16(ip[2:], uint16(20+len(tcp)))
+		// don't fragment
+		ip[6] = 0x40
+		ip[8] = 64
+		ip[9] = 6
+		copy(ip[12:], src4)
+		copy(ip[16:], dst4)
+		var sum uint32
+		for i := 0; i < 20; i += 2 {
+			sum += uint32(binary.BigEndian.Uint16(ip[i:]))
+		}
+		for sum > 0xffff {
+			sum = sum&0xffff + sum>>16
+		}
+		binary.BigEndian.PutUint16(ip[10:], ^uint16(sum))
+		return append(ip, tcp...)
+	}
+	ip := make([]byte, 40, 40+len(tcp))
+	ip[0] = 0x60
+	binary.BigEndian.PutUint16(ip[4:], uint16(len(tcp)))
+	ip[6] = 6
+	ip[7] = 64
+	copy(ip[8:], src.ip.To16())
+	copy(ip[24:], dst.ip.To16())
+	return append(ip, tcp...)
+}
@@ -0,0 +1,168 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/apiutil"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func TestDebugCaptureRing(t *testing.T) {
+	assert := assert.New(t)
+
+	c := newDebugCapture(nil, "", 3, nil)
+	for i := 0; i < 5; i++ {
+		c.add([]byte{byte(i)}, i%2 == 0, nil, nil, nil)
+	}
+	l := c.list()
+	assert.Len(l, 3)
+	for i, m := range l {
+		assert.Equal([]byte{byte(i + 2)}, m.data)
+		assert.Equal(i%2 == 0, m.isIn)
+	}
+
+	keepalive, _ := bgp.NewBGPKeepAliveMessage().Serialize()
+	c = newDebugCapture(nil, "", 0, nil)
+	assert.Equal(debugCaptureDefaultSize, c.size)
+	c.add(keepalive, false, nil, nil, nil)
+	m := c.list()[0].toApi()
+	assert.Equal(api.DebugCaptureMessage_SENT, m.Direction)
+	assert.Equal("ffffffffffffffffffffffffffffffff001304", m.Hex)
+	assert.Equal(`{"Header":{"Marker":null,"Len":19,"Type":4},"Body":{}}`, m.Json)
+}
+
+func TestCaptureFile(t *testing.T) {
+	assert := assert.New(t)
+
+	keepalive, _ := bgp.NewBGPKeepAliveMessage().Serialize()
+	now := time.Unix(1, 2)
+	local := newCaptureEndpoint(&net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 179})
+	remote := newCaptureEndpoint(&net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 50000})
+	dir := t.TempDir()
+
+	name := filepath.Join(dir, "raw")
+	f, err := newCaptureFile(name, api.StartDebugCaptureRequest_RAW, 0)
+	assert.NoError(err)
+	assert.NoError(f.write(&capturedMessage{timestamp: now, isIn: true, data: keepalive}, local, remote))
+	f.close()
+	b, err := os.ReadFile(name)
+	assert.NoError(err)
+	assert.Equal(append([]byte{0, 0, 0, 0, 0x3b, 0x9a, 0xca, 0x02, 0, 0, 0, 0, 19}, keepalive...), b)
+
+	name = filepath.Join(dir, "pcapng")
+	f, err = newCaptureFile(name, api.StartDebugCaptureRequest_PCAPNG, 300)
+	assert.NoError(err)
+	for i := 0; i < 4; i++ {
+		assert.NoError(f.write(&capturedMessage{timestamp: now, isIn: i%2 == 0, data: keepalive}, local, remote))
+	}
+	f.close()
+	// the header blocks and the packet blocks of the 59-byte IPv4 packets
+	headerLen := len(pcapngHeader())
+	packetLen := 32 + 60 // padded
+	for _, n := range []string{name + ".1", name} {
+		b, err = os.ReadFile(n)
+		assert.NoError(err)
+		assert.Equal(headerLen+2*packetLen, len(b), n)
+		assert.Equal([]byte{0x0a, 0x0d, 0x0d, 0x0a}, b[:4])
+		packet := b[headerLen+28 : headerLen+28+59]
+		assert.Equal(byte(0x45), packet[0])
+		assert.Equal(keepalive, packet[40:])
+	}
+	b, _ = os.ReadFile(name)
+	packet := b[headerLen+28:]
+	// the second message is sent
+	packet = packet[packetLen:]
+	assert.Equal([]byte{10, 0, 0, 1, 10, 0, 0, 2}, packet[12:20])
+	assert.Equal([]byte{0, 179, 0xc3, 0x50}, packet[20:24])
+	// the sequence numbers continue across the rotation
+	assert.Equal([]byte{0, 0, 0, 19, 0, 0, 0, 38}, packet[24:32])
+}
+
+func TestDebugCapture(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s1 := runNewServer(t, 1, "1.1.1.1", 10199)
+	defer s1.StopBgp(ctx, &api.StopBgpRequest{})
+	s2 := runNewServer(t, 2, "2.2.2.2", 20199)
+	defer s2.StopBgp(ctx, &api.StopBgpRequest{})
+
+	list := func() ([]*api.DebugCaptureMessage, error) {
+		l := make([]*api.DebugCaptureMessage, 0)
+		err := s1.ListDebugCapture(ctx, &api.ListDebugCaptureRequest{Address: "127.0.0.1"}, func(m *api.DebugCaptureMessage) {
+			l = append(l, m)
+		})
+		return l, err
+	}
+
+	assert.Error(s1.StartDebugCapture(ctx, &api.StartDebugCaptureRequest{Address: "127.0.0.1"}))
+	if err := peerServers(t, ctx, []*BgpServer{s1, s2}, []oc.AfiSafiType{oc.AFI_SAFI_TYPE_IPV4_UNICAST}); err != nil {
+		t.Fatal(err)
+	}
+	_, err := list()
+	assert.Error(err)
+	assert.Error(s1.StartDebugCapture(ctx, &api.StartDebugCaptureRequest{Address: "127.0.0.1", Size: debugCaptureMaxSize + 1}))
+	file := filepath.Join(t.TempDir(), "capture.pcapng")
+	assert.NoError(s1.StartDebugCapture(ctx, &api.StartDebugCaptureRequest{Address: "127.0.0.1", File: file}))
+
+	for i, s := range []*BgpServer{s1, s2} {
+		path, _ := apiutil.NewPath(bgp.NewIPAddrPrefix(24, fmt.Sprintf("10.0.%d.0", i)), false, []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop("127.0.0.1"),
+		}, time.Now())
+		_, err = s.AddPath(ctx, &api.AddPathRequest{TableType: api.TableType_GLOBAL, Path: path})
+		assert.NoError(err)
+	}
+
+	assert.Eventually(func() bool {
+		l, err := list()
+		if err != nil {
+			return false
+		}
+		var sent, received bool
+		for _, m := range l {
+			assert.NotEmpty(m.Hex)
+			switch {
+			case m.Direction == api.DebugCaptureMessage_SENT && strings.Contains(m.Json, "10.0.0.0/24"):
+				sent = true
+			case m.Direction == api.DebugCaptureMessage_RECEIVED && strings.Contains(m.Json, "10.0.1.0/24"):
+				received = true
+			}
+		}
+		return sent && received
+	}, 10*time.Second, 100*time.Millisecond)
+
+	assert.NoError(s1.StopDebugCapture(ctx, &api.StopDebugCaptureRequest{Address: "127.0.0.1"}))
+	fi, err := os.Stat(file)
+	assert.NoError(err)
+	assert.Greater(fi.Size(), int64(len(pcapngHeader())))
+	assert.Error(s1.StopDebugCapture(ctx, &api.StopDebugCaptureRequest{Address: "127.0.0.1"}))
+	_, err = list()
+	assert.Error(err)
+}
@@ -0,0 +1,220 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net"
+	"sort"
+	"strconv"
+
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+// COLOR BASED ROUTE RESOLUTION
+//
+// RFC9256 8.4. Per-Destination Steering
+// RFC9256 8.8. Multiple Colors
+//
+// A service route with the Color Extended Community is resolved over the SR
+// policy whose color is the color of the route and whose endpoint is the
+// nexthop of the route. The highest color is used if the route has multiple
+// ones. The routes without such SR policy in the Loc-RIB are marked as
+// unresolved, that is, as their nexthops are invalid, so that they are
+// neither selected as the best paths nor advertised.
+
+func colorResolutionFamilies() []bgp.RouteFamily {
+	return []bgp.RouteFamily{bgp.RF_IPv4_UC, bgp.RF_IPv6_UC, bgp.RF_IPv4_VPN, bgp.RF_IPv6_VPN}
+}
+
+func isColorResolutionFamily(family bgp.RouteFamily) bool {
+	for _, f := range colorResolutionFamilies() {
+		if family == f {
+			return true
+		}
+	}
+	return false
+}
+
+// pathColor returns the highest color of the Color Extended Communities of
+// the path.
+func pathColor(path *table.Path) (uint32, bool) {
+	var color uint32
+	found := false
+	for _, ec := range path.GetExtCommunities() {
+		if c, ok := ec.(*bgp.ColorExtended); ok && (!found || c.Color > color) {
+			color = c.Color
+			found = true
+		}
+	}
+	return color, found
+}
+
+func colorKey(color uint32, endpoint net.IP) string {
+	return strconv.FormatUint(uint64(color), 10) + "|" + endpoint.String()
+}
+
+func srPolicyNLRI(path *table.Path) *bgp.SRPolicyNLRI {
+	switch n := path.GetNlri().(type) {
+	case *bgp.SRPolicyIPv4:
+		return &n.SRPolicyNLRI
+	case *bgp.SRPolicyIPv6:
+		return &n.SRPolicyNLRI
+	}
+	return nil
+}
+
+type colorResolver struct {
+	s       *BgpServer
+	enabled bool
+	// the distinguishers of the SR policies in the Loc-RIB keyed by the
+	// colors and the endpoints
+	policies map[string]map[uint32]struct{}
+	// the keys whose SR policies have appeared or disappeared
+	dirty map[string]struct{}
+}
+
+func newColorResolver(s *BgpServer) *colorResolver {
+	return &colorResolver{
+		s:        s,
+		policies: make(map[string]map[uint32]struct{}),
+		dirty:    make(map[string]struct{}),
+	}
+}
+
+// update updates the SR policies with the changes of the best paths in the
+// Loc-RIB.
+func (r *colorResolver) update(bestList []*table.Path) {
+	if !r.enabled {
+		return
+	}
+	for _, path := range bestList {
+		if path == nil {
+			continue
+		}
+		n := srPolicyNLRI(path)
+		if n == nil {
+			continue
+		}
+		key := colorKey(n.Color, net.IP(n.Endpoint))
+		m, y := r.policies[key]
+		if path.IsWithdraw {
+			if _, found := m[n.Distinguisher]; !found {
+				continue
+			}
+			delete(m, n.Distinguisher)
+			if len(m) > 0 {
+				continue
+			}
+			delete(r.policies, key)
+		} else {
+			if !y {
+				m = make(map[uint32]struct{})
+				r.policies[key] = m
+			}
+			m[n.Distinguisher] = struct{}{}
+			if y {
+				continue
+			}
+		}
+		r.dirty[key] = struct{}{}
+	}
+}
+
+// key returns the key of the SR policy which the path is resolved over, or
+// false if the path isn't subject to the resolution.
+func (r *colorResolver) key(path *table.Path) (string, bool) {
+	if !r.enabled || path == nil || path.IsWithdraw || !isColorResolutionFamily(path.GetRouteFamily()) {
+		return "", false
+	}
+	color, found := pathColor(path)
+	if !found {
+		return "", false
+	}
+	return colorKey(color, path.GetNexthop()), true
+}
+
+// resolve returns the path marked as resolved or unresolved with the SR
+// policies in the Loc-RIB. The path is cloned if the mark changes.
+func (r *colorResolver) resolve(path *table.Path) *table.Path {
+	key, ok := r.key(path)
+	if !ok {
+		return path
+	}
+	_, resolved := r.policies[key]
+	if path.IsNexthopInvalid == !resolved {
+		return path
+	}
+	path = path.Clone(false)
+	path.IsNexthopInvalid = !resolved
+	return path
+}
+
+// flush resolves again the paths in the Loc-RIB whose SR policies have
+// appeared or disappeared.
+func (r *colorResolver) flush() {
+	if len(r.dirty) == 0 {
+		return
+	}
+	dirty := r.dirty
+	r.dirty = make(map[string]struct{})
+	s := r.s
+	for _, path := range s.globalRib.GetPathList(table.GLOBAL_RIB_NAME, 0, colorResolutionFamilies()) {
+		key, ok := r.key(path)
+		if !ok {
+			continue
+		}
+		if _, y := dirty[key]; !y {
+			continue
+		}
+		if p := r.resolve(path); p != path {
+			if dsts := s.globalRib.Update(p); len(dsts) > 0 {
+				s.propagateUpdateToNeighbors(s.globalRib, nil, p, dsts, true)
+			}
+		}
+	}
+}
+
+// bindings returns the bindings of the paths in the Loc-RIB with the Color
+// Extended Community to the SR policies.
+func (r *colorResolver) bindings(families []bgp.RouteFamily, color uint32) []*colorBinding {
+	l := make([]*colorBinding, 0)
+	for _, path := range r.s.globalRib.GetPathList(table.GLOBAL_RIB_NAME, 0, families) {
+		if path.IsWithdraw || !isColorResolutionFamily(path.GetRouteFamily()) {
+			continue
+		}
+		c, found := pathColor(path)
+		if !found || (color != 0 && c != color) {
+			continue
+		}
+		b := &colorBinding{
+			path:  path,
+			color: c,
+		}
+		for d := range r.policies[colorKey(c, path.GetNexthop())] {
+			b.distinguishers = append(b.distinguishers, d)
+		}
+		sort.Slice(b.distinguishers, func(i, j int) bool { return b.distinguishers[i] < b.distinguishers[j] })
+		l = append(l, b)
+	}
+	return l
+}
+
+type colorBinding struct {
+	path           *table.Path
+	color          uint32
+	distinguishers []uint32
+}
@@ -0,0 +1,202 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/apiutil"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func TestColorResolution(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.StartBgp(ctx, &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:             1,
+			RouterId:        "1.1.1.1",
+			ListenPort:      -1,
+			ColorResolution: &api.ColorResolution{Enabled: true},
+		},
+	})
+	assert.NoError(err)
+	defer s.StopBgp(ctx, &api.StopBgpRequest{})
+
+	addPath := func(prefix string, color uint32) {
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop("10.255.0.1"),
+		}
+		if color != 0 {
+			attrs = append(attrs, bgp.NewPathAttributeExtendedCommunities([]bgp.ExtendedCommunityInterface{bgp.NewColorExtended(color)}))
+		}
+		path, _ := apiutil.NewPath(bgp.NewIPAddrPrefix(24, prefix), false, attrs, time.Now())
+		_, err := s.AddPath(ctx, &api.AddPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Path:      path,
+		})
+		assert.NoError(err)
+	}
+	policy := func(distinguisher, color uint32, endpoint string) *api.Path {
+		nlri := bgp.NewSRPolicyIPv4(96, distinguisher, color, net.ParseIP(endpoint).To4())
+		path, _ := apiutil.NewPath(nlri, false, []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeMpReachNLRI("10.255.0.2", []bgp.AddrPrefixInterface{nlri}),
+		}, time.Now())
+		return path
+	}
+	// returns whether the paths in the global RIB are selected as the best
+	// paths with the valid nexthops
+	bests := func() map[string]bool {
+		m := make(map[string]bool)
+		s.ListPath(ctx, &api.ListPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Family:    &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST},
+		}, func(d *api.Destination) {
+			for _, p := range d.Paths {
+				m[d.Prefix] = p.Best && !p.IsNexthopInvalid
+			}
+		})
+		return m
+	}
+	bindings := func(color uint32) []*api.ColorBinding {
+		l := make([]*api.ColorBinding, 0)
+		err := s.ListColorBinding(ctx, &api.ListColorBindingRequest{Color: color}, func(b *api.ColorBinding) {
+			l = append(l, b)
+		})
+		assert.NoError(err)
+		return l
+	}
+
+	// the colored route without the SR policy is unresolved
+	addPath("10.0.1.0", 100)
+	addPath("10.0.2.0", 200)
+	addPath("10.0.3.0", 0)
+	assert.Equal(map[string]bool{"10.0.1.0/24": false, "10.0.2.0/24": false, "10.0.3.0/24": true}, bests())
+	l := bindings(0)
+	assert.Len(l, 2)
+	for _, b := range l {
+		assert.False(b.Resolved)
+		assert.Empty(b.Distinguishers)
+	}
+
+	// the SR policy of another endpoint doesn't resolve the routes
+	p2 := policy(1, 100, "10.255.0.9")
+	_, err = s.AddPath(ctx, &api.AddPathRequest{TableType: api.TableType_GLOBAL, Path: p2})
+	assert.NoError(err)
+	assert.Equal(map[string]bool{"10.0.1.0/24": false, "10.0.2.0/24": false, "10.0.3.0/24": true}, bests())
+
+	p1 := policy(2, 100, "10.255.0.1")
+	_, err = s.AddPath(ctx, &api.AddPathRequest{TableType: api.TableType_GLOBAL, Path: p1})
+	assert.NoError(err)
+	assert.Equal(map[string]bool{"10.0.1.0/24": true, "10.0.2.0/24": false, "10.0.3.0/24": true}, bests())
+	l = bindings(100)
+	assert.Len(l, 1)
+	assert.Equal("10.0.1.0/24", l[0].Prefix)
+	assert.Equal("10.255.0.1", l[0].Nexthop)
+	assert.True(l[0].Resolved)
+	assert.Equal([]uint32{2}, l[0].Distinguishers)
+
+	// the route is unresolved again by the withdrawal of the SR policy
+	err = s.DeletePath(ctx, &api.DeletePathRequest{TableType: api.TableType_GLOBAL, Path: p1})
+	assert.NoError(err)
+	assert.Equal(map[string]bool{"10.0.1.0/24": false, "10.0.2.0/24": false, "10.0.3.0/24": true}, bests())
+	l = bindings(100)
+	assert.Len(l, 1)
+	assert.False(l[0].Resolved)
+
+	err = s.ListColorBinding(ctx, &api.ListColorBindingRequest{
+		Family: &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_SR_POLICY},
+	}, func(*api.ColorBinding) {})
+	assert.Error(err)
+}
+
+func TestColorResolutionConcurrent(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s := NewBgpServer(BestPathWorkers(4))
+	go s.Serve()
+	err := s.StartBgp(ctx, &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:             1,
+			RouterId:        "1.1.1.1",
+			ListenPort:      -1,
+			ColorResolution: &api.ColorResolution{Enabled: true},
+		},
+	})
+	assert.NoError(err)
+	defer s.StopBgp(ctx, &api.StopBgpRequest{})
+
+	nlri := bgp.NewSRPolicyIPv4(96, 1, 100, net.ParseIP("10.255.0.1").To4())
+	policy, err := apiutil.NewPath(nlri, false, []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeMpReachNLRI("10.255.0.2", []bgp.AddrPrefixInterface{nlri}),
+	}, time.Now())
+	assert.NoError(err)
+	_, err = s.AddPath(ctx, &api.AddPathRequest{TableType: api.TableType_GLOBAL, Path: policy})
+	assert.NoError(err)
+
+	// the routes of color 100 are resolved over the SR policy and the
+	// ones of color 200 aren't
+	pathList := make([]*table.Path, 0, 2*minConcurrentUpdatePaths)
+	for i := 0; i < 2*minConcurrentUpdatePaths; i++ {
+		color := uint32(100)
+		if i%2 == 1 {
+			color = 200
+		}
+		p, err := apiutil.NewPath(bgp.NewIPAddrPrefix(24, fmt.Sprintf("10.%d.%d.0", i/256, i%256)), false, []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop("10.255.0.1"),
+			bgp.NewPathAttributeExtendedCommunities([]bgp.ExtendedCommunityInterface{bgp.NewColorExtended(color)}),
+		}, time.Now())
+		assert.NoError(err)
+		path, err := api2Path(api.TableType_GLOBAL, p, false)
+		assert.NoError(err)
+		pathList = append(pathList, path)
+	}
+	// the paths are imported concurrently
+	assert.True(canUpdateConcurrently(pathList))
+	assert.NoError(s.addPathStream("", pathList))
+
+	resolved := 0
+	err = s.ListPath(ctx, &api.ListPathRequest{
+		TableType: api.TableType_GLOBAL,
+		Family:    &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST},
+	}, func(d *api.Destination) {
+		var i, j int
+		fmt.Sscanf(d.Prefix, "10.%d.%d.0/24", &i, &j)
+		p := d.Paths[0]
+		assert.Equal((i*256+j)%2 == 0, p.Best && !p.IsNexthopInvalid, d.Prefix)
+		if !p.IsNexthopInvalid {
+			resolved++
+		}
+	})
+	assert.NoError(err)
+	assert.Equal(minConcurrentUpdatePaths, resolved)
+}
@@ -0,0 +1,177 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/log"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+// conditionalAdvertisement advertises the paths matching the advertise
+// prefix set to the peer only while the paths matching the condition
+// prefix set exist, or don't exist, in the Loc-RIB.
+type conditionalAdvertisement struct {
+	c          oc.ConditionalAdvertisementConfig
+	advertised bool
+	// the condition needs to be evaluated again as the paths matching the
+	// condition prefix set have changed
+	dirty bool
+	// the version of the condition prefix set at the last evaluation
+	version uint64
+}
+
+// isConditionallyWithdrawn returns true if the path matches the advertise
+// prefix set of a conditional advertisement whose condition isn't met.
+func (peer *peer) isConditionallyWithdrawn(path *table.Path) bool {
+	for _, a := range peer.condAdvs {
+		if a.advertised {
+			continue
+		}
+		if m, _ := peer.policy.MatchPrefixSet(a.c.AdvertisePrefixSet, path); m {
+			return true
+		}
+	}
+	return false
+}
+
+func (peer *peer) matchAdvertisePrefixSets(path *table.Path, l []*conditionalAdvertisement) bool {
+	for _, a := range l {
+		if m, _ := peer.policy.MatchPrefixSet(a.c.AdvertisePrefixSet, path); m {
+			return true
+		}
+	}
+	return false
+}
+
+// prefixSetExists returns true if any best path in the Loc-RIB matches the
+// prefix set.
+func (s *BgpServer) prefixSetExists(name string) bool {
+	for _, path := range s.globalRib.GetBestPathList(table.GLOBAL_RIB_NAME, 0, []bgp.RouteFamily{bgp.RF_IPv4_UC, bgp.RF_IPv6_UC}) {
+		if m, _ := s.policy.MatchPrefixSet(name, path); m {
+			return true
+		}
+	}
+	return false
+}
+
+func validateConditionalAdvertisements(policy *table.RoutingPolicy, l []oc.ConditionalAdvertisement) error {
+	for _, a := range l {
+		for _, name := range []string{a.Config.AdvertisePrefixSet, a.Config.ConditionPrefixSet} {
+			sets, err := policy.GetDefinedSet(table.DEFINED_TYPE_PREFIX, name)
+			if err != nil {
+				return err
+			}
+			if len(sets.PrefixSets) == 0 {
+				return fmt.Errorf("not found prefix set %s", name)
+			}
+		}
+	}
+	return nil
+}
+
+// setConditionalAdvertisements replaces the conditional advertisements of
+// the peer, evaluating the conditions, and sends the changes of the paths.
+func (s *BgpServer) setConditionalAdvertisements(p *peer, l []oc.ConditionalAdvertisement) {
+	exists := make(map[string]bool)
+	condAdvs := make([]*conditionalAdvertisement, 0, len(l))
+	for _, c := range l {
+		a := &conditionalAdvertisement{
+			c:       c.Config,
+			version: s.policy.DefinedSetVersion(table.DEFINED_TYPE_PREFIX, c.Config.ConditionPrefixSet),
+		}
+		e, y := exists[a.c.ConditionPrefixSet]
+		if !y {
+			e = s.prefixSetExists(a.c.ConditionPrefixSet)
+			exists[a.c.ConditionPrefixSet] = e
+		}
+		a.advertised = e == (a.c.Condition == oc.CONDITIONAL_ADVERTISEMENT_CONDITION_TYPE_EXIST)
+		condAdvs = append(condAdvs, a)
+	}
+	old := p.condAdvs
+	s.reevaluateExports([]*peer{p}, func(path *table.Path) bool {
+		return p.matchAdvertisePrefixSets(path, old) || p.matchAdvertisePrefixSets(path, condAdvs)
+	}, func() {
+		p.condAdvs = condAdvs
+	})
+}
+
+// markConditionalAdvertisements marks the conditional advertisements whose
+// conditions need to be evaluated again with the changes of the best paths
+// in the Loc-RIB.
+func (s *BgpServer) markConditionalAdvertisements(bestList []*table.Path) {
+	matched := make(map[string]bool)
+	for _, peer := range s.neighborMap {
+		for _, a := range peer.condAdvs {
+			if a.dirty {
+				continue
+			}
+			m, y := matched[a.c.ConditionPrefixSet]
+			if !y {
+				for _, path := range bestList {
+					if path == nil {
+						continue
+					}
+					if m, _ = s.policy.MatchPrefixSet(a.c.ConditionPrefixSet, path); m {
+						break
+					}
+				}
+				matched[a.c.ConditionPrefixSet] = m
+			}
+			a.dirty = m
+		}
+	}
+}
+
+// flushConditionalAdvertisements evaluates the conditions marked or whose
+// prefix sets have changed, and sends the changes of the paths.
+func (s *BgpServer) flushConditionalAdvertisements() {
+	exists := make(map[string]bool)
+	for _, p := range s.neighborMap {
+		for _, a := range p.condAdvs {
+			version := s.policy.DefinedSetVersion(table.DEFINED_TYPE_PREFIX, a.c.ConditionPrefixSet)
+			if !a.dirty && a.version == version {
+				continue
+			}
+			a.dirty = false
+			a.version = version
+			e, y := exists[a.c.ConditionPrefixSet]
+			if !y {
+				e = s.prefixSetExists(a.c.ConditionPrefixSet)
+				exists[a.c.ConditionPrefixSet] = e
+			}
+			advertised := e == (a.c.Condition == oc.CONDITIONAL_ADVERTISEMENT_CONDITION_TYPE_EXIST)
+			if advertised == a.advertised {
+				continue
+			}
+			s.logger.Info("conditional advertisement changed",
+				log.Fields{
+					"Topic":      "Peer",
+					"Key":        p.ID(),
+					"PrefixSet":  a.c.AdvertisePrefixSet,
+					"Condition":  a.c.ConditionPrefixSet,
+					"Advertised": advertised})
+			s.reevaluateExports([]*peer{p}, func(path *table.Path) bool {
+				return p.matchAdvertisePrefixSets(path, []*conditionalAdvertisement{a})
+			}, func() {
+				a.advertised = advertised
+			})
+		}
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/apiutil"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func TestConditionalAdvertisement(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s1 := runNewServer(t, 1, "1.1.1.1", 10196)
+	defer s1.StopBgp(ctx, &api.StopBgpRequest{})
+	s2 := runNewServer(t, 2, "2.2.2.2", 20196)
+	defer s2.StopBgp(ctx, &api.StopBgpRequest{})
+
+	if err := peerServers(t, ctx, []*BgpServer{s1, s2}, []oc.AfiSafiType{oc.AFI_SAFI_TYPE_IPV4_UNICAST}); err != nil {
+		t.Fatal(err)
+	}
+	for name, prefix := range map[string]string{"adv": "10.0.1.0/24", "cond": "10.0.2.0/24"} {
+		err := s1.AddDefinedSet(ctx, &api.AddDefinedSetRequest{
+			DefinedSet: &api.DefinedSet{
+				DefinedType: api.DefinedType_PREFIX,
+				Name:        name,
+				Prefixes:    []*api.Prefix{{IpPrefix: prefix, MaskLengthMin: 24, MaskLengthMax: 24}},
+			},
+		})
+		assert.NoError(err)
+	}
+	addPath := func(s *BgpServer, prefix string) []byte {
+		path, _ := apiutil.NewPath(bgp.NewIPAddrPrefix(24, prefix), false, []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop(s.bgpConfig.Global.Config.RouterId),
+		}, time.Now())
+		rsp, err := s.AddPath(ctx, &api.AddPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Path:      path,
+		})
+		assert.NoError(err)
+		return rsp.Uuid
+	}
+	// returns the prefixes in the global RIB
+	prefixes := func(s *BgpServer) string {
+		l := make([]string, 0)
+		s.ListPath(ctx, &api.ListPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Family:    &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST},
+		}, func(d *api.Destination) {
+			l = append(l, d.Prefix)
+		})
+		sort.Strings(l)
+		return strings.Join(l, " ")
+	}
+	getPeer := func(s *BgpServer) *api.Peer {
+		var peer *api.Peer
+		s.ListPeer(ctx, &api.ListPeerRequest{}, func(p *api.Peer) {
+			peer = p
+		})
+		return peer
+	}
+	setConditionalAdvertisements := func(l []oc.ConditionalAdvertisement) error {
+		// the same as the one peerServers adds
+		n := &oc.Neighbor{
+			Config: oc.NeighborConfig{
+				NeighborAddress: "127.0.0.1",
+				PeerAs:          2,
+			},
+			AfiSafis: oc.AfiSafis{{
+				Config: oc.AfiSafiConfig{
+					AfiSafiName: oc.AFI_SAFI_TYPE_IPV4_UNICAST,
+					Enabled:     true,
+				},
+			}},
+			Transport: oc.Transport{
+				Config: oc.TransportConfig{
+					RemotePort:  20196,
+					PassiveMode: true,
+				},
+			},
+			Timers: oc.Timers{
+				Config: oc.TimersConfig{
+					ConnectRetry:           1,
+					IdleHoldTimeAfterReset: 1,
+				},
+			},
+			ApplyPolicy: oc.ApplyPolicy{
+				ConditionalAdvertisements: l,
+			},
+		}
+		_, err := s1.UpdatePeer(ctx, &api.UpdatePeerRequest{Peer: oc.NewPeerFromConfigStruct(n)})
+		return err
+	}
+	condAdv := func(condition oc.ConditionalAdvertisementConditionType) oc.ConditionalAdvertisement {
+		return oc.ConditionalAdvertisement{
+			Config: oc.ConditionalAdvertisementConfig{
+				AdvertisePrefixSet: "adv",
+				ConditionPrefixSet: "cond",
+				Condition:          condition,
+			},
+		}
+	}
+
+	addPath(s1, "10.0.1.0")
+	assert.Eventually(func() bool {
+		return prefixes(s2) == "10.0.1.0/24"
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// the prefix set must exist
+	unknown := condAdv(oc.CONDITIONAL_ADVERTISEMENT_CONDITION_TYPE_NON_EXIST)
+	unknown.Config.ConditionPrefixSet = "unknown"
+	err := setConditionalAdvertisements([]oc.ConditionalAdvertisement{unknown})
+	assert.Error(err)
+
+	// advertised while the condition prefix doesn't exist
+	err = setConditionalAdvertisements([]oc.ConditionalAdvertisement{condAdv(oc.CONDITIONAL_ADVERTISEMENT_CONDITION_TYPE_NON_EXIST)})
+	assert.NoError(err)
+	l := getPeer(s1).ApplyPolicy.ConditionalAdvertisements
+	assert.Len(l, 1)
+	assert.True(l[0].Advertised)
+
+	// withdrawn when the condition prefix appears in the Loc-RIB
+	uuid := addPath(s1, "10.0.2.0")
+	assert.Eventually(func() bool {
+		return prefixes(s2) == "10.0.2.0/24"
+	}, 10*time.Second, 100*time.Millisecond)
+	assert.False(getPeer(s1).ApplyPolicy.ConditionalAdvertisements[0].Advertised)
+
+	// advertised again when the condition prefix disappears
+	err = s1.DeletePath(ctx, &api.DeletePathRequest{
+		TableType: api.TableType_GLOBAL,
+		Uuid:      uuid,
+	})
+	assert.NoError(err)
+	assert.Eventually(func() bool {
+		return prefixes(s2) == "10.0.1.0/24"
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// the exist condition is the opposite
+	err = setConditionalAdvertisements([]oc.ConditionalAdvertisement{condAdv(oc.CONDITIONAL_ADVERTISEMENT_CONDITION_TYPE_EXIST)})
+	assert.NoError(err)
+	assert.Eventually(func() bool {
+		return prefixes(s2) == ""
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// the prefix set changes are evaluated too
+	err = s1.AddDefinedSet(ctx, &api.AddDefinedSetRequest{
+		DefinedSet: &api.DefinedSet{
+			DefinedType: api.DefinedType_PREFIX,
+			Name:        "cond",
+			Prefixes:    []*api.Prefix{{IpPrefix: "10.0.1.0/24", MaskLengthMin: 24, MaskLengthMax: 24}},
+		},
+	})
+	assert.NoError(err)
+	assert.Eventually(func() bool {
+		return prefixes(s2) == "10.0.1.0/24"
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// removing the conditional advertisement advertises the prefix
+	err = setConditionalAdvertisements(nil)
+	assert.NoError(err)
+	assert.Empty(getPeer(s1).ApplyPolicy.ConditionalAdvertisements)
+}
@@ -0,0 +1,239 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/log"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+// DYNAMIC CAPABILITY
+//
+// draft-ietf-idr-dynamic-cap
+//
+// The speakers which advertise the Dynamic Capability listing the
+// multiprotocol one add and remove the address families of the
+// established session with the CAPABILITY message. The other
+// capabilities, e.g. ADD-PATH and graceful restart, aren't revised; the
+// session is reset if the change of the configuration needs them.
+
+// isDynamicCapabilityNegotiated returns true if both the local and the
+// remote speakers revise the capability of the code dynamically.
+func (peer *peer) isDynamicCapabilityNegotiated(code bgp.BGPCapabilityCode) bool {
+	peer.fsm.lock.RLock()
+	defer peer.fsm.lock.RUnlock()
+	if !peer.fsm.pConf.Config.DynamicCapability {
+		return false
+	}
+	for _, c := range peer.fsm.capMap[bgp.BGP_CAP_DYNAMIC] {
+		if d, ok := c.(*bgp.CapDynamic); ok && d.IsDynamic(code) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSameCapabilitiesButFamilies returns true if the capabilities from the
+// configurations are the same except for the ones listing the address
+// families, which are either revised or not used.
+func isSameCapabilitiesButFamilies(x, y *oc.Neighbor) bool {
+	filter := func(n *oc.Neighbor) [][]byte {
+		l := make([][]byte, 0)
+		for _, c := range capabilitiesFromConfig(n) {
+			switch c.Code() {
+			case bgp.BGP_CAP_MULTIPROTOCOL, bgp.BGP_CAP_EXTENDED_NEXTHOP:
+				continue
+			}
+			b, _ := c.Serialize()
+			l = append(l, b)
+		}
+		return l
+	}
+	a, b := filter(x), filter(y)
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// reviseFamilies applies the address families of c to the established
+// session with the CAPABILITY message instead of resetting the session.
+// It returns false if the session needs to be reset to apply c.
+func (s *BgpServer) reviseFamilies(peer *peer, c *oc.Neighbor) bool {
+	peer.fsm.lock.RLock()
+	established := peer.fsm.state == bgp.BGP_FSM_ESTABLISHED
+	original := *peer.fsm.pConf
+	peer.fsm.lock.RUnlock()
+	if !established || !peer.isDynamicCapabilityNegotiated(bgp.BGP_CAP_MULTIPROTOCOL) {
+		return false
+	}
+
+	n := *c
+	n.AfiSafis = original.AfiSafis
+	if original.NeedsResendOpenMessage(&n) {
+		return false
+	}
+	added, removed, ok := oc.AfiSafiRevisions(original.AfiSafis, c.AfiSafis)
+	if !ok || len(added)+len(removed) == 0 {
+		return false
+	}
+
+	// keep the states of the families not revised
+	afiSafis := make([]oc.AfiSafi, 0, len(c.AfiSafis))
+	for _, a := range c.AfiSafis {
+		if o := original.GetAfiSafi(a.State.Family); o != nil {
+			a = *o
+		}
+		afiSafis = append(afiSafis, a)
+	}
+	n = original
+	n.AfiSafis = afiSafis
+	if !isSameCapabilitiesButFamilies(&original, &n) {
+		return false
+	}
+
+	revisions := make([]*bgp.BGPCapabilityRevision, 0, len(added)+len(removed))
+	for _, a := range added {
+		revisions = append(revisions, bgp.NewBGPCapabilityRevision(bgp.BGP_CAPABILITY_ACTION_ADVERTISE, bgp.NewCapMultiProtocol(a.State.Family)))
+	}
+	for _, a := range removed {
+		revisions = append(revisions, bgp.NewBGPCapabilityRevision(bgp.BGP_CAPABILITY_ACTION_REMOVE, bgp.NewCapMultiProtocol(a.State.Family)))
+	}
+
+	peer.fsm.lock.Lock()
+	peer.fsm.pConf.AfiSafis = afiSafis
+	peer.fsm.lock.Unlock()
+
+	s.logger.Info("revise address families with dynamic capability",
+		log.Fields{
+			"Topic":   "Peer",
+			"Key":     peer.ID(),
+			"Added":   len(added),
+			"Removed": len(removed)})
+	// the CAPABILITY message goes before the paths of the added families
+	peer.fsm.outgoingCh.In() <- &fsmOutgoingMsg{
+		Messages: []*bgp.BGPMessage{bgp.NewBGPCapabilityMessage(revisions)},
+	}
+	s.renegotiateFamilies(peer)
+	return true
+}
+
+// handleCapability revises the remote multiprotocol capabilities with the
+// CAPABILITY message from the peer.
+func (s *BgpServer) handleCapability(peer *peer, e *fsmMsg) {
+	m := e.MsgData.(*bgp.BGPMessage)
+	if !peer.isDynamicCapabilityNegotiated(bgp.BGP_CAP_MULTIPROTOCOL) {
+		s.logger.Warn("CAPABILITY received but the dynamic capability wasn't negotiated",
+			log.Fields{
+				"Topic": "Peer",
+				"Key":   peer.ID()})
+		return
+	}
+
+	revised := false
+	for _, r := range m.Body.(*bgp.BGPCapability).Revisions {
+		c, ok := r.Capability.(*bgp.CapMultiProtocol)
+		if !ok || r.Action > bgp.BGP_CAPABILITY_ACTION_REMOVE {
+			s.logger.Warn("ignore unsupported capability revision",
+				log.Fields{
+					"Topic":      "Peer",
+					"Key":        peer.ID(),
+					"Action":     r.Action,
+					"Capability": r.Capability.Code()})
+			continue
+		}
+		peer.fsm.lock.Lock()
+		caps := make([]bgp.ParameterCapabilityInterface, 0, len(peer.fsm.capMap[bgp.BGP_CAP_MULTIPROTOCOL])+1)
+		for _, old := range peer.fsm.capMap[bgp.BGP_CAP_MULTIPROTOCOL] {
+			if old.(*bgp.CapMultiProtocol).CapValue != c.CapValue {
+				caps = append(caps, old)
+			}
+		}
+		if r.Action == bgp.BGP_CAPABILITY_ACTION_ADVERTISE {
+			caps = append(caps, c)
+		}
+		peer.fsm.capMap[bgp.BGP_CAP_MULTIPROTOCOL] = caps
+		peer.fsm.lock.Unlock()
+		revised = true
+	}
+	if revised {
+		s.renegotiateFamilies(peer)
+	}
+}
+
+// renegotiateFamilies negotiates the address families of the session
+// again after the local or the remote multiprotocol capabilities are
+// revised. The paths from the peer of the families no longer negotiated
+// are withdrawn, and the paths of the newly negotiated families are sent
+// to the peer followed by End-of-RIB.
+func (s *BgpServer) renegotiateFamilies(peer *peer) {
+	peer.fsm.lock.Lock()
+	old := peer.fsm.rfMap
+	rfMap := negotiateFamilies(peer.fsm.capMap, peer.fsm.pConf)
+	peer.fsm.rfMap = rfMap
+	if o := peer.fsm.marshallingOptions; o != nil && o.AddPath != nil {
+		options := *o
+		options.AddPath = rfMap
+		peer.fsm.marshallingOptions = &options
+	}
+	peer.fsm.lock.Unlock()
+
+	var added, removed []bgp.RouteFamily
+	for family := range rfMap {
+		if _, ok := old[family]; !ok {
+			added = append(added, family)
+		}
+	}
+	for family := range old {
+		if _, ok := rfMap[family]; !ok {
+			removed = append(removed, family)
+		}
+	}
+	if len(added)+len(removed) == 0 {
+		return
+	}
+	s.logger.Info("address families renegotiated",
+		log.Fields{
+			"Topic":   "Peer",
+			"Key":     peer.ID(),
+			"Added":   added,
+			"Removed": removed})
+
+	if len(removed) > 0 {
+		if pathList := peer.DropAll(removed); len(pathList) > 0 {
+			s.propagateUpdate(peer, pathList)
+		}
+	}
+	if len(added) > 0 {
+		peer.adjRibIn.AddFamilies(added)
+		pathList, _ := s.getBestFromLocal(peer, added)
+		if !peer.isGracefulRestartEnabled() {
+			for _, family := range added {
+				pathList = append(pathList, table.NewEOR(family))
+			}
+		}
+		sendfsmOutgoingMsg(peer, pathList, nil, false)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apb "google.golang.org/protobuf/types/known/anypb"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func TestDynamicCapability(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s1 := runNewServer(t, 1, "1.1.1.1", 10179)
+	defer s1.StopBgp(ctx, &api.StopBgpRequest{})
+	s2 := runNewServer(t, 2, "2.2.2.2", -1)
+	defer s2.StopBgp(ctx, &api.StopBgpRequest{})
+
+	v4 := &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST}
+	v6 := &api.Family{Afi: api.Family_AFI_IP6, Safi: api.Family_SAFI_UNICAST}
+	origin, _ := apb.New(&api.OriginAttribute{Origin: 0})
+	nlri, _ := apb.New(&api.IPAddressPrefix{Prefix: "10.1.0.0", PrefixLen: 24})
+	nh, _ := apb.New(&api.NextHopAttribute{NextHop: "10.0.0.1"})
+	_, err := s1.AddPath(ctx, &api.AddPathRequest{
+		Path: &api.Path{Family: v4, Nlri: nlri, Pattrs: []*apb.Any{origin, nh}},
+	})
+	assert.Nil(err)
+	nlri, _ = apb.New(&api.IPAddressPrefix{Prefix: "2001:db8::", PrefixLen: 64})
+	mpReach, _ := apb.New(&api.MpReachNLRIAttribute{Family: v6, NextHops: []string{"2001:db8::1"}, Nlris: []*apb.Any{nlri}})
+	_, err = s1.AddPath(ctx, &api.AddPathRequest{
+		Path: &api.Path{Family: v6, Nlri: nlri, Pattrs: []*apb.Any{origin, mpReach}},
+	})
+	assert.Nil(err)
+
+	peer1 := func(families ...*api.Family) *api.Peer {
+		p := &api.Peer{
+			Conf:      &api.PeerConf{NeighborAddress: "127.0.0.1", PeerAsn: 2, DynamicCapability: true},
+			Transport: &api.Transport{PassiveMode: true},
+		}
+		for _, f := range families {
+			p.AfiSafis = append(p.AfiSafis, &api.AfiSafi{Config: &api.AfiSafiConfig{Family: f, Enabled: true}})
+		}
+		return p
+	}
+	peer2 := func(families ...*api.Family) *api.Peer {
+		p := &api.Peer{
+			Conf:      &api.PeerConf{NeighborAddress: "127.0.0.1", PeerAsn: 1, DynamicCapability: true},
+			Transport: &api.Transport{RemotePort: 10179},
+			Timers: &api.Timers{Config: &api.TimersConfig{
+				ConnectRetry:           1,
+				IdleHoldTimeAfterReset: 1,
+			}},
+		}
+		for _, f := range families {
+			p.AfiSafis = append(p.AfiSafis, &api.AfiSafi{Config: &api.AfiSafiConfig{Family: f, Enabled: true}})
+		}
+		return p
+	}
+
+	ch := make(chan struct{})
+	go waitEstablished(s2, ch)
+	assert.Nil(s1.AddPeer(ctx, &api.AddPeerRequest{Peer: peer1(v4)}))
+	assert.Nil(s2.AddPeer(ctx, &api.AddPeerRequest{Peer: peer2(v4)}))
+	<-ch
+
+	received := func(family *api.Family) int {
+		n := 0
+		err := s2.ListPath(ctx, &api.ListPathRequest{TableType: api.TableType_GLOBAL, Family: family}, func(d *api.Destination) {
+			n++
+		})
+		assert.Nil(err)
+		return n
+	}
+	assert.Eventually(func() bool {
+		return received(v4) == 1
+	}, 5*time.Second, 100*time.Millisecond)
+	assert.Equal(0, received(v6))
+
+	opens := func() uint64 {
+		var n uint64
+		err := s2.ListPeer(ctx, &api.ListPeerRequest{}, func(p *api.Peer) {
+			n = p.State.Messages.Received.Open
+		})
+		assert.Nil(err)
+		return n
+	}
+	assert.Equal(uint64(1), opens())
+	assert.True(s2.neighborMap["127.0.0.1"].isDynamicCapabilityNegotiated(bgp.BGP_CAP_MULTIPROTOCOL))
+
+	// IPv6 unicast is negotiated once both add it
+	_, err = s1.UpdatePeer(ctx, &api.UpdatePeerRequest{Peer: peer1(v4, v6)})
+	assert.Nil(err)
+	_, err = s2.UpdatePeer(ctx, &api.UpdatePeerRequest{Peer: peer2(v4, v6)})
+	assert.Nil(err)
+	assert.Eventually(func() bool {
+		return received(v6) == 1
+	}, 5*time.Second, 100*time.Millisecond)
+	assert.Equal(1, received(v4))
+
+	// the paths are withdrawn once either removes it
+	_, err = s1.UpdatePeer(ctx, &api.UpdatePeerRequest{Peer: peer1(v4)})
+	assert.Nil(err)
+	assert.Eventually(func() bool {
+		return received(v6) == 0
+	}, 5*time.Second, 100*time.Millisecond)
+	assert.Equal(1, received(v4))
+
+	// the session is kept
+	assert.Equal(uint64(1), opens())
+}
@@ -0,0 +1,289 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/eapache/channels"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/log"
+)
+
+// eventBacklogEntry is the event recorded in the event backlog.
+type eventBacklogEntry struct {
+	sequence  uint64
+	timestamp time.Time
+	typ       watchEventType
+	ev        watchEvent
+	// response is set instead of ev for the event loaded from the journal.
+	response *api.WatchEventResponse
+}
+
+// toAPI converts the entry into the responses of WatchEvent with the
+// sequence number and the timestamp.
+func (e *eventBacklogEntry) toAPI(batchSize uint32, fn func(*api.WatchEventResponse)) {
+	if e.response != nil {
+		fn(proto.Clone(e.response).(*api.WatchEventResponse))
+		return
+	}
+	watchEventToAPI(e.ev, batchSize, func(r *api.WatchEventResponse) {
+		r.Timestamp = timestamppb.New(e.timestamp)
+		r.Sequence = e.sequence
+		fn(r)
+	})
+}
+
+// eventBacklog is the ring buffer of the latest best path and peer state
+// events. It's accessed only in the goroutine of the server.
+type eventBacklog struct {
+	entries  []*eventBacklogEntry
+	next     int
+	full     bool
+	sequence uint64
+	journal  *eventJournal
+}
+
+func newEventBacklog(size int) *eventBacklog {
+	return &eventBacklog{
+		entries: make([]*eventBacklogEntry, size),
+	}
+}
+
+func isBacklogEventType(typ watchEventType) bool {
+	return typ == watchEventTypeBestPath || typ == watchEventTypePeerState
+}
+
+func (b *eventBacklog) add(e *eventBacklogEntry) {
+	b.entries[b.next] = e
+	b.next = (b.next + 1) % len(b.entries)
+	if b.next == 0 {
+		b.full = true
+	}
+}
+
+func (b *eventBacklog) record(timestamp time.Time, typ watchEventType, ev watchEvent) *eventBacklogEntry {
+	b.sequence++
+	e := &eventBacklogEntry{
+		sequence:  b.sequence,
+		timestamp: timestamp,
+		typ:       typ,
+		ev:        ev,
+	}
+	b.add(e)
+	if b.journal != nil {
+		b.journal.write(e)
+	}
+	return e
+}
+
+// since returns the events recorded at or after t and of which sequence
+// number is greater than sequence in the recorded order.
+func (b *eventBacklog) since(t time.Time, sequence uint64) []*eventBacklogEntry {
+	entries := b.entries[:b.next]
+	if b.full {
+		entries = append(append(make([]*eventBacklogEntry, 0, len(b.entries)), b.entries[b.next:]...), entries...)
+	}
+	for i, e := range entries {
+		if e.sequence > sequence && !e.timestamp.Before(t) {
+			return entries[i:]
+		}
+	}
+	return nil
+}
+
+// openJournal loads the events from the journal file and appends the events
+// recorded after that to it.
+func (b *eventBacklog) openJournal(path string, logger log.Logger) error {
+	responses, err := readEventJournal(path, len(b.entries))
+	if err != nil {
+		return err
+	}
+	for _, r := range responses {
+		typ := watchEventTypeBestPath
+		if r.GetPeer() != nil {
+			typ = watchEventTypePeerState
+		}
+		b.add(&eventBacklogEntry{
+			sequence:  r.Sequence,
+			timestamp: r.Timestamp.AsTime(),
+			typ:       typ,
+			response:  r,
+		})
+		b.sequence = r.Sequence
+	}
+	b.journal, err = newEventJournal(path, len(b.entries), responses, logger)
+	return err
+}
+
+func (b *eventBacklog) close() {
+	if b.journal != nil {
+		b.journal.close()
+		b.journal = nil
+	}
+}
+
+// eventJournal appends the events recorded in the event backlog to the file
+// so that the backlog survives restarts. Each record of the file is the
+// WatchEventResponse prefixed with its length in uvarint. The file is
+// rewritten with the latest records when it has twice as many records as
+// the backlog.
+type eventJournal struct {
+	path    string
+	size    int
+	file    *os.File
+	writer  *bufio.Writer
+	records [][]byte
+	written int
+	ch      *channels.InfiniteChannel
+	done    chan struct{}
+	logger  log.Logger
+}
+
+// readEventJournal returns the latest records up to size in the file. The
+// truncated record at the end, which is left by a crash, is ignored.
+func readEventJournal(path string, size int) ([]*api.WatchEventResponse, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	responses := make([]*api.WatchEventResponse, 0, size)
+	for {
+		l, err := binary.ReadUvarint(r)
+		if err != nil {
+			break
+		}
+		buf := make([]byte, l)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			break
+		}
+		res := &api.WatchEventResponse{}
+		if err := proto.Unmarshal(buf, res); err != nil {
+			return nil, fmt.Errorf("invalid event journal %s: %w", path, err)
+		}
+		if len(responses) == size {
+			responses = responses[1:]
+		}
+		responses = append(responses, res)
+	}
+	return responses, nil
+}
+
+func marshalEventJournalRecord(r *api.WatchEventResponse) []byte {
+	buf, _ := proto.Marshal(r)
+	return append(binary.AppendUvarint(make([]byte, 0, binary.MaxVarintLen64+len(buf)), uint64(len(buf))), buf...)
+}
+
+func newEventJournal(path string, size int, responses []*api.WatchEventResponse, logger log.Logger) (*eventJournal, error) {
+	j := &eventJournal{
+		path:    path,
+		size:    size,
+		records: make([][]byte, 0, size),
+		ch:      channels.NewInfiniteChannel(),
+		done:    make(chan struct{}),
+		logger:  logger,
+	}
+	for _, r := range responses {
+		j.records = append(j.records, marshalEventJournalRecord(r))
+	}
+	if err := j.rewrite(); err != nil {
+		return nil, err
+	}
+	go j.loop()
+	return j, nil
+}
+
+// rewrite replaces the file with the one having only the latest records.
+func (j *eventJournal) rewrite() error {
+	if j.file != nil {
+		j.file.Close()
+	}
+	tmp := j.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	for _, record := range j.records {
+		w.Write(record)
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := os.Rename(tmp, j.path); err != nil {
+		f.Close()
+		return err
+	}
+	j.file = f
+	j.writer = bufio.NewWriter(f)
+	j.written = 0
+	return nil
+}
+
+func (j *eventJournal) write(e *eventBacklogEntry) {
+	j.ch.In() <- e
+}
+
+func (j *eventJournal) loop() {
+	defer close(j.done)
+	for v := range j.ch.Out() {
+		e := v.(*eventBacklogEntry)
+		e.toAPI(0, func(r *api.WatchEventResponse) {
+			record := marshalEventJournalRecord(r)
+			if len(j.records) == j.size {
+				j.records = j.records[1:]
+			}
+			j.records = append(j.records, record)
+			j.writer.Write(record)
+			j.written++
+		})
+		var err error
+		if j.written >= j.size {
+			err = j.rewrite()
+		} else if j.ch.Len() == 0 {
+			err = j.writer.Flush()
+		}
+		if err != nil {
+			j.logger.Error("failed to write the event journal",
+				log.Fields{
+					"Topic": "Config",
+					"File":  j.path,
+					"Error": err})
+		}
+	}
+	j.writer.Flush()
+	j.file.Close()
+}
+
+func (j *eventJournal) close() {
+	j.ch.Close()
+	<-j.done
+}
@@ -0,0 +1,218 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apb "google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/osrg/gobgp/v3/api"
+)
+
+func TestEventBacklog(t *testing.T) {
+	assert := assert.New(t)
+
+	b := newEventBacklog(3)
+	t0 := time.Now()
+	assert.Empty(b.since(t0, 0))
+	for i := 0; i < 5; i++ {
+		e := b.record(t0.Add(time.Duration(i)*time.Second), watchEventTypeBestPath, i)
+		assert.Equal(uint64(i+1), e.sequence)
+	}
+	events := func(entries []*eventBacklogEntry) []watchEvent {
+		l := make([]watchEvent, 0, len(entries))
+		for _, e := range entries {
+			l = append(l, e.ev)
+		}
+		return l
+	}
+	assert.Equal([]watchEvent{2, 3, 4}, events(b.since(t0, 0)))
+	assert.Equal([]watchEvent{3, 4}, events(b.since(t0.Add(3*time.Second), 0)))
+	assert.Equal([]watchEvent{4}, events(b.since(t0, 4)))
+	assert.Empty(b.since(t0.Add(5*time.Second), 0))
+	assert.Empty(b.since(t0, 5))
+}
+
+func newEventBacklogTestServer(t *testing.T, opts ...ServerOption) (*BgpServer, func(int)) {
+	s := NewBgpServer(opts...)
+	go s.Serve()
+	err := s.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        1,
+			RouterId:   "1.1.1.1",
+			ListenPort: -1,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	family := &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST}
+	origin, _ := apb.New(&api.OriginAttribute{Origin: 0})
+	nexthop, _ := apb.New(&api.NextHopAttribute{NextHop: "10.0.0.1"})
+	addPath := func(i int) {
+		nlri, _ := apb.New(&api.IPAddressPrefix{Prefix: fmt.Sprintf("10.%d.0.0", i), PrefixLen: 24})
+		_, err := s.AddPath(context.Background(), &api.AddPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Path: &api.Path{
+				Family: family,
+				Nlri:   nlri,
+				Pattrs: []*apb.Any{origin, nexthop},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return s, addPath
+}
+
+func watchEventBacklogTest(t *testing.T, s *BgpServer, r *api.WatchEventRequest) func() *api.WatchEventResponse {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	ch := make(chan *api.WatchEventResponse, 8)
+	if err := s.WatchEvent(ctx, r, func(r *api.WatchEventResponse) { ch <- r }); err != nil {
+		t.Fatal(err)
+	}
+	return func() *api.WatchEventResponse {
+		select {
+		case r := <-ch:
+			return r
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out")
+		}
+		return nil
+	}
+}
+
+var bestPathWatchEventRequestTable = &api.WatchEventRequest_Table{
+	Filters: []*api.WatchEventRequest_Table_Filter{
+		{Type: api.WatchEventRequest_Table_Filter_BEST},
+	},
+}
+
+func TestWatchEventSince(t *testing.T) {
+	assert := assert.New(t)
+
+	s, addPath := newEventBacklogTestServer(t, EventBacklogSize(2))
+	defer s.StopBgp(context.Background(), &api.StopBgpRequest{})
+
+	since := time.Now()
+	for i := 1; i <= 3; i++ {
+		addPath(i)
+	}
+
+	recv := watchEventBacklogTest(t, s, &api.WatchEventRequest{
+		Table: bestPathWatchEventRequestTable,
+		Since: timestamppb.New(since),
+	})
+	addPath(4)
+
+	// the oldest event is dropped from the backlog of the size 2
+	for _, prefix := range []string{"10.2.0.0/24", "10.3.0.0/24", "10.4.0.0/24"} {
+		r := recv()
+		assert.NotNil(r.Timestamp)
+		assert.False(r.Timestamp.AsTime().Before(since))
+		assert.Equal(prefix, nlriPrefix(r.GetTable().Paths[0]))
+	}
+
+	err := NewBgpServer().WatchEvent(context.Background(), &api.WatchEventRequest{
+		Peer:  &api.WatchEventRequest_Peer{},
+		Since: timestamppb.New(since),
+	}, func(r *api.WatchEventResponse) {})
+	assert.NotNil(err)
+}
+
+func TestWatchEventSinceSequence(t *testing.T) {
+	assert := assert.New(t)
+
+	s, addPath := newEventBacklogTestServer(t, EventBacklogSize(8))
+	defer s.StopBgp(context.Background(), &api.StopBgpRequest{})
+
+	recv := watchEventBacklogTest(t, s, &api.WatchEventRequest{Table: bestPathWatchEventRequestTable})
+	for i := 1; i <= 3; i++ {
+		addPath(i)
+		assert.Equal(uint64(i), recv().Sequence)
+	}
+
+	// resume after the first event
+	recv = watchEventBacklogTest(t, s, &api.WatchEventRequest{
+		Table:         bestPathWatchEventRequestTable,
+		SinceSequence: 1,
+	})
+	addPath(4)
+	for i, prefix := range []string{"10.2.0.0/24", "10.3.0.0/24", "10.4.0.0/24"} {
+		r := recv()
+		assert.Equal(uint64(i+2), r.Sequence)
+		assert.Equal(prefix, nlriPrefix(r.GetTable().Paths[0]))
+	}
+}
+
+func TestEventJournal(t *testing.T) {
+	assert := assert.New(t)
+
+	path := filepath.Join(t.TempDir(), "journal")
+	s, addPath := newEventBacklogTestServer(t, EventBacklogSize(2), EventJournalFile(path))
+	for i := 1; i <= 5; i++ {
+		addPath(i)
+	}
+	s.Stop()
+
+	// the journal is rewritten with the latest records
+	responses, err := readEventJournal(path, 8)
+	assert.Nil(err)
+	assert.Len(responses, 3)
+
+	s, addPath = newEventBacklogTestServer(t, EventBacklogSize(2), EventJournalFile(path))
+	defer s.Stop()
+	recv := watchEventBacklogTest(t, s, &api.WatchEventRequest{
+		Table:         bestPathWatchEventRequestTable,
+		SinceSequence: 3,
+	})
+	addPath(6)
+	for i, prefix := range []string{"10.4.0.0/24", "10.5.0.0/24", "10.6.0.0/24"} {
+		r := recv()
+		assert.Equal(uint64(i+4), r.Sequence)
+		assert.NotNil(r.Timestamp)
+		assert.Equal(prefix, nlriPrefix(r.GetTable().Paths[0]))
+	}
+
+	// the truncated record at the end is ignored
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	assert.Nil(err)
+	_, err = f.Write([]byte{10, 1, 2})
+	assert.Nil(err)
+	f.Close()
+	responses, err = readEventJournal(path, 8)
+	assert.Nil(err)
+	assert.Len(responses, 3)
+}
+
+func nlriPrefix(p *api.Path) string {
+	prefix := &api.IPAddressPrefix{}
+	if err := p.Nlri.UnmarshalTo(prefix); err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/%d", prefix.Prefix, prefix.PrefixLen)
+}
@@ -0,0 +1,431 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/log"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+// EVPN MULTIHOMING: DESIGNATED FORWARDER ELECTION
+//
+// RFC7432 8.5. Designated Forwarder Election
+// RFC8584 Framework for EVPN Designated Forwarder Election Extensibility
+//
+// The PEs attached to an Ethernet Segment are discovered by the Ethernet
+// Segment routes, and the Designated Forwarder (DF) for each Ethernet Tag is
+// elected among them. The HRW algorithm is used only when all the PEs
+// advertise it with the DF Election Extended Community, otherwise the PEs
+// fall back to the default algorithm (service carving).
+
+// evpnMaxEthernetTag is the Ethernet Tag of the Ethernet A-D per ES route.
+const evpnMaxEthernetTag = 0xffffffff
+
+type evpnDFCandidate struct {
+	address net.IP
+	local   bool
+}
+
+type evpnEthernetSegment struct {
+	esi          bgp.EthernetSegmentIdentifier
+	algorithm    bgp.DFAlgorithm
+	candidates   []evpnDFCandidate // in ascending order of the address
+	ethernetTags []uint32          // of the local Ethernet A-D per EVI routes
+}
+
+func evpnESIKey(esi bgp.EthernetSegmentIdentifier) string {
+	buf, _ := esi.Serialize()
+	return string(buf)
+}
+
+func evpnDFAlgorithm(path *table.Path) bgp.DFAlgorithm {
+	for _, ec := range path.GetExtCommunities() {
+		if df, ok := ec.(*bgp.DFElectionExtended); ok {
+			return df.Algorithm
+		}
+	}
+	return bgp.DF_ALGORITHM_DEFAULT
+}
+
+// newEvpnEthernetSegments returns the Ethernet Segments which this router is
+// attached to, that is, which have the locally originated Ethernet Segment
+// route.
+func newEvpnEthernetSegments(paths []*table.Path) []*evpnEthernetSegment {
+	segments := make(map[string]*evpnEthernetSegment)
+	algorithms := make(map[string]map[bgp.DFAlgorithm]bool)
+	local := make(map[string]bool)
+	tags := make(map[string]map[uint32]bool)
+	for _, path := range paths {
+		if path == nil || path.IsWithdraw {
+			continue
+		}
+		nlri, ok := path.GetNlri().(*bgp.EVPNNLRI)
+		if !ok {
+			continue
+		}
+		switch r := nlri.RouteTypeData.(type) {
+		case *bgp.EVPNEthernetSegmentRoute:
+			if r.IPAddress == nil {
+				continue
+			}
+			key := evpnESIKey(r.ESI)
+			es, ok := segments[key]
+			if !ok {
+				es = &evpnEthernetSegment{esi: r.ESI}
+				segments[key] = es
+				algorithms[key] = make(map[bgp.DFAlgorithm]bool)
+			}
+			es.candidates = append(es.candidates, evpnDFCandidate{
+				address: r.IPAddress,
+				local:   path.IsLocal(),
+			})
+			algorithms[key][evpnDFAlgorithm(path)] = true
+			if path.IsLocal() {
+				local[key] = true
+			}
+		case *bgp.EVPNEthernetAutoDiscoveryRoute:
+			if !path.IsLocal() || r.ETag == evpnMaxEthernetTag {
+				continue
+			}
+			key := evpnESIKey(r.ESI)
+			if tags[key] == nil {
+				tags[key] = make(map[uint32]bool)
+			}
+			tags[key][r.ETag] = true
+		}
+	}
+
+	l := make([]*evpnEthernetSegment, 0, len(local))
+	for key, es := range segments {
+		if !local[key] {
+			continue
+		}
+		if len(algorithms[key]) == 1 && algorithms[key][bgp.DF_ALGORITHM_HRW] {
+			es.algorithm = bgp.DF_ALGORITHM_HRW
+		}
+		sort.SliceStable(es.candidates, func(i, j int) bool {
+			return bytes.Compare(es.candidates[i].address.To16(), es.candidates[j].address.To16()) < 0
+		})
+		for tag := range tags[key] {
+			es.ethernetTags = append(es.ethernetTags, tag)
+		}
+		sort.Slice(es.ethernetTags, func(i, j int) bool { return es.ethernetTags[i] < es.ethernetTags[j] })
+		l = append(l, es)
+	}
+	sort.Slice(l, func(i, j int) bool { return evpnESIKey(l[i].esi) < evpnESIKey(l[j].esi) })
+	return l
+}
+
+// electDF returns the Designated Forwarder for the Ethernet Tag.
+func (es *evpnEthernetSegment) electDF(tag uint32) *evpnDFCandidate {
+	if len(es.candidates) == 0 {
+		return nil
+	}
+	if es.algorithm != bgp.DF_ALGORITHM_HRW {
+		// RFC7432 8.5: the PE with ordinal i = V mod N is the DF.
+		return &es.candidates[tag%uint32(len(es.candidates))]
+	}
+	// RFC8584 3.2: the PE with the highest weight is the DF. The PE with
+	// the lowest address wins in case of a tie.
+	var df *evpnDFCandidate
+	var max uint32
+	for i, c := range es.candidates {
+		if w := hrwWeight(tag, es.esi, c.address); df == nil || w > max {
+			df, max = &es.candidates[i], w
+		}
+	}
+	return df
+}
+
+// hrwWeight returns the weight of the PE for the Ethernet Tag in the HRW
+// algorithm (RFC8584 3.2):
+//
+//	Wrand(v, Es, Si) = (1103515245((1103515245.Si+12345) XOR D(v,Es))+12345) (mod 2^31)
+//
+// D(v,Es) is the 31-bit digest (CRC-32 and mod 2^31) of the Ethernet Tag v
+// followed by the ESI. Si is the address of the PE, and the CRC-32 of the
+// address is used for IPv6.
+func hrwWeight(tag uint32, esi bgp.EthernetSegmentIdentifier, address net.IP) uint32 {
+	buf := make([]byte, 4, 14)
+	binary.BigEndian.PutUint32(buf, tag)
+	esiBuf, _ := esi.Serialize()
+	buf = append(buf, esiBuf...)
+	d := uint64(crc32.ChecksumIEEE(buf)) & 0x7fffffff
+
+	var si uint64
+	if v4 := address.To4(); v4 != nil {
+		si = uint64(binary.BigEndian.Uint32(v4))
+	} else {
+		si = uint64(crc32.ChecksumIEEE(address.To16()))
+	}
+	return uint32((1103515245*(((1103515245*si+12345)&0x7fffffff)^d) + 12345) & 0x7fffffff)
+}
+
+// EVPN MAC MOBILITY: DUPLICATE MAC DETECTION
+//
+// RFC7432 15.1. MAC Mobility Procedures
+//
+// If a PE detects that a MAC address is moving more than N times within
+// an M-second window (default: N = 5, M = 180), it should stop sending and
+// processing any BGP MAC/IP Advertisement routes for that MAC address until
+// a corrective action is taken.
+//
+// A move is detected when a MAC/IP Advertisement route for the MAC address
+// has a higher sequence number than the ones seen before, whether it is
+// received from a peer or locally originated. A frozen MAC address can't be
+// originated and the routes for it received from the peers are ignored
+// until it is cleared or the freeze time expires.
+
+type evpnMac struct {
+	key  string
+	mac  net.HardwareAddr
+	etag uint32
+	rts  []string
+	// the highest sequence number seen, -1 without the MAC Mobility
+	// extended community
+	seq    int
+	moves  []time.Time
+	frozen time.Time // zero unless frozen
+	timer  *time.Timer
+}
+
+// recentMoves returns the moves within the interval.
+func (e *evpnMac) recentMoves(now time.Time, interval time.Duration) []time.Time {
+	i := 0
+	for i < len(e.moves) && now.Sub(e.moves[i]) >= interval {
+		i++
+	}
+	return e.moves[i:]
+}
+
+// evpnMacRoute returns the MAC/IP Advertisement route of the path and its
+// route targets in the sorted order, or nil for the other routes.
+func evpnMacRoute(path *table.Path) (*bgp.EVPNMacIPAdvertisementRoute, []string) {
+	nlri, ok := path.GetNlri().(*bgp.EVPNNLRI)
+	if !ok {
+		return nil, nil
+	}
+	r, ok := nlri.RouteTypeData.(*bgp.EVPNMacIPAdvertisementRoute)
+	if !ok {
+		return nil, nil
+	}
+	rts := make([]string, 0)
+	for _, rt := range path.GetRouteTargets() {
+		rts = append(rts, rt.String())
+	}
+	sort.Strings(rts)
+	return r, rts
+}
+
+// evpnMacKey identifies a MAC address in the MAC-VRFs of the route targets.
+func evpnMacKey(etag uint32, mac net.HardwareAddr, rts []string) string {
+	return fmt.Sprintf("%d/%s/%s", etag, mac, strings.Join(rts, ","))
+}
+
+type evpnMacMobility struct {
+	s      *BgpServer
+	config oc.EvpnMacMobilityConfig
+	macs   map[string]*evpnMac
+}
+
+func newEvpnMacMobility(s *BgpServer) *evpnMacMobility {
+	return &evpnMacMobility{
+		s:    s,
+		macs: make(map[string]*evpnMac),
+	}
+}
+
+func (m *evpnMacMobility) interval() time.Duration {
+	return time.Duration(m.config.Interval) * time.Second
+}
+
+// isFrozen returns true if the path advertises a frozen MAC address.
+func (m *evpnMacMobility) isFrozen(path *table.Path) bool {
+	if !m.config.DuplicateMacDetection || path.IsWithdraw {
+		return false
+	}
+	r, rts := evpnMacRoute(path)
+	if r == nil {
+		return false
+	}
+	e, y := m.macs[evpnMacKey(r.ETag, r.MacAddress, rts)]
+	return y && !e.frozen.IsZero()
+}
+
+// update counts the moves of the MAC address of the path to be installed
+// into the global RIB and returns the path to install instead. The routes
+// received from the peers for a frozen MAC address are ignored, that is,
+// installed as withdrawn.
+func (m *evpnMacMobility) update(peer *peer, path *table.Path) *table.Path {
+	if !m.config.DuplicateMacDetection {
+		return path
+	}
+	if path.IsWithdraw {
+		m.release(path)
+		return path
+	}
+	r, rts := evpnMacRoute(path)
+	if r == nil {
+		return path
+	}
+	seq := -1
+	if mm := path.GetMacMobility(); mm != nil {
+		seq = int(mm.Sequence)
+	}
+	key := evpnMacKey(r.ETag, r.MacAddress, rts)
+	e, y := m.macs[key]
+	if !y {
+		m.macs[key] = &evpnMac{
+			key:  key,
+			mac:  r.MacAddress,
+			etag: r.ETag,
+			rts:  rts,
+			seq:  seq,
+		}
+		return path
+	}
+	if !e.frozen.IsZero() {
+		if peer != nil {
+			return path.Clone(true)
+		}
+		return path
+	}
+	if seq <= e.seq {
+		return path
+	}
+	e.seq = seq
+	now := time.Now()
+	e.moves = append(e.recentMoves(now, m.interval()), now)
+	if len(e.moves) >= int(m.config.MaxMoves) {
+		m.freeze(e, now)
+	}
+	return path
+}
+
+// release forgets the MAC address of the withdrawn path unless it is
+// frozen, it moved recently or the other routes for it remain.
+func (m *evpnMacMobility) release(path *table.Path) {
+	t, y := m.s.globalRib.Tables[bgp.RF_EVPN]
+	if !y {
+		return
+	}
+	dst := t.GetDestination(path.GetNlri())
+	if dst == nil {
+		return
+	}
+	var installed *table.Path
+	for _, p := range dst.GetAllKnownPathList() {
+		if p.GetSource().Equal(path.GetSource()) {
+			installed = p
+			break
+		}
+	}
+	if installed == nil {
+		return
+	}
+	r, rts := evpnMacRoute(installed)
+	if r == nil {
+		return
+	}
+	e, y := m.macs[evpnMacKey(r.ETag, r.MacAddress, rts)]
+	if !y || !e.frozen.IsZero() || len(e.recentMoves(time.Now(), m.interval())) > 0 {
+		return
+	}
+	for _, rt := range installed.GetRouteTargets() {
+		for _, p := range m.s.globalRib.GetPathListWithMac(table.GLOBAL_RIB_NAME, 0, []bgp.RouteFamily{bgp.RF_EVPN}, rt, r.MacAddress) {
+			if p == installed {
+				continue
+			}
+			if r2, rts2 := evpnMacRoute(p); r2 != nil && evpnMacKey(r2.ETag, r2.MacAddress, rts2) == e.key {
+				return
+			}
+		}
+	}
+	delete(m.macs, e.key)
+}
+
+func (m *evpnMacMobility) freeze(e *evpnMac, now time.Time) {
+	e.frozen = now
+	e.moves = nil
+	m.s.logger.Warn("duplicate MAC address detected",
+		log.Fields{
+			"Topic":        "Evpn",
+			"Key":          e.mac.String(),
+			"EthernetTag":  e.etag,
+			"RouteTargets": e.rts,
+			"Sequence":     e.seq})
+	if m.config.FreezeTime == 0 {
+		return
+	}
+	e.timer = time.AfterFunc(time.Duration(m.config.FreezeTime)*time.Second, func() {
+		m.s.mgmtOperation(func() error {
+			if m.macs[e.key] == e {
+				m.unfreeze(e)
+			}
+			return nil
+		}, false)
+	})
+}
+
+// unfreeze forgets the frozen MAC address and processes the routes for it
+// received from the peers again.
+func (m *evpnMacMobility) unfreeze(e *evpnMac) {
+	if e.timer != nil {
+		e.timer.Stop()
+	}
+	delete(m.macs, e.key)
+	m.s.logger.Info("duplicate MAC address cleared",
+		log.Fields{
+			"Topic":       "Evpn",
+			"Key":         e.mac.String(),
+			"EthernetTag": e.etag})
+	for _, peer := range m.s.neighborMap {
+		var paths []*table.Path
+		for _, path := range peer.adjRibIn.PathList([]bgp.RouteFamily{bgp.RF_EVPN}, true) {
+			if r, rts := evpnMacRoute(path); r != nil && evpnMacKey(r.ETag, r.MacAddress, rts) == e.key {
+				paths = append(paths, path)
+			}
+		}
+		if len(paths) > 0 {
+			m.s.propagateUpdate(peer, paths)
+		}
+	}
+}
+
+// frozenMacs returns the frozen MAC addresses in the order of the keys. All
+// the frozen ones are returned if mac is nil.
+func (m *evpnMacMobility) frozenMacs(mac net.HardwareAddr) []*evpnMac {
+	l := make([]*evpnMac, 0)
+	for _, e := range m.macs {
+		if e.frozen.IsZero() || mac != nil && !bytes.Equal(mac, e.mac) {
+			continue
+		}
+		l = append(l, e)
+	}
+	sort.Slice(l, func(i, j int) bool { return l[i].key < l[j].key })
+	return l
+}
@@ -0,0 +1,281 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apb "google.golang.org/protobuf/types/known/anypb"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/apiutil"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func newEvpnTestPath(source string, r bgp.EVPNRouteTypeInterface, extComms ...bgp.ExtendedCommunityInterface) *table.Path {
+	peer := &table.PeerInfo{}
+	if source != "" {
+		peer.Address = net.ParseIP(source)
+	}
+	var typ uint8
+	switch r.(type) {
+	case *bgp.EVPNEthernetAutoDiscoveryRoute:
+		typ = bgp.EVPN_ROUTE_TYPE_ETHERNET_AUTO_DISCOVERY
+	case *bgp.EVPNEthernetSegmentRoute:
+		typ = bgp.EVPN_ETHERNET_SEGMENT_ROUTE
+	}
+	nlri := bgp.NewEVPNNLRI(typ, r)
+	attrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeMpReachNLRI("0.0.0.0", []bgp.AddrPrefixInterface{nlri}),
+	}
+	if len(extComms) > 0 {
+		attrs = append(attrs, bgp.NewPathAttributeExtendedCommunities(extComms))
+	}
+	return table.NewPath(peer, nlri, false, attrs, time.Now(), false)
+}
+
+func newEvpnTestESRoute(esi bgp.EthernetSegmentIdentifier, address string) *bgp.EVPNEthernetSegmentRoute {
+	return &bgp.EVPNEthernetSegmentRoute{
+		RD:              bgp.NewRouteDistinguisherIPAddressAS(address, 1),
+		ESI:             esi,
+		IPAddressLength: 32,
+		IPAddress:       net.ParseIP(address),
+	}
+}
+
+func Test_newEvpnEthernetSegments(t *testing.T) {
+	assert := assert.New(t)
+
+	esi1, _ := bgp.ParseEthernetSegmentIdentifier([]string{"MAC", "aa:bb:cc:dd:ee:ff", "1"})
+	esi2, _ := bgp.ParseEthernetSegmentIdentifier([]string{"MAC", "aa:bb:cc:dd:ee:ff", "2"})
+	hrw := bgp.NewDFElectionExtended(bgp.DF_ALGORITHM_HRW, 0)
+
+	paths := []*table.Path{
+		newEvpnTestPath("", newEvpnTestESRoute(esi1, "10.0.0.1"), hrw),
+		newEvpnTestPath("10.0.0.3", newEvpnTestESRoute(esi1, "10.0.0.3")),
+		newEvpnTestPath("10.0.0.2", newEvpnTestESRoute(esi1, "10.0.0.2"), hrw),
+		// not attached to this router
+		newEvpnTestPath("10.0.0.2", newEvpnTestESRoute(esi2, "10.0.0.2")),
+		newEvpnTestPath("", &bgp.EVPNEthernetAutoDiscoveryRoute{
+			RD:   bgp.NewRouteDistinguisherIPAddressAS("10.0.0.1", 1),
+			ESI:  esi1,
+			ETag: 200,
+		}),
+		newEvpnTestPath("", &bgp.EVPNEthernetAutoDiscoveryRoute{
+			RD:   bgp.NewRouteDistinguisherIPAddressAS("10.0.0.1", 1),
+			ESI:  esi1,
+			ETag: 100,
+		}),
+		// Ethernet A-D per ES route
+		newEvpnTestPath("", &bgp.EVPNEthernetAutoDiscoveryRoute{
+			RD:   bgp.NewRouteDistinguisherIPAddressAS("10.0.0.1", 1),
+			ESI:  esi1,
+			ETag: evpnMaxEthernetTag,
+		}),
+	}
+
+	l := newEvpnEthernetSegments(paths)
+	assert.Len(l, 1)
+	es := l[0]
+	assert.Equal(esi1, es.esi)
+	// 10.0.0.3 doesn't advertise HRW
+	assert.Equal(bgp.DF_ALGORITHM_DEFAULT, es.algorithm)
+	assert.Len(es.candidates, 3)
+	assert.Equal("10.0.0.1", es.candidates[0].address.String())
+	assert.True(es.candidates[0].local)
+	assert.Equal("10.0.0.2", es.candidates[1].address.String())
+	assert.Equal("10.0.0.3", es.candidates[2].address.String())
+	assert.Equal([]uint32{100, 200}, es.ethernetTags)
+
+	// service carving
+	assert.Equal("10.0.0.2", es.electDF(100).address.String())
+	assert.Equal("10.0.0.3", es.electDF(200).address.String())
+	assert.Equal("10.0.0.1", es.electDF(300).address.String())
+	assert.True(es.electDF(300).local)
+
+	// all the PEs advertise HRW
+	paths[1] = newEvpnTestPath("10.0.0.3", newEvpnTestESRoute(esi1, "10.0.0.3"), hrw)
+	l = newEvpnEthernetSegments(paths)
+	assert.Len(l, 1)
+	es = l[0]
+	assert.Equal(bgp.DF_ALGORITHM_HRW, es.algorithm)
+	for _, tag := range []uint32{0, 100, 200, 4094} {
+		df := es.electDF(tag)
+		for _, c := range es.candidates {
+			assert.True(hrwWeight(tag, esi1, df.address) >= hrwWeight(tag, esi1, c.address))
+		}
+	}
+
+	// the withdrawn path is ignored
+	paths[0] = paths[0].Clone(true)
+	assert.Len(newEvpnEthernetSegments(paths), 0)
+}
+
+func Test_hrwWeight(t *testing.T) {
+	assert := assert.New(t)
+
+	esi, _ := bgp.ParseEthernetSegmentIdentifier([]string{"LACP", "aa:bb:cc:dd:ee:ff", "100"})
+	w := hrwWeight(100, esi, net.ParseIP("10.0.0.1"))
+	assert.True(w < 1<<31)
+	assert.Equal(w, hrwWeight(100, esi, net.ParseIP("10.0.0.1")))
+	assert.NotEqual(w, hrwWeight(100, esi, net.ParseIP("10.0.0.2")))
+	assert.NotEqual(w, hrwWeight(101, esi, net.ParseIP("10.0.0.1")))
+	assert.True(hrwWeight(100, esi, net.ParseIP("2001:db8::1")) < 1<<31)
+}
+
+func TestListEvpnDesignatedForwarder(t *testing.T) {
+	assert := assert.New(t)
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        1,
+			RouterId:   "10.0.0.1",
+			ListenPort: -1,
+		},
+	})
+	assert.Nil(err)
+	defer s.StopBgp(context.Background(), &api.StopBgpRequest{})
+
+	esi, _ := bgp.ParseEthernetSegmentIdentifier([]string{"MAC", "aa:bb:cc:dd:ee:ff", "1"})
+	nlri := bgp.NewEVPNNLRI(bgp.EVPN_ETHERNET_SEGMENT_ROUTE, newEvpnTestESRoute(esi, "10.0.0.1"))
+	attrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeMpReachNLRI("0.0.0.0", []bgp.AddrPrefixInterface{nlri}),
+	}
+	path, _ := apiutil.NewPath(nlri, false, attrs, time.Now())
+	_, err = s.AddPath(context.Background(), &api.AddPathRequest{
+		TableType: api.TableType_GLOBAL,
+		Path:      path,
+	})
+	assert.Nil(err)
+
+	var l []*api.EvpnEthernetSegment
+	err = s.ListEvpnDesignatedForwarder(context.Background(), &api.ListEvpnDesignatedForwarderRequest{
+		EthernetTags: []uint32{100, 200},
+	}, func(es *api.EvpnEthernetSegment) {
+		l = append(l, es)
+	})
+	assert.Nil(err)
+	assert.Len(l, 1)
+	assert.Equal(api.EvpnEthernetSegment_DEFAULT, l[0].DfAlgorithm)
+	assert.Equal([]string{"10.0.0.1"}, l[0].Candidates)
+	assert.Len(l[0].DesignatedForwarders, 2)
+	assert.Equal(uint32(200), l[0].DesignatedForwarders[1].EthernetTag)
+	assert.Equal("10.0.0.1", l[0].DesignatedForwarders[1].Address)
+	assert.True(l[0].DesignatedForwarders[1].Local)
+
+	// another Ethernet Segment
+	other, _ := bgp.ParseEthernetSegmentIdentifier([]string{"MAC", "aa:bb:cc:dd:ee:ff", "2"})
+	a, _ := apb.New(&api.EthernetSegmentIdentifier{Type: uint32(other.Type), Value: other.Value})
+	l = nil
+	err = s.ListEvpnDesignatedForwarder(context.Background(), &api.ListEvpnDesignatedForwarderRequest{
+		Esi: a,
+	}, func(es *api.EvpnEthernetSegment) {
+		l = append(l, es)
+	})
+	assert.Nil(err)
+	assert.Len(l, 0)
+}
+
+func TestEvpnDuplicateMacDetection(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.StartBgp(ctx, &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        1,
+			RouterId:   "10.0.0.1",
+			ListenPort: -1,
+			EvpnMacMobility: &api.EvpnMacMobility{
+				DuplicateMacDetection: true,
+				MaxMoves:              3,
+			},
+		},
+	})
+	assert.Nil(err)
+	defer s.StopBgp(ctx, &api.StopBgpRequest{})
+
+	r := &bgp.EVPNMacIPAdvertisementRoute{
+		RD:               bgp.NewRouteDistinguisherIPAddressAS("10.0.0.1", 1),
+		ESI:              bgp.EthernetSegmentIdentifier{Type: bgp.ESI_ARBITRARY, Value: make([]byte, 9)},
+		MacAddressLength: 48,
+		MacAddress:       net.HardwareAddr{0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff},
+		IPAddressLength:  0,
+		Labels:           []uint32{100},
+	}
+	newPath := func(seq uint32) *table.Path {
+		nlri := bgp.NewEVPNNLRI(bgp.EVPN_ROUTE_TYPE_MAC_IP_ADVERTISEMENT, r)
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeMpReachNLRI("0.0.0.0", []bgp.AddrPrefixInterface{nlri}),
+			bgp.NewPathAttributeExtendedCommunities([]bgp.ExtendedCommunityInterface{
+				bgp.NewTwoOctetAsSpecificExtended(bgp.EC_SUBTYPE_ROUTE_TARGET, 65000, 1, true),
+				&bgp.MacMobilityExtended{Sequence: seq},
+			}),
+		}
+		return table.NewPath(&table.PeerInfo{}, nlri, false, attrs, time.Now(), false)
+	}
+	addPath := func(seq uint32) error {
+		p := newPath(seq)
+		path, _ := apiutil.NewPath(p.GetNlri(), false, p.GetPathAttrs(), time.Now())
+		_, err := s.AddPath(ctx, &api.AddPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Path:      path,
+		})
+		return err
+	}
+	frozen := func() []*api.EvpnFrozenMac {
+		var l []*api.EvpnFrozenMac
+		err := s.ListEvpnFrozenMac(ctx, &api.ListEvpnFrozenMacRequest{}, func(m *api.EvpnFrozenMac) {
+			l = append(l, m)
+		})
+		assert.Nil(err)
+		return l
+	}
+
+	// frozen by the third move
+	for seq := uint32(0); seq < 3; seq++ {
+		assert.Nil(addPath(seq))
+	}
+	assert.Len(frozen(), 0)
+	assert.Nil(addPath(3))
+	l := frozen()
+	assert.Len(l, 1)
+	assert.Equal("aa:bb:cc:dd:ee:ff", l[0].MacAddress)
+	assert.Equal([]string{"65000:1"}, l[0].RouteTargets)
+	assert.Equal(uint32(3), l[0].Sequence)
+	assert.NotNil(addPath(4))
+
+	// the routes from the peers are ignored
+	s.mgmtOperation(func() error {
+		assert.True(s.evpnMacs.update(&peer{}, newPath(5)).IsWithdraw)
+		assert.False(s.evpnMacs.update(nil, newPath(5)).IsWithdraw)
+		return nil
+	}, false)
+
+	assert.NotNil(s.ClearEvpnFrozenMac(ctx, &api.ClearEvpnFrozenMacRequest{MacAddress: "aa:bb:cc:dd:ee:00"}))
+	assert.Nil(s.ClearEvpnFrozenMac(ctx, &api.ClearEvpnFrozenMacRequest{MacAddress: "aa:bb:cc:dd:ee:ff"}))
+	assert.Len(frozen(), 0)
+	assert.Nil(addPath(6))
+}
@@ -17,6 +17,7 @@ package server
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"math/rand"
@@ -24,6 +25,7 @@ import (
 	"os"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -40,6 +42,9 @@ const (
 	minConnectRetryInterval = 5
 )
 
+// linkLocalNeighborAddress discovers the neighbor of the unnumbered peer.
+var linkLocalNeighborAddress = oc.GetIPv6LinkLocalNeighborAddress
+
 type fsmStateReasonType uint8
 
 const (
@@ -121,6 +126,7 @@ const (
 	fsmMsgStateChange
 	fsmMsgBGPMessage
 	fsmMsgRouteRefresh
+	fsmMsgCapability
 )
 
 type fsmMsg struct {
@@ -132,12 +138,17 @@ type fsmMsg struct {
 	PathList    []*table.Path
 	timestamp   time.Time
 	payload     []byte
+	// erroredPDU is set if the message is malformed; payload is
+	// the message as received.
+	erroredPDU bool
 }
 
 type fsmOutgoingMsg struct {
 	Paths        []*table.Path
 	Notification *bgp.BGPMessage
 	StayIdle     bool
+	// Messages are sent before the paths, e.g. ROUTE-REFRESH.
+	Messages []*bgp.BGPMessage
 }
 
 const (
@@ -145,6 +156,15 @@ const (
 	holdtimeIdle     = 5
 )
 
+const (
+	// how long the paths queued after the first ones are waited for to
+	// pack the paths with the same attributes into fewer UPDATE messages
+	updatePackingWindow = 10 * time.Millisecond
+	// the paths are sent without waiting any longer once this many are
+	// gathered
+	updatePackingMaxPaths = 16384
+)
+
 type adminState int
 
 const (
@@ -195,6 +215,51 @@ type fsm struct {
 	marshallingOptions   *bgp.MarshallingOption
 	notification         chan *bgp.BGPMessage
 	logger               log.Logger
+	// the number of the UPDATE messages treated as withdraw per the
+	// type of the path attribute which caused it
+	treatAsWithdrawAttributes map[bgp.BGPAttrType]uint32
+	// records the messages if the debug capture is started
+	capture *debugCapture
+	// limits the rate of the UPDATE messages sent
+	updateLimiter *updateRateLimiter
+	// the number of the UPDATE messages created from the outgoing paths
+	// but not sent yet, accessed atomically
+	pendingUpdates int64
+	// conn was initiated by the local speaker
+	connLocal bool
+	// the OPEN message was already sent on conn
+	connOpenSent bool
+	// the connection colliding with conn (RFC 4271 6.8), initiated by
+	// the other side than conn, kept until the collision is resolved
+	collisionConn net.Conn
+}
+
+// fourOctetAs returns true if the AS numbers are four-octet with the peer,
+// that is, both advertised the Four-octet AS Number Capability (RFC 6793).
+// The caller must hold the lock.
+func (fsm *fsm) fourOctetAs() bool {
+	_, y := fsm.capMap[bgp.BGP_CAP_FOUR_OCTET_AS_NUMBER]
+	return y && !fsm.pConf.Config.DisableFourOctetAs
+}
+
+// outgoingQueueLen returns the number of the messages queued to be sent.
+func (fsm *fsm) outgoingQueueLen() int {
+	return fsm.outgoingCh.Len() + int(atomic.LoadInt64(&fsm.pendingUpdates))
+}
+
+func (fsm *fsm) captureMessage(b []byte, isIn bool) {
+	fsm.lock.RLock()
+	c := fsm.capture
+	options := fsm.marshallingOptions
+	conn := fsm.conn
+	fsm.lock.RUnlock()
+	if c != nil {
+		var local, remote net.Addr
+		if conn != nil {
+			local, remote = conn.LocalAddr(), conn.RemoteAddr()
+		}
+		c.add(b, isIn, options, local, remote)
+	}
 }
 
 func (fsm *fsm) bgpMessageStateUpdate(MessageType uint8, isIn bool) {
@@ -239,6 +304,8 @@ func (fsm *fsm) bgpMessageStateUpdate(MessageType uint8, isIn bool) {
 		} else {
 			state.Sent.Refresh++
 		}
+	case bgp.BGP_MSG_CAPABILITY:
+		// counted only in the total
 	default:
 		if isIn {
 			state.Received.Discarded++
@@ -285,6 +352,9 @@ func newFSM(gConf *oc.Global, pConf *oc.Neighbor, logger log.Logger) *fsm {
 		gracefulRestartTimer: time.NewTimer(time.Hour),
 		notification:         make(chan *bgp.BGPMessage, 1),
 		logger:               logger,
+
+		treatAsWithdrawAttributes: make(map[bgp.BGPAttrType]uint32),
+		updateLimiter:             newUpdateRateLimiter(&pConf.UpdateRateLimit.Config),
 	}
 	fsm.gracefulRestartTimer.Stop()
 	return fsm
@@ -301,34 +371,57 @@ func (fsm *fsm) StateChange(nextState bgp.FSMState) {
 			"old":    fsm.state.String(),
 			"new":    nextState.String(),
 			"reason": fsm.reason})
+	if nextState == bgp.BGP_FSM_IDLE && fsm.state != bgp.BGP_FSM_IDLE {
+		fsm.idleHoldBackoff(fsm.state)
+	}
 	fsm.state = nextState
 	switch nextState {
 	case bgp.BGP_FSM_ESTABLISHED:
 		fsm.pConf.Timers.State.Uptime = time.Now().Unix()
 		fsm.pConf.State.EstablishedCount++
 		// reset the state set by the previous session
-		fsm.twoByteAsTrans = false
-		if _, y := fsm.capMap[bgp.BGP_CAP_FOUR_OCTET_AS_NUMBER]; !y {
-			fsm.twoByteAsTrans = true
-			break
-		}
-		y := func() bool {
-			for _, c := range capabilitiesFromConfig(fsm.pConf) {
-				switch c.(type) {
-				case *bgp.CapFourOctetASNumber:
-					return true
-				}
-			}
-			return false
-		}()
-		if !y {
-			fsm.twoByteAsTrans = true
-		}
+		fsm.twoByteAsTrans = !fsm.fourOctetAs()
 	default:
 		fsm.pConf.Timers.State.Downtime = time.Now().Unix()
 	}
 }
 
+// idleHoldBackoff doubles the idle hold time on every consecutive
+// session failure up to idle-hold-time-max. The failures are
+// forgotten once the session stays established longer than
+// idle-hold-time-max. Must be called with the lock held.
+func (fsm *fsm) idleHoldBackoff(oldState bgp.FSMState) {
+	config := &fsm.pConf.Timers.Config
+	if config.IdleHoldTimeMax == 0 {
+		return
+	}
+	state := &fsm.pConf.Timers.State
+	if oldState == bgp.BGP_FSM_ESTABLISHED && time.Since(time.Unix(state.Uptime, 0)).Seconds() >= config.IdleHoldTimeMax {
+		state.ConsecutiveFailures = 0
+	}
+	state.ConsecutiveFailures++
+
+	t := config.IdleHoldTimeMin
+	for i := uint32(1); i < state.ConsecutiveFailures && t < config.IdleHoldTimeMax; i++ {
+		t *= 2
+	}
+	if t > config.IdleHoldTimeMax {
+		t = config.IdleHoldTimeMax
+	}
+	t -= t * config.IdleHoldTimeJitter * rand.Float64()
+	state.IdleHoldTime = t
+	// the idle hold time after neighbor reset operation takes precedence
+	if fsm.idleHoldTime < t {
+		fsm.idleHoldTime = t
+	}
+	fsm.logger.Debug("idle hold time backed off",
+		log.Fields{
+			"Topic":    "Peer",
+			"Key":      fsm.pConf.State.NeighborAddress,
+			"Failures": state.ConsecutiveFailures,
+			"Duration": fsm.idleHoldTime})
+}
+
 func hostport(addr net.Addr) (string, uint16) {
 	if addr != nil {
 		host, port, err := net.SplitHostPort(addr.String())
@@ -361,6 +454,7 @@ func (fsm *fsm) sendNotificationFromErrorMsg(e *bgp.MessageError) (*bgp.BGPMessa
 		_, err := fsm.h.conn.Write(b)
 		if err == nil {
 			fsm.bgpMessageStateUpdate(m.Header.Type, false)
+			fsm.captureMessage(b, false)
 			fsm.h.sentNotification = m
 		}
 		fsm.h.conn.Close()
@@ -379,6 +473,163 @@ func (fsm *fsm) sendNotification(code, subType uint8, data []byte, msg string) (
 	return fsm.sendNotificationFromErrorMsg(e.(*bgp.MessageError))
 }
 
+// setConn makes conn the connection of the session. local is true if
+// conn was initiated by the local speaker, openSent if the OPEN message
+// was already sent on conn.
+func (fsm *fsm) setConn(conn net.Conn, local, openSent bool) {
+	fsm.lock.Lock()
+	fsm.conn = conn
+	fsm.connLocal = local
+	fsm.connOpenSent = openSent
+	fsm.lock.Unlock()
+
+	fsm.lock.RLock()
+	defer fsm.lock.RUnlock()
+	if err := setPeerConnTTL(fsm); err != nil {
+		fsm.logger.Warn("cannot set TTL for peer",
+			log.Fields{
+				"Topic": "Peer",
+				"Key":   fsm.pConf.Config.NeighborAddress,
+				"State": fsm.state.String(),
+				"Error": err})
+	}
+	if err := setPeerConnMSS(fsm); err != nil {
+		fsm.logger.Warn("cannot set MSS for peer",
+			log.Fields{
+				"Topic": "Peer",
+				"Key":   fsm.pConf.Config.NeighborAddress,
+				"State": fsm.state.String(),
+				"Error": err})
+	}
+}
+
+// collidesWithConn returns true if an accepted connection collides with
+// the current connection (RFC 4271 6.8), that is, the current one was
+// initiated by the local speaker.
+func (fsm *fsm) collidesWithConn() bool {
+	fsm.lock.RLock()
+	defer fsm.lock.RUnlock()
+	return fsm.connLocal
+}
+
+// keepCollision keeps conn, which collides with the current connection,
+// until the collision is resolved. The OPEN message is sent on conn so
+// that the neighbor, which may have conn as its current connection, gets
+// the BGP Identifier to resolve the collision too.
+func (fsm *fsm) keepCollision(conn net.Conn) {
+	fsm.lock.Lock()
+	m := buildopen(fsm.gConf, fsm.pConf)
+	old := fsm.collisionConn
+	fsm.collisionConn = conn
+	fsm.logger.Debug("connection collision detected",
+		log.Fields{
+			"Topic": "Peer",
+			"Key":   fsm.pConf.State.NeighborAddress,
+			"State": fsm.state.String()})
+	fsm.lock.Unlock()
+
+	if old != nil {
+		old.Close()
+	}
+	b, _ := m.Serialize()
+	if _, err := conn.Write(b); err == nil {
+		fsm.bgpMessageStateUpdate(m.Header.Type, false)
+		fsm.captureMessage(b, false)
+	}
+}
+
+// closeCollidingConn closes conn with the Cease NOTIFICATION for the
+// connection collision resolution (RFC 4486).
+func (fsm *fsm) closeCollidingConn(conn net.Conn) {
+	m := bgp.NewBGPNotificationMessage(bgp.BGP_ERROR_CEASE, bgp.BGP_ERROR_SUB_CONNECTION_COLLISION_RESOLUTION, nil)
+	b, _ := m.Serialize()
+	if _, err := conn.Write(b); err == nil {
+		fsm.bgpMessageStateUpdate(m.Header.Type, false)
+		fsm.captureMessage(b, false)
+	}
+	conn.Close()
+}
+
+// collisionLocalWins returns true if the connection initiated by the
+// local speaker is kept on the connection collision. RFC 4271 6.8: the
+// one initiated by the speaker with the higher BGP Identifier is kept.
+// RFC 6286 2.3: the one initiated by the speaker with the larger AS number
+// is kept if the BGP Identifiers are the same.
+func collisionLocalWins(localID, remoteID net.IP, localAS, remoteAS uint32) bool {
+	id := func(ip net.IP) uint32 {
+		if ip = ip.To4(); ip == nil {
+			return 0
+		}
+		return binary.BigEndian.Uint32(ip)
+	}
+	if l, r := id(localID), id(remoteID); l != r {
+		return l > r
+	}
+	return localAS > remoteAS
+}
+
+// resolveCollision resolves the collision of the current connection and
+// the colliding one with the BGP Identifier in the received OPEN message,
+// closing the one not kept. It returns true if the colliding connection
+// is kept and became the current connection.
+func (fsm *fsm) resolveCollision() bool {
+	fsm.lock.Lock()
+	collision := fsm.collisionConn
+	if collision == nil {
+		fsm.lock.Unlock()
+		return false
+	}
+	fsm.collisionConn = nil
+	conn, local := fsm.conn, fsm.connLocal
+	keep := collisionLocalWins(net.ParseIP(fsm.gConf.Config.RouterId), fsm.peerInfo.ID, fsm.peerInfo.LocalAS, fsm.peerInfo.AS) == local
+	fsm.logger.Info("connection collision resolved",
+		log.Fields{
+			"Topic":       "Peer",
+			"Key":         fsm.pConf.State.NeighborAddress,
+			"State":       fsm.state.String(),
+			"KeptLocal":   keep == local,
+			"KeptCurrent": keep})
+	fsm.lock.Unlock()
+
+	if keep {
+		fsm.closeCollidingConn(collision)
+		return false
+	}
+	fsm.closeCollidingConn(conn)
+	fsm.setConn(collision, !local, true)
+	return true
+}
+
+// takeOverCollision makes the colliding connection the current one if the
+// session on the current connection failed before the collision was
+// resolved. Otherwise the colliding connection is closed unless the
+// session is still being opened. It returns true if the colliding
+// connection took over.
+func (fsm *fsm) takeOverCollision(oldState, nextState bgp.FSMState, reason *fsmStateReason) bool {
+	fsm.lock.Lock()
+	collision, local := fsm.collisionConn, fsm.connLocal
+	if collision == nil || nextState == bgp.BGP_FSM_OPENSENT || nextState == bgp.BGP_FSM_OPENCONFIRM {
+		fsm.lock.Unlock()
+		return false
+	}
+	fsm.collisionConn = nil
+	fsm.lock.Unlock()
+
+	failed := false
+	if nextState == bgp.BGP_FSM_IDLE && reason != nil && (oldState == bgp.BGP_FSM_OPENSENT || oldState == bgp.BGP_FSM_OPENCONFIRM) {
+		switch reason.Type {
+		case fsmNotificationRecv, fsmReadFailed, fsmWriteFailed, fsmHoldTimerExpired:
+			failed = true
+		}
+	}
+	if !failed {
+		collision.Close()
+		return false
+	}
+	fsm.setConn(collision, !local, true)
+	return true
+}
+
 type fsmHandler struct {
 	fsm              *fsm
 	conn             net.Conn
@@ -414,7 +665,7 @@ func (h *fsmHandler) idle(ctx context.Context) (bgp.FSMState, *fsmStateReason) {
 	fsm := h.fsm
 
 	fsm.lock.RLock()
-	idleHoldTimer := time.NewTimer(time.Second * time.Duration(fsm.idleHoldTime))
+	idleHoldTimer := time.NewTimer(time.Duration(fsm.idleHoldTime * float64(time.Second)))
 	fsm.lock.RUnlock()
 
 	for {
@@ -461,7 +712,12 @@ func (h *fsmHandler) idle(ctx context.Context) (bgp.FSMState, *fsmStateReason) {
 						"Topic":    "Peer",
 						"Key":      fsm.pConf.State.NeighborAddress,
 						"Duration": fsm.idleHoldTime})
-				fsm.idleHoldTime = holdtimeIdle
+				if fsm.pConf.Timers.Config.IdleHoldTimeMax == 0 {
+					fsm.idleHoldTime = holdtimeIdle
+				} else {
+					// computed by idleHoldBackoff on the next failure
+					fsm.idleHoldTime = 0
+				}
 				fsm.lock.Unlock()
 				return bgp.BGP_FSM_ACTIVE, newfsmStateReason(fsmIdleTimerExpired, nil, nil)
 			} else {
@@ -482,7 +738,7 @@ func (h *fsmHandler) idle(ctx context.Context) (bgp.FSMState, *fsmStateReason) {
 				case adminStateUp:
 					// restart idle hold timer
 					fsm.lock.RLock()
-					idleHoldTimer.Reset(time.Second * time.Duration(fsm.idleHoldTime))
+					idleHoldTimer.Reset(time.Duration(fsm.idleHoldTime * float64(time.Second)))
 					fsm.lock.RUnlock()
 				}
 			}
@@ -490,11 +746,21 @@ func (h *fsmHandler) idle(ctx context.Context) (bgp.FSMState, *fsmStateReason) {
 	}
 }
 
-func (h *fsmHandler) connectLoop(ctx context.Context, wg *sync.WaitGroup) {
+// connectRetryTime returns the connect retry time of tick seconds randomly
+// reduced by up to the fraction jitter (RFC 4271 10).
+func connectRetryTime(tick int, jitter float64) time.Duration {
+	t := float64(tick)
+	t -= t * jitter * rand.Float64()
+	return time.Duration(t * float64(time.Second))
+}
+
+// connectLoop tries to connect to the neighbor every connect retry time
+// until it succeeds, and sends the connection to connCh.
+func (h *fsmHandler) connectLoop(ctx context.Context, wg *sync.WaitGroup, connCh chan<- net.Conn) {
 	defer wg.Done()
 	fsm := h.fsm
 
-	retry, addr, port, password, ttl, ttlMin, mss, localAddress, localPort, bindInterface := func() (int, string, int, string, uint8, uint8, uint16, string, int, string) {
+	retry, addr, port, password, passwordSource, ttl, ttlMin, mss, localAddress, localPort, bindInterface := func() (int, string, int, string, string, uint8, uint8, uint16, string, int, string) {
 		fsm.lock.RLock()
 		defer fsm.lock.RUnlock()
 
@@ -509,25 +775,31 @@ func (h *fsmHandler) connectLoop(ctx context.Context, wg *sync.WaitGroup) {
 			port = int(fsm.pConf.Transport.Config.RemotePort)
 		}
 		password := fsm.pConf.Config.AuthPassword
-		ttl := uint8(0)
-		ttlMin := uint8(0)
-
-		if fsm.pConf.TtlSecurity.Config.Enabled {
-			ttl = 255
-			ttlMin = fsm.pConf.TtlSecurity.Config.TtlMin
-		} else if fsm.pConf.Config.PeerAs != 0 && fsm.pConf.Config.PeerType == oc.PEER_TYPE_EXTERNAL {
-			ttl = 1
-			if fsm.pConf.EbgpMultihop.Config.Enabled {
-				ttl = fsm.pConf.EbgpMultihop.Config.MultihopTtl
-			}
+		ttl, ttlMin := peerConnTTL(fsm.pConf)
+		bindInterface := fsm.pConf.Transport.Config.BindInterface
+		if vrf := fsm.pConf.Transport.Config.Vrf; vrf != "" {
+			// SO_BINDTODEVICE to the VRF device (l3mdev)
+			bindInterface = vrf
 		}
-		return tick, addr, port, password, ttl, ttlMin, fsm.pConf.Transport.Config.TcpMss, fsm.pConf.Transport.Config.LocalAddress, int(fsm.pConf.Transport.Config.LocalPort), fsm.pConf.Transport.Config.BindInterface
+		return tick, addr, port, password, fsm.pConf.Config.AuthPasswordSource, ttl, ttlMin, fsm.pConf.Transport.Config.TcpMss, fsm.pConf.Transport.Config.LocalAddress, int(fsm.pConf.Transport.Config.LocalPort), bindInterface
 	}()
 
+	fsm.lock.RLock()
+	jitter := fsm.pConf.Timers.Config.ConnectRetryJitter
+	ifname := fsm.pConf.Config.NeighborInterface
+	passwordSourceData := newPasswordSourceData(fsm.pConf)
+	useQUIC := fsm.pConf.Transport.Config.TransportProtocol == oc.TRANSPORT_PROTOCOL_TYPE_QUIC
+	quicCaFile := fsm.pConf.Transport.Config.QuicCaFile
+	fsm.lock.RUnlock()
+
+	// the first attempt is delayed randomly up to twice of
+	// minConnectRetryInterval not to connect at the same time as the
+	// neighbor
 	tick := minConnectRetryInterval
+	delay := time.Duration(rand.Intn(tick)+tick) * time.Second
+	dialAddr := addr
 	for {
-		r := rand.New(rand.NewSource(time.Now().UnixNano()))
-		timer := time.NewTimer(time.Duration(r.Intn(tick)+tick) * time.Second)
+		timer := time.NewTimer(delay)
 		select {
 		case <-ctx.Done():
 			fsm.logger.Debug("stop connect loop",
@@ -545,37 +817,71 @@ func (h *fsmHandler) connectLoop(ctx context.Context, wg *sync.WaitGroup) {
 			}
 		}
 
-		laddr, err := net.ResolveTCPAddr("tcp", net.JoinHostPort(localAddress, strconv.Itoa(localPort)))
-		if err != nil {
-			fsm.logger.Warn("failed to resolve local address",
-				log.Fields{
-					"Topic": "Peer",
-					"Key":   addr})
+		var err error
+		if ifname != "" {
+			// the link-local address of the unnumbered neighbor changes
+			// when the neighbor is replaced, so it's discovered on every
+			// attempt.
+			var neighbor string
+			if neighbor, err = linkLocalNeighborAddress(ifname); err != nil {
+				fsm.logger.Warn("failed to discover the unnumbered neighbor",
+					log.Fields{
+						"Topic":     "Peer",
+						"Key":       addr,
+						"Interface": ifname,
+						"Error":     err})
+			} else if neighbor != dialAddr {
+				fsm.logger.Info("the address of the unnumbered neighbor changed",
+					log.Fields{
+						"Topic":   "Peer",
+						"Key":     addr,
+						"Address": neighbor})
+				dialAddr = neighbor
+			}
 		}
 
+		var laddr *net.TCPAddr
 		if err == nil {
-			d := net.Dialer{
-				LocalAddr: laddr,
-				Timeout:   time.Duration(tick-1) * time.Second,
-				Control: func(network, address string, c syscall.RawConn) error {
-					return dialerControl(fsm.logger, network, address, c, ttl, ttlMin, mss, password, bindInterface)
-				},
+			laddr, err = net.ResolveTCPAddr("tcp", net.JoinHostPort(localAddress, strconv.Itoa(localPort)))
+			if err != nil {
+				fsm.logger.Warn("failed to resolve local address",
+					log.Fields{
+						"Topic": "Peer",
+						"Key":   addr})
 			}
+		}
 
-			conn, err := d.DialContext(ctx, "tcp", net.JoinHostPort(addr, strconv.Itoa(port)))
+		if err == nil {
+			var conn net.Conn
+			if useQUIC {
+				conn, err = dialQUIC(ctx, &net.UDPAddr{IP: laddr.IP, Port: laddr.Port, Zone: laddr.Zone}, net.JoinHostPort(dialAddr, strconv.Itoa(port)), quicCaFile, time.Duration(tick-1)*time.Second)
+			} else {
+				password := neighborPassword(ctx, fsm.logger, passwordSource, password, passwordSourceData)
+				d := net.Dialer{
+					LocalAddr: laddr,
+					Timeout:   time.Duration(tick-1) * time.Second,
+					Control: func(network, address string, c syscall.RawConn) error {
+						return dialerControl(fsm.logger, network, address, c, ttl, ttlMin, mss, password, bindInterface)
+					},
+				}
+				conn, err = d.DialContext(ctx, "tcp", net.JoinHostPort(dialAddr, strconv.Itoa(port)))
+			}
 			select {
 			case <-ctx.Done():
 				fsm.logger.Debug("stop connect loop",
 					log.Fields{
 						"Topic": "Peer",
 						"Key":   addr})
+				if err == nil {
+					conn.Close()
+				}
 				return
 			default:
 			}
 
 			if err == nil {
 				select {
-				case fsm.connCh <- conn:
+				case connCh <- conn:
 					return
 				default:
 					conn.Close()
@@ -595,6 +901,7 @@ func (h *fsmHandler) connectLoop(ctx context.Context, wg *sync.WaitGroup) {
 			}
 		}
 		tick = retry
+		delay = connectRetryTime(tick, jitter)
 	}
 }
 
@@ -607,14 +914,28 @@ func (h *fsmHandler) active(ctx context.Context) (bgp.FSMState, *fsmStateReason)
 	fsm.lock.RLock()
 	tryConnect := !fsm.pConf.Transport.Config.PassiveMode
 	fsm.lock.RUnlock()
+	// the connections initiated by the local speaker, told apart from the
+	// accepted ones to resolve the connection collision
+	localConnCh := make(chan net.Conn, 1)
 	if tryConnect {
 		wg.Add(1)
-		go h.connectLoop(c, &wg)
+		go h.connectLoop(c, &wg, localConnCh)
 	}
 
+	accepted := false
 	defer func() {
 		cancel()
 		wg.Wait()
+		select {
+		case conn := <-localConnCh:
+			// connected while accepting a connection
+			if accepted {
+				fsm.keepCollision(conn)
+			} else {
+				conn.Close()
+			}
+		default:
+		}
 	}()
 
 	for {
@@ -625,31 +946,14 @@ func (h *fsmHandler) active(ctx context.Context) (bgp.FSMState, *fsmStateReason)
 			if !ok {
 				break
 			}
-			fsm.lock.Lock()
-			fsm.conn = conn
-			fsm.lock.Unlock()
-
-			fsm.lock.RLock()
-			if err := setPeerConnTTL(fsm); err != nil {
-				fsm.logger.Warn("cannot set TTL for peer",
-					log.Fields{
-						"Topic": "Peer",
-						"Key":   fsm.pConf.Config.NeighborAddress,
-						"State": fsm.state.String(),
-						"Error": err})
-			}
-			if err := setPeerConnMSS(fsm); err != nil {
-				fsm.logger.Warn("cannot set MSS for peer",
-					log.Fields{
-						"Topic": "Peer",
-						"Key":   fsm.pConf.Config.NeighborAddress,
-						"State": fsm.state.String(),
-						"Error": err})
-			}
-			fsm.lock.RUnlock()
+			fsm.setConn(conn, false, false)
+			accepted = true
 			// we don't implement delayed open timer so move to opensent right
 			// away.
 			return bgp.BGP_FSM_OPENSENT, newfsmStateReason(fsmNewConnection, nil, nil)
+		case conn := <-localConnCh:
+			fsm.setConn(conn, true, false)
+			return bgp.BGP_FSM_OPENSENT, newfsmStateReason(fsmNewConnection, nil, nil)
 		case <-fsm.gracefulRestartTimer.C:
 			fsm.lock.RLock()
 			restarting := fsm.pConf.GracefulRestart.State.PeerRestarting
@@ -685,44 +989,53 @@ func (h *fsmHandler) active(ctx context.Context) (bgp.FSMState, *fsmStateReason)
 	}
 }
 
-func setPeerConnTTL(fsm *fsm) error {
-	ttl := 0
-	ttlMin := 0
-
-	if fsm.pConf.TtlSecurity.Config.Enabled {
-		ttl = 255
-		ttlMin = int(fsm.pConf.TtlSecurity.Config.TtlMin)
-	} else if fsm.pConf.Config.PeerAs != 0 && fsm.pConf.Config.PeerType == oc.PEER_TYPE_EXTERNAL {
-		if fsm.pConf.EbgpMultihop.Config.Enabled {
-			ttl = int(fsm.pConf.EbgpMultihop.Config.MultihopTtl)
-		} else if fsm.pConf.Transport.Config.Ttl != 0 {
-			ttl = int(fsm.pConf.Transport.Config.Ttl)
-		} else {
-			ttl = 1
+// peerConnTTL returns the TTL of the packets sent to the neighbor and the
+// minimal TTL of the packets accepted from it (RFC 5082), zero if not set.
+func peerConnTTL(pConf *oc.Neighbor) (uint8, uint8) {
+	if pConf.TtlSecurity.Config.Enabled {
+		return 255, pConf.TtlSecurity.Config.TtlMin
+	}
+	if pConf.Config.PeerAs != 0 && pConf.Config.PeerType == oc.PEER_TYPE_EXTERNAL {
+		if pConf.EbgpMultihop.Config.Enabled {
+			return pConf.EbgpMultihop.Config.MultihopTtl, 0
+		} else if pConf.Transport.Config.Ttl != 0 {
+			return pConf.Transport.Config.Ttl, 0
 		}
-	} else if fsm.pConf.Transport.Config.Ttl != 0 {
-		ttl = int(fsm.pConf.Transport.Config.Ttl)
+		return 1, 0
 	}
+	return pConf.Transport.Config.Ttl, 0
+}
 
+func setConnTTL(conn net.Conn, ttl, ttlMin uint8) error {
+	tcpConn, ok := conn.(*net.TCPConn)
+	if !ok {
+		return nil
+	}
 	if ttl != 0 {
-		if err := setTCPTTLSockopt(fsm.conn.(*net.TCPConn), ttl); err != nil {
+		if err := setTCPTTLSockopt(tcpConn, int(ttl)); err != nil {
 			return fmt.Errorf("failed to set TTL %d: %w", ttl, err)
 		}
 	}
 	if ttlMin != 0 {
-		if err := setTCPMinTTLSockopt(fsm.conn.(*net.TCPConn), ttlMin); err != nil {
+		if err := setTCPMinTTLSockopt(tcpConn, int(ttlMin)); err != nil {
 			return fmt.Errorf("failed to set minimal TTL %d: %w", ttlMin, err)
 		}
 	}
 	return nil
 }
 
+func setPeerConnTTL(fsm *fsm) error {
+	ttl, ttlMin := peerConnTTL(fsm.pConf)
+	return setConnTTL(fsm.conn, ttl, ttlMin)
+}
+
 func setPeerConnMSS(fsm *fsm) error {
 	mss := fsm.pConf.Transport.Config.TcpMss
-	if mss == 0 {
+	conn, ok := fsm.conn.(*net.TCPConn)
+	if mss == 0 || !ok {
 		return nil
 	}
-	if err := setTCPMSSSockopt(fsm.conn.(*net.TCPConn), mss); err != nil {
+	if err := setTCPMSSSockopt(conn, mss); err != nil {
 		return fmt.Errorf("failed to set MSS %d: %w", mss, err)
 	}
 	return nil
@@ -753,6 +1066,7 @@ func capabilitiesFromConfig(pConf *oc.Neighbor) []bgp.ParameterCapabilityInterfa
 	caps := make([]bgp.ParameterCapabilityInterface, 0, 4)
 	caps = append(caps, bgp.NewCapRouteRefresh())
 	caps = append(caps, bgp.NewCapFQDN(fqdn, ""))
+	caps = append(caps, bgp.NewCapExtendedMessage())
 
 	if pConf.Config.SendSoftwareVersion || pConf.Config.PeerType == oc.PEER_TYPE_INTERNAL {
 		softwareVersion := fmt.Sprintf("GoBGP/%s", version.Version())
@@ -762,7 +1076,9 @@ func capabilitiesFromConfig(pConf *oc.Neighbor) []bgp.ParameterCapabilityInterfa
 	for _, af := range pConf.AfiSafis {
 		caps = append(caps, bgp.NewCapMultiProtocol(af.State.Family))
 	}
-	caps = append(caps, bgp.NewCapFourOctetASNumber(pConf.Config.LocalAs))
+	if !pConf.Config.DisableFourOctetAs {
+		caps = append(caps, bgp.NewCapFourOctetASNumber(pConf.Config.LocalAs))
+	}
 
 	if c := pConf.GracefulRestart.Config; c.Enabled {
 		tuples := []*bgp.CapGracefulRestartTuple{}
@@ -819,6 +1135,16 @@ func capabilitiesFromConfig(pConf *oc.Neighbor) []bgp.ParameterCapabilityInterfa
 		caps = append(caps, capAddPathFromConfig(pConf))
 	}
 
+	// Outbound Route Filtering Capability
+	if c := capOutboundRouteFilteringFromConfig(pConf); c != nil {
+		caps = append(caps, c)
+	}
+
+	// Dynamic Capability
+	if pConf.Config.DynamicCapability {
+		caps = append(caps, bgp.NewCapDynamic([]bgp.BGPCapabilityCode{bgp.BGP_CAP_MULTIPROTOCOL}))
+	}
+
 	return caps
 }
 
@@ -852,6 +1178,16 @@ func getPathAttrFromBGPUpdate(m *bgp.BGPUpdate, typ bgp.BGPAttrType) bgp.PathAtt
 	return nil
 }
 
+func removeAigpFromBGPUpdate(m *bgp.BGPUpdate) {
+	attrs := m.PathAttributes[:0]
+	for _, a := range m.PathAttributes {
+		if a.GetType() != bgp.BGP_ATTR_TYPE_AIGP {
+			attrs = append(attrs, a)
+		}
+	}
+	m.PathAttributes = attrs
+}
+
 func hasOwnASLoop(ownAS uint32, limit int, asPath *bgp.PathAttributeAsPath) bool {
 	cnt := 0
 	for _, param := range asPath.Value {
@@ -867,7 +1203,33 @@ func hasOwnASLoop(ownAS uint32, limit int, asPath *bgp.PathAttributeAsPath) bool
 	return false
 }
 
+// hasConfedASLoop returns true if the member AS appears in the
+// AS_CONFED_SEQUENCE and AS_CONFED_SET segments, or the confederation
+// identifier appears in the others, more than limit times (RFC 5065).
+func hasConfedASLoop(memberAS, identifier uint32, limit int, asPath *bgp.PathAttributeAsPath) bool {
+	cnt := 0
+	for _, param := range asPath.Value {
+		ownAS := identifier
+		switch param.GetType() {
+		case bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SEQ, bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SET:
+			ownAS = memberAS
+		}
+		for _, as := range param.GetAS() {
+			if as == ownAS {
+				cnt++
+				if cnt > limit {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 func extractRouteFamily(p *bgp.PathAttributeInterface) *bgp.RouteFamily {
+	if p == nil {
+		return nil
+	}
 	attr := *p
 
 	var afi uint16
@@ -885,6 +1247,9 @@ func extractRouteFamily(p *bgp.PathAttributeInterface) *bgp.RouteFamily {
 	}
 
 	rf := bgp.AfiSafiToRouteFamily(afi, safi)
+	if _, ok := bgp.AddressFamilyNameMap[rf]; !ok {
+		return nil
+	}
 	return &rf
 }
 
@@ -911,12 +1276,41 @@ func (h *fsmHandler) afiSafiDisable(rf bgp.RouteFamily) string {
 	return n
 }
 
+// errorAttributeType returns the type of the path attribute which caused
+// the error, 0 if unknown.
+func errorAttributeType(e *bgp.MessageError) bgp.BGPAttrType {
+	if e.ErrorAttribute != nil {
+		return (*e.ErrorAttribute).GetType()
+	}
+	if e.SubTypeCode == bgp.BGP_ERROR_SUB_MISSING_WELL_KNOWN_ATTRIBUTE && len(e.Data) == 1 {
+		return bgp.BGPAttrType(e.Data[0])
+	}
+	return 0
+}
+
+// lenientMarshallingOption makes the path attribute errors of the UPDATE
+// messages handled per RFC 7606 instead of aborting the decoding.
+var lenientMarshallingOption = &bgp.MarshallingOption{Lenient: true}
+
 func (h *fsmHandler) handlingError(m *bgp.BGPMessage, e error, useRevisedError bool) bgp.ErrorHandling {
 	// ineffectual assignment to handling (ineffassign)
 	var handling bgp.ErrorHandling
-	if m.Header.Type == bgp.BGP_MSG_UPDATE && useRevisedError {
-		factor := e.(*bgp.MessageError)
+	factor, ok := e.(*bgp.MessageError)
+	if m != nil && m.Header.Type == bgp.BGP_MSG_UPDATE && useRevisedError && ok {
 		handling = factor.ErrorHandling
+		if len(factor.Errors) > 1 {
+			h.fsm.lock.RLock()
+			for _, err := range factor.Errors {
+				h.fsm.logger.Debug("malformed path attribute",
+					log.Fields{
+						"Topic":         "Peer",
+						"Key":           h.fsm.pConf.State.NeighborAddress,
+						"State":         h.fsm.state.String(),
+						"ErrorHandling": err.ErrorHandling,
+						"Error":         err})
+			}
+			h.fsm.lock.RUnlock()
+		}
 		switch handling {
 		case bgp.ERROR_HANDLING_ATTRIBUTE_DISCARD:
 			h.fsm.lock.RLock()
@@ -929,17 +1323,24 @@ func (h *fsmHandler) handlingError(m *bgp.BGPMessage, e error, useRevisedError b
 			h.fsm.lock.RUnlock()
 		case bgp.ERROR_HANDLING_TREAT_AS_WITHDRAW:
 			m.Body = bgp.TreatAsWithdraw(m.Body.(*bgp.BGPUpdate))
-			h.fsm.lock.RLock()
+			attrType := errorAttributeType(factor)
+			h.fsm.lock.Lock()
+			h.fsm.pConf.ErrorHandling.State.ErroneousUpdateMessages++
+			h.fsm.treatAsWithdrawAttributes[attrType]++
 			h.fsm.logger.Warn("the received Update message was treated as withdraw",
 				log.Fields{
-					"Topic": "Peer",
-					"Key":   h.fsm.pConf.State.NeighborAddress,
-					"State": h.fsm.state.String(),
-					"Error": e})
-			h.fsm.lock.RUnlock()
+					"Topic":     "Peer",
+					"Key":       h.fsm.pConf.State.NeighborAddress,
+					"State":     h.fsm.state.String(),
+					"Attribute": attrType,
+					"Error":     e})
+			h.fsm.lock.Unlock()
 		case bgp.ERROR_HANDLING_AFISAFI_DISABLE:
 			rf := extractRouteFamily(factor.ErrorAttribute)
 			if rf == nil {
+				// RFC 7606 7.11. the session is reset if the AFI/SAFI
+				// can't be determined.
+				handling = bgp.ERROR_HANDLING_SESSION_RESET
 				h.fsm.lock.RLock()
 				h.fsm.logger.Warn("Error occurred during AFI/SAFI disabling",
 					log.Fields{
@@ -985,6 +1386,7 @@ func (h *fsmHandler) recvMessageWithError() (*fsmMsg, error) {
 	err = hd.DecodeFromBytes(headerBuf)
 	if err != nil {
 		h.fsm.bgpMessageStateUpdate(0, true)
+		h.fsm.captureMessage(headerBuf, true)
 		h.fsm.lock.RLock()
 		h.fsm.logger.Warn("Session will be reset due to malformed BGP Header",
 			log.Fields{
@@ -1010,26 +1412,35 @@ func (h *fsmHandler) recvMessageWithError() (*fsmMsg, error) {
 
 	now := time.Now()
 	handling := bgp.ERROR_HANDLING_NONE
+	payload := append(headerBuf, bodyBuf...)
+	h.fsm.captureMessage(payload, true)
 
 	h.fsm.lock.RLock()
 	useRevisedError := h.fsm.pConf.ErrorHandling.Config.TreatAsWithdraw
 	options := h.fsm.marshallingOptions
 	h.fsm.lock.RUnlock()
 
-	m, err := bgp.ParseBGPBody(hd, bodyBuf, options)
+	var m *bgp.BGPMessage
+	if useRevisedError {
+		m, err = bgp.ParseBGPBody(hd, bodyBuf, options, lenientMarshallingOption)
+	} else {
+		m, err = bgp.ParseBGPBody(hd, bodyBuf, options)
+	}
 	if err != nil {
 		handling = h.handlingError(m, err, useRevisedError)
 		h.fsm.bgpMessageStateUpdate(0, true)
 	} else {
 		h.fsm.bgpMessageStateUpdate(m.Header.Type, true)
-		err = bgp.ValidateBGPMessage(m)
+		err = bgp.ValidateBGPMessage(m, options)
 	}
 	h.fsm.lock.RLock()
 	fmsg := &fsmMsg{
-		fsm:       h.fsm,
-		MsgType:   fsmMsgBGPMessage,
-		MsgSrc:    h.fsm.pConf.State.NeighborAddress,
-		timestamp: now,
+		fsm:        h.fsm,
+		MsgType:    fsmMsgBGPMessage,
+		MsgSrc:     h.fsm.pConf.State.NeighborAddress,
+		timestamp:  now,
+		payload:    payload,
+		erroredPDU: handling != bgp.ERROR_HANDLING_NONE,
 	}
 	h.fsm.lock.RUnlock()
 
@@ -1059,6 +1470,8 @@ func (h *fsmHandler) recvMessageWithError() (*fsmMsg, error) {
 			switch m.Header.Type {
 			case bgp.BGP_MSG_ROUTE_REFRESH:
 				fmsg.MsgType = fsmMsgRouteRefresh
+			case bgp.BGP_MSG_CAPABILITY:
+				fmsg.MsgType = fsmMsgCapability
 			case bgp.BGP_MSG_UPDATE:
 				// if the length of h.holdTimerResetCh
 				// isn't zero, the timer will be reset
@@ -1071,10 +1484,6 @@ func (h *fsmHandler) recvMessageWithError() (*fsmMsg, error) {
 				isEBGP := h.fsm.pConf.IsEBGPPeer(h.fsm.gConf)
 				isConfed := h.fsm.pConf.IsConfederationMember(h.fsm.gConf)
 
-				fmsg.payload = make([]byte, len(headerBuf)+len(bodyBuf))
-				copy(fmsg.payload, headerBuf)
-				copy(fmsg.payload[len(headerBuf):], bodyBuf)
-
 				h.fsm.lock.RLock()
 				rfMap := h.fsm.rfMap
 				h.fsm.lock.RUnlock()
@@ -1089,6 +1498,7 @@ func (h *fsmHandler) recvMessageWithError() (*fsmMsg, error) {
 				ok, err := bgp.ValidateUpdateMsg(body, rfMap, isEBGP, isConfed, allowLoopback)
 				if !ok {
 					handling = h.handlingError(m, err, useRevisedError)
+					fmsg.erroredPDU = true
 				}
 				if handling == bgp.ERROR_HANDLING_SESSION_RESET {
 					h.fsm.lock.RLock()
@@ -1114,6 +1524,13 @@ func (h *fsmHandler) recvMessageWithError() (*fsmMsg, error) {
 					}
 				}
 
+				h.fsm.lock.RLock()
+				fourOctetAs := h.fsm.fourOctetAs()
+				h.fsm.lock.RUnlock()
+				if fourOctetAs {
+					table.DiscardAs4PathAttrs(h.fsm.logger, body)
+				}
+
 				if err = table.UpdatePathAttrs4ByteAs(h.fsm.logger, body); err != nil {
 					fmsg.MsgData = err
 					return fmsg, err
@@ -1126,7 +1543,14 @@ func (h *fsmHandler) recvMessageWithError() (*fsmMsg, error) {
 
 				h.fsm.lock.RLock()
 				peerInfo := h.fsm.peerInfo
+				receiveAigp := h.fsm.pConf.Config.ReceiveAigp
 				h.fsm.lock.RUnlock()
+				// RFC 7311 3.
+				// The AIGP attribute received on a session where it's
+				// disabled is ignored and not propagated.
+				if !receiveAigp {
+					removeAigpFromBGPUpdate(body)
+				}
 				fmsg.PathList = table.ProcessMessage(m, peerInfo, fmsg.timestamp)
 				fallthrough
 			case bgp.BGP_MSG_KEEPALIVE:
@@ -1222,6 +1646,13 @@ func open2Cap(open *bgp.BGPOpen, n *oc.Neighbor) (map[bgp.BGPCapabilityCode][]bg
 		capMap[bgp.BGP_CAP_MULTIPROTOCOL] = []bgp.ParameterCapabilityInterface{bgp.NewCapMultiProtocol(bgp.RF_IPv4_UC)}
 	}
 
+	return capMap, negotiateFamilies(capMap, n)
+}
+
+// negotiateFamilies returns the address families both in the local
+// configuration and in the remote capabilities with the negotiated
+// ADD-PATH modes.
+func negotiateFamilies(capMap map[bgp.BGPCapabilityCode][]bgp.ParameterCapabilityInterface, n *oc.Neighbor) map[bgp.RouteFamily]bgp.BGPAddPathMode {
 	local := n.CreateRfMap()
 	remote := make(map[bgp.RouteFamily]bgp.BGPAddPathMode)
 	for _, c := range capMap[bgp.BGP_CAP_MULTIPROTOCOL] {
@@ -1248,7 +1679,7 @@ func open2Cap(open *bgp.BGPOpen, n *oc.Neighbor) (map[bgp.BGPCapabilityCode][]bg
 			negotiated[family] = n
 		}
 	}
-	return capMap, negotiated
+	return negotiated
 }
 
 func (h *fsmHandler) opensent(ctx context.Context) (bgp.FSMState, *fsmStateReason) {
@@ -1256,11 +1687,17 @@ func (h *fsmHandler) opensent(ctx context.Context) (bgp.FSMState, *fsmStateReaso
 
 	fsm.lock.Lock()
 	m := buildopen(fsm.gConf, fsm.pConf)
+	// sent when the connection was kept on the collision
+	openSent := fsm.connOpenSent
+	fsm.connOpenSent = false
 	fsm.lock.Unlock()
 
-	b, _ := m.Serialize()
-	fsm.conn.Write(b)
-	fsm.bgpMessageStateUpdate(m.Header.Type, false)
+	if !openSent {
+		b, _ := m.Serialize()
+		fsm.conn.Write(b)
+		fsm.bgpMessageStateUpdate(m.Header.Type, false)
+		fsm.captureMessage(b, false)
+	}
 
 	h.msgCh = channels.NewInfiniteChannel()
 
@@ -1290,6 +1727,10 @@ func (h *fsmHandler) opensent(ctx context.Context) (bgp.FSMState, *fsmStateReaso
 			if !ok {
 				break
 			}
+			if fsm.collidesWithConn() {
+				fsm.keepCollision(conn)
+				break
+			}
 			conn.Close()
 			fsm.lock.RLock()
 			fsm.logger.Warn("Closed an accepted connection",
@@ -1366,9 +1807,17 @@ func (h *fsmHandler) opensent(ctx context.Context) (bgp.FSMState, *fsmStateReaso
 					fsm.peerInfo.ID = body.ID
 					fsm.capMap, fsm.rfMap = open2Cap(body, fsm.pConf)
 
-					if _, y := fsm.capMap[bgp.BGP_CAP_ADD_PATH]; y {
+					_, addPath := fsm.capMap[bgp.BGP_CAP_ADD_PATH]
+					// RFC 8654 3
+					// the extended message capability is always
+					// advertised, so receiving it is enough.
+					_, extendedMessage := fsm.capMap[bgp.BGP_CAP_EXTENDED_MESSAGE]
+					if addPath || extendedMessage {
 						fsm.marshallingOptions = &bgp.MarshallingOption{
-							AddPath: fsm.rfMap,
+							ExtendedMessage: extendedMessage,
+						}
+						if addPath {
+							fsm.marshallingOptions.AddPath = fsm.rfMap
 						}
 					} else {
 						fsm.marshallingOptions = nil
@@ -1466,6 +1915,7 @@ func (h *fsmHandler) opensent(ctx context.Context) (bgp.FSMState, *fsmStateReaso
 					b, _ := msg.Serialize()
 					fsm.conn.Write(b)
 					fsm.bgpMessageStateUpdate(msg.Header.Type, false)
+					fsm.captureMessage(b, false)
 					return bgp.BGP_FSM_OPENCONFIRM, newfsmStateReason(fsmOpenMsgReceived, nil, nil)
 				} else {
 					// send notification?
@@ -1526,6 +1976,12 @@ func keepaliveTicker(fsm *fsm) *time.Ticker {
 
 func (h *fsmHandler) openconfirm(ctx context.Context) (bgp.FSMState, *fsmStateReason) {
 	fsm := h.fsm
+	// RFC 4271 6.8
+	// the collision is resolved once the BGP Identifier of the neighbor
+	// is known from the OPEN message.
+	if fsm.resolveCollision() {
+		return bgp.BGP_FSM_OPENSENT, newfsmStateReason(fsmNewConnection, nil, nil)
+	}
 	ticker := keepaliveTicker(fsm)
 	h.msgCh = channels.NewInfiniteChannel()
 	fsm.lock.RLock()
@@ -1555,6 +2011,13 @@ func (h *fsmHandler) openconfirm(ctx context.Context) (bgp.FSMState, *fsmStateRe
 			if !ok {
 				break
 			}
+			if fsm.collidesWithConn() {
+				fsm.keepCollision(conn)
+				if fsm.resolveCollision() {
+					return bgp.BGP_FSM_OPENSENT, newfsmStateReason(fsmNewConnection, nil, nil)
+				}
+				break
+			}
 			conn.Close()
 			fsm.lock.RLock()
 			fsm.logger.Warn("Closed an accepted connection",
@@ -1584,6 +2047,7 @@ func (h *fsmHandler) openconfirm(ctx context.Context) (bgp.FSMState, *fsmStateRe
 			// TODO: check error
 			fsm.conn.Write(b)
 			fsm.bgpMessageStateUpdate(m.Header.Type, false)
+			fsm.captureMessage(b, false)
 		case i, ok := <-h.msgCh.Out():
 			if !ok {
 				continue
@@ -1712,6 +2176,7 @@ func (h *fsmHandler) sendMessageloop(ctx context.Context, wg *sync.WaitGroup) er
 			return fmt.Errorf("closed")
 		}
 		fsm.bgpMessageStateUpdate(m.Header.Type, false)
+		fsm.captureMessage(b, false)
 
 		switch m.Header.Type {
 		case bgp.BGP_MSG_NOTIFICATION:
@@ -1771,40 +2236,156 @@ func (h *fsmHandler) sendMessageloop(ctx context.Context, wg *sync.WaitGroup) er
 		return nil
 	}
 
+	// sendUpdate delays the UPDATE message per the update rate limit,
+	// sending KEEPALIVE messages meanwhile not to expire the hold timer of
+	// the neighbor.
+	sendUpdate := func(m *bgp.BGPMessage) error {
+		if delay := fsm.updateLimiter.reserve(m); delay > 0 {
+			timer := time.NewTimer(delay)
+			defer timer.Stop()
+		wait:
+			for {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-ticker.C:
+					if err := send(bgp.NewBGPKeepAliveMessage()); err != nil {
+						return err
+					}
+				case <-timer.C:
+					break wait
+				}
+			}
+		}
+		return send(m)
+	}
+
+	// the message read while gathering the paths but not sent yet
+	var pending interface{}
 	for {
+		var o interface{}
+		if pending != nil {
+			o, pending = pending, nil
+		} else {
+			select {
+			case <-ctx.Done():
+				return nil
+			case o = <-h.outgoing.Out():
+			case <-ticker.C:
+				if err := send(bgp.NewBGPKeepAliveMessage()); err != nil {
+					return nil
+				}
+				continue
+			}
+		}
+		switch m := o.(type) {
+		case *fsmOutgoingMsg:
+			for _, msg := range m.Messages {
+				if err := sendUpdate(msg); err != nil {
+					return nil
+				}
+			}
+			h.fsm.lock.RLock()
+			options := h.fsm.marshallingOptions
+			h.fsm.lock.RUnlock()
+			paths := m.Paths
+			if m.Notification == nil {
+				paths, pending = h.gatherOutgoingPaths(ctx, paths, options)
+			}
+			msgs := table.CreateUpdateMsgFromPaths(paths, options)
+			atomic.StoreInt64(&fsm.pendingUpdates, int64(len(msgs)))
+			for _, msg := range msgs {
+				err := sendUpdate(msg)
+				atomic.AddInt64(&fsm.pendingUpdates, -1)
+				if err != nil {
+					atomic.StoreInt64(&fsm.pendingUpdates, 0)
+					return nil
+				}
+			}
+			if m.Notification != nil {
+				if m.StayIdle {
+					// current user is only prefix-limit
+					// fix me if this is not the case
+					h.changeadminState(adminStatePfxCt)
+				}
+				if err := send(m.Notification); err != nil {
+					return nil
+				}
+			}
+		default:
+			return nil
+		}
+	}
+}
+
+// gatherOutgoingPaths appends the paths of the messages queued within
+// updatePackingWindow to paths so that the paths with the same attributes
+// are packed into as few UPDATE messages as possible. It stops at the
+// message which must be sent after paths, e.g. NOTIFICATION, and returns
+// it too.
+func (h *fsmHandler) gatherOutgoingPaths(ctx context.Context, paths []*table.Path, options *bgp.MarshallingOption) ([]*table.Path, interface{}) {
+	if len(paths) == 0 {
+		return paths, nil
+	}
+	gathered := false
+	var pending interface{}
+	timer := time.NewTimer(updatePackingWindow)
+	defer timer.Stop()
+loop:
+	for len(paths) < updatePackingMaxPaths {
 		select {
 		case <-ctx.Done():
-			return nil
+			break loop
+		case <-timer.C:
+			break loop
 		case o := <-h.outgoing.Out():
-			switch m := o.(type) {
-			case *fsmOutgoingMsg:
-				h.fsm.lock.RLock()
-				options := h.fsm.marshallingOptions
-				h.fsm.lock.RUnlock()
-				for _, msg := range table.CreateUpdateMsgFromPaths(m.Paths, options) {
-					if err := send(msg); err != nil {
-						return nil
-					}
-				}
-				if m.Notification != nil {
-					if m.StayIdle {
-						// current user is only prefix-limit
-						// fix me if this is not the case
-						h.changeadminState(adminStatePfxCt)
-					}
-					if err := send(m.Notification); err != nil {
-						return nil
-					}
-				}
-			default:
-				return nil
+			if m, ok := o.(*fsmOutgoingMsg); ok && len(m.Messages) == 0 && m.Notification == nil {
+				paths = append(paths, m.Paths...)
+				gathered = true
+				continue
 			}
-		case <-ticker.C:
-			if err := send(bgp.NewBGPKeepAliveMessage()); err != nil {
-				return nil
+			pending = o
+			break loop
+		}
+	}
+	if gathered {
+		paths = dedupOutgoingPaths(paths, options)
+	}
+	return paths, pending
+}
+
+// dedupOutgoingPaths keeps only the last one of the paths for the same
+// NLRI, which the peer would end up with, because the packer doesn't
+// keep the order of the paths.
+func dedupOutgoingPaths(paths []*table.Path, options *bgp.MarshallingOption) []*table.Path {
+	seen := make(map[string]struct{}, len(paths))
+	l := make([]*table.Path, 0, len(paths))
+	for i := len(paths) - 1; i >= 0; i-- {
+		path := paths[i]
+		if !path.IsEOR() {
+			key := outgoingPathKey(path, options)
+			if _, ok := seen[key]; ok {
+				continue
 			}
+			seen[key] = struct{}{}
 		}
+		l = append(l, path)
+	}
+	for i, j := 0, len(l)-1; i < j; i, j = i+1, j-1 {
+		l[i], l[j] = l[j], l[i]
+	}
+	return l
+}
+
+// outgoingPathKey returns the key of the NLRI of the path sent to the
+// peer, where the later path replaces the former one.
+func outgoingPathKey(path *table.Path, options *bgp.MarshallingOption) string {
+	family := path.GetRouteFamily()
+	key := family.String() + " " + path.GetNlri().String()
+	if bgp.IsAddPathEnabled(false, family, []*bgp.MarshallingOption{options}) {
+		key += " " + strconv.Itoa(int(path.GetNlri().PathLocalIdentifier()))
 	}
+	return key
 }
 
 func (h *fsmHandler) recvMessageloop(ctx context.Context, wg *sync.WaitGroup) error {
@@ -1866,6 +2447,7 @@ func (h *fsmHandler) established(ctx context.Context) (bgp.FSMState, *fsmStateRe
 				}
 				b, _ := m.Serialize(h.fsm.marshallingOptions)
 				h.conn.Write(b)
+				fsm.captureMessage(b, false)
 			default:
 				// nothing to do
 			}
@@ -1976,6 +2558,10 @@ func (h *fsmHandler) loop(ctx context.Context, wg *sync.WaitGroup) error {
 		nextState, reason = h.established(ctx)
 	}
 
+	if fsm.takeOverCollision(oldState, nextState, reason) {
+		nextState, reason = bgp.BGP_FSM_OPENSENT, newfsmStateReason(fsmNewConnection, nil, nil)
+	}
+
 	fsm.lock.RLock()
 	fsm.reason = reason
 
@@ -18,6 +18,7 @@ package server
 import (
 	"context"
 	"errors"
+	"io"
 	"net"
 	"strconv"
 	"sync"
@@ -25,6 +26,7 @@ import (
 	"time"
 
 	"github.com/eapache/channels"
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
 	"github.com/osrg/gobgp/v3/pkg/config/oc"
 	"github.com/osrg/gobgp/v3/pkg/log"
 	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
@@ -350,6 +352,25 @@ func TestCheckOwnASLoop(t *testing.T) {
 	assert.False(hasOwnASLoop(65200, 0, aspath))
 }
 
+func TestCheckConfedASLoop(t *testing.T) {
+	assert := assert.New(t)
+	aspath := bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{
+		bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SEQ, []uint32{65002, 65001}),
+		bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint32{100}),
+	})
+	assert.True(hasConfedASLoop(65001, 30, 0, aspath))
+	assert.False(hasConfedASLoop(65001, 30, 1, aspath))
+	// the member AS outside the confederation segments isn't a loop
+	assert.False(hasConfedASLoop(100, 30, 0, aspath))
+	assert.False(hasConfedASLoop(65003, 30, 0, aspath))
+
+	aspath = bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{
+		bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SEQ, []uint32{65002}),
+		bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, []uint32{100, 30}),
+	})
+	assert.True(hasConfedASLoop(65001, 30, 0, aspath))
+}
+
 func TestBadBGPIdentifier(t *testing.T) {
 	assert := assert.New(t)
 	msg1 := openWithBadBGPIdentifier_Zero()
@@ -368,6 +389,251 @@ func TestBadBGPIdentifier(t *testing.T) {
 	assert.Equal(uint8(bgp.BGP_ERROR_SUB_BAD_BGP_IDENTIFIER), err.(*bgp.MessageError).SubTypeCode)
 }
 
+func TestHandlingErrorTreatAsWithdraw(t *testing.T) {
+	assert := assert.New(t)
+	_, h := makePeerAndHandler()
+
+	// invalid ORIGIN and AGGREGATOR
+	buf := []byte{
+		0x00, 0x00, 0x00, 0x16,
+		0xc0, 0x07, 0x05, 0x00, 0x00, 0x00, 0x64, 0x00,
+		0x40, 0x01, 0x02, 0x00, 0x00,
+		0x40, 0x02, 0x00,
+		0x40, 0x03, 0x04, 0xc0, 0xa8, 0x01, 0x64,
+		0x18, 0x0a, 0x00, 0x00,
+	}
+	buf[3] = byte(len(buf) - 8)
+	m, err := bgp.ParseBGPBody(&bgp.BGPHeader{Type: bgp.BGP_MSG_UPDATE}, buf, lenientMarshallingOption)
+	assert.Error(err)
+	assert.Len(err.(*bgp.MessageError).Errors, 2)
+
+	assert.Equal(bgp.ERROR_HANDLING_TREAT_AS_WITHDRAW, h.handlingError(m, err, true))
+	assert.Len(m.Body.(*bgp.BGPUpdate).WithdrawnRoutes, 1)
+	assert.Equal(uint32(1), h.fsm.pConf.ErrorHandling.State.ErroneousUpdateMessages)
+	assert.Equal(map[bgp.BGPAttrType]uint32{bgp.BGP_ATTR_TYPE_ORIGIN: 1}, h.fsm.treatAsWithdrawAttributes)
+
+	// the session is reset if the AFI/SAFI of MP_REACH_NLRI is unknown
+	var attr bgp.PathAttributeInterface = &bgp.PathAttributeMpReachNLRI{}
+	err = bgp.NewMessageErrorWithErrorHandling(0, 0, nil, bgp.ERROR_HANDLING_AFISAFI_DISABLE, &attr, "")
+	assert.Equal(bgp.ERROR_HANDLING_SESSION_RESET, h.handlingError(m, err, true))
+
+	assert.Equal(bgp.ERROR_HANDLING_SESSION_RESET, h.handlingError(m, err, false))
+}
+
+func TestIdleHoldBackoff(t *testing.T) {
+	assert := assert.New(t)
+	m := newFSM(&oc.Global{}, &oc.Neighbor{}, log.NewDefaultLogger())
+	m.pConf.Timers.Config.IdleHoldTimeMin = 5
+	m.pConf.Timers.Config.IdleHoldTimeMax = 30
+
+	fail := func(from bgp.FSMState) {
+		m.StateChange(from)
+		m.StateChange(bgp.BGP_FSM_IDLE)
+	}
+	for _, expected := range []float64{5, 10, 20, 30, 30} {
+		m.idleHoldTime = 0
+		fail(bgp.BGP_FSM_OPENSENT)
+		assert.Equal(expected, m.idleHoldTime)
+		assert.Equal(expected, m.pConf.Timers.State.IdleHoldTime)
+	}
+	assert.Equal(uint32(5), m.pConf.Timers.State.ConsecutiveFailures)
+
+	// a short-lived session is counted as a failure
+	m.idleHoldTime = 0
+	fail(bgp.BGP_FSM_ESTABLISHED)
+	assert.Equal(uint32(6), m.pConf.Timers.State.ConsecutiveFailures)
+
+	// a session established longer than idle-hold-time-max resets the backoff
+	m.StateChange(bgp.BGP_FSM_ESTABLISHED)
+	m.pConf.Timers.State.Uptime = time.Now().Add(-time.Minute).Unix()
+	m.idleHoldTime = 0
+	m.StateChange(bgp.BGP_FSM_IDLE)
+	assert.Equal(uint32(1), m.pConf.Timers.State.ConsecutiveFailures)
+	assert.Equal(float64(5), m.idleHoldTime)
+
+	// the idle hold time after reset takes precedence
+	m.idleHoldTime = 60
+	fail(bgp.BGP_FSM_OPENSENT)
+	assert.Equal(float64(60), m.idleHoldTime)
+	assert.Equal(float64(10), m.pConf.Timers.State.IdleHoldTime)
+
+	m.pConf.Timers.Config.IdleHoldTimeJitter = 0.5
+	for i := 0; i < 10; i++ {
+		// stays at idle-hold-time-max
+		m.pConf.Timers.State.ConsecutiveFailures = 3
+		m.idleHoldTime = 0
+		fail(bgp.BGP_FSM_OPENSENT)
+		assert.True(m.idleHoldTime > 15 && m.idleHoldTime <= 30, m.idleHoldTime)
+	}
+}
+
+func TestConnectRetryTime(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(5*time.Second, connectRetryTime(5, 0))
+	for i := 0; i < 10; i++ {
+		d := connectRetryTime(120, 0.25)
+		assert.True(d > 90*time.Second && d <= 120*time.Second, d)
+	}
+}
+
+func TestConnectLoopUnnumbered(t *testing.T) {
+	assert := assert.New(t)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(err)
+	defer l.Close()
+	port := l.Addr().(*net.TCPAddr).Port
+
+	// the neighbor isn't discovered at the first attempt and is found
+	// at the next one
+	probeErr := errors.New("socket: operation not permitted")
+	var mu sync.Mutex
+	var ifnames []string
+	linkLocalNeighborAddress = func(ifname string) (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		ifnames = append(ifnames, ifname)
+		if len(ifnames) == 1 {
+			return "", probeErr
+		}
+		return "127.0.0.1", nil
+	}
+	defer func() { linkLocalNeighborAddress = oc.GetIPv6LinkLocalNeighborAddress }()
+
+	n := &oc.Neighbor{
+		Config: oc.NeighborConfig{NeighborInterface: "eth0"},
+		State:  oc.NeighborState{NeighborAddress: "fe80::1%eth0"},
+		Timers: oc.Timers{Config: oc.TimersConfig{ConnectRetry: minConnectRetryInterval}},
+		Transport: oc.Transport{Config: oc.TransportConfig{
+			RemotePort: uint16(port),
+		}},
+	}
+	h := &fsmHandler{fsm: newFSM(&oc.Global{}, n, log.NewDefaultLogger())}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	connCh := make(chan net.Conn, 1)
+	go h.connectLoop(ctx, &wg, connCh)
+
+	select {
+	case conn := <-connCh:
+		assert.Equal(l.Addr().String(), conn.RemoteAddr().String())
+		conn.Close()
+	case <-time.After(30 * time.Second):
+		t.Fatal("no connection to the discovered neighbor")
+	}
+	wg.Wait()
+	mu.Lock()
+	assert.Equal([]string{"eth0", "eth0"}, ifnames)
+	mu.Unlock()
+}
+
+func TestCollisionLocalWins(t *testing.T) {
+	assert := assert.New(t)
+	id := func(s string) net.IP { return net.ParseIP(s) }
+	assert.True(collisionLocalWins(id("10.0.0.2"), id("10.0.0.1"), 65001, 65002))
+	assert.False(collisionLocalWins(id("10.0.0.1"), id("10.0.0.2"), 65002, 65001))
+	// compared as the unsigned integers
+	assert.True(collisionLocalWins(id("192.168.0.1"), id("10.0.0.1"), 65001, 65001))
+	// RFC 6286, the AS numbers for the same identifiers
+	assert.True(collisionLocalWins(id("10.0.0.1"), id("10.0.0.1"), 65002, 65001))
+	assert.False(collisionLocalWins(id("10.0.0.1"), id("10.0.0.1"), 65001, 65002))
+}
+
+// readCease returns the message the other end of a net.Pipe received
+// until it was closed.
+func readCease(t *testing.T, conn net.Conn) chan *bgp.BGPMessage {
+	ch := make(chan *bgp.BGPMessage, 1)
+	go func() {
+		b, _ := io.ReadAll(conn)
+		m, err := bgp.ParseBGPMessage(b)
+		assert.NoError(t, err)
+		ch <- m
+	}()
+	return ch
+}
+
+func TestResolveCollision(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, c := range []struct {
+		routerID  string
+		local     bool
+		collision bool
+	}{
+		// the neighbor 10.0.0.2 with the higher identifier initiated the
+		// colliding connection
+		{"10.0.0.1", true, true},
+		{"10.0.0.1", false, false},
+		{"10.0.0.3", true, false},
+		{"10.0.0.3", false, true},
+	} {
+		p, _ := makePeerAndHandler()
+		fsm := p.fsm
+		fsm.gConf.Config.RouterId = c.routerID
+		fsm.peerInfo.ID = net.ParseIP("10.0.0.2").To4()
+		conn, connPeer := net.Pipe()
+		collision, collisionPeer := net.Pipe()
+		fsm.conn, fsm.connLocal = conn, c.local
+		fsm.collisionConn = collision
+
+		closed := connPeer
+		kept := collision
+		if !c.collision {
+			closed, kept = collisionPeer, conn
+		}
+		ch := readCease(t, closed)
+		assert.Equal(c.collision, fsm.resolveCollision())
+		m := <-ch
+		assert.Equal(uint8(bgp.BGP_MSG_NOTIFICATION), m.Header.Type)
+		body := m.Body.(*bgp.BGPNotification)
+		assert.Equal(uint8(bgp.BGP_ERROR_CEASE), body.ErrorCode)
+		assert.Equal(uint8(bgp.BGP_ERROR_SUB_CONNECTION_COLLISION_RESOLUTION), body.ErrorSubcode)
+		assert.Nil(fsm.collisionConn)
+		assert.Equal(kept, fsm.conn)
+		assert.Equal(c.local != c.collision, fsm.connLocal)
+		assert.Equal(c.collision, fsm.connOpenSent)
+		// nothing to resolve
+		assert.False(fsm.resolveCollision())
+	}
+}
+
+func TestTakeOverCollision(t *testing.T) {
+	assert := assert.New(t)
+
+	p, _ := makePeerAndHandler()
+	fsm := p.fsm
+	conn, _ := net.Pipe()
+	collision, _ := net.Pipe()
+	fsm.conn, fsm.connLocal = conn, true
+	fsm.collisionConn = collision
+
+	// kept while the session is being opened
+	assert.False(fsm.takeOverCollision(bgp.BGP_FSM_OPENSENT, bgp.BGP_FSM_OPENCONFIRM, newfsmStateReason(fsmOpenMsgReceived, nil, nil)))
+	assert.Equal(collision, fsm.collisionConn)
+
+	// the session on the current connection failed
+	assert.True(fsm.takeOverCollision(bgp.BGP_FSM_OPENCONFIRM, bgp.BGP_FSM_IDLE, newfsmStateReason(fsmNotificationRecv, nil, nil)))
+	assert.Equal(collision, fsm.conn)
+	assert.False(fsm.connLocal)
+	assert.True(fsm.connOpenSent)
+	assert.Nil(fsm.collisionConn)
+
+	// closed on the admin down
+	collision, collisionPeer := net.Pipe()
+	fsm.collisionConn = collision
+	ch := make(chan error, 1)
+	go func() {
+		_, err := collisionPeer.Read(make([]byte, 1))
+		ch <- err
+	}()
+	assert.False(fsm.takeOverCollision(bgp.BGP_FSM_OPENSENT, bgp.BGP_FSM_IDLE, newfsmStateReason(fsmAdminDown, nil, nil)))
+	assert.Equal(io.EOF, <-ch)
+	assert.Nil(fsm.collisionConn)
+}
+
 func makePeerAndHandler() (*peer, *fsmHandler) {
 	p := &peer{
 		fsm: newFSM(&oc.Global{}, &oc.Neighbor{}, log.NewDefaultLogger()),
@@ -384,6 +650,34 @@ func makePeerAndHandler() (*peer, *fsmHandler) {
 
 }
 
+func TestDedupOutgoingPaths(t *testing.T) {
+	assert := assert.New(t)
+
+	newPath := func(prefix string, id uint32, withdraw bool) *table.Path {
+		nlri := bgp.NewIPAddrPrefix(24, prefix)
+		nlri.SetPathLocalIdentifier(id)
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop("10.0.0.1"),
+		}
+		return table.NewPath(&table.PeerInfo{}, nlri, withdraw, attrs, time.Now(), false)
+	}
+
+	p1 := newPath("10.1.0.0", 1, false)
+	p2 := newPath("10.2.0.0", 1, false)
+	p3 := newPath("10.1.0.0", 1, true)
+	p4 := newPath("10.1.0.0", 2, false)
+	eor := table.NewEOR(bgp.RF_IPv4_UC)
+	paths := []*table.Path{p1, eor, p2, p3, p4, eor}
+
+	// the last path of each prefix wins and keeps its position
+	assert.Equal([]*table.Path{eor, p2, p4, eor}, dedupOutgoingPaths(paths, &bgp.MarshallingOption{}))
+
+	// with add-path, paths with different identifiers are distinct
+	options := &bgp.MarshallingOption{AddPath: map[bgp.RouteFamily]bgp.BGPAddPathMode{bgp.RF_IPv4_UC: bgp.BGP_ADD_PATH_SEND}}
+	assert.Equal([]*table.Path{eor, p2, p3, p4, eor}, dedupOutgoingPaths(paths, options))
+}
+
 func open() *bgp.BGPMessage {
 	p1 := bgp.NewOptionParameterCapability(
 		[]bgp.ParameterCapabilityInterface{bgp.NewCapRouteRefresh()})
@@ -412,3 +706,30 @@ func openWithBadBGPIdentifier_Same() *bgp.BGPMessage {
 func keepalive() *bgp.BGPMessage {
 	return bgp.NewBGPKeepAliveMessage()
 }
+
+func TestPeerConnTTL(t *testing.T) {
+	assert := assert.New(t)
+
+	ebgp := oc.NeighborConfig{PeerAs: 65001, PeerType: oc.PEER_TYPE_EXTERNAL}
+	tests := []struct {
+		pConf  *oc.Neighbor
+		ttl    uint8
+		ttlMin uint8
+	}{
+		{&oc.Neighbor{Config: ebgp}, 1, 0},
+		{&oc.Neighbor{Config: ebgp, EbgpMultihop: oc.EbgpMultihop{Config: oc.EbgpMultihopConfig{Enabled: true, MultihopTtl: 3}}}, 3, 0},
+		{&oc.Neighbor{Config: ebgp, Transport: oc.Transport{Config: oc.TransportConfig{Ttl: 10}}}, 10, 0},
+		{&oc.Neighbor{Config: oc.NeighborConfig{PeerAs: 65000, PeerType: oc.PEER_TYPE_INTERNAL}}, 0, 0},
+		// GTSM sends with TTL 255 regardless of ebgp-multihop
+		{&oc.Neighbor{
+			Config:       ebgp,
+			EbgpMultihop: oc.EbgpMultihop{Config: oc.EbgpMultihopConfig{Enabled: true, MultihopTtl: 3}},
+			TtlSecurity:  oc.TtlSecurity{Config: oc.TtlSecurityConfig{Enabled: true, TtlMin: 253}},
+		}, 255, 253},
+	}
+	for _, test := range tests {
+		ttl, ttlMin := peerConnTTL(test.pConf)
+		assert.Equal(test.ttl, ttl)
+		assert.Equal(test.ttlMin, ttlMin)
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/x509"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	grpcpeer "google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	api "github.com/osrg/gobgp/v3/api"
+)
+
+// GrpcAccessLevel is the set of the gRPC APIs which the client is permitted
+// to call.
+type GrpcAccessLevel int
+
+const (
+	GrpcAccessNone GrpcAccessLevel = iota
+	// GrpcAccessReadOnly permits the List, Get and Watch APIs and the health
+	// checking.
+	GrpcAccessReadOnly
+	// GrpcAccessReadWrite permits all the APIs.
+	GrpcAccessReadWrite
+)
+
+func (l GrpcAccessLevel) String() string {
+	switch l {
+	case GrpcAccessReadOnly:
+		return "read-only"
+	case GrpcAccessReadWrite:
+		return "read-write"
+	}
+	return "none"
+}
+
+// GrpcAnyClient matches any client whose certificate is verified.
+const GrpcAnyClient = "*"
+
+// GrpcAuthorizer authorizes the gRPC API calls by the identity of the
+// verified client certificate, that is, the subject common name or any of
+// the DNS, URI and email subject alternative names. It must be used with
+// the TLS credentials verifying the client certificates.
+type GrpcAuthorizer struct {
+	clients map[string]GrpcAccessLevel
+}
+
+// NewGrpcAuthorizer returns the authorizer permitting the client identities
+// to the access levels. GrpcAnyClient can be used as the identity.
+func NewGrpcAuthorizer(clients map[string]GrpcAccessLevel) *GrpcAuthorizer {
+	a := &GrpcAuthorizer{
+		clients: make(map[string]GrpcAccessLevel, len(clients)),
+	}
+	for id, level := range clients {
+		a.clients[id] = level
+	}
+	return a
+}
+
+// grpcMethodAccessLevel returns the access level required to call the full
+// gRPC method name like "/apipb.GobgpApi/ListPeer".
+func grpcMethodAccessLevel(fullMethod string) GrpcAccessLevel {
+	if translated, ok := api.TranslateDeprecatedMethod(fullMethod); ok {
+		fullMethod = translated
+	}
+	if strings.HasPrefix(fullMethod, "/"+healthpb.Health_ServiceDesc.ServiceName+"/") {
+		return GrpcAccessReadOnly
+	}
+	if !strings.HasPrefix(fullMethod, "/"+api.GobgpApi_ServiceDesc.ServiceName+"/") {
+		return GrpcAccessReadWrite
+	}
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range []string{"List", "Get", "Watch"} {
+		if strings.HasPrefix(name, prefix) {
+			return GrpcAccessReadOnly
+		}
+	}
+	return GrpcAccessReadWrite
+}
+
+func grpcCertificateIdentities(cert *x509.Certificate) []string {
+	ids := make([]string, 0, 1+len(cert.DNSNames)+len(cert.URIs)+len(cert.EmailAddresses))
+	if cert.Subject.CommonName != "" {
+		ids = append(ids, cert.Subject.CommonName)
+	}
+	ids = append(ids, cert.DNSNames...)
+	for _, u := range cert.URIs {
+		ids = append(ids, u.String())
+	}
+	return append(ids, cert.EmailAddresses...)
+}
+
+// grpcClientIdentity returns the first identity of the verified client
+// certificate, or the address of the client without it.
+func grpcClientIdentity(ctx context.Context) string {
+	p, ok := grpcpeer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	if info, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(info.State.VerifiedChains) > 0 && len(info.State.VerifiedChains[0]) > 0 {
+		if ids := grpcCertificateIdentities(info.State.VerifiedChains[0][0]); len(ids) > 0 {
+			return ids[0]
+		}
+	}
+	if p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
+
+func (a *GrpcAuthorizer) accessLevel(ctx context.Context) (GrpcAccessLevel, error) {
+	p, ok := grpcpeer.FromContext(ctx)
+	if !ok {
+		return GrpcAccessNone, status.Error(codes.Unauthenticated, "no peer information")
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return GrpcAccessNone, status.Error(codes.Unauthenticated, "no verified client certificate")
+	}
+	level := a.clients[GrpcAnyClient]
+	for _, id := range grpcCertificateIdentities(info.State.VerifiedChains[0][0]) {
+		if l := a.clients[id]; l > level {
+			level = l
+		}
+	}
+	return level, nil
+}
+
+func (a *GrpcAuthorizer) authorize(ctx context.Context, fullMethod string) error {
+	level, err := a.accessLevel(ctx)
+	if err != nil {
+		return err
+	}
+	if required := grpcMethodAccessLevel(fullMethod); level < required {
+		return status.Errorf(codes.PermissionDenied, "%s requires %s access", fullMethod, required)
+	}
+	return nil
+}
+
+// UnaryServerInterceptor returns the interceptor rejecting the unauthorized
+// unary calls.
+func (a *GrpcAuthorizer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := a.authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns the interceptor rejecting the unauthorized
+// stream calls.
+func (a *GrpcAuthorizer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := a.authorize(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	api "github.com/osrg/gobgp/v3/api"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pool *x509.CertPool
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "gobgp test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return &testCA{cert: cert, key: key, pool: pool}
+}
+
+func (ca *testCA) issue(t *testing.T, template *x509.Certificate) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	template.KeyUsage = x509.KeyUsageDigitalSignature
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func Test_grpcMethodAccessLevel(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, method := range []string{"ListPeer", "GetBgp", "WatchEvent", "ListRpkiTable"} {
+		assert.Equal(GrpcAccessReadOnly, grpcMethodAccessLevel("/apipb.GobgpApi/"+method), method)
+	}
+	for _, method := range []string{"AddPeer", "StartBgp", "SetPolicies", "ResetPeer", "EnableMrt"} {
+		assert.Equal(GrpcAccessReadWrite, grpcMethodAccessLevel("/apipb.GobgpApi/"+method), method)
+	}
+	// the deprecated services are the same as the current one
+	assert.Equal(GrpcAccessReadOnly, grpcMethodAccessLevel("/gobgpapi.GobgpApi/ListPeer"))
+	assert.Equal(GrpcAccessReadWrite, grpcMethodAccessLevel("/gobgpapi.GobgpApi/AddPeer"))
+	assert.Equal(GrpcAccessReadWrite, grpcMethodAccessLevel("/other.Service/ListThings"))
+	assert.Equal(GrpcAccessReadOnly, grpcMethodAccessLevel("/grpc.health.v1.Health/Check"))
+}
+
+func TestGrpcAuthorizer(t *testing.T) {
+	assert := assert.New(t)
+
+	ca := newTestCA(t)
+	serverCert := ca.issue(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "gobgp.example"},
+		DNSNames:    []string{"gobgp.example"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	clientCert := func(cn string, dnsNames ...string) tls.Certificate {
+		return ca.issue(t, &x509.Certificate{
+			Subject:     pkix.Name{CommonName: cn},
+			DNSNames:    dnsNames,
+			ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		})
+	}
+
+	authorizer := NewGrpcAuthorizer(map[string]GrpcAccessLevel{
+		"monitor":             GrpcAccessReadOnly,
+		"admin.gobgp.example": GrpcAccessReadWrite,
+	})
+	sock := filepath.Join(t.TempDir(), "gobgp.sock")
+	s := NewBgpServer(GrpcListenAddress("unix://"+sock), GrpcOption([]grpc.ServerOption{
+		grpc.Creds(credentials.NewTLS(&tls.Config{
+			Certificates: []tls.Certificate{serverCert},
+			ClientCAs:    ca.pool,
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+		})),
+		grpc.ChainUnaryInterceptor(authorizer.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(authorizer.StreamServerInterceptor()),
+	}))
+	go s.Serve()
+	defer s.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	newClient := func(cert tls.Certificate) api.GobgpApiClient {
+		conn, err := grpc.DialContext(ctx, "unix://"+sock, grpc.WithBlock(), grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+			Certificates: []tls.Certificate{cert},
+			RootCAs:      ca.pool,
+			ServerName:   "gobgp.example",
+		})))
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { conn.Close() })
+		return api.NewGobgpApiClient(conn)
+	}
+	startBgp := &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        1,
+			RouterId:   "1.1.1.1",
+			ListenPort: -1,
+		},
+	}
+
+	// the read-only client
+	client := newClient(clientCert("monitor"))
+	_, err := client.StartBgp(ctx, startBgp)
+	assert.Equal(codes.PermissionDenied, status.Code(err))
+
+	// the read-write client identified by the subject alternative name
+	admin := newClient(clientCert("admin", "admin.gobgp.example"))
+	_, err = admin.StartBgp(ctx, startBgp)
+	assert.Nil(err)
+
+	_, err = client.GetBgp(ctx, &api.GetBgpRequest{})
+	assert.Nil(err)
+	stream, err := client.ListPeer(ctx, &api.ListPeerRequest{})
+	assert.Nil(err)
+	_, err = stream.Recv()
+	assert.NotEqual(codes.PermissionDenied, status.Code(err))
+	_, err = client.AddPeer(ctx, &api.AddPeerRequest{})
+	assert.Equal(codes.PermissionDenied, status.Code(err))
+	addPathStream, err := client.AddPathStream(ctx)
+	assert.Nil(err)
+	_, err = addPathStream.CloseAndRecv()
+	assert.Equal(codes.PermissionDenied, status.Code(err))
+
+	// the unknown client
+	_, err = newClient(clientCert("stranger")).GetBgp(ctx, &api.GetBgpRequest{})
+	assert.Equal(codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,171 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	apb "google.golang.org/protobuf/types/known/anypb"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/log"
+)
+
+func (s *server) GetApiVersion(ctx context.Context, r *api.GetApiVersionRequest) (*api.GetApiVersionResponse, error) {
+	return &api.GetApiVersionResponse{
+		Major:              api.VersionMajor,
+		Minor:              api.VersionMinor,
+		DeprecatedServices: api.DeprecatedServiceNames,
+	}, nil
+}
+
+// warnDeprecated logs the call to the method of a deprecated service once
+// per method.
+func (s *server) warnDeprecated(fullMethod, translated string) {
+	if _, loaded := s.deprecatedCalls.LoadOrStore(fullMethod, struct{}{}); loaded {
+		return
+	}
+	s.bgpServer.logger.Warn("deprecated API service called, use the current one instead",
+		log.Fields{
+			"Topic":       "grpc",
+			"Key":         fullMethod,
+			"Replacement": translated})
+}
+
+// translateAnys replaces the type URLs of the Any messages in m, including
+// the ones in the values of the Any messages, with translate.
+func translateAnys(m protoreflect.Message, translate func(string) string) error {
+	if a, ok := m.Interface().(*apb.Any); ok {
+		return translateAny(a, translate)
+	}
+	var err error
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList():
+			if fd.Message() == nil {
+				break
+			}
+			l := v.List()
+			for i := 0; i < l.Len() && err == nil; i++ {
+				err = translateAnys(l.Get(i).Message(), translate)
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() == nil {
+				break
+			}
+			v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				err = translateAnys(v.Message(), translate)
+				return err == nil
+			})
+		case fd.Message() != nil:
+			err = translateAnys(v.Message(), translate)
+		}
+		return err == nil
+	})
+	return err
+}
+
+func translateAny(a *apb.Any, translate func(string) string) error {
+	typeURL := translate(a.TypeUrl)
+	// the value is decoded with the message type of the current package
+	// to translate the Any messages in it.
+	mt, err := protoregistry.GlobalTypes.FindMessageByURL(typeURL)
+	if err != nil {
+		mt, err = protoregistry.GlobalTypes.FindMessageByURL(a.TypeUrl)
+	}
+	if err == nil {
+		m := mt.New()
+		if err := proto.Unmarshal(a.Value, m.Interface()); err != nil {
+			return status.Errorf(codes.InvalidArgument, "failed to decode %s: %v", a.TypeUrl, err)
+		}
+		if err := translateAnys(m, translate); err != nil {
+			return err
+		}
+		if a.Value, err = proto.Marshal(m.Interface()); err != nil {
+			return status.Errorf(codes.Internal, "failed to encode %s: %v", typeURL, err)
+		}
+	}
+	a.TypeUrl = typeURL
+	return nil
+}
+
+// deprecatedServerStream translates the Any messages received from and
+// sent to the client of a deprecated service.
+type deprecatedServerStream struct {
+	grpc.ServerStream
+	service string
+}
+
+func (s *deprecatedServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return translateAnys(m.(proto.Message).ProtoReflect(), api.TranslateDeprecatedTypeURL)
+}
+
+func (s *deprecatedServerStream) SendMsg(m interface{}) error {
+	// the response may share the messages with the server
+	msg := proto.Clone(m.(proto.Message))
+	if err := translateAnys(msg.ProtoReflect(), func(typeURL string) string {
+		return api.DeprecatedTypeURL(typeURL, s.service)
+	}); err != nil {
+		return err
+	}
+	return s.ServerStream.SendMsg(msg)
+}
+
+// deprecatedServiceHandler serves the calls to the deprecated services with
+// the handlers of GobgpApi. It's called for all the unknown services after
+// the stream interceptors, so the instance and the authorization are
+// applied in the same way. The type URLs of the Any messages are translated
+// between the package of the deprecated service and the current one.
+func (s *server) deprecatedServiceHandler(_ interface{}, stream grpc.ServerStream) error {
+	fullMethod, ok := grpc.MethodFromServerStream(stream)
+	if !ok {
+		return status.Error(codes.Internal, "no method in the stream")
+	}
+	translated, ok := api.TranslateDeprecatedMethod(fullMethod)
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "unknown method %s", fullMethod)
+	}
+	s.warnDeprecated(fullMethod, translated)
+	service := strings.TrimPrefix(fullMethod, "/")
+	service = service[:strings.Index(service, "/")]
+	ds := &deprecatedServerStream{ServerStream: stream, service: service}
+	name := translated[strings.LastIndex(translated, "/")+1:]
+	for _, m := range api.GobgpApi_ServiceDesc.Methods {
+		if m.MethodName == name {
+			rsp, err := m.Handler(s, stream.Context(), ds.RecvMsg, nil)
+			if err != nil {
+				return err
+			}
+			return ds.SendMsg(rsp)
+		}
+	}
+	for _, d := range api.GobgpApi_ServiceDesc.Streams {
+		if d.StreamName == name {
+			return d.Handler(s, ds)
+		}
+	}
+	return status.Errorf(codes.Unimplemented, "method %s was removed", fullMethod)
+}
@@ -0,0 +1,240 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	api "github.com/osrg/gobgp/v3/api"
+)
+
+func TestTranslateDeprecatedMethod(t *testing.T) {
+	assert := assert.New(t)
+
+	m, ok := api.TranslateDeprecatedMethod("/gobgpapi.GobgpApi/ListPeer")
+	assert.True(ok)
+	assert.Equal("/apipb.GobgpApi/ListPeer", m)
+
+	for _, m := range []string{"/apipb.GobgpApi/ListPeer", "/other.Service/ListPeer", "gobgpapi.GobgpApi"} {
+		_, ok = api.TranslateDeprecatedMethod(m)
+		assert.False(ok, m)
+	}
+}
+
+func TestTranslateDeprecatedTypeURL(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("type.googleapis.com/apipb.OriginAttribute", api.TranslateDeprecatedTypeURL("type.googleapis.com/gobgpapi.OriginAttribute"))
+	assert.Equal("type.googleapis.com/apipb.OriginAttribute", api.TranslateDeprecatedTypeURL("type.googleapis.com/apipb.OriginAttribute"))
+	assert.Equal("type.googleapis.com/other.OriginAttribute", api.TranslateDeprecatedTypeURL("type.googleapis.com/other.OriginAttribute"))
+	assert.Equal("type.googleapis.com/gobgpapi.OriginAttribute", api.DeprecatedTypeURL("type.googleapis.com/apipb.OriginAttribute", "gobgpapi.GobgpApi"))
+	assert.Equal("type.googleapis.com/google.protobuf.Empty", api.DeprecatedTypeURL("type.googleapis.com/google.protobuf.Empty", "gobgpapi.GobgpApi"))
+}
+
+func TestGrpcDeprecatedService(t *testing.T) {
+	assert := assert.New(t)
+
+	sock := filepath.Join(t.TempDir(), "gobgp.sock")
+	s := NewBgpServer(GrpcListenAddress("unix://" + sock))
+	go s.Serve()
+	defer s.Stop()
+	assert.Nil(s.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        1,
+			RouterId:   "1.1.1.1",
+			ListenPort: -1,
+		},
+	}))
+	assert.Nil(s.AddPeer(context.Background(), &api.AddPeerRequest{
+		Peer: &api.Peer{
+			Conf: &api.PeerConf{NeighborAddress: "10.0.0.2", PeerAsn: 2},
+		},
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, "unix://"+sock, grpc.WithBlock(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(err)
+	defer conn.Close()
+
+	v, err := api.NewGobgpApiClient(conn).GetApiVersion(ctx, &api.GetApiVersionRequest{})
+	assert.Nil(err)
+	assert.Equal(uint32(api.VersionMajor), v.Major)
+	assert.Equal(api.DeprecatedServiceNames, v.DeprecatedServices)
+
+	// unary
+	r := &api.GetBgpResponse{}
+	assert.Nil(conn.Invoke(ctx, "/gobgpapi.GobgpApi/GetBgp", &api.GetBgpRequest{}, r))
+	assert.Equal(uint32(1), r.Global.Asn)
+
+	// server streaming
+	stream, err := conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, "/gobgpapi.GobgpApi/ListPeer")
+	assert.Nil(err)
+	assert.Nil(stream.SendMsg(&api.ListPeerRequest{}))
+	assert.Nil(stream.CloseSend())
+	peers := make([]string, 0)
+	for {
+		rsp := &api.ListPeerResponse{}
+		if err := stream.RecvMsg(rsp); err == io.EOF {
+			break
+		} else if !assert.Nil(err) {
+			break
+		}
+		peers = append(peers, rsp.Peer.Conf.NeighborAddress)
+	}
+	assert.Equal([]string{"10.0.0.2"}, peers)
+
+	err = conn.Invoke(ctx, "/gobgpapi.GobgpApi/NoSuchMethod", &api.GetBgpRequest{}, r)
+	assert.Equal(codes.Unimplemented, status.Code(err))
+	err = conn.Invoke(ctx, "/other.Service/GetBgp", &api.GetBgpRequest{}, r)
+	assert.Equal(codes.Unimplemented, status.Code(err))
+}
+
+// rawCodec sends and receives the messages encoded by the test as they are,
+// to make the requests of the clients generated from the proto files of
+// GoBGP 2.x.
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) { return *(v.(*[]byte)), nil }
+
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	*(v.(*[]byte)) = append([]byte(nil), data...)
+	return nil
+}
+
+func (rawCodec) Name() string { return "proto" }
+
+func TestGrpcDeprecatedServiceAny(t *testing.T) {
+	assert := assert.New(t)
+
+	sock := filepath.Join(t.TempDir(), "gobgp.sock")
+	s := NewBgpServer(GrpcListenAddress("unix://" + sock))
+	go s.Serve()
+	defer s.Stop()
+	assert.Nil(s.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        1,
+			RouterId:   "1.1.1.1",
+			ListenPort: -1,
+		},
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, "unix://"+sock, grpc.WithBlock(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(err)
+	defer conn.Close()
+
+	// the messages of gobgpapi, the package of GoBGP 2.x
+	message := func(fields ...func([]byte) []byte) []byte {
+		b := []byte{}
+		for _, f := range fields {
+			b = f(b)
+		}
+		return b
+	}
+	bytesField := func(num protowire.Number, v []byte) func([]byte) []byte {
+		return func(b []byte) []byte {
+			return protowire.AppendBytes(protowire.AppendTag(b, num, protowire.BytesType), v)
+		}
+	}
+	varintField := func(num protowire.Number, v uint64) func([]byte) []byte {
+		return func(b []byte) []byte {
+			return protowire.AppendVarint(protowire.AppendTag(b, num, protowire.VarintType), v)
+		}
+	}
+	anyField := func(num protowire.Number, name string, v []byte) func([]byte) []byte {
+		return bytesField(num, message(
+			bytesField(1, []byte("type.googleapis.com/gobgpapi."+name)),
+			bytesField(2, v),
+		))
+	}
+	family := message(varintField(1, 2), varintField(2, 1)) // AFI_IP6, SAFI_UNICAST
+	prefix := message(varintField(1, 64), bytesField(2, []byte("2001:db8::")))
+	path := message(
+		anyField(1, "IPAddressPrefix", prefix),
+		anyField(2, "OriginAttribute", nil),
+		anyField(2, "MpReachNLRIAttribute", message(
+			bytesField(1, family),
+			bytesField(2, []byte("2001:db8::1")),
+			anyField(3, "IPAddressPrefix", prefix),
+		)),
+		bytesField(9, family),
+	)
+
+	req := message(bytesField(3, path))
+	rsp := []byte{}
+	assert.Nil(conn.Invoke(ctx, "/gobgpapi.GobgpApi/AddPath", &req, &rsp, grpc.ForceCodec(rawCodec{})))
+
+	// the path is added as the one of the current package
+	n := 0
+	assert.Nil(s.ListPath(ctx, &api.ListPathRequest{
+		Family: &api.Family{Afi: api.Family_AFI_IP6, Safi: api.Family_SAFI_UNICAST},
+	}, func(d *api.Destination) {
+		n++
+		assert.Equal("2001:db8::/64", d.Prefix)
+		assert.Equal("type.googleapis.com/apipb.IPAddressPrefix", d.Paths[0].Nlri.TypeUrl)
+	}))
+	assert.Equal(1, n)
+
+	// and listed as the one of gobgpapi
+	stream, err := conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, "/gobgpapi.GobgpApi/ListPath", grpc.ForceCodec(rawCodec{}))
+	assert.Nil(err)
+	req = message(bytesField(3, family))
+	assert.Nil(stream.SendMsg(&req))
+	assert.Nil(stream.CloseSend())
+	typeURLs := make([]string, 0)
+	for {
+		raw := []byte{}
+		if err := stream.RecvMsg(&raw); err == io.EOF {
+			break
+		} else if !assert.Nil(err) {
+			break
+		}
+		rsp := &api.ListPathResponse{}
+		assert.Nil(proto.Unmarshal(raw, rsp))
+		p := rsp.Destination.Paths[0]
+		typeURLs = append(typeURLs, p.Nlri.TypeUrl)
+		for _, a := range p.Pattrs {
+			typeURLs = append(typeURLs, a.TypeUrl)
+			if a.TypeUrl == "type.googleapis.com/gobgpapi.MpReachNLRIAttribute" {
+				m := &api.MpReachNLRIAttribute{}
+				assert.Nil(proto.Unmarshal(a.Value, m))
+				for _, nlri := range m.Nlris {
+					typeURLs = append(typeURLs, nlri.TypeUrl)
+				}
+			}
+		}
+	}
+	assert.ElementsMatch([]string{
+		"type.googleapis.com/gobgpapi.IPAddressPrefix",
+		"type.googleapis.com/gobgpapi.OriginAttribute",
+		"type.googleapis.com/gobgpapi.MpReachNLRIAttribute",
+		"type.googleapis.com/gobgpapi.IPAddressPrefix",
+	}, typeURLs)
+}
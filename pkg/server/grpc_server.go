@@ -31,6 +31,8 @@ import (
 
 	"github.com/dgryski/go-farm"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 	apb "google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	tspb "google.golang.org/protobuf/types/known/timestamppb"
@@ -46,24 +48,126 @@ import (
 // Unlimited batch size by default
 const defaultListPathBatchSize = math.MaxUint64
 
+// GrpcInstanceMetadataKey is the gRPC metadata key used to select the
+// BgpServer instance handling a request. Requests without it are handled
+// by the BgpServer owning the gRPC server.
+const GrpcInstanceMetadataKey = "gobgp-instance"
+
 type server struct {
 	bgpServer  *BgpServer
 	grpcServer *grpc.Server
 	hosts      string
+	mu         sync.RWMutex
+	instances  map[string]*BgpServer
+	// the methods of the deprecated services already called
+	deprecatedCalls sync.Map
 	api.UnimplementedGobgpApiServer
 }
 
-func newAPIserver(b *BgpServer, g *grpc.Server, hosts string) *server {
+func init() {
+	// set before any client or server of the process uses it
 	grpc.EnableTracing = false
+}
+
+func newAPIserver(b *BgpServer, opts []grpc.ServerOption, hosts string) *server {
 	s := &server{
-		bgpServer:  b,
-		grpcServer: g,
-		hosts:      hosts,
-	}
-	api.RegisterGobgpApiServer(g, s)
+		bgpServer: b,
+		hosts:     hosts,
+		instances: make(map[string]*BgpServer),
+	}
+	opts = append(append([]grpc.ServerOption{}, opts...),
+		grpc.ChainUnaryInterceptor(s.unaryInstanceInterceptor),
+		grpc.ChainStreamInterceptor(s.streamInstanceInterceptor),
+		grpc.UnknownServiceHandler(s.deprecatedServiceHandler))
+	s.grpcServer = grpc.NewServer(opts...)
+	api.RegisterGobgpApiServer(s.grpcServer, s)
+	healthpb.RegisterHealthServer(s.grpcServer, &healthServer{s: s})
 	return s
 }
 
+func (s *server) addInstance(name string, b *BgpServer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if name == "" {
+		return fmt.Errorf("empty instance name")
+	}
+	if b == s.bgpServer {
+		return fmt.Errorf("can't add the default instance as %s", name)
+	}
+	if _, ok := s.instances[name]; ok {
+		return fmt.Errorf("instance %s already exists", name)
+	}
+	s.instances[name] = b
+	return nil
+}
+
+func (s *server) deleteInstance(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.instances[name]; !ok {
+		return fmt.Errorf("instance %s not found", name)
+	}
+	delete(s.instances, name)
+	return nil
+}
+
+func (s *server) lookupInstance(ctx context.Context) (*BgpServer, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return s.bgpServer, nil
+	}
+	names := md.Get(GrpcInstanceMetadataKey)
+	if len(names) == 0 || names[0] == "" {
+		return s.bgpServer, nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	b, ok := s.instances[names[0]]
+	if !ok {
+		return nil, fmt.Errorf("instance %s not found", names[0])
+	}
+	return b, nil
+}
+
+type instanceContextKey struct{}
+
+// instance returns the BgpServer resolved for the request by the
+// interceptors.
+func (s *server) instance(ctx context.Context) *BgpServer {
+	if b, ok := ctx.Value(instanceContextKey{}).(*BgpServer); ok {
+		return b
+	}
+	return s.bgpServer
+}
+
+func (s *server) unaryInstanceInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	b, err := s.lookupInstance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(context.WithValue(ctx, instanceContextKey{}, b), req)
+}
+
+type instanceServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (ss *instanceServerStream) Context() context.Context {
+	return ss.ctx
+}
+
+func (s *server) streamInstanceInterceptor(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	b, err := s.lookupInstance(ss.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &instanceServerStream{
+		ServerStream: ss,
+		ctx:          context.WithValue(ss.Context(), instanceContextKey{}, b),
+	})
+}
+
 func (s *server) serve() error {
 	var wg sync.WaitGroup
 	l := []net.Listener{}
@@ -118,7 +222,7 @@ func (s *server) ListDynamicNeighbor(r *api.ListDynamicNeighborRequest, stream a
 			return
 		}
 	}
-	return s.bgpServer.ListDynamicNeighbor(ctx, r, fn)
+	return s.instance(stream.Context()).ListDynamicNeighbor(ctx, r, fn)
 }
 
 func (s *server) ListPeerGroup(r *api.ListPeerGroupRequest, stream api.GobgpApi_ListPeerGroupServer) error {
@@ -130,7 +234,7 @@ func (s *server) ListPeerGroup(r *api.ListPeerGroupRequest, stream api.GobgpApi_
 			return
 		}
 	}
-	return s.bgpServer.ListPeerGroup(ctx, r, fn)
+	return s.instance(stream.Context()).ListPeerGroup(ctx, r, fn)
 }
 
 func parseHost(host string) (string, string) {
@@ -141,6 +245,14 @@ func parseHost(host string) (string, string) {
 	return "tcp", host
 }
 
+func (s *server) GetPathHistory(ctx context.Context, r *api.GetPathHistoryRequest) (*api.GetPathHistoryResponse, error) {
+	return s.instance(ctx).GetPathHistory(ctx, r)
+}
+
+func (s *server) GetEffectivePeerConfig(ctx context.Context, r *api.GetEffectivePeerConfigRequest) (*api.GetEffectivePeerConfigResponse, error) {
+	return s.instance(ctx).GetEffectivePeerConfig(ctx, r)
+}
+
 func (s *server) ListPeer(r *api.ListPeerRequest, stream api.GobgpApi_ListPeerServer) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -150,7 +262,18 @@ func (s *server) ListPeer(r *api.ListPeerRequest, stream api.GobgpApi_ListPeerSe
 			return
 		}
 	}
-	return s.bgpServer.ListPeer(ctx, r, fn)
+	return s.instance(stream.Context()).ListPeer(ctx, r, fn)
+}
+
+func (s *server) ListPeerAggregated(ctx context.Context, r *api.ListPeerRequest) (*api.ListPeerAggregatedResponse, error) {
+	rsp := &api.ListPeerAggregatedResponse{Peers: make([]*api.Peer, 0)}
+	err := s.instance(ctx).ListPeer(ctx, r, func(p *api.Peer) {
+		rsp.Peers = append(rsp.Peers, p)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
 }
 
 func newValidationFromTableStruct(v *table.Validation) *api.Validation {
@@ -250,26 +373,25 @@ func (s *server) ListPath(r *api.ListPathRequest, stream api.GobgpApi_ListPathSe
 	if batchSize == 0 {
 		batchSize = defaultListPathBatchSize
 	}
-	l := make([]*api.Destination, 0)
+	l := make([]*api.ListPathResponse, 0)
 	send := func() error {
-		for _, d := range l {
-			if err := stream.Send(&api.ListPathResponse{Destination: d}); err != nil {
+		for _, rsp := range l {
+			if err := stream.Send(rsp); err != nil {
 				return err
 			}
 		}
 		return nil
 	}
 	var sendErr error
-	err := s.bgpServer.ListPath(ctx, r, func(d *api.Destination) {
-		if uint64(len(l)) < batchSize {
-			l = append(l, d)
-			return
-		}
-		if sendErr = send(); sendErr != nil {
-			cancel()
-			return
+	err := s.instance(stream.Context()).listPath(ctx, r, func(d *api.Destination, token string) {
+		if uint64(len(l)) >= batchSize {
+			if sendErr = send(); sendErr != nil {
+				cancel()
+				return
+			}
+			l = l[:0]
 		}
-		l = l[:0]
+		l = append(l, &api.ListPathResponse{Destination: d, NextPageToken: token})
 	})
 	if sendErr != nil {
 		return sendErr
@@ -280,36 +402,85 @@ func (s *server) ListPath(r *api.ListPathRequest, stream api.GobgpApi_ListPathSe
 	return send()
 }
 
+func (s *server) ListPathAggregated(ctx context.Context, r *api.ListPathRequest) (*api.ListPathAggregatedResponse, error) {
+	rsp := &api.ListPathAggregatedResponse{Destinations: make([]*api.Destination, 0)}
+	err := s.instance(ctx).listPath(ctx, r, func(d *api.Destination, token string) {
+		rsp.Destinations = append(rsp.Destinations, d)
+		if token != "" {
+			rsp.NextPageToken = token
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
 func (s *server) WatchEvent(r *api.WatchEventRequest, stream api.GobgpApi_WatchEventServer) error {
 	ctx, cancel := context.WithCancel(stream.Context())
-	s.bgpServer.WatchEvent(ctx, r, func(rsp *api.WatchEventResponse) {
+	defer cancel()
+	if err := s.instance(stream.Context()).WatchEvent(ctx, r, func(rsp *api.WatchEventResponse) {
 		if err := stream.Send(rsp); err != nil {
 			cancel()
 			return
 		}
-	})
+	}); err != nil {
+		return err
+	}
 	<-ctx.Done()
 	return nil
 }
 
 func (s *server) ResetPeer(ctx context.Context, r *api.ResetPeerRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.ResetPeer(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).ResetPeer(ctx, r)
 }
 
 func (s *server) ShutdownPeer(ctx context.Context, r *api.ShutdownPeerRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.ShutdownPeer(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).ShutdownPeer(ctx, r)
 }
 
 func (s *server) EnablePeer(ctx context.Context, r *api.EnablePeerRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.EnablePeer(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).EnablePeer(ctx, r)
 }
 
 func (s *server) DisablePeer(ctx context.Context, r *api.DisablePeerRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.DisablePeer(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).DisablePeer(ctx, r)
+}
+
+func (s *server) GracefulShutdownPeer(ctx context.Context, r *api.GracefulShutdownPeerRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).GracefulShutdownPeer(ctx, r)
 }
 
 func (s *server) SetPolicies(ctx context.Context, r *api.SetPoliciesRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.SetPolicies(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).SetPolicies(ctx, r)
+}
+
+func (s *server) ReplacePolicies(ctx context.Context, r *api.ReplacePoliciesRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).ReplacePolicies(ctx, r)
+}
+
+func (s *server) StageConfig(ctx context.Context, r *api.StageConfigRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).StageConfig(ctx, r)
+}
+
+func (s *server) DiscardConfig(ctx context.Context, r *api.DiscardConfigRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).DiscardConfig(ctx, r)
+}
+
+func (s *server) CommitConfig(ctx context.Context, r *api.CommitConfigRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).CommitConfig(ctx, r)
+}
+
+func (s *server) ConfirmConfig(ctx context.Context, r *api.ConfirmConfigRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).ConfirmConfig(ctx, r)
+}
+
+func (s *server) RollbackConfig(ctx context.Context, r *api.RollbackConfigRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).RollbackConfig(ctx, r)
+}
+
+func (s *server) GetConfigTransaction(ctx context.Context, r *api.GetConfigTransactionRequest) (*api.GetConfigTransactionResponse, error) {
+	return s.instance(ctx).GetConfigTransaction(ctx, r)
 }
 
 func newRoutingPolicyFromApiStruct(arg *api.SetPoliciesRequest) (*oc.RoutingPolicy, error) {
@@ -414,19 +585,23 @@ func api2Path(resource api.TableType, path *api.Path, isWithdraw bool) (*table.P
 }
 
 func (s *server) AddPath(ctx context.Context, r *api.AddPathRequest) (*api.AddPathResponse, error) {
-	return s.bgpServer.AddPath(ctx, r)
+	return s.instance(ctx).AddPath(ctx, r)
 }
 
 func (s *server) DeletePath(ctx context.Context, r *api.DeletePathRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.DeletePath(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).DeletePath(ctx, r)
+}
+
+func (s *server) ExtendPathLifetime(ctx context.Context, r *api.ExtendPathLifetimeRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).ExtendPathLifetime(ctx, r)
 }
 
 func (s *server) EnableMrt(ctx context.Context, r *api.EnableMrtRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.EnableMrt(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).EnableMrt(ctx, r)
 }
 
 func (s *server) DisableMrt(ctx context.Context, r *api.DisableMrtRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.DisableMrt(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).DisableMrt(ctx, r)
 }
 
 func (s *server) AddPathStream(stream api.GobgpApi_AddPathStreamServer) error {
@@ -449,7 +624,7 @@ func (s *server) AddPathStream(stream api.GobgpApi_AddPathStreamServer) error {
 				pathList = append(pathList, path)
 			}
 		}
-		err = s.bgpServer.addPathStream(arg.VrfId, pathList)
+		err = s.instance(stream.Context()).addPathStream(arg.VrfId, pathList)
 		if err != nil {
 			return err
 		}
@@ -458,11 +633,11 @@ func (s *server) AddPathStream(stream api.GobgpApi_AddPathStreamServer) error {
 }
 
 func (s *server) AddBmp(ctx context.Context, r *api.AddBmpRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.AddBmp(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).AddBmp(ctx, r)
 }
 
 func (s *server) DeleteBmp(ctx context.Context, r *api.DeleteBmpRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.DeleteBmp(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).DeleteBmp(ctx, r)
 }
 
 func (s *server) ListBmp(r *api.ListBmpRequest, stream api.GobgpApi_ListBmpServer) error {
@@ -473,27 +648,194 @@ func (s *server) ListBmp(r *api.ListBmpRequest, stream api.GobgpApi_ListBmpServe
 			cancel()
 		}
 	}
-	return s.bgpServer.ListBmp(ctx, r, fn)
+	return s.instance(stream.Context()).ListBmp(ctx, r, fn)
+}
+
+func (s *server) AddKafkaExporter(ctx context.Context, r *api.AddKafkaExporterRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).AddKafkaExporter(ctx, r)
+}
+
+func (s *server) DeleteKafkaExporter(ctx context.Context, r *api.DeleteKafkaExporterRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).DeleteKafkaExporter(ctx, r)
+}
+
+func (s *server) ListKafkaExporter(r *api.ListKafkaExporterRequest, stream api.GobgpApi_ListKafkaExporterServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	fn := func(rsp *api.ListKafkaExporterResponse) {
+		if err := stream.Send(rsp); err != nil {
+			cancel()
+		}
+	}
+	return s.instance(stream.Context()).ListKafkaExporter(ctx, r, fn)
+}
+
+func (s *server) EnablePeeringDb(ctx context.Context, r *api.EnablePeeringDbRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).EnablePeeringDb(ctx, r)
+}
+
+func (s *server) DisablePeeringDb(ctx context.Context, r *api.DisablePeeringDbRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).DisablePeeringDb(ctx, r)
+}
+
+func (s *server) ListPeeringDbRecord(r *api.ListPeeringDbRecordRequest, stream api.GobgpApi_ListPeeringDbRecordServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	fn := func(record *api.PeeringDbRecord) {
+		if err := stream.Send(&api.ListPeeringDbRecordResponse{Record: record}); err != nil {
+			cancel()
+		}
+	}
+	return s.instance(stream.Context()).ListPeeringDbRecord(ctx, r, fn)
+}
+
+func (s *server) AddIrrFilter(ctx context.Context, r *api.AddIrrFilterRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).AddIrrFilter(ctx, r)
+}
+
+func (s *server) DeleteIrrFilter(ctx context.Context, r *api.DeleteIrrFilterRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).DeleteIrrFilter(ctx, r)
+}
+
+func (s *server) ListIrrFilter(r *api.ListIrrFilterRequest, stream api.GobgpApi_ListIrrFilterServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	fn := func(rsp *api.ListIrrFilterResponse) {
+		if err := stream.Send(rsp); err != nil {
+			cancel()
+		}
+	}
+	return s.instance(stream.Context()).ListIrrFilter(ctx, r, fn)
+}
+
+func (s *server) AddAggregate(ctx context.Context, r *api.AddAggregateRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).AddAggregate(ctx, r)
+}
+
+func (s *server) DeleteAggregate(ctx context.Context, r *api.DeleteAggregateRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).DeleteAggregate(ctx, r)
+}
+
+func (s *server) ListAggregate(r *api.ListAggregateRequest, stream api.GobgpApi_ListAggregateServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	fn := func(rsp *api.ListAggregateResponse) {
+		if err := stream.Send(rsp); err != nil {
+			cancel()
+		}
+	}
+	return s.instance(stream.Context()).ListAggregate(ctx, r, fn)
+}
+
+func (s *server) AddVrfLeak(ctx context.Context, r *api.AddVrfLeakRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).AddVrfLeak(ctx, r)
+}
+
+func (s *server) DeleteVrfLeak(ctx context.Context, r *api.DeleteVrfLeakRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).DeleteVrfLeak(ctx, r)
+}
+
+func (s *server) ListVrfLeak(r *api.ListVrfLeakRequest, stream api.GobgpApi_ListVrfLeakServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	fn := func(rsp *api.ListVrfLeakResponse) {
+		if err := stream.Send(rsp); err != nil {
+			cancel()
+		}
+	}
+	return s.instance(stream.Context()).ListVrfLeak(ctx, r, fn)
+}
+
+func (s *server) AddEvpnVpws(ctx context.Context, r *api.AddEvpnVpwsRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).AddEvpnVpws(ctx, r)
+}
+
+func (s *server) AddRtbh(ctx context.Context, r *api.AddRtbhRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).AddRtbh(ctx, r)
+}
+
+func (s *server) DeleteRtbh(ctx context.Context, r *api.DeleteRtbhRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).DeleteRtbh(ctx, r)
+}
+
+func (s *server) ListRtbh(r *api.ListRtbhRequest, stream api.GobgpApi_ListRtbhServer) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fn := func(rtbh *api.Rtbh) {
+		if err := stream.Send(&api.ListRtbhResponse{Rtbh: rtbh}); err != nil {
+			cancel()
+		}
+	}
+	return s.instance(stream.Context()).ListRtbh(ctx, r, fn)
+}
+
+func (s *server) DeleteEvpnVpws(ctx context.Context, r *api.DeleteEvpnVpwsRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).DeleteEvpnVpws(ctx, r)
+}
+
+func (s *server) ListEvpnVpws(r *api.ListEvpnVpwsRequest, stream api.GobgpApi_ListEvpnVpwsServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	fn := func(rsp *api.ListEvpnVpwsResponse) {
+		if err := stream.Send(rsp); err != nil {
+			cancel()
+		}
+	}
+	return s.instance(stream.Context()).ListEvpnVpws(ctx, r, fn)
+}
+
+func (s *server) AddOrrVantagePoint(ctx context.Context, r *api.AddOrrVantagePointRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).AddOrrVantagePoint(ctx, r)
+}
+
+func (s *server) DeleteOrrVantagePoint(ctx context.Context, r *api.DeleteOrrVantagePointRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).DeleteOrrVantagePoint(ctx, r)
+}
+
+func (s *server) ListOrrVantagePoint(r *api.ListOrrVantagePointRequest, stream api.GobgpApi_ListOrrVantagePointServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	fn := func(rsp *api.ListOrrVantagePointResponse) {
+		if err := stream.Send(rsp); err != nil {
+			cancel()
+		}
+	}
+	return s.instance(stream.Context()).ListOrrVantagePoint(ctx, r, fn)
+}
+
+func (s *server) ListPrefixActivity(r *api.ListPrefixActivityRequest, stream api.GobgpApi_ListPrefixActivityServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	fn := func(rsp *api.ListPrefixActivityResponse) {
+		if err := stream.Send(rsp); err != nil {
+			cancel()
+		}
+	}
+	return s.instance(stream.Context()).ListPrefixActivity(ctx, r, fn)
 }
 
 func (s *server) AddRpki(ctx context.Context, r *api.AddRpkiRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.AddRpki(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).AddRpki(ctx, r)
 }
 
 func (s *server) DeleteRpki(ctx context.Context, r *api.DeleteRpkiRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.DeleteRpki(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).DeleteRpki(ctx, r)
 }
 
 func (s *server) EnableRpki(ctx context.Context, r *api.EnableRpkiRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.EnableRpki(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).EnableRpki(ctx, r)
 }
 
 func (s *server) DisableRpki(ctx context.Context, r *api.DisableRpkiRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.DisableRpki(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).DisableRpki(ctx, r)
 }
 
 func (s *server) ResetRpki(ctx context.Context, r *api.ResetRpkiRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.ResetRpki(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).ResetRpki(ctx, r)
+}
+
+func (s *server) SetRpkiSlurm(ctx context.Context, r *api.SetRpkiSlurmRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).SetRpkiSlurm(ctx, r)
 }
 
 func (s *server) ListRpki(r *api.ListRpkiRequest, stream api.GobgpApi_ListRpkiServer) error {
@@ -504,7 +846,7 @@ func (s *server) ListRpki(r *api.ListRpkiRequest, stream api.GobgpApi_ListRpkiSe
 			cancel()
 		}
 	}
-	return s.bgpServer.ListRpki(ctx, r, fn)
+	return s.instance(stream.Context()).ListRpki(ctx, r, fn)
 }
 
 func (s *server) ListRpkiTable(r *api.ListRpkiTableRequest, stream api.GobgpApi_ListRpkiTableServer) error {
@@ -515,11 +857,23 @@ func (s *server) ListRpkiTable(r *api.ListRpkiTableRequest, stream api.GobgpApi_
 			cancel()
 		}
 	}
-	return s.bgpServer.ListRpkiTable(ctx, r, fn)
+	return s.instance(stream.Context()).ListRpkiTable(ctx, r, fn)
 }
 
 func (s *server) EnableZebra(ctx context.Context, r *api.EnableZebraRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.EnableZebra(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).EnableZebra(ctx, r)
+}
+
+func (s *server) EnableNetlink(ctx context.Context, r *api.EnableNetlinkRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).EnableNetlink(ctx, r)
+}
+
+func (s *server) DisableNetlink(ctx context.Context, r *api.DisableNetlinkRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).DisableNetlink(ctx, r)
+}
+
+func (s *server) SetSrv6Locator(ctx context.Context, r *api.SetSrv6LocatorRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).SetSrv6Locator(ctx, r)
 }
 
 func (s *server) ListVrf(r *api.ListVrfRequest, stream api.GobgpApi_ListVrfServer) error {
@@ -530,15 +884,52 @@ func (s *server) ListVrf(r *api.ListVrfRequest, stream api.GobgpApi_ListVrfServe
 			cancel()
 		}
 	}
-	return s.bgpServer.ListVrf(ctx, r, fn)
+	return s.instance(stream.Context()).ListVrf(ctx, r, fn)
+}
+
+func (s *server) ListEvpnDesignatedForwarder(r *api.ListEvpnDesignatedForwarderRequest, stream api.GobgpApi_ListEvpnDesignatedForwarderServer) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fn := func(es *api.EvpnEthernetSegment) {
+		if err := stream.Send(&api.ListEvpnDesignatedForwarderResponse{EthernetSegment: es}); err != nil {
+			cancel()
+		}
+	}
+	return s.instance(stream.Context()).ListEvpnDesignatedForwarder(ctx, r, fn)
+}
+
+func (s *server) ListEvpnFrozenMac(r *api.ListEvpnFrozenMacRequest, stream api.GobgpApi_ListEvpnFrozenMacServer) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fn := func(m *api.EvpnFrozenMac) {
+		if err := stream.Send(&api.ListEvpnFrozenMacResponse{Mac: m}); err != nil {
+			cancel()
+		}
+	}
+	return s.instance(stream.Context()).ListEvpnFrozenMac(ctx, r, fn)
+}
+
+func (s *server) ClearEvpnFrozenMac(ctx context.Context, r *api.ClearEvpnFrozenMacRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).ClearEvpnFrozenMac(ctx, r)
+}
+
+func (s *server) ListColorBinding(r *api.ListColorBindingRequest, stream api.GobgpApi_ListColorBindingServer) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fn := func(b *api.ColorBinding) {
+		if err := stream.Send(&api.ListColorBindingResponse{Binding: b}); err != nil {
+			cancel()
+		}
+	}
+	return s.instance(stream.Context()).ListColorBinding(ctx, r, fn)
 }
 
 func (s *server) AddVrf(ctx context.Context, r *api.AddVrfRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.AddVrf(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).AddVrf(ctx, r)
 }
 
 func (s *server) DeleteVrf(ctx context.Context, r *api.DeleteVrfRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.DeleteVrf(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).DeleteVrf(ctx, r)
 }
 
 func readMpGracefulRestartFromAPIStruct(c *oc.MpGracefulRestart, a *api.MpGracefulRestart) {
@@ -606,6 +997,15 @@ func readApplyPolicyFromAPIStruct(c *oc.ApplyPolicy, a *api.ApplyPolicy) {
 			c.Config.InPolicyList = append(c.Config.InPolicyList, p.Name)
 		}
 	}
+	for _, ca := range a.ConditionalAdvertisements {
+		c.ConditionalAdvertisements = append(c.ConditionalAdvertisements, oc.ConditionalAdvertisement{
+			Config: oc.ConditionalAdvertisementConfig{
+				AdvertisePrefixSet: ca.AdvertisePrefixSet,
+				ConditionPrefixSet: ca.ConditionPrefixSet,
+				Condition:          oc.IntToConditionalAdvertisementConditionTypeMap[int(ca.Condition)],
+			},
+		})
+	}
 }
 
 func readRouteSelectionOptionsFromAPIStruct(c *oc.RouteSelectionOptions, a *api.RouteSelectionOptions) {
@@ -672,6 +1072,47 @@ func readAddPathsFromAPIStruct(c *oc.AddPaths, a *api.AddPaths) {
 	if a.Config != nil {
 		c.Config.Receive = a.Config.Receive
 		c.Config.SendMax = uint8(a.Config.SendMax)
+		c.Config.SendMode = oc.IntToAddPathsSendModeTypeMap[int(a.Config.SendMode)]
+	}
+}
+
+func readPrefixOrfFromAPIStruct(c *oc.PrefixOrf, a *api.PrefixOrf) {
+	if c == nil || a == nil {
+		return
+	}
+	if a.Config != nil {
+		c.Config.Mode = oc.IntToPrefixOrfModeTypeMap[int(a.Config.Mode)]
+		c.Config.PrefixSet = a.Config.PrefixSet
+	}
+}
+
+func readMinimumAdvertisementIntervalFromAPIStruct(c *oc.MinimumAdvertisementInterval, a *api.MinimumAdvertisementInterval) {
+	if c == nil || a == nil {
+		return
+	}
+	if a.Config != nil {
+		c.Config.Interval = a.Config.Interval
+	}
+}
+
+func readNextHopOptionsFromAPIStruct(c *oc.NextHopOptions, a *api.NextHopOptions) {
+	if c == nil || a == nil {
+		return
+	}
+	if a.Config != nil {
+		c.Config.NextHopSelf = a.Config.NextHopSelf
+		c.Config.NextHopSelfForce = a.Config.NextHopSelfForce
+		c.Config.NextHopUnchanged = a.Config.NextHopUnchanged
+	}
+}
+
+func readLoopPreventionFromAPIStruct(c *oc.LoopPrevention, a *api.LoopPrevention) {
+	if c == nil || a == nil {
+		return
+	}
+	if a.Config != nil {
+		c.Config.AllowOwnAs = uint8(a.Config.AllowOwnAsn)
+		c.Config.ReplacePeerAs = a.Config.ReplacePeerAsn
 	}
 }
 
@@ -692,6 +1133,21 @@ func newNeighborFromAPIStruct(a *api.Peer) (*oc.Neighbor, error) {
 		pconf.AsPathOptions.Config.AllowOwnAs = uint8(a.Conf.AllowOwnAsn)
 		pconf.AsPathOptions.Config.ReplacePeerAs = a.Conf.ReplacePeerAsn
 		pconf.Config.SendSoftwareVersion = a.Conf.SendSoftwareVersion
+		pconf.Config.FlowspecValidation = a.Conf.FlowspecValidation
+		pconf.Config.SendAigp = a.Conf.SendAigp
+		pconf.Config.ReceiveAigp = a.Conf.ReceiveAigp
+		pconf.Config.PeeringdbMaxPrefix = a.Conf.PeeringdbMaxPrefix
+		pconf.Config.DynamicCapability = a.Conf.DynamicCapability
+		pconf.Config.AuthPasswordSource = a.Conf.AuthPasswordSource
+		pconf.Config.DisableFourOctetAs = a.Conf.DisableFourOctetAs
+		pconf.Config.AcceptOwn = a.Conf.AcceptOwn
+		pconf.Config.LocalAsMigration = a.Conf.LocalAsMigration
+		pconf.Config.LocalAsNoPrepend = a.Conf.LocalAsNoPrepend
+		pconf.Config.LocalAsReplaceAs = a.Conf.LocalAsReplaceAs
+		pconf.Config.NextHopSelf = a.Conf.NextHopSelf
+		pconf.Config.NextHopSelfForce = a.Conf.NextHopSelfForce
+		pconf.Config.NextHopUnchanged = a.Conf.NextHopUnchanged
+		pconf.Config.OrrVantagePoint = a.Conf.OrrVantagePoint
 
 		switch a.Conf.RemovePrivate {
 		case api.RemovePrivate_REMOVE_ALL:
@@ -727,6 +1183,10 @@ func newNeighborFromAPIStruct(a *api.Peer) (*oc.Neighbor, error) {
 			readRouteTargetMembershipFromAPIStruct(&afiSafi.RouteTargetMembership, af.RouteTargetMembership)
 			readLongLivedGracefulRestartFromAPIStruct(&afiSafi.LongLivedGracefulRestart, af.LongLivedGracefulRestart)
 			readAddPathsFromAPIStruct(&afiSafi.AddPaths, af.AddPaths)
+			readPrefixOrfFromAPIStruct(&afiSafi.PrefixOrf, af.PrefixOrf)
+			readMinimumAdvertisementIntervalFromAPIStruct(&afiSafi.MinimumAdvertisementInterval, af.MinimumAdvertisementInterval)
+			readLoopPreventionFromAPIStruct(&afiSafi.LoopPrevention, af.LoopPrevention)
+			readNextHopOptionsFromAPIStruct(&afiSafi.NextHopOptions, af.NextHopOptions)
 			pconf.AfiSafis = append(pconf.AfiSafis, afiSafi)
 		}
 	}
@@ -738,6 +1198,10 @@ func newNeighborFromAPIStruct(a *api.Peer) (*oc.Neighbor, error) {
 			pconf.Timers.Config.KeepaliveInterval = float64(a.Timers.Config.KeepaliveInterval)
 			pconf.Timers.Config.MinimumAdvertisementInterval = float64(a.Timers.Config.MinimumAdvertisementInterval)
 			pconf.Timers.Config.IdleHoldTimeAfterReset = float64(a.Timers.Config.IdleHoldTimeAfterReset)
+			pconf.Timers.Config.IdleHoldTimeMin = float64(a.Timers.Config.IdleHoldTimeMin)
+			pconf.Timers.Config.IdleHoldTimeMax = float64(a.Timers.Config.IdleHoldTimeMax)
+			pconf.Timers.Config.IdleHoldTimeJitter = a.Timers.Config.IdleHoldTimeJitter
+			pconf.Timers.Config.ConnectRetryJitter = a.Timers.Config.ConnectRetryJitter
 		}
 		if a.Timers.State != nil {
 			pconf.Timers.State.KeepaliveInterval = float64(a.Timers.State.KeepaliveInterval)
@@ -765,10 +1229,14 @@ func newNeighborFromAPIStruct(a *api.Peer) (*oc.Neighbor, error) {
 	if a.Transport != nil {
 		pconf.Transport.Config.LocalAddress = a.Transport.LocalAddress
 		pconf.Transport.Config.PassiveMode = a.Transport.PassiveMode
+		pconf.Transport.Config.ActiveMode = a.Transport.ActiveMode
 		pconf.Transport.Config.RemotePort = uint16(a.Transport.RemotePort)
 		pconf.Transport.Config.LocalPort = uint16(a.Transport.LocalPort)
 		pconf.Transport.Config.BindInterface = a.Transport.BindInterface
 		pconf.Transport.Config.TcpMss = uint16(a.Transport.TcpMss)
+		pconf.Transport.Config.TransportProtocol = oc.IntToTransportProtocolTypeMap[int(a.Transport.Protocol)]
+		pconf.Transport.Config.QuicCaFile = a.Transport.QuicCaFile
+		pconf.Transport.Config.Vrf = a.Transport.Vrf
 	}
 	if a.EbgpMultihop != nil {
 		pconf.EbgpMultihop.Config.Enabled = a.EbgpMultihop.Enabled
@@ -778,6 +1246,10 @@ func newNeighborFromAPIStruct(a *api.Peer) (*oc.Neighbor, error) {
 		pconf.TtlSecurity.Config.Enabled = a.TtlSecurity.Enabled
 		pconf.TtlSecurity.Config.TtlMin = uint8(a.TtlSecurity.TtlMin)
 	}
+	if a.UpdateRateLimit != nil {
+		pconf.UpdateRateLimit.Config.MessagesPerSecond = a.UpdateRateLimit.MessagesPerSecond
+		pconf.UpdateRateLimit.Config.PrefixesPerSecond = a.UpdateRateLimit.PrefixesPerSecond
+	}
 	if a.State != nil {
 		pconf.State.SessionState = oc.SessionState(strings.ToUpper(string(a.State.SessionState)))
 		pconf.State.AdminState = oc.IntToAdminStateMap[int(a.State.AdminState)]
@@ -819,6 +1291,21 @@ func newPeerGroupFromAPIStruct(a *api.PeerGroup) (*oc.PeerGroup, error) {
 		pconf.Config.Description = a.Conf.Description
 		pconf.Config.PeerGroupName = a.Conf.PeerGroupName
 		pconf.Config.SendSoftwareVersion = a.Conf.SendSoftwareVersion
+		pconf.Config.FlowspecValidation = a.Conf.FlowspecValidation
+		pconf.Config.SendAigp = a.Conf.SendAigp
+		pconf.Config.ReceiveAigp = a.Conf.ReceiveAigp
+		pconf.Config.PeeringdbMaxPrefix = a.Conf.PeeringdbMaxPrefix
+		pconf.Config.DynamicCapability = a.Conf.DynamicCapability
+		pconf.Config.AuthPasswordSource = a.Conf.AuthPasswordSource
+		pconf.Config.DisableFourOctetAs = a.Conf.DisableFourOctetAs
+		pconf.Config.AcceptOwn = a.Conf.AcceptOwn
+		pconf.Config.LocalAsMigration = a.Conf.LocalAsMigration
+		pconf.Config.LocalAsNoPrepend = a.Conf.LocalAsNoPrepend
+		pconf.Config.LocalAsReplaceAs = a.Conf.LocalAsReplaceAs
+		pconf.Config.NextHopSelf = a.Conf.NextHopSelf
+		pconf.Config.NextHopSelfForce = a.Conf.NextHopSelfForce
+		pconf.Config.NextHopUnchanged = a.Conf.NextHopUnchanged
+		pconf.Config.OrrVantagePoint = a.Conf.OrrVantagePoint
 
 		switch a.Conf.RemovePrivate {
 		case api.RemovePrivate_REMOVE_ALL:
@@ -839,6 +1326,10 @@ func newPeerGroupFromAPIStruct(a *api.PeerGroup) (*oc.PeerGroup, error) {
 			readRouteTargetMembershipFromAPIStruct(&afiSafi.RouteTargetMembership, af.RouteTargetMembership)
 			readLongLivedGracefulRestartFromAPIStruct(&afiSafi.LongLivedGracefulRestart, af.LongLivedGracefulRestart)
 			readAddPathsFromAPIStruct(&afiSafi.AddPaths, af.AddPaths)
+			readPrefixOrfFromAPIStruct(&afiSafi.PrefixOrf, af.PrefixOrf)
+			readMinimumAdvertisementIntervalFromAPIStruct(&afiSafi.MinimumAdvertisementInterval, af.MinimumAdvertisementInterval)
+			readLoopPreventionFromAPIStruct(&afiSafi.LoopPrevention, af.LoopPrevention)
+			readNextHopOptionsFromAPIStruct(&afiSafi.NextHopOptions, af.NextHopOptions)
 			pconf.AfiSafis = append(pconf.AfiSafis, afiSafi)
 		}
 	}
@@ -850,6 +1341,10 @@ func newPeerGroupFromAPIStruct(a *api.PeerGroup) (*oc.PeerGroup, error) {
 			pconf.Timers.Config.KeepaliveInterval = float64(a.Timers.Config.KeepaliveInterval)
 			pconf.Timers.Config.MinimumAdvertisementInterval = float64(a.Timers.Config.MinimumAdvertisementInterval)
 			pconf.Timers.Config.IdleHoldTimeAfterReset = float64(a.Timers.Config.IdleHoldTimeAfterReset)
+			pconf.Timers.Config.IdleHoldTimeMin = float64(a.Timers.Config.IdleHoldTimeMin)
+			pconf.Timers.Config.IdleHoldTimeMax = float64(a.Timers.Config.IdleHoldTimeMax)
+			pconf.Timers.Config.IdleHoldTimeJitter = a.Timers.Config.IdleHoldTimeJitter
+			pconf.Timers.Config.ConnectRetryJitter = a.Timers.Config.ConnectRetryJitter
 		}
 		if a.Timers.State != nil {
 			pconf.Timers.State.KeepaliveInterval = float64(a.Timers.State.KeepaliveInterval)
@@ -877,8 +1372,12 @@ func newPeerGroupFromAPIStruct(a *api.PeerGroup) (*oc.PeerGroup, error) {
 	if a.Transport != nil {
 		pconf.Transport.Config.LocalAddress = a.Transport.LocalAddress
 		pconf.Transport.Config.PassiveMode = a.Transport.PassiveMode
+		pconf.Transport.Config.ActiveMode = a.Transport.ActiveMode
 		pconf.Transport.Config.RemotePort = uint16(a.Transport.RemotePort)
 		pconf.Transport.Config.TcpMss = uint16(a.Transport.TcpMss)
+		pconf.Transport.Config.TransportProtocol = oc.IntToTransportProtocolTypeMap[int(a.Transport.Protocol)]
+		pconf.Transport.Config.QuicCaFile = a.Transport.QuicCaFile
+		pconf.Transport.Config.Vrf = a.Transport.Vrf
 	}
 	if a.EbgpMultihop != nil {
 		pconf.EbgpMultihop.Config.Enabled = a.EbgpMultihop.Enabled
@@ -888,6 +1387,10 @@ func newPeerGroupFromAPIStruct(a *api.PeerGroup) (*oc.PeerGroup, error) {
 		pconf.TtlSecurity.Config.Enabled = a.TtlSecurity.Enabled
 		pconf.TtlSecurity.Config.TtlMin = uint8(a.TtlSecurity.TtlMin)
 	}
+	if a.UpdateRateLimit != nil {
+		pconf.UpdateRateLimit.Config.MessagesPerSecond = a.UpdateRateLimit.MessagesPerSecond
+		pconf.UpdateRateLimit.Config.PrefixesPerSecond = a.UpdateRateLimit.PrefixesPerSecond
+	}
 	if a.Info != nil {
 		pconf.State.TotalPaths = a.Info.TotalPaths
 		pconf.State.TotalPrefixes = a.Info.TotalPrefixes
@@ -898,35 +1401,35 @@ func newPeerGroupFromAPIStruct(a *api.PeerGroup) (*oc.PeerGroup, error) {
 }
 
 func (s *server) AddPeer(ctx context.Context, r *api.AddPeerRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.AddPeer(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).AddPeer(ctx, r)
 }
 
 func (s *server) DeletePeer(ctx context.Context, r *api.DeletePeerRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.DeletePeer(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).DeletePeer(ctx, r)
 }
 
 func (s *server) UpdatePeer(ctx context.Context, r *api.UpdatePeerRequest) (*api.UpdatePeerResponse, error) {
-	return s.bgpServer.UpdatePeer(ctx, r)
+	return s.instance(ctx).UpdatePeer(ctx, r)
 }
 
 func (s *server) AddPeerGroup(ctx context.Context, r *api.AddPeerGroupRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.AddPeerGroup(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).AddPeerGroup(ctx, r)
 }
 
 func (s *server) DeletePeerGroup(ctx context.Context, r *api.DeletePeerGroupRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.DeletePeerGroup(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).DeletePeerGroup(ctx, r)
 }
 
 func (s *server) UpdatePeerGroup(ctx context.Context, r *api.UpdatePeerGroupRequest) (*api.UpdatePeerGroupResponse, error) {
-	return s.bgpServer.UpdatePeerGroup(ctx, r)
+	return s.instance(ctx).UpdatePeerGroup(ctx, r)
 }
 
 func (s *server) AddDynamicNeighbor(ctx context.Context, r *api.AddDynamicNeighborRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.AddDynamicNeighbor(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).AddDynamicNeighbor(ctx, r)
 }
 
 func (s *server) DeleteDynamicNeighbor(ctx context.Context, r *api.DeleteDynamicNeighborRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.DeleteDynamicNeighbor(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).DeleteDynamicNeighbor(ctx, r)
 }
 
 func newPrefixFromApiStruct(a *api.Prefix) (*table.Prefix, error) {
@@ -1077,6 +1580,10 @@ func newDefinedSetFromApiStruct(a *api.DefinedSet) (table.DefinedSet, error) {
 
 var _regexpPrefixMaskLengthRange = regexp.MustCompile(`(\d+)\.\.(\d+)`)
 
+func (s *server) UpdateDefinedSet(ctx context.Context, r *api.UpdateDefinedSetRequest) (*api.UpdateDefinedSetResponse, error) {
+	return s.instance(ctx).UpdateDefinedSet(ctx, r)
+}
+
 func (s *server) ListDefinedSet(r *api.ListDefinedSetRequest, stream api.GobgpApi_ListDefinedSetServer) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -1085,15 +1592,15 @@ func (s *server) ListDefinedSet(r *api.ListDefinedSetRequest, stream api.GobgpAp
 			cancel()
 		}
 	}
-	return s.bgpServer.ListDefinedSet(ctx, r, fn)
+	return s.instance(stream.Context()).ListDefinedSet(ctx, r, fn)
 }
 
 func (s *server) AddDefinedSet(ctx context.Context, r *api.AddDefinedSetRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.AddDefinedSet(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).AddDefinedSet(ctx, r)
 }
 
 func (s *server) DeleteDefinedSet(ctx context.Context, r *api.DeleteDefinedSetRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.DeleteDefinedSet(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).DeleteDefinedSet(ctx, r)
 }
 
 var _regexpMedActionType = regexp.MustCompile(`([+-]?)(\d+)`)
@@ -1175,6 +1682,8 @@ func toStatementApi(s *oc.Statement) *api.Statement {
 		}
 		cs.AfiSafiIn = afiSafiIn
 	}
+	cs.FlowSpecMatch = s.Conditions.BgpConditions.FlowSpecMatch
+	cs.PathIdentifierIn = s.Conditions.BgpConditions.PathIdentifierInList
 	cs.RpkiResult = int32(s.Conditions.BgpConditions.RpkiValidationResult.ToInt())
 	as := &api.Actions{
 		RouteAction: func() api.RouteAction {
@@ -1223,15 +1732,21 @@ func toStatementApi(s *oc.Statement) *api.Statement {
 			}
 			var asn uint64
 			useleft := false
-			if s.Actions.BgpActions.SetAsPathPrepend.As != "last-as" {
-				asn, _ = strconv.ParseUint(s.Actions.BgpActions.SetAsPathPrepend.As, 10, 32)
-			} else {
+			usepeer := false
+			switch s.Actions.BgpActions.SetAsPathPrepend.As {
+			case "last-as":
 				useleft = true
+			case "peer-as":
+				usepeer = true
+			default:
+				asn, _ = strconv.ParseUint(s.Actions.BgpActions.SetAsPathPrepend.As, 10, 32)
 			}
 			return &api.AsPrependAction{
-				Asn:         uint32(asn),
-				Repeat:      uint32(s.Actions.BgpActions.SetAsPathPrepend.RepeatN),
-				UseLeftMost: useleft,
+				Asn:             uint32(asn),
+				Repeat:          uint32(s.Actions.BgpActions.SetAsPathPrepend.RepeatN),
+				UseLeftMost:     useleft,
+				UsePeerAs:       usepeer,
+				RepeatCommunity: s.Actions.BgpActions.SetAsPathPrepend.RepeatNCommunity,
 			}
 		}(),
 		ExtCommunity: func() *api.CommunityAction {
@@ -1298,6 +1813,43 @@ func toStatementApi(s *oc.Statement) *api.Statement {
 			}
 			return &api.OriginAction{Origin: apiOrigin}
 		}(),
+		Aigp: func() *api.AigpAction {
+			aigpStr := strings.TrimSpace(string(s.Actions.BgpActions.SetAigp))
+			if len(aigpStr) == 0 {
+				return nil
+			}
+			matches := _regexpMedActionType.FindStringSubmatch(aigpStr)
+			if len(matches) == 0 {
+				return nil
+			}
+			action := api.AigpAction_REPLACE
+			switch matches[1] {
+			case "+", "-":
+				action = api.AigpAction_MOD
+			}
+			value, err := strconv.ParseInt(matches[1]+matches[2], 10, 64)
+			if err != nil {
+				return nil
+			}
+			return &api.AigpAction{
+				Value: value,
+				Type:  action,
+			}
+		}(),
+		LinkBandwidth: func() *api.LinkBandwidthAction {
+			c := s.Actions.BgpActions.SetLinkBandwidth
+			if c.Bandwidth == "" {
+				return nil
+			}
+			if c.Bandwidth == "cumulative" {
+				return &api.LinkBandwidthAction{Cumulative: true, Transitive: c.Transitive}
+			}
+			bw, err := strconv.ParseFloat(c.Bandwidth, 32)
+			if err != nil {
+				return nil
+			}
+			return &api.LinkBandwidthAction{Bandwidth: float32(bw), Transitive: c.Transitive}
+		}(),
 	}
 	return &api.Statement{
 		Name:       s.Name,
@@ -1560,6 +2112,23 @@ func newMedActionFromApiStruct(a *api.MedAction) (*table.MedAction, error) {
 	return table.NewMedActionFromApiStruct(table.MedActionType(a.Type), a.Value), nil
 }
 
+func newAigpActionFromApiStruct(a *api.AigpAction) (*table.AigpAction, error) {
+	if a == nil {
+		return nil, nil
+	}
+	return table.NewAigpActionFromApiStruct(table.AigpActionType(a.Type), a.Value), nil
+}
+
+func newLinkBandwidthActionFromApiStruct(a *api.LinkBandwidthAction) (*table.LinkBandwidthAction, error) {
+	if a == nil {
+		return nil, nil
+	}
+	if !a.Cumulative && a.Bandwidth < 0 {
+		return nil, fmt.Errorf("invalid link bandwidth: %f", a.Bandwidth)
+	}
+	return table.NewLinkBandwidthActionFromApiStruct(a.Bandwidth, a.Cumulative, a.Transitive), nil
+}
+
 func newLocalPrefActionFromApiStruct(a *api.LocalPrefAction) (*table.LocalPrefAction, error) {
 	if a == nil || a.Value == 0 {
 		return nil, nil
@@ -1596,11 +2165,15 @@ func newAsPathPrependActionFromApiStruct(a *api.AsPrependAction) (*table.AsPathP
 	return table.NewAsPathPrependAction(oc.SetAsPathPrepend{
 		RepeatN: uint8(a.Repeat),
 		As: func() string {
-			if a.UseLeftMost {
+			switch {
+			case a.UseLeftMost:
 				return "last-as"
+			case a.UsePeerAs:
+				return "peer-as"
 			}
 			return fmt.Sprintf("%d", a.Asn)
 		}(),
+		RepeatNCommunity: a.RepeatCommunity,
 	})
 }
 
@@ -1672,6 +2245,12 @@ func newStatementFromApiStruct(a *api.Statement) (*table.Statement, error) {
 			func() (table.Condition, error) {
 				return newAfiSafiInConditionFromApiStruct(a.Conditions.AfiSafiIn)
 			},
+			func() (table.Condition, error) {
+				return table.NewFlowSpecCondition(a.Conditions.FlowSpecMatch)
+			},
+			func() (table.Condition, error) {
+				return table.NewPathIdentifierCondition(a.Conditions.PathIdentifierIn)
+			},
 		}
 		cs = make([]table.Condition, 0, len(cfs))
 		for _, f := range cfs {
@@ -1714,6 +2293,12 @@ func newStatementFromApiStruct(a *api.Statement) (*table.Statement, error) {
 			func() (table.Action, error) {
 				return newOriginActionFromApiStruct(a.Actions.OriginAction)
 			},
+			func() (table.Action, error) {
+				return newAigpActionFromApiStruct(a.Actions.Aigp)
+			},
+			func() (table.Action, error) {
+				return newLinkBandwidthActionFromApiStruct(a.Actions.LinkBandwidth)
+			},
 		}
 		as = make([]table.Action, 0, len(afs))
 		for _, f := range afs {
@@ -1742,15 +2327,15 @@ func (s *server) ListStatement(r *api.ListStatementRequest, stream api.GobgpApi_
 			cancel()
 		}
 	}
-	return s.bgpServer.ListStatement(ctx, r, fn)
+	return s.instance(stream.Context()).ListStatement(ctx, r, fn)
 }
 
 func (s *server) AddStatement(ctx context.Context, r *api.AddStatementRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.AddStatement(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).AddStatement(ctx, r)
 }
 
 func (s *server) DeleteStatement(ctx context.Context, r *api.DeleteStatementRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.DeleteStatement(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).DeleteStatement(ctx, r)
 }
 
 func newConfigPolicyFromApiStruct(a *api.Policy) (*oc.PolicyDefinition, error) {
@@ -1799,7 +2384,11 @@ func newPolicyFromApiStruct(a *api.Policy) (*table.Policy, error) {
 func newRoaListFromTableStructList(origin []*table.ROA) []*api.Roa {
 	l := make([]*api.Roa, 0)
 	for _, r := range origin {
-		host, portStr, _ := net.SplitHostPort(r.Src)
+		host, portStr, err := net.SplitHostPort(r.Src)
+		if err != nil {
+			// locally added by SLURM
+			host = r.Src
+		}
 		port, _ := strconv.ParseUint(portStr, 10, 32)
 		ones, _ := r.Network.Mask.Size()
 		l = append(l, &api.Roa{
@@ -1824,15 +2413,15 @@ func (s *server) ListPolicy(r *api.ListPolicyRequest, stream api.GobgpApi_ListPo
 			cancel()
 		}
 	}
-	return s.bgpServer.ListPolicy(ctx, r, fn)
+	return s.instance(stream.Context()).ListPolicy(ctx, r, fn)
 }
 
 func (s *server) AddPolicy(ctx context.Context, r *api.AddPolicyRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.AddPolicy(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).AddPolicy(ctx, r)
 }
 
 func (s *server) DeletePolicy(ctx context.Context, r *api.DeletePolicyRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.DeletePolicy(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).DeletePolicy(ctx, r)
 }
 
 func (s *server) ListPolicyAssignment(r *api.ListPolicyAssignmentRequest, stream api.GobgpApi_ListPolicyAssignmentServer) error {
@@ -1843,7 +2432,7 @@ func (s *server) ListPolicyAssignment(r *api.ListPolicyAssignmentRequest, stream
 			cancel()
 		}
 	}
-	return s.bgpServer.ListPolicyAssignment(ctx, r, fn)
+	return s.instance(stream.Context()).ListPolicyAssignment(ctx, r, fn)
 }
 
 func defaultRouteType(d api.RouteAction) table.RouteType {
@@ -1866,19 +2455,27 @@ func toPolicyDefinition(policies []*api.Policy) []*oc.PolicyDefinition {
 }
 
 func (s *server) AddPolicyAssignment(ctx context.Context, r *api.AddPolicyAssignmentRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.AddPolicyAssignment(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).AddPolicyAssignment(ctx, r)
 }
 
 func (s *server) DeletePolicyAssignment(ctx context.Context, r *api.DeletePolicyAssignmentRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.DeletePolicyAssignment(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).DeletePolicyAssignment(ctx, r)
 }
 
 func (s *server) SetPolicyAssignment(ctx context.Context, r *api.SetPolicyAssignmentRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.SetPolicyAssignment(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).SetPolicyAssignment(ctx, r)
+}
+
+func (s *server) ReplacePolicyAssignment(ctx context.Context, r *api.ReplacePolicyAssignmentRequest) (*api.ReplacePolicyAssignmentResponse, error) {
+	return s.instance(ctx).ReplacePolicyAssignment(ctx, r)
+}
+
+func (s *server) SimulatePolicy(ctx context.Context, r *api.SimulatePolicyRequest) (*api.SimulatePolicyResponse, error) {
+	return s.instance(ctx).SimulatePolicy(ctx, r)
 }
 
 func (s *server) GetBgp(ctx context.Context, r *api.GetBgpRequest) (*api.GetBgpResponse, error) {
-	return s.bgpServer.GetBgp(ctx, r)
+	return s.instance(ctx).GetBgp(ctx, r)
 }
 
 func newGlobalFromAPIStruct(a *api.Global) *oc.Global {
@@ -1908,6 +2505,9 @@ func newGlobalFromAPIStruct(a *api.Global) *oc.Global {
 			RouterId:         a.RouterId,
 			Port:             a.ListenPort,
 			LocalAddressList: a.ListenAddresses,
+			QuicPort:         a.QuicListenPort,
+			QuicCertFile:     a.QuicCertFile,
+			QuicKeyFile:      a.QuicKeyFile,
 		},
 		ApplyPolicy: *applyPolicy,
 		AfiSafis:    families,
@@ -1917,6 +2517,21 @@ func newGlobalFromAPIStruct(a *api.Global) *oc.Global {
 			},
 		},
 	}
+	if a.MultiplePaths != nil {
+		readUseMultiplePathsFromAPIStruct(&global.UseMultiplePaths, a.MultiplePaths)
+		global.UseMultiplePaths.Config.Enabled = global.UseMultiplePaths.Config.Enabled || a.UseMultiplePaths
+	}
+	for _, m := range a.FamilyMultiplePaths {
+		if m.Family == nil {
+			continue
+		}
+		rf := apiutil.ToRouteFamily(m.Family)
+		for i := range global.AfiSafis {
+			if global.AfiSafis[i].State.Family == rf {
+				readUseMultiplePathsFromAPIStruct(&global.AfiSafis[i].UseMultiplePaths, m.UseMultiplePaths)
+			}
+		}
+	}
 	if a.RouteSelectionOptions != nil {
 		global.RouteSelectionOptions = oc.RouteSelectionOptions{
 			Config: oc.RouteSelectionOptionsConfig{
@@ -1960,21 +2575,129 @@ func newGlobalFromAPIStruct(a *api.Global) *oc.Global {
 			},
 		}
 	}
+	if d := a.PeerDefaults; d != nil {
+		readPeerDefaultsFromAPIStruct(&global.PeerDefaults, d)
+	}
+	if c := a.ColorResolution; c != nil {
+		global.ColorResolution.Config.Enabled = c.Enabled
+	}
+	if m := a.EvpnMacMobility; m != nil {
+		global.EvpnMacMobility.Config = oc.EvpnMacMobilityConfig{
+			DuplicateMacDetection: m.DuplicateMacDetection,
+			MaxMoves:              m.MaxMoves,
+			Interval:              m.Interval,
+			FreezeTime:            m.FreezeTime,
+		}
+	}
+	for _, l := range a.Listeners {
+		global.Listeners = append(global.Listeners, oc.Listener{
+			Config: oc.ListenerConfig{
+				Address:          l.Address,
+				Port:             l.Port,
+				AuthRequired:     l.AuthRequired,
+				BindToDevice:     l.BindToDevice,
+				NetworkNamespace: l.NetworkNamespace,
+			},
+		})
+	}
 	return global
 }
 
+func readPeerDefaultsFromAPIStruct(c *oc.PeerDefaults, a *api.PeerDefaults) {
+	if a.Timers != nil && a.Timers.Config != nil {
+		c.Timers.Config.ConnectRetry = float64(a.Timers.Config.ConnectRetry)
+		c.Timers.Config.HoldTime = float64(a.Timers.Config.HoldTime)
+		c.Timers.Config.KeepaliveInterval = float64(a.Timers.Config.KeepaliveInterval)
+		c.Timers.Config.MinimumAdvertisementInterval = float64(a.Timers.Config.MinimumAdvertisementInterval)
+		c.Timers.Config.IdleHoldTimeAfterReset = float64(a.Timers.Config.IdleHoldTimeAfterReset)
+		c.Timers.Config.IdleHoldTimeMin = float64(a.Timers.Config.IdleHoldTimeMin)
+		c.Timers.Config.IdleHoldTimeMax = float64(a.Timers.Config.IdleHoldTimeMax)
+		c.Timers.Config.IdleHoldTimeJitter = a.Timers.Config.IdleHoldTimeJitter
+		c.Timers.Config.ConnectRetryJitter = a.Timers.Config.ConnectRetryJitter
+	}
+	if a.Transport != nil {
+		c.Transport.Config.LocalAddress = a.Transport.LocalAddress
+		c.Transport.Config.PassiveMode = a.Transport.PassiveMode
+		c.Transport.Config.ActiveMode = a.Transport.ActiveMode
+		c.Transport.Config.RemotePort = uint16(a.Transport.RemotePort)
+		c.Transport.Config.LocalPort = uint16(a.Transport.LocalPort)
+		c.Transport.Config.BindInterface = a.Transport.BindInterface
+		c.Transport.Config.TcpMss = uint16(a.Transport.TcpMss)
+		// TCP, the zero value, is the built-in default
+		if a.Transport.Protocol != api.Transport_TCP {
+			c.Transport.Config.TransportProtocol = oc.IntToTransportProtocolTypeMap[int(a.Transport.Protocol)]
+		}
+		c.Transport.Config.QuicCaFile = a.Transport.QuicCaFile
+		c.Transport.Config.Vrf = a.Transport.Vrf
+	}
+	if a.EbgpMultihop != nil {
+		c.EbgpMultihop.Config.Enabled = a.EbgpMultihop.Enabled
+		c.EbgpMultihop.Config.MultihopTtl = uint8(a.EbgpMultihop.MultihopTtl)
+	}
+	if a.GracefulRestart != nil {
+		c.GracefulRestart.Config.Enabled = a.GracefulRestart.Enabled
+		c.GracefulRestart.Config.RestartTime = uint16(a.GracefulRestart.RestartTime)
+		c.GracefulRestart.Config.HelperOnly = a.GracefulRestart.HelperOnly
+		c.GracefulRestart.Config.DeferralTime = uint16(a.GracefulRestart.DeferralTime)
+		c.GracefulRestart.Config.NotificationEnabled = a.GracefulRestart.NotificationEnabled
+		c.GracefulRestart.Config.LongLivedEnabled = a.GracefulRestart.LonglivedEnabled
+	}
+	if a.TtlSecurity != nil {
+		c.TtlSecurity.Config.Enabled = a.TtlSecurity.Enabled
+		c.TtlSecurity.Config.TtlMin = uint8(a.TtlSecurity.TtlMin)
+	}
+}
+
 func (s *server) StartBgp(ctx context.Context, r *api.StartBgpRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.StartBgp(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).StartBgp(ctx, r)
 }
 
 func (s *server) StopBgp(ctx context.Context, r *api.StopBgpRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.StopBgp(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).StopBgp(ctx, r)
 }
 
 func (s *server) GetTable(ctx context.Context, r *api.GetTableRequest) (*api.GetTableResponse, error) {
-	return s.bgpServer.GetTable(ctx, r)
+	return s.instance(ctx).GetTable(ctx, r)
+}
+
+func (s *server) ListRibDiff(r *api.ListRibDiffRequest, stream api.GobgpApi_ListRibDiffServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	fn := func(d *api.RibDiff) {
+		if err := stream.Send(&api.ListRibDiffResponse{Diff: d}); err != nil {
+			cancel()
+		}
+	}
+	return s.instance(stream.Context()).ListRibDiff(ctx, r, fn)
+}
+
+func (s *server) GetRibSummary(ctx context.Context, r *api.GetRibSummaryRequest) (*api.GetRibSummaryResponse, error) {
+	return s.instance(ctx).GetRibSummary(ctx, r)
 }
 
 func (s *server) SetLogLevel(ctx context.Context, r *api.SetLogLevelRequest) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.bgpServer.SetLogLevel(ctx, r)
+	return &emptypb.Empty{}, s.instance(ctx).SetLogLevel(ctx, r)
+}
+
+func (s *server) GetLogLevel(ctx context.Context, r *api.GetLogLevelRequest) (*api.GetLogLevelResponse, error) {
+	return s.instance(ctx).GetLogLevel(ctx, r)
+}
+
+func (s *server) StartDebugCapture(ctx context.Context, r *api.StartDebugCaptureRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).StartDebugCapture(ctx, r)
+}
+
+func (s *server) StopDebugCapture(ctx context.Context, r *api.StopDebugCaptureRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.instance(ctx).StopDebugCapture(ctx, r)
+}
+
+func (s *server) ListDebugCapture(r *api.ListDebugCaptureRequest, stream api.GobgpApi_ListDebugCaptureServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	fn := func(m *api.DebugCaptureMessage) {
+		if err := stream.Send(&api.ListDebugCaptureResponse{Message: m}); err != nil {
+			cancel()
+		}
+	}
+	return s.instance(stream.Context()).ListDebugCapture(ctx, r, fn)
 }
@@ -1,7 +1,9 @@
 package server
 
 import (
+	"context"
 	"net"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -10,6 +12,9 @@ import (
 	"github.com/osrg/gobgp/v3/pkg/apiutil"
 	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
@@ -160,3 +165,167 @@ func anyAttrs(attrs []bgp.PathAttributeInterface) []*anypb.Any {
 	anyPattrs, _ := apiutil.MarshalPathAttributes(attrs)
 	return anyPattrs
 }
+
+func TestGrpcInstance(t *testing.T) {
+	assert := assert.New(t)
+
+	sock := filepath.Join(t.TempDir(), "gobgp.sock")
+	s := NewBgpServer(GrpcListenAddress("unix://" + sock))
+	go s.Serve()
+	defer s.Stop()
+	err := s.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        1,
+			RouterId:   "1.1.1.1",
+			ListenPort: -1,
+		},
+	})
+	assert.Nil(err)
+
+	i := NewBgpServer()
+	go i.Serve()
+	defer i.Stop()
+	err = i.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        2,
+			RouterId:   "2.2.2.2",
+			ListenPort: -1,
+		},
+	})
+	assert.Nil(err)
+
+	assert.Nil(s.AddGrpcInstance("tenant", i))
+	assert.NotNil(s.AddGrpcInstance("tenant", i))
+	assert.NotNil(s.AddGrpcInstance("self", s))
+	assert.NotNil(i.AddGrpcInstance("tenant", s))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, "unix://"+sock, grpc.WithBlock(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(err)
+	defer conn.Close()
+	client := api.NewGobgpApiClient(conn)
+
+	r, err := client.GetBgp(ctx, &api.GetBgpRequest{})
+	assert.Nil(err)
+	assert.Equal(uint32(1), r.Global.Asn)
+
+	r, err = client.GetBgp(metadata.AppendToOutgoingContext(ctx, GrpcInstanceMetadataKey, "tenant"), &api.GetBgpRequest{})
+	assert.Nil(err)
+	assert.Equal(uint32(2), r.Global.Asn)
+
+	stream, err := client.ListPeer(metadata.AppendToOutgoingContext(ctx, GrpcInstanceMetadataKey, "unknown"), &api.ListPeerRequest{})
+	assert.Nil(err)
+	_, err = stream.Recv()
+	assert.NotNil(err)
+
+	assert.Nil(s.DeleteGrpcInstance("tenant"))
+	_, err = client.GetBgp(metadata.AppendToOutgoingContext(ctx, GrpcInstanceMetadataKey, "tenant"), &api.GetBgpRequest{})
+	assert.NotNil(err)
+}
+
+func TestGrpcAggregatedAndWatchReady(t *testing.T) {
+	assert := assert.New(t)
+
+	sock := filepath.Join(t.TempDir(), "gobgp.sock")
+	s := NewBgpServer(GrpcListenAddress("unix://" + sock))
+	go s.Serve()
+	defer s.Stop()
+	assert.Nil(s.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        1,
+			RouterId:   "1.1.1.1",
+			ListenPort: -1,
+		},
+	}))
+	for _, addr := range []string{"10.0.0.2", "10.0.0.3"} {
+		assert.Nil(s.AddPeer(context.Background(), &api.AddPeerRequest{
+			Peer: &api.Peer{Conf: &api.PeerConf{NeighborAddress: addr, PeerAsn: 2}},
+		}))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, "unix://"+sock, grpc.WithBlock(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(err)
+	defer conn.Close()
+	client := api.NewGobgpApiClient(conn)
+
+	peers, err := client.ListPeerAggregated(ctx, &api.ListPeerRequest{})
+	assert.Nil(err)
+	assert.Len(peers.Peers, 2)
+
+	family := &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST}
+	addPath := func(prefix string) {
+		_, err := client.AddPath(ctx, &api.AddPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Path: &api.Path{
+				Family: family,
+				Nlri:   anyNlri(bgp.NewIPAddrPrefix(24, prefix)),
+				Pattrs: anyAttrs([]bgp.PathAttributeInterface{
+					bgp.NewPathAttributeOrigin(0),
+					bgp.NewPathAttributeNextHop("10.0.0.1"),
+				}),
+			},
+		})
+		assert.Nil(err)
+	}
+	for _, prefix := range []string{"10.1.0.0", "10.2.0.0", "10.3.0.0"} {
+		addPath(prefix)
+	}
+
+	paths, err := client.ListPathAggregated(ctx, &api.ListPathRequest{
+		TableType: api.TableType_GLOBAL,
+		Family:    family,
+		PageSize:  2,
+	})
+	assert.Nil(err)
+	assert.Len(paths.Destinations, 2)
+	assert.NotEmpty(paths.NextPageToken)
+	paths, err = client.ListPathAggregated(ctx, &api.ListPathRequest{
+		TableType: api.TableType_GLOBAL,
+		Family:    family,
+		PageSize:  2,
+		PageToken: paths.NextPageToken,
+	})
+	assert.Nil(err)
+	assert.Len(paths.Destinations, 1)
+	assert.Equal("10.3.0.0/24", paths.Destinations[0].Prefix)
+	assert.Empty(paths.NextPageToken)
+
+	// the invalid request fails instead of an idle stream
+	stream, err := client.WatchEvent(ctx, &api.WatchEventRequest{})
+	assert.Nil(err)
+	_, err = stream.Recv()
+	assert.NotNil(err)
+
+	stream, err = client.WatchEvent(ctx, &api.WatchEventRequest{
+		Table: &api.WatchEventRequest_Table{
+			Filters: []*api.WatchEventRequest_Table_Filter{
+				{Type: api.WatchEventRequest_Table_Filter_BEST, Init: true},
+			},
+		},
+		NotifyReady: true,
+	})
+	assert.Nil(err)
+	initial := 0
+	for {
+		rsp, err := stream.Recv()
+		if !assert.Nil(err) {
+			return
+		}
+		if rsp.GetReady() != nil {
+			break
+		}
+		initial += len(rsp.GetTable().GetPaths())
+	}
+	assert.Equal(3, initial)
+
+	addPath("10.4.0.0")
+	rsp, err := stream.Recv()
+	assert.Nil(err)
+	assert.Len(rsp.GetTable().GetPaths(), 1)
+	nlri, err := apiutil.GetNativeNlri(rsp.GetTable().GetPaths()[0])
+	assert.Nil(err)
+	assert.Equal("10.4.0.0/24", nlri.String())
+}
@@ -0,0 +1,145 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"time"
+
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/log"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+const defaultGracefulShutdownDrainTime = 60 * time.Second
+
+// gracefulShutdown is an RFC 8326 graceful shutdown of a peer. The one of
+// the whole router is shared by all the peers.
+type gracefulShutdown struct {
+	communication string
+	timer         *time.Timer
+}
+
+// gracefulShutdownImport lowers the LOCAL_PREF of the path received from
+// the peer in graceful shutdown so that the alternative paths are selected
+// before the session goes down.
+func (peer *peer) gracefulShutdownImport(path *table.Path) *table.Path {
+	if peer == nil || peer.gshut == nil || path == nil || path.IsWithdraw {
+		return path
+	}
+	path = path.Clone(false)
+	path.SetLocalPref(0)
+	return path
+}
+
+// gracefulShutdownExport attaches the GRACEFUL_SHUTDOWN community to the
+// path sent to the peer in graceful shutdown so that the peer can lower
+// its preference too.
+func (peer *peer) gracefulShutdownExport(path *table.Path) {
+	if peer.gshut == nil || path == nil || path.IsWithdraw {
+		return
+	}
+	path.SetCommunities([]uint32{uint32(bgp.COMMUNITY_GRACEFUL_SHUTDOWN)}, false)
+}
+
+// startGracefulShutdown starts the graceful shutdown of the peers, all the
+// peers of the router if addr is empty, and disables them after the drain
+// time.
+func (s *BgpServer) startGracefulShutdown(addr string, drain time.Duration, communication string) error {
+	peers, err := s.addrToPeers(addr)
+	if err != nil {
+		return err
+	}
+	if drain == 0 {
+		drain = defaultGracefulShutdownDrainTime
+	}
+	g := &gracefulShutdown{communication: communication}
+	if addr == "" {
+		if s.gshut != nil {
+			s.gshut.timer.Stop()
+		}
+		s.gshut = g
+	}
+	for _, peer := range peers {
+		s.stopGracefulShutdown(peer)
+		peer.gshut = g
+	}
+	g.timer = time.AfterFunc(drain, func() {
+		s.mgmtOperation(func() error {
+			return s.endGracefulShutdown(g)
+		}, false)
+	})
+	s.logger.Info("graceful shutdown started",
+		log.Fields{
+			"Topic":     "Operation",
+			"Key":       addr,
+			"DrainTime": drain})
+	s.softResetIn(addr, bgp.RouteFamily(0))
+	return s.softResetOut(addr, bgp.RouteFamily(0), false)
+}
+
+// endGracefulShutdown disables the peers drained by g.
+func (s *BgpServer) endGracefulShutdown(g *gracefulShutdown) error {
+	if s.gshut == g {
+		s.gshut = nil
+	}
+	for addr, peer := range s.neighborMap {
+		if peer.gshut != g {
+			continue
+		}
+		peer.gshut = nil
+		s.logger.Info("graceful shutdown completed",
+			log.Fields{
+				"Topic": "Peer",
+				"Key":   addr})
+		if err := s.setAdminState(addr, g.communication, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cancelGracefulShutdown ends the graceful shutdown of the peers without
+// disabling them.
+func (s *BgpServer) cancelGracefulShutdown(addr string) error {
+	peers, err := s.addrToPeers(addr)
+	if err != nil {
+		return err
+	}
+	if addr == "" && s.gshut != nil {
+		s.gshut.timer.Stop()
+		s.gshut = nil
+	}
+	for _, peer := range peers {
+		if peer.gshut == nil {
+			continue
+		}
+		s.stopGracefulShutdown(peer)
+		s.logger.Info("graceful shutdown canceled",
+			log.Fields{
+				"Topic": "Peer",
+				"Key":   peer.ID()})
+		s.softResetIn(peer.ID(), bgp.RouteFamily(0))
+		s.softResetOut(peer.ID(), bgp.RouteFamily(0), false)
+	}
+	return nil
+}
+
+func (s *BgpServer) stopGracefulShutdown(peer *peer) {
+	if peer.gshut != nil && peer.gshut != s.gshut {
+		peer.gshut.timer.Stop()
+	}
+	peer.gshut = nil
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/apiutil"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func TestGracefulShutdown(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s1 := runNewServer(t, 1, "1.1.1.1", 10194)
+	defer s1.StopBgp(ctx, &api.StopBgpRequest{})
+	s2 := runNewServer(t, 2, "2.2.2.2", 20194)
+	defer s2.StopBgp(ctx, &api.StopBgpRequest{})
+
+	if err := peerServers(t, ctx, []*BgpServer{s1, s2}, []oc.AfiSafiType{oc.AFI_SAFI_TYPE_IPV4_UNICAST}); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []*BgpServer{s1, s2} {
+		path, _ := apiutil.NewPath(bgp.NewIPAddrPrefix(24, s.bgpConfig.Global.Config.RouterId), false, []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop(s.bgpConfig.Global.Config.RouterId),
+		}, time.Now())
+		_, err := s.AddPath(ctx, &api.AddPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Path:      path,
+		})
+		assert.NoError(err)
+	}
+
+	// returns the attributes of the path received from the peer
+	received := func(s *BgpServer) map[bgp.BGPAttrType]bgp.PathAttributeInterface {
+		var attrs map[bgp.BGPAttrType]bgp.PathAttributeInterface
+		s.ListPath(ctx, &api.ListPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Family:    &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST},
+		}, func(d *api.Destination) {
+			for _, p := range d.Paths {
+				if p.NeighborIp == "<nil>" {
+					continue
+				}
+				attrs = make(map[bgp.BGPAttrType]bgp.PathAttributeInterface)
+				for _, a := range p.Pattrs {
+					attr, _ := apiutil.UnmarshalAttribute(a)
+					attrs[attr.GetType()] = attr
+				}
+			}
+		})
+		return attrs
+	}
+	inGracefulShutdown := func(s *BgpServer) bool {
+		attrs := received(s)
+		if lp, y := attrs[bgp.BGP_ATTR_TYPE_LOCAL_PREF]; !y || lp.(*bgp.PathAttributeLocalPref).Value != 0 {
+			return false
+		}
+		return true
+	}
+	gshutCommunity := func(s *BgpServer) bool {
+		attrs := received(s)
+		c, y := attrs[bgp.BGP_ATTR_TYPE_COMMUNITIES]
+		return y && len(c.(*bgp.PathAttributeCommunities).Value) == 1 &&
+			c.(*bgp.PathAttributeCommunities).Value[0] == uint32(bgp.COMMUNITY_GRACEFUL_SHUTDOWN)
+	}
+	peerState := func(s *BgpServer) *api.PeerState {
+		var state *api.PeerState
+		s.ListPeer(ctx, &api.ListPeerRequest{}, func(p *api.Peer) {
+			state = p.State
+		})
+		return state
+	}
+	assert.Eventually(func() bool {
+		return received(s1) != nil && received(s2) != nil
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// the whole router
+	err := s1.GracefulShutdownPeer(ctx, &api.GracefulShutdownPeerRequest{DrainTime: 60})
+	assert.NoError(err)
+	assert.True(inGracefulShutdown(s1))
+	assert.True(peerState(s1).GracefulShutdown)
+	assert.Eventually(func() bool {
+		return gshutCommunity(s2)
+	}, 10*time.Second, 100*time.Millisecond)
+
+	err = s1.GracefulShutdownPeer(ctx, &api.GracefulShutdownPeerRequest{Cancel: true})
+	assert.NoError(err)
+	assert.False(inGracefulShutdown(s1))
+	assert.False(peerState(s1).GracefulShutdown)
+	assert.Nil(s1.gshut)
+	assert.Eventually(func() bool {
+		return !gshutCommunity(s2)
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// the peer is disabled after the drain time
+	err = s1.GracefulShutdownPeer(ctx, &api.GracefulShutdownPeerRequest{
+		Address:       "127.0.0.1",
+		DrainTime:     1,
+		Communication: "maintenance",
+	})
+	assert.NoError(err)
+	assert.True(inGracefulShutdown(s1))
+	assert.Eventually(func() bool {
+		state := peerState(s1)
+		return state.AdminState == api.PeerState_DOWN && !state.GracefulShutdown
+	}, 10*time.Second, 100*time.Millisecond)
+
+	err = s1.GracefulShutdownPeer(ctx, &api.GracefulShutdownPeerRequest{Address: "10.0.0.1"})
+	assert.Error(err)
+}
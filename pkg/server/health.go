@@ -0,0 +1,177 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/osrg/gobgp/v3/pkg/log"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+// The service names of grpc.health.v1 reporting the health of the
+// subsystems. The empty service name reports SERVING while all of the
+// subsystems are healthy.
+const (
+	// the configuration is loaded, see BgpServer.ConfigLoaded
+	HealthServiceConfig = "gobgp.config"
+	// StartBgp has succeeded and the BGP listeners are up
+	HealthServiceListener = "gobgp.listener"
+	// the zebra client is connected if enabled
+	HealthServiceZebra = "gobgp.zebra"
+	// any RPKI server has sent the whole ROAs if configured
+	HealthServiceRpki = "gobgp.rpki"
+	// the initial convergence is done, for the readiness probes
+	HealthServiceReady = "gobgp.ready"
+)
+
+var healthServices = []string{
+	HealthServiceConfig,
+	HealthServiceListener,
+	HealthServiceZebra,
+	HealthServiceRpki,
+}
+
+// the interval in which Watch checks the changes of the status
+const healthWatchInterval = time.Second
+
+// readiness tracks the initial convergence, which is done when all the
+// subsystems are healthy and every neighbor not administratively down is
+// established and has sent End-of-RIB for all the families of graceful
+// restart, or the timeout has passed since the configuration was loaded.
+// Once done, the server stays ready.
+type readiness struct {
+	timeout      time.Duration
+	configLoaded time.Time
+	ready        bool
+}
+
+// ConfigLoaded tells the health service that the initial configuration has
+// been applied, that is, StartBgp has been called and the neighbors have
+// been added. The initial convergence is evaluated after that.
+func (s *BgpServer) ConfigLoaded() {
+	s.mgmtOperation(func() error {
+		if s.readiness.configLoaded.IsZero() {
+			s.readiness.configLoaded = time.Now()
+			s.logger.Info("config loaded",
+				log.Fields{"Topic": "Config"})
+		}
+		return nil
+	}, false)
+}
+
+func (s *BgpServer) peersConverged() bool {
+	for _, peer := range s.neighborMap {
+		peer.fsm.lock.RLock()
+		down := peer.fsm.adminState == adminStateDown
+		established := peer.fsm.state == bgp.BGP_FSM_ESTABLISHED
+		peer.fsm.lock.RUnlock()
+		if down {
+			continue
+		}
+		if !established || !peer.recvedAllEOR() {
+			return false
+		}
+	}
+	return true
+}
+
+// health returns the health of the subsystems keyed by the service names,
+// and of the whole server with the empty name.
+func (s *BgpServer) health() map[string]bool {
+	m := map[string]bool{"": false, HealthServiceReady: false}
+	for _, name := range healthServices {
+		m[name] = false
+	}
+	s.mgmtOperation(func() error {
+		started := s.active() == nil
+		m[HealthServiceConfig] = !s.readiness.configLoaded.IsZero()
+		m[HealthServiceListener] = started
+		if c := s.bgpConfig.Global.Config; started && (c.Port > 0 || c.QuicPort > 0 || len(s.bgpConfig.Global.Listeners) > 0) {
+			m[HealthServiceListener] = len(s.listeners)+len(s.quicListeners) > 0
+		}
+		m[HealthServiceZebra] = s.zclient == nil || atomic.LoadInt32(&s.zclient.disconnected) == 0
+		m[HealthServiceRpki] = s.roaManager.synced()
+		m[""] = true
+		for _, name := range healthServices {
+			m[""] = m[""] && m[name]
+		}
+
+		r := &s.readiness
+		if !r.ready && m[""] {
+			timedout := r.timeout > 0 && time.Since(r.configLoaded) >= r.timeout
+			if timedout || s.peersConverged() {
+				r.ready = true
+				s.logger.Info("initial convergence done",
+					log.Fields{
+						"Topic":    "Server",
+						"TimedOut": timedout})
+			}
+		}
+		m[HealthServiceReady] = r.ready
+		return nil
+	}, false)
+	return m
+}
+
+type healthServer struct {
+	s *server
+	healthpb.UnimplementedHealthServer
+}
+
+func (h *healthServer) status(service string) (healthpb.HealthCheckResponse_ServingStatus, bool) {
+	healthy, y := h.s.bgpServer.health()[service]
+	if !y {
+		return healthpb.HealthCheckResponse_SERVICE_UNKNOWN, false
+	}
+	if healthy {
+		return healthpb.HealthCheckResponse_SERVING, true
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING, true
+}
+
+func (h *healthServer) Check(ctx context.Context, r *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	st, y := h.status(r.Service)
+	if !y {
+		return nil, status.Errorf(codes.NotFound, "unknown service %s", r.Service)
+	}
+	return &healthpb.HealthCheckResponse{Status: st}, nil
+}
+
+func (h *healthServer) Watch(r *healthpb.HealthCheckRequest, stream healthpb.Health_WatchServer) error {
+	ticker := time.NewTicker(healthWatchInterval)
+	defer ticker.Stop()
+	last := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		if st, _ := h.status(r.Service); st != last {
+			if err := stream.Send(&healthpb.HealthCheckResponse{Status: st}); err != nil {
+				return err
+			}
+			last = st
+		}
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+)
+
+func TestHealth(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	check := func(s *BgpServer, service string) healthpb.HealthCheckResponse_ServingStatus {
+		h := &healthServer{s: &server{bgpServer: s}}
+		rsp, err := h.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			return healthpb.HealthCheckResponse_SERVICE_UNKNOWN
+		}
+		return rsp.Status
+	}
+
+	s1 := NewBgpServer()
+	go s1.Serve()
+	defer s1.Stop()
+	for _, service := range []string{"", HealthServiceConfig, HealthServiceListener, HealthServiceReady} {
+		assert.Equal(healthpb.HealthCheckResponse_NOT_SERVING, check(s1, service), service)
+	}
+	assert.Equal(healthpb.HealthCheckResponse_SERVING, check(s1, HealthServiceZebra))
+	assert.Equal(healthpb.HealthCheckResponse_SERVING, check(s1, HealthServiceRpki))
+	_, err := (&healthServer{s: &server{bgpServer: s1}}).Check(ctx, &healthpb.HealthCheckRequest{Service: "unknown"})
+	assert.Equal(codes.NotFound, status.Code(err))
+
+	err = s1.StartBgp(ctx, &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        1,
+			RouterId:   "1.1.1.1",
+			ListenPort: 10198,
+		},
+	})
+	assert.NoError(err)
+	s2 := runNewServer(t, 2, "2.2.2.2", 20198)
+	defer s2.StopBgp(ctx, &api.StopBgpRequest{})
+	if err := peerServers(t, ctx, []*BgpServer{s1, s2}, []oc.AfiSafiType{oc.AFI_SAFI_TYPE_IPV4_UNICAST}); err != nil {
+		t.Fatal(err)
+	}
+	// the initial convergence isn't evaluated until the config is loaded
+	assert.Equal(healthpb.HealthCheckResponse_NOT_SERVING, check(s1, HealthServiceConfig))
+	assert.Equal(healthpb.HealthCheckResponse_NOT_SERVING, check(s1, HealthServiceReady))
+	s1.ConfigLoaded()
+	assert.Equal(healthpb.HealthCheckResponse_SERVING, check(s1, HealthServiceConfig))
+	assert.Equal(healthpb.HealthCheckResponse_SERVING, check(s1, HealthServiceListener))
+	assert.Equal(healthpb.HealthCheckResponse_SERVING, check(s1, ""))
+	// ready once the neighbor is established
+	assert.Eventually(func() bool {
+		return check(s1, HealthServiceReady) == healthpb.HealthCheckResponse_SERVING
+	}, 10*time.Second, 100*time.Millisecond)
+	assert.NoError(s1.ShutdownPeer(ctx, &api.ShutdownPeerRequest{Address: "127.0.0.1"}))
+	assert.Equal(healthpb.HealthCheckResponse_SERVING, check(s1, HealthServiceReady))
+
+	// a neighbor never established doesn't block the readiness after the
+	// timeout
+	s3 := NewBgpServer(InitialConvergenceTimeout(time.Second))
+	go s3.Serve()
+	defer s3.Stop()
+	err = s3.StartBgp(ctx, &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        3,
+			RouterId:   "3.3.3.3",
+			ListenPort: -1,
+		},
+	})
+	assert.NoError(err)
+	err = s3.AddPeer(ctx, &api.AddPeerRequest{
+		Peer: &api.Peer{
+			Conf:      &api.PeerConf{NeighborAddress: "127.0.0.1", PeerAsn: 4},
+			Transport: &api.Transport{PassiveMode: true},
+		},
+	})
+	assert.NoError(err)
+	s3.ConfigLoaded()
+	assert.Equal(healthpb.HealthCheckResponse_SERVING, check(s3, ""))
+	assert.Equal(healthpb.HealthCheckResponse_NOT_SERVING, check(s3, HealthServiceReady))
+	assert.Eventually(func() bool {
+		return check(s3, HealthServiceReady) == healthpb.HealthCheckResponse_SERVING
+	}, 10*time.Second, 100*time.Millisecond)
+}
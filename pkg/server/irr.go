@@ -0,0 +1,452 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/log"
+)
+
+const irrQueryTimeout = 60 * time.Second
+
+// irrClient queries an IRRd server with the IRRd query protocol, the
+// "!" commands used by the filter generators like bgpq4.
+type irrClient struct {
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+func dialIrr(ctx context.Context, address string) (*irrClient, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	// keep the connection open for the following queries
+	conn.SetDeadline(time.Now().Add(irrQueryTimeout))
+	if _, err := conn.Write([]byte("!!\n")); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &irrClient{
+		conn: conn,
+		r:    bufio.NewReader(conn),
+	}, nil
+}
+
+func (c *irrClient) close() {
+	c.conn.Write([]byte("!q\n"))
+	c.conn.Close()
+}
+
+// query sends the query and returns the data of the response, which is
+// nil if the key isn't found.
+func (c *irrClient) query(q string) ([]byte, error) {
+	c.conn.SetDeadline(time.Now().Add(irrQueryTimeout))
+	if _, err := c.conn.Write([]byte(q + "\n")); err != nil {
+		return nil, err
+	}
+	line, err := c.r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	line = strings.TrimSpace(line)
+	switch {
+	case line == "C":
+		return []byte{}, nil
+	case line == "D":
+		return nil, nil
+	case strings.HasPrefix(line, "A"):
+		n, err := strconv.Atoi(line[1:])
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid response to %s: %s", q, line)
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(c.r, data); err != nil {
+			return nil, err
+		}
+		// the data is followed by the completion line
+		for {
+			line, err = c.r.ReadString('\n')
+			if err != nil {
+				return nil, err
+			}
+			if line = strings.TrimSpace(line); line != "" {
+				break
+			}
+		}
+		if line != "C" {
+			return nil, fmt.Errorf("invalid response to %s: %s", q, line)
+		}
+		return data, nil
+	case strings.HasPrefix(line, "F"):
+		return nil, fmt.Errorf("query %s failed: %s", q, strings.TrimSpace(line[1:]))
+	}
+	return nil, fmt.Errorf("unexpected response to %s: %s", q, line)
+}
+
+func parseIrrAsn(s string) (uint32, error) {
+	if len(s) < 3 || !strings.EqualFold(s[:2], "AS") {
+		return 0, fmt.Errorf("invalid AS number: %s", s)
+	}
+	asn, err := strconv.ParseUint(s[2:], 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid AS number: %s", s)
+	}
+	return uint32(asn), nil
+}
+
+type irrResult struct {
+	asns []uint32
+	ipv4 []*net.IPNet
+	ipv6 []*net.IPNet
+}
+
+// expand resolves the as-set of the filter into the AS numbers and the
+// prefixes of the route objects originated by them.
+func (c *irrClient) expand(conf *oc.IrrFilterConfig) (*irrResult, error) {
+	if len(conf.SourceList) > 0 {
+		if _, err := c.query("!s" + strings.Join(conf.SourceList, ",")); err != nil {
+			return nil, err
+		}
+	}
+
+	var asns []uint32
+	if asn, err := parseIrrAsn(conf.AsSet); err == nil {
+		asns = []uint32{asn}
+	} else {
+		data, err := c.query("!i" + conf.AsSet + ",1")
+		if err != nil {
+			return nil, err
+		}
+		if data == nil {
+			return nil, fmt.Errorf("as-set %s isn't found", conf.AsSet)
+		}
+		m := make(map[uint32]struct{})
+		for _, member := range strings.Fields(string(data)) {
+			asn, err := parseIrrAsn(member)
+			if err != nil {
+				return nil, err
+			}
+			if _, y := m[asn]; !y {
+				m[asn] = struct{}{}
+				asns = append(asns, asn)
+			}
+		}
+		sort.Slice(asns, func(i, j int) bool { return asns[i] < asns[j] })
+	}
+	if conf.MaxAsns > 0 && uint32(len(asns)) > conf.MaxAsns {
+		return nil, fmt.Errorf("as-set %s has too many AS numbers: %d", conf.AsSet, len(asns))
+	}
+
+	r := &irrResult{asns: asns}
+	prefixes := make(map[string]struct{})
+	for _, asn := range asns {
+		for _, q := range []struct {
+			set     string
+			command string
+			list    *[]*net.IPNet
+		}{
+			{conf.Ipv4PrefixSet, "!g", &r.ipv4},
+			{conf.Ipv6PrefixSet, "!6", &r.ipv6},
+		} {
+			if q.set == "" {
+				continue
+			}
+			data, err := c.query(fmt.Sprintf("%sAS%d", q.command, asn))
+			if err != nil {
+				return nil, err
+			}
+			for _, s := range strings.Fields(string(data)) {
+				_, n, err := net.ParseCIDR(s)
+				if err != nil {
+					return nil, fmt.Errorf("invalid prefix of AS%d: %s", asn, s)
+				}
+				if _, y := prefixes[n.String()]; y {
+					continue
+				}
+				prefixes[n.String()] = struct{}{}
+				*q.list = append(*q.list, n)
+			}
+			if conf.MaxPrefixes > 0 && uint32(len(prefixes)) > conf.MaxPrefixes {
+				return nil, fmt.Errorf("as-set %s has too many prefixes: more than %d", conf.AsSet, conf.MaxPrefixes)
+			}
+		}
+	}
+	return r, nil
+}
+
+type irrFilter struct {
+	s    *BgpServer
+	c    *oc.IrrFilterConfig
+	dead chan struct{}
+
+	mu          sync.Mutex
+	lastRefresh time.Time
+	asns        int
+	ipv4        int
+	ipv6        int
+	refreshes   uint64
+	errors      uint64
+	lastError   string
+}
+
+// syncDefinedSet updates the defined set to have the entries, adding and
+// removing only the differences so that only the affected routes are
+// evaluated again.
+func (f *irrFilter) syncDefinedSet(ctx context.Context, typ api.DefinedType, name string, list []string, prefixes []*api.Prefix) error {
+	var cur *api.DefinedSet
+	err := f.s.ListDefinedSet(ctx, &api.ListDefinedSetRequest{
+		DefinedType: typ,
+		Name:        name,
+	}, func(d *api.DefinedSet) {
+		cur = d
+	})
+	if err != nil {
+		return err
+	}
+
+	prefixKey := func(p *api.Prefix) string {
+		return fmt.Sprintf("%s %d %d", p.IpPrefix, p.MaskLengthMin, p.MaskLengthMax)
+	}
+	r := &api.UpdateDefinedSetRequest{
+		DefinedType: typ,
+		Name:        name,
+	}
+	listMap := make(map[string]struct{})
+	prefixMap := make(map[string]struct{})
+	if cur != nil {
+		r.Version = cur.Version
+		for _, l := range cur.List {
+			listMap[l] = struct{}{}
+		}
+		for _, p := range cur.Prefixes {
+			prefixMap[prefixKey(p)] = struct{}{}
+		}
+	}
+	for _, l := range list {
+		if _, y := listMap[l]; y {
+			delete(listMap, l)
+		} else {
+			r.AddList = append(r.AddList, l)
+		}
+	}
+	for _, p := range prefixes {
+		if _, y := prefixMap[prefixKey(p)]; y {
+			delete(prefixMap, prefixKey(p))
+		} else {
+			r.AddPrefixes = append(r.AddPrefixes, p)
+		}
+	}
+	if cur != nil {
+		for _, l := range cur.List {
+			if _, y := listMap[l]; y {
+				r.RemoveList = append(r.RemoveList, l)
+			}
+		}
+		for _, p := range cur.Prefixes {
+			if _, y := prefixMap[prefixKey(p)]; y {
+				r.RemovePrefixes = append(r.RemovePrefixes, p)
+			}
+		}
+	}
+	if len(r.AddList)+len(r.AddPrefixes)+len(r.RemoveList)+len(r.RemovePrefixes) == 0 {
+		return nil
+	}
+	_, err = f.s.UpdateDefinedSet(ctx, r)
+	return err
+}
+
+func irrPrefixes(list []*net.IPNet, maxLength uint32) []*api.Prefix {
+	prefixes := make([]*api.Prefix, 0, len(list))
+	for _, n := range list {
+		ones, _ := n.Mask.Size()
+		max := uint32(ones)
+		if maxLength > max {
+			max = maxLength
+		}
+		prefixes = append(prefixes, &api.Prefix{
+			IpPrefix:      n.String(),
+			MaskLengthMin: uint32(ones),
+			MaskLengthMax: max,
+		})
+	}
+	return prefixes
+}
+
+func (f *irrFilter) update(ctx context.Context) error {
+	c, err := dialIrr(ctx, f.c.ServerAddress)
+	if err != nil {
+		return err
+	}
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			c.conn.Close()
+		case <-done:
+		}
+	}()
+	r, err := c.expand(f.c)
+	c.close()
+	if err != nil {
+		return err
+	}
+
+	if f.c.AsPathSet != "" {
+		list := make([]string, 0, len(r.asns))
+		for _, asn := range r.asns {
+			list = append(list, fmt.Sprintf("_%d$", asn))
+		}
+		if err := f.syncDefinedSet(ctx, api.DefinedType_AS_PATH, f.c.AsPathSet, list, nil); err != nil {
+			return err
+		}
+	}
+	if f.c.Ipv4PrefixSet != "" {
+		if err := f.syncDefinedSet(ctx, api.DefinedType_PREFIX, f.c.Ipv4PrefixSet, nil, irrPrefixes(r.ipv4, uint32(f.c.Ipv4MaxLength))); err != nil {
+			return err
+		}
+	}
+	if f.c.Ipv6PrefixSet != "" {
+		if err := f.syncDefinedSet(ctx, api.DefinedType_PREFIX, f.c.Ipv6PrefixSet, nil, irrPrefixes(r.ipv6, uint32(f.c.Ipv6MaxLength))); err != nil {
+			return err
+		}
+	}
+
+	f.mu.Lock()
+	f.asns = len(r.asns)
+	f.ipv4 = len(r.ipv4)
+	f.ipv6 = len(r.ipv6)
+	f.mu.Unlock()
+	return nil
+}
+
+// refresh queries the IRRd server and updates the defined sets. The sets
+// are left unchanged if the query fails or exceeds the limits.
+func (f *irrFilter) refresh(ctx context.Context) {
+	err := f.update(ctx)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.lastRefresh = time.Now()
+	f.refreshes++
+	if err != nil {
+		f.errors++
+		f.lastError = err.Error()
+		f.s.logger.Warn("failed to refresh the irr filter",
+			log.Fields{
+				"Topic": "irr",
+				"Key":   f.c.Name,
+				"Error": err})
+		return
+	}
+	f.lastError = ""
+	f.s.logger.Info("refreshed the irr filter",
+		log.Fields{
+			"Topic": "irr",
+			"Key":   f.c.Name,
+			"ASNs":  f.asns,
+			"IPv4":  f.ipv4,
+			"IPv6":  f.ipv6})
+}
+
+func (f *irrFilter) loop() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-f.dead
+		cancel()
+	}()
+
+	ticker := time.NewTicker(time.Duration(f.c.RefreshInterval) * time.Second)
+	defer ticker.Stop()
+	for {
+		f.refresh(ctx)
+		select {
+		case <-ticker.C:
+		case <-f.dead:
+			return
+		}
+	}
+}
+
+func (f *irrFilter) state() *api.IrrFilterState {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	st := &api.IrrFilterState{
+		Ipv4Prefixes: uint32(f.ipv4),
+		Ipv6Prefixes: uint32(f.ipv6),
+		Asns:         uint32(f.asns),
+		Refreshes:    f.refreshes,
+		Errors:       f.errors,
+		LastError:    f.lastError,
+	}
+	if !f.lastRefresh.IsZero() {
+		st.LastRefresh = timestamppb.New(f.lastRefresh)
+	}
+	return st
+}
+
+type irrFilterManager struct {
+	s         *BgpServer
+	filterMap map[string]*irrFilter
+}
+
+func newIrrFilterManager(s *BgpServer) *irrFilterManager {
+	return &irrFilterManager{
+		s:         s,
+		filterMap: make(map[string]*irrFilter),
+	}
+}
+
+func (m *irrFilterManager) addFilter(c *oc.IrrFilterConfig) error {
+	if _, y := m.filterMap[c.Name]; y {
+		return fmt.Errorf("irr filter %s is already configured", c.Name)
+	}
+	f := &irrFilter{
+		s:    m.s,
+		c:    c,
+		dead: make(chan struct{}),
+	}
+	m.filterMap[c.Name] = f
+	go f.loop()
+	return nil
+}
+
+func (m *irrFilterManager) deleteFilter(name string) error {
+	f, y := m.filterMap[name]
+	if !y {
+		return fmt.Errorf("irr filter %s isn't found", name)
+	}
+	close(f.dead)
+	delete(m.filterMap, name)
+	return nil
+}
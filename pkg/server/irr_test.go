@@ -0,0 +1,231 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+)
+
+// fakeIrrd answers the IRRd queries with the registered data.
+type fakeIrrd struct {
+	l       net.Listener
+	mu      sync.Mutex
+	answers map[string]string
+}
+
+func newFakeIrrd(t *testing.T, answers map[string]string) *fakeIrrd {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &fakeIrrd{
+		l:       l,
+		answers: answers,
+	}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go d.serve(conn)
+		}
+	}()
+	return d
+}
+
+func (d *fakeIrrd) set(q, a string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.answers[q] = a
+}
+
+func (d *fakeIrrd) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		q := strings.TrimSpace(line)
+		switch {
+		case q == "!!":
+			continue
+		case q == "!q":
+			return
+		case strings.HasPrefix(q, "!s"):
+			conn.Write([]byte("C\n"))
+			continue
+		}
+		d.mu.Lock()
+		a, y := d.answers[q]
+		d.mu.Unlock()
+		switch {
+		case !y:
+			conn.Write([]byte("D\n"))
+		case a == "":
+			conn.Write([]byte("C\n"))
+		default:
+			conn.Write([]byte(fmt.Sprintf("A%d\n%s\nC\n", len(a)+1, a)))
+		}
+	}
+}
+
+func TestIrrExpand(t *testing.T) {
+	assert := assert.New(t)
+
+	d := newFakeIrrd(t, map[string]string{
+		"!iAS-TEST,1": "AS65002 AS65001 AS65002",
+		"!gAS65001":   "10.0.0.0/24 10.1.0.0/16",
+		"!gAS65002":   "10.0.0.0/24",
+		"!6AS65001":   "",
+		"!6AS65002":   "2001:db8::/32",
+	})
+	defer d.l.Close()
+
+	c, err := dialIrr(context.Background(), d.l.Addr().String())
+	assert.NoError(err)
+	defer c.close()
+
+	conf := &oc.IrrFilterConfig{
+		AsSet:         "AS-TEST",
+		SourceList:    []string{"RADB"},
+		Ipv4PrefixSet: "ps4",
+		Ipv6PrefixSet: "ps6",
+	}
+	r, err := c.expand(conf)
+	assert.NoError(err)
+	assert.Equal([]uint32{65001, 65002}, r.asns)
+	assert.Len(r.ipv4, 2)
+	assert.Equal("2001:db8::/32", r.ipv6[0].String())
+
+	conf.MaxPrefixes = 2
+	_, err = c.expand(conf)
+	assert.Error(err)
+
+	conf.MaxPrefixes = 0
+	conf.MaxAsns = 1
+	_, err = c.expand(conf)
+	assert.Error(err)
+
+	conf.AsSet = "AS-UNKNOWN"
+	_, err = c.expand(conf)
+	assert.Error(err)
+
+	// an AS number is used as is
+	conf.AsSet = "AS65001"
+	r, err = c.expand(conf)
+	assert.NoError(err)
+	assert.Equal([]uint32{65001}, r.asns)
+}
+
+func TestIrrFilter(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	d := newFakeIrrd(t, map[string]string{
+		"!iAS-TEST,1": "AS65001 AS65002",
+		"!gAS65001":   "10.0.0.0/24",
+		"!gAS65002":   "10.1.0.0/16",
+	})
+	defer d.l.Close()
+
+	s := runNewServer(t, 1, "1.1.1.1", -1)
+	defer s.StopBgp(ctx, &api.StopBgpRequest{})
+
+	err := s.AddIrrFilter(ctx, &api.AddIrrFilterRequest{Filter: &api.IrrFilter{
+		Name:          "test",
+		AsSet:         "AS-TEST",
+		Server:        d.l.Addr().String(),
+		Ipv4PrefixSet: "ps4",
+		AsPathSet:     "as",
+		Ipv4MaxLength: 24,
+		MaxPrefixes:   2,
+	}})
+	assert.NoError(err)
+	err = s.AddIrrFilter(ctx, &api.AddIrrFilterRequest{Filter: &api.IrrFilter{
+		Name:  "test",
+		AsSet: "AS-TEST",
+	}})
+	assert.Error(err)
+
+	listSet := func(typ api.DefinedType, name string) *api.DefinedSet {
+		var set *api.DefinedSet
+		s.ListDefinedSet(ctx, &api.ListDefinedSetRequest{
+			DefinedType: typ,
+			Name:        name,
+		}, func(d *api.DefinedSet) {
+			set = d
+		})
+		return set
+	}
+	assert.Eventually(func() bool {
+		set := listSet(api.DefinedType_PREFIX, "ps4")
+		return set != nil && len(set.Prefixes) == 2
+	}, 10*time.Second, 100*time.Millisecond)
+	prefixes := listSet(api.DefinedType_PREFIX, "ps4").Prefixes
+	assert.Equal("10.0.0.0/24", prefixes[0].IpPrefix)
+	assert.Equal(uint32(24), prefixes[0].MaskLengthMax)
+	assert.Equal("10.1.0.0/16", prefixes[1].IpPrefix)
+	assert.Equal(uint32(16), prefixes[1].MaskLengthMin)
+	assert.Equal(uint32(24), prefixes[1].MaskLengthMax)
+	assert.Equal([]string{"_65001$", "_65002$"}, listSet(api.DefinedType_AS_PATH, "as").List)
+
+	f := s.irrManager.filterMap["test"]
+	// only the difference is applied
+	d.set("!gAS65002", "")
+	f.refresh(ctx)
+	set := listSet(api.DefinedType_PREFIX, "ps4")
+	assert.Len(set.Prefixes, 1)
+	version := set.Version
+
+	// the sets are left unchanged when the result exceeds the limit
+	d.set("!gAS65002", "10.2.0.0/24 10.3.0.0/24")
+	f.refresh(ctx)
+	set = listSet(api.DefinedType_PREFIX, "ps4")
+	assert.Len(set.Prefixes, 1)
+	assert.Equal(version, set.Version)
+
+	var l []*api.ListIrrFilterResponse
+	err = s.ListIrrFilter(ctx, &api.ListIrrFilterRequest{}, func(r *api.ListIrrFilterResponse) {
+		l = append(l, r)
+	})
+	assert.NoError(err)
+	assert.Len(l, 1)
+	assert.Equal(uint32(3600), l[0].Filter.RefreshInterval)
+	assert.Equal(uint32(1), l[0].State.Ipv4Prefixes)
+	assert.Equal(uint32(2), l[0].State.Asns)
+	assert.Equal(uint64(1), l[0].State.Errors)
+	assert.NotEmpty(l[0].State.LastError)
+
+	err = s.DeleteIrrFilter(ctx, &api.DeleteIrrFilterRequest{Name: "test"})
+	assert.NoError(err)
+	err = s.DeleteIrrFilter(ctx, &api.DeleteIrrFilterRequest{Name: "test"})
+	assert.Error(err)
+}
@@ -0,0 +1,252 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/log"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+const (
+	kafkaPolicyPrePolicy  = "pre-policy"
+	kafkaPolicyPostPolicy = "post-policy"
+	kafkaPolicyLocalRib   = "local-rib"
+)
+
+// kafkaRoute is the JSON encoding of the route event, which follows the
+// route monitoring message of BMP.
+type kafkaRoute struct {
+	Timestamp   time.Time                    `json:"timestamp"`
+	Policy      string                       `json:"policy"`
+	Withdraw    bool                         `json:"withdraw"`
+	PeerAddress string                       `json:"peer_address"`
+	PeerAS      uint32                       `json:"peer_as"`
+	PeerID      string                       `json:"peer_bgp_id,omitempty"`
+	Family      string                       `json:"family"`
+	Prefix      string                       `json:"prefix"`
+	PathID      uint32                       `json:"path_id,omitempty"`
+	Nexthop     string                       `json:"nexthop,omitempty"`
+	Attributes  []bgp.PathAttributeInterface `json:"attributes,omitempty"`
+}
+
+// kafkaPeerAddress returns the address of the peer from which the path is
+// received, which is empty for the local path.
+func kafkaPeerAddress(path *table.Path) string {
+	if a := path.GetSource().Address; a != nil {
+		return a.String()
+	}
+	return ""
+}
+
+// kafkaWriter is the interface of kafka.Writer used by the exporter.
+type kafkaWriter interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+type kafkaExporter struct {
+	s      *BgpServer
+	c      *oc.KafkaExporterConfig
+	w      kafkaWriter
+	dead   chan struct{}
+	sent   uint64
+	errors uint64
+}
+
+func (e *kafkaExporter) message(policy string, path *table.Path) (kafka.Message, error) {
+	var value []byte
+	var err error
+	switch e.c.Encoding {
+	case oc.KAFKA_ENCODING_TYPE_PROTOBUF:
+		value, err = proto.Marshal(toPathApi(path, nil, false, false, false))
+	default:
+		source := path.GetSource()
+		r := &kafkaRoute{
+			Timestamp:   path.GetTimestamp(),
+			Policy:      policy,
+			Withdraw:    path.IsWithdraw,
+			PeerAddress: kafkaPeerAddress(path),
+			PeerAS:      source.AS,
+			Family:      path.GetRouteFamily().String(),
+			Prefix:      path.GetNlri().String(),
+			PathID:      path.GetNlri().PathIdentifier(),
+		}
+		if !path.IsWithdraw {
+			r.Nexthop = path.GetNexthop().String()
+			for _, a := range path.GetPathAttrs() {
+				switch a.GetType() {
+				case bgp.BGP_ATTR_TYPE_NEXT_HOP, bgp.BGP_ATTR_TYPE_MP_REACH_NLRI, bgp.BGP_ATTR_TYPE_MP_UNREACH_NLRI:
+				default:
+					r.Attributes = append(r.Attributes, a)
+				}
+			}
+		}
+		if source.ID != nil {
+			r.PeerID = source.ID.String()
+		}
+		value, err = json.Marshal(r)
+	}
+	if err != nil {
+		return kafka.Message{}, err
+	}
+
+	var key string
+	switch e.c.PartitionKey {
+	case oc.KAFKA_PARTITION_KEY_TYPE_PEER:
+		key = kafkaPeerAddress(path)
+	default:
+		key = path.GetNlri().String()
+	}
+	return kafka.Message{
+		Key:   []byte(key),
+		Value: value,
+		Headers: []kafka.Header{
+			{Key: "policy", Value: []byte(policy)},
+		},
+	}, nil
+}
+
+func (e *kafkaExporter) publish(policy string, pathList []*table.Path) {
+	msgs := make([]kafka.Message, 0, len(pathList))
+	for _, path := range pathList {
+		msg, err := e.message(policy, path)
+		if err != nil {
+			e.s.logger.Warn("failed to encode the route event",
+				log.Fields{
+					"Topic": "kafka",
+					"Key":   e.c.Name,
+					"Error": err})
+			continue
+		}
+		msgs = append(msgs, msg)
+	}
+	if len(msgs) == 0 {
+		return
+	}
+	if err := e.w.WriteMessages(context.Background(), msgs...); err != nil {
+		atomic.AddUint64(&e.errors, uint64(len(msgs)))
+		e.s.logger.Warn("failed to publish the route events",
+			log.Fields{
+				"Topic": "kafka",
+				"Key":   e.c.Name,
+				"Error": err})
+	}
+}
+
+func (e *kafkaExporter) loop() {
+	policy := e.c.RouteMonitoringPolicy
+	var ops []watchOption
+	if policy == oc.BMP_ROUTE_MONITORING_POLICY_TYPE_PRE_POLICY || policy == oc.BMP_ROUTE_MONITORING_POLICY_TYPE_BOTH || policy == oc.BMP_ROUTE_MONITORING_POLICY_TYPE_ALL {
+		ops = append(ops, watchUpdate(true, "", ""))
+	}
+	if policy == oc.BMP_ROUTE_MONITORING_POLICY_TYPE_POST_POLICY || policy == oc.BMP_ROUTE_MONITORING_POLICY_TYPE_BOTH || policy == oc.BMP_ROUTE_MONITORING_POLICY_TYPE_ALL {
+		ops = append(ops, watchPostUpdate(true, "", ""))
+	}
+	if policy == oc.BMP_ROUTE_MONITORING_POLICY_TYPE_LOCAL_RIB || policy == oc.BMP_ROUTE_MONITORING_POLICY_TYPE_ALL {
+		ops = append(ops, watchBestPath(true))
+	}
+	w := e.s.watch(ops...)
+	defer func() {
+		w.Stop()
+		e.w.Close()
+	}()
+
+	for {
+		select {
+		case ev := <-w.Event():
+			switch msg := ev.(type) {
+			case *watchEventUpdate:
+				if msg.PostPolicy {
+					e.publish(kafkaPolicyPostPolicy, msg.PathList)
+				} else {
+					e.publish(kafkaPolicyPrePolicy, msg.PathList)
+				}
+			case *watchEventBestPath:
+				e.publish(kafkaPolicyLocalRib, msg.PathList)
+			}
+		case <-e.dead:
+			return
+		}
+	}
+}
+
+type kafkaExporterManager struct {
+	s           *BgpServer
+	exporterMap map[string]*kafkaExporter
+}
+
+func newKafkaExporterManager(s *BgpServer) *kafkaExporterManager {
+	return &kafkaExporterManager{
+		s:           s,
+		exporterMap: make(map[string]*kafkaExporter),
+	}
+}
+
+func (m *kafkaExporterManager) addExporter(c *oc.KafkaExporterConfig, w kafkaWriter) error {
+	if _, y := m.exporterMap[c.Name]; y {
+		return fmt.Errorf("kafka exporter %s is already configured", c.Name)
+	}
+	e := &kafkaExporter{
+		s:    m.s,
+		c:    c,
+		dead: make(chan struct{}),
+	}
+	if w == nil {
+		w = &kafka.Writer{
+			Addr:     kafka.TCP(c.BrokerList...),
+			Topic:    c.Topic,
+			Balancer: &kafka.Hash{},
+			Async:    true,
+			Completion: func(msgs []kafka.Message, err error) {
+				if err != nil {
+					atomic.AddUint64(&e.errors, uint64(len(msgs)))
+					m.s.logger.Warn("failed to publish the route events",
+						log.Fields{
+							"Topic": "kafka",
+							"Key":   c.Name,
+							"Error": err})
+				} else {
+					atomic.AddUint64(&e.sent, uint64(len(msgs)))
+				}
+			},
+		}
+	}
+	e.w = w
+	m.exporterMap[c.Name] = e
+	go e.loop()
+	return nil
+}
+
+func (m *kafkaExporterManager) deleteExporter(name string) error {
+	e, y := m.exporterMap[name]
+	if !y {
+		return fmt.Errorf("kafka exporter %s isn't found", name)
+	}
+	close(e.dead)
+	delete(m.exporterMap, name)
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+)
+
+type testKafkaWriter struct {
+	ch chan kafka.Message
+}
+
+func (w *testKafkaWriter) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	for _, msg := range msgs {
+		w.ch <- msg
+	}
+	return nil
+}
+
+func (w *testKafkaWriter) Close() error {
+	return nil
+}
+
+func TestKafkaExporter(t *testing.T) {
+	assert := assert.New(t)
+
+	s, addPath := newEventBacklogTestServer(t)
+	defer s.StopBgp(context.Background(), &api.StopBgpRequest{})
+	addPath(1)
+
+	addExporter := func(c *oc.KafkaExporterConfig) *testKafkaWriter {
+		w := &testKafkaWriter{ch: make(chan kafka.Message, 8)}
+		err := s.mgmtOperation(func() error {
+			return s.kafkaManager.addExporter(c, w)
+		}, false)
+		assert.Nil(err)
+		return w
+	}
+	recv := func(w *testKafkaWriter) kafka.Message {
+		select {
+		case msg := <-w.ch:
+			return msg
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out")
+		}
+		return kafka.Message{}
+	}
+
+	jsonWriter := addExporter(&oc.KafkaExporterConfig{
+		Name:                  "json",
+		Encoding:              oc.KAFKA_ENCODING_TYPE_JSON,
+		PartitionKey:          oc.KAFKA_PARTITION_KEY_TYPE_PREFIX,
+		RouteMonitoringPolicy: oc.BMP_ROUTE_MONITORING_POLICY_TYPE_LOCAL_RIB,
+	})
+	protoWriter := addExporter(&oc.KafkaExporterConfig{
+		Name:                  "protobuf",
+		Encoding:              oc.KAFKA_ENCODING_TYPE_PROTOBUF,
+		PartitionKey:          oc.KAFKA_PARTITION_KEY_TYPE_PEER,
+		RouteMonitoringPolicy: oc.BMP_ROUTE_MONITORING_POLICY_TYPE_LOCAL_RIB,
+	})
+
+	// the current table is published first
+	msg := recv(jsonWriter)
+	assert.Equal("10.1.0.0/24", string(msg.Key))
+	assert.Equal([]kafka.Header{{Key: "policy", Value: []byte("local-rib")}}, msg.Headers)
+	route := map[string]interface{}{}
+	assert.Nil(json.Unmarshal(msg.Value, &route))
+	assert.Equal("local-rib", route["policy"])
+	assert.Equal(false, route["withdraw"])
+	assert.Equal("ipv4-unicast", route["family"])
+	assert.Equal("10.1.0.0/24", route["prefix"])
+	assert.Equal("10.0.0.1", route["nexthop"])
+	assert.Len(route["attributes"], 1)
+
+	msg = recv(protoWriter)
+	assert.Equal("", string(msg.Key))
+	path := &api.Path{}
+	assert.Nil(proto.Unmarshal(msg.Value, path))
+	assert.Equal("10.1.0.0/24", nlriPrefix(path))
+
+	addPath(2)
+	msg = recv(jsonWriter)
+	assert.Equal("10.2.0.0/24", string(msg.Key))
+	recv(protoWriter)
+
+	var l []*api.ListKafkaExporterResponse
+	err := s.ListKafkaExporter(context.Background(), &api.ListKafkaExporterRequest{Name: "json"}, func(r *api.ListKafkaExporterResponse) {
+		l = append(l, r)
+	})
+	assert.Nil(err)
+	assert.Len(l, 1)
+	assert.Equal(api.KafkaExporter_JSON, l[0].Exporter.Encoding)
+	assert.Equal(api.AddBmpRequest_LOCAL, l[0].Exporter.Policy)
+
+	assert.Nil(s.DeleteKafkaExporter(context.Background(), &api.DeleteKafkaExporterRequest{Name: "json"}))
+	assert.NotNil(s.DeleteKafkaExporter(context.Background(), &api.DeleteKafkaExporterRequest{Name: "json"}))
+	assert.NotNil(s.AddKafkaExporter(context.Background(), &api.AddKafkaExporterRequest{
+		Exporter: &api.KafkaExporter{Name: "invalid"},
+	}))
+}
@@ -0,0 +1,332 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/log"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+// vrfLeak leaks the best IPv4 and IPv6 unicast routes of a VRF or the
+// global RIB into another VRF or the global RIB. The routes leaked by a
+// leak are never leaked again by another one.
+type vrfLeak struct {
+	c *oc.VrfLeakConfig
+	// nil for the global RIB
+	src *table.Vrf
+	dst *table.Vrf
+	// the source of the leaked paths, which differs from the ones of the
+	// API and the other leaks not to implicitly withdraw their paths
+	source *table.PeerInfo
+	// the best paths in the source keyed by the prefixes and the NLRIs.
+	// The paths of the same prefix with the different RDs are leaked into
+	// the same route in the destination.
+	candidates map[string]map[string]*table.Path
+	dirty      map[string]struct{}
+	// the paths installed in the destination keyed by the prefixes
+	paths map[string]*table.Path
+}
+
+func (l *vrfLeak) families() []bgp.RouteFamily {
+	if l.src == nil {
+		return []bgp.RouteFamily{bgp.RF_IPv4_UC, bgp.RF_IPv6_UC}
+	}
+	return []bgp.RouteFamily{bgp.RF_IPv4_VPN, bgp.RF_IPv6_VPN}
+}
+
+// leakPrefix returns the prefix of the path without the RD.
+func leakPrefix(path *table.Path) string {
+	switch n := path.GetNlri().(type) {
+	case *bgp.LabeledVPNIPAddrPrefix:
+		return n.IPPrefix()
+	case *bgp.LabeledVPNIPv6AddrPrefix:
+		return n.IPPrefix()
+	}
+	return path.GetNlri().String()
+}
+
+// contains returns true if the path is a route of the source which the
+// destination doesn't import by itself.
+func (l *vrfLeak) contains(path *table.Path) bool {
+	family := path.GetRouteFamily()
+	for _, f := range l.families() {
+		if family != f {
+			continue
+		}
+		if l.src == nil {
+			return true
+		}
+		return table.CanImportToVrf(l.src, path) && (l.dst == nil || !table.CanImportToVrf(l.dst, path))
+	}
+	return false
+}
+
+// update updates the candidates with the change of the best path in the
+// source.
+func (l *vrfLeak) update(path *table.Path) {
+	prefix := leakPrefix(path)
+	key := path.GetNlri().String()
+	if path.IsWithdraw || !l.contains(path) {
+		m, y := l.candidates[prefix]
+		if _, found := m[key]; !y || !found {
+			return
+		}
+		delete(m, key)
+		if len(m) == 0 {
+			delete(l.candidates, prefix)
+		}
+	} else {
+		m, y := l.candidates[prefix]
+		if !y {
+			m = make(map[string]*table.Path)
+			l.candidates[prefix] = m
+		}
+		m[key] = path
+	}
+	l.dirty[prefix] = struct{}{}
+}
+
+// leak converts the path in the source into the one in the destination,
+// or returns nil if the policies reject it.
+func (l *vrfLeak) leak(s *BgpServer, a *table.PolicyAssignment, path *table.Path) *table.Path {
+	local := path
+	if l.src != nil {
+		local = path.ToLocal()
+	}
+	local = table.NewPath(l.source, local.GetNlri(), false, local.GetPathAttrs(), path.GetTimestamp(), false)
+	local = s.policy.ApplyPolicyAssignment(a, local, &table.PolicyOptions{Info: path.GetSource()})
+	if local == nil || l.dst == nil {
+		return local
+	}
+	return local.ToGlobal(l.dst)
+}
+
+type vrfLeakManager struct {
+	s      *BgpServer
+	leaks  map[string]*vrfLeak
+	lastID uint32
+}
+
+func newVrfLeakManager(s *BgpServer) *vrfLeakManager {
+	return &vrfLeakManager{
+		s:     s,
+		leaks: make(map[string]*vrfLeak),
+	}
+}
+
+func (m *vrfLeakManager) lookupVrf(name string) (*table.Vrf, error) {
+	if name == "" {
+		return nil, nil
+	}
+	vrf, y := m.s.globalRib.Vrfs[name]
+	if !y {
+		return nil, fmt.Errorf("vrf %s not found", name)
+	}
+	return vrf, nil
+}
+
+func (m *vrfLeakManager) assignment(c *oc.VrfLeakConfig) (*table.PolicyAssignment, error) {
+	policies := make([]*oc.PolicyDefinition, 0, len(c.PolicyList))
+	for _, name := range c.PolicyList {
+		policies = append(policies, &oc.PolicyDefinition{Name: name})
+	}
+	def := table.ROUTE_TYPE_ACCEPT
+	if c.DefaultPolicy == oc.DEFAULT_POLICY_TYPE_REJECT_ROUTE {
+		def = table.ROUTE_TYPE_REJECT
+	}
+	return m.s.policy.NewPolicyAssignment("", table.POLICY_DIRECTION_IMPORT, policies, def)
+}
+
+func (m *vrfLeakManager) addLeak(c *oc.VrfLeakConfig) error {
+	if c.Name == "" {
+		return fmt.Errorf("empty vrf leak name")
+	}
+	if _, y := m.leaks[c.Name]; y {
+		return fmt.Errorf("vrf leak %s is already configured", c.Name)
+	}
+	if c.SourceVrf == c.DestinationVrf {
+		return fmt.Errorf("vrf leak %s has the same source and destination", c.Name)
+	}
+	src, err := m.lookupVrf(c.SourceVrf)
+	if err != nil {
+		return err
+	}
+	dst, err := m.lookupVrf(c.DestinationVrf)
+	if err != nil {
+		return err
+	}
+	if _, err := m.assignment(c); err != nil {
+		return err
+	}
+	if c.DefaultPolicy == "" {
+		c.DefaultPolicy = oc.DEFAULT_POLICY_TYPE_ACCEPT_ROUTE
+	}
+
+	m.lastID++
+	id := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(id, m.lastID)
+	l := &vrfLeak{
+		c:   c,
+		src: src,
+		dst: dst,
+		source: &table.PeerInfo{
+			AS:      m.s.bgpConfig.Global.Config.As,
+			ID:      id,
+			LocalID: net.ParseIP(m.s.bgpConfig.Global.Config.RouterId).To4(),
+		},
+		candidates: make(map[string]map[string]*table.Path),
+		dirty:      make(map[string]struct{}),
+		paths:      make(map[string]*table.Path),
+	}
+	m.leaks[c.Name] = l
+	for _, path := range m.s.globalRib.GetBestPathList(table.GLOBAL_RIB_NAME, 0, l.families()) {
+		if !m.isLeaked(path) {
+			l.update(path)
+		}
+	}
+	m.s.logger.Info("vrf leak added",
+		log.Fields{
+			"Topic":       "Vrf",
+			"Key":         c.Name,
+			"Source":      c.SourceVrf,
+			"Destination": c.DestinationVrf})
+	return nil
+}
+
+func (m *vrfLeakManager) deleteLeak(name string) error {
+	l, y := m.leaks[name]
+	if !y {
+		return fmt.Errorf("vrf leak %s isn't found", name)
+	}
+	delete(m.leaks, name)
+	withdrawals := make([]*table.Path, 0, len(l.paths))
+	for _, path := range l.paths {
+		withdrawals = append(withdrawals, path.Clone(true))
+	}
+	if len(withdrawals) > 0 {
+		m.s.propagateUpdate(nil, withdrawals)
+	}
+	m.s.logger.Info("vrf leak deleted",
+		log.Fields{
+			"Topic": "Vrf",
+			"Key":   name})
+	return nil
+}
+
+// vrfInUse returns the name of a leak from or into the VRF.
+func (m *vrfLeakManager) vrfInUse(name string) string {
+	for _, l := range m.leaks {
+		if l.c.SourceVrf == name || l.c.DestinationVrf == name {
+			return l.c.Name
+		}
+	}
+	return ""
+}
+
+func (m *vrfLeakManager) isLeaked(path *table.Path) bool {
+	source := path.GetSource()
+	for _, l := range m.leaks {
+		if l.source == source {
+			return true
+		}
+	}
+	return false
+}
+
+// update updates the candidates of the leaks with the changes of the best
+// paths in the global RIB.
+func (m *vrfLeakManager) update(bestList []*table.Path) {
+	if len(m.leaks) == 0 {
+		return
+	}
+	for _, path := range bestList {
+		if path == nil || m.isLeaked(path) {
+			continue
+		}
+		for _, l := range m.leaks {
+			l.update(path)
+		}
+	}
+}
+
+// flush installs, updates or withdraws the leaked routes whose candidates
+// have changed, and returns true if any route is installed or withdrawn.
+func (m *vrfLeakManager) flush() bool {
+	changed := false
+	for _, l := range m.leaks {
+		if len(l.dirty) == 0 {
+			continue
+		}
+		dirty := l.dirty
+		l.dirty = make(map[string]struct{})
+		// the policies are looked up again as they may have been replaced
+		a, err := m.assignment(l.c)
+		if err != nil {
+			m.s.logger.Warn("failed to apply policies to vrf leak",
+				log.Fields{
+					"Topic": "Vrf",
+					"Key":   l.c.Name,
+					"Error": err})
+			a = &table.PolicyAssignment{Default: table.ROUTE_TYPE_REJECT}
+		}
+		for prefix := range dirty {
+			if m.install(l, a, prefix) {
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+// install leaks the first candidate of the prefix in the order of the NLRIs
+// which the policies accept.
+func (m *vrfLeakManager) install(l *vrfLeak, a *table.PolicyAssignment, prefix string) bool {
+	candidates := l.candidates[prefix]
+	keys := make([]string, 0, len(candidates))
+	for key := range candidates {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var path *table.Path
+	for _, key := range keys {
+		if path = l.leak(m.s, a, candidates[key]); path != nil {
+			break
+		}
+	}
+
+	old := l.paths[prefix]
+	if path == nil {
+		if old == nil {
+			return false
+		}
+		delete(l.paths, prefix)
+		m.s.propagateUpdate(nil, []*table.Path{old.Clone(true)})
+		return true
+	}
+	if old != nil && samePathAttrs(old.GetPathAttrs(), path.GetPathAttrs()) {
+		return false
+	}
+	l.paths[prefix] = path
+	m.s.propagateUpdate(nil, []*table.Path{path})
+	return true
+}
@@ -0,0 +1,165 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/apiutil"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func TestVrfLeak(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.StartBgp(ctx, &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        1,
+			RouterId:   "1.1.1.1",
+			ListenPort: -1,
+		},
+	})
+	assert.NoError(err)
+	defer s.StopBgp(ctx, &api.StopBgpRequest{})
+
+	addVrf(t, s, "vrf1", "100:1", []string{"100:1"}, []string{"100:1"}, 1)
+	addVrf(t, s, "vrf2", "100:2", []string{"100:2"}, []string{"100:2"}, 2)
+
+	addPath := func(vrf, prefix string) []byte {
+		path, _ := apiutil.NewPath(bgp.NewIPAddrPrefix(24, prefix), false, []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop("10.255.0.1"),
+		}, time.Now())
+		tableType := api.TableType_GLOBAL
+		if vrf != "" {
+			tableType = api.TableType_VRF
+		}
+		rsp, err := s.AddPath(ctx, &api.AddPathRequest{
+			TableType: tableType,
+			VrfId:     vrf,
+			Path:      path,
+		})
+		assert.NoError(err)
+		return rsp.Uuid
+	}
+	// returns the prefixes in the VRF or the global RIB
+	prefixes := func(vrf string) []string {
+		tableType := api.TableType_GLOBAL
+		if vrf != "" {
+			tableType = api.TableType_VRF
+		}
+		l := make([]string, 0)
+		s.ListPath(ctx, &api.ListPathRequest{
+			TableType: tableType,
+			Name:      vrf,
+			Family:    &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST},
+		}, func(d *api.Destination) {
+			l = append(l, d.Prefix)
+		})
+		sort.Strings(l)
+		return l
+	}
+	leaked := func(name string) uint32 {
+		var n uint32
+		s.ListVrfLeak(ctx, &api.ListVrfLeakRequest{Name: name}, func(r *api.ListVrfLeakResponse) {
+			n = r.Leaked
+		})
+		return n
+	}
+
+	uuid := addPath("vrf1", "10.0.1.0")
+	addPath("vrf1", "10.0.2.0")
+	addPath("", "10.9.0.0")
+	assert.Equal([]string{}, prefixes("vrf2"))
+
+	err = s.AddDefinedSet(ctx, &api.AddDefinedSetRequest{
+		DefinedSet: &api.DefinedSet{
+			DefinedType: api.DefinedType_PREFIX,
+			Name:        "d1",
+			Prefixes:    []*api.Prefix{{IpPrefix: "10.0.2.0/24", MaskLengthMin: 24, MaskLengthMax: 24}},
+		},
+	})
+	assert.NoError(err)
+	err = s.AddPolicy(ctx, &api.AddPolicyRequest{
+		Policy: &api.Policy{
+			Name: "p1",
+			Statements: []*api.Statement{{
+				Name:       "s1",
+				Conditions: &api.Conditions{PrefixSet: &api.MatchSet{Name: "d1"}},
+				Actions:    &api.Actions{RouteAction: api.RouteAction_REJECT},
+			}},
+		},
+	})
+	assert.NoError(err)
+
+	// the routes of vrf1 except the ones rejected by the policy
+	assert.Error(s.AddVrfLeak(ctx, &api.AddVrfLeakRequest{
+		Leak: &api.VrfLeak{Name: "l1", SourceVrf: "vrf1", DestinationVrf: "vrf3"},
+	}))
+	assert.Error(s.AddVrfLeak(ctx, &api.AddVrfLeakRequest{
+		Leak: &api.VrfLeak{Name: "l1", SourceVrf: "vrf1", DestinationVrf: "vrf2", Policies: []string{"p2"}},
+	}))
+	err = s.AddVrfLeak(ctx, &api.AddVrfLeakRequest{
+		Leak: &api.VrfLeak{Name: "l1", SourceVrf: "vrf1", DestinationVrf: "vrf2", Policies: []string{"p1"}},
+	})
+	assert.NoError(err)
+	assert.Equal([]string{"100:2:10.0.1.0/24"}, prefixes("vrf2"))
+	assert.Equal(uint32(1), leaked("l1"))
+
+	// from vrf1 to the global RIB
+	err = s.AddVrfLeak(ctx, &api.AddVrfLeakRequest{
+		Leak: &api.VrfLeak{Name: "l2", SourceVrf: "vrf1"},
+	})
+	assert.NoError(err)
+	assert.Equal([]string{"10.0.1.0/24", "10.0.2.0/24", "10.9.0.0/24"}, prefixes(""))
+
+	// the routes leaked into the global RIB aren't leaked again
+	err = s.AddVrfLeak(ctx, &api.AddVrfLeakRequest{
+		Leak: &api.VrfLeak{Name: "l3", DestinationVrf: "vrf2"},
+	})
+	assert.NoError(err)
+	assert.Equal([]string{"100:2:10.0.1.0/24", "100:2:10.9.0.0/24"}, prefixes("vrf2"))
+	assert.Equal(uint32(1), leaked("l3"))
+
+	// the leaked routes are withdrawn with the original one
+	err = s.DeletePath(ctx, &api.DeletePathRequest{
+		TableType: api.TableType_VRF,
+		VrfId:     "vrf1",
+		Uuid:      uuid,
+	})
+	assert.NoError(err)
+	assert.Equal([]string{"100:2:10.9.0.0/24"}, prefixes("vrf2"))
+	assert.Equal([]string{"10.0.2.0/24", "10.9.0.0/24"}, prefixes(""))
+
+	// and with the leak
+	assert.Error(s.DeleteVrf(ctx, &api.DeleteVrfRequest{Name: "vrf2"}))
+	assert.NoError(s.DeleteVrfLeak(ctx, &api.DeleteVrfLeakRequest{Name: "l3"}))
+	assert.NoError(s.DeleteVrfLeak(ctx, &api.DeleteVrfLeakRequest{Name: "l2"}))
+	assert.Error(s.DeleteVrfLeak(ctx, &api.DeleteVrfLeakRequest{Name: "l2"}))
+	assert.Equal([]string{}, prefixes("vrf2"))
+	assert.Equal([]string{"10.9.0.0/24"}, prefixes(""))
+	assert.NoError(s.DeleteVrfLeak(ctx, &api.DeleteVrfLeakRequest{Name: "l1"}))
+	assert.NoError(s.DeleteVrf(ctx, &api.DeleteVrfRequest{Name: "vrf2"}))
+}
@@ -0,0 +1,116 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/log"
+)
+
+// pathLifetime is the lifetime of the path added by AddPath, after which
+// the path is withdrawn automatically, e.g. for the announcements of the
+// DDoS mitigation.
+type pathLifetime struct {
+	path   *table.Path
+	expire time.Time
+	timer  *time.Timer
+}
+
+// setPathLifetime starts the lifetime of the path of the key, or stops the
+// one started before if d is zero.
+func (s *BgpServer) setPathLifetime(key string, path *table.Path, d time.Duration) {
+	if l, y := s.pathLifetimes[key]; y {
+		l.timer.Stop()
+		delete(s.pathLifetimes, key)
+	}
+	if d == 0 {
+		return
+	}
+	l := &pathLifetime{
+		path:   path,
+		expire: time.Now().Add(d),
+	}
+	l.timer = time.AfterFunc(d, func() {
+		s.mgmtOperation(func() error {
+			s.expirePath(key, l)
+			return nil
+		}, false)
+	})
+	s.pathLifetimes[key] = l
+}
+
+// expirePath withdraws the path whose lifetime l has expired unless it is
+// deleted or added again since.
+func (s *BgpServer) expirePath(key string, l *pathLifetime) {
+	if s.pathLifetimes[key] != l {
+		return
+	}
+	delete(s.pathLifetimes, key)
+	delete(s.uuidMap, key)
+	s.logger.Info("path lifetime expired",
+		log.Fields{
+			"Topic": "Operation",
+			"Key":   l.path.GetPrefix()})
+	s.propagateUpdate(nil, []*table.Path{l.path.Clone(true)})
+}
+
+func (s *BgpServer) stopPathLifetimes() {
+	for _, l := range s.pathLifetimes {
+		l.timer.Stop()
+	}
+	s.pathLifetimes = make(map[string]*pathLifetime)
+}
+
+// pathExpirations returns the times when the paths of the keys are
+// withdrawn.
+func (s *BgpServer) pathExpirations() map[string]time.Time {
+	m := make(map[string]time.Time, len(s.pathLifetimes))
+	for key, l := range s.pathLifetimes {
+		m[key] = l.expire
+	}
+	return m
+}
+
+func (s *BgpServer) ExtendPathLifetime(ctx context.Context, r *api.ExtendPathLifetimeRequest) error {
+	if r == nil || len(r.Uuid) == 0 {
+		return fmt.Errorf("nil request")
+	}
+	id, err := uuid.FromBytes(r.Uuid)
+	if err != nil {
+		return err
+	}
+	return s.mgmtOperation(func() error {
+		for key, v := range s.uuidMap {
+			if v != id {
+				continue
+			}
+			l, y := s.pathLifetimes[key]
+			if !y {
+				return fmt.Errorf("the specified path doesn't have lifetime")
+			}
+			s.setPathLifetime(key, l.path, time.Duration(r.Lifetime)*time.Second)
+			return nil
+		}
+		return fmt.Errorf("can't find a specified path")
+	}, true)
+}
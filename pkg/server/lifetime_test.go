@@ -0,0 +1,86 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apb "google.golang.org/protobuf/types/known/anypb"
+
+	api "github.com/osrg/gobgp/v3/api"
+)
+
+func TestPathLifetime(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s := runNewServer(t, 1, "1.1.1.1", -1)
+	defer s.StopBgp(ctx, &api.StopBgpRequest{})
+
+	family := &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST}
+	origin, _ := apb.New(&api.OriginAttribute{Origin: 0})
+	nh, _ := apb.New(&api.NextHopAttribute{NextHop: "10.0.0.1"})
+	addPath := func(prefix string, lifetime uint32) []byte {
+		nlri, _ := apb.New(&api.IPAddressPrefix{Prefix: prefix, PrefixLen: 24})
+		r, err := s.AddPath(ctx, &api.AddPathRequest{
+			Path:     &api.Path{Family: family, Nlri: nlri, Pattrs: []*apb.Any{origin, nh}},
+			Lifetime: lifetime,
+		})
+		assert.Nil(err)
+		return r.Uuid
+	}
+	remaining := func() map[string]uint32 {
+		m := make(map[string]uint32)
+		err := s.ListPath(ctx, &api.ListPathRequest{TableType: api.TableType_GLOBAL, Family: family}, func(d *api.Destination) {
+			m[d.Prefix] = d.Paths[0].RemainingLifetime
+		})
+		assert.Nil(err)
+		return m
+	}
+
+	id := addPath("10.1.0.0", 60)
+	forever := addPath("10.2.0.0", 0)
+	m := remaining()
+	assert.Equal(2, len(m))
+	assert.True(m["10.1.0.0/24"] > 50 && m["10.1.0.0/24"] <= 60)
+	assert.Equal(uint32(0), m["10.2.0.0/24"])
+
+	assert.NotNil(s.ExtendPathLifetime(ctx, &api.ExtendPathLifetimeRequest{Uuid: forever, Lifetime: 60}))
+
+	assert.Nil(s.ExtendPathLifetime(ctx, &api.ExtendPathLifetimeRequest{Uuid: id, Lifetime: 600}))
+	m = remaining()
+	assert.True(m["10.1.0.0/24"] > 590 && m["10.1.0.0/24"] <= 600)
+
+	// withdrawn after the lifetime
+	assert.Nil(s.ExtendPathLifetime(ctx, &api.ExtendPathLifetimeRequest{Uuid: id, Lifetime: 1}))
+	assert.Eventually(func() bool {
+		return len(remaining()) == 1
+	}, 5*time.Second, 100*time.Millisecond)
+	_, ok := remaining()["10.2.0.0/24"]
+	assert.True(ok)
+	assert.NotNil(s.ExtendPathLifetime(ctx, &api.ExtendPathLifetimeRequest{Uuid: id, Lifetime: 60}))
+
+	// the lifetime is stopped when the path is deleted
+	id = addPath("10.3.0.0", 1)
+	assert.Nil(s.DeletePath(ctx, &api.DeletePathRequest{TableType: api.TableType_GLOBAL, Family: family, Uuid: id}))
+	addPath("10.3.0.0", 0)
+	time.Sleep(1500 * time.Millisecond)
+	_, ok = remaining()["10.3.0.0/24"]
+	assert.True(ok)
+}
@@ -0,0 +1,203 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+// mraiQueue implements the MinRouteAdvertisementInterval (RFC 4271 9.2.1.1)
+// of a peer per family. The paths are advertised at once when the interval
+// of the family isn't running, which starts it. The paths advertised while
+// it runs are held and only the last path for each NLRI is kept, then sent
+// together when it expires, which starts the next one. The withdrawals
+// aren't delayed to converge fast, and cancel the held path for the NLRI.
+type mraiQueue struct {
+	mu       sync.Mutex
+	families map[bgp.RouteFamily]*mraiFamily
+	// incremented by reset to ignore the timers which already fired
+	generation uint64
+}
+
+type mraiFamily struct {
+	interval time.Duration
+	// non-nil while the interval runs
+	timer *time.Timer
+	// the held paths, nil if cancelled by the withdrawal
+	paths []*table.Path
+	// the index of the path in paths for the key of the NLRI
+	index map[string]int
+	// the number of the paths replaced or cancelled before advertised
+	coalesced uint64
+}
+
+func newMraiQueue() *mraiQueue {
+	return &mraiQueue{
+		families: make(map[bgp.RouteFamily]*mraiFamily),
+	}
+}
+
+func mraiDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// add holds the paths of the families whose interval is running, and
+// returns the others to be sent now.
+func (q *mraiQueue) add(peer *peer, paths []*table.Path) []*table.Path {
+	fsm := peer.fsm
+	fsm.lock.RLock()
+	options := fsm.marshallingOptions
+	intervals := make(map[bgp.RouteFamily]time.Duration, len(fsm.pConf.AfiSafis))
+	for _, af := range fsm.pConf.AfiSafis {
+		if d := mraiDuration(af.MinimumAdvertisementInterval.State.Interval); d > 0 {
+			intervals[af.State.Family] = d
+		}
+	}
+	fsm.lock.RUnlock()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(intervals) == 0 && len(q.families) == 0 {
+		return paths
+	}
+	started := make(map[bgp.RouteFamily]struct{})
+	l := make([]*table.Path, 0, len(paths))
+	for _, path := range paths {
+		family := path.GetRouteFamily()
+		interval, enabled := intervals[family]
+		f := q.families[family]
+		if f == nil {
+			if !enabled {
+				l = append(l, path)
+				continue
+			}
+			f = &mraiFamily{}
+			q.families[family] = f
+		}
+		// the interval disabled while running still holds the paths
+		// until it expires
+		f.interval = interval
+		if f.timer == nil && enabled {
+			started[family] = struct{}{}
+		}
+		if _, ok := started[family]; ok || f.timer == nil {
+			// the paths of the same call are sent together
+			l = append(l, path)
+			continue
+		}
+		switch {
+		case path.IsEOR():
+			if len(f.index) > 0 {
+				f.paths = append(f.paths, path)
+			} else {
+				l = append(l, path)
+			}
+		case path.IsWithdraw:
+			key := outgoingPathKey(path, options)
+			if i, ok := f.index[key]; ok {
+				f.paths[i] = nil
+				delete(f.index, key)
+				f.coalesced++
+			}
+			l = append(l, path)
+		default:
+			key := outgoingPathKey(path, options)
+			if i, ok := f.index[key]; ok {
+				f.paths[i] = path
+				f.coalesced++
+				continue
+			}
+			if f.index == nil {
+				f.index = make(map[string]int)
+			}
+			f.index[key] = len(f.paths)
+			f.paths = append(f.paths, path)
+		}
+	}
+	for family := range started {
+		q.startTimer(peer, family, q.families[family])
+	}
+	return l
+}
+
+func (q *mraiQueue) startTimer(peer *peer, family bgp.RouteFamily, f *mraiFamily) {
+	generation := q.generation
+	f.timer = time.AfterFunc(f.interval, func() {
+		q.expire(peer, family, generation)
+	})
+}
+
+// expire sends the paths held while the interval of the family ran.
+func (q *mraiQueue) expire(peer *peer, family bgp.RouteFamily, generation uint64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	f, ok := q.families[family]
+	if !ok || generation != q.generation {
+		return
+	}
+	paths := make([]*table.Path, 0, len(f.paths))
+	for _, path := range f.paths {
+		if path != nil {
+			paths = append(paths, path)
+		}
+	}
+	f.paths = nil
+	f.index = nil
+	if len(paths) == 0 || f.interval == 0 {
+		f.timer = nil
+	} else {
+		q.startTimer(peer, family, f)
+	}
+	if len(paths) == 0 {
+		return
+	}
+	// under the lock not to be overtaken by the paths sent after the
+	// next expiration, or be sent after reset
+	if !peer.slowQueue.add(peer, paths) {
+		peer.fsm.outgoingCh.In() <- &fsmOutgoingMsg{Paths: paths}
+	}
+}
+
+// reset discards the held paths and stops the timers, before the
+// outgoing queue is cleaned. The counters are kept.
+func (q *mraiQueue) reset() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.generation++
+	for _, f := range q.families {
+		if f.timer != nil {
+			f.timer.Stop()
+			f.timer = nil
+		}
+		f.paths = nil
+		f.index = nil
+	}
+}
+
+// coalesced returns the number of the paths of the family replaced or
+// cancelled before advertised.
+func (q *mraiQueue) coalesced(family bgp.RouteFamily) uint64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if f, ok := q.families[family]; ok {
+		return f.coalesced
+	}
+	return 0
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func TestMraiQueue(t *testing.T) {
+	assert := assert.New(t)
+
+	rib := table.NewTableManager(logger, []bgp.RouteFamily{bgp.RF_IPv4_UC, bgp.RF_IPv6_UC})
+	p, _ := newPeerandInfo(65000, 65001, "192.168.0.1", rib)
+	p.fsm.pConf.AfiSafis[0].MinimumAdvertisementInterval.State.Interval = 0.2
+	defer p.mrai.reset()
+
+	newPath := func(prefix string, med uint32, withdraw bool) *table.Path {
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop("10.0.0.1"),
+			bgp.NewPathAttributeMultiExitDisc(med),
+		}
+		return table.NewPath(&table.PeerInfo{}, bgp.NewIPAddrPrefix(24, prefix), withdraw, attrs, time.Now(), false)
+	}
+	received := func() []*table.Path {
+		select {
+		case m := <-p.fsm.outgoingCh.Out():
+			return m.(*fsmOutgoingMsg).Paths
+		case <-time.After(time.Second):
+			return nil
+		}
+	}
+
+	// sent at once and starts the interval
+	p1 := newPath("10.1.0.0", 1, false)
+	sendfsmOutgoingMsg(p, []*table.Path{p1}, nil, false)
+	assert.Equal([]*table.Path{p1}, received())
+
+	start := time.Now()
+	p2 := newPath("10.1.0.0", 2, false)
+	p3 := newPath("10.1.0.0", 3, false)
+	p4 := newPath("10.2.0.0", 4, false)
+	w4 := newPath("10.2.0.0", 4, true)
+	w5 := newPath("10.3.0.0", 5, true)
+	sendfsmOutgoingMsg(p, []*table.Path{p2, p4}, nil, false)
+	sendfsmOutgoingMsg(p, []*table.Path{p3}, nil, false)
+	// the withdrawals aren't delayed and cancel the held path
+	sendfsmOutgoingMsg(p, []*table.Path{w4, w5}, nil, false)
+	assert.Equal([]*table.Path{w4, w5}, received())
+	// the family without the interval
+	nlri := bgp.NewIPv6AddrPrefix(64, "2001:db8::")
+	p6 := table.NewPath(&table.PeerInfo{}, nlri, false, []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeMpReachNLRI("2001:db8::1", []bgp.AddrPrefixInterface{nlri}),
+	}, time.Now(), false)
+	sendfsmOutgoingMsg(p, []*table.Path{p6}, nil, false)
+	assert.Equal([]*table.Path{p6}, received())
+
+	eor := table.NewEOR(bgp.RF_IPv4_UC)
+	sendfsmOutgoingMsg(p, []*table.Path{eor}, nil, false)
+	assert.Equal([]*table.Path{p3, eor}, received())
+	assert.GreaterOrEqual(time.Since(start), 150*time.Millisecond)
+	assert.Equal(uint64(2), p.mrai.coalesced(bgp.RF_IPv4_UC))
+
+	// the next interval expires without paths
+	time.Sleep(300 * time.Millisecond)
+	p.mrai.mu.Lock()
+	assert.Nil(p.mrai.families[bgp.RF_IPv4_UC].timer)
+	p.mrai.mu.Unlock()
+	sendfsmOutgoingMsg(p, []*table.Path{p1}, nil, false)
+	assert.Equal([]*table.Path{p1}, received())
+
+	// reset discards the held paths
+	sendfsmOutgoingMsg(p, []*table.Path{p2}, nil, false)
+	p.mrai.reset()
+	assert.Nil(received())
+	sendfsmOutgoingMsg(p, []*table.Path{p3}, nil, false)
+	assert.Equal([]*table.Path{p3}, received())
+}
@@ -18,7 +18,9 @@ package server
 import (
 	"bytes"
 	"fmt"
+	"net"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/osrg/gobgp/v3/internal/pkg/table"
@@ -31,13 +33,131 @@ import (
 const (
 	minRotationInterval = 60
 	minDumpInterval     = 60
+	// number of the writes queued for a stream client; the client is
+	// disconnected when it doesn't read them in time
+	mrtStreamQueueSize = 1024
 )
 
+// mrtStream sends the MRT messages to the clients connected to the
+// stream address as they are generated.
+type mrtStream struct {
+	logger   log.Logger
+	address  string
+	listener net.Listener
+	mu       sync.Mutex
+	clients  map[net.Conn]chan []byte
+	closed   bool
+}
+
+func newMrtStream(logger log.Logger, address string) (*mrtStream, error) {
+	network, addr := parseHost(address)
+	if network == "unix" {
+		// remove the socket left by the previous run
+		if fi, err := os.Stat(addr); err == nil && fi.Mode()&os.ModeSocket != 0 {
+			os.Remove(addr)
+		}
+	}
+	l, err := net.Listen(network, addr)
+	if err != nil {
+		return nil, err
+	}
+	st := &mrtStream{
+		logger:   logger,
+		address:  address,
+		listener: l,
+		clients:  make(map[net.Conn]chan []byte),
+	}
+	go st.accept()
+	return st, nil
+}
+
+func (st *mrtStream) accept() {
+	for {
+		conn, err := st.listener.Accept()
+		if err != nil {
+			return
+		}
+		st.logger.Info("MRT stream client connected",
+			log.Fields{
+				"Topic":  "mrt",
+				"Key":    st.address,
+				"Client": conn.RemoteAddr().String()})
+		ch := make(chan []byte, mrtStreamQueueSize)
+		st.mu.Lock()
+		if st.closed {
+			st.mu.Unlock()
+			conn.Close()
+			return
+		}
+		st.clients[conn] = ch
+		st.mu.Unlock()
+		go func() {
+			defer conn.Close()
+			for buf := range ch {
+				if _, err := conn.Write(buf); err != nil {
+					st.logger.Info("MRT stream client disconnected",
+						log.Fields{
+							"Topic":  "mrt",
+							"Key":    st.address,
+							"Client": conn.RemoteAddr().String(),
+							"Error":  err})
+					st.remove(conn)
+					return
+				}
+			}
+		}()
+	}
+}
+
+func (st *mrtStream) remove(conn net.Conn) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if ch, ok := st.clients[conn]; ok {
+		delete(st.clients, conn)
+		close(ch)
+	}
+}
+
+func (st *mrtStream) write(buf []byte) {
+	// the buffer is shared by the clients and reused by the caller
+	buf = append([]byte(nil), buf...)
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	for conn, ch := range st.clients {
+		select {
+		case ch <- buf:
+		default:
+			st.logger.Warn("MRT stream client doesn't read messages, disconnecting it",
+				log.Fields{
+					"Topic":  "mrt",
+					"Key":    st.address,
+					"Client": conn.RemoteAddr().String()})
+			delete(st.clients, conn)
+			close(ch)
+			conn.Close()
+		}
+	}
+}
+
+// close stops accepting clients; the messages already queued are
+// written before the clients are disconnected.
+func (st *mrtStream) close() {
+	st.listener.Close()
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.closed = true
+	for conn, ch := range st.clients {
+		delete(st.clients, conn)
+		close(ch)
+	}
+}
+
 type mrtWriter struct {
 	dead             chan struct{}
 	s                *BgpServer
 	c                *oc.MrtConfig
 	file             *os.File
+	stream           *mrtStream
 	rotationInterval uint64
 	dumpInterval     uint64
 }
@@ -74,6 +194,9 @@ func (m *mrtWriter) loop() error {
 		if m.file != nil {
 			m.file.Close()
 		}
+		if m.stream != nil {
+			m.stream.close()
+		}
 		if m.rotationInterval != 0 {
 			rotator.Stop()
 		}
@@ -218,6 +341,10 @@ func (m *mrtWriter) loop() error {
 			}
 
 			w := func(buf []byte) {
+				if m.stream != nil {
+					m.stream.write(buf)
+					return
+				}
 				if _, err := m.file.Write(buf); err == nil {
 					m.file.Sync()
 				} else {
@@ -327,17 +454,22 @@ func mrtFileOpen(logger log.Logger, filename string, rInterval uint64) (*os.File
 }
 
 func newMrtWriter(s *BgpServer, c *oc.MrtConfig, rInterval, dInterval uint64) (*mrtWriter, error) {
-	file, err := mrtFileOpen(s.logger, c.FileName, rInterval)
-	if err != nil {
-		return nil, err
-	}
 	m := mrtWriter{
+		dead:             make(chan struct{}),
 		s:                s,
 		c:                c,
-		file:             file,
 		rotationInterval: rInterval,
 		dumpInterval:     dInterval,
 	}
+	var err error
+	if len(c.StreamAddress) > 0 {
+		m.stream, err = newMrtStream(s.logger, c.StreamAddress)
+	} else {
+		m.file, err = mrtFileOpen(s.logger, c.FileName, rInterval)
+	}
+	if err != nil {
+		return nil, err
+	}
 	go m.loop()
 	return &m, nil
 }
@@ -347,9 +479,27 @@ type mrtManager struct {
 	writer    map[string]*mrtWriter
 }
 
+// mrtKey returns the file name or the stream address identifying the
+// MRT dump.
+func mrtKey(c *oc.MrtConfig) string {
+	if len(c.StreamAddress) > 0 {
+		return c.StreamAddress
+	}
+	return c.FileName
+}
+
 func (m *mrtManager) enable(c *oc.MrtConfig) error {
-	if _, ok := m.writer[c.FileName]; ok {
-		return fmt.Errorf("%s already exists", c.FileName)
+	key := mrtKey(c)
+	if _, ok := m.writer[key]; ok {
+		return fmt.Errorf("%s already exists", key)
+	}
+	if len(c.StreamAddress) > 0 {
+		if len(c.FileName) > 0 {
+			return fmt.Errorf("can't specify both the file name and the stream address")
+		}
+		if c.DumpType != oc.MRT_TYPE_UPDATES {
+			return fmt.Errorf("the stream address can be used only with the update dump type")
+		}
 	}
 
 	rInterval := c.RotationInterval
@@ -385,23 +535,29 @@ func (m *mrtManager) enable(c *oc.MrtConfig) error {
 		if len(c.TableName) > 0 {
 			return fmt.Errorf("can't specify the table name with the update dump type")
 		}
-		setRotationMin()
+		if len(c.StreamAddress) > 0 {
+			// nothing to rotate
+			rInterval = 0
+		} else {
+			setRotationMin()
+		}
 	}
 
 	w, err := newMrtWriter(m.bgpServer, c, rInterval, dInterval)
 	if err == nil {
-		m.writer[c.FileName] = w
+		m.writer[key] = w
 	}
 	return err
 }
 
 func (m *mrtManager) disable(c *oc.MrtConfig) error {
-	w, ok := m.writer[c.FileName]
+	key := mrtKey(c)
+	w, ok := m.writer[key]
 	if !ok {
-		return fmt.Errorf("%s doesn't exists", c.FileName)
+		return fmt.Errorf("%s doesn't exists", key)
 	}
 	w.Stop()
-	delete(m.writer, c.FileName)
+	delete(m.writer, key)
 	return nil
 }
 
@@ -0,0 +1,106 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"io"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/apiutil"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+	"github.com/osrg/gobgp/v3/pkg/packet/mrt"
+)
+
+func readMrtMessage(t *testing.T, conn net.Conn) *mrt.MRTMessage {
+	conn.SetReadDeadline(time.Now().Add(30 * time.Second))
+	buf := make([]byte, mrt.MRT_COMMON_HEADER_LEN)
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		t.Fatal(err)
+	}
+	h := &mrt.MRTHeader{}
+	if err := h.DecodeFromBytes(buf); err != nil {
+		t.Fatal(err)
+	}
+	buf = make([]byte, h.Len)
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		t.Fatal(err)
+	}
+	msg, err := mrt.ParseMRTBody(h, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return msg
+}
+
+func TestMrtStream(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s1 := runNewServer(t, 1, "1.1.1.1", 10189)
+	defer s1.StopBgp(ctx, &api.StopBgpRequest{})
+	s2 := runNewServer(t, 2, "2.2.2.2", 20189)
+	defer s2.StopBgp(ctx, &api.StopBgpRequest{})
+
+	// only the update dump can be streamed
+	sock := filepath.Join(t.TempDir(), "mrt.sock")
+	assert.Error(s1.EnableMrt(ctx, &api.EnableMrtRequest{
+		Type:          api.EnableMrtRequest_TABLE,
+		StreamAddress: "unix://" + sock,
+	}))
+	assert.NoError(s1.EnableMrt(ctx, &api.EnableMrtRequest{
+		Type:          api.EnableMrtRequest_UPDATES,
+		StreamAddress: "unix://" + sock,
+	}))
+	conn, err := net.Dial("unix", sock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if err := peerServers(t, ctx, []*BgpServer{s1, s2}, []oc.AfiSafiType{oc.AFI_SAFI_TYPE_IPV4_UNICAST}); err != nil {
+		t.Fatal(err)
+	}
+	path, _ := apiutil.NewPath(bgp.NewIPAddrPrefix(24, "10.0.0.0"), false, []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeNextHop("2.2.2.2"),
+	}, time.Now())
+	_, err = s2.AddPath(ctx, &api.AddPathRequest{
+		TableType: api.TableType_GLOBAL,
+		Path:      path,
+	})
+	assert.NoError(err)
+
+	msg := readMrtMessage(t, conn)
+	assert.Equal(mrt.BGP4MP, msg.Header.Type)
+	body := msg.Body.(*mrt.BGP4MPMessage)
+	assert.Equal(uint32(2), body.PeerAS)
+	update := body.BGPMessage.Body.(*bgp.BGPUpdate)
+	assert.Equal("10.0.0.0/24", update.NLRI[0].String())
+
+	// the clients are disconnected
+	assert.NoError(s1.DisableMrt(ctx, &api.DisableMrtRequest{StreamAddress: "unix://" + sock}))
+	conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	_, err = conn.Read(make([]byte, 1))
+	assert.Equal(io.EOF, err)
+}
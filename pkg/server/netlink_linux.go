@@ -0,0 +1,341 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//go:build linux
+// +build linux
+
+package server
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/log"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+const defaultNetlinkReconcileTime = 60 * time.Second
+
+// netlinkClient installs the best paths of the global RIB into a Linux
+// routing table without going through zebra.
+type netlinkClient struct {
+	server        *BgpServer
+	families      map[bgp.RouteFamily]bool
+	tableID       int
+	protocol      netlink.RouteProtocol
+	metric        int
+	reconcileTime time.Duration
+	// mu protects the fields below, which are touched both by loop() and
+	// by stop() running in the management goroutine.
+	mu        sync.Mutex
+	installed map[string]*netlink.Route
+	stale     map[string]*netlink.Route
+	// routing tables of the Linux VRF devices keyed by the neighbor
+	// address; the routes learned from the neighbors bound to VRFs are
+	// installed there instead of tableID.
+	peerTables map[string]int
+	closed     bool
+	dead       chan struct{}
+}
+
+func netlinkFamily(rf bgp.RouteFamily) int {
+	if rf == bgp.RF_IPv6_UC {
+		return netlink.FAMILY_V6
+	}
+	return netlink.FAMILY_V4
+}
+
+// netlinkRouteKey returns the key of the destination of the route r. The
+// kernel reports the default route without Dst.
+func netlinkRouteKey(r *netlink.Route, family int) string {
+	if r.Dst != nil {
+		return r.Dst.String()
+	}
+	if family == netlink.FAMILY_V6 {
+		return "::/0"
+	}
+	return "0.0.0.0/0"
+}
+
+// newNetlinkRoute returns the destination of paths and the route to be
+// installed for it. The route is nil when the destination is withdrawn or
+// none of the paths has a nexthop usable in the kernel.
+func newNetlinkRoute(paths []*table.Path, tableID int, protocol netlink.RouteProtocol, metric int) (*net.IPNet, *netlink.Route) {
+	if len(paths) == 0 || paths[0] == nil {
+		return nil, nil
+	}
+	_, dst, err := net.ParseCIDR(paths[0].GetNlri().String())
+	if err != nil {
+		return nil, nil
+	}
+	if paths[0].IsWithdraw {
+		return dst, nil
+	}
+
+	nexthops := make([]*netlink.NexthopInfo, 0, len(paths))
+	usable := make([]*table.Path, 0, len(paths))
+	for _, path := range paths {
+		// Here filters out:
+		// - Nil path and withdrawn path
+		// - External path (advertised from Zebra)
+		// - Locally originated path which has no nexthop to forward to
+		// - Unreachable path because invalidated by Zebra
+		if path == nil || path.IsWithdraw || path.IsFromExternal() || path.IsNexthopInvalid {
+			continue
+		}
+		nexthop := path.GetNexthop()
+		if nexthop == nil || nexthop.IsUnspecified() {
+			continue
+		}
+		nh := &netlink.NexthopInfo{Gw: nexthop}
+		if dst.IP.To4() != nil && nexthop.To4() == nil {
+			// RFC 8950: IPv4 route with an IPv6 nexthop.
+			nh = &netlink.NexthopInfo{Via: &netlink.Via{AddrFamily: netlink.FAMILY_V6, Addr: nexthop}}
+		}
+		nexthops = append(nexthops, nh)
+		usable = append(usable, path)
+	}
+	if len(nexthops) == 0 {
+		return dst, nil
+	}
+	// the weight of a nexthop is rtnh_hops + 1
+	if weights := nexthopWeights(usable); weights != nil {
+		for i, nh := range nexthops {
+			nh.Hops = int(weights[i] - 1)
+		}
+	}
+
+	r := &netlink.Route{
+		Dst:      dst,
+		Table:    tableID,
+		Protocol: protocol,
+		Priority: metric,
+	}
+	if len(nexthops) == 1 {
+		r.Gw = nexthops[0].Gw
+		r.Via = nexthops[0].Via
+	} else {
+		r.MultiPath = nexthops
+	}
+	return dst, r
+}
+
+func newNetlinkClient(s *BgpServer, families []bgp.RouteFamily, tableID, protocol, metric int, reconcileTime time.Duration) (*netlinkClient, error) {
+	if tableID == 0 {
+		tableID = unix.RT_TABLE_MAIN
+	}
+	if protocol == 0 {
+		protocol = unix.RTPROT_BGP
+	}
+	if protocol > 255 {
+		return nil, fmt.Errorf("invalid route protocol: %d", protocol)
+	}
+	if reconcileTime == 0 {
+		reconcileTime = defaultNetlinkReconcileTime
+	}
+	n := &netlinkClient{
+		server:        s,
+		families:      make(map[bgp.RouteFamily]bool, len(families)),
+		tableID:       tableID,
+		protocol:      netlink.RouteProtocol(protocol),
+		metric:        metric,
+		reconcileTime: reconcileTime,
+		installed:     make(map[string]*netlink.Route),
+		stale:         make(map[string]*netlink.Route),
+		peerTables:    make(map[string]int),
+		dead:          make(chan struct{}),
+	}
+	for _, rf := range families {
+		if rf != bgp.RF_IPv4_UC && rf != bgp.RF_IPv6_UC {
+			return nil, fmt.Errorf("unsupported family for netlink: %s", rf)
+		}
+		n.families[rf] = true
+	}
+
+	// Routes with our protocol number in the table are left over by a
+	// previous run. They are kept until they are reinstalled or the
+	// reconcile time expires so that forwarding isn't interrupted while
+	// the sessions come back.
+	for rf := range n.families {
+		family := netlinkFamily(rf)
+		routes, err := netlink.RouteListFiltered(family, &netlink.Route{
+			Table:    n.tableID,
+			Protocol: n.protocol,
+		}, netlink.RT_FILTER_TABLE|netlink.RT_FILTER_PROTOCOL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list routes: %v", err)
+		}
+		for i := range routes {
+			n.stale[netlinkRouteKey(&routes[i], family)] = &routes[i]
+		}
+	}
+	if len(n.stale) > 0 {
+		s.logger.Info("found stale routes in the routing table",
+			log.Fields{
+				"Topic": "Netlink",
+				"Table": n.tableID,
+				"Count": len(n.stale)})
+	}
+
+	go n.loop()
+	return n, nil
+}
+
+// setPeerVrf makes the routes learned from the neighbor addr installed into
+// the routing table of the Linux VRF device vrf, or into the default table
+// if vrf is empty.
+func (n *netlinkClient) setPeerVrf(addr, vrf string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if vrf == "" {
+		delete(n.peerTables, addr)
+		return nil
+	}
+	link, err := netlink.LinkByName(vrf)
+	if err != nil {
+		return err
+	}
+	v, ok := link.(*netlink.Vrf)
+	if !ok {
+		return fmt.Errorf("%s isn't a VRF device", vrf)
+	}
+	n.peerTables[addr] = int(v.Table)
+	return nil
+}
+
+func (n *netlinkClient) pathTable(path *table.Path) int {
+	if src := path.GetSource(); src != nil && src.Address != nil {
+		if id, ok := n.peerTables[src.Address.String()]; ok {
+			return id
+		}
+	}
+	return n.tableID
+}
+
+func (n *netlinkClient) update(paths []*table.Path) {
+	if len(paths) == 0 || paths[0] == nil || !n.families[paths[0].GetRouteFamily()] {
+		return
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.closed {
+		return
+	}
+	dst, r := newNetlinkRoute(paths, n.pathTable(paths[0]), n.protocol, n.metric)
+	if dst == nil {
+		return
+	}
+	key := dst.String()
+	if r == nil {
+		old, ok := n.installed[key]
+		if !ok {
+			old, ok = n.stale[key]
+		}
+		if ok {
+			n.delete(key, old)
+		}
+		return
+	}
+	// the best path moved to a neighbor in another VRF
+	for _, m := range []map[string]*netlink.Route{n.installed, n.stale} {
+		if old, ok := m[key]; ok && old.Table != r.Table {
+			n.delete(key, old)
+		}
+	}
+	if err := netlink.RouteReplace(r); err != nil {
+		n.server.logger.Warn("failed to install route",
+			log.Fields{
+				"Topic": "Netlink",
+				"Key":   key,
+				"Error": err})
+		return
+	}
+	n.installed[key] = r
+	delete(n.stale, key)
+}
+
+func (n *netlinkClient) delete(key string, r *netlink.Route) {
+	if err := netlink.RouteDel(r); err != nil && err != unix.ESRCH {
+		n.server.logger.Warn("failed to delete route",
+			log.Fields{
+				"Topic": "Netlink",
+				"Key":   key,
+				"Error": err})
+	}
+	delete(n.installed, key)
+	delete(n.stale, key)
+}
+
+func (n *netlinkClient) deleteStaleRoutes() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.closed {
+		return
+	}
+	for key, r := range n.stale {
+		n.delete(key, r)
+	}
+}
+
+func (n *netlinkClient) loop() {
+	w := n.server.watch(watchBestPath(true))
+	defer w.Stop()
+
+	reconcile := time.NewTimer(n.reconcileTime)
+	defer reconcile.Stop()
+
+	for {
+		select {
+		case <-n.dead:
+			return
+		case <-reconcile.C:
+			n.deleteStaleRoutes()
+		case ev := <-w.Event():
+			msg, ok := ev.(*watchEventBestPath)
+			if !ok {
+				continue
+			}
+			if table.MultiPathEnabled() {
+				for _, paths := range msg.MultiPathList {
+					n.update(paths)
+				}
+			} else {
+				for _, path := range msg.PathList {
+					n.update([]*table.Path{path})
+				}
+			}
+		}
+	}
+}
+
+// stop removes all the routes installed by n from the routing table.
+func (n *netlinkClient) stop() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.closed {
+		return
+	}
+	for key, r := range n.installed {
+		n.delete(key, r)
+	}
+	n.closed = true
+	close(n.dead)
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//go:build linux
+// +build linux
+
+package server
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func Test_newNetlinkRoute(t *testing.T) {
+	assert := assert.New(t)
+
+	newPath := func(prefix, nexthop string, withdraw bool) *table.Path {
+		attrs := []bgp.PathAttributeInterface{bgp.NewPathAttributeOrigin(0)}
+		ip, ipNet, _ := net.ParseCIDR(prefix)
+		l, _ := ipNet.Mask.Size()
+		var nlri bgp.AddrPrefixInterface
+		if ip.To4() != nil && net.ParseIP(nexthop).To4() != nil {
+			nlri = bgp.NewIPAddrPrefix(uint8(l), ip.String())
+			attrs = append(attrs, bgp.NewPathAttributeNextHop(nexthop))
+		} else {
+			if ip.To4() != nil {
+				nlri = bgp.NewIPAddrPrefix(uint8(l), ip.String())
+			} else {
+				nlri = bgp.NewIPv6AddrPrefix(uint8(l), ip.String())
+			}
+			attrs = append(attrs, bgp.NewPathAttributeMpReachNLRI(nexthop, []bgp.AddrPrefixInterface{nlri}))
+		}
+		return table.NewPath(&table.PeerInfo{}, nlri, withdraw, attrs, time.Now(), false)
+	}
+
+	// single nexthop
+	dst, r := newNetlinkRoute([]*table.Path{newPath("10.0.0.0/24", "192.168.0.1", false)}, 100, 186, 20)
+	assert.Equal("10.0.0.0/24", dst.String())
+	assert.NotNil(r)
+	assert.Equal("192.168.0.1", r.Gw.String())
+	assert.Equal(100, r.Table)
+	assert.Equal(netlink.RouteProtocol(186), r.Protocol)
+	assert.Equal(20, r.Priority)
+	assert.Nil(r.MultiPath)
+
+	// multiple nexthops
+	dst, r = newNetlinkRoute([]*table.Path{
+		newPath("2001:db8::/32", "2001:db8:1::1", false),
+		newPath("2001:db8::/32", "2001:db8:1::2", false),
+	}, 254, 186, 0)
+	assert.Equal("2001:db8::/32", dst.String())
+	assert.NotNil(r)
+	assert.Nil(r.Gw)
+	assert.Len(r.MultiPath, 2)
+	assert.Equal("2001:db8:1::1", r.MultiPath[0].Gw.String())
+	assert.Equal("2001:db8:1::2", r.MultiPath[1].Gw.String())
+
+	// IPv4 route with an IPv6 nexthop
+	_, r = newNetlinkRoute([]*table.Path{newPath("10.0.0.0/24", "2001:db8:1::1", false)}, 254, 186, 0)
+	assert.NotNil(r)
+	assert.Nil(r.Gw)
+	assert.Equal(&netlink.Via{AddrFamily: netlink.FAMILY_V6, Addr: net.ParseIP("2001:db8:1::1")}, r.Via)
+
+	// withdrawal
+	dst, r = newNetlinkRoute([]*table.Path{newPath("10.0.0.0/24", "192.168.0.1", true)}, 254, 186, 0)
+	assert.Equal("10.0.0.0/24", dst.String())
+	assert.Nil(r)
+
+	// locally originated path isn't installed
+	dst, r = newNetlinkRoute([]*table.Path{newPath("10.0.0.0/24", "0.0.0.0", false)}, 254, 186, 0)
+	assert.Equal("10.0.0.0/24", dst.String())
+	assert.Nil(r)
+
+	// path from zebra isn't installed
+	p := newPath("10.0.0.0/24", "192.168.0.1", false)
+	p.SetIsFromExternal(true)
+	_, r = newNetlinkRoute([]*table.Path{p}, 254, 186, 0)
+	assert.Nil(r)
+}
+
+func Test_netlinkRouteKey(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("0.0.0.0/0", netlinkRouteKey(&netlink.Route{}, netlink.FAMILY_V4))
+	assert.Equal("::/0", netlinkRouteKey(&netlink.Route{}, netlink.FAMILY_V6))
+	_, dst, _ := net.ParseCIDR("10.0.0.0/8")
+	assert.Equal("10.0.0.0/8", netlinkRouteKey(&netlink.Route{Dst: dst}, netlink.FAMILY_V4))
+}
+
+func Test_netlinkPathTable(t *testing.T) {
+	assert := assert.New(t)
+	n := &netlinkClient{
+		tableID:    254,
+		peerTables: map[string]int{"192.168.0.1": 100},
+	}
+	newPath := func(peer string) *table.Path {
+		nlri := bgp.NewIPAddrPrefix(24, "10.0.0.0")
+		attrs := []bgp.PathAttributeInterface{bgp.NewPathAttributeOrigin(0), bgp.NewPathAttributeNextHop(peer)}
+		return table.NewPath(&table.PeerInfo{Address: net.ParseIP(peer)}, nlri, false, attrs, time.Now(), false)
+	}
+	assert.Equal(100, n.pathTable(newPath("192.168.0.1")))
+	assert.Equal(254, n.pathTable(newPath("192.168.0.2")))
+}
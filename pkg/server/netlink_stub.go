@@ -0,0 +1,37 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//go:build !linux
+// +build !linux
+
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+type netlinkClient struct{}
+
+func newNetlinkClient(s *BgpServer, families []bgp.RouteFamily, tableID, protocol, metric int, reconcileTime time.Duration) (*netlinkClient, error) {
+	return nil, fmt.Errorf("netlink is only supported on Linux")
+}
+
+func (n *netlinkClient) setPeerVrf(addr, vrf string) error {
+	return nil
+}
+
+func (n *netlinkClient) stop() {}
@@ -0,0 +1,61 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//go:build linux
+// +build linux
+
+package server
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/vishvananda/netns"
+)
+
+// runInNetns calls f in the named network namespace. Sockets created by f
+// stay in the namespace after it returns.
+func runInNetns(name string, f func() error) error {
+	if name == "" {
+		return f()
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		// The thread is discarded, not unlocked, if the original
+		// namespace can't be restored.
+		runtime.LockOSThread()
+		orig, err := netns.Get()
+		if err != nil {
+			errCh <- fmt.Errorf("failed to get the current network namespace: %w", err)
+			return
+		}
+		defer orig.Close()
+		ns, err := netns.GetFromName(name)
+		if err != nil {
+			errCh <- fmt.Errorf("failed to open network namespace %s: %w", name, err)
+			return
+		}
+		defer ns.Close()
+		if err := netns.Set(ns); err != nil {
+			errCh <- fmt.Errorf("failed to enter network namespace %s: %w", name, err)
+			return
+		}
+		err = f()
+		if netns.Set(orig) == nil {
+			runtime.UnlockOSThread()
+		}
+		errCh <- err
+	}()
+	return <-errCh
+}
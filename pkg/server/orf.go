@@ -0,0 +1,329 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/log"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+// prefixOrf is the list of the address prefix ORF entries (RFC 5292)
+// received from the peer for an address family, sorted by the sequence.
+type prefixOrf struct {
+	entries []*bgp.AddressPrefixORFEntry
+}
+
+func isSamePrefixOrfEntry(a, b *bgp.AddressPrefixORFEntry) bool {
+	return a.Sequence == b.Sequence && a.Length == b.Length && a.MinLen == b.MinLen && a.MaxLen == b.MaxLen && a.Prefix.Equal(b.Prefix)
+}
+
+func (o *prefixOrf) update(entries []*bgp.AddressPrefixORFEntry) {
+	for _, e := range entries {
+		switch e.Action {
+		case bgp.ORF_ACTION_REMOVE_ALL:
+			o.entries = nil
+		case bgp.ORF_ACTION_ADD, bgp.ORF_ACTION_REMOVE:
+			l := make([]*bgp.AddressPrefixORFEntry, 0, len(o.entries)+1)
+			for _, old := range o.entries {
+				if !isSamePrefixOrfEntry(old, e) {
+					l = append(l, old)
+				}
+			}
+			if e.Action == bgp.ORF_ACTION_ADD {
+				l = append(l, e)
+			}
+			o.entries = l
+		}
+	}
+	sort.SliceStable(o.entries, func(i, j int) bool {
+		return o.entries[i].Sequence < o.entries[j].Sequence
+	})
+}
+
+// matchPrefixOrfEntry returns true if the prefix is covered by the entry.
+// Following the prefix-list convention, zero minlen and maxlen match the
+// prefix length of the entry exactly and zero maxlen alone means up to
+// the host route.
+func matchPrefixOrfEntry(e *bgp.AddressPrefixORFEntry, prefix net.IP, length uint8) bool {
+	bits := uint8(len(e.Prefix) * 8)
+	if e.Length > length || e.Length > bits {
+		return false
+	}
+	mask := net.CIDRMask(int(e.Length), int(bits))
+	if !e.Prefix.Mask(mask).Equal(prefix.Mask(mask)) {
+		return false
+	}
+	min, max := e.MinLen, e.MaxLen
+	if min == 0 && max == 0 {
+		min, max = e.Length, e.Length
+	} else if max == 0 {
+		max = bits
+	}
+	if min == 0 {
+		min = e.Length
+	}
+	return min <= length && length <= max
+}
+
+// permit returns true if the prefix is permitted by the entries. No entry
+// means no filtering while the prefix matching no entry is denied.
+func (o *prefixOrf) permit(nlri bgp.AddrPrefixInterface) bool {
+	if o == nil || len(o.entries) == 0 {
+		return true
+	}
+	var prefix net.IP
+	var length uint8
+	switch n := nlri.(type) {
+	case *bgp.IPAddrPrefix:
+		prefix, length = n.Prefix.To4(), n.Length
+	case *bgp.IPv6AddrPrefix:
+		prefix, length = n.Prefix.To16(), n.Length
+	default:
+		return true
+	}
+	for _, e := range o.entries {
+		if len(e.Prefix) == len(prefix) && matchPrefixOrfEntry(e, prefix, length) {
+			return e.Match == bgp.ORF_MATCH_PERMIT
+		}
+	}
+	return false
+}
+
+func prefixOrfModeFromConfig(mode oc.PrefixOrfModeType) bgp.BGPORFMode {
+	switch mode {
+	case oc.PREFIX_ORF_MODE_TYPE_RECEIVE:
+		return bgp.BGP_ORF_RECEIVE
+	case oc.PREFIX_ORF_MODE_TYPE_SEND:
+		return bgp.BGP_ORF_SEND
+	case oc.PREFIX_ORF_MODE_TYPE_BOTH:
+		return bgp.BGP_ORF_BOTH
+	}
+	return bgp.BGP_ORF_NONE
+}
+
+func capOutboundRouteFilteringFromConfig(pConf *oc.Neighbor) bgp.ParameterCapabilityInterface {
+	tuples := make([]*bgp.CapOutboundRouteFilteringTuple, 0, len(pConf.AfiSafis))
+	for _, af := range pConf.AfiSafis {
+		if mode := prefixOrfModeFromConfig(af.PrefixOrf.State.Mode); mode != bgp.BGP_ORF_NONE {
+			tuples = append(tuples, bgp.NewCapOutboundRouteFilteringTuple(af.State.Family, []*bgp.CapORFEntry{
+				{Type: bgp.ORF_TYPE_ADDRESS_PREFIX, Mode: mode},
+			}))
+		}
+	}
+	if len(tuples) == 0 {
+		return nil
+	}
+	return bgp.NewCapOutboundRouteFiltering(tuples)
+}
+
+// prefixOrfMode returns the address prefix ORF mode negotiated with the
+// peer; BGP_ORF_SEND means that the entries can be sent to the peer.
+func (peer *peer) prefixOrfMode(family bgp.RouteFamily) bgp.BGPORFMode {
+	peer.fsm.lock.RLock()
+	defer peer.fsm.lock.RUnlock()
+
+	var local, remote bgp.BGPORFMode
+	for _, a := range peer.fsm.pConf.AfiSafis {
+		if a.State.Family == family {
+			local = prefixOrfModeFromConfig(a.PrefixOrf.State.Mode)
+		}
+	}
+	for _, c := range peer.fsm.capMap[bgp.BGP_CAP_OUTBOUND_ROUTE_FILTERING] {
+		for _, t := range c.(*bgp.CapOutboundRouteFiltering).Tuples {
+			if t.RouteFamily != family {
+				continue
+			}
+			for _, e := range t.Entries {
+				if e.Type == bgp.ORF_TYPE_ADDRESS_PREFIX {
+					remote |= e.Mode
+				}
+			}
+		}
+	}
+
+	mode := bgp.BGP_ORF_NONE
+	if local&bgp.BGP_ORF_SEND > 0 && remote&bgp.BGP_ORF_RECEIVE > 0 {
+		mode |= bgp.BGP_ORF_SEND
+	}
+	if local&bgp.BGP_ORF_RECEIVE > 0 && remote&bgp.BGP_ORF_SEND > 0 {
+		mode |= bgp.BGP_ORF_RECEIVE
+	}
+	return mode
+}
+
+func (peer *peer) isPrefixOrfPermitted(path *table.Path) bool {
+	if path == nil || path.IsWithdraw {
+		return true
+	}
+	return peer.prefixOrfs[path.GetRouteFamily()].permit(path.GetNlri())
+}
+
+// prefixOrfEntries converts the prefix-set to the address prefix ORF
+// entries of the family, numbered in the order of the set.
+func (s *BgpServer) prefixOrfEntries(name string, family bgp.RouteFamily) ([]*bgp.AddressPrefixORFEntry, error) {
+	sets, err := s.policy.GetDefinedSet(table.DEFINED_TYPE_PREFIX, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(sets.PrefixSets) == 0 {
+		return nil, fmt.Errorf("prefix set %s doesn't exist", name)
+	}
+	entries := make([]*bgp.AddressPrefixORFEntry, 0, len(sets.PrefixSets[0].PrefixList))
+	for _, p := range sets.PrefixSets[0].PrefixList {
+		_, prefix, err := net.ParseCIDR(p.IpPrefix)
+		if err != nil {
+			return nil, err
+		}
+		if (prefix.IP.To4() != nil) != (family == bgp.RF_IPv4_UC) {
+			continue
+		}
+		min, max, err := oc.ParseMaskLength(p.IpPrefix, p.MasklengthRange)
+		if err != nil {
+			return nil, err
+		}
+		seq := uint32(len(entries)+1) * 10
+		entries = append(entries, bgp.NewAddressPrefixORFEntry(bgp.ORF_ACTION_ADD, bgp.ORF_MATCH_PERMIT, seq, prefix, uint8(min), uint8(max)))
+	}
+	return entries, nil
+}
+
+// sendPrefixOrf pushes the configured prefix-set to the peer with the
+// ROUTE-REFRESH message, which replaces the entries sent before and asks
+// the peer to re-advertise the routes.
+func (s *BgpServer) sendPrefixOrf(peer *peer, families []bgp.RouteFamily) {
+	msgs := make([]*bgp.BGPMessage, 0, len(families))
+	for _, family := range families {
+		if peer.prefixOrfMode(family)&bgp.BGP_ORF_SEND == 0 {
+			continue
+		}
+		peer.fsm.lock.RLock()
+		name := ""
+		if a := peer.fsm.pConf.GetAfiSafi(family); a != nil {
+			name = a.PrefixOrf.State.PrefixSet
+		}
+		peer.fsm.lock.RUnlock()
+
+		entries := []*bgp.AddressPrefixORFEntry{
+			bgp.NewAddressPrefixORFEntry(bgp.ORF_ACTION_REMOVE_ALL, bgp.ORF_MATCH_PERMIT, 0, nil, 0, 0),
+		}
+		if name != "" {
+			l, err := s.prefixOrfEntries(name, family)
+			if err != nil {
+				s.logger.Warn("failed to build the address prefix ORF entries",
+					log.Fields{
+						"Topic":     "Peer",
+						"Key":       peer.ID(),
+						"Family":    family,
+						"PrefixSet": name,
+						"Error":     err})
+				continue
+			}
+			entries = append(entries, l...)
+		}
+		peer.prefixOrfSent[family] = uint32(len(entries) - 1)
+
+		afi, safi := bgp.RouteFamilyToAfiSafi(family)
+		msgs = append(msgs, bgp.NewBGPRouteRefreshORFMessage(afi, safi, bgp.ORF_WHEN_TO_REFRESH_IMMEDIATE, []*bgp.RouteRefreshORF{
+			bgp.NewRouteRefreshAddressPrefixORF(entries),
+		}))
+		s.logger.Debug("send address prefix ORF",
+			log.Fields{
+				"Topic":   "Peer",
+				"Key":     peer.ID(),
+				"Family":  family,
+				"Entries": len(entries) - 1})
+	}
+	if len(msgs) > 0 {
+		peer.fsm.outgoingCh.In() <- &fsmOutgoingMsg{
+			Messages: msgs,
+		}
+	}
+}
+
+// advertisedPaths returns the paths of the family currently advertised to
+// the peer.
+func (s *BgpServer) advertisedPaths(peer *peer, family bgp.RouteFamily) map[table.PathLocalKey]*table.Path {
+	m := make(map[table.PathLocalKey]*table.Path)
+	for _, f := range peer.toGlobalFamilies([]bgp.RouteFamily{family}) {
+		for _, path := range s.getPossibleBest(peer, f) {
+			if p := s.filterpath(peer, path, nil); p != nil && !p.IsWithdraw {
+				m[p.GetLocalKey()] = p
+			}
+		}
+	}
+	return m
+}
+
+// handlePrefixOrf updates the address prefix ORF entries of the peer with
+// the ones carried in the ROUTE-REFRESH message and returns the paths to
+// re-advertise, including the withdrawals of the paths no longer permitted.
+func (s *BgpServer) handlePrefixOrf(peer *peer, family bgp.RouteFamily, rr *bgp.BGPRouteRefresh) []*table.Path {
+	if peer.prefixOrfMode(family)&bgp.BGP_ORF_RECEIVE == 0 {
+		s.logger.Warn("ORF entries received but the capability wasn't negotiated",
+			log.Fields{
+				"Topic":  "Peer",
+				"Key":    peer.ID(),
+				"Family": family})
+	} else {
+		advertised := s.advertisedPaths(peer, family)
+		o, ok := peer.prefixOrfs[family]
+		if !ok {
+			o = &prefixOrf{}
+			peer.prefixOrfs[family] = o
+		}
+		for _, orf := range rr.ORFs {
+			if orf.Type != bgp.ORF_TYPE_ADDRESS_PREFIX {
+				s.logger.Warn("unsupported ORF type",
+					log.Fields{
+						"Topic": "Peer",
+						"Key":   peer.ID(),
+						"Type":  orf.Type})
+				continue
+			}
+			o.update(orf.Entries)
+		}
+		s.logger.Info("address prefix ORF updated",
+			log.Fields{
+				"Topic":   "Peer",
+				"Key":     peer.ID(),
+				"Family":  family,
+				"Entries": len(o.entries)})
+		if rr.WhenToRefresh == bgp.ORF_WHEN_TO_REFRESH_DEFER {
+			return nil
+		}
+
+		accepted, _ := s.getBestFromLocal(peer, []bgp.RouteFamily{family})
+		for _, p := range accepted {
+			delete(advertised, p.GetLocalKey())
+		}
+		pathList := make([]*table.Path, 0, len(advertised)+len(accepted))
+		for _, p := range advertised {
+			pathList = append(pathList, p.Clone(true))
+		}
+		return append(pathList, accepted...)
+	}
+	if rr.WhenToRefresh == bgp.ORF_WHEN_TO_REFRESH_DEFER {
+		return nil
+	}
+	accepted, _ := s.getBestFromLocal(peer, []bgp.RouteFamily{family})
+	return accepted
+}
@@ -0,0 +1,161 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apb "google.golang.org/protobuf/types/known/anypb"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func TestPrefixOrfPermit(t *testing.T) {
+	assert := assert.New(t)
+
+	entry := func(action bgp.ORFAction, match bgp.ORFMatch, seq uint32, prefix string, min, max uint8) *bgp.AddressPrefixORFEntry {
+		_, n, _ := net.ParseCIDR(prefix)
+		return bgp.NewAddressPrefixORFEntry(action, match, seq, n, min, max)
+	}
+
+	o := &prefixOrf{}
+	// no entry permits everything
+	assert.True(o.permit(bgp.NewIPAddrPrefix(24, "10.1.0.0")))
+
+	o.update([]*bgp.AddressPrefixORFEntry{
+		entry(bgp.ORF_ACTION_ADD, bgp.ORF_MATCH_PERMIT, 20, "10.0.0.0/8", 0, 24),
+		entry(bgp.ORF_ACTION_ADD, bgp.ORF_MATCH_DENY, 10, "10.1.0.0/16", 0, 0),
+		entry(bgp.ORF_ACTION_ADD, bgp.ORF_MATCH_PERMIT, 30, "2001:db8::/32", 48, 0),
+	})
+	assert.Len(o.entries, 3)
+	assert.Equal(uint32(10), o.entries[0].Sequence)
+
+	assert.False(o.permit(bgp.NewIPAddrPrefix(16, "10.1.0.0")))
+	assert.True(o.permit(bgp.NewIPAddrPrefix(24, "10.1.0.0")))
+	assert.False(o.permit(bgp.NewIPAddrPrefix(25, "10.1.0.0")))
+	assert.False(o.permit(bgp.NewIPAddrPrefix(24, "192.168.0.0")))
+	assert.True(o.permit(bgp.NewIPv6AddrPrefix(64, "2001:db8:1::")))
+	assert.False(o.permit(bgp.NewIPv6AddrPrefix(40, "2001:db8::")))
+
+	o.update([]*bgp.AddressPrefixORFEntry{
+		entry(bgp.ORF_ACTION_REMOVE, bgp.ORF_MATCH_DENY, 10, "10.1.0.0/16", 0, 0),
+	})
+	assert.Len(o.entries, 2)
+	assert.True(o.permit(bgp.NewIPAddrPrefix(16, "10.1.0.0")))
+
+	o.update([]*bgp.AddressPrefixORFEntry{
+		bgp.NewAddressPrefixORFEntry(bgp.ORF_ACTION_REMOVE_ALL, bgp.ORF_MATCH_PERMIT, 0, nil, 0, 0),
+	})
+	assert.Len(o.entries, 0)
+	assert.True(o.permit(bgp.NewIPAddrPrefix(24, "192.168.0.0")))
+}
+
+func TestPrefixOrf(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s1 := runNewServer(t, 1, "1.1.1.1", 10179)
+	defer s1.StopBgp(ctx, &api.StopBgpRequest{})
+	s2 := runNewServer(t, 2, "2.2.2.2", -1)
+	defer s2.StopBgp(ctx, &api.StopBgpRequest{})
+
+	family := &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST}
+	for _, prefix := range []string{"10.1.0.0", "10.2.0.0", "10.3.0.0"} {
+		nlri, _ := apb.New(&api.IPAddressPrefix{Prefix: prefix, PrefixLen: 24})
+		origin, _ := apb.New(&api.OriginAttribute{Origin: 0})
+		nh, _ := apb.New(&api.NextHopAttribute{NextHop: "10.0.0.1"})
+		_, err := s1.AddPath(ctx, &api.AddPathRequest{
+			Path: &api.Path{Family: family, Nlri: nlri, Pattrs: []*apb.Any{origin, nh}},
+		})
+		assert.Nil(err)
+	}
+
+	setPrefixSet := func(prefixes ...string) {
+		l := make([]*api.Prefix, 0, len(prefixes))
+		for _, p := range prefixes {
+			l = append(l, &api.Prefix{IpPrefix: p, MaskLengthMin: 24, MaskLengthMax: 24})
+		}
+		assert.Nil(s2.AddDefinedSet(ctx, &api.AddDefinedSetRequest{
+			DefinedSet: &api.DefinedSet{DefinedType: api.DefinedType_PREFIX, Name: "orf", Prefixes: l},
+			Replace:    true,
+		}))
+	}
+	setPrefixSet("10.1.0.0/24", "10.2.0.0/24")
+
+	afiSafis := func(mode api.PrefixOrfConfig_Mode, prefixSet string) []*api.AfiSafi {
+		return []*api.AfiSafi{{
+			Config:    &api.AfiSafiConfig{Family: family, Enabled: true},
+			PrefixOrf: &api.PrefixOrf{Config: &api.PrefixOrfConfig{Mode: mode, PrefixSet: prefixSet}},
+		}}
+	}
+	ch := make(chan struct{})
+	go waitEstablished(s2, ch)
+	assert.Nil(s1.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{
+		Conf:      &api.PeerConf{NeighborAddress: "127.0.0.1", PeerAsn: 2},
+		Transport: &api.Transport{PassiveMode: true},
+		AfiSafis:  afiSafis(api.PrefixOrfConfig_RECEIVE, ""),
+	}}))
+	assert.Nil(s2.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{
+		Conf:      &api.PeerConf{NeighborAddress: "127.0.0.1", PeerAsn: 1},
+		Transport: &api.Transport{RemotePort: 10179},
+		Timers: &api.Timers{Config: &api.TimersConfig{
+			ConnectRetry:           1,
+			IdleHoldTimeAfterReset: 1,
+		}},
+		AfiSafis: afiSafis(api.PrefixOrfConfig_SEND, "orf"),
+	}}))
+	<-ch
+
+	received := func() []string {
+		var l []string
+		err := s2.ListPath(ctx, &api.ListPathRequest{TableType: api.TableType_GLOBAL, Family: family}, func(d *api.Destination) {
+			l = append(l, d.Prefix)
+		})
+		assert.Nil(err)
+		sort.Strings(l)
+		return l
+	}
+	assert.Eventually(func() bool {
+		l := received()
+		return len(l) == 2 && l[0] == "10.1.0.0/24" && l[1] == "10.2.0.0/24"
+	}, 5*time.Second, 100*time.Millisecond)
+
+	orfState := func(s *BgpServer) *api.PrefixOrfState {
+		var state *api.PrefixOrfState
+		err := s.ListPeer(ctx, &api.ListPeerRequest{}, func(p *api.Peer) {
+			state = p.AfiSafis[0].PrefixOrf.State
+		})
+		assert.Nil(err)
+		return state
+	}
+	assert.Equal(uint32(2), orfState(s2).SentEntries)
+	assert.Equal(uint32(2), orfState(s1).ReceivedEntries)
+
+	// the new prefix list is pushed by soft reset in
+	setPrefixSet("10.3.0.0/24")
+	assert.Nil(s2.ResetPeer(ctx, &api.ResetPeerRequest{Address: "127.0.0.1", Soft: true, Direction: api.ResetPeerRequest_IN}))
+	assert.Eventually(func() bool {
+		l := received()
+		return len(l) == 1 && l[0] == "10.3.0.0/24"
+	}, 5*time.Second, 100*time.Millisecond)
+	assert.Equal(uint32(1), orfState(s1).ReceivedEntries)
+}
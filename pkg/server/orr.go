@@ -0,0 +1,189 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"sort"
+
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/log"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+// OPTIMAL ROUTE REFLECTION
+//
+// RFC9107 BGP Optimal Route Reflection (BGP ORR)
+//
+// The route reflector selects the best paths advertised to a client from
+// the point of view of the IGP location of the client, the vantage point,
+// instead of its own. The IGP costs from the vantage point to the next hops
+// are given statically by the configuration or fed through the API, e.g.
+// by a controller computing them from the IGP topology.
+
+type orrIGPCost struct {
+	prefix netip.Prefix
+	cost   uint32
+}
+
+type orrVantagePoint struct {
+	name string
+	// sorted by the prefix length in descending order for the longest
+	// match
+	costs []orrIGPCost
+}
+
+func newOrrVantagePoint(c *oc.OrrVantagePointConfig) (*orrVantagePoint, error) {
+	vp := &orrVantagePoint{
+		name:  c.Name,
+		costs: make([]orrIGPCost, 0, len(c.IgpCostList)),
+	}
+	for _, cost := range c.IgpCostList {
+		prefix, err := netip.ParsePrefix(cost.Prefix)
+		if err != nil {
+			return nil, fmt.Errorf("invalid igp cost prefix %s: %v", cost.Prefix, err)
+		}
+		vp.costs = append(vp.costs, orrIGPCost{
+			prefix: netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()).Masked(),
+			cost:   cost.Cost,
+		})
+	}
+	sort.SliceStable(vp.costs, func(i, j int) bool {
+		return vp.costs[i].prefix.Bits() > vp.costs[j].prefix.Bits()
+	})
+	return vp, nil
+}
+
+// cost returns the IGP cost to the next hop from the vantage point.
+func (vp *orrVantagePoint) cost(nexthop net.IP) (uint32, bool) {
+	addr, ok := netip.AddrFromSlice(nexthop)
+	if !ok {
+		return 0, false
+	}
+	addr = addr.Unmap()
+	for _, c := range vp.costs {
+		if c.prefix.Contains(addr) {
+			return c.cost, true
+		}
+	}
+	return 0, false
+}
+
+func (vp *orrVantagePoint) config() *oc.OrrVantagePointConfig {
+	c := &oc.OrrVantagePointConfig{
+		Name:        vp.name,
+		IgpCostList: make([]oc.IgpCost, 0, len(vp.costs)),
+	}
+	for _, cost := range vp.costs {
+		c.IgpCostList = append(c.IgpCostList, oc.IgpCost{
+			Prefix: cost.prefix.String(),
+			Cost:   cost.cost,
+		})
+	}
+	return c
+}
+
+type orrManager struct {
+	s             *BgpServer
+	vantagePoints map[string]*orrVantagePoint
+}
+
+func newOrrManager(s *BgpServer) *orrManager {
+	return &orrManager{
+		s:             s,
+		vantagePoints: make(map[string]*orrVantagePoint),
+	}
+}
+
+// costFunc returns the IGP costs from the vantage point of the peer, nil
+// if the best paths of the global RIB are advertised to the peer.
+func (m *orrManager) costFunc(peer *peer) table.IGPCostFunc {
+	if len(m.vantagePoints) == 0 || peer.isRouteServerClient() {
+		return nil
+	}
+	if vp, y := m.vantagePoints[peer.orrVantagePoint()]; y {
+		return vp.cost
+	}
+	return nil
+}
+
+// peers returns the peers whose vantage point is name.
+func (m *orrManager) peers(name string) []*peer {
+	l := make([]*peer, 0)
+	for _, peer := range m.s.neighborMap {
+		if peer.orrVantagePoint() == name && !peer.isRouteServerClient() {
+			l = append(l, peer)
+		}
+	}
+	return l
+}
+
+// refresh advertises the best paths again to the peers of the vantage
+// point whose IGP costs have changed.
+func (m *orrManager) refresh(name string) {
+	for _, peer := range m.peers(name) {
+		m.s.softResetOut(peer.ID(), bgp.RouteFamily(0), false)
+	}
+}
+
+// addVantagePoint adds the vantage point or replaces the IGP costs of the
+// existing one.
+func (m *orrManager) addVantagePoint(c *oc.OrrVantagePointConfig) error {
+	if c.Name == "" {
+		return fmt.Errorf("empty vantage point name")
+	}
+	vp, err := newOrrVantagePoint(c)
+	if err != nil {
+		return err
+	}
+	m.vantagePoints[c.Name] = vp
+	m.s.logger.Info("orr vantage point updated",
+		log.Fields{
+			"Topic": "ORR",
+			"Key":   c.Name,
+			"Costs": len(vp.costs)})
+	m.refresh(c.Name)
+	return nil
+}
+
+func (m *orrManager) deleteVantagePoint(name string) error {
+	if _, y := m.vantagePoints[name]; !y {
+		return fmt.Errorf("orr vantage point %s doesn't exist", name)
+	}
+	delete(m.vantagePoints, name)
+	m.s.logger.Info("orr vantage point deleted",
+		log.Fields{
+			"Topic": "ORR",
+			"Key":   name})
+	m.refresh(name)
+	return nil
+}
+
+// orrChanges returns the changes of the best paths of dsts from the vantage
+// point of cost in the same way as dstsToPaths.
+func orrChanges(cost table.IGPCostFunc, dsts []*table.Update) ([]*table.Path, []*table.Path) {
+	bestList := make([]*table.Path, 0, len(dsts))
+	oldList := make([]*table.Path, 0, len(dsts))
+	for _, dst := range dsts {
+		best, old := dst.GetOptimalChanges(cost)
+		bestList = append(bestList, best)
+		oldList = append(oldList, old)
+	}
+	return bestList, oldList
+}
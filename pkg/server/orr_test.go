@@ -0,0 +1,125 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+)
+
+func TestOrrVantagePointCost(t *testing.T) {
+	assert := assert.New(t)
+	vp, err := newOrrVantagePoint(&oc.OrrVantagePointConfig{
+		Name: "pop1",
+		IgpCostList: []oc.IgpCost{
+			{Prefix: "10.0.0.0/8", Cost: 100},
+			{Prefix: "10.0.1.0/24", Cost: 10},
+			{Prefix: "2001:db8::/32", Cost: 20},
+		},
+	})
+	assert.Nil(err)
+
+	for nexthop, want := range map[string]uint32{
+		"10.0.1.1":         10,
+		"10.0.2.1":         100,
+		"::ffff:10.0.1.1":  10,
+		"2001:db8::1":      20,
+		"2001:db8:1::1:10": 20,
+	} {
+		c, y := vp.cost(net.ParseIP(nexthop))
+		assert.True(y, nexthop)
+		assert.Equal(want, c, nexthop)
+	}
+	_, y := vp.cost(net.ParseIP("192.0.2.1"))
+	assert.False(y)
+
+	_, err = newOrrVantagePoint(&oc.OrrVantagePointConfig{
+		Name:        "pop1",
+		IgpCostList: []oc.IgpCost{{Prefix: "10.0.0.1", Cost: 1}},
+	})
+	assert.NotNil(err)
+}
+
+func TestOrrVantagePoint(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s := runNewServer(t, 1, "1.1.1.1", -1)
+	defer s.StopBgp(ctx, &api.StopBgpRequest{})
+
+	list := func() map[string]*api.ListOrrVantagePointResponse {
+		m := make(map[string]*api.ListOrrVantagePointResponse)
+		assert.Nil(s.ListOrrVantagePoint(ctx, &api.ListOrrVantagePointRequest{}, func(r *api.ListOrrVantagePointResponse) {
+			m[r.VantagePoint.Name] = r
+		}))
+		return m
+	}
+
+	assert.Nil(s.AddOrrVantagePoint(ctx, &api.AddOrrVantagePointRequest{
+		VantagePoint: &api.OrrVantagePoint{
+			Name:     "pop1",
+			IgpCosts: []*api.OrrIgpCost{{Prefix: "10.0.0.0/24", Cost: 10}},
+		},
+	}))
+	assert.NotNil(s.AddOrrVantagePoint(ctx, &api.AddOrrVantagePointRequest{
+		VantagePoint: &api.OrrVantagePoint{
+			Name:     "pop2",
+			IgpCosts: []*api.OrrIgpCost{{Prefix: "foo", Cost: 10}},
+		},
+	}))
+
+	// the vantage point of the eBGP neighbor is rejected
+	n := &api.Peer{
+		Conf: &api.PeerConf{
+			NeighborAddress: "10.0.0.2",
+			PeerAsn:         2,
+			OrrVantagePoint: "pop1",
+		},
+	}
+	assert.NotNil(s.AddPeer(ctx, &api.AddPeerRequest{Peer: n}))
+	n.Conf.PeerAsn = 1
+	n.RouteReflector = &api.RouteReflector{RouteReflectorClient: true}
+	assert.Nil(s.AddPeer(ctx, &api.AddPeerRequest{Peer: n}))
+
+	l := list()
+	assert.Equal(1, len(l))
+	assert.Equal([]string{"10.0.0.2"}, l["pop1"].Peers)
+	assert.Equal(uint32(10), l["pop1"].VantagePoint.IgpCosts[0].Cost)
+
+	// the IGP costs are replaced
+	assert.Nil(s.AddOrrVantagePoint(ctx, &api.AddOrrVantagePointRequest{
+		VantagePoint: &api.OrrVantagePoint{
+			Name: "pop1",
+			IgpCosts: []*api.OrrIgpCost{
+				{Prefix: "10.0.0.0/24", Cost: 20},
+				{Prefix: "10.0.1.0/24", Cost: 30},
+			},
+		},
+	}))
+	l = list()
+	assert.Equal(2, len(l["pop1"].VantagePoint.IgpCosts))
+	assert.Equal(uint32(20), l["pop1"].VantagePoint.IgpCosts[0].Cost)
+
+	assert.Nil(s.DeleteOrrVantagePoint(ctx, &api.DeleteOrrVantagePointRequest{Name: "pop1"}))
+	assert.NotNil(s.DeleteOrrVantagePoint(ctx, &api.DeleteOrrVantagePointRequest{Name: "pop1"}))
+	assert.Equal(0, len(list()))
+}
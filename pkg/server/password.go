@@ -0,0 +1,235 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/log"
+)
+
+// the password of the TCP MD5 signature is retrieved from the source of
+// auth-password-source at the session establishment, so that it is rotated
+// and kept out of the configuration file.
+const (
+	passwordSourceExec = "exec"
+	passwordSourceFile = "file"
+	passwordSourceEnv  = "env"
+
+	passwordSourceTimeout = 5 * time.Second
+)
+
+// passwordSourceData is what the template of the source is executed with,
+// e.g. file:/etc/gobgp/secrets/{{.NeighborAddress}}.
+type passwordSourceData struct {
+	NeighborAddress string
+	PeerAs          uint32
+	LocalAs         uint32
+	PeerGroup       string
+}
+
+type passwordSource struct {
+	kind string
+	tmpl *template.Template
+}
+
+func parsePasswordSource(s string) (*passwordSource, error) {
+	kind, arg, found := strings.Cut(s, ":")
+	if !found || arg == "" {
+		return nil, fmt.Errorf("invalid auth-password-source: %s", s)
+	}
+	switch kind {
+	case passwordSourceExec, passwordSourceFile, passwordSourceEnv:
+	default:
+		return nil, fmt.Errorf("unknown auth-password-source type: %s", kind)
+	}
+	tmpl, err := template.New(kind).Option("missingkey=error").Parse(arg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid auth-password-source %s: %w", s, err)
+	}
+	return &passwordSource{kind: kind, tmpl: tmpl}, nil
+}
+
+// lookup returns the password retrieved from the source, without the
+// trailing newline.
+func (p *passwordSource) lookup(ctx context.Context, d *passwordSourceData) (string, error) {
+	var b strings.Builder
+	if err := p.tmpl.Execute(&b, d); err != nil {
+		return "", err
+	}
+	arg := b.String()
+
+	var password string
+	switch p.kind {
+	case passwordSourceExec:
+		args := strings.Fields(arg)
+		if len(args) == 0 {
+			return "", fmt.Errorf("empty command")
+		}
+		ctx, cancel := context.WithTimeout(ctx, passwordSourceTimeout)
+		defer cancel()
+		cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+		cmd.Env = append(os.Environ(),
+			"GOBGP_NEIGHBOR_ADDRESS="+d.NeighborAddress,
+			fmt.Sprintf("GOBGP_PEER_AS=%d", d.PeerAs),
+			fmt.Sprintf("GOBGP_LOCAL_AS=%d", d.LocalAs),
+			"GOBGP_PEER_GROUP="+d.PeerGroup)
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+		out, err := cmd.Output()
+		if err != nil {
+			return "", fmt.Errorf("%s failed: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+		}
+		password = string(out)
+	case passwordSourceFile:
+		b, err := os.ReadFile(arg)
+		if err != nil {
+			return "", err
+		}
+		password = string(b)
+	case passwordSourceEnv:
+		v, y := os.LookupEnv(arg)
+		if !y {
+			return "", fmt.Errorf("environment variable %s isn't set", arg)
+		}
+		password = v
+	}
+	password = strings.TrimRight(password, "\r\n")
+	if password == "" {
+		return "", fmt.Errorf("empty password from %s", p.kind)
+	}
+	return password, nil
+}
+
+func newPasswordSourceData(c *oc.Neighbor) *passwordSourceData {
+	addr := c.State.NeighborAddress
+	if addr == "" {
+		addr = c.Config.NeighborAddress
+	}
+	return &passwordSourceData{
+		NeighborAddress: addr,
+		PeerAs:          c.Config.PeerAs,
+		LocalAs:         c.Config.LocalAs,
+		PeerGroup:       c.Config.PeerGroup,
+	}
+}
+
+// neighborPassword returns the password of the TCP MD5 signature of the
+// neighbor, retrieved from auth-password-source if configured. The static
+// auth-password is used if the retrieval fails.
+func neighborPassword(ctx context.Context, logger log.Logger, source string, static string, d *passwordSourceData) string {
+	if source == "" {
+		return static
+	}
+	p, err := parsePasswordSource(source)
+	if err == nil {
+		var password string
+		if password, err = p.lookup(ctx, d); err == nil {
+			return password
+		}
+	}
+	logger.Warn("failed to retrieve the password",
+		log.Fields{
+			"Topic":  "Peer",
+			"Key":    d.NeighborAddress,
+			"Source": source,
+			"Err":    err})
+	return static
+}
+
+func (s *BgpServer) setListenersPassword(addr, key, password string) {
+	for _, l := range s.listListeners(addr) {
+		if err := setTCPMD5SigSockopt(l, key, password); err != nil {
+			s.logger.Warn("failed to set md5",
+				log.Fields{
+					"Topic": "Peer",
+					"Key":   key,
+					"Err":   err})
+		}
+	}
+}
+
+// refreshNeighborPassword retrieves the password of the neighbor from
+// auth-password-source and sets it to the listeners for the passive
+// connection. The server isn't blocked while retrieving it.
+func (s *BgpServer) refreshNeighborPassword(peer *peer) {
+	if s.bgpConfig.Global.Config.Port <= 0 || peer.isDynamicNeighbor() {
+		return
+	}
+	peer.fsm.lock.RLock()
+	source := peer.fsm.pConf.Config.AuthPasswordSource
+	static := peer.fsm.pConf.Config.AuthPassword
+	d := newPasswordSourceData(peer.fsm.pConf)
+	peer.fsm.lock.RUnlock()
+	if source == "" {
+		return
+	}
+	go func() {
+		password := neighborPassword(context.Background(), s.logger, source, static, d)
+		if password == "" {
+			return
+		}
+		s.mgmtOperation(func() error {
+			if s.neighborMap[d.NeighborAddress] == peer {
+				s.setListenersPassword(d.NeighborAddress, d.NeighborAddress, password)
+			}
+			return nil
+		}, false)
+	}()
+}
+
+// refreshDynamicNeighborPassword is refreshNeighborPassword for the prefix
+// of the dynamic neighbors of the peer group.
+func (s *BgpServer) refreshDynamicNeighborPassword(pg *peerGroup, prefix, intf string) {
+	source := pg.Conf.Config.AuthPasswordSource
+	if s.bgpConfig.Global.Config.Port <= 0 || source == "" {
+		return
+	}
+	addr, _, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return
+	}
+	static := pg.Conf.Config.AuthPassword
+	d := &passwordSourceData{
+		NeighborAddress: prefix,
+		PeerAs:          pg.Conf.Config.PeerAs,
+		LocalAs:         pg.Conf.Config.LocalAs,
+		PeerGroup:       pg.Conf.Config.PeerGroupName,
+	}
+	go func() {
+		password := neighborPassword(context.Background(), s.logger, source, static, d)
+		if password == "" {
+			return
+		}
+		s.mgmtOperation(func() error {
+			if s.peerGroupMap[d.PeerGroup] == pg {
+				if _, y := pg.dynamicNeighbors[dynamicNeighborKey(prefix, intf)]; y {
+					s.setListenersPassword(addr.String(), prefix, password)
+				}
+			}
+			return nil
+		}, false)
+	}()
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/osrg/gobgp/v3/api"
+)
+
+func TestPasswordSource(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	d := &passwordSourceData{NeighborAddress: "10.0.0.1", PeerAs: 65001}
+
+	dir := t.TempDir()
+	assert.Nil(os.WriteFile(filepath.Join(dir, "10.0.0.1"), []byte("file-secret\n"), 0600))
+	t.Setenv("BGP_PASSWORD_65001", "env-secret")
+
+	for source, want := range map[string]string{
+		"file:" + dir + "/{{.NeighborAddress}}": "file-secret",
+		"env:BGP_PASSWORD_{{.PeerAs}}":          "env-secret",
+		"exec:echo exec-{{.PeerAs}}":            "exec-65001",
+	} {
+		p, err := parsePasswordSource(source)
+		assert.Nil(err)
+		password, err := p.lookup(ctx, d)
+		assert.Nil(err, source)
+		assert.Equal(want, password)
+	}
+
+	for _, source := range []string{"foo", "file:", "vault:secret", "file:{{.Foo"} {
+		_, err := parsePasswordSource(source)
+		assert.NotNil(err, source)
+	}
+
+	for _, source := range []string{"file:" + dir + "/{{.PeerGroup}}none", "env:BGP_PASSWORD_NONE", "exec:false", "file:{{.Foo}}"} {
+		p, err := parsePasswordSource(source)
+		assert.Nil(err)
+		_, err = p.lookup(ctx, d)
+		assert.NotNil(err, source)
+	}
+
+	// the static password is used if the retrieval fails
+	assert.Equal("static", neighborPassword(ctx, logger, "env:BGP_PASSWORD_NONE", "static", d))
+	assert.Equal("env-secret", neighborPassword(ctx, logger, "env:BGP_PASSWORD_{{.PeerAs}}", "static", d))
+	assert.Equal("static", neighborPassword(ctx, logger, "", "static", d))
+}
+
+func TestAddPeerPasswordSource(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s := runNewServer(t, 1, "1.1.1.1", -1)
+	defer s.StopBgp(ctx, &api.StopBgpRequest{})
+
+	conf := &api.PeerConf{NeighborAddress: "10.0.0.1", PeerAsn: 2}
+	conf.AuthPasswordSource = "vault:secret"
+	assert.NotNil(s.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{Conf: conf}}))
+	conf.AuthPasswordSource = "env:BGP_PASSWORD_{{.PeerAs}}"
+	assert.Nil(s.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{Conf: conf}}))
+
+	assert.Nil(s.ListPeer(ctx, &api.ListPeerRequest{}, func(p *api.Peer) {
+		assert.Equal("env:BGP_PASSWORD_{{.PeerAs}}", p.Conf.AuthPasswordSource)
+	}))
+}
@@ -0,0 +1,147 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"container/list"
+	"time"
+
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+// the number of the prefixes without paths whose histories are kept. The
+// histories of the prefixes withdrawn earliest are removed beyond this.
+const pathHistoryWithdrawnPrefixes = 10000
+
+// pathEventType has the same values as api.PathEvent_Type.
+type pathEventType uint8
+
+const (
+	pathEventAdvertise pathEventType = iota
+	pathEventWithdraw
+	pathEventAttributeChange
+)
+
+type pathEvent struct {
+	typ       pathEventType
+	timestamp time.Time
+	neighbor  string
+	path      *table.Path
+}
+
+type pathHistoryKey struct {
+	family bgp.RouteFamily
+	prefix string
+}
+
+type pathSource struct {
+	neighbor string
+	id       uint32
+}
+
+type pathHistoryEntry struct {
+	key    pathHistoryKey
+	events []*pathEvent
+	// the paths of the prefix in the Adj-RIB-Ins
+	sources map[pathSource]struct{}
+	// the element in the list of the prefixes without paths
+	withdrawn *list.Element
+}
+
+// pathHistory keeps the latest events of the paths received from the
+// peers per prefix. It's accessed only in the goroutine of the server.
+type pathHistory struct {
+	size      int
+	entries   map[pathHistoryKey]*pathHistoryEntry
+	withdrawn *list.List
+}
+
+func newPathHistory(size int) *pathHistory {
+	return &pathHistory{
+		size:      size,
+		entries:   make(map[pathHistoryKey]*pathHistoryEntry),
+		withdrawn: list.New(),
+	}
+}
+
+func (h *pathHistory) add(path *table.Path, typ pathEventType, neighbor string, timestamp time.Time) {
+	k := pathHistoryKey{
+		family: path.GetRouteFamily(),
+		prefix: path.GetNlri().String(),
+	}
+	e, y := h.entries[k]
+	if !y {
+		if typ == pathEventWithdraw {
+			return
+		}
+		e = &pathHistoryEntry{
+			key:     k,
+			sources: make(map[pathSource]struct{}),
+		}
+		h.entries[k] = e
+	}
+	if len(e.events) == h.size {
+		copy(e.events, e.events[1:])
+		e.events = e.events[:h.size-1]
+	}
+	e.events = append(e.events, &pathEvent{
+		typ:       typ,
+		timestamp: timestamp,
+		neighbor:  neighbor,
+		path:      path,
+	})
+
+	source := pathSource{neighbor: neighbor, id: path.GetNlri().PathIdentifier()}
+	if typ == pathEventWithdraw {
+		delete(e.sources, source)
+		if len(e.sources) == 0 && e.withdrawn == nil {
+			e.withdrawn = h.withdrawn.PushBack(e)
+			for h.withdrawn.Len() > pathHistoryWithdrawnPrefixes {
+				oldest := h.withdrawn.Remove(h.withdrawn.Front()).(*pathHistoryEntry)
+				delete(h.entries, oldest.key)
+			}
+		}
+	} else {
+		e.sources[source] = struct{}{}
+		if e.withdrawn != nil {
+			h.withdrawn.Remove(e.withdrawn)
+			e.withdrawn = nil
+		}
+	}
+}
+
+// record adds the events of the changes of the Adj-RIB-In of the peer.
+func (h *pathHistory) record(neighbor string, pathList []*table.Path, types []table.UpdateType, timestamp time.Time) {
+	for i, path := range pathList {
+		switch types[i] {
+		case table.UPDATE_TYPE_ANNOUNCEMENT:
+			h.add(path, pathEventAdvertise, neighbor, timestamp)
+		case table.UPDATE_TYPE_ATTRIBUTE_CHANGE:
+			h.add(path, pathEventAttributeChange, neighbor, timestamp)
+		case table.UPDATE_TYPE_FLAP:
+			h.add(path, pathEventWithdraw, neighbor, timestamp)
+		}
+	}
+}
+
+// events returns the events of the prefix, oldest first.
+func (h *pathHistory) events(family bgp.RouteFamily, prefix string) []*pathEvent {
+	if e, y := h.entries[pathHistoryKey{family: family, prefix: prefix}]; y {
+		return e.events
+	}
+	return nil
+}
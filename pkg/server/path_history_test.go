@@ -0,0 +1,83 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func TestPathHistory(t *testing.T) {
+	assert := assert.New(t)
+
+	path := func(prefix string, withdraw bool) *table.Path {
+		return table.NewPath(&table.PeerInfo{}, bgp.NewIPAddrPrefix(24, prefix), withdraw, []bgp.PathAttributeInterface{bgp.NewPathAttributeOrigin(0)}, time.Now(), false)
+	}
+	types := func(t table.UpdateType) []table.UpdateType {
+		return []table.UpdateType{t}
+	}
+	now := time.Now()
+
+	h := newPathHistory(3)
+	// the withdrawal of an unknown prefix isn't recorded
+	h.record("10.0.0.1", []*table.Path{path("10.0.1.0", true)}, types(table.UPDATE_TYPE_FLAP), now)
+	assert.Empty(h.entries)
+
+	h.record("10.0.0.1", []*table.Path{path("10.0.1.0", false)}, types(table.UPDATE_TYPE_ANNOUNCEMENT), now)
+	h.record("10.0.0.2", []*table.Path{path("10.0.1.0", false)}, types(table.UPDATE_TYPE_ANNOUNCEMENT), now.Add(time.Second))
+	// the duplicates aren't recorded
+	h.record("10.0.0.2", []*table.Path{path("10.0.1.0", false)}, types(table.UPDATE_TYPE_DUPLICATE), now.Add(2*time.Second))
+	h.record("10.0.0.1", []*table.Path{path("10.0.1.0", true)}, types(table.UPDATE_TYPE_FLAP), now.Add(3*time.Second))
+	l := h.events(bgp.RF_IPv4_UC, "10.0.1.0/24")
+	assert.Len(l, 3)
+	assert.Equal(pathEventAdvertise, l[0].typ)
+	assert.Equal("10.0.0.1", l[0].neighbor)
+	assert.Equal(pathEventWithdraw, l[2].typ)
+	assert.Equal(now.Add(3*time.Second), l[2].timestamp)
+	// still advertised by 10.0.0.2
+	assert.Equal(0, h.withdrawn.Len())
+
+	// the oldest event is removed beyond the size
+	h.record("10.0.0.2", []*table.Path{path("10.0.1.0", false)}, types(table.UPDATE_TYPE_ATTRIBUTE_CHANGE), now.Add(4*time.Second))
+	l = h.events(bgp.RF_IPv4_UC, "10.0.1.0/24")
+	assert.Len(l, 3)
+	assert.Equal("10.0.0.2", l[0].neighbor)
+	assert.Equal(pathEventAttributeChange, l[2].typ)
+
+	// the history of the prefix without paths is kept
+	h.record("10.0.0.2", []*table.Path{path("10.0.1.0", true)}, types(table.UPDATE_TYPE_FLAP), now.Add(5*time.Second))
+	assert.Len(h.events(bgp.RF_IPv4_UC, "10.0.1.0/24"), 3)
+	assert.Equal(1, h.withdrawn.Len())
+	h.record("10.0.0.2", []*table.Path{path("10.0.1.0", false)}, types(table.UPDATE_TYPE_ANNOUNCEMENT), now.Add(6*time.Second))
+	assert.Equal(0, h.withdrawn.Len())
+	h.record("10.0.0.2", []*table.Path{path("10.0.1.0", true)}, types(table.UPDATE_TYPE_FLAP), now.Add(7*time.Second))
+
+	// up to the limit of the prefixes without paths, the earliest withdrawn
+	// first
+	for i := 0; i < pathHistoryWithdrawnPrefixes; i++ {
+		prefix := fmt.Sprintf("10.%d.%d.0", 1+i/256, i%256)
+		h.record("10.0.0.1", []*table.Path{path(prefix, false), path(prefix, true)}, []table.UpdateType{table.UPDATE_TYPE_ANNOUNCEMENT, table.UPDATE_TYPE_FLAP}, now)
+	}
+	assert.Equal(pathHistoryWithdrawnPrefixes, h.withdrawn.Len())
+	assert.Len(h.entries, pathHistoryWithdrawnPrefixes)
+	assert.Nil(h.events(bgp.RF_IPv4_UC, "10.0.1.0/24"))
+}
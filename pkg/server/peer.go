@@ -52,12 +52,22 @@ func (pg *peerGroup) DeleteMember(c oc.Neighbor) {
 	delete(pg.members, c.State.NeighborAddress)
 }
 
+// dynamicNeighborKey returns the key of a dynamic neighbor. Prefixes
+// scoped to an interface are keyed as "prefix%interface" so that the
+// same link-local prefix can be used on several interfaces.
+func dynamicNeighborKey(prefix, intf string) string {
+	if intf == "" {
+		return prefix
+	}
+	return prefix + "%" + intf
+}
+
 func (pg *peerGroup) AddDynamicNeighbor(c *oc.DynamicNeighbor) {
-	pg.dynamicNeighbors[c.Config.Prefix] = c
+	pg.dynamicNeighbors[dynamicNeighborKey(c.Config.Prefix, c.Config.Interface)] = c
 }
 
-func (pg *peerGroup) DeleteDynamicNeighbor(prefix string) {
-	delete(pg.dynamicNeighbors, prefix)
+func (pg *peerGroup) DeleteDynamicNeighbor(prefix, intf string) {
+	delete(pg.dynamicNeighbors, dynamicNeighborKey(prefix, intf))
 }
 
 func newDynamicPeer(g *oc.Global, neighborAddress string, pg *oc.PeerGroup, loc *table.TableManager, policy *table.RoutingPolicy, logger log.Logger) *peer {
@@ -74,6 +84,7 @@ func newDynamicPeer(g *oc.Global, neighborAddress string, pg *oc.PeerGroup, loc
 			},
 		},
 	}
+	raw := conf
 	if err := oc.OverwriteNeighborConfigWithPeerGroup(&conf, pg); err != nil {
 		logger.Debug("Can't overwrite neighbor config",
 			log.Fields{
@@ -91,6 +102,7 @@ func newDynamicPeer(g *oc.Global, neighborAddress string, pg *oc.PeerGroup, loc
 		return nil
 	}
 	peer := newPeer(g, &conf, loc, policy, logger)
+	peer.configSources = oc.NeighborConfigSources(&raw, &conf, pg, g)
 	peer.fsm.lock.Lock()
 	peer.fsm.state = bgp.BGP_FSM_ACTIVE
 	peer.fsm.lock.Unlock()
@@ -105,9 +117,24 @@ type peer struct {
 	localRib          *table.TableManager
 	prefixLimitWarned map[bgp.RouteFamily]bool
 	// map of path local identifiers sent for that prefix
-	sentPaths           map[table.PathDestLocalKey]map[uint32]struct{}
+	sentPaths           map[table.PathDestLocalKey]map[uint32]*table.Path
 	sendMaxPathFiltered map[table.PathLocalKey]struct{}
 	llgrEndChs          []chan struct{}
+	// address prefix ORF entries received from the peer and the number
+	// of the entries sent to the peer
+	prefixOrfs    map[bgp.RouteFamily]*prefixOrf
+	prefixOrfSent map[bgp.RouteFamily]uint32
+	// where the values of the configuration come from
+	configSources []oc.NeighborConfigSource
+	// the PeeringDB record if the max prefixes are set from it
+	peeringdb *peeringdbPrefixes
+	// non-nil while the peer is in graceful shutdown
+	gshut    *gracefulShutdown
+	condAdvs []*conditionalAdvertisement
+	// the paths held while the peer consumes the outgoing queue slowly
+	slowQueue *slowPeerQueue
+	// the paths held while the MinRouteAdvertisementInterval runs
+	mrai *mraiQueue
 }
 
 func newPeer(g *oc.Global, conf *oc.Neighbor, loc *table.TableManager, policy *table.RoutingPolicy, logger log.Logger) *peer {
@@ -116,8 +143,10 @@ func newPeer(g *oc.Global, conf *oc.Neighbor, loc *table.TableManager, policy *t
 		policy:              policy,
 		fsm:                 newFSM(g, conf, logger),
 		prefixLimitWarned:   make(map[bgp.RouteFamily]bool),
-		sentPaths:           make(map[table.PathDestLocalKey]map[uint32]struct{}),
+		sentPaths:           make(map[table.PathDestLocalKey]map[uint32]*table.Path),
 		sendMaxPathFiltered: make(map[table.PathLocalKey]struct{}),
+		prefixOrfs:          make(map[bgp.RouteFamily]*prefixOrf),
+		prefixOrfSent:       make(map[bgp.RouteFamily]uint32),
 	}
 	if peer.isRouteServerClient() {
 		peer.tableId = conf.State.NeighborAddress
@@ -126,6 +155,8 @@ func newPeer(g *oc.Global, conf *oc.Neighbor, loc *table.TableManager, policy *t
 	}
 	rfs, _ := oc.AfiSafis(conf.AfiSafis).ToRfList()
 	peer.adjRibIn = table.NewAdjRib(peer.fsm.logger, rfs)
+	peer.slowQueue = newSlowPeerQueue(peer.fsm.logger)
+	peer.mrai = newMraiQueue()
 	return peer
 }
 
@@ -164,6 +195,34 @@ func (peer *peer) isIBGPPeer() bool {
 	return peer.fsm.pConf.State.PeerType == oc.PEER_TYPE_INTERNAL
 }
 
+// acceptOwn returns true if the VPN path of the own origin is accepted with
+// the ACCEPT_OWN community (RFC 7611).
+func (peer *peer) acceptOwn(path *table.Path) bool {
+	peer.fsm.lock.RLock()
+	acceptOwn := peer.fsm.pConf.Config.AcceptOwn
+	peer.fsm.lock.RUnlock()
+	if !acceptOwn {
+		return false
+	}
+	switch path.GetRouteFamily() {
+	case bgp.RF_IPv4_VPN, bgp.RF_IPv6_VPN:
+	default:
+		return false
+	}
+	for _, c := range path.GetCommunities() {
+		if c == uint32(bgp.COMMUNITY_ACCEPT_OWN) {
+			return true
+		}
+	}
+	return false
+}
+
+func (peer *peer) isConfederationMember() bool {
+	peer.fsm.lock.RLock()
+	defer peer.fsm.lock.RUnlock()
+	return peer.fsm.pConf.IsConfederationMember(peer.fsm.gConf)
+}
+
 func (peer *peer) isRouteServerClient() bool {
 	peer.fsm.lock.RLock()
 	defer peer.fsm.lock.RUnlock()
@@ -182,6 +241,12 @@ func (peer *peer) isRouteReflectorClient() bool {
 	return peer.fsm.pConf.RouteReflector.Config.RouteReflectorClient
 }
 
+func (peer *peer) orrVantagePoint() string {
+	peer.fsm.lock.RLock()
+	defer peer.fsm.lock.RUnlock()
+	return peer.fsm.pConf.Config.OrrVantagePoint
+}
+
 func (peer *peer) isGracefulRestartEnabled() bool {
 	peer.fsm.lock.RLock()
 	defer peer.fsm.lock.RUnlock()
@@ -216,6 +281,39 @@ func (peer *peer) getAddPathSendMax(family bgp.RouteFamily) uint8 {
 	return 0
 }
 
+func (peer *peer) getAddPathSendMode(family bgp.RouteFamily) oc.AddPathsSendModeType {
+	peer.fsm.lock.RLock()
+	defer peer.fsm.lock.RUnlock()
+	for _, a := range peer.fsm.pConf.AfiSafis {
+		if a.State.Family == family {
+			return a.AddPaths.Config.SendMode
+		}
+	}
+	return oc.ADD_PATHS_SEND_MODE_TYPE_N_PATHS
+}
+
+// isAddPathSendBestEnabled returns true if the paths to advertise are
+// selected in best path order rather than in the order they are learned.
+// loopPrevention returns allow-own-as and replace-peer-as for the family.
+func (peer *peer) loopPrevention(family bgp.RouteFamily) (uint8, bool) {
+	peer.fsm.lock.RLock()
+	defer peer.fsm.lock.RUnlock()
+	for _, a := range peer.fsm.pConf.AfiSafis {
+		if a.State.Family == family {
+			return a.LoopPrevention.State.AllowOwnAs, a.LoopPrevention.State.ReplacePeerAs
+		}
+	}
+	return peer.fsm.pConf.AsPathOptions.State.AllowOwnAs, peer.fsm.pConf.AsPathOptions.State.ReplacePeerAs
+}
+
+func (peer *peer) isAddPathSendBestEnabled(family bgp.RouteFamily) bool {
+	switch peer.getAddPathSendMode(family) {
+	case oc.ADD_PATHS_SEND_MODE_TYPE_N_BEST, oc.ADD_PATHS_SEND_MODE_TYPE_BEST_EXTERNAL:
+		return true
+	}
+	return false
+}
+
 func (peer *peer) getRoutesCount(family bgp.RouteFamily, dstPrefix string) uint8 {
 	destLocalKey := table.NewPathDestLocalKey(family, dstPrefix)
 	if identifiers, ok := peer.sentPaths[*destLocalKey]; ok {
@@ -241,11 +339,11 @@ func (peer *peer) updateRoutes(paths ...*table.Path) {
 			delete(identifiers, path.GetNlri().PathLocalIdentifier())
 		} else if !path.IsWithdraw {
 			if !destExists {
-				peer.sentPaths[destLocalKey] = make(map[uint32]struct{})
+				peer.sentPaths[destLocalKey] = make(map[uint32]*table.Path)
 			}
 			identifiers := peer.sentPaths[destLocalKey]
 			if len(identifiers) < int(peer.getAddPathSendMax(destLocalKey.Family)) {
-				identifiers[localKey.Id] = struct{}{}
+				identifiers[localKey.Id] = path
 			}
 		}
 	}
@@ -561,7 +659,16 @@ func (peer *peer) updatePrefixLimitConfig(c []oc.AfiSafi) error {
 	return nil
 }
 
-func (peer *peer) handleUpdate(e *fsmMsg) ([]*table.Path, []bgp.RouteFamily, *bgp.BGPMessage) {
+func hasClusterID(path *table.Path, clusterID net.IP) bool {
+	for _, id := range path.GetClusterList() {
+		if id.Equal(clusterID) {
+			return true
+		}
+	}
+	return false
+}
+
+func (peer *peer) handleUpdate(e *fsmMsg, record func([]*table.Path, []table.UpdateType)) ([]*table.Path, []bgp.RouteFamily, *bgp.BGPMessage) {
 	m := e.MsgData.(*bgp.BGPMessage)
 	update := m.Body.(*bgp.BGPUpdate)
 
@@ -597,14 +704,35 @@ func (peer *peer) handleUpdate(e *fsmMsg) ([]*table.Path, []bgp.RouteFamily, *bg
 			// If the AS_PATH attribute of a BGP route contains an AS loop, the BGP
 			// route should be excluded from the Phase 2 decision function.
 			if aspath := path.GetAsPath(); aspath != nil {
+				allowOwnAs, _ := peer.loopPrevention(path.GetRouteFamily())
+				allowOwnAS := int(allowOwnAs)
 				peer.fsm.lock.RLock()
 				localAS := peer.fsm.peerInfo.LocalAS
-				allowOwnAS := int(peer.fsm.pConf.AsPathOptions.Config.AllowOwnAs)
+				confed := peer.fsm.gConf.Confederation.Config
+				memberAS := peer.fsm.gConf.Config.As
+				migration := peer.fsm.pConf.Config.LocalAsMigration
+				noPrepend := peer.fsm.pConf.Config.LocalAsNoPrepend
+				migrationAS := peer.fsm.pConf.Config.LocalAs
 				peer.fsm.lock.RUnlock()
-				if hasOwnASLoop(localAS, allowOwnAS, aspath) {
+				// RFC5065 5.3: in the confederation, the member AS is
+				// looked for only in the AS_CONFED segments and the
+				// confederation identifier in the others.
+				var ownASLoop bool
+				if confed.Enabled && (localAS == memberAS || localAS == confed.Identifier) {
+					ownASLoop = hasConfedASLoop(memberAS, confed.Identifier, allowOwnAS, aspath)
+				} else {
+					ownASLoop = hasOwnASLoop(localAS, allowOwnAS, aspath)
+				}
+				if ownASLoop || (migration && hasOwnASLoop(migrationAS, allowOwnAS, aspath)) {
 					path.SetRejected(true)
 					continue
 				}
+				// RFC7705 3.1. No Prepend: the local-as is prepended to
+				// the received routes as if they were received by the
+				// router in the local-as.
+				if migration && !noPrepend && !path.IsWithdraw {
+					path.PrependAsn(migrationAS, 1, false)
+				}
 			}
 			// RFC4456 8. Avoiding Routing Information Loops
 			// A router that recognizes the ORIGINATOR_ID attribute SHOULD
@@ -615,23 +743,61 @@ func (peer *peer) handleUpdate(e *fsmMsg) ([]*table.Path, []bgp.RouteFamily, *bg
 			peer.fsm.lock.RUnlock()
 			if isIBGPPeer {
 				if id := path.GetOriginatorID(); routerId == id.String() {
-					peer.fsm.logger.Debug("Originator ID is mine, ignore",
-						log.Fields{
-							"Topic":        "Peer",
-							"Key":          peer.ID(),
-							"OriginatorID": id,
-							"Data":         path})
-					path.SetRejected(true)
-					continue
+					// RFC7611 2. ACCEPT_OWN Community
+					// The route of the own origin is accepted to be
+					// imported into the VRFs other than the source one.
+					if peer.acceptOwn(path) {
+						path.SetAcceptOwn(true)
+					} else {
+						peer.fsm.logger.Debug("Originator ID is mine, ignore",
+							log.Fields{
+								"Topic":        "Peer",
+								"Key":          peer.ID(),
+								"OriginatorID": id,
+								"Data":         path})
+						path.SetRejected(true)
+						continue
+					}
+				}
+				// If the local CLUSTER_ID is found in the CLUSTER_LIST,
+				// the advertisement received SHOULD be ignored.
+				if peer.isRouteReflectorClient() {
+					peer.fsm.lock.RLock()
+					clusterID := peer.fsm.peerInfo.RouteReflectorClusterID
+					peer.fsm.lock.RUnlock()
+					if hasClusterID(path, clusterID) {
+						peer.fsm.logger.Debug("cluster list path attribute has local cluster id, ignore",
+							log.Fields{
+								"Topic":     "Peer",
+								"Key":       peer.ID(),
+								"ClusterID": clusterID,
+								"Data":      path})
+						path.SetRejected(true)
+						continue
+					}
 				}
 			}
+			// RFC8955 6. Validation Procedure
+			peer.fsm.lock.RLock()
+			flowspecValidation := peer.fsm.pConf.Config.FlowspecValidation
+			peer.fsm.lock.RUnlock()
+			if flowspecValidation && !path.IsWithdraw && !peer.localRib.IsFlowSpecFeasible(path) {
+				peer.fsm.logger.Debug("Flowspec validation failed, reject",
+					log.Fields{
+						"Topic": "Peer",
+						"Key":   peer.ID(),
+						"Data":  path})
+				path.SetRejected(true)
+				continue
+			}
 			paths = append(paths, path)
 		}
-		peer.adjRibIn.Update(e.PathList)
+		record(e.PathList, peer.adjRibIn.Update(e.PathList))
 		peer.fsm.lock.RLock()
 		peerAfiSafis := peer.fsm.pConf.AfiSafis
 		peer.fsm.lock.RUnlock()
 		for _, af := range peerAfiSafis {
+			peer.checkPeeringdbPrefixes(af.State.Family)
 			if msg := peer.doPrefixLimit(af.State.Family, &af.PrefixLimit.Config); msg != nil {
 				return nil, nil, msg
 			}
@@ -652,7 +818,7 @@ func (peer *peer) StaleAll(rfList []bgp.RouteFamily) []*table.Path {
 	return peer.adjRibIn.StaleAll(rfList)
 }
 
-func (peer *peer) PassConn(conn *net.TCPConn) {
+func (peer *peer) PassConn(conn net.Conn) {
 	select {
 	case peer.fsm.connCh <- conn:
 	default:
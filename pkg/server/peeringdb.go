@@ -0,0 +1,352 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/log"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+const (
+	// the number of the AS numbers looked up with a request
+	peeringdbBatchSize = 100
+	peeringdbTimeout   = 30 * time.Second
+)
+
+type peeringdbRecord struct {
+	asn       uint32
+	name      string
+	ipv4      uint32
+	ipv6      uint32
+	timestamp time.Time
+}
+
+func (r *peeringdbRecord) prefixes(family bgp.RouteFamily) uint32 {
+	switch family {
+	case bgp.RF_IPv4_UC:
+		return r.ipv4
+	case bgp.RF_IPv6_UC:
+		return r.ipv6
+	}
+	return 0
+}
+
+func (r *peeringdbRecord) toApiStruct() *api.PeeringDbRecord {
+	return &api.PeeringDbRecord{
+		Asn:          r.asn,
+		Name:         r.name,
+		Ipv4Prefixes: r.ipv4,
+		Ipv6Prefixes: r.ipv6,
+		Timestamp:    timestamppb.New(r.timestamp),
+	}
+}
+
+// peeringdbNet is the network object of the PeeringDB API.
+type peeringdbNet struct {
+	Asn           uint32 `json:"asn"`
+	Name          string `json:"name"`
+	InfoPrefixes4 uint32 `json:"info_prefixes4"`
+	InfoPrefixes6 uint32 `json:"info_prefixes6"`
+}
+
+// peeringdbPrefixes is the PeeringDB record of a peer, with which the
+// number of the received prefixes is compared.
+type peeringdbPrefixes struct {
+	record     *peeringdbRecord
+	warningPct uint32
+	warned     map[bgp.RouteFamily]bool
+}
+
+func (peer *peer) checkPeeringdbPrefixes(family bgp.RouteFamily) {
+	p := peer.peeringdb
+	if p == nil || p.warningPct == 0 || p.warned[family] {
+		return
+	}
+	record := p.record.prefixes(family)
+	if record == 0 {
+		return
+	}
+	count := peer.adjRibIn.Count([]bgp.RouteFamily{family})
+	if uint64(count)*100 >= uint64(record)*uint64(p.warningPct) {
+		p.warned[family] = true
+		peer.fsm.logger.Warn("prefix count approaching the PeeringDB record",
+			log.Fields{
+				"Topic":  "Peer",
+				"Key":    peer.ID(),
+				"Family": family.String(),
+				"Count":  count,
+				"Record": record})
+	}
+}
+
+type peeringdbClient struct {
+	s          *BgpServer
+	url        string
+	apiKey     string
+	interval   time.Duration
+	multiplier float64
+	warningPct uint32
+	httpClient *http.Client
+	// accessed only in the server goroutine
+	records   map[uint32]*peeringdbRecord
+	triggerCh chan struct{}
+	dead      chan struct{}
+}
+
+func newPeeringdbClient(s *BgpServer, r *api.EnablePeeringDbRequest) (*peeringdbClient, error) {
+	c := &peeringdbClient{
+		s:          s,
+		url:        strings.TrimSuffix(r.Url, "/"),
+		apiKey:     r.ApiKey,
+		interval:   time.Duration(r.RefreshInterval) * time.Second,
+		multiplier: r.Multiplier,
+		warningPct: r.WarningThresholdPct,
+		httpClient: &http.Client{Timeout: peeringdbTimeout},
+		records:    make(map[uint32]*peeringdbRecord),
+		triggerCh:  make(chan struct{}, 1),
+		dead:       make(chan struct{}),
+	}
+	if c.url == "" {
+		c.url = oc.DEFAULT_PEERINGDB_URL
+	}
+	if c.interval == 0 {
+		c.interval = oc.DEFAULT_PEERINGDB_REFRESH_INTERVAL * time.Second
+	}
+	if c.multiplier == 0 {
+		c.multiplier = oc.DEFAULT_PEERINGDB_MULTIPLIER
+	} else if c.multiplier < 1 {
+		return nil, fmt.Errorf("peeringdb multiplier must be 1 or more: %v", c.multiplier)
+	}
+	if c.warningPct > 100 {
+		return nil, fmt.Errorf("peeringdb warning threshold must be 100 or less: %d", c.warningPct)
+	}
+	go c.loop()
+	return c, nil
+}
+
+func (c *peeringdbClient) stop() {
+	close(c.dead)
+}
+
+// trigger makes the client look up the AS numbers without records.
+func (c *peeringdbClient) trigger() {
+	select {
+	case c.triggerCh <- struct{}{}:
+	default:
+	}
+}
+
+func (c *peeringdbClient) fetch(ctx context.Context, asns []uint32) ([]*peeringdbRecord, error) {
+	records := make([]*peeringdbRecord, 0, len(asns))
+	for i := 0; i < len(asns); i += peeringdbBatchSize {
+		batch := asns[i:]
+		if len(batch) > peeringdbBatchSize {
+			batch = batch[:peeringdbBatchSize]
+		}
+		l := make([]string, 0, len(batch))
+		for _, asn := range batch {
+			l = append(l, strconv.FormatUint(uint64(asn), 10))
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+"/net?asn__in="+strings.Join(l, ","), nil)
+		if err != nil {
+			return records, err
+		}
+		if c.apiKey != "" {
+			req.Header.Set("Authorization", "Api-Key "+c.apiKey)
+		}
+		rsp, err := c.httpClient.Do(req)
+		if err != nil {
+			return records, err
+		}
+		var body struct {
+			Data []peeringdbNet `json:"data"`
+		}
+		err = json.NewDecoder(rsp.Body).Decode(&body)
+		rsp.Body.Close()
+		if rsp.StatusCode != http.StatusOK {
+			return records, fmt.Errorf("peeringdb returned %s", rsp.Status)
+		}
+		if err != nil {
+			return records, err
+		}
+		now := time.Now()
+		for _, n := range body.Data {
+			records = append(records, &peeringdbRecord{
+				asn:       n.Asn,
+				name:      n.Name,
+				ipv4:      n.InfoPrefixes4,
+				ipv6:      n.InfoPrefixes6,
+				timestamp: now,
+			})
+		}
+	}
+	return records, nil
+}
+
+// setMaxPrefixes sets the max prefixes of the address families from the
+// record of the AS. It returns false if the record isn't found.
+func (c *peeringdbClient) setMaxPrefixes(asn uint32, afiSafis []oc.AfiSafi) bool {
+	record, y := c.records[asn]
+	if !y {
+		return false
+	}
+	for i := range afiSafis {
+		if n := record.prefixes(afiSafis[i].State.Family); n > 0 {
+			afiSafis[i].PrefixLimit.Config.MaxPrefixes = uint32(math.Ceil(float64(n) * c.multiplier))
+		}
+	}
+	return true
+}
+
+// attach makes the peer compare the number of the received prefixes with
+// the record.
+func (c *peeringdbClient) attach(peer *peer, asn uint32) {
+	record, y := c.records[asn]
+	if !y {
+		peer.peeringdb = nil
+		return
+	}
+	if peer.peeringdb != nil && peer.peeringdb.record == record {
+		return
+	}
+	peer.peeringdb = &peeringdbPrefixes{
+		record:     record,
+		warningPct: c.warningPct,
+		warned:     make(map[bgp.RouteFamily]bool),
+	}
+}
+
+func peeringdbPeerAs(peer *peer) (uint32, bool) {
+	peer.fsm.lock.RLock()
+	defer peer.fsm.lock.RUnlock()
+	asn := peer.fsm.pConf.Config.PeerAs
+	if asn == 0 {
+		asn = peer.fsm.pConf.State.PeerAs
+	}
+	return asn, peer.fsm.pConf.Config.PeeringdbMaxPrefix && asn != 0
+}
+
+// asns returns the AS numbers of the peers to be looked up.
+func (c *peeringdbClient) asns(all bool) []uint32 {
+	m := make(map[uint32]struct{})
+	asns := make([]uint32, 0)
+	for _, peer := range c.s.neighborMap {
+		asn, enabled := peeringdbPeerAs(peer)
+		if !enabled {
+			continue
+		}
+		if _, y := c.records[asn]; y && !all {
+			continue
+		}
+		if _, y := m[asn]; !y {
+			m[asn] = struct{}{}
+			asns = append(asns, asn)
+		}
+	}
+	return asns
+}
+
+func (c *peeringdbClient) apply() {
+	for _, peer := range c.s.neighborMap {
+		asn, enabled := peeringdbPeerAs(peer)
+		if !enabled {
+			peer.peeringdb = nil
+			continue
+		}
+		peer.fsm.lock.RLock()
+		afiSafis := make([]oc.AfiSafi, len(peer.fsm.pConf.AfiSafis))
+		copy(afiSafis, peer.fsm.pConf.AfiSafis)
+		peer.fsm.lock.RUnlock()
+		if c.setMaxPrefixes(asn, afiSafis) {
+			if err := peer.updatePrefixLimitConfig(afiSafis); err != nil {
+				c.s.logger.Warn("failed to set max prefixes from peeringdb",
+					log.Fields{
+						"Topic": "Peer",
+						"Key":   peer.ID(),
+						"Error": err})
+			}
+		}
+		c.attach(peer, asn)
+	}
+}
+
+func (c *peeringdbClient) loop() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-c.dead
+		cancel()
+	}()
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	all := true
+	for {
+		var asns []uint32
+		if err := c.s.mgmtOperation(func() error {
+			asns = c.asns(all)
+			return nil
+		}, false); err != nil {
+			return
+		}
+		var records []*peeringdbRecord
+		if len(asns) > 0 {
+			var err error
+			records, err = c.fetch(ctx, asns)
+			if err != nil {
+				c.s.logger.Warn("failed to look up peeringdb",
+					log.Fields{
+						"Topic": "peeringdb",
+						"Error": err})
+			}
+		}
+		if err := c.s.mgmtOperation(func() error {
+			if c.s.peeringdb != c {
+				// disabled
+				return nil
+			}
+			for _, r := range records {
+				c.records[r.asn] = r
+			}
+			c.apply()
+			return nil
+		}, false); err != nil {
+			return
+		}
+
+		select {
+		case <-ticker.C:
+			all = true
+		case <-c.triggerCh:
+			all = false
+		case <-c.dead:
+			return
+		}
+	}
+}
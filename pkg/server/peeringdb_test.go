@@ -0,0 +1,122 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/osrg/gobgp/v3/api"
+)
+
+func TestPeeringdbMaxPrefix(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	nets := map[string]peeringdbNet{
+		"65001": {Asn: 65001, Name: "AS65001", InfoPrefixes4: 100, InfoPrefixes6: 10},
+		"65002": {Asn: 65002, Name: "AS65002", InfoPrefixes4: 1000},
+	}
+	requests := make(chan string, 10)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/net" || r.Header.Get("Authorization") != "Api-Key secret" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		requests <- r.URL.Query().Get("asn__in")
+		var body struct {
+			Data []peeringdbNet `json:"data"`
+		}
+		for _, asn := range strings.Split(r.URL.Query().Get("asn__in"), ",") {
+			if n, y := nets[asn]; y {
+				body.Data = append(body.Data, n)
+			}
+		}
+		json.NewEncoder(w).Encode(&body)
+	}))
+	defer ts.Close()
+
+	s := runNewServer(t, 1, "1.1.1.1", -1)
+	defer s.StopBgp(ctx, &api.StopBgpRequest{})
+
+	addPeer := func(addr string, asn uint32) {
+		err := s.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{
+			Conf: &api.PeerConf{
+				NeighborAddress:    addr,
+				PeerAsn:            asn,
+				PeeringdbMaxPrefix: true,
+			},
+			AfiSafis: []*api.AfiSafi{
+				{Config: &api.AfiSafiConfig{Family: &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST}, Enabled: true}},
+				{Config: &api.AfiSafiConfig{Family: &api.Family{Afi: api.Family_AFI_IP6, Safi: api.Family_SAFI_UNICAST}, Enabled: true}},
+			},
+		}})
+		assert.NoError(err)
+	}
+	maxPrefixes := func(addr string) []uint32 {
+		var l []uint32
+		s.ListPeer(ctx, &api.ListPeerRequest{Address: addr}, func(p *api.Peer) {
+			for _, a := range p.AfiSafis {
+				l = append(l, a.GetPrefixLimits().GetMaxPrefixes())
+			}
+		})
+		return l
+	}
+
+	addPeer("10.0.0.1", 65001)
+	err := s.EnablePeeringDb(ctx, &api.EnablePeeringDbRequest{
+		Url:        ts.URL + "/api/",
+		ApiKey:     "secret",
+		Multiplier: 1.5,
+	})
+	assert.NoError(err)
+	assert.Equal("65001", <-requests)
+	assert.Eventually(func() bool {
+		l := maxPrefixes("10.0.0.1")
+		return len(l) == 2 && l[0] == 150 && l[1] == 15
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// a new peer is looked up without waiting for the refresh
+	addPeer("10.0.0.2", 65002)
+	assert.Equal("65002", <-requests)
+	assert.Eventually(func() bool {
+		l := maxPrefixes("10.0.0.2")
+		return len(l) == 2 && l[0] == 1500 && l[1] == 0
+	}, 10*time.Second, 100*time.Millisecond)
+
+	var records []*api.PeeringDbRecord
+	err = s.ListPeeringDbRecord(ctx, &api.ListPeeringDbRecordRequest{}, func(r *api.PeeringDbRecord) {
+		records = append(records, r)
+	})
+	assert.NoError(err)
+	assert.Len(records, 2)
+	assert.Equal(uint32(65001), records[0].Asn)
+	assert.Equal(uint32(100), records[0].Ipv4Prefixes)
+
+	err = s.EnablePeeringDb(ctx, &api.EnablePeeringDbRequest{})
+	assert.Error(err)
+	err = s.DisablePeeringDb(ctx, &api.DisablePeeringDbRequest{})
+	assert.NoError(err)
+	// the limits are left
+	assert.Equal([]uint32{150, 15}, maxPrefixes("10.0.0.1"))
+}
@@ -0,0 +1,269 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/quic-go/quic-go"
+
+	"github.com/osrg/gobgp/v3/pkg/log"
+)
+
+// Experimental BGP over QUIC (draft-retana-idr-bgp-quic). A BGP session
+// runs over a single bidirectional stream opened by the connecting side,
+// so the FSM and the message codec see a plain net.Conn.
+
+const (
+	quicALPN = "bgp"
+	// how long a connecting side may take to open the BGP stream
+	quicStreamAcceptTimeout = 30 * time.Second
+	// how long Close waits for the peer to read the pending messages
+	// (e.g. NOTIFICATION) before tearing down the QUIC connection
+	quicCloseTimeout = time.Second
+)
+
+var quicConfig = &quic.Config{
+	KeepAlivePeriod: 10 * time.Second,
+}
+
+type quicConn struct {
+	quic.Stream
+	conn quic.Connection
+	// the local socket of an outgoing connection, nil if accepted
+	pconn net.PacketConn
+	once  sync.Once
+}
+
+func (c *quicConn) LocalAddr() net.Addr {
+	return c.conn.LocalAddr()
+}
+
+func (c *quicConn) RemoteAddr() net.Addr {
+	return c.conn.RemoteAddr()
+}
+
+func (c *quicConn) Close() error {
+	c.once.Do(func() {
+		// like TCP FIN; the data already written is still delivered.
+		c.Stream.Close()
+		// unblock the reader
+		c.Stream.CancelRead(0)
+		go func() {
+			t := time.NewTimer(quicCloseTimeout)
+			select {
+			case <-c.conn.Context().Done():
+			case <-t.C:
+			}
+			t.Stop()
+			c.conn.CloseWithError(0, "")
+			if c.pconn != nil {
+				c.pconn.Close()
+			}
+		}()
+	})
+	return nil
+}
+
+type quicListener struct {
+	l      *quic.Listener
+	pconn  net.PacketConn
+	ch     chan struct{}
+	cancel context.CancelFunc
+}
+
+func (l *quicListener) Close() error {
+	if err := l.l.Close(); err != nil {
+		return err
+	}
+	l.cancel()
+	<-l.ch
+	return l.pconn.Close()
+}
+
+func quicSelfSignedCertificate() (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	now := time.Now()
+	template := x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: "gobgp"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.AddDate(10, 0, 0),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}, nil
+}
+
+// avoid mapped IPv6 address
+func newQUICListener(logger log.Logger, address string, port uint32, certFile, keyFile string, ch chan acceptedConn) (*quicListener, error) {
+	proto := "udp4"
+	if ip := net.ParseIP(address); ip == nil {
+		return nil, fmt.Errorf("can't listen on %s", address)
+	} else if ip.To4() == nil {
+		proto = "udp6"
+	}
+	addr := net.JoinHostPort(address, strconv.Itoa(int(port)))
+
+	var cert tls.Certificate
+	var err error
+	if certFile != "" || keyFile != "" {
+		cert, err = tls.LoadX509KeyPair(certFile, keyFile)
+	} else {
+		cert, err = quicSelfSignedCertificate()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load QUIC certificate: %w", err)
+	}
+	tlsConf := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   []string{quicALPN},
+	}
+
+	udpAddr, err := net.ResolveUDPAddr(proto, addr)
+	if err != nil {
+		return nil, err
+	}
+	pconn, err := net.ListenUDP(proto, udpAddr)
+	if err != nil {
+		return nil, err
+	}
+	listener, err := quic.Listen(pconn, tlsConf, quicConfig)
+	if err != nil {
+		pconn.Close()
+		return nil, err
+	}
+
+	closeCh := make(chan struct{})
+	listenerCtx, listenerCancel := context.WithCancel(context.Background())
+	go func() error {
+		for {
+			conn, err := listener.Accept(listenerCtx)
+			if err != nil {
+				close(closeCh)
+				if !errors.Is(err, quic.ErrServerClosed) && !errors.Is(err, context.Canceled) {
+					logger.Warn("Failed to Accept QUIC connection",
+						log.Fields{
+							"Topic": "Peer",
+							"Error": err,
+						})
+				}
+				return err
+			}
+			go func() {
+				ctx, cancel := context.WithTimeout(listenerCtx, quicStreamAcceptTimeout)
+				defer cancel()
+				stream, err := conn.AcceptStream(ctx)
+				if err != nil {
+					logger.Debug("Failed to accept QUIC stream",
+						log.Fields{
+							"Topic": "Peer",
+							"Key":   conn.RemoteAddr().String(),
+							"Error": err,
+						})
+					conn.CloseWithError(0, "")
+					return
+				}
+				select {
+				case ch <- acceptedConn{conn: &quicConn{Stream: stream, conn: conn}}:
+				case <-listenerCtx.Done():
+					conn.CloseWithError(0, "")
+				}
+			}()
+		}
+	}()
+	return &quicListener{
+		l:      listener,
+		pconn:  pconn,
+		ch:     closeCh,
+		cancel: listenerCancel,
+	}, nil
+}
+
+func dialQUIC(ctx context.Context, laddr *net.UDPAddr, raddr string, caFile string, timeout time.Duration) (net.Conn, error) {
+	host, _, err := net.SplitHostPort(raddr)
+	if err != nil {
+		return nil, err
+	}
+	udpAddr, err := net.ResolveUDPAddr("udp", raddr)
+	if err != nil {
+		return nil, err
+	}
+	tlsConf := &tls.Config{
+		ServerName: host,
+		NextProtos: []string{quicALPN},
+	}
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in %s", caFile)
+		}
+		tlsConf.RootCAs = pool
+	} else {
+		tlsConf.InsecureSkipVerify = true
+	}
+
+	pconn, err := net.ListenUDP("udp", laddr)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	conn, err := quic.Dial(ctx, pconn, udpAddr, tlsConf, quicConfig)
+	if err != nil {
+		pconn.Close()
+		return nil, err
+	}
+	stream, err := conn.OpenStreamSync(ctx)
+	if err != nil {
+		conn.CloseWithError(0, "")
+		pconn.Close()
+		return nil, err
+	}
+	return &quicConn{Stream: stream, conn: conn, pconn: pconn}, nil
+}
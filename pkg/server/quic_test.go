@@ -0,0 +1,136 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apb "google.golang.org/protobuf/types/known/anypb"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/log"
+)
+
+func TestQUICTransport(t *testing.T) {
+	assert := assert.New(t)
+	s1 := NewBgpServer()
+	s1.logger.SetLevel(log.DebugLevel)
+	go s1.Serve()
+	err := s1.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:            1,
+			RouterId:       "1.1.1.1",
+			ListenPort:     -1,
+			QuicListenPort: 10179,
+		},
+	})
+	assert.Nil(err)
+	defer s1.StopBgp(context.Background(), &api.StopBgpRequest{})
+
+	n1 := &oc.Neighbor{
+		Config: oc.NeighborConfig{
+			NeighborAddress: "127.0.0.1",
+			PeerAs:          2,
+		},
+		Transport: oc.Transport{
+			Config: oc.TransportConfig{
+				PassiveMode:       true,
+				TransportProtocol: oc.TRANSPORT_PROTOCOL_TYPE_QUIC,
+			},
+		},
+	}
+	err = s1.AddPeer(context.Background(), &api.AddPeerRequest{Peer: oc.NewPeerFromConfigStruct(n1)})
+	assert.Nil(err)
+
+	s2 := NewBgpServer()
+	go s2.Serve()
+	err = s2.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        2,
+			RouterId:   "2.2.2.2",
+			ListenPort: -1,
+		},
+	})
+	assert.Nil(err)
+	defer s2.StopBgp(context.Background(), &api.StopBgpRequest{})
+
+	n2 := &oc.Neighbor{
+		Config: oc.NeighborConfig{
+			NeighborAddress: "127.0.0.1",
+			PeerAs:          1,
+		},
+		Transport: oc.Transport{
+			Config: oc.TransportConfig{
+				RemotePort:        10179,
+				TransportProtocol: oc.TRANSPORT_PROTOCOL_TYPE_QUIC,
+			},
+		},
+		Timers: oc.Timers{
+			Config: oc.TimersConfig{
+				ConnectRetry:           1,
+				IdleHoldTimeAfterReset: 1,
+			},
+		},
+	}
+	ch := make(chan struct{})
+	go waitEstablished(s1, ch)
+	err = s2.AddPeer(context.Background(), &api.AddPeerRequest{Peer: oc.NewPeerFromConfigStruct(n2)})
+	assert.Nil(err)
+	<-ch
+
+	family := &api.Family{
+		Afi:  api.Family_AFI_IP,
+		Safi: api.Family_SAFI_UNICAST,
+	}
+	nlri, _ := apb.New(&api.IPAddressPrefix{
+		Prefix:    "10.0.0.0",
+		PrefixLen: 24,
+	})
+	a1, _ := apb.New(&api.OriginAttribute{
+		Origin: 0,
+	})
+	a2, _ := apb.New(&api.NextHopAttribute{
+		NextHop: "10.0.0.1",
+	})
+	_, err = s2.AddPath(context.Background(), &api.AddPathRequest{
+		TableType: api.TableType_GLOBAL,
+		Path: &api.Path{
+			Family: family,
+			Nlri:   nlri,
+			Pattrs: []*apb.Any{a1, a2},
+		},
+	})
+	assert.Nil(err)
+
+	received := false
+	for i := 0; i < 50 && !received; i++ {
+		time.Sleep(100 * time.Millisecond)
+		s1.ListPath(context.Background(), &api.ListPathRequest{
+			TableType: api.TableType_ADJ_IN,
+			Family:    family,
+			Name:      "127.0.0.1",
+		}, func(d *api.Destination) {
+			if d.Prefix == "10.0.0.0/24" {
+				received = true
+			}
+		})
+	}
+	assert.True(received)
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+// updateRateLimiter limits the rate of the UPDATE messages sent to a
+// neighbor with the token buckets of the messages and the prefixes. The
+// limits can be changed while the messages are sent.
+type updateRateLimiter struct {
+	mu sync.Mutex
+	// nil if unlimited
+	messages *rate.Limiter
+	prefixes *rate.Limiter
+}
+
+func newUpdateRateLimiter(c *oc.UpdateRateLimitConfig) *updateRateLimiter {
+	l := &updateRateLimiter{}
+	l.setLimit(c)
+	return l
+}
+
+// setLimit sets the limits per second, unlimited if zero. The burst is
+// the limit so that up to one second worth of messages is sent at once.
+func (l *updateRateLimiter) setLimit(c *oc.UpdateRateLimitConfig) {
+	newLimiter := func(n uint32) *rate.Limiter {
+		if n == 0 {
+			return nil
+		}
+		return rate.NewLimiter(rate.Limit(n), int(n))
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.messages = newLimiter(c.MessagesPerSecond)
+	l.prefixes = newLimiter(c.PrefixesPerSecond)
+}
+
+// reserve takes the tokens to send the message and returns how long to
+// wait before sending it.
+func (l *updateRateLimiter) reserve(m *bgp.BGPMessage) time.Duration {
+	if m.Header.Type != bgp.BGP_MSG_UPDATE {
+		return 0
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	var delay time.Duration
+	if l.messages != nil {
+		delay = l.messages.ReserveN(now, 1).DelayFrom(now)
+	}
+	if n := updatePrefixCount(m.Body.(*bgp.BGPUpdate)); l.prefixes != nil && n > 0 {
+		// the message with more prefixes than the burst takes the whole
+		// bucket
+		if b := l.prefixes.Burst(); n > b {
+			n = b
+		}
+		if d := l.prefixes.ReserveN(now, n).DelayFrom(now); d > delay {
+			delay = d
+		}
+	}
+	return delay
+}
+
+// updatePrefixCount returns the number of the prefixes advertised and
+// withdrawn by the UPDATE message.
+func updatePrefixCount(u *bgp.BGPUpdate) int {
+	n := len(u.NLRI) + len(u.WithdrawnRoutes)
+	for _, a := range u.PathAttributes {
+		switch a := a.(type) {
+		case *bgp.PathAttributeMpReachNLRI:
+			n += len(a.Value)
+		case *bgp.PathAttributeMpUnreachNLRI:
+			n += len(a.Value)
+		}
+	}
+	return n
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func TestUpdateRateLimiter(t *testing.T) {
+	assert := assert.New(t)
+
+	update := func(n int) *bgp.BGPMessage {
+		nlri := make([]*bgp.IPAddrPrefix, 0, n)
+		for i := 0; i < n; i++ {
+			nlri = append(nlri, bgp.NewIPAddrPrefix(24, fmt.Sprintf("10.0.%d.0", i)))
+		}
+		return bgp.NewBGPUpdateMessage(nil, []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop("10.0.0.1"),
+		}, nlri)
+	}
+
+	l := newUpdateRateLimiter(&oc.UpdateRateLimitConfig{})
+	for i := 0; i < 10; i++ {
+		assert.Zero(l.reserve(update(10)))
+	}
+
+	l.setLimit(&oc.UpdateRateLimitConfig{MessagesPerSecond: 2})
+	assert.Zero(l.reserve(update(1)))
+	assert.Zero(l.reserve(update(1)))
+	assert.InDelta(500*time.Millisecond, l.reserve(update(1)), float64(50*time.Millisecond))
+	// only the UPDATE messages are limited
+	assert.Zero(l.reserve(bgp.NewBGPKeepAliveMessage()))
+
+	l = newUpdateRateLimiter(&oc.UpdateRateLimitConfig{PrefixesPerSecond: 10})
+	assert.Zero(l.reserve(update(10)))
+	assert.InDelta(500*time.Millisecond, l.reserve(update(5)), float64(50*time.Millisecond))
+	// the message with more prefixes than the burst waits for the whole
+	// bucket
+	assert.InDelta(1500*time.Millisecond, l.reserve(update(20)), float64(50*time.Millisecond))
+
+	l.setLimit(&oc.UpdateRateLimitConfig{})
+	assert.Zero(l.reserve(update(10)))
+}
+
+func TestUpdatePrefixCount(t *testing.T) {
+	assert := assert.New(t)
+
+	m := bgp.NewBGPUpdateMessage([]*bgp.IPAddrPrefix{bgp.NewIPAddrPrefix(24, "10.0.0.0")}, []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeMpReachNLRI("2001:db8::1", []bgp.AddrPrefixInterface{
+			bgp.NewIPv6AddrPrefix(64, "2001:db8:1::"),
+			bgp.NewIPv6AddrPrefix(64, "2001:db8:2::"),
+		}),
+		bgp.NewPathAttributeMpUnreachNLRI([]bgp.AddrPrefixInterface{bgp.NewIPv6AddrPrefix(64, "2001:db8:3::")}),
+	}, []*bgp.IPAddrPrefix{bgp.NewIPAddrPrefix(24, "10.0.1.0")})
+	assert.Equal(5, updatePrefixCount(m.Body.(*bgp.BGPUpdate)))
+}
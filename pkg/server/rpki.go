@@ -52,7 +52,7 @@ type roaEvent struct {
 	EventType roaEventType
 	Src       string
 	Data      []byte
-	conn      *net.TCPConn
+	conn      io.ReadWriteCloser
 }
 
 type roaManager struct {
@@ -73,10 +73,10 @@ func newROAManager(table *table.ROATable, logger log.Logger) *roaManager {
 }
 
 func (m *roaManager) enabled() bool {
-	return len(m.clientMap) != 0
+	return len(m.clientMap) != 0 || m.table.SLURM() != nil
 }
 
-func (m *roaManager) AddServer(host string, lifetime int64) error {
+func (m *roaManager) AddServer(host string, lifetime int64, transport oc.RpkiTransportType, preference uint8, dial rtrDialer) error {
 	address, port, err := net.SplitHostPort(host)
 	if err != nil {
 		return err
@@ -87,7 +87,8 @@ func (m *roaManager) AddServer(host string, lifetime int64) error {
 	if _, ok := m.clientMap[host]; ok {
 		return fmt.Errorf("ROA server exists %s", host)
 	}
-	m.clientMap[host] = newRoaClient(address, port, m.eventCh, lifetime)
+	m.clientMap[host] = newRoaClient(address, port, m.eventCh, lifetime, transport, preference, dial)
+	m.failover()
 	return nil
 }
 
@@ -99,9 +100,43 @@ func (m *roaManager) DeleteServer(host string) error {
 	client.stop()
 	m.table.DeleteAll(host)
 	delete(m.clientMap, host)
+	m.failover()
 	return nil
 }
 
+// failover puts the ROA servers on standby while a server with the higher
+// preference is synchronized, and wakes them up when no such server remains
+// (RFC 6810 10).
+func (m *roaManager) failover() {
+	best := -1
+	for _, client := range m.clientMap {
+		if !client.standby && client.conn != nil && client.endOfData && int(client.preference) > best {
+			best = int(client.preference)
+		}
+	}
+	for _, client := range m.clientMap {
+		if best >= 0 && int(client.preference) < best {
+			if !client.standby {
+				m.logger.Info("ROA server is on standby",
+					log.Fields{
+						"Topic":      "rpki",
+						"Key":        client.host,
+						"Preference": client.preference})
+				client.pause()
+				client.hasSession = false
+				m.table.DeleteAll(client.host)
+			}
+		} else if client.standby {
+			m.logger.Info("ROA server is resumed",
+				log.Fields{
+					"Topic":      "rpki",
+					"Key":        client.host,
+					"Preference": client.preference})
+			client.resume()
+		}
+	}
+}
+
 func (m *roaManager) Enable(address string) error {
 	for network, client := range m.clientMap {
 		add, _, _ := net.SplitHostPort(network)
@@ -118,7 +153,8 @@ func (m *roaManager) Disable(address string) error {
 		add, _, _ := net.SplitHostPort(network)
 		if add == address {
 			client.reset()
-			m.table.DeleteAll(add)
+			client.hasSession = false
+			m.table.DeleteAll(network)
 			return nil
 		}
 	}
@@ -176,19 +212,30 @@ func (m *roaManager) HandleROAEvent(ev *roaEvent) {
 		client.pendingROAs = make([]*table.ROA, 0)
 		client.state.RpkiMessages = oc.RpkiMessages{}
 		client.conn = nil
-		go client.tryConnect()
-		client.timer = time.AfterFunc(time.Duration(client.lifetime)*time.Second, client.lifetimeout)
+		if !client.standby {
+			client.connect()
+			client.timer = time.AfterFunc(time.Duration(client.lifetime)*time.Second, client.lifetimeout)
+		}
 		client.oldSessionID = client.sessionID
+		m.failover()
 	case roaConnected:
+		if client.standby {
+			ev.conn.Close()
+			return
+		}
 		m.logger.Info("ROA server is connected",
 			log.Fields{
 				"Topic": "rpki",
 				"Key":   ev.Src})
 		client.conn = ev.conn
 		client.state.Uptime = time.Now().Unix()
-		go client.established()
+		client.synchronize()
+		go client.established(ev.conn)
 	case roaRTR:
 		m.handleRTRMsg(client, &client.state, ev.Data)
+		if client.endOfData {
+			m.failover()
+		}
 	case roaLifetimeout:
 		// a) already reconnected but hasn't received
 		// EndOfData -> needs to delete stale ROAs
@@ -207,6 +254,7 @@ func (m *roaManager) HandleROAEvent(ev *roaEvent) {
 				log.Fields{
 					"Topic": "rpki",
 					"Key":   client.host})
+			client.hasSession = false
 			m.table.DeleteAll(client.host)
 		}
 	}
@@ -219,7 +267,9 @@ func (m *roaManager) handleRTRMsg(client *roaClient, state *oc.RpkiServerState,
 	if err == nil {
 		switch msg := m1.(type) {
 		case *rtr.RTRSerialNotify:
-			if before(client.serialNumber, msg.RTRCommon.SerialNumber) {
+			if !client.hasSession || client.sessionID != msg.RTRCommon.SessionID {
+				client.softReset()
+			} else if before(client.serialNumber, msg.RTRCommon.SerialNumber) {
 				client.enable(client.serialNumber)
 			} else if client.serialNumber == msg.RTRCommon.SerialNumber {
 				// nothing
@@ -232,6 +282,12 @@ func (m *roaManager) handleRTRMsg(client *roaClient, state *oc.RpkiServerState,
 		case *rtr.RTRResetQuery:
 		case *rtr.RTRCacheResponse:
 			received.CacheResponse++
+			if !client.resetting && client.sessionID != msg.SessionID {
+				// RFC 8210 5.5: the cache server has restarted and the
+				// serial numbers of the previous session are meaningless.
+				client.softReset()
+				break
+			}
 			client.endOfData = false
 		case *rtr.RTRIPPrefix:
 			family := bgp.AFI_IP
@@ -253,14 +309,16 @@ func (m *roaManager) handleRTRMsg(client *roaClient, state *oc.RpkiServerState,
 			}
 		case *rtr.RTREndOfData:
 			received.EndOfData++
-			if client.sessionID != msg.RTRCommon.SessionID {
-				// remove all ROAs related with the
-				// previous session
+			if client.resetting || client.sessionID != msg.RTRCommon.SessionID {
+				// the response to the Reset Query replaces all
+				// ROAs of the previous query or session
 				m.table.DeleteAll(client.host)
 			}
 			client.sessionID = msg.RTRCommon.SessionID
 			client.serialNumber = msg.RTRCommon.SerialNumber
 			client.endOfData = true
+			client.resetting = false
+			client.hasSession = true
 			if client.timer != nil {
 				client.timer.Stop()
 				client.timer = nil
@@ -284,6 +342,20 @@ func (m *roaManager) handleRTRMsg(client *roaClient, state *oc.RpkiServerState,
 	}
 }
 
+// synced returns true if any ROA server has sent the whole ROAs, or no
+// server is configured.
+func (m *roaManager) synced() bool {
+	if len(m.clientMap) == 0 {
+		return true
+	}
+	for _, client := range m.clientMap {
+		if !client.standby && client.conn != nil && client.endOfData {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *roaManager) GetServers() []*oc.RpkiServer {
 	recordsV4, prefixesV4 := m.table.Info(bgp.RF_IPv4_UC)
 	recordsV6, prefixesV6 := m.table.Info(bgp.RF_IPv6_UC)
@@ -315,7 +387,9 @@ func (m *roaManager) GetServers() []*oc.RpkiServer {
 				Address: addr,
 				// Note: RpkiServerConfig.Port is uint32 type, but the TCP/UDP
 				// port is 16-bit length.
-				Port: func() uint32 { p, _ := strconv.ParseUint(port, 10, 16); return uint32(p) }(),
+				Port:       func() uint32 { p, _ := strconv.ParseUint(port, 10, 16); return uint32(p) }(),
+				Preference: client.preference,
+				Transport:  client.transport,
 			},
 			State: client.state,
 		})
@@ -325,7 +399,7 @@ func (m *roaManager) GetServers() []*oc.RpkiServer {
 
 type roaClient struct {
 	host         string
-	conn         *net.TCPConn
+	conn         io.ReadWriteCloser
 	state        oc.RpkiServerState
 	eventCh      chan *roaEvent
 	sessionID    uint16
@@ -334,13 +408,28 @@ type roaClient struct {
 	timer        *time.Timer
 	lifetime     int64
 	endOfData    bool
-	pendingROAs  []*table.ROA
-	cancelfnc    context.CancelFunc
-	ctx          context.Context
+	// hasSession is true while the ROAs of the session are kept, so the
+	// Serial Query can continue the session after reconnecting. resetting
+	// is true until the response to the Reset Query ends.
+	hasSession  bool
+	resetting   bool
+	pendingROAs []*table.ROA
+	cancelfnc   context.CancelFunc
+	ctx         context.Context
+	dial        rtrDialer
+	transport   oc.RpkiTransportType
+	preference  uint8
+	// standby is true while a server with the higher preference is
+	// synchronized. connectCancel stops the connection attempts.
+	standby       bool
+	connectCancel context.CancelFunc
 }
 
-func newRoaClient(address, port string, ch chan *roaEvent, lifetime int64) *roaClient {
+func newRoaClient(address, port string, ch chan *roaEvent, lifetime int64, transport oc.RpkiTransportType, preference uint8, dial rtrDialer) *roaClient {
 	ctx, cancel := context.WithCancel(context.Background())
+	if dial == nil {
+		dial = dialRTRTCP
+	}
 	c := &roaClient{
 		host:        net.JoinHostPort(address, port),
 		eventCh:     ch,
@@ -348,8 +437,11 @@ func newRoaClient(address, port string, ch chan *roaEvent, lifetime int64) *roaC
 		pendingROAs: make([]*table.ROA, 0),
 		ctx:         ctx,
 		cancelfnc:   cancel,
+		dial:        dial,
+		transport:   transport,
+		preference:  preference,
 	}
-	go c.tryConnect()
+	c.connect()
 	return c
 }
 
@@ -376,11 +468,21 @@ func (c *roaClient) softReset() error {
 		}
 		c.state.RpkiMessages.RpkiSent.ResetQuery++
 		c.endOfData = false
+		c.resetting = true
 		c.pendingROAs = make([]*table.ROA, 0)
 	}
 	return nil
 }
 
+// synchronize sends the Serial Query to continue the previous session, or
+// the Reset Query to fetch all the ROAs (RFC 8210 8.1, 8.3).
+func (c *roaClient) synchronize() error {
+	if c.hasSession {
+		return c.enable(c.serialNumber)
+	}
+	return c.softReset()
+}
+
 func (c *roaClient) reset() {
 	if c.conn != nil {
 		c.conn.Close()
@@ -392,43 +494,72 @@ func (c *roaClient) stop() {
 	c.reset()
 }
 
-func (c *roaClient) tryConnect() {
+// pause closes the connection and stops connecting to the server until
+// resume is called.
+func (c *roaClient) pause() {
+	c.standby = true
+	if c.connectCancel != nil {
+		c.connectCancel()
+		c.connectCancel = nil
+	}
+	if c.timer != nil {
+		c.timer.Stop()
+		c.timer = nil
+	}
+	c.reset()
+}
+
+func (c *roaClient) resume() {
+	c.standby = false
+	c.connect()
+}
+
+func (c *roaClient) connect() {
+	ctx, cancel := context.WithCancel(c.ctx)
+	c.connectCancel = cancel
+	go c.tryConnect(ctx)
+}
+
+func (c *roaClient) tryConnect(ctx context.Context) {
 	for {
 		select {
-		case <-c.ctx.Done():
+		case <-ctx.Done():
 			return
 		default:
 		}
-		if conn, err := net.Dial("tcp", c.host); err != nil {
-			// better to use context with timeout
-			time.Sleep(connectRetryInterval * time.Second)
+		if conn, err := c.dial(ctx, c.host); err != nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(connectRetryInterval * time.Second):
+			}
 		} else {
-			c.eventCh <- &roaEvent{
+			select {
+			case <-ctx.Done():
+				conn.Close()
+			case c.eventCh <- &roaEvent{
 				EventType: roaConnected,
 				Src:       c.host,
-				conn:      conn.(*net.TCPConn),
+				conn:      conn,
+			}:
 			}
 			return
 		}
 	}
 }
 
-func (c *roaClient) established() (err error) {
+func (c *roaClient) established(conn io.ReadWriteCloser) (err error) {
 	defer func() {
-		c.conn.Close()
+		conn.Close()
 		c.eventCh <- &roaEvent{
 			EventType: roaDisconnected,
 			Src:       c.host,
 		}
 	}()
 
-	if err := c.softReset(); err != nil {
-		return err
-	}
-
 	for {
 		header := make([]byte, rtr.RTR_MIN_LEN)
-		if _, err = io.ReadFull(c.conn, header); err != nil {
+		if _, err = io.ReadFull(conn, header); err != nil {
 			return err
 		}
 		totalLen := binary.BigEndian.Uint32(header[4:8])
@@ -437,7 +568,7 @@ func (c *roaClient) established() (err error) {
 		}
 
 		body := make([]byte, totalLen-rtr.RTR_MIN_LEN)
-		if _, err = io.ReadFull(c.conn, body); err != nil {
+		if _, err = io.ReadFull(conn, body); err != nil {
 			return
 		}
 
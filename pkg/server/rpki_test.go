@@ -0,0 +1,324 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/log"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+	"github.com/osrg/gobgp/v3/pkg/packet/rtr"
+)
+
+type nopRTRConn struct {
+	closed bool
+}
+
+func (c *nopRTRConn) Read(p []byte) (int, error)  { return 0, io.EOF }
+func (c *nopRTRConn) Write(p []byte) (int, error) { return len(p), nil }
+func (c *nopRTRConn) Close() error                { c.closed = true; return nil }
+
+func TestROAManagerFailover(t *testing.T) {
+	assert := assert.New(t)
+
+	m := newROAManager(table.NewROATable(log.NewDefaultLogger()), log.NewDefaultLogger())
+	dial := func(ctx context.Context, host string) (io.ReadWriteCloser, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	for host, preference := range map[string]uint8{
+		"10.0.0.1:323": 10,
+		"10.0.0.2:323": 10,
+		"10.0.0.3:323": 5,
+	} {
+		assert.Nil(m.AddServer(host, 0, oc.RPKI_TRANSPORT_TYPE_TCP, preference, dial))
+	}
+	defer func() {
+		for _, c := range m.clientMap {
+			c.stop()
+		}
+	}()
+	c1, c2, c3 := m.clientMap["10.0.0.1:323"], m.clientMap["10.0.0.2:323"], m.clientMap["10.0.0.3:323"]
+
+	// no server is synchronized yet
+	assert.False(c3.standby)
+
+	// the preferred server is synchronized
+	conn1, conn3 := &nopRTRConn{}, &nopRTRConn{}
+	c1.conn, c1.endOfData = conn1, true
+	c3.conn, c3.endOfData = conn3, true
+	m.failover()
+	assert.False(c1.standby)
+	assert.False(c2.standby)
+	assert.True(c3.standby)
+	assert.True(conn3.closed)
+	assert.False(conn1.closed)
+
+	// the standby server doesn't reconnect
+	c3.conn, c3.endOfData = nil, false
+	m.HandleROAEvent(&roaEvent{EventType: roaConnected, Src: c3.host, conn: conn3})
+	assert.Nil(c3.conn)
+
+	// all the preferred servers go down
+	c1.conn, c1.endOfData = nil, false
+	m.failover()
+	assert.False(c3.standby)
+
+	// the server with the same preference keeps the others on standby
+	c2.conn, c2.endOfData = &nopRTRConn{}, true
+	m.failover()
+	assert.True(c3.standby)
+
+	assert.Nil(m.DeleteServer("10.0.0.2:323"))
+	assert.False(c3.standby)
+}
+
+type rtrTestConn struct {
+	nopRTRConn
+	sent []rtr.RTRMessage
+}
+
+func (c *rtrTestConn) Write(p []byte) (int, error) {
+	m, err := rtr.ParseRTR(p)
+	if err != nil {
+		return 0, err
+	}
+	c.sent = append(c.sent, m)
+	return len(p), nil
+}
+
+func TestROAManagerSession(t *testing.T) {
+	assert := assert.New(t)
+
+	m := newROAManager(table.NewROATable(log.NewDefaultLogger()), log.NewDefaultLogger())
+	c := &roaClient{host: "10.0.0.1:323", pendingROAs: make([]*table.ROA, 0)}
+	m.clientMap[c.host] = c
+	recv := func(msgs ...rtr.RTRMessage) {
+		for _, msg := range msgs {
+			data, _ := msg.Serialize()
+			m.handleRTRMsg(c, &c.state, data)
+		}
+	}
+	prefixes := func() []string {
+		l := make([]string, 0)
+		roas, _ := m.table.List(bgp.RF_IPv4_UC)
+		for _, roa := range roas {
+			l = append(l, roa.Network.String())
+		}
+		return l
+	}
+
+	// the first connection fetches all the ROAs
+	conn := &rtrTestConn{}
+	c.conn = conn
+	c.synchronize()
+	assert.IsType(&rtr.RTRResetQuery{}, conn.sent[0])
+	recv(rtr.NewRTRCacheResponse(1),
+		rtr.NewRTRIPPrefix(net.ParseIP("192.0.2.0").To4(), 24, 24, 100, 1),
+		rtr.NewRTREndOfData(1, 1))
+	assert.Equal([]string{"192.0.2.0/24"}, prefixes())
+
+	// the session continues after reconnecting
+	conn = &rtrTestConn{}
+	c.conn = conn
+	c.synchronize()
+	assert.Equal(rtr.NewRTRSerialQuery(1, 1), conn.sent[0])
+	recv(rtr.NewRTRCacheResponse(1),
+		rtr.NewRTRIPPrefix(net.ParseIP("198.51.100.0").To4(), 24, 24, 100, 1),
+		rtr.NewRTRIPPrefix(net.ParseIP("192.0.2.0").To4(), 24, 24, 100, 0))
+	assert.Equal([]string{}, prefixes())
+	recv(rtr.NewRTREndOfData(1, 2))
+	assert.Equal([]string{"198.51.100.0/24"}, prefixes())
+
+	// the response to the Reset Query replaces the ROAs
+	recv(rtr.NewRTRCacheReset())
+	assert.IsType(&rtr.RTRResetQuery{}, conn.sent[1])
+	recv(rtr.NewRTRCacheResponse(1),
+		rtr.NewRTRIPPrefix(net.ParseIP("203.0.113.0").To4(), 24, 24, 100, 1),
+		rtr.NewRTREndOfData(1, 3))
+	assert.Equal([]string{"203.0.113.0/24"}, prefixes())
+
+	// the cache server has restarted with the new session
+	recv(rtr.NewRTRSerialNotify(2, 1))
+	assert.IsType(&rtr.RTRResetQuery{}, conn.sent[2])
+	recv(rtr.NewRTRCacheResponse(2),
+		rtr.NewRTRIPPrefix(net.ParseIP("192.0.2.0").To4(), 24, 24, 100, 1),
+		rtr.NewRTREndOfData(2, 1))
+	assert.Equal([]string{"192.0.2.0/24"}, prefixes())
+	assert.Equal(uint16(2), c.sessionID)
+
+	c.conn = conn
+	c.enable(c.serialNumber)
+	recv(rtr.NewRTRCacheResponse(3))
+	assert.IsType(&rtr.RTRResetQuery{}, conn.sent[4])
+}
+
+func newTestCertificate(t *testing.T, dir string) (string, tls.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "rpki.example"},
+		DNSNames:              []string{"rpki.example"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caFile := filepath.Join(dir, "ca.pem")
+	if err := os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return caFile, tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func TestRTRDialerTLS(t *testing.T) {
+	assert := assert.New(t)
+
+	caFile, cert := newTestCertificate(t, t.TempDir())
+	l, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{cert}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		buf := make([]byte, rtr.RTR_MIN_LEN)
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			return
+		}
+		data, _ := rtr.NewRTRCacheReset().Serialize()
+		conn.Write(data)
+	}()
+
+	// the certificate doesn't match the address
+	dial, err := newRTRDialer(&api.AddRpkiRequest{
+		Transport: api.RPKIConf_TLS,
+		Tls:       &api.RPKITLSConf{CaFile: caFile},
+	})
+	assert.Nil(err)
+	_, err = dial(context.Background(), l.Addr().String())
+	assert.Error(err)
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		buf := make([]byte, rtr.RTR_MIN_LEN)
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			return
+		}
+		data, _ := rtr.NewRTRCacheReset().Serialize()
+		conn.Write(data)
+	}()
+	dial, err = newRTRDialer(&api.AddRpkiRequest{
+		Transport: api.RPKIConf_TLS,
+		Tls:       &api.RPKITLSConf{CaFile: caFile, ServerName: "rpki.example"},
+	})
+	assert.Nil(err)
+	conn, err := dial(context.Background(), l.Addr().String())
+	assert.Nil(err)
+	defer conn.Close()
+	data, _ := rtr.NewRTRResetQuery().Serialize()
+	_, err = conn.Write(data)
+	assert.Nil(err)
+	buf := make([]byte, rtr.RTR_MIN_LEN)
+	_, err = io.ReadFull(conn, buf)
+	assert.Nil(err)
+	msg, err := rtr.ParseRTR(buf)
+	assert.Nil(err)
+	assert.IsType(&rtr.RTRCacheReset{}, msg)
+}
+
+func TestNewRTRDialer(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := newRTRDialer(&api.AddRpkiRequest{})
+	assert.Nil(err)
+	_, err = newRTRDialer(&api.AddRpkiRequest{
+		Transport: api.RPKIConf_TLS,
+		Tls:       &api.RPKITLSConf{CaFile: "/nonexistent"},
+	})
+	assert.Error(err)
+	_, err = newRTRDialer(&api.AddRpkiRequest{
+		Transport: api.RPKIConf_TLS,
+		Tls:       &api.RPKITLSConf{CertFile: "/nonexistent"},
+	})
+	assert.Error(err)
+
+	// the host key must be verified
+	_, err = newRTRDialer(&api.AddRpkiRequest{
+		Transport: api.RPKIConf_SSH,
+		Ssh:       &api.RPKISSHConf{Username: "rpki", Password: "rpki"},
+	})
+	assert.Error(err)
+	knownHosts := filepath.Join(t.TempDir(), "known_hosts")
+	assert.Nil(os.WriteFile(knownHosts, nil, 0o600))
+	_, err = newRTRDialer(&api.AddRpkiRequest{
+		Transport: api.RPKIConf_SSH,
+		Ssh:       &api.RPKISSHConf{Username: "rpki", KnownHostsFile: knownHosts},
+	})
+	assert.Error(err)
+	_, err = newRTRDialer(&api.AddRpkiRequest{
+		Transport: api.RPKIConf_SSH,
+		Ssh:       &api.RPKISSHConf{Username: "rpki", Password: "rpki", KnownHostsFile: knownHosts},
+	})
+	assert.Nil(err)
+}
+
+func TestRTRDialerTCP(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	conn, err := dialRTRTCP(context.Background(), l.Addr().String())
+	assert.Nil(t, err)
+	conn.Close()
+}
@@ -0,0 +1,175 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"os"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/packet/rtr"
+)
+
+// rtrDialer connects to the RPKI cache server of the address "host:port".
+type rtrDialer func(ctx context.Context, host string) (io.ReadWriteCloser, error)
+
+func dialRTRTCP(ctx context.Context, host string) (io.ReadWriteCloser, error) {
+	var d net.Dialer
+	return d.DialContext(ctx, "tcp", host)
+}
+
+func newRTRTLSDialer(conf *api.RPKITLSConf) (rtrDialer, error) {
+	if conf == nil {
+		conf = &api.RPKITLSConf{}
+	}
+	tlsConfig := &tls.Config{
+		ServerName: conf.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if conf.CaFile != "" {
+		pem, err := os.ReadFile(conf.CaFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tls ca file: %v", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no valid certificate in tls ca file: %s", conf.CaFile)
+		}
+	}
+	if conf.CertFile != "" || conf.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(conf.CertFile, conf.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load tls certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return func(ctx context.Context, host string) (io.ReadWriteCloser, error) {
+		d := &tls.Dialer{Config: tlsConfig.Clone()}
+		if d.Config.ServerName == "" {
+			d.Config.ServerName, _, _ = net.SplitHostPort(host)
+		}
+		return d.DialContext(ctx, "tcp", host)
+	}, nil
+}
+
+// rtrSSHConn is the RTR session over the "rpki-rtr" SSH subsystem.
+type rtrSSHConn struct {
+	io.Reader
+	io.WriteCloser
+	session *ssh.Session
+	client  *ssh.Client
+}
+
+func (c *rtrSSHConn) Close() error {
+	c.WriteCloser.Close()
+	c.session.Close()
+	return c.client.Close()
+}
+
+func newRTRSSHDialer(conf *api.RPKISSHConf) (rtrDialer, error) {
+	if conf == nil || conf.Username == "" {
+		return nil, fmt.Errorf("ssh username is required")
+	}
+	// RFC 6810 7.1: the cache server must be authenticated.
+	if conf.KnownHostsFile == "" {
+		return nil, fmt.Errorf("ssh known hosts file is required")
+	}
+	hostKeyCallback, err := knownhosts.New(conf.KnownHostsFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ssh known hosts file: %v", err)
+	}
+	var auth []ssh.AuthMethod
+	if conf.PrivateKeyFile != "" {
+		pem, err := os.ReadFile(conf.PrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ssh private key file: %v", err)
+		}
+		signer, err := ssh.ParsePrivateKey(pem)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse ssh private key: %v", err)
+		}
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+	if conf.Password != "" {
+		auth = append(auth, ssh.Password(conf.Password))
+	}
+	if len(auth) == 0 {
+		return nil, fmt.Errorf("ssh private key file or password is required")
+	}
+	sshConfig := &ssh.ClientConfig{
+		User:            conf.Username,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+	}
+	return func(ctx context.Context, host string) (io.ReadWriteCloser, error) {
+		var d net.Dialer
+		conn, err := d.DialContext(ctx, "tcp", host)
+		if err != nil {
+			return nil, err
+		}
+		c, chans, reqs, err := ssh.NewClientConn(conn, host, sshConfig)
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		client := ssh.NewClient(c, chans, reqs)
+		session, err := client.NewSession()
+		if err != nil {
+			client.Close()
+			return nil, err
+		}
+		r, err := session.StdoutPipe()
+		if err != nil {
+			client.Close()
+			return nil, err
+		}
+		w, err := session.StdinPipe()
+		if err != nil {
+			client.Close()
+			return nil, err
+		}
+		if err := session.RequestSubsystem(rtr.RPKI_SSH_SUBSYSTEM); err != nil {
+			client.Close()
+			return nil, err
+		}
+		return &rtrSSHConn{
+			Reader:      r,
+			WriteCloser: w,
+			session:     session,
+			client:      client,
+		}, nil
+	}, nil
+}
+
+func newRTRDialer(r *api.AddRpkiRequest) (rtrDialer, error) {
+	switch r.Transport {
+	case api.RPKIConf_TCP:
+		return dialRTRTCP, nil
+	case api.RPKIConf_TLS:
+		return newRTRTLSDialer(r.Tls)
+	case api.RPKIConf_SSH:
+		return newRTRSSHDialer(r.Ssh)
+	}
+	return nil, fmt.Errorf("unsupported rpki transport: %s", r.Transport)
+}
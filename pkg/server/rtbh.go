@@ -0,0 +1,228 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/log"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+// REMOTELY TRIGGERED BLACK HOLE
+//
+// RFC7999 BLACKHOLE Community
+//
+// The black hole route of a prefix under attack is originated with the
+// BLACKHOLE community so that the neighbors drop the traffic to it, and with
+// the NO_EXPORT community not to be propagated further. The prefix length
+// is guarded not to drop the traffic to a large block by mistake.
+
+const (
+	defaultRtbhIPv4MinPrefixLength = 24
+	defaultRtbhIPv6MinPrefixLength = 48
+)
+
+var (
+	// the addresses of TEST-NET-1 (RFC5737) and the discard prefix
+	// (RFC6666), commonly routed to the discard interface
+	defaultRtbhIPv4Nexthop = netip.MustParseAddr("192.0.2.1")
+	defaultRtbhIPv6Nexthop = netip.MustParseAddr("100::1")
+)
+
+type rtbh struct {
+	prefix      netip.Prefix
+	communities []uint32
+	nexthop     netip.Addr
+	requester   string
+	reason      string
+	created     time.Time
+	// zero if the route has no lifetime
+	expire time.Time
+	timer  *time.Timer
+	path   *table.Path
+}
+
+func (r *rtbh) newPath(source *table.PeerInfo) *table.Path {
+	comms := make([]uint32, 0, len(r.communities)+2)
+	comms = append(comms, uint32(bgp.COMMUNITY_BLACKHOLE), uint32(bgp.COMMUNITY_NO_EXPORT))
+	comms = append(comms, r.communities...)
+	attrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(bgp.BGP_ORIGIN_ATTR_TYPE_IGP),
+	}
+	var nlri bgp.AddrPrefixInterface
+	if r.prefix.Addr().Is4() {
+		nlri = bgp.NewIPAddrPrefix(uint8(r.prefix.Bits()), r.prefix.Addr().String())
+		attrs = append(attrs, bgp.NewPathAttributeNextHop(r.nexthop.String()))
+	} else {
+		nlri = bgp.NewIPv6AddrPrefix(uint8(r.prefix.Bits()), r.prefix.Addr().String())
+		attrs = append(attrs, bgp.NewPathAttributeMpReachNLRI(r.nexthop.String(), []bgp.AddrPrefixInterface{nlri}))
+	}
+	attrs = append(attrs, bgp.NewPathAttributeCommunities(comms))
+	return table.NewPath(source, nlri, false, attrs, r.created, false)
+}
+
+// parseRtbhPrefix parses the host address or the prefix.
+func parseRtbhPrefix(s string) (netip.Prefix, error) {
+	if !strings.Contains(s, "/") {
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		addr = addr.Unmap()
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()).Masked(), nil
+}
+
+type rtbhManager struct {
+	s                   *BgpServer
+	ipv4MinPrefixLength int
+	ipv6MinPrefixLength int
+	routes              map[netip.Prefix]*rtbh
+}
+
+func newRtbhManager(s *BgpServer, ipv4MinPrefixLength, ipv6MinPrefixLength int) *rtbhManager {
+	if ipv4MinPrefixLength <= 0 {
+		ipv4MinPrefixLength = defaultRtbhIPv4MinPrefixLength
+	}
+	if ipv6MinPrefixLength <= 0 {
+		ipv6MinPrefixLength = defaultRtbhIPv6MinPrefixLength
+	}
+	return &rtbhManager{
+		s:                   s,
+		ipv4MinPrefixLength: ipv4MinPrefixLength,
+		ipv6MinPrefixLength: ipv6MinPrefixLength,
+		routes:              make(map[netip.Prefix]*rtbh),
+	}
+}
+
+// add originates the black hole route, replacing the one of the same
+// prefix.
+func (m *rtbhManager) add(r *rtbh, lifetime time.Duration) error {
+	min := m.ipv4MinPrefixLength
+	if r.prefix.Addr().Is6() {
+		min = m.ipv6MinPrefixLength
+	}
+	if r.prefix.Bits() < min {
+		return fmt.Errorf("prefix length of %s is shorter than %d", r.prefix, min)
+	}
+	if !r.nexthop.IsValid() {
+		r.nexthop = defaultRtbhIPv4Nexthop
+		if r.prefix.Addr().Is6() {
+			r.nexthop = defaultRtbhIPv6Nexthop
+		}
+	} else if r.nexthop.Is4() != r.prefix.Addr().Is4() {
+		return fmt.Errorf("nexthop %s isn't of the family of %s", r.nexthop, r.prefix)
+	}
+	if old, y := m.routes[r.prefix]; y && old.timer != nil {
+		old.timer.Stop()
+	}
+
+	// not to implicitly withdraw the path of the same NLRI added via the
+	// API, the source differs from the one of the API.
+	id := net.ParseIP(m.s.bgpConfig.Global.Config.RouterId).To4()
+	r.path = r.newPath(&table.PeerInfo{ID: id, LocalID: id})
+	if lifetime > 0 {
+		r.expire = r.created.Add(lifetime)
+		r.timer = time.AfterFunc(lifetime, func() {
+			m.s.mgmtOperation(func() error {
+				m.expire(r)
+				return nil
+			}, false)
+		})
+	}
+	m.routes[r.prefix] = r
+	m.s.propagateUpdate(nil, []*table.Path{r.path})
+	m.s.logger.Info("rtbh added",
+		log.Fields{
+			"Topic":     "Operation",
+			"Key":       r.prefix,
+			"Requester": r.requester,
+			"Reason":    r.reason,
+			"Lifetime":  lifetime})
+	return nil
+}
+
+func (m *rtbhManager) withdraw(r *rtbh) {
+	if r.timer != nil {
+		r.timer.Stop()
+	}
+	delete(m.routes, r.prefix)
+	m.s.propagateUpdate(nil, []*table.Path{r.path.Clone(true)})
+}
+
+// delete withdraws the black hole route of the prefix, or all of them if
+// prefix is empty.
+func (m *rtbhManager) delete(prefix string) error {
+	if prefix == "" {
+		for _, r := range m.routes {
+			m.withdraw(r)
+		}
+		m.s.logger.Info("all rtbh deleted",
+			log.Fields{
+				"Topic": "Operation"})
+		return nil
+	}
+	p, err := parseRtbhPrefix(prefix)
+	if err != nil {
+		return err
+	}
+	r, y := m.routes[p]
+	if !y {
+		return fmt.Errorf("rtbh %s isn't found", p)
+	}
+	m.withdraw(r)
+	m.s.logger.Info("rtbh deleted",
+		log.Fields{
+			"Topic": "Operation",
+			"Key":   p})
+	return nil
+}
+
+// expire withdraws the black hole route whose lifetime has expired unless
+// it is deleted or replaced since.
+func (m *rtbhManager) expire(r *rtbh) {
+	if m.routes[r.prefix] != r {
+		return
+	}
+	m.withdraw(r)
+	m.s.logger.Info("rtbh expired",
+		log.Fields{
+			"Topic":     "Operation",
+			"Key":       r.prefix,
+			"Requester": r.requester})
+}
+
+// clear forgets the black hole routes without withdrawing them when the
+// global RIB is gone.
+func (m *rtbhManager) clear() {
+	for _, r := range m.routes {
+		if r.timer != nil {
+			r.timer.Stop()
+		}
+	}
+	m.routes = make(map[netip.Prefix]*rtbh)
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/pkg/apiutil"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func TestParseRtbhPrefix(t *testing.T) {
+	assert := assert.New(t)
+	for s, want := range map[string]string{
+		"192.0.2.1":       "192.0.2.1/32",
+		"192.0.2.1/24":    "192.0.2.0/24",
+		"2001:db8::1":     "2001:db8::1/128",
+		"::ffff:10.0.0.1": "10.0.0.1/32",
+	} {
+		p, err := parseRtbhPrefix(s)
+		assert.Nil(err)
+		assert.Equal(want, p.String())
+	}
+	_, err := parseRtbhPrefix("foo")
+	assert.NotNil(err)
+}
+
+func TestRtbh(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s := runNewServer(t, 1, "1.1.1.1", -1)
+	defer s.StopBgp(ctx, &api.StopBgpRequest{})
+
+	list := func() []*api.Rtbh {
+		l := make([]*api.Rtbh, 0)
+		assert.Nil(s.ListRtbh(ctx, &api.ListRtbhRequest{}, func(r *api.Rtbh) {
+			l = append(l, r)
+		}))
+		return l
+	}
+	paths := func(family *api.Family) map[string]*api.Path {
+		m := make(map[string]*api.Path)
+		assert.Nil(s.ListPath(ctx, &api.ListPathRequest{TableType: api.TableType_GLOBAL, Family: family}, func(d *api.Destination) {
+			m[d.Prefix] = d.Paths[0]
+		}))
+		return m
+	}
+	v4 := &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST}
+	v6 := &api.Family{Afi: api.Family_AFI_IP6, Safi: api.Family_SAFI_UNICAST}
+
+	// the prefix length guards
+	assert.NotNil(s.AddRtbh(ctx, &api.AddRtbhRequest{Rtbh: &api.Rtbh{Prefix: "10.0.0.0/16"}}))
+	assert.NotNil(s.AddRtbh(ctx, &api.AddRtbhRequest{Rtbh: &api.Rtbh{Prefix: "2001:db8::/32"}}))
+	assert.NotNil(s.AddRtbh(ctx, &api.AddRtbhRequest{Rtbh: &api.Rtbh{Prefix: "10.0.0.1", Nexthop: "2001:db8::1"}}))
+	assert.Equal(0, len(list()))
+
+	assert.Nil(s.AddRtbh(ctx, &api.AddRtbhRequest{Rtbh: &api.Rtbh{
+		Prefix:      "10.0.0.1",
+		Communities: []uint32{65000<<16 | 666},
+		Requester:   "noc",
+		Reason:      "ddos",
+	}}))
+	assert.Nil(s.AddRtbh(ctx, &api.AddRtbhRequest{Rtbh: &api.Rtbh{Prefix: "2001:db8::1", Lifetime: 60}}))
+
+	l := list()
+	assert.Equal(2, len(l))
+	assert.Equal("10.0.0.1/32", l[0].Prefix)
+	assert.Equal("192.0.2.1", l[0].Nexthop)
+	assert.Equal("noc", l[0].Requester)
+	assert.Equal("ddos", l[0].Reason)
+	assert.Equal(uint32(0), l[0].RemainingLifetime)
+	assert.Equal("2001:db8::1/128", l[1].Prefix)
+	assert.Equal("100::1", l[1].Nexthop)
+	assert.True(l[1].RemainingLifetime > 50 && l[1].RemainingLifetime <= 60)
+
+	p := paths(v4)["10.0.0.1/32"]
+	assert.NotNil(p)
+	attrs, err := apiutil.GetNativePathAttributes(p)
+	assert.Nil(err)
+	for _, a := range attrs {
+		if c, ok := a.(*bgp.PathAttributeCommunities); ok {
+			assert.Equal([]uint32{uint32(bgp.COMMUNITY_BLACKHOLE), uint32(bgp.COMMUNITY_NO_EXPORT), 65000<<16 | 666}, c.Value)
+		}
+	}
+	assert.NotNil(paths(v6)["2001:db8::1/128"])
+
+	// replaced with the lifetime, and withdrawn after it
+	assert.Nil(s.AddRtbh(ctx, &api.AddRtbhRequest{Rtbh: &api.Rtbh{Prefix: "10.0.0.1/32", Lifetime: 1}}))
+	assert.Equal(2, len(list()))
+	assert.Eventually(func() bool {
+		return len(paths(v4)) == 0 && len(list()) == 1
+	}, 5*time.Second, 100*time.Millisecond)
+
+	assert.NotNil(s.DeleteRtbh(ctx, &api.DeleteRtbhRequest{Prefix: "10.0.0.1"}))
+	assert.Nil(s.DeleteRtbh(ctx, &api.DeleteRtbhRequest{}))
+	assert.Equal(0, len(list()))
+	assert.Equal(0, len(paths(v6)))
+}
@@ -18,11 +18,18 @@ package server
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
 	"net"
+	"net/netip"
 	"reflect"
+	"runtime"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"syscall"
@@ -31,6 +38,8 @@ import (
 	"github.com/eapache/channels"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
+	apb "google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	api "github.com/osrg/gobgp/v3/api"
 	"github.com/osrg/gobgp/v3/internal/pkg/table"
@@ -49,6 +58,12 @@ type tcpListener struct {
 	cancel context.CancelFunc
 }
 
+type acceptedConn struct {
+	conn net.Conn
+	// accepted by the listener which requires TCP MD5 authentication
+	authRequired bool
+}
+
 func (l *tcpListener) Close() error {
 	if err := l.l.Close(); err != nil {
 		return err
@@ -59,7 +74,7 @@ func (l *tcpListener) Close() error {
 }
 
 // avoid mapped IPv6 address
-func newTCPListener(logger log.Logger, address string, port uint32, bindToDev string, ch chan *net.TCPConn) (*tcpListener, error) {
+func newTCPListener(logger log.Logger, address string, port uint32, bindToDev, netns string, authRequired bool, ch chan acceptedConn) (*tcpListener, error) {
 	proto := "tcp4"
 	family := syscall.AF_INET
 	if ip := net.ParseIP(address); ip == nil {
@@ -97,14 +112,16 @@ func newTCPListener(logger log.Logger, address string, port uint32, bindToDev st
 		return nil
 	}
 
-	l, err := lc.Listen(context.Background(), proto, addr)
-	if err != nil {
+	var l net.Listener
+	if err := runInNetns(netns, func() (err error) {
+		l, err = lc.Listen(context.Background(), proto, addr)
+		return err
+	}); err != nil {
 		return nil, err
 	}
 	listener, ok := l.(*net.TCPListener)
 	if !ok {
-		err = fmt.Errorf("unexpected connection listener (not for TCP)")
-		return nil, err
+		return nil, fmt.Errorf("unexpected connection listener (not for TCP)")
 	}
 
 	closeCh := make(chan struct{})
@@ -124,7 +141,7 @@ func newTCPListener(logger log.Logger, address string, port uint32, bindToDev st
 				return err
 			}
 			select {
-			case ch <- conn:
+			case ch <- acceptedConn{conn: conn, authRequired: authRequired}:
 			case <-listenerCtx.Done():
 			}
 		}
@@ -140,6 +157,17 @@ type options struct {
 	grpcAddress string
 	grpcOption  []grpc.ServerOption
 	logger      log.Logger
+	backlogSize int
+	journalFile string
+	// zero disables the path history
+	pathHistorySize int
+	// zero means runtime.GOMAXPROCS(0)
+	bestPathWorkers int
+	// zero waits for the initial convergence without a limit
+	initialConvergenceTimeout time.Duration
+	// zero means the defaults
+	rtbhIPv4MinPrefixLength int
+	rtbhIPv6MinPrefixLength int
 }
 
 type ServerOption func(*options)
@@ -162,27 +190,121 @@ func LoggerOption(logger log.Logger) ServerOption {
 	}
 }
 
+// EventBacklogSize makes the server keep the latest best path and peer
+// state events up to the size so that WatchEvent can replay them.
+func EventBacklogSize(size int) ServerOption {
+	return func(o *options) {
+		o.backlogSize = size
+	}
+}
+
+// BestPathWorkers sets the number of the goroutines which apply the import
+// policy and compute the best paths of the different destinations
+// concurrently when a large number of paths are received at once, for
+// example, at the initial convergence with full tables. The default is
+// runtime.GOMAXPROCS(0) and 1 disables the concurrency.
+func BestPathWorkers(n int) ServerOption {
+	return func(o *options) {
+		o.bestPathWorkers = n
+	}
+}
+
+// EventJournalFile makes the server append the events kept by
+// EventBacklogSize to the file and load them from the file at start, so
+// that the watchers can resume from them across restarts.
+func EventJournalFile(path string) ServerOption {
+	return func(o *options) {
+		o.journalFile = path
+	}
+}
+
+// PathHistorySize makes the server keep the latest events of the paths
+// received from the peers up to the size per prefix so that GetPathHistory
+// can return them.
+func PathHistorySize(size int) ServerOption {
+	return func(o *options) {
+		o.pathHistorySize = size
+	}
+}
+
+// InitialConvergenceTimeout makes the server regard the initial
+// convergence as done after the duration since ConfigLoaded even if some
+// neighbors haven't been established or sent End-of-RIB yet, so that the
+// readiness reported by the gRPC health service doesn't wait for them
+// forever.
+func InitialConvergenceTimeout(d time.Duration) ServerOption {
+	return func(o *options) {
+		o.initialConvergenceTimeout = d
+	}
+}
+
+// RtbhMinPrefixLength sets the shortest prefix lengths of the remotely
+// triggered black hole routes added by AddRtbh, so that the traffic to a
+// large block isn't dropped by mistake. The defaults are 24 for IPv4 and 48
+// for IPv6.
+func RtbhMinPrefixLength(ipv4, ipv6 int) ServerOption {
+	return func(o *options) {
+		o.rtbhIPv4MinPrefixLength = ipv4
+		o.rtbhIPv6MinPrefixLength = ipv6
+	}
+}
+
 type BgpServer struct {
-	apiServer    *server
-	bgpConfig    oc.Bgp
-	acceptCh     chan *net.TCPConn
-	incomings    []*channels.InfiniteChannel
-	mgmtCh       chan *mgmtOp
-	policy       *table.RoutingPolicy
-	listeners    []*tcpListener
-	neighborMap  map[string]*peer
-	peerGroupMap map[string]*peerGroup
-	globalRib    *table.TableManager
-	rsRib        *table.TableManager
-	roaManager   *roaManager
-	shutdownWG   *sync.WaitGroup
-	watcherMap   map[watchEventType][]*watcher
-	zclient      *zebraClient
+	apiServer *server
+	bgpConfig oc.Bgp
+	acceptCh  chan acceptedConn
+	incomings []*channels.InfiniteChannel
+	mgmtCh    chan *mgmtOp
+	policy    *table.RoutingPolicy
+	listeners []*tcpListener
+	// listeners bound to the Linux VRF devices, created on demand for the
+	// neighbors with the transport vrf option
+	vrfListeners  map[string][]*tcpListener
+	quicListeners []*quicListener
+	neighborMap   map[string]*peer
+	peerGroupMap  map[string]*peerGroup
+	globalRib     *table.TableManager
+	rsRib         *table.TableManager
+	roaManager    *roaManager
+	shutdownWG    *sync.WaitGroup
+	watcherMap    map[watchEventType][]*watcher
+	eventBacklog  *eventBacklog
+	zclient       *zebraClient
+	nlclient      *netlinkClient
+	peeringdb     *peeringdbClient
+	// the graceful shutdown of the whole router
+	gshut        *gracefulShutdown
+	txn          configTransaction
+	srv6Locator  *srv6Locator
 	bmpManager   *bmpClientManager
+	kafkaManager *kafkaExporterManager
+	irrManager   *irrFilterManager
+	aggregates   *aggregateManager
+	leaks        *vrfLeakManager
+	orr          *orrManager
+	vpws         *evpnVpwsManager
+	rtbh         *rtbhManager
+	evpnMacs     *evpnMacMobility
+	colors       *colorResolver
+	activity     *prefixActivity
+	pathHistory  *pathHistory
+	readiness    readiness
 	mrtManager   *mrtManager
 	roaTable     *table.ROATable
 	uuidMap      map[string]uuid.UUID
-	logger       log.Logger
+	// the lifetimes of the paths added by AddPath, keyed like uuidMap
+	pathLifetimes map[string]*pathLifetime
+	logger        log.Logger
+	logLevels     *log.ModuleLevels
+	// the number of the goroutines to apply the import policy and compute
+	// the best paths concurrently
+	bestPathWorkers int
+	// stopCh is closed by Stop to make Serve return, and doneCh is closed
+	// when Serve returns.
+	stopCh    chan struct{}
+	doneCh    chan struct{}
+	serveOnce sync.Once
+	stopOnce  sync.Once
 }
 
 func NewBgpServer(opt ...ServerOption) *BgpServer {
@@ -190,28 +312,63 @@ func NewBgpServer(opt ...ServerOption) *BgpServer {
 	for _, o := range opt {
 		o(&opts)
 	}
-	logger := opts.logger
-	if logger == nil {
-		logger = log.NewDefaultLogger()
+	backend := opts.logger
+	if backend == nil {
+		backend = log.NewDefaultLogger()
 	}
+	logLevels := log.NewModuleLevels(backend)
+	logger := logLevels.Logger("")
 	roaTable := table.NewROATable(logger)
 
 	s := &BgpServer{
-		neighborMap:  make(map[string]*peer),
-		peerGroupMap: make(map[string]*peerGroup),
-		policy:       table.NewRoutingPolicy(logger),
-		mgmtCh:       make(chan *mgmtOp, 1),
-		watcherMap:   make(map[watchEventType][]*watcher),
-		uuidMap:      make(map[string]uuid.UUID),
-		roaManager:   newROAManager(roaTable, logger),
-		roaTable:     roaTable,
-		logger:       logger,
+		neighborMap:   make(map[string]*peer),
+		peerGroupMap:  make(map[string]*peerGroup),
+		policy:        table.NewRoutingPolicy(logLevels.Logger(log.ModulePolicy)),
+		mgmtCh:        make(chan *mgmtOp, 1),
+		watcherMap:    make(map[watchEventType][]*watcher),
+		uuidMap:       make(map[string]uuid.UUID),
+		pathLifetimes: make(map[string]*pathLifetime),
+		roaManager:    newROAManager(roaTable, logger),
+		roaTable:      roaTable,
+		logger:        logger,
+		logLevels:     logLevels,
+		stopCh:        make(chan struct{}),
+		doneCh:        make(chan struct{}),
+	}
+	s.readiness.timeout = opts.initialConvergenceTimeout
+	s.bestPathWorkers = opts.bestPathWorkers
+	if s.bestPathWorkers <= 0 {
+		s.bestPathWorkers = runtime.GOMAXPROCS(0)
+	}
+	if opts.backlogSize > 0 {
+		s.eventBacklog = newEventBacklog(opts.backlogSize)
+		if opts.journalFile != "" {
+			if err := s.eventBacklog.openJournal(opts.journalFile, logger); err != nil {
+				logger.Error("failed to open the event journal",
+					log.Fields{
+						"Topic": "Config",
+						"File":  opts.journalFile,
+						"Error": err})
+			}
+		}
+	}
+	if opts.pathHistorySize > 0 {
+		s.pathHistory = newPathHistory(opts.pathHistorySize)
 	}
 	s.bmpManager = newBmpClientManager(s)
+	s.kafkaManager = newKafkaExporterManager(s)
+	s.irrManager = newIrrFilterManager(s)
+	s.aggregates = newAggregateManager(s)
+	s.leaks = newVrfLeakManager(s)
+	s.orr = newOrrManager(s)
+	s.vpws = newEvpnVpwsManager(s)
+	s.rtbh = newRtbhManager(s, opts.rtbhIPv4MinPrefixLength, opts.rtbhIPv6MinPrefixLength)
+	s.evpnMacs = newEvpnMacMobility(s)
+	s.colors = newColorResolver(s)
+	s.activity = newPrefixActivity()
 	s.mrtManager = newMrtManager(s)
 	if len(opts.grpcAddress) != 0 {
-		grpc.EnableTracing = false
-		s.apiServer = newAPIserver(s, grpc.NewServer(opts.grpcOption...), opts.grpcAddress)
+		s.apiServer = newAPIserver(s, opts.grpcOption, opts.grpcAddress)
 		go func() {
 			if err := s.apiServer.serve(); err != nil {
 				logger.Fatal("failed to listen grpc port",
@@ -223,12 +380,85 @@ func NewBgpServer(opt ...ServerOption) *BgpServer {
 	return s
 }
 
+// Stop stops the server and releases all the resources, that is, the
+// peers, the listeners, the watchers, the BMP, MRT, Kafka, RPKI and zebra
+// clients and the gRPC API. Serve returns after Stop, and the server can't
+// be used any more. Stop can be called more than once.
 func (s *BgpServer) Stop() {
-	s.StopBgp(context.Background(), &api.StopBgpRequest{})
+	s.stopOnce.Do(func() {
+		s.StopBgp(context.Background(), &api.StopBgpRequest{})
+		s.mgmtOperation(func() error {
+			s.stopClients()
+			if s.eventBacklog != nil {
+				s.eventBacklog.close()
+			}
+			s.stopWatchers()
+			return nil
+		}, false)
+
+		if s.apiServer != nil {
+			s.apiServer.grpcServer.Stop()
+		}
+		close(s.stopCh)
+		<-s.doneCh
+	})
+}
+
+// stopClients stops the clients which run their own goroutines.
+func (s *BgpServer) stopClients() {
+	for _, c := range s.bmpManager.clientMap {
+		s.bmpManager.deleteServer(c.c)
+	}
+	for name := range s.kafkaManager.exporterMap {
+		s.kafkaManager.deleteExporter(name)
+	}
+	for name := range s.irrManager.filterMap {
+		s.irrManager.deleteFilter(name)
+	}
+	for key, w := range s.mrtManager.writer {
+		w.Stop()
+		delete(s.mrtManager.writer, key)
+	}
+	for host := range s.roaManager.clientMap {
+		s.roaManager.DeleteServer(host)
+	}
+	if s.zclient != nil {
+		s.zclient.stop()
+		s.zclient = nil
+	}
+	if s.peeringdb != nil {
+		s.peeringdb.stop()
+		s.peeringdb = nil
+	}
+}
+
+// stopWatchers closes the event channels of all the watchers, for
+// example, of WatchEvent whose context is never canceled.
+func (s *BgpServer) stopWatchers() {
+	for _, l := range s.watcherMap {
+		for _, w := range l {
+			w.stop()
+		}
+	}
+	s.watcherMap = make(map[watchEventType][]*watcher)
+}
+
+// AddGrpcInstance makes b reachable through the gRPC API of s as the
+// instance name. Clients select it by setting GrpcInstanceMetadataKey in
+// the request metadata. s must have been created with GrpcListenAddress.
+func (s *BgpServer) AddGrpcInstance(name string, b *BgpServer) error {
+	if s.apiServer == nil {
+		return fmt.Errorf("gRPC API isn't enabled")
+	}
+	return s.apiServer.addInstance(name, b)
+}
 
-	if s.apiServer != nil {
-		s.apiServer.grpcServer.Stop()
+// DeleteGrpcInstance removes the instance name from the gRPC API of s.
+func (s *BgpServer) DeleteGrpcInstance(name string) error {
+	if s.apiServer == nil {
+		return fmt.Errorf("gRPC API isn't enabled")
 	}
+	return s.apiServer.deleteInstance(name)
 }
 
 func (s *BgpServer) addIncoming(ch *channels.InfiniteChannel) {
@@ -280,22 +510,101 @@ func (s *BgpServer) handleMGMTOp(op *mgmtOp) {
 	op.errCh <- op.f()
 }
 
-func (s *BgpServer) mgmtOperation(f func() error, checkActive bool) (err error) {
+var errServerStopped = fmt.Errorf("bgp server has been stopped")
+
+func (s *BgpServer) mgmtOperation(f func() error, checkActive bool) error {
 	ch := make(chan error)
-	defer func() { err = <-ch }()
-	s.mgmtCh <- &mgmtOp{
+	select {
+	case s.mgmtCh <- &mgmtOp{
 		f:           f,
 		errCh:       ch,
 		checkActive: checkActive,
+	}:
+	case <-s.doneCh:
+		return errServerStopped
 	}
-	return
+	// the operation might be queued but not handled before Serve returns
+	select {
+	case err := <-ch:
+		return err
+	case <-s.doneCh:
+		return errServerStopped
+	}
+}
+
+// unnumberedPeer returns the peer configured with the neighbor interface
+// and its key in neighborMap, which is the interface until the neighbor is
+// discovered.
+func (s *BgpServer) unnumberedPeer(ifname string) (string, *peer, bool) {
+	for addr, peer := range s.neighborMap {
+		peer.fsm.lock.RLock()
+		neighborIface := peer.fsm.pConf.Config.NeighborInterface
+		peer.fsm.lock.RUnlock()
+		if neighborIface == ifname {
+			return addr, peer, true
+		}
+	}
+	return "", nil, false
 }
 
-func (s *BgpServer) passConnToPeer(conn *net.TCPConn) {
+// configuredPeer returns the peer of the neighbor configuration c, or nil
+// if it's not configured, and its key in neighborMap.
+func (s *BgpServer) configuredPeer(c *oc.Neighbor) (string, *peer, error) {
+	if intf := c.Config.NeighborInterface; intf != "" {
+		if addr, peer, y := s.unnumberedPeer(intf); y {
+			return addr, peer, nil
+		}
+		return intf, nil, nil
+	}
+	addr, err := c.ExtractNeighborAddress()
+	if err != nil {
+		return "", nil, err
+	}
+	return addr, s.neighborMap[addr], nil
+}
+
+// updateUnnumberedPeer keys the unnumbered peer by the address of the
+// neighbor it's connected to, which is unknown until discovered and
+// changes when the neighbor is replaced.
+func (s *BgpServer) updateUnnumberedPeer(peer *peer) {
+	peer.fsm.lock.Lock()
+	ifname := peer.fsm.pConf.Config.NeighborInterface
+	addr, _ := peer.fsm.RemoteHostPort()
+	if ifname == "" || addr == "" || addr == peer.fsm.pConf.State.NeighborAddress {
+		peer.fsm.lock.Unlock()
+		return
+	}
+	peer.fsm.pConf.State.NeighborAddress = addr
+	ipaddr, _ := net.ResolveIPAddr("ip", addr)
+	peer.fsm.peerInfo.Address = ipaddr.IP
+	applyPolicy := peer.fsm.pConf.ApplyPolicy
+	vrf := peer.fsm.pConf.Transport.Config.Vrf
+	peer.fsm.lock.Unlock()
+
+	if key, _, y := s.unnumberedPeer(ifname); y {
+		delete(s.neighborMap, key)
+		s.setNetlinkPeerVrf(key, "")
+	}
+	s.logger.Info("Discovered the unnumbered neighbor",
+		log.Fields{
+			"Topic":     "Peer",
+			"Key":       addr,
+			"Interface": ifname})
+	s.policy.SetPeerPolicy(addr, applyPolicy)
+	s.neighborMap[addr] = peer
+	s.setNetlinkPeerVrf(addr, vrf)
+}
+
+func (s *BgpServer) passConnToPeer(conn net.Conn, authRequired bool) {
 	host, _, _ := net.SplitHostPort(conn.RemoteAddr().String())
 	ipaddr, _ := net.ResolveIPAddr("ip", host)
 	remoteAddr := ipaddr.String()
 	peer, found := s.neighborMap[remoteAddr]
+	if !found && ipaddr != nil && ipaddr.Zone != "" {
+		// the unnumbered neighbor may connect from a new link-local
+		// address
+		_, peer, found = s.unnumberedPeer(ipaddr.Zone)
+	}
 	if found {
 		peer.fsm.lock.RLock()
 		adminStateNotUp := peer.fsm.adminState != adminStateUp
@@ -313,9 +622,45 @@ func (s *BgpServer) passConnToPeer(conn *net.TCPConn) {
 			return
 		}
 		peer.fsm.lock.RLock()
+		activeMode := peer.fsm.pConf.Transport.Config.ActiveMode
+		peer.fsm.lock.RUnlock()
+		if activeMode {
+			s.logger.Info("Rejected a connection from active-mode peer",
+				log.Fields{
+					"Topic":       "Peer",
+					"Remote Addr": remoteAddr,
+				})
+			conn.Close()
+			return
+		}
+		peer.fsm.lock.RLock()
 		localAddr := peer.fsm.pConf.Transport.Config.LocalAddress
 		bindInterface := peer.fsm.pConf.Transport.Config.BindInterface
+		if vrf := peer.fsm.pConf.Transport.Config.Vrf; vrf != "" {
+			bindInterface = vrf
+		}
+		transportProtocol := peer.fsm.pConf.Transport.Config.TransportProtocol
+		password := peer.fsm.pConf.Config.AuthPassword
+		passwordSource := peer.fsm.pConf.Config.AuthPasswordSource
+		ttl, ttlMin := peerConnTTL(peer.fsm.pConf)
 		peer.fsm.lock.RUnlock()
+		if authRequired && password == "" && passwordSource == "" {
+			s.logger.Info("Rejected a connection without TCP MD5 authentication",
+				log.Fields{
+					"Topic": "Peer",
+					"Key":   remoteAddr})
+			conn.Close()
+			return
+		}
+		if !transportProtocolValid(conn, transportProtocol) {
+			s.logger.Info("Mismatched transport protocol",
+				log.Fields{
+					"Topic":               "Peer",
+					"Key":                 remoteAddr,
+					"Configured protocol": transportProtocol})
+			conn.Close()
+			return
+		}
 		localAddrValid := func(laddr string) bool {
 			if laddr == "0.0.0.0" || laddr == "::" {
 				return true
@@ -344,6 +689,9 @@ func (s *BgpServer) passConnToPeer(conn *net.TCPConn) {
 			conn.Close()
 			return
 		}
+		if !s.setPassiveConnTTL(conn, remoteAddr, ttl, ttlMin) {
+			return
+		}
 
 		s.logger.Debug("Accepted a new passive connection",
 			log.Fields{
@@ -351,6 +699,23 @@ func (s *BgpServer) passConnToPeer(conn *net.TCPConn) {
 				"Key":   remoteAddr})
 		peer.PassConn(conn)
 	} else if pg := s.matchLongestDynamicNeighborPrefix(remoteAddr); pg != nil {
+		if authRequired && pg.Conf.Config.AuthPassword == "" && pg.Conf.Config.AuthPasswordSource == "" {
+			s.logger.Info("Rejected a connection without TCP MD5 authentication",
+				log.Fields{
+					"Topic": "Peer",
+					"Key":   remoteAddr})
+			conn.Close()
+			return
+		}
+		if !transportProtocolValid(conn, pg.Conf.Transport.Config.TransportProtocol) {
+			s.logger.Info("Mismatched transport protocol",
+				log.Fields{
+					"Topic":               "Peer",
+					"Key":                 remoteAddr,
+					"Configured protocol": pg.Conf.Transport.Config.TransportProtocol})
+			conn.Close()
+			return
+		}
 		s.logger.Debug("Accepted a new dynamic neighbor",
 			log.Fields{
 				"Topic": "Peer",
@@ -359,7 +724,7 @@ func (s *BgpServer) passConnToPeer(conn *net.TCPConn) {
 		if pg.Conf.RouteServer.Config.RouteServerClient {
 			rib = s.rsRib
 		}
-		peer := newDynamicPeer(&s.bgpConfig.Global, remoteAddr, pg.Conf, rib, s.policy, s.logger)
+		peer := newDynamicPeer(&s.bgpConfig.Global, remoteAddr, pg.Conf, rib, s.policy, s.logLevels.Logger(log.ModuleFsm))
 		if peer == nil {
 			s.logger.Info("Can't create new Dynamic Peer",
 				log.Fields{
@@ -368,12 +733,21 @@ func (s *BgpServer) passConnToPeer(conn *net.TCPConn) {
 			conn.Close()
 			return
 		}
+		peer.fsm.lock.RLock()
+		ttl, ttlMin := peerConnTTL(peer.fsm.pConf)
+		peer.fsm.lock.RUnlock()
+		if !s.setPassiveConnTTL(conn, remoteAddr, ttl, ttlMin) {
+			return
+		}
 		s.addIncoming(peer.fsm.incomingCh)
 		peer.fsm.lock.RLock()
 		policy := peer.fsm.pConf.ApplyPolicy
 		peer.fsm.lock.RUnlock()
 		s.policy.SetPeerPolicy(peer.ID(), policy)
+		s.setConditionalAdvertisements(peer, policy.ConditionalAdvertisements)
+		peer.gshut = s.gshut
 		s.neighborMap[remoteAddr] = peer
+		s.setNetlinkPeerVrf(remoteAddr, pg.Conf.Transport.Config.Vrf)
 		peer.startFSMHandler()
 		s.broadcastPeerState(peer, bgp.BGP_FSM_ACTIVE, nil)
 		peer.PassConn(conn)
@@ -386,9 +760,59 @@ func (s *BgpServer) passConnToPeer(conn *net.TCPConn) {
 	}
 }
 
-const firstPeerCaseIndex = 3
+// setPassiveConnTTL enforces TTL security (RFC 5082) on the accepted
+// connection before the fsm reads the messages from it, which are dropped
+// unless sent by the neighbor within the allowed hops. The connection is
+// closed if TTL security can't be enforced.
+func (s *BgpServer) setPassiveConnTTL(conn net.Conn, remoteAddr string, ttl, ttlMin uint8) bool {
+	if ttlMin == 0 {
+		return true
+	}
+	if err := setConnTTL(conn, ttl, ttlMin); err != nil {
+		s.logger.Warn("Rejected a connection, cannot enforce TTL security",
+			log.Fields{
+				"Topic": "Peer",
+				"Key":   remoteAddr,
+				"Error": err})
+		conn.Close()
+		return false
+	}
+	return true
+}
+
+func transportProtocolValid(conn net.Conn, protocol oc.TransportProtocolType) bool {
+	_, isQUIC := conn.(*quicConn)
+	return isQUIC == (protocol == oc.TRANSPORT_PROTOCOL_TYPE_QUIC)
+}
+
+const firstPeerCaseIndex = 4
 
+// Serve runs the main loop of the server until Stop is called.
 func (s *BgpServer) Serve() {
+	s.ServeContext(context.Background())
+}
+
+// ServeContext runs the main loop of the server until Stop is called or
+// ctx is canceled. The server is stopped in the latter case, and ctx.Err()
+// is returned.
+func (s *BgpServer) ServeContext(ctx context.Context) error {
+	served := false
+	s.serveOnce.Do(func() {
+		served = true
+	})
+	if !served {
+		return fmt.Errorf("bgp server is already served")
+	}
+	defer close(s.doneCh)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.Stop()
+		case <-s.stopCh:
+		}
+	}()
+
 	s.listeners = make([]*tcpListener, 0, 2)
 
 	handlefsmMsg := func(e *fsmMsg) {
@@ -452,6 +876,16 @@ func (s *BgpServer) Serve() {
 		}
 
 		peer, found := s.neighborMap[e.MsgSrc]
+		if !found {
+			// the message from the unnumbered peer before it's keyed by
+			// the discovered address
+			for _, p := range s.neighborMap {
+				if p.fsm == e.fsm {
+					peer, found = p, true
+					break
+				}
+			}
+		}
 		if !found {
 			s.logger.Warn("Can't find the neighbor",
 				log.Fields{
@@ -476,6 +910,10 @@ func (s *BgpServer) Serve() {
 			Dir:  reflect.SelectRecv,
 			Chan: reflect.ValueOf(s.roaManager.ReceiveROA()),
 		}
+		cases[3] = reflect.SelectCase{
+			Dir:  reflect.SelectRecv,
+			Chan: reflect.ValueOf(s.stopCh),
+		}
 		for i := firstPeerCaseIndex; i < len(cases); i++ {
 			cases[i] = reflect.SelectCase{
 				Dir:  reflect.SelectRecv,
@@ -489,11 +927,13 @@ func (s *BgpServer) Serve() {
 			op := value.Interface().(*mgmtOp)
 			s.handleMGMTOp(op)
 		case 1:
-			conn := value.Interface().(*net.TCPConn)
-			s.passConnToPeer(conn)
+			a := value.Interface().(acceptedConn)
+			s.passConnToPeer(a.conn, a.authRequired)
 		case 2:
 			ev := value.Interface().(*roaEvent)
 			s.roaManager.HandleROAEvent(ev)
+		case 3:
+			return ctx.Err()
 		default:
 			// in the case of dynamic peer, handleFSMMessage closed incoming channel so
 			// nil fsmMsg can happen here.
@@ -502,16 +942,40 @@ func (s *BgpServer) Serve() {
 				handlefsmMsg(e)
 			}
 		}
+		// the colored routes, the aggregates, the leaked routes and the
+		// conditional advertisements are updated once for all the
+		// changes by the event. The leaked routes may contribute to the
+		// aggregates and vice versa.
+		s.colors.flush()
+		s.aggregates.flush()
+		for s.leaks.flush() {
+			s.aggregates.flush()
+		}
+		s.flushConditionalAdvertisements()
 	}
 }
 
 func (s *BgpServer) matchLongestDynamicNeighborPrefix(a string) *peerGroup {
+	// link-local addresses carry the interface as zone, e.g. "fe80::1%eth0"
+	zone := ""
+	if i := strings.LastIndex(a, "%"); i >= 0 {
+		a, zone = a[:i], a[i+1:]
+	}
 	ipAddr := net.ParseIP(a)
+	if ipAddr == nil {
+		return nil
+	}
 	longestMask := net.CIDRMask(0, 32).String()
 	var longestPG *peerGroup
 	for _, pg := range s.peerGroupMap {
 		for _, d := range pg.dynamicNeighbors {
-			_, netAddr, _ := net.ParseCIDR(d.Config.Prefix)
+			if d.Config.Interface != "" && d.Config.Interface != zone {
+				continue
+			}
+			_, netAddr, err := net.ParseCIDR(d.Config.Prefix)
+			if err != nil {
+				continue
+			}
 			if netAddr.Contains(ipAddr) {
 				if netAddr.Mask.String() > longestMask ||
 					(netAddr.Mask.String() == longestMask && longestMask == net.CIDRMask(0, 32).String()) {
@@ -525,6 +989,14 @@ func (s *BgpServer) matchLongestDynamicNeighborPrefix(a string) *peerGroup {
 }
 
 func sendfsmOutgoingMsg(peer *peer, paths []*table.Path, notification *bgp.BGPMessage, stayIdle bool) {
+	if notification == nil && len(paths) > 0 {
+		if paths = peer.mrai.add(peer, paths); len(paths) == 0 {
+			return
+		}
+		if peer.slowQueue.add(peer, paths) {
+			return
+		}
+	}
 	peer.fsm.outgoingCh.In() <- &fsmOutgoingMsg{
 		Paths:        paths,
 		Notification: notification,
@@ -557,18 +1029,11 @@ func filterpath(peer *peer, path, old *table.Path) *table.Path {
 	peer.fsm.lock.RLock()
 	_, y := peer.fsm.rfMap[bgp.RF_RTC_UC]
 	peer.fsm.lock.RUnlock()
-	if y && path.GetRouteFamily() != bgp.RF_RTC_UC {
+	if y && table.IsRouteTargetConstrained(path.GetRouteFamily()) {
 		ignore := true
-		for _, ext := range path.GetExtCommunities() {
-			for _, p := range peer.adjRibIn.PathList([]bgp.RouteFamily{bgp.RF_RTC_UC}, true) {
-				rt := p.GetNlri().(*bgp.RouteTargetMembershipNLRI).RouteTarget
-				// Note: nil RT means the default route target
-				if rt == nil || ext.String() == rt.String() {
-					ignore = false
-					break
-				}
-			}
-			if !ignore {
+		for _, p := range peer.adjRibIn.PathList([]bgp.RouteFamily{bgp.RF_RTC_UC}, true) {
+			if table.MatchRouteTarget(p.GetNlri().(*bgp.RouteTargetMembershipNLRI), path) {
+				ignore = false
 				break
 			}
 		}
@@ -599,19 +1064,17 @@ func filterpath(peer *peer, path, old *table.Path) *table.Path {
 				// RFC4456 8. Avoiding Routing Information Loops
 				// If the local CLUSTER_ID is found in the CLUSTER_LIST,
 				// the advertisement received SHOULD be ignored.
-				for _, clusterID := range path.GetClusterList() {
-					peer.fsm.lock.RLock()
-					rrClusterID := peer.fsm.peerInfo.RouteReflectorClusterID
-					peer.fsm.lock.RUnlock()
-					if clusterID.Equal(rrClusterID) {
-						peer.fsm.logger.Debug("cluster list path attribute has local cluster id, ignore",
-							log.Fields{
-								"Topic":     "Peer",
-								"Key":       peer.ID(),
-								"ClusterID": clusterID,
-								"Data":      path})
-						return nil
-					}
+				peer.fsm.lock.RLock()
+				rrClusterID := peer.fsm.peerInfo.RouteReflectorClusterID
+				peer.fsm.lock.RUnlock()
+				if hasClusterID(path, rrClusterID) {
+					peer.fsm.logger.Debug("cluster list path attribute has local cluster id, ignore",
+						log.Fields{
+							"Topic":     "Peer",
+							"Key":       peer.ID(),
+							"ClusterID": rrClusterID,
+							"Data":      path})
+					return nil
 				}
 				ignore = false
 			}
@@ -718,11 +1181,13 @@ func (s *BgpServer) prePolicyFilterpath(peer *peer, path, old *table.Path) (*tab
 	}
 
 	// replace-peer-as handling
-	peer.fsm.lock.RLock()
-	if path != nil && !path.IsWithdraw && peer.fsm.pConf.AsPathOptions.State.ReplacePeerAs {
-		path = path.ReplaceAS(peer.fsm.pConf.Config.LocalAs, peer.fsm.pConf.Config.PeerAs)
+	if path != nil && !path.IsWithdraw {
+		if _, replacePeerAS := peer.loopPrevention(path.GetRouteFamily()); replacePeerAS {
+			peer.fsm.lock.RLock()
+			path = path.ReplaceAS(peer.fsm.pConf.Config.LocalAs, peer.fsm.pConf.Config.PeerAs)
+			peer.fsm.lock.RUnlock()
+		}
 	}
-	peer.fsm.lock.RUnlock()
 
 	if path = filterpath(peer, path, old); path == nil {
 		return nil, nil, true
@@ -733,12 +1198,28 @@ func (s *BgpServer) prePolicyFilterpath(peer *peer, path, old *table.Path) (*tab
 		Info:       peer.fsm.peerInfo,
 		OldNextHop: path.GetNexthop(),
 	}
+	if !peer.isRouteServerClient() {
+		options.MultiPath = s.multiPath
+	}
 	path = table.UpdatePathAttrs(peer.fsm.logger, peer.fsm.gConf, peer.fsm.pConf, peer.fsm.peerInfo, path)
 	peer.fsm.lock.RUnlock()
 
 	return path, options, false
 }
 
+// multiPath returns the multipaths of the destination of the path in the
+// global RIB, used to generate the cumulative link bandwidth.
+func (s *BgpServer) multiPath(path *table.Path) []*table.Path {
+	if !table.MultiPathEnabled() {
+		return nil
+	}
+	dst := s.globalRib.GetDestination(path)
+	if dst == nil {
+		return nil
+	}
+	return dst.GetMultiBestPath(table.GLOBAL_RIB_NAME)
+}
+
 func (s *BgpServer) postFilterpath(peer *peer, path *table.Path) *table.Path {
 	// draft-uttaro-idr-bgp-persistence-02
 	// 4.3.  Processing LLGR_STALE Routes
@@ -757,14 +1238,33 @@ func (s *BgpServer) postFilterpath(peer *peer, path *table.Path) *table.Path {
 	// remove local-pref attribute
 	// we should do this after applying export policy since policy may
 	// set local-preference
-	if path != nil && !peer.isIBGPPeer() && !peer.isRouteServerClient() {
+	// the attribute is preserved within the confederation
+	if path != nil && !peer.isIBGPPeer() && !peer.isRouteServerClient() && !peer.isConfederationMember() {
 		path.RemoveLocalPref()
 	}
 
+	// RFC 7311 3.
+	// The AIGP attribute isn't sent on a session where it's disabled.
+	peer.fsm.lock.RLock()
+	sendAigp := peer.fsm.pConf.Config.SendAigp
+	peer.fsm.lock.RUnlock()
+	if path != nil && !sendAigp && !peer.isRouteServerClient() {
+		path.RemoveAigp()
+	}
+
+	// RFC 8326 4.1.
+	peer.gracefulShutdownExport(path)
+
 	return path
 }
 
 func (s *BgpServer) filterpath(peer *peer, path, old *table.Path) *table.Path {
+	if !peer.isPrefixOrfPermitted(path) {
+		return nil
+	}
+	if s.aggregates.suppressed(peer, path) || peer.isConditionallyWithdrawn(path) {
+		return nil
+	}
 	path, options, stop := s.prePolicyFilterpath(peer, path, old)
 	if stop {
 		return nil
@@ -819,12 +1319,12 @@ func (s *BgpServer) notifyBestWatcher(best []*table.Path, multipath [][]*table.P
 	clonedM := make([][]*table.Path, len(multipath))
 	for i, pathList := range multipath {
 		clonedM[i] = clonePathList(pathList)
-		if table.UseMultiplePaths.Enabled {
+		if table.MultiPathEnabled() {
 			s.setPathVrfIdMap(clonedM[i], m)
 		}
 	}
 	clonedB := clonePathList(best)
-	if !table.UseMultiplePaths.Enabled {
+	if !table.MultiPathEnabled() {
 		s.setPathVrfIdMap(clonedB, m)
 	}
 	w := &watchEventBestPath{PathList: clonedB, MultiPathList: clonedM}
@@ -834,6 +1334,19 @@ func (s *BgpServer) notifyBestWatcher(best []*table.Path, multipath [][]*table.P
 	s.notifyWatcher(watchEventTypeBestPath, w)
 }
 
+func copyCapabilities(capMap map[bgp.BGPCapabilityCode][]bgp.ParameterCapabilityInterface) []bgp.ParameterCapabilityInterface {
+	l := make([]bgp.ParameterCapabilityInterface, 0, len(capMap))
+	for _, caps := range capMap {
+		for _, m := range caps {
+			// need to copy all values here
+			buf, _ := m.Serialize()
+			c, _ := bgp.DecodeCapability(buf)
+			l = append(l, c)
+		}
+	}
+	return l
+}
+
 func (s *BgpServer) toConfig(peer *peer, getAdvertised bool) *oc.Neighbor {
 	// create copy which can be access to without mutex
 	peer.fsm.lock.RLock()
@@ -851,19 +1364,14 @@ func (s *BgpServer) toConfig(peer *peer, getAdvertised bool) *oc.Neighbor {
 		} else {
 			conf.AfiSafis[i].AddPaths.State.SendMax = 0
 		}
-	}
-
-	remoteCap := make([]bgp.ParameterCapabilityInterface, 0, len(peerCapMap))
-	for _, caps := range peerCapMap {
-		for _, m := range caps {
-			// need to copy all values here
-			buf, _ := m.Serialize()
-			c, _ := bgp.DecodeCapability(buf)
-			remoteCap = append(remoteCap, c)
+		if o, ok := peer.prefixOrfs[af.State.Family]; ok {
+			conf.AfiSafis[i].PrefixOrf.State.ReceivedEntries = uint32(len(o.entries))
 		}
+		conf.AfiSafis[i].PrefixOrf.State.SentEntries = peer.prefixOrfSent[af.State.Family]
+		conf.AfiSafis[i].MinimumAdvertisementInterval.State.Coalesced = peer.mrai.coalesced(af.State.Family)
 	}
 
-	conf.State.RemoteCapabilityList = remoteCap
+	conf.State.RemoteCapabilityList = copyCapabilities(peerCapMap)
 
 	peer.fsm.lock.Lock()
 	conf.State.LocalCapabilityList = capabilitiesFromConfig(peer.fsm.pConf)
@@ -873,8 +1381,26 @@ func (s *BgpServer) toConfig(peer *peer, getAdvertised bool) *oc.Neighbor {
 	conf.State.SessionState = oc.IntToSessionStateMap[int(peer.fsm.state)]
 	conf.State.AdminState = oc.IntToAdminStateMap[int(peer.fsm.adminState)]
 	conf.State.Flops = peer.fsm.pConf.State.Flops
+	conf.State.GracefulShutdown = peer.gshut != nil
+	conf.State.FourOctetAs = peer.fsm.state == bgp.BGP_FSM_ESTABLISHED && peer.fsm.fourOctetAs()
 	state := peer.fsm.state
 	peer.fsm.lock.RUnlock()
+	conf.State.Queues.Input = uint32(peer.fsm.incomingCh.Len())
+	conf.State.Queues.Output = uint32(peer.fsm.outgoingQueueLen())
+	conf.State.SlowPeer, conf.State.SlowPeerCount, conf.State.SlowPeerDropped = peer.slowQueue.state()
+
+	conf.ApplyPolicy.ConditionalAdvertisements = make([]oc.ConditionalAdvertisement, 0, len(peer.condAdvs))
+	for _, a := range peer.condAdvs {
+		conf.ApplyPolicy.ConditionalAdvertisements = append(conf.ApplyPolicy.ConditionalAdvertisements, oc.ConditionalAdvertisement{
+			Config: a.c,
+			State: oc.ConditionalAdvertisementState{
+				AdvertisePrefixSet: a.c.AdvertisePrefixSet,
+				ConditionPrefixSet: a.c.ConditionPrefixSet,
+				Condition:          a.c.Condition,
+				Advertised:         a.advertised,
+			},
+		})
+	}
 
 	if state == bgp.BGP_FSM_ESTABLISHED {
 		peer.fsm.lock.RLock()
@@ -988,17 +1514,56 @@ func newWatchEventPeer(peer *peer, m *fsmMsg, oldState bgp.FSMState, t PeerEvent
 	return e
 }
 
+// setWatchEventPeerStatistics sets the counters of the paths per family
+// and the negotiated capabilities of the peer to the event. The paths
+// advertised are counted only while the session is established.
+func (s *BgpServer) setWatchEventPeerStatistics(ev *watchEventPeer, peer *peer) {
+	peer.fsm.lock.RLock()
+	established := peer.fsm.state == bgp.BGP_FSM_ESTABLISHED
+	if established {
+		ev.RemoteCapabilities = copyCapabilities(peer.fsm.capMap)
+	}
+	peer.fsm.lock.RUnlock()
+	if established {
+		peer.fsm.lock.Lock()
+		ev.LocalCapabilities = capabilitiesFromConfig(peer.fsm.pConf)
+		peer.fsm.lock.Unlock()
+	}
+
+	families := peer.configuredRFlist()
+	ev.Families = make([]*watchEventPeerFamily, 0, len(families))
+	for _, family := range families {
+		flist := []bgp.RouteFamily{family}
+		f := &watchEventPeerFamily{
+			Family:   family,
+			Received: uint64(peer.adjRibIn.Count(flist)),
+			Accepted: uint64(peer.adjRibIn.Accepted(flist)),
+		}
+		if established {
+			pathList, _ := s.getBestFromLocal(peer, flist)
+			f.Advertised = uint64(len(pathList))
+		}
+		ev.Families = append(ev.Families, f)
+	}
+}
+
 func (s *BgpServer) broadcastPeerState(peer *peer, oldState bgp.FSMState, e *fsmMsg) {
-	s.notifyWatcher(watchEventTypePeerState, newWatchEventPeer(peer, e, oldState, PEER_EVENT_STATE))
+	ev := newWatchEventPeer(peer, e, oldState, PEER_EVENT_STATE)
+	if s.isWatched(watchEventTypePeerState) || s.eventBacklog != nil {
+		s.setWatchEventPeerStatistics(ev, peer)
+	}
+	s.notifyWatcher(watchEventTypePeerState, ev)
 }
 
-func (s *BgpServer) notifyMessageWatcher(peer *peer, timestamp time.Time, msg *bgp.BGPMessage, isSent bool) {
+func (s *BgpServer) notifyMessageWatcher(peer *peer, timestamp time.Time, msg *bgp.BGPMessage, payload []byte, erroredPDU, isSent bool) {
 	// validation should be done in the caller of this function
 	peer.fsm.lock.RLock()
 	_, y := peer.fsm.capMap[bgp.BGP_CAP_FOUR_OCTET_AS_NUMBER]
 	l, _ := peer.fsm.LocalHostPort()
 	ev := &watchEventMessage{
 		Message:      msg,
+		Payload:      payload,
+		ErroredPDU:   erroredPDU,
 		PeerAS:       peer.fsm.peerInfo.AS,
 		LocalAS:      peer.fsm.peerInfo.LocalAS,
 		PeerAddress:  peer.fsm.peerInfo.Address,
@@ -1014,17 +1579,20 @@ func (s *BgpServer) notifyMessageWatcher(peer *peer, timestamp time.Time, msg *b
 	}
 }
 
-func (s *BgpServer) notifyRecvMessageWatcher(peer *peer, timestamp time.Time, msg *bgp.BGPMessage) {
+func (s *BgpServer) notifyRecvMessageWatcher(peer *peer, e *fsmMsg, msg *bgp.BGPMessage) {
 	if peer == nil || !s.isWatched(watchEventTypeRecvMsg) {
 		return
 	}
-	s.notifyMessageWatcher(peer, timestamp, msg, false)
+	s.notifyMessageWatcher(peer, e.timestamp, msg, e.payload, e.erroredPDU, false)
 }
 
 func (s *BgpServer) getPossibleBest(peer *peer, family bgp.RouteFamily) []*table.Path {
 	if peer.isAddPathSendEnabled(family) {
 		return peer.localRib.GetPathList(peer.TableID(), peer.AS(), []bgp.RouteFamily{family})
 	}
+	if cost := s.orr.costFunc(peer); cost != nil {
+		return peer.localRib.GetOptimalBestPathList([]bgp.RouteFamily{family}, cost)
+	}
 	return peer.localRib.GetBestPathList(peer.TableID(), peer.AS(), []bgp.RouteFamily{family})
 }
 
@@ -1051,6 +1619,15 @@ func (s *BgpServer) getBestFromLocal(peer *peer, rfList []bgp.RouteFamily) ([]*t
 	}
 
 	for _, family := range peer.toGlobalFamilies(rfList) {
+		if peer.isAddPathSendEnabled(family) && peer.isAddPathSendBestEnabled(family) {
+			for _, d := range peer.localRib.Tables[family].GetDestinations() {
+				u := &table.Update{
+					KnownPathList: d.GetAllKnownPathList(),
+				}
+				pathList = append(pathList, s.getAddPathBestChanges(peer, family, nil, []*table.Update{u})...)
+			}
+			continue
+		}
 		for _, path := range s.getPossibleBest(peer, family) {
 			if p := s.filterpath(peer, path, nil); p != nil {
 				pathList = append(pathList, p)
@@ -1067,6 +1644,54 @@ func (s *BgpServer) getBestFromLocal(peer *peer, rfList []bgp.RouteFamily) ([]*t
 	return pathList, filtered
 }
 
+// getAddPathBestChanges returns the paths to send to the ADD-PATH peer in
+// the "n-best" and "best-external" send modes. The paths selected for the
+// peer are compared with the ones sent before; the paths no longer selected
+// are withdrawn and the newly selected ones are advertised. A nil newPath
+// means all the selected paths are advertised again.
+func (s *BgpServer) getAddPathBestChanges(peer *peer, family bgp.RouteFamily, newPath *table.Path, dsts []*table.Update) []*table.Path {
+	max := int(peer.getAddPathSendMax(family))
+	bestExternal := peer.getAddPathSendMode(family) == oc.ADD_PATHS_SEND_MODE_TYPE_BEST_EXTERNAL
+	filter := func(p *table.Path) *table.Path {
+		return s.filterpath(peer, p, nil)
+	}
+
+	withdrawn := make([]*table.Path, 0)
+	advertised := make([]*table.Path, 0)
+	for _, d := range dsts {
+		var key table.PathDestLocalKey
+		if len(d.KnownPathList) > 0 {
+			key = d.KnownPathList[0].GetDestLocalKey()
+		} else if len(d.OldKnownPathList) > 0 {
+			key = d.OldKnownPathList[0].GetDestLocalKey()
+		} else {
+			continue
+		}
+
+		sent := peer.sentPaths[key]
+		selected := make(map[uint32]*table.Path)
+		for _, p := range table.SelectAddPathBest(peer.TableID(), peer.AS(), d.KnownPathList, max, bestExternal, filter) {
+			id := p.GetNlri().PathLocalIdentifier()
+			selected[id] = p
+			if _, y := sent[id]; !y || newPath == nil || (!newPath.IsWithdraw && newPath.GetNlri().PathLocalIdentifier() == id) {
+				advertised = append(advertised, p)
+			}
+		}
+		for id, p := range sent {
+			if _, y := selected[id]; !y {
+				withdrawn = append(withdrawn, p.Clone(true))
+			}
+		}
+
+		if len(selected) == 0 {
+			delete(peer.sentPaths, key)
+		} else {
+			peer.sentPaths[key] = selected
+		}
+	}
+	return append(withdrawn, advertised...)
+}
+
 func needToAdvertise(peer *peer) bool {
 	peer.fsm.lock.RLock()
 	notEstablished := peer.fsm.state != bgp.BGP_FSM_ESTABLISHED
@@ -1179,31 +1804,45 @@ func (s *BgpServer) handleRouteRefresh(peer *peer, e *fsmMsg) []*table.Path {
 				"Key":   peer.ID()})
 		return nil
 	}
+	if len(rr.ORFs) > 0 || rr.WhenToRefresh != 0 {
+		return s.handlePrefixOrf(peer, rf, rr)
+	}
 	rfList := []bgp.RouteFamily{rf}
 	accepted, _ := s.getBestFromLocal(peer, rfList)
 	return accepted
 }
 
-func (s *BgpServer) propagateUpdate(peer *peer, pathList []*table.Path) {
-	rs := peer != nil && peer.isRouteServerClient()
-	vrf := false
+// importTarget returns whether peer is a route server client or a VRF
+// peer, and the table ID and the RIB which the paths from peer go into.
+func (s *BgpServer) importTarget(peer *peer) (rs, vrf bool, tableId string, rib *table.TableManager) {
+	rs = peer != nil && peer.isRouteServerClient()
 	if peer != nil {
 		peer.fsm.lock.RLock()
 		vrf = !rs && peer.fsm.pConf.Config.Vrf != ""
 		peer.fsm.lock.RUnlock()
 	}
 
-	tableId := table.GLOBAL_RIB_NAME
-	rib := s.globalRib
+	tableId = table.GLOBAL_RIB_NAME
+	rib = s.globalRib
 	if rs {
 		tableId = peer.TableID()
 		rib = s.rsRib
 	}
+	return rs, vrf, tableId, rib
+}
 
-	for _, path := range pathList {
-		if vrf {
-			peer.fsm.lock.RLock()
-			peerVrf := peer.fsm.pConf.Config.Vrf
+func (s *BgpServer) propagateUpdate(peer *peer, pathList []*table.Path) {
+	rs, vrf, tableId, rib := s.importTarget(peer)
+
+	if s.bestPathWorkers > 1 && len(pathList) >= minConcurrentUpdatePaths && canUpdateConcurrently(pathList) {
+		s.propagateUpdateConcurrently(peer, rs, vrf, tableId, rib, pathList)
+		return
+	}
+
+	for _, path := range pathList {
+		if vrf {
+			peer.fsm.lock.RLock()
+			peerVrf := peer.fsm.pConf.Config.Vrf
 			peer.fsm.lock.RUnlock()
 			path = path.ToGlobal(rib.Vrfs[peerVrf])
 			if s.zclient != nil {
@@ -1222,7 +1861,7 @@ func (s *BgpServer) propagateUpdate(peer *peer, pathList []*table.Path) {
 		}
 
 		if p := s.policy.ApplyPolicy(tableId, table.POLICY_DIRECTION_IMPORT, path, policyOptions); p != nil {
-			path = p
+			path = peer.gracefulShutdownImport(p)
 		} else {
 			path = path.Clone(true)
 		}
@@ -1242,10 +1881,10 @@ func (s *BgpServer) propagateUpdate(peer *peer, pathList []*table.Path) {
 			// between the previous and current state of the route distribution
 			// graph that is derived from Route Target membership information.
 			if peer != nil && path != nil && path.GetRouteFamily() == bgp.RF_RTC_UC {
-				rt := path.GetNlri().(*bgp.RouteTargetMembershipNLRI).RouteTarget
+				nlri := path.GetNlri().(*bgp.RouteTargetMembershipNLRI)
 				fs := make([]bgp.RouteFamily, 0, len(peer.negotiatedRFList()))
 				for _, f := range peer.negotiatedRFList() {
-					if f != bgp.RF_RTC_UC {
+					if table.IsRouteTargetConstrained(f) {
 						fs = append(fs, f)
 					}
 				}
@@ -1260,7 +1899,7 @@ func (s *BgpServer) propagateUpdate(peer *peer, pathList []*table.Path) {
 					membershipsForSource := s.globalRib.GetPathListWithSource(table.GLOBAL_RIB_NAME, []bgp.RouteFamily{bgp.RF_RTC_UC}, path.GetSource())
 					found := false
 					for _, membership := range membershipsForSource {
-						if membership.GetNlri().(*bgp.RouteTargetMembershipNLRI).RouteTarget.String() == rt.String() {
+						if table.SameRouteTarget(membership.GetNlri().(*bgp.RouteTargetMembershipNLRI).RouteTarget, nlri.RouteTarget) {
 							found = true
 							break
 						}
@@ -1271,14 +1910,11 @@ func (s *BgpServer) propagateUpdate(peer *peer, pathList []*table.Path) {
 				}
 				paths := make([]*table.Path, 0, len(candidates))
 				for _, p := range candidates {
-					for _, ext := range p.GetExtCommunities() {
-						if rt == nil || ext.String() == rt.String() {
-							if path.IsWithdraw {
-								p = p.Clone(true)
-							}
-							paths = append(paths, p)
-							break
+					if table.MatchRouteTarget(nlri, p) {
+						if path.IsWithdraw {
+							p = p.Clone(true)
 						}
+						paths = append(paths, p)
 					}
 				}
 				if path.IsWithdraw {
@@ -1291,12 +1927,273 @@ func (s *BgpServer) propagateUpdate(peer *peer, pathList []*table.Path) {
 			}
 		}
 
+		if !rs && path.GetRouteFamily() == bgp.RF_EVPN {
+			path = s.evpnMacs.update(peer, path)
+		}
+
+		if !rs {
+			path = s.colors.resolve(path)
+		}
+
 		if dsts := rib.Update(path); len(dsts) > 0 {
 			s.propagateUpdateToNeighbors(rib, peer, path, dsts, true)
 		}
 	}
 }
 
+// the paths of the smaller batches are processed one by one since the
+// goroutines cost more than they save.
+const minConcurrentUpdatePaths = 256
+
+// canUpdateConcurrently returns false if the paths need the changes of
+// the other destinations made by the preceding paths.
+func canUpdateConcurrently(pathList []*table.Path) bool {
+	for _, path := range pathList {
+		switch path.GetRouteFamily() {
+		case bgp.RF_RTC_UC, bgp.RF_EVPN:
+			return false
+		}
+	}
+	return true
+}
+
+// propagateUpdateConcurrently is propagateUpdate for a large number of
+// paths, which applies the import policy and computes the best paths of
+// the different destinations with up to s.bestPathWorkers goroutines.
+// The paths for the same destination are still processed in their order
+// in pathList. The paths are split into the batches of the distinct
+// destinations since propagating a withdrawal to an add-path peer looks
+// up the current state of the destination.
+func (s *BgpServer) propagateUpdateConcurrently(peer *peer, rs, vrf bool, tableId string, rib *table.TableManager, pathList []*table.Path) {
+	imported := s.importPathsConcurrently(peer, rs, vrf, tableId, rib, pathList)
+
+	update := func(l []*table.Path) {
+		for i, dsts := range rib.UpdateBatch(l, s.bestPathWorkers) {
+			if len(dsts) > 0 {
+				s.propagateUpdateToNeighbors(rib, peer, l[i], dsts, true)
+			}
+		}
+	}
+	start := 0
+	seen := make(map[string]struct{}, len(imported))
+	for i, path := range imported {
+		key := path.GetRouteFamily().String() + " " + path.GetNlri().String()
+		if _, ok := seen[key]; ok {
+			update(imported[start:i])
+			start = i
+			seen = make(map[string]struct{}, len(imported)-i)
+		}
+		seen[key] = struct{}{}
+	}
+	update(imported[start:])
+}
+
+// importPathsConcurrently converts the paths from the VRF peer and
+// applies the import policy to them with up to s.bestPathWorkers
+// goroutines, and returns the paths to be put into rib, resolved over the
+// SR policies by the color. The paths resolved over an SR policy in the
+// same batch are resolved again by s.colors.flush().
+func (s *BgpServer) importPathsConcurrently(peer *peer, rs, vrf bool, tableId string, rib *table.TableManager, pathList []*table.Path) []*table.Path {
+	var info *table.PeerInfo
+	var peerVrf string
+	if peer != nil {
+		peer.fsm.lock.RLock()
+		info = peer.fsm.peerInfo
+		peerVrf = peer.fsm.pConf.Config.Vrf
+		peer.fsm.lock.RUnlock()
+	}
+	if rs {
+		info = nil
+	}
+
+	imported := make([]*table.Path, len(pathList))
+	for i, path := range pathList {
+		if vrf {
+			path = path.ToGlobal(rib.Vrfs[peerVrf])
+			if s.zclient != nil {
+				s.zclient.pathVrfMap[path] = rib.Vrfs[peerVrf].Id
+			}
+		}
+		imported[i] = path
+	}
+
+	// the policies and the ROA table are only read here
+	var wg sync.WaitGroup
+	chunk := (len(imported) + s.bestPathWorkers - 1) / s.bestPathWorkers
+	for start := 0; start < len(imported); start += chunk {
+		end := start + chunk
+		if end > len(imported) {
+			end = len(imported)
+		}
+		wg.Add(1)
+		go func(l []*table.Path) {
+			defer wg.Done()
+			for i, path := range l {
+				policyOptions := &table.PolicyOptions{
+					Info:     info,
+					Validate: s.roaTable.Validate,
+				}
+				if p := s.policy.ApplyPolicy(tableId, table.POLICY_DIRECTION_IMPORT, path, policyOptions); p != nil {
+					l[i] = peer.gracefulShutdownImport(p)
+				} else {
+					l[i] = path.Clone(true)
+				}
+			}
+		}(imported[start:end])
+	}
+	wg.Wait()
+
+	if !rs {
+		for i, path := range imported {
+			s.notifyPostPolicyUpdateWatcher(peer, []*table.Path{path})
+			imported[i] = s.colors.resolve(path)
+		}
+	}
+	return imported
+}
+
+// recordWithdrawals adds the withdrawals of the paths dropped from the
+// adj-RIB-in of the peer to the path history.
+func (s *BgpServer) recordWithdrawals(peer *peer, pathList []*table.Path) {
+	if s.pathHistory == nil {
+		return
+	}
+	types := make([]table.UpdateType, len(pathList))
+	for i := range types {
+		types[i] = table.UPDATE_TYPE_FLAP
+	}
+	s.pathHistory.record(peer.ID(), pathList, types, time.Now())
+}
+
+// the withdrawals of a peer with the smaller number of paths are
+// propagated one by one.
+const minBatchedWithdrawPaths = 256
+
+// dropPeerPaths removes the paths from peer of the families in rfList
+// from the adj-RIB-in and the RIB, and withdraws them from the other
+// peers. The time taken is recorded as the peer-down convergence time.
+func (s *BgpServer) dropPeerPaths(peer *peer, rfList []bgp.RouteFamily) {
+	start := time.Now()
+	pathList := peer.DropAll(rfList)
+	if len(pathList) == 0 {
+		return
+	}
+	s.recordWithdrawals(peer, pathList)
+	if len(pathList) < minBatchedWithdrawPaths || !canWithdrawInBatch(pathList) {
+		s.propagateUpdate(peer, pathList)
+	} else {
+		s.propagateWithdrawals(peer, pathList)
+	}
+	elapsed := time.Since(start)
+
+	peer.fsm.lock.Lock()
+	peer.fsm.pConf.Timers.State.PeerDownConvergenceTime = elapsed.Seconds()
+	peer.fsm.lock.Unlock()
+	s.logger.Info("paths from the peer are withdrawn",
+		log.Fields{
+			"Topic":    "Peer",
+			"Key":      peer.ID(),
+			"Paths":    len(pathList),
+			"Duration": elapsed})
+}
+
+// canWithdrawInBatch returns false if the withdrawals need the special
+// handling of propagateUpdate.
+func canWithdrawInBatch(pathList []*table.Path) bool {
+	for _, path := range pathList {
+		if path.GetRouteFamily() == bgp.RF_RTC_UC {
+			return false
+		}
+	}
+	return true
+}
+
+// propagateWithdrawals is propagateUpdate for the withdrawals of all the
+// paths from peer. The paths are removed from the RIB in one batch, and
+// the changes of the destinations are sent to each of the other peers
+// at once instead of path by path.
+func (s *BgpServer) propagateWithdrawals(peer *peer, pathList []*table.Path) {
+	rs, vrf, tableId, rib := s.importTarget(peer)
+	imported := s.importPathsConcurrently(peer, rs, vrf, tableId, rib, pathList)
+	paths, dsts := rib.WithdrawBatch(imported, s.bestPathWorkers)
+	s.propagateWithdrawalsToNeighbors(rib, peer, paths, dsts)
+}
+
+// propagateWithdrawalsToNeighbors is propagateUpdateToNeighbors for the
+// withdrawals in pathList, where dsts[i] is the change of the destination
+// of pathList[i]. The changes are sent to each peer in one message except
+// to the add-path peers and the route server clients with the secondary
+// routes, which get the changes destination by destination.
+func (s *BgpServer) propagateWithdrawalsToNeighbors(rib *table.TableManager, source *peer, pathList []*table.Path, dsts []*table.Update) {
+	if table.SelectionOptions.DisableBestPathSelection {
+		return
+	}
+	var gBestList, gOldList []*table.Path
+	if source == nil || !source.isRouteServerClient() {
+		var mpathList [][]*table.Path
+		gBestList, gOldList, mpathList = dstsToPaths(table.GLOBAL_RIB_NAME, 0, dsts)
+		s.notifyBestWatcher(gBestList, mpathList)
+		s.aggregates.update(gBestList)
+		s.leaks.update(gBestList)
+		s.colors.update(gBestList)
+		s.markConditionalAdvertisements(gBestList)
+		s.addMultiPathChanges(dsts, gBestList, gOldList)
+	}
+	for _, targetPeer := range s.neighborMap {
+		if !isPropagationTarget(source, targetPeer) {
+			continue
+		}
+		rsClient := targetPeer.isRouteServerClient()
+		secondary := rsClient && targetPeer.isSecondaryRouteEnabled()
+		cost := s.orr.costFunc(targetPeer)
+		var bestList, oldList []*table.Path
+		var rsDsts, orrDsts []*table.Update
+		for i, path := range pathList {
+			if secondary || targetPeer.isAddPathSendEnabled(targetFamily(targetPeer, path.GetRouteFamily())) {
+				var gBest, gOld []*table.Path
+				if gBestList != nil {
+					gBest, gOld = gBestList[i:i+1], gOldList[i:i+1]
+				}
+				s.propagateUpdateToNeighbor(rib, targetPeer, path, dsts[i:i+1], gBest, gOld, true)
+			} else if rsClient {
+				rsDsts = append(rsDsts, dsts[i])
+			} else if cost != nil {
+				orrDsts = append(orrDsts, dsts[i])
+			} else {
+				bestList = append(bestList, gBestList[i])
+				oldList = append(oldList, gOldList[i])
+			}
+		}
+		if len(rsDsts) > 0 {
+			bestList, oldList, _ = dstsToPaths(targetPeer.TableID(), targetPeer.AS(), rsDsts)
+		}
+		if len(orrDsts) > 0 {
+			bestList, oldList = orrChanges(cost, orrDsts)
+		}
+		if paths := s.processOutgoingPaths(targetPeer, bestList, oldList); len(paths) > 0 {
+			sendfsmOutgoingMsg(targetPeer, paths, nil, false)
+		}
+	}
+}
+
+// addMultiPathChanges sets the best paths of the destinations whose
+// multipaths have changed to bestList and oldList, so that the cumulative
+// link bandwidth is generated again with the multipaths.
+func (s *BgpServer) addMultiPathChanges(dsts []*table.Update, bestList, oldList []*table.Path) {
+	if !table.MultiPathEnabled() || !s.policy.HasCumulativeLinkBandwidthAction() {
+		return
+	}
+	for i, dst := range dsts {
+		if bestList[i] != nil {
+			continue
+		}
+		if best := dst.GetMultiPathChangedBest(table.GLOBAL_RIB_NAME); best != nil {
+			bestList[i] = best
+			oldList[i] = best
+		}
+	}
+}
+
 func dstsToPaths(id string, as uint32, dsts []*table.Update) ([]*table.Path, []*table.Path, [][]*table.Path) {
 	bestList := make([]*table.Path, 0, len(dsts))
 	oldList := make([]*table.Path, 0, len(dsts))
@@ -1317,139 +2214,167 @@ func (s *BgpServer) propagateUpdateToNeighbors(rib *table.TableManager, source *
 	if table.SelectionOptions.DisableBestPathSelection {
 		return
 	}
-	var gBestList, gOldList, bestList, oldList []*table.Path
+	var gBestList, gOldList []*table.Path
 	var mpathList [][]*table.Path
 	if source == nil || !source.isRouteServerClient() {
 		gBestList, gOldList, mpathList = dstsToPaths(table.GLOBAL_RIB_NAME, 0, dsts)
 		s.notifyBestWatcher(gBestList, mpathList)
+		s.aggregates.update(gBestList)
+		s.leaks.update(gBestList)
+		s.colors.update(gBestList)
+		s.markConditionalAdvertisements(gBestList)
+		s.addMultiPathChanges(dsts, gBestList, gOldList)
 	}
-	family := newPath.GetRouteFamily()
 	for _, targetPeer := range s.neighborMap {
-		if (source == nil && targetPeer.isRouteServerClient()) || (source != nil && source.isRouteServerClient() != targetPeer.isRouteServerClient()) {
+		if !isPropagationTarget(source, targetPeer) {
 			continue
 		}
-		f := func() bgp.RouteFamily {
-			targetPeer.fsm.lock.RLock()
-			peerVrf := targetPeer.fsm.pConf.Config.Vrf
-			targetPeer.fsm.lock.RUnlock()
-			if peerVrf != "" {
-				switch family {
-				case bgp.RF_IPv4_VPN:
-					return bgp.RF_IPv4_UC
-				case bgp.RF_IPv6_VPN:
-					return bgp.RF_IPv6_UC
-				case bgp.RF_FS_IPv4_VPN:
-					return bgp.RF_FS_IPv4_UC
-				case bgp.RF_FS_IPv6_VPN:
-					return bgp.RF_FS_IPv6_UC
-				}
-			}
-			return family
-		}()
-		if targetPeer.isAddPathSendEnabled(f) {
-			// in case of multiple paths to the same destination, we need to
-			// filter the paths before counting the number of paths to be sent.
-			if newPath.IsWithdraw {
-				bestList = func() []*table.Path {
-					l := []*table.Path{}
-					for _, d := range dsts {
-						toDelete := d.GetWithdrawnPath()
-						toActuallyDelete := make([]*table.Path, 0, len(toDelete))
-						for _, p := range toDelete {
-							// if the path is filtered, there is no need to send the withdrawal
-							p := s.filterpath(targetPeer, p, nil)
-							// the path was never advertized to the peer
-							if p == nil || targetPeer.unsetPathSendMaxFiltered(p) {
-								continue
-							}
-							toActuallyDelete = append(toActuallyDelete, p)
-						}
-
-						if len(toActuallyDelete) == 0 {
-							continue
-						}
+		s.propagateUpdateToNeighbor(rib, targetPeer, newPath, dsts, gBestList, gOldList, needOld)
+	}
+}
 
-						destination := rib.GetDestination(toActuallyDelete[0])
-						l = append(l, toActuallyDelete...)
+// isPropagationTarget returns true if the changes of the paths from
+// source are propagated to targetPeer.
+func isPropagationTarget(source, targetPeer *peer) bool {
+	if source == nil {
+		return !targetPeer.isRouteServerClient()
+	}
+	return source.isRouteServerClient() == targetPeer.isRouteServerClient()
+}
 
-						// the destination has been removed from the table
-						// e.g. no more paths to it
-						if destination == nil {
+// targetFamily returns the family of the paths of family sent to
+// targetPeer.
+func targetFamily(targetPeer *peer, family bgp.RouteFamily) bgp.RouteFamily {
+	targetPeer.fsm.lock.RLock()
+	peerVrf := targetPeer.fsm.pConf.Config.Vrf
+	targetPeer.fsm.lock.RUnlock()
+	if peerVrf != "" {
+		switch family {
+		case bgp.RF_IPv4_VPN:
+			return bgp.RF_IPv4_UC
+		case bgp.RF_IPv6_VPN:
+			return bgp.RF_IPv6_UC
+		case bgp.RF_FS_IPv4_VPN:
+			return bgp.RF_FS_IPv4_UC
+		case bgp.RF_FS_IPv6_VPN:
+			return bgp.RF_FS_IPv6_UC
+		}
+	}
+	return family
+}
+
+// propagateUpdateToNeighbor sends targetPeer the changes of dsts made by
+// newPath.
+func (s *BgpServer) propagateUpdateToNeighbor(rib *table.TableManager, targetPeer *peer, newPath *table.Path, dsts []*table.Update, gBestList, gOldList []*table.Path, needOld bool) {
+	var bestList, oldList []*table.Path
+	f := targetFamily(targetPeer, newPath.GetRouteFamily())
+	if targetPeer.isAddPathSendEnabled(f) {
+		// in case of multiple paths to the same destination, we need to
+		// filter the paths before counting the number of paths to be sent.
+		if targetPeer.isAddPathSendBestEnabled(f) {
+			bestList = s.getAddPathBestChanges(targetPeer, f, newPath, dsts)
+		} else if newPath.IsWithdraw {
+			bestList = func() []*table.Path {
+				l := []*table.Path{}
+				for _, d := range dsts {
+					toDelete := d.GetWithdrawnPath()
+					toActuallyDelete := make([]*table.Path, 0, len(toDelete))
+					for _, p := range toDelete {
+						// if the path is filtered, there is no need to send the withdrawal
+						p := s.filterpath(targetPeer, p, nil)
+						// the path was never advertized to the peer
+						if p == nil || targetPeer.unsetPathSendMaxFiltered(p) {
 							continue
 						}
+						toActuallyDelete = append(toActuallyDelete, p)
+					}
 
-						knownPathList := destination.GetKnownPathList(targetPeer.TableID(), targetPeer.AS())
-						toAdd := make([]*table.Path, 0, len(knownPathList))
-						for _, p := range knownPathList {
-							// if the path is filtered, there is no need to send the path
-							p := s.filterpath(targetPeer, p, nil)
-							if p == nil || !targetPeer.isPathSendMaxFiltered(p) {
-								continue
-							}
-							toAdd = append(toAdd, p)
-							if len(toAdd) == len(toActuallyDelete) {
-								break
-							}
-						}
-						l = append(l, toAdd...)
+					if len(toActuallyDelete) == 0 {
+						continue
 					}
-					targetPeer.updateRoutes(l...)
-					return l
-				}()
-			} else {
-				alreadySent := targetPeer.hasPathAlreadyBeenSent(newPath)
-				newPath := s.filterpath(targetPeer, newPath, nil)
-				// if the path is not filtered and the path has already been sent or land in the limit, we can send it
-				if newPath == nil {
-					bestList = []*table.Path{}
-				} else if alreadySent || targetPeer.getRoutesCount(f, newPath.GetPrefix()) < targetPeer.getAddPathSendMax(f) {
-					bestList = []*table.Path{newPath}
-					if !alreadySent {
-						targetPeer.updateRoutes(newPath)
+
+					destination := rib.GetDestination(toActuallyDelete[0])
+					l = append(l, toActuallyDelete...)
+
+					// the destination has been removed from the table
+					// e.g. no more paths to it
+					if destination == nil {
+						continue
 					}
-					if newPath.GetRouteFamily() == bgp.RF_RTC_UC {
-						// we assumes that new "path" nlri was already sent before. This assumption avoids the
-						// infinite UPDATE loop between Route Reflector and its clients.
-						for _, old := range dsts[0].OldKnownPathList {
-							if old.IsLocal() {
-								bestList = []*table.Path{}
-								break
-							}
+
+					knownPathList := destination.GetKnownPathList(targetPeer.TableID(), targetPeer.AS())
+					toAdd := make([]*table.Path, 0, len(knownPathList))
+					for _, p := range knownPathList {
+						// if the path is filtered, there is no need to send the path
+						p := s.filterpath(targetPeer, p, nil)
+						if p == nil || !targetPeer.isPathSendMaxFiltered(p) {
+							continue
+						}
+						toAdd = append(toAdd, p)
+						if len(toAdd) == len(toActuallyDelete) {
+							break
 						}
 					}
-				} else {
-					bestList = []*table.Path{}
-					targetPeer.sendMaxPathFiltered[newPath.GetLocalKey()] = struct{}{}
-					s.logger.Warn("exceeding max routes for prefix",
-						log.Fields{
-							"Topic":  "Peer",
-							"Key":    targetPeer.ID(),
-							"Prefix": newPath.GetPrefix(),
-						})
+					l = append(l, toAdd...)
 				}
-			}
-			if needToAdvertise(targetPeer) && len(bestList) > 0 {
-				sendfsmOutgoingMsg(targetPeer, bestList, nil, false)
-			}
+				targetPeer.updateRoutes(l...)
+				return l
+			}()
 		} else {
-			if targetPeer.isRouteServerClient() {
-				if targetPeer.isSecondaryRouteEnabled() {
-					if paths := s.sendSecondaryRoutes(targetPeer, newPath, dsts); len(paths) > 0 {
-						sendfsmOutgoingMsg(targetPeer, paths, nil, false)
+			alreadySent := targetPeer.hasPathAlreadyBeenSent(newPath)
+			newPath := s.filterpath(targetPeer, newPath, nil)
+			// if the path is not filtered and the path has already been sent or land in the limit, we can send it
+			if newPath == nil {
+				bestList = []*table.Path{}
+			} else if alreadySent || targetPeer.getRoutesCount(f, newPath.GetPrefix()) < targetPeer.getAddPathSendMax(f) {
+				bestList = []*table.Path{newPath}
+				if !alreadySent {
+					targetPeer.updateRoutes(newPath)
+				}
+				if newPath.GetRouteFamily() == bgp.RF_RTC_UC {
+					// we assumes that new "path" nlri was already sent before. This assumption avoids the
+					// infinite UPDATE loop between Route Reflector and its clients.
+					for _, old := range dsts[0].OldKnownPathList {
+						if old.IsLocal() {
+							bestList = []*table.Path{}
+							break
+						}
 					}
-					continue
 				}
-				bestList, oldList, _ = dstsToPaths(targetPeer.TableID(), targetPeer.AS(), dsts)
 			} else {
-				bestList = gBestList
-				oldList = gOldList
-			}
-			if !needOld {
-				oldList = nil
+				bestList = []*table.Path{}
+				targetPeer.sendMaxPathFiltered[newPath.GetLocalKey()] = struct{}{}
+				s.logger.Warn("exceeding max routes for prefix",
+					log.Fields{
+						"Topic":  "Peer",
+						"Key":    targetPeer.ID(),
+						"Prefix": newPath.GetPrefix(),
+					})
 			}
-			if paths := s.processOutgoingPaths(targetPeer, bestList, oldList); len(paths) > 0 {
-				sendfsmOutgoingMsg(targetPeer, paths, nil, false)
+		}
+		if needToAdvertise(targetPeer) && len(bestList) > 0 {
+			sendfsmOutgoingMsg(targetPeer, bestList, nil, false)
+		}
+	} else {
+		if targetPeer.isRouteServerClient() {
+			if targetPeer.isSecondaryRouteEnabled() {
+				if paths := s.sendSecondaryRoutes(targetPeer, newPath, dsts); len(paths) > 0 {
+					sendfsmOutgoingMsg(targetPeer, paths, nil, false)
+				}
+				return
 			}
+			bestList, oldList, _ = dstsToPaths(targetPeer.TableID(), targetPeer.AS(), dsts)
+		} else if cost := s.orr.costFunc(targetPeer); cost != nil {
+			bestList, oldList = orrChanges(cost, dsts)
+		} else {
+			bestList = gBestList
+			oldList = gOldList
+		}
+		if !needOld {
+			oldList = nil
+		}
+		if paths := s.processOutgoingPaths(targetPeer, bestList, oldList); len(paths) > 0 {
+			sendfsmOutgoingMsg(targetPeer, paths, nil, false)
 		}
 	}
 }
@@ -1458,7 +2383,10 @@ func (s *BgpServer) deleteDynamicNeighbor(peer *peer, oldState bgp.FSMState, e *
 	peer.stopPeerRestarting()
 	peer.fsm.lock.RLock()
 	delete(s.neighborMap, peer.fsm.pConf.State.NeighborAddress)
+	s.setNetlinkPeerVrf(peer.fsm.pConf.State.NeighborAddress, "")
 	peer.fsm.lock.RUnlock()
+	peer.mrai.reset()
+	peer.slowQueue.reset()
 	cleanInfiniteChannel(peer.fsm.outgoingCh)
 	cleanInfiniteChannel(peer.fsm.incomingCh)
 	s.delIncoming(peer.fsm.incomingCh)
@@ -1476,6 +2404,11 @@ func (s *BgpServer) handleFSMMessage(peer *peer, e *fsmMsg) {
 
 		peer.fsm.StateChange(nextState)
 
+		if nextState == bgp.BGP_FSM_ACTIVE {
+			// retrieved again for every session establishment
+			s.refreshNeighborPassword(peer)
+		}
+
 		peer.fsm.lock.RLock()
 		nextStateIdle := peer.fsm.pConf.GracefulRestart.State.PeerRestarting && nextState == bgp.BGP_FSM_IDLE
 		peer.fsm.lock.RUnlock()
@@ -1509,7 +2442,11 @@ func (s *BgpServer) handleFSMMessage(peer *peer, e *fsmMsg) {
 			peer.fsm.lock.Unlock()
 
 			peer.prefixLimitWarned = make(map[bgp.RouteFamily]bool)
-			s.propagateUpdate(peer, peer.DropAll(drop))
+			peer.sentPaths = make(map[table.PathDestLocalKey]map[uint32]*table.Path)
+			peer.sendMaxPathFiltered = make(map[table.PathLocalKey]struct{})
+			peer.prefixOrfs = make(map[bgp.RouteFamily]*prefixOrf)
+			peer.prefixOrfSent = make(map[bgp.RouteFamily]uint32)
+			s.dropPeerPaths(peer, drop)
 
 			peer.fsm.lock.Lock()
 			if peer.fsm.pConf.Config.PeerAs == 0 {
@@ -1530,7 +2467,7 @@ func (s *BgpServer) handleFSMMessage(peer *peer, e *fsmMsg) {
 			if longLivedEnabled {
 				llgr, no_llgr := peer.llgrFamilies()
 
-				s.propagateUpdate(peer, peer.DropAll(no_llgr))
+				s.dropPeerPaths(peer, no_llgr)
 
 				// attach LLGR_STALE community to paths in peer's adj-rib-in
 				// paths with NO_LLGR are deleted
@@ -1566,7 +2503,7 @@ func (s *BgpServer) handleFSMMessage(peer *peer, e *fsmMsg) {
 										"Family":   family,
 										"Duration": t})
 
-								s.propagateUpdate(peer, peer.DropAll([]bgp.RouteFamily{family}))
+								s.dropPeerPaths(peer, []bgp.RouteFamily{family})
 
 								// when all llgr restart timer expired, stop PeerRestarting
 								if peer.llgrRestartTimerExpired(family) {
@@ -1593,7 +2530,7 @@ func (s *BgpServer) handleFSMMessage(peer *peer, e *fsmMsg) {
 				peer.fsm.pConf.GracefulRestart.State.PeerRestarting = false
 				peer.fsm.lock.Unlock()
 
-				s.propagateUpdate(peer, peer.DropAll(peer.configuredRFlist()))
+				s.dropPeerPaths(peer, peer.configuredRFlist())
 
 				if peer.isDynamicNeighbor() {
 					s.deleteDynamicNeighbor(peer, oldState, e)
@@ -1602,9 +2539,12 @@ func (s *BgpServer) handleFSMMessage(peer *peer, e *fsmMsg) {
 			}
 		}
 
+		peer.mrai.reset()
+		peer.slowQueue.reset()
 		cleanInfiniteChannel(peer.fsm.outgoingCh)
 		peer.fsm.outgoingCh = channels.NewInfiniteChannel()
 		if nextState == bgp.BGP_FSM_ESTABLISHED {
+			s.updateUnnumberedPeer(peer)
 			// update for export policy
 			laddr, _ := peer.fsm.LocalHostPort()
 			// may include zone info
@@ -1623,14 +2563,18 @@ func (s *BgpServer) handleFSMMessage(peer *peer, e *fsmMsg) {
 					}, false)
 				}
 			}
+			// push the ORF entries first so that the peer filters
+			// the routes it advertises
+			s.sendPrefixOrf(peer, peer.negotiatedRFList())
+
 			peer.fsm.lock.RLock()
 			notLocalRestarting := !peer.fsm.pConf.GracefulRestart.State.LocalRestarting
 			peer.fsm.lock.RUnlock()
 			if notLocalRestarting {
 				// When graceful-restart cap (which means intention
 				// of sending EOR) and route-target address family are negotiated,
-				// send route-target NLRIs first, and wait to send others
-				// till receiving EOR of route-target address family.
+				// send route-target NLRIs first, and wait to send the VPN
+				// NLRIs till receiving EOR of route-target address family.
 				// This prevents sending uninterested routes to peers.
 				//
 				// However, when the peer is graceful restarting, give up
@@ -1643,13 +2587,17 @@ func (s *BgpServer) handleFSMMessage(peer *peer, e *fsmMsg) {
 				notPeerRestarting := !peer.fsm.pConf.GracefulRestart.State.PeerRestarting
 				peer.fsm.lock.RUnlock()
 				if y && notPeerRestarting && c.RouteTargetMembership.Config.DeferralTime > 0 {
-					pathList, _ = s.getBestFromLocal(peer, []bgp.RouteFamily{bgp.RF_RTC_UC})
+					// only the families constrained by route-target wait
 					t := c.RouteTargetMembership.Config.DeferralTime
+					families := make([]bgp.RouteFamily, 0, len(peer.negotiatedRFList()))
 					for _, f := range peer.negotiatedRFList() {
-						if f != bgp.RF_RTC_UC {
+						if table.IsRouteTargetConstrained(f) {
 							time.AfterFunc(time.Second*time.Duration(t), deferralExpiredFunc(f))
+						} else {
+							families = append(families, f)
 						}
 					}
+					pathList, _ = s.getBestFromLocal(peer, families)
 				} else {
 					pathList, _ = s.getBestFromLocal(peer, peer.negotiatedRFList())
 				}
@@ -1736,13 +2684,25 @@ func (s *BgpServer) handleFSMMessage(peer *peer, e *fsmMsg) {
 			sendfsmOutgoingMsg(peer, paths, nil, false)
 			return
 		}
+	case fsmMsgCapability:
+		peer.fsm.lock.RLock()
+		notEstablished := peer.fsm.state != bgp.BGP_FSM_ESTABLISHED
+		beforeUptime := e.timestamp.Unix() < peer.fsm.pConf.Timers.State.Uptime
+		peer.fsm.lock.RUnlock()
+		if notEstablished || beforeUptime {
+			return
+		}
+		s.handleCapability(peer, e)
 	case fsmMsgBGPMessage:
 		switch m := e.MsgData.(type) {
 		case *bgp.MessageError:
+			if len(e.payload) > 0 {
+				s.notifyRecvMessageWatcher(peer, e, nil)
+			}
 			sendfsmOutgoingMsg(peer, nil, bgp.NewBGPNotificationMessage(m.TypeCode, m.SubTypeCode, m.Data), false)
 			return
 		case *bgp.BGPMessage:
-			s.notifyRecvMessageWatcher(peer, e.timestamp, m)
+			s.notifyRecvMessageWatcher(peer, e, m)
 			peer.fsm.lock.RLock()
 			notEstablished := peer.fsm.state != bgp.BGP_FSM_ESTABLISHED
 			beforeUptime := e.timestamp.Unix() < peer.fsm.pConf.Timers.State.Uptime
@@ -1750,7 +2710,12 @@ func (s *BgpServer) handleFSMMessage(peer *peer, e *fsmMsg) {
 			if notEstablished || beforeUptime {
 				return
 			}
-			pathList, eor, notification := peer.handleUpdate(e)
+			pathList, eor, notification := peer.handleUpdate(e, func(pathList []*table.Path, types []table.UpdateType) {
+				s.activity.record(peer.ID(), pathList, types, e.timestamp)
+				if s.pathHistory != nil {
+					s.pathHistory.record(peer.ID(), pathList, types, e.timestamp)
+				}
+			})
 			if notification != nil {
 				sendfsmOutgoingMsg(peer, nil, notification, true)
 				return
@@ -1845,6 +2810,7 @@ func (s *BgpServer) handleFSMMessage(peer *peer, e *fsmMsg) {
 					if peer.recvedAllEOR() {
 						peer.stopPeerRestarting()
 						pathList := peer.adjRibIn.DropStale(peer.configuredRFlist())
+						s.recordWithdrawals(peer, pathList)
 						peer.fsm.lock.RLock()
 						s.logger.Debug("withdraw stale routes",
 							log.Fields{
@@ -1871,7 +2837,7 @@ func (s *BgpServer) handleFSMMessage(peer *peer, e *fsmMsg) {
 							"Key":   peer.ID()})
 					families := make([]bgp.RouteFamily, 0, len(peer.negotiatedRFList()))
 					for _, f := range peer.negotiatedRFList() {
-						if f != bgp.RF_RTC_UC {
+						if table.IsRouteTargetConstrained(f) {
 							families = append(families, f)
 						}
 					}
@@ -1915,91 +2881,950 @@ func (s *BgpServer) EnableZebra(ctx context.Context, r *api.EnableZebraRequest)
 	}, false)
 }
 
-func (s *BgpServer) AddBmp(ctx context.Context, r *api.AddBmpRequest) error {
+func (s *BgpServer) EnableNetlink(ctx context.Context, r *api.EnableNetlinkRequest) error {
 	if r == nil {
 		return fmt.Errorf("nil request")
 	}
 	return s.mgmtOperation(func() error {
-		_, ok := api.AddBmpRequest_MonitoringPolicy_name[int32(r.Policy)]
-		if !ok {
-			return fmt.Errorf("invalid bmp route monitoring policy: %v", r.Policy)
+		if s.nlclient != nil {
+			return fmt.Errorf("netlink is already enabled")
 		}
-		port := r.Port
-		if port == 0 {
-			port = bmp.BMP_DEFAULT_PORT
+		families := make([]bgp.RouteFamily, 0, len(r.Families))
+		for _, f := range r.Families {
+			families = append(families, bgp.AfiSafiToRouteFamily(uint16(f.Afi), uint8(f.Safi)))
 		}
-		sysname := r.SysName
-		if sysname == "" {
-			sysname = "GoBGP"
+		if len(families) == 0 {
+			families = []bgp.RouteFamily{bgp.RF_IPv4_UC, bgp.RF_IPv6_UC}
 		}
-		sysDescr := r.SysDescr
-		if sysDescr == "" {
-			sysDescr = version.Version()
+		var err error
+		s.nlclient, err = newNetlinkClient(s, families, int(r.TableId), int(r.Protocol), int(r.Metric), time.Duration(r.ReconcileTime)*time.Second)
+		if err != nil {
+			return err
 		}
-		s.logger.Debug("add bmp server", log.Fields{"address": r.Address, "port": r.Port, "policy": r.Policy})
-		return s.bmpManager.addServer(&oc.BmpServerConfig{
-			Address:               r.Address,
-			Port:                  port,
-			SysName:               sysname,
-			SysDescr:              sysDescr,
-			RouteMonitoringPolicy: oc.IntToBmpRouteMonitoringPolicyTypeMap[int(r.Policy)],
-			StatisticsTimeout:     uint16(r.StatisticsTimeout),
-		})
-	}, true)
+		for addr, peer := range s.neighborMap {
+			peer.fsm.lock.RLock()
+			vrf := peer.fsm.pConf.Transport.Config.Vrf
+			peer.fsm.lock.RUnlock()
+			if vrf != "" {
+				s.setNetlinkPeerVrf(addr, vrf)
+			}
+		}
+		return nil
+	}, false)
 }
 
-func (s *BgpServer) DeleteBmp(ctx context.Context, r *api.DeleteBmpRequest) error {
+func (s *BgpServer) DisableNetlink(ctx context.Context, r *api.DisableNetlinkRequest) error {
 	if r == nil {
 		return fmt.Errorf("nil request")
 	}
 	return s.mgmtOperation(func() error {
-		return s.bmpManager.deleteServer(&oc.BmpServerConfig{
-			Address: r.Address,
-			Port:    r.Port,
-		})
-	}, true)
+		if s.nlclient == nil {
+			return fmt.Errorf("netlink isn't enabled")
+		}
+		s.nlclient.stop()
+		s.nlclient = nil
+		return nil
+	}, false)
 }
 
-func (s *BgpServer) ListBmp(ctx context.Context, req *api.ListBmpRequest, fn func(*api.ListBmpResponse_BmpStation)) error {
-	if req == nil {
-		return fmt.Errorf("null request")
+func (s *BgpServer) EnablePeeringDb(ctx context.Context, r *api.EnablePeeringDbRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
 	}
-	var stations []*api.ListBmpResponse_BmpStation
+	return s.mgmtOperation(func() error {
+		if s.peeringdb != nil {
+			return fmt.Errorf("peeringdb is already enabled")
+		}
+		var err error
+		s.peeringdb, err = newPeeringdbClient(s, r)
+		return err
+	}, false)
+}
+
+func (s *BgpServer) DisablePeeringDb(ctx context.Context, r *api.DisablePeeringDbRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		if s.peeringdb == nil {
+			return fmt.Errorf("peeringdb isn't enabled")
+		}
+		s.peeringdb.stop()
+		s.peeringdb = nil
+		for _, peer := range s.neighborMap {
+			peer.peeringdb = nil
+		}
+		return nil
+	}, false)
+}
+
+func (s *BgpServer) ListPeeringDbRecord(ctx context.Context, r *api.ListPeeringDbRecordRequest, fn func(*api.PeeringDbRecord)) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	var l []*api.PeeringDbRecord
 	err := s.mgmtOperation(func() error {
-		for _, s := range s.bmpManager.clientMap {
-			stations = append(stations, &api.ListBmpResponse_BmpStation{
-				Conf: &api.ListBmpResponse_BmpStation_Conf{
-					Address: s.c.Address,
-					Port:    s.c.Port,
-				},
-				State: &api.ListBmpResponse_BmpStation_State{
-					Uptime:   oc.ProtoTimestamp(atomic.LoadInt64(&s.uptime)),
-					Downtime: oc.ProtoTimestamp(atomic.LoadInt64(&s.downtime)),
-				},
-			})
+		if s.peeringdb == nil {
+			return fmt.Errorf("peeringdb isn't enabled")
+		}
+		for asn, record := range s.peeringdb.records {
+			if r.Asn != 0 && r.Asn != asn {
+				continue
+			}
+			l = append(l, record.toApiStruct())
 		}
 		return nil
 	}, false)
 	if err == nil {
-		for _, rsp := range stations {
+		sort.Slice(l, func(i, j int) bool { return l[i].Asn < l[j].Asn })
+		for _, record := range l {
 			select {
 			case <-ctx.Done():
 				return nil
 			default:
-				fn(rsp)
+				fn(record)
 			}
 		}
 	}
 	return err
 }
 
-func (s *BgpServer) StopBgp(ctx context.Context, r *api.StopBgpRequest) error {
+func (s *BgpServer) SetSrv6Locator(ctx context.Context, r *api.SetSrv6LocatorRequest) error {
 	if r == nil {
 		return fmt.Errorf("nil request")
 	}
-	s.mgmtOperation(func() error {
-		names := make([]string, 0, len(s.neighborMap))
-		for k := range s.neighborMap {
+	return s.mgmtOperation(func() error {
+		if len(s.globalRib.Vrfs) > 0 {
+			return fmt.Errorf("srv6 locator must be set before adding vrfs")
+		}
+		l, err := newSRv6Locator(r)
+		if err != nil {
+			return err
+		}
+		s.srv6Locator = l
+		return nil
+	}, false)
+}
+
+func (s *BgpServer) AddBmp(ctx context.Context, r *api.AddBmpRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		_, ok := api.AddBmpRequest_MonitoringPolicy_name[int32(r.Policy)]
+		if !ok {
+			return fmt.Errorf("invalid bmp route monitoring policy: %v", r.Policy)
+		}
+		if _, ok := api.AddBmpRequest_Mode_name[int32(r.Mode)]; !ok {
+			return fmt.Errorf("invalid bmp mode: %v", r.Mode)
+		}
+		if _, ok := api.AddBmpRequest_Transport_name[int32(r.Transport)]; !ok {
+			return fmt.Errorf("invalid bmp transport: %v", r.Transport)
+		}
+		port := r.Port
+		if port == 0 {
+			port = bmp.BMP_DEFAULT_PORT
+		}
+		sysname := r.SysName
+		if sysname == "" {
+			sysname = "GoBGP"
+		}
+		sysDescr := r.SysDescr
+		if sysDescr == "" {
+			sysDescr = version.Version()
+		}
+		tlsConf := r.Tls
+		if tlsConf == nil {
+			tlsConf = &api.BMPTLSConf{}
+		}
+		for _, n := range r.RouteMirroringNeighbors {
+			if net.ParseIP(n) == nil {
+				return fmt.Errorf("invalid route mirroring neighbor address: %s", n)
+			}
+		}
+		s.logger.Debug("add bmp server", log.Fields{"address": r.Address, "port": r.Port, "policy": r.Policy, "mode": r.Mode, "transport": r.Transport})
+		return s.bmpManager.addServer(&oc.BmpServerConfig{
+			Address:                    r.Address,
+			Port:                       port,
+			SysName:                    sysname,
+			SysDescr:                   sysDescr,
+			RouteMonitoringPolicy:      oc.IntToBmpRouteMonitoringPolicyTypeMap[int(r.Policy)],
+			StatisticsTimeout:          uint16(r.StatisticsTimeout),
+			Mode:                       oc.IntToBmpModeTypeMap[int(r.Mode)],
+			LocalAddress:               r.LocalAddress,
+			AuthPassword:               r.AuthPassword,
+			Transport:                  oc.IntToBmpTransportTypeMap[int(r.Transport)],
+			TlsCaFile:                  tlsConf.CaFile,
+			TlsCertFile:                tlsConf.CertFile,
+			TlsKeyFile:                 tlsConf.KeyFile,
+			TlsServerName:              tlsConf.ServerName,
+			RouteMirroringEnabled:      r.RouteMirroring,
+			RouteMirroringNeighborList: r.RouteMirroringNeighbors,
+			RouteMirroringRateLimit:    r.RouteMirroringRateLimit,
+		})
+	}, true)
+}
+
+func (s *BgpServer) DeleteBmp(ctx context.Context, r *api.DeleteBmpRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		return s.bmpManager.deleteServer(&oc.BmpServerConfig{
+			Address: r.Address,
+			Port:    r.Port,
+		})
+	}, true)
+}
+
+func (s *BgpServer) ListBmp(ctx context.Context, req *api.ListBmpRequest, fn func(*api.ListBmpResponse_BmpStation)) error {
+	if req == nil {
+		return fmt.Errorf("null request")
+	}
+	var stations []*api.ListBmpResponse_BmpStation
+	err := s.mgmtOperation(func() error {
+		for _, s := range s.bmpManager.clientMap {
+			stations = append(stations, &api.ListBmpResponse_BmpStation{
+				Conf: &api.ListBmpResponse_BmpStation_Conf{
+					Address:   s.c.Address,
+					Port:      s.c.Port,
+					Mode:      api.AddBmpRequest_Mode(s.c.Mode.ToInt()),
+					Transport: api.AddBmpRequest_Transport(s.c.Transport.ToInt()),
+				},
+				State: &api.ListBmpResponse_BmpStation_State{
+					Uptime:   oc.ProtoTimestamp(atomic.LoadInt64(&s.uptime)),
+					Downtime: oc.ProtoTimestamp(atomic.LoadInt64(&s.downtime)),
+					Sent:     atomic.LoadUint64(&s.sent),
+					Dropped:  atomic.LoadUint64(&s.dropped),
+				},
+			})
+		}
+		return nil
+	}, false)
+	if err == nil {
+		for _, rsp := range stations {
+			select {
+			case <-ctx.Done():
+				return nil
+			default:
+				fn(rsp)
+			}
+		}
+	}
+	return err
+}
+
+func (s *BgpServer) AddKafkaExporter(ctx context.Context, r *api.AddKafkaExporterRequest) error {
+	if r == nil || r.Exporter == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		e := r.Exporter
+		if e.Name == "" {
+			return fmt.Errorf("kafka exporter name is missing")
+		}
+		if len(e.Brokers) == 0 || e.Topic == "" {
+			return fmt.Errorf("kafka exporter %s needs brokers and topic", e.Name)
+		}
+		if _, ok := api.AddBmpRequest_MonitoringPolicy_name[int32(e.Policy)]; !ok {
+			return fmt.Errorf("invalid kafka route monitoring policy: %v", e.Policy)
+		}
+		return s.kafkaManager.addExporter(&oc.KafkaExporterConfig{
+			Name:                  e.Name,
+			BrokerList:            e.Brokers,
+			Topic:                 e.Topic,
+			Encoding:              oc.IntToKafkaEncodingTypeMap[int(e.Encoding)],
+			PartitionKey:          oc.IntToKafkaPartitionKeyTypeMap[int(e.PartitionKey)],
+			RouteMonitoringPolicy: oc.IntToBmpRouteMonitoringPolicyTypeMap[int(e.Policy)],
+		}, nil)
+	}, true)
+}
+
+func (s *BgpServer) DeleteKafkaExporter(ctx context.Context, r *api.DeleteKafkaExporterRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		return s.kafkaManager.deleteExporter(r.Name)
+	}, true)
+}
+
+func (s *BgpServer) ListKafkaExporter(ctx context.Context, r *api.ListKafkaExporterRequest, fn func(*api.ListKafkaExporterResponse)) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	var l []*api.ListKafkaExporterResponse
+	err := s.mgmtOperation(func() error {
+		for name, e := range s.kafkaManager.exporterMap {
+			if r.Name != "" && r.Name != name {
+				continue
+			}
+			l = append(l, &api.ListKafkaExporterResponse{
+				Exporter: &api.KafkaExporter{
+					Name:         e.c.Name,
+					Brokers:      e.c.BrokerList,
+					Topic:        e.c.Topic,
+					Encoding:     api.KafkaExporter_Encoding(e.c.Encoding.ToInt()),
+					PartitionKey: api.KafkaExporter_PartitionKey(e.c.PartitionKey.ToInt()),
+					Policy:       api.AddBmpRequest_MonitoringPolicy(e.c.RouteMonitoringPolicy.ToInt()),
+				},
+				State: &api.KafkaExporterState{
+					Sent:   atomic.LoadUint64(&e.sent),
+					Errors: atomic.LoadUint64(&e.errors),
+				},
+			})
+		}
+		return nil
+	}, false)
+	if err == nil {
+		for _, rsp := range l {
+			select {
+			case <-ctx.Done():
+				return nil
+			default:
+				fn(rsp)
+			}
+		}
+	}
+	return err
+}
+
+func (s *BgpServer) AddIrrFilter(ctx context.Context, r *api.AddIrrFilterRequest) error {
+	if r == nil || r.Filter == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		f := r.Filter
+		if f.Name == "" {
+			return fmt.Errorf("irr filter name is missing")
+		}
+		if f.AsSet == "" {
+			return fmt.Errorf("irr filter %s needs as-set", f.Name)
+		}
+		if f.Ipv4PrefixSet == "" && f.Ipv6PrefixSet == "" && f.AsPathSet == "" {
+			return fmt.Errorf("irr filter %s needs any of ipv4 prefix set, ipv6 prefix set and as-path set", f.Name)
+		}
+		if f.Ipv4MaxLength > 32 || f.Ipv6MaxLength > 128 {
+			return fmt.Errorf("irr filter %s has invalid max length", f.Name)
+		}
+		c := &oc.IrrFilterConfig{
+			Name:            f.Name,
+			AsSet:           f.AsSet,
+			ServerAddress:   f.Server,
+			SourceList:      f.Sources,
+			RefreshInterval: f.RefreshInterval,
+			Ipv4PrefixSet:   f.Ipv4PrefixSet,
+			Ipv6PrefixSet:   f.Ipv6PrefixSet,
+			AsPathSet:       f.AsPathSet,
+			Ipv4MaxLength:   uint8(f.Ipv4MaxLength),
+			Ipv6MaxLength:   uint8(f.Ipv6MaxLength),
+			MaxPrefixes:     f.MaxPrefixes,
+			MaxAsns:         f.MaxAsns,
+		}
+		if c.ServerAddress == "" {
+			c.ServerAddress = oc.DEFAULT_IRR_SERVER_ADDRESS
+		}
+		if c.RefreshInterval == 0 {
+			c.RefreshInterval = oc.DEFAULT_IRR_REFRESH_INTERVAL
+		}
+		return s.irrManager.addFilter(c)
+	}, false)
+}
+
+func (s *BgpServer) DeleteIrrFilter(ctx context.Context, r *api.DeleteIrrFilterRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		return s.irrManager.deleteFilter(r.Name)
+	}, false)
+}
+
+func (s *BgpServer) ListIrrFilter(ctx context.Context, r *api.ListIrrFilterRequest, fn func(*api.ListIrrFilterResponse)) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	var l []*api.ListIrrFilterResponse
+	err := s.mgmtOperation(func() error {
+		for name, f := range s.irrManager.filterMap {
+			if r.Name != "" && r.Name != name {
+				continue
+			}
+			l = append(l, &api.ListIrrFilterResponse{
+				Filter: &api.IrrFilter{
+					Name:            f.c.Name,
+					AsSet:           f.c.AsSet,
+					Server:          f.c.ServerAddress,
+					Sources:         f.c.SourceList,
+					RefreshInterval: f.c.RefreshInterval,
+					Ipv4PrefixSet:   f.c.Ipv4PrefixSet,
+					Ipv6PrefixSet:   f.c.Ipv6PrefixSet,
+					AsPathSet:       f.c.AsPathSet,
+					Ipv4MaxLength:   uint32(f.c.Ipv4MaxLength),
+					Ipv6MaxLength:   uint32(f.c.Ipv6MaxLength),
+					MaxPrefixes:     f.c.MaxPrefixes,
+					MaxAsns:         f.c.MaxAsns,
+				},
+				State: f.state(),
+			})
+		}
+		return nil
+	}, false)
+	if err == nil {
+		for _, rsp := range l {
+			select {
+			case <-ctx.Done():
+				return nil
+			default:
+				fn(rsp)
+			}
+		}
+	}
+	return err
+}
+
+func (s *BgpServer) AddAggregate(ctx context.Context, r *api.AddAggregateRequest) error {
+	if r == nil || r.Aggregate == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		return s.aggregates.addAggregate(&oc.AggregateConfig{
+			Prefix:               r.Aggregate.Prefix,
+			AsSet:                r.Aggregate.AsSet,
+			SummaryOnly:          r.Aggregate.SummaryOnly,
+			SuppressNeighborList: r.Aggregate.SuppressNeighbors,
+		})
+	}, true)
+}
+
+func (s *BgpServer) DeleteAggregate(ctx context.Context, r *api.DeleteAggregateRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		return s.aggregates.deleteAggregate(r.Prefix)
+	}, true)
+}
+
+func (s *BgpServer) ListAggregate(ctx context.Context, r *api.ListAggregateRequest, fn func(*api.ListAggregateResponse)) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	key := r.Prefix
+	if _, p, err := net.ParseCIDR(key); err == nil {
+		key = p.String()
+	}
+	var l []*api.ListAggregateResponse
+	err := s.mgmtOperation(func() error {
+		for prefix, a := range s.aggregates.aggregates {
+			if key != "" && key != prefix {
+				continue
+			}
+			l = append(l, &api.ListAggregateResponse{
+				Aggregate: &api.Aggregate{
+					Prefix:            a.c.Prefix,
+					AsSet:             a.c.AsSet,
+					SummaryOnly:       a.c.SummaryOnly,
+					SuppressNeighbors: a.c.SuppressNeighborList,
+				},
+				Contributors: uint32(len(a.contributors)),
+				Installed:    a.path != nil,
+			})
+		}
+		return nil
+	}, false)
+	if err == nil {
+		for _, rsp := range l {
+			select {
+			case <-ctx.Done():
+				return nil
+			default:
+				fn(rsp)
+			}
+		}
+	}
+	return err
+}
+
+func (s *BgpServer) ListPrefixActivity(ctx context.Context, r *api.ListPrefixActivityRequest, fn func(*api.ListPrefixActivityResponse)) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	window := defaultActivityWindow
+	if r.Window != 0 {
+		window = time.Duration(r.Window) * time.Second
+	}
+	if window > activitySlots*activitySlotDuration {
+		return fmt.Errorf("window must be less than or equal to %d seconds", activitySlots*activitySlotDuration/time.Second)
+	}
+	limit := defaultActivityLimit
+	if r.Limit != 0 {
+		limit = int(r.Limit)
+	}
+	var family bgp.RouteFamily
+	if r.Family != nil {
+		family = bgp.AfiSafiToRouteFamily(uint16(r.Family.Afi), uint8(r.Family.Safi))
+	}
+	var l []*api.ListPrefixActivityResponse
+	err := s.mgmtOperation(func() error {
+		neighbor := ""
+		if r.Neighbor != "" {
+			peer, ok := s.neighborMap[r.Neighbor]
+			if !ok {
+				return fmt.Errorf("neighbor that has %v doesn't exist", r.Neighbor)
+			}
+			neighbor = peer.ID()
+		}
+		match := func(k activityKey) bool {
+			return (neighbor == "" || neighbor == k.neighbor) && (family == 0 || family == k.family)
+		}
+		for _, e := range s.activity.top(window, limit, match, time.Now()) {
+			l = append(l, &api.ListPrefixActivityResponse{
+				Activity: &api.PrefixActivity{
+					Family:           apiutil.ToApiFamily(bgp.RouteFamilyToAfiSafi(e.family)),
+					Prefix:           e.prefix,
+					Neighbor:         e.neighbor,
+					Updates:          e.Updates,
+					Withdrawals:      e.Withdrawals,
+					Duplicates:       e.Duplicates,
+					AttributeChanges: e.AttributeChanges,
+					Flaps:            e.Flaps,
+				},
+			})
+		}
+		return nil
+	}, false)
+	if err == nil {
+		for _, rsp := range l {
+			select {
+			case <-ctx.Done():
+				return nil
+			default:
+				fn(rsp)
+			}
+		}
+	}
+	return err
+}
+
+// GetPathHistory returns the latest events of the paths of the prefix
+// received from the peers, kept with PathHistorySize.
+func (s *BgpServer) GetPathHistory(ctx context.Context, r *api.GetPathHistoryRequest) (*api.GetPathHistoryResponse, error) {
+	if r == nil || r.Family == nil || r.Prefix == "" {
+		return nil, fmt.Errorf("nil request")
+	}
+	family := bgp.AfiSafiToRouteFamily(uint16(r.Family.Afi), uint8(r.Family.Safi))
+	prefix := r.Prefix
+	switch family {
+	case bgp.RF_IPv4_UC, bgp.RF_IPv6_UC, bgp.RF_IPv4_MC, bgp.RF_IPv6_MC:
+		_, n, err := net.ParseCIDR(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("invalid prefix: %s", prefix)
+		}
+		prefix = n.String()
+	}
+	rsp := &api.GetPathHistoryResponse{}
+	err := s.mgmtOperation(func() error {
+		if s.pathHistory == nil {
+			return fmt.Errorf("path history isn't enabled")
+		}
+		neighbor := ""
+		if r.Neighbor != "" {
+			if peer, ok := s.neighborMap[r.Neighbor]; ok {
+				neighbor = peer.ID()
+			} else if addr := net.ParseIP(r.Neighbor); addr != nil {
+				// the neighbor may have been deleted
+				neighbor = addr.String()
+			} else {
+				return fmt.Errorf("neighbor that has %v doesn't exist", r.Neighbor)
+			}
+		}
+		for _, e := range s.pathHistory.events(family, prefix) {
+			if neighbor != "" && neighbor != e.neighbor {
+				continue
+			}
+			rsp.Events = append(rsp.Events, &api.PathEvent{
+				Type:      api.PathEvent_Type(e.typ),
+				Timestamp: timestamppb.New(e.timestamp),
+				Neighbor:  e.neighbor,
+				Path:      toPathApi(e.path, nil, false, false, false),
+			})
+		}
+		return nil
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func (s *BgpServer) AddVrfLeak(ctx context.Context, r *api.AddVrfLeakRequest) error {
+	if r == nil || r.Leak == nil {
+		return fmt.Errorf("nil request")
+	}
+	def := oc.DEFAULT_POLICY_TYPE_ACCEPT_ROUTE
+	if r.Leak.DefaultAction == api.RouteAction_REJECT {
+		def = oc.DEFAULT_POLICY_TYPE_REJECT_ROUTE
+	}
+	return s.mgmtOperation(func() error {
+		return s.leaks.addLeak(&oc.VrfLeakConfig{
+			Name:           r.Leak.Name,
+			SourceVrf:      r.Leak.SourceVrf,
+			DestinationVrf: r.Leak.DestinationVrf,
+			PolicyList:     r.Leak.Policies,
+			DefaultPolicy:  def,
+		})
+	}, true)
+}
+
+func (s *BgpServer) DeleteVrfLeak(ctx context.Context, r *api.DeleteVrfLeakRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		return s.leaks.deleteLeak(r.Name)
+	}, true)
+}
+
+func (s *BgpServer) ListVrfLeak(ctx context.Context, r *api.ListVrfLeakRequest, fn func(*api.ListVrfLeakResponse)) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	var l []*api.ListVrfLeakResponse
+	err := s.mgmtOperation(func() error {
+		for name, leak := range s.leaks.leaks {
+			if r.Name != "" && r.Name != name {
+				continue
+			}
+			def := api.RouteAction_ACCEPT
+			if leak.c.DefaultPolicy == oc.DEFAULT_POLICY_TYPE_REJECT_ROUTE {
+				def = api.RouteAction_REJECT
+			}
+			l = append(l, &api.ListVrfLeakResponse{
+				Leak: &api.VrfLeak{
+					Name:           leak.c.Name,
+					SourceVrf:      leak.c.SourceVrf,
+					DestinationVrf: leak.c.DestinationVrf,
+					Policies:       leak.c.PolicyList,
+					DefaultAction:  def,
+				},
+				Leaked: uint32(len(leak.paths)),
+			})
+		}
+		return nil
+	}, false)
+	if err == nil {
+		for _, rsp := range l {
+			select {
+			case <-ctx.Done():
+				return nil
+			default:
+				fn(rsp)
+			}
+		}
+	}
+	return err
+}
+
+func (s *BgpServer) AddEvpnVpws(ctx context.Context, r *api.AddEvpnVpwsRequest) error {
+	if r == nil || r.Vpws == nil {
+		return fmt.Errorf("nil request")
+	}
+	rd, err := apiutil.UnmarshalRD(r.Vpws.Rd)
+	if err != nil {
+		return err
+	}
+	rts, err := apiutil.UnmarshalRTs(r.Vpws.RouteTargets)
+	if err != nil {
+		return err
+	}
+	esi := bgp.EthernetSegmentIdentifier{Type: bgp.ESI_ARBITRARY, Value: make([]byte, 9)}
+	if r.Vpws.Esi != nil {
+		a := &api.EthernetSegmentIdentifier{}
+		if err := r.Vpws.Esi.UnmarshalTo(a); err != nil {
+			return fmt.Errorf("invalid esi: %v", err)
+		}
+		if len(a.Value) != 9 {
+			return fmt.Errorf("invalid esi value length: %d", len(a.Value))
+		}
+		esi = bgp.EthernetSegmentIdentifier{Type: bgp.ESIType(a.Type), Value: a.Value}
+	}
+	if r.Vpws.Mtu > math.MaxUint16 {
+		return fmt.Errorf("invalid mtu: %d", r.Vpws.Mtu)
+	}
+	return s.mgmtOperation(func() error {
+		return s.vpws.add(&evpnVpws{
+			name:        r.Vpws.Name,
+			rd:          rd,
+			rts:         rts,
+			esi:         esi,
+			localID:     r.Vpws.LocalServiceId,
+			remoteID:    r.Vpws.RemoteServiceId,
+			label:       r.Vpws.Label,
+			controlWord: r.Vpws.ControlWord,
+			flowLabel:   r.Vpws.FlowLabel,
+			mtu:         uint16(r.Vpws.Mtu),
+		})
+	}, true)
+}
+
+func (s *BgpServer) AddRtbh(ctx context.Context, r *api.AddRtbhRequest) error {
+	if r == nil || r.Rtbh == nil {
+		return fmt.Errorf("nil request")
+	}
+	prefix, err := parseRtbhPrefix(r.Rtbh.Prefix)
+	if err != nil {
+		return fmt.Errorf("invalid prefix: %s", r.Rtbh.Prefix)
+	}
+	var nexthop netip.Addr
+	if r.Rtbh.Nexthop != "" {
+		if nexthop, err = netip.ParseAddr(r.Rtbh.Nexthop); err != nil {
+			return fmt.Errorf("invalid nexthop: %s", r.Rtbh.Nexthop)
+		}
+	}
+	requester := r.Rtbh.Requester
+	if requester == "" {
+		requester = grpcClientIdentity(ctx)
+	}
+	return s.mgmtOperation(func() error {
+		return s.rtbh.add(&rtbh{
+			prefix:      prefix,
+			communities: r.Rtbh.Communities,
+			nexthop:     nexthop.Unmap(),
+			requester:   requester,
+			reason:      r.Rtbh.Reason,
+			created:     time.Now(),
+		}, time.Duration(r.Rtbh.Lifetime)*time.Second)
+	}, true)
+}
+
+func (s *BgpServer) DeleteRtbh(ctx context.Context, r *api.DeleteRtbhRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		return s.rtbh.delete(r.Prefix)
+	}, true)
+}
+
+func (s *BgpServer) ListRtbh(ctx context.Context, r *api.ListRtbhRequest, fn func(*api.Rtbh)) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	var prefix netip.Prefix
+	if r.Prefix != "" {
+		var err error
+		if prefix, err = parseRtbhPrefix(r.Prefix); err != nil {
+			return fmt.Errorf("invalid prefix: %s", r.Prefix)
+		}
+	}
+	var l []*api.Rtbh
+	err := s.mgmtOperation(func() error {
+		now := time.Now()
+		for p, e := range s.rtbh.routes {
+			if prefix.IsValid() && p != prefix {
+				continue
+			}
+			a := &api.Rtbh{
+				Prefix:      p.String(),
+				Communities: e.communities,
+				Nexthop:     e.nexthop.String(),
+				Requester:   e.requester,
+				Reason:      e.reason,
+				Created:     timestamppb.New(e.created),
+			}
+			if !e.expire.IsZero() {
+				a.Lifetime = uint32(e.expire.Sub(e.created) / time.Second)
+				if e.expire.After(now) {
+					a.RemainingLifetime = uint32(e.expire.Sub(now).Round(time.Second) / time.Second)
+				}
+			}
+			l = append(l, a)
+		}
+		return nil
+	}, false)
+	if err == nil {
+		sort.Slice(l, func(i, j int) bool {
+			return l[i].Prefix < l[j].Prefix
+		})
+		for _, a := range l {
+			select {
+			case <-ctx.Done():
+				return nil
+			default:
+				fn(a)
+			}
+		}
+	}
+	return err
+}
+
+func (s *BgpServer) DeleteEvpnVpws(ctx context.Context, r *api.DeleteEvpnVpwsRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		return s.vpws.delete(r.Name)
+	}, true)
+}
+
+func (s *BgpServer) ListEvpnVpws(ctx context.Context, r *api.ListEvpnVpwsRequest, fn func(*api.ListEvpnVpwsResponse)) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	toApi := func(v *evpnVpws, remote *evpnVpwsRemote) (*api.ListEvpnVpwsResponse, error) {
+		rd, err := apiutil.MarshalRD(v.rd)
+		if err != nil {
+			return nil, err
+		}
+		rts, err := apiutil.MarshalRTs(v.rts)
+		if err != nil {
+			return nil, err
+		}
+		e, _ := apiutil.NewEthernetSegmentIdentifierFromNative(&v.esi)
+		esi, err := apb.New(e)
+		if err != nil {
+			return nil, err
+		}
+		rsp := &api.ListEvpnVpwsResponse{
+			Vpws: &api.EvpnVpws{
+				Name:            v.name,
+				Rd:              rd,
+				RouteTargets:    rts,
+				Esi:             esi,
+				LocalServiceId:  v.localID,
+				RemoteServiceId: v.remoteID,
+				Label:           v.label,
+				ControlWord:     v.controlWord,
+				FlowLabel:       v.flowLabel,
+				Mtu:             uint32(v.mtu),
+			},
+			Up: v.up(remote),
+		}
+		if remote != nil {
+			rsp.Remote = &api.EvpnVpwsRemote{
+				Nexthop:     remote.path.GetNexthop().String(),
+				Label:       remote.label,
+				ControlWord: remote.attrs.HasControlWord,
+				FlowLabel:   remote.attrs.HasFlowLabel,
+				Mtu:         uint32(remote.attrs.Mtu),
+			}
+		}
+		return rsp, nil
+	}
+	var l []*api.ListEvpnVpwsResponse
+	err := s.mgmtOperation(func() error {
+		paths := s.globalRib.GetBestPathList(table.GLOBAL_RIB_NAME, 0, []bgp.RouteFamily{bgp.RF_EVPN})
+		for name, v := range s.vpws.instances {
+			if r.Name != "" && r.Name != name {
+				continue
+			}
+			rsp, err := toApi(v, v.remote(paths))
+			if err != nil {
+				return err
+			}
+			l = append(l, rsp)
+		}
+		return nil
+	}, false)
+	if err == nil {
+		for _, rsp := range l {
+			select {
+			case <-ctx.Done():
+				return nil
+			default:
+				fn(rsp)
+			}
+		}
+	}
+	return err
+}
+
+func newOrrVantagePointFromAPIStruct(a *api.OrrVantagePoint) *oc.OrrVantagePointConfig {
+	c := &oc.OrrVantagePointConfig{
+		Name:        a.Name,
+		IgpCostList: make([]oc.IgpCost, 0, len(a.IgpCosts)),
+	}
+	for _, cost := range a.IgpCosts {
+		c.IgpCostList = append(c.IgpCostList, oc.IgpCost{
+			Prefix: cost.Prefix,
+			Cost:   cost.Cost,
+		})
+	}
+	return c
+}
+
+func (s *BgpServer) AddOrrVantagePoint(ctx context.Context, r *api.AddOrrVantagePointRequest) error {
+	if r == nil || r.VantagePoint == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		return s.orr.addVantagePoint(newOrrVantagePointFromAPIStruct(r.VantagePoint))
+	}, true)
+}
+
+func (s *BgpServer) DeleteOrrVantagePoint(ctx context.Context, r *api.DeleteOrrVantagePointRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		return s.orr.deleteVantagePoint(r.Name)
+	}, true)
+}
+
+func (s *BgpServer) ListOrrVantagePoint(ctx context.Context, r *api.ListOrrVantagePointRequest, fn func(*api.ListOrrVantagePointResponse)) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	var l []*api.ListOrrVantagePointResponse
+	err := s.mgmtOperation(func() error {
+		for name, vp := range s.orr.vantagePoints {
+			if r.Name != "" && r.Name != name {
+				continue
+			}
+			c := vp.config()
+			costs := make([]*api.OrrIgpCost, 0, len(c.IgpCostList))
+			for _, cost := range c.IgpCostList {
+				costs = append(costs, &api.OrrIgpCost{
+					Prefix: cost.Prefix,
+					Cost:   cost.Cost,
+				})
+			}
+			peers := make([]string, 0)
+			for _, peer := range s.orr.peers(name) {
+				peers = append(peers, peer.ID())
+			}
+			sort.Strings(peers)
+			l = append(l, &api.ListOrrVantagePointResponse{
+				VantagePoint: &api.OrrVantagePoint{
+					Name:     name,
+					IgpCosts: costs,
+				},
+				Peers: peers,
+			})
+		}
+		return nil
+	}, false)
+	if err == nil {
+		for _, rsp := range l {
+			select {
+			case <-ctx.Done():
+				return nil
+			default:
+				fn(rsp)
+			}
+		}
+	}
+	return err
+}
+
+func (s *BgpServer) StopBgp(ctx context.Context, r *api.StopBgpRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	s.mgmtOperation(func() error {
+		names := make([]string, 0, len(s.neighborMap))
+		for k := range s.neighborMap {
 			names = append(names, k)
 		}
 
@@ -2016,6 +3841,23 @@ func (s *BgpServer) StopBgp(ctx context.Context, r *api.StopBgpRequest) error {
 		for _, l := range s.listeners {
 			l.Close()
 		}
+		s.listeners = nil
+		s.vrfListeners = nil
+		for _, l := range s.quicListeners {
+			l.Close()
+		}
+		s.quicListeners = nil
+		if s.nlclient != nil {
+			s.nlclient.stop()
+			s.nlclient = nil
+		}
+		if s.gshut != nil {
+			s.gshut.timer.Stop()
+			s.gshut = nil
+		}
+		s.stopPathLifetimes()
+		s.rtbh.clear()
+		s.stopConfigTransaction()
 		s.bgpConfig.Global = oc.Global{}
 		return nil
 	}, false)
@@ -2093,6 +3935,124 @@ func (s *BgpServer) SetPolicies(ctx context.Context, r *api.SetPoliciesRequest)
 	}, false)
 }
 
+// ReplacePolicies replaces all the defined sets, the policies and the
+// assignments of the global table and the route server clients together.
+// Unlike SetPolicies, the request is rejected without any change if the
+// assignments or the conditional advertisements of the peers refer to the
+// policies or the prefix sets which don't exist in it. Like SetPolicies,
+// the routes aren't evaluated again until the soft reset.
+func (s *BgpServer) ReplacePolicies(ctx context.Context, r *api.ReplacePoliciesRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	rp, err := newRoutingPolicyFromApiStruct(&api.SetPoliciesRequest{
+		DefinedSets: r.DefinedSets,
+		Policies:    r.Policies,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.mgmtOperation(func() error {
+		rsClients := make(map[string]bool, len(s.neighborMap))
+		for addr, peer := range s.neighborMap {
+			rsClients[addr] = peer.isRouteServerClient()
+		}
+		ap, err := newApplyPolicies(r.Assignments, rsClients)
+		if err != nil {
+			return err
+		}
+		for _, peer := range s.neighborMap {
+			for _, a := range peer.condAdvs {
+				if err := checkConditionalAdvertisementSets(rp, peer.ID(), a.c); err != nil {
+					return err
+				}
+			}
+		}
+		return s.policy.Replace(rp, ap, r.DryRun)
+	}, false)
+}
+
+// newApplyPolicies returns the policy assignments of the global table and
+// the route server clients from the API ones. peers maps the address of
+// every peer to whether it's a route server client. The tables not
+// assigned accept the paths without policies.
+func newApplyPolicies(assignments []*api.PolicyAssignment, peers map[string]bool) (map[string]oc.ApplyPolicy, error) {
+	ap := make(map[string]oc.ApplyPolicy, len(peers)+1)
+	ap[table.GLOBAL_RIB_NAME] = oc.ApplyPolicy{}
+	for addr, rsClient := range peers {
+		if rsClient {
+			ap[addr] = oc.ApplyPolicy{}
+		}
+	}
+	seen := make(map[string]bool)
+	for _, a := range assignments {
+		id := a.Name
+		if id == "" {
+			return nil, fmt.Errorf("empty table name")
+		}
+		if id != table.GLOBAL_RIB_NAME {
+			rsClient, ok := peers[id]
+			if !ok {
+				return nil, fmt.Errorf("not found peer %s", id)
+			}
+			if !rsClient {
+				return nil, fmt.Errorf("non-rs-client peer %s doesn't have per peer policy", id)
+			}
+		}
+		var dir table.PolicyDirection
+		switch a.Direction {
+		case api.PolicyDirection_IMPORT:
+			dir = table.POLICY_DIRECTION_IMPORT
+		case api.PolicyDirection_EXPORT:
+			dir = table.POLICY_DIRECTION_EXPORT
+		default:
+			return nil, fmt.Errorf("invalid policy type")
+		}
+		key := id + " " + dir.String()
+		if seen[key] {
+			return nil, fmt.Errorf("duplicated %s policy assignment of %s", dir, id)
+		}
+		seen[key] = true
+		names := make([]string, 0, len(a.Policies))
+		for _, p := range a.Policies {
+			names = append(names, p.Name)
+		}
+		def := oc.DEFAULT_POLICY_TYPE_ACCEPT_ROUTE
+		if a.DefaultAction == api.RouteAction_REJECT {
+			def = oc.DEFAULT_POLICY_TYPE_REJECT_ROUTE
+		}
+		c := ap[id]
+		if dir == table.POLICY_DIRECTION_IMPORT {
+			c.Config.ImportPolicyList = names
+			c.Config.DefaultImportPolicy = def
+		} else {
+			c.Config.ExportPolicyList = names
+			c.Config.DefaultExportPolicy = def
+		}
+		ap[id] = c
+	}
+	return ap, nil
+}
+
+// checkConditionalAdvertisementSets returns an error if the prefix sets
+// used by the conditional advertisement of the peer aren't in rp.
+func checkConditionalAdvertisementSets(rp *oc.RoutingPolicy, id string, c oc.ConditionalAdvertisementConfig) error {
+	for _, name := range []string{c.AdvertisePrefixSet, c.ConditionPrefixSet} {
+		found := false
+		for _, ps := range rp.DefinedSets.PrefixSets {
+			if ps.PrefixSetName == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("not found prefix set %s used by the conditional advertisement of %s", name, id)
+		}
+	}
+	return nil
+}
+
 // EVPN MAC MOBILITY HANDLING
 //
 // We don't have multihoming function now, so ignore
@@ -2190,6 +4150,9 @@ func (s *BgpServer) fixupApiPath(vrfId string, pathList []*table.Path) error {
 		case *bgp.EVPNNLRI:
 			switch r := nlri.RouteTypeData.(type) {
 			case *bgp.EVPNMacIPAdvertisementRoute:
+				if s.evpnMacs.isFrozen(path) {
+					return fmt.Errorf("MAC address %s is frozen as a duplicate", r.MacAddress)
+				}
 				// MAC Mobility Extended Community
 				var paths []*table.Path
 				for _, ec := range path.GetRouteTargets() {
@@ -2272,6 +4235,7 @@ func (s *BgpServer) AddPath(ctx context.Context, r *api.AddPathRequest) (*api.Ad
 		}
 
 		s.uuidMap[pathTokey(path)] = id
+		s.setPathLifetime(pathTokey(path), path, time.Duration(r.Lifetime)*time.Second)
 		uuidBytes, _ = id.MarshalBinary()
 		return nil
 	}, true)
@@ -2305,6 +4269,7 @@ func (s *BgpServer) DeletePath(ctx context.Context, r *api.DeletePathRequest) er
 						for _, path := range s.globalRib.GetPathList(table.GLOBAL_RIB_NAME, 0, s.globalRib.GetRFlist()) {
 							if path.IsLocal() && k == pathTokey(path) {
 								delete(s.uuidMap, k)
+								s.setPathLifetime(k, nil, 0)
 								return path
 							}
 						}
@@ -2326,6 +4291,7 @@ func (s *BgpServer) DeletePath(ctx context.Context, r *api.DeletePathRequest) er
 			for _, path := range s.globalRib.GetPathList(table.GLOBAL_RIB_NAME, 0, families) {
 				if path.IsLocal() {
 					deletePathList = append(deletePathList, path.Clone(true))
+					s.setPathLifetime(pathTokey(path), nil, 0)
 				}
 			}
 			s.uuidMap = make(map[string]uuid.UUID)
@@ -2336,6 +4302,7 @@ func (s *BgpServer) DeletePath(ctx context.Context, r *api.DeletePathRequest) er
 			deletePathList = pathList
 			for _, p := range deletePathList {
 				delete(s.uuidMap, pathTokey(p))
+				s.setPathLifetime(pathTokey(p), nil, 0)
 			}
 		}
 		s.propagateUpdate(nil, deletePathList)
@@ -2354,87 +4321,321 @@ func (s *BgpServer) updatePath(vrfId string, pathList []*table.Path) error {
 	return err
 }
 
-func (s *BgpServer) StartBgp(ctx context.Context, r *api.StartBgpRequest) error {
-	if r == nil || r.Global == nil {
+func (s *BgpServer) StartBgp(ctx context.Context, r *api.StartBgpRequest) error {
+	if r == nil || r.Global == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		g := r.Global
+		if net.ParseIP(g.RouterId) == nil {
+			return fmt.Errorf("invalid router-id format: %s", g.RouterId)
+		}
+
+		c := newGlobalFromAPIStruct(g)
+		if err := oc.SetDefaultGlobalConfigValues(c); err != nil {
+			return err
+		}
+
+		if c.Config.Port > 0 || c.Config.QuicPort > 0 || len(c.Listeners) > 0 {
+			acceptCh := make(chan acceptedConn, 32)
+			for _, addr := range c.Config.LocalAddressList {
+				if c.Config.Port > 0 {
+					l, err := newTCPListener(s.logger, addr, uint32(c.Config.Port), g.BindToDevice, "", false, acceptCh)
+					if err != nil {
+						return err
+					}
+					s.listeners = append(s.listeners, l)
+				}
+				if c.Config.QuicPort > 0 {
+					l, err := newQUICListener(s.logger, addr, uint32(c.Config.QuicPort), c.Config.QuicCertFile, c.Config.QuicKeyFile, acceptCh)
+					if err != nil {
+						return err
+					}
+					s.quicListeners = append(s.quicListeners, l)
+				}
+			}
+			for _, lc := range c.Listeners {
+				l, err := newTCPListener(s.logger, lc.Config.Address, uint32(lc.Config.Port), lc.Config.BindToDevice, lc.Config.NetworkNamespace, lc.Config.AuthRequired, acceptCh)
+				if err != nil {
+					return err
+				}
+				s.listeners = append(s.listeners, l)
+			}
+			s.acceptCh = acceptCh
+		}
+
+		rfs, _ := oc.AfiSafis(c.AfiSafis).ToRfList()
+		s.globalRib = table.NewTableManager(s.logLevels.Logger(log.ModuleTable), rfs)
+		s.rsRib = table.NewTableManager(s.logLevels.Logger(log.ModuleTable), rfs)
+
+		if err := s.policy.Initialize(); err != nil {
+			return err
+		}
+		s.bgpConfig.Global = *c
+		s.evpnMacs.config = c.EvpnMacMobility.Config
+		s.colors.enabled = c.ColorResolution.Config.Enabled
+		// update route selection options
+		table.SelectionOptions = c.RouteSelectionOptions.Config
+		table.UseMultiplePaths = c.UseMultiplePaths.Config
+		table.MultiPathOptions = make(map[bgp.RouteFamily]table.MultiPathOption)
+		for _, a := range c.AfiSafis {
+			rf, err := bgp.GetRouteFamily(string(a.Config.AfiSafiName))
+			if err != nil {
+				continue
+			}
+			if a.UseMultiplePaths.Config.Enabled {
+				table.MultiPathOptions[rf] = table.NewMultiPathOption(&a.UseMultiplePaths)
+			} else if c.UseMultiplePaths.Config.Enabled {
+				table.MultiPathOptions[rf] = table.NewMultiPathOption(&c.UseMultiplePaths)
+			}
+		}
+		return nil
+	}, false)
+}
+
+// TODO: delete this function
+func (s *BgpServer) listVrf() (l []*table.Vrf) {
+	s.mgmtOperation(func() error {
+		l = make([]*table.Vrf, 0, len(s.globalRib.Vrfs))
+		for _, vrf := range s.globalRib.Vrfs {
+			l = append(l, vrf.Clone())
+		}
+		return nil
+	}, true)
+	return l
+}
+
+func (s *BgpServer) ListVrf(ctx context.Context, r *api.ListVrfRequest, fn func(*api.Vrf)) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	toApi := func(v *table.Vrf) *api.Vrf {
+		d, _ := apiutil.MarshalRD(v.Rd)
+		irt, _ := apiutil.MarshalRTs(v.ImportRt)
+		ert, _ := apiutil.MarshalRTs(v.ExportRt)
+		vrf := &api.Vrf{
+			Name:     v.Name,
+			Rd:       d,
+			Id:       v.Id,
+			ImportRt: irt,
+			ExportRt: ert,
+		}
+		if v.SRv6SID != nil {
+			vrf.Srv6Sid = v.SRv6SID.SID.String()
+		}
+		if v.RouterId != nil {
+			vrf.RouterId = v.RouterId.String()
+		}
+		return vrf
+	}
+	var l []*api.Vrf
+	s.mgmtOperation(func() error {
+		l = make([]*api.Vrf, 0, len(s.globalRib.Vrfs))
+		for name, vrf := range s.globalRib.Vrfs {
+			if r.Name != "" && r.Name != name {
+				continue
+			}
+			l = append(l, toApi(vrf.Clone()))
+		}
+		return nil
+	}, true)
+	for _, v := range l {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+			fn(v)
+		}
+	}
+	return nil
+}
+
+// ListEvpnDesignatedForwarder lists the local Ethernet Segments and their
+// Designated Forwarders elected from the Ethernet Segment routes in the
+// global RIB.
+func (s *BgpServer) ListEvpnDesignatedForwarder(ctx context.Context, r *api.ListEvpnDesignatedForwarderRequest, fn func(*api.EvpnEthernetSegment)) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	var key string
+	if r.Esi != nil {
+		esi := &api.EthernetSegmentIdentifier{}
+		if err := r.Esi.UnmarshalTo(esi); err != nil {
+			return fmt.Errorf("invalid esi: %v", err)
+		}
+		key = evpnESIKey(bgp.EthernetSegmentIdentifier{
+			Type:  bgp.ESIType(esi.Type),
+			Value: esi.Value,
+		})
+	}
+	toApi := func(es *evpnEthernetSegment) (*api.EvpnEthernetSegment, error) {
+		esi, _ := apiutil.NewEthernetSegmentIdentifierFromNative(&es.esi)
+		a, err := apb.New(esi)
+		if err != nil {
+			return nil, err
+		}
+		candidates := make([]string, 0, len(es.candidates))
+		for _, c := range es.candidates {
+			candidates = append(candidates, c.address.String())
+		}
+		tags := r.EthernetTags
+		if len(tags) == 0 {
+			tags = es.ethernetTags
+		}
+		if len(tags) == 0 {
+			// port-based service interface
+			tags = []uint32{0}
+		}
+		dfs := make([]*api.EvpnDesignatedForwarder, 0, len(tags))
+		for _, tag := range tags {
+			df := es.electDF(tag)
+			dfs = append(dfs, &api.EvpnDesignatedForwarder{
+				EthernetTag: tag,
+				Address:     df.address.String(),
+				Local:       df.local,
+			})
+		}
+		return &api.EvpnEthernetSegment{
+			Esi:                  a,
+			DfAlgorithm:          api.EvpnEthernetSegment_DFAlgorithm(es.algorithm),
+			Candidates:           candidates,
+			DesignatedForwarders: dfs,
+		}, nil
+	}
+	var l []*api.EvpnEthernetSegment
+	err := s.mgmtOperation(func() error {
+		paths := s.globalRib.GetPathList(table.GLOBAL_RIB_NAME, 0, []bgp.RouteFamily{bgp.RF_EVPN})
+		for _, es := range newEvpnEthernetSegments(paths) {
+			if key != "" && key != evpnESIKey(es.esi) {
+				continue
+			}
+			v, err := toApi(es)
+			if err != nil {
+				return err
+			}
+			l = append(l, v)
+		}
+		return nil
+	}, true)
+	if err != nil {
+		return err
+	}
+	for _, v := range l {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+			fn(v)
+		}
+	}
+	return nil
+}
+
+// ListEvpnFrozenMac lists the MAC addresses frozen by the duplicate MAC
+// detection.
+func (s *BgpServer) ListEvpnFrozenMac(ctx context.Context, r *api.ListEvpnFrozenMacRequest, fn func(*api.EvpnFrozenMac)) error {
+	if r == nil {
 		return fmt.Errorf("nil request")
 	}
-	return s.mgmtOperation(func() error {
-		g := r.Global
-		if net.ParseIP(g.RouterId) == nil {
-			return fmt.Errorf("invalid router-id format: %s", g.RouterId)
-		}
-
-		c := newGlobalFromAPIStruct(g)
-		if err := oc.SetDefaultGlobalConfigValues(c); err != nil {
-			return err
+	var mac net.HardwareAddr
+	if r.MacAddress != "" {
+		var err error
+		if mac, err = net.ParseMAC(r.MacAddress); err != nil {
+			return fmt.Errorf("invalid mac address: %s", r.MacAddress)
 		}
-
-		if c.Config.Port > 0 {
-			acceptCh := make(chan *net.TCPConn, 32)
-			for _, addr := range c.Config.LocalAddressList {
-				l, err := newTCPListener(s.logger, addr, uint32(c.Config.Port), g.BindToDevice, acceptCh)
-				if err != nil {
-					return err
-				}
-				s.listeners = append(s.listeners, l)
+	}
+	var l []*api.EvpnFrozenMac
+	s.mgmtOperation(func() error {
+		for _, e := range s.evpnMacs.frozenMacs(mac) {
+			seq := uint32(0)
+			if e.seq > 0 {
+				seq = uint32(e.seq)
 			}
-			s.acceptCh = acceptCh
-		}
-
-		rfs, _ := oc.AfiSafis(c.AfiSafis).ToRfList()
-		s.globalRib = table.NewTableManager(s.logger, rfs)
-		s.rsRib = table.NewTableManager(s.logger, rfs)
-
-		if err := s.policy.Initialize(); err != nil {
-			return err
+			l = append(l, &api.EvpnFrozenMac{
+				MacAddress:   e.mac.String(),
+				EthernetTag:  e.etag,
+				RouteTargets: e.rts,
+				Sequence:     seq,
+				FrozenTime:   timestamppb.New(e.frozen),
+			})
 		}
-		s.bgpConfig.Global = *c
-		// update route selection options
-		table.SelectionOptions = c.RouteSelectionOptions.Config
-		table.UseMultiplePaths = c.UseMultiplePaths.Config
 		return nil
 	}, false)
+	for _, v := range l {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+			fn(v)
+		}
+	}
+	return nil
 }
 
-// TODO: delete this function
-func (s *BgpServer) listVrf() (l []*table.Vrf) {
-	s.mgmtOperation(func() error {
-		l = make([]*table.Vrf, 0, len(s.globalRib.Vrfs))
-		for _, vrf := range s.globalRib.Vrfs {
-			l = append(l, vrf.Clone())
+// ClearEvpnFrozenMac unfreezes the MAC addresses frozen by the duplicate MAC
+// detection, whose routes received from the peers are processed again.
+func (s *BgpServer) ClearEvpnFrozenMac(ctx context.Context, r *api.ClearEvpnFrozenMacRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	var mac net.HardwareAddr
+	if r.MacAddress != "" {
+		var err error
+		if mac, err = net.ParseMAC(r.MacAddress); err != nil {
+			return fmt.Errorf("invalid mac address: %s", r.MacAddress)
+		}
+	}
+	return s.mgmtOperation(func() error {
+		l := s.evpnMacs.frozenMacs(mac)
+		if len(l) == 0 && mac != nil {
+			return fmt.Errorf("mac address %s isn't frozen", mac)
+		}
+		for _, e := range l {
+			s.evpnMacs.unfreeze(e)
 		}
 		return nil
 	}, true)
-	return l
 }
 
-func (s *BgpServer) ListVrf(ctx context.Context, r *api.ListVrfRequest, fn func(*api.Vrf)) error {
+// ListColorBinding lists the paths in the Loc-RIB with the Color Extended
+// Community and the SR policies which they are resolved over.
+func (s *BgpServer) ListColorBinding(ctx context.Context, r *api.ListColorBindingRequest, fn func(*api.ColorBinding)) error {
 	if r == nil {
 		return fmt.Errorf("nil request")
 	}
-	toApi := func(v *table.Vrf) *api.Vrf {
-		d, _ := apiutil.MarshalRD(v.Rd)
-		irt, _ := apiutil.MarshalRTs(v.ImportRt)
-		ert, _ := apiutil.MarshalRTs(v.ExportRt)
-		return &api.Vrf{
-			Name:     v.Name,
-			Rd:       d,
-			Id:       v.Id,
-			ImportRt: irt,
-			ExportRt: ert,
+	families := colorResolutionFamilies()
+	if r.Family != nil {
+		family := bgp.AfiSafiToRouteFamily(uint16(r.Family.Afi), uint8(r.Family.Safi))
+		if !isColorResolutionFamily(family) {
+			return fmt.Errorf("unsupported family: %s", family)
 		}
+		families = []bgp.RouteFamily{family}
 	}
-	var l []*api.Vrf
-	s.mgmtOperation(func() error {
-		l = make([]*api.Vrf, 0, len(s.globalRib.Vrfs))
-		for name, vrf := range s.globalRib.Vrfs {
-			if r.Name != "" && r.Name != name {
-				continue
+	var l []*api.ColorBinding
+	err := s.mgmtOperation(func() error {
+		if !s.colors.enabled {
+			return fmt.Errorf("color resolution is not enabled")
+		}
+		for _, b := range s.colors.bindings(families, r.Color) {
+			nlri := b.path.GetNlri()
+			v := &api.ColorBinding{
+				Family:         &api.Family{Afi: api.Family_Afi(nlri.AFI()), Safi: api.Family_Safi(nlri.SAFI())},
+				Prefix:         nlri.String(),
+				Nexthop:        b.path.GetNexthop().String(),
+				Color:          b.color,
+				Resolved:       len(b.distinguishers) > 0,
+				Distinguishers: b.distinguishers,
 			}
-			l = append(l, toApi(vrf.Clone()))
+			if a := b.path.GetSource().Address; a != nil {
+				v.NeighborAddress = a.String()
+			}
+			l = append(l, v)
 		}
 		return nil
-	}, true)
+	}, false)
+	if err != nil {
+		return err
+	}
 	for _, v := range l {
 		select {
 		case <-ctx.Done():
@@ -2466,6 +4667,17 @@ func (s *BgpServer) AddVrf(ctx context.Context, r *api.AddVrfRequest) error {
 		if err != nil {
 			return err
 		}
+		var routerID net.IP
+		if r.Vrf.RouterId != "" {
+			if routerID = net.ParseIP(r.Vrf.RouterId).To4(); routerID == nil {
+				return fmt.Errorf("invalid router-id for vrf %s: %s", name, r.Vrf.RouterId)
+			}
+		}
+		for _, vrf := range s.globalRib.Vrfs {
+			if vrf.Name != name && vrf.Rd.String() == rd.String() {
+				return fmt.Errorf("rd %s is already used by vrf %s", rd, vrf.Name)
+			}
+		}
 
 		pi := &table.PeerInfo{
 			AS:      s.bgpConfig.Global.Config.As,
@@ -2478,14 +4690,38 @@ func (s *BgpServer) AddVrf(ctx context.Context, r *api.AddVrfRequest) error {
 			s.propagateUpdate(nil, pathList)
 		}
 		if vrf, ok := s.globalRib.Vrfs[name]; ok {
+			vrf.RouterId = routerID
 			if s.zclient != nil && s.zclient.mplsLabel.rangeSize > 0 {
 				s.zclient.assignAndSendVrfMplsLabel(vrf)
 			}
+			if s.srv6Locator != nil {
+				sid, err := s.srv6Locator.allocate()
+				if err != nil {
+					s.logger.Warn("failed to allocate srv6 sid",
+						log.Fields{
+							"Topic": "Vrf",
+							"Key":   name,
+							"Error": err})
+				} else {
+					vrf.SRv6SID = sid
+				}
+			}
 		}
 		return nil
 	}, true)
 }
 
+// peerGlobalConfig returns the global configuration for the neighbor, whose
+// router ID is the one of the VRF of the neighbor if configured.
+func (s *BgpServer) peerGlobalConfig(c *oc.Neighbor) *oc.Global {
+	if vrf, y := s.globalRib.Vrfs[c.Config.Vrf]; y && vrf.RouterId != nil {
+		g := s.bgpConfig.Global
+		g.Config.RouterId = vrf.RouterId.String()
+		return &g
+	}
+	return &s.bgpConfig.Global
+}
+
 func (s *BgpServer) DeleteVrf(ctx context.Context, r *api.DeleteVrfRequest) error {
 	if r == nil || r.Name == "" {
 		return fmt.Errorf("nil request")
@@ -2500,11 +4736,17 @@ func (s *BgpServer) DeleteVrf(ctx context.Context, r *api.DeleteVrfRequest) erro
 				return fmt.Errorf("failed to delete VRF %s: neighbor %s is in use", name, n.ID())
 			}
 		}
+		if leak := s.leaks.vrfInUse(name); leak != "" {
+			return fmt.Errorf("failed to delete VRF %s: vrf leak %s is in use", name, leak)
+		}
 
 		if vrf, ok := s.globalRib.Vrfs[name]; ok {
 			if vrf.MplsLabel > 0 {
 				s.zclient.releaseMplsLabel(vrf.MplsLabel)
 			}
+			if vrf.SRv6SID != nil && s.srv6Locator != nil {
+				s.srv6Locator.release(vrf.SRv6SID)
+			}
 		}
 		pathList, err := s.globalRib.DeleteVrf(name)
 		if err != nil {
@@ -2537,7 +4779,15 @@ func (s *BgpServer) softResetIn(addr string, family bgp.RouteFamily) error {
 		return err
 	}
 	for _, peer := range peers {
-		s.propagateUpdate(peer, peer.adjRibIn.PathList(familiesForSoftreset(peer, family), true))
+		families := familiesForSoftreset(peer, family)
+		s.propagateUpdate(peer, peer.adjRibIn.PathList(families, true))
+
+		peer.fsm.lock.RLock()
+		established := peer.fsm.state == bgp.BGP_FSM_ESTABLISHED
+		peer.fsm.lock.RUnlock()
+		if established {
+			s.sendPrefixOrf(peer, families)
+		}
 	}
 	return err
 }
@@ -2604,6 +4854,67 @@ func (s *BgpServer) softResetOut(addr string, family bgp.RouteFamily, deferral b
 	return nil
 }
 
+// reevaluateExports sends the changes of the paths exported to the peers
+// made by change. Only the paths for which match returns true are
+// evaluated. The add-path peers selecting the best paths and the route
+// server clients with the secondary routes get the soft reset instead.
+func (s *BgpServer) reevaluateExports(peers []*peer, match func(*table.Path) bool, change func()) {
+	type exported struct {
+		peer   *peer
+		paths  []*table.Path
+		before []*table.Path
+	}
+	exports := make([]*exported, 0, len(peers))
+	softResetOuts := make(map[*peer]struct{})
+	for _, peer := range peers {
+		peer.fsm.lock.RLock()
+		established := peer.fsm.state == bgp.BGP_FSM_ESTABLISHED
+		peer.fsm.lock.RUnlock()
+		if !established {
+			continue
+		}
+		if peer.isSecondaryRouteEnabled() {
+			softResetOuts[peer] = struct{}{}
+			continue
+		}
+		e := &exported{peer: peer}
+		for _, family := range peer.toGlobalFamilies(familiesForSoftreset(peer, bgp.RouteFamily(0))) {
+			if peer.isAddPathSendEnabled(family) && peer.isAddPathSendBestEnabled(family) {
+				softResetOuts[peer] = struct{}{}
+				continue
+			}
+			for _, path := range s.getPossibleBest(peer, family) {
+				if match(path) {
+					e.paths = append(e.paths, path)
+					e.before = append(e.before, s.filterpath(peer, path, nil))
+				}
+			}
+		}
+		exports = append(exports, e)
+	}
+
+	change()
+
+	for _, e := range exports {
+		pathList := make([]*table.Path, 0, len(e.paths))
+		for i, path := range e.paths {
+			before := e.before[i]
+			after := s.filterpath(e.peer, path, nil)
+			if after != nil && !after.Equal(before) {
+				pathList = append(pathList, after)
+			} else if after == nil && before != nil && !before.IsWithdraw {
+				pathList = append(pathList, before.Clone(true))
+			}
+		}
+		if len(pathList) > 0 {
+			sendfsmOutgoingMsg(e.peer, pathList, nil, false)
+		}
+	}
+	for peer := range softResetOuts {
+		s.softResetOut(peer.ID(), bgp.RouteFamily(0), false)
+	}
+}
+
 func (s *BgpServer) sResetIn(addr string, family bgp.RouteFamily) error {
 	s.logger.Info("Neighbor soft reset in",
 		log.Fields{
@@ -2734,7 +5045,7 @@ func (s *BgpServer) getAdjRib(addr string, family bgp.RouteFamily, in bool, enab
 				}
 			}
 		} else {
-			adjRib = table.NewAdjRib(s.logger, peer.configuredRFlist())
+			adjRib = table.NewAdjRib(s.logLevels.Logger(log.ModuleTable), peer.configuredRFlist())
 			pathList := []*table.Path{}
 			if enableFiltered {
 				for _, path := range s.getPossibleBest(peer, family) {
@@ -2770,7 +5081,70 @@ func (s *BgpServer) getAdjRib(addr string, family bgp.RouteFamily, in bool, enab
 	return
 }
 
+// listPathCursor is the position of a destination in the order of
+// ListPathRequest's sort_type. The page token is the cursor of the last
+// destination of the page, so a page resumes after it even if the
+// destinations before it are added or removed.
+type listPathCursor struct {
+	sortType api.ListPathRequest_SortType
+	// the timestamp of the best path in nanoseconds, only for AGE
+	timestamp int64
+	// the rank of the flowspec rule from 1, only for PRECEDENCE
+	precedence uint64
+	// the key of the destination in the table
+	key string
+}
+
+func (c *listPathCursor) less(o *listPathCursor) bool {
+	if c.sortType == api.ListPathRequest_AGE && c.timestamp != o.timestamp {
+		// youngest first
+		return c.timestamp > o.timestamp
+	}
+	if c.sortType == api.ListPathRequest_PRECEDENCE && c.precedence != o.precedence {
+		// the highest first
+		return c.precedence < o.precedence
+	}
+	return c.key < o.key
+}
+
+func (c *listPathCursor) token() string {
+	b := make([]byte, 9+len(c.key))
+	b[0] = byte(c.sortType)
+	if c.sortType == api.ListPathRequest_PRECEDENCE {
+		binary.BigEndian.PutUint64(b[1:9], c.precedence)
+	} else {
+		binary.BigEndian.PutUint64(b[1:9], uint64(c.timestamp))
+	}
+	copy(b[9:], c.key)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func parseListPathCursor(token string) (*listPathCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(b) < 9 {
+		return nil, fmt.Errorf("invalid page token")
+	}
+	c := &listPathCursor{
+		sortType: api.ListPathRequest_SortType(b[0]),
+		key:      string(b[9:]),
+	}
+	if c.sortType == api.ListPathRequest_PRECEDENCE {
+		c.precedence = binary.BigEndian.Uint64(b[1:9])
+	} else {
+		c.timestamp = int64(binary.BigEndian.Uint64(b[1:9]))
+	}
+	return c, nil
+}
+
 func (s *BgpServer) ListPath(ctx context.Context, r *api.ListPathRequest, fn func(*api.Destination)) error {
+	return s.listPath(ctx, r, func(d *api.Destination, _ string) {
+		fn(d)
+	})
+}
+
+// listPath calls fn with the next page token along with the last destination
+// of a page if more destinations remain, otherwise with an empty string.
+func (s *BgpServer) listPath(ctx context.Context, r *api.ListPathRequest, fn func(*api.Destination, string)) error {
 	if r == nil {
 		return fmt.Errorf("nil request")
 	}
@@ -2790,6 +5164,29 @@ func (s *BgpServer) ListPath(ctx context.Context, r *api.ListPathRequest, fn fun
 		return l
 	}
 
+	var pathFilter *table.PathFilter
+	if r.Filter != "" {
+		var err error
+		if pathFilter, err = table.ParsePathFilter(r.Filter); err != nil {
+			return fmt.Errorf("invalid filter: %w", err)
+		}
+	}
+
+	sortType := r.SortType
+	if sortType == api.ListPathRequest_NONE && (r.PageSize > 0 || r.PageToken != "") {
+		sortType = api.ListPathRequest_PREFIX
+	}
+	var after *listPathCursor
+	if r.PageToken != "" {
+		var err error
+		if after, err = parseListPathCursor(r.PageToken); err != nil {
+			return err
+		}
+		if after.sortType != sortType {
+			return fmt.Errorf("page token for sort type %s is used with %s", after.sortType, sortType)
+		}
+	}
+
 	in := false
 	family := bgp.RouteFamily(0)
 	if r.Family != nil {
@@ -2814,14 +5211,87 @@ func (s *BgpServer) ListPath(ctx context.Context, r *api.ListPathRequest, fn fun
 		return err
 	}
 
+	// the times when the paths added with the lifetime are withdrawn
+	var expirations map[string]time.Time
+	if r.TableType == api.TableType_GLOBAL {
+		s.mgmtOperation(func() error {
+			expirations = s.pathExpirations()
+			return nil
+		}, false)
+	}
+	now := time.Now()
+
+	// the ranks of the flowspec rules in the order of precedence
+	var precedence map[*table.Destination]uint64
+	if l := tbl.GetFlowSpecDestinations(); l != nil {
+		precedence = make(map[*table.Destination]uint64, len(l))
+		for i, dst := range l {
+			precedence[dst] = uint64(i + 1)
+		}
+	} else if sortType == api.ListPathRequest_PRECEDENCE {
+		return fmt.Errorf("sort type %s is supported only for flowspec families", sortType)
+	}
+	if after != nil && sortType == api.ListPathRequest_PRECEDENCE {
+		// resumes after the rule in the current order if it still exists
+		if dst, y := tbl.GetDestinations()[after.key]; y && precedence[dst] > 0 {
+			after.precedence = precedence[dst]
+		}
+	}
+
+	type entry struct {
+		dst    *table.Destination
+		cursor *listPathCursor
+	}
+	entries := make([]entry, 0, len(tbl.GetDestinations()))
+	for key, dst := range tbl.GetDestinations() {
+		c := &listPathCursor{sortType: sortType, key: key}
+		switch sortType {
+		case api.ListPathRequest_AGE:
+			if l := dst.GetAllKnownPathList(); len(l) > 0 {
+				c.timestamp = l[0].GetTimestamp().UnixNano()
+			}
+		case api.ListPathRequest_PRECEDENCE:
+			if c.precedence = precedence[dst]; c.precedence == 0 {
+				continue
+			}
+		}
+		if after != nil && !after.less(c) {
+			continue
+		}
+		entries = append(entries, entry{dst: dst, cursor: c})
+	}
+	if sortType != api.ListPathRequest_NONE {
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].cursor.less(entries[j].cursor)
+		})
+	}
+
 	err = func() error {
-		for _, dst := range tbl.GetDestinations() {
+		// the previous destination is held until the next one is found to
+		// know if it is the last one of the page and more remain.
+		var last *api.Destination
+		var lastCursor *listPathCursor
+		n := uint64(0)
+		for _, e := range entries {
+			dst := e.dst
 			d := api.Destination{
-				Prefix: dst.GetNlri().String(),
-				Paths:  make([]*api.Path, 0, len(dst.GetAllKnownPathList())),
+				Prefix:     dst.GetNlri().String(),
+				Paths:      make([]*api.Path, 0, len(dst.GetAllKnownPathList())),
+				Precedence: precedence[dst],
 			}
 			knownPathList := dst.GetAllKnownPathList()
+			multipath := make(map[*table.Path]struct{})
+			if r.TableType == api.TableType_GLOBAL && table.MultiPathEnabled() && !table.SelectionOptions.DisableBestPathSelection {
+				if l := dst.GetMultiBestPath(table.GLOBAL_RIB_NAME); len(l) > 1 {
+					for _, path := range l {
+						multipath[path] = struct{}{}
+					}
+				}
+			}
 			for i, path := range knownPathList {
+				if pathFilter != nil && !pathFilter.Match(path) {
+					continue
+				}
 				p := toPathApi(path, getValidation(v, path), r.EnableOnlyBinary, r.EnableNlriBinary, r.EnableAttributeBinary)
 				if !table.SelectionOptions.DisableBestPathSelection {
 					if i == 0 {
@@ -2829,8 +5299,12 @@ func (s *BgpServer) ListPath(ctx context.Context, r *api.ListPathRequest, fn fun
 						case api.TableType_LOCAL, api.TableType_GLOBAL:
 							p.Best = true
 						}
-					} else if s.bgpConfig.Global.UseMultiplePaths.Config.Enabled && path.Equal(knownPathList[i-1]) {
-						p.Best = true
+					}
+					_, p.Multipath = multipath[path]
+				}
+				if path.IsLocal() && len(expirations) > 0 {
+					if t, y := expirations[pathTokey(path)]; y && t.After(now) {
+						p.RemainingLifetime = uint32(t.Sub(now).Round(time.Second) / time.Second)
 					}
 				}
 				d.Paths = append(d.Paths, p)
@@ -2843,13 +5317,27 @@ func (s *BgpServer) ListPath(ctx context.Context, r *api.ListPathRequest, fn fun
 					p.SendMaxFiltered = true
 				}
 			}
+			if pathFilter != nil && len(d.Paths) == 0 {
+				continue
+			}
 
 			select {
 			case <-ctx.Done():
 				return nil
 			default:
-				fn(&d)
 			}
+			if last != nil {
+				if r.PageSize > 0 && n == r.PageSize {
+					fn(last, lastCursor.token())
+					return nil
+				}
+				fn(last, "")
+			}
+			n++
+			last, lastCursor = &d, e.cursor
+		}
+		if last != nil {
+			fn(last, "")
 		}
 		return nil
 	}()
@@ -2898,7 +5386,7 @@ func (s *BgpServer) getAdjRibInfo(addr string, family bgp.RouteFamily, in bool)
 		if in {
 			adjRib = peer.adjRibIn
 		} else {
-			adjRib = table.NewAdjRib(s.logger, peer.configuredRFlist())
+			adjRib = table.NewAdjRib(s.logLevels.Logger(log.ModuleTable), peer.configuredRFlist())
 			accepted, _ := s.getBestFromLocal(peer, peer.configuredRFlist())
 			adjRib.UpdateAdjRibOut(accepted)
 		}
@@ -2978,6 +5466,122 @@ func (s *BgpServer) GetTable(ctx context.Context, r *api.GetTableRequest) (*api.
 	}, nil
 }
 
+// GetRibSummary returns the breakdown of the paths in the global RIB per
+// family and per peer.
+func (s *BgpServer) GetRibSummary(ctx context.Context, r *api.GetRibSummaryRequest) (*api.GetRibSummaryResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("nil request")
+	}
+	rsp := &api.GetRibSummaryResponse{}
+	err := s.mgmtOperation(func() error {
+		families := make([]bgp.RouteFamily, 0, len(r.Families))
+		for _, f := range r.Families {
+			family := bgp.AfiSafiToRouteFamily(uint16(f.Afi), uint8(f.Safi))
+			if _, ok := s.globalRib.Tables[family]; !ok {
+				return fmt.Errorf("address family: %s not supported", family)
+			}
+			families = append(families, family)
+		}
+		all := len(families) == 0
+		if all {
+			for family := range s.globalRib.Tables {
+				families = append(families, family)
+			}
+			sort.Slice(families, func(i, j int) bool { return families[i] < families[j] })
+		}
+		for _, family := range families {
+			summary := s.globalRib.Tables[family].Summary()
+			if all && summary.NumPath == 0 {
+				continue
+			}
+			afi, safi := bgp.RouteFamilyToAfiSafi(family)
+			rs := &api.RibSummary{
+				Family:          apiutil.ToApiFamily(afi, safi),
+				NumDestination:  uint64(summary.NumDestination),
+				NumPath:         uint64(summary.NumPath),
+				NumAttributeSet: uint64(summary.NumAttributeSet),
+				Memory:          summary.Memory,
+				Peers:           make([]*api.RibPeerSummary, 0, len(summary.Peers)),
+			}
+			for neighbor, p := range summary.Peers {
+				rs.Peers = append(rs.Peers, &api.RibPeerSummary{
+					Neighbor: neighbor,
+					NumPath:  uint64(p.NumPath),
+					NumBest:  uint64(p.NumBest),
+				})
+			}
+			sort.Slice(rs.Peers, func(i, j int) bool { return rs.Peers[i].Neighbor < rs.Peers[j].Neighbor })
+			rsp.Summaries = append(rsp.Summaries, rs)
+		}
+		return nil
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+// ListRibDiff returns the paths only in either Adj-RIB-In of two neighbors
+// or with the different attributes.
+func (s *BgpServer) ListRibDiff(ctx context.Context, r *api.ListRibDiffRequest, fn func(*api.RibDiff)) error {
+	if r == nil || r.NeighborA == "" || r.NeighborB == "" || r.Family == nil {
+		return fmt.Errorf("nil request")
+	}
+	family := bgp.AfiSafiToRouteFamily(uint16(r.Family.Afi), uint8(r.Family.Safi))
+	ignored := make(map[bgp.BGPAttrType]struct{}, len(r.IgnoredAttributes))
+	for _, t := range r.IgnoredAttributes {
+		ignored[bgp.BGPAttrType(t)] = struct{}{}
+	}
+	var l []*api.RibDiff
+	err := s.mgmtOperation(func() error {
+		a, ok := s.neighborMap[r.NeighborA]
+		if !ok {
+			return fmt.Errorf("neighbor that has %v doesn't exist", r.NeighborA)
+		}
+		b, ok := s.neighborMap[r.NeighborB]
+		if !ok {
+			return fmt.Errorf("neighbor that has %v doesn't exist", r.NeighborB)
+		}
+		diffs, err := a.adjRibIn.Diff(b.adjRibIn, family, ignored)
+		if err != nil {
+			return err
+		}
+		l = make([]*api.RibDiff, 0, len(diffs))
+		for _, d := range diffs {
+			diff := &api.RibDiff{}
+			switch d.Type {
+			case table.ADJ_RIB_DIFF_ONLY_LEFT:
+				diff.Type = api.RibDiff_ONLY_A
+			case table.ADJ_RIB_DIFF_ONLY_RIGHT:
+				diff.Type = api.RibDiff_ONLY_B
+			case table.ADJ_RIB_DIFF_DIFFERENT:
+				diff.Type = api.RibDiff_DIFFERENT
+			}
+			if d.Left != nil {
+				diff.Prefix = d.Left.GetNlri().String()
+				diff.PathA = toPathApi(d.Left, nil, false, false, false)
+			}
+			if d.Right != nil {
+				diff.Prefix = d.Right.GetNlri().String()
+				diff.PathB = toPathApi(d.Right, nil, false, false, false)
+			}
+			l = append(l, diff)
+		}
+		return nil
+	}, true)
+	if err == nil {
+		for _, d := range l {
+			select {
+			case <-ctx.Done():
+				return nil
+			default:
+				fn(d)
+			}
+		}
+	}
+	return err
+}
+
 func (s *BgpServer) GetBgp(ctx context.Context, r *api.GetBgpRequest) (*api.GetBgpResponse, error) {
 	if r == nil {
 		return nil, fmt.Errorf("nil request")
@@ -2992,6 +5596,9 @@ func (s *BgpServer) GetBgp(ctx context.Context, r *api.GetBgpRequest) (*api.GetB
 				ListenPort:       g.Config.Port,
 				ListenAddresses:  g.Config.LocalAddressList,
 				UseMultiplePaths: g.UseMultiplePaths.Config.Enabled,
+				QuicListenPort:   g.Config.QuicPort,
+				QuicCertFile:     g.Config.QuicCertFile,
+				QuicKeyFile:      g.Config.QuicKeyFile,
 			},
 		}
 		return nil
@@ -3007,6 +5614,7 @@ func (s *BgpServer) ListDynamicNeighbor(ctx context.Context, r *api.ListDynamicN
 		return &api.DynamicNeighbor{
 			Prefix:    dn.Config.Prefix,
 			PeerGroup: dn.Config.PeerGroup,
+			Interface: dn.Config.Interface,
 		}
 	}
 	var l []*api.DynamicNeighbor
@@ -3061,6 +5669,70 @@ func (s *BgpServer) ListPeerGroup(ctx context.Context, r *api.ListPeerGroupReque
 	return nil
 }
 
+// GetEffectivePeerConfig returns the configuration of the neighbor with the
+// values inherited from the peer group, the peer defaults and the built-in
+// defaults, and where each value comes from.
+func (s *BgpServer) GetEffectivePeerConfig(ctx context.Context, r *api.GetEffectivePeerConfigRequest) (*api.GetEffectivePeerConfigResponse, error) {
+	if r == nil || r.Address == "" {
+		return nil, fmt.Errorf("nil request")
+	}
+	var rsp *api.GetEffectivePeerConfigResponse
+	err := s.mgmtOperation(func() error {
+		var peer *peer
+		for k, p := range s.neighborMap {
+			p.fsm.lock.RLock()
+			neighborIface := p.fsm.pConf.Config.NeighborInterface
+			p.fsm.lock.RUnlock()
+			if r.Address == k || r.Address == neighborIface {
+				peer = p
+				break
+			}
+		}
+		if peer == nil {
+			return fmt.Errorf("neighbor that has %v doesn't exist", r.Address)
+		}
+
+		peer.fsm.lock.RLock()
+		p := oc.NewPeerFromConfigStruct(peer.fsm.pConf)
+		peerGroup := peer.fsm.pConf.Config.PeerGroup
+		peer.fsm.lock.RUnlock()
+		if p == nil {
+			return fmt.Errorf("failed to convert the configuration of %v", r.Address)
+		}
+		// only the configuration
+		p.State = nil
+		if p.Timers != nil {
+			p.Timers.State = nil
+		}
+
+		sources := make([]*api.PeerConfigSource, 0, len(peer.configSources))
+		for _, c := range peer.configSources {
+			source := &api.PeerConfigSource{
+				Path:  c.Path,
+				Value: fmt.Sprint(c.Value),
+			}
+			switch c.Origin {
+			case oc.NEIGHBOR_CONFIG_ORIGIN_NEIGHBOR:
+				source.Origin = api.PeerConfigSource_NEIGHBOR
+			case oc.NEIGHBOR_CONFIG_ORIGIN_PEER_GROUP:
+				source.Origin = api.PeerConfigSource_PEER_GROUP
+				source.PeerGroup = peerGroup
+			case oc.NEIGHBOR_CONFIG_ORIGIN_PEER_DEFAULTS:
+				source.Origin = api.PeerConfigSource_PEER_DEFAULTS
+			case oc.NEIGHBOR_CONFIG_ORIGIN_DEFAULT:
+				source.Origin = api.PeerConfigSource_DEFAULT
+			}
+			sources = append(sources, source)
+		}
+		rsp = &api.GetEffectivePeerConfigResponse{
+			Peer:    p,
+			Sources: sources,
+		}
+		return nil
+	}, false)
+	return rsp, err
+}
+
 func (s *BgpServer) ListPeer(ctx context.Context, r *api.ListPeerRequest, fn func(*api.Peer)) error {
 	if r == nil {
 		return fmt.Errorf("nil request")
@@ -3079,6 +5751,14 @@ func (s *BgpServer) ListPeer(ctx context.Context, r *api.ListPeerRequest, fn fun
 			}
 			// FIXME: should remove toConfig() conversion
 			p := oc.NewPeerFromConfigStruct(s.toConfig(peer, getAdvertised))
+			peer.fsm.lock.RLock()
+			if len(peer.fsm.treatAsWithdrawAttributes) > 0 {
+				p.State.TreatAsWithdrawAttributes = make(map[uint32]uint32, len(peer.fsm.treatAsWithdrawAttributes))
+				for t, n := range peer.fsm.treatAsWithdrawAttributes {
+					p.State.TreatAsWithdrawAttributes[uint32(t)] = n
+				}
+			}
+			peer.fsm.lock.RUnlock()
 			for _, family := range peer.configuredRFlist() {
 				for i, afisafi := range p.AfiSafis {
 					if !afisafi.Config.Enabled {
@@ -3095,12 +5775,20 @@ func (s *BgpServer) ListPeer(ctx context.Context, r *api.ListPeerRequest, fn fun
 							pathList, _ := s.getBestFromLocal(peer, flist)
 							advertised = uint64(len(pathList))
 						}
+						stats := peer.adjRibIn.Statistics(family)
 						p.AfiSafis[i].State = &api.AfiSafiState{
 							Family:     c.Family,
 							Enabled:    true,
 							Received:   received,
 							Accepted:   accepted,
 							Advertised: advertised,
+							UpdateStatistics: &api.UpdateStatistics{
+								Updates:          stats.Updates,
+								Withdrawals:      stats.Withdrawals,
+								Duplicates:       stats.Duplicates,
+								AttributeChanges: stats.AttributeChanges,
+								Flaps:            stats.Flaps,
+							},
 						}
 					}
 				}
@@ -3125,6 +5813,11 @@ func (s *BgpServer) addPeerGroup(c *oc.PeerGroup) error {
 	if _, y := s.peerGroupMap[name]; y {
 		return fmt.Errorf("can't overwrite the existing peer-group: %s", name)
 	}
+	if source := c.Config.AuthPasswordSource; source != "" {
+		if _, err := parsePasswordSource(source); err != nil {
+			return err
+		}
+	}
 
 	s.logger.Info("Add a peer group configuration",
 		log.Fields{
@@ -3137,12 +5830,11 @@ func (s *BgpServer) addPeerGroup(c *oc.PeerGroup) error {
 }
 
 func (s *BgpServer) addNeighbor(c *oc.Neighbor) error {
-	addr, err := c.ExtractNeighborAddress()
+	addr, p, err := s.configuredPeer(c)
 	if err != nil {
 		return err
 	}
-
-	if _, y := s.neighborMap[addr]; y {
+	if p != nil {
 		return fmt.Errorf("can't overwrite the existing peer: %s", addr)
 	}
 
@@ -3155,9 +5847,18 @@ func (s *BgpServer) addNeighbor(c *oc.Neighbor) error {
 		pgConf = pg.Conf
 	}
 
+	raw := *c
 	if err := oc.SetDefaultNeighborConfigValues(c, pgConf, &s.bgpConfig.Global); err != nil {
 		return err
 	}
+	if c.Config.NeighborInterface != "" && c.State.NeighborAddress != "" {
+		// the unnumbered peer is keyed by the interface until the
+		// neighbor is discovered
+		addr = c.State.NeighborAddress
+		if _, y := s.neighborMap[addr]; y {
+			return fmt.Errorf("can't overwrite the existing peer: %s", addr)
+		}
+	}
 
 	if vrf := c.Config.Vrf; vrf != "" {
 		if c.RouteServer.Config.RouteServerClient {
@@ -3179,7 +5880,23 @@ func (s *BgpServer) addNeighbor(c *oc.Neighbor) error {
 		return fmt.Errorf("can't be both route-server-client and route-reflector-client")
 	}
 
-	if s.bgpConfig.Global.Config.Port > 0 {
+	if source := c.Config.AuthPasswordSource; source != "" {
+		if _, err := parsePasswordSource(source); err != nil {
+			return err
+		}
+	}
+
+	if err := validateConditionalAdvertisements(s.policy, c.ApplyPolicy.ConditionalAdvertisements); err != nil {
+		return err
+	}
+
+	if vrf := c.Transport.Config.Vrf; vrf != "" {
+		if err := s.addVrfListeners(vrf); err != nil {
+			return err
+		}
+	}
+
+	if s.bgpConfig.Global.Config.Port > 0 && c.State.NeighborAddress != "" {
 		for _, l := range s.listListeners(addr) {
 			if c.Config.AuthPassword != "" {
 				if err := setTCPMD5SigSockopt(l, addr, c.Config.AuthPassword); err != nil {
@@ -3197,14 +5914,27 @@ func (s *BgpServer) addNeighbor(c *oc.Neighbor) error {
 			"Topic": "Peer",
 			"Key":   addr})
 
+	if s.peeringdb != nil && c.Config.PeeringdbMaxPrefix {
+		if !s.peeringdb.setMaxPrefixes(c.Config.PeerAs, c.AfiSafis) {
+			s.peeringdb.trigger()
+		}
+	}
+
 	rib := s.globalRib
 	if c.RouteServer.Config.RouteServerClient {
 		rib = s.rsRib
 	}
-	peer := newPeer(&s.bgpConfig.Global, c, rib, s.policy, s.logger)
+	peer := newPeer(s.peerGlobalConfig(c), c, rib, s.policy, s.logLevels.Logger(log.ModuleFsm))
+	peer.configSources = oc.NeighborConfigSources(&raw, c, pgConf, &s.bgpConfig.Global)
+	if s.peeringdb != nil && c.Config.PeeringdbMaxPrefix {
+		s.peeringdb.attach(peer, c.Config.PeerAs)
+	}
+	peer.gshut = s.gshut
 	s.addIncoming(peer.fsm.incomingCh)
 	s.policy.SetPeerPolicy(peer.ID(), c.ApplyPolicy)
+	s.setConditionalAdvertisements(peer, c.ApplyPolicy.ConditionalAdvertisements)
 	s.neighborMap[addr] = peer
+	s.setNetlinkPeerVrf(addr, c.Transport.Config.Vrf)
 	if name := c.Config.PeerGroup; name != "" {
 		s.peerGroupMap[name].AddMember(*c)
 	}
@@ -3243,14 +5973,27 @@ func (s *BgpServer) AddDynamicNeighbor(ctx context.Context, r *api.AddDynamicNei
 	if r == nil || r.DynamicNeighbor == nil {
 		return fmt.Errorf("nil request")
 	}
+	if intf := r.DynamicNeighbor.Interface; intf != "" {
+		addr, _, err := net.ParseCIDR(r.DynamicNeighbor.Prefix)
+		if err != nil || addr.To4() != nil || !addr.IsLinkLocalUnicast() {
+			return fmt.Errorf("dynamic neighbor prefix %s with interface %s must be IPv6 link-local", r.DynamicNeighbor.Prefix, intf)
+		}
+	}
 	return s.mgmtOperation(func() error {
 		c := &oc.DynamicNeighbor{Config: oc.DynamicNeighborConfig{
 			Prefix:    r.DynamicNeighbor.Prefix,
-			PeerGroup: r.DynamicNeighbor.PeerGroup},
+			PeerGroup: r.DynamicNeighbor.PeerGroup,
+			Interface: r.DynamicNeighbor.Interface},
 		}
 		s.peerGroupMap[c.Config.PeerGroup].AddDynamicNeighbor(c)
 
 		pConf := s.peerGroupMap[c.Config.PeerGroup].Conf
+		if vrf := pConf.Transport.Config.Vrf; vrf != "" {
+			if err := s.addVrfListeners(vrf); err != nil {
+				return err
+			}
+		}
+		s.refreshDynamicNeighborPassword(s.peerGroupMap[c.Config.PeerGroup], c.Config.Prefix, c.Config.Interface)
 		if pConf.Config.AuthPassword != "" {
 			prefix := r.DynamicNeighbor.Prefix
 			addr, _, _ := net.ParseCIDR(prefix)
@@ -3297,24 +6040,15 @@ func (s *BgpServer) deleteNeighbor(c *oc.Neighbor, code, subcode uint8) error {
 		}
 	}
 
-	addr, err := c.ExtractNeighborAddress()
+	addr, n, err := s.configuredPeer(c)
 	if err != nil {
 		return err
 	}
-
-	if intf := c.Config.NeighborInterface; intf != "" {
-		var err error
-		addr, err = oc.GetIPv6LinkLocalNeighborAddress(intf)
-		if err != nil {
-			return err
-		}
-	}
-	n, y := s.neighborMap[addr]
-	if !y {
+	if n == nil {
 		return fmt.Errorf("can't delete a peer configuration for %s", addr)
 	}
 	for _, l := range s.listListeners(addr) {
-		if c.Config.AuthPassword != "" {
+		if (c.Config.AuthPassword != "" || c.Config.AuthPasswordSource != "") && n.ID() != "" {
 			if err := setTCPMD5SigSockopt(l, addr, ""); err != nil {
 				s.logger.Warn("failed to unset md5",
 					log.Fields{
@@ -3330,14 +6064,101 @@ func (s *BgpServer) deleteNeighbor(c *oc.Neighbor, code, subcode uint8) error {
 			"Key":   addr})
 
 	n.stopPeerRestarting()
+	s.stopGracefulShutdown(n)
+	n.mrai.reset()
+	n.slowQueue.reset()
 	n.fsm.notification <- bgp.NewBGPNotificationMessage(code, subcode, nil)
 	n.fsm.h.ctxCancel()
+	n.fsm.lock.RLock()
+	if c := n.fsm.capture; c != nil {
+		c.close()
+	}
+	n.fsm.lock.RUnlock()
 
 	delete(s.neighborMap, addr)
-	s.propagateUpdate(n, n.DropAll(n.configuredRFlist()))
+	s.setNetlinkPeerVrf(addr, "")
+	if vrf := n.fsm.pConf.Transport.Config.Vrf; vrf != "" {
+		s.deleteVrfListeners(vrf)
+	}
+	s.dropPeerPaths(n, n.configuredRFlist())
+	return nil
+}
+
+// addVrfListeners starts listening in the Linux VRF device vrf. The
+// listeners not bound to a VRF device don't accept the connections in VRFs
+// unless net.ipv4.tcp_l3mdev_accept is enabled.
+func (s *BgpServer) addVrfListeners(vrf string) error {
+	port := s.bgpConfig.Global.Config.Port
+	if s.acceptCh == nil || port <= 0 {
+		return nil
+	}
+	if _, y := s.vrfListeners[vrf]; y {
+		return nil
+	}
+	listeners := make([]*tcpListener, 0, len(s.bgpConfig.Global.Config.LocalAddressList))
+	for _, addr := range s.bgpConfig.Global.Config.LocalAddressList {
+		l, err := newTCPListener(s.logger, addr, uint32(port), vrf, "", false, s.acceptCh)
+		if err != nil {
+			for _, l := range listeners {
+				l.Close()
+			}
+			return fmt.Errorf("failed to listen in VRF %s: %w", vrf, err)
+		}
+		listeners = append(listeners, l)
+	}
+	if s.vrfListeners == nil {
+		s.vrfListeners = make(map[string][]*tcpListener)
+	}
+	s.vrfListeners[vrf] = listeners
+	s.listeners = append(s.listeners, listeners...)
 	return nil
 }
 
+// deleteVrfListeners stops listening in the Linux VRF device vrf if no
+// neighbor uses it anymore.
+func (s *BgpServer) deleteVrfListeners(vrf string) {
+	for _, peer := range s.neighborMap {
+		peer.fsm.lock.RLock()
+		v := peer.fsm.pConf.Transport.Config.Vrf
+		peer.fsm.lock.RUnlock()
+		if v == vrf {
+			return
+		}
+	}
+	for _, pg := range s.peerGroupMap {
+		if len(pg.dynamicNeighbors) > 0 && pg.Conf.Transport.Config.Vrf == vrf {
+			return
+		}
+	}
+	closed := make(map[*tcpListener]bool)
+	for _, l := range s.vrfListeners[vrf] {
+		l.Close()
+		closed[l] = true
+	}
+	delete(s.vrfListeners, vrf)
+	listeners := make([]*tcpListener, 0, len(s.listeners))
+	for _, l := range s.listeners {
+		if !closed[l] {
+			listeners = append(listeners, l)
+		}
+	}
+	s.listeners = listeners
+}
+
+func (s *BgpServer) setNetlinkPeerVrf(addr, vrf string) {
+	if s.nlclient == nil {
+		return
+	}
+	if err := s.nlclient.setPeerVrf(addr, vrf); err != nil {
+		s.logger.Warn("failed to find the routing table of VRF",
+			log.Fields{
+				"Topic": "Netlink",
+				"Key":   addr,
+				"Vrf":   vrf,
+				"Error": err})
+	}
+}
+
 func (s *BgpServer) DeletePeerGroup(ctx context.Context, r *api.DeletePeerGroupRequest) error {
 	if r == nil {
 		return fmt.Errorf("nil request")
@@ -3374,11 +6195,11 @@ func (s *BgpServer) DeleteDynamicNeighbor(ctx context.Context, r *api.DeleteDyna
 		return fmt.Errorf("nil request")
 	}
 	return s.mgmtOperation(func() error {
-		s.peerGroupMap[r.PeerGroup].DeleteDynamicNeighbor(r.Prefix)
+		s.peerGroupMap[r.PeerGroup].DeleteDynamicNeighbor(r.Prefix, r.Interface)
 
 		if pg, ok := s.peerGroupMap[r.PeerGroup]; ok {
 			pConf := pg.Conf
-			if pConf.Config.AuthPassword != "" {
+			if pConf.Config.AuthPassword != "" || pConf.Config.AuthPasswordSource != "" {
 				prefix := r.Prefix
 				addr, _, perr := net.ParseCIDR(prefix)
 				if perr == nil {
@@ -3450,19 +6271,19 @@ func (s *BgpServer) updateNeighbor(c *oc.Neighbor) (needsSoftResetIn bool, err e
 			return needsSoftResetIn, fmt.Errorf("no such peer-group: %s", c.Config.PeerGroup)
 		}
 	}
+	raw := *c
 	if err := oc.SetDefaultNeighborConfigValues(c, pgConf, &s.bgpConfig.Global); err != nil {
 		return needsSoftResetIn, err
 	}
 
-	addr, err := c.ExtractNeighborAddress()
+	addr, peer, err := s.configuredPeer(c)
 	if err != nil {
 		return needsSoftResetIn, err
 	}
-
-	peer, ok := s.neighborMap[addr]
-	if !ok {
+	if peer == nil {
 		return needsSoftResetIn, fmt.Errorf("neighbor that has %v doesn't exist", addr)
 	}
+	configSources := oc.NeighborConfigSources(&raw, c, pgConf, &s.bgpConfig.Global)
 
 	if !peer.fsm.pConf.ApplyPolicy.Equal(&c.ApplyPolicy) {
 		s.logger.Info("Update ApplyPolicy",
@@ -3470,8 +6291,12 @@ func (s *BgpServer) updateNeighbor(c *oc.Neighbor) (needsSoftResetIn bool, err e
 				"Topic": "Peer",
 				"Key":   addr})
 
+		if err := validateConditionalAdvertisements(s.policy, c.ApplyPolicy.ConditionalAdvertisements); err != nil {
+			return needsSoftResetIn, err
+		}
 		s.policy.SetPeerPolicy(peer.ID(), c.ApplyPolicy)
 		peer.fsm.pConf.ApplyPolicy = c.ApplyPolicy
+		s.setConditionalAdvertisements(peer, c.ApplyPolicy.ConditionalAdvertisements)
 		needsSoftResetIn = true
 	}
 	original := peer.fsm.pConf
@@ -3484,7 +6309,7 @@ func (s *BgpServer) updateNeighbor(c *oc.Neighbor) (needsSoftResetIn bool, err e
 		needsSoftResetIn = true
 	}
 
-	if original.NeedsResendOpenMessage(c) {
+	if original.NeedsResendOpenMessage(c) && !s.reviseFamilies(peer, c) {
 		sub := uint8(bgp.BGP_ERROR_SUB_OTHER_CONFIGURATION_CHANGE)
 		if original.Config.AdminDown != c.Config.AdminDown {
 			sub = bgp.BGP_ERROR_SUB_ADMINISTRATIVE_SHUTDOWN
@@ -3516,6 +6341,10 @@ func (s *BgpServer) updateNeighbor(c *oc.Neighbor) (needsSoftResetIn bool, err e
 					"Topic": "Peer",
 					"Key":   addr,
 					"Err":   err})
+		} else {
+			// c is already resolved
+			_, peer, _ = s.configuredPeer(c)
+			peer.configSources = configSources
 		}
 		return needsSoftResetIn, err
 	}
@@ -3529,6 +6358,12 @@ func (s *BgpServer) updateNeighbor(c *oc.Neighbor) (needsSoftResetIn bool, err e
 		peer.fsm.pConf.Timers.Config = c.Timers.Config
 	}
 
+	if s.peeringdb != nil && c.Config.PeeringdbMaxPrefix {
+		// keep the max prefixes set from PeeringDB
+		if !s.peeringdb.setMaxPrefixes(c.Config.PeerAs, c.AfiSafis) {
+			s.peeringdb.trigger()
+		}
+	}
 	err = peer.updatePrefixLimitConfig(c.AfiSafis)
 	if err != nil {
 		s.logger.Error("failed to update prefixLimit",
@@ -3564,6 +6399,12 @@ func (s *BgpServer) updateNeighbor(c *oc.Neighbor) (needsSoftResetIn bool, err e
 		}
 	}
 
+	if !original.UpdateRateLimit.Config.Equal(&c.UpdateRateLimit.Config) {
+		peer.fsm.pConf.UpdateRateLimit.Config = c.UpdateRateLimit.Config
+		peer.fsm.updateLimiter.setLimit(&c.UpdateRateLimit.Config)
+	}
+
+	peer.configSources = configSources
 	return needsSoftResetIn, err
 }
 
@@ -3715,14 +6556,32 @@ func (s *BgpServer) DisablePeer(ctx context.Context, r *api.DisablePeerRequest)
 	}, true)
 }
 
+func (s *BgpServer) GracefulShutdownPeer(ctx context.Context, r *api.GracefulShutdownPeerRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		addr := r.Address
+		if addr == "all" {
+			addr = ""
+		}
+		if r.Cancel {
+			return s.cancelGracefulShutdown(addr)
+		}
+		return s.startGracefulShutdown(addr, time.Duration(r.DrainTime)*time.Second, r.Communication)
+	}, true)
+}
+
 func (s *BgpServer) ListDefinedSet(ctx context.Context, r *api.ListDefinedSetRequest, fn func(*api.DefinedSet)) error {
 	if r == nil {
 		return fmt.Errorf("nil request")
 	}
 	var cd *oc.DefinedSets
+	var versions map[table.DefinedType]map[string]uint64
 	var err error
 	err = s.mgmtOperation(func() error {
 		cd, err = s.policy.GetDefinedSet(table.DefinedType(r.DefinedType), r.Name)
+		versions = s.policy.GetDefinedSetVersions()
 		return err
 	}, false)
 
@@ -3730,6 +6589,7 @@ func (s *BgpServer) ListDefinedSet(ctx context.Context, r *api.ListDefinedSetReq
 		return err
 	}
 	exec := func(d *api.DefinedSet) bool {
+		d.Version = versions[table.DefinedType(d.DefinedType)][d.Name]
 		select {
 		case <-ctx.Done():
 			return true
@@ -3825,6 +6685,146 @@ func (s *BgpServer) AddDefinedSet(ctx context.Context, r *api.AddDefinedSetReque
 	}, false)
 }
 
+// UpdateDefinedSet adds entries to and removes entries from a defined set,
+// and evaluates again the routes whose policy result might be changed by
+// the update. Unlike soft resets, only the routes matching the added or
+// removed entries are evaluated unless the set is used in a way that
+// makes it impossible to narrow them down, e.g. with the match-set-option
+// all.
+func (s *BgpServer) UpdateDefinedSet(ctx context.Context, r *api.UpdateDefinedSetRequest) (*api.UpdateDefinedSetResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("nil request")
+	}
+	var rsp *api.UpdateDefinedSetResponse
+	err := s.mgmtOperation(func() error {
+		var add, remove table.DefinedSet
+		var err error
+		if len(r.AddList) > 0 || len(r.AddPrefixes) > 0 {
+			add, err = newDefinedSetFromApiStruct(&api.DefinedSet{
+				DefinedType: r.DefinedType,
+				Name:        r.Name,
+				List:        r.AddList,
+				Prefixes:    r.AddPrefixes,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(r.RemoveList) > 0 || len(r.RemovePrefixes) > 0 {
+			remove, err = newDefinedSetFromApiStruct(&api.DefinedSet{
+				DefinedType: r.DefinedType,
+				Name:        r.Name,
+				List:        r.RemoveList,
+				Prefixes:    r.RemovePrefixes,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		u, err := s.policy.NewDefinedSetUpdate(add, remove, r.Version)
+		if err != nil {
+			return err
+		}
+
+		type exported struct {
+			peer   *peer
+			paths  []*table.Path
+			before []*table.Path
+		}
+		imports := make(map[*peer][]*table.Path)
+		exports := make([]*exported, 0)
+		softResetOuts := make(map[*peer]struct{})
+		count := 0
+		for _, a := range u.Assignments {
+			for _, peer := range s.neighborMap {
+				if peer.TableID() != a.Name {
+					continue
+				}
+				families := familiesForSoftreset(peer, bgp.RouteFamily(0))
+				peer.fsm.lock.RLock()
+				info := peer.fsm.peerInfo
+				vrf := peer.fsm.pConf.Config.Vrf != ""
+				established := peer.fsm.state == bgp.BGP_FSM_ESTABLISHED
+				peer.fsm.lock.RUnlock()
+
+				if a.Type == table.POLICY_DIRECTION_IMPORT {
+					options := &table.PolicyOptions{}
+					if !peer.isRouteServerClient() {
+						options.Info = info
+					}
+					for _, path := range peer.adjRibIn.PathList(families, true) {
+						// the policies are applied to the paths converted for the global table
+						if vrf || u.Affects(path, options) {
+							imports[peer] = append(imports[peer], path)
+						}
+					}
+					count += len(imports[peer])
+					continue
+				}
+
+				if !established {
+					continue
+				}
+				if peer.isSecondaryRouteEnabled() {
+					softResetOuts[peer] = struct{}{}
+					continue
+				}
+				e := &exported{peer: peer}
+				options := &table.PolicyOptions{Info: info}
+				for _, family := range peer.toGlobalFamilies(families) {
+					if peer.isAddPathSendEnabled(family) && peer.isAddPathSendBestEnabled(family) {
+						softResetOuts[peer] = struct{}{}
+						continue
+					}
+					for _, path := range s.getPossibleBest(peer, family) {
+						if u.Affects(path, options) {
+							e.paths = append(e.paths, path)
+							e.before = append(e.before, s.filterpath(peer, path, nil))
+						}
+					}
+				}
+				count += len(e.paths)
+				exports = append(exports, e)
+			}
+		}
+
+		version, err := s.policy.UpdateDefinedSet(u, r.Version)
+		if err != nil {
+			return err
+		}
+
+		for peer, paths := range imports {
+			s.propagateUpdate(peer, paths)
+		}
+		for _, e := range exports {
+			pathList := make([]*table.Path, 0, len(e.paths))
+			for i, path := range e.paths {
+				before := e.before[i]
+				after := s.filterpath(e.peer, path, nil)
+				if after != nil && !after.Equal(before) {
+					pathList = append(pathList, after)
+				} else if after == nil && before != nil && !before.IsWithdraw {
+					pathList = append(pathList, before.Clone(true))
+				}
+			}
+			if len(pathList) > 0 {
+				sendfsmOutgoingMsg(e.peer, pathList, nil, false)
+			}
+		}
+		for peer := range softResetOuts {
+			if err := s.softResetOut(peer.ID(), bgp.RouteFamily(0), false); err != nil {
+				return err
+			}
+		}
+		rsp = &api.UpdateDefinedSetResponse{
+			Version:     version,
+			Reevaluated: uint64(count),
+		}
+		return nil
+	}, false)
+	return rsp, err
+}
+
 func (s *BgpServer) DeleteDefinedSet(ctx context.Context, r *api.DeleteDefinedSetRequest) error {
 	if r == nil || r.DefinedSet == nil {
 		return fmt.Errorf("nil request")
@@ -4039,34 +7039,308 @@ func (s *BgpServer) AddPolicyAssignment(ctx context.Context, r *api.AddPolicyAss
 		if err != nil {
 			return err
 		}
-		return s.policy.AddPolicyAssignment(id, dir, toPolicyDefinition(r.Assignment.Policies), defaultRouteType(r.Assignment.DefaultAction))
-	}, false)
+		return s.policy.AddPolicyAssignment(id, dir, toPolicyDefinition(r.Assignment.Policies), defaultRouteType(r.Assignment.DefaultAction))
+	}, false)
+}
+
+func (s *BgpServer) DeletePolicyAssignment(ctx context.Context, r *api.DeletePolicyAssignmentRequest) error {
+	if r == nil || r.Assignment == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		id, dir, err := s.toPolicyInfo(r.Assignment.Name, r.Assignment.Direction)
+		if err != nil {
+			return err
+		}
+		return s.policy.DeletePolicyAssignment(id, dir, toPolicyDefinition(r.Assignment.Policies), r.All)
+	}, false)
+}
+
+func (s *BgpServer) SetPolicyAssignment(ctx context.Context, r *api.SetPolicyAssignmentRequest) error {
+	if r == nil || r.Assignment == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		id, dir, err := s.toPolicyInfo(r.Assignment.Name, r.Assignment.Direction)
+		if err != nil {
+			return err
+		}
+		return s.policy.SetPolicyAssignment(id, dir, toPolicyDefinition(r.Assignment.Policies), defaultRouteType(r.Assignment.DefaultAction))
+	}, false)
+}
+
+// ReplacePolicyAssignment replaces the policies assigned to the global RIB
+// or a route server client, and re-evaluates the routes received from or
+// advertised to the affected peers in the same operation. The response
+// counts the routes whose disposition changes. If r.DryRun is set, the
+// routes are only evaluated and the assignment is left unchanged.
+//
+// The locally originated routes are not counted for the import policies
+// because they are not re-evaluated by a soft reset either.
+func (s *BgpServer) ReplacePolicyAssignment(ctx context.Context, r *api.ReplacePolicyAssignmentRequest) (*api.ReplacePolicyAssignmentResponse, error) {
+	if r == nil || r.Assignment == nil {
+		return nil, fmt.Errorf("nil request")
+	}
+	var rsp *api.ReplacePolicyAssignmentResponse
+	err := s.mgmtOperation(func() error {
+		id, dir, err := s.toPolicyInfo(r.Assignment.Name, r.Assignment.Direction)
+		if err != nil {
+			return err
+		}
+		policies := toPolicyDefinition(r.Assignment.Policies)
+		def := defaultRouteType(r.Assignment.DefaultAction)
+		a, err := s.policy.NewPolicyAssignment(id, dir, policies, def)
+		if err != nil {
+			return err
+		}
+		peers := make([]*peer, 0)
+		for _, peer := range s.neighborMap {
+			if peer.TableID() == id {
+				peers = append(peers, peer)
+			}
+		}
+		rsp = s.comparePolicyAssignment(peers, a)
+		if r.DryRun {
+			return nil
+		}
+
+		if err := s.policy.SetPolicyAssignment(id, dir, policies, def); err != nil {
+			return err
+		}
+		for _, peer := range peers {
+			switch dir {
+			case table.POLICY_DIRECTION_IMPORT:
+				err = s.softResetIn(peer.ID(), bgp.RouteFamily(0))
+			case table.POLICY_DIRECTION_EXPORT:
+				err = s.softResetOut(peer.ID(), bgp.RouteFamily(0), false)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}, true)
+	return rsp, err
+}
+
+// comparePolicyAssignment counts the routes of peers whose disposition
+// changes if the current assignment is replaced with a.
+func (s *BgpServer) comparePolicyAssignment(peers []*peer, a *table.PolicyAssignment) *api.ReplacePolicyAssignmentResponse {
+	rsp := &api.ReplacePolicyAssignmentResponse{}
+	count := func(path *table.Path, options *table.PolicyOptions) {
+		options.Validate = s.roaTable.Validate
+		cur := s.policy.ApplyPolicy(a.Name, a.Type, path, options)
+		next := s.policy.ApplyPolicyAssignment(a, path, options)
+		switch {
+		case cur == nil && next != nil:
+			rsp.Accepted++
+		case cur != nil && next == nil:
+			rsp.Rejected++
+		case cur != nil && !cur.Equal(next):
+			rsp.Modified++
+		}
+	}
+
+	for _, peer := range peers {
+		families := familiesForSoftreset(peer, bgp.RouteFamily(0))
+		if a.Type == table.POLICY_DIRECTION_IMPORT {
+			rs, vrf, _, rib := s.importTarget(peer)
+			peer.fsm.lock.RLock()
+			peerVrf := peer.fsm.pConf.Config.Vrf
+			info := peer.fsm.peerInfo
+			peer.fsm.lock.RUnlock()
+			for _, path := range peer.adjRibIn.PathList(families, true) {
+				if vrf {
+					path = path.ToGlobal(rib.Vrfs[peerVrf])
+				}
+				options := &table.PolicyOptions{}
+				if !rs {
+					options.Info = info
+				}
+				count(path, options)
+			}
+			continue
+		}
+
+		peer.fsm.lock.RLock()
+		established := peer.fsm.state == bgp.BGP_FSM_ESTABLISHED
+		peer.fsm.lock.RUnlock()
+		if !established {
+			continue
+		}
+		for _, family := range families {
+			for _, path := range s.getPossibleBest(peer, family) {
+				p, options, stop := s.prePolicyFilterpath(peer, path, nil)
+				if stop {
+					continue
+				}
+				count(p, options)
+			}
+		}
+	}
+	return rsp
+}
+
+// SimulatePolicy evaluates policies against a path without changing the
+// RIBs, and returns the statements which matched, the actions applied and
+// the resulting path.
+func (s *BgpServer) SimulatePolicy(ctx context.Context, r *api.SimulatePolicyRequest) (*api.SimulatePolicyResponse, error) {
+	if r == nil || r.Assignment == nil {
+		return nil, fmt.Errorf("nil request")
+	}
+	var rsp *api.SimulatePolicyResponse
+	err := s.mgmtOperation(func() error {
+		var peer *peer
+		if r.Neighbor != "" {
+			var ok bool
+			if peer, ok = s.neighborMap[r.Neighbor]; !ok {
+				return fmt.Errorf("neighbor that has %v doesn't exist", r.Neighbor)
+			}
+		}
+		path, err := s.simulatedPath(r, peer)
+		if err != nil {
+			return err
+		}
+		a, err := s.simulatedPolicyAssignment(r.Assignment)
+		if err != nil {
+			return err
+		}
+
+		options := &table.PolicyOptions{}
+		if peer != nil {
+			if a.Type == table.POLICY_DIRECTION_EXPORT {
+				var stop bool
+				path, options, stop = s.prePolicyFilterpath(peer, path, nil)
+				if stop {
+					return fmt.Errorf("the path isn't advertised to %s regardless of the policies", r.Neighbor)
+				}
+			} else if !peer.isRouteServerClient() {
+				peer.fsm.lock.RLock()
+				options.Info = peer.fsm.peerInfo
+				peer.fsm.lock.RUnlock()
+			}
+		}
+		options.Validate = s.roaTable.Validate
+
+		result := s.policy.SimulatePolicy(a, path, options)
+		rsp = &api.SimulatePolicyResponse{
+			Statements:    make([]*api.SimulatePolicyResponse_StatementResult, 0, len(result.Statements)),
+			DefaultAction: result.Default,
+		}
+		for _, st := range result.Statements {
+			rsp.Statements = append(rsp.Statements, &api.SimulatePolicyResponse_StatementResult{
+				Policy:    st.Policy,
+				Statement: st.Statement,
+				Matched:   st.Matched,
+				Actions:   st.Actions,
+			})
+		}
+		switch result.Result {
+		case table.ROUTE_TYPE_ACCEPT:
+			rsp.Action = api.RouteAction_ACCEPT
+		case table.ROUTE_TYPE_REJECT:
+			rsp.Action = api.RouteAction_REJECT
+		}
+		if result.Path != nil {
+			rsp.Path = toPathApi(result.Path, nil, false, false, false)
+		}
+		return nil
+	}, true)
+	return rsp, err
 }
 
-func (s *BgpServer) DeletePolicyAssignment(ctx context.Context, r *api.DeletePolicyAssignmentRequest) error {
-	if r == nil || r.Assignment == nil {
-		return fmt.Errorf("nil request")
+// simulatedPath returns the path given in r, or looks up the path of
+// r.Prefix in the table specified by r.
+func (s *BgpServer) simulatedPath(r *api.SimulatePolicyRequest, peer *peer) (*table.Path, error) {
+	if r.Path != nil {
+		path, err := api2Path(api.TableType_GLOBAL, r.Path, false)
+		if err != nil {
+			return nil, err
+		}
+		if path.IsWithdraw {
+			return nil, fmt.Errorf("can't evaluate a withdrawal")
+		}
+		return path, nil
 	}
-	return s.mgmtOperation(func() error {
-		id, dir, err := s.toPolicyInfo(r.Assignment.Name, r.Assignment.Direction)
+	if r.Family == nil || r.Prefix == "" {
+		return nil, fmt.Errorf("either path or family and prefix must be specified")
+	}
+	family := bgp.AfiSafiToRouteFamily(uint16(r.Family.Afi), uint8(r.Family.Safi))
+	option := table.TableSelectOption{
+		LookupPrefixes: []*table.LookupPrefix{{Prefix: r.Prefix}},
+	}
+	var paths []*table.Path
+	switch r.TableType {
+	case api.TableType_GLOBAL:
+		tbl, ok := s.globalRib.Tables[family]
+		if !ok {
+			return nil, fmt.Errorf("address family: %s not supported", family)
+		}
+		option.Best = true
+		t, err := tbl.Select(option)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		return s.policy.DeletePolicyAssignment(id, dir, toPolicyDefinition(r.Assignment.Policies), r.All)
-	}, false)
+		for _, dst := range t.GetDestinations() {
+			if best := dst.GetBestPath(table.GLOBAL_RIB_NAME, 0); best != nil {
+				paths = append(paths, best)
+			}
+		}
+	case api.TableType_ADJ_IN:
+		if peer == nil {
+			return nil, fmt.Errorf("neighbor must be specified for adj-in")
+		}
+		t, err := peer.adjRibIn.Select(family, false, option)
+		if err != nil {
+			return nil, err
+		}
+		for _, dst := range t.GetDestinations() {
+			paths = append(paths, dst.GetAllKnownPathList()...)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported resource type: %v", r.TableType)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("path for %s not found", r.Prefix)
+	}
+	return paths[0], nil
 }
 
-func (s *BgpServer) SetPolicyAssignment(ctx context.Context, r *api.SetPolicyAssignmentRequest) error {
-	if r == nil || r.Assignment == nil {
-		return fmt.Errorf("nil request")
-	}
-	return s.mgmtOperation(func() error {
-		id, dir, err := s.toPolicyInfo(r.Assignment.Name, r.Assignment.Direction)
-		if err != nil {
-			return err
+// simulatedPolicyAssignment returns the policies to evaluate for
+// SimulatePolicy.
+func (s *BgpServer) simulatedPolicyAssignment(a *api.PolicyAssignment) (*table.PolicyAssignment, error) {
+	policies := toPolicyDefinition(a.Policies)
+	def := defaultRouteType(a.DefaultAction)
+	if a.Name == "" {
+		if len(policies) == 0 {
+			return nil, fmt.Errorf("empty table name")
 		}
-		return s.policy.SetPolicyAssignment(id, dir, toPolicyDefinition(r.Assignment.Policies), defaultRouteType(r.Assignment.DefaultAction))
-	}, false)
+		if def == table.ROUTE_TYPE_NONE {
+			def = table.ROUTE_TYPE_ACCEPT
+		}
+		dir := table.POLICY_DIRECTION_IMPORT
+		if a.Direction == api.PolicyDirection_EXPORT {
+			dir = table.POLICY_DIRECTION_EXPORT
+		}
+		return s.policy.NewPolicyAssignment("", dir, policies, def)
+	}
+	id, dir, err := s.toPolicyInfo(a.Name, a.Direction)
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) > 0 {
+		return s.policy.NewPolicyAssignment(id, dir, policies, def)
+	}
+	rt, ps, err := s.policy.GetPolicyAssignment(id, dir)
+	if err != nil {
+		return nil, err
+	}
+	return &table.PolicyAssignment{
+		Name:     id,
+		Type:     dir,
+		Policies: ps,
+		Default:  rt,
+	}, nil
 }
 
 func (s *BgpServer) EnableMrt(ctx context.Context, r *api.EnableMrtRequest) error {
@@ -4079,6 +7353,7 @@ func (s *BgpServer) EnableMrt(ctx context.Context, r *api.EnableMrtRequest) erro
 			RotationInterval: r.RotationInterval,
 			DumpType:         oc.IntToMrtTypeMap[int(r.Type)],
 			FileName:         r.Filename,
+			StreamAddress:    r.StreamAddress,
 		})
 	}, false)
 }
@@ -4088,7 +7363,10 @@ func (s *BgpServer) DisableMrt(ctx context.Context, r *api.DisableMrtRequest) er
 		return fmt.Errorf("nil request")
 	}
 	return s.mgmtOperation(func() error {
-		return s.mrtManager.disable(&oc.MrtConfig{})
+		return s.mrtManager.disable(&oc.MrtConfig{
+			FileName:      r.Filename,
+			StreamAddress: r.StreamAddress,
+		})
 	}, false)
 }
 
@@ -4105,6 +7383,8 @@ func (s *BgpServer) ListRpki(ctx context.Context, r *api.ListRpkiRequest, fn fun
 				Conf: &api.RPKIConf{
 					Address:    r.Config.Address,
 					RemotePort: uint32(r.Config.Port),
+					Transport:  api.RPKIConf_Transport(r.Config.Transport.ToInt()),
+					Preference: uint32(r.Config.Preference),
 				},
 				State: &api.RPKIState{
 					Uptime:        oc.ProtoTimestamp(r.State.Uptime),
@@ -4176,8 +7456,15 @@ func (s *BgpServer) AddRpki(ctx context.Context, r *api.AddRpkiRequest) error {
 	if r == nil {
 		return fmt.Errorf("nil request")
 	}
+	if r.Preference > math.MaxUint8 {
+		return fmt.Errorf("invalid rpki preference: %d", r.Preference)
+	}
+	dial, err := newRTRDialer(r)
+	if err != nil {
+		return err
+	}
 	return s.mgmtOperation(func() error {
-		return s.roaManager.AddServer(net.JoinHostPort(r.Address, strconv.Itoa(int(r.Port))), r.Lifetime)
+		return s.roaManager.AddServer(net.JoinHostPort(r.Address, strconv.Itoa(int(r.Port))), r.Lifetime, oc.IntToRpkiTransportTypeMap[int(r.Transport)], uint8(r.Preference), dial)
 	}, false)
 }
 
@@ -4220,6 +7507,23 @@ func (s *BgpServer) ResetRpki(ctx context.Context, r *api.ResetRpkiRequest) erro
 	}, false)
 }
 
+func (s *BgpServer) SetRpkiSlurm(ctx context.Context, r *api.SetRpkiSlurmRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	var slurm *table.SLURM
+	if r.File != "" {
+		var err error
+		if slurm, err = table.LoadSLURM(r.File); err != nil {
+			return fmt.Errorf("failed to load slurm file %s: %v", r.File, err)
+		}
+	}
+	return s.mgmtOperation(func() error {
+		s.roaTable.SetSLURM(slurm)
+		return nil
+	}, false)
+}
+
 func (s *BgpServer) WatchEvent(ctx context.Context, r *api.WatchEventRequest, fn func(*api.WatchEventResponse)) error {
 	if r == nil {
 		return fmt.Errorf("nil request")
@@ -4246,11 +7550,23 @@ func (s *BgpServer) WatchEvent(ctx context.Context, r *api.WatchEventRequest, fn
 	if len(opts) == 0 {
 		return fmt.Errorf("no events to watch")
 	}
-	w := s.watch(opts...)
-
-	simpleSend := func(paths []*api.Path) {
-		fn(&api.WatchEventResponse{Event: &api.WatchEventResponse_Table{Table: &api.WatchEventResponse_TableEvent{Paths: paths}}})
+	if r.Since != nil || r.SinceSequence != 0 {
+		if s.eventBacklog == nil {
+			return fmt.Errorf("event backlog isn't enabled")
+		}
+		var since time.Time
+		if r.Since != nil {
+			since = r.Since.AsTime()
+		}
+		opts = append(opts, watchSince(since, r.SinceSequence))
+	}
+	if s.eventBacklog != nil {
+		opts = append(opts, watchRecorded())
 	}
+	if r.NotifyReady {
+		opts = append(opts, watchReady())
+	}
+	w := s.watch(opts...)
 
 	go func() {
 		defer func() {
@@ -4259,74 +7575,15 @@ func (s *BgpServer) WatchEvent(ctx context.Context, r *api.WatchEventRequest, fn
 
 		for {
 			select {
-			case ev := <-w.Event():
-				switch msg := ev.(type) {
-				case *watchEventUpdate:
-					paths := make([]*api.Path, 0, r.BatchSize)
-					for _, path := range msg.PathList {
-						paths = append(paths, toPathApi(path, nil, false, false, false))
-						if r.BatchSize > 0 && len(paths) > int(r.BatchSize) {
-							simpleSend(paths)
-							paths = make([]*api.Path, 0, r.BatchSize)
-						}
-					}
-					simpleSend(paths)
-
-				case *watchEventBestPath:
-					var paths []*table.Path
-					if len(msg.MultiPathList) > 0 {
-						for _, p := range msg.MultiPathList {
-							paths = append(paths, p...)
-						}
-					} else {
-						paths = msg.PathList
-					}
-
-					pl := make([]*api.Path, 0, r.BatchSize)
-					for _, path := range paths {
-						pl = append(pl, toPathApi(path, nil, false, false, false))
-						if r.BatchSize > 0 && len(pl) > int(r.BatchSize) {
-							simpleSend(pl)
-							pl = make([]*api.Path, 0, r.BatchSize)
-						}
-					}
-					simpleSend(pl)
-
-				case *watchEventEor:
-					eor := table.NewEOR(msg.Family)
-					eor.SetSource(msg.PeerInfo)
-					path := eorToPathAPI(eor)
-					simpleSend([]*api.Path{path})
-
-				case *watchEventPeer:
-					fn(&api.WatchEventResponse{
-						Event: &api.WatchEventResponse_Peer{
-							Peer: &api.WatchEventResponse_PeerEvent{
-								Type: api.WatchEventResponse_PeerEvent_Type(msg.Type),
-								Peer: &api.Peer{
-									Conf: &api.PeerConf{
-										PeerAsn:           msg.PeerAS,
-										LocalAsn:          msg.LocalAS,
-										NeighborAddress:   msg.PeerAddress.String(),
-										NeighborInterface: msg.PeerInterface,
-									},
-									State: &api.PeerState{
-										PeerAsn:         msg.PeerAS,
-										LocalAsn:        msg.LocalAS,
-										NeighborAddress: msg.PeerAddress.String(),
-										SessionState:    api.PeerState_SessionState(int(msg.State) + 1),
-										AdminState:      api.PeerState_AdminState(msg.AdminState),
-										RouterId:        msg.PeerID.String(),
-									},
-									Transport: &api.Transport{
-										LocalAddress: msg.LocalAddress.String(),
-										LocalPort:    uint32(msg.LocalPort),
-										RemotePort:   uint32(msg.PeerPort),
-									},
-								},
-							},
-						},
-					})
+			case ev, ok := <-w.Event():
+				if !ok {
+					// the server is stopped
+					return
+				}
+				if e, ok := ev.(*eventBacklogEntry); ok {
+					e.toAPI(r.BatchSize, fn)
+				} else {
+					watchEventToAPI(ev, r.BatchSize, fn)
 				}
 			case <-ctx.Done():
 				return
@@ -4336,25 +7593,272 @@ func (s *BgpServer) WatchEvent(ctx context.Context, r *api.WatchEventRequest, fn
 	return nil
 }
 
+// watchEventToAPI converts the event into the responses of WatchEvent, each
+// of which has up to batchSize paths (0 for unlimited).
+func watchEventToAPI(ev watchEvent, batchSize uint32, fn func(*api.WatchEventResponse)) {
+	simpleSend := func(paths []*api.Path) {
+		fn(&api.WatchEventResponse{Event: &api.WatchEventResponse_Table{Table: &api.WatchEventResponse_TableEvent{Paths: paths}}})
+	}
+
+	switch msg := ev.(type) {
+	case *watchEventUpdate:
+		paths := make([]*api.Path, 0, batchSize)
+		for _, path := range msg.PathList {
+			paths = append(paths, toPathApi(path, nil, false, false, false))
+			if batchSize > 0 && len(paths) > int(batchSize) {
+				simpleSend(paths)
+				paths = make([]*api.Path, 0, batchSize)
+			}
+		}
+		simpleSend(paths)
+
+	case *watchEventBestPath:
+		var paths []*table.Path
+		if len(msg.MultiPathList) > 0 {
+			for _, p := range msg.MultiPathList {
+				paths = append(paths, p...)
+			}
+		} else {
+			paths = msg.PathList
+		}
+
+		pl := make([]*api.Path, 0, batchSize)
+		for _, path := range paths {
+			pl = append(pl, toPathApi(path, nil, false, false, false))
+			if batchSize > 0 && len(pl) > int(batchSize) {
+				simpleSend(pl)
+				pl = make([]*api.Path, 0, batchSize)
+			}
+		}
+		simpleSend(pl)
+
+	case *watchEventEor:
+		eor := table.NewEOR(msg.Family)
+		eor.SetSource(msg.PeerInfo)
+		path := eorToPathAPI(eor)
+		simpleSend([]*api.Path{path})
+
+	case *watchEventReady:
+		fn(&api.WatchEventResponse{Event: &api.WatchEventResponse_Ready{Ready: &api.WatchEventResponse_ReadyEvent{}}})
+
+	case *watchEventPeer:
+		afiSafis := make([]*api.AfiSafi, 0, len(msg.Families))
+		for _, f := range msg.Families {
+			family := apiutil.ToApiFamily(bgp.RouteFamilyToAfiSafi(f.Family))
+			afiSafis = append(afiSafis, &api.AfiSafi{
+				Config: &api.AfiSafiConfig{
+					Family:  family,
+					Enabled: true,
+				},
+				State: &api.AfiSafiState{
+					Family:     family,
+					Enabled:    true,
+					Received:   f.Received,
+					Accepted:   f.Accepted,
+					Advertised: f.Advertised,
+				},
+			})
+		}
+		localCap, _ := apiutil.MarshalCapabilities(msg.LocalCapabilities)
+		remoteCap, _ := apiutil.MarshalCapabilities(msg.RemoteCapabilities)
+		fn(&api.WatchEventResponse{
+			Event: &api.WatchEventResponse_Peer{
+				Peer: &api.WatchEventResponse_PeerEvent{
+					Type: api.WatchEventResponse_PeerEvent_Type(msg.Type),
+					Peer: &api.Peer{
+						Conf: &api.PeerConf{
+							PeerAsn:           msg.PeerAS,
+							LocalAsn:          msg.LocalAS,
+							NeighborAddress:   msg.PeerAddress.String(),
+							NeighborInterface: msg.PeerInterface,
+						},
+						State: &api.PeerState{
+							PeerAsn:         msg.PeerAS,
+							LocalAsn:        msg.LocalAS,
+							NeighborAddress: msg.PeerAddress.String(),
+							SessionState:    api.PeerState_SessionState(int(msg.State) + 1),
+							AdminState:      api.PeerState_AdminState(msg.AdminState),
+							RouterId:        msg.PeerID.String(),
+							LocalCap:        localCap,
+							RemoteCap:       remoteCap,
+						},
+						AfiSafis: afiSafis,
+						Transport: &api.Transport{
+							LocalAddress: msg.LocalAddress.String(),
+							LocalPort:    uint32(msg.LocalPort),
+							RemotePort:   uint32(msg.PeerPort),
+						},
+					},
+				},
+			},
+		})
+	}
+}
+
+// SetLogLevel sets the global log level, or the level of the module.
 func (s *BgpServer) SetLogLevel(ctx context.Context, r *api.SetLogLevelRequest) error {
-	oldLevel := uint32(s.logger.GetLevel())
-	newLevel := uint32(r.Level)
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	oldLevel := s.logLevels.Level(r.Module)
+	if r.Unset {
+		if r.Module == "" {
+			return fmt.Errorf("global log level can't be unset")
+		}
+		if err := s.logLevels.UnsetLevel(r.Module); err != nil {
+			return err
+		}
+		s.logger.Info("Logging level unset",
+			log.Fields{
+				"Topic":    "Config",
+				"Module":   r.Module,
+				"OldLevel": oldLevel,
+				"NewLevel": s.logLevels.Level(r.Module)})
+		return nil
+	}
+	newLevel := log.LogLevel(r.Level)
+	if err := s.logLevels.SetLevel(r.Module, newLevel); err != nil {
+		return err
+	}
 	if oldLevel == newLevel {
 		s.logger.Info("Logging level unchanged",
 			log.Fields{
 				"Topic":    "Config",
+				"Module":   r.Module,
 				"OldLevel": oldLevel})
 	} else {
-		s.logger.SetLevel(log.LogLevel(newLevel))
 		s.logger.Info("Logging level changed",
 			log.Fields{
 				"Topic":    "Config",
+				"Module":   r.Module,
 				"OldLevel": oldLevel,
 				"NewLevel": newLevel})
 	}
 	return nil
 }
 
+// GetLogLevel returns the global log level and the levels set to the
+// modules.
+func (s *BgpServer) GetLogLevel(ctx context.Context, r *api.GetLogLevelRequest) (*api.GetLogLevelResponse, error) {
+	level, modules := s.logLevels.Levels()
+	rsp := &api.GetLogLevelResponse{
+		Level:   api.SetLogLevelRequest_Level(level),
+		Modules: make(map[string]api.SetLogLevelRequest_Level, len(modules)),
+	}
+	for module, l := range modules {
+		rsp.Modules[module] = api.SetLogLevelRequest_Level(l)
+	}
+	return rsp, nil
+}
+
+// StartDebugCapture starts recording the BGP messages sent to and received
+// from the neighbor, to troubleshoot the neighbor without the debug logging.
+// The messages are written also to the file in pcapng or the raw format if
+// specified.
+func (s *BgpServer) StartDebugCapture(ctx context.Context, r *api.StartDebugCaptureRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	if r.Size > debugCaptureMaxSize {
+		return fmt.Errorf("size must be less than or equal to %d", debugCaptureMaxSize)
+	}
+	return s.mgmtOperation(func() error {
+		peer, ok := s.neighborMap[r.Address]
+		if !ok {
+			return fmt.Errorf("neighbor that has %v doesn't exist", r.Address)
+		}
+		var file *captureFile
+		if r.File != "" {
+			for _, p := range s.neighborMap {
+				if p == peer {
+					continue
+				}
+				p.fsm.lock.RLock()
+				c := p.fsm.capture
+				p.fsm.lock.RUnlock()
+				if c != nil && c.fileName() == r.File {
+					return fmt.Errorf("file %s is used by debug capture of %s", r.File, p.ID())
+				}
+			}
+			var err error
+			if file, err = newCaptureFile(r.File, r.Format, r.RotationSize); err != nil {
+				return err
+			}
+		}
+		peer.fsm.lock.Lock()
+		old := peer.fsm.capture
+		peer.fsm.capture = newDebugCapture(s.logger, r.Address, int(r.Size), file)
+		peer.fsm.lock.Unlock()
+		if old != nil {
+			old.close()
+		}
+		s.logger.Info("debug capture started",
+			log.Fields{
+				"Topic": "Peer",
+				"Key":   r.Address,
+				"Size":  r.Size,
+				"File":  r.File})
+		return nil
+	}, true)
+}
+
+func (s *BgpServer) StopDebugCapture(ctx context.Context, r *api.StopDebugCaptureRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		peer, ok := s.neighborMap[r.Address]
+		if !ok {
+			return fmt.Errorf("neighbor that has %v doesn't exist", r.Address)
+		}
+		peer.fsm.lock.Lock()
+		c := peer.fsm.capture
+		peer.fsm.capture = nil
+		peer.fsm.lock.Unlock()
+		if c == nil {
+			return fmt.Errorf("debug capture of %v isn't started", r.Address)
+		}
+		c.close()
+		s.logger.Info("debug capture stopped",
+			log.Fields{
+				"Topic": "Peer",
+				"Key":   r.Address})
+		return nil
+	}, true)
+}
+
+func (s *BgpServer) ListDebugCapture(ctx context.Context, r *api.ListDebugCaptureRequest, fn func(*api.DebugCaptureMessage)) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	var c *debugCapture
+	err := s.mgmtOperation(func() error {
+		peer, ok := s.neighborMap[r.Address]
+		if !ok {
+			return fmt.Errorf("neighbor that has %v doesn't exist", r.Address)
+		}
+		peer.fsm.lock.RLock()
+		c = peer.fsm.capture
+		peer.fsm.lock.RUnlock()
+		if c == nil {
+			return fmt.Errorf("debug capture of %v isn't started", r.Address)
+		}
+		return nil
+	}, true)
+	if err != nil {
+		return err
+	}
+	for _, m := range c.list() {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+			fn(m.toApi())
+		}
+	}
+	return nil
+}
+
 func (s *BgpServer) Log() log.Logger {
 	return s.logger
 }
@@ -4416,6 +7920,18 @@ type watchEventPeer struct {
 	AdminState    adminState
 	Timestamp     time.Time
 	PeerInterface string
+	// the counters of the paths per family and the negotiated
+	// capabilities, set only if the event is watched or recorded
+	Families           []*watchEventPeerFamily
+	LocalCapabilities  []bgp.ParameterCapabilityInterface
+	RemoteCapabilities []bgp.ParameterCapabilityInterface
+}
+
+type watchEventPeerFamily struct {
+	Family     bgp.RouteFamily
+	Received   uint64
+	Accepted   uint64
+	Advertised uint64
 }
 
 type watchEventAdjIn struct {
@@ -4435,7 +7951,11 @@ type watchEventBestPath struct {
 }
 
 type watchEventMessage struct {
-	Message      *bgp.BGPMessage
+	// Message is nil if the malformed message resets the session.
+	Message *bgp.BGPMessage
+	// Payload is the message as received.
+	Payload      []byte
+	ErroredPDU   bool
 	PeerAS       uint32
 	LocalAS      uint32
 	PeerAddress  net.IP
@@ -4451,6 +7971,10 @@ type watchEventEor struct {
 	PeerInfo *table.PeerInfo
 }
 
+// watchEventReady follows the initial and replayed events, which are
+// generated atomically with registering the watcher.
+type watchEventReady struct{}
+
 type watchOptions struct {
 	bestPath         bool
 	preUpdate        bool
@@ -4466,6 +7990,11 @@ type watchOptions struct {
 	recvMessage    bool
 	initEor        bool
 	eor            bool
+	replay         bool
+	since          time.Time
+	sinceSequence  uint64
+	recorded       bool
+	ready          bool
 }
 
 type watchOption func(*watchOptions)
@@ -4542,6 +8071,28 @@ func watchPeer() watchOption {
 	}
 }
 
+func watchSince(t time.Time, sequence uint64) watchOption {
+	return func(o *watchOptions) {
+		o.replay = true
+		o.since = t
+		o.sinceSequence = sequence
+	}
+}
+
+func watchReady() watchOption {
+	return func(o *watchOptions) {
+		o.ready = true
+	}
+}
+
+// watchRecorded makes the watcher receive the events recorded in the event
+// backlog as *eventBacklogEntry to know their sequence numbers.
+func watchRecorded() watchOption {
+	return func(o *watchOptions) {
+		o.recorded = true
+	}
+}
+
 func watchTableName(name string) watchOption {
 	return func(o *watchOptions) {
 		o.tableName = name
@@ -4566,6 +8117,8 @@ type watcher struct {
 	realCh chan watchEvent
 	ch     *channels.InfiniteChannel
 	s      *BgpServer
+	// set by stop, which may be called by both Stop and BgpServer.Stop
+	stopped bool
 	// filters are used for notifyWatcher by using the filter for the given watchEvent,
 	// call notify method for skipping filtering.
 	filters map[watchEventType]func(w watchEvent) bool
@@ -4648,16 +8201,23 @@ func (w *watcher) Stop() {
 				}
 			}
 		}
-
-		cleanInfiniteChannel(w.ch)
-		// the loop function goroutine might be blocked for
-		// writing to realCh. make sure it finishes.
-		for range w.realCh {
-		}
+		w.stop()
 		return nil
 	}, false)
 }
 
+func (w *watcher) stop() {
+	if w.stopped {
+		return
+	}
+	w.stopped = true
+	cleanInfiniteChannel(w.ch)
+	// the loop function goroutine might be blocked for
+	// writing to realCh. make sure it finishes.
+	for range w.realCh {
+	}
+}
+
 func (s *BgpServer) isWatched(typ watchEventType) bool {
 	return len(s.watcherMap[typ]) != 0
 }
@@ -4666,17 +8226,25 @@ func (s *BgpServer) isWatched(typ watchEventType) bool {
 // If the filter is set(and not nil) for the watchEventType, it will be used for filtering.
 // Otherwise, all events will be processed without any filtering.
 func (s *BgpServer) notifyWatcher(typ watchEventType, ev watchEvent) {
+	var entry *eventBacklogEntry
+	if s.eventBacklog != nil && isBacklogEventType(typ) {
+		entry = s.eventBacklog.record(time.Now(), typ, ev)
+	}
 	for _, w := range s.watcherMap[typ] {
 		if f := w.filters[typ]; f != nil && !f(ev) {
 			// Filter is set and the event doesn't pass it.
 			continue
 		}
-		w.notify(ev)
+		if entry != nil && w.opts.recorded {
+			w.notify(entry)
+		} else {
+			w.notify(ev)
+		}
 	}
 }
 
 func (s *BgpServer) watch(opts ...watchOption) (w *watcher) {
-	s.mgmtOperation(func() error {
+	if err := s.mgmtOperation(func() error {
 		w = &watcher{
 			s:       s,
 			realCh:  make(chan watchEvent, 8),
@@ -4688,6 +8256,14 @@ func (s *BgpServer) watch(opts ...watchOption) (w *watcher) {
 			opt(&w.opts)
 		}
 
+		if w.opts.replay && s.eventBacklog != nil {
+			for _, e := range s.eventBacklog.since(w.opts.since, w.opts.sinceSequence) {
+				if (e.typ == watchEventTypeBestPath && w.opts.bestPath) || (e.typ == watchEventTypePeerState && w.opts.peerState) {
+					w.notify(e)
+				}
+			}
+		}
+
 		register := func(t watchEventType, w *watcher) {
 			s.watcherMap[t] = append(s.watcherMap[t], w)
 		}
@@ -4712,7 +8288,9 @@ func (s *BgpServer) watch(opts ...watchOption) (w *watcher) {
 		}
 		if w.opts.peerState {
 			for _, p := range s.neighborMap {
-				w.notify(newWatchEventPeer(p, nil, p.fsm.state, PEER_EVENT_INIT))
+				ev := newWatchEventPeer(p, nil, p.fsm.state, PEER_EVENT_INIT)
+				s.setWatchEventPeerStatistics(ev, p)
+				w.notify(ev)
 			}
 			w.notify(&watchEventPeer{Type: PEER_EVENT_END_OF_INIT})
 
@@ -4858,9 +8436,20 @@ func (s *BgpServer) watch(opts ...watchOption) (w *watcher) {
 		if w.opts.recvMessage {
 			register(watchEventTypeRecvMsg, w)
 		}
+		if w.opts.ready {
+			w.notify(&watchEventReady{})
+		}
 
 		go w.loop()
 		return nil
-	}, false)
+	}, false); err != nil {
+		// the server has been stopped, no event is delivered
+		w = &watcher{
+			s:       s,
+			realCh:  make(chan watchEvent),
+			stopped: true,
+		}
+		close(w.realCh)
+	}
 	return w
 }
@@ -17,8 +17,11 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
 	"runtime"
 	"sort"
 	"strconv"
@@ -28,6 +31,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 	apb "google.golang.org/protobuf/types/known/anypb"
 
 	api "github.com/osrg/gobgp/v3/api"
@@ -82,6 +86,78 @@ func TestStop(t *testing.T) {
 	s.StopBgp(context.Background(), &api.StopBgpRequest{})
 }
 
+func TestServeContext(t *testing.T) {
+	assert := assert.New(t)
+
+	before := runtime.NumGoroutine()
+	for i := 0; i < 3; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		s := NewBgpServer(GrpcListenAddress("127.0.0.1:0"), EventBacklogSize(8))
+		errCh := make(chan error)
+		go func() {
+			errCh <- s.ServeContext(ctx)
+		}()
+		// the listeners of the previous servers must be closed
+		err := s.StartBgp(ctx, &api.StartBgpRequest{
+			Global: &api.Global{
+				Asn:        1,
+				RouterId:   "1.1.1.1",
+				ListenPort: 10192,
+			},
+		})
+		assert.NoError(err)
+		assert.NoError(s.StopBgp(ctx, &api.StopBgpRequest{}))
+		err = s.StartBgp(ctx, &api.StartBgpRequest{
+			Global: &api.Global{
+				Asn:        1,
+				RouterId:   "1.1.1.1",
+				ListenPort: 10192,
+			},
+		})
+		assert.NoError(err)
+		assert.NoError(s.AddPeer(ctx, &api.AddPeerRequest{
+			Peer: &api.Peer{
+				Conf: &api.PeerConf{
+					NeighborAddress: "127.0.0.2",
+					PeerAsn:         2,
+				},
+			},
+		}))
+		assert.NoError(s.EnableMrt(ctx, &api.EnableMrtRequest{
+			Type:     api.EnableMrtRequest_UPDATES,
+			Filename: filepath.Join(t.TempDir(), "updates.dump"),
+		}))
+		// the watcher is never canceled by the context
+		assert.NoError(s.WatchEvent(context.Background(), &api.WatchEventRequest{
+			Peer: &api.WatchEventRequest_Peer{},
+		}, func(*api.WatchEventResponse) {}))
+
+		if i%2 == 0 {
+			cancel()
+			assert.Equal(context.Canceled, <-errCh)
+		} else {
+			s.Stop()
+			assert.NoError(<-errCh)
+			cancel()
+		}
+		s.Stop()
+		assert.Error(s.AddPeer(context.Background(), &api.AddPeerRequest{
+			Peer: &api.Peer{
+				Conf: &api.PeerConf{
+					NeighborAddress: "127.0.0.3",
+					PeerAsn:         3,
+				},
+			},
+		}))
+		assert.Error(s.ServeContext(context.Background()))
+	}
+	// assert.Eventually can't be used as it runs its own goroutines
+	for i := 0; i < 500 && runtime.NumGoroutine() > before; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.LessOrEqual(runtime.NumGoroutine(), before)
+}
+
 func TestModPolicyAssign(t *testing.T) {
 	assert := assert.New(t)
 	s := NewBgpServer()
@@ -216,6 +292,470 @@ func TestListPolicyAssignment(t *testing.T) {
 	assert.Equal(4, len(ps))
 }
 
+func TestReplacePolicies(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.StartBgp(ctx, &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        1,
+			RouterId:   "1.1.1.1",
+			ListenPort: -1,
+		},
+	})
+	assert.NoError(err)
+	defer s.StopBgp(ctx, &api.StopBgpRequest{})
+
+	for i := 1; i < 3; i++ {
+		err = s.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{
+			Conf: &api.PeerConf{
+				NeighborAddress: "127.0.0." + strconv.Itoa(i),
+				PeerAsn:         uint32(i + 1),
+			},
+			RouteServer: &api.RouteServer{
+				RouteServerClient: true,
+			},
+		}})
+		assert.NoError(err)
+	}
+	err = s.AddDefinedSet(ctx, &api.AddDefinedSetRequest{DefinedSet: &api.DefinedSet{
+		DefinedType: api.DefinedType_NEIGHBOR,
+		Name:        "old",
+		List:        []string{"10.0.0.1/32"},
+	}})
+	assert.NoError(err)
+	err = s.AddPolicy(ctx, &api.AddPolicyRequest{Policy: &api.Policy{Name: "old"}})
+	assert.NoError(err)
+	err = s.AddPolicyAssignment(ctx, &api.AddPolicyAssignmentRequest{Assignment: &api.PolicyAssignment{
+		Name:          "127.0.0.2",
+		Direction:     api.PolicyDirection_IMPORT,
+		Policies:      []*api.Policy{{Name: "old"}},
+		DefaultAction: api.RouteAction_REJECT,
+	}})
+	assert.NoError(err)
+
+	type snapshot struct {
+		sets        []string
+		policies    []string
+		assignments map[string][]string
+	}
+	current := func() snapshot {
+		ss := snapshot{assignments: make(map[string][]string)}
+		s.ListDefinedSet(ctx, &api.ListDefinedSetRequest{DefinedType: api.DefinedType_PREFIX}, func(d *api.DefinedSet) {
+			ss.sets = append(ss.sets, d.Name)
+		})
+		s.ListDefinedSet(ctx, &api.ListDefinedSetRequest{DefinedType: api.DefinedType_NEIGHBOR}, func(d *api.DefinedSet) {
+			ss.sets = append(ss.sets, d.Name)
+		})
+		s.ListPolicy(ctx, &api.ListPolicyRequest{}, func(p *api.Policy) {
+			ss.policies = append(ss.policies, p.Name)
+		})
+		s.ListPolicyAssignment(ctx, &api.ListPolicyAssignmentRequest{}, func(a *api.PolicyAssignment) {
+			key := a.Name + " " + a.Direction.String() + " " + a.DefaultAction.String()
+			for _, p := range a.Policies {
+				ss.assignments[key] = append(ss.assignments[key], p.Name)
+			}
+			if len(a.Policies) == 0 {
+				ss.assignments[key] = nil
+			}
+		})
+		return ss
+	}
+	before := current()
+	assert.Equal([]string{"old"}, before.policies)
+	assert.Equal([]string{"old"}, before.assignments["127.0.0.2 IMPORT REJECT"])
+
+	p1 := &api.Policy{
+		Name: "p1",
+		Statements: []*api.Statement{{
+			Name:       "s1",
+			Conditions: &api.Conditions{PrefixSet: &api.MatchSet{Name: "d1"}},
+			Actions:    &api.Actions{RouteAction: api.RouteAction_REJECT},
+		}},
+	}
+	d1 := &api.DefinedSet{
+		DefinedType: api.DefinedType_PREFIX,
+		Name:        "d1",
+		Prefixes:    []*api.Prefix{{IpPrefix: "10.1.0.0/24", MaskLengthMin: 24, MaskLengthMax: 24}},
+	}
+	req := &api.ReplacePoliciesRequest{
+		DefinedSets: []*api.DefinedSet{d1},
+		Policies:    []*api.Policy{p1},
+		Assignments: []*api.PolicyAssignment{
+			{
+				Name:          table.GLOBAL_RIB_NAME,
+				Direction:     api.PolicyDirection_EXPORT,
+				Policies:      []*api.Policy{{Name: "p1"}},
+				DefaultAction: api.RouteAction_ACCEPT,
+			},
+			{
+				Name:          "127.0.0.1",
+				Direction:     api.PolicyDirection_IMPORT,
+				Policies:      []*api.Policy{{Name: "p1"}},
+				DefaultAction: api.RouteAction_REJECT,
+			},
+		},
+	}
+
+	// nothing is changed by the invalid requests
+	invalid := func(f func(r *api.ReplacePoliciesRequest)) {
+		r := proto.Clone(req).(*api.ReplacePoliciesRequest)
+		f(r)
+		assert.Error(s.ReplacePolicies(ctx, r))
+		assert.Equal(before, current())
+	}
+	invalid(func(r *api.ReplacePoliciesRequest) {
+		r.DefinedSets = nil
+	})
+	invalid(func(r *api.ReplacePoliciesRequest) {
+		r.Assignments[1].Policies[0].Name = "old"
+	})
+	invalid(func(r *api.ReplacePoliciesRequest) {
+		r.Assignments[1].Name = "127.0.0.3"
+	})
+	invalid(func(r *api.ReplacePoliciesRequest) {
+		r.Assignments = append(r.Assignments, r.Assignments[0])
+	})
+	invalid(func(r *api.ReplacePoliciesRequest) {
+		r.DryRun = true
+		r.Policies = nil
+	})
+	req.DryRun = true
+	assert.NoError(s.ReplacePolicies(ctx, req))
+	assert.Equal(before, current())
+
+	req.DryRun = false
+	assert.NoError(s.ReplacePolicies(ctx, req))
+	after := current()
+	assert.Equal([]string{"d1"}, after.sets)
+	assert.Equal([]string{"p1"}, after.policies)
+	assert.Equal(map[string][]string{
+		"global IMPORT ACCEPT":    nil,
+		"global EXPORT ACCEPT":    {"p1"},
+		"127.0.0.1 IMPORT REJECT": {"p1"},
+		"127.0.0.1 EXPORT ACCEPT": nil,
+		"127.0.0.2 IMPORT ACCEPT": nil,
+		"127.0.0.2 EXPORT ACCEPT": nil,
+	}, after.assignments)
+}
+
+func TestReplacePolicyAssignment(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s1 := runNewServer(t, 1, "1.1.1.1", 10192)
+	defer s1.StopBgp(ctx, &api.StopBgpRequest{})
+	s2 := runNewServer(t, 2, "2.2.2.2", 20192)
+	defer s2.StopBgp(ctx, &api.StopBgpRequest{})
+
+	if err := peerServers(t, ctx, []*BgpServer{s1, s2}, []oc.AfiSafiType{oc.AFI_SAFI_TYPE_IPV4_UNICAST}); err != nil {
+		t.Fatal(err)
+	}
+	for _, prefix := range []string{"10.0.0.0", "10.1.0.0"} {
+		path, _ := apiutil.NewPath(bgp.NewIPAddrPrefix(24, prefix), false, []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop("2.2.2.2"),
+		}, time.Now())
+		_, err := s2.AddPath(ctx, &api.AddPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Path:      path,
+		})
+		assert.NoError(err)
+	}
+	countPaths := func() int {
+		n := 0
+		s1.ListPath(ctx, &api.ListPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Family:    &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST},
+		}, func(d *api.Destination) {
+			n += len(d.Paths)
+		})
+		return n
+	}
+	assert.Eventually(func() bool {
+		return countPaths() == 2
+	}, 10*time.Second, 100*time.Millisecond)
+
+	err := s1.AddDefinedSet(ctx, &api.AddDefinedSetRequest{DefinedSet: &api.DefinedSet{
+		DefinedType: api.DefinedType_PREFIX,
+		Name:        "d1",
+		Prefixes: []*api.Prefix{
+			{
+				IpPrefix:      "10.1.0.0/24",
+				MaskLengthMax: 24,
+				MaskLengthMin: 24,
+			},
+		},
+	}})
+	assert.NoError(err)
+	p1 := &api.Policy{
+		Name: "p1",
+		Statements: []*api.Statement{{
+			Name:       "s1",
+			Conditions: &api.Conditions{PrefixSet: &api.MatchSet{Name: "d1"}},
+			Actions:    &api.Actions{RouteAction: api.RouteAction_REJECT},
+		}},
+	}
+	err = s1.AddPolicy(ctx, &api.AddPolicyRequest{Policy: p1})
+	assert.NoError(err)
+
+	req := &api.ReplacePolicyAssignmentRequest{
+		Assignment: &api.PolicyAssignment{
+			Name:          table.GLOBAL_RIB_NAME,
+			Direction:     api.PolicyDirection_IMPORT,
+			Policies:      []*api.Policy{p1},
+			DefaultAction: api.RouteAction_ACCEPT,
+		},
+		DryRun: true,
+	}
+	rsp, err := s1.ReplacePolicyAssignment(ctx, req)
+	assert.NoError(err)
+	assert.Equal(uint64(0), rsp.Accepted)
+	assert.Equal(uint64(1), rsp.Rejected)
+	assert.Equal(uint64(0), rsp.Modified)
+	// dry-run leaves the assignment and the routes unchanged
+	var ps []*api.PolicyAssignment
+	err = s1.ListPolicyAssignment(ctx, &api.ListPolicyAssignmentRequest{
+		Name:      table.GLOBAL_RIB_NAME,
+		Direction: api.PolicyDirection_IMPORT,
+	}, func(p *api.PolicyAssignment) { ps = append(ps, p) })
+	assert.NoError(err)
+	assert.Len(ps[0].Policies, 0)
+	assert.Equal(2, countPaths())
+
+	req.DryRun = false
+	rsp, err = s1.ReplacePolicyAssignment(ctx, req)
+	assert.NoError(err)
+	assert.Equal(uint64(1), rsp.Rejected)
+	assert.Equal(1, countPaths())
+
+	// nothing changes with the same assignment
+	req.DryRun = true
+	rsp, err = s1.ReplacePolicyAssignment(ctx, req)
+	assert.NoError(err)
+	assert.Equal(uint64(0), rsp.Accepted+rsp.Rejected+rsp.Modified)
+
+	req.Assignment.Policies = []*api.Policy{{Name: "unknown"}}
+	_, err = s1.ReplacePolicyAssignment(ctx, req)
+	assert.Error(err)
+}
+
+func TestSimulatePolicy(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s := runNewServer(t, 1, "1.1.1.1", -1)
+	defer s.StopBgp(ctx, &api.StopBgpRequest{})
+
+	path, _ := apiutil.NewPath(bgp.NewIPAddrPrefix(24, "10.0.0.0"), false, []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeNextHop("10.0.0.1"),
+	}, time.Now())
+	_, err := s.AddPath(ctx, &api.AddPathRequest{
+		TableType: api.TableType_GLOBAL,
+		Path:      path,
+	})
+	assert.NoError(err)
+
+	p1 := &api.Policy{
+		Name: "p1",
+		Statements: []*api.Statement{{
+			Name: "s1",
+			Actions: &api.Actions{
+				RouteAction: api.RouteAction_REJECT,
+				LocalPref:   &api.LocalPrefAction{Value: 200},
+			},
+		}},
+	}
+	err = s.AddPolicy(ctx, &api.AddPolicyRequest{Policy: p1})
+	assert.NoError(err)
+
+	// the policy on its own with the best path in the global RIB
+	rsp, err := s.SimulatePolicy(ctx, &api.SimulatePolicyRequest{
+		TableType:  api.TableType_GLOBAL,
+		Family:     &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST},
+		Prefix:     "10.0.0.0/24",
+		Assignment: &api.PolicyAssignment{Policies: []*api.Policy{{Name: "p1"}}},
+	})
+	assert.NoError(err)
+	assert.Equal(api.RouteAction_REJECT, rsp.Action)
+	assert.False(rsp.DefaultAction)
+	assert.Len(rsp.Statements, 1)
+	assert.True(rsp.Statements[0].Matched)
+	assert.Len(rsp.Statements[0].Actions, 2)
+	assert.Nil(rsp.Path)
+
+	// the current global import policies with the given path
+	rsp, err = s.SimulatePolicy(ctx, &api.SimulatePolicyRequest{
+		Path: path,
+		Assignment: &api.PolicyAssignment{
+			Name:      table.GLOBAL_RIB_NAME,
+			Direction: api.PolicyDirection_IMPORT,
+		},
+	})
+	assert.NoError(err)
+	assert.Equal(api.RouteAction_ACCEPT, rsp.Action)
+	assert.True(rsp.DefaultAction)
+	assert.Len(rsp.Statements, 0)
+	assert.NotNil(rsp.Path)
+
+	_, err = s.SimulatePolicy(ctx, &api.SimulatePolicyRequest{
+		TableType:  api.TableType_GLOBAL,
+		Family:     &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST},
+		Prefix:     "10.1.0.0/24",
+		Assignment: &api.PolicyAssignment{Policies: []*api.Policy{{Name: "p1"}}},
+	})
+	assert.Error(err)
+
+	// the RIB is left unchanged
+	n := 0
+	s.ListPath(ctx, &api.ListPathRequest{
+		TableType: api.TableType_GLOBAL,
+		Family:    &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST},
+	}, func(d *api.Destination) {
+		n += len(d.Paths)
+	})
+	assert.Equal(1, n)
+}
+
+func TestUpdateDefinedSet(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s1 := runNewServer(t, 1, "1.1.1.1", 10193)
+	defer s1.StopBgp(ctx, &api.StopBgpRequest{})
+	s2 := runNewServer(t, 2, "2.2.2.2", 20193)
+	defer s2.StopBgp(ctx, &api.StopBgpRequest{})
+
+	if err := peerServers(t, ctx, []*BgpServer{s1, s2}, []oc.AfiSafiType{oc.AFI_SAFI_TYPE_IPV4_UNICAST}); err != nil {
+		t.Fatal(err)
+	}
+	for _, prefix := range []string{"10.0.0.0", "10.1.0.0"} {
+		path, _ := apiutil.NewPath(bgp.NewIPAddrPrefix(24, prefix), false, []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop("2.2.2.2"),
+		}, time.Now())
+		_, err := s2.AddPath(ctx, &api.AddPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Path:      path,
+		})
+		assert.NoError(err)
+	}
+	countPaths := func() int {
+		n := 0
+		s1.ListPath(ctx, &api.ListPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Family:    &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST},
+		}, func(d *api.Destination) {
+			n += len(d.Paths)
+		})
+		return n
+	}
+	assert.Eventually(func() bool {
+		return countPaths() == 2
+	}, 10*time.Second, 100*time.Millisecond)
+
+	reject := func(s *BgpServer, dir api.PolicyDirection) {
+		err := s.AddDefinedSet(ctx, &api.AddDefinedSetRequest{DefinedSet: &api.DefinedSet{
+			DefinedType: api.DefinedType_PREFIX,
+			Name:        "d1",
+			Prefixes:    []*api.Prefix{{IpPrefix: "10.9.0.0/24", MaskLengthMax: 24, MaskLengthMin: 24}},
+		}})
+		assert.NoError(err)
+		p1 := &api.Policy{
+			Name: "p1",
+			Statements: []*api.Statement{{
+				Name:       "s1",
+				Conditions: &api.Conditions{PrefixSet: &api.MatchSet{Name: "d1"}},
+				Actions:    &api.Actions{RouteAction: api.RouteAction_REJECT},
+			}},
+		}
+		err = s.AddPolicy(ctx, &api.AddPolicyRequest{Policy: p1})
+		assert.NoError(err)
+		err = s.AddPolicyAssignment(ctx, &api.AddPolicyAssignmentRequest{Assignment: &api.PolicyAssignment{
+			Name:          table.GLOBAL_RIB_NAME,
+			Direction:     dir,
+			Policies:      []*api.Policy{p1},
+			DefaultAction: api.RouteAction_ACCEPT,
+		}})
+		assert.NoError(err)
+	}
+	prefixes := []*api.Prefix{{IpPrefix: "10.1.0.0/24", MaskLengthMax: 24, MaskLengthMin: 24}}
+
+	// import policy
+	reject(s1, api.PolicyDirection_IMPORT)
+	var sets []*api.DefinedSet
+	err := s1.ListDefinedSet(ctx, &api.ListDefinedSetRequest{
+		DefinedType: api.DefinedType_PREFIX,
+		Name:        "d1",
+	}, func(d *api.DefinedSet) { sets = append(sets, d) })
+	assert.NoError(err)
+	assert.Len(sets, 1)
+	version := sets[0].Version
+
+	rsp, err := s1.UpdateDefinedSet(ctx, &api.UpdateDefinedSetRequest{
+		DefinedType: api.DefinedType_PREFIX,
+		Name:        "d1",
+		AddPrefixes: prefixes,
+		Version:     version,
+	})
+	assert.NoError(err)
+	assert.Equal(version+1, rsp.Version)
+	// only the path matching the added prefix is evaluated
+	assert.Equal(uint64(1), rsp.Reevaluated)
+	assert.Equal(1, countPaths())
+
+	// the set was updated after the version
+	_, err = s1.UpdateDefinedSet(ctx, &api.UpdateDefinedSetRequest{
+		DefinedType:    api.DefinedType_PREFIX,
+		Name:           "d1",
+		RemovePrefixes: prefixes,
+		Version:        version,
+	})
+	assert.Error(err)
+	assert.Equal(1, countPaths())
+
+	rsp, err = s1.UpdateDefinedSet(ctx, &api.UpdateDefinedSetRequest{
+		DefinedType:    api.DefinedType_PREFIX,
+		Name:           "d1",
+		RemovePrefixes: prefixes,
+	})
+	assert.NoError(err)
+	assert.Equal(version+2, rsp.Version)
+	assert.Equal(2, countPaths())
+
+	// export policy
+	reject(s2, api.PolicyDirection_EXPORT)
+	rsp, err = s2.UpdateDefinedSet(ctx, &api.UpdateDefinedSetRequest{
+		DefinedType: api.DefinedType_PREFIX,
+		Name:        "d1",
+		AddPrefixes: prefixes,
+	})
+	assert.NoError(err)
+	assert.Equal(uint64(1), rsp.Reevaluated)
+	assert.Eventually(func() bool {
+		return countPaths() == 1
+	}, 10*time.Second, 100*time.Millisecond)
+
+	rsp, err = s2.UpdateDefinedSet(ctx, &api.UpdateDefinedSetRequest{
+		DefinedType:    api.DefinedType_PREFIX,
+		Name:           "d1",
+		RemovePrefixes: prefixes,
+	})
+	assert.NoError(err)
+	assert.Eventually(func() bool {
+		return countPaths() == 2
+	}, 10*time.Second, 100*time.Millisecond)
+
+	_, err = s1.UpdateDefinedSet(ctx, &api.UpdateDefinedSetRequest{
+		DefinedType: api.DefinedType_PREFIX,
+		Name:        "d1",
+	})
+	assert.Error(err)
+}
+
 func waitState(s *BgpServer, ch chan struct{}, state api.PeerState_SessionState) {
 	watchCtx, watchCancel := context.WithCancel(context.Background())
 	s.WatchEvent(watchCtx, &api.WatchEventRequest{Peer: &api.WatchEventRequest_Peer{}}, func(r *api.WatchEventResponse) {
@@ -956,6 +1496,58 @@ func newPeerandInfo(myAs, as uint32, address string, rib *table.TableManager) (*
 	return p, &table.PeerInfo{AS: as, Address: net.ParseIP(address), ID: net.ParseIP(address)}
 }
 
+func TestHandleUpdateConfedASLoop(t *testing.T) {
+	assert := assert.New(t)
+	g := &oc.Global{
+		Config: oc.GlobalConfig{As: 65001, RouterId: "10.0.0.1"},
+		Confederation: oc.Confederation{Config: oc.ConfederationConfig{
+			Enabled:      true,
+			Identifier:   30,
+			MemberAsList: []uint32{65002},
+		}},
+	}
+	rib := table.NewTableManager(logger, []bgp.RouteFamily{bgp.RF_IPv4_UC})
+	policy := table.NewRoutingPolicy(logger)
+	policy.Reset(&oc.RoutingPolicy{}, nil)
+	newConfedPeer := func(peerAs uint32, address string) (*peer, *table.PeerInfo) {
+		n := &oc.Neighbor{Config: oc.NeighborConfig{PeerAs: peerAs, NeighborAddress: address}}
+		assert.NoError(oc.SetDefaultNeighborConfigValues(n, nil, g))
+		return newPeer(g, n, rib, policy, logger), &table.PeerInfo{AS: peerAs, Address: net.ParseIP(address)}
+	}
+	handle := func(p *peer, pi *table.PeerInfo, segments ...bgp.AsPathParamInterface) bool {
+		path := table.NewPath(pi, bgp.NewIPAddrPrefix(24, "10.10.10.0"), false, []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeAsPath(segments),
+			bgp.NewPathAttributeNextHop(pi.Address.String()),
+		}, time.Now(), false)
+		paths, _, _ := p.handleUpdate(&fsmMsg{
+			MsgData:  bgp.NewBGPUpdateMessage(nil, nil, nil),
+			PathList: []*table.Path{path},
+		}, func([]*table.Path, []table.UpdateType) {})
+		return len(paths) == 1
+	}
+	seq := func(as ...uint32) bgp.AsPathParamInterface {
+		return bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_SEQ, as)
+	}
+	confedSeq := func(as ...uint32) bgp.AsPathParamInterface {
+		return bgp.NewAs4PathParam(bgp.BGP_ASPATH_ATTR_TYPE_CONFED_SEQ, as)
+	}
+
+	// the confederation peer in the other member AS
+	p, pi := newConfedPeer(65002, "10.0.0.2")
+	assert.True(handle(p, pi, confedSeq(65002), seq(100)))
+	// the member AS in the ordinary segment is another AS out of the
+	// confederation
+	assert.True(handle(p, pi, confedSeq(65002), seq(100, 65001)))
+	assert.False(handle(p, pi, confedSeq(65002, 65001), seq(100)))
+	assert.False(handle(p, pi, confedSeq(65002), seq(100, 30)))
+
+	// the external peer
+	p, pi = newConfedPeer(100, "10.0.0.3")
+	assert.True(handle(p, pi, seq(100, 65001)))
+	assert.False(handle(p, pi, seq(100, 30)))
+}
+
 func process(rib *table.TableManager, l []*table.Path) (*table.Path, *table.Path) {
 	dsts := make([]*table.Update, 0)
 	for _, path := range l {
@@ -1041,6 +1633,122 @@ func TestFilterpathWithiBGP(t *testing.T) {
 
 }
 
+func TestFilterpathWithRouteTargetConstraint(t *testing.T) {
+	assert := assert.New(t)
+	families := []bgp.RouteFamily{bgp.RF_IPv4_UC, bgp.RF_IPv4_VPN, bgp.RF_RTC_UC}
+	rib := table.NewTableManager(logger, families)
+	_, pi1 := newPeerandInfo(65000, 65001, "192.168.0.1", rib)
+	p2, pi2 := newPeerandInfo(65000, 65002, "192.168.0.2", rib)
+	p2.adjRibIn = table.NewAdjRib(logger, families)
+
+	rd, _ := bgp.ParseRouteDistinguisher("65000:1")
+	rt1, _ := bgp.ParseRouteTarget("65000:1")
+	rt2, _ := bgp.ParseRouteTarget("65000:2")
+	// the same string as rt2
+	soo := bgp.NewTwoOctetAsSpecificExtended(bgp.EC_SUBTYPE_ROUTE_ORIGIN, 65000, 2, true)
+	newPath := func(nlri bgp.AddrPrefixInterface, ecs ...bgp.ExtendedCommunityInterface) *table.Path {
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAs4PathParam(2, []uint32{65001})}),
+			bgp.NewPathAttributeNextHop("192.168.0.1"),
+		}
+		if len(ecs) > 0 {
+			attrs = append(attrs, bgp.NewPathAttributeExtendedCommunities(ecs))
+		}
+		return table.NewPath(pi1, nlri, false, attrs, time.Now(), false)
+	}
+	vpn := func(prefix string) bgp.AddrPrefixInterface {
+		return bgp.NewLabeledVPNIPAddrPrefix(24, prefix, *bgp.NewMPLSLabelStack(100), rd)
+	}
+	membership := func(rt bgp.ExtendedCommunityInterface) *table.Path {
+		nlri := bgp.NewRouteTargetMembershipNLRI(65002, rt)
+		return table.NewPath(pi2, nlri, false, []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeMpReachNLRI("192.168.0.2", []bgp.AddrPrefixInterface{nlri}),
+		}, time.Now(), false)
+	}
+	unicast := newPath(bgp.NewIPAddrPrefix(24, "10.0.0.0"))
+	vpn1 := newPath(vpn("10.1.0.0"), rt1)
+	vpn2 := newPath(vpn("10.2.0.0"), soo)
+	vpn3 := newPath(vpn("10.3.0.0"))
+
+	// the families without route targets aren't constrained
+	assert.Equal(unicast, filterpath(p2, unicast, nil))
+	for _, path := range []*table.Path{vpn1, vpn2, vpn3} {
+		assert.Nil(filterpath(p2, path, nil))
+	}
+
+	p2.adjRibIn.Update([]*table.Path{membership(rt1), membership(rt2)})
+	assert.Equal(unicast, filterpath(p2, unicast, nil))
+	assert.Equal(vpn1, filterpath(p2, vpn1, nil))
+	assert.Nil(filterpath(p2, vpn2, nil))
+	assert.Nil(filterpath(p2, vpn3, nil))
+
+	// the default route target matches all
+	p2.adjRibIn.Update([]*table.Path{membership(nil)})
+	for _, path := range []*table.Path{vpn1, vpn2, vpn3} {
+		assert.Equal(path, filterpath(p2, path, nil))
+	}
+}
+
+func TestAddPathBestChanges(t *testing.T) {
+	s := NewBgpServer()
+	as := uint32(65000)
+	rib := table.NewTableManager(logger, []bgp.RouteFamily{bgp.RF_IPv4_UC})
+	_, pi1 := newPeerandInfo(as, 65001, "192.168.0.1", rib)
+	_, pi2 := newPeerandInfo(as, 65002, "192.168.0.2", rib)
+	_, pi3 := newPeerandInfo(as, 65003, "192.168.0.3", rib)
+	target, _ := newPeerandInfo(as, 65004, "192.168.0.4", rib)
+	target.fsm.rfMap[bgp.RF_IPv4_UC] = bgp.BGP_ADD_PATH_SEND
+	target.fsm.pConf.AfiSafis[0].AddPaths.Config.SendMax = 2
+	target.fsm.pConf.AfiSafis[0].AddPaths.Config.SendMode = oc.ADD_PATHS_SEND_MODE_TYPE_N_BEST
+	assert.True(t, target.isAddPathSendBestEnabled(bgp.RF_IPv4_UC))
+
+	newPath := func(pi *table.PeerInfo, localPref uint32) *table.Path {
+		nlri := bgp.NewIPAddrPrefix(24, "10.10.10.0")
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAs4PathParam(2, []uint32{pi.AS})}),
+			bgp.NewPathAttributeNextHop(pi.Address.String()),
+			bgp.NewPathAttributeLocalPref(localPref),
+		}
+		return table.NewPath(pi, nlri, false, attrs, time.Now(), false)
+	}
+	update := func(path *table.Path) []*table.Path {
+		return s.getAddPathBestChanges(target, bgp.RF_IPv4_UC, path, rib.Update(path))
+	}
+
+	path1 := newPath(pi1, 100)
+	l := update(path1)
+	assert.Equal(t, 1, len(l))
+	assert.False(t, l[0].IsWithdraw)
+	id1 := path1.GetNlri().PathLocalIdentifier()
+	assert.Equal(t, id1, l[0].GetNlri().PathLocalIdentifier())
+
+	path2 := newPath(pi2, 200)
+	l = update(path2)
+	assert.Equal(t, 1, len(l))
+	assert.False(t, l[0].IsWithdraw)
+	assert.Equal(t, path2.GetNlri().PathLocalIdentifier(), l[0].GetNlri().PathLocalIdentifier())
+
+	// path3 is better than path1, which is no longer one of the 2 best paths
+	path3 := newPath(pi3, 300)
+	l = update(path3)
+	assert.Equal(t, 2, len(l))
+	assert.True(t, l[0].IsWithdraw)
+	assert.Equal(t, id1, l[0].GetNlri().PathLocalIdentifier())
+	assert.False(t, l[1].IsWithdraw)
+	assert.Equal(t, path3.GetNlri().PathLocalIdentifier(), l[1].GetNlri().PathLocalIdentifier())
+
+	// path1 is selected again when path3 is withdrawn
+	l = update(path3.Clone(true))
+	assert.Equal(t, 2, len(l))
+	assert.True(t, l[0].IsWithdraw)
+	assert.Equal(t, path3.GetNlri().PathLocalIdentifier(), l[0].GetNlri().PathLocalIdentifier())
+	assert.False(t, l[1].IsWithdraw)
+	assert.Equal(t, id1, l[1].GetNlri().PathLocalIdentifier())
+}
+
 func TestFilterpathWithRejectPolicy(t *testing.T) {
 	rib1 := table.NewTableManager(logger, []bgp.RouteFamily{bgp.RF_IPv4_UC})
 	_, pi1 := newPeerandInfo(1, 2, "192.168.0.1", rib1)
@@ -1186,38 +1894,49 @@ func TestPeerGroup(test *testing.T) {
 	<-ch
 }
 
-func TestDynamicNeighbor(t *testing.T) {
+func TestListeners(t *testing.T) {
 	assert := assert.New(t)
 	s1 := NewBgpServer()
-	s1.logger.SetLevel(log.DebugLevel)
 	go s1.Serve()
 	err := s1.StartBgp(context.Background(), &api.StartBgpRequest{
 		Global: &api.Global{
 			Asn:        1,
 			RouterId:   "1.1.1.1",
-			ListenPort: 10179,
+			ListenPort: -1,
+			Listeners: []*api.Listener{
+				{Address: "127.0.0.1", Port: 10179},
+				{Address: "127.0.0.1", Port: 10180, AuthRequired: true},
+			},
 		},
 	})
 	assert.Nil(err)
 	defer s1.StopBgp(context.Background(), &api.StopBgpRequest{})
 
-	g := &oc.PeerGroup{
-		Config: oc.PeerGroupConfig{
-			PeerAs:        2,
-			PeerGroupName: "g",
+	n := &oc.Neighbor{
+		Config: oc.NeighborConfig{
+			NeighborAddress: "127.0.0.1",
+			PeerAs:          2,
+		},
+		Transport: oc.Transport{
+			Config: oc.TransportConfig{
+				PassiveMode: true,
+			},
 		},
 	}
-	err = s1.addPeerGroup(g)
+	ch := make(chan struct{})
+	go waitActive(s1, ch)
+	err = s1.AddPeer(context.Background(), &api.AddPeerRequest{Peer: oc.NewPeerFromConfigStruct(n)})
 	assert.Nil(err)
+	<-ch
 
-	d := &api.AddDynamicNeighborRequest{
-		DynamicNeighbor: &api.DynamicNeighbor{
-			Prefix:    "127.0.0.0/24",
-			PeerGroup: "g",
-		},
-	}
-	err = s1.AddDynamicNeighbor(context.Background(), d)
+	// the neighbor without TCP MD5 password is rejected
+	conn, err := net.Dial("tcp", "127.0.0.1:10180")
 	assert.Nil(err)
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, err = conn.Read(make([]byte, bgp.BGP_HEADER_LENGTH))
+	assert.NotNil(err)
+	assert.False(errors.Is(err, os.ErrDeadlineExceeded))
+	conn.Close()
 
 	s2 := NewBgpServer()
 	go s2.Serve()
@@ -1248,16 +1967,17 @@ func TestDynamicNeighbor(t *testing.T) {
 			},
 		},
 	}
-	ch := make(chan struct{})
-	go waitEstablished(s2, ch)
+	ch = make(chan struct{})
+	go waitEstablished(s1, ch)
 	err = s2.AddPeer(context.Background(), &api.AddPeerRequest{Peer: oc.NewPeerFromConfigStruct(m)})
 	assert.Nil(err)
 	<-ch
 }
 
-func TestGracefulRestartTimerExpired(t *testing.T) {
+func TestDynamicNeighbor(t *testing.T) {
 	assert := assert.New(t)
 	s1 := NewBgpServer()
+	s1.logger.SetLevel(log.DebugLevel)
 	go s1.Serve()
 	err := s1.StartBgp(context.Background(), &api.StartBgpRequest{
 		Global: &api.Global{
@@ -1269,28 +1989,189 @@ func TestGracefulRestartTimerExpired(t *testing.T) {
 	assert.Nil(err)
 	defer s1.StopBgp(context.Background(), &api.StopBgpRequest{})
 
-	p1 := &api.Peer{
-		Conf: &api.PeerConf{
-			NeighborAddress: "127.0.0.1",
-			PeerAsn:         2,
-		},
-		Transport: &api.Transport{
-			PassiveMode: true,
-		},
-		GracefulRestart: &api.GracefulRestart{
-			Enabled:     true,
-			RestartTime: minConnectRetryInterval,
+	g := &oc.PeerGroup{
+		Config: oc.PeerGroupConfig{
+			PeerAs:        2,
+			PeerGroupName: "g",
 		},
 	}
-	err = s1.AddPeer(context.Background(), &api.AddPeerRequest{Peer: p1})
+	err = s1.addPeerGroup(g)
 	assert.Nil(err)
 
-	s2 := NewBgpServer()
-	go s2.Serve()
-	err = s2.StartBgp(context.Background(), &api.StartBgpRequest{
-		Global: &api.Global{
-			Asn:        2,
-			RouterId:   "2.2.2.2",
+	d := &api.AddDynamicNeighborRequest{
+		DynamicNeighbor: &api.DynamicNeighbor{
+			Prefix:    "127.0.0.0/24",
+			PeerGroup: "g",
+		},
+	}
+	err = s1.AddDynamicNeighbor(context.Background(), d)
+	assert.Nil(err)
+
+	s2 := NewBgpServer()
+	go s2.Serve()
+	err = s2.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        2,
+			RouterId:   "2.2.2.2",
+			ListenPort: -1,
+		},
+	})
+	assert.Nil(err)
+	defer s2.StopBgp(context.Background(), &api.StopBgpRequest{})
+
+	m := &oc.Neighbor{
+		Config: oc.NeighborConfig{
+			NeighborAddress: "127.0.0.1",
+			PeerAs:          1,
+		},
+		Transport: oc.Transport{
+			Config: oc.TransportConfig{
+				RemotePort: 10179,
+			},
+		},
+		Timers: oc.Timers{
+			Config: oc.TimersConfig{
+				ConnectRetry:           1,
+				IdleHoldTimeAfterReset: 1,
+			},
+		},
+	}
+	ch := make(chan struct{})
+	go waitEstablished(s2, ch)
+	err = s2.AddPeer(context.Background(), &api.AddPeerRequest{Peer: oc.NewPeerFromConfigStruct(m)})
+	assert.Nil(err)
+	<-ch
+}
+
+func TestDynamicNeighborLinkLocal(t *testing.T) {
+	assert := assert.New(t)
+	s := NewBgpServer()
+
+	ll := newPeerGroup(&oc.PeerGroup{Config: oc.PeerGroupConfig{PeerGroupName: "ll"}})
+	ll.AddDynamicNeighbor(&oc.DynamicNeighbor{Config: oc.DynamicNeighborConfig{
+		Prefix:    "fe80::/10",
+		PeerGroup: "ll",
+		Interface: "eth0",
+	}})
+	g := newPeerGroup(&oc.PeerGroup{Config: oc.PeerGroupConfig{PeerGroupName: "g"}})
+	g.AddDynamicNeighbor(&oc.DynamicNeighbor{Config: oc.DynamicNeighborConfig{
+		Prefix:    "::/0",
+		PeerGroup: "g",
+	}})
+	s.peerGroupMap["ll"] = ll
+	s.peerGroupMap["g"] = g
+
+	assert.Equal(ll, s.matchLongestDynamicNeighborPrefix("fe80::1%eth0"))
+	assert.Equal(g, s.matchLongestDynamicNeighborPrefix("fe80::1%eth1"))
+	assert.Equal(g, s.matchLongestDynamicNeighborPrefix("2001:db8::1"))
+	assert.Nil(s.matchLongestDynamicNeighborPrefix("10.0.0.1"))
+
+	ll.DeleteDynamicNeighbor("fe80::/10", "eth1")
+	assert.Len(ll.dynamicNeighbors, 1)
+	ll.DeleteDynamicNeighbor("fe80::/10", "eth0")
+	assert.Len(ll.dynamicNeighbors, 0)
+	assert.Equal(g, s.matchLongestDynamicNeighborPrefix("fe80::1%eth0"))
+
+	err := s.AddDynamicNeighbor(context.Background(), &api.AddDynamicNeighborRequest{
+		DynamicNeighbor: &api.DynamicNeighbor{
+			Prefix:    "2001:db8::/32",
+			PeerGroup: "ll",
+			Interface: "eth0",
+		},
+	})
+	assert.NotNil(err)
+}
+
+func TestUnnumberedPeerDiscovered(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	discovered := make(chan struct{})
+	linkLocalNeighborAddress = func(ifname string) (string, error) {
+		select {
+		case <-discovered:
+			return "127.0.0.1", nil
+		default:
+			return "", fmt.Errorf("no ipv6 link-local neighbor found on %s", ifname)
+		}
+	}
+	defer func() { linkLocalNeighborAddress = oc.GetIPv6LinkLocalNeighborAddress }()
+
+	s1 := runNewServer(t, 1, "1.1.1.1", -1)
+	defer s1.StopBgp(ctx, &api.StopBgpRequest{})
+	s2 := runNewServer(t, 2, "2.2.2.2", 20200)
+	defer s2.StopBgp(ctx, &api.StopBgpRequest{})
+
+	assert.NoError(s2.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{
+		Conf:      &api.PeerConf{NeighborAddress: "127.0.0.1", PeerAsn: 1},
+		Transport: &api.Transport{PassiveMode: true},
+	}}))
+
+	// the neighbor isn't in the neighbor table yet
+	assert.NoError(s1.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{
+		Conf:      &api.PeerConf{NeighborInterface: "bgptest0", PeerAsn: 2},
+		Transport: &api.Transport{LocalAddress: "127.0.0.1", RemotePort: 20200},
+		Timers:    &api.Timers{Config: &api.TimersConfig{ConnectRetry: 1}},
+	}}))
+	peerAddress := func() string {
+		var addr string
+		s1.ListPeer(ctx, &api.ListPeerRequest{}, func(p *api.Peer) {
+			addr = p.State.NeighborAddress
+		})
+		return addr
+	}
+	assert.Equal("", peerAddress())
+
+	ch := make(chan struct{})
+	go waitEstablished(s1, ch)
+	close(discovered)
+	select {
+	case <-ch:
+	case <-time.After(30 * time.Second):
+		t.Fatal("no session with the discovered neighbor")
+	}
+	assert.Equal("127.0.0.1", peerAddress())
+
+	assert.NoError(s1.DeletePeer(ctx, &api.DeletePeerRequest{Interface: "bgptest0"}))
+	assert.Len(s1.neighborMap, 0)
+}
+
+func TestGracefulRestartTimerExpired(t *testing.T) {
+	assert := assert.New(t)
+	s1 := NewBgpServer()
+	go s1.Serve()
+	err := s1.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        1,
+			RouterId:   "1.1.1.1",
+			ListenPort: 10179,
+		},
+	})
+	assert.Nil(err)
+	defer s1.StopBgp(context.Background(), &api.StopBgpRequest{})
+
+	p1 := &api.Peer{
+		Conf: &api.PeerConf{
+			NeighborAddress: "127.0.0.1",
+			PeerAsn:         2,
+		},
+		Transport: &api.Transport{
+			PassiveMode: true,
+		},
+		GracefulRestart: &api.GracefulRestart{
+			Enabled:     true,
+			RestartTime: minConnectRetryInterval,
+		},
+	}
+	err = s1.AddPeer(context.Background(), &api.AddPeerRequest{Peer: p1})
+	assert.Nil(err)
+
+	s2 := NewBgpServer()
+	go s2.Serve()
+	err = s2.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        2,
+			RouterId:   "2.2.2.2",
 			ListenPort: -1,
 		},
 	})
@@ -1363,6 +2244,177 @@ func TestGracefulRestartTimerExpired(t *testing.T) {
 	}
 }
 
+func TestActiveMode(t *testing.T) {
+	assert := assert.New(t)
+	s1 := NewBgpServer()
+	go s1.Serve()
+	err := s1.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        1,
+			RouterId:   "1.1.1.1",
+			ListenPort: 10179,
+		},
+	})
+	require.NoError(t, err)
+	defer s1.StopBgp(context.Background(), &api.StopBgpRequest{})
+
+	s2 := NewBgpServer()
+	go s2.Serve()
+	err = s2.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        2,
+			RouterId:   "2.2.2.2",
+			ListenPort: 10180,
+		},
+	})
+	require.NoError(t, err)
+	defer s2.StopBgp(context.Background(), &api.StopBgpRequest{})
+
+	// s1 rejects the connections from s2
+	p1 := &api.Peer{
+		Conf: &api.PeerConf{
+			NeighborAddress: "127.0.0.1",
+			PeerAsn:         2,
+		},
+		Transport: &api.Transport{
+			ActiveMode: true,
+			RemotePort: 10180,
+		},
+		Timers: &api.Timers{
+			Config: &api.TimersConfig{
+				ConnectRetry: 1,
+			},
+		},
+	}
+	p2 := &api.Peer{
+		Conf: &api.PeerConf{
+			NeighborAddress: "127.0.0.1",
+			PeerAsn:         1,
+		},
+		Transport: &api.Transport{
+			RemotePort: 10179,
+		},
+		Timers: &api.Timers{
+			Config: &api.TimersConfig{
+				ConnectRetry: 1,
+			},
+		},
+	}
+	err = s2.AddPeer(context.Background(), &api.AddPeerRequest{Peer: p2})
+	assert.NoError(err)
+	err = s1.AddPeer(context.Background(), &api.AddPeerRequest{Peer: p1})
+	assert.NoError(err)
+
+	establishedCh := make(chan struct{})
+	go waitEstablished(s1, establishedCh)
+	<-establishedCh
+
+	err = s1.mgmtOperation(func() error {
+		fsm := s1.neighborMap["127.0.0.1"].fsm
+		fsm.lock.RLock()
+		defer fsm.lock.RUnlock()
+		assert.True(fsm.connLocal)
+		_, port := hostport(fsm.conn.RemoteAddr())
+		assert.Equal(uint16(10180), port)
+		return nil
+	}, true)
+	assert.NoError(err)
+
+	// can't be passive too
+	p1.Conf.NeighborAddress = "127.0.0.2"
+	p1.Transport.PassiveMode = true
+	assert.Error(s1.AddPeer(context.Background(), &api.AddPeerRequest{Peer: p1}))
+}
+
+func TestConnectionCollision(t *testing.T) {
+	readMsg := func(conn net.Conn) *bgp.BGPMessage {
+		conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+		hdr, err := readAll(conn, bgp.BGP_HEADER_LENGTH)
+		require.NoError(t, err)
+		h := &bgp.BGPHeader{}
+		require.NoError(t, h.DecodeFromBytes(hdr))
+		body, err := readAll(conn, int(h.Len)-bgp.BGP_HEADER_LENGTH)
+		require.NoError(t, err)
+		m, err := bgp.ParseBGPBody(h, body)
+		require.NoError(t, err)
+		return m
+	}
+	write := func(conn net.Conn, m *bgp.BGPMessage) {
+		b, _ := m.Serialize()
+		_, err := conn.Write(b)
+		require.NoError(t, err)
+	}
+	isCease := func(m *bgp.BGPMessage) bool {
+		n, ok := m.Body.(*bgp.BGPNotification)
+		return ok && n.ErrorCode == bgp.BGP_ERROR_CEASE && n.ErrorSubcode == bgp.BGP_ERROR_SUB_CONNECTION_COLLISION_RESOLUTION
+	}
+
+	// the neighbor with the higher and the lower identifier than 1.1.1.1
+	for _, id := range []string{"2.2.2.2", "0.0.0.1"} {
+		s := NewBgpServer()
+		go s.Serve()
+		err := s.StartBgp(context.Background(), &api.StartBgpRequest{
+			Global: &api.Global{
+				Asn:        1,
+				RouterId:   "1.1.1.1",
+				ListenPort: 10179,
+			},
+		})
+		require.NoError(t, err)
+
+		ln, err := net.Listen("tcp", "127.0.0.1:10180")
+		require.NoError(t, err)
+		err = s.AddPeer(context.Background(), &api.AddPeerRequest{Peer: &api.Peer{
+			Conf: &api.PeerConf{
+				NeighborAddress: "127.0.0.1",
+				PeerAsn:         2,
+			},
+			Transport: &api.Transport{
+				RemotePort: 10180,
+			},
+			Timers: &api.Timers{
+				Config: &api.TimersConfig{
+					ConnectRetry: 1,
+				},
+			},
+		}})
+		require.NoError(t, err)
+
+		// the connection initiated by the server, then the one by the
+		// neighbor while the server is in OpenSent
+		local, err := ln.Accept()
+		require.NoError(t, err)
+		assert.Equal(t, uint8(bgp.BGP_MSG_OPEN), readMsg(local).Header.Type)
+		remote, err := net.Dial("tcp", "127.0.0.1:10179")
+		require.NoError(t, err)
+		// sent on the colliding connection too
+		assert.Equal(t, uint8(bgp.BGP_MSG_OPEN), readMsg(remote).Header.Type)
+
+		open := bgp.NewBGPOpenMessage(2, 90, id, nil)
+		write(remote, open)
+		write(local, open)
+		assert.Equal(t, uint8(bgp.BGP_MSG_KEEPALIVE), readMsg(local).Header.Type)
+
+		// the connection initiated by the higher identifier is kept
+		kept, closed := local, remote
+		if id == "2.2.2.2" {
+			kept, closed = remote, local
+			assert.Equal(t, uint8(bgp.BGP_MSG_KEEPALIVE), readMsg(remote).Header.Type)
+		}
+		assert.True(t, isCease(readMsg(closed)))
+
+		establishedCh := make(chan struct{})
+		go waitEstablished(s, establishedCh)
+		write(kept, bgp.NewBGPKeepAliveMessage())
+		<-establishedCh
+
+		local.Close()
+		remote.Close()
+		ln.Close()
+		s.StopBgp(context.Background(), &api.StopBgpRequest{})
+	}
+}
+
 func TestTcpConnectionClosedAfterPeerDel(t *testing.T) {
 	assert := assert.New(t)
 	s1 := NewBgpServer()
@@ -2031,6 +3083,47 @@ func TestDeleteVrf(t *testing.T) {
 	s.StopBgp(context.Background(), &api.StopBgpRequest{})
 }
 
+func TestAddVrfWithRouterId(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	s := runNewServer(t, 1, "1.1.1.1", 10179)
+	defer s.StopBgp(ctx, &api.StopBgpRequest{})
+
+	rd, _ := apiutil.MarshalRD(bgp.NewRouteDistinguisherIPAddressAS("10.0.0.2", 1))
+	err := s.AddVrf(ctx, &api.AddVrfRequest{
+		Vrf: &api.Vrf{Name: "vrf1", Rd: rd, RouterId: "10.0.0.2"},
+	})
+	assert.NoError(err)
+	// the RD is used by vrf1
+	assert.Error(s.AddVrf(ctx, &api.AddVrfRequest{
+		Vrf: &api.Vrf{Name: "vrf2", Rd: rd},
+	}))
+	assert.Error(s.AddVrf(ctx, &api.AddVrfRequest{
+		Vrf: &api.Vrf{Name: "vrf3", Rd: rd, RouterId: "2001:db8::1"},
+	}))
+	var vrfs []*api.Vrf
+	s.ListVrf(ctx, &api.ListVrfRequest{}, func(v *api.Vrf) {
+		vrfs = append(vrfs, v)
+	})
+	assert.Len(vrfs, 1)
+	assert.Equal("10.0.0.2", vrfs[0].RouterId)
+
+	// the neighbors in the VRF identify themselves with the router ID of it
+	err = s.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{
+		Conf: &api.PeerConf{NeighborAddress: "127.0.0.2", PeerAsn: 2, Vrf: "vrf1"},
+	}})
+	assert.NoError(err)
+	err = s.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{
+		Conf: &api.PeerConf{NeighborAddress: "127.0.0.3", PeerAsn: 3},
+	}})
+	assert.NoError(err)
+	s.mgmtOperation(func() error {
+		assert.Equal("10.0.0.2", s.neighborMap["127.0.0.2"].fsm.gConf.Config.RouterId)
+		assert.Equal("1.1.1.1", s.neighborMap["127.0.0.3"].fsm.gConf.Config.RouterId)
+		return nil
+	}, false)
+}
+
 func TestAddBogusPath(t *testing.T) {
 	ctx := context.Background()
 	s := runNewServer(t, 1, "1.1.1.1", 10179)
@@ -2167,78 +3260,413 @@ func TestListPathWithIdentifiers(t *testing.T) {
 	}
 }
 
-func TestWatchEvent(test *testing.T) {
-	assert := assert.New(test)
+func TestGetEffectivePeerConfig(t *testing.T) {
+	assert := assert.New(t)
 	s := NewBgpServer()
 	go s.Serve()
 	err := s.StartBgp(context.Background(), &api.StartBgpRequest{
 		Global: &api.Global{
 			Asn:        1,
 			RouterId:   "1.1.1.1",
-			ListenPort: 10179,
+			ListenPort: -1,
+			PeerDefaults: &api.PeerDefaults{
+				Timers: &api.Timers{
+					Config: &api.TimersConfig{
+						HoldTime: 30,
+					},
+				},
+			},
 		},
 	})
 	assert.Nil(err)
 	defer s.StopBgp(context.Background(), &api.StopBgpRequest{})
 
-	peer1 := &api.Peer{
-		Conf: &api.PeerConf{
-			NeighborAddress: "127.0.0.1",
-			PeerAsn:         2,
-		},
-		Transport: &api.Transport{
-			PassiveMode: true,
+	err = s.AddPeerGroup(context.Background(), &api.AddPeerGroupRequest{
+		PeerGroup: &api.PeerGroup{
+			Conf: &api.PeerGroupConf{
+				PeerGroupName: "pg",
+				PeerAsn:       3,
+			},
+			Timers: &api.Timers{
+				Config: &api.TimersConfig{
+					HoldTime: 60,
+				},
+			},
 		},
-	}
-	err = s.AddPeer(context.Background(), &api.AddPeerRequest{Peer: peer1})
+	})
 	assert.Nil(err)
 
-	d1 := &api.DefinedSet{
-		DefinedType: api.DefinedType_PREFIX,
-		Name:        "d1",
-		Prefixes: []*api.Prefix{
-			{
-				IpPrefix:      "10.1.0.0/24",
-				MaskLengthMax: 24,
-				MaskLengthMin: 24,
+	err = s.AddPeer(context.Background(), &api.AddPeerRequest{
+		Peer: &api.Peer{
+			Conf: &api.PeerConf{
+				NeighborAddress: "127.0.0.2",
+				PeerAsn:         2,
 			},
-		},
-	}
-	s1 := &api.Statement{
-		Name: "s1",
-		Conditions: &api.Conditions{
-			PrefixSet: &api.MatchSet{
-				Name: "d1",
+			Timers: &api.Timers{
+				Config: &api.TimersConfig{
+					ConnectRetry: 5,
+				},
 			},
 		},
-		Actions: &api.Actions{
-			RouteAction: api.RouteAction_REJECT,
-		},
-	}
-	err = s.AddDefinedSet(context.Background(), &api.AddDefinedSetRequest{DefinedSet: d1})
-	assert.Nil(err)
-	p1 := &api.Policy{
-		Name:       "p1",
-		Statements: []*api.Statement{s1},
-	}
-	err = s.AddPolicy(context.Background(), &api.AddPolicyRequest{Policy: p1})
+	})
 	assert.Nil(err)
-	err = s.AddPolicyAssignment(context.Background(), &api.AddPolicyAssignmentRequest{
-		Assignment: &api.PolicyAssignment{
-			Name:          table.GLOBAL_RIB_NAME,
-			Direction:     api.PolicyDirection_IMPORT,
-			Policies:      []*api.Policy{p1},
-			DefaultAction: api.RouteAction_ACCEPT,
+	err = s.AddPeer(context.Background(), &api.AddPeerRequest{
+		Peer: &api.Peer{
+			Conf: &api.PeerConf{
+				NeighborAddress: "127.0.0.3",
+				PeerGroup:       "pg",
+			},
 		},
 	})
 	assert.Nil(err)
 
-	t := NewBgpServer()
-	go t.Serve()
-	err = t.StartBgp(context.Background(), &api.StartBgpRequest{
-		Global: &api.Global{
-			Asn:        2,
-			RouterId:   "2.2.2.2",
+	sources := func(addr string) map[string]*api.PeerConfigSource {
+		rsp, err := s.GetEffectivePeerConfig(context.Background(), &api.GetEffectivePeerConfigRequest{
+			Address: addr,
+		})
+		assert.Nil(err)
+		m := make(map[string]*api.PeerConfigSource)
+		for _, source := range rsp.Sources {
+			m[source.Path] = source
+		}
+		return m
+	}
+
+	m := sources("127.0.0.2")
+	assert.Equal(api.PeerConfigSource_PEER_DEFAULTS, m["timers.config.hold-time"].Origin)
+	assert.Equal("30", m["timers.config.hold-time"].Value)
+	assert.Equal(api.PeerConfigSource_NEIGHBOR, m["timers.config.connect-retry"].Origin)
+	assert.Equal(api.PeerConfigSource_DEFAULT, m["timers.config.keepalive-interval"].Origin)
+	assert.Equal("10", m["timers.config.keepalive-interval"].Value)
+
+	m = sources("127.0.0.3")
+	assert.Equal(api.PeerConfigSource_PEER_GROUP, m["timers.config.hold-time"].Origin)
+	assert.Equal("pg", m["timers.config.hold-time"].PeerGroup)
+	assert.Equal("60", m["timers.config.hold-time"].Value)
+	assert.Equal(api.PeerConfigSource_PEER_GROUP, m["config.peer-as"].Origin)
+	assert.Equal(api.PeerConfigSource_DEFAULT, m["timers.config.connect-retry"].Origin)
+
+	_, err = s.GetEffectivePeerConfig(context.Background(), &api.GetEffectivePeerConfigRequest{
+		Address: "127.0.0.4",
+	})
+	assert.NotNil(err)
+}
+
+func TestListPathWithFilter(t *testing.T) {
+	assert := assert.New(t)
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        1,
+			RouterId:   "1.1.1.1",
+			ListenPort: -1,
+		},
+	})
+	assert.Nil(err)
+	defer s.StopBgp(context.Background(), &api.StopBgpRequest{})
+
+	family := &api.Family{
+		Afi:  api.Family_AFI_IP,
+		Safi: api.Family_SAFI_UNICAST,
+	}
+	for i, comm := range []uint32{65000<<16 | 100, 65000<<16 | 200} {
+		nlri, _ := apb.New(&api.IPAddressPrefix{
+			Prefix:    fmt.Sprintf("10.%d.0.0", i),
+			PrefixLen: 24,
+		})
+		a1, _ := apb.New(&api.OriginAttribute{
+			Origin: 0,
+		})
+		a2, _ := apb.New(&api.NextHopAttribute{
+			NextHop: "10.0.0.1",
+		})
+		a3, _ := apb.New(&api.CommunitiesAttribute{
+			Communities: []uint32{comm},
+		})
+		_, err = s.AddPath(context.Background(), &api.AddPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Path: &api.Path{
+				Family: family,
+				Nlri:   nlri,
+				Pattrs: []*apb.Any{a1, a2, a3},
+			},
+		})
+		assert.Nil(err)
+	}
+
+	list := func(filter string) ([]string, error) {
+		prefixes := make([]string, 0)
+		err := s.ListPath(context.Background(), &api.ListPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Family:    family,
+			Filter:    filter,
+		}, func(d *api.Destination) {
+			prefixes = append(prefixes, d.Prefix)
+		})
+		sort.Strings(prefixes)
+		return prefixes, err
+	}
+
+	prefixes, err := list("")
+	assert.Nil(err)
+	assert.Equal([]string{"10.0.0.0/24", "10.1.0.0/24"}, prefixes)
+
+	prefixes, err = list("community ~ 65000:200")
+	assert.Nil(err)
+	assert.Equal([]string{"10.1.0.0/24"}, prefixes)
+
+	prefixes, err = list("not community ~ 65000:200 and localpref == 100")
+	assert.Nil(err)
+	assert.Equal([]string{"10.0.0.0/24"}, prefixes)
+
+	_, err = list("community ==")
+	assert.NotNil(err)
+}
+
+func TestListPathPagination(t *testing.T) {
+	assert := assert.New(t)
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        1,
+			RouterId:   "1.1.1.1",
+			ListenPort: -1,
+		},
+	})
+	assert.Nil(err)
+	defer s.StopBgp(context.Background(), &api.StopBgpRequest{})
+
+	family := &api.Family{
+		Afi:  api.Family_AFI_IP,
+		Safi: api.Family_SAFI_UNICAST,
+	}
+	path := func(i int) *api.Path {
+		nlri, _ := apb.New(&api.IPAddressPrefix{
+			Prefix:    fmt.Sprintf("10.%d.0.0", i),
+			PrefixLen: 24,
+		})
+		a1, _ := apb.New(&api.OriginAttribute{
+			Origin: 0,
+		})
+		a2, _ := apb.New(&api.NextHopAttribute{
+			NextHop: "10.0.0.1",
+		})
+		return &api.Path{
+			Family: family,
+			Nlri:   nlri,
+			Pattrs: []*apb.Any{a1, a2},
+		}
+	}
+	for _, i := range []int{10, 2, 0, 1} {
+		_, err = s.AddPath(context.Background(), &api.AddPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Path:      path(i),
+		})
+		assert.Nil(err)
+		time.Sleep(time.Millisecond)
+	}
+
+	list := func(sortType api.ListPathRequest_SortType, token string) ([]string, string, error) {
+		prefixes := make([]string, 0)
+		next := ""
+		err := s.listPath(context.Background(), &api.ListPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Family:    family,
+			SortType:  sortType,
+			PageSize:  2,
+			PageToken: token,
+		}, func(d *api.Destination, token string) {
+			prefixes = append(prefixes, d.Prefix)
+			next = token
+		})
+		return prefixes, next, err
+	}
+
+	prefixes, token, err := list(api.ListPathRequest_PREFIX, "")
+	assert.Nil(err)
+	assert.Equal([]string{"10.0.0.0/24", "10.1.0.0/24"}, prefixes)
+	assert.NotEmpty(token)
+
+	// removing the destinations of the previous page doesn't shift the next
+	err = s.DeletePath(context.Background(), &api.DeletePathRequest{
+		TableType: api.TableType_GLOBAL,
+		Path:      path(1),
+	})
+	assert.Nil(err)
+	prefixes, token, err = list(api.ListPathRequest_PREFIX, token)
+	assert.Nil(err)
+	assert.Equal([]string{"10.2.0.0/24", "10.10.0.0/24"}, prefixes)
+	assert.Empty(token)
+
+	// youngest first
+	prefixes, token, err = list(api.ListPathRequest_AGE, "")
+	assert.Nil(err)
+	assert.Equal([]string{"10.0.0.0/24", "10.2.0.0/24"}, prefixes)
+	assert.NotEmpty(token)
+	prefixes, token, err = list(api.ListPathRequest_AGE, token)
+	assert.Nil(err)
+	assert.Equal([]string{"10.10.0.0/24"}, prefixes)
+	assert.Empty(token)
+
+	_, _, err = list(api.ListPathRequest_PREFIX, "invalid")
+	assert.NotNil(err)
+	_, token, _ = list(api.ListPathRequest_AGE, "")
+	_, _, err = list(api.ListPathRequest_PREFIX, token)
+	assert.NotNil(err)
+}
+
+func TestListPathPrecedence(t *testing.T) {
+	assert := assert.New(t)
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        1,
+			RouterId:   "1.1.1.1",
+			ListenPort: -1,
+		},
+	})
+	assert.Nil(err)
+	defer s.StopBgp(context.Background(), &api.StopBgpRequest{})
+
+	path := func(rule string) *api.Path {
+		cmp, err := bgp.ParseFlowSpecComponents(bgp.RF_FS_IPv4_UC, rule)
+		assert.Nil(err)
+		nlri := bgp.NewFlowSpecIPv4Unicast(cmp)
+		path, _ := apiutil.NewPath(nlri, false, []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeMpReachNLRI("0.0.0.0", []bgp.AddrPrefixInterface{nlri}),
+			bgp.NewPathAttributeExtendedCommunities([]bgp.ExtendedCommunityInterface{bgp.NewTrafficRateExtended(0, 0)}),
+		}, time.Now())
+		return path
+	}
+	for _, rule := range []string{
+		"destination 10.0.0.0/8",
+		"destination 10.0.1.0/24 protocol tcp",
+		"destination 10.0.1.0/24",
+	} {
+		_, err = s.AddPath(context.Background(), &api.AddPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Path:      path(rule),
+		})
+		assert.Nil(err)
+	}
+
+	list := func(family *api.Family, token string) ([]string, []uint64, string, error) {
+		rules := make([]string, 0)
+		precedence := make([]uint64, 0)
+		next := ""
+		err := s.listPath(context.Background(), &api.ListPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Family:    family,
+			SortType:  api.ListPathRequest_PRECEDENCE,
+			PageSize:  2,
+			PageToken: token,
+		}, func(d *api.Destination, token string) {
+			rules = append(rules, d.Prefix)
+			precedence = append(precedence, d.Precedence)
+			next = token
+		})
+		return rules, precedence, next, err
+	}
+	family := &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_FLOW_SPEC_UNICAST}
+
+	rules, precedence, token, err := list(family, "")
+	assert.Nil(err)
+	assert.Equal([]string{"[destination: 10.0.1.0/24][protocol: ==tcp]", "[destination: 10.0.1.0/24]"}, rules)
+	assert.Equal([]uint64{1, 2}, precedence)
+	assert.NotEmpty(token)
+
+	// a rule of higher precedence added doesn't shift the next page
+	_, err = s.AddPath(context.Background(), &api.AddPathRequest{
+		TableType: api.TableType_GLOBAL,
+		Path:      path("destination 10.0.0.0/24"),
+	})
+	assert.Nil(err)
+	rules, precedence, token, err = list(family, token)
+	assert.Nil(err)
+	assert.Equal([]string{"[destination: 10.0.0.0/8]"}, rules)
+	assert.Equal([]uint64{4}, precedence)
+	assert.Empty(token)
+
+	_, _, _, err = list(&api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST}, "")
+	assert.NotNil(err)
+}
+
+func TestWatchEvent(test *testing.T) {
+	assert := assert.New(test)
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        1,
+			RouterId:   "1.1.1.1",
+			ListenPort: 10179,
+		},
+	})
+	assert.Nil(err)
+	defer s.StopBgp(context.Background(), &api.StopBgpRequest{})
+
+	peer1 := &api.Peer{
+		Conf: &api.PeerConf{
+			NeighborAddress: "127.0.0.1",
+			PeerAsn:         2,
+		},
+		Transport: &api.Transport{
+			PassiveMode: true,
+		},
+	}
+	err = s.AddPeer(context.Background(), &api.AddPeerRequest{Peer: peer1})
+	assert.Nil(err)
+
+	d1 := &api.DefinedSet{
+		DefinedType: api.DefinedType_PREFIX,
+		Name:        "d1",
+		Prefixes: []*api.Prefix{
+			{
+				IpPrefix:      "10.1.0.0/24",
+				MaskLengthMax: 24,
+				MaskLengthMin: 24,
+			},
+		},
+	}
+	s1 := &api.Statement{
+		Name: "s1",
+		Conditions: &api.Conditions{
+			PrefixSet: &api.MatchSet{
+				Name: "d1",
+			},
+		},
+		Actions: &api.Actions{
+			RouteAction: api.RouteAction_REJECT,
+		},
+	}
+	err = s.AddDefinedSet(context.Background(), &api.AddDefinedSetRequest{DefinedSet: d1})
+	assert.Nil(err)
+	p1 := &api.Policy{
+		Name:       "p1",
+		Statements: []*api.Statement{s1},
+	}
+	err = s.AddPolicy(context.Background(), &api.AddPolicyRequest{Policy: p1})
+	assert.Nil(err)
+	err = s.AddPolicyAssignment(context.Background(), &api.AddPolicyAssignmentRequest{
+		Assignment: &api.PolicyAssignment{
+			Name:          table.GLOBAL_RIB_NAME,
+			Direction:     api.PolicyDirection_IMPORT,
+			Policies:      []*api.Policy{p1},
+			DefaultAction: api.RouteAction_ACCEPT,
+		},
+	})
+	assert.Nil(err)
+
+	t := NewBgpServer()
+	go t.Serve()
+	err = t.StartBgp(context.Background(), &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        2,
+			RouterId:   "2.2.2.2",
 			ListenPort: -1,
 		},
 	})
@@ -2334,6 +3762,82 @@ func TestWatchEvent(test *testing.T) {
 	assert.Equal(2, count)
 }
 
+func TestWatchEventPeerStatistics(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s1 := runNewServer(t, 1, "1.1.1.1", 10179)
+	defer s1.StopBgp(ctx, &api.StopBgpRequest{})
+	s2 := runNewServer(t, 2, "2.2.2.2", -1)
+	defer s2.StopBgp(ctx, &api.StopBgpRequest{})
+
+	family := &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST}
+	origin, _ := apb.New(&api.OriginAttribute{Origin: 0})
+	nh, _ := apb.New(&api.NextHopAttribute{NextHop: "10.0.0.1"})
+	for _, prefix := range []string{"10.1.0.0", "10.2.0.0"} {
+		nlri, _ := apb.New(&api.IPAddressPrefix{Prefix: prefix, PrefixLen: 24})
+		_, err := s2.AddPath(ctx, &api.AddPathRequest{
+			Path: &api.Path{Family: family, Nlri: nlri, Pattrs: []*apb.Any{origin, nh}},
+		})
+		assert.Nil(err)
+	}
+
+	ch := make(chan struct{})
+	go waitEstablished(s2, ch)
+	assert.Nil(s1.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{
+		Conf:      &api.PeerConf{NeighborAddress: "127.0.0.1", PeerAsn: 2},
+		Transport: &api.Transport{PassiveMode: true},
+	}}))
+	assert.Nil(s2.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{
+		Conf:      &api.PeerConf{NeighborAddress: "127.0.0.1", PeerAsn: 1},
+		Transport: &api.Transport{RemotePort: 10179},
+		Timers: &api.Timers{Config: &api.TimersConfig{
+			ConnectRetry:           1,
+			IdleHoldTimeAfterReset: 1,
+		}},
+	}}))
+	<-ch
+
+	assert.Eventually(func() bool {
+		n := 0
+		s1.ListPath(ctx, &api.ListPathRequest{TableType: api.TableType_GLOBAL, Family: family}, func(d *api.Destination) {
+			n++
+		})
+		return n == 2
+	}, 5*time.Second, 100*time.Millisecond)
+
+	// the initial events carry the counters and the capabilities
+	events := make(chan *api.Peer, 8)
+	watch := func(s *BgpServer) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		err := s.WatchEvent(ctx, &api.WatchEventRequest{Peer: &api.WatchEventRequest_Peer{}}, func(r *api.WatchEventResponse) {
+			if p := r.GetPeer(); p != nil && p.Type == api.WatchEventResponse_PeerEvent_INIT {
+				events <- p.Peer
+			}
+		})
+		assert.Nil(err)
+		peer := <-events
+		assert.Equal(api.PeerState_ESTABLISHED, peer.State.SessionState)
+		assert.NotEmpty(peer.State.LocalCap)
+		assert.NotEmpty(peer.State.RemoteCap)
+		assert.Equal(1, len(peer.AfiSafis))
+		st := peer.AfiSafis[0].State
+		assert.Equal(family.Afi, st.Family.Afi)
+		assert.Equal(family.Safi, st.Family.Safi)
+		if s == s1 {
+			assert.Equal(uint64(2), st.Received)
+			assert.Equal(uint64(2), st.Accepted)
+			assert.Equal(uint64(0), st.Advertised)
+		} else {
+			assert.Equal(uint64(0), st.Received)
+			assert.Equal(uint64(2), st.Advertised)
+		}
+	}
+	watch(s1)
+	watch(s2)
+}
+
 func TestAddDefinedSetReplace(t *testing.T) {
 	assert := assert.New(t)
 	s := NewBgpServer()
@@ -2391,3 +3895,630 @@ func TestAddDefinedSetReplace(t *testing.T) {
 	assert.Equal("replaceme", ns[0].Name)
 	assert.Equal([]string{"203.0.113.2/32"}, ns[0].List)
 }
+
+func TestDropPeerPaths(t *testing.T) {
+	for _, n := range []int{16, 1024} {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			assert := assert.New(t)
+
+			s := NewBgpServer(BestPathWorkers(4))
+			s.globalRib = table.NewTableManager(logger, []bgp.RouteFamily{bgp.RF_IPv4_UC})
+			s.policy.Reset(&oc.RoutingPolicy{}, nil)
+			as := uint32(65000)
+			p1, pi1 := newPeerandInfo(as, 65001, "192.168.0.1", s.globalRib)
+			p2, _ := newPeerandInfo(as, 65002, "192.168.0.2", s.globalRib)
+			p3, _ := newPeerandInfo(as, 65003, "192.168.0.3", s.globalRib)
+			p4, pi4 := newPeerandInfo(as, 65004, "192.168.0.4", s.globalRib)
+			p3.fsm.rfMap[bgp.RF_IPv4_UC] = bgp.BGP_ADD_PATH_SEND
+			p3.fsm.pConf.AfiSafis[0].AddPaths.Config.SendMax = 2
+			for _, p := range []*peer{p1, p2, p3, p4} {
+				p.fsm.state = bgp.BGP_FSM_ESTABLISHED
+				s.neighborMap[p.ID()] = p
+			}
+
+			newPath := func(pi *table.PeerInfo, i int, asPath []uint32) *table.Path {
+				nlri := bgp.NewIPAddrPrefix(24, fmt.Sprintf("10.%d.%d.0", i/256, i%256))
+				attrs := []bgp.PathAttributeInterface{
+					bgp.NewPathAttributeOrigin(0),
+					bgp.NewPathAttributeAsPath([]bgp.AsPathParamInterface{bgp.NewAs4PathParam(2, asPath)}),
+					bgp.NewPathAttributeNextHop(pi.Address.String()),
+				}
+				return table.NewPath(pi, nlri, false, attrs, time.Now(), false)
+			}
+			// p4 has the longer paths to the half of the destinations
+			var l1, l4 []*table.Path
+			for i := 0; i < n; i++ {
+				l1 = append(l1, newPath(pi1, i, []uint32{65001}))
+				if i%2 == 0 {
+					l4 = append(l4, newPath(pi4, i, []uint32{65004, 65100}))
+				}
+			}
+			p1.adjRibIn.Update(l1)
+			s.propagateUpdate(p1, l1)
+			p4.adjRibIn.Update(l4)
+			s.propagateUpdate(p4, l4)
+
+			received := func(p *peer) (int, []*table.Path) {
+				msgs := 0
+				paths := make([]*table.Path, 0)
+				for {
+					select {
+					case m := <-p.fsm.outgoingCh.Out():
+						msgs++
+						paths = append(paths, m.(*fsmOutgoingMsg).Paths...)
+					case <-time.After(100 * time.Millisecond):
+						return msgs, paths
+					}
+				}
+			}
+			received(p2)
+			received(p3)
+
+			s.dropPeerPaths(p1, []bgp.RouteFamily{bgp.RF_IPv4_UC})
+			assert.Len(s.globalRib.Tables[bgp.RF_IPv4_UC].GetDestinations(), n/2)
+			assert.True(p1.fsm.pConf.Timers.State.PeerDownConvergenceTime > 0)
+
+			// the best paths are replaced by the paths from p4 or withdrawn
+			msgs, paths := received(p2)
+			if n >= minBatchedWithdrawPaths {
+				assert.Equal(1, msgs)
+			}
+			if assert.Len(paths, n) {
+				withdrawals := 0
+				for _, path := range paths {
+					if path.IsWithdraw {
+						withdrawals++
+					} else {
+						assert.True(path.GetSource().Equal(pi4))
+					}
+				}
+				assert.Equal(n/2, withdrawals)
+			}
+
+			// all the paths from p1 are withdrawn from the add-path peer
+			_, paths = received(p3)
+			if assert.Len(paths, n) {
+				for _, path := range paths {
+					assert.True(path.IsWithdraw)
+				}
+			}
+		})
+	}
+}
+
+func TestPropagateUpdateConcurrently(t *testing.T) {
+	assert := assert.New(t)
+
+	family := &api.Family{
+		Afi:  api.Family_AFI_IP,
+		Safi: api.Family_SAFI_UNICAST,
+	}
+	newPath := func(prefix, nexthop string, withdraw bool) *table.Path {
+		nlri, _ := apb.New(&api.IPAddressPrefix{
+			Prefix:    prefix,
+			PrefixLen: 24,
+		})
+		a1, _ := apb.New(&api.OriginAttribute{
+			Origin: 0,
+		})
+		a2, _ := apb.New(&api.NextHopAttribute{
+			NextHop: nexthop,
+		})
+		path, err := api2Path(api.TableType_GLOBAL, &api.Path{
+			Family:     family,
+			Nlri:       nlri,
+			Pattrs:     []*apb.Any{a1, a2},
+			IsWithdraw: withdraw,
+		}, withdraw)
+		assert.NoError(err)
+		return path
+	}
+	prefix := func(i int) string {
+		return fmt.Sprintf("10.%d.%d.0", i/256, i%256)
+	}
+
+	rib := func(workers int) map[string]string {
+		s := NewBgpServer(BestPathWorkers(workers))
+		go s.Serve()
+		err := s.StartBgp(context.Background(), &api.StartBgpRequest{
+			Global: &api.Global{
+				Asn:        1,
+				RouterId:   "1.1.1.1",
+				ListenPort: -1,
+			},
+		})
+		assert.NoError(err)
+		defer s.StopBgp(context.Background(), &api.StopBgpRequest{})
+
+		// rejects 10.1.0.0/16 and adds a community to the others
+		ps, err := table.NewPrefixSet(oc.PrefixSet{
+			PrefixSetName: "ps1",
+			PrefixList: []oc.Prefix{
+				{
+					IpPrefix:        "10.1.0.0/16",
+					MasklengthRange: "16..24",
+				},
+			},
+		})
+		assert.NoError(err)
+		assert.NoError(s.policy.AddDefinedSet(ps, false))
+		p, err := table.NewPolicy(oc.PolicyDefinition{
+			Name: "p1",
+			Statements: []oc.Statement{
+				{
+					Name: "reject",
+					Conditions: oc.Conditions{
+						MatchPrefixSet: oc.MatchPrefixSet{
+							PrefixSet: "ps1",
+						},
+					},
+					Actions: oc.Actions{
+						RouteDisposition: oc.ROUTE_DISPOSITION_REJECT_ROUTE,
+					},
+				},
+				{
+					Name: "community",
+					Actions: oc.Actions{
+						BgpActions: oc.BgpActions{
+							SetCommunity: oc.SetCommunity{
+								SetCommunityMethod: oc.SetCommunityMethod{
+									CommunitiesList: []string{"100:100"},
+								},
+								Options: string(oc.BGP_SET_COMMUNITY_OPTION_TYPE_ADD),
+							},
+						},
+						RouteDisposition: oc.ROUTE_DISPOSITION_ACCEPT_ROUTE,
+					},
+				},
+			},
+		})
+		assert.NoError(err)
+		assert.NoError(s.policy.AddPolicy(p, false))
+		assert.NoError(s.policy.AddPolicyAssignment(table.GLOBAL_RIB_NAME, table.POLICY_DIRECTION_IMPORT, []*oc.PolicyDefinition{{Name: "p1"}}, table.ROUTE_TYPE_ACCEPT))
+
+		// the paths for the same destinations are added, replaced and
+		// withdrawn in a stream
+		pathList := make([]*table.Path, 0)
+		for i := 0; i < 1024; i++ {
+			pathList = append(pathList, newPath(prefix(i), "10.0.0.1", false))
+		}
+		for i := 0; i < 1024; i += 2 {
+			pathList = append(pathList, newPath(prefix(i), "10.0.0.2", false))
+		}
+		for i := 0; i < 1024; i += 4 {
+			pathList = append(pathList, newPath(prefix(i), "10.0.0.2", true))
+		}
+		assert.NoError(s.addPathStream("", pathList))
+
+		m := make(map[string]string)
+		err = s.ListPath(context.Background(), &api.ListPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Family:    family,
+		}, func(d *api.Destination) {
+			attrs, err := apiutil.GetNativePathAttributes(d.Paths[0])
+			assert.NoError(err)
+			m[d.Prefix] = fmt.Sprint(len(d.Paths), attrs)
+		})
+		assert.NoError(err)
+		return m
+	}
+
+	expected := rib(1)
+	assert.Len(expected, 768-256/4*3)
+	assert.Equal("1 [{Origin: i} {Nexthop: 10.0.0.1} {Communities: 100:100}]", expected["10.0.1.0/24"])
+	assert.Equal("1 [{Origin: i} {Nexthop: 10.0.0.2} {Communities: 100:100}]", expected["10.0.2.0/24"])
+	assert.NotContains(expected, "10.0.4.0/24")
+	assert.NotContains(expected, "10.1.1.0/24")
+	assert.Equal(expected, rib(4))
+}
+
+func TestExtendedMessage(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s1 := runNewServer(t, 1, "1.1.1.1", 10190)
+	defer s1.StopBgp(ctx, &api.StopBgpRequest{})
+	s2 := runNewServer(t, 2, "2.2.2.2", 20190)
+	defer s2.StopBgp(ctx, &api.StopBgpRequest{})
+
+	if err := peerServers(t, ctx, []*BgpServer{s1, s2}, []oc.AfiSafiType{oc.AFI_SAFI_TYPE_IPV4_UNICAST}); err != nil {
+		t.Fatal(err)
+	}
+	// the attributes don't fit in 4096 bytes
+	communities := make([]uint32, 0, 2048)
+	for i := 0; i < 2048; i++ {
+		communities = append(communities, uint32(i))
+	}
+	path, _ := apiutil.NewPath(bgp.NewIPAddrPrefix(24, "10.0.0.0"), false, []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeNextHop("2.2.2.2"),
+		bgp.NewPathAttributeCommunities(communities),
+	}, time.Now())
+	_, err := s2.AddPath(ctx, &api.AddPathRequest{
+		TableType: api.TableType_GLOBAL,
+		Path:      path,
+	})
+	assert.NoError(err)
+
+	assert.Eventually(func() bool {
+		n := 0
+		s1.ListPath(ctx, &api.ListPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Family:    &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST},
+		}, func(d *api.Destination) {
+			n += len(d.Paths)
+		})
+		return n == 1
+	}, 10*time.Second, 100*time.Millisecond)
+
+	p := s1.neighborMap["127.0.0.1"]
+	p.fsm.lock.RLock()
+	assert.True(bgp.IsExtendedMessage([]*bgp.MarshallingOption{p.fsm.marshallingOptions}))
+	p.fsm.lock.RUnlock()
+}
+
+func TestAigpSession(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s1 := runNewServer(t, 1, "1.1.1.1", 10191)
+	defer s1.StopBgp(ctx, &api.StopBgpRequest{})
+	s2 := runNewServer(t, 1, "2.2.2.2", 20191)
+	defer s2.StopBgp(ctx, &api.StopBgpRequest{})
+
+	if err := peerServers(t, ctx, []*BgpServer{s1, s2}, []oc.AfiSafiType{oc.AFI_SAFI_TYPE_IPV4_UNICAST}); err != nil {
+		t.Fatal(err)
+	}
+	path, _ := apiutil.NewPath(bgp.NewIPAddrPrefix(24, "10.0.0.0"), false, []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeNextHop("2.2.2.2"),
+		bgp.NewPathAttributeAigp([]bgp.AigpTLVInterface{bgp.NewAigpTLVIgpMetric(100)}),
+	}, time.Now())
+	_, err := s2.AddPath(ctx, &api.AddPathRequest{
+		TableType: api.TableType_GLOBAL,
+		Path:      path,
+	})
+	assert.NoError(err)
+
+	// returns the AIGP metric of the received path or -1 without it
+	receivedAigp := func() int {
+		aigp := -2
+		s1.ListPath(ctx, &api.ListPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Family:    &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST},
+		}, func(d *api.Destination) {
+			for _, p := range d.Paths {
+				attrs, _ := apiutil.GetNativePathAttributes(p)
+				aigp = -1
+				for _, a := range attrs {
+					if a, ok := a.(*bgp.PathAttributeAigp); ok {
+						aigp = int(a.Values[0].(*bgp.AigpTLVIgpMetric).Metric)
+					}
+				}
+			}
+		})
+		return aigp
+	}
+	// not sent by default
+	assert.Eventually(func() bool {
+		return receivedAigp() == -1
+	}, 10*time.Second, 100*time.Millisecond)
+
+	enable := func(s *BgpServer) {
+		var peer *api.Peer
+		s.ListPeer(ctx, &api.ListPeerRequest{}, func(p *api.Peer) {
+			peer = p
+		})
+		peer.Conf.SendAigp = true
+		peer.Conf.ReceiveAigp = true
+		_, err := s.UpdatePeer(ctx, &api.UpdatePeerRequest{Peer: peer})
+		assert.NoError(err)
+	}
+	enable(s2)
+	// the receiver ignores it
+	time.Sleep(time.Second)
+	assert.Eventually(func() bool {
+		return receivedAigp() == -1
+	}, 10*time.Second, 100*time.Millisecond)
+
+	enable(s1)
+	assert.Eventually(func() bool {
+		return receivedAigp() == 100
+	}, 20*time.Second, 100*time.Millisecond)
+}
+
+func TestL2VPNFlowSpec(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s1 := runNewServer(t, 1, "1.1.1.1", 10197)
+	defer s1.StopBgp(ctx, &api.StopBgpRequest{})
+	s2 := runNewServer(t, 2, "2.2.2.2", 20197)
+	defer s2.StopBgp(ctx, &api.StopBgpRequest{})
+
+	if err := peerServers(t, ctx, []*BgpServer{s1, s2}, []oc.AfiSafiType{oc.AFI_SAFI_TYPE_L2VPN_FLOWSPEC}); err != nil {
+		t.Fatal(err)
+	}
+	mac, err := net.ParseMAC("aa:bb:cc:dd:ee:ff")
+	require.NoError(t, err)
+	nlri := bgp.NewFlowSpecL2VPN(bgp.NewRouteDistinguisherTwoOctetAS(65000, 100), []bgp.FlowSpecComponentInterface{
+		bgp.NewFlowSpecComponent(bgp.FLOW_SPEC_TYPE_ETHERNET_TYPE, []*bgp.FlowSpecComponentItem{
+			bgp.NewFlowSpecComponentItem(bgp.DEC_NUM_OP_EQ, 0x0800),
+		}),
+		bgp.NewFlowSpecDestinationMac(mac),
+		bgp.NewFlowSpecComponent(bgp.FLOW_SPEC_TYPE_VID, []*bgp.FlowSpecComponentItem{
+			bgp.NewFlowSpecComponentItem(bgp.DEC_NUM_OP_EQ, 100),
+		}),
+	})
+	path, err := apiutil.NewPath(nlri, false, []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeMpReachNLRI("0.0.0.0", []bgp.AddrPrefixInterface{nlri}),
+		bgp.NewPathAttributeExtendedCommunities([]bgp.ExtendedCommunityInterface{
+			bgp.NewTrafficRateExtended(0, 0),
+		}),
+	}, time.Now())
+	require.NoError(t, err)
+	_, err = s1.AddPath(ctx, &api.AddPathRequest{
+		TableType: api.TableType_GLOBAL,
+		Path:      path,
+	})
+	assert.NoError(err)
+
+	var received string
+	assert.Eventually(func() bool {
+		err := s2.ListPath(ctx, &api.ListPathRequest{
+			TableType: api.TableType_GLOBAL,
+			Family:    &api.Family{Afi: api.Family_AFI_L2VPN, Safi: api.Family_SAFI_FLOW_SPEC_VPN},
+		}, func(d *api.Destination) {
+			received = d.Prefix
+		})
+		return assert.NoError(err) && received != ""
+	}, 10*time.Second, 100*time.Millisecond)
+	assert.Equal(nlri.String(), received)
+}
+
+func TestModuleLogLevel(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	s := runNewServer(t, 1, "1.1.1.1", -1)
+	defer s.Stop()
+
+	err := s.AddPeer(ctx, &api.AddPeerRequest{
+		Peer: &api.Peer{
+			Conf:      &api.PeerConf{NeighborAddress: "127.0.0.1", PeerAsn: 2},
+			Transport: &api.Transport{PassiveMode: true},
+		},
+	})
+	assert.NoError(err)
+	p := s.neighborMap["127.0.0.1"]
+
+	err = s.SetLogLevel(ctx, &api.SetLogLevelRequest{Level: api.SetLogLevelRequest_DEBUG, Module: log.ModuleFsm})
+	assert.NoError(err)
+	assert.Equal(log.DebugLevel, p.fsm.logger.GetLevel())
+	assert.Equal(log.InfoLevel, s.logLevels.Level(log.ModuleTable))
+	rsp, err := s.GetLogLevel(ctx, &api.GetLogLevelRequest{})
+	assert.NoError(err)
+	assert.Equal(api.SetLogLevelRequest_INFO, rsp.Level)
+	assert.Equal(map[string]api.SetLogLevelRequest_Level{log.ModuleFsm: api.SetLogLevelRequest_DEBUG}, rsp.Modules)
+
+	// the module follows the global level again
+	err = s.SetLogLevel(ctx, &api.SetLogLevelRequest{Module: log.ModuleFsm, Unset: true})
+	assert.NoError(err)
+	err = s.SetLogLevel(ctx, &api.SetLogLevelRequest{Level: api.SetLogLevelRequest_WARN})
+	assert.NoError(err)
+	assert.Equal(log.WarnLevel, p.fsm.logger.GetLevel())
+	rsp, err = s.GetLogLevel(ctx, &api.GetLogLevelRequest{})
+	assert.NoError(err)
+	assert.Equal(api.SetLogLevelRequest_WARN, rsp.Level)
+	assert.Empty(rsp.Modules)
+
+	assert.Error(s.SetLogLevel(ctx, &api.SetLogLevelRequest{Module: "unknown"}))
+	assert.Error(s.SetLogLevel(ctx, &api.SetLogLevelRequest{Unset: true}))
+}
+
+func TestDisableFourOctetAs(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s1 := runNewServer(t, 1, "1.1.1.1", 10179)
+	defer s1.StopBgp(ctx, &api.StopBgpRequest{})
+	s2 := runNewServer(t, 2, "2.2.2.2", -1)
+	defer s2.StopBgp(ctx, &api.StopBgpRequest{})
+
+	family := &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST}
+	origin, _ := apb.New(&api.OriginAttribute{Origin: 0})
+	nlri, _ := apb.New(&api.IPAddressPrefix{Prefix: "10.1.0.0", PrefixLen: 24})
+	nh, _ := apb.New(&api.NextHopAttribute{NextHop: "10.0.0.1"})
+	asPath, _ := apb.New(&api.AsPathAttribute{
+		Segments: []*api.AsSegment{{Type: 2, Numbers: []uint32{400000, 100}}},
+	})
+	aggregator, _ := apb.New(&api.AggregatorAttribute{Asn: 400001, Address: "10.0.0.2"})
+	_, err := s1.AddPath(ctx, &api.AddPathRequest{
+		Path: &api.Path{Family: family, Nlri: nlri, Pattrs: []*apb.Any{origin, nh, asPath, aggregator}},
+	})
+	assert.Nil(err)
+
+	// s1 acts as the 2-octet only speaker
+	ch := make(chan struct{})
+	go waitEstablished(s2, ch)
+	assert.Nil(s1.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{
+		Conf:      &api.PeerConf{NeighborAddress: "127.0.0.1", PeerAsn: 2, DisableFourOctetAs: true},
+		Transport: &api.Transport{PassiveMode: true},
+	}}))
+	assert.Nil(s2.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{
+		Conf:      &api.PeerConf{NeighborAddress: "127.0.0.1", PeerAsn: 1},
+		Transport: &api.Transport{RemotePort: 10179},
+		Timers:    &api.Timers{Config: &api.TimersConfig{ConnectRetry: 1}},
+	}}))
+	<-ch
+
+	for _, s := range []*BgpServer{s1, s2} {
+		assert.Nil(s.ListPeer(ctx, &api.ListPeerRequest{}, func(p *api.Peer) {
+			assert.False(p.State.FourOctetAs)
+		}))
+	}
+
+	// the AS path reconstructed from AS_PATH with AS_TRANS and AS4_PATH
+	var path *api.Path
+	assert.Eventually(func() bool {
+		err := s2.ListPath(ctx, &api.ListPathRequest{TableType: api.TableType_GLOBAL, Family: family}, func(d *api.Destination) {
+			path = d.Paths[0]
+		})
+		return err == nil && path != nil
+	}, 5*time.Second, 100*time.Millisecond)
+	attrs, err := apiutil.GetNativePathAttributes(path)
+	assert.Nil(err)
+	for _, a := range attrs {
+		switch a := a.(type) {
+		case *bgp.PathAttributeAsPath:
+			assert.Equal([]uint32{1, 400000, 100}, a.Value[0].GetAS())
+		case *bgp.PathAttributeAggregator:
+			assert.Equal(uint32(400001), a.Value.AS)
+		case *bgp.PathAttributeAs4Path, *bgp.PathAttributeAs4Aggregator:
+			assert.Fail("AS4 attribute isn't removed", a.String())
+		}
+	}
+}
+
+func TestLocalAsMigration(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s1 := runNewServer(t, 1, "1.1.1.1", 10179)
+	defer s1.StopBgp(ctx, &api.StopBgpRequest{})
+	s2 := runNewServer(t, 2, "2.2.2.2", -1)
+	defer s2.StopBgp(ctx, &api.StopBgpRequest{})
+
+	family := &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST}
+	addPath := func(s *BgpServer, prefix string) {
+		origin, _ := apb.New(&api.OriginAttribute{Origin: 0})
+		nlri, _ := apb.New(&api.IPAddressPrefix{Prefix: prefix, PrefixLen: 24})
+		nh, _ := apb.New(&api.NextHopAttribute{NextHop: "10.0.0.1"})
+		_, err := s.AddPath(ctx, &api.AddPathRequest{
+			Path: &api.Path{Family: family, Nlri: nlri, Pattrs: []*apb.Any{origin, nh}},
+		})
+		assert.Nil(err)
+	}
+	addPath(s1, "10.1.0.0")
+	addPath(s2, "10.2.0.0")
+
+	// s1 migrates to AS 1 from AS 100, which s2 still peers with
+	ch := make(chan struct{})
+	go waitEstablished(s2, ch)
+	assert.Nil(s1.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{
+		Conf:      &api.PeerConf{NeighborAddress: "127.0.0.1", PeerAsn: 2, LocalAsn: 100, LocalAsMigration: true},
+		Transport: &api.Transport{PassiveMode: true},
+	}}))
+	assert.Nil(s2.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{
+		Conf:      &api.PeerConf{NeighborAddress: "127.0.0.1", PeerAsn: 100},
+		Transport: &api.Transport{RemotePort: 10179},
+		Timers:    &api.Timers{Config: &api.TimersConfig{ConnectRetry: 1}},
+	}}))
+	<-ch
+
+	asPath := func(s *BgpServer, prefix string) []uint32 {
+		var l []uint32
+		assert.Eventually(func() bool {
+			err := s.ListPath(ctx, &api.ListPathRequest{TableType: api.TableType_GLOBAL, Family: family}, func(d *api.Destination) {
+				if d.Prefix != prefix {
+					return
+				}
+				attrs, _ := apiutil.GetNativePathAttributes(d.Paths[0])
+				for _, a := range attrs {
+					if a, ok := a.(*bgp.PathAttributeAsPath); ok {
+						l = a.Value[0].GetAS()
+					}
+				}
+			})
+			return err == nil && l != nil
+		}, 5*time.Second, 100*time.Millisecond)
+		return l
+	}
+	// both ASes are prepended to the advertised routes, and the local-as
+	// to the received ones
+	assert.Equal([]uint32{100, 1}, asPath(s2, "10.1.0.0/24"))
+	assert.Equal([]uint32{100, 2}, asPath(s1, "10.2.0.0/24"))
+
+	// local-as validation
+	for _, conf := range []*api.PeerConf{
+		{NeighborAddress: "10.0.0.2", PeerAsn: 2, LocalAsMigration: true},
+		{NeighborAddress: "10.0.0.2", PeerAsn: 1, LocalAsn: 1, LocalAsMigration: true},
+		{NeighborAddress: "10.0.0.2", PeerAsn: 2, LocalAsn: 100, LocalAsNoPrepend: true},
+	} {
+		assert.NotNil(s1.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{Conf: conf}}))
+	}
+}
+
+func TestLoopPrevention(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s1 := runNewServer(t, 1, "1.1.1.1", 10179)
+	defer s1.StopBgp(ctx, &api.StopBgpRequest{})
+	s2 := runNewServer(t, 2, "2.2.2.2", -1)
+	defer s2.StopBgp(ctx, &api.StopBgpRequest{})
+
+	family := &api.Family{Afi: api.Family_AFI_IP, Safi: api.Family_SAFI_UNICAST}
+	addPath := func(s *BgpServer, prefix string, as []uint32) {
+		origin, _ := apb.New(&api.OriginAttribute{Origin: 0})
+		nlri, _ := apb.New(&api.IPAddressPrefix{Prefix: prefix, PrefixLen: 24})
+		nh, _ := apb.New(&api.NextHopAttribute{NextHop: "10.0.0.1"})
+		asPath, _ := apb.New(&api.AsPathAttribute{
+			Segments: []*api.AsSegment{{Type: 2, Numbers: as}},
+		})
+		_, err := s.AddPath(ctx, &api.AddPathRequest{
+			Path: &api.Path{Family: family, Nlri: nlri, Pattrs: []*apb.Any{origin, nh, asPath}},
+		})
+		assert.Nil(err)
+	}
+	// the routes between the sites of the same AS
+	addPath(s1, "10.1.0.0", []uint32{2, 300})
+	addPath(s2, "10.2.0.0", []uint32{1, 300})
+
+	ch := make(chan struct{})
+	go waitEstablished(s2, ch)
+	assert.Nil(s1.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{
+		Conf:      &api.PeerConf{NeighborAddress: "127.0.0.1", PeerAsn: 2},
+		Transport: &api.Transport{PassiveMode: true},
+		AfiSafis: []*api.AfiSafi{{
+			Config: &api.AfiSafiConfig{Family: family, Enabled: true},
+			LoopPrevention: &api.LoopPrevention{
+				Config: &api.LoopPreventionConfig{AllowOwnAsn: 1, ReplacePeerAsn: true},
+			},
+		}},
+	}}))
+	assert.Nil(s2.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{
+		Conf:      &api.PeerConf{NeighborAddress: "127.0.0.1", PeerAsn: 1},
+		Transport: &api.Transport{RemotePort: 10179},
+		Timers:    &api.Timers{Config: &api.TimersConfig{ConnectRetry: 1}},
+	}}))
+	<-ch
+
+	asPath := func(s *BgpServer, prefix string) []uint32 {
+		var l []uint32
+		assert.Eventually(func() bool {
+			err := s.ListPath(ctx, &api.ListPathRequest{TableType: api.TableType_GLOBAL, Family: family}, func(d *api.Destination) {
+				if d.Prefix != prefix {
+					return
+				}
+				attrs, _ := apiutil.GetNativePathAttributes(d.Paths[0])
+				for _, a := range attrs {
+					if a, ok := a.(*bgp.PathAttributeAsPath); ok {
+						l = a.Value[0].GetAS()
+					}
+				}
+			})
+			return err == nil && l != nil
+		}, 5*time.Second, 100*time.Millisecond)
+		return l
+	}
+	// the AS of s2 is replaced on export (as-override), and the routes
+	// with the own AS are accepted (allowas-in)
+	assert.Equal([]uint32{1, 1, 300}, asPath(s2, "10.1.0.0/24"))
+	assert.Equal([]uint32{2, 1, 300}, asPath(s1, "10.2.0.0/24"))
+
+	assert.Nil(s1.ListPeer(ctx, &api.ListPeerRequest{}, func(p *api.Peer) {
+		assert.Equal(uint32(1), p.AfiSafis[0].LoopPrevention.State.AllowOwnAsn)
+		assert.True(p.AfiSafis[0].LoopPrevention.State.ReplacePeerAsn)
+	}))
+}
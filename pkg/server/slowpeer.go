@@ -0,0 +1,178 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/eapache/channels"
+
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/log"
+)
+
+const (
+	// the peer is regarded as slow when the depth of the outgoing queue
+	// stays at slowPeerQueueHigh or more for slowPeerDetectTime
+	slowPeerQueueHigh  = 1000
+	slowPeerDetectTime = 5 * time.Second
+	// the depth of the outgoing queue at which the buffered paths are
+	// moved to it
+	slowPeerQueueLow      = 100
+	slowPeerCheckInterval = 100 * time.Millisecond
+)
+
+// slowPeerQueue buffers the paths to the peer which doesn't consume its
+// outgoing queue fast enough, e.g. its TCP socket stalls. Once the outgoing
+// queue stays at slowPeerQueueHigh or more for slowPeerDetectTime, the paths
+// are held here instead and
+// only the last path for each NLRI is kept, so the buffer is bounded by the
+// number of the NLRIs however often they change. A dedicated goroutine
+// moves the buffered paths to the outgoing queue whenever it drains, and
+// the peer recovers when nothing is left.
+type slowPeerQueue struct {
+	mu     sync.Mutex
+	logger log.Logger
+	slow   bool
+	// when the outgoing queue reached slowPeerQueueHigh
+	highSince time.Time
+	paths     []*table.Path
+	// the index of the path in paths for the key of the NLRI
+	index  map[string]int
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	// the number of the times the peer became slow
+	count uint32
+	// the number of the paths replaced by the later ones for the same NLRI
+	dropped uint64
+}
+
+func newSlowPeerQueue(logger log.Logger) *slowPeerQueue {
+	return &slowPeerQueue{
+		logger: logger,
+	}
+}
+
+// add buffers the paths if the peer is slow, or becomes slow with the
+// current depth of the outgoing queue. It returns false if the paths
+// should be put in the outgoing queue.
+func (q *slowPeerQueue) add(peer *peer, paths []*table.Path) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	fsm := peer.fsm
+	if !q.slow {
+		depth := fsm.outgoingQueueLen()
+		if depth < slowPeerQueueHigh {
+			q.highSince = time.Time{}
+			return false
+		}
+		if q.highSince.IsZero() {
+			q.highSince = time.Now()
+		}
+		if time.Since(q.highSince) < slowPeerDetectTime {
+			return false
+		}
+		q.slow = true
+		q.highSince = time.Time{}
+		q.count++
+		q.index = make(map[string]int)
+		q.logger.Warn("slow peer detected, buffering the outgoing paths",
+			log.Fields{
+				"Topic": "Peer",
+				"Key":   peer.ID(),
+				"Queue": depth})
+		var ctx context.Context
+		ctx, q.cancel = context.WithCancel(context.Background())
+		q.wg.Add(1)
+		go q.loop(ctx, peer, fsm.outgoingCh)
+	}
+	fsm.lock.RLock()
+	options := fsm.marshallingOptions
+	fsm.lock.RUnlock()
+	for _, path := range paths {
+		if !path.IsEOR() {
+			key := outgoingPathKey(path, options)
+			if i, ok := q.index[key]; ok {
+				q.paths[i] = path
+				q.dropped++
+				continue
+			}
+			q.index[key] = len(q.paths)
+		}
+		q.paths = append(q.paths, path)
+	}
+	return true
+}
+
+func (q *slowPeerQueue) loop(ctx context.Context, peer *peer, outgoing *channels.InfiniteChannel) {
+	defer q.wg.Done()
+	ticker := time.NewTicker(slowPeerCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if peer.fsm.outgoingQueueLen() > slowPeerQueueLow {
+			continue
+		}
+		q.mu.Lock()
+		if len(q.paths) == 0 {
+			q.slow = false
+			q.index = nil
+			q.mu.Unlock()
+			q.logger.Info("slow peer recovered",
+				log.Fields{
+					"Topic": "Peer",
+					"Key":   peer.ID()})
+			return
+		}
+		// under the lock not to be overtaken by the paths added after the
+		// recovery
+		outgoing.In() <- &fsmOutgoingMsg{Paths: q.paths}
+		q.paths = nil
+		q.index = make(map[string]int)
+		q.mu.Unlock()
+	}
+}
+
+// reset discards the buffered paths and stops the goroutine, before the
+// outgoing queue is cleaned.
+func (q *slowPeerQueue) reset() {
+	q.mu.Lock()
+	cancel := q.cancel
+	q.slow = false
+	q.highSince = time.Time{}
+	q.paths = nil
+	q.index = nil
+	q.cancel = nil
+	q.mu.Unlock()
+	if cancel != nil {
+		cancel()
+	}
+	q.wg.Wait()
+}
+
+// state returns whether the peer is slow, the number of the times it
+// became slow and the number of the paths dropped.
+func (q *slowPeerQueue) state() (bool, uint32, uint64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.slow, q.count, q.dropped
+}
@@ -0,0 +1,102 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/log"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func TestSlowPeerQueue(t *testing.T) {
+	assert := assert.New(t)
+
+	newPath := func(prefix string, withdraw bool) *table.Path {
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop("10.0.0.1"),
+		}
+		return table.NewPath(&table.PeerInfo{}, bgp.NewIPAddrPrefix(24, prefix), withdraw, attrs, time.Now(), false)
+	}
+
+	logger := log.NewDefaultLogger()
+	p := &peer{
+		fsm:       newFSM(&oc.Global{}, &oc.Neighbor{}, logger),
+		slowQueue: newSlowPeerQueue(logger),
+		mrai:      newMraiQueue(),
+	}
+	defer p.slowQueue.reset()
+
+	p1 := newPath("10.1.0.0", false)
+	sendfsmOutgoingMsg(p, []*table.Path{p1}, nil, false)
+	assert.Equal(1, p.fsm.outgoingCh.Len())
+	for i := 1; i < slowPeerQueueHigh; i++ {
+		sendfsmOutgoingMsg(p, []*table.Path{p1}, nil, false)
+	}
+	assert.Equal(slowPeerQueueHigh, p.fsm.outgoingCh.Len())
+	slow, _, _ := p.slowQueue.state()
+	assert.False(slow)
+	sendfsmOutgoingMsg(p, []*table.Path{p1}, nil, false)
+	assert.Equal(slowPeerQueueHigh+1, p.fsm.outgoingCh.Len())
+	slow, _, _ = p.slowQueue.state()
+	assert.False(slow)
+	// the queue has stayed long enough
+	p.slowQueue.highSince = time.Now().Add(-slowPeerDetectTime)
+
+	// the paths for the same NLRI are coalesced once the peer is slow
+	p2 := newPath("10.2.0.0", false)
+	p3 := newPath("10.1.0.0", true)
+	eor := table.NewEOR(bgp.RF_IPv4_UC)
+	sendfsmOutgoingMsg(p, []*table.Path{p1, p2}, nil, false)
+	sendfsmOutgoingMsg(p, []*table.Path{p3, eor}, nil, false)
+	assert.Equal(slowPeerQueueHigh+1, p.fsm.outgoingCh.Len())
+	slow, count, dropped := p.slowQueue.state()
+	assert.True(slow)
+	assert.Equal(uint32(1), count)
+	assert.Equal(uint64(1), dropped)
+
+	// notifications aren't buffered
+	sendfsmOutgoingMsg(p, nil, bgp.NewBGPNotificationMessage(bgp.BGP_ERROR_CEASE, 0, nil), false)
+	assert.Equal(slowPeerQueueHigh+2, p.fsm.outgoingCh.Len())
+
+	for i := 0; i < slowPeerQueueHigh+2; i++ {
+		<-p.fsm.outgoingCh.Out()
+	}
+	var m *fsmOutgoingMsg
+	select {
+	case o := <-p.fsm.outgoingCh.Out():
+		m = o.(*fsmOutgoingMsg)
+	case <-time.After(10 * time.Second):
+		t.Fatal("the buffered paths aren't flushed")
+	}
+	assert.Equal([]*table.Path{p3, p2, eor}, m.Paths)
+
+	assert.Eventually(func() bool {
+		slow, _, _ := p.slowQueue.state()
+		return !slow
+	}, 10*time.Second, 100*time.Millisecond)
+	sendfsmOutgoingMsg(p, []*table.Path{p1}, nil, false)
+	assert.Equal(1, p.fsm.outgoingCh.Len())
+	_, count, dropped = p.slowQueue.state()
+	assert.Equal(uint32(1), count)
+	assert.Equal(uint64(1), dropped)
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net/netip"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+// srv6MaxFunctionLength is limited by the label where the function is
+// transposed.
+const srv6MaxFunctionLength = 20
+
+// srv6Locator allocates the End.DT46 SID of each VRF from the locator. The
+// function part of the SID identifies the VRF.
+type srv6Locator struct {
+	prefix         netip.Prefix
+	blockLength    uint8
+	nodeLength     uint8
+	functionLength uint8
+	argumentLength uint8
+	transposition  bool
+	functions      *table.Bitmap
+}
+
+func newSRv6Locator(r *api.SetSrv6LocatorRequest) (*srv6Locator, error) {
+	prefix, err := netip.ParsePrefix(r.Prefix)
+	if err != nil || !prefix.Addr().Is6() {
+		return nil, fmt.Errorf("invalid srv6 locator: %s", r.Prefix)
+	}
+	if r.BlockLength+r.NodeLength != uint32(prefix.Bits()) {
+		return nil, fmt.Errorf("prefix length of srv6 locator %s must be the sum of block length %d and node length %d", r.Prefix, r.BlockLength, r.NodeLength)
+	}
+	if r.FunctionLength == 0 || r.FunctionLength > srv6MaxFunctionLength {
+		return nil, fmt.Errorf("invalid srv6 function length: %d", r.FunctionLength)
+	}
+	if uint32(prefix.Bits())+r.FunctionLength+r.ArgumentLength > 128 {
+		return nil, fmt.Errorf("srv6 sid structure exceeds 128 bits")
+	}
+	l := &srv6Locator{
+		prefix:         prefix.Masked(),
+		blockLength:    uint8(r.BlockLength),
+		nodeLength:     uint8(r.NodeLength),
+		functionLength: uint8(r.FunctionLength),
+		argumentLength: uint8(r.ArgumentLength),
+		transposition:  r.Transposition,
+		functions:      table.NewBitmap(1 << r.FunctionLength),
+	}
+	// the function 0 isn't used
+	l.functions.Flag(0)
+	return l, nil
+}
+
+// allocate returns the SID of the unused function.
+func (l *srv6Locator) allocate() (*bgp.SRv6SID, error) {
+	f, err := l.functions.FindandSetZeroBit()
+	if err != nil || f >= 1<<l.functionLength {
+		return nil, fmt.Errorf("no srv6 function available in locator %s", l.prefix)
+	}
+	sid := l.prefix.Addr().As16()
+	offset := l.prefix.Bits()
+	for i := 0; i < int(l.functionLength); i++ {
+		if f&(1<<(int(l.functionLength)-1-i)) != 0 {
+			pos := offset + i
+			sid[pos/8] |= 0x80 >> (pos % 8)
+		}
+	}
+	var tl, to uint8
+	if l.transposition {
+		tl, to = l.functionLength, uint8(offset)
+	}
+	return &bgp.SRv6SID{
+		SID:       netip.AddrFrom16(sid),
+		Behavior:  bgp.END_DT46,
+		Structure: bgp.NewSRv6SIDStructureSubSubTLV(l.blockLength, l.nodeLength, l.functionLength, l.argumentLength, tl, to),
+	}, nil
+}
+
+// release returns the function of the SID to the locator.
+func (l *srv6Locator) release(sid *bgp.SRv6SID) {
+	if !l.prefix.Contains(sid.SID) {
+		return
+	}
+	b := sid.SID.As16()
+	offset := l.prefix.Bits()
+	var f uint
+	for i := 0; i < int(l.functionLength); i++ {
+		pos := offset + i
+		f <<= 1
+		if b[pos/8]&(0x80>>(pos%8)) != 0 {
+			f |= 1
+		}
+	}
+	if f > 0 {
+		l.functions.Unflag(f)
+	}
+}
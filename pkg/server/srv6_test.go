@@ -0,0 +1,133 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/apiutil"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func Test_srv6Locator(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := newSRv6Locator(&api.SetSrv6LocatorRequest{Prefix: "10.0.0.0/8", BlockLength: 8, FunctionLength: 16})
+	assert.Error(err)
+	_, err = newSRv6Locator(&api.SetSrv6LocatorRequest{Prefix: "fd00:1:1::/48", BlockLength: 32, NodeLength: 8, FunctionLength: 16})
+	assert.Error(err)
+	_, err = newSRv6Locator(&api.SetSrv6LocatorRequest{Prefix: "fd00:1:1::/48", BlockLength: 32, NodeLength: 16, FunctionLength: 24})
+	assert.Error(err)
+
+	l, err := newSRv6Locator(&api.SetSrv6LocatorRequest{
+		Prefix:         "fd00:1:1::/48",
+		BlockLength:    32,
+		NodeLength:     16,
+		FunctionLength: 2,
+		Transposition:  true,
+	})
+	assert.Nil(err)
+
+	sid1, err := l.allocate()
+	assert.Nil(err)
+	assert.Equal("fd00:1:1:4000::", sid1.SID.String())
+	assert.Equal(bgp.END_DT46, sid1.Behavior)
+	assert.Equal(uint8(2), sid1.Structure.TranspositionLength)
+	assert.Equal(uint8(48), sid1.Structure.TranspositionOffset)
+	sid2, _ := l.allocate()
+	assert.Equal("fd00:1:1:8000::", sid2.SID.String())
+	sid3, _ := l.allocate()
+	assert.Equal("fd00:1:1:c000::", sid3.SID.String())
+	_, err = l.allocate()
+	assert.Error(err)
+
+	l.release(sid2)
+	sid, err := l.allocate()
+	assert.Nil(err)
+	assert.Equal(sid2.SID, sid.SID)
+}
+
+func TestSetSrv6Locator(t *testing.T) {
+	assert := assert.New(t)
+	s := runNewServer(t, 1, "1.1.1.1", -1)
+	defer s.StopBgp(context.Background(), &api.StopBgpRequest{})
+
+	err := s.SetSrv6Locator(context.Background(), &api.SetSrv6LocatorRequest{
+		Prefix:         "fd00:1:1::/48",
+		BlockLength:    32,
+		NodeLength:     16,
+		FunctionLength: 16,
+		Transposition:  true,
+	})
+	assert.Nil(err)
+	addVrf(t, s, "vrf1", "111:111", []string{"111:111"}, []string{"111:111"}, 1)
+
+	err = s.SetSrv6Locator(context.Background(), &api.SetSrv6LocatorRequest{
+		Prefix:         "fd00:1:2::/48",
+		BlockLength:    32,
+		NodeLength:     16,
+		FunctionLength: 16,
+	})
+	assert.Error(err)
+
+	var vrfs []*api.Vrf
+	s.ListVrf(context.Background(), &api.ListVrfRequest{}, func(v *api.Vrf) {
+		vrfs = append(vrfs, v)
+	})
+	assert.Len(vrfs, 1)
+	assert.Equal("fd00:1:1:1::", vrfs[0].Srv6Sid)
+
+	nlri := bgp.NewIPv6AddrPrefix(64, "2001:db8:1::")
+	attrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(0),
+		bgp.NewPathAttributeMpReachNLRI("fd00::1", []bgp.AddrPrefixInterface{nlri}),
+	}
+	path, _ := apiutil.NewPath(nlri, false, attrs, time.Now())
+	_, err = s.AddPath(context.Background(), &api.AddPathRequest{
+		TableType: api.TableType_VRF,
+		VrfId:     "vrf1",
+		Path:      path,
+	})
+	assert.Nil(err)
+
+	var paths []*table.Path
+	s.mgmtOperation(func() error {
+		paths = s.globalRib.GetPathList(table.GLOBAL_RIB_NAME, 0, []bgp.RouteFamily{bgp.RF_IPv6_VPN})
+		return nil
+	}, true)
+	assert.Len(paths, 1)
+	vpn := paths[0].GetNlri().(*bgp.LabeledVPNIPv6AddrPrefix)
+	// the function is transposed into the label
+	assert.Equal([]uint32{0x10}, vpn.Labels.Labels)
+	var prefixSID *bgp.PathAttributePrefixSID
+	for _, a := range paths[0].GetPathAttrs() {
+		if p, ok := a.(*bgp.PathAttributePrefixSID); ok {
+			prefixSID = p
+		}
+	}
+	assert.NotNil(prefixSID)
+	info := prefixSID.TLVs[0].(*bgp.SRv6ServiceTLV).SubTLVs[0].(*bgp.SRv6InformationSubTLV)
+	assert.Equal("fd00:1:1::", net.IP(info.SID).String())
+	assert.Equal(uint16(bgp.END_DT46), info.EndpointBehavior)
+	assert.Equal("fd00:1:1:1::", srv6SIDFromPath(paths[0]).String())
+}
@@ -0,0 +1,505 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/config/oc"
+	"github.com/osrg/gobgp/v3/pkg/log"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+// configTransaction is the configuration staged to be committed, and the
+// commit waiting for the confirmation. The configuration before the commit
+// is restored unless it's confirmed in time so that the operator changing
+// the configuration over the session being changed doesn't lose the
+// access to the router.
+type configTransaction struct {
+	staged *api.StageConfigRequest
+	// non-nil while the commit waits for the confirmation
+	rollback *configSnapshot
+	timer    *time.Timer
+	deadline time.Time
+}
+
+// configSnapshot is the configuration replaced by the commit. The nil
+// sections weren't changed.
+type configSnapshot struct {
+	neighbors *configNeighbors
+	policy    *table.PolicySnapshot
+}
+
+// configNeighbors is the peer groups and the neighbors except the dynamic
+// ones.
+type configNeighbors struct {
+	peerGroups []*oc.PeerGroup
+	neighbors  []*oc.Neighbor
+}
+
+func newConfigNeighbors(n *api.ConfigNeighbors) (*configNeighbors, error) {
+	c := &configNeighbors{
+		peerGroups: make([]*oc.PeerGroup, 0, len(n.PeerGroups)),
+		neighbors:  make([]*oc.Neighbor, 0, len(n.Peers)),
+	}
+	groups := make(map[string]bool, len(n.PeerGroups))
+	for _, p := range n.PeerGroups {
+		pg, err := newPeerGroupFromAPIStruct(p)
+		if err != nil {
+			return nil, err
+		}
+		name := pg.Config.PeerGroupName
+		if groups[name] {
+			return nil, fmt.Errorf("duplicated peer-group: %s", name)
+		}
+		groups[name] = true
+		c.peerGroups = append(c.peerGroups, pg)
+	}
+	addrs := make(map[string]bool, len(n.Peers))
+	for _, p := range n.Peers {
+		neighbor, err := newNeighborFromAPIStruct(p)
+		if err != nil {
+			return nil, err
+		}
+		// the address of the unnumbered neighbor may not be discovered
+		addr := neighbor.Config.NeighborInterface
+		if addr == "" {
+			if addr, err = neighbor.ExtractNeighborAddress(); err != nil {
+				return nil, err
+			}
+		}
+		if addrs[addr] {
+			return nil, fmt.Errorf("duplicated peer: %s", addr)
+		}
+		addrs[addr] = true
+		if name := neighbor.Config.PeerGroup; name != "" && !groups[name] {
+			return nil, fmt.Errorf("no such peer-group: %s", name)
+		}
+		c.neighbors = append(c.neighbors, neighbor)
+	}
+	return c, nil
+}
+
+// configNeighbors returns the copy of the configuration of the peer
+// groups and the neighbors except the dynamic ones.
+func (s *BgpServer) configNeighbors() *configNeighbors {
+	c := &configNeighbors{
+		peerGroups: make([]*oc.PeerGroup, 0, len(s.peerGroupMap)),
+		neighbors:  make([]*oc.Neighbor, 0, len(s.neighborMap)),
+	}
+	for _, pg := range s.peerGroupMap {
+		conf := *pg.Conf
+		c.peerGroups = append(c.peerGroups, &conf)
+	}
+	for _, peer := range s.neighborMap {
+		if peer.isDynamicNeighbor() {
+			continue
+		}
+		peer.fsm.lock.RLock()
+		conf := *peer.fsm.pConf
+		peer.fsm.lock.RUnlock()
+		c.neighbors = append(c.neighbors, &conf)
+	}
+	return c
+}
+
+// replaceNeighbors replaces the peer groups and the neighbors except the
+// dynamic ones with c, and returns whether the soft reset in is needed.
+func (s *BgpServer) replaceNeighbors(c *configNeighbors) (needsSoftResetIn bool, err error) {
+	groups := make(map[string]bool, len(c.peerGroups))
+	for _, pg := range c.peerGroups {
+		groups[pg.Config.PeerGroupName] = true
+	}
+	configured := make(map[*peer]bool, len(c.neighbors))
+	for _, n := range c.neighbors {
+		_, peer, err := s.configuredPeer(n)
+		if err != nil {
+			return false, err
+		}
+		configured[peer] = true
+	}
+
+	for _, peer := range s.neighborMap {
+		if peer.isDynamicNeighbor() || configured[peer] {
+			continue
+		}
+		if err := s.deleteNeighbor(peer.fsm.pConf, bgp.BGP_ERROR_CEASE, bgp.BGP_ERROR_SUB_PEER_DECONFIGURED); err != nil {
+			return needsSoftResetIn, err
+		}
+	}
+	for _, pg := range c.peerGroups {
+		old, ok := s.peerGroupMap[pg.Config.PeerGroupName]
+		if !ok {
+			if err := s.addPeerGroup(pg); err != nil {
+				return needsSoftResetIn, err
+			}
+			continue
+		}
+		if old.Conf.Equal(pg) {
+			continue
+		}
+		u, err := s.updatePeerGroup(pg)
+		if err != nil {
+			return needsSoftResetIn, err
+		}
+		needsSoftResetIn = needsSoftResetIn || u
+	}
+	for _, n := range c.neighbors {
+		if _, peer, _ := s.configuredPeer(n); peer == nil {
+			if err := s.addNeighbor(n); err != nil {
+				return needsSoftResetIn, err
+			}
+			continue
+		}
+		u, err := s.updateNeighbor(n)
+		if err != nil {
+			return needsSoftResetIn, err
+		}
+		needsSoftResetIn = needsSoftResetIn || u
+	}
+	for name := range s.peerGroupMap {
+		if !groups[name] {
+			if err := s.deletePeerGroup(name); err != nil {
+				return needsSoftResetIn, err
+			}
+		}
+	}
+	return needsSoftResetIn, nil
+}
+
+// applyConfig applies the neighbors and the policies, the nil ones aren't
+// changed, and evaluates the paths again.
+func (s *BgpServer) applyConfig(neighbors *configNeighbors, replacePolicy func() error) error {
+	needsSoftResetIn := false
+	if replacePolicy != nil {
+		if err := replacePolicy(); err != nil {
+			return err
+		}
+		needsSoftResetIn = true
+	}
+	if neighbors != nil {
+		u, err := s.replaceNeighbors(neighbors)
+		if err != nil {
+			return err
+		}
+		needsSoftResetIn = needsSoftResetIn || u
+	}
+	if needsSoftResetIn {
+		s.softResetIn("", bgp.RouteFamily(0))
+	}
+	if replacePolicy != nil {
+		return s.softResetOut("", bgp.RouteFamily(0), false)
+	}
+	return nil
+}
+
+// commitConfig validates and applies the staged configuration, and returns
+// the configuration replaced. Nothing is changed on error.
+func (s *BgpServer) commitConfig(r *api.StageConfigRequest) (*configSnapshot, error) {
+	var neighbors *configNeighbors
+	if r.Neighbors != nil {
+		var err error
+		if neighbors, err = newConfigNeighbors(r.Neighbors); err != nil {
+			return nil, err
+		}
+		groups := make(map[string]bool, len(neighbors.peerGroups))
+		for _, pg := range neighbors.peerGroups {
+			groups[pg.Config.PeerGroupName] = true
+		}
+		for addr, peer := range s.neighborMap {
+			if !peer.isDynamicNeighbor() {
+				continue
+			}
+			peer.fsm.lock.RLock()
+			name := peer.fsm.pConf.Config.PeerGroup
+			peer.fsm.lock.RUnlock()
+			if !groups[name] {
+				return nil, fmt.Errorf("peer-group %s is used by dynamic neighbor %s", name, addr)
+			}
+		}
+		for _, n := range neighbors.neighbors {
+			addr, _ := n.ExtractNeighborAddress()
+			if peer, ok := s.neighborMap[addr]; ok && peer.isDynamicNeighbor() {
+				return nil, fmt.Errorf("can't overwrite the dynamic neighbor: %s", addr)
+			}
+		}
+	}
+
+	var replacePolicy func() error
+	if r.Policies != nil {
+		rp, err := newRoutingPolicyFromApiStruct(&api.SetPoliciesRequest{
+			DefinedSets: r.Policies.DefinedSets,
+			Policies:    r.Policies.Policies,
+		})
+		if err != nil {
+			return nil, err
+		}
+		peers := make(map[string]bool, len(s.neighborMap))
+		for addr, peer := range s.neighborMap {
+			if neighbors != nil && !peer.isDynamicNeighbor() {
+				continue
+			}
+			peers[addr] = peer.isRouteServerClient()
+			for _, a := range peer.condAdvs {
+				if err := checkConditionalAdvertisementSets(rp, addr, a.c); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if neighbors != nil {
+			for _, n := range neighbors.neighbors {
+				addr, _ := n.ExtractNeighborAddress()
+				peers[addr] = n.RouteServer.Config.RouteServerClient
+				for _, a := range n.ApplyPolicy.ConditionalAdvertisements {
+					if err := checkConditionalAdvertisementSets(rp, addr, a.Config); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+		ap, err := newApplyPolicies(r.Policies.Assignments, peers)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.policy.Replace(rp, ap, true); err != nil {
+			return nil, err
+		}
+		if neighbors != nil {
+			// the route server clients added or updated by the commit
+			// follow the assignments too
+			for _, n := range neighbors.neighbors {
+				addr, _ := n.ExtractNeighborAddress()
+				if a, ok := ap[addr]; ok {
+					n.ApplyPolicy.Config = a.Config
+				}
+			}
+		}
+		replacePolicy = func() error {
+			return s.policy.Replace(rp, ap, false)
+		}
+	} else if neighbors != nil {
+		for _, n := range neighbors.neighbors {
+			if err := validateConditionalAdvertisements(s.policy, n.ApplyPolicy.ConditionalAdvertisements); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	snapshot := &configSnapshot{}
+	if replacePolicy != nil {
+		snapshot.policy = s.policy.Snapshot()
+	}
+	if neighbors != nil {
+		snapshot.neighbors = s.configNeighbors()
+	}
+	if err := s.applyConfig(neighbors, replacePolicy); err != nil {
+		s.logger.Warn("failed to commit the configuration",
+			log.Fields{
+				"Topic": "Config",
+				"Error": err})
+		s.restoreConfig(snapshot)
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// restoreConfig restores the configuration replaced by the commit.
+func (s *BgpServer) restoreConfig(c *configSnapshot) error {
+	var replacePolicy func() error
+	if c.policy != nil {
+		replacePolicy = func() error {
+			s.policy.Restore(c.policy)
+			return nil
+		}
+	}
+	err := s.applyConfig(c.neighbors, replacePolicy)
+	if err != nil {
+		s.logger.Error("failed to restore the configuration",
+			log.Fields{
+				"Topic": "Config",
+				"Error": err})
+	}
+	return err
+}
+
+// rollbackConfig restores the configuration before the commit waiting for
+// the confirmation.
+func (s *BgpServer) rollbackConfig(reason string) error {
+	t := &s.txn
+	if t.rollback == nil {
+		return fmt.Errorf("no commit to roll back")
+	}
+	t.timer.Stop()
+	rollback := t.rollback
+	t.rollback = nil
+	t.timer = nil
+	s.logger.Info("roll back the configuration",
+		log.Fields{
+			"Topic":  "Config",
+			"Reason": reason})
+	return s.restoreConfig(rollback)
+}
+
+// stopConfigTransaction discards the staged configuration, and confirms
+// the commit waiting for the confirmation.
+func (s *BgpServer) stopConfigTransaction() {
+	if s.txn.timer != nil {
+		s.txn.timer.Stop()
+	}
+	s.txn = configTransaction{}
+}
+
+// StageConfig stages the configuration to be applied by CommitConfig,
+// replacing the one staged before.
+func (s *BgpServer) StageConfig(ctx context.Context, r *api.StageConfigRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	if r.Neighbors == nil && r.Policies == nil {
+		return fmt.Errorf("empty configuration")
+	}
+	r = proto.Clone(r).(*api.StageConfigRequest)
+	if r.Neighbors != nil {
+		if _, err := newConfigNeighbors(r.Neighbors); err != nil {
+			return err
+		}
+	}
+	if r.Policies != nil {
+		if _, err := newRoutingPolicyFromApiStruct(&api.SetPoliciesRequest{
+			DefinedSets: r.Policies.DefinedSets,
+			Policies:    r.Policies.Policies,
+		}); err != nil {
+			return err
+		}
+	}
+	return s.mgmtOperation(func() error {
+		s.txn.staged = r
+		return nil
+	}, true)
+}
+
+// DiscardConfig discards the staged configuration.
+func (s *BgpServer) DiscardConfig(ctx context.Context, r *api.DiscardConfigRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		if s.txn.staged == nil {
+			return fmt.Errorf("no staged configuration")
+		}
+		s.txn.staged = nil
+		return nil
+	}, true)
+}
+
+// CommitConfig applies the staged configuration. If ConfirmTimeout isn't
+// zero, the configuration before the commit is restored after
+// ConfirmTimeout seconds unless ConfirmConfig is called. Nothing is
+// changed if the staged configuration is invalid.
+func (s *BgpServer) CommitConfig(ctx context.Context, r *api.CommitConfigRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		t := &s.txn
+		if t.staged == nil {
+			return fmt.Errorf("no staged configuration")
+		}
+		if t.rollback != nil {
+			return fmt.Errorf("the previous commit isn't confirmed")
+		}
+		rollback, err := s.commitConfig(t.staged)
+		if err != nil {
+			return err
+		}
+		t.staged = nil
+		s.logger.Info("commit the configuration",
+			log.Fields{
+				"Topic":          "Config",
+				"ConfirmTimeout": r.ConfirmTimeout})
+		if r.ConfirmTimeout == 0 {
+			return nil
+		}
+		timeout := time.Duration(r.ConfirmTimeout) * time.Second
+		t.rollback = rollback
+		t.deadline = time.Now().Add(timeout)
+		t.timer = time.AfterFunc(timeout, func() {
+			s.mgmtOperation(func() error {
+				if s.txn.rollback != rollback {
+					return nil
+				}
+				return s.rollbackConfig("not confirmed")
+			}, false)
+		})
+		return nil
+	}, true)
+}
+
+// ConfirmConfig confirms the commit waiting for the confirmation.
+func (s *BgpServer) ConfirmConfig(ctx context.Context, r *api.ConfirmConfigRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		t := &s.txn
+		if t.rollback == nil {
+			return fmt.Errorf("no commit to confirm")
+		}
+		t.timer.Stop()
+		t.rollback = nil
+		t.timer = nil
+		s.logger.Info("confirm the configuration",
+			log.Fields{
+				"Topic": "Config"})
+		return nil
+	}, true)
+}
+
+// RollbackConfig restores the configuration before the commit waiting for
+// the confirmation now.
+func (s *BgpServer) RollbackConfig(ctx context.Context, r *api.RollbackConfigRequest) error {
+	if r == nil {
+		return fmt.Errorf("nil request")
+	}
+	return s.mgmtOperation(func() error {
+		return s.rollbackConfig("requested")
+	}, true)
+}
+
+func (s *BgpServer) GetConfigTransaction(ctx context.Context, r *api.GetConfigTransactionRequest) (*api.GetConfigTransactionResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("nil request")
+	}
+	var rsp *api.GetConfigTransactionResponse
+	err := s.mgmtOperation(func() error {
+		rsp = &api.GetConfigTransactionResponse{
+			Staged:  s.txn.staged != nil,
+			Pending: s.txn.rollback != nil,
+		}
+		if rsp.Pending {
+			rsp.RollbackTime = timestamppb.New(s.txn.deadline)
+		}
+		return nil
+	}, false)
+	return rsp, err
+}
@@ -0,0 +1,173 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+)
+
+func TestConfigTransaction(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.StartBgp(ctx, &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        1,
+			RouterId:   "1.1.1.1",
+			ListenPort: -1,
+		},
+	})
+	assert.NoError(err)
+	defer s.StopBgp(ctx, &api.StopBgpRequest{})
+
+	err = s.AddPeer(ctx, &api.AddPeerRequest{Peer: &api.Peer{
+		Conf: &api.PeerConf{
+			NeighborAddress: "127.0.0.1",
+			PeerAsn:         2,
+		},
+	}})
+	assert.NoError(err)
+
+	type snapshot struct {
+		peers       []string
+		groups      []string
+		policies    []string
+		assignments []string
+	}
+	current := func() snapshot {
+		var ss snapshot
+		s.ListPeer(ctx, &api.ListPeerRequest{}, func(p *api.Peer) {
+			ss.peers = append(ss.peers, p.Conf.NeighborAddress+" "+p.Conf.PeerGroup)
+		})
+		sort.Strings(ss.peers)
+		s.ListPeerGroup(ctx, &api.ListPeerGroupRequest{}, func(pg *api.PeerGroup) {
+			ss.groups = append(ss.groups, pg.Conf.PeerGroupName)
+		})
+		s.ListPolicy(ctx, &api.ListPolicyRequest{}, func(p *api.Policy) {
+			ss.policies = append(ss.policies, p.Name)
+		})
+		s.ListPolicyAssignment(ctx, &api.ListPolicyAssignmentRequest{
+			Name:      table.GLOBAL_RIB_NAME,
+			Direction: api.PolicyDirection_IMPORT,
+		}, func(a *api.PolicyAssignment) {
+			for _, p := range a.Policies {
+				ss.assignments = append(ss.assignments, p.Name)
+			}
+		})
+		return ss
+	}
+	status := func() *api.GetConfigTransactionResponse {
+		rsp, err := s.GetConfigTransaction(ctx, &api.GetConfigTransactionRequest{})
+		assert.NoError(err)
+		return rsp
+	}
+	before := current()
+	assert.Equal(snapshot{peers: []string{"127.0.0.1 "}}, before)
+
+	req := &api.StageConfigRequest{
+		Neighbors: &api.ConfigNeighbors{
+			PeerGroups: []*api.PeerGroup{{
+				Conf: &api.PeerGroupConf{PeerGroupName: "g1", PeerAsn: 3},
+			}},
+			Peers: []*api.Peer{{
+				Conf: &api.PeerConf{NeighborAddress: "127.0.0.2", PeerGroup: "g1"},
+			}},
+		},
+		Policies: &api.ReplacePoliciesRequest{
+			DefinedSets: []*api.DefinedSet{{
+				DefinedType: api.DefinedType_PREFIX,
+				Name:        "d1",
+				Prefixes:    []*api.Prefix{{IpPrefix: "10.1.0.0/24", MaskLengthMin: 24, MaskLengthMax: 24}},
+			}},
+			Policies: []*api.Policy{{
+				Name: "p1",
+				Statements: []*api.Statement{{
+					Name:       "s1",
+					Conditions: &api.Conditions{PrefixSet: &api.MatchSet{Name: "d1"}},
+					Actions:    &api.Actions{RouteAction: api.RouteAction_REJECT},
+				}},
+			}},
+			Assignments: []*api.PolicyAssignment{{
+				Name:          table.GLOBAL_RIB_NAME,
+				Direction:     api.PolicyDirection_IMPORT,
+				Policies:      []*api.Policy{{Name: "p1"}},
+				DefaultAction: api.RouteAction_ACCEPT,
+			}},
+		},
+	}
+	after := snapshot{
+		peers:       []string{"127.0.0.2 g1"},
+		groups:      []string{"g1"},
+		policies:    []string{"p1"},
+		assignments: []string{"p1"},
+	}
+
+	assert.Error(s.CommitConfig(ctx, &api.CommitConfigRequest{}))
+	assert.Error(s.StageConfig(ctx, &api.StageConfigRequest{}))
+
+	// nothing is changed by the invalid configuration
+	assert.NoError(s.StageConfig(ctx, &api.StageConfigRequest{
+		Neighbors: req.Neighbors,
+		Policies: &api.ReplacePoliciesRequest{
+			Assignments: req.Policies.Assignments,
+		},
+	}))
+	assert.Error(s.CommitConfig(ctx, &api.CommitConfigRequest{}))
+	assert.Equal(before, current())
+	assert.True(status().Staged)
+	assert.NoError(s.DiscardConfig(ctx, &api.DiscardConfigRequest{}))
+	assert.False(status().Staged)
+
+	// rolled back unless confirmed
+	assert.NoError(s.StageConfig(ctx, req))
+	assert.NoError(s.CommitConfig(ctx, &api.CommitConfigRequest{ConfirmTimeout: 1}))
+	assert.Equal(after, current())
+	rsp := status()
+	assert.False(rsp.Staged)
+	assert.True(rsp.Pending)
+	assert.WithinDuration(time.Now().Add(time.Second), rsp.RollbackTime.AsTime(), time.Second)
+	assert.Eventually(func() bool {
+		return !status().Pending
+	}, 3*time.Second, 100*time.Millisecond)
+	assert.Equal(before, current())
+	assert.Error(s.ConfirmConfig(ctx, &api.ConfirmConfigRequest{}))
+
+	// rolled back on request
+	assert.NoError(s.StageConfig(ctx, req))
+	assert.NoError(s.CommitConfig(ctx, &api.CommitConfigRequest{ConfirmTimeout: 60}))
+	assert.Equal(after, current())
+	assert.NoError(s.StageConfig(ctx, req))
+	assert.Error(s.CommitConfig(ctx, &api.CommitConfigRequest{}))
+	assert.NoError(s.RollbackConfig(ctx, &api.RollbackConfigRequest{}))
+	assert.Equal(before, current())
+
+	// kept once confirmed
+	assert.NoError(s.CommitConfig(ctx, &api.CommitConfigRequest{ConfirmTimeout: 60}))
+	assert.NoError(s.ConfirmConfig(ctx, &api.ConfirmConfigRequest{}))
+	assert.False(status().Pending)
+	assert.Error(s.RollbackConfig(ctx, &api.RollbackConfigRequest{}))
+	assert.Equal(after, current())
+}
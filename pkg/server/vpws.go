@@ -0,0 +1,208 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/log"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+// EVPN VPWS
+//
+// RFC8214 Virtual Private Wire Service Support in Ethernet VPN
+//
+// A VPWS service instance originates the Ethernet A-D per EVI route whose
+// Ethernet Tag is the local service identifier, with the Layer 2 Attributes
+// Extended Community. The pseudowire is up when the Ethernet A-D per EVI
+// route whose Ethernet Tag is the remote service identifier is received
+// with one of the Route Targets and the MTUs match.
+
+type evpnVpws struct {
+	name        string
+	rd          bgp.RouteDistinguisherInterface
+	rts         []bgp.ExtendedCommunityInterface
+	esi         bgp.EthernetSegmentIdentifier
+	localID     uint32
+	remoteID    uint32
+	label       uint32
+	controlWord bool
+	flowLabel   bool
+	mtu         uint16
+	path        *table.Path
+}
+
+// evpnVpwsRemote is the remote endpoint of the pseudowire.
+type evpnVpwsRemote struct {
+	path  *table.Path
+	label uint32
+	attrs *bgp.Layer2AttributesExtended
+}
+
+// newPath returns the Ethernet A-D per EVI route for the local service
+// identifier.
+func (v *evpnVpws) newPath(source *table.PeerInfo) *table.Path {
+	var flags bgp.EvpnControlFlag
+	if v.controlWord {
+		flags |= bgp.CONTROL_WORD
+	}
+	if v.flowLabel {
+		flags |= bgp.FLOW_LABEL
+	}
+	comms := make([]bgp.ExtendedCommunityInterface, 0, len(v.rts)+1)
+	comms = append(comms, v.rts...)
+	comms = append(comms, bgp.NewLayer2AttributesExtended(flags, v.mtu))
+	nlri := bgp.NewEVPNNLRI(bgp.EVPN_ROUTE_TYPE_ETHERNET_AUTO_DISCOVERY, &bgp.EVPNEthernetAutoDiscoveryRoute{
+		RD:    v.rd,
+		ESI:   v.esi,
+		ETag:  v.localID,
+		Label: v.label,
+	})
+	attrs := []bgp.PathAttributeInterface{
+		bgp.NewPathAttributeOrigin(bgp.BGP_ORIGIN_ATTR_TYPE_IGP),
+		bgp.NewPathAttributeMpReachNLRI("0.0.0.0", []bgp.AddrPrefixInterface{nlri}),
+		bgp.NewPathAttributeExtendedCommunities(comms),
+	}
+	return table.NewPath(source, nlri, false, attrs, time.Now(), false)
+}
+
+// imports returns true if the path has one of the Route Targets.
+func (v *evpnVpws) imports(path *table.Path) bool {
+	for _, ec := range path.GetExtCommunities() {
+		if _, subtype := ec.GetTypes(); subtype != bgp.EC_SUBTYPE_ROUTE_TARGET {
+			continue
+		}
+		for _, rt := range v.rts {
+			if ec.String() == rt.String() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// remote returns the remote endpoint from the best paths. The primary PE is
+// preferred to the backup one if the remote is multihomed.
+func (v *evpnVpws) remote(paths []*table.Path) *evpnVpwsRemote {
+	candidates := make([]*evpnVpwsRemote, 0)
+	for _, path := range paths {
+		if path.IsLocal() {
+			continue
+		}
+		nlri, ok := path.GetNlri().(*bgp.EVPNNLRI)
+		if !ok {
+			continue
+		}
+		r, ok := nlri.RouteTypeData.(*bgp.EVPNEthernetAutoDiscoveryRoute)
+		if !ok || r.ETag != v.remoteID || !v.imports(path) {
+			continue
+		}
+		remote := &evpnVpwsRemote{
+			path:  path,
+			label: r.Label,
+			attrs: &bgp.Layer2AttributesExtended{},
+		}
+		for _, ec := range path.GetExtCommunities() {
+			if a, ok := ec.(*bgp.Layer2AttributesExtended); ok {
+				remote.attrs = a
+			}
+		}
+		candidates = append(candidates, remote)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if a, b := candidates[i].attrs.IsBackupPe, candidates[j].attrs.IsBackupPe; a != b {
+			return b
+		}
+		return candidates[i].path.GetNlri().String() < candidates[j].path.GetNlri().String()
+	})
+	return candidates[0]
+}
+
+// up returns true if the MTUs of the remote endpoint match. The MTU isn't
+// checked if either is zero (RFC8214 3.1).
+func (v *evpnVpws) up(remote *evpnVpwsRemote) bool {
+	if remote == nil {
+		return false
+	}
+	return v.mtu == 0 || remote.attrs.Mtu == 0 || v.mtu == remote.attrs.Mtu
+}
+
+type evpnVpwsManager struct {
+	s         *BgpServer
+	instances map[string]*evpnVpws
+}
+
+func newEvpnVpwsManager(s *BgpServer) *evpnVpwsManager {
+	return &evpnVpwsManager{
+		s:         s,
+		instances: make(map[string]*evpnVpws),
+	}
+}
+
+func (m *evpnVpwsManager) add(v *evpnVpws) error {
+	if v.name == "" {
+		return fmt.Errorf("empty evpn vpws name")
+	}
+	if _, y := m.instances[v.name]; y {
+		return fmt.Errorf("evpn vpws %s is already configured", v.name)
+	}
+	if v.rd == nil {
+		return fmt.Errorf("evpn vpws %s has no rd", v.name)
+	}
+	if v.label > 0xffffff {
+		return fmt.Errorf("evpn vpws %s has out of range label: %d", v.name, v.label)
+	}
+	for _, o := range m.instances {
+		if o.rd.String() == v.rd.String() && o.localID == v.localID {
+			return fmt.Errorf("evpn vpws %s has the same rd and local service id as %s", v.name, o.name)
+		}
+	}
+	// not to implicitly withdraw the path of the same NLRI added via the
+	// API, the source differs from the one of the API.
+	id := net.ParseIP(m.s.bgpConfig.Global.Config.RouterId).To4()
+	v.path = v.newPath(&table.PeerInfo{ID: id, LocalID: id})
+	m.instances[v.name] = v
+	m.s.propagateUpdate(nil, []*table.Path{v.path})
+	m.s.logger.Info("evpn vpws added",
+		log.Fields{
+			"Topic":           "Evpn",
+			"Key":             v.name,
+			"LocalServiceId":  v.localID,
+			"RemoteServiceId": v.remoteID})
+	return nil
+}
+
+func (m *evpnVpwsManager) delete(name string) error {
+	v, y := m.instances[name]
+	if !y {
+		return fmt.Errorf("evpn vpws %s isn't found", name)
+	}
+	delete(m.instances, name)
+	m.s.propagateUpdate(nil, []*table.Path{v.path.Clone(true)})
+	m.s.logger.Info("evpn vpws deleted",
+		log.Fields{
+			"Topic": "Evpn",
+			"Key":   name})
+	return nil
+}
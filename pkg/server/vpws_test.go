@@ -0,0 +1,139 @@
+// Copyright (C) 2026 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apb "google.golang.org/protobuf/types/known/anypb"
+
+	api "github.com/osrg/gobgp/v3/api"
+	"github.com/osrg/gobgp/v3/internal/pkg/table"
+	"github.com/osrg/gobgp/v3/pkg/apiutil"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
+)
+
+func TestEvpnVpws(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	s := NewBgpServer()
+	go s.Serve()
+	err := s.StartBgp(ctx, &api.StartBgpRequest{
+		Global: &api.Global{
+			Asn:        1,
+			RouterId:   "10.0.0.1",
+			ListenPort: -1,
+		},
+	})
+	assert.NoError(err)
+	defer s.StopBgp(ctx, &api.StopBgpRequest{})
+
+	rd, _ := apiutil.MarshalRD(bgp.NewRouteDistinguisherIPAddressAS("10.0.0.1", 1))
+	rt, _ := apiutil.MarshalRT(bgp.NewTwoOctetAsSpecificExtended(bgp.EC_SUBTYPE_ROUTE_TARGET, 65000, 1, true))
+	vpws := &api.EvpnVpws{
+		Name:            "vpws1",
+		Rd:              rd,
+		RouteTargets:    []*apb.Any{rt},
+		LocalServiceId:  100,
+		RemoteServiceId: 200,
+		Label:           1000,
+		ControlWord:     true,
+		Mtu:             1500,
+	}
+	assert.NoError(s.AddEvpnVpws(ctx, &api.AddEvpnVpwsRequest{Vpws: vpws}))
+	assert.Error(s.AddEvpnVpws(ctx, &api.AddEvpnVpwsRequest{Vpws: vpws}))
+
+	local := func() []*table.Path {
+		var l []*table.Path
+		s.mgmtOperation(func() error {
+			for _, path := range s.globalRib.GetBestPathList(table.GLOBAL_RIB_NAME, 0, []bgp.RouteFamily{bgp.RF_EVPN}) {
+				if path.IsLocal() {
+					l = append(l, path)
+				}
+			}
+			return nil
+		}, false)
+		return l
+	}
+	list := func() *api.ListEvpnVpwsResponse {
+		var rsp *api.ListEvpnVpwsResponse
+		assert.NoError(s.ListEvpnVpws(ctx, &api.ListEvpnVpwsRequest{Name: "vpws1"}, func(r *api.ListEvpnVpwsResponse) {
+			rsp = r
+		}))
+		return rsp
+	}
+	// injects the Ethernet A-D per EVI route from the remote PE
+	remote := func(etag uint32, mtu uint16) {
+		nlri := bgp.NewEVPNNLRI(bgp.EVPN_ROUTE_TYPE_ETHERNET_AUTO_DISCOVERY, &bgp.EVPNEthernetAutoDiscoveryRoute{
+			RD:    bgp.NewRouteDistinguisherIPAddressAS("10.0.0.2", 1),
+			ESI:   bgp.EthernetSegmentIdentifier{Type: bgp.ESI_ARBITRARY, Value: make([]byte, 9)},
+			ETag:  etag,
+			Label: 2000,
+		})
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeMpReachNLRI("10.0.0.2", []bgp.AddrPrefixInterface{nlri}),
+			bgp.NewPathAttributeExtendedCommunities([]bgp.ExtendedCommunityInterface{
+				bgp.NewTwoOctetAsSpecificExtended(bgp.EC_SUBTYPE_ROUTE_TARGET, 65000, 1, true),
+				bgp.NewLayer2AttributesExtended(bgp.CONTROL_WORD, mtu),
+			}),
+		}
+		source := &table.PeerInfo{AS: 1, Address: net.ParseIP("10.0.0.2"), ID: net.ParseIP("10.0.0.2")}
+		s.mgmtOperation(func() error {
+			s.propagateUpdate(nil, []*table.Path{table.NewPath(source, nlri, false, attrs, time.Now(), false)})
+			return nil
+		}, false)
+	}
+
+	// the local route with the layer 2 attributes
+	l := local()
+	assert.Len(l, 1)
+	assert.Equal("[type:A-D][rd:10.0.0.1:1][esi:single-homed][etag:100]", l[0].GetNlri().String())
+	assert.Contains(l[0].GetExtCommunities(), bgp.NewLayer2AttributesExtended(bgp.CONTROL_WORD, 1500))
+
+	rsp := list()
+	assert.Equal("vpws1", rsp.Vpws.Name)
+	assert.False(rsp.Up)
+	assert.Nil(rsp.Remote)
+
+	// a route for the other service
+	remote(300, 1500)
+	assert.Nil(list().Remote)
+
+	remote(200, 1500)
+	rsp = list()
+	assert.True(rsp.Up)
+	assert.Equal("10.0.0.2", rsp.Remote.Nexthop)
+	assert.Equal(uint32(2000), rsp.Remote.Label)
+	assert.True(rsp.Remote.ControlWord)
+	assert.Equal(uint32(1500), rsp.Remote.Mtu)
+
+	// MTU mismatch
+	remote(200, 9000)
+	rsp = list()
+	assert.False(rsp.Up)
+	assert.Equal(uint32(9000), rsp.Remote.Mtu)
+
+	assert.NoError(s.DeleteEvpnVpws(ctx, &api.DeleteEvpnVpwsRequest{Name: "vpws1"}))
+	assert.Error(s.DeleteEvpnVpws(ctx, &api.DeleteEvpnVpwsRequest{Name: "vpws1"}))
+	assert.Len(local(), 0)
+	assert.Nil(list())
+}
@@ -21,6 +21,7 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -115,6 +116,34 @@ func filterOutExternalPath(paths []*table.Path) []*table.Path {
 	return filteredPaths
 }
 
+// nexthopWeights returns the weights of the nexthops of the multipaths in
+// proportion to the bandwidths of the link bandwidth extended communities,
+// from 1 to 100. nil is returned unless all the paths have the bandwidth.
+func nexthopWeights(paths []*table.Path) []uint32 {
+	if len(paths) < 2 {
+		return nil
+	}
+	bws := make([]float64, 0, len(paths))
+	var total float64
+	for _, path := range paths {
+		bw, y := path.GetLinkBandwidth()
+		if !y || bw <= 0 {
+			return nil
+		}
+		bws = append(bws, float64(bw))
+		total += float64(bw)
+	}
+	weights := make([]uint32, 0, len(paths))
+	for _, bw := range bws {
+		w := uint32(math.Round(bw * 100 / total))
+		if w == 0 {
+			w = 1
+		}
+		weights = append(weights, w)
+	}
+	return weights
+}
+
 func addLabelToNexthop(path *table.Path, z *zebraClient, msgFlags *zebra.MessageFlag, nexthop *zebra.Nexthop) {
 	rf := path.GetRouteFamily()
 	if rf == bgp.RF_IPv4_VPN || rf == bgp.RF_IPv6_VPN {
@@ -134,6 +163,38 @@ func addLabelToNexthop(path *table.Path, z *zebraClient, msgFlags *zebra.Message
 	}
 }
 
+// srv6SIDFromPath returns the SRv6 SID in the SRv6 L3 Service TLV of the VPN
+// path. When the SID structure has the transposition, the function part of
+// the SID is restored from the label of the NLRI (RFC 9252).
+func srv6SIDFromPath(path *table.Path) net.IP {
+	var prefixSID *bgp.PathAttributePrefixSID
+	for _, attr := range path.GetPathAttrs() {
+		if a, ok := attr.(*bgp.PathAttributePrefixSID); ok {
+			prefixSID = a
+			break
+		}
+	}
+	if prefixSID == nil {
+		return nil
+	}
+	var label uint32
+	switch nlri := path.GetNlri().(type) {
+	case *bgp.LabeledVPNIPAddrPrefix:
+		if len(nlri.Labels.Labels) > 0 {
+			label = nlri.Labels.Labels[0]
+		}
+	case *bgp.LabeledVPNIPv6AddrPrefix:
+		if len(nlri.Labels.Labels) > 0 {
+			label = nlri.Labels.Labels[0]
+		}
+	}
+	sid := prefixSID.SRv6ServiceSID(bgp.TLVTypeSRv6L3Service, label)
+	if sid == nil {
+		return nil
+	}
+	return net.IP(sid.SID.AsSlice())
+}
+
 func newIPRouteBody(dst []*table.Path, vrfID uint32, z *zebraClient) (body *zebra.IPRouteBody, isWithdraw bool) {
 	version := z.client.Version
 	paths := filterOutExternalPath(dst)
@@ -144,7 +205,6 @@ func newIPRouteBody(dst []*table.Path, vrfID uint32, z *zebraClient) (body *zebr
 
 	l := strings.SplitN(path.GetPrefix(), "/", 2)
 	var prefix net.IP
-	var nexthop zebra.Nexthop
 	nexthops := make([]zebra.Nexthop, 0, len(paths))
 	msgFlags := zebra.MessageNexthop
 	switch path.GetRouteFamily() {
@@ -168,11 +228,21 @@ func newIPRouteBody(dst []*table.Path, vrfID uint32, z *zebraClient) (body *zebr
 			continue
 		}
 	}
-	for _, p := range paths {
-		nexthop.Gate = p.GetNexthop()
-		nexthop.VrfID = nhVrfID
+	weights := nexthopWeights(paths)
+	for i, p := range paths {
+		nexthop := zebra.Nexthop{
+			Gate:  p.GetNexthop(),
+			VrfID: nhVrfID,
+		}
+		if weights != nil {
+			nexthop.Weight = weights[i]
+		}
 		if nhVrfID != vrfID {
-			addLabelToNexthop(path, z, &msgFlags, &nexthop)
+			// SRv6 L3VPN paths are encapsulated with the SID instead of
+			// the MPLS label when zebra supports SRv6.
+			if sid := srv6SIDFromPath(p); sid == nil || !z.client.SetSeg6Flag(&nexthop, sid) {
+				addLabelToNexthop(path, z, &msgFlags, &nexthop)
+			}
 		}
 		nexthops = append(nexthops, nexthop)
 	}
@@ -314,10 +384,13 @@ type mplsLabelParameter struct {
 type zebraClient struct {
 	client       *zebra.Client
 	server       *BgpServer
+	logger       log.Logger
 	nexthopCache nexthopStateCache
 	pathVrfMap   map[*table.Path]uint32 //vpn paths and nexthop vpn id
 	mplsLabel    mplsLabelParameter
 	dead         chan struct{}
+	// set to 1 when the connection to zebra is closed
+	disconnected int32
 }
 
 func (z *zebraClient) getPathListWithNexthopUpdate(body *zebra.NexthopUpdateBody) []*table.Path {
@@ -336,7 +409,7 @@ func (z *zebraClient) getPathListWithNexthopUpdate(body *zebra.NexthopUpdateBody
 	for _, rf := range rfList {
 		tbl, _, err := z.server.getRib("", rf, nil)
 		if err != nil {
-			z.server.logger.Error("failed to get global rib",
+			z.logger.Error("failed to get global rib",
 				log.Fields{
 					"Topic":  "Zebra",
 					"Family": rf.String(),
@@ -353,7 +426,7 @@ func (z *zebraClient) updatePathByNexthopCache(paths []*table.Path) {
 	paths = z.nexthopCache.applyToPathList(paths)
 	if len(paths) > 0 {
 		if err := z.server.updatePath("", paths); err != nil {
-			z.server.logger.Error("failed to update nexthop reachability",
+			z.logger.Error("failed to update nexthop reachability",
 				log.Fields{
 					"Topic":    "Zebra",
 					"PathList": paths})
@@ -361,6 +434,11 @@ func (z *zebraClient) updatePathByNexthopCache(paths []*table.Path) {
 	}
 }
 
+func (z *zebraClient) stop() {
+	close(z.dead)
+	z.client.Close()
+}
+
 func (z *zebraClient) loop() {
 	w := z.server.watch([]watchOption{
 		watchBestPath(true),
@@ -374,13 +452,14 @@ func (z *zebraClient) loop() {
 			return
 		case msg := <-z.client.Receive():
 			if msg == nil {
+				atomic.StoreInt32(&z.disconnected, 1)
 				break
 			}
 			switch body := msg.Body.(type) {
 			case *zebra.IPRouteBody:
-				if path := newPathFromIPRouteMessage(z.server.logger, msg, z.client.Version, z.client.Software); path != nil {
+				if path := newPathFromIPRouteMessage(z.logger, msg, z.client.Version, z.client.Software); path != nil {
 					if err := z.server.addPathList("", []*table.Path{path}); err != nil {
-						z.server.logger.Error("failed to add path from zebra",
+						z.logger.Error("failed to add path from zebra",
 							log.Fields{
 								"Topic": "Zebra",
 								"Path":  path,
@@ -400,7 +479,7 @@ func (z *zebraClient) loop() {
 				}
 				z.updatePathByNexthopCache(paths)
 			case *zebra.GetLabelChunkBody:
-				z.server.logger.Debug("zebra GetLabelChunkBody is received",
+				z.logger.Debug("zebra GetLabelChunkBody is received",
 					log.Fields{
 						"Topic": "Zebra",
 						"Start": body.Start,
@@ -409,7 +488,7 @@ func (z *zebraClient) loop() {
 				z.mplsLabel.maps[startEnd] = table.NewBitmap(int(body.End - body.Start + 1))
 				for _, vrf := range z.mplsLabel.unassignedVrf {
 					if err := z.assignAndSendVrfMplsLabel(vrf); err != nil {
-						z.server.logger.Error("zebra failed to assign and send vrf mpls label",
+						z.logger.Error("zebra failed to assign and send vrf mpls label",
 							log.Fields{
 								"Topic": "Zebra",
 								"Error": err})
@@ -420,7 +499,7 @@ func (z *zebraClient) loop() {
 		case ev := <-w.Event():
 			switch msg := ev.(type) {
 			case *watchEventBestPath:
-				if table.UseMultiplePaths.Enabled {
+				if table.MultiPathEnabled() {
 					for _, paths := range msg.MultiPathList {
 						z.updatePathByNexthopCache(paths)
 						for i := range msg.Vrf {
@@ -468,6 +547,7 @@ func newZebraClient(s *BgpServer, url string, protos []string, version uint8, nh
 	if len(l) != 2 {
 		return nil, fmt.Errorf("unsupported url: %s", url)
 	}
+	logger := s.logLevels.Logger(log.ModuleZebra)
 	var cli *zebra.Client
 	var err error
 	var usingVersion uint8
@@ -481,18 +561,18 @@ func newZebraClient(s *BgpServer, url string, protos []string, version uint8, nh
 		ver++
 	}
 	for elem, ver := range zapivers {
-		cli, err = zebra.NewClient(s.logger, l[0], l[1], zebra.RouteBGP, ver, software, mplsLabelRangeSize)
+		cli, err = zebra.NewClient(logger, l[0], l[1], zebra.RouteBGP, ver, software, mplsLabelRangeSize)
 		if cli != nil && err == nil {
 			usingVersion = ver
 			break
 		}
 		// Retry with another Zebra message version
-		s.logger.Warn("cannot connect to Zebra with message version",
+		logger.Warn("cannot connect to Zebra with message version",
 			log.Fields{
 				"Topic":   "Zebra",
 				"Version": ver})
 		if elem < len(zapivers)-1 {
-			s.logger.Warn("going to retry another version",
+			logger.Warn("going to retry another version",
 				log.Fields{
 					"Topic":   "Zebra",
 					"Version": zapivers[elem+1]})
@@ -501,7 +581,7 @@ func newZebraClient(s *BgpServer, url string, protos []string, version uint8, nh
 	if cli == nil || err != nil {
 		return nil, err
 	}
-	s.logger.Info("success to connect to Zebra",
+	logger.Info("success to connect to Zebra",
 		log.Fields{
 			"Topic":   "Zebra",
 			"Version": usingVersion})
@@ -521,6 +601,7 @@ func newZebraClient(s *BgpServer, url string, protos []string, version uint8, nh
 	w := &zebraClient{
 		client:       cli,
 		server:       s,
+		logger:       logger,
 		nexthopCache: make(nexthopStateCache),
 		pathVrfMap:   make(map[*table.Path]uint32),
 		mplsLabel: mplsLabelParameter{
@@ -17,6 +17,7 @@ package server
 
 import (
 	"net"
+	"net/netip"
 	"testing"
 	"time"
 
@@ -24,6 +25,7 @@ import (
 
 	"github.com/osrg/gobgp/v3/internal/pkg/table"
 	"github.com/osrg/gobgp/v3/pkg/log"
+	"github.com/osrg/gobgp/v3/pkg/packet/bgp"
 	"github.com/osrg/gobgp/v3/pkg/zebra"
 )
 
@@ -137,3 +139,52 @@ func Test_newPathFromIPRouteMessage(t *testing.T) {
 		assert.True(pp.IsWithdraw)
 	}
 }
+
+func Test_srv6SIDFromPath(t *testing.T) {
+	assert := assert.New(t)
+
+	newPath := func(label uint32, attrs ...bgp.PathAttributeInterface) *table.Path {
+		nlri := bgp.NewLabeledVPNIPv6AddrPrefix(64, "2001:db8:1::", *bgp.NewMPLSLabelStack(label), bgp.NewRouteDistinguisherTwoOctetAS(65000, 100))
+		attrs = append(attrs, bgp.NewPathAttributeOrigin(0), bgp.NewPathAttributeMpReachNLRI("2001:db8::1", []bgp.AddrPrefixInterface{nlri}))
+		return table.NewPath(&table.PeerInfo{}, nlri, false, attrs, time.Now(), false)
+	}
+
+	// without transposition
+	sid := bgp.NewPathAttributePrefixSID(
+		bgp.NewSRv6ServiceTLV(bgp.TLVTypeSRv6L3Service,
+			bgp.NewSRv6InformationSubTLV(netip.MustParseAddr("fd00:1:1:1::"), bgp.END_DT6,
+				bgp.NewSRv6SIDStructureSubSubTLV(32, 16, 16, 0, 0, 0))))
+	assert.Equal("fd00:1:1:1::", srv6SIDFromPath(newPath(3, sid)).String())
+
+	// the function is transposed into the label
+	sid = bgp.NewPathAttributePrefixSID(
+		bgp.NewSRv6ServiceTLV(bgp.TLVTypeSRv6L3Service,
+			bgp.NewSRv6InformationSubTLV(netip.MustParseAddr("fd00:1:1::"), bgp.END_DT6,
+				bgp.NewSRv6SIDStructureSubSubTLV(32, 16, 16, 0, 16, 48))))
+	assert.Equal("fd00:1:1:1234::", srv6SIDFromPath(newPath(0x12340, sid)).String())
+
+	// MPLS VPN
+	assert.Nil(srv6SIDFromPath(newPath(100)))
+}
+
+func Test_nexthopWeights(t *testing.T) {
+	assert := assert.New(t)
+	newPath := func(nexthop string, bw float32) *table.Path {
+		attrs := []bgp.PathAttributeInterface{
+			bgp.NewPathAttributeOrigin(0),
+			bgp.NewPathAttributeNextHop(nexthop),
+		}
+		if bw > 0 {
+			attrs = append(attrs, bgp.NewPathAttributeExtendedCommunities([]bgp.ExtendedCommunityInterface{
+				bgp.NewLinkBandwidthExtended(65000, bw),
+			}))
+		}
+		return table.NewPath(nil, bgp.NewIPAddrPrefix(24, "10.0.0.0"), false, attrs, time.Now(), false)
+	}
+
+	assert.Equal([]uint32{25, 75}, nexthopWeights([]*table.Path{newPath("10.0.0.1", 1000), newPath("10.0.0.2", 3000)}))
+	assert.Equal([]uint32{1, 100}, nexthopWeights([]*table.Path{newPath("10.0.0.1", 1), newPath("10.0.0.2", 10000)}))
+	// equal cost without the bandwidth of any of the paths
+	assert.Nil(nexthopWeights([]*table.Path{newPath("10.0.0.1", 1000), newPath("10.0.0.2", 0)}))
+	assert.Nil(nexthopWeights([]*table.Path{newPath("10.0.0.1", 1000)}))
+}
@@ -35,6 +35,8 @@ const (
 	// MinZapiVer is minimum zebra api version which is referred in zclient
 	MinZapiVer uint8 = 2
 	// MaxZapiVer is maximum zebra api version which is referredd in zclient
+	// Note: FRR8.x still uses zebra api version 6 (ZSERV_VERSION), so the
+	// differences between the FRR releases are handled by Software.
 	MaxZapiVer uint8 = 6
 	// DefaultVrf is default vrf id is referredd in zclient and server
 	DefaultVrf = 0
@@ -361,39 +363,39 @@ const (
 	_vxlanSgAdd
 	_vxlanSgDel
 	_vxlanSgReplay
-	_mlagProcessUp                  // added in frr7.3
-	_mlagProcessDown                // added in frr7.3
-	_mlagClientRegister             // added in frr7.3
-	_mlagClientUnregister           // 110 // added in frr7.3
-	_mlagClientForwardMsg           // added in frr7.3
-	_nhgAdd                         // added in frr8
-	_nhgDel                         // 113 // 110 in frr8.2 // added in frr8
-	_nhgNotifyOwner                 // added in frr8
-	_nhgEvpnRemoteNhAdd             // added in frr8
-	_nhgEvpnRemoteNhDel             // added in frr8
-	_srv6LocatorAdd                 // added in frr8.1
-	_srv6LocatorDelete              // added in frr8.1
-	_srv6ManagerGetLocatorChunk     // added in frr8.1
-	_srv6ManagerReleaseLocatorChunk // 120 //added in frr8.1
-	zebraError                      // added in frr7.3
-	_clientCapabilities             // added in frr7.4
-	_opaqueMessage                  // 123 // 120 in frr8.2 // added in frr7.5
-	_opaqueRegister                 // added in frr7.5
-	_opaqueUnregister               // added in frr7.5
-	_neighDiscover                  // added in frr7.5
-	_RouteNotifyRequest             // added in frr8
-	_ClientCloseNotify              // added in frr8
-	_NhrpNeighAdded                 // added in frr8
-	_NhrpNeighRemoved               // 130 // added in frr8
-	_NhrpNeighGet                   // added in frr8
-	_NhrpNeighRegister              // added in frr8
-	_NhrpNeighUnregister            // 133// 130 in frr8.2 // added in frr8
-	_NeighIPAdd                     // added in frr8
-	_NeighIPDel                     // added in frr8
-	_ConfigureArp                   // added in frr8
-	_GreGet                         // added in frr8
-	_GreUpdate                      // added in frr8
-	_GreSourceSet                   // added in frr8
+	_mlagProcessUp                 // added in frr7.3
+	_mlagProcessDown               // added in frr7.3
+	_mlagClientRegister            // added in frr7.3
+	_mlagClientUnregister          // 110 // added in frr7.3
+	_mlagClientForwardMsg          // added in frr7.3
+	_nhgAdd                        // added in frr8
+	_nhgDel                        // 113 // 110 in frr8.2 // added in frr8
+	_nhgNotifyOwner                // added in frr8
+	_nhgEvpnRemoteNhAdd            // added in frr8
+	_nhgEvpnRemoteNhDel            // added in frr8
+	srv6LocatorAdd                 // 117 // 114 in frr8.2 // added in frr8.1
+	srv6LocatorDelete              // added in frr8.1
+	srv6ManagerGetLocatorChunk     // added in frr8.1
+	srv6ManagerReleaseLocatorChunk // 120 // 117 in frr8.2 // added in frr8.1
+	zebraError                     // added in frr7.3
+	_clientCapabilities            // added in frr7.4
+	_opaqueMessage                 // 123 // 120 in frr8.2 // added in frr7.5
+	_opaqueRegister                // added in frr7.5
+	_opaqueUnregister              // added in frr7.5
+	_neighDiscover                 // added in frr7.5
+	_RouteNotifyRequest            // added in frr8
+	_ClientCloseNotify             // added in frr8
+	_NhrpNeighAdded                // added in frr8
+	_NhrpNeighRemoved              // 130 // added in frr8
+	_NhrpNeighGet                  // added in frr8
+	_NhrpNeighRegister             // added in frr8
+	_NhrpNeighUnregister           // 133// 130 in frr8.2 // added in frr8
+	_NeighIPAdd                    // added in frr8
+	_NeighIPDel                    // added in frr8
+	_ConfigureArp                  // added in frr8
+	_GreGet                        // added in frr8
+	_GreUpdate                     // added in frr8
+	_GreSourceSet                  // added in frr8
 	// BackwardIPv6RouteAdd is referred in zclient_test
 	BackwardIPv6RouteAdd // quagga, frr3, frr4, frr5
 	// BackwardIPv6RouteDelete is referred in zclient_test
@@ -431,25 +433,33 @@ func minDifferentAPIType(version uint8, software Software) APIType {
 }
 
 const (
-	zapi6Frr8dot2RedistributeRouteAdd     APIType = 30
-	zapi6Frr8dot2RedistributeRouteDel     APIType = 31
-	zapi6Frr8dot2VrfLabel                 APIType = 35
-	zapi6Frr8dot2Ipv4NexthopLookupMRIB    APIType = 41
-	zapi6Frr8dot2LabelManagerConnect      APIType = 50
-	zapi6Frr8dot2LabelManagerConnectAsync APIType = 51
-	zapi6Frr8dot2GetLabelChunk            APIType = 52
-	zapi6Frr8dot2ReleaseLabelChunk        APIType = 53
+	zapi6Frr8dot2RedistributeRouteAdd           APIType = 30
+	zapi6Frr8dot2RedistributeRouteDel           APIType = 31
+	zapi6Frr8dot2VrfLabel                       APIType = 35
+	zapi6Frr8dot2Ipv4NexthopLookupMRIB          APIType = 41
+	zapi6Frr8dot2LabelManagerConnect            APIType = 50
+	zapi6Frr8dot2LabelManagerConnectAsync       APIType = 51
+	zapi6Frr8dot2GetLabelChunk                  APIType = 52
+	zapi6Frr8dot2ReleaseLabelChunk              APIType = 53
+	zapi6Frr8dot2SRv6LocatorAdd                 APIType = 114
+	zapi6Frr8dot2SRv6LocatorDelete              APIType = 115
+	zapi6Frr8dot2SRv6ManagerGetLocatorChunk     APIType = 116
+	zapi6Frr8dot2SRv6ManagerReleaseLocatorChunk APIType = 117
 )
 
 var apiTypeZapi6Frr8dot2Map = map[APIType]APIType{ // frr8.2
-	RedistributeRouteAdd:     zapi6Frr8dot2RedistributeRouteAdd,
-	RedistributeRouteDel:     zapi6Frr8dot2RedistributeRouteDel,
-	vrfLabel:                 zapi6Frr8dot2VrfLabel,
-	ipv4NexthopLookupMRIB:    zapi6Frr8dot2Ipv4NexthopLookupMRIB,
-	labelManagerConnect:      zapi6Frr8dot2LabelManagerConnect,
-	labelManagerConnectAsync: zapi6Frr8dot2LabelManagerConnectAsync,
-	getLabelChunk:            zapi6Frr8dot2GetLabelChunk,
-	releaseLabelChunk:        zapi6Frr8dot2ReleaseLabelChunk,
+	RedistributeRouteAdd:           zapi6Frr8dot2RedistributeRouteAdd,
+	RedistributeRouteDel:           zapi6Frr8dot2RedistributeRouteDel,
+	vrfLabel:                       zapi6Frr8dot2VrfLabel,
+	ipv4NexthopLookupMRIB:          zapi6Frr8dot2Ipv4NexthopLookupMRIB,
+	labelManagerConnect:            zapi6Frr8dot2LabelManagerConnect,
+	labelManagerConnectAsync:       zapi6Frr8dot2LabelManagerConnectAsync,
+	getLabelChunk:                  zapi6Frr8dot2GetLabelChunk,
+	releaseLabelChunk:              zapi6Frr8dot2ReleaseLabelChunk,
+	srv6LocatorAdd:                 zapi6Frr8dot2SRv6LocatorAdd,
+	srv6LocatorDelete:              zapi6Frr8dot2SRv6LocatorDelete,
+	srv6ManagerGetLocatorChunk:     zapi6Frr8dot2SRv6ManagerGetLocatorChunk,
+	srv6ManagerReleaseLocatorChunk: zapi6Frr8dot2SRv6ManagerReleaseLocatorChunk,
 }
 
 const (
@@ -1684,6 +1694,63 @@ func (c *Client) SendVrfLabel(label uint32, vrfID uint32) error {
 	return c.sendCommand(vrfLabel, vrfID, body)
 }
 
+// SupportSRv6 is referred in zclient. It returns bool value.
+func (c *Client) SupportSRv6() bool {
+	// SRV6_MANAGER messages and seg6 nexthops are added in frr8.1.
+	return c.Version == 6 && c.Software.name == "frr" && c.Software.version >= 8.1
+}
+
+// SendSRv6ManagerGetLocatorChunk sends SRV6_MANAGER_GET_LOCATOR_CHUNK message to zebra daemon.
+// zebra replies with the chunk of the locator, and it also notifies the chunk
+// again when the locator is configured later.
+func (c *Client) SendSRv6ManagerGetLocatorChunk(locator string) error {
+	if !c.SupportSRv6() {
+		return fmt.Errorf("SRv6ManagerGetLocatorChunk is not supported in zebra API version: %d software: %s", c.Version, c.Software.string())
+	}
+	return c.sendCommand(srv6ManagerGetLocatorChunk, DefaultVrf, &srv6LocatorNameBody{name: locator})
+}
+
+// SendSRv6ManagerReleaseLocatorChunk sends SRV6_MANAGER_RELEASE_LOCATOR_CHUNK message to zebra daemon.
+func (c *Client) SendSRv6ManagerReleaseLocatorChunk(locator string) error {
+	if !c.SupportSRv6() {
+		return fmt.Errorf("SRv6ManagerReleaseLocatorChunk is not supported in zebra API version: %d software: %s", c.Version, c.Software.string())
+	}
+	return c.sendCommand(srv6ManagerReleaseLocatorChunk, DefaultVrf, &srv6LocatorNameBody{name: locator})
+}
+
+// SendSRv6LocalSID sends ROUTE message for the seg6local route of the SID to
+// zebra daemon. table is the VRF table used by End.DT4/End.DT6/End.DT46 and
+// oif is the interface where the decapsulated packets are processed.
+// The seg6local route is withdrawn when isWithdraw is true.
+// Ref: zclient_send_localsid in lib/zclient.c of FRR8.1
+func (c *Client) SendSRv6LocalSID(sid net.IP, oif uint32, action Seg6LocalAction, table uint32, isWithdraw bool) error {
+	if !c.SupportSRv6() {
+		return fmt.Errorf("SRv6 local SID is not supported in zebra API version: %d software: %s", c.Version, c.Software.string())
+	}
+	body := &IPRouteBody{
+		Type: RouteBGP,
+		Safi: SafiUnicast,
+		Prefix: Prefix{
+			Family:    syscall.AF_INET6,
+			PrefixLen: 128,
+			Prefix:    sid.To16(),
+		},
+	}
+	if !isWithdraw {
+		body.Flags = FlagAllowRecursion
+		body.Message = MessageNexthop
+		body.Nexthops = []Nexthop{{
+			Type:            nexthopTypeIFIndex,
+			VrfID:           DefaultVrf,
+			Ifindex:         oif,
+			flags:           zapiNexthopFlagSeg6Local,
+			seg6localAction: uint32(action),
+			seg6localCtx:    seg6localContext{table: table},
+		}}
+	}
+	return c.SendIPRoute(DefaultVrf, body, isWithdraw)
+}
+
 // for avoiding double close
 func closeChannel(ch chan *Message) bool {
 	select {
@@ -1702,6 +1769,19 @@ func (c *Client) close() error {
 	return c.conn.Close()
 }
 
+// Close closes the connection to zebra and stops the goroutines sending
+// and receiving the messages.
+func (c *Client) Close() error {
+	func() {
+		// the outgoing channel might have been closed on a write error
+		defer func() {
+			recover()
+		}()
+		close(c.outgoing)
+	}()
+	return c.conn.Close()
+}
+
 // SetLabelFlag is referred in zclient, this func sets label flag
 func (c Client) SetLabelFlag(msgFlags *MessageFlag, nexthop *Nexthop) {
 	if c.Version == 6 && c.Software.name == "frr" {
@@ -1711,6 +1791,18 @@ func (c Client) SetLabelFlag(msgFlags *MessageFlag, nexthop *Nexthop) {
 	}
 }
 
+// SetSeg6Flag is referred in zclient, this func sets the SRv6 SID to nexthop
+// so that zebra encapsulates the packets with the SID (H.Encaps). It returns
+// false if zebra doesn't support SRv6.
+func (c Client) SetSeg6Flag(nexthop *Nexthop, sid net.IP) bool {
+	if !c.SupportSRv6() {
+		return false
+	}
+	nexthop.flags |= zapiNexthopFlagSeg6
+	nexthop.seg6Segs = sid.To16()
+	return true
+}
+
 // Header is header of zebra message.
 type Header struct {
 	Len     uint16
@@ -2134,10 +2226,19 @@ type seg6localContext struct {
 
 func (s6lc seg6localContext) encode() []byte {
 	var buf []byte
-	buf = append(buf, s6lc.nh4.To4()...)
-	buf = append(buf, s6lc.nh6.To16()...)
+	nh4, nh6 := s6lc.nh4.To4(), s6lc.nh6.To16()
+	if nh4 == nil {
+		nh4 = net.IPv4zero.To4()
+	}
+	if nh6 == nil {
+		nh6 = net.IPv6zero
+	}
+	buf = append(buf, nh4...)
+	buf = append(buf, nh6...)
 	tmpbuf := make([]byte, 4)
-	binary.BigEndian.PutUint32(tmpbuf, s6lc.table)
+	// frr uses stream_write for seg6local_context which is unaware of
+	// byteorder. Therefore LittleEndian is used instead of BigEndian.
+	binary.LittleEndian.PutUint32(tmpbuf, s6lc.table)
 	buf = append(buf, tmpbuf...)
 	return buf
 }
@@ -2147,11 +2248,37 @@ func (s6lc *seg6localContext) decode(data []byte) int {
 	offset += 4
 	s6lc.nh6 = net.IP(data[offset : offset+16]).To16()
 	offset += 16
-	s6lc.table = binary.BigEndian.Uint32(data[offset : offset+4])
+	s6lc.table = binary.LittleEndian.Uint32(data[offset : offset+4])
 	offset += 4
 	return offset
 }
 
+// Seg6LocalAction is referred in zclient (Ref: enum seg6local_action_t in lib/srv6.h of FRR8.1)
+//
+//go:generate stringer -type=Seg6LocalAction
+type Seg6LocalAction uint32
+
+// For Seg6LocalAction
+const (
+	Seg6LocalActionUnspec     Seg6LocalAction = iota
+	Seg6LocalActionEnd                        // Node segment
+	Seg6LocalActionEndX                       // Adjacency segment (IPv6 cross-connect)
+	Seg6LocalActionEndT                       // Lookup of next seg NH in table
+	Seg6LocalActionEndDX2                     // Decap and L2 cross-connect
+	Seg6LocalActionEndDX6                     // Decap and IPv6 cross-connect
+	Seg6LocalActionEndDX4                     // Decap and IPv4 cross-connect
+	Seg6LocalActionEndDT6                     // Decap and lookup of DA in v6 table
+	Seg6LocalActionEndDT4                     // Decap and lookup of DA in v4 table
+	Seg6LocalActionEndB6                      // Binding segment to insert SRH
+	Seg6LocalActionEndB6Encap                 // Binding segment with encapsulation
+	Seg6LocalActionEndBM                      // Binding segment to MPLS
+	Seg6LocalActionEndS                       // End.S
+	Seg6LocalActionEndAS                      // End.AS
+	Seg6LocalActionEndAM                      // End.AM
+	Seg6LocalActionEndBPF                     // Custom BPF action
+	Seg6LocalActionEndDT46                    // Decap and lookup of DA in v4 or v6 table
+)
+
 // Ref: struct zapi_nexthop in lib/zclient.h of FRR5&FRR6&FRR7.x&FRR8, FRR8.1 (ZAPI5&6)
 // Nexthop is referred in zclient
 type Nexthop struct {
@@ -2185,6 +2312,13 @@ func (n Nexthop) string() string {
 	for i := uint8(0); i < n.backupNum; i++ {
 		s = append(s, fmt.Sprintf(" backupIndex[%d]: %d", i, n.backupIndex[i]))
 	}
+	if n.flags&zapiNexthopFlagSeg6Local > 0 {
+		s = append(s, fmt.Sprintf(" seg6localAction: %s, seg6localTable: %d",
+			Seg6LocalAction(n.seg6localAction).String(), n.seg6localCtx.table))
+	}
+	if n.flags&zapiNexthopFlagSeg6 > 0 {
+		s = append(s, fmt.Sprintf(" seg6Segs: %s", n.seg6Segs.String()))
+	}
 	return strings.Join(s, ", ")
 }
 func (n Nexthop) gateToType(version uint8) nexthopType {
@@ -2303,7 +2437,7 @@ func (n Nexthop) encode(version uint8, software Software, processFlag nexthopPro
 		buf = append(buf, tmpbuf...)
 	}
 	// added in frr8.1
-	if n.flags&zapiNexthopFlagSeg6 > 0 {
+	if n.flags&zapiNexthopFlagSeg6Local > 0 {
 		tmpbuf := make([]byte, 4)
 		binary.BigEndian.PutUint32(tmpbuf, uint32(n.seg6localAction))
 		buf = append(buf, tmpbuf...) // stream_putl(s, api_nh->seg6local_action);
@@ -2311,7 +2445,7 @@ func (n Nexthop) encode(version uint8, software Software, processFlag nexthopPro
 		buf = append(buf, n.seg6localCtx.encode()...)
 	}
 	// added in frr8.1
-	if n.flags&zapiNexthopFlagSeg6Local > 0 {
+	if n.flags&zapiNexthopFlagSeg6 > 0 {
 		//frr: stream_write(s, &api_nh->seg6_segs, sizeof(struct in6_addr));
 		buf = append(buf, n.seg6Segs.To16()...)
 	}
@@ -2423,13 +2557,16 @@ func (n *Nexthop) decode(data []byte, version uint8, software Software, family u
 		}
 	}
 	// added in frr8.1
-	if n.flags&zapiNexthopFlagSeg6 > 0 {
+	if n.flags&zapiNexthopFlagSeg6Local > 0 {
+		//frr: STREAM_GETL(s, api_nh->seg6local_action);
 		n.seg6localAction = binary.BigEndian.Uint32(data[offset : offset+4])
 		offset += 4
+		//frr: STREAM_GET(&api_nh->seg6local_ctx, s, sizeof(struct seg6local_context));
 		offset += n.seg6localCtx.decode(data[offset : offset+24])
 	}
 	// added in frr8.1
-	if n.flags&zapiNexthopFlagSeg6Local > 0 {
+	if n.flags&zapiNexthopFlagSeg6 > 0 {
+		//frr: STREAM_GET(&api_nh->seg6_segs, s, sizeof(struct in6_addr));
 		n.seg6Segs = net.IP(data[offset : offset+16]).To16()
 		offset += 16
 	}
@@ -3526,6 +3663,120 @@ func (b *vrfLabelBody) string(version uint8, software Software) string {
 		b.label, b.afi, b.labelType)
 }
 
+// SRv6LocatorBody is referred in zclient (Ref: struct srv6_locator in lib/srv6.h of FRR8.1)
+type SRv6LocatorBody struct {
+	Name   string
+	Prefix Prefix
+}
+
+// Ref: zapi_srv6_locator_encode in lib/zclient.c of FRR8.1
+func (b *SRv6LocatorBody) serialize(version uint8, software Software) ([]byte, error) {
+	buf := make([]byte, 2, 2+len(b.Name)+2+16)
+	binary.BigEndian.PutUint16(buf[0:2], uint16(len(b.Name))) //frr: stream_putw(s, strlen(l->name));
+	buf = append(buf, []byte(b.Name)...)                      //frr: stream_put(s, l->name, strlen(l->name));
+	tmpbuf := make([]byte, 2)
+	binary.BigEndian.PutUint16(tmpbuf, uint16(b.Prefix.PrefixLen))
+	buf = append(buf, tmpbuf...) //frr: stream_putw(s, l->prefix.prefixlen);
+	//frr: stream_put(s, &l->prefix.prefix, sizeof(l->prefix.prefix));
+	buf = append(buf, b.Prefix.Prefix.To16()...)
+	return buf, nil
+}
+
+// Ref: zapi_srv6_locator_decode in lib/zclient.c of FRR8.1
+func (b *SRv6LocatorBody) decodeFromBytes(data []byte, version uint8, software Software) error {
+	_, err := b.decode(data)
+	return err
+}
+
+func (b *SRv6LocatorBody) decode(data []byte) (int, error) {
+	if len(data) < 2 {
+		return 0, fmt.Errorf("invalid message length for SRv6Locator message: %d<2", len(data))
+	}
+	nameLen := int(binary.BigEndian.Uint16(data[0:2])) //frr: STREAM_GETW(s, len);
+	if len(data) < 2+nameLen+2+16 {
+		return 0, fmt.Errorf("invalid message length for SRv6Locator message: %d<%d",
+			len(data), 2+nameLen+2+16)
+	}
+	offset := 2
+	b.Name = string(data[offset : offset+nameLen]) //frr: STREAM_GET(l->name, s, len);
+	offset += nameLen
+	b.Prefix.Family = syscall.AF_INET6
+	//frr: STREAM_GETW(s, l->prefix.prefixlen);
+	b.Prefix.PrefixLen = uint8(binary.BigEndian.Uint16(data[offset : offset+2]))
+	offset += 2
+	//frr: STREAM_GET(&l->prefix.prefix, s, sizeof(l->prefix.prefix));
+	b.Prefix.Prefix = net.IP(append([]byte{}, data[offset:offset+16]...)).To16()
+	offset += 16
+	return offset, nil
+}
+
+func (b *SRv6LocatorBody) string(version uint8, software Software) string {
+	return fmt.Sprintf("name: %s, prefix: %s/%d",
+		b.Name, b.Prefix.Prefix.String(), b.Prefix.PrefixLen)
+}
+
+// SRv6LocatorChunkBody is referred in zclient (Ref: struct srv6_locator_chunk in lib/srv6.h of FRR8.1)
+type SRv6LocatorChunkBody struct {
+	SRv6LocatorBody
+	BlockBitsLength    uint8
+	NodeBitsLength     uint8
+	FunctionBitsLength uint8
+	ArgumentBitsLength uint8
+}
+
+// Ref: zapi_srv6_locator_chunk_encode in lib/zclient.c of FRR8.1
+func (b *SRv6LocatorChunkBody) serialize(version uint8, software Software) ([]byte, error) {
+	buf, _ := b.SRv6LocatorBody.serialize(version, software)
+	return append(buf, b.BlockBitsLength, b.NodeBitsLength,
+		b.FunctionBitsLength, b.ArgumentBitsLength), nil
+}
+
+// Ref: zapi_srv6_locator_chunk_decode in lib/zclient.c of FRR8.1
+func (b *SRv6LocatorChunkBody) decodeFromBytes(data []byte, version uint8, software Software) error {
+	offset, err := b.SRv6LocatorBody.decode(data)
+	if err != nil {
+		return err
+	}
+	// zebra which doesn't know the structure of the locator omits the length of each part.
+	if len(data) >= offset+4 {
+		b.BlockBitsLength = data[offset]      //frr: STREAM_GETC(s, c->block_bits_length);
+		b.NodeBitsLength = data[offset+1]     //frr: STREAM_GETC(s, c->node_bits_length);
+		b.FunctionBitsLength = data[offset+2] //frr: STREAM_GETC(s, c->function_bits_length);
+		b.ArgumentBitsLength = data[offset+3] //frr: STREAM_GETC(s, c->argument_bits_length);
+	}
+	return nil
+}
+
+func (b *SRv6LocatorChunkBody) string(version uint8, software Software) string {
+	return fmt.Sprintf("%s, block: %d, node: %d, function: %d, argument: %d",
+		b.SRv6LocatorBody.string(version, software), b.BlockBitsLength,
+		b.NodeBitsLength, b.FunctionBitsLength, b.ArgumentBitsLength)
+}
+
+type srv6LocatorNameBody struct {
+	name string
+}
+
+// Ref: srv6_manager_get_locator_chunk in lib/zclient.c of FRR8.1
+// Ref: srv6_manager_release_locator_chunk in lib/zclient.c of FRR8.1
+func (b *srv6LocatorNameBody) serialize(version uint8, software Software) ([]byte, error) {
+	buf := make([]byte, 2, 2+len(b.name))
+	binary.BigEndian.PutUint16(buf[0:2], uint16(len(b.name))) //frr: stream_putw(s, len);
+	return append(buf, []byte(b.name)...), nil                //frr: stream_put(s, locator_name, len);
+}
+
+func (b *srv6LocatorNameBody) decodeFromBytes(data []byte, version uint8, software Software) error {
+	if len(data) < 2 || len(data) < 2+int(binary.BigEndian.Uint16(data[0:2])) {
+		return fmt.Errorf("invalid message length for SRv6 locator name: %d", len(data))
+	}
+	b.name = string(data[2 : 2+int(binary.BigEndian.Uint16(data[0:2]))])
+	return nil
+}
+
+func (b *srv6LocatorNameBody) string(version uint8, software Software) string {
+	return fmt.Sprintf("locator: %s", b.name)
+}
+
 // Message is referred in zclient
 type Message struct {
 	Header Header
@@ -3576,6 +3827,12 @@ func parseMessage(hdr *Header, data []byte, software Software) (m *Message, err
 		m.Body = &releaseLabelChunkBody{}
 	case vrfLabel:
 		m.Body = &vrfLabelBody{}
+	case srv6LocatorAdd, srv6LocatorDelete:
+		m.Body = &SRv6LocatorBody{}
+	case srv6ManagerGetLocatorChunk:
+		m.Body = &SRv6LocatorChunkBody{}
+	case srv6ManagerReleaseLocatorChunk:
+		m.Body = &srv6LocatorNameBody{}
 	case RouteAdd, RouteDelete, BackwardIPv6RouteAdd, BackwardIPv6RouteDelete: // for quagga
 		m.Body = &IPRouteBody{API: m.Header.Command}
 	case ipv4NexthopLookupMRIB:
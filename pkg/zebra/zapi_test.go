@@ -1121,6 +1121,107 @@ func Test_vrfLabelBody(t *testing.T) {
 	}
 }
 
+func Test_SRv6LocatorChunkBody(t *testing.T) {
+	assert := assert.New(t)
+
+	software := NewSoftware(6, "frr8.1")
+	bufIn := []byte{0x00, 0x04, 'l', 'o', 'c', '1', 0x00, 0x30}
+	bufIn = append(bufIn, net.ParseIP("2001:db8:1:1::").To16()...)
+	bufIn = append(bufIn, 40, 8, 16, 0)
+
+	b := &SRv6LocatorChunkBody{}
+	err := b.decodeFromBytes(bufIn, 6, software)
+	assert.Nil(err)
+	assert.Equal("loc1", b.Name)
+	assert.Equal(uint8(48), b.Prefix.PrefixLen)
+	assert.Equal("2001:db8:1:1::", b.Prefix.Prefix.String())
+	assert.Equal(uint8(40), b.BlockBitsLength)
+	assert.Equal(uint8(8), b.NodeBitsLength)
+	assert.Equal(uint8(16), b.FunctionBitsLength)
+	assert.Equal(uint8(0), b.ArgumentBitsLength)
+
+	bufOut, err := b.serialize(6, software)
+	assert.Nil(err)
+	assert.Equal(bufIn, bufOut)
+
+	// zebra of frr8.1 may omit the structure of the locator.
+	b = &SRv6LocatorChunkBody{}
+	err = b.decodeFromBytes(bufIn[:len(bufIn)-4], 6, software)
+	assert.Nil(err)
+	assert.Equal("loc1", b.Name)
+	assert.Equal(uint8(0), b.BlockBitsLength)
+
+	err = b.decodeFromBytes(bufIn[:10], 6, software)
+	assert.NotNil(err)
+}
+
+func Test_srv6APIType(t *testing.T) {
+	assert := assert.New(t)
+
+	frr8dot1 := NewSoftware(6, "frr8.1")
+	assert.Equal(srv6LocatorAdd, srv6LocatorAdd.ToEach(6, frr8dot1))
+	assert.Equal(srv6ManagerGetLocatorChunk, srv6ManagerGetLocatorChunk.ToCommon(6, frr8dot1))
+
+	frr8dot2 := NewSoftware(6, "frr8.2")
+	assert.Equal(APIType(114), srv6LocatorAdd.ToEach(6, frr8dot2))
+	assert.Equal(APIType(117), srv6ManagerReleaseLocatorChunk.ToEach(6, frr8dot2))
+	assert.Equal(srv6ManagerGetLocatorChunk, APIType(116).ToCommon(6, frr8dot2))
+	assert.Equal("srv6LocatorDelete", srv6LocatorDelete.String())
+}
+
+func Test_Nexthop_seg6(t *testing.T) {
+	assert := assert.New(t)
+
+	software := NewSoftware(6, "frr8.1")
+	processFlag := nexthopProcessFlagForIPRouteBody(6, software, false)
+	c := Client{Version: 6, Software: software}
+
+	// SRv6 encapsulation (H.Encaps)
+	nexthop := Nexthop{
+		Type: nexthopTypeIPv6,
+		Gate: net.ParseIP("2001:db8::1"),
+	}
+	assert.True(c.SetSeg6Flag(&nexthop, net.ParseIP("fd00:1:1:1::")))
+	buf := nexthop.encode(6, software, processFlag, MessageNexthop, Flag(0))
+	// vrf_id(4) + type(1) + flags(1) + gate(16) + ifindex(4) + seg6_segs(16)
+	assert.Equal(42, len(buf))
+	assert.Equal(zapiNexthopFlagSeg6, buf[5])
+	assert.Equal(net.ParseIP("fd00:1:1:1::").To16(), net.IP(buf[26:42]))
+
+	decoded := Nexthop{}
+	n, err := decoded.decode(buf, 6, software, syscall.AF_INET6, processFlag, MessageNexthop, Flag(0), nexthopType(0))
+	assert.Nil(err)
+	assert.Equal(len(buf), n)
+	assert.Equal("fd00:1:1:1::", decoded.seg6Segs.String())
+
+	// seg6local (End.DT4)
+	nexthop = Nexthop{
+		Type:            nexthopTypeIFIndex,
+		Ifindex:         10,
+		flags:           zapiNexthopFlagSeg6Local,
+		seg6localAction: uint32(Seg6LocalActionEndDT4),
+		seg6localCtx:    seg6localContext{table: 100},
+	}
+	buf = nexthop.encode(6, software, processFlag, MessageNexthop, Flag(0))
+	// vrf_id(4) + type(1) + flags(1) + ifindex(4) + action(4) + seg6local_ctx(24)
+	assert.Equal(38, len(buf))
+	assert.Equal([]byte{0, 0, 0, 8}, buf[10:14])
+	assert.Equal([]byte{100, 0, 0, 0}, buf[34:38])
+
+	decoded = Nexthop{}
+	n, err = decoded.decode(buf, 6, software, syscall.AF_INET6, processFlag, MessageNexthop, Flag(0), nexthopType(0))
+	assert.Nil(err)
+	assert.Equal(len(buf), n)
+	assert.Equal(uint32(Seg6LocalActionEndDT4), decoded.seg6localAction)
+	assert.Equal(uint32(100), decoded.seg6localCtx.table)
+
+	// SRv6 isn't supported by frr8.0
+	c = Client{Version: 6, Software: NewSoftware(6, "frr8")}
+	nexthop = Nexthop{}
+	assert.False(c.SetSeg6Flag(&nexthop, net.ParseIP("fd00:1:1:1::")))
+	assert.Equal(uint8(0), nexthop.flags)
+}
+
 func FuzzZapi(f *testing.F) {
 
 	f.Fuzz(func(t *testing.T, data []byte) {
@@ -89,8 +89,8 @@ class FlowSpecTest(unittest.TestCase):
             route='ipv6/dst/src/label',  # others are tested on IPv4
             rf='ipv6-flowspec',
             matchs=[
-                'destination 2001:1::/64 10',
-                'source 2001:2::/64 15',
+                'destination 1:1::/64 10',
+                'source 1:2::/64 15',
                 'label 12',
             ],
             thens=['discard'])
@@ -126,8 +126,8 @@ class FlowSpecTest(unittest.TestCase):
             route='ipv6/dst/src/protocol/label',  # others are tested on IPv4
             rf='ipv6-flowspec',
             matchs=[
-                'destination 2002:1::/64/10',
-                'source 2002:2::/64/15',
+                'destination 1:3::/64/10',
+                'source 1:4::/64/15',
                 'next-header udp',
                 'flow-label >100',
             ],
@@ -157,8 +157,8 @@ class FlowSpecTest(unittest.TestCase):
         #     route='ipv6/dst/src/label',  # others are tested on IPv4
         #     rf='ipv6-flowspec',
         #     matchs=[
-        #         'destination 2003:1::/64/10',
-        #         'source 2003:2::/64/15',
+        #         'destination 1:5::/64/10',
+        #         'source 1:6::/64/15',
         #         'label 12',
         #     ],
         #     thens=['traffic-rate:0:0'])  # 'discard'
@@ -204,10 +204,10 @@ class FlowSpecTest(unittest.TestCase):
         nlri = list(rib)[0]  # advertised from G1(GoBGP)
         expected = (
             # INPUTS:
-            # 'destination 2001:1::/64 10',
-            "destination-ipv6 2001:1::/64/10 "
-            # 'source 2001:2::/64 15',
-            "source-ipv6 2001:2::/64/15 "
+            # 'destination 1:1::/64 10',
+            "destination-ipv6 1:1::/64/10 "
+            # 'source 1:2::/64 15',
+            "source-ipv6 1:2::/64/15 "
             # 'label 12',
             "flow-label =12"
         )
@@ -339,19 +339,19 @@ class FlowSpecTest(unittest.TestCase):
         output_nlri_list = [r['prefix'] for r in rib]
         nlri_g1 = (
             # INPUTS:
-            # 'destination 2001:1::/64 10',
-            "[destination: 2001:1::/64/10]"
-            # 'source 2001:2::/64 15',
-            "[source: 2001:2::/64/15]"
+            # 'destination 1:1::/64 10',
+            "[destination: 1:1::/64/10]"
+            # 'source 1:2::/64 15',
+            "[source: 1:2::/64/15]"
             # 'label 12',
             "[label: ==12]"
         )
         nlri_e1 = (
             # INPUTS:
-            # 'destination 2002:1::/64/10',
-            '[destination: 2002:1::/64/10]'
-            # 'source 2002:2::/64/15',
-            '[source: 2002:2::/64/15]'
+            # 'destination 1:3::/64/10',
+            '[destination: 1:3::/64/10]'
+            # 'source 1:4::/64/15',
+            '[source: 1:4::/64/15]'
             # 'next-header udp',
             '[protocol: ==udp]'
             # 'flow-label >100',
@@ -21,7 +21,9 @@ fi
 echo "installing plugins"
 go install google.golang.org/protobuf/cmd/protoc-gen-go@v1.28.1
 go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@v1.1.0
+go install github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-grpc-gateway@v2.15.2
 
 echo "generating code"
 protoc -I api -I /usr/local/include \
-       --go_out=api --go_opt=paths=source_relative --go-grpc_out=api --go-grpc_opt=paths=source_relative api/*.proto
+       --go_out=api --go_opt=paths=source_relative --go-grpc_out=api --go-grpc_opt=paths=source_relative api/*.proto \
+       --grpc-gateway_out=api --grpc-gateway_opt=paths=source_relative,grpc_api_configuration=api/gobgp_gateway.yaml
@@ -0,0 +1,52 @@
+#!/usr/bin/env python
+
+from __future__ import absolute_import
+from __future__ import print_function
+
+import grpc
+
+import gobgp_pb2
+import gobgp_pb2_grpc
+
+_TIMEOUT_SECONDS = 1000
+
+
+def run():
+    channel = grpc.insecure_channel('localhost:50051')
+    stub = gobgp_pb2_grpc.GobgpApiStub(channel)
+
+    # all the peers in a single response
+    peers = stub.ListPeerAggregated(
+        gobgp_pb2.ListPeerRequest(),
+        _TIMEOUT_SECONDS,
+    )
+    for peer in peers.peers:
+        print(peer.conf.neighbor_address, peer.state.session_state)
+
+    events = stub.WatchEvent(
+        gobgp_pb2.WatchEventRequest(
+            table=gobgp_pb2.WatchEventRequest.Table(
+                filters=[
+                    gobgp_pb2.WatchEventRequest.Table.Filter(
+                        type=gobgp_pb2.WatchEventRequest.Table.Filter.BEST,
+                        init=True,
+                    ),
+                ],
+            ),
+            notify_ready=True,
+        ),
+    )
+
+    for event in events:
+        kind = event.WhichOneof('event')
+        if kind == 'ready':
+            # the best paths before are the current ones, and the ones
+            # after are the changes
+            print('ready')
+        elif kind == 'table':
+            for path in event.table.paths:
+                print('withdraw' if path.is_withdraw else 'update', path.nlri)
+
+
+if __name__ == '__main__':
+    run()